@@ -61,37 +61,167 @@ type ClusterResourceSetSpec struct {
 	ClusterSelector metav1.LabelSelector `json:"clusterSelector,omitempty,omitzero"`
 
 	// resources is a list of Secrets/ConfigMaps where each contains 1 or more resources to be applied to remote clusters.
+	// Resources of kind OCIArtifact or HelmChart refer to entries in sources, which are rendered before being applied.
 	// +required
 	// +listType=atomic
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=100
 	Resources []ResourceRef `json:"resources,omitempty"`
 
+	// sources is a list of OCI artifacts and Helm charts that can be referenced in resources
+	// using kind OCIArtifact or HelmChart.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	Sources []ClusterResourceSetSource `json:"sources,omitempty"`
+
 	// strategy is the strategy to be used during applying resources. Defaults to ApplyOnce. This field is immutable.
 	// +kubebuilder:validation:Enum=ApplyOnce;Reconcile
 	// +optional
 	Strategy string `json:"strategy,omitempty"`
 }
 
+// ClusterResourceSetSource defines an OCI artifact or a Helm chart to be rendered and applied to remote clusters.
+// Exactly one of ociArtifact or helmChart must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.ociArtifact) != has(self.helmChart)",message="exactly one of ociArtifact or helmChart must be set"
+type ClusterResourceSetSource struct {
+	// name of the source, used to refer to the source in resources.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+
+	// ociArtifact defines an OCI artifact containing resources to be applied to remote clusters.
+	// +optional
+	OCIArtifact *OCIArtifactSource `json:"ociArtifact,omitempty"`
+
+	// helmChart defines a Helm chart to be rendered and applied to remote clusters.
+	// +optional
+	HelmChart *HelmChartSource `json:"helmChart,omitempty"`
+}
+
+// OCIArtifactSource defines an OCI artifact containing resources to be applied to remote clusters.
+// Each layer of the OCI artifact must contain a file with one or more resources, in yaml or json format;
+// layers are identified by the org.opencontainers.image.title annotation, like e.g. when using
+// `oras push registry.example.com/addons/cni:v1.0.0 cni.yaml`.
+type OCIArtifactSource struct {
+	// url of the OCI artifact, in the form oci://{registry}/{repository}:{tag} or oci://{registry}/{repository}@{digest}.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	// +kubebuilder:validation:Pattern=`^oci://`
+	URL string `json:"url,omitempty"`
+
+	// files is the list of files in the OCI artifact to be applied; files are applied in the given order.
+	// If not set, all the files in the OCI artifact are applied in the order of the layers.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=256
+	Files []string `json:"files,omitempty"`
+
+	// pullSecretName is the name of a Secret of type kubernetes.io/dockerconfigjson, in the same namespace
+	// of the ClusterResourceSet, with the credentials for the registry.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	PullSecretName string `json:"pullSecretName,omitempty"`
+}
+
+// HelmChartSource defines a Helm chart to be rendered and applied to remote clusters.
+type HelmChartSource struct {
+	// url of the Helm chart; supported values are a chart pushed to an OCI registry, in the form
+	// oci://{registry}/{repository}:{version}, or a chart archive served over https, e.g. https://example.com/charts/cni-1.0.0.tgz.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	// +kubebuilder:validation:Pattern=`^(oci|https)://`
+	URL string `json:"url,omitempty"`
+
+	// releaseName is the name of the release used when rendering the chart.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=53
+	ReleaseName string `json:"releaseName,omitempty"`
+
+	// releaseNamespace is the namespace of the release used when rendering the chart,
+	// and the namespace namespaced resources without a namespace are created in. Defaults to default.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	ReleaseNamespace string `json:"releaseNamespace,omitempty"`
+
+	// valuesFrom is a reference to a ConfigMap, in the same namespace of the ClusterResourceSet,
+	// with the values used when rendering the chart; values are merged on top of the default values of the chart.
+	// +optional
+	ValuesFrom HelmChartValuesReference `json:"valuesFrom,omitempty,omitzero"`
+
+	// pullSecretName is the name of a Secret of type kubernetes.io/dockerconfigjson, in the same namespace
+	// of the ClusterResourceSet, with the credentials for the registry.
+	// Only used for charts pushed to an OCI registry.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	PullSecretName string `json:"pullSecretName,omitempty"`
+}
+
+// HelmChartValuesReference is a reference to a ConfigMap with values for a Helm chart.
+// +kubebuilder:validation:MinProperties=1
+type HelmChartValuesReference struct {
+	// configMapName is the name of the ConfigMap with the values.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// key in the ConfigMap containing the values in yaml format. Defaults to values.yaml.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Key string `json:"key,omitempty"`
+}
+
+// IsDefined returns true if the HelmChartValuesReference is set.
+func (r *HelmChartValuesReference) IsDefined() bool {
+	return r.ConfigMapName != ""
+}
+
+// GetSource returns the source with the given name, if any.
+func (c *ClusterResourceSetSpec) GetSource(name string) *ClusterResourceSetSource {
+	for i := range c.Sources {
+		if c.Sources[i].Name == name {
+			return &c.Sources[i]
+		}
+	}
+	return nil
+}
+
 // ClusterResourceSetResourceKind is a string representation of a ClusterResourceSet resource kind.
 type ClusterResourceSetResourceKind string
 
 // Define the ClusterResourceSetResourceKind constants.
 const (
-	SecretClusterResourceSetResourceKind    ClusterResourceSetResourceKind = "Secret"
-	ConfigMapClusterResourceSetResourceKind ClusterResourceSetResourceKind = "ConfigMap"
+	SecretClusterResourceSetResourceKind      ClusterResourceSetResourceKind = "Secret"
+	ConfigMapClusterResourceSetResourceKind   ClusterResourceSetResourceKind = "ConfigMap"
+	OCIArtifactClusterResourceSetResourceKind ClusterResourceSetResourceKind = "OCIArtifact"
+	HelmChartClusterResourceSetResourceKind   ClusterResourceSetResourceKind = "HelmChart"
 )
 
 // ResourceRef specifies a resource.
 type ResourceRef struct {
 	// name of the resource that is in the same namespace with ClusterResourceSet object.
+	// For resources of kind OCIArtifact or HelmChart, name of the entry in spec.sources.
 	// +required
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=253
 	Name string `json:"name,omitempty"`
 
-	// kind of the resource. Supported kinds are: Secrets and ConfigMaps.
-	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	// kind of the resource. Supported kinds are: Secrets, ConfigMaps, OCIArtifact and HelmChart.
+	// +kubebuilder:validation:Enum=Secret;ConfigMap;OCIArtifact;HelmChart
 	// +required
 	Kind string `json:"kind,omitempty"`
 }
@@ -23,6 +23,33 @@ import (
 	"k8s.io/utils/ptr"
 )
 
+// ClusterResourceSetBinding's ResourcesApplied condition and corresponding reasons.
+const (
+	// ClusterResourceSetBindingResourcesAppliedCondition surfaces whether the resources of all the ClusterResourceSets
+	// in the ClusterResourceSetBinding are applied to the cluster the binding belongs to.
+	ClusterResourceSetBindingResourcesAppliedCondition = "ResourcesApplied"
+
+	// ClusterResourceSetBindingResourcesAppliedReason is the reason used when all the resources of all the ClusterResourceSets
+	// in the ClusterResourceSetBinding got applied to the cluster.
+	ClusterResourceSetBindingResourcesAppliedReason = "Applied"
+
+	// ClusterResourceSetBindingResourcesNotAppliedReason is the reason used when at least one of the resources of
+	// the ClusterResourceSets in the ClusterResourceSetBinding is not applied to the cluster.
+	ClusterResourceSetBindingResourcesNotAppliedReason = "NotApplied"
+)
+
+// ResourceBinding reasons.
+const (
+	// ResourceBindingAppliedReason is the reason used when a resource got applied to the cluster.
+	ResourceBindingAppliedReason = "Applied"
+
+	// ResourceBindingInvalidResourceReason is the reason used when the objects of a resource cannot be parsed.
+	ResourceBindingInvalidResourceReason = "InvalidResource"
+
+	// ResourceBindingApplyFailedReason is the reason used when applying the objects of a resource to the cluster failed.
+	ResourceBindingApplyFailedReason = "ApplyFailed"
+)
+
 // ResourceBinding shows the status of a resource that belongs to a ClusterResourceSet matched by the owner cluster of the ClusterResourceSetBinding object.
 type ResourceBinding struct {
 	// ResourceRef specifies a resource.
@@ -42,6 +69,20 @@ type ResourceBinding struct {
 	// applied is to track if a resource is applied to the cluster or not.
 	// +required
 	Applied *bool `json:"applied,omitempty"`
+
+	// reason is the CamelCase reason for the outcome of the last attempt to apply this resource to the cluster,
+	// e.g. Applied, InvalidResource or ApplyFailed.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Reason string `json:"reason,omitempty"`
+
+	// message is a human readable message with details about the outcome of the last attempt to apply this
+	// resource to the cluster, e.g. the error returned when applying it.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty"`
 }
 
 // ResourceSetBinding keeps info on all of the resources in a ClusterResourceSet.
@@ -111,10 +152,22 @@ func (c *ClusterResourceSetBinding) RemoveBinding(clusterResourceSet *ClusterRes
 	}
 }
 
+// GetConditions returns the set of conditions for this object.
+func (c *ClusterResourceSetBinding) GetConditions() []metav1.Condition {
+	return c.Status.Conditions
+}
+
+// SetConditions sets conditions for an API object.
+func (c *ClusterResourceSetBinding) SetConditions(conditions []metav1.Condition) {
+	c.Status.Conditions = conditions
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=clusterresourcesetbindings,scope=Namespaced,categories=cluster-api
+// +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterName",description="Cluster"
+// +kubebuilder:printcolumn:name="Applied",type="string",JSONPath=`.status.conditions[?(@.type=="ResourcesApplied")].status`,description="Resources applied"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of ClusterResourceSetBinding"
 
 // ClusterResourceSetBinding lists all matching ClusterResourceSets with the cluster it belongs to.
@@ -127,6 +180,9 @@ type ClusterResourceSetBinding struct {
 	// spec is the desired state of ClusterResourceSetBinding.
 	// +required
 	Spec ClusterResourceSetBindingSpec `json:"spec,omitempty,omitzero"`
+	// status is the observed state of ClusterResourceSetBinding.
+	// +optional
+	Status ClusterResourceSetBindingStatus `json:"status,omitempty,omitzero"`
 }
 
 // ClusterResourceSetBindingSpec defines the desired state of ClusterResourceSetBinding.
@@ -144,6 +200,18 @@ type ClusterResourceSetBindingSpec struct {
 	ClusterName string `json:"clusterName,omitempty"`
 }
 
+// ClusterResourceSetBindingStatus defines the observed state of ClusterResourceSetBinding.
+// +kubebuilder:validation:MinProperties=1
+type ClusterResourceSetBindingStatus struct {
+	// conditions represents the observations of a ClusterResourceSetBinding's current state.
+	// Known condition types are ResourcesApplied.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 
 // ClusterResourceSetBindingList contains a list of ClusterResourceSetBinding.
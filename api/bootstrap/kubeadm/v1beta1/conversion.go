@@ -84,6 +84,11 @@ func RestoreKubeadmConfigSpec(restored *bootstrapv1.KubeadmConfigSpec, dst *boot
 	if restored.ClusterConfiguration.EncryptionAlgorithm != "" {
 		dst.ClusterConfiguration.EncryptionAlgorithm = restored.ClusterConfiguration.EncryptionAlgorithm
 	}
+	dst.TrustedCertificateAuthorities = restored.TrustedCertificateAuthorities
+	dst.AdditionalUserData = restored.AdditionalUserData
+	dst.Ignition.Passthrough = restored.Ignition.Passthrough
+	dst.Diagnostics = restored.Diagnostics
+	dst.Templating = restored.Templating
 }
 
 func RestoreBoolIntentKubeadmConfigSpec(src *KubeadmConfigSpec, dst *bootstrapv1.KubeadmConfigSpec, hasRestored bool, restored *bootstrapv1.KubeadmConfigSpec) error {
@@ -1024,6 +1024,7 @@ func autoConvert_v1beta1_IgnitionSpec_To_v1beta2_IgnitionSpec(in *IgnitionSpec,
 
 func autoConvert_v1beta2_IgnitionSpec_To_v1beta1_IgnitionSpec(in *v1beta2.IgnitionSpec, out *IgnitionSpec, s conversion.Scope) error {
 	// WARNING: in.ContainerLinuxConfig requires manual conversion: inconvertible types (sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2.ContainerLinuxConfig vs *sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta1.ContainerLinuxConfig)
+	// WARNING: in.Passthrough requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1394,9 +1395,13 @@ func autoConvert_v1beta2_KubeadmConfigSpec_To_v1beta1_KubeadmConfigSpec(in *v1be
 		out.Users = nil
 	}
 	// WARNING: in.NTP requires manual conversion: inconvertible types (sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2.NTP vs *sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta1.NTP)
+	// WARNING: in.TrustedCertificateAuthorities requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalUserData requires manual conversion: does not exist in peer-type
 	out.Format = Format(in.Format)
 	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	// WARNING: in.Ignition requires manual conversion: inconvertible types (sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2.IgnitionSpec vs *sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta1.IgnitionSpec)
+	// WARNING: in.Diagnostics requires manual conversion: does not exist in peer-type
+	// WARNING: in.Templating requires manual conversion: does not exist in peer-type
 	return nil
 }
 
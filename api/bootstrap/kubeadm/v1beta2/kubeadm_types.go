@@ -72,6 +72,26 @@ const (
 	KubeadmConfigDataSecretNotAvailableReason = clusterv1.NotAvailableReason
 )
 
+// KubeadmConfig's BootstrapCommandsSucceeded condition and corresponding reasons.
+// Note: BootstrapCommandsSucceeded condition is set only if spec.diagnostics.enabled is true and the KubeadmConfig is owned by a Machine.
+const (
+	// KubeadmConfigBootstrapCommandsSucceededCondition is true if no failure has been reported for the commands run to
+	// bootstrap the Machine and the Machine's Node registered to the workload cluster.
+	KubeadmConfigBootstrapCommandsSucceededCondition = "BootstrapCommandsSucceeded"
+
+	// KubeadmConfigBootstrapCommandsSucceededReason surfaces when no failure has been reported for the commands run to
+	// bootstrap the Machine and the Machine's Node registered to the workload cluster.
+	KubeadmConfigBootstrapCommandsSucceededReason = "BootstrapCommandsSucceeded"
+
+	// KubeadmConfigBootstrapCommandFailedReason surfaces when a command run to bootstrap the Machine failed, as reported
+	// by the Machine's NodeBootstrapped condition.
+	KubeadmConfigBootstrapCommandFailedReason = "BootstrapCommandFailed"
+
+	// KubeadmConfigBootstrapCommandsRunningReason surfaces when the commands run to bootstrap the Machine have not
+	// completed yet.
+	KubeadmConfigBootstrapCommandsRunningReason = "BootstrapCommandsRunning"
+)
+
 // EncryptionAlgorithmType can define an asymmetric encryption algorithm type.
 // +kubebuilder:validation:Enum=ECDSA-P256;ECDSA-P384;RSA-2048;RSA-3072;RSA-4096
 type EncryptionAlgorithmType string
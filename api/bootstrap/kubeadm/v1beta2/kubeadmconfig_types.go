@@ -17,11 +17,14 @@ limitations under the License.
 package v1beta2
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/feature"
@@ -39,9 +42,20 @@ const (
 	Ignition Format = "ignition"
 )
 
+const (
+	// ClusterCAHashAnnotation is the annotation set on a KubeadmConfig to track the hash of the cluster CA
+	// certificates embedded in the bootstrap data.
+	// NOTE: This annotation is used by KCP to detect Machines which have to be rolled out after the cluster CA certificates
+	// changed, e.g. during a CA rotation.
+	ClusterCAHashAnnotation = "bootstrap.cluster.x-k8s.io/cluster-ca-hash"
+)
+
 var (
 	cannotUseWithIgnition                            = fmt.Sprintf("not supported when spec.format is set to: %q", Ignition)
 	conflictingFileSourceMsg                         = "only one of content or contentFrom may be specified for a single file"
+	conflictingTrustedCASourceMsg                    = "only one of secret or configMap may be specified for a single trusted certificate authority"
+	conflictingAdditionalUserDataSourceMsg           = "only one of secret or configMap may be specified for a single additional user data"
+	missingAdditionalUserDataSourceMsg               = "one of secret or configMap must be specified for a single additional user data"
 	conflictingUserSourceMsg                         = "only one of passwd or passwdFrom may be specified for a single user"
 	kubeadmBootstrapFormatIgnitionFeatureDisabledMsg = "can be set only if the KubeadmBootstrapFormatIgnition feature gate is enabled"
 	missingSecretNameMsg                             = "secret file source must specify non-empty secret name"
@@ -127,6 +141,27 @@ type KubeadmConfigSpec struct {
 	// +optional
 	NTP NTP `json:"ntp,omitempty,omitzero"`
 
+	// trustedCertificateAuthorities specifies additional certificate authorities to be added to the system trust store
+	// of the machine, e.g. the certificate authorities of corporate proxies or private registries.
+	// With cloud-init, certificate authorities are added using the ca_certs module, which takes care of the differences
+	// between OS families. In Ignition, certificate authorities are added to /etc/ssl/certs before kubeadm runs.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	TrustedCertificateAuthorities []TrustedCertificateAuthority `json:"trustedCertificateAuthorities,omitempty"`
+
+	// additionalUserData references additional user-provided cloud-init documents to be merged with the cloud-config
+	// generated by the bootstrap provider, e.g. to extend the bootstrap with cloud-init modules not exposed in
+	// KubeadmConfig without patching the generated cloud-config via files or commands.
+	// When set, the bootstrap data is a multi-part MIME archive with the generated cloud-config as a first part
+	// and every document as an additional part, in order. This has no effect in Ignition.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	AdditionalUserData []AdditionalUserData `json:"additionalUserData,omitempty"`
+
 	// format specifies the output format of the bootstrap data.
 	// Defaults to cloud-config if not set.
 	// +optional
@@ -140,6 +175,18 @@ type KubeadmConfigSpec struct {
 	// ignition contains Ignition specific configuration.
 	// +optional
 	Ignition IgnitionSpec `json:"ignition,omitempty,omitzero"`
+
+	// diagnostics configures the capture of the exit codes and of the output of preKubeadmCommands,
+	// the kubeadm command and postKubeadmCommands, so failures can be debugged without accessing the machine.
+	// +optional
+	Diagnostics BootstrapDiagnostics `json:"diagnostics,omitempty,omitzero"`
+
+	// templating configures the rendering of Go-template expressions using the builtin variables of the Cluster,
+	// e.g. `{{ .builtin.cluster.name }}`, in the inline content of files; the name, gecos, groups, homeDir, shell,
+	// primaryGroup, sudo and sshAuthorizedKeys of users; ntp servers; the device of diskSetup partitions;
+	// the device, label, partition and extraOpts of diskSetup filesystems. All the other fields are used as is.
+	// +optional
+	Templating BootstrapTemplating `json:"templating,omitempty,omitzero"`
 }
 
 // Validate ensures the KubeadmConfigSpec is valid.
@@ -148,7 +195,10 @@ func (c *KubeadmConfigSpec) Validate(isKCP bool, pathPrefix *field.Path) field.E
 
 	allErrs = append(allErrs, c.validateFiles(pathPrefix)...)
 	allErrs = append(allErrs, c.validateUsers(pathPrefix)...)
+	allErrs = append(allErrs, c.validateTrustedCertificateAuthorities(pathPrefix)...)
+	allErrs = append(allErrs, c.validateAdditionalUserData(pathPrefix)...)
 	allErrs = append(allErrs, c.validateIgnition(pathPrefix)...)
+	allErrs = append(allErrs, c.validateDiagnostics(pathPrefix)...)
 
 	// Validate JoinConfiguration.
 	if c.JoinConfiguration.IsDefined() {
@@ -256,6 +306,56 @@ func (c *KubeadmConfigSpec) validateFiles(pathPrefix *field.Path) field.ErrorLis
 	return allErrs
 }
 
+func (c *KubeadmConfigSpec) validateTrustedCertificateAuthorities(pathPrefix *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i := range c.TrustedCertificateAuthorities {
+		ca := c.TrustedCertificateAuthorities[i]
+		if ca.Secret.IsDefined() && ca.ConfigMap.IsDefined() {
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					pathPrefix.Child("trustedCertificateAuthorities").Index(i),
+					ca,
+					conflictingTrustedCASourceMsg,
+				),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+func (c *KubeadmConfigSpec) validateAdditionalUserData(pathPrefix *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i := range c.AdditionalUserData {
+		userData := c.AdditionalUserData[i]
+		switch {
+		case userData.Secret.IsDefined() && userData.ConfigMap.IsDefined():
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					pathPrefix.Child("additionalUserData").Index(i),
+					userData,
+					conflictingAdditionalUserDataSourceMsg,
+				),
+			)
+		case !userData.Secret.IsDefined() && !userData.ConfigMap.IsDefined():
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					pathPrefix.Child("additionalUserData").Index(i),
+					userData,
+					missingAdditionalUserDataSourceMsg,
+				),
+			)
+		}
+	}
+
+	return allErrs
+}
+
 func (c *KubeadmConfigSpec) validateUsers(pathPrefix *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -331,6 +431,10 @@ func (c *KubeadmConfigSpec) validateIgnition(pathPrefix *field.Path) field.Error
 		return allErrs
 	}
 
+	if c.Ignition.Passthrough.IsDefined() {
+		allErrs = append(allErrs, c.Ignition.Passthrough.validate(pathPrefix.Child("ignition", "passthrough"))...)
+	}
+
 	for i, user := range c.Users {
 		if user.Inactive != nil && *user.Inactive {
 			allErrs = append(
@@ -365,6 +469,16 @@ func (c *KubeadmConfigSpec) validateIgnition(pathPrefix *field.Path) field.Error
 		)
 	}
 
+	if c.AdditionalUserData != nil {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(
+				pathPrefix.Child("additionalUserData"),
+				cannotUseWithIgnition,
+			),
+		)
+	}
+
 	for i, partition := range c.DiskSetup.Partitions {
 		if partition.TableType != "" && partition.TableType != "gpt" {
 			allErrs = append(
@@ -407,12 +521,96 @@ func (c *KubeadmConfigSpec) validateIgnition(pathPrefix *field.Path) field.Error
 	return allErrs
 }
 
+func (c *KubeadmConfigSpec) validateDiagnostics(pathPrefix *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if c.Diagnostics.Enabled != nil && *c.Diagnostics.Enabled && c.Format == Ignition {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(
+				pathPrefix.Child("diagnostics", "enabled"),
+				fmt.Sprintf("diagnostics are not supported when format is %q", Ignition),
+			),
+		)
+	}
+	if c.Diagnostics.Progress != nil && *c.Diagnostics.Progress && c.Format == Ignition {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(
+				pathPrefix.Child("diagnostics", "progress"),
+				fmt.Sprintf("bootstrap progress is not supported when format is %q", Ignition),
+			),
+		)
+	}
+
+	return allErrs
+}
+
+// BootstrapDiagnostics configures the capture of the exit codes and of the output of the commands run to bootstrap
+// a machine.
+// When enabled, the commands are run by a wrapper script that appends the exit code of every command, and the tail
+// of the output of failed commands, to the /run/cluster-api/bootstrap-diagnostics report; infrastructure providers
+// can then surface the first failed command in status.bootstrapDiagnostics of the InfrastructureMachine.
+// When progress is enabled, the current bootstrap phase is written to /run/cluster-api/bootstrap-progress;
+// infrastructure providers can then surface it in status.bootstrapProgress of the InfrastructureMachine.
+// +kubebuilder:validation:MinProperties=1
+type BootstrapDiagnostics struct {
+	// enabled enables the capture of the exit codes and of the output of the commands run to bootstrap a machine.
+	// Diagnostics are supported only for the cloud-config format, and they require bash to be available on the machine.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// outputTailLines is the number of lines at the end of the output of a failed command which are included
+	// in the report.
+	// Defaults to 20.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	OutputTailLines *int32 `json:"outputTailLines,omitempty"`
+
+	// progress enables writing the current bootstrap phase, i.e. FilesWritten, KubeadmStarted and KubeadmFinished,
+	// to /run/cluster-api/bootstrap-progress; it can be enabled independently of enabled.
+	// Progress is supported only for the cloud-config format.
+	// +optional
+	Progress *bool `json:"progress,omitempty"`
+}
+
+// IsDefined returns true if the BootstrapDiagnostics is defined.
+func (r *BootstrapDiagnostics) IsDefined() bool {
+	return !reflect.DeepEqual(r, &BootstrapDiagnostics{})
+}
+
+// BootstrapTemplating configures the rendering of Go-template expressions in selected fields of the KubeadmConfigSpec.
+// When enabled, the following fields are rendered by the bootstrap controller before generating the bootstrap data:
+// the inline content of files; the name, gecos, groups, homeDir, shell, primaryGroup, sudo and sshAuthorizedKeys
+// of users; ntp servers; the device of diskSetup partitions; the device, label, partition and extraOpts of
+// diskSetup filesystems. All the other fields, e.g. the passwd of users, are used as is.
+// Templates can use the same builtin variables available in ClusterClass patches, e.g. `{{ .builtin.cluster.name }}`,
+// `{{ .builtin.cluster.namespace }}` or `{{ .builtin.cluster.network.serviceDomain }}`, as well as sprig functions.
+// +kubebuilder:validation:MinProperties=1
+type BootstrapTemplating struct {
+	// enabled enables the rendering of Go-template expressions.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// IsEnabled returns true if the rendering of Go-template expressions is enabled.
+func (r *BootstrapTemplating) IsEnabled() bool {
+	return r.Enabled != nil && *r.Enabled
+}
+
 // IgnitionSpec contains Ignition specific configuration.
 // +kubebuilder:validation:MinProperties=1
 type IgnitionSpec struct {
 	// containerLinuxConfig contains CLC specific configuration.
 	// +optional
 	ContainerLinuxConfig ContainerLinuxConfig `json:"containerLinuxConfig,omitempty,omitzero"`
+
+	// passthrough contains a raw Ignition or Butane config to be merged with the Ignition
+	// configuration generated by the bootstrapper controller, e.g. to configure systemd units or storage
+	// which are not modeled by the KubeadmConfigSpec.
+	// +optional
+	Passthrough IgnitionPassthrough `json:"passthrough,omitempty,omitzero"`
 }
 
 // IsDefined returns true if the IgnitionSpec is defined.
@@ -444,6 +642,89 @@ func (r *ContainerLinuxConfig) IsDefined() bool {
 	return !reflect.DeepEqual(r, &ContainerLinuxConfig{})
 }
 
+// IgnitionPassthroughFormat is the format of an IgnitionPassthrough config.
+// +kubebuilder:validation:Enum=Ignition;Butane
+type IgnitionPassthroughFormat string
+
+const (
+	// IgnitionPassthroughFormatIgnition is an Ignition config using spec version 2.x or 3.x, in JSON format.
+	IgnitionPassthroughFormatIgnition IgnitionPassthroughFormat = "Ignition"
+
+	// IgnitionPassthroughFormatButane is a Butane config using the flatcar or the fcos variant, in YAML format.
+	IgnitionPassthroughFormatButane IgnitionPassthroughFormat = "Butane"
+)
+
+// IgnitionPassthrough contains a raw Ignition or Butane config.
+//
+// The config is translated to the Ignition spec version used by the configuration generated by the
+// bootstrapper controller and then merged with it, overriding already defined fields following the
+// merge strategy described in https://coreos.github.io/ignition/operator-notes/#config-merging.
+// Fields which cannot be translated, e.g. kernel arguments or LUKS devices, are rejected.
+// +kubebuilder:validation:MinProperties=1
+type IgnitionPassthrough struct {
+	// format is the format of config, either Ignition or Butane.
+	// +required
+	Format IgnitionPassthroughFormat `json:"format,omitempty"`
+
+	// config is the raw Ignition (JSON) or Butane (YAML) config.
+	// More info: https://coreos.github.io/ignition/specs/ and https://coreos.github.io/butane/specs/
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32768
+	Config string `json:"config,omitempty"`
+
+	// strict controls if config should be strictly parsed. If so, unknown fields and warnings are treated as errors.
+	// +optional
+	Strict *bool `json:"strict,omitempty"`
+}
+
+// IsDefined returns true if the IgnitionPassthrough is defined.
+func (r *IgnitionPassthrough) IsDefined() bool {
+	return !reflect.DeepEqual(r, &IgnitionPassthrough{})
+}
+
+// validate checks that the config can be parsed and uses a supported spec version or variant.
+// Note: The config is fully validated when it is translated and merged by the bootstrapper controller.
+func (r *IgnitionPassthrough) validate(pathPrefix *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	configPath := pathPrefix.Child("config")
+	switch r.Format {
+	case IgnitionPassthroughFormatIgnition:
+		header := struct {
+			Ignition struct {
+				Version string `json:"version"`
+			} `json:"ignition"`
+		}{}
+		if err := json.Unmarshal([]byte(r.Config), &header); err != nil {
+			allErrs = append(allErrs, field.Invalid(configPath, field.OmitValueType{}, fmt.Sprintf("must be a valid Ignition config in JSON format: %v", err)))
+			break
+		}
+		if !strings.HasPrefix(header.Ignition.Version, "2.") && !strings.HasPrefix(header.Ignition.Version, "3.") {
+			allErrs = append(allErrs, field.Invalid(configPath, field.OmitValueType{}, fmt.Sprintf("ignition.version must be 2.x or 3.x, got %q", header.Ignition.Version)))
+		}
+	case IgnitionPassthroughFormatButane:
+		header := struct {
+			Variant string `json:"variant"`
+			Version string `json:"version"`
+		}{}
+		if err := yaml.Unmarshal([]byte(r.Config), &header); err != nil {
+			allErrs = append(allErrs, field.Invalid(configPath, field.OmitValueType{}, fmt.Sprintf("must be a valid Butane config in YAML format: %v", err)))
+			break
+		}
+		if header.Variant != "flatcar" && header.Variant != "fcos" {
+			allErrs = append(allErrs, field.Invalid(configPath, field.OmitValueType{}, fmt.Sprintf("variant must be flatcar or fcos, got %q", header.Variant)))
+		}
+		if header.Version == "" {
+			allErrs = append(allErrs, field.Invalid(configPath, field.OmitValueType{}, "version must be set"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(pathPrefix.Child("format"), r.Format, []IgnitionPassthroughFormat{IgnitionPassthroughFormatIgnition, IgnitionPassthroughFormatButane}))
+	}
+
+	return allErrs
+}
+
 // KubeadmConfigStatus defines the observed state of KubeadmConfig.
 // +kubebuilder:validation:MinProperties=1
 type KubeadmConfigStatus struct {
@@ -677,6 +958,84 @@ type SecretFileSource struct {
 	Key string `json:"key,omitempty"`
 }
 
+// TrustedCertificateAuthority references a PEM encoded certificate authority bundle to be added to the system trust store.
+// Only one of secret or configMap may be populated.
+// +kubebuilder:validation:MinProperties=1
+type TrustedCertificateAuthority struct {
+	// secret references a key in a Secret containing the PEM encoded certificate authority bundle.
+	// +optional
+	Secret TrustedCertificateAuthoritySource `json:"secret,omitempty,omitzero"`
+
+	// configMap references a key in a ConfigMap containing the PEM encoded certificate authority bundle.
+	// +optional
+	ConfigMap TrustedCertificateAuthoritySource `json:"configMap,omitempty,omitzero"`
+}
+
+// TrustedCertificateAuthoritySource references a key in a Secret or in a ConfigMap.
+type TrustedCertificateAuthoritySource struct {
+	// name of the Secret or of the ConfigMap in the KubeadmBootstrapConfig's namespace to use.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+
+	// key is the key in the data map containing the PEM encoded certificate authority bundle.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Key string `json:"key,omitempty"`
+}
+
+// IsDefined returns true if the TrustedCertificateAuthoritySource is defined.
+func (r *TrustedCertificateAuthoritySource) IsDefined() bool {
+	return !reflect.DeepEqual(r, &TrustedCertificateAuthoritySource{})
+}
+
+// AdditionalUserData references a user-provided cloud-init document to be merged with the cloud-config
+// generated by the bootstrap provider.
+// The document must be a cloud-config document (starting with #cloud-config), a jinja template
+// (starting with ## template: jinja) or a shell script (starting with #!).
+// Only one of secret or configMap may be populated.
+// +kubebuilder:validation:MinProperties=1
+type AdditionalUserData struct {
+	// secret references a key in a Secret containing the cloud-init document.
+	// +optional
+	Secret AdditionalUserDataSource `json:"secret,omitempty,omitzero"`
+
+	// configMap references a key in a ConfigMap containing the cloud-init document.
+	// +optional
+	ConfigMap AdditionalUserDataSource `json:"configMap,omitempty,omitzero"`
+
+	// mergeType is the cloud-init merge type used to merge the document with the previous parts,
+	// e.g. "list(append)+dict(no_replace,recurse_list)+str()". It is ignored for shell scripts.
+	// Defaults to "list(append)+dict(no_replace,recurse_list)+str()", which appends lists like runcmd
+	// or write_files and preserves the values generated by the bootstrap provider.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	MergeType string `json:"mergeType,omitempty"`
+}
+
+// AdditionalUserDataSource references a key in a Secret or in a ConfigMap.
+type AdditionalUserDataSource struct {
+	// name of the Secret or of the ConfigMap in the KubeadmBootstrapConfig's namespace to use.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+
+	// key is the key in the data map containing the cloud-init document.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Key string `json:"key,omitempty"`
+}
+
+// IsDefined returns true if the AdditionalUserDataSource is defined.
+func (r *AdditionalUserDataSource) IsDefined() bool {
+	return !reflect.DeepEqual(r, &AdditionalUserDataSource{})
+}
+
 // PasswdSource is a union of all possible external source types for passwd data.
 // Only one field may be populated in any given instance. Developers adding new
 // sources of data for target systems should add them here.
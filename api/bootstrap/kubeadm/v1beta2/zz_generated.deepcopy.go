@@ -87,6 +87,38 @@ func (in *APIServer) DeepCopy() *APIServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalUserData) DeepCopyInto(out *AdditionalUserData) {
+	*out = *in
+	out.Secret = in.Secret
+	out.ConfigMap = in.ConfigMap
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalUserData.
+func (in *AdditionalUserData) DeepCopy() *AdditionalUserData {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalUserData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalUserDataSource) DeepCopyInto(out *AdditionalUserDataSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalUserDataSource.
+func (in *AdditionalUserDataSource) DeepCopy() *AdditionalUserDataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalUserDataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Arg) DeepCopyInto(out *Arg) {
 	*out = *in
@@ -107,6 +139,56 @@ func (in *Arg) DeepCopy() *Arg {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapDiagnostics) DeepCopyInto(out *BootstrapDiagnostics) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OutputTailLines != nil {
+		in, out := &in.OutputTailLines, &out.OutputTailLines
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapDiagnostics.
+func (in *BootstrapDiagnostics) DeepCopy() *BootstrapDiagnostics {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapDiagnostics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapTemplating) DeepCopyInto(out *BootstrapTemplating) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapTemplating.
+func (in *BootstrapTemplating) DeepCopy() *BootstrapTemplating {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapTemplating)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BootstrapToken) DeepCopyInto(out *BootstrapToken) {
 	*out = *in
@@ -495,10 +577,31 @@ func (in *HostPathMount) DeepCopy() *HostPathMount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnitionPassthrough) DeepCopyInto(out *IgnitionPassthrough) {
+	*out = *in
+	if in.Strict != nil {
+		in, out := &in.Strict, &out.Strict
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionPassthrough.
+func (in *IgnitionPassthrough) DeepCopy() *IgnitionPassthrough {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnitionPassthrough)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IgnitionSpec) DeepCopyInto(out *IgnitionSpec) {
 	*out = *in
 	in.ContainerLinuxConfig.DeepCopyInto(&out.ContainerLinuxConfig)
+	in.Passthrough.DeepCopyInto(&out.Passthrough)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionSpec.
@@ -843,12 +946,24 @@ func (in *KubeadmConfigSpec) DeepCopyInto(out *KubeadmConfigSpec) {
 		}
 	}
 	in.NTP.DeepCopyInto(&out.NTP)
+	if in.TrustedCertificateAuthorities != nil {
+		in, out := &in.TrustedCertificateAuthorities, &out.TrustedCertificateAuthorities
+		*out = make([]TrustedCertificateAuthority, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalUserData != nil {
+		in, out := &in.AdditionalUserData, &out.AdditionalUserData
+		*out = make([]AdditionalUserData, len(*in))
+		copy(*out, *in)
+	}
 	if in.Verbosity != nil {
 		in, out := &in.Verbosity, &out.Verbosity
 		*out = new(int32)
 		**out = **in
 	}
 	in.Ignition.DeepCopyInto(&out.Ignition)
+	in.Diagnostics.DeepCopyInto(&out.Diagnostics)
+	in.Templating.DeepCopyInto(&out.Templating)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmConfigSpec.
@@ -1303,6 +1418,38 @@ func (in *Timeouts) DeepCopy() *Timeouts {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedCertificateAuthority) DeepCopyInto(out *TrustedCertificateAuthority) {
+	*out = *in
+	out.Secret = in.Secret
+	out.ConfigMap = in.ConfigMap
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedCertificateAuthority.
+func (in *TrustedCertificateAuthority) DeepCopy() *TrustedCertificateAuthority {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedCertificateAuthority)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedCertificateAuthoritySource) DeepCopyInto(out *TrustedCertificateAuthoritySource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedCertificateAuthoritySource.
+func (in *TrustedCertificateAuthoritySource) DeepCopy() *TrustedCertificateAuthoritySource {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedCertificateAuthoritySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
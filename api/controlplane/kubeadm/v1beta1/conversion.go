@@ -78,6 +78,13 @@ func (src *KubeadmControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 	// Recover other values
 	if ok {
 		bootstrapv1beta1.RestoreKubeadmConfigSpec(&restored.Spec.KubeadmConfigSpec, &dst.Spec.KubeadmConfigSpec)
+		dst.Status.RolloutReasons = restored.Status.RolloutReasons
+		dst.Spec.MachineTemplate.Spec.TaintPolicy = restored.Spec.MachineTemplate.Spec.TaintPolicy
+		dst.Spec.Etcd = restored.Spec.Etcd
+		dst.Spec.EndpointManager = restored.Spec.EndpointManager
+		dst.Spec.EncryptionAtRest = restored.Spec.EncryptionAtRest
+		dst.Status.EncryptionAtRest = restored.Status.EncryptionAtRest
+		dst.Status.Etcd = restored.Status.Etcd
 	}
 
 	if src.Spec.RemediationStrategy != nil {
@@ -134,6 +141,9 @@ func (src *KubeadmControlPlaneTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	// Recover other values
 	if ok {
 		bootstrapv1beta1.RestoreKubeadmConfigSpec(&restored.Spec.Template.Spec.KubeadmConfigSpec, &dst.Spec.Template.Spec.KubeadmConfigSpec)
+		dst.Spec.Template.Spec.MachineTemplate.Spec.TaintPolicy = restored.Spec.Template.Spec.MachineTemplate.Spec.TaintPolicy
+		dst.Spec.Template.Spec.Etcd = restored.Spec.Template.Spec.Etcd
+		dst.Spec.Template.Spec.EndpointManager = restored.Spec.Template.Spec.EndpointManager
 	}
 
 	if src.Spec.Template.Spec.RemediationStrategy != nil {
@@ -35,11 +35,42 @@ const (
 	RollingUpdateStrategyType KubeadmControlPlaneRolloutStrategyType = "RollingUpdate"
 )
 
+// KubeadmControlPlaneTaintPolicy defines whether control plane nodes are tainted to prevent scheduling of workloads.
+// +kubebuilder:validation:Enum=NoSchedule;None
+type KubeadmControlPlaneTaintPolicy string
+
+const (
+	// KubeadmControlPlaneTaintPolicyNoSchedule applies the node-role.kubernetes.io/control-plane:NoSchedule taint
+	// to control plane nodes, thus preventing workloads without a matching toleration to be scheduled on them.
+	KubeadmControlPlaneTaintPolicyNoSchedule KubeadmControlPlaneTaintPolicy = "NoSchedule"
+
+	// KubeadmControlPlaneTaintPolicyNone does not apply the node-role.kubernetes.io/control-plane:NoSchedule taint
+	// to control plane nodes, thus allowing control plane nodes to run workloads too.
+	KubeadmControlPlaneTaintPolicyNone KubeadmControlPlaneTaintPolicy = "None"
+)
+
 const (
 	// KubeadmControlPlaneFinalizer is the finalizer applied to KubeadmControlPlane resources
 	// by its managing controller.
 	KubeadmControlPlaneFinalizer = "kubeadm.controlplane.cluster.x-k8s.io"
 
+	// EncryptionConfigurationLabel is set on the Secrets with the API server EncryptionConfiguration generated
+	// by a KubeadmControlPlane; the value of the label is the name of the KubeadmControlPlane.
+	EncryptionConfigurationLabel = "controlplane.cluster.x-k8s.io/encryption-configuration"
+
+	// EtcdSnapshotLabel is set on the Secrets storing the etcd snapshots taken by a KubeadmControlPlane;
+	// the value of the label is the name of the KubeadmControlPlane.
+	EtcdSnapshotLabel = "controlplane.cluster.x-k8s.io/etcd-snapshot"
+
+	// EtcdSnapshotNameAnnotation is set on the Secrets storing an etcd snapshot; the value of the annotation
+	// is the name of the snapshot, as reported in the KubeadmControlPlane's status.etcd.lastSnapshotName.
+	EtcdSnapshotNameAnnotation = "controlplane.cluster.x-k8s.io/etcd-snapshot-name"
+
+	// EtcdSnapshotChunkAnnotation is set on the Secrets storing an etcd snapshot; given that a snapshot might not fit
+	// in a single Secret, it is split into chunks, and the value of the annotation is the index of the chunk
+	// stored in the Secret, starting from 0.
+	EtcdSnapshotChunkAnnotation = "controlplane.cluster.x-k8s.io/etcd-snapshot-chunk"
+
 	// SkipCoreDNSAnnotation annotation explicitly skips reconciling CoreDNS if set.
 	SkipCoreDNSAnnotation = "controlplane.cluster.x-k8s.io/skip-coredns"
 
@@ -156,6 +187,38 @@ const (
 	KubeadmControlPlaneEtcdClusterHealthUnknownReason = "HealthUnknown"
 )
 
+// KubeadmControlPlane's EtcdDefragmented condition and corresponding reasons.
+const (
+	// KubeadmControlPlaneEtcdDefragmentedCondition documents the result of the last periodic defragmentation
+	// of the etcd members.
+	// Note: this condition is set only when spec.etcd.defragmentation is set.
+	KubeadmControlPlaneEtcdDefragmentedCondition = "EtcdDefragmented"
+
+	// KubeadmControlPlaneEtcdDefragmentedReason surfaces when the last periodic defragmentation completed successfully.
+	KubeadmControlPlaneEtcdDefragmentedReason = "Defragmented"
+
+	// KubeadmControlPlaneEtcdDefragmentationFailedReason surfaces when the last periodic defragmentation failed.
+	KubeadmControlPlaneEtcdDefragmentationFailedReason = "DefragmentationFailed"
+)
+
+// KubeadmControlPlane's EtcdSnapshotted condition and corresponding reasons.
+const (
+	// KubeadmControlPlaneEtcdSnapshottedCondition documents the result of the last periodic snapshot
+	// of the etcd database.
+	// Note: this condition is set only when spec.etcd.snapshot is set.
+	KubeadmControlPlaneEtcdSnapshottedCondition = "EtcdSnapshotted"
+
+	// KubeadmControlPlaneEtcdSnapshottedReason surfaces when the last periodic snapshot completed successfully.
+	KubeadmControlPlaneEtcdSnapshottedReason = "Snapshotted"
+
+	// KubeadmControlPlaneEtcdSnapshotFailedReason surfaces when the last periodic snapshot failed.
+	KubeadmControlPlaneEtcdSnapshotFailedReason = "SnapshotFailed"
+
+	// KubeadmControlPlaneEtcdSnapshotTooLargeReason surfaces when the last periodic snapshot was discarded
+	// because exceeding spec.etcd.snapshot.maxSizeMiB.
+	KubeadmControlPlaneEtcdSnapshotTooLargeReason = "SnapshotTooLarge"
+)
+
 // KubeadmControlPlane's ControlPlaneComponentsHealthy condition and corresponding reasons.
 const (
 	// KubeadmControlPlaneControlPlaneComponentsHealthyCondition surfaces issues to Kubernetes control plane components
@@ -449,6 +512,23 @@ type KubeadmControlPlaneSpec struct {
 	// InfraMachines & KubeadmConfigs will use the same name as the corresponding Machines.
 	// +optional
 	MachineNaming MachineNamingSpec `json:"machineNaming,omitempty,omitzero"`
+
+	// etcd allows configuring how KCP manages the members of the local (stacked) etcd cluster.
+	// +optional
+	Etcd KubeadmControlPlaneEtcdSpec `json:"etcd,omitempty,omitzero"`
+
+	// encryptionAtRest configures the encryption of resources stored in etcd, e.g. Secrets.
+	// When set, KCP manages the API server EncryptionConfiguration on the control plane Machines
+	// and orchestrates key rotation, including the rewrite of the encrypted resources in the workload cluster.
+	// NOTE: Once set, this field cannot be unset.
+	// +optional
+	EncryptionAtRest KubeadmControlPlaneEncryptionAtRestSpec `json:"encryptionAtRest,omitempty,omitzero"`
+
+	// endpointManager configures a static pod managing the control plane endpoint on the control plane Machines,
+	// e.g. kube-vip or keepalived announcing a virtual IP set as the Cluster's spec.controlPlaneEndpoint.host.
+	// NOTE: Changes to this field trigger a rollout of control plane Machines.
+	// +optional
+	EndpointManager KubeadmControlPlaneEndpointManagerSpec `json:"endpointManager,omitempty,omitzero"`
 }
 
 // KubeadmControlPlaneMachineTemplate defines the template for Machines
@@ -491,6 +571,17 @@ type KubeadmControlPlaneMachineTemplateSpec struct {
 	// deletion contains configuration options for Machine deletion.
 	// +optional
 	Deletion KubeadmControlPlaneMachineTemplateDeletionSpec `json:"deletion,omitempty,omitzero"`
+
+	// taintPolicy defines whether the node-role.kubernetes.io/control-plane:NoSchedule taint is applied to control plane nodes.
+	// When set to NoSchedule, the taint is applied; when set to None, the taint is not applied, thus allowing control plane
+	// nodes to run workloads too, e.g. in small edge clusters.
+	// The taint policy is applied by setting taints in nodeRegistration of both initConfiguration and joinConfiguration,
+	// and it can't be used in combination with taints explicitly set in nodeRegistration which are not consistent with the policy.
+	// If not set, taints are determined by nodeRegistration only; if taints are not set in nodeRegistration, kubeadm defaults
+	// to apply the node-role.kubernetes.io/control-plane:NoSchedule taint.
+	// NOTE: Changes to this field trigger a rollout of control plane Machines.
+	// +optional
+	TaintPolicy KubeadmControlPlaneTaintPolicy `json:"taintPolicy,omitempty"`
 }
 
 // KubeadmControlPlaneMachineTemplateDeletionSpec contains configuration options for Machine deletion.
@@ -637,9 +728,15 @@ type MachineNamingSpec struct {
 	// If the generated name string exceeds 63 characters, it will be trimmed to 58 characters and will
 	// get concatenated with a random suffix of length 5.
 	// Length of the template string must not exceed 256 characters.
-	// The template allows the following variables `.cluster.name`, `.kubeadmControlPlane.name` and `.random`.
+	// The template allows the following variables `.cluster.name`, `.kubeadmControlPlane.name`, `.predecessor.name` and `.random`.
 	// The variable `.cluster.name` retrieves the name of the cluster object that owns the Machines being created.
 	// The variable `.kubeadmControlPlane.name` retrieves the name of the KubeadmControlPlane object that owns the Machines being created.
+	// The variable `.predecessor.name` retrieves the name of the Machine being replaced when a Machine is created
+	// as a consequence of remediation; it is empty otherwise, e.g.
+	// `{{ if .predecessor.name }}{{ .predecessor.name }}-r{{ else }}{{ .kubeadmControlPlane.name }}{{ end }}-{{ .random }}`.
+	// The template is validated both for new Machines and for Machines replacing a remediated Machine, so templates like
+	// `{{ .predecessor.name }}-{{ .random }}` are rejected because the names of new Machines would start with "-";
+	// use the `{{ if .predecessor.name }}` pattern above instead.
 	// The variable `.random` is substituted with random alphanumeric string, without vowels, of length 5. This variable is required
 	// part of the template. If not provided, validation will fail.
 	// +optional
@@ -648,12 +745,238 @@ type MachineNamingSpec struct {
 	Template string `json:"template,omitempty"`
 }
 
+// KubeadmControlPlaneEtcdSpec allows configuring how KCP manages the members of the local (stacked) etcd cluster.
+// +kubebuilder:validation:MinProperties=1
+type KubeadmControlPlaneEtcdSpec struct {
+	// joinAsLearner configures new etcd members to join the local etcd cluster as non-voting learners;
+	// KCP promotes learners to voting members once they are caught up with the leader.
+	// This reduces the risk of losing quorum while a new member is syncing data, e.g. during scale up
+	// and rollouts on slow disks.
+	// When enabled, KCP sets the kubeadm EtcdLearnerMode feature gate, unless it is explicitly set in
+	// kubeadmConfigSpec.clusterConfiguration.featureGates.
+	// This field is ignored when using external etcd.
+	// NOTE: Changing this field triggers a rollout of the control plane Machines, because it changes the kubeadm feature gates.
+	// +optional
+	JoinAsLearner *bool `json:"joinAsLearner,omitempty"`
+
+	// defragmentation configures the periodic defragmentation of the etcd members, reclaiming the disk space
+	// freed by etcd compaction without the need of running defragmentation jobs in the workload cluster.
+	// Members are defragmented one at a time, with the leader last, only when the etcd cluster is healthy.
+	// This field is ignored when using external etcd.
+	// +optional
+	Defragmentation KubeadmControlPlaneEtcdDefragmentationSpec `json:"defragmentation,omitempty,omitzero"`
+
+	// snapshot configures periodic snapshots of the etcd database, stored in Secrets in the namespace
+	// of the KubeadmControlPlane without the need of running backup jobs in the workload cluster.
+	// Snapshots are stored only in Secrets, and thus in the etcd of the management cluster; storing snapshots
+	// in an object store is not supported, and it requires backup tooling outside of Cluster API.
+	// Snapshots are not deleted when the KubeadmControlPlane is deleted.
+	// This field is ignored when using external etcd.
+	// +optional
+	Snapshot KubeadmControlPlaneEtcdSnapshotSpec `json:"snapshot,omitempty,omitzero"`
+}
+
+// KubeadmControlPlaneEtcdDefragmentationSpec configures the periodic defragmentation of the etcd members.
+type KubeadmControlPlaneEtcdDefragmentationSpec struct {
+	// intervalSeconds is the interval between two runs of the defragmentation; on each run KCP checks
+	// all the etcd members and defragments the ones exceeding thresholdPercentage.
+	// +required
+	// +kubebuilder:validation:Minimum=600
+	IntervalSeconds *int32 `json:"intervalSeconds,omitempty"`
+
+	// thresholdPercentage is the minimum percentage of the database size which can be reclaimed,
+	// i.e. the database size allocated on disk minus the size in use, for a member to be defragmented.
+	// If not set, it defaults to 50.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	ThresholdPercentage *int32 `json:"thresholdPercentage,omitempty"`
+}
+
+// KubeadmControlPlaneEtcdSnapshotSpec configures the periodic snapshots of the etcd database.
+type KubeadmControlPlaneEtcdSnapshotSpec struct {
+	// intervalSeconds is the interval between two snapshots.
+	// +required
+	// +kubebuilder:validation:Minimum=900
+	IntervalSeconds *int32 `json:"intervalSeconds,omitempty"`
+
+	// retention is the number of snapshots to keep; older snapshots are deleted.
+	// If not set, it defaults to 3.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32
+	Retention *int32 `json:"retention,omitempty"`
+
+	// maxSizeMiB is the maximum size, in MiB, of a gzip compressed snapshot; snapshots exceeding this size
+	// are discarded and reported in the EtcdSnapshotted condition, given that they are stored
+	// in the etcd of the management cluster.
+	// If not set, it defaults to 64.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=512
+	MaxSizeMiB *int32 `json:"maxSizeMiB,omitempty"`
+}
+
+// KubeadmControlPlaneEndpointManagerSpec configures a static pod managing the control plane endpoint on the control plane Machines.
+type KubeadmControlPlaneEndpointManagerSpec struct {
+	// staticPod defines the static pod managing the control plane endpoint.
+	// +required
+	StaticPod KubeadmControlPlaneEndpointManagerStaticPod `json:"staticPod,omitempty,omitzero"`
+}
+
+// KubeadmControlPlaneEndpointManagerStaticPod defines a static pod managing the control plane endpoint.
+type KubeadmControlPlaneEndpointManagerStaticPod struct {
+	// name of the static pod manifest; the manifest is written to /etc/kubernetes/manifests/<name>.yaml
+	// on every control plane Machine.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name,omitempty"`
+
+	// template is the static pod manifest, as a Go text/template.
+	// The template allows the following variables `.controlPlaneEndpoint.host`, `.controlPlaneEndpoint.port`
+	// and `.kubeconfigPath`.
+	// The variables `.controlPlaneEndpoint.host` and `.controlPlaneEndpoint.port` retrieve the Cluster's
+	// spec.controlPlaneEndpoint, e.g. the virtual IP to be announced.
+	// The variable `.kubeconfigPath` retrieves the path of a kubeconfig file with admin credentials which
+	// can be used by the static pod; on the first control plane Machine this is /etc/kubernetes/super-admin.conf
+	// for Kubernetes versions >= v1.29, given that the credentials in /etc/kubernetes/admin.conf are only
+	// authorized after the control plane is initialized, otherwise it is /etc/kubernetes/admin.conf.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32768
+	Template string `json:"template,omitempty"`
+}
+
+// KubeadmControlPlaneEncryptionProvider defines the provider used to encrypt resources stored in etcd.
+// +kubebuilder:validation:Enum=aescbc;aesgcm;secretbox
+type KubeadmControlPlaneEncryptionProvider string
+
+const (
+	// KubeadmControlPlaneEncryptionProviderAESCBC encrypts resources with AES-CBC with PKCS#7 padding;
+	// keys must be 16, 24 or 32 bytes long, 32 bytes are recommended.
+	KubeadmControlPlaneEncryptionProviderAESCBC KubeadmControlPlaneEncryptionProvider = "aescbc"
+
+	// KubeadmControlPlaneEncryptionProviderAESGCM encrypts resources with AES-GCM with a random nonce;
+	// keys must be 16, 24 or 32 bytes long.
+	// NOTE: Keys used with AES-GCM must be rotated frequently, see the Kubernetes documentation for more details.
+	KubeadmControlPlaneEncryptionProviderAESGCM KubeadmControlPlaneEncryptionProvider = "aesgcm"
+
+	// KubeadmControlPlaneEncryptionProviderSecretbox encrypts resources with XSalsa20 and Poly1305;
+	// keys must be 32 bytes long.
+	KubeadmControlPlaneEncryptionProviderSecretbox KubeadmControlPlaneEncryptionProvider = "secretbox"
+)
+
+// KubeadmControlPlaneEncryptionAtRestSpec configures the encryption of resources stored in etcd.
+type KubeadmControlPlaneEncryptionAtRestSpec struct {
+	// resources is the list of resources to encrypt, in the resource or resource.group format, e.g. secrets or
+	// deployments.apps. Defaults to secrets.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	Resources []string `json:"resources,omitempty"`
+
+	// provider is the provider used to encrypt resources.
+	// +required
+	Provider KubeadmControlPlaneEncryptionProvider `json:"provider,omitempty"`
+
+	// keys is the list of keys of the provider. The first key is used to encrypt resources, all the keys are
+	// used to decrypt resources.
+	// To rotate keys, add a new key as the first key; KCP first rolls out control plane Machines that can
+	// decrypt resources with the new key, then control plane Machines that encrypt resources with the new key,
+	// and finally rewrites all the encrypted resources in the workload cluster. The previous key can be removed
+	// once status.encryptionAtRest.writeKeyName reports the new key.
+	// NOTE: The content of the key Secrets must not be changed; to change a key, add a new key with a different name.
+	// +required
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Keys []KubeadmControlPlaneEncryptionKey `json:"keys,omitempty"`
+}
+
+// KubeadmControlPlaneEncryptionKey is a key used to encrypt resources stored in etcd.
+type KubeadmControlPlaneEncryptionKey struct {
+	// name of the key.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name,omitempty"`
+
+	// secret references the Secret key containing the key, which must be in the same namespace as the KubeadmControlPlane.
+	// The Secret key must contain the raw key, e.g. 32 random bytes for aescbc.
+	// +required
+	Secret KubeadmControlPlaneEncryptionKeySecretReference `json:"secret,omitempty,omitzero"`
+}
+
+// KubeadmControlPlaneEncryptionKeySecretReference references a key of a Secret.
+type KubeadmControlPlaneEncryptionKeySecretReference struct {
+	// name of the Secret in the KubeadmControlPlane's namespace to use.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+
+	// key is the key in the Secret's data map for this value.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Key string `json:"key,omitempty"`
+}
+
+// KubeadmControlPlaneEncryptionAtRestStatus reports the state of the encryption of resources stored in etcd.
+// +kubebuilder:validation:MinProperties=1
+type KubeadmControlPlaneEncryptionAtRestStatus struct {
+	// writeKeyName is the name of the key used by all the control plane Machines to encrypt resources;
+	// all the encrypted resources in the workload cluster have been rewritten with this key.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	WriteKeyName string `json:"writeKeyName,omitempty"`
+
+	// pendingWriteKeyName is the name of the key which is going to be used to encrypt resources during a key rotation;
+	// it is set once all the control plane Machines can decrypt resources with this key.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	PendingWriteKeyName string `json:"pendingWriteKeyName,omitempty"`
+}
+
+// KubeadmControlPlaneEtcdStatus reports the state of the periodic maintenance of the local (stacked) etcd cluster.
+// +kubebuilder:validation:MinProperties=1
+type KubeadmControlPlaneEtcdStatus struct {
+	// lastDefragmentationTime is the time of the last successful defragmentation run.
+	// +optional
+	LastDefragmentationTime metav1.Time `json:"lastDefragmentationTime,omitempty,omitzero"`
+
+	// lastSnapshotTime is the time of the last successful snapshot.
+	// +optional
+	LastSnapshotTime metav1.Time `json:"lastSnapshotTime,omitempty,omitzero"`
+
+	// lastSnapshotAttemptTime is the time of the last snapshot attempt, either successful or discarded
+	// because exceeding spec.etcd.snapshot.maxSizeMiB.
+	// +optional
+	LastSnapshotAttemptTime metav1.Time `json:"lastSnapshotAttemptTime,omitempty,omitzero"`
+
+	// lastSnapshotName is the name of the last successful snapshot; the snapshot is stored, gzip compressed,
+	// in the Secrets with the controlplane.cluster.x-k8s.io/etcd-snapshot-name annotation set to this value.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	LastSnapshotName string `json:"lastSnapshotName,omitempty"`
+}
+
 // KubeadmControlPlaneStatus defines the observed state of KubeadmControlPlane.
 // +kubebuilder:validation:MinProperties=1
 type KubeadmControlPlaneStatus struct {
 	// conditions represents the observations of a KubeadmControlPlane's current state.
 	// Known condition types are Available, CertificatesAvailable, EtcdClusterAvailable, MachinesReady, MachinesUpToDate,
-	// ScalingUp, ScalingDown, Remediating, Deleting, Paused.
+	// ScalingUp, ScalingDown, Remediating, Deleting, Paused, EtcdDefragmented, EtcdSnapshotted.
 	// +optional
 	// +listType=map
 	// +listMapKey=type
@@ -708,6 +1031,23 @@ type KubeadmControlPlaneStatus struct {
 	// +optional
 	LastRemediation LastRemediationStatus `json:"lastRemediation,omitempty,omitzero"`
 
+	// rolloutReasons lists the Machines which are not up-to-date with the KubeadmControlPlane spec
+	// and for each of them the reasons why a rollout is required, e.g. the spec fields that diverged.
+	// +optional
+	// +listType=map
+	// +listMapKey=machineName
+	// +kubebuilder:validation:MaxItems=32
+	RolloutReasons []KubeadmControlPlaneMachineRolloutReasons `json:"rolloutReasons,omitempty"`
+
+	// encryptionAtRest reports the state of the encryption of resources stored in etcd, including key rotations.
+	// +optional
+	EncryptionAtRest *KubeadmControlPlaneEncryptionAtRestStatus `json:"encryptionAtRest,omitempty"`
+
+	// etcd reports the state of the periodic maintenance of the local (stacked) etcd cluster,
+	// i.e. defragmentation and snapshots.
+	// +optional
+	Etcd *KubeadmControlPlaneEtcdStatus `json:"etcd,omitempty"`
+
 	// deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.
 	// +optional
 	Deprecated *KubeadmControlPlaneDeprecatedStatus `json:"deprecated,omitempty"`
@@ -809,6 +1149,25 @@ type LastRemediationStatus struct {
 	RetryCount *int32 `json:"retryCount,omitempty"`
 }
 
+// KubeadmControlPlaneMachineRolloutReasons stores the reasons why a Machine must be rolled out.
+type KubeadmControlPlaneMachineRolloutReasons struct {
+	// machineName is the name of the Machine which must be rolled out.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	MachineName string `json:"machineName,omitempty"`
+
+	// reasons why the Machine must be rolled out, e.g. spec.version, spec.rollout.after or the path of the
+	// KubeadmControlPlane spec fields that diverged from the Machine's KubeadmConfig or InfraMachine.
+	// +required
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=512
+	Reasons []string `json:"reasons,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=kubeadmcontrolplanes,shortName=kcp,scope=Namespaced,categories=cluster-api
 // +kubebuilder:storageversion
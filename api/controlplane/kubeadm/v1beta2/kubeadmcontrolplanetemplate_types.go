@@ -111,6 +111,15 @@ type KubeadmControlPlaneTemplateResourceSpec struct {
 	// InfraMachines & KubeadmConfigs will use the same name as the corresponding Machines.
 	// +optional
 	MachineNaming MachineNamingSpec `json:"machineNaming,omitempty,omitzero"`
+
+	// etcd allows configuring how KCP manages the members of the local (stacked) etcd cluster.
+	// +optional
+	Etcd KubeadmControlPlaneEtcdSpec `json:"etcd,omitempty,omitzero"`
+
+	// endpointManager configures a static pod managing the control plane endpoint on the control plane Machines,
+	// e.g. kube-vip or keepalived announcing a virtual IP set as the Cluster's spec.controlPlaneEndpoint.host.
+	// +optional
+	EndpointManager KubeadmControlPlaneEndpointManagerSpec `json:"endpointManager,omitempty,omitzero"`
 }
 
 // KubeadmControlPlaneTemplateMachineTemplate defines the template for Machines
@@ -139,6 +148,13 @@ type KubeadmControlPlaneTemplateMachineTemplateSpec struct {
 	// deletion contains configuration options for Machine deletion.
 	// +optional
 	Deletion KubeadmControlPlaneTemplateMachineTemplateDeletionSpec `json:"deletion,omitempty,omitzero"`
+
+	// taintPolicy defines whether the node-role.kubernetes.io/control-plane:NoSchedule taint is applied to control plane nodes.
+	// When set to NoSchedule, the taint is applied; when set to None, the taint is not applied, thus allowing control plane
+	// nodes to run workloads too, e.g. in small edge clusters.
+	// If not set, taints are determined by nodeRegistration only.
+	// +optional
+	TaintPolicy KubeadmControlPlaneTaintPolicy `json:"taintPolicy,omitempty"`
 }
 
 // KubeadmControlPlaneTemplateMachineTemplateDeletionSpec contains configuration options for Machine deletion.
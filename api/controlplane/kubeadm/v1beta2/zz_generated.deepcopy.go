@@ -74,6 +74,203 @@ func (in *KubeadmControlPlaneDeprecatedStatus) DeepCopy() *KubeadmControlPlaneDe
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEncryptionAtRestSpec) DeepCopyInto(out *KubeadmControlPlaneEncryptionAtRestSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]KubeadmControlPlaneEncryptionKey, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEncryptionAtRestSpec.
+func (in *KubeadmControlPlaneEncryptionAtRestSpec) DeepCopy() *KubeadmControlPlaneEncryptionAtRestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEncryptionAtRestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEncryptionAtRestStatus) DeepCopyInto(out *KubeadmControlPlaneEncryptionAtRestStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEncryptionAtRestStatus.
+func (in *KubeadmControlPlaneEncryptionAtRestStatus) DeepCopy() *KubeadmControlPlaneEncryptionAtRestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEncryptionAtRestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEncryptionKey) DeepCopyInto(out *KubeadmControlPlaneEncryptionKey) {
+	*out = *in
+	out.Secret = in.Secret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEncryptionKey.
+func (in *KubeadmControlPlaneEncryptionKey) DeepCopy() *KubeadmControlPlaneEncryptionKey {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEncryptionKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEncryptionKeySecretReference) DeepCopyInto(out *KubeadmControlPlaneEncryptionKeySecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEncryptionKeySecretReference.
+func (in *KubeadmControlPlaneEncryptionKeySecretReference) DeepCopy() *KubeadmControlPlaneEncryptionKeySecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEncryptionKeySecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEndpointManagerSpec) DeepCopyInto(out *KubeadmControlPlaneEndpointManagerSpec) {
+	*out = *in
+	out.StaticPod = in.StaticPod
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEndpointManagerSpec.
+func (in *KubeadmControlPlaneEndpointManagerSpec) DeepCopy() *KubeadmControlPlaneEndpointManagerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEndpointManagerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEndpointManagerStaticPod) DeepCopyInto(out *KubeadmControlPlaneEndpointManagerStaticPod) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEndpointManagerStaticPod.
+func (in *KubeadmControlPlaneEndpointManagerStaticPod) DeepCopy() *KubeadmControlPlaneEndpointManagerStaticPod {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEndpointManagerStaticPod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEtcdDefragmentationSpec) DeepCopyInto(out *KubeadmControlPlaneEtcdDefragmentationSpec) {
+	*out = *in
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ThresholdPercentage != nil {
+		in, out := &in.ThresholdPercentage, &out.ThresholdPercentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEtcdDefragmentationSpec.
+func (in *KubeadmControlPlaneEtcdDefragmentationSpec) DeepCopy() *KubeadmControlPlaneEtcdDefragmentationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEtcdDefragmentationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEtcdSnapshotSpec) DeepCopyInto(out *KubeadmControlPlaneEtcdSnapshotSpec) {
+	*out = *in
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSizeMiB != nil {
+		in, out := &in.MaxSizeMiB, &out.MaxSizeMiB
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEtcdSnapshotSpec.
+func (in *KubeadmControlPlaneEtcdSnapshotSpec) DeepCopy() *KubeadmControlPlaneEtcdSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEtcdSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEtcdSpec) DeepCopyInto(out *KubeadmControlPlaneEtcdSpec) {
+	*out = *in
+	if in.JoinAsLearner != nil {
+		in, out := &in.JoinAsLearner, &out.JoinAsLearner
+		*out = new(bool)
+		**out = **in
+	}
+	in.Defragmentation.DeepCopyInto(&out.Defragmentation)
+	in.Snapshot.DeepCopyInto(&out.Snapshot)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEtcdSpec.
+func (in *KubeadmControlPlaneEtcdSpec) DeepCopy() *KubeadmControlPlaneEtcdSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEtcdSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneEtcdStatus) DeepCopyInto(out *KubeadmControlPlaneEtcdStatus) {
+	*out = *in
+	in.LastDefragmentationTime.DeepCopyInto(&out.LastDefragmentationTime)
+	in.LastSnapshotTime.DeepCopyInto(&out.LastSnapshotTime)
+	in.LastSnapshotAttemptTime.DeepCopyInto(&out.LastSnapshotAttemptTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneEtcdStatus.
+func (in *KubeadmControlPlaneEtcdStatus) DeepCopy() *KubeadmControlPlaneEtcdStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneEtcdStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeadmControlPlaneInitializationStatus) DeepCopyInto(out *KubeadmControlPlaneInitializationStatus) {
 	*out = *in
@@ -126,6 +323,26 @@ func (in *KubeadmControlPlaneList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneMachineRolloutReasons) DeepCopyInto(out *KubeadmControlPlaneMachineRolloutReasons) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneMachineRolloutReasons.
+func (in *KubeadmControlPlaneMachineRolloutReasons) DeepCopy() *KubeadmControlPlaneMachineRolloutReasons {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneMachineRolloutReasons)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeadmControlPlaneMachineTemplate) DeepCopyInto(out *KubeadmControlPlaneMachineTemplate) {
 	*out = *in
@@ -307,6 +524,9 @@ func (in *KubeadmControlPlaneSpec) DeepCopyInto(out *KubeadmControlPlaneSpec) {
 	in.Rollout.DeepCopyInto(&out.Rollout)
 	in.Remediation.DeepCopyInto(&out.Remediation)
 	out.MachineNaming = in.MachineNaming
+	in.Etcd.DeepCopyInto(&out.Etcd)
+	in.EncryptionAtRest.DeepCopyInto(&out.EncryptionAtRest)
+	out.EndpointManager = in.EndpointManager
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneSpec.
@@ -351,6 +571,23 @@ func (in *KubeadmControlPlaneStatus) DeepCopyInto(out *KubeadmControlPlaneStatus
 		**out = **in
 	}
 	in.LastRemediation.DeepCopyInto(&out.LastRemediation)
+	if in.RolloutReasons != nil {
+		in, out := &in.RolloutReasons, &out.RolloutReasons
+		*out = make([]KubeadmControlPlaneMachineRolloutReasons, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EncryptionAtRest != nil {
+		in, out := &in.EncryptionAtRest, &out.EncryptionAtRest
+		*out = new(KubeadmControlPlaneEncryptionAtRestStatus)
+		**out = **in
+	}
+	if in.Etcd != nil {
+		in, out := &in.Etcd, &out.Etcd
+		*out = new(KubeadmControlPlaneEtcdStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(KubeadmControlPlaneDeprecatedStatus)
@@ -514,6 +751,8 @@ func (in *KubeadmControlPlaneTemplateResourceSpec) DeepCopyInto(out *KubeadmCont
 	in.Rollout.DeepCopyInto(&out.Rollout)
 	in.Remediation.DeepCopyInto(&out.Remediation)
 	out.MachineNaming = in.MachineNaming
+	in.Etcd.DeepCopyInto(&out.Etcd)
+	out.EndpointManager = in.EndpointManager
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneTemplateResourceSpec.
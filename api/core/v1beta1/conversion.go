@@ -74,8 +74,13 @@ func (src *Cluster) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.Topology.ControlPlane.HealthCheck.Checks.UnhealthyMachineConditions = restored.Spec.Topology.ControlPlane.HealthCheck.Checks.UnhealthyMachineConditions
+	clusterv1.RestoreUnhealthyNodeConditionsSlidingWindow(restored.Spec.Topology.ControlPlane.HealthCheck.Checks.UnhealthyNodeConditions, dst.Spec.Topology.ControlPlane.HealthCheck.Checks.UnhealthyNodeConditions)
+	dst.Spec.Topology.ControlPlane.HealthCheck.OverrideStrategy = restored.Spec.Topology.ControlPlane.HealthCheck.OverrideStrategy
 	for i, md := range restored.Spec.Topology.Workers.MachineDeployments {
 		dst.Spec.Topology.Workers.MachineDeployments[i].HealthCheck.Checks.UnhealthyMachineConditions = md.HealthCheck.Checks.UnhealthyMachineConditions
+		dst.Spec.Topology.Workers.MachineDeployments[i].HealthCheck.OverrideStrategy = md.HealthCheck.OverrideStrategy
+		clusterv1.RestoreUnhealthyNodeConditionsSlidingWindow(md.HealthCheck.Checks.UnhealthyNodeConditions, dst.Spec.Topology.Workers.MachineDeployments[i].HealthCheck.Checks.UnhealthyNodeConditions)
+		dst.Spec.Topology.Workers.MachineDeployments[i].Architecture = md.Architecture
 	}
 
 	// Recover intent for bool values converted to *bool.
@@ -89,6 +94,22 @@ func (src *Cluster) ConvertTo(dstRaw conversion.Hub) error {
 	if !reflect.DeepEqual(initialization, clusterv1.ClusterInitializationStatus{}) {
 		dst.Status.Initialization = initialization
 	}
+
+	// Recover other values
+	if ok {
+		dst.Spec.Pause = restored.Spec.Pause
+		dst.Spec.DegradedFreeze = restored.Spec.DegradedFreeze
+		dst.Spec.Deletion = restored.Spec.Deletion
+		dst.Spec.IdentityRef = restored.Spec.IdentityRef
+		dst.Spec.Topology.FailureDomains = restored.Spec.Topology.FailureDomains
+		dst.Status.FailureDomainsSummary = restored.Status.FailureDomainsSummary
+		dst.Status.Topology = restored.Status.Topology
+		dst.Status.LastOperations = restored.Status.LastOperations
+		dst.Status.Deletion = restored.Status.Deletion
+		if restored.Status.ControlPlane != nil && dst.Status.ControlPlane != nil {
+			dst.Status.ControlPlane.Version = restored.Status.ControlPlane.Version
+		}
+	}
 	return nil
 }
 
@@ -151,8 +172,14 @@ func (src *ClusterClass) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.ControlPlane.HealthCheck.Checks.UnhealthyMachineConditions = restored.Spec.ControlPlane.HealthCheck.Checks.UnhealthyMachineConditions
+	clusterv1.RestoreUnhealthyNodeConditionsSlidingWindow(restored.Spec.ControlPlane.HealthCheck.Checks.UnhealthyNodeConditions, dst.Spec.ControlPlane.HealthCheck.Checks.UnhealthyNodeConditions)
+	dst.Spec.ControlPlane.Naming.MachineInfrastructureTemplate = restored.Spec.ControlPlane.Naming.MachineInfrastructureTemplate
 	for i, md := range restored.Spec.Workers.MachineDeployments {
 		dst.Spec.Workers.MachineDeployments[i].HealthCheck.Checks.UnhealthyMachineConditions = md.HealthCheck.Checks.UnhealthyMachineConditions
+		clusterv1.RestoreUnhealthyNodeConditionsSlidingWindow(md.HealthCheck.Checks.UnhealthyNodeConditions, dst.Spec.Workers.MachineDeployments[i].HealthCheck.Checks.UnhealthyNodeConditions)
+		dst.Spec.Workers.MachineDeployments[i].Naming.InfrastructureTemplate = md.Naming.InfrastructureTemplate
+		dst.Spec.Workers.MachineDeployments[i].Naming.BootstrapTemplate = md.Naming.BootstrapTemplate
+		dst.Spec.Workers.MachineDeployments[i].Architecture = md.Architecture
 	}
 
 	// Recover intent for bool values converted to *bool.
@@ -409,10 +436,17 @@ func (src *Machine) ConvertTo(dstRaw conversion.Hub) error {
 	if ok {
 		dst.Spec.MinReadySeconds = restored.Spec.MinReadySeconds
 		dst.Spec.Taints = restored.Spec.Taints
+		dst.Spec.Deletion.NodeVolumeDetachExclusions = restored.Spec.Deletion.NodeVolumeDetachExclusions
+		dst.Spec.KubeletServingCertificateVerification = restored.Spec.KubeletServingCertificateVerification
+		dst.Spec.NodeReadinessChecks = restored.Spec.NodeReadinessChecks
+		dst.Spec.PowerState = restored.Spec.PowerState
+		dst.Spec.InfrastructureReadyTimeoutSeconds = restored.Spec.InfrastructureReadyTimeoutSeconds
+		dst.Spec.InfrastructureOverrides = restored.Spec.InfrastructureOverrides
 		// Restore the phase, this also means that any client using v1beta1 during a round-trip
 		// won't be able to write the Phase field. But that's okay as the only client writing the Phase
 		// field should be the Machine controller.
 		dst.Status.Phase = restored.Status.Phase
+		dst.Status.LastProvisioningFailure = restored.Status.LastProvisioningFailure
 	}
 
 	return nil
@@ -460,6 +494,15 @@ func (src *MachineSet) ConvertTo(dstRaw conversion.Hub) error {
 	// Recover other values
 	if ok {
 		dst.Spec.Template.Spec.Taints = restored.Spec.Template.Spec.Taints
+		dst.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions
+		dst.Spec.Template.Spec.KubeletServingCertificateVerification = restored.Spec.Template.Spec.KubeletServingCertificateVerification
+		dst.Spec.Template.Spec.NodeReadinessChecks = restored.Spec.Template.Spec.NodeReadinessChecks
+		dst.Spec.Template.Spec.PowerState = restored.Spec.Template.Spec.PowerState
+		dst.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds = restored.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds
+		dst.Spec.Template.Spec.InfrastructureOverrides = restored.Spec.Template.Spec.InfrastructureOverrides
+		dst.Spec.Rebalance = restored.Spec.Rebalance
+		dst.Spec.Standby = restored.Spec.Standby
+		dst.Status.StoppedReplicas = restored.Status.StoppedReplicas
 	}
 
 	return nil
@@ -508,6 +551,21 @@ func (src *MachineDeployment) ConvertTo(dstRaw conversion.Hub) error {
 	// Recover other values
 	if ok {
 		dst.Spec.Template.Spec.Taints = restored.Spec.Template.Spec.Taints
+		dst.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions
+		dst.Spec.Template.Spec.KubeletServingCertificateVerification = restored.Spec.Template.Spec.KubeletServingCertificateVerification
+		dst.Spec.Template.Spec.NodeReadinessChecks = restored.Spec.Template.Spec.NodeReadinessChecks
+		dst.Spec.Template.Spec.PowerState = restored.Spec.Template.Spec.PowerState
+		dst.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds = restored.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds
+		dst.Spec.Template.Spec.InfrastructureOverrides = restored.Spec.Template.Spec.InfrastructureOverrides
+		dst.Spec.Rebalance = restored.Spec.Rebalance
+		dst.Spec.Standby = restored.Spec.Standby
+		dst.Spec.PowerStateSchedule = restored.Spec.PowerStateSchedule
+		dst.Spec.MinReplicas = restored.Spec.MinReplicas
+		dst.Spec.MaxReplicas = restored.Spec.MaxReplicas
+		dst.Status.StoppedReplicas = restored.Status.StoppedReplicas
+		dst.Spec.Rollout.MachineSetReadyTimeoutSeconds = restored.Spec.Rollout.MachineSetReadyTimeoutSeconds
+		dst.Spec.Rollout.NodeReadinessChecks = restored.Spec.Rollout.NodeReadinessChecks
+		dst.Spec.Rollout.Rollback = restored.Spec.Rollout.Rollback
 	}
 
 	return nil
@@ -546,6 +604,10 @@ func (src *MachineHealthCheck) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.Checks.UnhealthyMachineConditions = restored.Spec.Checks.UnhealthyMachineConditions
+	dst.Spec.Remediation.Reboot = restored.Spec.Remediation.Reboot
+	dst.Spec.Remediation.Cordon = restored.Spec.Remediation.Cordon
+	dst.Spec.Checks.NodeProbe = restored.Spec.Checks.NodeProbe
+	clusterv1.RestoreUnhealthyNodeConditionsSlidingWindow(restored.Spec.Checks.UnhealthyNodeConditions, dst.Spec.Checks.UnhealthyNodeConditions)
 
 	clusterv1.Convert_int32_To_Pointer_int32(src.Status.ExpectedMachines, ok, restored.Status.ExpectedMachines, &dst.Status.ExpectedMachines)
 	clusterv1.Convert_int32_To_Pointer_int32(src.Status.CurrentHealthy, ok, restored.Status.CurrentHealthy, &dst.Status.CurrentHealthy)
@@ -599,6 +661,16 @@ func (src *MachinePool) ConvertTo(dstRaw conversion.Hub) error {
 	// Recover other values
 	if ok {
 		dst.Spec.Template.Spec.Taints = restored.Spec.Template.Spec.Taints
+		dst.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions
+		dst.Spec.Template.Spec.KubeletServingCertificateVerification = restored.Spec.Template.Spec.KubeletServingCertificateVerification
+		dst.Spec.Template.Spec.NodeReadinessChecks = restored.Spec.Template.Spec.NodeReadinessChecks
+		dst.Spec.Template.Spec.PowerState = restored.Spec.Template.Spec.PowerState
+		dst.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds = restored.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds
+		dst.Spec.Template.Spec.InfrastructureOverrides = restored.Spec.Template.Spec.InfrastructureOverrides
+		dst.Spec.Machines = restored.Spec.Machines
+		dst.Spec.MinReplicas = restored.Spec.MinReplicas
+		dst.Spec.MaxReplicas = restored.Spec.MaxReplicas
+		dst.Status.Selector = restored.Status.Selector
 	}
 
 	return nil
@@ -1318,6 +1390,10 @@ func Convert_v1beta2_ClusterStatus_To_v1beta1_ClusterStatus(in *clusterv1.Cluste
 	return nil
 }
 
+func Convert_v1beta2_ClusterControlPlaneStatus_To_v1beta1_ClusterControlPlaneStatus(in *clusterv1.ClusterControlPlaneStatus, out *ClusterControlPlaneStatus, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_ClusterControlPlaneStatus_To_v1beta1_ClusterControlPlaneStatus(in, out, s)
+}
+
 func Convert_v1beta1_Topology_To_v1beta2_Topology(in *Topology, out *clusterv1.Topology, s apimachineryconversion.Scope) error {
 	if err := autoConvert_v1beta1_Topology_To_v1beta2_Topology(in, out, s); err != nil {
 		return err
@@ -1329,6 +1405,9 @@ func Convert_v1beta1_Topology_To_v1beta2_Topology(in *Topology, out *clusterv1.T
 	}
 	out.ClassRef.Name = in.Class
 	out.ClassRef.Namespace = in.ClassNamespace
+	if in.RolloutAfter != nil && !reflect.DeepEqual(in.RolloutAfter, &metav1.Time{}) {
+		out.RolloutAfter = *in.RolloutAfter
+	}
 	return nil
 }
 
@@ -1412,6 +1491,9 @@ func Convert_v1beta2_Topology_To_v1beta1_Topology(in *clusterv1.Topology, out *T
 	}
 	out.Class = in.ClassRef.Name
 	out.ClassNamespace = in.ClassRef.Namespace
+	if !reflect.DeepEqual(in.RolloutAfter, metav1.Time{}) {
+		out.RolloutAfter = ptr.To(in.RolloutAfter)
+	}
 	return nil
 }
 
@@ -1900,6 +1982,10 @@ func Convert_v1beta1_MachinePoolSpec_To_v1beta2_MachinePoolSpec(in *MachinePoolS
 	return autoConvert_v1beta1_MachinePoolSpec_To_v1beta2_MachinePoolSpec(in, out, s)
 }
 
+func Convert_v1beta2_MachinePoolSpec_To_v1beta1_MachinePoolSpec(in *clusterv1.MachinePoolSpec, out *MachinePoolSpec, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_MachinePoolSpec_To_v1beta1_MachinePoolSpec(in, out, s)
+}
+
 func Convert_v1beta1_ClusterClassStatusVariableDefinition_To_v1beta2_ClusterClassStatusVariableDefinition(in *ClusterClassStatusVariableDefinition, out *clusterv1.ClusterClassStatusVariableDefinition, s apimachineryconversion.Scope) error {
 	if err := autoConvert_v1beta1_ClusterClassStatusVariableDefinition_To_v1beta2_ClusterClassStatusVariableDefinition(in, out, s); err != nil {
 		return err
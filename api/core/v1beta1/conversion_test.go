@@ -238,8 +238,9 @@ func spokeCluster(in *Cluster, c randfill.Continue) {
 func spokeClusterTopology(in *Topology, c randfill.Continue) {
 	c.FillNoCustom(in)
 
-	// RolloutAfter was unused and has been removed in v1beta2.
-	in.RolloutAfter = nil
+	if reflect.DeepEqual(in.RolloutAfter, &metav1.Time{}) {
+		in.RolloutAfter = nil
+	}
 
 	if in.Workers != nil && reflect.DeepEqual(in.Workers, &WorkersTopology{}) {
 		in.Workers = nil
@@ -510,6 +511,11 @@ func hubMachineSpec(in *clusterv1.MachineSpec, c randfill.Continue) {
 		in.Bootstrap.ConfigRef.APIGroup = gvk.Group
 		in.Bootstrap.ConfigRef.Kind = gvk.Kind
 	}
+
+	// Ensure infrastructureOverrides have a valid JSON spec.
+	for i := range in.InfrastructureOverrides {
+		in.InfrastructureOverrides[i].Spec = apiextensionsv1.JSON{Raw: []byte(`{"instanceType":"test"}`)}
+	}
 }
 
 func hubMachineStatus(in *clusterv1.MachineStatus, c randfill.Continue) {
@@ -434,6 +434,31 @@ const (
 	ClusterRemediatingInternalErrorReason = InternalErrorReason
 )
 
+// Cluster's DegradedFreeze condition and corresponding reasons.
+const (
+	// ClusterDegradedFreezeCondition is True when automated disruptive actions on the Cluster are frozen
+	// because the number of unhealthy Machines reached spec.degradedFreeze.unhealthyMachinesThreshold.
+	// Note: This condition is only set when spec.degradedFreeze.unhealthyMachinesThreshold is set.
+	ClusterDegradedFreezeCondition = "DegradedFreeze"
+
+	// ClusterDegradedFreezeReason surfaces when the number of unhealthy Machines reached
+	// spec.degradedFreeze.unhealthyMachinesThreshold and the freeze has not been acknowledged yet.
+	ClusterDegradedFreezeReason = "DegradedFreeze"
+
+	// ClusterNotDegradedFreezeReason surfaces when the number of unhealthy Machines is below
+	// spec.degradedFreeze.unhealthyMachinesThreshold.
+	ClusterNotDegradedFreezeReason = "NotDegradedFreeze"
+
+	// ClusterDegradedFreezeAcknowledgedReason surfaces when the number of unhealthy Machines is still
+	// at or above spec.degradedFreeze.unhealthyMachinesThreshold, but the freeze has been acknowledged
+	// via the cluster.x-k8s.io/degraded-freeze-acknowledged annotation.
+	ClusterDegradedFreezeAcknowledgedReason = "DegradedFreezeAcknowledged"
+
+	// ClusterDegradedFreezeInternalErrorReason surfaces unexpected failures when listing machines
+	// or computing the DegradedFreeze condition.
+	ClusterDegradedFreezeInternalErrorReason = InternalErrorReason
+)
+
 // Cluster's Deleting condition and corresponding reasons.
 const (
 	// ClusterDeletingCondition surfaces details about ongoing deletion of the cluster.
@@ -477,6 +502,12 @@ type ClusterSpec struct {
 	// +optional
 	Paused *bool `json:"paused,omitempty"`
 
+	// pause provides additional information about why the Cluster is paused, and when the pause
+	// must be cleared automatically. It can only be set when paused is set to true, and it is
+	// removed when the Cluster is unpaused.
+	// +optional
+	Pause ClusterPauseSpec `json:"pause,omitempty,omitzero"`
+
 	// clusterNetwork represents the cluster network configuration.
 	// +optional
 	ClusterNetwork ClusterNetwork `json:"clusterNetwork,omitempty,omitzero"`
@@ -513,6 +544,67 @@ type ClusterSpec struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=32
 	AvailabilityGates []ClusterAvailabilityGate `json:"availabilityGates,omitempty"`
+
+	// degradedFreeze configures when automated disruptive actions on the Cluster, like rollouts and remediation,
+	// must be frozen after repeated failures, e.g. to prevent cascading damage caused by a bad template.
+	// +optional
+	DegradedFreeze ClusterDegradedFreezeSpec `json:"degradedFreeze,omitempty,omitzero"`
+
+	// deletion contains configuration options for the deletion of the Cluster.
+	// +optional
+	Deletion ClusterDeletionSpec `json:"deletion,omitempty,omitzero"`
+
+	// identityRef is an optional reference to the credentials that infrastructure providers should use
+	// to manage the infrastructure of the Cluster, e.g. a Secret or a provider-specific identity.
+	// Cluster API does not use the referenced credentials; it validates the reference and ensures
+	// the referenced object is moved together with the Cluster by clusterctl move.
+	// Infrastructure providers supporting this field should prefer it over provider-specific
+	// identity references in the InfraCluster, if any.
+	// +optional
+	IdentityRef ClusterIdentityReference `json:"identityRef,omitempty,omitzero"`
+}
+
+// ClusterIdentityReference is a reference to the credentials used to manage the infrastructure of a Cluster.
+type ClusterIdentityReference struct {
+	// kind of the resource being referenced, e.g. Secret.
+	// kind must consist of alphanumeric characters or '-', start with an alphabetic character, and end with an alphanumeric character.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`
+	Kind string `json:"kind,omitempty"`
+
+	// name of the resource being referenced.
+	// Namespaced resources are looked up in the namespace of the Cluster; cross-namespace references are not supported.
+	// name must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Name string `json:"name,omitempty"`
+
+	// apiGroup is the group of the resource being referenced.
+	// If not set, the resource being referenced must be a Secret.
+	// For other groups, the corresponding version for this reference will be looked up from the contract
+	// labels of the corresponding CRD of the resource being referenced.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	APIGroup string `json:"apiGroup,omitempty"`
+}
+
+// IsDefined returns true if the ClusterIdentityReference is set.
+func (r *ClusterIdentityReference) IsDefined() bool {
+	if r == nil {
+		return false
+	}
+	return r.Kind != "" || r.Name != "" || r.APIGroup != ""
+}
+
+// IsSecret returns true if the ClusterIdentityReference refers to a Secret.
+func (r *ClusterIdentityReference) IsSecret() bool {
+	return r.APIGroup == "" && r.Kind == "Secret"
 }
 
 // ConditionPolarity defines the polarity for a metav1.Condition.
@@ -561,6 +653,16 @@ type Topology struct {
 	// +kubebuilder:validation:MaxLength=256
 	Version string `json:"version,omitempty"`
 
+	// rolloutAfter is a field to indicate a rollout should be performed
+	// after the specified time even if no changes have been made to the
+	// Cluster, e.g. to refresh certificates or machine images.
+	// The rollout is first performed on the control plane; MachineDeployments are
+	// rolled out only after the control plane completed its rollout.
+	// NOTE: the ControlPlane provider must support the spec.rollout.after field
+	// (e.g. as in KubeadmControlPlane); MachinePools are not rolled out.
+	// +optional
+	RolloutAfter metav1.Time `json:"rolloutAfter,omitempty,omitzero"`
+
 	// controlPlane describes the cluster control plane.
 	// +optional
 	ControlPlane ControlPlaneTopology `json:"controlPlane,omitempty,omitzero"`
@@ -570,6 +672,18 @@ type Topology struct {
 	// +optional
 	Workers WorkersTopology `json:"workers,omitempty,omitzero"`
 
+	// failureDomains is the list of the failure domains the Cluster is expected to span, e.g.
+	// the regions or zones where the infrastructure provider should create infrastructure.
+	// It is surfaced to ClusterClass patches as the builtin.cluster.topology.failureDomains variable,
+	// so that the InfrastructureCluster can be composed from per-failure-domain fragments.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=256
+	FailureDomains []string `json:"failureDomains,omitempty"`
+
 	// variables can be used to customize the Cluster through
 	// patches. They must comply to the corresponding
 	// VariableClasses defined in the ClusterClass.
@@ -657,6 +771,21 @@ type ControlPlaneTopology struct {
 	Variables ControlPlaneVariables `json:"variables,omitempty,omitzero"`
 }
 
+// TopologyHealthCheckOverrideStrategy defines how a health check defined in the Cluster topology is combined
+// with the health check defined in the ClusterClass.
+// +kubebuilder:validation:Enum=Replace;Merge
+type TopologyHealthCheckOverrideStrategy string
+
+const (
+	// TopologyHealthCheckOverrideStrategyReplace uses checks and remediation defined in the Cluster topology instead of
+	// the ones defined in the ClusterClass.
+	TopologyHealthCheckOverrideStrategyReplace TopologyHealthCheckOverrideStrategy = "Replace"
+
+	// TopologyHealthCheckOverrideStrategyMerge overrides each field of checks and remediation defined in the ClusterClass
+	// with the corresponding field defined in the Cluster topology, if set.
+	TopologyHealthCheckOverrideStrategyMerge TopologyHealthCheckOverrideStrategy = "Merge"
+)
+
 // ControlPlaneTopologyHealthCheck defines a MachineHealthCheck for control plane machines.
 // +kubebuilder:validation:MinProperties=1
 type ControlPlaneTopologyHealthCheck struct {
@@ -703,6 +832,17 @@ type ControlPlaneTopologyHealthCheck struct {
 	//
 	// +optional
 	Remediation ControlPlaneTopologyHealthCheckRemediation `json:"remediation,omitempty,omitzero"`
+
+	// overrideStrategy defines how checks and remediation are combined with the health check defined in the ClusterClass.
+	//
+	// If set to Replace (default), checks and remediation are used instead of the corresponding fields in ClusterClass
+	// as soon as one of them is set.
+	//
+	// If set to Merge, each field set in checks and remediation overrides the corresponding field in ClusterClass,
+	// while fields which are not set are inherited from ClusterClass, e.g. to only tune remediation.triggerIf.
+	//
+	// +optional
+	OverrideStrategy TopologyHealthCheckOverrideStrategy `json:"overrideStrategy,omitempty"`
 }
 
 // IsDefined returns true if one of checks and remediation are not zero.
@@ -869,6 +1009,11 @@ type MachineDeploymentTopology struct {
 	// +kubebuilder:validation:MaxLength=256
 	FailureDomain string `json:"failureDomain,omitempty"`
 
+	// architecture is the CPU architecture of the machines.
+	// If not set, the architecture from the corresponding MachineDeploymentClass will be used, if any.
+	// +optional
+	Architecture MachineArchitecture `json:"architecture,omitempty"`
+
 	// replicas is the number of worker nodes belonging to this set.
 	// If the value is nil, the MachineDeployment is created without the number of Replicas (defaulting to 1)
 	// and it's assumed that an external entity (like cluster autoscaler) is responsible for the management
@@ -963,6 +1108,17 @@ type MachineDeploymentTopologyHealthCheck struct {
 	//
 	// +optional
 	Remediation MachineDeploymentTopologyHealthCheckRemediation `json:"remediation,omitempty,omitzero"`
+
+	// overrideStrategy defines how checks and remediation are combined with the health check defined in the ClusterClass.
+	//
+	// If set to Replace (default), checks and remediation are used instead of the corresponding fields in ClusterClass
+	// as soon as one of them is set.
+	//
+	// If set to Merge, each field set in checks and remediation overrides the corresponding field in ClusterClass,
+	// while fields which are not set are inherited from ClusterClass, e.g. to only tune remediation.triggerIf.
+	//
+	// +optional
+	OverrideStrategy TopologyHealthCheckOverrideStrategy `json:"overrideStrategy,omitempty"`
 }
 
 // IsDefined returns true if one of checks and remediation are not zero.
@@ -1297,6 +1453,86 @@ type MachinePoolVariables struct {
 	Overrides []ClusterVariable `json:"overrides,omitempty"`
 }
 
+// ClusterPauseSpec defines additional information about a Cluster pause.
+// +kubebuilder:validation:MinProperties=1
+type ClusterPauseSpec struct {
+	// reason is a human-readable explanation of why the Cluster is paused.
+	// The reason is surfaced in the Paused condition of the Cluster and of all its associated objects.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Reason string `json:"reason,omitempty"`
+
+	// requestedBy identifies who paused the Cluster, e.g. a user or a system.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	RequestedBy string `json:"requestedBy,omitempty"`
+
+	// expireAfter is the time after which the pause is automatically cleared by the Cluster controller,
+	// by setting paused to false and by removing the pause field.
+	// If not set, the pause has to be cleared manually.
+	// Example: In the YAML the time can be specified in the RFC3339 format.
+	// To specify the expireAfter target as March 9, 2023, at 9 am UTC
+	// use "2023-03-09T09:00:00Z".
+	// +optional
+	ExpireAfter metav1.Time `json:"expireAfter,omitempty,omitzero"`
+}
+
+// ClusterDeletionSpec contains configuration options for the deletion of a Cluster.
+// +kubebuilder:validation:MinProperties=1
+type ClusterDeletionSpec struct {
+	// phaseTimeoutSeconds is the amount of time each phase of the Cluster deletion, i.e. deleting the workers,
+	// deleting the control plane and deleting the infrastructure cluster, is expected to take.
+	// When a phase takes longer, it is reported as timed out in status.deletion, in the Deleting condition and with an event,
+	// so stuck deletions can be identified; the deletion keeps waiting for the phase to complete.
+	// The default value is 0, meaning that phases are never reported as timed out.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	PhaseTimeoutSeconds *int32 `json:"phaseTimeoutSeconds,omitempty"`
+}
+
+// ClusterDeletionPhase is a phase of the Cluster deletion.
+// +kubebuilder:validation:Enum=WaitingForBeforeDeleteHook;DeletingWorkers;DeletingControlPlane;DeletingInfrastructure;Completed
+type ClusterDeletionPhase string
+
+const (
+	// ClusterDeletionPhaseWaitingForBeforeDeleteHook is the phase during which the Cluster deletion waits for
+	// the BeforeClusterDelete lifecycle hook.
+	ClusterDeletionPhaseWaitingForBeforeDeleteHook ClusterDeletionPhase = "WaitingForBeforeDeleteHook"
+
+	// ClusterDeletionPhaseDeletingWorkers is the phase during which MachinePools, MachineDeployments, MachineSets and
+	// worker Machines are deleted.
+	ClusterDeletionPhaseDeletingWorkers ClusterDeletionPhase = "DeletingWorkers"
+
+	// ClusterDeletionPhaseDeletingControlPlane is the phase during which the control plane, or the stand-alone
+	// control plane Machines, are deleted; it starts only after all the workers have been deleted.
+	ClusterDeletionPhaseDeletingControlPlane ClusterDeletionPhase = "DeletingControlPlane"
+
+	// ClusterDeletionPhaseDeletingInfrastructure is the phase during which the infrastructure cluster is deleted;
+	// it starts only after the control plane has been deleted.
+	ClusterDeletionPhaseDeletingInfrastructure ClusterDeletionPhase = "DeletingInfrastructure"
+
+	// ClusterDeletionPhaseCompleted is the phase reached when all the objects of the Cluster have been deleted.
+	ClusterDeletionPhaseCompleted ClusterDeletionPhase = "Completed"
+)
+
+// ClusterDegradedFreezeSpec defines when automated disruptive actions on a Cluster must be frozen.
+// +kubebuilder:validation:MinProperties=1
+type ClusterDegradedFreezeSpec struct {
+	// unhealthyMachinesThreshold is the number of Machines with the HealthCheckSucceeded condition set to False,
+	// e.g. Machines created by a rollout which never became healthy or Machines which could not be remediated,
+	// at which the DegradedFreeze condition is set to True.
+	// While the DegradedFreeze condition is True, MachineHealthChecks do not trigger remediation, MachineDeployments
+	// do not roll out, and KubeadmControlPlane neither rolls out nor remediates Machines.
+	// The DegradedFreeze condition stays True until the freeze is acknowledged by adding the
+	// cluster.x-k8s.io/degraded-freeze-acknowledged annotation to the Cluster; the annotation is removed
+	// by the Cluster controller as soon as the number of unhealthy Machines is below the threshold again.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	UnhealthyMachinesThreshold *int32 `json:"unhealthyMachinesThreshold,omitempty"`
+}
+
 // ClusterNetwork specifies the different networking
 // parameters for a cluster.
 // +kubebuilder:validation:MinProperties=1
@@ -1376,6 +1612,30 @@ type ClusterStatus struct {
 	// +kubebuilder:validation:MaxItems=100
 	FailureDomains []FailureDomain `json:"failureDomains,omitempty"`
 
+	// failureDomainsSummary groups the observations about how Machines are spread across failure domains.
+	// +optional
+	FailureDomainsSummary *ClusterFailureDomainsSummary `json:"failureDomainsSummary,omitempty"`
+
+	// topology groups the observations about the reconciliation of the managed topology.
+	// This field is set only if the Cluster is referencing a ClusterClass / defining a managed Topology.
+	// +optional
+	Topology *ClusterTopologyStatus `json:"topology,omitempty"`
+
+	// lastOperations is a bounded history of the significant operations observed on the Cluster, e.g. upgrades,
+	// scale events, remediations and moves, from the oldest to the most recent.
+	// When the history is full, the oldest operation is dropped to make room for a new one.
+	// NOTE: The history is not preserved when the Cluster is moved to another management cluster.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+	LastOperations []ClusterOperation `json:"lastOperations,omitempty"`
+
+	// deletion reports the progress of the Cluster deletion.
+	// Only present when the Cluster has a deletionTimestamp.
+	// +optional
+	Deletion *ClusterDeletionStatus `json:"deletion,omitempty"`
+
 	// phase represents the current phase of cluster actuation.
 	// +optional
 	// +kubebuilder:validation:Enum=Pending;Provisioning;Provisioned;Deleting;Failed;Unknown
@@ -1411,6 +1671,31 @@ type ClusterInitializationStatus struct {
 	ControlPlaneInitialized *bool `json:"controlPlaneInitialized,omitempty"`
 }
 
+// ClusterDeletionStatus is the deletion state of the Cluster.
+type ClusterDeletionStatus struct {
+	// phase is the current phase of the Cluster deletion.
+	// +optional
+	Phase ClusterDeletionPhase `json:"phase,omitempty"`
+
+	// phaseStartTime is the time when the current phase started and is used to determine
+	// if the phaseTimeoutSeconds is exceeded.
+	// +optional
+	PhaseStartTime metav1.Time `json:"phaseStartTime,omitempty,omitzero"`
+
+	// phaseTimedOut is true when the current phase takes longer than spec.deletion.phaseTimeoutSeconds.
+	// +optional
+	PhaseTimedOut *bool `json:"phaseTimedOut,omitempty"`
+
+	// pendingObjects is a summary of the objects the current phase is waiting for to be deleted.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=2048
+	PendingObjects []string `json:"pendingObjects,omitempty"`
+}
+
 // ClusterDeprecatedStatus groups all the status fields that are deprecated and will be removed in a future version.
 // See https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20240916-improve-status-in-CAPI-resources.md for more context.
 type ClusterDeprecatedStatus struct {
@@ -1471,6 +1756,14 @@ type ClusterControlPlaneStatus struct {
 	// availableReplicas is the total number of available control plane machines in this cluster. A machine is considered available when Machine's Available condition is true.
 	// +optional
 	AvailableReplicas *int32 `json:"availableReplicas,omitempty"`
+
+	// version is the Kubernetes version reported by the control plane in status.version.
+	// When the Cluster is using a managed topology, comparing this value with spec.topology.version
+	// surfaces the version drift of the control plane, e.g. during upgrades.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Version string `json:"version,omitempty"`
 }
 
 // WorkersStatus groups all the observations about workers current state.
@@ -1497,6 +1790,162 @@ type WorkersStatus struct {
 	AvailableReplicas *int32 `json:"availableReplicas,omitempty"`
 }
 
+// ClusterFailureDomainsSummary groups the observations about how Machines are spread across failure domains.
+// +kubebuilder:validation:MinProperties=1
+type ClusterFailureDomainsSummary struct {
+	// controlPlaneSkew is the difference between the highest and the lowest number of control plane machines
+	// across the failure domains suitable for control plane machines; a value greater than one usually indicates
+	// that control plane machines are not evenly spread, e.g. after a remediation or a scale down.
+	// This field is not set if there are no failure domains suitable for control plane machines or no control plane machines.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ControlPlaneSkew *int32 `json:"controlPlaneSkew,omitempty"`
+
+	// failureDomains reports the number of machines in each failure domain; it includes all the failure domains in
+	// status.failureDomains and the failure domains of existing machines, if not already included.
+	// Machines without a failure domain are not counted.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	FailureDomains []ClusterFailureDomainSummary `json:"failureDomains,omitempty"`
+}
+
+// ClusterTopologyStatus groups the observations about the reconciliation of the managed topology.
+// +kubebuilder:validation:MinProperties=1
+type ClusterTopologyStatus struct {
+	// lastAppliedPatches reports the external patches of the ClusterClass called during the last computation
+	// of the desired state of the managed topology, in the order in which they are defined in the ClusterClass.
+	// Inline patches are not reported.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	LastAppliedPatches []ClusterTopologyAppliedPatch `json:"lastAppliedPatches,omitempty"`
+}
+
+// ClusterTopologyPatchValidationResult is the outcome of the validation of the managed topology
+// by the ValidateTopology extension of an external patch.
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type ClusterTopologyPatchValidationResult string
+
+const (
+	// ClusterTopologyPatchValidationSucceeded is the outcome of a ValidateTopology extension accepting the managed topology.
+	ClusterTopologyPatchValidationSucceeded ClusterTopologyPatchValidationResult = "Succeeded"
+
+	// ClusterTopologyPatchValidationFailed is the outcome of a ValidateTopology extension rejecting the managed topology,
+	// or failing to validate it.
+	ClusterTopologyPatchValidationFailed ClusterTopologyPatchValidationResult = "Failed"
+)
+
+// ClusterTopologyAppliedPatch reports the outcome of an external patch of the ClusterClass.
+type ClusterTopologyAppliedPatch struct {
+	// name is the name of the patch in the ClusterClass.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name,omitempty"`
+
+	// generatePatchesExtension is the name of the GeneratePatches extension called for this patch.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	GeneratePatchesExtension string `json:"generatePatchesExtension,omitempty"`
+
+	// appliedPatches is the number of patches returned by the GeneratePatches extension and applied to the templates.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	AppliedPatches *int32 `json:"appliedPatches,omitempty"`
+
+	// validateTopologyExtension is the name of the ValidateTopology extension called for this patch.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	ValidateTopologyExtension string `json:"validateTopologyExtension,omitempty"`
+
+	// validationResult is the outcome of the ValidateTopology extension.
+	// This field is not set if the patch does not have a ValidateTopology extension, or if validation
+	// did not happen e.g. because a previous patch failed.
+	// +optional
+	ValidationResult ClusterTopologyPatchValidationResult `json:"validationResult,omitempty"`
+
+	// validationMessage is the message returned by the ValidateTopology extension when validation failed.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=10240
+	ValidationMessage string `json:"validationMessage,omitempty"`
+}
+
+// ClusterOperationType is the type of an operation recorded in the Cluster status.
+// +kubebuilder:validation:Enum=ControlPlaneUpgradeStarted;ControlPlaneUpgradeCompleted;ControlPlaneScaled;WorkersScaled;MachineRemediated;Moved
+type ClusterOperationType string
+
+const (
+	// ClusterOperationControlPlaneUpgradeStarted records that the control plane started upgrading to a new version.
+	ClusterOperationControlPlaneUpgradeStarted ClusterOperationType = "ControlPlaneUpgradeStarted"
+
+	// ClusterOperationControlPlaneUpgradeCompleted records that the control plane completed an upgrade to a new version.
+	ClusterOperationControlPlaneUpgradeCompleted ClusterOperationType = "ControlPlaneUpgradeCompleted"
+
+	// ClusterOperationControlPlaneScaled records a change of the desired number of control plane replicas.
+	ClusterOperationControlPlaneScaled ClusterOperationType = "ControlPlaneScaled"
+
+	// ClusterOperationWorkersScaled records a change of the desired number of worker replicas.
+	ClusterOperationWorkersScaled ClusterOperationType = "WorkersScaled"
+
+	// ClusterOperationMachineRemediated records that a Machine of the Cluster started being remediated.
+	ClusterOperationMachineRemediated ClusterOperationType = "MachineRemediated"
+
+	// ClusterOperationMoved records that the Cluster has been moved to this management cluster by clusterctl move.
+	ClusterOperationMoved ClusterOperationType = "Moved"
+)
+
+// ClusterOperation is a significant operation observed on the Cluster.
+type ClusterOperation struct {
+	// type is the type of the operation.
+	// +required
+	Type ClusterOperationType `json:"type,omitempty"`
+
+	// message is a human-readable description of the operation.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty"`
+
+	// time is the time when the operation has been observed.
+	// +required
+	Time metav1.Time `json:"time,omitempty,omitzero"`
+}
+
+// ClusterFailureDomainSummary reports the number of machines in a failure domain.
+type ClusterFailureDomainSummary struct {
+	// name is the name of the failure domain.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name,omitempty"`
+
+	// machines is the number of machines in this failure domain.
+	// NOTE: machines also includes machines still being provisioned or being deleted.
+	// +optional
+	Machines *int32 `json:"machines,omitempty"`
+
+	// readyMachines is the number of ready machines in this failure domain. A machine is considered ready when Machine's Ready condition is true.
+	// +optional
+	ReadyMachines *int32 `json:"readyMachines,omitempty"`
+
+	// controlPlaneMachines is the number of control plane machines in this failure domain.
+	// NOTE: controlPlaneMachines also includes machines still being provisioned or being deleted.
+	// +optional
+	ControlPlaneMachines *int32 `json:"controlPlaneMachines,omitempty"`
+
+	// readyControlPlaneMachines is the number of ready control plane machines in this failure domain. A machine is considered ready when Machine's Ready condition is true.
+	// +optional
+	ReadyControlPlaneMachines *int32 `json:"readyControlPlaneMachines,omitempty"`
+}
+
 // SetTypedPhase sets the Phase field to the string representation of ClusterPhase.
 func (c *ClusterStatus) SetTypedPhase(p ClusterPhase) {
 	c.Phase = string(p)
@@ -1569,6 +2018,8 @@ func (v APIEndpoint) String() string {
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Cluster status such as Pending/Provisioning/Provisioned/Deleting/Failed"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of Cluster"
 // +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.topology.version",description="Kubernetes version associated with this Cluster"
+// +kubebuilder:printcolumn:name="CP Version",type="string",JSONPath=".status.controlPlane.version",description="Kubernetes version reported by the control plane"
+// +kubebuilder:printcolumn:name="Topology Reconciled",type="string",JSONPath=`.status.conditions[?(@.type=="TopologyReconciled")].status`,description="Managed topology reconciled",priority=10
 
 // Cluster is the Schema for the clusters API.
 type Cluster struct {
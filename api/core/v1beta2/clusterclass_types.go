@@ -376,6 +376,21 @@ type ControlPlaneClassNamingSpec struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=1024
 	Template string `json:"template,omitempty"`
+
+	// machineInfrastructureTemplate defines the template to use for generating the name of the
+	// InfrastructureMachineTemplate object of the control plane.
+	// If not defined, it will fallback to `{{ .cluster.name }}-{{ .random }}`.
+	// The template must contain `{{ .random }}`, because a new InfrastructureMachineTemplate is created
+	// with a new name every time the template is rotated.
+	// If the templated string exceeds 63 characters, it will be trimmed to 58 characters and will
+	// get concatenated with a random suffix of length 5.
+	// The templating mechanism provides the following arguments:
+	// * `.cluster.name`: The name of the cluster object.
+	// * `.random`: A random alphanumeric string, without vowels, of length 5.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	MachineInfrastructureTemplate string `json:"machineInfrastructureTemplate,omitempty"`
 }
 
 // InfrastructureClassNamingSpec defines the naming strategy for infrastructure objects.
@@ -454,6 +469,15 @@ type MachineDeploymentClass struct {
 	// +kubebuilder:validation:MaxLength=256
 	FailureDomain string `json:"failureDomain,omitempty"`
 
+	// architecture is the CPU architecture of the machines.
+	// If set, the architecture is added as a label to the machines and to the corresponding nodes,
+	// and it is surfaced to the cluster autoscaler for scaling from zero; also, if the infrastructure
+	// machine template reports the architecture it supports via status.nodeInfo.architecture, the
+	// architecture must match.
+	// NOTE: This value can be overridden while defining a Cluster.Topology using this MachineDeploymentClass.
+	// +optional
+	Architecture MachineArchitecture `json:"architecture,omitempty"`
+
 	// naming allows changing the naming pattern used when creating the MachineDeployment.
 	// +optional
 	Naming MachineDeploymentClassNamingSpec `json:"naming,omitempty,omitzero"`
@@ -491,6 +515,24 @@ type MachineDeploymentClass struct {
 	Rollout MachineDeploymentClassRolloutSpec `json:"rollout,omitempty,omitzero"`
 }
 
+// MachineArchitecture represents the CPU architecture of a machine.
+// +kubebuilder:validation:Enum=amd64;arm64;s390x;ppc64le
+type MachineArchitecture string
+
+const (
+	// MachineArchitectureAmd64 is the amd64 CPU architecture.
+	MachineArchitectureAmd64 MachineArchitecture = "amd64"
+
+	// MachineArchitectureArm64 is the arm64 CPU architecture.
+	MachineArchitectureArm64 MachineArchitecture = "arm64"
+
+	// MachineArchitectureS390x is the s390x CPU architecture.
+	MachineArchitectureS390x MachineArchitecture = "s390x"
+
+	// MachineArchitecturePpc64le is the ppc64le CPU architecture.
+	MachineArchitecturePpc64le MachineArchitecture = "ppc64le"
+)
+
 // MachineDeploymentClassHealthCheck defines a MachineHealthCheck for MachineDeployment machines.
 // +kubebuilder:validation:MinProperties=1
 type MachineDeploymentClassHealthCheck struct {
@@ -671,6 +713,36 @@ type MachineDeploymentClassNamingSpec struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=1024
 	Template string `json:"template,omitempty"`
+
+	// infrastructureTemplate defines the template to use for generating the name of the InfrastructureMachineTemplate object.
+	// If not defined, it will fallback to `{{ .cluster.name }}-{{ .machineDeployment.topologyName }}-{{ .random }}`.
+	// The template must contain `{{ .random }}`, because a new InfrastructureMachineTemplate is created
+	// with a new name every time the template is rotated.
+	// If the templated string exceeds 63 characters, it will be trimmed to 58 characters and will
+	// get concatenated with a random suffix of length 5.
+	// The templating mechanism provides the following arguments:
+	// * `.cluster.name`: The name of the cluster object.
+	// * `.random`: A random alphanumeric string, without vowels, of length 5.
+	// * `.machineDeployment.topologyName`: The name of the MachineDeployment topology (Cluster.spec.topology.workers.machineDeployments[].name).
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	InfrastructureTemplate string `json:"infrastructureTemplate,omitempty"`
+
+	// bootstrapTemplate defines the template to use for generating the name of the BootstrapTemplate object.
+	// If not defined, it will fallback to `{{ .cluster.name }}-{{ .machineDeployment.topologyName }}-{{ .random }}`.
+	// The template must contain `{{ .random }}`, because a new BootstrapTemplate is created
+	// with a new name every time the template is rotated.
+	// If the templated string exceeds 63 characters, it will be trimmed to 58 characters and will
+	// get concatenated with a random suffix of length 5.
+	// The templating mechanism provides the following arguments:
+	// * `.cluster.name`: The name of the cluster object.
+	// * `.random`: A random alphanumeric string, without vowels, of length 5.
+	// * `.machineDeployment.topologyName`: The name of the MachineDeployment topology (Cluster.spec.topology.workers.machineDeployments[].name).
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	BootstrapTemplate string `json:"bootstrapTemplate,omitempty"`
 }
 
 // MachineDeploymentClassRolloutSpec defines the rollout behavior.
@@ -1400,6 +1472,10 @@ type JSONPatch struct {
 	// Note: For now the only allowed array modifications are `append` and `prepend`, i.e.:
 	// * for op: `add`: only index 0 (prepend) and - (append) are allowed
 	// * for op: `replace` or `remove`: no indexes are allowed
+	// Note: Existing array items can be selected by key using a list item selector in the form `[key=value]`,
+	// e.g. `/spec/template/spec/files/[path=/etc/hosts]/content`; the selector is resolved to the index
+	// of the first item having the field `key` equal to `value` when the patch is applied.
+	// For op: `add` a list item selector can't be the last element of the path.
 	// +required
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=512
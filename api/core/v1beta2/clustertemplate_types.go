@@ -0,0 +1,291 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ClusterTemplateInstanceLabel is the label set on all the objects created from a ClusterTemplateInstance.
+	// The value of the label is the name of the ClusterTemplateInstance.
+	ClusterTemplateInstanceLabel = "cluster.x-k8s.io/cluster-template-instance"
+
+	// ClusterTemplateClusterNameParameter is the name of the built-in parameter that is set to the name of the Cluster
+	// being instantiated from a ClusterTemplate.
+	ClusterTemplateClusterNameParameter = "CLUSTER_NAME"
+
+	// ClusterTemplateNamespaceParameter is the name of the built-in parameter that is set to the namespace
+	// in which a ClusterTemplate is instantiated.
+	ClusterTemplateNamespaceParameter = "NAMESPACE"
+)
+
+// ClusterTemplateInstance's Instantiated condition and corresponding reasons.
+const (
+	// ClusterTemplateInstanceInstantiatedCondition surfaces whether the objects of the ClusterTemplate
+	// have been created.
+	ClusterTemplateInstanceInstantiatedCondition = "Instantiated"
+
+	// ClusterTemplateInstanceInstantiatedReason surfaces when all the objects of the ClusterTemplate have been created.
+	ClusterTemplateInstanceInstantiatedReason = "Instantiated"
+
+	// ClusterTemplateInstanceClusterTemplateNotFoundReason surfaces when the ClusterTemplate referenced by
+	// the ClusterTemplateInstance does not exist.
+	ClusterTemplateInstanceClusterTemplateNotFoundReason = "ClusterTemplateNotFound"
+
+	// ClusterTemplateInstanceInvalidParametersReason surfaces when the parameters of the ClusterTemplateInstance
+	// are not valid for the referenced ClusterTemplate, or when the ClusterTemplate cannot be rendered with them.
+	ClusterTemplateInstanceInvalidParametersReason = "InvalidParameters"
+
+	// ClusterTemplateInstanceObjectNotAllowedReason surfaces when the ClusterTemplate contains objects of a kind
+	// which is not allowed, e.g. Secrets when they have not been explicitly enabled.
+	ClusterTemplateInstanceObjectNotAllowedReason = "ObjectNotAllowed"
+
+	// ClusterTemplateInstanceInstantiatedInternalErrorReason surfaces unexpected failures when instantiating
+	// a ClusterTemplate.
+	ClusterTemplateInstanceInstantiatedInternalErrorReason = InternalErrorReason
+)
+
+// ClusterTemplateParameterType defines the type of a ClusterTemplate parameter.
+// +kubebuilder:validation:Enum=String;Integer;Boolean
+type ClusterTemplateParameterType string
+
+const (
+	// ClusterTemplateParameterTypeString is the type of parameters accepting any string value.
+	ClusterTemplateParameterTypeString ClusterTemplateParameterType = "String"
+
+	// ClusterTemplateParameterTypeInteger is the type of parameters accepting integer values.
+	ClusterTemplateParameterTypeInteger ClusterTemplateParameterType = "Integer"
+
+	// ClusterTemplateParameterTypeBoolean is the type of parameters accepting the values true and false.
+	ClusterTemplateParameterTypeBoolean ClusterTemplateParameterType = "Boolean"
+)
+
+// ClusterTemplateSpec defines the desired state of a ClusterTemplate.
+type ClusterTemplateSpec struct {
+	// parameters defines the typed parameters of the template.
+	// Parameters are referenced in the template using the ${NAME} syntax.
+	// The parameters CLUSTER_NAME and NAMESPACE are built-in and cannot be defined here.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	Parameters []ClusterTemplateParameter `json:"parameters,omitempty"`
+
+	// template is a multi-document YAML with the objects to be created when the ClusterTemplate is instantiated,
+	// e.g. the Cluster, the InfrastructureCluster, the control plane and the MachineDeployments.
+	// The template must contain a Cluster named ${CLUSTER_NAME}; all the objects are created in the
+	// namespace of the ClusterTemplateInstance.
+	// Objects are created by the Cluster API controller, so the template can only contain Clusters,
+	// MachineDeployments, MachineHealthChecks, MachinePools, ConfigMaps and objects of the
+	// infrastructure.cluster.x-k8s.io, bootstrap.cluster.x-k8s.io and controlplane.cluster.x-k8s.io
+	// API groups; Secrets and ClusterResourceSets are only allowed if enabled with the
+	// --clustertemplate-allow-secrets-and-clusterresourcesets flag.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=524288
+	Template string `json:"template,omitempty"`
+}
+
+// ClusterTemplateParameter defines a typed parameter of a ClusterTemplate.
+type ClusterTemplateParameter struct {
+	// name of the parameter.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*$`
+	Name string `json:"name,omitempty"`
+
+	// description is a human-readable description of the parameter.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Description string `json:"description,omitempty"`
+
+	// type of the parameter.
+	// Can be either "String", "Integer" or "Boolean".
+	// +required
+	Type ClusterTemplateParameterType `json:"type,omitempty"`
+
+	// required specifies if a value for the parameter must be provided when instantiating the ClusterTemplate.
+	// A required parameter cannot have a default.
+	// +optional
+	Required *bool `json:"required,omitempty"`
+
+	// default is the value used for the parameter if no value is provided when instantiating the ClusterTemplate.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=10240
+	Default string `json:"default,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=clustertemplates,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of the ClusterTemplate"
+
+// ClusterTemplate is the Schema for the clustertemplates API.
+// A ClusterTemplate defines a parametrized set of objects, which can be instantiated
+// with a ClusterTemplateInstance to create a Cluster.
+//
+// NOTE: This CRD can only be used if the ClusterTemplate feature gate is enabled.
+type ClusterTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +required
+	metav1.ObjectMeta `json:"metadata"`
+
+	// spec is the desired state of ClusterTemplate.
+	// +required
+	Spec ClusterTemplateSpec `json:"spec,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterTemplateList contains a list of ClusterTemplates.
+type ClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard list's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds
+	// +required
+	metav1.ListMeta `json:"metadata"`
+
+	// items is the list of ClusterTemplates.
+	Items []ClusterTemplate `json:"items"`
+}
+
+// ClusterTemplateInstanceSpec defines the desired state of a ClusterTemplateInstance.
+type ClusterTemplateInstanceSpec struct {
+	// clusterTemplateName is the name of the ClusterTemplate to be instantiated.
+	// The ClusterTemplate must exist in the namespace of the ClusterTemplateInstance.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	ClusterTemplateName string `json:"clusterTemplateName,omitempty"`
+
+	// clusterName is the name of the Cluster to be created.
+	// It is available in the ClusterTemplate as the built-in parameter CLUSTER_NAME.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// parameters are the values for the parameters of the ClusterTemplate.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	Parameters []ClusterTemplateInstanceParameter `json:"parameters,omitempty"`
+}
+
+// ClusterTemplateInstanceParameter defines the value of a ClusterTemplate parameter.
+type ClusterTemplateInstanceParameter struct {
+	// name of the parameter.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name,omitempty"`
+
+	// value of the parameter.
+	// The value must be compatible with the type of the parameter defined in the ClusterTemplate.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=10240
+	Value string `json:"value,omitempty"`
+}
+
+// ClusterTemplateInstanceStatus defines the observed state of a ClusterTemplateInstance.
+// +kubebuilder:validation:MinProperties=1
+type ClusterTemplateInstanceStatus struct {
+	// conditions represents the observations of a ClusterTemplateInstance's current state.
+	// Known condition types are Instantiated and Paused.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// observedGeneration is the latest generation observed by the controller.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=clustertemplateinstances,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ClusterTemplate",type="string",JSONPath=".spec.clusterTemplateName",description="ClusterTemplate"
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterName",description="Cluster"
+// +kubebuilder:printcolumn:name="Instantiated",type="string",JSONPath=`.status.conditions[?(@.type=="Instantiated")].status`,description="ClusterTemplate instantiated"
+// +kubebuilder:printcolumn:name="Paused",type="string",JSONPath=`.status.conditions[?(@.type=="Paused")].status`,description="Reconciliation paused",priority=10
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of the ClusterTemplateInstance"
+
+// ClusterTemplateInstance is the Schema for the clustertemplateinstances API.
+// A ClusterTemplateInstance instantiates a ClusterTemplate with a set of parameter values.
+//
+// NOTE: This CRD can only be used if the ClusterTemplate feature gate is enabled.
+type ClusterTemplateInstance struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +required
+	metav1.ObjectMeta `json:"metadata"`
+
+	// spec is the desired state of ClusterTemplateInstance.
+	// +required
+	Spec ClusterTemplateInstanceSpec `json:"spec,omitempty,omitzero"`
+
+	// status is the observed state of ClusterTemplateInstance.
+	// +optional
+	Status ClusterTemplateInstanceStatus `json:"status,omitempty,omitzero"`
+}
+
+// GetConditions returns the set of conditions for this object.
+func (c *ClusterTemplateInstance) GetConditions() []metav1.Condition {
+	return c.Status.Conditions
+}
+
+// SetConditions sets conditions for an API object.
+func (c *ClusterTemplateInstance) SetConditions(conditions []metav1.Condition) {
+	c.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterTemplateInstanceList contains a list of ClusterTemplateInstances.
+type ClusterTemplateInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard list's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds
+	// +required
+	metav1.ListMeta `json:"metadata"`
+
+	// items is the list of ClusterTemplateInstances.
+	Items []ClusterTemplateInstance `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &ClusterTemplate{}, &ClusterTemplateList{}, &ClusterTemplateInstance{}, &ClusterTemplateInstanceList{})
+}
@@ -112,6 +112,17 @@ const (
 	// on the reconciled object.
 	PausedAnnotation = "cluster.x-k8s.io/paused"
 
+	// DegradedFreezeAcknowledgedAnnotation is an annotation that can be applied to a Cluster by an operator
+	// to acknowledge a degraded freeze, thus resuming automated disruptive actions on the Cluster.
+	// The annotation is removed by the Cluster controller as soon as the number of unhealthy Machines
+	// is below spec.degradedFreeze.unhealthyMachinesThreshold again.
+	DegradedFreezeAcknowledgedAnnotation = "cluster.x-k8s.io/degraded-freeze-acknowledged"
+
+	// ClusterMovedAnnotation is an annotation set by clusterctl move on the Clusters created in the target management
+	// cluster; its value is the time of the move in RFC3339 format.
+	// The Cluster controller uses this annotation to record the move in status.lastOperations.
+	ClusterMovedAnnotation = "cluster.x-k8s.io/moved"
+
 	// DisableMachineCreateAnnotation is an annotation that can be used to signal a MachineSet to stop creating new machines.
 	// It is utilized in the OnDelete rollout strategy to allow the MachineDeployment controller to scale down
 	// older MachineSets when Machines are deleted and add the new replicas to the latest MachineSet.
@@ -127,6 +138,12 @@ const (
 	// when KCP or a machineset scales down. This annotation is given top priority on all delete policies.
 	DeleteMachineAnnotation = "cluster.x-k8s.io/delete-machine"
 
+	// MachineStandbyLabel is set by the MachineSet controller on standby Machines, i.e. spare Machines which are
+	// provisioned in advance and promoted to replace unhealthy Machines when remediation occurs.
+	// Standby Machines are not counted as replicas of the MachineSet; the label is removed when the Machine is promoted.
+	// Note: This label is only used when the MachineSetStandby feature flag is enabled.
+	MachineStandbyLabel = "cluster.x-k8s.io/standby"
+
 	// TemplateClonedFromNameAnnotation is the infrastructure machine annotation that stores the name of the infrastructure template resource
 	// that was cloned for the machine. This annotation is set only during cloning a template. Older/adopted machines will not have this annotation.
 	TemplateClonedFromNameAnnotation = "cluster.x-k8s.io/cloned-from-name"
@@ -141,6 +158,39 @@ const (
 	// RemediateMachineAnnotation request the MachineHealthCheck reconciler to mark a Machine as unhealthy. CAPI builtin remediation will prioritize Machines with the annotation to be remediated.
 	RemediateMachineAnnotation = "cluster.x-k8s.io/remediate-machine"
 
+	// MachineRebootRequestedAnnotation is set by the MachineHealthCheck reconciler on unhealthy Machines
+	// when remediation via reboot is configured. The value of the annotation is the time of the request in RFC3339 format.
+	// Infrastructure providers supporting instance restart are expected to reboot the instance
+	// backing the Machine once for each distinct value of this annotation.
+	MachineRebootRequestedAnnotation = "cluster.x-k8s.io/reboot-requested"
+
+	// MachineRebootAttemptsAnnotation is set by the MachineHealthCheck reconciler on unhealthy Machines
+	// to track the number of reboots requested since the Machine was last healthy.
+	MachineRebootAttemptsAnnotation = "cluster.x-k8s.io/reboot-attempts"
+
+	// MachineCordonRequestedAnnotation is set on Machines to request the Machine controller to cordon and drain the
+	// Machine's Node while keeping the Machine, e.g. to investigate a failure. The value of the annotation is the time
+	// of the request in RFC3339 format. The annotation is set by the MachineHealthCheck reconciler on unhealthy Machines
+	// when remediation via cordon is configured, but it can also be set by users.
+	// When the annotation is removed, the Machine controller uncordons the Machine's Node.
+	MachineCordonRequestedAnnotation = "cluster.x-k8s.io/cordon-requested"
+
+	// MachineRebootstrapRequestedAnnotation is set on Machines to request the regeneration of the bootstrap data, e.g.
+	// when the Machine failed to join the cluster before the bootstrap token embedded in the bootstrap data expired.
+	// The value of the annotation is an opaque identifier of the request, e.g. a timestamp; a new request can be made by
+	// changing the value. Requests are ignored for Machines which already have a Node.
+	// The Machine controller propagates the annotation to the BootstrapConfig; bootstrap providers are expected to
+	// regenerate the bootstrap data secret and then to set MachineRebootstrapCompletedAnnotation with the same value
+	// on the BootstrapConfig. After this, the Machine controller propagates the annotation to the InfraMachine;
+	// infrastructure providers supporting it are expected to re-inject the new bootstrap data in the instance
+	// backing the Machine once for each distinct value of this annotation.
+	MachineRebootstrapRequestedAnnotation = "cluster.x-k8s.io/rebootstrap-requested"
+
+	// MachineRebootstrapCompletedAnnotation is set by bootstrap providers on BootstrapConfigs after regenerating the
+	// bootstrap data, and by the Machine controller on Machines after signaling the InfraMachine; the value of the
+	// annotation is the value of the MachineRebootstrapRequestedAnnotation which has been handled.
+	MachineRebootstrapCompletedAnnotation = "cluster.x-k8s.io/rebootstrap-completed"
+
 	// MachineSetSkipPreflightChecksAnnotation is the annotation used to provide a comma-separated list of
 	// preflight checks that should be skipped during the MachineSet reconciliation.
 	// Supported items are:
@@ -184,6 +234,13 @@ const (
 	// This annotation can be used to inform MachinePool status during in-progress scaling scenarios.
 	ReplicasManagedByAnnotation = "cluster.x-k8s.io/replicas-managed-by"
 
+	// MachinePoolBootstrapDataHashAnnotation is set by the MachinePool controller on the InfraMachinePool to the hash
+	// of the bootstrap data of the MachinePool. The hash changes every time the bootstrap data is regenerated, e.g. when
+	// the bootstrap provider rotates the bootstrap token embedded in the bootstrap data; infrastructure providers are
+	// expected to watch this annotation and to update the bootstrap data used by the infrastructure when new instances
+	// are created, e.g. in launch templates or scale sets, so new instances are always created with valid bootstrap data.
+	MachinePoolBootstrapDataHashAnnotation = "cluster.x-k8s.io/bootstrap-data-hash"
+
 	// AutoscalerMinSizeAnnotation defines the minimum node group size.
 	// The annotation is used by autoscaler.
 	// The annotation is copied from kubernetes/autoscaler.
@@ -191,7 +248,7 @@ const (
 	// Note: With the Kubernetes autoscaler it is possible to use different annotations by configuring a different
 	// "Cluster API group" than "cluster.x-k8s.io" via the "CAPI_GROUP" environment variable.
 	// We only handle the default group in our implementation.
-	// Note: It can be used by setting as top level annotation on MachineDeployment and MachineSets.
+	// Note: It can be used by setting as top level annotation on MachineDeployment, MachineSets and MachinePools.
 	AutoscalerMinSizeAnnotation = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size"
 
 	// AutoscalerMaxSizeAnnotation defines the maximum node group size.
@@ -201,9 +258,21 @@ const (
 	// Note: With the Kubernetes autoscaler it is possible to use different annotations by configuring a different
 	// "Cluster API group" than "cluster.x-k8s.io" via the "CAPI_GROUP" environment variable.
 	// We only handle the default group in our implementation.
-	// Note: It can be used by setting as top level annotation on MachineDeployment and MachineSets.
+	// Note: It can be used by setting as top level annotation on MachineDeployment, MachineSets and MachinePools.
 	AutoscalerMaxSizeAnnotation = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size"
 
+	// AutoscalerCapacityLabelsAnnotation defines the labels of the Nodes of a node group, used by the autoscaler
+	// when scaling the node group from zero.
+	// The value is a comma separated list of key=value pairs.
+	// The annotation definition is copied from kubernetes/autoscaler.
+	// Ref:https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/cloudprovider/clusterapi/README.md#scale-from-zero-support
+	// Note: It can be used by setting as top level annotation on MachineDeployment, MachineSets and MachinePools.
+	AutoscalerCapacityLabelsAnnotation = "capacity.cluster-autoscaler.kubernetes.io/labels"
+
+	// MachineArchitectureLabel is the label set on Machines created from a MachineDeploymentClass or a
+	// MachineDeploymentTopology with an architecture; the label is then propagated to the corresponding Node.
+	MachineArchitectureLabel = ManagedNodeLabelDomain + "/architecture"
+
 	// VariableDefinitionFromInline indicates a patch or variable was defined in the `.spec` of a ClusterClass
 	// rather than from an external patch extension.
 	VariableDefinitionFromInline = "inline"
@@ -277,6 +346,19 @@ var NodeUninitializedTaint = corev1.Taint{
 	Effect: corev1.TaintEffectNoSchedule,
 }
 
+// NodeStandbyTaint is added to the Nodes of standby Machines, i.e. Machines with the MachineStandbyLabel.
+// This taint is used to prevent workloads to be scheduled on Nodes which are kept as spare capacity until
+// the Machine is promoted to replace an unhealthy Machine; once the Machine is promoted the taint is removed.
+var NodeStandbyTaint = corev1.Taint{
+	Key:    "node.cluster.x-k8s.io/standby",
+	Effect: corev1.TaintEffectNoSchedule,
+}
+
+// NodeConditionFromMachinePrefix is the prefix of the types of the Node conditions mirroring the conditions of the
+// corresponding Machine, e.g. the Machine's UpToDate condition is mirrored as the cluster.x-k8s.io/UpToDate Node condition.
+// Node conditions with this prefix are managed by Cluster API, and they are removed when not mirrored anymore.
+const NodeConditionFromMachinePrefix = "cluster.x-k8s.io/"
+
 const (
 	// TemplateSuffix is the object kind suffix used by template types.
 	TemplateSuffix = "Template"
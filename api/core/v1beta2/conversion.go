@@ -104,3 +104,17 @@ func Convert_Duration_To_Pointer_int32(in metav1.Duration, hasRestored bool, res
 	// Otherwise, if the value is not 0, convert to *value.
 	*out = ConvertToSeconds(&in)
 }
+
+// RestoreUnhealthyNodeConditionsSlidingWindow restores the sliding window of unhealthy node conditions,
+// which does not exist in previous API versions, if the conditions at the same index have the same type and status.
+// NOTE: this is a util function intended only for usage in API conversions.
+func RestoreUnhealthyNodeConditionsSlidingWindow(restored []UnhealthyNodeCondition, out []UnhealthyNodeCondition) {
+	for i := range out {
+		if i >= len(restored) {
+			return
+		}
+		if restored[i].Type == out[i].Type && restored[i].Status == out[i].Status {
+			out[i].SlidingWindow = restored[i].SlidingWindow
+		}
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -86,3 +87,48 @@ func machineByProviderID(o client.Object) []string {
 
 	return []string{machine.Spec.ProviderID}
 }
+
+// GetMachineForNode returns the Machine corresponding to a Node of a workload cluster, or nil if there is not exactly one.
+// Machines are matched by status.nodeRef.name and, if that is not conclusive (e.g. because the nodeRef has not been set yet),
+// by spec.providerID, using the corresponding indexes. If the Node has the cluster name and namespace annotations
+// the lookup is restricted accordingly, so Nodes with the same name in different workload clusters are not mixed up.
+func GetMachineForNode(ctx context.Context, c client.Reader, node *corev1.Node) (*clusterv1.Machine, error) {
+	var filters []client.ListOption
+	// Match by clusterName when the node has the annotation.
+	if clusterName, ok := node.GetAnnotations()[clusterv1.ClusterNameAnnotation]; ok {
+		filters = append(filters, client.MatchingLabels{
+			clusterv1.ClusterNameLabel: clusterName,
+		})
+	}
+
+	// Match by namespace when the node has the annotation.
+	if namespace, ok := node.GetAnnotations()[clusterv1.ClusterNamespaceAnnotation]; ok {
+		filters = append(filters, client.InNamespace(namespace))
+	}
+
+	// Match by nodeName and status.nodeRef.name.
+	machineList := &clusterv1.MachineList{}
+	if err := c.List(ctx, machineList, append(filters, client.MatchingFields{MachineNodeNameField: node.Name})...); err != nil {
+		return nil, errors.Wrapf(err, "failed to list Machines for Node %s", node.Name)
+	}
+
+	// There should be exactly 1 Machine for the node.
+	if len(machineList.Items) == 1 {
+		return &machineList.Items[0], nil
+	}
+
+	// Otherwise let's match by providerID. This is useful when e.g the NodeRef has not been set yet.
+	if node.Spec.ProviderID == "" {
+		return nil, nil
+	}
+	machineList = &clusterv1.MachineList{}
+	if err := c.List(ctx, machineList, append(filters, client.MatchingFields{MachineProviderIDField: node.Spec.ProviderID})...); err != nil {
+		return nil, errors.Wrapf(err, "failed to list Machines for Node %s", node.Name)
+	}
+
+	// There should be exactly 1 Machine for the node.
+	if len(machineList.Items) == 1 {
+		return &machineList.Items[0], nil
+	}
+	return nil, nil
+}
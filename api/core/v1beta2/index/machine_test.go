@@ -20,7 +20,11 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
@@ -99,3 +103,101 @@ func TestIndexMachineByProviderID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMachineForNode(t *testing.T) {
+	newMachine := func(namespace, name, clusterName, nodeName, providerID string) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+			},
+			Spec: clusterv1.MachineSpec{
+				ClusterName: clusterName,
+				ProviderID:  providerID,
+			},
+			Status: clusterv1.MachineStatus{
+				NodeRef: clusterv1.MachineNodeReference{Name: nodeName},
+			},
+		}
+	}
+	newNode := func(name, providerID string, annotations map[string]string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations},
+			Spec:       corev1.NodeSpec{ProviderID: providerID},
+		}
+	}
+	clusterAnnotations := func(namespace, clusterName string) map[string]string {
+		return map[string]string{
+			clusterv1.ClusterNameAnnotation:      clusterName,
+			clusterv1.ClusterNamespaceAnnotation: namespace,
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	g := NewWithT(t)
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+	machineA := newMachine("ns1", "machine-a", "cluster-a", "node-1", "test://id-a")
+	machineB := newMachine("ns2", "machine-b", "cluster-b", "node-1", "test://id-b")
+	machineWithoutNodeRef := newMachine("ns1", "machine-c", "cluster-a", "", "test://id-c")
+
+	tests := []struct {
+		name    string
+		node    *corev1.Node
+		want    *clusterv1.Machine
+		wantNil bool
+	}{
+		{
+			name: "match by node name using the cluster annotations",
+			node: newNode("node-1", "", clusterAnnotations("ns2", "cluster-b")),
+			want: machineB,
+		},
+		{
+			name:    "no match if node names are ambiguous and there are no cluster annotations",
+			node:    newNode("node-1", "", nil),
+			wantNil: true,
+		},
+		{
+			name: "fall back to match by providerID if node names are ambiguous",
+			node: newNode("node-1", "test://id-a", nil),
+			want: machineA,
+		},
+		{
+			name: "match by providerID if the nodeRef is not set yet",
+			node: newNode("node-2", "test://id-c", clusterAnnotations("ns1", "cluster-a")),
+			want: machineWithoutNodeRef,
+		},
+		{
+			name:    "no match if the cluster annotations point to another cluster",
+			node:    newNode("node-2", "test://id-c", clusterAnnotations("ns2", "cluster-b")),
+			wantNil: true,
+		},
+		{
+			name:    "no match for unknown nodes",
+			node:    newNode("node-3", "test://id-d", nil),
+			wantNil: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(machineA, machineB, machineWithoutNodeRef).
+				WithIndex(&clusterv1.Machine{}, MachineNodeNameField, MachineByNodeName).
+				WithIndex(&clusterv1.Machine{}, MachineProviderIDField, machineByProviderID).
+				Build()
+
+			got, err := GetMachineForNode(t.Context(), c, tt.node)
+			g.Expect(err).ToNot(HaveOccurred())
+			if tt.wantNil {
+				g.Expect(got).To(BeNil())
+				return
+			}
+			g.Expect(got).ToNot(BeNil())
+			g.Expect(client.ObjectKeyFromObject(got)).To(Equal(client.ObjectKeyFromObject(tt.want)))
+		})
+	}
+}
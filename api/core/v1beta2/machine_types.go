@@ -18,6 +18,7 @@ package v1beta2
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	capierrors "sigs.k8s.io/cluster-api/errors"
@@ -121,7 +122,8 @@ const (
 const (
 	// MachineReadyCondition is true if the Machine's deletionTimestamp is not set, Machine's BootstrapConfigReady, InfrastructureReady,
 	// NodeHealthy and HealthCheckSucceeded (if present) conditions are true, Updating condition is false; if other conditions are defined in spec.readinessGates,
-	// these conditions must be true as well.
+	// these conditions must be true as well. If spec.kubeletServingCertificateVerification is Enabled, the KubeletServingCertificateVerified
+	// condition must be true as well; if spec.nodeReadinessChecks is set, the NodeReadinessChecksSucceeded condition must be true as well.
 	// Note:
 	// - When summarizing the Deleting condition:
 	//   - Details about Pods stuck in draining or volumes waiting for detach are dropped, in order to improve readability & reduce flickering
@@ -295,6 +297,143 @@ const (
 	MachineNodeConnectionDownReason = ConnectionDownReason
 )
 
+// Machine's NodeBootstrapped condition and corresponding reasons.
+// Note: NodeBootstrapped condition is set only if the InfrastructureMachine reports the bootstrap progress of the node
+// or the failure of a bootstrap command.
+const (
+	// MachineNodeBootstrappedCondition is true if the Machine's Node completed bootstrap and registered to the workload cluster.
+	MachineNodeBootstrappedCondition = "NodeBootstrapped"
+
+	// MachineNodeBootstrappedReason surfaces when the Machine's Node completed bootstrap and registered to the workload cluster.
+	MachineNodeBootstrappedReason = "NodeBootstrapped"
+
+	// MachineNodeBootstrappingReason surfaces when the Machine's Node is bootstrapping, as reported
+	// by the InfrastructureMachine in status.bootstrapProgress.
+	MachineNodeBootstrappingReason = "NodeBootstrapping"
+
+	// MachineNodeBootstrapCommandFailedReason surfaces when a command run to bootstrap the Machine's Node failed, as reported
+	// by the InfrastructureMachine in status.bootstrapDiagnostics.
+	MachineNodeBootstrapCommandFailedReason = "BootstrapCommandFailed"
+)
+
+// Machine's InfrastructureProvisioningFailed condition and corresponding reasons.
+// Note: InfrastructureProvisioningFailed condition is set only if spec.infrastructureReadyTimeoutSeconds is set.
+const (
+	// MachineInfrastructureProvisioningFailedCondition is true if the InfrastructureMachine did not report it is
+	// provisioned within spec.infrastructureReadyTimeoutSeconds.
+	// Note: InfrastructureProvisioningFailed is a condition with negative polarity.
+	MachineInfrastructureProvisioningFailedCondition = "InfrastructureProvisioningFailed"
+
+	// MachineInfrastructureQuotaExceededReason surfaces when the InfrastructureMachine is not provisioned within the timeout
+	// because the quota of the infrastructure provider is exceeded.
+	MachineInfrastructureQuotaExceededReason = "QuotaExceeded"
+
+	// MachineInfrastructureImageNotFoundReason surfaces when the InfrastructureMachine is not provisioned within the timeout
+	// because the machine image does not exist.
+	MachineInfrastructureImageNotFoundReason = "ImageNotFound"
+
+	// MachineInfrastructureProvisioningFailedUnknownReason surfaces when the InfrastructureMachine is not provisioned within
+	// the timeout for any other reason, or the InfrastructureMachine does not report a reason.
+	MachineInfrastructureProvisioningFailedUnknownReason = "Unknown"
+
+	// MachineInfrastructureProvisioningNotFailedReason surfaces when the InfrastructureMachine is provisioned, or
+	// when the timeout did not expire yet.
+	MachineInfrastructureProvisioningNotFailedReason = "NotFailed"
+)
+
+// Machine's Stopped condition and corresponding reasons.
+// Note: Stopped condition is set only if spec.powerState is set or if the InfrastructureMachine reports status.powerState.
+const (
+	// MachineStoppedCondition is true if the Machine is stopped or transitioning between power states.
+	// Note: Stopped is a condition with negative polarity; stopped Machines are not ready,
+	// but they are not considered unhealthy by MachineHealthChecks.
+	MachineStoppedCondition = "Stopped"
+
+	// MachineStoppedReason surfaces when the Machine is stopped, as requested by spec.powerState
+	// and reported by the InfrastructureMachine in status.powerState.
+	MachineStoppedReason = "Stopped"
+
+	// MachineStoppingReason surfaces when spec.powerState is Stopped, but the InfrastructureMachine
+	// did not report status.powerState Stopped yet.
+	MachineStoppingReason = "Stopping"
+
+	// MachineStartingReason surfaces when spec.powerState is Running, but the InfrastructureMachine
+	// still reports status.powerState Stopped.
+	MachineStartingReason = "Starting"
+
+	// MachineNotStoppedReason surfaces when the Machine is running.
+	MachineNotStoppedReason = "NotStopped"
+)
+
+// Machine's KubeletServingCertificateVerified condition and corresponding reasons.
+// Note: KubeletServingCertificateVerified condition is set only if spec.kubeletServingCertificateVerification is Enabled.
+const (
+	// MachineKubeletServingCertificateVerifiedCondition is true if the serving certificate of the kubelet running on
+	// the Machine's Node is issued by the cluster CA and it is not expired.
+	// Note: The certificate is verified only once; after the condition is true for the first time, it is not verified anymore.
+	MachineKubeletServingCertificateVerifiedCondition = "KubeletServingCertificateVerified"
+
+	// MachineKubeletServingCertificateVerifiedReason surfaces when the kubelet serving certificate
+	// is issued by the cluster CA and it is not expired.
+	MachineKubeletServingCertificateVerifiedReason = "KubeletServingCertificateVerified"
+
+	// MachineKubeletServingCertificateNotIssuedReason surfaces when there is no issued kubelet serving certificate for the Machine's Node yet.
+	MachineKubeletServingCertificateNotIssuedReason = "KubeletServingCertificateNotIssued"
+
+	// MachineKubeletServingCertificateInvalidReason surfaces when the kubelet serving certificate
+	// is not issued by the cluster CA or it is expired.
+	MachineKubeletServingCertificateInvalidReason = "KubeletServingCertificateInvalid"
+
+	// MachineKubeletServingCertificateWaitingForNodeReason surfaces when the Machine's Node does not exist yet.
+	MachineKubeletServingCertificateWaitingForNodeReason = "WaitingForNode"
+
+	// MachineKubeletServingCertificateInternalErrorReason surfaces unexpected failures when verifying the kubelet serving certificate.
+	MachineKubeletServingCertificateInternalErrorReason = InternalErrorReason
+)
+
+// Machine's NodeReadinessChecksSucceeded condition and corresponding reasons.
+// Note: NodeReadinessChecksSucceeded condition is set only if spec.nodeReadinessChecks is set.
+const (
+	// MachineNodeReadinessChecksSucceededCondition is true if all the DaemonSets selected by spec.nodeReadinessChecks
+	// have a ready Pod on the Machine's Node.
+	// Note: The checks are performed only once; after the condition is true for the first time, they are not performed anymore.
+	MachineNodeReadinessChecksSucceededCondition = "NodeReadinessChecksSucceeded"
+
+	// MachineNodeReadinessChecksSucceededReason surfaces when all the DaemonSets selected by spec.nodeReadinessChecks
+	// have a ready Pod on the Machine's Node.
+	MachineNodeReadinessChecksSucceededReason = "NodeReadinessChecksSucceeded"
+
+	// MachineNodeReadinessChecksPendingReason surfaces when some of the DaemonSets selected by spec.nodeReadinessChecks
+	// do not have a ready Pod on the Machine's Node yet, or when no DaemonSets match a check.
+	MachineNodeReadinessChecksPendingReason = "NodeReadinessChecksPending"
+
+	// MachineNodeReadinessChecksWaitingForNodeReason surfaces when the Machine's Node does not exist yet.
+	MachineNodeReadinessChecksWaitingForNodeReason = "WaitingForNode"
+
+	// MachineNodeReadinessChecksInternalErrorReason surfaces unexpected failures when performing the node readiness checks.
+	MachineNodeReadinessChecksInternalErrorReason = InternalErrorReason
+)
+
+// Machine's Cordoned condition and corresponding reasons.
+// Note: Cordoned condition is set only if the cluster.x-k8s.io/cordon-requested annotation is set on the Machine.
+const (
+	// MachineCordonedCondition is true if the Machine's Node has been cordoned because the
+	// cluster.x-k8s.io/cordon-requested annotation is set on the Machine.
+	MachineCordonedCondition = "Cordoned"
+
+	// MachineCordonedReason surfaces when the Machine's Node has been cordoned and drained.
+	MachineCordonedReason = "Cordoned"
+
+	// MachineCordonedDrainingNodeReason surfaces when the Machine's Node has been cordoned and it is being drained.
+	MachineCordonedDrainingNodeReason = "DrainingNode"
+
+	// MachineCordonedWaitingForNodeReason surfaces when the Machine's Node does not exist.
+	MachineCordonedWaitingForNodeReason = "WaitingForNode"
+
+	// MachineCordonedInternalErrorReason surfaces unexpected failures when cordoning or draining the Machine's Node.
+	MachineCordonedInternalErrorReason = InternalErrorReason
+)
+
 // Machine's HealthCheckSucceeded condition and corresponding reasons.
 // Note: HealthCheckSucceeded condition is set by the MachineHealthCheck controller.
 const (
@@ -309,6 +448,10 @@ const (
 	// defined by a MachineHealthCheck object.
 	MachineHealthCheckUnhealthyNodeReason = "UnhealthyNode"
 
+	// MachineHealthCheckNodeUnreachableReason surfaces when the node hosted on the machine cannot be reached by
+	// the node probe defined by a MachineHealthCheck object.
+	MachineHealthCheckNodeUnreachableReason = "NodeUnreachable"
+
 	// MachineHealthCheckUnhealthyMachineReason surfaces when the machine does not pass the health checks
 	// defined by a MachineHealthCheck object.
 	MachineHealthCheckUnhealthyMachineReason = "UnhealthyMachine"
@@ -465,6 +608,20 @@ type MachineSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
 
+	// infrastructureReadyTimeoutSeconds is the maximum number of seconds, measured from the Machine's creation,
+	// for the InfrastructureMachine to report it is provisioned.
+	// When the timeout expires, the Machine controller classifies the failure based on the reason reported by the
+	// InfrastructureMachine, and it surfaces it in the Machine's InfrastructureProvisioningFailed condition,
+	// with reason QuotaExceeded, ImageNotFound or Unknown.
+	// The Machine is not deleted nor remediated by the Machine controller; owners of the Machine, e.g. MachineHealthChecks
+	// via spec.checks.unhealthyMachineConditions, can use the condition to decide if the Machine should be replaced.
+	// NOTE: This field is propagated in-place from MachineDeployments and MachineSets to their Machines,
+	// without triggering a rollout.
+	// If not set, the Machine controller waits indefinitely for the InfrastructureMachine to be provisioned.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	InfrastructureReadyTimeoutSeconds *int32 `json:"infrastructureReadyTimeoutSeconds,omitempty"`
+
 	// readinessGates specifies additional conditions to include when evaluating Machine Ready condition.
 	//
 	// This field can be used e.g. by Cluster API control plane providers to extend the semantic of the
@@ -489,6 +646,47 @@ type MachineSpec struct {
 	// +optional
 	Deletion MachineDeletionSpec `json:"deletion,omitempty,omitzero"`
 
+	// kubeletServingCertificateVerification defines if the serving certificate of the kubelet running on the Machine's Node
+	// must be verified before considering the Machine ready.
+	// When set to Enabled, the Machine controller looks up the latest approved CertificateSigningRequest
+	// with signer kubernetes.io/kubelet-serving for the Node in the workload cluster, and it checks that the
+	// issued certificate is signed by the cluster CA and it is not expired; the result is surfaced in the
+	// KubeletServingCertificateVerified condition, which is then included when computing the Machine's Ready condition.
+	// This prevents rollouts from completing onto Nodes where the kubelet serving certificate bootstrap is broken.
+	// NOTE: This requires the kubelet to request its serving certificate via the certificates API,
+	// e.g. by setting serverTLSBootstrap in the kubelet configuration.
+	// NOTE: The certificate is verified only once, before the Machine becomes ready for the first time.
+	// Defaults to Disabled.
+	// +optional
+	KubeletServingCertificateVerification MachineKubeletServingCertificateVerification `json:"kubeletServingCertificateVerification,omitempty"`
+
+	// nodeReadinessChecks defines DaemonSets which must have a ready Pod on the Machine's Node before considering
+	// the Machine ready, e.g. GPU drivers or CSI node plugins that workloads depend on.
+	// When set, the Machine controller checks the DaemonSets in the workload cluster and it surfaces the result in the
+	// NodeReadinessChecksSucceeded condition, which is then included when computing the Machine's Ready condition.
+	// This prevents rollouts from removing old Machines before the DaemonSets are running on the new Machines.
+	// NOTE: The checks are performed only once, before the Machine becomes ready for the first time.
+	// NOTE: This field is propagated in-place from MachineDeployments and MachineSets to their Machines,
+	// without triggering a rollout; for MachineDeployments, it is set from spec.rollout.nodeReadinessChecks if defined.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	NodeReadinessChecks []MachineNodeReadinessCheck `json:"nodeReadinessChecks,omitempty"`
+
+	// powerState is the desired power state of the Machine, either Running or Stopped.
+	// When set to Stopped, the infrastructure provider stops the machine without deleting it, and it starts
+	// the machine again when set back to Running; this allows e.g. to hibernate Machines of dev clusters at night.
+	// The power state observed by the infrastructure provider is surfaced in the Machine's Stopped condition;
+	// stopped Machines are not ready, but they are not considered unhealthy by MachineHealthChecks.
+	// NOTE: Stopping a Machine requires support by the infrastructure provider; Nodes are not drained before stopping.
+	// NOTE: This field is propagated in-place from MachineDeployments and MachineSets to their Machines,
+	// without triggering a rollout; it is ignored for MachinePools.
+	// Defaults to Running.
+	// +optional
+	PowerState MachinePowerState `json:"powerState,omitempty"`
+
 	// taints are the node taints that Cluster API will manage.
 	// This list is not necessarily complete: other Kubernetes components may add or remove other taints from nodes,
 	// e.g. the node controller might add the node.kubernetes.io/not-ready taint.
@@ -505,6 +703,86 @@ type MachineSpec struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=64
 	Taints []MachineTaint `json:"taints,omitempty"`
+
+	// infrastructureOverrides are overrides for the InfrastructureMachine, keyed by failure domain.
+	// When an InfrastructureMachine is created from the template referenced by infrastructureRef for a Machine
+	// in a failure domain with a matching entry, the spec of the entry is merged into the spec of the InfrastructureMachine.
+	// This allows e.g. to use zone-specific instance types or subnets without requiring a MachineDeployment per zone.
+	//
+	// When failureDomain is not set, Machines are spread across the failure domains defined in infrastructureOverrides.
+	//
+	// NOTE: This field is only used by the MachineSet controller when creating InfrastructureMachines for
+	// MachineDeployments and MachineSets; it is ignored for Machines and MachinePools.
+	// +optional
+	// +listType=map
+	// +listMapKey=failureDomain
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	InfrastructureOverrides []MachineInfrastructureOverride `json:"infrastructureOverrides,omitempty"`
+}
+
+// MachinePowerState defines the power state of a Machine.
+// +kubebuilder:validation:Enum=Running;Stopped
+type MachinePowerState string
+
+const (
+	// MachinePowerStateRunning is the power state of a Machine which is running.
+	MachinePowerStateRunning MachinePowerState = "Running"
+
+	// MachinePowerStateStopped is the power state of a Machine which is stopped.
+	MachinePowerStateStopped MachinePowerState = "Stopped"
+)
+
+// MachineKubeletServingCertificateVerification defines if the kubelet serving certificate of a Machine must be verified.
+// +kubebuilder:validation:Enum=Enabled;Disabled
+type MachineKubeletServingCertificateVerification string
+
+const (
+	// MachineKubeletServingCertificateVerificationEnabled enables the verification of the kubelet serving certificate.
+	MachineKubeletServingCertificateVerificationEnabled MachineKubeletServingCertificateVerification = "Enabled"
+
+	// MachineKubeletServingCertificateVerificationDisabled disables the verification of the kubelet serving certificate.
+	MachineKubeletServingCertificateVerificationDisabled MachineKubeletServingCertificateVerification = "Disabled"
+)
+
+// MachineNodeReadinessCheck defines DaemonSets which must have a ready Pod on the Machine's Node.
+type MachineNodeReadinessCheck struct {
+	// name of the check; it is used to identify the check in the NodeReadinessChecksSucceeded condition.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name,omitempty"`
+
+	// namespace of the DaemonSets.
+	// If not set, DaemonSets in all namespaces are selected.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace,omitempty"`
+
+	// selector is a label query over the DaemonSets which must have a ready Pod on the Machine's Node.
+	// DaemonSets whose Pod template nodeSelector does not match the Node labels are ignored.
+	// If no DaemonSets match the selector, the check does not succeed; this allows to wait
+	// for DaemonSets which are deployed after the Node is created.
+	// +required
+	Selector metav1.LabelSelector `json:"selector,omitempty,omitzero"`
+}
+
+// MachineInfrastructureOverride defines overrides for the InfrastructureMachine of Machines in a failure domain.
+type MachineInfrastructureOverride struct {
+	// failureDomain is the failure domain this override applies to.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	FailureDomain string `json:"failureDomain,omitempty"`
+
+	// spec is merged into the spec of the InfrastructureMachine using JSON merge patch semantics (RFC 7386).
+	// Note: We have to use apiextensionsv1.JSON instead of a custom JSON type, because controller-tools has a
+	// hard-coded schema for apiextensionsv1.JSON which cannot be produced by another type via controller-tools,
+	// i.e. it is not possible to have no type field.
+	// Ref: https://github.com/kubernetes-sigs/controller-tools/blob/d0e03a142d0ecdd5491593e941ee1d6b5d91dba6/pkg/crd/known_types.go#L106-L111
+	// +required
+	Spec apiextensionsv1.JSON `json:"spec,omitempty,omitzero"`
 }
 
 // MachineDeletionSpec contains configuration options for Machine deletion.
@@ -523,6 +801,11 @@ type MachineDeletionSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	NodeVolumeDetachTimeoutSeconds *int32 `json:"nodeVolumeDetachTimeoutSeconds,omitempty"`
 
+	// nodeVolumeDetachExclusions defines volumes the controller does not wait for to be detached before deleting the Node,
+	// e.g. node-local volumes which are never detached from the Node.
+	// +optional
+	NodeVolumeDetachExclusions MachineNodeVolumeDetachExclusions `json:"nodeVolumeDetachExclusions,omitempty,omitzero"`
+
 	// nodeDeletionTimeoutSeconds defines how long the controller will attempt to delete the Node that the Machine
 	// hosts after the Machine is marked for deletion. A duration of 0 will retry deletion indefinitely.
 	// Defaults to 10 seconds.
@@ -531,6 +814,28 @@ type MachineDeletionSpec struct {
 	NodeDeletionTimeoutSeconds *int32 `json:"nodeDeletionTimeoutSeconds,omitempty"`
 }
 
+// MachineNodeVolumeDetachExclusions defines volumes the controller does not wait for to be detached.
+// +kubebuilder:validation:MinProperties=1
+type MachineNodeVolumeDetachExclusions struct {
+	// storageClassNames is the list of StorageClasses whose PersistentVolumes are not waited for to be detached.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	StorageClassNames []string `json:"storageClassNames,omitempty"`
+
+	// csiDrivers is the list of CSI drivers whose volumes are not waited for to be detached.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=63
+	CSIDrivers []string `json:"csiDrivers,omitempty"`
+}
+
 // MachineReadinessGate contains the type of a Machine condition to be used as a readiness gate.
 type MachineReadinessGate struct {
 	// conditionType refers to a condition with matching type in the Machine's condition list.
@@ -609,11 +914,43 @@ type MachineStatus struct {
 	// +optional
 	Deletion *MachineDeletionStatus `json:"deletion,omitempty"`
 
+	// lastProvisioningFailure describes the last failure reported by the InfrastructureMachine while provisioning the Machine,
+	// and whether the failure is transient or terminal.
+	// It is removed once the InfrastructureMachine is provisioned.
+	// +optional
+	LastProvisioningFailure *MachineProvisioningFailure `json:"lastProvisioningFailure,omitempty"`
+
 	// deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.
 	// +optional
 	Deprecated *MachineDeprecatedStatus `json:"deprecated,omitempty"`
 }
 
+// MachineProvisioningFailure describes a failure reported by the InfrastructureMachine while provisioning a Machine.
+type MachineProvisioningFailure struct {
+	// time is when the failure has been observed.
+	// +required
+	Time metav1.Time `json:"time,omitempty,omitzero"`
+
+	// retryable is true if the failure is transient and the infrastructure provider keeps trying to provision the Machine,
+	// e.g. because the quota of the infrastructure provider is exceeded; it is false if the failure is terminal,
+	// e.g. because the machine image does not exist, and the Machine must be replaced.
+	// +required
+	Retryable *bool `json:"retryable,omitempty"`
+
+	// code identifies the failure as reported by the InfrastructureMachine, i.e. the reason of its Ready condition or,
+	// for InfrastructureMachines implementing the v1beta1 contract, status.failureReason.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Code string `json:"code,omitempty"`
+
+	// message is a human readable message describing the failure.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=10240
+	Message string `json:"message,omitempty"`
+}
+
 // MachineNodeReference is a reference to the node running on the machine.
 type MachineNodeReference struct {
 	// name of the node.
@@ -42,7 +42,22 @@ const (
 
 	// OnDeleteMachineDeploymentStrategyType replaces old MachineSets when the deletion of the associated machines are completed.
 	OnDeleteMachineDeploymentStrategyType MachineDeploymentRolloutStrategyType = "OnDelete"
+)
+
+// MachineDeploymentRollbackPolicy defines how a MachineDeployment reacts to a failed rollout.
+// +kubebuilder:validation:Enum=Automatic;Never
+type MachineDeploymentRollbackPolicy string
+
+const (
+	// AutomaticMachineDeploymentRollbackPolicy scales down the MachineSet which failed to become available and
+	// restores the previous MachineSet.
+	AutomaticMachineDeploymentRollbackPolicy MachineDeploymentRollbackPolicy = "Automatic"
 
+	// NeverMachineDeploymentRollbackPolicy keeps rolling out the MachineSet which failed to become available.
+	NeverMachineDeploymentRollbackPolicy MachineDeploymentRollbackPolicy = "Never"
+)
+
+const (
 	// RevisionAnnotation is the revision annotation of a machine deployment's machine sets which records its rollout sequence.
 	RevisionAnnotation = "machinedeployment.clusters.x-k8s.io/revision"
 
@@ -98,6 +113,48 @@ const (
 	MachineDeploymentAvailableInternalErrorReason = InternalErrorReason
 )
 
+// MachineDeployment's RollbackPerformed condition and corresponding reasons.
+const (
+	// MachineDeploymentRollbackPerformedCondition is true if the MachineDeployment has been rolled back to the previous
+	// MachineSet because the new MachineSet did not become available within spec.rollout.machineSetReadyTimeoutSeconds.
+	// Note: This condition is set only when spec.rollout.rollback.policy is Automatic.
+	MachineDeploymentRollbackPerformedCondition = "RollbackPerformed"
+
+	// MachineDeploymentRollbackPerformedReason surfaces when the MachineDeployment has been rolled back to the previous MachineSet,
+	// and no new rollout has been started afterwards.
+	MachineDeploymentRollbackPerformedReason = "RollbackPerformed"
+
+	// MachineDeploymentRollbackNotPerformedReason surfaces when the MachineDeployment has not been rolled back,
+	// or a new rollout has been started after the last rollback.
+	MachineDeploymentRollbackNotPerformedReason = "RollbackNotPerformed"
+)
+
+// MachineDeployment's AutoscalerHealthy condition and corresponding reasons.
+const (
+	// MachineDeploymentAutoscalerHealthyCondition surfaces the status of the node group corresponding to the MachineDeployment
+	// as reported by the cluster autoscaler in its status ConfigMap, e.g. if scale up is in backoff due to capacity failures.
+	// Note: This condition is set only for MachineDeployments with the autoscaler min and max size annotations, and only
+	// if the status of the node group is reported in the cluster-autoscaler-status ConfigMap in the MachineDeployment namespace
+	// of the management cluster or in the kube-system namespace of the workload cluster.
+	MachineDeploymentAutoscalerHealthyCondition = "AutoscalerHealthy"
+
+	// MachineDeploymentAutoscalerHealthyReason surfaces when the cluster autoscaler reports the node group as healthy,
+	// and scale up is not in backoff.
+	MachineDeploymentAutoscalerHealthyReason = "AutoscalerHealthy"
+
+	// MachineDeploymentAutoscalerScaleUpBackoffReason surfaces when the cluster autoscaler is not scaling up the node group
+	// because of previous scale up failures, e.g. because the infrastructure provider is out of capacity.
+	MachineDeploymentAutoscalerScaleUpBackoffReason = "AutoscalerScaleUpBackoff"
+
+	// MachineDeploymentAutoscalerNodeGroupUnhealthyReason surfaces when the cluster autoscaler reports the node group
+	// as unhealthy, e.g. because too many Nodes are not ready.
+	MachineDeploymentAutoscalerNodeGroupUnhealthyReason = "AutoscalerNodeGroupUnhealthy"
+
+	// MachineDeploymentAutoscalerStatusInvalidReason surfaces when the status ConfigMap of the cluster autoscaler
+	// cannot be parsed, e.g. because it is in a format not supported by Cluster API.
+	MachineDeploymentAutoscalerStatusInvalidReason = "AutoscalerStatusInvalid"
+)
+
 // MachineDeployment's MachinesReady condition and corresponding reasons.
 const (
 	// MachineDeploymentMachinesReadyCondition surfaces detail of issues on the controlled machines, if any.
@@ -233,6 +290,9 @@ const (
 )
 
 // MachineDeploymentSpec defines the desired state of MachineDeployment.
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || !has(self.maxReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must be less than or equal to maxReplicas"
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || !has(self.replicas) || self.replicas >= self.minReplicas",message="replicas must be greater than or equal to minReplicas"
+// +kubebuilder:validation:XValidation:rule="!has(self.maxReplicas) || !has(self.replicas) || self.replicas <= self.maxReplicas",message="replicas must be less than or equal to maxReplicas"
 type MachineDeploymentSpec struct {
 	// clusterName is the name of the Cluster this object belongs to.
 	// +required
@@ -259,6 +319,21 @@ type MachineDeploymentSpec struct {
 	//   should be later controlled by the autoscaler
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
+	// minReplicas is the minimum number of desired machines.
+	// If set, replicas is defaulted to at least minReplicas; additionally replicas cannot be set to a lower value,
+	// e.g. by the Kubernetes autoscaler or by a scale command, and the min size annotation of the
+	// Kubernetes autoscaler cannot be set to a lower value.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// maxReplicas is the maximum number of desired machines.
+	// If set, replicas is defaulted to at most maxReplicas; additionally replicas cannot be set to a higher value,
+	// e.g. by the Kubernetes autoscaler or by a scale command, and the max size annotation of the
+	// Kubernetes autoscaler cannot be set to a higher value.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
 
 	// rollout allows you to configure the behaviour of rolling updates to the MachineDeployment Machines.
 	// It allows you to require that all Machines are replaced after a certain time,
@@ -289,6 +364,27 @@ type MachineDeploymentSpec struct {
 	// +optional
 	Deletion MachineDeploymentDeletionSpec `json:"deletion,omitempty,omitzero"`
 
+	// rebalance contains configuration options for rebalancing Machines across failure domains.
+	// This field is propagated to the MachineSets of the MachineDeployment; Machines are only rebalanced by the
+	// MachineSet with the most current revision.
+	// +optional
+	Rebalance MachineDeploymentRebalanceSpec `json:"rebalance,omitempty,omitzero"`
+
+	// standby contains configuration options for standby Machines, i.e. spare Machines which are provisioned
+	// in advance and promoted to replace unhealthy Machines when remediation occurs.
+	// This field is propagated to the MachineSets of the MachineDeployment; standby Machines are only kept by the
+	// MachineSet with the most current revision.
+	// Note: This field requires the MachineSetStandby feature gate to be enabled.
+	// +optional
+	Standby MachineDeploymentStandbySpec `json:"standby,omitempty,omitzero"`
+
+	// powerStateSchedule defines time windows during which the Machines of the MachineDeployment are stopped,
+	// e.g. to stop the workers of dev clusters at night.
+	// During a window, Machines are stopped by setting spec.powerState to Stopped on MachineSets and Machines,
+	// without triggering a rollout; outside the windows, spec.template.spec.powerState is used.
+	// +optional
+	PowerStateSchedule MachineDeploymentPowerStateScheduleSpec `json:"powerStateSchedule,omitempty,omitzero"`
+
 	// paused indicates that the deployment is paused.
 	// +optional
 	Paused *bool `json:"paused,omitempty"`
@@ -309,6 +405,46 @@ type MachineDeploymentRolloutSpec struct {
 	// strategy specifies how to roll out control plane Machines.
 	// +optional
 	Strategy MachineDeploymentRolloutStrategy `json:"strategy,omitempty,omitzero"`
+
+	// machineSetReadyTimeoutSeconds is the maximum time in seconds a new MachineSet can take, from its creation,
+	// to have all the desired replicas available during a rollout.
+	// When the timeout expires, the rollout is considered failed and rollback.policy determines how to proceed.
+	// If not set, rollouts never fail.
+	// Note: This field is used only with the RollingUpdate rollout strategy.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MachineSetReadyTimeoutSeconds *int32 `json:"machineSetReadyTimeoutSeconds,omitempty"`
+
+	// rollback defines how to proceed when a rollout fails.
+	// +optional
+	Rollback MachineDeploymentRolloutRollbackSpec `json:"rollback,omitempty,omitzero"`
+
+	// nodeReadinessChecks defines DaemonSets which must have a ready Pod on the Node of each new Machine
+	// before the Machine is considered ready, and thus before it is counted as available and the rollout proceeds;
+	// this prevents capacity gaps for workloads depending e.g. on GPU drivers or CSI node plugins.
+	// If set, it overrides spec.template.spec.nodeReadinessChecks; it is propagated in-place to the MachineSets
+	// and Machines of the MachineDeployment, without triggering a rollout.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	NodeReadinessChecks []MachineNodeReadinessCheck `json:"nodeReadinessChecks,omitempty"`
+}
+
+// MachineDeploymentRolloutRollbackSpec defines how to proceed when a rollout fails.
+// +kubebuilder:validation:MinProperties=1
+type MachineDeploymentRolloutRollbackSpec struct {
+	// policy defines how to proceed when the new MachineSet does not become available within machineSetReadyTimeoutSeconds.
+	// If set to Automatic, the new MachineSet is scaled down and the previous MachineSet is restored by setting
+	// the version, bootstrap, infrastructureRef and failureDomain of the MachineDeployment's template back to
+	// the values of the previous MachineSet; a RollbackPerformed condition is surfaced on the MachineDeployment.
+	// If set to Never, the rollout continues.
+	// Defaults to Never.
+	// Note: MachineDeployments managed by a ClusterClass cannot use the Automatic policy, because the topology
+	// controller owns the MachineDeployment's template and would revert the rollback.
+	// +optional
+	Policy MachineDeploymentRollbackPolicy `json:"policy,omitempty"`
 }
 
 // MachineDeploymentRolloutStrategy describes how to replace existing machines
@@ -396,11 +532,19 @@ type MachineNamingSpec struct {
 	// get concatenated with a random suffix of length 5.
 	// Length of the template string must not exceed 256 characters.
 	// The template allows the following variables `.cluster.name`,
-	// `.machineSet.name` and `.random`.
+	// `.machineSet.name`, `.predecessor.name` and `.random`.
 	// The variable `.cluster.name` retrieves the name of the cluster object
 	// that owns the Machines being created.
 	// The variable `.machineSet.name` retrieves the name of the MachineSet
 	// object that owns the Machines being created.
+	// The variable `.predecessor.name` retrieves the name of the Machine
+	// being replaced when a Machine is created as a consequence of remediation;
+	// it is empty otherwise, e.g.
+	// `{{ if .predecessor.name }}{{ .predecessor.name }}-r{{ else }}{{ .machineSet.name }}{{ end }}-{{ .random }}`.
+	// The template is validated both for new Machines and for Machines replacing a
+	// remediated Machine, so templates like `{{ .predecessor.name }}-{{ .random }}`
+	// are rejected because the names of new Machines would start with "-";
+	// use the `{{ if .predecessor.name }}` pattern above instead.
 	// The variable `.random` is substituted with random alphanumeric string,
 	// without vowels, of length 5. This variable is required part of the
 	// template. If not provided, validation will fail.
@@ -419,6 +563,87 @@ type MachineDeploymentDeletionSpec struct {
 	Order MachineSetDeletionOrder `json:"order,omitempty"`
 }
 
+// MachineDeploymentPowerStateScheduleSpec defines time windows during which the Machines of a MachineDeployment are stopped.
+// +kubebuilder:validation:MinProperties=1
+type MachineDeploymentPowerStateScheduleSpec struct {
+	// timeZone is the IANA time zone name used to interpret the start and end of the windows, e.g. Europe/Rome.
+	// Defaults to UTC.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// stoppedWindows are the daily time windows during which the Machines are stopped.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	StoppedWindows []MachinePowerStateWindow `json:"stoppedWindows,omitempty"`
+}
+
+// MachinePowerStateWindow defines a daily time window.
+type MachinePowerStateWindow struct {
+	// start is the time of the day the window starts, in the format HH:MM.
+	// +required
+	// +kubebuilder:validation:MinLength=5
+	// +kubebuilder:validation:MaxLength=5
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start,omitempty"`
+
+	// end is the time of the day the window ends, in the format HH:MM.
+	// If end is before start, the window ends on the next day, e.g. start 20:00 and end 07:00.
+	// +required
+	// +kubebuilder:validation:MinLength=5
+	// +kubebuilder:validation:MaxLength=5
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end,omitempty"`
+
+	// days are the days of the week the window starts on, e.g. Monday; if not set, the window starts every day.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=7
+	Days []Weekday `json:"days,omitempty"`
+}
+
+// Weekday is a day of the week.
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type Weekday string
+
+// MachineDeploymentRebalanceSpec contains configuration options for rebalancing Machines across failure domains.
+// +kubebuilder:validation:MinProperties=1
+type MachineDeploymentRebalanceSpec struct {
+	// policy defines if Machines are rebalanced across failure domains when their spread becomes skewed,
+	// e.g. after an outage of a failure domain.
+	// When set to Automatic, Machines in over-represented failure domains are deleted, and replaced with
+	// new Machines in the under-represented failure domains.
+	// Note: Rebalancing only applies to MachineDeployments spreading Machines across the failure domains defined in
+	// spec.template.spec.infrastructureOverrides, i.e. when spec.template.spec.failureDomain is not set.
+	// Defaults to "Never". Valid values are "Never", "Automatic".
+	// +optional
+	Policy MachineSetRebalancePolicy `json:"policy,omitempty"`
+
+	// maxUnavailable is the maximum number of Machines that can be unavailable while rebalancing;
+	// Machines which are not available for other reasons, e.g. because they are still provisioning, are counted as well.
+	// Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%), which is calculated by rounding down.
+	// Defaults to 1; if a percentage is resolved to 0, 1 is used.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// MachineDeploymentStandbySpec contains configuration options for standby Machines.
+// +kubebuilder:validation:MinProperties=1
+type MachineDeploymentStandbySpec struct {
+	// replicas is the number of standby Machines kept in addition to the desired replicas of the MachineDeployment.
+	// Standby Machines get the cluster.x-k8s.io/standby label and their Nodes get the node.cluster.x-k8s.io/standby:NoSchedule
+	// taint, so they do not run workloads. When an unhealthy Machine is remediated, an available standby Machine is
+	// promoted in its place by removing the label and the taint, and a new standby Machine is created.
+	// Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
 // MachineDeploymentStatus defines the observed state of MachineDeployment.
 // +kubebuilder:validation:MinProperties=1
 type MachineDeploymentStatus struct {
@@ -460,6 +685,10 @@ type MachineDeploymentStatus struct {
 	// +optional
 	UpToDateReplicas *int32 `json:"upToDateReplicas,omitempty"`
 
+	// stoppedReplicas is the number of stopped replicas for this MachineDeployment. A machine is considered stopped when Machine's Stopped condition is true.
+	// +optional
+	StoppedReplicas *int32 `json:"stoppedReplicas,omitempty"`
+
 	// phase represents the current phase of a MachineDeployment (ScalingUp, ScalingDown, Running, Failed, or Unknown).
 	// +optional
 	// +kubebuilder:validation:Enum=ScalingUp;ScalingDown;Running;Failed;Unknown
@@ -574,7 +803,7 @@ func (md *MachineDeploymentStatus) GetTypedPhase() MachineDeploymentPhase {
 // +kubebuilder:subresource:status
 // +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterName",description="Cluster"
-// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=`.status.conditions[?(@.type=="Available")].status`,description="Cluster pass all availability checks"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=`.status.conditions[?(@.type=="Available")].status`,description="MachineDeployment pass all availability checks"
 // +kubebuilder:printcolumn:name="Desired",type=integer,JSONPath=".spec.replicas",description="The desired number of machines"
 // +kubebuilder:printcolumn:name="Current",type="integer",JSONPath=".status.replicas",description="The number of machines"
 // +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyReplicas",description="The number of machines with Ready condition true"
@@ -33,6 +33,10 @@ const (
 	// the MachineHealthCheck is blocked from making any further remediation.
 	MachineHealthCheckTooManyUnhealthyReason = "TooManyUnhealthy"
 
+	// MachineHealthCheckClusterDegradedFreezeReason is the reason used when the Cluster has the DegradedFreeze
+	// condition set to True and the MachineHealthCheck is blocked from making any further remediation.
+	MachineHealthCheckClusterDegradedFreezeReason = "ClusterDegradedFreeze"
+
 	// MachineHealthCheckRemediationAllowedReason is the reason used when the number of unhealthy machine
 	// is within the limits defined by the MachineHealthCheck, and thus remediation is allowed.
 	MachineHealthCheckRemediationAllowedReason = "RemediationAllowed"
@@ -44,6 +48,24 @@ var (
 	// 10 minutes should allow the instance to start and the node to join the
 	// cluster on most providers.
 	DefaultNodeStartupTimeoutSeconds = int32(600)
+
+	// DefaultMachineRebootTimeoutSeconds is the time allowed for a Machine to become healthy
+	// again after a reboot has been requested.
+	// Can be made longer as part of spec if required for particular provider.
+	DefaultMachineRebootTimeoutSeconds = int32(300)
+
+	// DefaultNodeProbePort is the default port used by TCP Node probes, i.e. the kubelet port.
+	DefaultNodeProbePort = int32(10250)
+
+	// DefaultNodeProbeTimeoutSeconds is the default timeout of a single Node probe.
+	DefaultNodeProbeTimeoutSeconds = int32(5)
+
+	// DefaultNodeProbePeriodSeconds is the default interval between Node probes.
+	DefaultNodeProbePeriodSeconds = int32(60)
+
+	// DefaultNodeProbeUnhealthyTimeoutSeconds is the default duration a Node probe must be failing
+	// for, before the machine is considered unhealthy.
+	DefaultNodeProbeUnhealthyTimeoutSeconds = int32(180)
 )
 
 // MachineHealthCheckSpec defines the desired state of MachineHealthCheck.
@@ -121,6 +143,91 @@ type MachineHealthCheckChecks struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=100
 	UnhealthyMachineConditions []UnhealthyMachineCondition `json:"unhealthyMachineConditions,omitempty"`
+
+	// nodeProbe configures an active probe of the Node from the management cluster, which is
+	// used as an additional signal to determine whether a machine is considered unhealthy.
+	// This allows to catch Nodes which are reported as Ready by the kubelet, but that cannot be
+	// reached anymore e.g. because of a network partition.
+	//
+	// +optional
+	NodeProbe MachineHealthCheckNodeProbe `json:"nodeProbe,omitempty,omitzero"`
+}
+
+// MachineHealthCheckNodeProbeType is the type of a Node probe.
+// +kubebuilder:validation:Enum=TCP;ICMP;KubeletHealthz
+type MachineHealthCheckNodeProbeType string
+
+const (
+	// MachineHealthCheckNodeProbeTypeTCP probes the Node by opening a TCP connection to one of its addresses.
+	MachineHealthCheckNodeProbeTypeTCP MachineHealthCheckNodeProbeType = "TCP"
+
+	// MachineHealthCheckNodeProbeTypeICMP probes the Node by sending an ICMP echo request to one of its addresses.
+	// Note: the MachineHealthCheck controller must be allowed to send ICMP echo requests, e.g. via
+	// the net.ipv4.ping_group_range sysctl.
+	MachineHealthCheckNodeProbeTypeICMP MachineHealthCheckNodeProbeType = "ICMP"
+
+	// MachineHealthCheckNodeProbeTypeKubeletHealthz probes the healthz endpoint of the kubelet
+	// via the Node proxy of the workload cluster API server.
+	MachineHealthCheckNodeProbeTypeKubeletHealthz MachineHealthCheckNodeProbeType = "KubeletHealthz"
+)
+
+// MachineHealthCheckNodeProbe configures an active probe of the Node from the management cluster.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:XValidation:rule="!has(self.port) || self.type == 'TCP'",message="port can only be set if type is TCP"
+// +kubebuilder:validation:XValidation:rule="!has(self.addressType) || self.type != 'KubeletHealthz'",message="addressType can not be set if type is KubeletHealthz"
+type MachineHealthCheckNodeProbe struct {
+	// type of the probe, one of TCP, ICMP or KubeletHealthz.
+	// TCP and ICMP probes connect to the Node address directly from the management cluster,
+	// KubeletHealthz probes query the kubelet healthz endpoint via the workload cluster API server.
+	// +required
+	Type MachineHealthCheckNodeProbeType `json:"type,omitempty"`
+
+	// port is the port used by TCP probes.
+	//
+	// Defaults to 10250, the kubelet port.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
+	// addressType is the type of the Node address used by TCP and ICMP probes.
+	//
+	// Defaults to InternalIP.
+	// +optional
+	// +kubebuilder:validation:Enum=InternalIP;ExternalIP
+	AddressType MachineAddressType `json:"addressType,omitempty"`
+
+	// timeoutSeconds is the timeout of a single probe.
+	//
+	// Defaults to 5 seconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=60
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// periodSeconds is how often the Node is probed.
+	//
+	// Defaults to 60 seconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=3600
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+
+	// unhealthyTimeoutSeconds is the duration the probe must be failing for,
+	// after which the machine is considered unhealthy.
+	//
+	// Defaults to 180 seconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	UnhealthyTimeoutSeconds *int32 `json:"unhealthyTimeoutSeconds,omitempty"`
+}
+
+// IsDefined returns true if the MachineHealthCheckNodeProbe is set.
+func (p *MachineHealthCheckNodeProbe) IsDefined() bool {
+	if p == nil {
+		return false
+	}
+	return p.Type != ""
 }
 
 // MachineHealthCheckRemediation configures if and how remediations are triggered if a Machine is unhealthy.
@@ -139,6 +246,77 @@ type MachineHealthCheckRemediation struct {
 	// a controller that lives outside of Cluster API.
 	// +optional
 	TemplateRef MachineHealthCheckRemediationTemplateReference `json:"templateRef,omitempty,omitzero"`
+
+	// reboot configures if unhealthy Machines should be rebooted before triggering remediation.
+	//
+	// When set, the MachineHealthCheck controller requests a reboot of an unhealthy Machine by setting
+	// the `cluster.x-k8s.io/reboot-requested` annotation on it; infrastructure providers supporting
+	// instance restart are expected to reboot the corresponding instance once for each distinct value of the annotation.
+	// Only after maxAttempts reboots did not make the Machine healthy again, remediation
+	// is triggered via the owner of the Machine.
+	//
+	// Note: this field is ignored if templateRef is set.
+	// +optional
+	Reboot MachineHealthCheckRemediationReboot `json:"reboot,omitempty,omitzero"`
+
+	// cordon configures if the Nodes of unhealthy Machines should be cordoned and drained while keeping the Machines
+	// for a limited time before triggering remediation, e.g. to investigate recurring Node failures.
+	//
+	// When set, the MachineHealthCheck controller requests to cordon an unhealthy Machine by setting
+	// the `cluster.x-k8s.io/cordon-requested` annotation on it; the Machine controller then cordons and drains
+	// the Machine's Node and reports progress in the Machine's Cordoned condition.
+	// Only after durationSeconds, remediation is triggered via the owner of the Machine.
+	// If reboot is set too, the Machine is cordoned only after all the reboot attempts did not make the Machine healthy again.
+	//
+	// Note: this field is ignored if templateRef is set.
+	// +optional
+	Cordon MachineHealthCheckRemediationCordon `json:"cordon,omitempty,omitzero"`
+}
+
+// MachineHealthCheckRemediationCordon configures if the Nodes of unhealthy Machines should be cordoned and drained
+// before triggering remediation.
+// +kubebuilder:validation:MinProperties=1
+type MachineHealthCheckRemediationCordon struct {
+	// durationSeconds is the time an unhealthy Machine is kept with its Node cordoned and drained,
+	// before triggering remediation via the owner of the Machine.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	DurationSeconds *int32 `json:"durationSeconds,omitempty"`
+}
+
+// IsDefined returns true if the MachineHealthCheckRemediationCordon is set.
+func (r *MachineHealthCheckRemediationCordon) IsDefined() bool {
+	if r == nil {
+		return false
+	}
+	return r.DurationSeconds != nil
+}
+
+// MachineHealthCheckRemediationReboot configures if unhealthy Machines should be rebooted before triggering remediation.
+// +kubebuilder:validation:MinProperties=1
+type MachineHealthCheckRemediationReboot struct {
+	// maxAttempts is the maximum number of reboots requested for an unhealthy Machine
+	// before triggering remediation via the owner of the Machine.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+
+	// timeoutSeconds is the time the MachineHealthCheck controller waits after requesting a reboot
+	// for the Machine to become healthy again, before considering the reboot attempt failed.
+	//
+	// Defaults to 5 minutes.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// IsDefined returns true if the MachineHealthCheckRemediationReboot is set.
+func (r *MachineHealthCheckRemediationReboot) IsDefined() bool {
+	if r == nil {
+		return false
+	}
+	return r.MaxAttempts != nil
 }
 
 // MachineHealthCheckRemediationTriggerIf configures if remediations are triggered.
@@ -242,6 +420,42 @@ type UnhealthyNodeCondition struct {
 	// +required
 	// +kubebuilder:validation:Minimum=0
 	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// slidingWindow configures an additional policy to detect nodes flapping in and out of the given status,
+	// which could never match timeoutSeconds, e.g. a node which is NotReady for 9 minutes repeatedly with
+	// a timeout of 10 minutes. When the node has been in the given status for a cumulative time of at least
+	// cumulativeTimeoutSeconds within the last windowSeconds, the node is considered unhealthy.
+	// +optional
+	SlidingWindow UnhealthyNodeConditionSlidingWindow `json:"slidingWindow,omitempty,omitzero"`
+}
+
+// UnhealthyNodeConditionSlidingWindow configures the cumulative time a node can be in a given status within a time window.
+// +kubebuilder:validation:XValidation:rule="self.cumulativeTimeoutSeconds <= self.windowSeconds",message="cumulativeTimeoutSeconds must be less than or equal to windowSeconds"
+type UnhealthyNodeConditionSlidingWindow struct {
+	// windowSeconds is the duration of the sliding time window.
+	// For example, with a value of "3600", the time the node has been in the given status
+	// during the last hour is considered.
+	// +required
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=86400
+	WindowSeconds *int32 `json:"windowSeconds,omitempty"`
+
+	// cumulativeTimeoutSeconds is the cumulative duration that a node must be in a given status for
+	// within the time window, after which the node is considered unhealthy.
+	// For example, with a value of "1200", the node must match the status for at least 20 minutes
+	// in total within the time window before being considered unhealthy.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+	CumulativeTimeoutSeconds *int32 `json:"cumulativeTimeoutSeconds,omitempty"`
+}
+
+// IsDefined returns true if the UnhealthyNodeConditionSlidingWindow is set.
+func (w *UnhealthyNodeConditionSlidingWindow) IsDefined() bool {
+	if w == nil {
+		return false
+	}
+	return w.WindowSeconds != nil && w.CumulativeTimeoutSeconds != nil
 }
 
 // UnhealthyMachineCondition represents a Machine condition type and value with a timeout
@@ -341,6 +555,7 @@ type MachineHealthCheckV1Beta1DeprecatedStatus struct {
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterName",description="Cluster"
 // +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.expectedMachines",description="Number of machines currently monitored"
 // +kubebuilder:printcolumn:name="Healthy",type="integer",JSONPath=".status.currentHealthy",description="Current observed healthy machines"
+// +kubebuilder:printcolumn:name="Remediation Allowed",type="string",JSONPath=`.status.conditions[?(@.type=="RemediationAllowed")].status`,description="MachineHealthCheck is allowed to remediate unhealthy machines"
 // +kubebuilder:printcolumn:name="Paused",type="string",JSONPath=`.status.conditions[?(@.type=="Paused")].status`,description="Reconciliation paused",priority=10
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of MachineHealthCheck"
 
@@ -65,6 +65,9 @@ const (
 */
 
 // MachinePoolSpec defines the desired state of MachinePool.
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || !has(self.maxReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must be less than or equal to maxReplicas"
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || !has(self.replicas) || self.replicas >= self.minReplicas",message="replicas must be greater than or equal to minReplicas"
+// +kubebuilder:validation:XValidation:rule="!has(self.maxReplicas) || !has(self.replicas) || self.replicas <= self.maxReplicas",message="replicas must be less than or equal to maxReplicas"
 type MachinePoolSpec struct {
 	// clusterName is the name of the Cluster this object belongs to.
 	// +required
@@ -77,6 +80,22 @@ type MachinePoolSpec struct {
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
 
+	// minReplicas is the minimum number of desired machines.
+	// If set, replicas is defaulted to at least minReplicas; additionally replicas cannot be set to a lower value,
+	// e.g. by the Kubernetes autoscaler or by a scale command, and the min size annotation of the
+	// Kubernetes autoscaler cannot be set to a lower value.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// maxReplicas is the maximum number of desired machines.
+	// If set, replicas is defaulted to at most maxReplicas; additionally replicas cannot be set to a higher value,
+	// e.g. by the Kubernetes autoscaler or by a scale command, and the max size annotation of the
+	// Kubernetes autoscaler cannot be set to a higher value.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
 	// template describes the machines that will be created.
 	// +required
 	Template MachineTemplateSpec `json:"template,omitempty,omitzero"`
@@ -97,8 +116,56 @@ type MachinePoolSpec struct {
 	// +kubebuilder:validation:items:MinLength=1
 	// +kubebuilder:validation:items:MaxLength=256
 	FailureDomains []string `json:"failureDomains,omitempty"`
+
+	// machines configures the Machine objects representing the instances of the MachinePool.
+	// +optional
+	Machines MachinePoolMachinesSpec `json:"machines,omitempty,omitzero"`
+}
+
+// MachinePoolMachinesPolicy defines if the infrastructure provider is required to support MachinePool Machines.
+// +kubebuilder:validation:Enum=Optional;Required
+type MachinePoolMachinesPolicy string
+
+const (
+	// OptionalMachinePoolMachinesPolicy creates Machine objects for the MachinePool instances only if
+	// the infrastructure provider supports MachinePool Machines.
+	OptionalMachinePoolMachinesPolicy MachinePoolMachinesPolicy = "Optional"
+
+	// RequiredMachinePoolMachinesPolicy requires the infrastructure provider to support MachinePool Machines;
+	// non-compliance is surfaced by the MachinesSupported condition.
+	RequiredMachinePoolMachinesPolicy MachinePoolMachinesPolicy = "Required"
+)
+
+// MachinePoolMachinesSpec configures the Machine objects representing the instances of the MachinePool.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolMachinesSpec struct {
+	// policy defines if the infrastructure provider is required to support MachinePool Machines, i.e. to create
+	// an InfraMachine for each instance of the MachinePool and to report their kind in status.infrastructureMachineKind
+	// of the InfraMachinePool.
+	// If set to Required and the infrastructure provider does not support MachinePool Machines, the MachinesSupported
+	// condition is set to false on the MachinePool.
+	// Defaults to Optional.
+	// +optional
+	Policy MachinePoolMachinesPolicy `json:"policy,omitempty"`
 }
 
+// MachinePool's MachinesSupported condition and corresponding reasons.
+const (
+	// MachinePoolMachinesSupportedCondition is true if the infrastructure provider supports MachinePool Machines.
+	// Note: This condition is set only when spec.machines.policy is Required.
+	MachinePoolMachinesSupportedCondition = "MachinesSupported"
+
+	// MachinePoolMachinesSupportedReason surfaces when the InfraMachinePool reports status.infrastructureMachineKind.
+	MachinePoolMachinesSupportedReason = "MachinesSupported"
+
+	// MachinePoolMachinesNotSupportedReason surfaces when the InfraMachinePool does not report status.infrastructureMachineKind.
+	MachinePoolMachinesNotSupportedReason = "MachinesNotSupported"
+
+	// MachinePoolMachinesSupportedInternalErrorReason surfaces unexpected failures when reading status.infrastructureMachineKind
+	// from the InfraMachinePool.
+	MachinePoolMachinesSupportedInternalErrorReason = InternalErrorReason
+)
+
 // MachinePoolStatus defines the observed state of MachinePool.
 // +kubebuilder:validation:MinProperties=1
 type MachinePoolStatus struct {
@@ -138,6 +205,15 @@ type MachinePoolStatus struct {
 	// +optional
 	UpToDateReplicas *int32 `json:"upToDateReplicas,omitempty"`
 
+	// selector is the label selector of the Machines and Nodes belonging to this MachinePool in string format
+	// to avoid introspection by clients. The string will be in the same format as the query-param syntax.
+	// This is necessary for the scale subresource, e.g. when the MachinePool is autoscaled.
+	// More info about label selectors: http://kubernetes.io/docs/user-guide/labels#label-selectors
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Selector string `json:"selector,omitempty"`
+
 	// phase represents the current phase of cluster actuation.
 	// +optional
 	// +kubebuilder:validation:Enum=Pending;Provisioning;Provisioned;Running;ScalingUp;ScalingDown;Scaling;Deleting;Failed;Unknown
@@ -320,9 +396,10 @@ func (m *MachinePoolStatus) GetTypedPhase() MachinePoolPhase {
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=machinepools,shortName=mp,scope=Namespaced,categories=cluster-api
 // +kubebuilder:subresource:status
-// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterName",description="Cluster"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=`.status.conditions[?(@.type=="Available")].status`,description="MachinePool pass all availability checks"
 // +kubebuilder:printcolumn:name="Desired",type=integer,JSONPath=".spec.replicas",description="The desired number of machines"
 // +kubebuilder:printcolumn:name="Current",type="integer",JSONPath=".status.replicas",description="The number of machines"
 // +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyReplicas",description="The number of machines with Ready condition true"
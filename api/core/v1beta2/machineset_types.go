@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	capierrors "sigs.k8s.io/cluster-api/errors"
@@ -56,6 +57,13 @@ const (
 	// from this annotation as soon as pending-acknowledge-move is removed from the machine; the annotation is dropped when empty.
 	// Note: This annotation is used in pair with PendingAcknowledgeMoveAnnotation on Machines.
 	AcknowledgedMoveAnnotation = "in-place-updates.internal.cluster.x-k8s.io/acknowledged-move"
+
+	// MachineSetRemediatedMachinesAnnotation is an internal annotation added by the MachineSet controller
+	// to keep track of Machines deleted as a consequence of remediation and not yet replaced.
+	// The annotation value is a comma separated list of Machine names; when creating a replacement Machine,
+	// the first Machine in the list is exposed as predecessor to the machineNaming template and then dropped
+	// from the list; the annotation is dropped when empty.
+	MachineSetRemediatedMachinesAnnotation = "machineset.internal.cluster.x-k8s.io/remediated-machines"
 )
 
 // MachineSetSpec defines the desired state of MachineSet.
@@ -107,8 +115,67 @@ type MachineSetSpec struct {
 	// deletion contains configuration options for MachineSet deletion.
 	// +optional
 	Deletion MachineSetDeletionSpec `json:"deletion,omitempty,omitzero"`
+
+	// rebalance contains configuration options for rebalancing Machines across failure domains.
+	// +optional
+	Rebalance MachineSetRebalanceSpec `json:"rebalance,omitempty,omitzero"`
+
+	// standby contains configuration options for standby Machines, i.e. spare Machines which are provisioned
+	// in advance and promoted to replace unhealthy Machines when remediation occurs.
+	// Note: This field requires the MachineSetStandby feature gate to be enabled.
+	// +optional
+	Standby MachineSetStandbySpec `json:"standby,omitempty,omitzero"`
+}
+
+// MachineSetStandbySpec contains configuration options for standby Machines.
+// +kubebuilder:validation:MinProperties=1
+type MachineSetStandbySpec struct {
+	// replicas is the number of standby Machines kept in addition to the desired replicas of the MachineSet.
+	// Standby Machines are created like other Machines of the MachineSet, but they get the cluster.x-k8s.io/standby
+	// label and their Nodes get the node.cluster.x-k8s.io/standby:NoSchedule taint, so they do not run workloads.
+	// When an unhealthy Machine is remediated, an available standby Machine is promoted in its place by removing
+	// the label and the taint, and a new standby Machine is created.
+	// Note: If the MachineSet is owned by a MachineDeployment, standby Machines are only kept by the MachineSet
+	// with the most current revision.
+	// Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int32 `json:"replicas,omitempty"`
 }
 
+// MachineSetRebalanceSpec contains configuration options for rebalancing Machines across failure domains.
+// +kubebuilder:validation:MinProperties=1
+type MachineSetRebalanceSpec struct {
+	// policy defines if Machines are rebalanced across failure domains when their spread becomes skewed,
+	// e.g. after an outage of a failure domain.
+	// When set to Automatic, Machines in over-represented failure domains are deleted, and the MachineSet
+	// replaces them with new Machines in the under-represented failure domains.
+	// Note: Rebalancing only applies to MachineSets spreading Machines across the failure domains defined in
+	// spec.template.spec.infrastructureOverrides, i.e. when spec.template.spec.failureDomain is not set.
+	// Defaults to "Never". Valid values are "Never", "Automatic".
+	// +optional
+	Policy MachineSetRebalancePolicy `json:"policy,omitempty"`
+
+	// maxUnavailable is the maximum number of Machines that can be unavailable while rebalancing;
+	// Machines which are not available for other reasons, e.g. because they are still provisioning, are counted as well.
+	// Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%), which is calculated by rounding down.
+	// Defaults to 1; if a percentage is resolved to 0, 1 is used.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// MachineSetRebalancePolicy defines if Machines are rebalanced across failure domains.
+// +kubebuilder:validation:Enum=Never;Automatic
+type MachineSetRebalancePolicy string
+
+const (
+	// NeverMachineSetRebalancePolicy means that Machines are never rebalanced across failure domains.
+	NeverMachineSetRebalancePolicy MachineSetRebalancePolicy = "Never"
+
+	// AutomaticMachineSetRebalancePolicy means that Machines are automatically rebalanced across failure domains.
+	AutomaticMachineSetRebalancePolicy MachineSetRebalancePolicy = "Automatic"
+)
+
 // MachineSetDeletionSpec contains configuration options for MachineSet deletion.
 // +kubebuilder:validation:MinProperties=1
 type MachineSetDeletionSpec struct {
@@ -278,21 +345,24 @@ const (
 	// RandomMachineSetDeletionOrder prioritizes both Machines that have the annotation
 	// "cluster.x-k8s.io/delete-machine=yes" and Machines that are unhealthy
 	// (Status.FailureReason or Status.FailureMessage are set to a non-empty value
-	// or NodeHealthy type of Status.Conditions is not true).
+	// or NodeHealthy type of Status.Conditions is not true), followed by Machines that are
+	// not up-to-date (UpToDate type of Status.Conditions is false).
 	// Finally, it picks Machines at random to delete.
 	RandomMachineSetDeletionOrder MachineSetDeletionOrder = "Random"
 
 	// NewestMachineSetDeletionOrder prioritizes both Machines that have the annotation
 	// "cluster.x-k8s.io/delete-machine=yes" and Machines that are unhealthy
 	// (Status.FailureReason or Status.FailureMessage are set to a non-empty value
-	// or NodeHealthy type of Status.Conditions is not true).
+	// or NodeHealthy type of Status.Conditions is not true), followed by Machines that are
+	// not up-to-date (UpToDate type of Status.Conditions is false).
 	// It then prioritizes the newest Machines for deletion based on the Machine's CreationTimestamp.
 	NewestMachineSetDeletionOrder MachineSetDeletionOrder = "Newest"
 
 	// OldestMachineSetDeletionOrder prioritizes both Machines that have the annotation
 	// "cluster.x-k8s.io/delete-machine=yes" and Machines that are unhealthy
 	// (Status.FailureReason or Status.FailureMessage are set to a non-empty value
-	// or NodeHealthy type of Status.Conditions is not true).
+	// or NodeHealthy type of Status.Conditions is not true), followed by Machines that are
+	// not up-to-date (UpToDate type of Status.Conditions is false).
 	// It then prioritizes the oldest Machines for deletion based on the Machine's CreationTimestamp.
 	OldestMachineSetDeletionOrder MachineSetDeletionOrder = "Oldest"
 )
@@ -332,6 +402,10 @@ type MachineSetStatus struct {
 	// +optional
 	UpToDateReplicas *int32 `json:"upToDateReplicas,omitempty"`
 
+	// stoppedReplicas is the number of stopped replicas for this MachineSet. A machine is considered stopped when Machine's Stopped condition is true.
+	// +optional
+	StoppedReplicas *int32 `json:"stoppedReplicas,omitempty"`
+
 	// observedGeneration reflects the generation of the most recently observed MachineSet.
 	// +optional
 	// +kubebuilder:validation:Minimum=1
@@ -158,6 +158,9 @@ const (
 	// UnhealthyNodeConditionV1Beta1Reason is the reason used when a machine's node has one of the MachineHealthCheck's unhealthy conditions.
 	UnhealthyNodeConditionV1Beta1Reason = "UnhealthyNode"
 
+	// NodeUnreachableV1Beta1Reason is the reason used when a machine's node cannot be reached by the MachineHealthCheck's node probe.
+	NodeUnreachableV1Beta1Reason = "NodeUnreachable"
+
 	// UnhealthyMachineConditionV1Beta1Reason is the reason used when a machine has one of the MachineHealthCheck's unhealthy conditions.
 	// When both machine and node issues are detected, this reason takes precedence over node-related reasons
 	// (NodeNotFoundV1Beta1Reason, NodeStartupTimeoutV1Beta1Reason, UnhealthyNodeConditionV1Beta1Reason).
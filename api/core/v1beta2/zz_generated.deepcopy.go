@@ -513,6 +513,72 @@ func (in *ClusterControlPlaneStatus) DeepCopy() *ClusterControlPlaneStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDegradedFreezeSpec) DeepCopyInto(out *ClusterDegradedFreezeSpec) {
+	*out = *in
+	if in.UnhealthyMachinesThreshold != nil {
+		in, out := &in.UnhealthyMachinesThreshold, &out.UnhealthyMachinesThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDegradedFreezeSpec.
+func (in *ClusterDegradedFreezeSpec) DeepCopy() *ClusterDegradedFreezeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDegradedFreezeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDeletionSpec) DeepCopyInto(out *ClusterDeletionSpec) {
+	*out = *in
+	if in.PhaseTimeoutSeconds != nil {
+		in, out := &in.PhaseTimeoutSeconds, &out.PhaseTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDeletionSpec.
+func (in *ClusterDeletionSpec) DeepCopy() *ClusterDeletionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDeletionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDeletionStatus) DeepCopyInto(out *ClusterDeletionStatus) {
+	*out = *in
+	in.PhaseStartTime.DeepCopyInto(&out.PhaseStartTime)
+	if in.PhaseTimedOut != nil {
+		in, out := &in.PhaseTimedOut, &out.PhaseTimedOut
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PendingObjects != nil {
+		in, out := &in.PendingObjects, &out.PendingObjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDeletionStatus.
+func (in *ClusterDeletionStatus) DeepCopy() *ClusterDeletionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDeletionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterDeprecatedStatus) DeepCopyInto(out *ClusterDeprecatedStatus) {
 	*out = *in
@@ -533,6 +599,83 @@ func (in *ClusterDeprecatedStatus) DeepCopy() *ClusterDeprecatedStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterFailureDomainSummary) DeepCopyInto(out *ClusterFailureDomainSummary) {
+	*out = *in
+	if in.Machines != nil {
+		in, out := &in.Machines, &out.Machines
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReadyMachines != nil {
+		in, out := &in.ReadyMachines, &out.ReadyMachines
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ControlPlaneMachines != nil {
+		in, out := &in.ControlPlaneMachines, &out.ControlPlaneMachines
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReadyControlPlaneMachines != nil {
+		in, out := &in.ReadyControlPlaneMachines, &out.ReadyControlPlaneMachines
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterFailureDomainSummary.
+func (in *ClusterFailureDomainSummary) DeepCopy() *ClusterFailureDomainSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterFailureDomainSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterFailureDomainsSummary) DeepCopyInto(out *ClusterFailureDomainsSummary) {
+	*out = *in
+	if in.ControlPlaneSkew != nil {
+		in, out := &in.ControlPlaneSkew, &out.ControlPlaneSkew
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]ClusterFailureDomainSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterFailureDomainsSummary.
+func (in *ClusterFailureDomainsSummary) DeepCopy() *ClusterFailureDomainsSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterFailureDomainsSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterIdentityReference) DeepCopyInto(out *ClusterIdentityReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterIdentityReference.
+func (in *ClusterIdentityReference) DeepCopy() *ClusterIdentityReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterIdentityReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterInitializationStatus) DeepCopyInto(out *ClusterInitializationStatus) {
 	*out = *in
@@ -607,6 +750,38 @@ func (in *ClusterNetwork) DeepCopy() *ClusterNetwork {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterOperation) DeepCopyInto(out *ClusterOperation) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterOperation.
+func (in *ClusterOperation) DeepCopy() *ClusterOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPauseSpec) DeepCopyInto(out *ClusterPauseSpec) {
+	*out = *in
+	in.ExpireAfter.DeepCopyInto(&out.ExpireAfter)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPauseSpec.
+func (in *ClusterPauseSpec) DeepCopy() *ClusterPauseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPauseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 	*out = *in
@@ -615,6 +790,7 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	in.Pause.DeepCopyInto(&out.Pause)
 	in.ClusterNetwork.DeepCopyInto(&out.ClusterNetwork)
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	out.ControlPlaneRef = in.ControlPlaneRef
@@ -625,6 +801,9 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = make([]ClusterAvailabilityGate, len(*in))
 		copy(*out, *in)
 	}
+	in.DegradedFreeze.DeepCopyInto(&out.DegradedFreeze)
+	in.Deletion.DeepCopyInto(&out.Deletion)
+	out.IdentityRef = in.IdentityRef
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
@@ -665,6 +844,28 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FailureDomainsSummary != nil {
+		in, out := &in.FailureDomainsSummary, &out.FailureDomainsSummary
+		*out = new(ClusterFailureDomainsSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Topology != nil {
+		in, out := &in.Topology, &out.Topology
+		*out = new(ClusterTopologyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastOperations != nil {
+		in, out := &in.LastOperations, &out.LastOperations
+		*out = make([]ClusterOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Deletion != nil {
+		in, out := &in.Deletion, &out.Deletion
+		*out = new(ClusterDeletionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(ClusterDeprecatedStatus)
@@ -682,6 +883,264 @@ func (in *ClusterStatus) DeepCopy() *ClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplate) DeepCopyInto(out *ClusterTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplate.
+func (in *ClusterTemplate) DeepCopy() *ClusterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateInstance) DeepCopyInto(out *ClusterTemplateInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateInstance.
+func (in *ClusterTemplateInstance) DeepCopy() *ClusterTemplateInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplateInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateInstanceList) DeepCopyInto(out *ClusterTemplateInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterTemplateInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateInstanceList.
+func (in *ClusterTemplateInstanceList) DeepCopy() *ClusterTemplateInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplateInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateInstanceParameter) DeepCopyInto(out *ClusterTemplateInstanceParameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateInstanceParameter.
+func (in *ClusterTemplateInstanceParameter) DeepCopy() *ClusterTemplateInstanceParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateInstanceParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateInstanceSpec) DeepCopyInto(out *ClusterTemplateInstanceSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ClusterTemplateInstanceParameter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateInstanceSpec.
+func (in *ClusterTemplateInstanceSpec) DeepCopy() *ClusterTemplateInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateInstanceStatus) DeepCopyInto(out *ClusterTemplateInstanceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateInstanceStatus.
+func (in *ClusterTemplateInstanceStatus) DeepCopy() *ClusterTemplateInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateList) DeepCopyInto(out *ClusterTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateList.
+func (in *ClusterTemplateList) DeepCopy() *ClusterTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateParameter) DeepCopyInto(out *ClusterTemplateParameter) {
+	*out = *in
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateParameter.
+func (in *ClusterTemplateParameter) DeepCopy() *ClusterTemplateParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateSpec) DeepCopyInto(out *ClusterTemplateSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ClusterTemplateParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateSpec.
+func (in *ClusterTemplateSpec) DeepCopy() *ClusterTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTopologyAppliedPatch) DeepCopyInto(out *ClusterTopologyAppliedPatch) {
+	*out = *in
+	if in.AppliedPatches != nil {
+		in, out := &in.AppliedPatches, &out.AppliedPatches
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTopologyAppliedPatch.
+func (in *ClusterTopologyAppliedPatch) DeepCopy() *ClusterTopologyAppliedPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTopologyAppliedPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTopologyStatus) DeepCopyInto(out *ClusterTopologyStatus) {
+	*out = *in
+	if in.LastAppliedPatches != nil {
+		in, out := &in.LastAppliedPatches, &out.LastAppliedPatches
+		*out = make([]ClusterTopologyAppliedPatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTopologyStatus.
+func (in *ClusterTopologyStatus) DeepCopy() *ClusterTopologyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTopologyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterV1Beta1DeprecatedStatus) DeepCopyInto(out *ClusterV1Beta1DeprecatedStatus) {
 	*out = *in
@@ -1477,6 +1936,7 @@ func (in *MachineDeletionSpec) DeepCopyInto(out *MachineDeletionSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	in.NodeVolumeDetachExclusions.DeepCopyInto(&out.NodeVolumeDetachExclusions)
 	if in.NodeDeletionTimeoutSeconds != nil {
 		in, out := &in.NodeDeletionTimeoutSeconds, &out.NodeDeletionTimeoutSeconds
 		*out = new(int32)
@@ -1864,6 +2324,48 @@ func (in *MachineDeploymentList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDeploymentPowerStateScheduleSpec) DeepCopyInto(out *MachineDeploymentPowerStateScheduleSpec) {
+	*out = *in
+	if in.StoppedWindows != nil {
+		in, out := &in.StoppedWindows, &out.StoppedWindows
+		*out = make([]MachinePowerStateWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentPowerStateScheduleSpec.
+func (in *MachineDeploymentPowerStateScheduleSpec) DeepCopy() *MachineDeploymentPowerStateScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDeploymentPowerStateScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDeploymentRebalanceSpec) DeepCopyInto(out *MachineDeploymentRebalanceSpec) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentRebalanceSpec.
+func (in *MachineDeploymentRebalanceSpec) DeepCopy() *MachineDeploymentRebalanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDeploymentRebalanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDeploymentRemediationSpec) DeepCopyInto(out *MachineDeploymentRemediationSpec) {
 	*out = *in
@@ -1884,11 +2386,39 @@ func (in *MachineDeploymentRemediationSpec) DeepCopy() *MachineDeploymentRemedia
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDeploymentRolloutRollbackSpec) DeepCopyInto(out *MachineDeploymentRolloutRollbackSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentRolloutRollbackSpec.
+func (in *MachineDeploymentRolloutRollbackSpec) DeepCopy() *MachineDeploymentRolloutRollbackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDeploymentRolloutRollbackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDeploymentRolloutSpec) DeepCopyInto(out *MachineDeploymentRolloutSpec) {
 	*out = *in
 	in.After.DeepCopyInto(&out.After)
 	in.Strategy.DeepCopyInto(&out.Strategy)
+	if in.MachineSetReadyTimeoutSeconds != nil {
+		in, out := &in.MachineSetReadyTimeoutSeconds, &out.MachineSetReadyTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	out.Rollback = in.Rollback
+	if in.NodeReadinessChecks != nil {
+		in, out := &in.NodeReadinessChecks, &out.NodeReadinessChecks
+		*out = make([]MachineNodeReadinessCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentRolloutSpec.
@@ -1950,12 +2480,25 @@ func (in *MachineDeploymentSpec) DeepCopyInto(out *MachineDeploymentSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
 	in.Rollout.DeepCopyInto(&out.Rollout)
 	in.Selector.DeepCopyInto(&out.Selector)
 	in.Template.DeepCopyInto(&out.Template)
 	out.MachineNaming = in.MachineNaming
 	in.Remediation.DeepCopyInto(&out.Remediation)
 	out.Deletion = in.Deletion
+	in.Rebalance.DeepCopyInto(&out.Rebalance)
+	in.Standby.DeepCopyInto(&out.Standby)
+	in.PowerStateSchedule.DeepCopyInto(&out.PowerStateSchedule)
 	if in.Paused != nil {
 		in, out := &in.Paused, &out.Paused
 		*out = new(bool)
@@ -1973,6 +2516,26 @@ func (in *MachineDeploymentSpec) DeepCopy() *MachineDeploymentSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDeploymentStandbySpec) DeepCopyInto(out *MachineDeploymentStandbySpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentStandbySpec.
+func (in *MachineDeploymentStandbySpec) DeepCopy() *MachineDeploymentStandbySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDeploymentStandbySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDeploymentStatus) DeepCopyInto(out *MachineDeploymentStatus) {
 	*out = *in
@@ -2003,6 +2566,11 @@ func (in *MachineDeploymentStatus) DeepCopyInto(out *MachineDeploymentStatus) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.StoppedReplicas != nil {
+		in, out := &in.StoppedReplicas, &out.StoppedReplicas
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(MachineDeploymentDeprecatedStatus)
@@ -2511,6 +3079,7 @@ func (in *MachineHealthCheckChecks) DeepCopyInto(out *MachineHealthCheckChecks)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.NodeProbe.DeepCopyInto(&out.NodeProbe)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckChecks.
@@ -2575,11 +3144,48 @@ func (in *MachineHealthCheckList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckNodeProbe) DeepCopyInto(out *MachineHealthCheckNodeProbe) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnhealthyTimeoutSeconds != nil {
+		in, out := &in.UnhealthyTimeoutSeconds, &out.UnhealthyTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckNodeProbe.
+func (in *MachineHealthCheckNodeProbe) DeepCopy() *MachineHealthCheckNodeProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckNodeProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineHealthCheckRemediation) DeepCopyInto(out *MachineHealthCheckRemediation) {
 	*out = *in
 	in.TriggerIf.DeepCopyInto(&out.TriggerIf)
 	out.TemplateRef = in.TemplateRef
+	in.Reboot.DeepCopyInto(&out.Reboot)
+	in.Cordon.DeepCopyInto(&out.Cordon)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckRemediation.
@@ -2592,6 +3198,51 @@ func (in *MachineHealthCheckRemediation) DeepCopy() *MachineHealthCheckRemediati
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckRemediationCordon) DeepCopyInto(out *MachineHealthCheckRemediationCordon) {
+	*out = *in
+	if in.DurationSeconds != nil {
+		in, out := &in.DurationSeconds, &out.DurationSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckRemediationCordon.
+func (in *MachineHealthCheckRemediationCordon) DeepCopy() *MachineHealthCheckRemediationCordon {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckRemediationCordon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckRemediationReboot) DeepCopyInto(out *MachineHealthCheckRemediationReboot) {
+	*out = *in
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckRemediationReboot.
+func (in *MachineHealthCheckRemediationReboot) DeepCopy() *MachineHealthCheckRemediationReboot {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckRemediationReboot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineHealthCheckRemediationTemplateReference) DeepCopyInto(out *MachineHealthCheckRemediationTemplateReference) {
 	*out = *in
@@ -2714,6 +3365,22 @@ func (in *MachineHealthCheckV1Beta1DeprecatedStatus) DeepCopy() *MachineHealthCh
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineInfrastructureOverride) DeepCopyInto(out *MachineInfrastructureOverride) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineInfrastructureOverride.
+func (in *MachineInfrastructureOverride) DeepCopy() *MachineInfrastructureOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineInfrastructureOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineInitializationStatus) DeepCopyInto(out *MachineInitializationStatus) {
 	*out = *in
@@ -2786,6 +3453,22 @@ func (in *MachineNamingSpec) DeepCopy() *MachineNamingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineNodeReadinessCheck) DeepCopyInto(out *MachineNodeReadinessCheck) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineNodeReadinessCheck.
+func (in *MachineNodeReadinessCheck) DeepCopy() *MachineNodeReadinessCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineNodeReadinessCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineNodeReference) DeepCopyInto(out *MachineNodeReference) {
 	*out = *in
@@ -2801,6 +3484,31 @@ func (in *MachineNodeReference) DeepCopy() *MachineNodeReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineNodeVolumeDetachExclusions) DeepCopyInto(out *MachineNodeVolumeDetachExclusions) {
+	*out = *in
+	if in.StorageClassNames != nil {
+		in, out := &in.StorageClassNames, &out.StorageClassNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CSIDrivers != nil {
+		in, out := &in.CSIDrivers, &out.CSIDrivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineNodeVolumeDetachExclusions.
+func (in *MachineNodeVolumeDetachExclusions) DeepCopy() *MachineNodeVolumeDetachExclusions {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineNodeVolumeDetachExclusions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachinePool) DeepCopyInto(out *MachinePool) {
 	*out = *in
@@ -3012,6 +3720,21 @@ func (in *MachinePoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolMachinesSpec) DeepCopyInto(out *MachinePoolMachinesSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolMachinesSpec.
+func (in *MachinePoolMachinesSpec) DeepCopy() *MachinePoolMachinesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolMachinesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachinePoolSpec) DeepCopyInto(out *MachinePoolSpec) {
 	*out = *in
@@ -3020,6 +3743,16 @@ func (in *MachinePoolSpec) DeepCopyInto(out *MachinePoolSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
 	in.Template.DeepCopyInto(&out.Template)
 	if in.ProviderIDList != nil {
 		in, out := &in.ProviderIDList, &out.ProviderIDList
@@ -3031,6 +3764,7 @@ func (in *MachinePoolSpec) DeepCopyInto(out *MachinePoolSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	out.Machines = in.Machines
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolSpec.
@@ -3213,6 +3947,47 @@ func (in *MachinePoolVariables) DeepCopy() *MachinePoolVariables {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePowerStateWindow) DeepCopyInto(out *MachinePowerStateWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePowerStateWindow.
+func (in *MachinePowerStateWindow) DeepCopy() *MachinePowerStateWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePowerStateWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineProvisioningFailure) DeepCopyInto(out *MachineProvisioningFailure) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Retryable != nil {
+		in, out := &in.Retryable, &out.Retryable
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineProvisioningFailure.
+func (in *MachineProvisioningFailure) DeepCopy() *MachineProvisioningFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineProvisioningFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineReadinessGate) DeepCopyInto(out *MachineReadinessGate) {
 	*out = *in
@@ -3322,6 +4097,26 @@ func (in *MachineSetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSetRebalanceSpec) DeepCopyInto(out *MachineSetRebalanceSpec) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSetRebalanceSpec.
+func (in *MachineSetRebalanceSpec) DeepCopy() *MachineSetRebalanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSetRebalanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineSetSpec) DeepCopyInto(out *MachineSetSpec) {
 	*out = *in
@@ -3334,6 +4129,8 @@ func (in *MachineSetSpec) DeepCopyInto(out *MachineSetSpec) {
 	in.Template.DeepCopyInto(&out.Template)
 	out.MachineNaming = in.MachineNaming
 	out.Deletion = in.Deletion
+	in.Rebalance.DeepCopyInto(&out.Rebalance)
+	in.Standby.DeepCopyInto(&out.Standby)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSetSpec.
@@ -3346,6 +4143,26 @@ func (in *MachineSetSpec) DeepCopy() *MachineSetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSetStandbySpec) DeepCopyInto(out *MachineSetStandbySpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSetStandbySpec.
+func (in *MachineSetStandbySpec) DeepCopy() *MachineSetStandbySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSetStandbySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineSetStatus) DeepCopyInto(out *MachineSetStatus) {
 	*out = *in
@@ -3376,6 +4193,11 @@ func (in *MachineSetStatus) DeepCopyInto(out *MachineSetStatus) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.StoppedReplicas != nil {
+		in, out := &in.StoppedReplicas, &out.StoppedReplicas
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(MachineSetDeprecatedStatus)
@@ -3435,17 +4257,36 @@ func (in *MachineSpec) DeepCopyInto(out *MachineSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.InfrastructureReadyTimeoutSeconds != nil {
+		in, out := &in.InfrastructureReadyTimeoutSeconds, &out.InfrastructureReadyTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ReadinessGates != nil {
 		in, out := &in.ReadinessGates, &out.ReadinessGates
 		*out = make([]MachineReadinessGate, len(*in))
 		copy(*out, *in)
 	}
 	in.Deletion.DeepCopyInto(&out.Deletion)
+	if in.NodeReadinessChecks != nil {
+		in, out := &in.NodeReadinessChecks, &out.NodeReadinessChecks
+		*out = make([]MachineNodeReadinessCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Taints != nil {
 		in, out := &in.Taints, &out.Taints
 		*out = make([]MachineTaint, len(*in))
 		copy(*out, *in)
 	}
+	if in.InfrastructureOverrides != nil {
+		in, out := &in.InfrastructureOverrides, &out.InfrastructureOverrides
+		*out = make([]MachineInfrastructureOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSpec.
@@ -3487,6 +4328,11 @@ func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
 		*out = new(MachineDeletionStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastProvisioningFailure != nil {
+		in, out := &in.LastProvisioningFailure, &out.LastProvisioningFailure
+		*out = new(MachineProvisioningFailure)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(MachineDeprecatedStatus)
@@ -3735,8 +4581,14 @@ func (in *PatchSelectorMatchMachinePoolClass) DeepCopy() *PatchSelectorMatchMach
 func (in *Topology) DeepCopyInto(out *Topology) {
 	*out = *in
 	out.ClassRef = in.ClassRef
+	in.RolloutAfter.DeepCopyInto(&out.RolloutAfter)
 	in.ControlPlane.DeepCopyInto(&out.ControlPlane)
 	in.Workers.DeepCopyInto(&out.Workers)
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Variables != nil {
 		in, out := &in.Variables, &out.Variables
 		*out = make([]ClusterVariable, len(*in))
@@ -3784,6 +4636,7 @@ func (in *UnhealthyNodeCondition) DeepCopyInto(out *UnhealthyNodeCondition) {
 		*out = new(int32)
 		**out = **in
 	}
+	in.SlidingWindow.DeepCopyInto(&out.SlidingWindow)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyNodeCondition.
@@ -3796,6 +4649,31 @@ func (in *UnhealthyNodeCondition) DeepCopy() *UnhealthyNodeCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnhealthyNodeConditionSlidingWindow) DeepCopyInto(out *UnhealthyNodeConditionSlidingWindow) {
+	*out = *in
+	if in.WindowSeconds != nil {
+		in, out := &in.WindowSeconds, &out.WindowSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CumulativeTimeoutSeconds != nil {
+		in, out := &in.CumulativeTimeoutSeconds, &out.CumulativeTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyNodeConditionSlidingWindow.
+func (in *UnhealthyNodeConditionSlidingWindow) DeepCopy() *UnhealthyNodeConditionSlidingWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(UnhealthyNodeConditionSlidingWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValidationRule) DeepCopyInto(out *ValidationRule) {
 	*out = *in
@@ -48,12 +48,31 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassVariable":                                     schema_cluster_api_api_core_v1beta2_ClusterClassVariable(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassVariableMetadata":                             schema_cluster_api_api_core_v1beta2_ClusterClassVariableMetadata(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterControlPlaneStatus":                                schema_cluster_api_api_core_v1beta2_ClusterControlPlaneStatus(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDegradedFreezeSpec":                                schema_cluster_api_api_core_v1beta2_ClusterDegradedFreezeSpec(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDeletionSpec":                                      schema_cluster_api_api_core_v1beta2_ClusterDeletionSpec(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDeletionStatus":                                    schema_cluster_api_api_core_v1beta2_ClusterDeletionStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDeprecatedStatus":                                  schema_cluster_api_api_core_v1beta2_ClusterDeprecatedStatus(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterFailureDomainSummary":                              schema_cluster_api_api_core_v1beta2_ClusterFailureDomainSummary(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterFailureDomainsSummary":                             schema_cluster_api_api_core_v1beta2_ClusterFailureDomainsSummary(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterIdentityReference":                                 schema_cluster_api_api_core_v1beta2_ClusterIdentityReference(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterInitializationStatus":                              schema_cluster_api_api_core_v1beta2_ClusterInitializationStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterList":                                              schema_cluster_api_api_core_v1beta2_ClusterList(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterNetwork":                                           schema_cluster_api_api_core_v1beta2_ClusterNetwork(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterOperation":                                         schema_cluster_api_api_core_v1beta2_ClusterOperation(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterPauseSpec":                                         schema_cluster_api_api_core_v1beta2_ClusterPauseSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterSpec":                                              schema_cluster_api_api_core_v1beta2_ClusterSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterStatus":                                            schema_cluster_api_api_core_v1beta2_ClusterStatus(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplate":                                          schema_cluster_api_api_core_v1beta2_ClusterTemplate(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateInstance":                                  schema_cluster_api_api_core_v1beta2_ClusterTemplateInstance(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateInstanceList":                              schema_cluster_api_api_core_v1beta2_ClusterTemplateInstanceList(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateInstanceParameter":                         schema_cluster_api_api_core_v1beta2_ClusterTemplateInstanceParameter(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateInstanceSpec":                              schema_cluster_api_api_core_v1beta2_ClusterTemplateInstanceSpec(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateInstanceStatus":                            schema_cluster_api_api_core_v1beta2_ClusterTemplateInstanceStatus(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateList":                                      schema_cluster_api_api_core_v1beta2_ClusterTemplateList(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateParameter":                                 schema_cluster_api_api_core_v1beta2_ClusterTemplateParameter(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateSpec":                                      schema_cluster_api_api_core_v1beta2_ClusterTemplateSpec(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTopologyAppliedPatch":                              schema_cluster_api_api_core_v1beta2_ClusterTopologyAppliedPatch(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTopologyStatus":                                    schema_cluster_api_api_core_v1beta2_ClusterTopologyStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterV1Beta1DeprecatedStatus":                           schema_cluster_api_api_core_v1beta2_ClusterV1Beta1DeprecatedStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterVariable":                                          schema_cluster_api_api_core_v1beta2_ClusterVariable(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.Condition":                                                schema_cluster_api_api_core_v1beta2_Condition(ref),
@@ -100,11 +119,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentDeletionSpec":                            schema_cluster_api_api_core_v1beta2_MachineDeploymentDeletionSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentDeprecatedStatus":                        schema_cluster_api_api_core_v1beta2_MachineDeploymentDeprecatedStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentList":                                    schema_cluster_api_api_core_v1beta2_MachineDeploymentList(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentPowerStateScheduleSpec":                  schema_cluster_api_api_core_v1beta2_MachineDeploymentPowerStateScheduleSpec(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRebalanceSpec":                           schema_cluster_api_api_core_v1beta2_MachineDeploymentRebalanceSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRemediationSpec":                         schema_cluster_api_api_core_v1beta2_MachineDeploymentRemediationSpec(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutRollbackSpec":                     schema_cluster_api_api_core_v1beta2_MachineDeploymentRolloutRollbackSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutSpec":                             schema_cluster_api_api_core_v1beta2_MachineDeploymentRolloutSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutStrategy":                         schema_cluster_api_api_core_v1beta2_MachineDeploymentRolloutStrategy(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutStrategyRollingUpdate":            schema_cluster_api_api_core_v1beta2_MachineDeploymentRolloutStrategyRollingUpdate(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentSpec":                                    schema_cluster_api_api_core_v1beta2_MachineDeploymentSpec(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentStandbySpec":                             schema_cluster_api_api_core_v1beta2_MachineDeploymentStandbySpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentStatus":                                  schema_cluster_api_api_core_v1beta2_MachineDeploymentStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentTopology":                                schema_cluster_api_api_core_v1beta2_MachineDeploymentTopology(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentTopologyHealthCheck":                     schema_cluster_api_api_core_v1beta2_MachineDeploymentTopologyHealthCheck(ref),
@@ -128,16 +151,22 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckChecks":                                 schema_cluster_api_api_core_v1beta2_MachineHealthCheckChecks(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckDeprecatedStatus":                       schema_cluster_api_api_core_v1beta2_MachineHealthCheckDeprecatedStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckList":                                   schema_cluster_api_api_core_v1beta2_MachineHealthCheckList(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckNodeProbe":                              schema_cluster_api_api_core_v1beta2_MachineHealthCheckNodeProbe(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediation":                            schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediation(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationCordon":                      schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediationCordon(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationReboot":                      schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediationReboot(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationTemplateReference":           schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediationTemplateReference(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationTriggerIf":                   schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediationTriggerIf(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckSpec":                                   schema_cluster_api_api_core_v1beta2_MachineHealthCheckSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckStatus":                                 schema_cluster_api_api_core_v1beta2_MachineHealthCheckStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckV1Beta1DeprecatedStatus":                schema_cluster_api_api_core_v1beta2_MachineHealthCheckV1Beta1DeprecatedStatus(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineInfrastructureOverride":                            schema_cluster_api_api_core_v1beta2_MachineInfrastructureOverride(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineInitializationStatus":                              schema_cluster_api_api_core_v1beta2_MachineInitializationStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineList":                                              schema_cluster_api_api_core_v1beta2_MachineList(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNamingSpec":                                        schema_cluster_api_api_core_v1beta2_MachineNamingSpec(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNodeReadinessCheck":                                schema_cluster_api_api_core_v1beta2_MachineNodeReadinessCheck(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNodeReference":                                     schema_cluster_api_api_core_v1beta2_MachineNodeReference(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNodeVolumeDetachExclusions":                        schema_cluster_api_api_core_v1beta2_MachineNodeVolumeDetachExclusions(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePool":                                              schema_cluster_api_api_core_v1beta2_MachinePool(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolClass":                                         schema_cluster_api_api_core_v1beta2_MachinePoolClass(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolClassBootstrapTemplate":                        schema_cluster_api_api_core_v1beta2_MachinePoolClassBootstrapTemplate(ref),
@@ -147,18 +176,23 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolDeprecatedStatus":                              schema_cluster_api_api_core_v1beta2_MachinePoolDeprecatedStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolInitializationStatus":                          schema_cluster_api_api_core_v1beta2_MachinePoolInitializationStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolList":                                          schema_cluster_api_api_core_v1beta2_MachinePoolList(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolMachinesSpec":                                  schema_cluster_api_api_core_v1beta2_MachinePoolMachinesSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolSpec":                                          schema_cluster_api_api_core_v1beta2_MachinePoolSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolStatus":                                        schema_cluster_api_api_core_v1beta2_MachinePoolStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolTopology":                                      schema_cluster_api_api_core_v1beta2_MachinePoolTopology(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolTopologyMachineDeletionSpec":                   schema_cluster_api_api_core_v1beta2_MachinePoolTopologyMachineDeletionSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolV1Beta1DeprecatedStatus":                       schema_cluster_api_api_core_v1beta2_MachinePoolV1Beta1DeprecatedStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolVariables":                                     schema_cluster_api_api_core_v1beta2_MachinePoolVariables(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePowerStateWindow":                                  schema_cluster_api_api_core_v1beta2_MachinePowerStateWindow(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineProvisioningFailure":                               schema_cluster_api_api_core_v1beta2_MachineProvisioningFailure(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineReadinessGate":                                     schema_cluster_api_api_core_v1beta2_MachineReadinessGate(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSet":                                               schema_cluster_api_api_core_v1beta2_MachineSet(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetDeletionSpec":                                   schema_cluster_api_api_core_v1beta2_MachineSetDeletionSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetDeprecatedStatus":                               schema_cluster_api_api_core_v1beta2_MachineSetDeprecatedStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetList":                                           schema_cluster_api_api_core_v1beta2_MachineSetList(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetRebalanceSpec":                                  schema_cluster_api_api_core_v1beta2_MachineSetRebalanceSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetSpec":                                           schema_cluster_api_api_core_v1beta2_MachineSetSpec(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetStandbySpec":                                    schema_cluster_api_api_core_v1beta2_MachineSetStandbySpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetStatus":                                         schema_cluster_api_api_core_v1beta2_MachineSetStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetV1Beta1DeprecatedStatus":                        schema_cluster_api_api_core_v1beta2_MachineSetV1Beta1DeprecatedStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSpec":                                              schema_cluster_api_api_core_v1beta2_MachineSpec(ref),
@@ -176,6 +210,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.Topology":                                                 schema_cluster_api_api_core_v1beta2_Topology(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyMachineCondition":                                schema_cluster_api_api_core_v1beta2_UnhealthyMachineCondition(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeCondition":                                   schema_cluster_api_api_core_v1beta2_UnhealthyNodeCondition(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeConditionSlidingWindow":                      schema_cluster_api_api_core_v1beta2_UnhealthyNodeConditionSlidingWindow(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ValidationRule":                                           schema_cluster_api_api_core_v1beta2_ValidationRule(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.VariableSchema":                                           schema_cluster_api_api_core_v1beta2_VariableSchema(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.VariableSchemaMetadata":                                   schema_cluster_api_api_core_v1beta2_VariableSchemaMetadata(ref),
@@ -1056,9 +1091,111 @@ func schema_cluster_api_api_core_v1beta2_ClusterControlPlaneStatus(ref common.Re
 							Format:      "int32",
 						},
 					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "version is the Kubernetes version reported by the control plane in status.version. When the Cluster is using a managed topology, comparing this value with spec.topology.version surfaces the version drift of the control plane, e.g. during upgrades.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterDegradedFreezeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterDegradedFreezeSpec defines when automated disruptive actions on a Cluster must be frozen.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"unhealthyMachinesThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "unhealthyMachinesThreshold is the number of Machines with the HealthCheckSucceeded condition set to False, e.g. Machines created by a rollout which never became healthy or Machines which could not be remediated, at which the DegradedFreeze condition is set to True. While the DegradedFreeze condition is True, MachineHealthChecks do not trigger remediation, MachineDeployments do not roll out, and KubeadmControlPlane neither rolls out nor remediates Machines. The DegradedFreeze condition stays True until the freeze is acknowledged by adding the cluster.x-k8s.io/degraded-freeze-acknowledged annotation to the Cluster; the annotation is removed by the Cluster controller as soon as the number of unhealthy Machines is below the threshold again.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterDeletionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterDeletionSpec contains configuration options for the deletion of a Cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phaseTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "phaseTimeoutSeconds is the amount of time each phase of the Cluster deletion, i.e. deleting the workers, deleting the control plane and deleting the infrastructure cluster, is expected to take. When a phase takes longer, it is reported as timed out in status.deletion, in the Deleting condition and with an event, so stuck deletions can be identified; the deletion keeps waiting for the phase to complete. The default value is 0, meaning that phases are never reported as timed out.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterDeletionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterDeletionStatus is the deletion state of the Cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "phase is the current phase of the Cluster deletion.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phaseStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "phaseStartTime is the time when the current phase started and is used to determine if the phaseTimeoutSeconds is exceeded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"phaseTimedOut": {
+						SchemaProps: spec.SchemaProps{
+							Description: "phaseTimedOut is true when the current phase takes longer than spec.deletion.phaseTimeoutSeconds.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"pendingObjects": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "pendingObjects is a summary of the objects the current phase is waiting for to be deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1083,6 +1220,134 @@ func schema_cluster_api_api_core_v1beta2_ClusterDeprecatedStatus(ref common.Refe
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_ClusterFailureDomainSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterFailureDomainSummary reports the number of machines in a failure domain.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the failure domain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "machines is the number of machines in this failure domain. NOTE: machines also includes machines still being provisioned or being deleted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyMachines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "readyMachines is the number of ready machines in this failure domain. A machine is considered ready when Machine's Ready condition is true.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"controlPlaneMachines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "controlPlaneMachines is the number of control plane machines in this failure domain. NOTE: controlPlaneMachines also includes machines still being provisioned or being deleted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyControlPlaneMachines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "readyControlPlaneMachines is the number of ready control plane machines in this failure domain. A machine is considered ready when Machine's Ready condition is true.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterFailureDomainsSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterFailureDomainsSummary groups the observations about how Machines are spread across failure domains.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"controlPlaneSkew": {
+						SchemaProps: spec.SchemaProps{
+							Description: "controlPlaneSkew is the difference between the highest and the lowest number of control plane machines across the failure domains suitable for control plane machines; a value greater than one usually indicates that control plane machines are not evenly spread, e.g. after a remediation or a scale down. This field is not set if there are no failure domains suitable for control plane machines or no control plane machines.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failureDomains": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "failureDomains reports the number of machines in each failure domain; it includes all the failure domains in status.failureDomains and the failure domains of existing machines, if not already included. Machines without a failure domain are not counted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterFailureDomainSummary"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterFailureDomainSummary"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterIdentityReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterIdentityReference is a reference to the credentials used to manage the infrastructure of a Cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "kind of the resource being referenced, e.g. Secret. kind must consist of alphanumeric characters or '-', start with an alphabetic character, and end with an alphanumeric character.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the resource being referenced. Namespaced resources are looked up in the namespace of the Cluster; cross-namespace references are not supported. name must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "apiGroup is the group of the resource being referenced. If not set, the resource being referenced must be a Secret. For other groups, the corresponding version for this reference will be looked up from the contract labels of the corresponding CRD of the resource being referenced.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_ClusterInitializationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1204,6 +1469,77 @@ func schema_cluster_api_api_core_v1beta2_ClusterNetwork(ref common.ReferenceCall
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_ClusterOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterOperation is a significant operation observed on the Cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "type is the type of the operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "message is a human-readable description of the operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "time is the time when the operation has been observed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"type", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterPauseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterPauseSpec defines additional information about a Cluster pause.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "reason is a human-readable explanation of why the Cluster is paused. The reason is surfaced in the Paused condition of the Cluster and of all its associated objects.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requestedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "requestedBy identifies who paused the Cluster, e.g. a user or a system.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expireAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "expireAfter is the time after which the pause is automatically cleared by the Cluster controller, by setting paused to false and by removing the pause field. If not set, the pause has to be cleared manually. Example: In the YAML the time can be specified in the RFC3339 format. To specify the expireAfter target as March 9, 2023, at 9 am UTC use \"2023-03-09T09:00:00Z\".",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_ClusterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1218,6 +1554,13 @@ func schema_cluster_api_api_core_v1beta2_ClusterSpec(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"pause": {
+						SchemaProps: spec.SchemaProps{
+							Description: "pause provides additional information about why the Cluster is paused, and when the pause must be cleared automatically. It can only be set when paused is set to true, and it is removed when the Cluster is unpaused.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterPauseSpec"),
+						},
+					},
 					"clusterNetwork": {
 						SchemaProps: spec.SchemaProps{
 							Description: "clusterNetwork represents the cluster network configuration.",
@@ -1246,92 +1589,678 @@ func schema_cluster_api_api_core_v1beta2_ClusterSpec(ref common.ReferenceCallbac
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ContractVersionedObjectReference"),
 						},
 					},
-					"topology": {
+					"topology": {
+						SchemaProps: spec.SchemaProps{
+							Description: "topology encapsulates the topology for the cluster. NOTE: It is required to enable the ClusterTopology feature gate flag to activate managed topologies support; this feature is highly experimental, and parts of it might still be not implemented.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.Topology"),
+						},
+					},
+					"availabilityGates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"conditionType",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "availabilityGates specifies additional conditions to include when evaluating Cluster Available condition.\n\nIf this field is not defined and the Cluster implements a managed topology, availabilityGates from the corresponding ClusterClass will be used, if any.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterAvailabilityGate"),
+									},
+								},
+							},
+						},
+					},
+					"degradedFreeze": {
+						SchemaProps: spec.SchemaProps{
+							Description: "degradedFreeze configures when automated disruptive actions on the Cluster, like rollouts and remediation, must be frozen after repeated failures, e.g. to prevent cascading damage caused by a bad template.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDegradedFreezeSpec"),
+						},
+					},
+					"deletion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "deletion contains configuration options for the deletion of the Cluster.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDeletionSpec"),
+						},
+					},
+					"identityRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "identityRef is an optional reference to the credentials that infrastructure providers should use to manage the infrastructure of the Cluster, e.g. a Secret or a provider-specific identity. Cluster API does not use the referenced credentials; it validates the reference and ensures the referenced object is moved together with the Cluster by clusterctl move. Infrastructure providers supporting this field should prefer it over provider-specific identity references in the InfraCluster, if any.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterIdentityReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.APIEndpoint", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterAvailabilityGate", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDegradedFreezeSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDeletionSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterIdentityReference", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterNetwork", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterPauseSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.ContractVersionedObjectReference", "sigs.k8s.io/cluster-api/api/core/v1beta2.Topology"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterStatus defines the observed state of Cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "conditions represents the observations of a Cluster's current state. Known condition types are Available, InfrastructureReady, ControlPlaneInitialized, ControlPlaneAvailable, WorkersAvailable, MachinesReady MachinesUpToDate, RemoteConnectionProbe, ScalingUp, ScalingDown, Remediating, Deleting, Paused. Additionally, a TopologyReconciled condition will be added in case the Cluster is referencing a ClusterClass / defining a managed Topology.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"initialization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "initialization provides observations of the Cluster initialization process. NOTE: Fields in this struct are part of the Cluster API contract and are used to orchestrate initial Cluster provisioning.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterInitializationStatus"),
+						},
+					},
+					"controlPlane": {
+						SchemaProps: spec.SchemaProps{
+							Description: "controlPlane groups all the observations about Cluster's ControlPlane current state.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterControlPlaneStatus"),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "workers groups all the observations about Cluster's Workers current state.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersStatus"),
+						},
+					},
+					"failureDomains": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "failureDomains is a slice of failure domain objects synced from the infrastructure provider.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.FailureDomain"),
+									},
+								},
+							},
+						},
+					},
+					"failureDomainsSummary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "failureDomainsSummary groups the observations about how Machines are spread across failure domains.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterFailureDomainsSummary"),
+						},
+					},
+					"topology": {
+						SchemaProps: spec.SchemaProps{
+							Description: "topology groups the observations about the reconciliation of the managed topology. This field is set only if the Cluster is referencing a ClusterClass / defining a managed Topology.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTopologyStatus"),
+						},
+					},
+					"lastOperations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "lastOperations is a bounded history of the significant operations observed on the Cluster, e.g. upgrades, scale events, remediations and moves, from the oldest to the most recent. When the history is full, the oldest operation is dropped to make room for a new one. NOTE: The history is not preserved when the Cluster is moved to another management cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterOperation"),
+									},
+								},
+							},
+						},
+					},
+					"deletion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "deletion reports the progress of the Cluster deletion. Only present when the Cluster has a deletionTimestamp.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDeletionStatus"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "phase represents the current phase of cluster actuation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "observedGeneration is the latest generation observed by the controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"deprecated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDeprecatedStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterControlPlaneStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDeletionStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDeprecatedStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterFailureDomainsSummary", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterInitializationStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterOperation", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTopologyStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.FailureDomain", "sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersStatus"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterTemplate is the Schema for the clustertemplates API. A ClusterTemplate defines a parametrized set of objects, which can be instantiated with a ClusterTemplateInstance to create a Cluster.\n\nNOTE: This CRD can only be used if the ClusterTemplate feature gate is enabled.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "metadata is the standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "spec is the desired state of ClusterTemplate.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateSpec"),
+						},
+					},
+				},
+				Required: []string{"metadata", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateSpec"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterTemplateInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterTemplateInstance is the Schema for the clustertemplateinstances API. A ClusterTemplateInstance instantiates a ClusterTemplate with a set of parameter values.\n\nNOTE: This CRD can only be used if the ClusterTemplate feature gate is enabled.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "metadata is the standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "spec is the desired state of ClusterTemplateInstance.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateInstanceSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "status is the observed state of ClusterTemplateInstance.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateInstanceStatus"),
+						},
+					},
+				},
+				Required: []string{"metadata", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateInstanceSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateInstanceStatus"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterTemplateInstanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterTemplateInstanceList contains a list of ClusterTemplateInstances.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "metadata is the standard list's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "items is the list of ClusterTemplateInstances.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateInstance"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateInstance"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterTemplateInstanceParameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterTemplateInstanceParameter defines the value of a ClusterTemplate parameter.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the parameter.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "value of the parameter. The value must be compatible with the type of the parameter defined in the ClusterTemplate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterTemplateInstanceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterTemplateInstanceSpec defines the desired state of a ClusterTemplateInstance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterTemplateName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "clusterTemplateName is the name of the ClusterTemplate to be instantiated. The ClusterTemplate must exist in the namespace of the ClusterTemplateInstance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "clusterName is the name of the Cluster to be created. It is available in the ClusterTemplate as the built-in parameter CLUSTER_NAME.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parameters": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "parameters are the values for the parameters of the ClusterTemplate.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateInstanceParameter"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"clusterTemplateName", "clusterName"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateInstanceParameter"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterTemplateInstanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterTemplateInstanceStatus defines the observed state of a ClusterTemplateInstance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "conditions represents the observations of a ClusterTemplateInstance's current state. Known condition types are Instantiated and Paused.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "observedGeneration is the latest generation observed by the controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterTemplateList contains a list of ClusterTemplates.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "metadata is the standard list's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "items is the list of ClusterTemplates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplate"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplate"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterTemplateParameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterTemplateParameter defines a typed parameter of a ClusterTemplate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the parameter.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "description is a human-readable description of the parameter.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "type of the parameter. Can be either \"String\", \"Integer\" or \"Boolean\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"required": {
+						SchemaProps: spec.SchemaProps{
+							Description: "required specifies if a value for the parameter must be provided when instantiating the ClusterTemplate. A required parameter cannot have a default.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"default": {
 						SchemaProps: spec.SchemaProps{
-							Description: "topology encapsulates the topology for the cluster. NOTE: It is required to enable the ClusterTopology feature gate flag to activate managed topologies support; this feature is highly experimental, and parts of it might still be not implemented.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.Topology"),
+							Description: "default is the value used for the parameter if no value is provided when instantiating the ClusterTemplate.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"availabilityGates": {
+				},
+				Required: []string{"name", "type"},
+			},
+		},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterTemplateSpec defines the desired state of a ClusterTemplate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"parameters": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-map-keys": []interface{}{
-									"conditionType",
+									"name",
 								},
 								"x-kubernetes-list-type": "map",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "availabilityGates specifies additional conditions to include when evaluating Cluster Available condition.\n\nIf this field is not defined and the Cluster implements a managed topology, availabilityGates from the corresponding ClusterClass will be used, if any.",
+							Description: "parameters defines the typed parameters of the template. Parameters are referenced in the template using the ${NAME} syntax. The parameters CLUSTER_NAME and NAMESPACE are built-in and cannot be defined here.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterAvailabilityGate"),
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateParameter"),
 									},
 								},
 							},
 						},
 					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "template is a multi-document YAML with the objects to be created when the ClusterTemplate is instantiated, e.g. the Cluster, the InfrastructureCluster, the control plane and the MachineDeployments. The template must contain a Cluster named ${CLUSTER_NAME}; all the objects are created in the namespace of the ClusterTemplateInstance. Objects are created by the Cluster API controller, so the template can only contain Clusters, MachineDeployments, MachineHealthChecks, MachinePools, ConfigMaps and objects of the infrastructure.cluster.x-k8s.io, bootstrap.cluster.x-k8s.io and controlplane.cluster.x-k8s.io API groups; Secrets and ClusterResourceSets are only allowed if enabled with the --clustertemplate-allow-secrets-and-clusterresourcesets flag.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"template"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api/api/core/v1beta2.APIEndpoint", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterAvailabilityGate", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterNetwork", "sigs.k8s.io/cluster-api/api/core/v1beta2.ContractVersionedObjectReference", "sigs.k8s.io/cluster-api/api/core/v1beta2.Topology"},
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTemplateParameter"},
 	}
 }
 
-func schema_cluster_api_api_core_v1beta2_ClusterStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_cluster_api_api_core_v1beta2_ClusterTopologyAppliedPatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterStatus defines the observed state of Cluster.",
+				Description: "ClusterTopologyAppliedPatch reports the outcome of an external patch of the ClusterClass.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"type",
-								},
-								"x-kubernetes-list-type": "map",
-							},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the patch in the ClusterClass.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"generatePatchesExtension": {
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observations of a Cluster's current state. Known condition types are Available, InfrastructureReady, ControlPlaneInitialized, ControlPlaneAvailable, WorkersAvailable, MachinesReady MachinesUpToDate, RemoteConnectionProbe, ScalingUp, ScalingDown, Remediating, Deleting, Paused. Additionally, a TopologyReconciled condition will be added in case the Cluster is referencing a ClusterClass / defining a managed Topology.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
-									},
-								},
-							},
+							Description: "generatePatchesExtension is the name of the GeneratePatches extension called for this patch.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"initialization": {
+					"appliedPatches": {
 						SchemaProps: spec.SchemaProps{
-							Description: "initialization provides observations of the Cluster initialization process. NOTE: Fields in this struct are part of the Cluster API contract and are used to orchestrate initial Cluster provisioning.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterInitializationStatus"),
+							Description: "appliedPatches is the number of patches returned by the GeneratePatches extension and applied to the templates.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"controlPlane": {
+					"validateTopologyExtension": {
 						SchemaProps: spec.SchemaProps{
-							Description: "controlPlane groups all the observations about Cluster's ControlPlane current state.",
-							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterControlPlaneStatus"),
+							Description: "validateTopologyExtension is the name of the ValidateTopology extension called for this patch.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"workers": {
+					"validationResult": {
 						SchemaProps: spec.SchemaProps{
-							Description: "workers groups all the observations about Cluster's Workers current state.",
-							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersStatus"),
+							Description: "validationResult is the outcome of the ValidateTopology extension. This field is not set if the patch does not have a ValidateTopology extension, or if validation did not happen e.g. because a previous patch failed.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"failureDomains": {
+					"validationMessage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "validationMessage is the message returned by the ValidateTopology extension when validation failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterTopologyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterTopologyStatus groups the observations about the reconciliation of the managed topology.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastAppliedPatches": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-map-keys": []interface{}{
@@ -1341,43 +2270,23 @@ func schema_cluster_api_api_core_v1beta2_ClusterStatus(ref common.ReferenceCallb
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "failureDomains is a slice of failure domain objects synced from the infrastructure provider.",
+							Description: "lastAppliedPatches reports the external patches of the ClusterClass called during the last computation of the desired state of the managed topology, in the order in which they are defined in the ClusterClass. Inline patches are not reported.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.FailureDomain"),
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTopologyAppliedPatch"),
 									},
 								},
 							},
 						},
 					},
-					"phase": {
-						SchemaProps: spec.SchemaProps{
-							Description: "phase represents the current phase of cluster actuation.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "observedGeneration is the latest generation observed by the controller.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"deprecated": {
-						SchemaProps: spec.SchemaProps{
-							Description: "deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.",
-							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDeprecatedStatus"),
-						},
-					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterControlPlaneStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDeprecatedStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterInitializationStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.FailureDomain", "sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersStatus"},
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterTopologyAppliedPatch"},
 	}
 }
 
@@ -1844,6 +2753,13 @@ func schema_cluster_api_api_core_v1beta2_ControlPlaneClassNamingSpec(ref common.
 							Format:      "",
 						},
 					},
+					"machineInfrastructureTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "machineInfrastructureTemplate defines the template to use for generating the name of the InfrastructureMachineTemplate object of the control plane. If not defined, it will fallback to `{{ .cluster.name }}-{{ .random }}`. The template must contain `{{ .random }}`, because a new InfrastructureMachineTemplate is created with a new name every time the template is rotated. If the templated string exceeds 63 characters, it will be trimmed to 58 characters and will get concatenated with a random suffix of length 5. The templating mechanism provides the following arguments: * `.cluster.name`: The name of the cluster object. * `.random`: A random alphanumeric string, without vowels, of length 5.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1950,6 +2866,13 @@ func schema_cluster_api_api_core_v1beta2_ControlPlaneTopologyHealthCheck(ref com
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ControlPlaneTopologyHealthCheckRemediation"),
 						},
 					},
+					"overrideStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "overrideStrategy defines how checks and remediation are combined with the health check defined in the ClusterClass.\n\nIf set to Replace (default), checks and remediation are used instead of the corresponding fields in ClusterClass as soon as one of them is set.\n\nIf set to Merge, each field set in checks and remediation overrides the corresponding field in ClusterClass, while fields which are not set are inherited from ClusterClass, e.g. to only tune remediation.triggerIf.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2306,7 +3229,7 @@ func schema_cluster_api_api_core_v1beta2_JSONPatch(ref common.ReferenceCallback)
 					},
 					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "path defines the path of the patch. Note: Only the spec of a template can be patched, thus the path has to start with /spec/. Note: For now the only allowed array modifications are `append` and `prepend`, i.e.: * for op: `add`: only index 0 (prepend) and - (append) are allowed * for op: `replace` or `remove`: no indexes are allowed",
+							Description: "path defines the path of the patch. Note: Only the spec of a template can be patched, thus the path has to start with /spec/. Note: For now the only allowed array modifications are `append` and `prepend`, i.e.: * for op: `add`: only index 0 (prepend) and - (append) are allowed * for op: `replace` or `remove`: no indexes are allowed Note: Existing array items can be selected by key using a list item selector in the form `[key=value]`, e.g. `/spec/template/spec/files/[path=/etc/hosts]/content`; the selector is resolved to the index of the first item having the field `key` equal to `value` when the patch is applied. For op: `add` a list item selector can't be the last element of the path.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2747,6 +3670,13 @@ func schema_cluster_api_api_core_v1beta2_MachineDeletionSpec(ref common.Referenc
 							Format:      "int32",
 						},
 					},
+					"nodeVolumeDetachExclusions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "nodeVolumeDetachExclusions defines volumes the controller does not wait for to be detached before deleting the Node, e.g. node-local volumes which are never detached from the Node.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNodeVolumeDetachExclusions"),
+						},
+					},
 					"nodeDeletionTimeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
 							Description: "nodeDeletionTimeoutSeconds defines how long the controller will attempt to delete the Node that the Machine hosts after the Machine is marked for deletion. A duration of 0 will retry deletion indefinitely. Defaults to 10 seconds.",
@@ -2757,6 +3687,8 @@ func schema_cluster_api_api_core_v1beta2_MachineDeletionSpec(ref common.Referenc
 				},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNodeVolumeDetachExclusions"},
 	}
 }
 
@@ -2887,6 +3819,13 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentClass(ref common.Refer
 							Format:      "",
 						},
 					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "architecture is the CPU architecture of the machines. If set, the architecture is added as a label to the machines and to the corresponding nodes, and it is surfaced to the cluster autoscaler for scaling from zero; also, if the infrastructure machine template reports the architecture it supports via status.nodeInfo.architecture, the architecture must match. NOTE: This value can be overridden while defining a Cluster.Topology using this MachineDeploymentClass.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"naming": {
 						SchemaProps: spec.SchemaProps{
 							Description: "naming allows changing the naming pattern used when creating the MachineDeployment.",
@@ -3199,6 +4138,20 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentClassNamingSpec(ref co
 							Format:      "",
 						},
 					},
+					"infrastructureTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "infrastructureTemplate defines the template to use for generating the name of the InfrastructureMachineTemplate object. If not defined, it will fallback to `{{ .cluster.name }}-{{ .machineDeployment.topologyName }}-{{ .random }}`. The template must contain `{{ .random }}`, because a new InfrastructureMachineTemplate is created with a new name every time the template is rotated. If the templated string exceeds 63 characters, it will be trimmed to 58 characters and will get concatenated with a random suffix of length 5. The templating mechanism provides the following arguments: * `.cluster.name`: The name of the cluster object. * `.random`: A random alphanumeric string, without vowels, of length 5. * `.machineDeployment.topologyName`: The name of the MachineDeployment topology (Cluster.spec.topology.workers.machineDeployments[].name).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bootstrapTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "bootstrapTemplate defines the template to use for generating the name of the BootstrapTemplate object. If not defined, it will fallback to `{{ .cluster.name }}-{{ .machineDeployment.topologyName }}-{{ .random }}`. The template must contain `{{ .random }}`, because a new BootstrapTemplate is created with a new name every time the template is rotated. If the templated string exceeds 63 characters, it will be trimmed to 58 characters and will get concatenated with a random suffix of length 5. The templating mechanism provides the following arguments: * `.cluster.name`: The name of the cluster object. * `.random`: A random alphanumeric string, without vowels, of length 5. * `.machineDeployment.topologyName`: The name of the MachineDeployment topology (Cluster.spec.topology.workers.machineDeployments[].name).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -3376,6 +4329,75 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentList(ref common.Refere
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_MachineDeploymentPowerStateScheduleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentPowerStateScheduleSpec defines time windows during which the Machines of a MachineDeployment are stopped.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "timeZone is the IANA time zone name used to interpret the start and end of the windows, e.g. Europe/Rome. Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stoppedWindows": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "stoppedWindows are the daily time windows during which the Machines are stopped.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePowerStateWindow"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePowerStateWindow"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_MachineDeploymentRebalanceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentRebalanceSpec contains configuration options for rebalancing Machines across failure domains.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "policy defines if Machines are rebalanced across failure domains when their spread becomes skewed, e.g. after an outage of a failure domain. When set to Automatic, Machines in over-represented failure domains are deleted, and replaced with new Machines in the under-represented failure domains. Note: Rebalancing only applies to MachineDeployments spreading Machines across the failure domains defined in spec.template.spec.infrastructureOverrides, i.e. when spec.template.spec.failureDomain is not set. Defaults to \"Never\". Valid values are \"Never\", \"Automatic\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxUnavailable is the maximum number of Machines that can be unavailable while rebalancing; Machines which are not available for other reasons, e.g. because they are still provisioning, are counted as well. Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%), which is calculated by rounding down. Defaults to 1; if a percentage is resolved to 0, 1 is used.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_MachineDeploymentRemediationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3397,6 +4419,26 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentRemediationSpec(ref co
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_MachineDeploymentRolloutRollbackSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentRolloutRollbackSpec defines how to proceed when a rollout fails.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "policy defines how to proceed when the new MachineSet does not become available within machineSetReadyTimeoutSeconds. If set to Automatic, the new MachineSet is scaled down and the previous MachineSet is restored by setting the version, bootstrap, infrastructureRef and failureDomain of the MachineDeployment's template back to the values of the previous MachineSet; a RollbackPerformed condition is surfaced on the MachineDeployment. If set to Never, the rollout continues. Defaults to Never. Note: MachineDeployments managed by a ClusterClass cannot use the Automatic policy, because the topology controller owns the MachineDeployment's template and would revert the rollback.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_MachineDeploymentRolloutSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3417,11 +4459,47 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentRolloutSpec(ref common
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutStrategy"),
 						},
 					},
+					"machineSetReadyTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "machineSetReadyTimeoutSeconds is the maximum time in seconds a new MachineSet can take, from its creation, to have all the desired replicas available during a rollout. When the timeout expires, the rollout is considered failed and rollback.policy determines how to proceed. If not set, rollouts never fail. Note: This field is used only with the RollingUpdate rollout strategy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"rollback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "rollback defines how to proceed when a rollout fails.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutRollbackSpec"),
+						},
+					},
+					"nodeReadinessChecks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "nodeReadinessChecks defines DaemonSets which must have a ready Pod on the Node of each new Machine before the Machine is considered ready, and thus before it is counted as available and the rollout proceeds; this prevents capacity gaps for workloads depending e.g. on GPU drivers or CSI node plugins. If set, it overrides spec.template.spec.nodeReadinessChecks; it is propagated in-place to the MachineSets and Machines of the MachineDeployment, without triggering a rollout.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNodeReadinessCheck"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutStrategy"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutRollbackSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutStrategy", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNodeReadinessCheck"},
 	}
 }
 
@@ -3503,6 +4581,20 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentSpec(ref common.Refere
 							Format:      "int32",
 						},
 					},
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "minReplicas is the minimum number of desired machines. If set, replicas is defaulted to at least minReplicas; additionally replicas cannot be set to a lower value, e.g. by the Kubernetes autoscaler or by a scale command, and the min size annotation of the Kubernetes autoscaler cannot be set to a lower value.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxReplicas is the maximum number of desired machines. If set, replicas is defaulted to at most maxReplicas; additionally replicas cannot be set to a higher value, e.g. by the Kubernetes autoscaler or by a scale command, and the max size annotation of the Kubernetes autoscaler cannot be set to a higher value.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"rollout": {
 						SchemaProps: spec.SchemaProps{
 							Description: "rollout allows you to configure the behaviour of rolling updates to the MachineDeployment Machines. It allows you to require that all Machines are replaced after a certain time, and allows you to define the strategy used during rolling replacements.",
@@ -3545,6 +4637,27 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentSpec(ref common.Refere
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentDeletionSpec"),
 						},
 					},
+					"rebalance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "rebalance contains configuration options for rebalancing Machines across failure domains. This field is propagated to the MachineSets of the MachineDeployment; Machines are only rebalanced by the MachineSet with the most current revision.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRebalanceSpec"),
+						},
+					},
+					"standby": {
+						SchemaProps: spec.SchemaProps{
+							Description: "standby contains configuration options for standby Machines, i.e. spare Machines which are provisioned in advance and promoted to replace unhealthy Machines when remediation occurs. This field is propagated to the MachineSets of the MachineDeployment; standby Machines are only kept by the MachineSet with the most current revision. Note: This field requires the MachineSetStandby feature gate to be enabled.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentStandbySpec"),
+						},
+					},
+					"powerStateSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "powerStateSchedule defines time windows during which the Machines of the MachineDeployment are stopped, e.g. to stop the workers of dev clusters at night. During a window, Machines are stopped by setting spec.powerState to Stopped on MachineSets and Machines, without triggering a rollout; outside the windows, spec.template.spec.powerState is used.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentPowerStateScheduleSpec"),
+						},
+					},
 					"paused": {
 						SchemaProps: spec.SchemaProps{
 							Description: "paused indicates that the deployment is paused.",
@@ -3557,7 +4670,27 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentSpec(ref common.Refere
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentDeletionSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRemediationSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNamingSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineTemplateSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentDeletionSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentPowerStateScheduleSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRebalanceSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRemediationSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentStandbySpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNamingSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineTemplateSpec"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_MachineDeploymentStandbySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentStandbySpec contains configuration options for standby Machines.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "replicas is the number of standby Machines kept in addition to the desired replicas of the MachineDeployment. Standby Machines get the cluster.x-k8s.io/standby label and their Nodes get the node.cluster.x-k8s.io/standby:NoSchedule taint, so they do not run workloads. When an unhealthy Machine is remediated, an available standby Machine is promoted in its place by removing the label and the taint, and a new standby Machine is created. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -3632,6 +4765,13 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentStatus(ref common.Refe
 							Format:      "int32",
 						},
 					},
+					"stoppedReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "stoppedReplicas is the number of stopped replicas for this MachineDeployment. A machine is considered stopped when Machine's Stopped condition is true.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
 							Description: "phase represents the current phase of a MachineDeployment (ScalingUp, ScalingDown, Running, Failed, or Unknown).",
@@ -3688,6 +4828,13 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentTopology(ref common.Re
 							Format:      "",
 						},
 					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "architecture is the CPU architecture of the machines. If not set, the architecture from the corresponding MachineDeploymentClass will be used, if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"replicas": {
 						SchemaProps: spec.SchemaProps{
 							Description: "replicas is the number of worker nodes belonging to this set. If the value is nil, the MachineDeployment is created without the number of Replicas (defaulting to 1) and it's assumed that an external entity (like cluster autoscaler) is responsible for the management of this value.",
@@ -3789,6 +4936,13 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentTopologyHealthCheck(re
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentTopologyHealthCheckRemediation"),
 						},
 					},
+					"overrideStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "overrideStrategy defines how checks and remediation are combined with the health check defined in the ClusterClass.\n\nIf set to Replace (default), checks and remediation are used instead of the corresponding fields in ClusterClass as soon as one of them is set.\n\nIf set to Merge, each field set in checks and remediation overrides the corresponding field in ClusterClass, while fields which are not set are inherited from ClusterClass, e.g. to only tune remediation.triggerIf.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -4500,11 +5654,18 @@ func schema_cluster_api_api_core_v1beta2_MachineHealthCheckChecks(ref common.Ref
 							},
 						},
 					},
+					"nodeProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "nodeProbe configures an active probe of the Node from the management cluster, which is used as an additional signal to determine whether a machine is considered unhealthy. This allows to catch Nodes which are reported as Ready by the kubelet, but that cannot be reached anymore e.g. because of a network partition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckNodeProbe"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyMachineCondition", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeCondition"},
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckNodeProbe", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyMachineCondition", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeCondition"},
 	}
 }
 
@@ -4580,6 +5741,62 @@ func schema_cluster_api_api_core_v1beta2_MachineHealthCheckList(ref common.Refer
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_MachineHealthCheckNodeProbe(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineHealthCheckNodeProbe configures an active probe of the Node from the management cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "type of the probe, one of TCP, ICMP or KubeletHealthz. TCP and ICMP probes connect to the Node address directly from the management cluster, KubeletHealthz probes query the kubelet healthz endpoint via the workload cluster API server.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "port is the port used by TCP probes.\n\nDefaults to 10250, the kubelet port.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"addressType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "addressType is the type of the Node address used by TCP and ICMP probes.\n\nDefaults to InternalIP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "timeoutSeconds is the timeout of a single probe.\n\nDefaults to 5 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"periodSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "periodSeconds is how often the Node is probed.\n\nDefaults to 60 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"unhealthyTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "unhealthyTimeoutSeconds is the duration the probe must be failing for, after which the machine is considered unhealthy.\n\nDefaults to 180 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4601,11 +5818,74 @@ func schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediation(ref commo
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationTemplateReference"),
 						},
 					},
+					"reboot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "reboot configures if unhealthy Machines should be rebooted before triggering remediation.\n\nWhen set, the MachineHealthCheck controller requests a reboot of an unhealthy Machine by setting the `cluster.x-k8s.io/reboot-requested` annotation on it; infrastructure providers supporting instance restart are expected to reboot the corresponding instance once for each distinct value of the annotation. Only after maxAttempts reboots did not make the Machine healthy again, remediation is triggered via the owner of the Machine.\n\nNote: this field is ignored if templateRef is set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationReboot"),
+						},
+					},
+					"cordon": {
+						SchemaProps: spec.SchemaProps{
+							Description: "cordon configures if the Nodes of unhealthy Machines should be cordoned and drained while keeping the Machines for a limited time before triggering remediation, e.g. to investigate recurring Node failures.\n\nWhen set, the MachineHealthCheck controller requests to cordon an unhealthy Machine by setting the `cluster.x-k8s.io/cordon-requested` annotation on it; the Machine controller then cordons and drains the Machine's Node and reports progress in the Machine's Cordoned condition. Only after durationSeconds, remediation is triggered via the owner of the Machine. If reboot is set too, the Machine is cordoned only after all the reboot attempts did not make the Machine healthy again.\n\nNote: this field is ignored if templateRef is set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationCordon"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationTemplateReference", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationTriggerIf"},
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationCordon", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationReboot", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationTemplateReference", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationTriggerIf"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediationCordon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineHealthCheckRemediationCordon configures if the Nodes of unhealthy Machines should be cordoned and drained before triggering remediation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"durationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "durationSeconds is the time an unhealthy Machine is kept with its Node cordoned and drained, before triggering remediation via the owner of the Machine.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"durationSeconds"},
+			},
+		},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediationReboot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineHealthCheckRemediationReboot configures if unhealthy Machines should be rebooted before triggering remediation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxAttempts is the maximum number of reboots requested for an unhealthy Machine before triggering remediation via the owner of the Machine.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "timeoutSeconds is the time the MachineHealthCheck controller waits after requesting a reboot for the Machine to become healthy again, before considering the reboot attempt failed.\n\nDefaults to 5 minutes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"maxAttempts"},
+			},
+		},
 	}
 }
 
@@ -4836,6 +6116,35 @@ func schema_cluster_api_api_core_v1beta2_MachineHealthCheckV1Beta1DeprecatedStat
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_MachineInfrastructureOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineInfrastructureOverride defines overrides for the InfrastructureMachine of Machines in a failure domain.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"failureDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "failureDomain is the failure domain this override applies to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "spec is merged into the spec of the InfrastructureMachine using JSON merge patch semantics (RFC 7386). Note: We have to use apiextensionsv1.JSON instead of a custom JSON type, because controller-tools has a hard-coded schema for apiextensionsv1.JSON which cannot be produced by another type via controller-tools, i.e. it is not possible to have no type field. Ref: https://github.com/kubernetes-sigs/controller-tools/blob/d0e03a142d0ecdd5491593e941ee1d6b5d91dba6/pkg/crd/known_types.go#L106-L111",
+							Ref:         ref("k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON"),
+						},
+					},
+				},
+				Required: []string{"failureDomain", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON"},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_MachineInitializationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4923,14 +6232,51 @@ func schema_cluster_api_api_core_v1beta2_MachineNamingSpec(ref common.ReferenceC
 				Properties: map[string]spec.Schema{
 					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "template defines the template to use for generating the names of the Machine objects. If not defined, it will fallback to `{{ .machineSet.name }}-{{ .random }}`. If the generated name string exceeds 63 characters, it will be trimmed to 58 characters and will get concatenated with a random suffix of length 5. Length of the template string must not exceed 256 characters. The template allows the following variables `.cluster.name`, `.machineSet.name` and `.random`. The variable `.cluster.name` retrieves the name of the cluster object that owns the Machines being created. The variable `.machineSet.name` retrieves the name of the MachineSet object that owns the Machines being created. The variable `.random` is substituted with random alphanumeric string, without vowels, of length 5. This variable is required part of the template. If not provided, validation will fail.",
+							Description: "template defines the template to use for generating the names of the Machine objects. If not defined, it will fallback to `{{ .machineSet.name }}-{{ .random }}`. If the generated name string exceeds 63 characters, it will be trimmed to 58 characters and will get concatenated with a random suffix of length 5. Length of the template string must not exceed 256 characters. The template allows the following variables `.cluster.name`, `.machineSet.name`, `.predecessor.name` and `.random`. The variable `.cluster.name` retrieves the name of the cluster object that owns the Machines being created. The variable `.machineSet.name` retrieves the name of the MachineSet object that owns the Machines being created. The variable `.predecessor.name` retrieves the name of the Machine being replaced when a Machine is created as a consequence of remediation; it is empty otherwise, e.g. `{{ if .predecessor.name }}{{ .predecessor.name }}-r{{ else }}{{ .machineSet.name }}{{ end }}-{{ .random }}`. The template is validated both for new Machines and for Machines replacing a remediated Machine, so templates like `{{ .predecessor.name }}-{{ .random }}` are rejected because the names of new Machines would start with \"-\"; use the `{{ if .predecessor.name }}` pattern above instead. The variable `.random` is substituted with random alphanumeric string, without vowels, of length 5. This variable is required part of the template. If not provided, validation will fail.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_MachineNodeReadinessCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineNodeReadinessCheck defines DaemonSets which must have a ready Pod on the Machine's Node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the check; it is used to identify the check in the NodeReadinessChecksSucceeded condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "namespace of the DaemonSets. If not set, DaemonSets in all namespaces are selected.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "selector is a label query over the DaemonSets which must have a ready Pod on the Machine's Node. DaemonSets whose Pod template nodeSelector does not match the Node labels are ignored. If no DaemonSets match the selector, the check does not succeed; this allows to wait for DaemonSets which are deployed after the Node is created.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
 				},
+				Required: []string{"name", "selector"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -4955,6 +6301,59 @@ func schema_cluster_api_api_core_v1beta2_MachineNodeReference(ref common.Referen
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_MachineNodeVolumeDetachExclusions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineNodeVolumeDetachExclusions defines volumes the controller does not wait for to be detached.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageClassNames": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "storageClassNames is the list of StorageClasses whose PersistentVolumes are not waited for to be detached.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"csiDrivers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "csiDrivers is the list of CSI drivers whose volumes are not waited for to be detached.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_MachinePool(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5282,11 +6681,31 @@ func schema_cluster_api_api_core_v1beta2_MachinePoolList(ref common.ReferenceCal
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePool"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_MachinePoolMachinesSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachinePoolMachinesSpec configures the Machine objects representing the instances of the MachinePool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "policy defines if the infrastructure provider is required to support MachinePool Machines, i.e. to create an InfraMachine for each instance of the MachinePool and to report their kind in status.infrastructureMachineKind of the InfraMachinePool. If set to Required and the infrastructure provider does not support MachinePool Machines, the MachinesSupported condition is set to false on the MachinePool. Defaults to Optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePool"},
 	}
 }
 
@@ -5311,6 +6730,20 @@ func schema_cluster_api_api_core_v1beta2_MachinePoolSpec(ref common.ReferenceCal
 							Format:      "int32",
 						},
 					},
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "minReplicas is the minimum number of desired machines. If set, replicas is defaulted to at least minReplicas; additionally replicas cannot be set to a lower value, e.g. by the Kubernetes autoscaler or by a scale command, and the min size annotation of the Kubernetes autoscaler cannot be set to a lower value.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxReplicas is the maximum number of desired machines. If set, replicas is defaulted to at most maxReplicas; additionally replicas cannot be set to a higher value, e.g. by the Kubernetes autoscaler or by a scale command, and the max size annotation of the Kubernetes autoscaler cannot be set to a higher value.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"template": {
 						SchemaProps: spec.SchemaProps{
 							Description: "template describes the machines that will be created.",
@@ -5358,12 +6791,19 @@ func schema_cluster_api_api_core_v1beta2_MachinePoolSpec(ref common.ReferenceCal
 							},
 						},
 					},
+					"machines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "machines configures the Machine objects representing the instances of the MachinePool.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolMachinesSpec"),
+						},
+					},
 				},
 				Required: []string{"clusterName", "template"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineTemplateSpec"},
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolMachinesSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineTemplateSpec"},
 	}
 }
 
@@ -5450,6 +6890,13 @@ func schema_cluster_api_api_core_v1beta2_MachinePoolStatus(ref common.ReferenceC
 							Format:      "int32",
 						},
 					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "selector is the label selector of the Machines and Nodes belonging to this MachinePool in string format to avoid introspection by clients. The string will be in the same format as the query-param syntax. This is necessary for the scale subresource, e.g. when the MachinePool is autoscaled. More info about label selectors: http://kubernetes.io/docs/user-guide/labels#label-selectors",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
 							Description: "phase represents the current phase of cluster actuation.",
@@ -5698,6 +7145,97 @@ func schema_cluster_api_api_core_v1beta2_MachinePoolVariables(ref common.Referen
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_MachinePowerStateWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachinePowerStateWindow defines a daily time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "start is the time of the day the window starts, in the format HH:MM.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "end is the time of the day the window ends, in the format HH:MM. If end is before start, the window ends on the next day, e.g. start 20:00 and end 07:00.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"days": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "days are the days of the week the window starts on, e.g. Monday; if not set, the window starts every day.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_MachineProvisioningFailure(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineProvisioningFailure describes a failure reported by the InfrastructureMachine while provisioning a Machine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "time is when the failure has been observed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"retryable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "retryable is true if the failure is transient and the infrastructure provider keeps trying to provision the Machine, e.g. because the quota of the infrastructure provider is exceeded; it is false if the failure is terminal, e.g. because the machine image does not exist, and the Machine must be replaced.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"code": {
+						SchemaProps: spec.SchemaProps{
+							Description: "code identifies the failure as reported by the InfrastructureMachine, i.e. the reason of its Ready condition or, for InfrastructureMachines implementing the v1beta1 contract, status.failureReason.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "message is a human readable message describing the failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"time", "retryable"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_MachineReadinessGate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5869,6 +7407,34 @@ func schema_cluster_api_api_core_v1beta2_MachineSetList(ref common.ReferenceCall
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_MachineSetRebalanceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineSetRebalanceSpec contains configuration options for rebalancing Machines across failure domains.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "policy defines if Machines are rebalanced across failure domains when their spread becomes skewed, e.g. after an outage of a failure domain. When set to Automatic, Machines in over-represented failure domains are deleted, and the MachineSet replaces them with new Machines in the under-represented failure domains. Note: Rebalancing only applies to MachineSets spreading Machines across the failure domains defined in spec.template.spec.infrastructureOverrides, i.e. when spec.template.spec.failureDomain is not set. Defaults to \"Never\". Valid values are \"Never\", \"Automatic\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxUnavailable is the maximum number of Machines that can be unavailable while rebalancing; Machines which are not available for other reasons, e.g. because they are still provisioning, are counted as well. Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%), which is calculated by rounding down. Defaults to 1; if a percentage is resolved to 0, 1 is used.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_MachineSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5918,12 +7484,46 @@ func schema_cluster_api_api_core_v1beta2_MachineSetSpec(ref common.ReferenceCall
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetDeletionSpec"),
 						},
 					},
+					"rebalance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "rebalance contains configuration options for rebalancing Machines across failure domains.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetRebalanceSpec"),
+						},
+					},
+					"standby": {
+						SchemaProps: spec.SchemaProps{
+							Description: "standby contains configuration options for standby Machines, i.e. spare Machines which are provisioned in advance and promoted to replace unhealthy Machines when remediation occurs. Note: This field requires the MachineSetStandby feature gate to be enabled.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetStandbySpec"),
+						},
+					},
 				},
 				Required: []string{"clusterName", "selector", "template"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNamingSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetDeletionSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineTemplateSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNamingSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetDeletionSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetRebalanceSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetStandbySpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineTemplateSpec"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_MachineSetStandbySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineSetStandbySpec contains configuration options for standby Machines.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "replicas is the number of standby Machines kept in addition to the desired replicas of the MachineSet. Standby Machines are created like other Machines of the MachineSet, but they get the cluster.x-k8s.io/standby label and their Nodes get the node.cluster.x-k8s.io/standby:NoSchedule taint, so they do not run workloads. When an unhealthy Machine is remediated, an available standby Machine is promoted in its place by removing the label and the taint, and a new standby Machine is created. Note: If the MachineSet is owned by a MachineDeployment, standby Machines are only kept by the MachineSet with the most current revision. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -5991,6 +7591,13 @@ func schema_cluster_api_api_core_v1beta2_MachineSetStatus(ref common.ReferenceCa
 							Format:      "int32",
 						},
 					},
+					"stoppedReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "stoppedReplicas is the number of stopped replicas for this MachineSet. A machine is considered stopped when Machine's Stopped condition is true.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
 							Description: "observedGeneration reflects the generation of the most recently observed MachineSet.",
@@ -6135,6 +7742,13 @@ func schema_cluster_api_api_core_v1beta2_MachineSpec(ref common.ReferenceCallbac
 							Format:      "int32",
 						},
 					},
+					"infrastructureReadyTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "infrastructureReadyTimeoutSeconds is the maximum number of seconds, measured from the Machine's creation, for the InfrastructureMachine to report it is provisioned. When the timeout expires, the Machine controller classifies the failure based on the reason reported by the InfrastructureMachine, and it surfaces it in the Machine's InfrastructureProvisioningFailed condition, with reason QuotaExceeded, ImageNotFound or Unknown. The Machine is not deleted nor remediated by the Machine controller; owners of the Machine, e.g. MachineHealthChecks via spec.checks.unhealthyMachineConditions, can use the condition to decide if the Machine should be replaced. NOTE: This field is propagated in-place from MachineDeployments and MachineSets to their Machines, without triggering a rollout. If not set, the Machine controller waits indefinitely for the InfrastructureMachine to be provisioned.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"readinessGates": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -6164,6 +7778,42 @@ func schema_cluster_api_api_core_v1beta2_MachineSpec(ref common.ReferenceCallbac
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeletionSpec"),
 						},
 					},
+					"kubeletServingCertificateVerification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "kubeletServingCertificateVerification defines if the serving certificate of the kubelet running on the Machine's Node must be verified before considering the Machine ready. When set to Enabled, the Machine controller looks up the latest approved CertificateSigningRequest with signer kubernetes.io/kubelet-serving for the Node in the workload cluster, and it checks that the issued certificate is signed by the cluster CA and it is not expired; the result is surfaced in the KubeletServingCertificateVerified condition, which is then included when computing the Machine's Ready condition. This prevents rollouts from completing onto Nodes where the kubelet serving certificate bootstrap is broken. NOTE: This requires the kubelet to request its serving certificate via the certificates API, e.g. by setting serverTLSBootstrap in the kubelet configuration. NOTE: The certificate is verified only once, before the Machine becomes ready for the first time. Defaults to Disabled.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeReadinessChecks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "nodeReadinessChecks defines DaemonSets which must have a ready Pod on the Machine's Node before considering the Machine ready, e.g. GPU drivers or CSI node plugins that workloads depend on. When set, the Machine controller checks the DaemonSets in the workload cluster and it surfaces the result in the NodeReadinessChecksSucceeded condition, which is then included when computing the Machine's Ready condition. This prevents rollouts from removing old Machines before the DaemonSets are running on the new Machines. NOTE: The checks are performed only once, before the Machine becomes ready for the first time. NOTE: This field is propagated in-place from MachineDeployments and MachineSets to their Machines, without triggering a rollout; for MachineDeployments, it is set from spec.rollout.nodeReadinessChecks if defined.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNodeReadinessCheck"),
+									},
+								},
+							},
+						},
+					},
+					"powerState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "powerState is the desired power state of the Machine, either Running or Stopped. When set to Stopped, the infrastructure provider stops the machine without deleting it, and it starts the machine again when set back to Running; this allows e.g. to hibernate Machines of dev clusters at night. The power state observed by the infrastructure provider is surfaced in the Machine's Stopped condition; stopped Machines are not ready, but they are not considered unhealthy by MachineHealthChecks. NOTE: Stopping a Machine requires support by the infrastructure provider; Nodes are not drained before stopping. NOTE: This field is propagated in-place from MachineDeployments and MachineSets to their Machines, without triggering a rollout; it is ignored for MachinePools. Defaults to Running.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"taints": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -6187,12 +7837,34 @@ func schema_cluster_api_api_core_v1beta2_MachineSpec(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"infrastructureOverrides": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"failureDomain",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "infrastructureOverrides are overrides for the InfrastructureMachine, keyed by failure domain. When an InfrastructureMachine is created from the template referenced by infrastructureRef for a Machine in a failure domain with a matching entry, the spec of the entry is merged into the spec of the InfrastructureMachine. This allows e.g. to use zone-specific instance types or subnets without requiring a MachineDeployment per zone.\n\nWhen failureDomain is not set, Machines are spread across the failure domains defined in infrastructureOverrides.\n\nNOTE: This field is only used by the MachineSet controller when creating InfrastructureMachines for MachineDeployments and MachineSets; it is ignored for Machines and MachinePools.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineInfrastructureOverride"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"clusterName", "bootstrap", "infrastructureRef"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api/api/core/v1beta2.Bootstrap", "sigs.k8s.io/cluster-api/api/core/v1beta2.ContractVersionedObjectReference", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeletionSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineReadinessGate", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineTaint"},
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.Bootstrap", "sigs.k8s.io/cluster-api/api/core/v1beta2.ContractVersionedObjectReference", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeletionSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineInfrastructureOverride", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNodeReadinessCheck", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineReadinessGate", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineTaint"},
 	}
 }
 
@@ -6291,6 +7963,12 @@ func schema_cluster_api_api_core_v1beta2_MachineStatus(ref common.ReferenceCallb
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeletionStatus"),
 						},
 					},
+					"lastProvisioningFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "lastProvisioningFailure describes the last failure reported by the InfrastructureMachine while provisioning the Machine, and whether the failure is transient or terminal. It is removed once the InfrastructureMachine is provisioned.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineProvisioningFailure"),
+						},
+					},
 					"deprecated": {
 						SchemaProps: spec.SchemaProps{
 							Description: "deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.",
@@ -6301,7 +7979,7 @@ func schema_cluster_api_api_core_v1beta2_MachineStatus(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.NodeSystemInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineAddress", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeletionStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeprecatedStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineInitializationStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNodeReference"},
+			"k8s.io/api/core/v1.NodeSystemInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineAddress", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeletionStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeprecatedStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineInitializationStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineNodeReference", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineProvisioningFailure"},
 	}
 }
 
@@ -6706,6 +8384,12 @@ func schema_cluster_api_api_core_v1beta2_Topology(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"rolloutAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "rolloutAfter is a field to indicate a rollout should be performed after the specified time even if no changes have been made to the Cluster, e.g. to refresh certificates or machine images. The rollout is first performed on the control plane; MachineDeployments are rolled out only after the control plane completed its rollout. NOTE: the ControlPlane provider must support the spec.rollout.after field (e.g. as in KubeadmControlPlane); MachinePools are not rolled out.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 					"controlPlane": {
 						SchemaProps: spec.SchemaProps{
 							Description: "controlPlane describes the cluster control plane.",
@@ -6720,6 +8404,26 @@ func schema_cluster_api_api_core_v1beta2_Topology(ref common.ReferenceCallback)
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersTopology"),
 						},
 					},
+					"failureDomains": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "failureDomains is the list of the failure domains the Cluster is expected to span, e.g. the regions or zones where the infrastructure provider should create infrastructure. It is surfaced to ClusterClass patches as the builtin.cluster.topology.failureDomains variable, so that the InfrastructureCluster can be composed from per-failure-domain fragments.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"variables": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -6747,7 +8451,7 @@ func schema_cluster_api_api_core_v1beta2_Topology(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassRef", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterVariable", "sigs.k8s.io/cluster-api/api/core/v1beta2.ControlPlaneTopology", "sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersTopology"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassRef", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterVariable", "sigs.k8s.io/cluster-api/api/core/v1beta2.ControlPlaneTopology", "sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersTopology"},
 	}
 }
 
@@ -6814,10 +8518,47 @@ func schema_cluster_api_api_core_v1beta2_UnhealthyNodeCondition(ref common.Refer
 							Format:      "int32",
 						},
 					},
+					"slidingWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "slidingWindow configures an additional policy to detect nodes flapping in and out of the given status, which could never match timeoutSeconds, e.g. a node which is NotReady for 9 minutes repeatedly with a timeout of 10 minutes. When the node has been in the given status for a cumulative time of at least cumulativeTimeoutSeconds within the last windowSeconds, the node is considered unhealthy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeConditionSlidingWindow"),
+						},
+					},
 				},
 				Required: []string{"type", "status", "timeoutSeconds"},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeConditionSlidingWindow"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_UnhealthyNodeConditionSlidingWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UnhealthyNodeConditionSlidingWindow configures the cumulative time a node can be in a given status within a time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"windowSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "windowSeconds is the duration of the sliding time window. For example, with a value of \"3600\", the time the node has been in the given status during the last hour is considered.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"cumulativeTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "cumulativeTimeoutSeconds is the cumulative duration that a node must be in a given status for within the time window, after which the node is considered unhealthy. For example, with a value of \"1200\", the node must match the status for at least 20 minutes in total within the time window before being considered unhealthy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"windowSeconds", "cumulativeTimeoutSeconds"},
+			},
+		},
 	}
 }
 
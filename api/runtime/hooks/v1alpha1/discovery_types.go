@@ -46,6 +46,53 @@ type DiscoveryResponse struct {
 	// +listMapKey=name
 	// +optional
 	Handlers []ExtensionHandler `json:"handlers,omitempty"`
+
+	// settingsSchema defines the schema of the settings accepted by the Extension.
+	// If set, the settings defined in the ExtensionConfig are validated against it before
+	// any ExtensionHandler is called.
+	// +optional
+	SettingsSchema *SettingsSchema `json:"settingsSchema,omitempty"`
+}
+
+// SettingsSchema defines the schema of the settings accepted by an Extension.
+type SettingsSchema struct {
+	// settings defines the settings accepted by the Extension.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Settings []SettingSchema `json:"settings,omitempty"`
+
+	// allowUnknownSettings defines if settings not defined in the schema are accepted.
+	// Defaults to false.
+	// +optional
+	AllowUnknownSettings bool `json:"allowUnknownSettings,omitempty"`
+}
+
+// SettingSchema defines the schema of a single setting accepted by an Extension.
+type SettingSchema struct {
+	// name is the name of the setting.
+	// +required
+	Name string `json:"name"`
+
+	// description is a human-readable description of the setting.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// required defines if the setting must be set.
+	// +optional
+	Required bool `json:"required,omitempty"`
+
+	// enum defines the allowed values of the setting.
+	// +optional
+	Enum []string `json:"enum,omitempty"`
+
+	// pattern defines a regular expression the value of the setting must match.
+	// +optional
+	Pattern string `json:"pattern,omitempty"`
+
+	// maxLength defines the maximum length of the value of the setting.
+	// +optional
+	MaxLength *int32 `json:"maxLength,omitempty"`
 }
 
 // ExtensionHandler represents the discovery information for an extension handler which includes
@@ -19,6 +19,8 @@ package v1alpha1
 import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
 )
 
@@ -40,4 +42,10 @@ func init() {
 	// Add Open API definitions for RuntimeHooks request and response types in this package
 	// NOTE: the GetOpenAPIDefinitions func is automatically generated by openapi-gen.
 	catalogBuilder.RegisterOpenAPIDefinitions(GetOpenAPIDefinitions)
+
+	// Add Open API definitions for the Cluster API and Kubernetes types used in RuntimeHooks request and response types,
+	// so the OpenAPI spec can be generated from a catalog without registering additional definitions.
+	catalogBuilder.RegisterOpenAPIDefinitions(clusterv1.GetOpenAPIDefinitions)
+	catalogBuilder.RegisterOpenAPIDefinitions(clusterv1beta1.GetOpenAPIDefinitions)
+	catalogBuilder.RegisterOpenAPIDefinitions(runtimecatalog.GetVendoredOpenAPIDefinitions)
 }
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+)
+
+// MachineRemediationAction is the action Cluster API is going to perform to remediate an unhealthy Machine.
+// +kubebuilder:validation:Enum=Reboot;Cordon;ExternalRemediation;OwnerRemediation;Delete
+type MachineRemediationAction string
+
+const (
+	// MachineRemediationActionReboot is used when the MachineHealthCheck is going to request a reboot of the Machine.
+	MachineRemediationActionReboot MachineRemediationAction = "Reboot"
+
+	// MachineRemediationActionCordon is used when the MachineHealthCheck is going to request to cordon and drain
+	// the Node of the Machine, keeping the Machine for investigation.
+	MachineRemediationActionCordon MachineRemediationAction = "Cordon"
+
+	// MachineRemediationActionExternalRemediation is used when the MachineHealthCheck is going to create
+	// an external remediation request for the Machine.
+	MachineRemediationActionExternalRemediation MachineRemediationAction = "ExternalRemediation"
+
+	// MachineRemediationActionOwnerRemediation is used when the MachineHealthCheck is going to mark the Machine
+	// for remediation by its owner, e.g. a MachineSet or a control plane provider.
+	MachineRemediationActionOwnerRemediation MachineRemediationAction = "OwnerRemediation"
+
+	// MachineRemediationActionDelete is used when the owner of the Machine, e.g. the KubeadmControlPlane,
+	// is going to delete the Machine to replace it with a new one.
+	MachineRemediationActionDelete MachineRemediationAction = "Delete"
+)
+
+// BeforeMachineRemediationRequest is the request of the BeforeMachineRemediation hook.
+// +kubebuilder:object:root=true
+type BeforeMachineRemediationRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// cluster is the Cluster the Machine belongs to.
+	// +required
+	Cluster clusterv1.Cluster `json:"cluster,omitempty,omitzero"`
+
+	// machine is the Machine that is going to be remediated.
+	// +required
+	Machine clusterv1.Machine `json:"machine,omitempty,omitzero"`
+
+	// unhealthyConditions are the conditions surfacing why the Machine is considered unhealthy,
+	// e.g. the HealthCheckSucceeded condition of the Machine as well as the Machine and Node conditions
+	// matching the checks of the MachineHealthCheck, when known.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=32
+	UnhealthyConditions []metav1.Condition `json:"unhealthyConditions,omitempty"`
+
+	// action is the action Cluster API is going to perform to remediate the Machine.
+	// +required
+	Action MachineRemediationAction `json:"action,omitempty"`
+}
+
+var _ RetryResponseObject = &BeforeMachineRemediationResponse{}
+
+// BeforeMachineRemediationResponse is the response of the BeforeMachineRemediation hook.
+// +kubebuilder:object:root=true
+type BeforeMachineRemediationResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// BeforeMachineRemediation is the hook that will be called before an unhealthy Machine is remediated.
+func BeforeMachineRemediation(*BeforeMachineRemediationRequest, *BeforeMachineRemediationResponse) {}
+
+func init() {
+	catalogBuilder.RegisterHook(BeforeMachineRemediation, &runtimecatalog.HookMeta{
+		Tags:    []string{"Lifecycle Hooks"},
+		Summary: "Cluster API Runtime will call this hook before an unhealthy Machine is remediated",
+		Description: "Cluster API Runtime will call this hook before the MachineHealthCheck triggers remediation of an unhealthy Machine, " +
+			"and before the KubeadmControlPlane deletes an unhealthy control plane Machine.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- The call's request contains the Cluster and the Machine objects, the conditions surfacing why the Machine is unhealthy " +
+			"and the remediation action that is going to be performed\n" +
+			"- The hook is called every time a new remediation action is going to be performed for a Machine; for control plane " +
+			"Machines it is called both when the MachineHealthCheck marks the Machine for remediation and before the KubeadmControlPlane deletes it\n" +
+			"- This is a blocking hook; Runtime Extension implementers can use this hook to delay remediation, e.g. during " +
+			"incident freeze windows, or to record audit events before the remediation happens",
+	})
+}
@@ -66,6 +66,15 @@ type ClusterBuiltins struct {
 	// network represents the cluster network variables.
 	// +optional
 	Network *ClusterNetworkBuiltins `json:"network,omitempty"`
+
+	// failureDomains are the failure domains of the Cluster, as reported by the InfrastructureCluster.
+	// +optional
+	FailureDomains []ClusterFailureDomainBuiltins `json:"failureDomains,omitempty"`
+
+	// controlPlaneEndpoint is the endpoint used to communicate with the control plane of the Cluster,
+	// e.g. a virtual IP announced by kube-vip on the control plane Machines.
+	// +optional
+	ControlPlaneEndpoint *ClusterControlPlaneEndpointBuiltins `json:"controlPlaneEndpoint,omitempty"`
 }
 
 // ClusterTopologyBuiltins represents builtin cluster topology variables.
@@ -94,6 +103,11 @@ type ClusterTopologyBuiltins struct {
 	//
 	// +optional
 	ClassNamespace string `json:"classNamespace,omitempty"`
+
+	// failureDomains is the list of the failure domains the Cluster is expected to span,
+	// as defined in Cluster.spec.topology.failureDomains.
+	// +optional
+	FailureDomains []string `json:"failureDomains,omitempty"`
 }
 
 // ClusterTopologyClusterClassRefBuiltins is the ref to the ClusterClass that is used for the topology.
@@ -122,6 +136,32 @@ type ClusterNetworkBuiltins struct {
 	Pods []string `json:"pods,omitempty"`
 }
 
+// ClusterFailureDomainBuiltins represents a builtin cluster failure domain variable.
+type ClusterFailureDomainBuiltins struct {
+	// name is the name of the failure domain.
+	// +required
+	Name string `json:"name"`
+
+	// controlPlane determines if this failure domain is suitable for use by control plane machines.
+	// +optional
+	ControlPlane *bool `json:"controlPlane,omitempty"`
+
+	// attributes is a free form map of attributes an infrastructure provider might use or require.
+	// +optional
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// ClusterControlPlaneEndpointBuiltins represents builtin cluster control plane endpoint variables.
+type ClusterControlPlaneEndpointBuiltins struct {
+	// host is the hostname or the IP address of the control plane endpoint.
+	// +required
+	Host string `json:"host"`
+
+	// port is the port of the control plane endpoint.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+}
+
 // ControlPlaneBuiltins represents builtin ControlPlane variables.
 // NOTE: These variables are only set for templates belonging to the ControlPlane object.
 type ControlPlaneBuiltins struct {
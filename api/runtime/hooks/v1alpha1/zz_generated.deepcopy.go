@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
@@ -473,6 +474,65 @@ func (in *BeforeControlPlaneUpgradeResponse) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeforeMachineRemediationRequest) DeepCopyInto(out *BeforeMachineRemediationRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	in.Machine.DeepCopyInto(&out.Machine)
+	if in.UnhealthyConditions != nil {
+		in, out := &in.UnhealthyConditions, &out.UnhealthyConditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeMachineRemediationRequest.
+func (in *BeforeMachineRemediationRequest) DeepCopy() *BeforeMachineRemediationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BeforeMachineRemediationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BeforeMachineRemediationRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeforeMachineRemediationResponse) DeepCopyInto(out *BeforeMachineRemediationResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonRetryResponse = in.CommonRetryResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeMachineRemediationResponse.
+func (in *BeforeMachineRemediationResponse) DeepCopy() *BeforeMachineRemediationResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(BeforeMachineRemediationResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BeforeMachineRemediationResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BeforeWorkersUpgradeRequest) DeepCopyInto(out *BeforeWorkersUpgradeRequest) {
 	*out = *in
@@ -726,13 +786,25 @@ func (in *ClusterBuiltins) DeepCopyInto(out *ClusterBuiltins) {
 	if in.Topology != nil {
 		in, out := &in.Topology, &out.Topology
 		*out = new(ClusterTopologyBuiltins)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Network != nil {
 		in, out := &in.Network, &out.Network
 		*out = new(ClusterNetworkBuiltins)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]ClusterFailureDomainBuiltins, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ControlPlaneEndpoint != nil {
+		in, out := &in.ControlPlaneEndpoint, &out.ControlPlaneEndpoint
+		*out = new(ClusterControlPlaneEndpointBuiltins)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuiltins.
@@ -745,6 +817,48 @@ func (in *ClusterBuiltins) DeepCopy() *ClusterBuiltins {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterControlPlaneEndpointBuiltins) DeepCopyInto(out *ClusterControlPlaneEndpointBuiltins) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterControlPlaneEndpointBuiltins.
+func (in *ClusterControlPlaneEndpointBuiltins) DeepCopy() *ClusterControlPlaneEndpointBuiltins {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterControlPlaneEndpointBuiltins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterFailureDomainBuiltins) DeepCopyInto(out *ClusterFailureDomainBuiltins) {
+	*out = *in
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterFailureDomainBuiltins.
+func (in *ClusterFailureDomainBuiltins) DeepCopy() *ClusterFailureDomainBuiltins {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterFailureDomainBuiltins)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterNetworkBuiltins) DeepCopyInto(out *ClusterNetworkBuiltins) {
 	*out = *in
@@ -779,6 +893,11 @@ func (in *ClusterNetworkBuiltins) DeepCopy() *ClusterNetworkBuiltins {
 func (in *ClusterTopologyBuiltins) DeepCopyInto(out *ClusterTopologyBuiltins) {
 	*out = *in
 	out.ClassRef = in.ClassRef
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTopologyBuiltins.
@@ -1013,6 +1132,11 @@ func (in *DiscoveryResponse) DeepCopyInto(out *DiscoveryResponse) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SettingsSchema != nil {
+		in, out := &in.SettingsSchema, &out.SettingsSchema
+		*out = new(SettingsSchema)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveryResponse.
@@ -1405,6 +1529,53 @@ func (in *Patch) DeepCopy() *Patch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingSchema) DeepCopyInto(out *SettingSchema) {
+	*out = *in
+	if in.Enum != nil {
+		in, out := &in.Enum, &out.Enum
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxLength != nil {
+		in, out := &in.MaxLength, &out.MaxLength
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingSchema.
+func (in *SettingSchema) DeepCopy() *SettingSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsSchema) DeepCopyInto(out *SettingsSchema) {
+	*out = *in
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make([]SettingSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsSchema.
+func (in *SettingsSchema) DeepCopy() *SettingsSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpdateMachineRequest) DeepCopyInto(out *UpdateMachineRequest) {
 	*out = *in
@@ -26,12 +26,28 @@ import (
 
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 )
 
 func (src *ExtensionConfig) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*runtimev1.ExtensionConfig)
 
-	return Convert_v1alpha1_ExtensionConfig_To_v1beta2_ExtensionConfig(src, dst, nil)
+	if err := Convert_v1alpha1_ExtensionConfig_To_v1beta2_ExtensionConfig(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &runtimev1.ExtensionConfig{}
+	ok, err := utilconversion.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+	if ok {
+		dst.Spec.HandlerOverrides = restored.Spec.HandlerOverrides
+		dst.Spec.SecondaryClientConfig = restored.Spec.SecondaryClientConfig
+		dst.Status.SettingsSchema = restored.Status.SettingsSchema
+	}
+	return nil
 }
 
 func (dst *ExtensionConfig) ConvertFrom(srcRaw conversion.Hub) error {
@@ -48,7 +64,13 @@ func (dst *ExtensionConfig) ConvertFrom(srcRaw conversion.Hub) error {
 		}
 		dst.Status.Handlers[i] = h
 	}
-	return nil
+
+	// Preserve Hub data on down-conversion except for metadata.
+	return utilconversion.MarshalData(src, dst)
+}
+
+func Convert_v1beta2_ExtensionConfigSpec_To_v1alpha1_ExtensionConfigSpec(in *runtimev1.ExtensionConfigSpec, out *ExtensionConfigSpec, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_ExtensionConfigSpec_To_v1alpha1_ExtensionConfigSpec(in, out, s)
 }
 
 func Convert_v1beta2_ExtensionConfigStatus_To_v1alpha1_ExtensionConfigStatus(in *runtimev1.ExtensionConfigStatus, out *ExtensionConfigStatus, s apimachineryconversion.Scope) error {
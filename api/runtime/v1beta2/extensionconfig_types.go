@@ -30,6 +30,16 @@ type ExtensionConfigSpec struct {
 	// +required
 	ClientConfig ClientConfig `json:"clientConfig,omitempty,omitzero"`
 
+	// secondaryClientConfig defines a secondary Extension server, e.g. a new deployment of the Extension,
+	// and the share of the calls to the ExtensionHandlers which are sent to it.
+	// It can be used to gradually shift calls from one deployment of the Extension to another one, e.g. during
+	// an upgrade of the Extension. If a call to one of the Extension servers fails, the call is retried
+	// against the other one.
+	// Note: Discovery is performed against clientConfig, and against secondaryClientConfig only if it fails;
+	// both the Extension servers are expected to serve the same ExtensionHandlers.
+	// +optional
+	SecondaryClientConfig WeightedClientConfig `json:"secondaryClientConfig,omitempty,omitzero"`
+
 	// namespaceSelector decides whether to call the hook for an object based
 	// on whether the namespace for that object matches the selector.
 	// Defaults to the empty LabelSelector, which matches all objects.
@@ -41,6 +51,43 @@ type ExtensionConfigSpec struct {
 	// Note: Settings can be overridden on the ClusterClass.
 	// +optional
 	Settings map[string]string `json:"settings,omitempty"`
+
+	// handlerOverrides defines overrides for the timeout and the failure policy of the ExtensionHandlers
+	// of the Extension, which take precedence over the values returned by the Extension during discovery.
+	// Overrides for a specific ExtensionHandler take precedence over overrides for a hook.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=512
+	HandlerOverrides []ExtensionHandlerOverride `json:"handlerOverrides,omitempty"`
+}
+
+// ExtensionHandlerOverride defines overrides for the timeout and the failure policy of ExtensionHandlers.
+// Note: Exactly one of `name` or `hook` must be specified.
+// +kubebuilder:validation:MinProperties=2
+type ExtensionHandlerOverride struct {
+	// name is the name of the ExtensionHandler the override applies to, as reported in status.handlers.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	Name string `json:"name,omitempty"`
+
+	// hook is the name of the hook the override applies to, e.g. BeforeClusterUpgrade.
+	// The override applies to all the ExtensionHandlers of the Extension serving the hook.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Hook string `json:"hook,omitempty"`
+
+	// timeoutSeconds overrides the timeout duration for client calls to the ExtensionHandlers.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=30
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// failurePolicy overrides how failures in calls to the ExtensionHandlers should be handled by a client.
+	// +optional
+	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
 }
 
 // ClientConfig contains the information to make a client
@@ -83,6 +130,27 @@ type ClientConfig struct {
 	CABundle []byte `json:"caBundle,omitempty"`
 }
 
+// WeightedClientConfig contains the information to make a client connection with an Extension server,
+// and the share of the calls which are sent to it.
+// +kubebuilder:validation:MinProperties=1
+type WeightedClientConfig struct {
+	// clientConfig defines how to communicate with the Extension server.
+	// +required
+	ClientConfig ClientConfig `json:"clientConfig,omitempty,omitzero"`
+
+	// weight is the percentage of the calls to the ExtensionHandlers which are sent to this Extension server.
+	// The remaining calls are sent to the Extension server defined in spec.clientConfig.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// IsDefined returns true if the WeightedClientConfig is set.
+func (c *WeightedClientConfig) IsDefined() bool {
+	return !reflect.DeepEqual(c, &WeightedClientConfig{})
+}
+
 // ServiceReference holds a reference to a Kubernetes Service of an Extension server.
 type ServiceReference struct {
 	// namespace is the namespace of the service.
@@ -120,7 +188,7 @@ func (r *ServiceReference) IsDefined() bool {
 // +kubebuilder:validation:MinProperties=1
 type ExtensionConfigStatus struct {
 	// conditions represents the observations of a ExtensionConfig's current state.
-	// Known condition types are Discovered, Paused.
+	// Known condition types are Discovered, SettingsValid, Paused.
 	// +optional
 	// +listType=map
 	// +listMapKey=type
@@ -134,6 +202,11 @@ type ExtensionConfigStatus struct {
 	// +kubebuilder:validation:MaxItems=512
 	Handlers []ExtensionHandler `json:"handlers,omitempty"`
 
+	// settingsSchema defines the schema of the settings accepted by the Extension, as returned during discovery.
+	// If set, spec.settings are validated against it.
+	// +optional
+	SettingsSchema *ExtensionSettingsSchema `json:"settingsSchema,omitempty"`
+
 	// deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.
 	// +optional
 	Deprecated *ExtensionConfigDeprecatedStatus `json:"deprecated,omitempty"`
@@ -184,6 +257,57 @@ type ExtensionHandler struct {
 	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
 }
 
+// ExtensionSettingsSchema defines the schema of the settings accepted by an Extension.
+type ExtensionSettingsSchema struct {
+	// settings defines the settings accepted by the Extension.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=256
+	Settings []ExtensionSettingSchema `json:"settings,omitempty"`
+
+	// allowUnknownSettings defines if settings not defined in the schema are accepted.
+	// +optional
+	AllowUnknownSettings *bool `json:"allowUnknownSettings,omitempty"`
+}
+
+// ExtensionSettingSchema defines the schema of a single setting accepted by an Extension.
+type ExtensionSettingSchema struct {
+	// name is the name of the setting.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name,omitempty"`
+
+	// description is a human-readable description of the setting.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Description string `json:"description,omitempty"`
+
+	// required defines if the setting must be set.
+	// +optional
+	Required *bool `json:"required,omitempty"`
+
+	// enum defines the allowed values of the setting.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=256
+	// +kubebuilder:validation:items:MaxLength=4096
+	Enum []string `json:"enum,omitempty"`
+
+	// pattern defines a regular expression the value of the setting must match.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Pattern string `json:"pattern,omitempty"`
+
+	// maxLength defines the maximum length of the value of the setting.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxLength *int32 `json:"maxLength,omitempty"`
+}
+
 // GroupVersionHook defines the runtime hook when the ExtensionHandler is called.
 type GroupVersionHook struct {
 	// apiVersion is the group and version of the Hook.
@@ -299,6 +423,24 @@ const (
 	ExtensionConfigNotDiscoveredReason = "NotDiscovered"
 )
 
+// ExtensionConfig's SettingsValid conditions and corresponding reasons that will be used in v1Beta2 API version.
+const (
+	// ExtensionConfigSettingsValidCondition is true if the settings of the ExtensionConfig are valid according
+	// to the settings schema returned by the runtime extension during discovery.
+	// Note: ExtensionHandlers of an ExtensionConfig with invalid settings are not called.
+	ExtensionConfigSettingsValidCondition = "SettingsValid"
+
+	// ExtensionConfigSettingsValidReason surfaces that the settings of the ExtensionConfig are valid.
+	ExtensionConfigSettingsValidReason = "SettingsValid"
+
+	// ExtensionConfigSettingsSchemaNotDefinedReason surfaces that the runtime extension does not define a settings schema,
+	// and thus settings are not validated.
+	ExtensionConfigSettingsSchemaNotDefinedReason = "SettingsSchemaNotDefined"
+
+	// ExtensionConfigSettingsInvalidReason surfaces that the settings of the ExtensionConfig are not valid.
+	ExtensionConfigSettingsInvalidReason = "SettingsInvalid"
+)
+
 const (
 	// RuntimeExtensionDiscoveredV1Beta1Condition is a condition set on an ExtensionConfig object once it has been discovered by the Runtime SDK client.
 	RuntimeExtensionDiscoveredV1Beta1Condition clusterv1.ConditionType = "Discovered"
@@ -311,6 +453,10 @@ const (
 	// as <namespace>/<name>.
 	InjectCAFromSecretAnnotation string = "runtime.cluster.x-k8s.io/inject-ca-from-secret"
 
+	// ValidateOpenAPIAnnotation is the annotation that specifies that during discovery the OpenAPI spec served
+	// by the Extension should be validated against the Runtime SDK catalog, if set to "true".
+	ValidateOpenAPIAnnotation string = "runtime.cluster.x-k8s.io/validate-openapi"
+
 	// PendingHooksAnnotation is the annotation used to keep track of pending runtime hooks.
 	// The annotation will be used to track the intent to call a hook as soon as an operation completes;
 	// the intent will be removed as soon as the hook call completes successfully.
@@ -130,6 +130,7 @@ func (in *ExtensionConfigList) DeepCopyObject() runtime.Object {
 func (in *ExtensionConfigSpec) DeepCopyInto(out *ExtensionConfigSpec) {
 	*out = *in
 	in.ClientConfig.DeepCopyInto(&out.ClientConfig)
+	in.SecondaryClientConfig.DeepCopyInto(&out.SecondaryClientConfig)
 	if in.NamespaceSelector != nil {
 		in, out := &in.NamespaceSelector, &out.NamespaceSelector
 		*out = new(v1.LabelSelector)
@@ -142,6 +143,13 @@ func (in *ExtensionConfigSpec) DeepCopyInto(out *ExtensionConfigSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.HandlerOverrides != nil {
+		in, out := &in.HandlerOverrides, &out.HandlerOverrides
+		*out = make([]ExtensionHandlerOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionConfigSpec.
@@ -169,6 +177,11 @@ func (in *ExtensionConfigStatus) DeepCopyInto(out *ExtensionConfigStatus) {
 		*out = make([]ExtensionHandler, len(*in))
 		copy(*out, *in)
 	}
+	if in.SettingsSchema != nil {
+		in, out := &in.SettingsSchema, &out.SettingsSchema
+		*out = new(ExtensionSettingsSchema)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(ExtensionConfigDeprecatedStatus)
@@ -224,6 +237,83 @@ func (in *ExtensionHandler) DeepCopy() *ExtensionHandler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionHandlerOverride) DeepCopyInto(out *ExtensionHandlerOverride) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionHandlerOverride.
+func (in *ExtensionHandlerOverride) DeepCopy() *ExtensionHandlerOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionHandlerOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionSettingSchema) DeepCopyInto(out *ExtensionSettingSchema) {
+	*out = *in
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Enum != nil {
+		in, out := &in.Enum, &out.Enum
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxLength != nil {
+		in, out := &in.MaxLength, &out.MaxLength
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionSettingSchema.
+func (in *ExtensionSettingSchema) DeepCopy() *ExtensionSettingSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionSettingSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionSettingsSchema) DeepCopyInto(out *ExtensionSettingsSchema) {
+	*out = *in
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make([]ExtensionSettingSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowUnknownSettings != nil {
+		in, out := &in.AllowUnknownSettings, &out.AllowUnknownSettings
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionSettingsSchema.
+func (in *ExtensionSettingsSchema) DeepCopy() *ExtensionSettingsSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionSettingsSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupVersionHook) DeepCopyInto(out *GroupVersionHook) {
 	*out = *in
@@ -258,3 +348,24 @@ func (in *ServiceReference) DeepCopy() *ServiceReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedClientConfig) DeepCopyInto(out *WeightedClientConfig) {
+	*out = *in
+	in.ClientConfig.DeepCopyInto(&out.ClientConfig)
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedClientConfig.
+func (in *WeightedClientConfig) DeepCopy() *WeightedClientConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedClientConfig)
+	in.DeepCopyInto(out)
+	return out
+}
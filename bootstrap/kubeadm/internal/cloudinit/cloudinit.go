@@ -19,41 +19,78 @@ package cloudinit
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/blang/semver/v4"
 	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 )
 
 const (
+	standardInitCommand = "kubeadm init --config /run/kubeadm/kubeadm.yaml %s"
 	standardJoinCommand = "kubeadm join --config /run/kubeadm/kubeadm-join-config.yaml %s"
 	// sentinelFileCommand writes a file to /run/cluster-api to signal successful Kubernetes bootstrapping in a way that
 	// works both for Linux and Windows OS.
 	sentinelFileCommand = "echo success > /run/cluster-api/bootstrap-success.complete"
-	cloudConfigHeader   = `## template: jinja
+	// bootstrapProgressCommand writes the current bootstrap phase to /run/cluster-api, so infrastructure providers
+	// can report the bootstrap progress of the node in the InfrastructureMachine status; it is used only when
+	// diagnostics.progress is enabled, so the bootstrap data of existing machines does not change.
+	// Reported phases are FilesWritten, KubeadmStarted and KubeadmFinished.
+	bootstrapProgressCommand = "echo %s > /run/cluster-api/bootstrap-progress"
+	cloudConfigHeader        = `## template: jinja
 #cloud-config
 `
+
+	// diagnosticsScriptPath is the path of the script used to run bootstrap commands when diagnostics are enabled.
+	diagnosticsScriptPath = "/run/cluster-api/bootstrap-diagnostics.sh"
+	// diagnosticsScript runs a bootstrap command and appends its exit code and, if the command failed, the tail of
+	// its output to the /run/cluster-api/bootstrap-diagnostics report, so infrastructure providers can report
+	// the failed command in the InfrastructureMachine status.
+	diagnosticsScript = `#!/bin/bash
+# Usage: bootstrap-diagnostics.sh <name> <command>...
+name="$1"
+shift
+output="$(mktemp)"
+bash -c "$*" 2>&1 | tee "${output}"
+exitCode="${PIPESTATUS[0]}"
+{
+  echo "### ${name} exitCode=${exitCode}"
+  if [ "${exitCode}" -ne 0 ]; then
+    tail -n %d "${output}"
+  fi
+} >> /run/cluster-api/bootstrap-diagnostics
+rm -f "${output}"
+exit "${exitCode}"
+`
+	// defaultDiagnosticsOutputTailLines is the default number of lines at the end of the output of a failed command
+	// included in the diagnostics report.
+	defaultDiagnosticsOutputTailLines = 20
 )
 
 // BaseUserData is shared across all the various types of files written to disk.
 type BaseUserData struct {
-	Header              string
-	BootCommands        []string
-	PreKubeadmCommands  []string
-	PostKubeadmCommands []string
-	AdditionalFiles     []bootstrapv1.File
-	WriteFiles          []bootstrapv1.File
-	Users               []bootstrapv1.User
-	NTP                 *bootstrapv1.NTP
-	DiskSetup           *bootstrapv1.DiskSetup
-	Mounts              []bootstrapv1.MountPoints
-	ControlPlane        bool
-	KubeadmCommand      string
-	KubeadmVerbosity    string
-	SentinelFileCommand string
-	KubernetesVersion   semver.Version
+	Header                        string
+	BootCommands                  []string
+	PreKubeadmCommands            []string
+	PostKubeadmCommands           []string
+	AdditionalFiles               []bootstrapv1.File
+	WriteFiles                    []bootstrapv1.File
+	Users                         []bootstrapv1.User
+	NTP                           *bootstrapv1.NTP
+	TrustedCertificateAuthorities []string
+	DiskSetup                     *bootstrapv1.DiskSetup
+	Mounts                        []bootstrapv1.MountPoints
+	ControlPlane                  bool
+	KubeadmCommand                string
+	KubeadmVerbosity              string
+	SentinelFileCommand           string
+	KubernetesVersion             semver.Version
+	Diagnostics                   *bootstrapv1.BootstrapDiagnostics
+	ReportBootstrapProgress       bool
+	AdditionalUserData            []AdditionalUserData
 }
 
 func (input *BaseUserData) prepare() {
@@ -61,6 +98,56 @@ func (input *BaseUserData) prepare() {
 	input.WriteFiles = append(input.WriteFiles, input.AdditionalFiles...)
 	input.KubeadmCommand = fmt.Sprintf(standardJoinCommand, input.KubeadmVerbosity)
 	input.SentinelFileCommand = sentinelFileCommand
+	input.prepareBootstrapProgress()
+	input.prepareDiagnostics()
+}
+
+// prepareBootstrapProgress enables the commands reporting the bootstrap progress when diagnostics.progress is enabled.
+func (input *BaseUserData) prepareBootstrapProgress() {
+	input.ReportBootstrapProgress = input.Diagnostics != nil && ptr.Deref(input.Diagnostics.Progress, false)
+}
+
+// prepareDiagnostics wraps preKubeadmCommands, the kubeadm command and postKubeadmCommands with the diagnostics
+// script when diagnostics are enabled.
+// NOTE: Each command runs in its own shell, so e.g. variables exported by a command are not visible to the following ones.
+func (input *BaseUserData) prepareDiagnostics() {
+	if input.Diagnostics == nil || !ptr.Deref(input.Diagnostics.Enabled, false) {
+		return
+	}
+
+	input.WriteFiles = append(input.WriteFiles, bootstrapv1.File{
+		Path:        diagnosticsScriptPath,
+		Owner:       "root:root",
+		Permissions: "0700",
+		Content:     fmt.Sprintf(diagnosticsScript, ptr.Deref(input.Diagnostics.OutputTailLines, defaultDiagnosticsOutputTailLines)),
+	})
+
+	preKubeadmCommands := make([]string, 0, len(input.PreKubeadmCommands))
+	for i, command := range input.PreKubeadmCommands {
+		preKubeadmCommands = append(preKubeadmCommands, diagnosticsCommand(shellQuote(fmt.Sprintf("preKubeadmCommands[%d]", i)), shellQuote(command)))
+	}
+	input.PreKubeadmCommands = preKubeadmCommands
+
+	// NOTE: The name and the kubeadm command do not contain characters requiring quoting, and they are not quoted to keep
+	// the command compatible with templates using it in single-quoted YAML strings.
+	input.KubeadmCommand = diagnosticsCommand("kubeadm", input.KubeadmCommand)
+
+	postKubeadmCommands := make([]string, 0, len(input.PostKubeadmCommands))
+	for i, command := range input.PostKubeadmCommands {
+		postKubeadmCommands = append(postKubeadmCommands, diagnosticsCommand(shellQuote(fmt.Sprintf("postKubeadmCommands[%d]", i)), shellQuote(command)))
+	}
+	input.PostKubeadmCommands = postKubeadmCommands
+}
+
+// diagnosticsCommand returns a command running the given command with the diagnostics script.
+// NOTE: name and command are passed to the shell as is, so they must be quoted by the caller if required.
+func diagnosticsCommand(name, command string) string {
+	return fmt.Sprintf("/bin/bash %s %s %s", diagnosticsScriptPath, name, command)
+}
+
+// shellQuote quotes a string so it is interpreted as a single word by the shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
 }
 
 func generate(kind string, tpl string, data interface{}) ([]byte, error) {
@@ -81,6 +168,10 @@ func generate(kind string, tpl string, data interface{}) ([]byte, error) {
 		return nil, errors.Wrap(err, "failed to parse ntp template")
 	}
 
+	if _, err := tm.Parse(caCertsTemplate); err != nil {
+		return nil, errors.Wrap(err, "failed to parse ca certs template")
+	}
+
 	if _, err := tm.Parse(usersTemplate); err != nil {
 		return nil, errors.Wrap(err, "failed to parse users template")
 	}
@@ -17,6 +17,10 @@ limitations under the License.
 package cloudinit
 
 import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -325,6 +329,261 @@ func TestNewJoinNodeCommands(t *testing.T) {
 	g.Expect(out).To(ContainSubstring(expectedRunCmd))
 }
 
+func TestNewJoinNodeDiagnostics(t *testing.T) {
+	g := NewWithT(t)
+
+	nodeinput := &NodeInput{
+		BaseUserData: BaseUserData{
+			Header:              "test",
+			PreKubeadmCommands:  []string{"echo 'hello PreKubeadmCommands!'"},
+			PostKubeadmCommands: []string{"echo $(date)"},
+			Diagnostics: &bootstrapv1.BootstrapDiagnostics{
+				Enabled:         ptr.To(true),
+				OutputTailLines: ptr.To[int32](5),
+				Progress:        ptr.To(true),
+			},
+		},
+		JoinConfiguration: "my-join-config",
+	}
+
+	out, err := NewNode(nodeinput)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(checkWriteFiles("/run/cluster-api/bootstrap-diagnostics.sh", "/run/kubeadm/kubeadm-join-config.yaml", "/run/cluster-api/placeholder")(out)).To(Succeed())
+	g.Expect(out).To(ContainSubstring("tail -n 5 \"${output}\""))
+
+	expectedRunCmd := `runcmd:
+  - echo FilesWritten > /run/cluster-api/bootstrap-progress
+  - "/bin/bash /run/cluster-api/bootstrap-diagnostics.sh 'preKubeadmCommands[0]' 'echo '\"'\"'hello PreKubeadmCommands!'\"'\"''"
+  - echo KubeadmStarted > /run/cluster-api/bootstrap-progress
+  - /bin/bash /run/cluster-api/bootstrap-diagnostics.sh kubeadm kubeadm join --config /run/kubeadm/kubeadm-join-config.yaml  && echo KubeadmFinished > /run/cluster-api/bootstrap-progress && echo success > /run/cluster-api/bootstrap-success.complete
+  - "/bin/bash /run/cluster-api/bootstrap-diagnostics.sh 'postKubeadmCommands[0]' 'echo $(date)'"`
+
+	g.Expect(string(out)).To(ContainSubstring(expectedRunCmd))
+}
+
+func TestNewInitControlPlaneDiagnostics(t *testing.T) {
+	g := NewWithT(t)
+
+	cpinput := &ControlPlaneInput{
+		BaseUserData: BaseUserData{
+			Header:           "test",
+			KubeadmVerbosity: "--v=5",
+			Diagnostics: &bootstrapv1.BootstrapDiagnostics{
+				Enabled: ptr.To(true),
+			},
+		},
+		ClusterConfiguration: "my-cluster-config",
+		InitConfiguration:    "my-init-config",
+	}
+
+	out, err := NewInitControlPlane(cpinput)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(out).To(ContainSubstring("tail -n 20 \"${output}\""))
+	g.Expect(out).To(ContainSubstring("  - '/bin/bash /run/cluster-api/bootstrap-diagnostics.sh kubeadm kubeadm init --config /run/kubeadm/kubeadm.yaml --v=5 && echo success > /run/cluster-api/bootstrap-success.complete'"))
+}
+
+func TestBootstrapProgress(t *testing.T) {
+	tests := []struct {
+		name            string
+		diagnostics     *bootstrapv1.BootstrapDiagnostics
+		wantRunCmd      string
+		wantNotContains string
+	}{
+		{
+			name: "Does not report progress by default",
+			wantRunCmd: `runcmd:
+  - "echo 'hello PreKubeadmCommands!'"
+  - kubeadm join --config /run/kubeadm/kubeadm-join-config.yaml  && echo success > /run/cluster-api/bootstrap-success.complete`,
+			wantNotContains: "bootstrap-progress",
+		},
+		{
+			name:        "Does not report progress when only diagnostics are enabled",
+			diagnostics: &bootstrapv1.BootstrapDiagnostics{Enabled: ptr.To(true)},
+			wantRunCmd: `runcmd:
+  - "/bin/bash /run/cluster-api/bootstrap-diagnostics.sh 'preKubeadmCommands[0]' 'echo '\"'\"'hello PreKubeadmCommands!'\"'\"''"
+  - /bin/bash /run/cluster-api/bootstrap-diagnostics.sh kubeadm kubeadm join --config /run/kubeadm/kubeadm-join-config.yaml  && echo success > /run/cluster-api/bootstrap-success.complete`,
+			wantNotContains: "bootstrap-progress",
+		},
+		{
+			name:        "Reports progress when enabled",
+			diagnostics: &bootstrapv1.BootstrapDiagnostics{Progress: ptr.To(true)},
+			wantRunCmd: `runcmd:
+  - echo FilesWritten > /run/cluster-api/bootstrap-progress
+  - "echo 'hello PreKubeadmCommands!'"
+  - echo KubeadmStarted > /run/cluster-api/bootstrap-progress
+  - kubeadm join --config /run/kubeadm/kubeadm-join-config.yaml  && echo KubeadmFinished > /run/cluster-api/bootstrap-progress && echo success > /run/cluster-api/bootstrap-success.complete`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			baseUserData := BaseUserData{
+				Header:             "test",
+				PreKubeadmCommands: []string{"echo 'hello PreKubeadmCommands!'"},
+				Diagnostics:        tt.diagnostics,
+			}
+
+			node, err := NewNode(&NodeInput{BaseUserData: baseUserData, JoinConfiguration: "my-join-config"})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(node)).To(ContainSubstring(tt.wantRunCmd))
+
+			joinControlPlane, err := NewJoinControlPlane(&ControlPlaneJoinInput{BaseUserData: baseUserData, JoinConfiguration: "my-join-config"})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(joinControlPlane)).To(ContainSubstring(tt.wantRunCmd))
+
+			initControlPlane, err := NewInitControlPlane(&ControlPlaneInput{BaseUserData: baseUserData, ClusterConfiguration: "my-cluster-config", InitConfiguration: "my-init-config"})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			if tt.wantNotContains != "" {
+				g.Expect(string(node)).ToNot(ContainSubstring(tt.wantNotContains))
+				g.Expect(string(joinControlPlane)).ToNot(ContainSubstring(tt.wantNotContains))
+				g.Expect(string(initControlPlane)).ToNot(ContainSubstring(tt.wantNotContains))
+				return
+			}
+			g.Expect(string(initControlPlane)).To(ContainSubstring("  - echo KubeadmStarted > /run/cluster-api/bootstrap-progress\n" +
+				"  - 'kubeadm init --config /run/kubeadm/kubeadm.yaml  && echo KubeadmFinished > /run/cluster-api/bootstrap-progress && echo success > /run/cluster-api/bootstrap-success.complete'"))
+		})
+	}
+}
+
+func TestNewJoinNodeTrustedCertificateAuthorities(t *testing.T) {
+	g := NewWithT(t)
+
+	nodeinput := &NodeInput{
+		BaseUserData: BaseUserData{
+			Header: "test",
+			TrustedCertificateAuthorities: []string{
+				"-----BEGIN CERTIFICATE-----\nfoo\n-----END CERTIFICATE-----",
+				"-----BEGIN CERTIFICATE-----\nbar\n-----END CERTIFICATE-----",
+			},
+		},
+		JoinConfiguration: "my-join-config",
+	}
+
+	out, err := NewNode(nodeinput)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	expectedCACerts := `ca_certs:
+  trusted:
+    - |
+      -----BEGIN CERTIFICATE-----
+      foo
+      -----END CERTIFICATE-----
+    - |
+      -----BEGIN CERTIFICATE-----
+      bar
+      -----END CERTIFICATE-----
+`
+
+	g.Expect(string(out)).To(ContainSubstring(expectedCACerts))
+}
+
+func TestNewJoinNodeAdditionalUserData(t *testing.T) {
+	g := NewWithT(t)
+
+	nodeinput := &NodeInput{
+		BaseUserData: BaseUserData{
+			AdditionalUserData: []AdditionalUserData{
+				{
+					Content: "#cloud-config\npackages:\n  - jq\n",
+				},
+				{
+					Content:   "## template: jinja\n#cloud-config\nhostname: {{ ds.meta_data.local_hostname }}\n",
+					MergeType: "dict(recurse_array)+list(append)",
+				},
+				{
+					Content:   "#!/bin/bash\necho hello\n",
+					MergeType: "ignored",
+				},
+			},
+		},
+		JoinConfiguration: "my-join-config",
+	}
+
+	out, err := NewNode(nodeinput)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Parse the MIME archive.
+	header, body, found := strings.Cut(string(out), "\r\n\r\n")
+	g.Expect(found).To(BeTrue())
+	mediaType, params, err := mime.ParseMediaType(strings.TrimPrefix(strings.Split(header, "\r\n")[0], "Content-Type: "))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(mediaType).To(Equal("multipart/mixed"))
+
+	type part struct {
+		contentType string
+		mergeType   string
+		content     string
+	}
+	var parts []part
+	r := multipart.NewReader(strings.NewReader(body), params["boundary"])
+	for {
+		p, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		g.Expect(err).ToNot(HaveOccurred())
+		content, err := io.ReadAll(p)
+		g.Expect(err).ToNot(HaveOccurred())
+		parts = append(parts, part{
+			contentType: p.Header.Get("Content-Type"),
+			mergeType:   p.Header.Get("Merge-Type"),
+			content:     string(content),
+		})
+	}
+
+	g.Expect(parts).To(HaveLen(4))
+	g.Expect(parts[0].contentType).To(Equal(`text/jinja2; charset="utf-8"`))
+	g.Expect(parts[0].mergeType).To(BeEmpty())
+	g.Expect(parts[0].content).To(HavePrefix(cloudConfigHeader))
+	g.Expect(parts[0].content).To(ContainSubstring("my-join-config"))
+	g.Expect(parts[1]).To(Equal(part{
+		contentType: `text/cloud-config; charset="utf-8"`,
+		mergeType:   DefaultAdditionalUserDataMergeType,
+		content:     nodeinput.AdditionalUserData[0].Content,
+	}))
+	g.Expect(parts[2]).To(Equal(part{
+		contentType: `text/jinja2; charset="utf-8"`,
+		mergeType:   "dict(recurse_array)+list(append)",
+		content:     nodeinput.AdditionalUserData[1].Content,
+	}))
+	g.Expect(parts[3]).To(Equal(part{
+		contentType: `text/x-shellscript; charset="utf-8"`,
+		content:     nodeinput.AdditionalUserData[2].Content,
+	}))
+}
+
+func TestAdditionalUserDataErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "unsupported document",
+			content: "packages:\n  - jq\n",
+		},
+		{
+			name:    "document containing the MIME boundary",
+			content: "#cloud-config\n--" + mimeBoundary + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := NewNode(&NodeInput{
+				BaseUserData: BaseUserData{
+					AdditionalUserData: []AdditionalUserData{{Content: tt.content}},
+				},
+			})
+			g.Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
 func TestOmittableFields(t *testing.T) {
 	tests := []struct {
 		name string
@@ -462,6 +721,15 @@ func TestOmittableFields(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "No diff between empty or nil trustedCertificateAuthorities",
+			A: BaseUserData{
+				TrustedCertificateAuthorities: []string{},
+			},
+			B: BaseUserData{
+				TrustedCertificateAuthorities: nil,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -17,6 +17,8 @@ limitations under the License.
 package cloudinit
 
 import (
+	"fmt"
+
 	"sigs.k8s.io/cluster-api/util/secret"
 )
 
@@ -37,10 +39,17 @@ const (
     content: "This placeholder file is used to create the /run/cluster-api sub directory in a way that is compatible with both Linux and Windows (mkdir -p /run/cluster-api does not work with Windows)"
 {{- template "boot_commands" .BootCommands }}
 runcmd:
+{{- if .ReportBootstrapProgress }}
+  - {{ BootstrapProgress "FilesWritten" }}
+{{- end }}
 {{- template "commands" .PreKubeadmCommands }}
-  - 'kubeadm init --config /run/kubeadm/kubeadm.yaml {{.KubeadmVerbosity}} && {{ .SentinelFileCommand }}'
+{{- if .ReportBootstrapProgress }}
+  - {{ BootstrapProgress "KubeadmStarted" }}
+{{- end }}
+  - '{{ .KubeadmCommand }} && {{ if .ReportBootstrapProgress }}{{ BootstrapProgress "KubeadmFinished" }} && {{ end }}{{ .SentinelFileCommand }}'
 {{- template "commands" .PostKubeadmCommands }}
 {{- template "ntp" .NTP }}
+{{- template "ca_certs" .TrustedCertificateAuthorities }}
 {{- template "users" .Users }}
 {{- template "disk_setup" .DiskSetup}}
 {{- template "fs_setup" .DiskSetup}}
@@ -62,11 +71,14 @@ func NewInitControlPlane(input *ControlPlaneInput) ([]byte, error) {
 	input.Header = cloudConfigHeader
 	input.WriteFiles = input.AsFiles()
 	input.WriteFiles = append(input.WriteFiles, input.AdditionalFiles...)
+	input.KubeadmCommand = fmt.Sprintf(standardInitCommand, input.KubeadmVerbosity)
 	input.SentinelFileCommand = sentinelFileCommand
+	input.prepareBootstrapProgress()
+	input.prepareDiagnostics()
 	userData, err := generate("InitControlplane", controlPlaneCloudInit, input)
 	if err != nil {
 		return nil, err
 	}
 
-	return userData, nil
+	return withAdditionalUserData(userData, input.AdditionalUserData)
 }
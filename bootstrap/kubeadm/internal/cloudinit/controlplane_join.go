@@ -36,10 +36,17 @@ const (
     content: "This placeholder file is used to create the /run/cluster-api sub directory in a way that is compatible with both Linux and Windows (mkdir -p /run/cluster-api does not work with Windows)"
 {{- template "boot_commands" .BootCommands }}
 runcmd:
+{{- if .ReportBootstrapProgress }}
+  - {{ BootstrapProgress "FilesWritten" }}
+{{- end }}
 {{- template "commands" .PreKubeadmCommands }}
-  - {{ .KubeadmCommand }} && {{ .SentinelFileCommand }}
+{{- if .ReportBootstrapProgress }}
+  - {{ BootstrapProgress "KubeadmStarted" }}
+{{- end }}
+  - {{ .KubeadmCommand }} && {{ if .ReportBootstrapProgress }}{{ BootstrapProgress "KubeadmFinished" }} && {{ end }}{{ .SentinelFileCommand }}
 {{- template "commands" .PostKubeadmCommands }}
 {{- template "ntp" .NTP }}
+{{- template "ca_certs" .TrustedCertificateAuthorities }}
 {{- template "users" .Users }}
 {{- template "disk_setup" .DiskSetup}}
 {{- template "fs_setup" .DiskSetup}}
@@ -67,5 +74,5 @@ func NewJoinControlPlane(input *ControlPlaneJoinInput) ([]byte, error) {
 		return nil, errors.Wrapf(err, "failed to generate user data for machine joining control plane")
 	}
 
-	return userData, err
+	return withAdditionalUserData(userData, input.AdditionalUserData)
 }
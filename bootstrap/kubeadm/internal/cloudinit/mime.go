@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinit
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultAdditionalUserDataMergeType is the cloud-init merge type used for additional user data if not specified.
+	// It appends lists like runcmd or write_files and preserves the values generated by the bootstrap provider.
+	DefaultAdditionalUserDataMergeType = "list(append)+dict(no_replace,recurse_list)+str()"
+
+	// mimeBoundary is the boundary separating the parts of the bootstrap data when additional user data are set.
+	mimeBoundary = "==CLUSTER-API-BOOTSTRAP-DATA=="
+
+	jinjaTemplateHeader = "## template: jinja"
+	cloudConfigPrefix   = "#cloud-config"
+	shellScriptPrefix   = "#!"
+)
+
+// AdditionalUserData is a user-provided cloud-init document merged with the generated cloud-config.
+type AdditionalUserData struct {
+	// Content of the document.
+	Content string
+
+	// MergeType is the cloud-init merge type of the document.
+	// If empty, DefaultAdditionalUserDataMergeType is used.
+	MergeType string
+}
+
+// withAdditionalUserData returns a multi-part MIME archive with the generated cloud-config as a first part and
+// the additional user data as the following parts, so cloud-init merges them using the merge type of each part.
+// If there are no additional user data, the generated cloud-config is returned as is.
+func withAdditionalUserData(userData []byte, additionalUserData []AdditionalUserData) ([]byte, error) {
+	if len(additionalUserData) == 0 {
+		return userData, nil
+	}
+
+	var out bytes.Buffer
+	w := multipart.NewWriter(&out)
+	if err := w.SetBoundary(mimeBoundary); err != nil {
+		return nil, errors.Wrap(err, "failed to set MIME boundary")
+	}
+	fmt.Fprintf(&out, "Content-Type: multipart/mixed; boundary=%q\r\nMIME-Version: 1.0\r\n\r\n", w.Boundary())
+
+	if err := writePart(w, "cloud-config.yaml", string(userData), ""); err != nil {
+		return nil, err
+	}
+	for i, additional := range additionalUserData {
+		mergeType := additional.MergeType
+		if mergeType == "" {
+			mergeType = DefaultAdditionalUserDataMergeType
+		}
+		if err := writePart(w, fmt.Sprintf("additional-user-data-%d", i), additional.Content, mergeType); err != nil {
+			return nil, errors.Wrapf(err, "failed to add additional user data %d", i)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close MIME archive")
+	}
+	return out.Bytes(), nil
+}
+
+// writePart writes a document as a part of a multi-part MIME archive.
+// NOTE: The merge type is only set for cloud-config documents and for jinja templates, given that it does not apply to shell scripts.
+func writePart(w *multipart.Writer, filename, content, mergeType string) error {
+	if strings.Contains(content, "--"+mimeBoundary) {
+		return errors.Errorf("content must not contain the MIME boundary %q", mimeBoundary)
+	}
+
+	var contentType string
+	switch {
+	case strings.HasPrefix(content, jinjaTemplateHeader):
+		contentType = "text/jinja2"
+	case strings.HasPrefix(content, cloudConfigPrefix):
+		contentType = "text/cloud-config"
+	case strings.HasPrefix(content, shellScriptPrefix):
+		contentType = "text/x-shellscript"
+		mergeType = ""
+	default:
+		return errors.Errorf("content must start with %q, %q or %q", cloudConfigPrefix, jinjaTemplateHeader, shellScriptPrefix)
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", fmt.Sprintf("%s; charset=\"utf-8\"", contentType))
+	header.Set("MIME-Version", "1.0")
+	header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	if mergeType != "" {
+		header.Set("Merge-Type", mergeType)
+	}
+
+	part, err := w.CreatePart(header)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create MIME part %s", filename)
+	}
+	if _, err := part.Write([]byte(content)); err != nil {
+		return errors.Wrapf(err, "failed to write MIME part %s", filename)
+	}
+	return nil
+}
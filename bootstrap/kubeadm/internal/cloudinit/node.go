@@ -31,10 +31,17 @@ const (
     content: "This placeholder file is used to create the /run/cluster-api sub directory in a way that is compatible with both Linux and Windows (mkdir -p /run/cluster-api does not work with Windows)"
 {{- template "boot_commands" .BootCommands }}
 runcmd:
+{{- if .ReportBootstrapProgress }}
+  - {{ BootstrapProgress "FilesWritten" }}
+{{- end }}
 {{- template "commands" .PreKubeadmCommands }}
-  - {{ .KubeadmCommand }} && {{ .SentinelFileCommand }}
+{{- if .ReportBootstrapProgress }}
+  - {{ BootstrapProgress "KubeadmStarted" }}
+{{- end }}
+  - {{ .KubeadmCommand }} && {{ if .ReportBootstrapProgress }}{{ BootstrapProgress "KubeadmFinished" }} && {{ end }}{{ .SentinelFileCommand }}
 {{- template "commands" .PostKubeadmCommands }}
 {{- template "ntp" .NTP }}
+{{- template "ca_certs" .TrustedCertificateAuthorities }}
 {{- template "users" .Users }}
 {{- template "disk_setup" .DiskSetup}}
 {{- template "fs_setup" .DiskSetup}}
@@ -52,5 +59,10 @@ type NodeInput struct {
 func NewNode(input *NodeInput) ([]byte, error) {
 	input.prepare()
 	input.Header = cloudConfigHeader
-	return generate("Node", nodeCloudInit, input)
+	userData, err := generate("Node", nodeCloudInit, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return withAdditionalUserData(userData, input.AdditionalUserData)
 }
@@ -17,16 +17,22 @@ limitations under the License.
 package cloudinit
 
 import (
+	"fmt"
 	"strings"
 	"text/template"
 )
 
 var (
 	defaultTemplateFuncMap = template.FuncMap{
-		"Indent": templateYAMLIndent,
+		"Indent":            templateYAMLIndent,
+		"BootstrapProgress": templateBootstrapProgress,
 	}
 )
 
+func templateBootstrapProgress(phase string) string {
+	return fmt.Sprintf(bootstrapProgressCommand, phase)
+}
+
 func templateYAMLIndent(i int, input string) string {
 	split := strings.Split(input, "\n")
 	ident := "\n" + strings.Repeat(" ", i)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/blang/semver/v4"
@@ -48,6 +49,7 @@ import (
 	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/internal/cloudinit"
 	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/internal/ignition"
 	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/internal/locking"
+	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/internal/templating"
 	kubeadmtypes "sigs.k8s.io/cluster-api/bootstrap/kubeadm/types"
 	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/types/upstream"
 	bsutil "sigs.k8s.io/cluster-api/bootstrap/util"
@@ -55,6 +57,7 @@ import (
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/util/taints"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 	clog "sigs.k8s.io/cluster-api/util/log"
@@ -260,6 +263,7 @@ func (r *KubeadmConfigReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 				bootstrapv1.KubeadmConfigReadyCondition,
 				bootstrapv1.KubeadmConfigDataSecretAvailableCondition,
 				bootstrapv1.KubeadmConfigCertificatesAvailableCondition,
+				bootstrapv1.KubeadmConfigBootstrapCommandsSucceededCondition,
 			}},
 		}
 		if rerr == nil {
@@ -291,6 +295,8 @@ func (r *KubeadmConfigReconciler) reconcile(ctx context.Context, scope *Scope, c
 	if err := r.ensureBootstrapSecretOwnersRef(ctx, scope); err != nil {
 		return ctrl.Result{}, err
 	}
+	setBootstrapCommandsSucceededCondition(config, configOwner)
+
 	switch {
 	// Wait for the infrastructure to be provisioned.
 	case !ptr.Deref(cluster.Status.Initialization.InfrastructureProvisioned, false):
@@ -338,6 +344,9 @@ func (r *KubeadmConfigReconciler) reconcile(ctx context.Context, scope *Scope, c
 			Status: metav1.ConditionTrue,
 			Reason: bootstrapv1.KubeadmConfigCertificatesAvailableReason,
 		})
+		if requestID, ok := rebootstrapRequested(config); ok && !configOwner.IsMachinePool() && !configOwner.HasNodeRefs() {
+			return r.rebootstrap(ctx, config, cluster, scope, requestID)
+		}
 		if config.Spec.JoinConfiguration.Discovery.BootstrapToken.IsDefined() {
 			if !configOwner.HasNodeRefs() {
 				// If the BootstrapToken has been generated for a join but the config owner has no nodeRefs,
@@ -375,6 +384,46 @@ func (r *KubeadmConfigReconciler) reconcile(ctx context.Context, scope *Scope, c
 	return r.joinWorker(ctx, scope)
 }
 
+// setBootstrapCommandsSucceededCondition surfaces failures of the commands run to bootstrap a Machine, as reported by
+// the Machine's NodeBootstrapped condition, if diagnostics are enabled.
+// NOTE: Failures are reported by the InfrastructureMachine, so the condition can be False only for infrastructure
+// providers implementing the bootstrap diagnostics contract.
+func setBootstrapCommandsSucceededCondition(config *bootstrapv1.KubeadmConfig, configOwner *bsutil.ConfigOwner) {
+	if !ptr.Deref(config.Spec.Diagnostics.Enabled, false) || configOwner.IsMachinePool() {
+		if conditions.Has(config, bootstrapv1.KubeadmConfigBootstrapCommandsSucceededCondition) {
+			conditions.Delete(config, bootstrapv1.KubeadmConfigBootstrapCommandsSucceededCondition)
+		}
+		return
+	}
+
+	if nodeBootstrapped, err := conditions.UnstructuredGet(configOwner, clusterv1.MachineNodeBootstrappedCondition); err == nil &&
+		nodeBootstrapped != nil && nodeBootstrapped.Reason == clusterv1.MachineNodeBootstrapCommandFailedReason {
+		conditions.Set(config, metav1.Condition{
+			Type:    bootstrapv1.KubeadmConfigBootstrapCommandsSucceededCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  bootstrapv1.KubeadmConfigBootstrapCommandFailedReason,
+			Message: nodeBootstrapped.Message,
+		})
+		return
+	}
+
+	if configOwner.HasNodeRefs() {
+		conditions.Set(config, metav1.Condition{
+			Type:   bootstrapv1.KubeadmConfigBootstrapCommandsSucceededCondition,
+			Status: metav1.ConditionTrue,
+			Reason: bootstrapv1.KubeadmConfigBootstrapCommandsSucceededReason,
+		})
+		return
+	}
+
+	conditions.Set(config, metav1.Condition{
+		Type:    bootstrapv1.KubeadmConfigBootstrapCommandsSucceededCondition,
+		Status:  metav1.ConditionUnknown,
+		Reason:  bootstrapv1.KubeadmConfigBootstrapCommandsRunningReason,
+		Message: "Waiting for the Machine's Node to complete bootstrap",
+	})
+}
+
 func (r *KubeadmConfigReconciler) refreshBootstrapTokenIfNeeded(ctx context.Context, config *bootstrapv1.KubeadmConfig, cluster *clusterv1.Cluster, scope *Scope) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	token := config.Spec.JoinConfiguration.Discovery.BootstrapToken.Token
@@ -433,6 +482,55 @@ func (r *KubeadmConfigReconciler) refreshBootstrapTokenIfNeeded(ctx context.Cont
 	}, nil
 }
 
+// rebootstrapRequested returns the identifier of a pending request to regenerate the bootstrap data, if any.
+func rebootstrapRequested(config *bootstrapv1.KubeadmConfig) (string, bool) {
+	requestID, ok := config.GetAnnotations()[clusterv1.MachineRebootstrapRequestedAnnotation]
+	if !ok || config.GetAnnotations()[clusterv1.MachineRebootstrapCompletedAnnotation] == requestID {
+		return "", false
+	}
+	return requestID, true
+}
+
+// rebootstrap regenerates the bootstrap data with a new bootstrap token.
+func (r *KubeadmConfigReconciler) rebootstrap(ctx context.Context, config *bootstrapv1.KubeadmConfig, cluster *clusterv1.Cluster, scope *Scope, requestID string) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx).WithValues("request", requestID)
+
+	// Note: bootstrap data for the Machine initializing the control plane and bootstrap data using file discovery
+	// do not embed a bootstrap token, so there is nothing that can expire and the bootstrap data is not regenerated.
+	if !config.Spec.JoinConfiguration.Discovery.BootstrapToken.IsDefined() {
+		log.Info("Bootstrap data does not embed a bootstrap token, skipping regeneration of bootstrap data")
+		annotations.AddAnnotations(config, map[string]string{clusterv1.MachineRebootstrapCompletedAnnotation: requestID})
+		return ctrl.Result{}, nil
+	}
+
+	remoteClient, err := r.ClusterCache.GetClient(ctx, util.ObjectKey(cluster))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	token, err := createToken(ctx, remoteClient, r.TokenTTL)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to create new bootstrap token")
+	}
+	config.Spec.JoinConfiguration.Discovery.BootstrapToken.Token = token
+	log.V(3).Info("Altering JoinConfiguration.Discovery.BootstrapToken.Token")
+
+	// Update the bootstrap data.
+	var res ctrl.Result
+	if scope.ConfigOwner.IsControlPlaneMachine() {
+		res, err = r.joinControlplane(ctx, scope)
+	} else {
+		res, err = r.joinWorker(ctx, scope)
+	}
+	if err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to regenerate bootstrap data")
+	}
+
+	annotations.AddAnnotations(config, map[string]string{clusterv1.MachineRebootstrapCompletedAnnotation: requestID})
+	log.Info("Regenerated bootstrap data with a new bootstrap token")
+	return res, nil
+}
+
 func (r *KubeadmConfigReconciler) recreateBootstrapToken(ctx context.Context, config *bootstrapv1.KubeadmConfig, scope *Scope, remoteClient client.Client) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -579,7 +677,19 @@ func (r *KubeadmConfigReconciler) handleClusterNotInitialized(ctx context.Contex
 		verbosityFlag = fmt.Sprintf("--v %s", strconv.Itoa(int(*scope.Config.Spec.Verbosity)))
 	}
 
-	files, err := r.resolveFiles(ctx, scope.Config)
+	config, err := r.resolveTemplates(scope)
+	if err != nil {
+		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		conditions.Set(scope.Config, metav1.Condition{
+			Type:    bootstrapv1.KubeadmConfigDataSecretAvailableCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  bootstrapv1.KubeadmConfigDataSecretNotAvailableReason,
+			Message: fmt.Sprintf("Failed to render templates: %s", err.Error()),
+		})
+		return ctrl.Result{}, err
+	}
+
+	files, err := r.resolveFiles(ctx, config)
 	if err != nil {
 		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
 		conditions.Set(scope.Config, metav1.Condition{
@@ -591,7 +701,7 @@ func (r *KubeadmConfigReconciler) handleClusterNotInitialized(ctx context.Contex
 		return ctrl.Result{}, err
 	}
 
-	users, err := r.resolveUsers(ctx, scope.Config)
+	users, err := r.resolveUsers(ctx, config)
 	if err != nil {
 		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
 		conditions.Set(scope.Config, metav1.Condition{
@@ -603,23 +713,55 @@ func (r *KubeadmConfigReconciler) handleClusterNotInitialized(ctx context.Contex
 		return ctrl.Result{}, err
 	}
 
+	trustedCAs, err := r.resolveTrustedCertificateAuthorities(ctx, scope.Config)
+	if err != nil {
+		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		conditions.Set(scope.Config, metav1.Condition{
+			Type:    bootstrapv1.KubeadmConfigDataSecretAvailableCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  bootstrapv1.KubeadmConfigDataSecretNotAvailableReason,
+			Message: "Failed to read certificate authorities for spec.trustedCertificateAuthorities",
+		})
+		return ctrl.Result{}, err
+	}
+
+	additionalUserData, err := r.resolveAdditionalUserData(ctx, scope.Config)
+	if err != nil {
+		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		conditions.Set(scope.Config, metav1.Condition{
+			Type:    bootstrapv1.KubeadmConfigDataSecretAvailableCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  bootstrapv1.KubeadmConfigDataSecretNotAvailableReason,
+			Message: "Failed to read additional user data for spec.additionalUserData",
+		})
+		return ctrl.Result{}, err
+	}
+
 	controlPlaneInput := &cloudinit.ControlPlaneInput{
 		BaseUserData: cloudinit.BaseUserData{
 			AdditionalFiles: files,
 			NTP: func() *bootstrapv1.NTP {
-				if scope.Config.Spec.NTP.IsDefined() {
-					return &scope.Config.Spec.NTP
+				if config.Spec.NTP.IsDefined() {
+					return &config.Spec.NTP
 				}
 				return nil
 			}(),
-			BootCommands:        scope.Config.Spec.BootCommands,
-			PreKubeadmCommands:  scope.Config.Spec.PreKubeadmCommands,
-			PostKubeadmCommands: scope.Config.Spec.PostKubeadmCommands,
-			Users:               users,
-			Mounts:              scope.Config.Spec.Mounts,
+			BootCommands:                  scope.Config.Spec.BootCommands,
+			PreKubeadmCommands:            scope.Config.Spec.PreKubeadmCommands,
+			PostKubeadmCommands:           scope.Config.Spec.PostKubeadmCommands,
+			Users:                         users,
+			TrustedCertificateAuthorities: trustedCAs,
+			AdditionalUserData:            additionalUserData,
+			Mounts:                        scope.Config.Spec.Mounts,
 			DiskSetup: func() *bootstrapv1.DiskSetup {
-				if scope.Config.Spec.DiskSetup.IsDefined() {
-					return &scope.Config.Spec.DiskSetup
+				if config.Spec.DiskSetup.IsDefined() {
+					return &config.Spec.DiskSetup
+				}
+				return nil
+			}(),
+			Diagnostics: func() *bootstrapv1.BootstrapDiagnostics {
+				if scope.Config.Spec.Diagnostics.IsDefined() {
+					return &scope.Config.Spec.Diagnostics
 				}
 				return nil
 			}(),
@@ -647,6 +789,11 @@ func (r *KubeadmConfigReconciler) handleClusterNotInitialized(ctx context.Contex
 		return ctrl.Result{}, err
 	}
 
+	if err := setClusterCAHashAnnotation(scope.Config, certificates); err != nil {
+		scope.Error(err, "Failed to compute cluster CA certificates hash")
+		return ctrl.Result{}, err
+	}
+
 	if err := r.storeBootstrapData(ctx, scope, bootstrapInitData); err != nil {
 		scope.Error(err, "Failed to store bootstrap data")
 		return ctrl.Result{}, err
@@ -731,7 +878,19 @@ func (r *KubeadmConfigReconciler) joinWorker(ctx context.Context, scope *Scope)
 		verbosityFlag = fmt.Sprintf("--v %s", strconv.Itoa(int(*scope.Config.Spec.Verbosity)))
 	}
 
-	files, err := r.resolveFiles(ctx, scope.Config)
+	config, err := r.resolveTemplates(scope)
+	if err != nil {
+		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		conditions.Set(scope.Config, metav1.Condition{
+			Type:    bootstrapv1.KubeadmConfigDataSecretAvailableCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  bootstrapv1.KubeadmConfigDataSecretNotAvailableReason,
+			Message: fmt.Sprintf("Failed to render templates: %s", err.Error()),
+		})
+		return ctrl.Result{}, err
+	}
+
+	files, err := r.resolveFiles(ctx, config)
 	if err != nil {
 		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
 		conditions.Set(scope.Config, metav1.Condition{
@@ -743,7 +902,7 @@ func (r *KubeadmConfigReconciler) joinWorker(ctx context.Context, scope *Scope)
 		return ctrl.Result{}, err
 	}
 
-	users, err := r.resolveUsers(ctx, scope.Config)
+	users, err := r.resolveUsers(ctx, config)
 	if err != nil {
 		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
 		conditions.Set(scope.Config, metav1.Condition{
@@ -755,6 +914,30 @@ func (r *KubeadmConfigReconciler) joinWorker(ctx context.Context, scope *Scope)
 		return ctrl.Result{}, err
 	}
 
+	trustedCAs, err := r.resolveTrustedCertificateAuthorities(ctx, scope.Config)
+	if err != nil {
+		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		conditions.Set(scope.Config, metav1.Condition{
+			Type:    bootstrapv1.KubeadmConfigDataSecretAvailableCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  bootstrapv1.KubeadmConfigDataSecretNotAvailableReason,
+			Message: "Failed to read certificate authorities for spec.trustedCertificateAuthorities",
+		})
+		return ctrl.Result{}, err
+	}
+
+	additionalUserData, err := r.resolveAdditionalUserData(ctx, scope.Config)
+	if err != nil {
+		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		conditions.Set(scope.Config, metav1.Condition{
+			Type:    bootstrapv1.KubeadmConfigDataSecretAvailableCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  bootstrapv1.KubeadmConfigDataSecretNotAvailableReason,
+			Message: "Failed to read additional user data for spec.additionalUserData",
+		})
+		return ctrl.Result{}, err
+	}
+
 	if discoveryFile := scope.Config.Spec.JoinConfiguration.Discovery.File; discoveryFile.KubeConfig.IsDefined() {
 		kubeconfig, err := r.resolveDiscoveryKubeConfig(discoveryFile)
 		if err != nil {
@@ -774,19 +957,27 @@ func (r *KubeadmConfigReconciler) joinWorker(ctx context.Context, scope *Scope)
 		BaseUserData: cloudinit.BaseUserData{
 			AdditionalFiles: files,
 			NTP: func() *bootstrapv1.NTP {
-				if scope.Config.Spec.NTP.IsDefined() {
-					return &scope.Config.Spec.NTP
+				if config.Spec.NTP.IsDefined() {
+					return &config.Spec.NTP
 				}
 				return nil
 			}(),
-			BootCommands:        scope.Config.Spec.BootCommands,
-			PreKubeadmCommands:  scope.Config.Spec.PreKubeadmCommands,
-			PostKubeadmCommands: scope.Config.Spec.PostKubeadmCommands,
-			Users:               users,
-			Mounts:              scope.Config.Spec.Mounts,
+			BootCommands:                  scope.Config.Spec.BootCommands,
+			PreKubeadmCommands:            scope.Config.Spec.PreKubeadmCommands,
+			PostKubeadmCommands:           scope.Config.Spec.PostKubeadmCommands,
+			Users:                         users,
+			TrustedCertificateAuthorities: trustedCAs,
+			AdditionalUserData:            additionalUserData,
+			Mounts:                        scope.Config.Spec.Mounts,
 			DiskSetup: func() *bootstrapv1.DiskSetup {
-				if scope.Config.Spec.DiskSetup.IsDefined() {
-					return &scope.Config.Spec.DiskSetup
+				if config.Spec.DiskSetup.IsDefined() {
+					return &config.Spec.DiskSetup
+				}
+				return nil
+			}(),
+			Diagnostics: func() *bootstrapv1.BootstrapDiagnostics {
+				if scope.Config.Spec.Diagnostics.IsDefined() {
+					return &scope.Config.Spec.Diagnostics
 				}
 				return nil
 			}(),
@@ -812,6 +1003,11 @@ func (r *KubeadmConfigReconciler) joinWorker(ctx context.Context, scope *Scope)
 		return ctrl.Result{}, err
 	}
 
+	if err := setClusterCAHashAnnotation(scope.Config, certificates); err != nil {
+		scope.Error(err, "Failed to compute cluster CA certificates hash")
+		return ctrl.Result{}, err
+	}
+
 	if err := r.storeBootstrapData(ctx, scope, bootstrapJoinData); err != nil {
 		scope.Error(err, "Failed to store bootstrap data")
 		return ctrl.Result{}, err
@@ -891,7 +1087,19 @@ func (r *KubeadmConfigReconciler) joinControlplane(ctx context.Context, scope *S
 		verbosityFlag = fmt.Sprintf("--v %s", strconv.Itoa(int(*scope.Config.Spec.Verbosity)))
 	}
 
-	files, err := r.resolveFiles(ctx, scope.Config)
+	config, err := r.resolveTemplates(scope)
+	if err != nil {
+		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		conditions.Set(scope.Config, metav1.Condition{
+			Type:    bootstrapv1.KubeadmConfigDataSecretAvailableCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  bootstrapv1.KubeadmConfigDataSecretNotAvailableReason,
+			Message: fmt.Sprintf("Failed to render templates: %s", err.Error()),
+		})
+		return ctrl.Result{}, err
+	}
+
+	files, err := r.resolveFiles(ctx, config)
 	if err != nil {
 		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
 		conditions.Set(scope.Config, metav1.Condition{
@@ -903,7 +1111,7 @@ func (r *KubeadmConfigReconciler) joinControlplane(ctx context.Context, scope *S
 		return ctrl.Result{}, err
 	}
 
-	users, err := r.resolveUsers(ctx, scope.Config)
+	users, err := r.resolveUsers(ctx, config)
 	if err != nil {
 		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
 		conditions.Set(scope.Config, metav1.Condition{
@@ -915,6 +1123,30 @@ func (r *KubeadmConfigReconciler) joinControlplane(ctx context.Context, scope *S
 		return ctrl.Result{}, err
 	}
 
+	trustedCAs, err := r.resolveTrustedCertificateAuthorities(ctx, scope.Config)
+	if err != nil {
+		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		conditions.Set(scope.Config, metav1.Condition{
+			Type:    bootstrapv1.KubeadmConfigDataSecretAvailableCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  bootstrapv1.KubeadmConfigDataSecretNotAvailableReason,
+			Message: "Failed to read certificate authorities for spec.trustedCertificateAuthorities",
+		})
+		return ctrl.Result{}, err
+	}
+
+	additionalUserData, err := r.resolveAdditionalUserData(ctx, scope.Config)
+	if err != nil {
+		v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		conditions.Set(scope.Config, metav1.Condition{
+			Type:    bootstrapv1.KubeadmConfigDataSecretAvailableCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  bootstrapv1.KubeadmConfigDataSecretNotAvailableReason,
+			Message: "Failed to read additional user data for spec.additionalUserData",
+		})
+		return ctrl.Result{}, err
+	}
+
 	if discoveryFile := scope.Config.Spec.JoinConfiguration.Discovery.File; discoveryFile.KubeConfig.IsDefined() {
 		kubeconfig, err := r.resolveDiscoveryKubeConfig(discoveryFile)
 		if err != nil {
@@ -936,19 +1168,27 @@ func (r *KubeadmConfigReconciler) joinControlplane(ctx context.Context, scope *S
 		BaseUserData: cloudinit.BaseUserData{
 			AdditionalFiles: files,
 			NTP: func() *bootstrapv1.NTP {
-				if scope.Config.Spec.NTP.IsDefined() {
-					return &scope.Config.Spec.NTP
+				if config.Spec.NTP.IsDefined() {
+					return &config.Spec.NTP
 				}
 				return nil
 			}(),
-			BootCommands:        scope.Config.Spec.BootCommands,
-			PreKubeadmCommands:  scope.Config.Spec.PreKubeadmCommands,
-			PostKubeadmCommands: scope.Config.Spec.PostKubeadmCommands,
-			Users:               users,
-			Mounts:              scope.Config.Spec.Mounts,
+			BootCommands:                  scope.Config.Spec.BootCommands,
+			PreKubeadmCommands:            scope.Config.Spec.PreKubeadmCommands,
+			PostKubeadmCommands:           scope.Config.Spec.PostKubeadmCommands,
+			Users:                         users,
+			TrustedCertificateAuthorities: trustedCAs,
+			AdditionalUserData:            additionalUserData,
+			Mounts:                        scope.Config.Spec.Mounts,
 			DiskSetup: func() *bootstrapv1.DiskSetup {
-				if scope.Config.Spec.DiskSetup.IsDefined() {
-					return &scope.Config.Spec.DiskSetup
+				if config.Spec.DiskSetup.IsDefined() {
+					return &config.Spec.DiskSetup
+				}
+				return nil
+			}(),
+			Diagnostics: func() *bootstrapv1.BootstrapDiagnostics {
+				if scope.Config.Spec.Diagnostics.IsDefined() {
+					return &scope.Config.Spec.Diagnostics
 				}
 				return nil
 			}(),
@@ -973,6 +1213,11 @@ func (r *KubeadmConfigReconciler) joinControlplane(ctx context.Context, scope *S
 		return ctrl.Result{}, err
 	}
 
+	if err := setClusterCAHashAnnotation(scope.Config, certificates); err != nil {
+		scope.Error(err, "Failed to compute cluster CA certificates hash")
+		return ctrl.Result{}, err
+	}
+
 	if err := r.storeBootstrapData(ctx, scope, bootstrapJoinData); err != nil {
 		scope.Error(err, "Failed to store bootstrap data")
 		return ctrl.Result{}, err
@@ -982,6 +1227,24 @@ func (r *KubeadmConfigReconciler) joinControlplane(ctx context.Context, scope *S
 	return ctrl.Result{RequeueAfter: r.tokenCheckRefreshOrRotationInterval()}, nil
 }
 
+// resolveTemplates returns the KubeadmConfig to be used to generate the bootstrap data; if templating is enabled,
+// Go-template expressions in files, users, ntp and diskSetup are rendered using the builtin variables of the Cluster.
+// NOTE: The returned KubeadmConfig is a copy which must only be used to generate the bootstrap data, so rendered
+// values are never written back to the KubeadmConfig.
+func (r *KubeadmConfigReconciler) resolveTemplates(scope *Scope) (*bootstrapv1.KubeadmConfig, error) {
+	if !scope.Config.Spec.Templating.IsEnabled() {
+		return scope.Config, nil
+	}
+
+	spec, err := templating.Render(&scope.Config.Spec, scope.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	config := scope.Config.DeepCopy()
+	config.Spec = *spec
+	return config, nil
+}
+
 // resolveFiles maps .Spec.Files into cloudinit.Files, resolving any object references
 // along the way.
 func (r *KubeadmConfigReconciler) resolveFiles(ctx context.Context, cfg *bootstrapv1.KubeadmConfig) ([]bootstrapv1.File, error) {
@@ -1042,6 +1305,126 @@ func (r *KubeadmConfigReconciler) resolveUsers(ctx context.Context, cfg *bootstr
 	return collected, nil
 }
 
+// resolveTrustedCertificateAuthorities returns the PEM encoded certificate authority bundles referenced
+// in .Spec.TrustedCertificateAuthorities.
+func (r *KubeadmConfigReconciler) resolveTrustedCertificateAuthorities(ctx context.Context, cfg *bootstrapv1.KubeadmConfig) ([]string, error) {
+	collected := make([]string, 0, len(cfg.Spec.TrustedCertificateAuthorities))
+
+	for _, ca := range cfg.Spec.TrustedCertificateAuthorities {
+		var data []byte
+		var err error
+		switch {
+		case ca.Secret.IsDefined():
+			data, err = r.resolveSecretTrustedCertificateAuthority(ctx, cfg.Namespace, ca.Secret)
+		case ca.ConfigMap.IsDefined():
+			data, err = r.resolveConfigMapTrustedCertificateAuthority(ctx, cfg.Namespace, ca.ConfigMap)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve trusted certificate authority source")
+		}
+		collected = append(collected, strings.TrimSpace(string(data)))
+	}
+
+	return collected, nil
+}
+
+// resolveSecretTrustedCertificateAuthority returns a certificate authority bundle fetched from a referenced secret object.
+func (r *KubeadmConfigReconciler) resolveSecretTrustedCertificateAuthority(ctx context.Context, ns string, source bootstrapv1.TrustedCertificateAuthoritySource) ([]byte, error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: ns, Name: source.Name}
+	if err := r.Client.Get(ctx, key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "secret not found: %s", key)
+		}
+		return nil, errors.Wrapf(err, "failed to retrieve Secret %q", key)
+	}
+	data, ok := secret.Data[source.Key]
+	if !ok {
+		return nil, errors.Errorf("secret references non-existent secret key: %q", source.Key)
+	}
+	return data, nil
+}
+
+// resolveConfigMapTrustedCertificateAuthority returns a certificate authority bundle fetched from a referenced config map object.
+func (r *KubeadmConfigReconciler) resolveConfigMapTrustedCertificateAuthority(ctx context.Context, ns string, source bootstrapv1.TrustedCertificateAuthoritySource) ([]byte, error) {
+	configMap := &corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: ns, Name: source.Name}
+	if err := r.Client.Get(ctx, key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "config map not found: %s", key)
+		}
+		return nil, errors.Wrapf(err, "failed to retrieve ConfigMap %q", key)
+	}
+	data, ok := configMap.Data[source.Key]
+	if !ok {
+		return nil, errors.Errorf("config map references non-existent config map key: %q", source.Key)
+	}
+	return []byte(data), nil
+}
+
+// resolveAdditionalUserData returns the cloud-init documents referenced in .Spec.AdditionalUserData.
+func (r *KubeadmConfigReconciler) resolveAdditionalUserData(ctx context.Context, cfg *bootstrapv1.KubeadmConfig) ([]cloudinit.AdditionalUserData, error) {
+	collected := make([]cloudinit.AdditionalUserData, 0, len(cfg.Spec.AdditionalUserData))
+
+	for _, userData := range cfg.Spec.AdditionalUserData {
+		var data []byte
+		var err error
+		switch {
+		case userData.Secret.IsDefined():
+			data, err = r.resolveSecretAdditionalUserData(ctx, cfg.Namespace, userData.Secret)
+		case userData.ConfigMap.IsDefined():
+			data, err = r.resolveConfigMapAdditionalUserData(ctx, cfg.Namespace, userData.ConfigMap)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve additional user data source")
+		}
+		collected = append(collected, cloudinit.AdditionalUserData{
+			Content:   string(data),
+			MergeType: userData.MergeType,
+		})
+	}
+
+	return collected, nil
+}
+
+// resolveSecretAdditionalUserData returns a cloud-init document fetched from a referenced secret object.
+func (r *KubeadmConfigReconciler) resolveSecretAdditionalUserData(ctx context.Context, ns string, source bootstrapv1.AdditionalUserDataSource) ([]byte, error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: ns, Name: source.Name}
+	if err := r.Client.Get(ctx, key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "secret not found: %s", key)
+		}
+		return nil, errors.Wrapf(err, "failed to retrieve Secret %q", key)
+	}
+	data, ok := secret.Data[source.Key]
+	if !ok {
+		return nil, errors.Errorf("secret references non-existent secret key: %q", source.Key)
+	}
+	return data, nil
+}
+
+// resolveConfigMapAdditionalUserData returns a cloud-init document fetched from a referenced config map object.
+func (r *KubeadmConfigReconciler) resolveConfigMapAdditionalUserData(ctx context.Context, ns string, source bootstrapv1.AdditionalUserDataSource) ([]byte, error) {
+	configMap := &corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: ns, Name: source.Name}
+	if err := r.Client.Get(ctx, key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "config map not found: %s", key)
+		}
+		return nil, errors.Wrapf(err, "failed to retrieve ConfigMap %q", key)
+	}
+	data, ok := configMap.Data[source.Key]
+	if !ok {
+		return nil, errors.Errorf("config map references non-existent config map key: %q", source.Key)
+	}
+	return []byte(data), nil
+}
+
 func (r *KubeadmConfigReconciler) resolveDiscoveryKubeConfig(cfg bootstrapv1.FileDiscovery) (*bootstrapv1.File, error) {
 	cluster := clientcmdv1.Cluster{
 		Server:                   cfg.KubeConfig.Cluster.Server,
@@ -1353,6 +1736,24 @@ func (r *KubeadmConfigReconciler) computeClusterConfigurationAndAdditionalData(c
 	return data
 }
 
+// setClusterCAHashAnnotation tracks the hash of the cluster CA certificates embedded in the bootstrap data,
+// thus allowing to detect Machines bootstrapped before the cluster CA certificates changed, e.g. during a CA rotation.
+func setClusterCAHashAnnotation(config *bootstrapv1.KubeadmConfig, certificates secret.Certificates) error {
+	clusterCA := certificates.GetByPurpose(secret.ClusterCA)
+	if clusterCA == nil || clusterCA.KeyPair == nil {
+		return nil
+	}
+	hash, err := clusterCA.BundleHash()
+	if err != nil {
+		return err
+	}
+	if config.Annotations == nil {
+		config.Annotations = map[string]string{}
+	}
+	config.Annotations[bootstrapv1.ClusterCAHashAnnotation] = hash
+	return nil
+}
+
 // storeBootstrapData creates a new secret with the data passed in as input,
 // sets the reference in the configuration status and ready to true.
 func (r *KubeadmConfigReconciler) storeBootstrapData(ctx context.Context, scope *Scope, data []byte) error {
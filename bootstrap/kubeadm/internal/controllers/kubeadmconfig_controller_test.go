@@ -27,6 +27,8 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	bootstrapapi "k8s.io/cluster-bootstrap/token/api"
 	utilfeature "k8s.io/component-base/featuregate/testing"
@@ -40,6 +42,8 @@ import (
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	bootstrapbuilder "sigs.k8s.io/cluster-api/bootstrap/kubeadm/internal/builder"
+	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/internal/cloudinit"
+	bsutil "sigs.k8s.io/cluster-api/bootstrap/util"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/util"
@@ -532,6 +536,7 @@ func TestKubeadmConfigReconciler_Reconcile_GenerateCloudConfigData(t *testing.T)
 	g.Expect(ptr.Deref(cfg.Status.Initialization.DataSecretCreated, false)).To(BeTrue())
 	g.Expect(cfg.Status.DataSecretName).NotTo(BeEmpty())
 	g.Expect(cfg.Status.ObservedGeneration).NotTo(BeNil())
+	g.Expect(cfg.Annotations).To(HaveKey(bootstrapv1.ClusterCAHashAnnotation))
 	assertHasTrueCondition(g, myclient, request, bootstrapv1.KubeadmConfigCertificatesAvailableCondition)
 	assertHasTrueCondition(g, myclient, request, bootstrapv1.KubeadmConfigDataSecretAvailableCondition)
 
@@ -1581,6 +1586,98 @@ func TestBootstrapTokenRefreshIfTokenSecretCleaned(t *testing.T) {
 	})
 }
 
+func TestBootstrapDataRegenerationOnRebootstrapRequest(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := builder.Cluster(metav1.NamespaceDefault, "cluster").Build()
+	cluster.Status.Initialization.InfrastructureProvisioned = ptr.To(true)
+	cluster.Status.Conditions = []metav1.Condition{{Type: clusterv1.ClusterControlPlaneInitializedCondition, Status: metav1.ConditionTrue}}
+	cluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{Host: "100.105.150.1", Port: 6443}
+
+	controlPlaneInitMachine := newControlPlaneMachine(cluster, "control-plane-init-machine")
+	initConfig := newControlPlaneInitKubeadmConfig(controlPlaneInitMachine.Namespace, "control-plane-init-config")
+
+	addKubeadmConfigToMachine(initConfig, controlPlaneInitMachine)
+
+	workerMachine := newWorkerMachineForCluster(cluster)
+	workerJoinConfig := newWorkerJoinKubeadmConfig(metav1.NamespaceDefault, "worker-join-cfg")
+	addKubeadmConfigToMachine(workerJoinConfig, workerMachine)
+	objects := []client.Object{
+		cluster,
+		workerMachine,
+		workerJoinConfig,
+	}
+
+	objects = append(objects, createSecrets(t, cluster, initConfig)...)
+	myclient := fake.NewClientBuilder().WithObjects(objects...).WithStatusSubresource(&bootstrapv1.KubeadmConfig{}).Build()
+	remoteClient := fake.NewClientBuilder().Build()
+	k := &KubeadmConfigReconciler{
+		Client:              myclient,
+		SecretCachingClient: myclient,
+		KubeadmInitLock:     &myInitLocker{},
+		TokenTTL:            DefaultTokenTTL,
+		ClusterCache:        clustercache.NewFakeClusterCache(remoteClient, client.ObjectKey{Name: cluster.Name, Namespace: cluster.Namespace}),
+	}
+	request := ctrl.Request{
+		NamespacedName: client.ObjectKey{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "worker-join-cfg",
+		},
+	}
+	result, err := k.Reconcile(ctx, request)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(k.TokenTTL / 3))
+
+	cfg, err := getKubeadmConfig(myclient, "worker-join-cfg", metav1.NamespaceDefault)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ptr.Deref(cfg.Status.Initialization.DataSecretCreated, false)).To(BeTrue())
+	firstToken := cfg.Spec.JoinConfiguration.Discovery.BootstrapToken.Token
+	g.Expect(firstToken).ToNot(BeEmpty())
+
+	dataSecret := &corev1.Secret{}
+	g.Expect(myclient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: cfg.Status.DataSecretName}, dataSecret)).To(Succeed())
+	g.Expect(string(dataSecret.Data["value"])).To(ContainSubstring(firstToken))
+
+	// Simulate token cleaner of Kubernetes having deleted the token secret.
+	l := &corev1.SecretList{}
+	g.Expect(remoteClient.List(ctx, l, client.ListOption(client.InNamespace(metav1.NamespaceSystem)))).To(Succeed())
+	g.Expect(l.Items).To(HaveLen(1))
+	g.Expect(remoteClient.Delete(ctx, &l.Items[0])).To(Succeed())
+
+	t.Log("Request regeneration of bootstrap data")
+	cfg.Annotations = map[string]string{clusterv1.MachineRebootstrapRequestedAnnotation: "request-1"}
+	g.Expect(myclient.Update(ctx, cfg)).To(Succeed())
+
+	result, err = k.Reconcile(ctx, request)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(k.TokenTTL / 3))
+
+	cfg, err = getKubeadmConfig(myclient, "worker-join-cfg", metav1.NamespaceDefault)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cfg.Annotations).To(HaveKeyWithValue(clusterv1.MachineRebootstrapCompletedAnnotation, "request-1"))
+	secondToken := cfg.Spec.JoinConfiguration.Discovery.BootstrapToken.Token
+	g.Expect(secondToken).ToNot(BeEmpty())
+	g.Expect(secondToken).ToNot(Equal(firstToken))
+
+	// The bootstrap data secret should have been updated with the new token.
+	g.Expect(myclient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: cfg.Status.DataSecretName}, dataSecret)).To(Succeed())
+	g.Expect(string(dataSecret.Data["value"])).To(ContainSubstring(secondToken))
+	g.Expect(string(dataSecret.Data["value"])).ToNot(ContainSubstring(firstToken))
+
+	l = &corev1.SecretList{}
+	g.Expect(remoteClient.List(ctx, l, client.ListOption(client.InNamespace(metav1.NamespaceSystem)))).To(Succeed())
+	g.Expect(l.Items).To(HaveLen(1))
+
+	t.Log("The same request should not regenerate bootstrap data again")
+	result, err = k.Reconcile(ctx, request)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(k.TokenTTL / 3))
+
+	cfg, err = getKubeadmConfig(myclient, "worker-join-cfg", metav1.NamespaceDefault)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cfg.Spec.JoinConfiguration.Discovery.BootstrapToken.Token).To(Equal(secondToken))
+}
+
 // Ensure the discovery portion of the JoinConfiguration gets generated correctly.
 func TestKubeadmConfigReconciler_Reconcile_DiscoveryReconcileBehaviors(t *testing.T) {
 	caHash := []string{"...."}
@@ -2519,6 +2616,271 @@ func TestKubeadmConfigReconciler_ResolveUsers(t *testing.T) {
 	}
 }
 
+func TestKubeadmConfigReconciler_ResolveTemplates(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster1",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+
+	cases := map[string]struct {
+		templating  bootstrapv1.BootstrapTemplating
+		expectFiles []bootstrapv1.File
+		expectErr   bool
+	}{
+		"templates are not rendered if templating is not enabled": {
+			expectFiles: []bootstrapv1.File{{Path: "/etc/cluster", Content: "{{ .builtin.cluster.name }}"}},
+		},
+		"templates are rendered if templating is enabled": {
+			templating:  bootstrapv1.BootstrapTemplating{Enabled: ptr.To(true)},
+			expectFiles: []bootstrapv1.File{{Path: "/etc/cluster", Content: "cluster1"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			config := &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cfg",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Files:      []bootstrapv1.File{{Path: "/etc/cluster", Content: "{{ .builtin.cluster.name }}"}},
+					Templating: tc.templating,
+				},
+			}
+			original := config.DeepCopy()
+
+			k := &KubeadmConfigReconciler{}
+			got, err := k.resolveTemplates(&Scope{Config: config, Cluster: cluster})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got.Spec.Files).To(BeComparableTo(tc.expectFiles))
+			// Rendered values must never be written back to the KubeadmConfig.
+			g.Expect(config).To(BeComparableTo(original))
+		})
+	}
+}
+
+func TestKubeadmConfigReconciler_ResolveTrustedCertificateAuthorities(t *testing.T) {
+	fakeCA := "-----BEGIN CERTIFICATE-----\nfoo\n-----END CERTIFICATE-----"
+	testSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "source",
+		},
+		Data: map[string][]byte{
+			"ca.crt": []byte(fakeCA + "\n"),
+		},
+	}
+	testConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "source",
+		},
+		Data: map[string]string{
+			"ca.crt": fakeCA,
+		},
+	}
+
+	cases := map[string]struct {
+		cfg       *bootstrapv1.KubeadmConfig
+		objects   []client.Object
+		expect    []string
+		expectErr bool
+	}{
+		"no certificate authorities": {
+			cfg:    &bootstrapv1.KubeadmConfig{},
+			expect: []string{},
+		},
+		"certificate authorities from a Secret and from a ConfigMap should be resolved": {
+			cfg: &bootstrapv1.KubeadmConfig{
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					TrustedCertificateAuthorities: []bootstrapv1.TrustedCertificateAuthority{
+						{
+							Secret: bootstrapv1.TrustedCertificateAuthoritySource{
+								Name: "source",
+								Key:  "ca.crt",
+							},
+						},
+						{
+							ConfigMap: bootstrapv1.TrustedCertificateAuthoritySource{
+								Name: "source",
+								Key:  "ca.crt",
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{testSecret, testConfigMap},
+			expect:  []string{fakeCA, fakeCA},
+		},
+		"missing Secret should fail": {
+			cfg: &bootstrapv1.KubeadmConfig{
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					TrustedCertificateAuthorities: []bootstrapv1.TrustedCertificateAuthority{
+						{
+							Secret: bootstrapv1.TrustedCertificateAuthoritySource{
+								Name: "source",
+								Key:  "ca.crt",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"missing ConfigMap key should fail": {
+			cfg: &bootstrapv1.KubeadmConfig{
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					TrustedCertificateAuthorities: []bootstrapv1.TrustedCertificateAuthority{
+						{
+							ConfigMap: bootstrapv1.TrustedCertificateAuthoritySource{
+								Name: "source",
+								Key:  "does-not-exist",
+							},
+						},
+					},
+				},
+			},
+			objects:   []client.Object{testConfigMap},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			myclient := fake.NewClientBuilder().WithObjects(tc.objects...).Build()
+			k := &KubeadmConfigReconciler{
+				Client:              myclient,
+				SecretCachingClient: myclient,
+				KubeadmInitLock:     &myInitLocker{},
+			}
+
+			cas, err := k.resolveTrustedCertificateAuthorities(ctx, tc.cfg)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(cas).To(Equal(tc.expect))
+		})
+	}
+}
+
+func TestKubeadmConfigReconciler_ResolveAdditionalUserData(t *testing.T) {
+	cloudConfig := "#cloud-config\npackages:\n  - jq\n"
+	shellScript := "#!/bin/bash\necho hello\n"
+	testSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "source",
+		},
+		Data: map[string][]byte{
+			"user-data": []byte(cloudConfig),
+		},
+	}
+	testConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "source",
+		},
+		Data: map[string]string{
+			"user-data": shellScript,
+		},
+	}
+
+	cases := map[string]struct {
+		cfg       *bootstrapv1.KubeadmConfig
+		objects   []client.Object
+		expect    []cloudinit.AdditionalUserData
+		expectErr bool
+	}{
+		"no additional user data": {
+			cfg:    &bootstrapv1.KubeadmConfig{},
+			expect: []cloudinit.AdditionalUserData{},
+		},
+		"additional user data from a Secret and from a ConfigMap should be resolved": {
+			cfg: &bootstrapv1.KubeadmConfig{
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					AdditionalUserData: []bootstrapv1.AdditionalUserData{
+						{
+							Secret: bootstrapv1.AdditionalUserDataSource{
+								Name: "source",
+								Key:  "user-data",
+							},
+							MergeType: "list(append)+dict(recurse_array)+str()",
+						},
+						{
+							ConfigMap: bootstrapv1.AdditionalUserDataSource{
+								Name: "source",
+								Key:  "user-data",
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{testSecret, testConfigMap},
+			expect: []cloudinit.AdditionalUserData{
+				{Content: cloudConfig, MergeType: "list(append)+dict(recurse_array)+str()"},
+				{Content: shellScript},
+			},
+		},
+		"missing Secret should fail": {
+			cfg: &bootstrapv1.KubeadmConfig{
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					AdditionalUserData: []bootstrapv1.AdditionalUserData{
+						{
+							Secret: bootstrapv1.AdditionalUserDataSource{
+								Name: "source",
+								Key:  "user-data",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"missing ConfigMap key should fail": {
+			cfg: &bootstrapv1.KubeadmConfig{
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					AdditionalUserData: []bootstrapv1.AdditionalUserData{
+						{
+							ConfigMap: bootstrapv1.AdditionalUserDataSource{
+								Name: "source",
+								Key:  "does-not-exist",
+							},
+						},
+					},
+				},
+			},
+			objects:   []client.Object{testConfigMap},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			myclient := fake.NewClientBuilder().WithObjects(tc.objects...).Build()
+			k := &KubeadmConfigReconciler{
+				Client:              myclient,
+				SecretCachingClient: myclient,
+				KubeadmInitLock:     &myInitLocker{},
+			}
+
+			userData, err := k.resolveAdditionalUserData(ctx, tc.cfg)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(userData).To(Equal(tc.expect))
+		})
+	}
+}
+
 // test utils.
 
 // newWorkerMachineForCluster returns a Machine with the passed Cluster's information and a pre-configured name.
@@ -2771,3 +3133,83 @@ func TestKubeadmConfigReconciler_Reconcile_v1beta2_conditions(t *testing.T) {
 		})
 	}
 }
+
+func TestSetBootstrapCommandsSucceededCondition(t *testing.T) {
+	commandFailed := metav1.Condition{
+		Type:    clusterv1.MachineNodeBootstrappedCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  clusterv1.MachineNodeBootstrapCommandFailedReason,
+		Message: "Bootstrap command preKubeadmCommands[0] failed with exit code 1",
+	}
+	configOwner := func(g *WithT, machine *clusterv1.Machine) *bsutil.ConfigOwner {
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(machine)
+		g.Expect(err).ToNot(HaveOccurred())
+		u := &unstructured.Unstructured{Object: obj}
+		u.SetKind("Machine")
+		return &bsutil.ConfigOwner{Unstructured: u}
+	}
+
+	tests := []struct {
+		name            string
+		diagnostics     bootstrapv1.BootstrapDiagnostics
+		machine         *clusterv1.Machine
+		expectCondition *metav1.Condition
+	}{
+		{
+			name:            "diagnostics are not enabled",
+			machine:         &clusterv1.Machine{Status: clusterv1.MachineStatus{Conditions: []metav1.Condition{commandFailed}}},
+			expectCondition: nil,
+		},
+		{
+			name:        "bootstrap commands are running",
+			diagnostics: bootstrapv1.BootstrapDiagnostics{Enabled: ptr.To(true)},
+			machine:     &clusterv1.Machine{},
+			expectCondition: &metav1.Condition{
+				Type:    bootstrapv1.KubeadmConfigBootstrapCommandsSucceededCondition,
+				Status:  metav1.ConditionUnknown,
+				Reason:  bootstrapv1.KubeadmConfigBootstrapCommandsRunningReason,
+				Message: "Waiting for the Machine's Node to complete bootstrap",
+			},
+		},
+		{
+			name:        "a bootstrap command failed",
+			diagnostics: bootstrapv1.BootstrapDiagnostics{Enabled: ptr.To(true)},
+			machine: &clusterv1.Machine{Status: clusterv1.MachineStatus{
+				NodeRef:    clusterv1.MachineNodeReference{Name: "node1"},
+				Conditions: []metav1.Condition{commandFailed},
+			}},
+			expectCondition: &metav1.Condition{
+				Type:    bootstrapv1.KubeadmConfigBootstrapCommandsSucceededCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  bootstrapv1.KubeadmConfigBootstrapCommandFailedReason,
+				Message: "Bootstrap command preKubeadmCommands[0] failed with exit code 1",
+			},
+		},
+		{
+			name:        "node registered",
+			diagnostics: bootstrapv1.BootstrapDiagnostics{Enabled: ptr.To(true)},
+			machine:     &clusterv1.Machine{Status: clusterv1.MachineStatus{NodeRef: clusterv1.MachineNodeReference{Name: "node1"}}},
+			expectCondition: &metav1.Condition{
+				Type:   bootstrapv1.KubeadmConfigBootstrapCommandsSucceededCondition,
+				Status: metav1.ConditionTrue,
+				Reason: bootstrapv1.KubeadmConfigBootstrapCommandsSucceededReason,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			config := &bootstrapv1.KubeadmConfig{Spec: bootstrapv1.KubeadmConfigSpec{Diagnostics: tt.diagnostics}}
+			setBootstrapCommandsSucceededCondition(config, configOwner(g, tt.machine))
+
+			condition := conditions.Get(config, bootstrapv1.KubeadmConfigBootstrapCommandsSucceededCondition)
+			if tt.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(*tt.expectCondition, conditions.IgnoreLastTransitionTime(true)))
+		})
+	}
+}
@@ -33,6 +33,7 @@ limitations under the License.
 // using AdditionalConfig field. Data from this field takes precedence and will be merged with
 // configuration generated by the bootstrap provider, overriding already defined fields following the
 // merge strategy described in https://coreos.github.io/ignition/operator-notes/#config-merging.
+// Additional Ignition configs, e.g. translated from passthrough configs, are merged last.
 package clc
 
 import (
@@ -207,12 +208,22 @@ storage:
         {{- end }}
           {{ .Content | Indent 10 }}
     {{- end }}
+    {{- range $i, $ca := .TrustedCertificateAuthorities }}
+    - path: /etc/ssl/certs/cluster-api-trusted-ca-{{ $i }}.pem
+      mode: 0644
+      contents:
+        inline: |
+          {{ $ca | Indent 10 }}
+    {{- end }}
     - path: /etc/kubeadm.sh
       mode: 0700
       contents:
         inline: |
           #!/bin/bash
           set -e
+          {{- if .TrustedCertificateAuthorities }}
+          update-ca-certificates
+          {{- end }}
           {{ range .PreKubeadmCommands }}
           {{ . | Indent 10 }}
           {{- end }}
@@ -351,7 +362,8 @@ func renderCLC(input *cloudinit.BaseUserData, kubeadmConfig string) ([]byte, err
 }
 
 // Render renders the provided user data and CLC snippets into Ignition config.
-func Render(input *cloudinit.BaseUserData, clc *bootstrapv1.ContainerLinuxConfig, kubeadmConfig string) ([]byte, string, error) {
+// The additional Ignition configs are merged in order after the CLC snippets.
+func Render(input *cloudinit.BaseUserData, clc *bootstrapv1.ContainerLinuxConfig, kubeadmConfig string, additionalConfigs ...ignitionTypes.Config) ([]byte, string, error) {
 	if input == nil {
 		return nil, "", errors.New("empty base user data")
 	}
@@ -361,7 +373,7 @@ func Render(input *cloudinit.BaseUserData, clc *bootstrapv1.ContainerLinuxConfig
 		return nil, "", errors.Wrapf(err, "rendering CLC configuration")
 	}
 
-	userData, warnings, err := buildIgnitionConfig(clcBytes, clc)
+	userData, warnings, err := buildIgnitionConfig(clcBytes, clc, additionalConfigs...)
 	if err != nil {
 		return nil, "", errors.Wrapf(err, "building Ignition config")
 	}
@@ -369,7 +381,7 @@ func Render(input *cloudinit.BaseUserData, clc *bootstrapv1.ContainerLinuxConfig
 	return userData, warnings, nil
 }
 
-func buildIgnitionConfig(baseCLC []byte, clc *bootstrapv1.ContainerLinuxConfig) ([]byte, string, error) {
+func buildIgnitionConfig(baseCLC []byte, clc *bootstrapv1.ContainerLinuxConfig, additionalConfigs ...ignitionTypes.Config) ([]byte, string, error) {
 	// We control baseCLC config, so treat it as strict.
 	ign, _, err := clcToIgnition(baseCLC, true)
 	if err != nil {
@@ -389,6 +401,10 @@ func buildIgnitionConfig(baseCLC []byte, clc *bootstrapv1.ContainerLinuxConfig)
 		ign = ignition.Append(ign, additionalIgn)
 	}
 
+	for _, additionalConfig := range additionalConfigs {
+		ign = ignition.Append(ign, additionalConfig)
+	}
+
 	userData, err := json.Marshal(&ign)
 	if err != nil {
 		return nil, "", errors.Wrapf(err, "marshaling generated Ignition config into JSON")
@@ -430,6 +430,67 @@ func TestRender(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "trusted certificate authorities",
+			input: &cloudinit.BaseUserData{
+				KubeadmCommand: "kubeadm join",
+				TrustedCertificateAuthorities: []string{
+					"-----BEGIN CERTIFICATE-----\nfoo\n-----END CERTIFICATE-----",
+				},
+			},
+			wantIgnition: types.Config{
+				Ignition: types.Ignition{
+					Version: "2.3.0",
+				},
+				Storage: types.Storage{
+					Files: []types.File{
+						{
+							Node: types.Node{
+								Filesystem: "root",
+								Path:       "/etc/ssl/certs/cluster-api-trusted-ca-0.pem",
+							},
+							FileEmbedded1: types.FileEmbedded1{
+								Contents: types.FileContents{Source: "data:,-----BEGIN%20CERTIFICATE-----%0Afoo%0A-----END%20CERTIFICATE-----%0A"},
+								Mode:     ptr.To(420),
+							},
+						},
+						{
+							Node: types.Node{
+								Filesystem: "root",
+								Path:       "/etc/kubeadm.sh",
+							},
+							FileEmbedded1: types.FileEmbedded1{
+								Contents: types.FileContents{
+									Source: "data:,%23!%2Fbin%2Fbash%0Aset%20-e%0Aupdate-ca-certificates%0A%0A%0Akubeadm%20join%0Amkdir%20-p%20%2Frun%2Fcluster-api%20%26%26%20echo%20success%20%3E%20%2Frun%2Fcluster-api%2Fbootstrap-success.complete%0Amv%20%2Fetc%2Fkubeadm.yml%20%2Ftmp%2F%0A",
+								},
+								Mode: ptr.To(448),
+							},
+						},
+						{
+							Node: types.Node{
+								Filesystem: "root",
+								Path:       "/etc/kubeadm.yml",
+							},
+							FileEmbedded1: types.FileEmbedded1{
+								Contents: types.FileContents{
+									Source: "data:,---%0Afoo%0A",
+								},
+								Mode: ptr.To(384),
+							},
+						},
+					},
+				},
+				Systemd: types.Systemd{
+					Units: []types.Unit{
+						{
+							Contents: "[Unit]\nDescription=kubeadm\n# Run only once. After successful run, this file is moved to /tmp/.\nConditionPathExists=/etc/kubeadm.yml\nAfter=network.target\n[Service]\n# To not restart the unit when it exits, as it is expected.\nType=oneshot\nExecStart=/etc/kubeadm.sh\n[Install]\nWantedBy=multi-user.target\n",
+							Enabled:  ptr.To(true),
+							Name:     "kubeadm.service",
+						},
+					},
+				},
+			},
+		},
 		{
 			desc: "all file ownership combinations",
 			input: &cloudinit.BaseUserData{
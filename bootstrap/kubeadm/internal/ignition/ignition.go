@@ -24,6 +24,7 @@ import (
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/internal/cloudinit"
 	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/internal/ignition/clc"
+	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/internal/ignition/passthrough"
 )
 
 const (
@@ -111,5 +112,24 @@ func render(input *cloudinit.BaseUserData, ignitionConfig *bootstrapv1.IgnitionS
 		clcConfig = &ignitionConfig.ContainerLinuxConfig
 	}
 
-	return clc.Render(input, clcConfig, kubeadmConfig)
+	if ignitionConfig == nil || !ignitionConfig.Passthrough.IsDefined() {
+		return clc.Render(input, clcConfig, kubeadmConfig)
+	}
+
+	passthroughConfig, passthroughWarnings, err := passthrough.ToIgnition(&ignitionConfig.Passthrough)
+	if err != nil {
+		return nil, "", fmt.Errorf("converting passthrough config to Ignition: %w", err)
+	}
+
+	userData, warnings, err := clc.Render(input, clcConfig, kubeadmConfig, passthroughConfig)
+	if err != nil {
+		return nil, "", err
+	}
+	if passthroughWarnings != "" {
+		if warnings != "" {
+			warnings += "\n"
+		}
+		warnings += passthroughWarnings
+	}
+	return userData, warnings, nil
 }
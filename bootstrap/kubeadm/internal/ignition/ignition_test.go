@@ -144,6 +144,49 @@ func Test_NewNode(t *testing.T) {
 			t.Fatalf("Data should be returned with warnings but no errors")
 		}
 	})
+
+	t.Run("returns Ignition with user-specified passthrough config", func(t *testing.T) {
+		t.Parallel()
+
+		input := &ignition.NodeInput{
+			NodeInput: &cloudinit.NodeInput{},
+			Ignition: &bootstrapv1.IgnitionSpec{
+				Passthrough: bootstrapv1.IgnitionPassthrough{
+					Format: bootstrapv1.IgnitionPassthroughFormatIgnition,
+					Config: `{"ignition":{"version":"3.3.0"},"systemd":{"units":[{"name":"foo.service","enabled":true,"contents":"[Unit]\nDescription=foo"}]}}`,
+				},
+			},
+		}
+
+		ignitionData, _, err := ignition.NewNode(input)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		for _, expected := range []string{`"name":"foo.service"`, `"name":"kubeadm.service"`} {
+			if !strings.Contains(string(ignitionData), expected) {
+				t.Fatalf("Expected %q to be included in %q", expected, string(ignitionData))
+			}
+		}
+	})
+
+	t.Run("returns error for invalid passthrough config", func(t *testing.T) {
+		t.Parallel()
+
+		input := &ignition.NodeInput{
+			NodeInput: &cloudinit.NodeInput{},
+			Ignition: &bootstrapv1.IgnitionSpec{
+				Passthrough: bootstrapv1.IgnitionPassthrough{
+					Format: bootstrapv1.IgnitionPassthroughFormatIgnition,
+					Config: `{"ignition":{"version":"3.3.0"},"kernelArguments":{"shouldExist":["foo"]}}`,
+				},
+			},
+		}
+
+		if _, _, err := ignition.NewNode(input); err == nil {
+			t.Fatalf("Expected error")
+		}
+	})
 }
 
 func Test_NewJoinControlPlane(t *testing.T) {
@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package passthrough
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// butaneIgnitionVersions maps the supported Butane variants and versions to the Ignition spec version they are transpiled to.
+var butaneIgnitionVersions = map[string]map[string]string{
+	"fcos": {
+		"1.0.0": "3.0.0",
+		"1.1.0": "3.1.0",
+		"1.2.0": "3.2.0",
+		"1.3.0": "3.2.0",
+		"1.4.0": "3.3.0",
+		"1.5.0": "3.4.0",
+	},
+	"flatcar": {
+		"1.0.0": "3.3.0",
+		"1.1.0": "3.4.0",
+	},
+}
+
+// butaneFieldNames are the Butane field names which are not the camel case version of the Ignition field names.
+var butaneFieldNames = map[string]string{
+	"size_mib":  "sizeMiB",
+	"start_mib": "startMiB",
+}
+
+// butaneToIgnitionV3 transpiles a Butane config to an Ignition 3.x config.
+// Butane sugar, e.g. storage.trees or boot_device, and local file references are not supported.
+func butaneToIgnitionV3(data []byte) ([]byte, error) {
+	config := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, errors.Wrap(err, "parsing Butane config")
+	}
+
+	variant, _ := config["variant"].(string)
+	version, _ := config["version"].(string)
+	versions, ok := butaneIgnitionVersions[variant]
+	if !ok {
+		return nil, errors.Errorf("unsupported Butane variant %q, supported variants are fcos, flatcar", variant)
+	}
+	ignitionVersion, ok := versions[version]
+	if !ok {
+		return nil, errors.Errorf("unsupported Butane version %q for variant %q", version, variant)
+	}
+	delete(config, "variant")
+	delete(config, "version")
+
+	for _, field := range []string{"boot_device", "grub"} {
+		if _, ok := config[field]; ok {
+			return nil, errors.Errorf("%s is not supported", field)
+		}
+	}
+	if storage, ok := config["storage"].(map[string]interface{}); ok {
+		if _, ok := storage["trees"]; ok {
+			return nil, errors.New("storage.trees is not supported")
+		}
+	}
+
+	translated, err := translateButaneValue(config, "")
+	if err != nil {
+		return nil, err
+	}
+	ignitionConfig := translated.(map[string]interface{})
+	ignitionSection, _ := ignitionConfig["ignition"].(map[string]interface{})
+	if ignitionSection == nil {
+		ignitionSection = map[string]interface{}{}
+	}
+	ignitionSection["version"] = ignitionVersion
+	ignitionConfig["ignition"] = ignitionSection
+
+	return json.Marshal(ignitionConfig)
+}
+
+// translateButaneValue translates Butane field names to Ignition field names and resolves inline contents.
+func translateButaneValue(value interface{}, path string) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, fieldValue := range v {
+			fieldPath := strings.TrimPrefix(path+"."+key, ".")
+			if key == "local" || strings.HasSuffix(key, "_local") {
+				return nil, errors.Errorf("%s: local file references are not supported", fieldPath)
+			}
+			if key == "inline" {
+				if _, ok := v["source"]; ok {
+					return nil, errors.Errorf("%s: inline and source can't be set at the same time", fieldPath)
+				}
+				inline, ok := fieldValue.(string)
+				if !ok {
+					return nil, errors.Errorf("%s: must be a string", fieldPath)
+				}
+				out["source"] = "data:;base64," + base64.StdEncoding.EncodeToString([]byte(inline))
+				continue
+			}
+			translated, err := translateButaneValue(fieldValue, fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			out[butaneFieldName(key)] = translated
+		}
+		return out, nil
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i := range v {
+			translated, err := translateButaneValue(v[i], path)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = translated
+		}
+		return out, nil
+	default:
+		return v, nil
+	}
+}
+
+// butaneFieldName returns the Ignition field name for a Butane field name, e.g. ssh_authorized_keys -> sshAuthorizedKeys.
+func butaneFieldName(key string) string {
+	if name, ok := butaneFieldNames[key]; ok {
+		return name
+	}
+	parts := strings.Split(key, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,451 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package passthrough
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	ignitionTypes "github.com/flatcar/ignition/config/v2_3/types"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// defaultFileMode is the mode of files without a mode in Ignition 3.x, Ignition 2.x defaults to 0000 instead.
+	defaultFileMode = 0o644
+
+	// defaultDirectoryMode is the mode of directories without a mode in Ignition 3.x, Ignition 2.x defaults to 0000 instead.
+	defaultDirectoryMode = 0o755
+)
+
+// supportedIgnitionV3Versions are the Ignition 3.x spec versions which can be translated.
+var supportedIgnitionV3Versions = []string{"3.0.0", "3.1.0", "3.2.0", "3.3.0", "3.4.0"}
+
+// The following types model the subset of the Ignition 3.x spec which can be translated to Ignition 2.3.
+// Fields without an equivalent in Ignition 2.3 are modeled as json.RawMessage, so they can be rejected.
+
+type ignitionV3Config struct {
+	Ignition        ignitionV3Ignition `json:"ignition"`
+	KernelArguments json.RawMessage    `json:"kernelArguments,omitempty"`
+	Passwd          ignitionV3Passwd   `json:"passwd,omitempty"`
+	Storage         ignitionV3Storage  `json:"storage,omitempty"`
+	Systemd         ignitionV3Systemd  `json:"systemd,omitempty"`
+}
+
+type ignitionV3Ignition struct {
+	Version  string             `json:"version"`
+	Config   json.RawMessage    `json:"config,omitempty"`
+	Proxy    json.RawMessage    `json:"proxy,omitempty"`
+	Security json.RawMessage    `json:"security,omitempty"`
+	Timeouts ignitionV3Timeouts `json:"timeouts,omitempty"`
+}
+
+type ignitionV3Timeouts struct {
+	HTTPResponseHeaders *int `json:"httpResponseHeaders,omitempty"`
+	HTTPTotal           *int `json:"httpTotal,omitempty"`
+}
+
+type ignitionV3Passwd struct {
+	Groups []ignitionV3Group `json:"groups,omitempty"`
+	Users  []ignitionV3User  `json:"users,omitempty"`
+}
+
+type ignitionV3Group struct {
+	Gid          *int    `json:"gid,omitempty"`
+	Name         string  `json:"name"`
+	PasswordHash *string `json:"passwordHash,omitempty"`
+	ShouldExist  *bool   `json:"shouldExist,omitempty"`
+	System       *bool   `json:"system,omitempty"`
+}
+
+type ignitionV3User struct {
+	Gecos             *string  `json:"gecos,omitempty"`
+	Groups            []string `json:"groups,omitempty"`
+	HomeDir           *string  `json:"homeDir,omitempty"`
+	Name              string   `json:"name"`
+	NoCreateHome      *bool    `json:"noCreateHome,omitempty"`
+	NoLogInit         *bool    `json:"noLogInit,omitempty"`
+	NoUserGroup       *bool    `json:"noUserGroup,omitempty"`
+	PasswordHash      *string  `json:"passwordHash,omitempty"`
+	PrimaryGroup      *string  `json:"primaryGroup,omitempty"`
+	SSHAuthorizedKeys []string `json:"sshAuthorizedKeys,omitempty"`
+	Shell             *string  `json:"shell,omitempty"`
+	ShouldExist       *bool    `json:"shouldExist,omitempty"`
+	System            *bool    `json:"system,omitempty"`
+	UID               *int     `json:"uid,omitempty"`
+}
+
+type ignitionV3Storage struct {
+	Directories []ignitionV3Directory  `json:"directories,omitempty"`
+	Disks       []ignitionV3Disk       `json:"disks,omitempty"`
+	Files       []ignitionV3File       `json:"files,omitempty"`
+	Filesystems []ignitionV3Filesystem `json:"filesystems,omitempty"`
+	Links       []ignitionV3Link       `json:"links,omitempty"`
+	Luks        json.RawMessage        `json:"luks,omitempty"`
+	Raid        []ignitionV3Raid       `json:"raid,omitempty"`
+}
+
+type ignitionV3Node struct {
+	Group     ignitionV3NodeOwner `json:"group,omitempty"`
+	Overwrite *bool               `json:"overwrite,omitempty"`
+	Path      string              `json:"path"`
+	User      ignitionV3NodeOwner `json:"user,omitempty"`
+}
+
+type ignitionV3NodeOwner struct {
+	ID   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type ignitionV3Directory struct {
+	ignitionV3Node
+	Mode *int `json:"mode,omitempty"`
+}
+
+type ignitionV3File struct {
+	ignitionV3Node
+	Append   []ignitionV3Resource `json:"append,omitempty"`
+	Contents ignitionV3Resource   `json:"contents,omitempty"`
+	Mode     *int                 `json:"mode,omitempty"`
+}
+
+type ignitionV3Resource struct {
+	Compression  *string                `json:"compression,omitempty"`
+	HTTPHeaders  json.RawMessage        `json:"httpHeaders,omitempty"`
+	Source       *string                `json:"source,omitempty"`
+	Verification ignitionV3Verification `json:"verification,omitempty"`
+}
+
+type ignitionV3Verification struct {
+	Hash *string `json:"hash,omitempty"`
+}
+
+type ignitionV3Link struct {
+	ignitionV3Node
+	Hard   *bool   `json:"hard,omitempty"`
+	Target *string `json:"target,omitempty"`
+}
+
+type ignitionV3Disk struct {
+	Device     string                `json:"device"`
+	Partitions []ignitionV3Partition `json:"partitions,omitempty"`
+	WipeTable  *bool                 `json:"wipeTable,omitempty"`
+}
+
+type ignitionV3Partition struct {
+	GUID               *string `json:"guid,omitempty"`
+	Label              *string `json:"label,omitempty"`
+	Number             int     `json:"number,omitempty"`
+	Resize             *bool   `json:"resize,omitempty"`
+	ShouldExist        *bool   `json:"shouldExist,omitempty"`
+	SizeMiB            *int    `json:"sizeMiB,omitempty"`
+	StartMiB           *int    `json:"startMiB,omitempty"`
+	TypeGUID           *string `json:"typeGuid,omitempty"`
+	WipePartitionEntry *bool   `json:"wipePartitionEntry,omitempty"`
+}
+
+type ignitionV3Filesystem struct {
+	Device         string   `json:"device"`
+	Format         *string  `json:"format,omitempty"`
+	Label          *string  `json:"label,omitempty"`
+	MountOptions   []string `json:"mountOptions,omitempty"`
+	Options        []string `json:"options,omitempty"`
+	Path           *string  `json:"path,omitempty"`
+	UUID           *string  `json:"uuid,omitempty"`
+	WipeFilesystem *bool    `json:"wipeFilesystem,omitempty"`
+}
+
+type ignitionV3Raid struct {
+	Devices []string `json:"devices"`
+	Level   *string  `json:"level,omitempty"`
+	Name    string   `json:"name"`
+	Options []string `json:"options,omitempty"`
+	Spares  *int     `json:"spares,omitempty"`
+}
+
+type ignitionV3Systemd struct {
+	Units []ignitionV3Unit `json:"units,omitempty"`
+}
+
+type ignitionV3Unit struct {
+	Contents *string            `json:"contents,omitempty"`
+	Dropins  []ignitionV3Dropin `json:"dropins,omitempty"`
+	Enabled  *bool              `json:"enabled,omitempty"`
+	Mask     *bool              `json:"mask,omitempty"`
+	Name     string             `json:"name"`
+}
+
+type ignitionV3Dropin struct {
+	Contents *string `json:"contents,omitempty"`
+	Name     string  `json:"name"`
+}
+
+// parseIgnitionV3 parses an Ignition 3.x config.
+// Unknown fields are returned as warnings, unless the config is parsed strictly.
+func parseIgnitionV3(data []byte, strict bool) (*ignitionV3Config, string, error) {
+	config := &ignitionV3Config{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var warnings string
+	if err := decoder.Decode(config); err != nil {
+		if strict {
+			return nil, "", err
+		}
+		config = &ignitionV3Config{}
+		if err := json.Unmarshal(data, config); err != nil {
+			return nil, "", err
+		}
+		warnings = err.Error()
+	}
+
+	if !slices.Contains(supportedIgnitionV3Versions, config.Ignition.Version) {
+		return nil, "", errors.Errorf("unsupported Ignition config version %q, supported versions are %s", config.Ignition.Version, strings.Join(supportedIgnitionV3Versions, ", "))
+	}
+	return config, warnings, nil
+}
+
+// translateIgnitionV3 translates an Ignition 3.x config to Ignition 2.3.
+func translateIgnitionV3(in *ignitionV3Config) (ignitionTypes.Config, error) {
+	var errs []error
+	unsupported := func(field string, value json.RawMessage) {
+		if len(value) > 0 && string(value) != "null" {
+			errs = append(errs, errors.Errorf("%s is not supported", field))
+		}
+	}
+	unsupported("kernelArguments", in.KernelArguments)
+	unsupported("ignition.config", in.Ignition.Config)
+	unsupported("ignition.proxy", in.Ignition.Proxy)
+	unsupported("ignition.security", in.Ignition.Security)
+	unsupported("storage.luks", in.Storage.Luks)
+
+	out := ignitionTypes.Config{
+		Ignition: ignitionTypes.Ignition{
+			Version: ignitionTypes.MaxVersion.String(),
+			Timeouts: ignitionTypes.Timeouts{
+				HTTPResponseHeaders: in.Ignition.Timeouts.HTTPResponseHeaders,
+				HTTPTotal:           in.Ignition.Timeouts.HTTPTotal,
+			},
+		},
+	}
+
+	for _, group := range in.Passwd.Groups {
+		if group.ShouldExist != nil && !*group.ShouldExist {
+			errs = append(errs, errors.Errorf("passwd.groups[%s].shouldExist: false is not supported", group.Name))
+		}
+		out.Passwd.Groups = append(out.Passwd.Groups, ignitionTypes.PasswdGroup{
+			Gid:          group.Gid,
+			Name:         group.Name,
+			PasswordHash: ptr.Deref(group.PasswordHash, ""),
+			System:       ptr.Deref(group.System, false),
+		})
+	}
+
+	for _, user := range in.Passwd.Users {
+		if user.ShouldExist != nil && !*user.ShouldExist {
+			errs = append(errs, errors.Errorf("passwd.users[%s].shouldExist: false is not supported", user.Name))
+		}
+		outUser := ignitionTypes.PasswdUser{
+			Gecos:        ptr.Deref(user.Gecos, ""),
+			HomeDir:      ptr.Deref(user.HomeDir, ""),
+			Name:         user.Name,
+			NoCreateHome: ptr.Deref(user.NoCreateHome, false),
+			NoLogInit:    ptr.Deref(user.NoLogInit, false),
+			NoUserGroup:  ptr.Deref(user.NoUserGroup, false),
+			PasswordHash: user.PasswordHash,
+			PrimaryGroup: ptr.Deref(user.PrimaryGroup, ""),
+			Shell:        ptr.Deref(user.Shell, ""),
+			System:       ptr.Deref(user.System, false),
+			UID:          user.UID,
+		}
+		for _, group := range user.Groups {
+			outUser.Groups = append(outUser.Groups, ignitionTypes.Group(group))
+		}
+		for _, key := range user.SSHAuthorizedKeys {
+			outUser.SSHAuthorizedKeys = append(outUser.SSHAuthorizedKeys, ignitionTypes.SSHAuthorizedKey(key))
+		}
+		out.Passwd.Users = append(out.Passwd.Users, outUser)
+	}
+
+	for _, disk := range in.Storage.Disks {
+		outDisk := ignitionTypes.Disk{
+			Device:    disk.Device,
+			WipeTable: ptr.Deref(disk.WipeTable, false),
+		}
+		for _, partition := range disk.Partitions {
+			if ptr.Deref(partition.Resize, false) {
+				errs = append(errs, errors.Errorf("storage.disks[%s].partitions[%d].resize is not supported", disk.Device, partition.Number))
+			}
+			outDisk.Partitions = append(outDisk.Partitions, ignitionTypes.Partition{
+				GUID:               ptr.Deref(partition.GUID, ""),
+				Label:              partition.Label,
+				Number:             partition.Number,
+				ShouldExist:        partition.ShouldExist,
+				SizeMiB:            partition.SizeMiB,
+				StartMiB:           partition.StartMiB,
+				TypeGUID:           ptr.Deref(partition.TypeGUID, ""),
+				WipePartitionEntry: ptr.Deref(partition.WipePartitionEntry, false),
+			})
+		}
+		out.Storage.Disks = append(out.Storage.Disks, outDisk)
+	}
+
+	for _, raid := range in.Storage.Raid {
+		outRaid := ignitionTypes.Raid{
+			Level:  ptr.Deref(raid.Level, ""),
+			Name:   raid.Name,
+			Spares: ptr.Deref(raid.Spares, 0),
+		}
+		for _, device := range raid.Devices {
+			outRaid.Devices = append(outRaid.Devices, ignitionTypes.Device(device))
+		}
+		for _, option := range raid.Options {
+			outRaid.Options = append(outRaid.Options, ignitionTypes.RaidOption(option))
+		}
+		out.Storage.Raid = append(out.Storage.Raid, outRaid)
+	}
+
+	// In Ignition 3.x files are written to the filesystem mounted at the longest prefix of their path,
+	// while in Ignition 2.x files explicitly reference the filesystem they are written to.
+	mountedFilesystems := map[string]string{}
+	for i, filesystem := range in.Storage.Filesystems {
+		if len(filesystem.MountOptions) > 0 {
+			errs = append(errs, errors.Errorf("storage.filesystems[%s].mountOptions is not supported", filesystem.Device))
+		}
+		mount := &ignitionTypes.Mount{
+			Device:         filesystem.Device,
+			Format:         ptr.Deref(filesystem.Format, ""),
+			Label:          filesystem.Label,
+			UUID:           filesystem.UUID,
+			WipeFilesystem: ptr.Deref(filesystem.WipeFilesystem, false),
+		}
+		for _, option := range filesystem.Options {
+			mount.Options = append(mount.Options, ignitionTypes.MountOption(option))
+		}
+		name := fmt.Sprintf("passthrough-%d", i)
+		if filesystem.Path != nil && *filesystem.Path != "" {
+			mountedFilesystems[path.Clean(*filesystem.Path)] = name
+		}
+		out.Storage.Filesystems = append(out.Storage.Filesystems, ignitionTypes.Filesystem{Name: name, Mount: mount})
+	}
+
+	for _, directory := range in.Storage.Directories {
+		out.Storage.Directories = append(out.Storage.Directories, ignitionTypes.Directory{
+			Node:               translateNode(directory.ignitionV3Node, mountedFilesystems),
+			DirectoryEmbedded1: ignitionTypes.DirectoryEmbedded1{Mode: ptr.To(ptr.Deref(directory.Mode, defaultDirectoryMode))},
+		})
+	}
+
+	for _, file := range in.Storage.Files {
+		node := translateNode(file.ignitionV3Node, mountedFilesystems)
+		resources := file.Append
+		if file.Contents.Source != nil || len(file.Append) == 0 {
+			resources = append([]ignitionV3Resource{file.Contents}, file.Append...)
+		}
+		for i, resource := range resources {
+			if len(resource.HTTPHeaders) > 0 && string(resource.HTTPHeaders) != "null" {
+				errs = append(errs, errors.Errorf("storage.files[%s].httpHeaders is not supported", file.Path))
+			}
+			outFile := ignitionTypes.File{
+				Node: node,
+				FileEmbedded1: ignitionTypes.FileEmbedded1{
+					Mode: ptr.To(ptr.Deref(file.Mode, defaultFileMode)),
+					Contents: ignitionTypes.FileContents{
+						Compression:  ptr.Deref(resource.Compression, ""),
+						Source:       ptr.Deref(resource.Source, ""),
+						Verification: ignitionTypes.Verification{Hash: resource.Verification.Hash},
+					},
+				},
+			}
+			// Resources after the first one, or all of them if the file has no contents, are appended to the file.
+			if i > 0 || file.Contents.Source == nil && len(file.Append) > 0 {
+				outFile.Append = true
+				outFile.Overwrite = nil
+			}
+			out.Storage.Files = append(out.Storage.Files, outFile)
+		}
+	}
+
+	for _, link := range in.Storage.Links {
+		if link.Target == nil {
+			errs = append(errs, errors.Errorf("storage.links[%s].target must be set", link.Path))
+			continue
+		}
+		out.Storage.Links = append(out.Storage.Links, ignitionTypes.Link{
+			Node: translateNode(link.ignitionV3Node, mountedFilesystems),
+			LinkEmbedded1: ignitionTypes.LinkEmbedded1{
+				Hard:   ptr.Deref(link.Hard, false),
+				Target: *link.Target,
+			},
+		})
+	}
+
+	for _, unit := range in.Systemd.Units {
+		outUnit := ignitionTypes.Unit{
+			Contents: ptr.Deref(unit.Contents, ""),
+			Enabled:  unit.Enabled,
+			Mask:     ptr.Deref(unit.Mask, false),
+			Name:     unit.Name,
+		}
+		for _, dropin := range unit.Dropins {
+			outUnit.Dropins = append(outUnit.Dropins, ignitionTypes.SystemdDropin{
+				Contents: ptr.Deref(dropin.Contents, ""),
+				Name:     dropin.Name,
+			})
+		}
+		out.Systemd.Units = append(out.Systemd.Units, outUnit)
+	}
+
+	if len(errs) > 0 {
+		return ignitionTypes.Config{}, kerrors.NewAggregate(errs)
+	}
+	return out, nil
+}
+
+// translateNode translates an Ignition 3.x node, resolving the filesystem the node is written to.
+// Note: In Ignition 3.x nodes are not overwritten by default, while in Ignition 2.x files are.
+func translateNode(in ignitionV3Node, mountedFilesystems map[string]string) ignitionTypes.Node {
+	out := ignitionTypes.Node{
+		Filesystem: "root",
+		Overwrite:  ptr.To(ptr.Deref(in.Overwrite, false)),
+		Path:       in.Path,
+	}
+	if in.User.ID != nil || in.User.Name != nil {
+		out.User = &ignitionTypes.NodeUser{ID: in.User.ID, Name: ptr.Deref(in.User.Name, "")}
+	}
+	if in.Group.ID != nil || in.Group.Name != nil {
+		out.Group = &ignitionTypes.NodeGroup{ID: in.Group.ID, Name: ptr.Deref(in.Group.Name, "")}
+	}
+
+	var mountPath string
+	for filesystemPath, name := range mountedFilesystems {
+		if (in.Path == filesystemPath || strings.HasPrefix(in.Path, strings.TrimSuffix(filesystemPath, "/")+"/")) && len(filesystemPath) > len(mountPath) {
+			mountPath = filesystemPath
+			out.Filesystem = name
+		}
+	}
+	if mountPath != "" {
+		out.Path = path.Join("/", strings.TrimPrefix(in.Path, mountPath))
+	}
+	return out
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package passthrough translates raw Ignition and Butane configs to the Ignition spec version used by the
+// configuration generated by the bootstrap provider, so they can be merged with it.
+//
+// Ignition 2.x configs are parsed and translated by Ignition itself. Ignition 3.x configs are translated
+// field by field; fields without an equivalent in Ignition 2.3, e.g. kernel arguments or LUKS devices, are rejected.
+// Butane configs are transpiled to Ignition 3.x first, by resolving inline contents and by mapping the
+// Butane field names to the Ignition ones; local file references are not supported.
+package passthrough
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	ignition "github.com/flatcar/ignition/config/v2_3"
+	ignitionTypes "github.com/flatcar/ignition/config/v2_3/types"
+	"github.com/flatcar/ignition/config/validate"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+)
+
+// ToIgnition translates the passthrough config to an Ignition 2.3 config.
+// Warnings found while parsing the config are returned, unless the config is parsed strictly;
+// in this case warnings are returned as errors.
+func ToIgnition(passthrough *bootstrapv1.IgnitionPassthrough) (ignitionTypes.Config, string, error) {
+	if passthrough == nil {
+		return ignitionTypes.Config{}, "", errors.New("passthrough config can't be nil")
+	}
+	strict := ptr.Deref(passthrough.Strict, false)
+
+	switch passthrough.Format {
+	case bootstrapv1.IgnitionPassthroughFormatIgnition:
+		return fromIgnition([]byte(passthrough.Config), strict)
+	case bootstrapv1.IgnitionPassthroughFormatButane:
+		data, err := butaneToIgnitionV3([]byte(passthrough.Config))
+		if err != nil {
+			return ignitionTypes.Config{}, "", errors.Wrap(err, "transpiling Butane config")
+		}
+		return fromIgnitionV3(data, strict)
+	default:
+		return ignitionTypes.Config{}, "", errors.Errorf("unknown passthrough config format %q", passthrough.Format)
+	}
+}
+
+func fromIgnition(data []byte, strict bool) (ignitionTypes.Config, string, error) {
+	header := struct {
+		Ignition struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}{}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return ignitionTypes.Config{}, "", errors.Wrap(err, "parsing Ignition config")
+	}
+
+	switch {
+	case strings.HasPrefix(header.Ignition.Version, "2."):
+		config, report, err := ignition.Parse(data)
+		if err != nil {
+			return ignitionTypes.Config{}, "", errors.Wrapf(err, "parsing Ignition config: %s", report.String())
+		}
+		if strict && len(report.Entries) > 0 {
+			return ignitionTypes.Config{}, "", errors.Errorf("parsing Ignition config: %s", report.String())
+		}
+		return config, report.String(), nil
+	case strings.HasPrefix(header.Ignition.Version, "3."):
+		return fromIgnitionV3(data, strict)
+	default:
+		return ignitionTypes.Config{}, "", errors.Errorf("unsupported Ignition config version %q, only 2.x and 3.x are supported", header.Ignition.Version)
+	}
+}
+
+// fromIgnitionV3 translates an Ignition 3.x config to Ignition 2.3 and validates the result.
+func fromIgnitionV3(data []byte, strict bool) (ignitionTypes.Config, string, error) {
+	config, warnings, err := parseIgnitionV3(data, strict)
+	if err != nil {
+		return ignitionTypes.Config{}, "", errors.Wrap(err, "parsing Ignition config")
+	}
+
+	translated, err := translateIgnitionV3(config)
+	if err != nil {
+		return ignitionTypes.Config{}, "", errors.Wrap(err, "translating Ignition config")
+	}
+
+	// Validate the translated config using Ignition.
+	report := validate.ValidateWithoutSource(reflect.ValueOf(translated))
+	if report.IsFatal() || strict && len(report.Entries) > 0 {
+		return ignitionTypes.Config{}, "", errors.Errorf("validating translated Ignition config: %s", report.String())
+	}
+
+	if reportWarnings := report.String(); reportWarnings != "" {
+		if warnings != "" {
+			warnings += "\n"
+		}
+		warnings += reportWarnings
+	}
+	return translated, warnings, nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package passthrough
+
+import (
+	"testing"
+
+	"github.com/flatcar/ignition/config/v2_3/types"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+)
+
+func TestToIgnition(t *testing.T) {
+	tests := []struct {
+		name         string
+		passthrough  bootstrapv1.IgnitionPassthrough
+		wantErr      string
+		wantWarnings bool
+		assert       func(g *WithT, config types.Config)
+	}{
+		{
+			name: "Ignition 2.x config",
+			passthrough: bootstrapv1.IgnitionPassthrough{
+				Format: bootstrapv1.IgnitionPassthroughFormatIgnition,
+				Config: `{"ignition":{"version":"2.3.0"},"systemd":{"units":[{"name":"foo.service","mask":true}]}}`,
+			},
+			assert: func(g *WithT, config types.Config) {
+				g.Expect(config.Systemd.Units).To(Equal([]types.Unit{{Name: "foo.service", Mask: true}}))
+			},
+		},
+		{
+			name: "Ignition 3.x config",
+			passthrough: bootstrapv1.IgnitionPassthrough{
+				Format: bootstrapv1.IgnitionPassthroughFormatIgnition,
+				Config: `{
+  "ignition": {"version": "3.4.0"},
+  "passwd": {"users": [{"name": "core", "sshAuthorizedKeys": ["ssh-ed25519 foo"]}]},
+  "storage": {
+    "filesystems": [{"device": "/dev/disk/by-label/DATA", "format": "ext4", "path": "/var/lib/data", "wipeFilesystem": true}],
+    "files": [
+      {"path": "/etc/foo", "mode": 420, "contents": {"source": "data:,foo"}},
+      {"path": "/var/lib/data/bar", "overwrite": true, "contents": {"source": "data:,bar"}, "append": [{"source": "data:,baz"}]}
+    ]
+  },
+  "systemd": {"units": [{"name": "var-lib-data.mount", "enabled": true, "contents": "[Mount]\nWhat=/dev/disk/by-label/DATA\n[Install]\nWantedBy=local-fs.target"}]}
+}`,
+			},
+			assert: func(g *WithT, config types.Config) {
+				g.Expect(config.Ignition.Version).To(Equal(types.MaxVersion.String()))
+				g.Expect(config.Passwd.Users).To(Equal([]types.PasswdUser{{Name: "core", SSHAuthorizedKeys: []types.SSHAuthorizedKey{"ssh-ed25519 foo"}}}))
+				g.Expect(config.Storage.Filesystems).To(Equal([]types.Filesystem{{
+					Name:  "passthrough-0",
+					Mount: &types.Mount{Device: "/dev/disk/by-label/DATA", Format: "ext4", WipeFilesystem: true},
+				}}))
+				g.Expect(config.Storage.Files).To(HaveLen(3))
+				g.Expect(config.Storage.Files[0].Node).To(Equal(types.Node{Filesystem: "root", Path: "/etc/foo", Overwrite: ptr.To(false)}))
+				g.Expect(config.Storage.Files[0].Mode).To(Equal(ptr.To(420)))
+				g.Expect(config.Storage.Files[1].Node).To(Equal(types.Node{Filesystem: "passthrough-0", Path: "/bar", Overwrite: ptr.To(true)}))
+				g.Expect(config.Storage.Files[1].Append).To(BeFalse())
+				g.Expect(config.Storage.Files[2].Append).To(BeTrue())
+				g.Expect(config.Storage.Files[2].Contents.Source).To(Equal("data:,baz"))
+				g.Expect(config.Systemd.Units).To(Equal([]types.Unit{{Name: "var-lib-data.mount", Enabled: ptr.To(true), Contents: "[Mount]\nWhat=/dev/disk/by-label/DATA\n[Install]\nWantedBy=local-fs.target"}}))
+			},
+		},
+		{
+			name: "Butane config",
+			passthrough: bootstrapv1.IgnitionPassthrough{
+				Format: bootstrapv1.IgnitionPassthroughFormatButane,
+				Config: `variant: flatcar
+version: 1.1.0
+storage:
+  files:
+  - path: /etc/foo
+    contents:
+      inline: foo
+  disks:
+  - device: /dev/sdb
+    wipe_table: true
+    partitions:
+    - label: data
+      size_mib: 1024
+systemd:
+  units:
+  - name: foo.service
+    enabled: true
+`,
+			},
+			assert: func(g *WithT, config types.Config) {
+				g.Expect(config.Storage.Files).To(HaveLen(1))
+				g.Expect(config.Storage.Files[0].Contents.Source).To(Equal("data:;base64,Zm9v"))
+				g.Expect(config.Storage.Disks).To(Equal([]types.Disk{{
+					Device:     "/dev/sdb",
+					WipeTable:  true,
+					Partitions: []types.Partition{{Label: ptr.To("data"), SizeMiB: ptr.To(1024)}},
+				}}))
+				g.Expect(config.Systemd.Units).To(Equal([]types.Unit{{Name: "foo.service", Enabled: ptr.To(true)}}))
+			},
+		},
+		{
+			name: "unknown fields are returned as warnings",
+			passthrough: bootstrapv1.IgnitionPassthrough{
+				Format: bootstrapv1.IgnitionPassthroughFormatIgnition,
+				Config: `{"ignition":{"version":"3.3.0"},"foo":"bar"}`,
+			},
+			wantWarnings: true,
+		},
+		{
+			name: "unknown fields are rejected in strict mode",
+			passthrough: bootstrapv1.IgnitionPassthrough{
+				Format: bootstrapv1.IgnitionPassthroughFormatIgnition,
+				Config: `{"ignition":{"version":"3.3.0"},"foo":"bar"}`,
+				Strict: ptr.To(true),
+			},
+			wantErr: `unknown field "foo"`,
+		},
+		{
+			name: "fields without an equivalent in Ignition 2.3 are rejected",
+			passthrough: bootstrapv1.IgnitionPassthrough{
+				Format: bootstrapv1.IgnitionPassthroughFormatIgnition,
+				Config: `{"ignition":{"version":"3.3.0"},"kernelArguments":{"shouldExist":["foo"]},"storage":{"luks":[{"name":"foo"}]}}`,
+			},
+			wantErr: "kernelArguments is not supported, storage.luks is not supported",
+		},
+		{
+			name: "unsupported Ignition versions are rejected",
+			passthrough: bootstrapv1.IgnitionPassthrough{
+				Format: bootstrapv1.IgnitionPassthroughFormatIgnition,
+				Config: `{"ignition":{"version":"3.9.0"}}`,
+			},
+			wantErr: `unsupported Ignition config version "3.9.0"`,
+		},
+		{
+			name: "unsupported Butane variants are rejected",
+			passthrough: bootstrapv1.IgnitionPassthrough{
+				Format: bootstrapv1.IgnitionPassthroughFormatButane,
+				Config: "variant: openshift\nversion: 4.14.0\n",
+			},
+			wantErr: `unsupported Butane variant "openshift"`,
+		},
+		{
+			name: "Butane local file references are rejected",
+			passthrough: bootstrapv1.IgnitionPassthrough{
+				Format: bootstrapv1.IgnitionPassthroughFormatButane,
+				Config: "variant: fcos\nversion: 1.5.0\nstorage:\n  files:\n  - path: /etc/foo\n    contents:\n      local: foo\n",
+			},
+			wantErr: "storage.files.contents.local: local file references are not supported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			config, warnings, err := ToIgnition(&tt.passthrough)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(warnings != "").To(Equal(tt.wantWarnings), warnings)
+			if tt.assert != nil {
+				tt.assert(g, config)
+			}
+		})
+	}
+}
+
+func TestButaneFieldName(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(butaneFieldName("name")).To(Equal("name"))
+	g.Expect(butaneFieldName("ssh_authorized_keys")).To(Equal("sshAuthorizedKeys"))
+	g.Expect(butaneFieldName("http_response_headers")).To(Equal("httpResponseHeaders"))
+	g.Expect(butaneFieldName("size_mib")).To(Equal("sizeMiB"))
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package templating implements the rendering of Go-template expressions in selected fields of a KubeadmConfigSpec.
+package templating
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/patches/variables"
+)
+
+// Render returns a copy of the KubeadmConfigSpec with the Go-template expressions in the fields documented in
+// BootstrapTemplating rendered using the builtin variables of the Cluster.
+// NOTE: The list of rendered fields must be kept in sync with the BootstrapTemplating documentation.
+// The given KubeadmConfigSpec is not modified.
+func Render(spec *bootstrapv1.KubeadmConfigSpec, cluster *clusterv1.Cluster) (*bootstrapv1.KubeadmConfigSpec, error) {
+	data, err := templateData(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &renderer{data: data}
+	rendered := spec.DeepCopy()
+
+	fldPath := field.NewPath("spec")
+	for i := range rendered.Files {
+		// NOTE: Content from secrets is not rendered.
+		r.render(fldPath.Child("files").Index(i).Child("content"), &rendered.Files[i].Content)
+	}
+
+	for i := range rendered.Users {
+		u := &rendered.Users[i]
+		userPath := fldPath.Child("users").Index(i)
+		r.render(userPath.Child("name"), &u.Name)
+		r.render(userPath.Child("gecos"), &u.Gecos)
+		r.render(userPath.Child("groups"), &u.Groups)
+		r.render(userPath.Child("homeDir"), &u.HomeDir)
+		r.render(userPath.Child("shell"), &u.Shell)
+		r.render(userPath.Child("primaryGroup"), &u.PrimaryGroup)
+		r.render(userPath.Child("sudo"), &u.Sudo)
+		for j := range u.SSHAuthorizedKeys {
+			r.render(userPath.Child("sshAuthorizedKeys").Index(j), &u.SSHAuthorizedKeys[j])
+		}
+	}
+
+	for i := range rendered.NTP.Servers {
+		r.render(fldPath.Child("ntp", "servers").Index(i), &rendered.NTP.Servers[i])
+	}
+
+	for i := range rendered.DiskSetup.Partitions {
+		r.render(fldPath.Child("diskSetup", "partitions").Index(i).Child("device"), &rendered.DiskSetup.Partitions[i].Device)
+	}
+	for i := range rendered.DiskSetup.Filesystems {
+		fs := &rendered.DiskSetup.Filesystems[i]
+		fsPath := fldPath.Child("diskSetup", "filesystems").Index(i)
+		r.render(fsPath.Child("device"), &fs.Device)
+		r.render(fsPath.Child("label"), &fs.Label)
+		r.render(fsPath.Child("partition"), &fs.Partition)
+		for j := range fs.ExtraOpts {
+			r.render(fsPath.Child("extraOpts").Index(j), &fs.ExtraOpts[j])
+		}
+	}
+
+	if len(r.errs) > 0 {
+		return nil, kerrors.NewAggregate(r.errs)
+	}
+	return rendered, nil
+}
+
+// templateData returns the data used to render templates, so that builtin variables
+// can be consumed in templates like this: `{{ .builtin.cluster.name }}`.
+func templateData(cluster *clusterv1.Cluster) (map[string]interface{}, error) {
+	// NOTE: Only builtin variables are computed, because no variable definitions are passed in.
+	vars, err := variables.Global(cluster.Spec.Topology, cluster, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate builtin variables")
+	}
+
+	values := make(map[string]apiextensionsv1.JSON, len(vars))
+	for _, v := range vars {
+		values[v.Name] = v.Value
+	}
+
+	// Convert the variable values to their Go types, as they cannot be directly consumed as byte arrays.
+	tmp, err := json.Marshal(values)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal builtin variables")
+	}
+	data := map[string]interface{}{}
+	if err := json.Unmarshal(tmp, &data); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal builtin variables")
+	}
+	return data, nil
+}
+
+type renderer struct {
+	data map[string]interface{}
+	errs []error
+}
+
+// render renders the Go-template expressions in value, if any.
+func (r *renderer) render(fldPath *field.Path, value *string) {
+	// Skip values without template expressions, so they are preserved as is.
+	if !strings.Contains(*value, "{{") {
+		return
+	}
+
+	tpl, err := template.New(fldPath.String()).Option("missingkey=error").Funcs(sprig.HermeticTxtFuncMap()).Parse(*value)
+	if err != nil {
+		r.errs = append(r.errs, errors.Wrapf(err, "failed to parse template in %s", fldPath))
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, r.data); err != nil {
+		r.errs = append(r.errs, errors.Wrapf(err, "failed to render template in %s", fldPath))
+		return
+	}
+	*value = buf.String()
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templating
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestRender(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster1",
+			Namespace: "ns1",
+		},
+		Spec: clusterv1.ClusterSpec{
+			ClusterNetwork: clusterv1.ClusterNetwork{
+				ServiceDomain: "cluster.local",
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		spec     *bootstrapv1.KubeadmConfigSpec
+		wantSpec *bootstrapv1.KubeadmConfigSpec
+		wantErr  bool
+	}{
+		{
+			name: "values without templates are preserved",
+			spec: &bootstrapv1.KubeadmConfigSpec{
+				Files: []bootstrapv1.File{{Path: "/etc/foo", Content: "foo"}},
+				NTP:   bootstrapv1.NTP{Servers: []string{"time.example.com"}},
+			},
+			wantSpec: &bootstrapv1.KubeadmConfigSpec{
+				Files: []bootstrapv1.File{{Path: "/etc/foo", Content: "foo"}},
+				NTP:   bootstrapv1.NTP{Servers: []string{"time.example.com"}},
+			},
+		},
+		{
+			name: "templates are rendered",
+			spec: &bootstrapv1.KubeadmConfigSpec{
+				Files: []bootstrapv1.File{
+					{Path: "/etc/cluster", Content: "name: {{ .builtin.cluster.name }}\nnamespace: {{ .builtin.cluster.namespace }}"},
+					{Path: "/etc/secret", ContentFrom: bootstrapv1.FileSource{Secret: bootstrapv1.SecretFileSource{Name: "secret", Key: "key"}}},
+				},
+				Users: []bootstrapv1.User{{
+					Name:              "{{ .builtin.cluster.name }}-admin",
+					Gecos:             "Admin of {{ .builtin.cluster.name | upper }}",
+					SSHAuthorizedKeys: []string{"ssh-rsa AAAA {{ .builtin.cluster.name }}"},
+				}},
+				NTP: bootstrapv1.NTP{Servers: []string{"ntp.{{ .builtin.cluster.network.serviceDomain }}"}},
+				DiskSetup: bootstrapv1.DiskSetup{
+					Partitions: []bootstrapv1.Partition{{Device: "/dev/disk/by-id/{{ .builtin.cluster.name }}"}},
+					Filesystems: []bootstrapv1.Filesystem{{
+						Device:    "/dev/disk/by-id/{{ .builtin.cluster.name }}",
+						Label:     "{{ .builtin.cluster.name }}-etcd",
+						ExtraOpts: []string{"-L", "{{ .builtin.cluster.name }}"},
+					}},
+				},
+			},
+			wantSpec: &bootstrapv1.KubeadmConfigSpec{
+				Files: []bootstrapv1.File{
+					{Path: "/etc/cluster", Content: "name: cluster1\nnamespace: ns1"},
+					{Path: "/etc/secret", ContentFrom: bootstrapv1.FileSource{Secret: bootstrapv1.SecretFileSource{Name: "secret", Key: "key"}}},
+				},
+				Users: []bootstrapv1.User{{
+					Name:              "cluster1-admin",
+					Gecos:             "Admin of CLUSTER1",
+					SSHAuthorizedKeys: []string{"ssh-rsa AAAA cluster1"},
+				}},
+				NTP: bootstrapv1.NTP{Servers: []string{"ntp.cluster.local"}},
+				DiskSetup: bootstrapv1.DiskSetup{
+					Partitions: []bootstrapv1.Partition{{Device: "/dev/disk/by-id/cluster1"}},
+					Filesystems: []bootstrapv1.Filesystem{{
+						Device:    "/dev/disk/by-id/cluster1",
+						Label:     "cluster1-etcd",
+						ExtraOpts: []string{"-L", "cluster1"},
+					}},
+				},
+			},
+		},
+		{
+			name: "fields not documented as rendered are used as is",
+			spec: &bootstrapv1.KubeadmConfigSpec{
+				Users: []bootstrapv1.User{{Name: "admin", Passwd: "{{ .builtin.cluster.name }}"}},
+				DiskSetup: bootstrapv1.DiskSetup{
+					Partitions: []bootstrapv1.Partition{{Device: "/dev/sdb", TableType: "{{ .builtin.cluster.name }}"}},
+				},
+			},
+			wantSpec: &bootstrapv1.KubeadmConfigSpec{
+				Users: []bootstrapv1.User{{Name: "admin", Passwd: "{{ .builtin.cluster.name }}"}},
+				DiskSetup: bootstrapv1.DiskSetup{
+					Partitions: []bootstrapv1.Partition{{Device: "/dev/sdb", TableType: "{{ .builtin.cluster.name }}"}},
+				},
+			},
+		},
+		{
+			name: "fails for invalid templates",
+			spec: &bootstrapv1.KubeadmConfigSpec{
+				Files: []bootstrapv1.File{{Path: "/etc/foo", Content: "{{ .builtin.cluster.name "}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails for unknown variables",
+			spec: &bootstrapv1.KubeadmConfigSpec{
+				NTP: bootstrapv1.NTP{Servers: []string{"{{ .builtin.cluster.foo }}"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			original := tt.spec.DeepCopy()
+			got, err := Render(tt.spec, cluster)
+			g.Expect(tt.spec).To(Equal(original), "the given spec must not be modified")
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.wantSpec))
+		})
+	}
+}
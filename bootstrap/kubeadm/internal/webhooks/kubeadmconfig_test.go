@@ -93,6 +93,117 @@ func TestKubeadmConfigValidate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		"valid trustedCertificateAuthorities": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					TrustedCertificateAuthorities: []bootstrapv1.TrustedCertificateAuthority{
+						{
+							Secret: bootstrapv1.TrustedCertificateAuthoritySource{
+								Name: "foo",
+								Key:  "ca.crt",
+							},
+						},
+						{
+							ConfigMap: bootstrapv1.TrustedCertificateAuthoritySource{
+								Name: "bar",
+								Key:  "ca.crt",
+							},
+						},
+					},
+				},
+			},
+		},
+		"invalid trustedCertificateAuthorities with secret and configMap": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					TrustedCertificateAuthorities: []bootstrapv1.TrustedCertificateAuthority{
+						{
+							Secret: bootstrapv1.TrustedCertificateAuthoritySource{
+								Name: "foo",
+								Key:  "ca.crt",
+							},
+							ConfigMap: bootstrapv1.TrustedCertificateAuthoritySource{
+								Name: "bar",
+								Key:  "ca.crt",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"valid additionalUserData": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					AdditionalUserData: []bootstrapv1.AdditionalUserData{
+						{
+							Secret: bootstrapv1.AdditionalUserDataSource{
+								Name: "foo",
+								Key:  "user-data",
+							},
+						},
+						{
+							ConfigMap: bootstrapv1.AdditionalUserDataSource{
+								Name: "bar",
+								Key:  "user-data",
+							},
+							MergeType: "list(append)+dict(recurse_array)+str()",
+						},
+					},
+				},
+			},
+		},
+		"invalid additionalUserData with secret and configMap": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					AdditionalUserData: []bootstrapv1.AdditionalUserData{
+						{
+							Secret: bootstrapv1.AdditionalUserDataSource{
+								Name: "foo",
+								Key:  "user-data",
+							},
+							ConfigMap: bootstrapv1.AdditionalUserDataSource{
+								Name: "bar",
+								Key:  "user-data",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"invalid additionalUserData without secret and configMap": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					AdditionalUserData: []bootstrapv1.AdditionalUserData{
+						{
+							MergeType: "list(append)+dict(recurse_array)+str()",
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		"invalid contentFrom without name": {
 			in: &bootstrapv1.KubeadmConfig{
 				ObjectMeta: metav1.ObjectMeta{
@@ -299,6 +410,52 @@ func TestKubeadmConfigValidate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		"format is Ignition, diagnostics are enabled": {
+			enableIgnitionFeature: true,
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: "default",
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Format: bootstrapv1.Ignition,
+					Diagnostics: bootstrapv1.BootstrapDiagnostics{
+						Enabled: ptr.To(true),
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"format is Ignition, bootstrap progress is enabled": {
+			enableIgnitionFeature: true,
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: "default",
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Format: bootstrapv1.Ignition,
+					Diagnostics: bootstrapv1.BootstrapDiagnostics{
+						Progress: ptr.To(true),
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"format is cloud-config, diagnostics are enabled": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: "default",
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Format: bootstrapv1.CloudConfig,
+					Diagnostics: bootstrapv1.BootstrapDiagnostics{
+						Enabled: ptr.To(true),
+					},
+				},
+			},
+		},
 		"format is Ignition, non-GPT partition configured": {
 			enableIgnitionFeature: true,
 			in: &bootstrapv1.KubeadmConfig{
@@ -319,6 +476,43 @@ func TestKubeadmConfigValidate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		"format is Ignition, valid passthrough config": {
+			enableIgnitionFeature: true,
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: "default",
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Format: bootstrapv1.Ignition,
+					Ignition: bootstrapv1.IgnitionSpec{
+						Passthrough: bootstrapv1.IgnitionPassthrough{
+							Format: bootstrapv1.IgnitionPassthroughFormatButane,
+							Config: "variant: flatcar\nversion: 1.1.0\n",
+						},
+					},
+				},
+			},
+		},
+		"format is Ignition, invalid passthrough config": {
+			enableIgnitionFeature: true,
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: "default",
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Format: bootstrapv1.Ignition,
+					Ignition: bootstrapv1.IgnitionSpec{
+						Passthrough: bootstrapv1.IgnitionPassthrough{
+							Format: bootstrapv1.IgnitionPassthroughFormatIgnition,
+							Config: `{"ignition":{"version":"1.0.0"}}`,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		"feature gate disabled, format is Ignition": {
 			in: &bootstrapv1.KubeadmConfig{
 				ObjectMeta: metav1.ObjectMeta{
@@ -438,6 +632,27 @@ func TestKubeadmConfigValidate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		"additionalUserData configured with Ignition format": {
+			enableIgnitionFeature: true,
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Format: bootstrapv1.Ignition,
+					AdditionalUserData: []bootstrapv1.AdditionalUserData{
+						{
+							Secret: bootstrapv1.AdditionalUserDataSource{
+								Name: "foo",
+								Key:  "user-data",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		"bootCommands configured with CloudConfig format": {
 			in: &bootstrapv1.KubeadmConfig{
 				ObjectMeta: metav1.ObjectMeta{
@@ -58,6 +58,7 @@ import (
 	bootstrapv1alpha3 "sigs.k8s.io/cluster-api/internal/api/bootstrap/kubeadm/v1alpha3"
 	bootstrapv1alpha4 "sigs.k8s.io/cluster-api/internal/api/bootstrap/kubeadm/v1alpha4"
 	"sigs.k8s.io/cluster-api/util/apiwarnings"
+	"sigs.k8s.io/cluster-api/util/controllerstatus"
 	"sigs.k8s.io/cluster-api/util/flags"
 	"sigs.k8s.io/cluster-api/version"
 )
@@ -299,6 +300,10 @@ func main() {
 	ctx := ctrl.SetupSignalHandler()
 
 	setupChecks(mgr)
+	if err := controllerstatus.AddToManager(mgr, controllerstatus.NewFromFlags(controllerName, feature.MutableGates, pflag.CommandLine)); err != nil {
+		setupLog.Error(err, "unable to expose controller status")
+		os.Exit(1)
+	}
 	setupWebhooks(mgr)
 	setupReconcilers(ctx, mgr)
 
@@ -0,0 +1,189 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ManagementClusterName is the name of the ManagementCluster object clusterctl reads the desired state
+// of the management cluster from.
+const ManagementClusterName = "management-cluster"
+
+// ManagementCluster's Ready condition and corresponding reasons.
+const (
+	// ManagementClusterReadyCondition is true if all the providers defined in the ManagementCluster spec
+	// are installed with the desired version.
+	ManagementClusterReadyCondition = "Ready"
+
+	// ManagementClusterReadyReason surfaces when all the providers defined in the ManagementCluster spec
+	// are installed with the desired version.
+	ManagementClusterReadyReason = "Ready"
+
+	// ManagementClusterInstallFailedReason surfaces when installing providers defined in the ManagementCluster spec failed.
+	ManagementClusterInstallFailedReason = "InstallFailed"
+
+	// ManagementClusterUpgradeFailedReason surfaces when upgrading providers defined in the ManagementCluster spec failed.
+	ManagementClusterUpgradeFailedReason = "UpgradeFailed"
+
+	// ManagementClusterInternalErrorReason surfaces unexpected failures when reconciling a ManagementCluster.
+	ManagementClusterInternalErrorReason = "InternalError"
+)
+
+// +kubebuilder:resource:path=managementclusters,scope=Cluster,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Management cluster pass all readiness checks"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of ManagementCluster"
+
+// ManagementCluster defines the desired state of the providers installed in a management cluster.
+// clusterctl continuously reconciles the providers in the management cluster against it
+// when running in declarative mode.
+type ManagementCluster struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of ManagementCluster.
+	// +optional
+	Spec ManagementClusterSpec `json:"spec,omitempty"`
+
+	// status is the observed state of ManagementCluster.
+	// +optional
+	Status ManagementClusterStatus `json:"status,omitempty"`
+}
+
+// ManagementClusterSpec defines the desired state of ManagementCluster.
+type ManagementClusterSpec struct {
+	// providers is the list of providers which should be installed in the management cluster.
+	// Providers which are installed in the management cluster but not listed here are left untouched.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:XValidation:rule="self.filter(p, p.type == 'CoreProvider').size() <= 1",message="at most one CoreProvider can be defined"
+	Providers []ManagementClusterProvider `json:"providers,omitempty"`
+
+	// targetNamespace is the namespace where the providers should be installed.
+	// If empty, each provider is installed in the provider's default namespace.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+}
+
+// ManagementClusterProvider defines a provider which should be installed in the management cluster.
+type ManagementClusterProvider struct {
+	// type is the type of the provider.
+	// +required
+	// +kubebuilder:validation:Enum=CoreProvider;BootstrapProvider;ControlPlaneProvider;InfrastructureProvider;IPAMProvider;RuntimeExtensionProvider;AddonProvider
+	Type ProviderType `json:"type"`
+
+	// name is the name of the provider, e.g. aws.
+	// The name must match one of the providers in the clusterctl configuration.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// version is the version of the provider, e.g. v2.9.0.
+	// If empty, the latest release of the provider is installed, and the provider is never upgraded.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Version string `json:"version,omitempty"`
+}
+
+// ManagementClusterStatus defines the observed state of ManagementCluster.
+type ManagementClusterStatus struct {
+	// conditions represents the observations of a ManagementCluster's current state.
+	// Known condition types are Ready.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// providers is the list of providers installed in the management cluster, as observed
+	// by the last reconcile.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=100
+	Providers []ManagementClusterProviderStatus `json:"providers,omitempty"`
+
+	// observedGeneration is the latest generation observed by clusterctl.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// ManagementClusterProviderStatus defines a provider installed in the management cluster.
+type ManagementClusterProviderStatus struct {
+	// type is the type of the provider.
+	// +required
+	// +kubebuilder:validation:Enum=CoreProvider;BootstrapProvider;ControlPlaneProvider;InfrastructureProvider;IPAMProvider;RuntimeExtensionProvider;AddonProvider
+	Type ProviderType `json:"type"`
+
+	// name is the name of the provider, e.g. aws.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// namespace is the namespace where the provider is installed.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace,omitempty"`
+
+	// version is the installed version of the provider.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Version string `json:"version,omitempty"`
+}
+
+// GetConditions returns the set of conditions for this object.
+func (m *ManagementCluster) GetConditions() []metav1.Condition {
+	return m.Status.Conditions
+}
+
+// SetConditions sets conditions for an API object.
+func (m *ManagementCluster) SetConditions(conditions []metav1.Condition) {
+	m.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// ManagementClusterList contains a list of ManagementCluster.
+type ManagementClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard list's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// items is the list of ManagementClusters.
+	Items []ManagementCluster `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &ManagementCluster{}, &ManagementClusterList{})
+}
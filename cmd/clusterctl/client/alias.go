@@ -48,6 +48,9 @@ type CertManagerUpgradePlan cluster.CertManagerUpgradePlan
 // Kubeconfig is a type that specifies inputs related to the actual kubeconfig.
 type Kubeconfig cluster.Kubeconfig
 
+// ClusterClassVariablePromptFunc returns the value for a required ClusterClass variable which is not set.
+type ClusterClassVariablePromptFunc cluster.ClusterClassVariablePromptFunc
+
 // Processor defines the methods necessary for creating a specific yaml
 // processor.
 type Processor yaml.Processor
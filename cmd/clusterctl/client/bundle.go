@@ -0,0 +1,622 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/repository"
+	yamlprocessor "sigs.k8s.io/cluster-api/cmd/clusterctl/client/yamlprocessor"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/util"
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+const (
+	// BundleManifestFile is the name of the file describing the content of a clusterctl bundle.
+	BundleManifestFile = "bundle.yaml"
+
+	// BundleImagesFile is the name of the file listing the container images required by the components in a clusterctl bundle.
+	BundleImagesFile = "images.txt"
+
+	// BundleConfigFile is the name of the clusterctl configuration file written in the directory where a clusterctl bundle
+	// is extracted, pointing to the provider repositories in the bundle.
+	BundleConfigFile = "clusterctl.yaml"
+
+	// bundleComponentsFile is the name of the components YAML file of each provider in a clusterctl bundle.
+	bundleComponentsFile = "components.yaml"
+
+	// bundleMetadataFile is the name of the metadata file of each provider in a clusterctl bundle.
+	bundleMetadataFile = "metadata.yaml"
+
+	// bundleCertManagerName is the name of the directory of cert-manager in a clusterctl bundle.
+	bundleCertManagerName = "cert-manager"
+
+	// bundleCertManagerFile is the name of the cert-manager YAML file in a clusterctl bundle.
+	bundleCertManagerFile = "cert-manager.yaml"
+)
+
+// Bundle describes a clusterctl bundle, a portable archive with the components of a set of providers and of cert-manager,
+// the cluster templates of the infrastructure providers and the list of the required container images,
+// which allows to initialize a management cluster without network access.
+//
+// A clusterctl bundle uses the same layout of local provider repositories, i.e.
+// {provider-label}/{version}/{components.yaml|metadata.yaml|cluster-template[-flavor].yaml}.
+type Bundle struct {
+	// Directory is the directory where the bundle is extracted.
+	Directory string `json:"-"`
+
+	// CertManager is the cert-manager included in the bundle.
+	CertManager BundleCertManager `json:"certManager"`
+
+	// Providers are the providers included in the bundle.
+	Providers []BundleProvider `json:"providers"`
+
+	// Images are the container images required by the components in the bundle.
+	Images []string `json:"-"`
+}
+
+// BundleCertManager describes the cert-manager included in a clusterctl bundle.
+type BundleCertManager struct {
+	// Version of cert-manager.
+	Version string `json:"version"`
+}
+
+// BundleProvider describes a provider included in a clusterctl bundle.
+type BundleProvider struct {
+	// Name of the provider.
+	Name string `json:"name"`
+
+	// Type of the provider.
+	Type clusterctlv1.ProviderType `json:"type"`
+
+	// Version of the provider.
+	Version string `json:"version"`
+
+	// Flavors of the cluster templates included in the bundle; the empty flavor is the default cluster template.
+	Flavors []string `json:"flavors,omitempty"`
+}
+
+// ExportBundleOptions carries the options supported by ExportBundle.
+type ExportBundleOptions struct {
+	// CoreProvider version (e.g. cluster-api:v1.1.5) to add to the bundle. If unspecified, the
+	// cluster-api core provider's latest release is used.
+	CoreProvider string
+
+	// BootstrapProviders and versions (e.g. kubeadm:v1.1.5) to add to the bundle.
+	// If unspecified, the kubeadm bootstrap provider's latest release is used.
+	BootstrapProviders []string
+
+	// ControlPlaneProviders and versions (e.g. kubeadm:v1.1.5) to add to the bundle.
+	// If unspecified, the kubeadm control plane provider latest release is used.
+	ControlPlaneProviders []string
+
+	// InfrastructureProviders and versions (e.g. aws:v0.5.0) to add to the bundle.
+	InfrastructureProviders []string
+
+	// IPAMProviders and versions (e.g. infoblox:v0.0.1) to add to the bundle.
+	IPAMProviders []string
+
+	// RuntimeExtensionProviders and versions (e.g. test:v0.0.1) to add to the bundle.
+	RuntimeExtensionProviders []string
+
+	// AddonProviders and versions (e.g. helm:v0.1.0) to add to the bundle.
+	AddonProviders []string
+
+	// Flavors of the cluster templates of the infrastructure providers to add to the bundle; the empty flavor is the
+	// default cluster template. If unspecified, the default cluster template is added, if it exists.
+	Flavors []string
+
+	// OutputFile is the path of the bundle archive.
+	OutputFile string
+}
+
+// ExportBundle downloads the components of the requested providers and of cert-manager, the cluster templates of the
+// infrastructure providers and the list of the required container images into a clusterctl bundle archive.
+func (c *clusterctlClient) ExportBundle(ctx context.Context, options ExportBundleOptions) (*Bundle, error) {
+	if options.OutputFile == "" {
+		return nil, errors.New("invalid arguments: please provide an output file")
+	}
+
+	// Use the same providers installed by init on an empty management cluster, if not explicitly requested by the user.
+	if options.CoreProvider == "" {
+		options.CoreProvider = config.ClusterAPIProviderName
+	}
+	if len(options.BootstrapProviders) == 0 {
+		options.BootstrapProviders = []string{config.KubeadmBootstrapProviderName}
+	}
+	if len(options.ControlPlaneProviders) == 0 {
+		options.ControlPlaneProviders = []string{config.KubeadmControlPlaneProviderName}
+	}
+
+	content := &bundleContent{
+		files:  map[string][]byte{},
+		images: sets.Set[string]{},
+	}
+	bundle := &Bundle{}
+
+	for _, providers := range []struct {
+		providerType clusterctlv1.ProviderType
+		providers    []string
+	}{
+		{clusterctlv1.CoreProviderType, []string{options.CoreProvider}},
+		{clusterctlv1.BootstrapProviderType, options.BootstrapProviders},
+		{clusterctlv1.ControlPlaneProviderType, options.ControlPlaneProviders},
+		{clusterctlv1.InfrastructureProviderType, options.InfrastructureProviders},
+		{clusterctlv1.IPAMProviderType, options.IPAMProviders},
+		{clusterctlv1.RuntimeExtensionProviderType, options.RuntimeExtensionProviders},
+		{clusterctlv1.AddonProviderType, options.AddonProviders},
+	} {
+		for _, provider := range providers.providers {
+			// It is possible to opt-out from bundling the default bootstrap/control-plane providers using '-' as a provider name (NoopProvider).
+			if provider == NoopProvider {
+				if providers.providerType == clusterctlv1.CoreProviderType {
+					return nil, errors.New("the '-' value can not be used for the core provider")
+				}
+				continue
+			}
+			bundleProvider, err := c.exportBundleProvider(ctx, content, providers.providerType, provider, options.Flavors)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to export the %q provider", provider)
+			}
+			bundle.Providers = append(bundle.Providers, *bundleProvider)
+		}
+	}
+
+	certManager, err := c.exportBundleCertManager(ctx, content)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export cert-manager")
+	}
+	bundle.CertManager = *certManager
+
+	bundle.Images = sets.List(content.images)
+	content.files[BundleImagesFile] = []byte(strings.Join(bundle.Images, "\n") + "\n")
+
+	manifest, err := yaml.Marshal(bundle)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the bundle manifest")
+	}
+	content.files[BundleManifestFile] = manifest
+
+	if err := writeBundleArchive(options.OutputFile, content.files); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// bundleContent collects the files and the container images of a clusterctl bundle.
+type bundleContent struct {
+	files  map[string][]byte
+	images sets.Set[string]
+}
+
+// exportBundleProvider adds the components, the metadata and the cluster templates of a provider to a clusterctl bundle.
+func (c *clusterctlClient) exportBundleProvider(ctx context.Context, content *bundleContent, providerType clusterctlv1.ProviderType, provider string, flavors []string) (*BundleProvider, error) {
+	log := logf.Log
+
+	// Parse the abbreviated syntax for name[:version]
+	name, version, err := parseProviderName(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	providerConfig, err := c.configClient.Providers().Get(name, providerType)
+	if err != nil {
+		return nil, err
+	}
+
+	repositoryClient, err := c.repositoryClientFactory(ctx, RepositoryClientFactoryInput{Provider: providerConfig})
+	if err != nil {
+		return nil, err
+	}
+	if version == "" {
+		version = repositoryClient.DefaultVersion()
+	}
+
+	log.Info("Exporting", "provider", providerConfig.ManifestLabel(), "version", version)
+	bundleProvider := &BundleProvider{
+		Name:    providerConfig.Name(),
+		Type:    providerConfig.Type(),
+		Version: version,
+	}
+	providerPath := path.Join(providerConfig.ManifestLabel(), version)
+
+	// Gets the components YAML, and the images it requires.
+	componentsOptions := repository.ComponentsOptions{
+		Version:             version,
+		SkipTemplateProcess: true,
+	}
+	rawComponents, err := repositoryClient.Components().Raw(ctx, componentsOptions)
+	if err != nil {
+		return nil, err
+	}
+	components, err := repository.NewComponents(repository.ComponentsInput{
+		Provider:     providerConfig,
+		ConfigClient: c.configClient,
+		Processor:    yamlprocessor.NewSimpleProcessor(),
+		RawYaml:      rawComponents,
+		Options:      componentsOptions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if components.Type() != providerType {
+		return nil, errors.Errorf("can't use %q provider as an %q, it is a %q", provider, providerType, components.Type())
+	}
+	content.files[path.Join(providerPath, bundleComponentsFile)] = rawComponents
+	content.images.Insert(components.Images()...)
+
+	// Gets the metadata; NOTE: metadata are re-serialized, because they can be embedded in clusterctl for some providers.
+	metadata, err := repositoryClient.Metadata(version).Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	metadata.APIVersion = clusterctlv1.GroupVersion.String()
+	metadata.Kind = "Metadata"
+	rawMetadata, err := yaml.Marshal(metadata)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal %q", bundleMetadataFile)
+	}
+	content.files[path.Join(providerPath, bundleMetadataFile)] = rawMetadata
+
+	// Gets the cluster templates; templates are expected to exist for the infrastructure providers only.
+	if providerType != clusterctlv1.InfrastructureProviderType {
+		return bundleProvider, nil
+	}
+	requireTemplates := len(flavors) > 0
+	if !requireTemplates {
+		flavors = []string{""}
+	}
+	processor := yamlprocessor.NewSimpleProcessor()
+	for _, flavor := range flavors {
+		rawTemplate, err := repositoryClient.Templates(version).Raw(ctx, flavor)
+		if err != nil {
+			if requireTemplates {
+				return nil, err
+			}
+			log.Info("Skipping the default cluster template", "provider", providerConfig.ManifestLabel(), "version", version, "reason", err.Error())
+			continue
+		}
+		content.files[path.Join(providerPath, processor.GetTemplateName(version, flavor))] = rawTemplate
+		bundleProvider.Flavors = append(bundleProvider.Flavors, flavor)
+	}
+	return bundleProvider, nil
+}
+
+// exportBundleCertManager adds the cert-manager YAML to a clusterctl bundle.
+func (c *clusterctlClient) exportBundleCertManager(ctx context.Context, content *bundleContent) (*BundleCertManager, error) {
+	log := logf.Log
+
+	certManagerConfig, err := c.configClient.CertManager().Get()
+	if err != nil {
+		return nil, err
+	}
+
+	// Given that cert manager components yaml are stored in a repository like providers components yaml,
+	// we are using the same machinery to retrieve the file by using a fake provider object using
+	// the cert manager repository url.
+	certManagerRepository, err := c.repositoryClientFactory(ctx, RepositoryClientFactoryInput{
+		Provider: config.NewProvider(bundleCertManagerName, certManagerConfig.URL(), ""),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Exporting", "provider", bundleCertManagerName, "version", certManagerConfig.Version())
+	rawCertManager, err := certManagerRepository.Components().Raw(ctx, repository.ComponentsOptions{
+		Version: certManagerConfig.Version(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	objs, err := utilyaml.ToUnstructured(rawCertManager)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse yaml for cert-manager manifest")
+	}
+	objs, err = util.FixImages(objs, func(image string) (string, error) {
+		return c.configClient.ImageMeta().AlterImage(config.CertManagerImageComponent, image)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to apply image override to the cert-manager manifest")
+	}
+	images, err := util.InspectImages(objs)
+	if err != nil {
+		return nil, err
+	}
+
+	content.files[path.Join(bundleCertManagerName, certManagerConfig.Version(), bundleCertManagerFile)] = rawCertManager
+	content.images.Insert(images...)
+	return &BundleCertManager{Version: certManagerConfig.Version()}, nil
+}
+
+// writeBundleArchive writes the files of a clusterctl bundle into a gzipped tar archive.
+func writeBundleArchive(archive string, files map[string][]byte) error {
+	f, err := os.Create(archive) //nolint:gosec
+	if err != nil {
+		return errors.Wrapf(err, "failed to create bundle archive %q", archive)
+	}
+	defer f.Close()
+
+	gzipWriter := gzip.NewWriter(f)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	// Write files in a consistent order.
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := tarWriter.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(files[name])),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to write %q to bundle archive %q", name, archive)
+		}
+		if _, err := tarWriter.Write(files[name]); err != nil {
+			return errors.Wrapf(err, "failed to write %q to bundle archive %q", name, archive)
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return errors.Wrapf(err, "failed to write bundle archive %q", archive)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return errors.Wrapf(err, "failed to write bundle archive %q", archive)
+	}
+	return f.Close()
+}
+
+// ExtractBundle extracts a clusterctl bundle archive into a directory and returns the corresponding Bundle.
+// A clusterctl configuration file pointing to the provider repositories in the bundle is written in the directory too,
+// so it can be used e.g. for generating workload clusters from the cluster templates in the bundle without network access.
+func ExtractBundle(archive, directory string) (*Bundle, error) {
+	directory, err := filepath.Abs(directory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the absolute path of %q", directory)
+	}
+
+	if err := extractBundleArchive(archive, directory); err != nil {
+		return nil, err
+	}
+
+	rawManifest, err := os.ReadFile(filepath.Join(directory, BundleManifestFile)) //nolint:gosec
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %q from bundle archive %q", BundleManifestFile, archive)
+	}
+	bundle := &Bundle{}
+	if err := yaml.Unmarshal(rawManifest, bundle); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %q from bundle archive %q", BundleManifestFile, archive)
+	}
+	bundle.Directory = directory
+
+	rawImages, err := os.ReadFile(filepath.Join(directory, BundleImagesFile)) //nolint:gosec
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %q from bundle archive %q", BundleImagesFile, archive)
+	}
+	for _, image := range strings.Split(string(rawImages), "\n") {
+		if image = strings.TrimSpace(image); image != "" {
+			bundle.Images = append(bundle.Images, image)
+		}
+	}
+
+	if err := bundle.writeConfig(); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// extractBundleArchive extracts a gzipped tar archive into a directory.
+func extractBundleArchive(archive, directory string) error {
+	f, err := os.Open(archive) //nolint:gosec
+	if err != nil {
+		return errors.Wrapf(err, "failed to open bundle archive %q", archive)
+	}
+	defer f.Close()
+
+	gzipReader, err := gzip.NewReader(f)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read bundle archive %q", archive)
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to read bundle archive %q", archive)
+		}
+
+		// Ensure entries can't be written outside the target directory.
+		name := path.Clean(header.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return errors.Errorf("invalid entry %q in bundle archive %q", header.Name, archive)
+		}
+		target := filepath.Join(directory, filepath.FromSlash(name))
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o750); err != nil {
+				return errors.Wrapf(err, "failed to create directory %q", target)
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+				return errors.Wrapf(err, "failed to create directory %q", filepath.Dir(target))
+			}
+			content, err := io.ReadAll(tarReader)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read %q from bundle archive %q", header.Name, archive)
+			}
+			if err := os.WriteFile(target, content, 0o600); err != nil {
+				return errors.Wrapf(err, "failed to write %q", target)
+			}
+		default:
+			return errors.Errorf("unsupported entry %q in bundle archive %q", header.Name, archive)
+		}
+	}
+}
+
+// writeConfig writes a clusterctl configuration file pointing to the provider repositories in the bundle directory.
+func (b *Bundle) writeConfig() error {
+	providers := []map[string]string{}
+	for _, p := range b.providerConfigs() {
+		providers = append(providers, map[string]string{
+			"name": p.Name(),
+			"url":  p.URL(),
+			"type": string(p.Type()),
+		})
+	}
+	certManager := b.certManagerConfig()
+
+	rawConfig, err := yaml.Marshal(map[string]interface{}{
+		config.ProvidersConfigKey: providers,
+		config.CertManagerConfigKey: map[string]string{
+			"url":     certManager.URL(),
+			"version": certManager.Version(),
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %q", BundleConfigFile)
+	}
+
+	configFile := filepath.Join(b.Directory, BundleConfigFile)
+	if err := os.WriteFile(configFile, rawConfig, 0o600); err != nil {
+		return errors.Wrapf(err, "failed to write %q", configFile)
+	}
+	return nil
+}
+
+// providerConfigs returns the configuration of the providers in the bundle, pointing to the bundle directory.
+func (b *Bundle) providerConfigs() []config.Provider {
+	providers := make([]config.Provider, 0, len(b.Providers))
+	for _, p := range b.Providers {
+		providers = append(providers, config.NewProvider(p.Name, b.fileURL(clusterctlv1.ManifestLabel(p.Name, p.Type), p.Version, bundleComponentsFile), p.Type))
+	}
+	return providers
+}
+
+// certManagerConfig returns the configuration of cert-manager, pointing to the bundle directory.
+func (b *Bundle) certManagerConfig() config.CertManager {
+	return config.NewCertManager(b.fileURL(bundleCertManagerName, b.CertManager.Version, bundleCertManagerFile), b.CertManager.Version, "")
+}
+
+// fileURL returns the URL of a file in the bundle directory.
+func (b *Bundle) fileURL(elem ...string) string {
+	p := filepath.ToSlash(filepath.Join(append([]string{b.Directory}, elem...)...))
+	// NB. the URL of a windows path requires an additional /; see https://blogs.msdn.microsoft.com/ie/2006/12/06/file-uris-in-windows/ for more details.
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	return "file://" + p
+}
+
+// setInitProviders sets the providers to install to all the providers in the bundle,
+// if no providers are explicitly requested by the user.
+func (b *Bundle) setInitProviders(options *InitOptions) {
+	if options.CoreProvider != "" ||
+		len(options.BootstrapProviders) > 0 ||
+		len(options.ControlPlaneProviders) > 0 ||
+		len(options.InfrastructureProviders) > 0 ||
+		len(options.IPAMProviders) > 0 ||
+		len(options.RuntimeExtensionProviders) > 0 ||
+		len(options.AddonProviders) > 0 {
+		return
+	}
+
+	for _, p := range b.Providers {
+		provider := p.Name + ":" + p.Version
+		switch p.Type {
+		case clusterctlv1.CoreProviderType:
+			options.CoreProvider = provider
+		case clusterctlv1.BootstrapProviderType:
+			options.BootstrapProviders = append(options.BootstrapProviders, provider)
+		case clusterctlv1.ControlPlaneProviderType:
+			options.ControlPlaneProviders = append(options.ControlPlaneProviders, provider)
+		case clusterctlv1.InfrastructureProviderType:
+			options.InfrastructureProviders = append(options.InfrastructureProviders, provider)
+		case clusterctlv1.IPAMProviderType:
+			options.IPAMProviders = append(options.IPAMProviders, provider)
+		case clusterctlv1.RuntimeExtensionProviderType:
+			options.RuntimeExtensionProviders = append(options.RuntimeExtensionProviders, provider)
+		case clusterctlv1.AddonProviderType:
+			options.AddonProviders = append(options.AddonProviders, provider)
+		}
+	}
+}
+
+// validateInitProviders ensures that all the providers to install are included in the bundle,
+// so a management cluster can be initialized without network access.
+func (b *Bundle) validateInitProviders(options InitOptions) error {
+	for _, providers := range []struct {
+		providerType clusterctlv1.ProviderType
+		providers    []string
+	}{
+		{clusterctlv1.CoreProviderType, []string{options.CoreProvider}},
+		{clusterctlv1.BootstrapProviderType, options.BootstrapProviders},
+		{clusterctlv1.ControlPlaneProviderType, options.ControlPlaneProviders},
+		{clusterctlv1.InfrastructureProviderType, options.InfrastructureProviders},
+		{clusterctlv1.IPAMProviderType, options.IPAMProviders},
+		{clusterctlv1.RuntimeExtensionProviderType, options.RuntimeExtensionProviders},
+		{clusterctlv1.AddonProviderType, options.AddonProviders},
+	} {
+		for _, provider := range providers.providers {
+			if provider == "" || provider == NoopProvider {
+				continue
+			}
+			name, version, err := parseProviderName(provider)
+			if err != nil {
+				return err
+			}
+			if !b.hasProvider(name, providers.providerType, version) {
+				return errors.Errorf("the %s %q is not included in the bundle; please export a bundle including it", providers.providerType, provider)
+			}
+		}
+	}
+	return nil
+}
+
+// hasProvider returns true if the bundle includes a provider with the given name, type and version;
+// an empty version matches any version.
+func (b *Bundle) hasProvider(name string, providerType clusterctlv1.ProviderType, version string) bool {
+	for _, p := range b.Providers {
+		if p.Name == name && p.Type == providerType && (version == "" || p.Version == version) {
+			return true
+		}
+	}
+	return false
+}
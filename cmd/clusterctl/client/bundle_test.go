@@ -0,0 +1,274 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+)
+
+var certManagerBundleYAML = []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: cert-manager
+  namespace: cert-manager
+spec:
+  template:
+    spec:
+      containers:
+      - image: quay.io/jetstack/cert-manager-controller:v1.19.1
+        name: cert-manager-controller
+`)
+
+func fakeBundleClient() *fakeClient {
+	client := fakeEmptyCluster()
+
+	certManagerProvider := config.NewProvider(bundleCertManagerName, config.CertManagerDefaultURL, "")
+	client.repositories[certManagerProvider.ManifestLabel()] = newFakeRepository(ctx, certManagerProvider, client.configClient).
+		WithPaths("root", "cert-manager.yaml").
+		WithDefaultVersion(config.CertManagerDefaultVersion).
+		WithFile(config.CertManagerDefaultVersion, "cert-manager.yaml", certManagerBundleYAML)
+	return client
+}
+
+func Test_clusterctlClient_ExportBundle(t *testing.T) {
+	t.Run("exports and extracts a bundle", func(t *testing.T) {
+		g := NewWithT(t)
+
+		archive := filepath.Join(t.TempDir(), "bundle.tar.gz")
+		bundle, err := fakeBundleClient().ExportBundle(ctx, ExportBundleOptions{
+			InfrastructureProviders: []string{"infra"},
+			OutputFile:              archive,
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.CertManager.Version).To(Equal(config.CertManagerDefaultVersion))
+		g.Expect(bundle.Providers).To(Equal([]BundleProvider{
+			{Name: config.ClusterAPIProviderName, Type: clusterctlv1.CoreProviderType, Version: "v1.0.0"},
+			{Name: config.KubeadmBootstrapProviderName, Type: clusterctlv1.BootstrapProviderType, Version: "v2.0.0"},
+			{Name: config.KubeadmControlPlaneProviderName, Type: clusterctlv1.ControlPlaneProviderType, Version: "v2.0.0"},
+			{Name: "infra", Type: clusterctlv1.InfrastructureProviderType, Version: "v3.0.0", Flavors: []string{""}},
+		}))
+		g.Expect(bundle.Images).To(Equal([]string{
+			"quay.io/jetstack/cert-manager-controller:v1.19.1",
+			"registry.k8s.io/cluster-api-aws/cluster-api-aws-controller:v0.5.3",
+		}))
+
+		directory := t.TempDir()
+		extracted, err := ExtractBundle(archive, directory)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(extracted.Directory).To(Equal(directory))
+		g.Expect(extracted.CertManager).To(Equal(bundle.CertManager))
+		g.Expect(extracted.Providers).To(Equal(bundle.Providers))
+		g.Expect(extracted.Images).To(Equal(bundle.Images))
+
+		for _, file := range []string{
+			"cluster-api/v1.0.0/components.yaml",
+			"cluster-api/v1.0.0/metadata.yaml",
+			"bootstrap-kubeadm/v2.0.0/components.yaml",
+			"control-plane-kubeadm/v2.0.0/components.yaml",
+			"infrastructure-infra/v3.0.0/components.yaml",
+			"infrastructure-infra/v3.0.0/cluster-template.yaml",
+			"cert-manager/" + config.CertManagerDefaultVersion + "/cert-manager.yaml",
+			BundleConfigFile,
+		} {
+			g.Expect(filepath.Join(directory, file)).To(BeAnExistingFile())
+		}
+		content, err := os.ReadFile(filepath.Join(directory, "infrastructure-infra/v3.0.0/components.yaml"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(content).To(Equal(infraComponentsYAML("ns4")))
+
+		// Reads the providers from the extracted bundle.
+		configFile := filepath.Join(t.TempDir(), "clusterctl.yaml")
+		g.Expect(os.WriteFile(configFile, []byte("SOME_VARIABLE: value\n"), 0o600)).To(Succeed())
+		c, err := New(ctx, configFile, InjectBundle(extracted))
+		g.Expect(err).ToNot(HaveOccurred())
+		components, err := c.GetProviderComponents(ctx, "infra", clusterctlv1.InfrastructureProviderType, ComponentsOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(components.Version()).To(Equal("v3.0.0"))
+		g.Expect(components.URL()).To(Equal(extracted.fileURL("infrastructure-infra", "v3.0.0", "components.yaml")))
+	})
+
+	t.Run("exports the requested cluster templates", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := fakeBundleClient()
+		client.repositories[infraProviderConfig.ManifestLabel()].(*fakeRepositoryClient).
+			WithFile("v3.0.0", "cluster-template-dev.yaml", templateYAML("ns4", "dev"))
+
+		bundle, err := client.ExportBundle(ctx, ExportBundleOptions{
+			CoreProvider:            "cluster-api:v1.1.0",
+			BootstrapProviders:      []string{NoopProvider},
+			ControlPlaneProviders:   []string{NoopProvider},
+			InfrastructureProviders: []string{"infra"},
+			Flavors:                 []string{"dev"},
+			OutputFile:              filepath.Join(t.TempDir(), "bundle.tar.gz"),
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(bundle.Providers).To(Equal([]BundleProvider{
+			{Name: config.ClusterAPIProviderName, Type: clusterctlv1.CoreProviderType, Version: "v1.1.0"},
+			{Name: "infra", Type: clusterctlv1.InfrastructureProviderType, Version: "v3.0.0", Flavors: []string{"dev"}},
+		}))
+	})
+
+	t.Run("fails if a requested cluster template does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := fakeBundleClient().ExportBundle(ctx, ExportBundleOptions{
+			InfrastructureProviders: []string{"infra"},
+			Flavors:                 []string{"not-existing"},
+			OutputFile:              filepath.Join(t.TempDir(), "bundle.tar.gz"),
+		})
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("fails if the core provider is opted-out", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := fakeBundleClient().ExportBundle(ctx, ExportBundleOptions{
+			CoreProvider: NoopProvider,
+			OutputFile:   filepath.Join(t.TempDir(), "bundle.tar.gz"),
+		})
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestExtractBundle(t *testing.T) {
+	writeArchive := func(t *testing.T, name string) string {
+		t.Helper()
+		g := NewWithT(t)
+
+		archive := filepath.Join(t.TempDir(), "bundle.tar.gz")
+		f, err := os.Create(archive) //nolint:gosec
+		g.Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		gzipWriter := gzip.NewWriter(f)
+		tarWriter := tar.NewWriter(gzipWriter)
+		g.Expect(tarWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Mode: 0o644, Size: 3})).To(Succeed())
+		_, err = tarWriter.Write([]byte("foo"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tarWriter.Close()).To(Succeed())
+		g.Expect(gzipWriter.Close()).To(Succeed())
+		return archive
+	}
+
+	tests := []struct {
+		name      string
+		entryName string
+	}{
+		{
+			name:      "fails with entries outside the target directory",
+			entryName: "../foo.yaml",
+		},
+		{
+			name:      "fails with absolute entries",
+			entryName: "/foo.yaml",
+		},
+		{
+			name:      "fails without bundle manifest",
+			entryName: "foo.yaml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			directory := filepath.Join(t.TempDir(), "bundle")
+			_, err := ExtractBundle(writeArchive(t, tt.entryName), directory)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(filepath.Join(filepath.Dir(directory), "foo.yaml")).ToNot(BeAnExistingFile())
+		})
+	}
+}
+
+func TestBundle_InitProviders(t *testing.T) {
+	bundle := &Bundle{
+		Providers: []BundleProvider{
+			{Name: config.ClusterAPIProviderName, Type: clusterctlv1.CoreProviderType, Version: "v1.0.0"},
+			{Name: config.KubeadmBootstrapProviderName, Type: clusterctlv1.BootstrapProviderType, Version: "v2.0.0"},
+			{Name: config.KubeadmControlPlaneProviderName, Type: clusterctlv1.ControlPlaneProviderType, Version: "v2.0.0"},
+			{Name: "infra", Type: clusterctlv1.InfrastructureProviderType, Version: "v3.0.0"},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		options         InitOptions
+		expectedOptions InitOptions
+		expectedErr     bool
+	}{
+		{
+			name:    "installs all the providers in the bundle if no providers are specified",
+			options: InitOptions{},
+			expectedOptions: InitOptions{
+				CoreProvider:            "cluster-api:v1.0.0",
+				BootstrapProviders:      []string{"kubeadm:v2.0.0"},
+				ControlPlaneProviders:   []string{"kubeadm:v2.0.0"},
+				InfrastructureProviders: []string{"infra:v3.0.0"},
+			},
+		},
+		{
+			name: "installs the specified providers",
+			options: InitOptions{
+				CoreProvider:            "cluster-api",
+				BootstrapProviders:      []string{NoopProvider},
+				InfrastructureProviders: []string{"infra:v3.0.0"},
+			},
+			expectedOptions: InitOptions{
+				CoreProvider:            "cluster-api",
+				BootstrapProviders:      []string{NoopProvider},
+				InfrastructureProviders: []string{"infra:v3.0.0"},
+			},
+		},
+		{
+			name: "fails if a provider is not in the bundle",
+			options: InitOptions{
+				InfrastructureProviders: []string{"aws"},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "fails if a provider version is not in the bundle",
+			options: InitOptions{
+				InfrastructureProviders: []string{"infra:v3.1.0"},
+			},
+			expectedErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			options := tt.options
+			bundle.setInitProviders(&options)
+			err := bundle.validateInitProviders(options)
+			if tt.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(options).To(Equal(tt.expectedOptions))
+		})
+	}
+}
@@ -47,6 +47,10 @@ type Client interface {
 	// InitImages returns the list of images required for executing the init command.
 	InitImages(ctx context.Context, options InitOptions) ([]string, error)
 
+	// ExportBundle downloads the components of a set of providers and of cert-manager, the cluster templates and the list of
+	// the required container images into a clusterctl bundle archive, which allows to initialize a management cluster without network access.
+	ExportBundle(ctx context.Context, options ExportBundleOptions) (*Bundle, error)
+
 	// GetClusterTemplate returns a workload cluster template.
 	GetClusterTemplate(ctx context.Context, options GetClusterTemplateOptions) (Template, error)
 
@@ -75,6 +79,9 @@ type Client interface {
 	// DescribeCluster returns the object tree representing the status of a Cluster API cluster.
 	DescribeCluster(ctx context.Context, options DescribeClusterOptions) (*tree.ObjectTree, error)
 
+	// DescribeExtensions returns the description of the Runtime Extensions registered in a management cluster.
+	DescribeExtensions(ctx context.Context, options DescribeExtensionsOptions) (*cluster.ExtensionsDescription, error)
+
 	// AlphaClient is an Interface for alpha features in clusterctl
 	AlphaClient
 }
@@ -87,6 +94,25 @@ type AlphaClient interface {
 	RolloutPause(ctx context.Context, options RolloutPauseOptions) error
 	// RolloutResume provides rollout resume of paused cluster-api resources
 	RolloutResume(ctx context.Context, options RolloutResumeOptions) error
+	// ReportSkew returns the Kubernetes version skew report for the Clusters in a management cluster.
+	ReportSkew(ctx context.Context, options ReportSkewOptions) (*cluster.SkewReport, error)
+	// ReportManagedComponents returns the components managed by Cluster API for the Clusters in a management cluster,
+	// optionally storing them in a ConfigMap for each Cluster.
+	ReportManagedComponents(ctx context.Context, options ReportManagedComponentsOptions) (*cluster.ManagedComponentsReport, error)
+	// RepairOwnerRefs checks and repairs the ownerReferences and finalizers of the objects of the Clusters in a management cluster.
+	RepairOwnerRefs(ctx context.Context, options RepairOwnerRefsOptions) (*cluster.OwnerRefsReport, error)
+	// MigrateStorage migrates the CRs of the CRDs installed by clusterctl in a management cluster to their storage version.
+	MigrateStorage(ctx context.Context, options MigrateStorageOptions) ([]cluster.CRDMigration, error)
+	// MigrateAPIVersions migrates the Cluster API objects stored at deprecated API versions in a management cluster
+	// to the hub version, removing their conversion data annotation.
+	MigrateAPIVersions(ctx context.Context, options MigrateAPIVersionsOptions) (*cluster.APIVersionMigrationReport, error)
+	// ApplyManagementCluster records the desired providers in the ManagementCluster object of a management cluster.
+	ApplyManagementCluster(ctx context.Context, options ApplyManagementClusterOptions) (*clusterctlv1.ManagementCluster, error)
+	// ReconcileManagementCluster installs and upgrades the providers in a management cluster according to its ManagementCluster object.
+	ReconcileManagementCluster(ctx context.Context, options ReconcileManagementClusterOptions) (*clusterctlv1.ManagementCluster, error)
+	// DrainExplain evaluates the MachineDrainRules matching a Machine against the Pods running on its Node and returns
+	// which Pods would be evicted, skipped or would block the drain, and in which order.
+	DrainExplain(ctx context.Context, options DrainExplainOptions) (*cluster.DrainExplanation, error)
 }
 
 // YamlPrinter exposes methods that prints the processed template and
@@ -107,6 +133,7 @@ type clusterctlClient struct {
 	alphaClient                   alpha.Client
 	currentContractVersion        string
 	getCompatibleContractVersions func(string) sets.Set[string]
+	bundle                        *Bundle
 }
 
 // RepositoryClientFactoryInput represents the inputs required by the factory.
@@ -156,6 +183,15 @@ func InjectClusterClientFactory(factory ClusterClientFactory) Option {
 	}
 }
 
+// InjectBundle allows to read the providers and cert-manager from an extracted clusterctl bundle instead of from their
+// repositories, e.g. to initialize a management cluster without network access.
+// NOTE: InjectBundle is ignored if a configuration client is injected with InjectConfig.
+func InjectBundle(bundle *Bundle) Option {
+	return func(c *clusterctlClient) {
+		c.bundle = bundle
+	}
+}
+
 // InjectCurrentContractVersion allows you to override the currentContractVersion that
 // cluster client uses. This option is intended for internal tests only.
 func InjectCurrentContractVersion(currentContractVersion string) Option {
@@ -181,7 +217,11 @@ func newClusterctlClient(ctx context.Context, path string, options ...Option) (*
 	// if there is an injected config, use it, otherwise use the default one
 	// provided by the config low level library.
 	if client.configClient == nil {
-		c, err := config.New(ctx, path)
+		configOptions := []config.Option{}
+		if client.bundle != nil {
+			configOptions = append(configOptions, config.InjectRepositoryOverrides(client.bundle.providerConfigs(), client.bundle.certManagerConfig()))
+		}
+		c, err := config.New(ctx, path, configOptions...)
 		if err != nil {
 			return nil, err
 		}
@@ -105,6 +105,10 @@ func (f fakeClient) InitImages(ctx context.Context, options InitOptions) ([]stri
 	return f.internalClient.InitImages(ctx, options)
 }
 
+func (f fakeClient) ExportBundle(ctx context.Context, options ExportBundleOptions) (*Bundle, error) {
+	return f.internalClient.ExportBundle(ctx, options)
+}
+
 func (f fakeClient) Delete(ctx context.Context, options DeleteOptions) error {
 	return f.internalClient.Delete(ctx, options)
 }
@@ -137,6 +141,10 @@ func (f fakeClient) DescribeCluster(ctx context.Context, options DescribeCluster
 	return f.internalClient.DescribeCluster(ctx, options)
 }
 
+func (f fakeClient) DescribeExtensions(ctx context.Context, options DescribeExtensionsOptions) (*cluster.ExtensionsDescription, error) {
+	return f.internalClient.DescribeExtensions(ctx, options)
+}
+
 func (f fakeClient) RolloutPause(ctx context.Context, options RolloutPauseOptions) error {
 	return f.internalClient.RolloutPause(ctx, options)
 }
@@ -145,6 +153,38 @@ func (f fakeClient) RolloutResume(ctx context.Context, options RolloutResumeOpti
 	return f.internalClient.RolloutResume(ctx, options)
 }
 
+func (f fakeClient) ReportSkew(ctx context.Context, options ReportSkewOptions) (*cluster.SkewReport, error) {
+	return f.internalClient.ReportSkew(ctx, options)
+}
+
+func (f fakeClient) ReportManagedComponents(ctx context.Context, options ReportManagedComponentsOptions) (*cluster.ManagedComponentsReport, error) {
+	return f.internalClient.ReportManagedComponents(ctx, options)
+}
+
+func (f fakeClient) RepairOwnerRefs(ctx context.Context, options RepairOwnerRefsOptions) (*cluster.OwnerRefsReport, error) {
+	return f.internalClient.RepairOwnerRefs(ctx, options)
+}
+
+func (f fakeClient) DrainExplain(ctx context.Context, options DrainExplainOptions) (*cluster.DrainExplanation, error) {
+	return f.internalClient.DrainExplain(ctx, options)
+}
+
+func (f fakeClient) MigrateStorage(ctx context.Context, options MigrateStorageOptions) ([]cluster.CRDMigration, error) {
+	return f.internalClient.MigrateStorage(ctx, options)
+}
+
+func (f fakeClient) MigrateAPIVersions(ctx context.Context, options MigrateAPIVersionsOptions) (*cluster.APIVersionMigrationReport, error) {
+	return f.internalClient.MigrateAPIVersions(ctx, options)
+}
+
+func (f fakeClient) ApplyManagementCluster(ctx context.Context, options ApplyManagementClusterOptions) (*clusterctlv1.ManagementCluster, error) {
+	return f.internalClient.ApplyManagementCluster(ctx, options)
+}
+
+func (f fakeClient) ReconcileManagementCluster(ctx context.Context, options ReconcileManagementClusterOptions) (*clusterctlv1.ManagementCluster, error) {
+	return f.internalClient.ReconcileManagementCluster(ctx, options)
+}
+
 // newFakeClient returns a clusterctl client that allows to execute tests on a set of fake config, fake repositories and fake clusters.
 // you can use WithCluster and WithRepository to prepare for the test case.
 func newFakeClient(ctx context.Context, configClient config.Client) *fakeClient {
@@ -318,6 +358,26 @@ func (f *fakeClusterClient) WorkloadCluster() cluster.WorkloadCluster {
 	return f.internalclient.WorkloadCluster()
 }
 
+func (f *fakeClusterClient) SkewReporter() cluster.SkewReporter {
+	return f.internalclient.SkewReporter()
+}
+
+func (f *fakeClusterClient) ManagedComponentsReporter() cluster.ManagedComponentsReporter {
+	return f.internalclient.ManagedComponentsReporter()
+}
+
+func (f *fakeClusterClient) OwnerRefsRepairer() cluster.OwnerRefsRepairer {
+	return f.internalclient.OwnerRefsRepairer()
+}
+
+func (f *fakeClusterClient) ExtensionsDescriber() cluster.ExtensionsDescriber {
+	return f.internalclient.ExtensionsDescriber()
+}
+
+func (f *fakeClusterClient) DrainExplainer() cluster.DrainExplainer {
+	return f.internalclient.DrainExplainer()
+}
+
 func (f *fakeClusterClient) WithObjs(objs ...client.Object) *fakeClusterClient {
 	f.fakeProxy.WithObjs(objs...)
 	return f
@@ -497,13 +557,7 @@ type fakeTemplateClient struct {
 }
 
 func (f *fakeTemplateClient) Get(ctx context.Context, flavor, targetNamespace string, skipTemplateProcess bool) (repository.Template, error) {
-	name := "cluster-template"
-	if flavor != "" {
-		name = fmt.Sprintf("%s-%s", name, flavor)
-	}
-	name = fmt.Sprintf("%s.yaml", name)
-
-	content, err := f.fakeRepository.GetFile(ctx, f.version, name)
+	content, err := f.Raw(ctx, flavor)
 	if err != nil {
 		return nil, err
 	}
@@ -516,6 +570,16 @@ func (f *fakeTemplateClient) Get(ctx context.Context, flavor, targetNamespace st
 	})
 }
 
+func (f *fakeTemplateClient) Raw(ctx context.Context, flavor string) ([]byte, error) {
+	name := "cluster-template"
+	if flavor != "" {
+		name = fmt.Sprintf("%s-%s", name, flavor)
+	}
+	name = fmt.Sprintf("%s.yaml", name)
+
+	return f.fakeRepository.GetFile(ctx, f.version, name)
+}
+
 // fakeClusterClassClient provides a super simple TemplateClient (e.g. without support for local overrides).
 type fakeClusterClassClient struct {
 	version               string
@@ -0,0 +1,265 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+	addonsv1alpha3 "sigs.k8s.io/cluster-api/internal/api/addons/v1alpha3"
+	addonsv1alpha4 "sigs.k8s.io/cluster-api/internal/api/addons/v1alpha4"
+	bootstrapv1alpha3 "sigs.k8s.io/cluster-api/internal/api/bootstrap/kubeadm/v1alpha3"
+	bootstrapv1alpha4 "sigs.k8s.io/cluster-api/internal/api/bootstrap/kubeadm/v1alpha4"
+	controlplanev1alpha3 "sigs.k8s.io/cluster-api/internal/api/controlplane/kubeadm/v1alpha3"
+	controlplanev1alpha4 "sigs.k8s.io/cluster-api/internal/api/controlplane/kubeadm/v1alpha4"
+	clusterv1alpha3 "sigs.k8s.io/cluster-api/internal/api/core/v1alpha3"
+	clusterv1alpha4 "sigs.k8s.io/cluster-api/internal/api/core/v1alpha4"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+)
+
+// deprecatedAPIVersionsScheme contains the deprecated API versions of Cluster API for which conversions
+// to the hub version still exist; objects stored at these versions must be migrated before upgrading
+// to a release which removes them.
+var deprecatedAPIVersionsScheme = runtime.NewScheme()
+
+func init() {
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		clusterv1alpha3.AddToScheme,
+		clusterv1alpha4.AddToScheme,
+		addonsv1alpha3.AddToScheme,
+		addonsv1alpha4.AddToScheme,
+		bootstrapv1alpha3.AddToScheme,
+		bootstrapv1alpha4.AddToScheme,
+		controlplanev1alpha3.AddToScheme,
+		controlplanev1alpha4.AddToScheme,
+	} {
+		utilruntime.Must(addToScheme(deprecatedAPIVersionsScheme))
+	}
+}
+
+// APIVersionMigrator migrates Cluster API objects stored at deprecated API versions.
+type APIVersionMigrator interface {
+	// Migrate rewrites the objects of the CRDs which have deprecated API versions in status.storedVersions
+	// at the hub version, removes their conversion data annotation, and returns a report of the migration.
+	Migrate(ctx context.Context, options APIVersionMigrationOptions) (*APIVersionMigrationReport, error)
+}
+
+// APIVersionMigrationOptions carries the options supported by APIVersionMigrator.Migrate.
+type APIVersionMigrationOptions struct {
+	// DryRun defines if objects requiring migration should only be reported, without migrating them.
+	DryRun bool
+}
+
+// APIVersionMigrationReport describes the migration of objects stored at deprecated API versions.
+type APIVersionMigrationReport struct {
+	// CRDs are the CRDs which have deprecated API versions in status.storedVersions.
+	CRDs []APIVersionMigrationCRD `json:"crds,omitempty"`
+
+	// Namespaces are the namespaces with objects of the CRDs above.
+	Namespaces []APIVersionMigrationNamespace `json:"namespaces,omitempty"`
+}
+
+// APIVersionMigrationCRD describes the migration of the objects of a CRD.
+type APIVersionMigrationCRD struct {
+	// CRD is the name of the CRD.
+	CRD string `json:"crd"`
+
+	// DeprecatedVersions are the deprecated API versions listed in status.storedVersions of the CRD before the migration.
+	DeprecatedVersions []string `json:"deprecatedVersions"`
+
+	// StorageVersion is the storage version of the CRD, i.e. the hub version.
+	StorageVersion string `json:"storageVersion"`
+
+	// Migrated is true if the objects have been rewritten at the storage version and
+	// status.storedVersions of the CRD has been updated.
+	Migrated bool `json:"migrated"`
+}
+
+// APIVersionMigrationNamespace describes the migration of the objects in a namespace.
+type APIVersionMigrationNamespace struct {
+	// Namespace is the name of the namespace.
+	Namespace string `json:"namespace"`
+
+	// Resources are the migrated objects in the namespace grouped by kind.
+	Resources []APIVersionMigrationResource `json:"resources"`
+}
+
+// APIVersionMigrationResource describes the migration of the objects of a kind in a namespace.
+type APIVersionMigrationResource struct {
+	// Kind is the kind of the objects.
+	Kind string `json:"kind"`
+
+	// Objects is the number of objects.
+	Objects int `json:"objects"`
+
+	// ConversionDataAnnotations is the number of objects with the conversion data annotation,
+	// which is removed by the migration.
+	ConversionDataAnnotations int `json:"conversionDataAnnotations"`
+}
+
+// apiVersionMigrator migrates Cluster API objects stored at deprecated API versions.
+type apiVersionMigrator struct {
+	Client client.Client
+}
+
+// NewAPIVersionMigrator creates a new APIVersionMigrator.
+func NewAPIVersionMigrator(client client.Client) APIVersionMigrator {
+	return &apiVersionMigrator{
+		Client: client,
+	}
+}
+
+func (m *apiVersionMigrator) Migrate(ctx context.Context, options APIVersionMigrationOptions) (*APIVersionMigrationReport, error) {
+	crdList := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := retryWithExponentialBackoff(ctx, newReadBackoff(), func(ctx context.Context) error {
+		return m.Client.List(ctx, crdList)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to list CRDs")
+	}
+
+	report := &APIVersionMigrationReport{}
+	namespaces := map[string]map[string]*APIVersionMigrationResource{}
+	for i := range crdList.Items {
+		crd := &crdList.Items[i]
+
+		deprecatedVersions := deprecatedStoredVersions(crd)
+		if len(deprecatedVersions) == 0 {
+			continue
+		}
+		storageVersion, err := storageVersionForCRD(crd)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := m.migrateResourcesForCRD(ctx, crd, storageVersion, options.DryRun, namespaces); err != nil {
+			return nil, err
+		}
+		migration := APIVersionMigrationCRD{
+			CRD:                crd.Name,
+			DeprecatedVersions: deprecatedVersions,
+			StorageVersion:     storageVersion,
+		}
+		if !options.DryRun {
+			// All the objects are now stored at the storage version.
+			if err := (&crdMigrator{Client: m.Client}).patchCRDStoredVersions(ctx, crd, storageVersion); err != nil {
+				return nil, err
+			}
+			migration.Migrated = true
+		}
+		report.CRDs = append(report.CRDs, migration)
+	}
+
+	sort.Slice(report.CRDs, func(i, j int) bool {
+		return report.CRDs[i].CRD < report.CRDs[j].CRD
+	})
+	for namespace, resources := range namespaces {
+		n := APIVersionMigrationNamespace{Namespace: namespace}
+		for _, r := range resources {
+			n.Resources = append(n.Resources, *r)
+		}
+		sort.Slice(n.Resources, func(i, j int) bool {
+			return n.Resources[i].Kind < n.Resources[j].Kind
+		})
+		report.Namespaces = append(report.Namespaces, n)
+	}
+	sort.Slice(report.Namespaces, func(i, j int) bool {
+		return report.Namespaces[i].Namespace < report.Namespaces[j].Namespace
+	})
+	return report, nil
+}
+
+// deprecatedStoredVersions returns the versions in status.storedVersions of a CRD which are deprecated API versions of Cluster API.
+func deprecatedStoredVersions(crd *apiextensionsv1.CustomResourceDefinition) []string {
+	var versions []string
+	for _, version := range crd.Status.StoredVersions {
+		gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: version, Kind: crd.Spec.Names.Kind}
+		if deprecatedAPIVersionsScheme.Recognizes(gvk) {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// migrateResourcesForCRD rewrites all the objects of a CRD at the storage version, removing the conversion data annotation,
+// and records them in the per namespace report.
+func (m *apiVersionMigrator) migrateResourcesForCRD(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition, storageVersion string, dryRun bool, namespaces map[string]map[string]*APIVersionMigrationResource) error {
+	log := logf.Log.WithValues("CustomResourceDefinition", klog.KObj(crd))
+	if !dryRun {
+		log.Info("Migrating objects stored at deprecated API versions, this operation may take a while...")
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   crd.Spec.Group,
+		Version: storageVersion,
+		Kind:    crd.Spec.Names.ListKind,
+	})
+
+	for {
+		if err := retryWithExponentialBackoff(ctx, newCRDMigrationBackoff(), func(ctx context.Context) error {
+			return m.Client.List(ctx, list, client.Continue(list.GetContinue()))
+		}); err != nil {
+			return errors.Wrapf(err, "failed to list %q", list.GetKind())
+		}
+
+		for i := range list.Items {
+			obj := list.Items[i]
+
+			if namespaces[obj.GetNamespace()] == nil {
+				namespaces[obj.GetNamespace()] = map[string]*APIVersionMigrationResource{}
+			}
+			resource, ok := namespaces[obj.GetNamespace()][crd.Spec.Names.Kind]
+			if !ok {
+				resource = &APIVersionMigrationResource{Kind: crd.Spec.Names.Kind}
+				namespaces[obj.GetNamespace()][crd.Spec.Names.Kind] = resource
+			}
+			resource.Objects++
+
+			annotations := obj.GetAnnotations()
+			if _, ok := annotations[utilconversion.DataAnnotation]; ok {
+				resource.ConversionDataAnnotations++
+				delete(annotations, utilconversion.DataAnnotation)
+				obj.SetAnnotations(annotations)
+			}
+
+			if dryRun {
+				continue
+			}
+			log.V(5).Info("Migrating", logf.UnstructuredToValues(obj)...)
+			if err := retryWithExponentialBackoff(ctx, newCRDMigrationBackoff(), func(ctx context.Context) error {
+				return handleMigrateErr(m.Client.Update(ctx, &obj))
+			}); err != nil {
+				return errors.Wrapf(err, "failed to migrate %s/%s", obj.GetNamespace(), obj.GetName())
+			}
+		}
+
+		if list.GetContinue() == "" {
+			break
+		}
+	}
+	return nil
+}
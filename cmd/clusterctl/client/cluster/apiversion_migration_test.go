@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+)
+
+func Test_APIVersionMigrator_Migrate(t *testing.T) {
+	newCRD := func(group, kind, storageVersion string, storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: strings.ToLower(kind) + "s." + group},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: group,
+				Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: kind, ListKind: kind + "List"},
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{Name: storageVersion, Storage: true, Served: true},
+				},
+			},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+		}
+	}
+	newCR := func(apiVersion, kind, namespace, name string, annotations map[string]interface{}) *unstructured.Unstructured {
+		metadata := map[string]interface{}{"name": name, "namespace": namespace}
+		if annotations != nil {
+			metadata["annotations"] = annotations
+		}
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata":   metadata,
+		}}
+	}
+
+	objs := []client.Object{
+		newCRD("cluster.x-k8s.io", "Machine", "v1beta2", "v1alpha4", "v1beta1", "v1beta2"),
+		newCR("cluster.x-k8s.io/v1beta2", "Machine", "ns1", "m1", map[string]interface{}{utilconversion.DataAnnotation: "{}", "foo": "bar"}),
+		newCR("cluster.x-k8s.io/v1beta2", "Machine", "ns1", "m2", nil),
+		newCR("cluster.x-k8s.io/v1beta2", "Machine", "ns2", "m3", nil),
+		newCRD("cluster.x-k8s.io", "MachineSet", "v1beta2", "v1alpha3"),
+		newCR("cluster.x-k8s.io/v1beta2", "MachineSet", "ns1", "ms1", nil),
+		// Not a deprecated API version of Cluster API.
+		newCRD("infrastructure.cluster.x-k8s.io", "Foo", "v1beta1", "v1alpha1", "v1beta1"),
+		newCR("infrastructure.cluster.x-k8s.io/v1beta1", "Foo", "ns1", "foo1", nil),
+		// Already migrated.
+		newCRD("bootstrap.cluster.x-k8s.io", "KubeadmConfig", "v1beta2", "v1beta2"),
+	}
+
+	tests := []struct {
+		name               string
+		options            APIVersionMigrationOptions
+		wantMigrated       bool
+		wantMigratedCounts map[string]int
+	}{
+		{
+			name:               "Report objects stored at deprecated API versions",
+			options:            APIVersionMigrationOptions{DryRun: true},
+			wantMigratedCounts: map[string]int{},
+		},
+		{
+			name:               "Migrate objects stored at deprecated API versions",
+			options:            APIVersionMigrationOptions{},
+			wantMigrated:       true,
+			wantMigratedCounts: map[string]int{"cluster.x-k8s.io/v1beta2, Kind=Machine": 3, "cluster.x-k8s.io/v1beta2, Kind=MachineSet": 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			testObjs := make([]client.Object, 0, len(objs))
+			for _, o := range objs {
+				testObjs = append(testObjs, o.DeepCopyObject().(client.Object))
+			}
+			c, err := test.NewFakeProxy().WithObjs(testObjs...).NewClient(context.Background())
+			g.Expect(err).ToNot(HaveOccurred())
+			countingClient := newUpgradeCountingClient(c)
+
+			got, err := NewAPIVersionMigrator(countingClient).Migrate(context.Background(), tt.options)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(BeComparableTo(&APIVersionMigrationReport{
+				CRDs: []APIVersionMigrationCRD{
+					{CRD: "machines.cluster.x-k8s.io", DeprecatedVersions: []string{"v1alpha4"}, StorageVersion: "v1beta2", Migrated: tt.wantMigrated},
+					{CRD: "machinesets.cluster.x-k8s.io", DeprecatedVersions: []string{"v1alpha3"}, StorageVersion: "v1beta2", Migrated: tt.wantMigrated},
+				},
+				Namespaces: []APIVersionMigrationNamespace{
+					{Namespace: "ns1", Resources: []APIVersionMigrationResource{
+						{Kind: "Machine", Objects: 2, ConversionDataAnnotations: 1},
+						{Kind: "MachineSet", Objects: 1},
+					}},
+					{Namespace: "ns2", Resources: []APIVersionMigrationResource{
+						{Kind: "Machine", Objects: 1},
+					}},
+				},
+			}))
+			g.Expect(countingClient.count).To(Equal(tt.wantMigratedCounts))
+
+			crd := &apiextensionsv1.CustomResourceDefinition{}
+			g.Expect(c.Get(context.Background(), client.ObjectKey{Name: "machines.cluster.x-k8s.io"}, crd)).To(Succeed())
+			machine := &unstructured.Unstructured{}
+			machine.SetAPIVersion("cluster.x-k8s.io/v1beta2")
+			machine.SetKind("Machine")
+			g.Expect(c.Get(context.Background(), client.ObjectKey{Namespace: "ns1", Name: "m1"}, machine)).To(Succeed())
+			if tt.wantMigrated {
+				g.Expect(crd.Status.StoredVersions).To(Equal([]string{"v1beta2"}))
+				g.Expect(machine.GetAnnotations()).To(Equal(map[string]string{"foo": "bar"}))
+			} else {
+				g.Expect(crd.Status.StoredVersions).To(Equal([]string{"v1alpha4", "v1beta1", "v1beta2"}))
+				g.Expect(machine.GetAnnotations()).To(HaveKey(utilconversion.DataAnnotation))
+			}
+		})
+	}
+}
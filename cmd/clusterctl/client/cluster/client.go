@@ -81,6 +81,22 @@ type Client interface {
 
 	// WorkloadCluster has methods for fetching kubeconfig of workload cluster from management cluster.
 	WorkloadCluster() WorkloadCluster
+
+	// SkewReporter has methods for reporting the Kubernetes version skew of the Clusters in the management cluster.
+	SkewReporter() SkewReporter
+
+	// ManagedComponentsReporter has methods for reporting the components managed by Cluster API for the Clusters in the management cluster.
+	ManagedComponentsReporter() ManagedComponentsReporter
+
+	// OwnerRefsRepairer has methods for checking and repairing the ownerReferences and finalizers of the objects
+	// of the Clusters in the management cluster.
+	OwnerRefsRepairer() OwnerRefsRepairer
+
+	// ExtensionsDescriber has methods for describing the Runtime Extensions registered in the management cluster.
+	ExtensionsDescriber() ExtensionsDescriber
+
+	// DrainExplainer has methods for explaining how the Nodes of the Machines in the management cluster would be drained.
+	DrainExplainer() DrainExplainer
 }
 
 // PollImmediateWaiter tries a condition func until it returns true, an error, or the timeout is reached.
@@ -144,6 +160,26 @@ func (c *clusterClient) WorkloadCluster() WorkloadCluster {
 	return newWorkloadCluster(c.proxy)
 }
 
+func (c *clusterClient) SkewReporter() SkewReporter {
+	return newSkewReporter(c.proxy)
+}
+
+func (c *clusterClient) ManagedComponentsReporter() ManagedComponentsReporter {
+	return newManagedComponentsReporter(c.proxy)
+}
+
+func (c *clusterClient) OwnerRefsRepairer() OwnerRefsRepairer {
+	return newOwnerRefsRepairer(c.proxy)
+}
+
+func (c *clusterClient) ExtensionsDescriber() ExtensionsDescriber {
+	return newExtensionsDescriber(c.proxy)
+}
+
+func (c *clusterClient) DrainExplainer() DrainExplainer {
+	return newDrainExplainer(c.proxy)
+}
+
 // Option is a configuration option supplied to New.
 type Option func(*clusterClient)
 
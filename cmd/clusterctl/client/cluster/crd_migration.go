@@ -19,6 +19,7 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -27,12 +28,15 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/scheme"
 	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
 )
@@ -40,6 +44,43 @@ import (
 // CRDMigrator interface defines methods for migrating CRs to the storage version of new CRDs.
 type CRDMigrator interface {
 	Run(ctx context.Context, objs []unstructured.Unstructured) error
+
+	// Check verifies that the CRDs in objs can be applied on top of the CRDs currently installed, i.e. that they
+	// contain the storage version of the current CRDs and, if CRs are not going to be migrated, that they contain
+	// all the versions listed in status.storedVersions of the current CRDs.
+	Check(ctx context.Context, objs []unstructured.Unstructured, migrate bool) error
+
+	// MigrateInstalled migrates CRs of the CRDs installed by clusterctl to their storage version,
+	// and returns the CRDs which required migration.
+	MigrateInstalled(ctx context.Context, options CRDMigrationOptions) ([]CRDMigration, error)
+}
+
+// CRDMigrationOptions carries the options supported by CRDMigrator.MigrateInstalled.
+type CRDMigrationOptions struct {
+	// Providers limits the migration to the CRDs of the given providers, e.g. infrastructure-docker.
+	// If empty, the CRDs of all the providers are migrated.
+	Providers []string
+
+	// DryRun defines if CRDs requiring migration should only be reported, without migrating them.
+	DryRun bool
+}
+
+// CRDMigration describes the storage version migration of a CRD.
+type CRDMigration struct {
+	// CRD is the name of the CRD.
+	CRD string `json:"crd"`
+
+	// Provider is the provider the CRD belongs to.
+	Provider string `json:"provider,omitempty"`
+
+	// StorageVersion is the storage version of the CRD.
+	StorageVersion string `json:"storageVersion"`
+
+	// StoredVersions are the versions listed in status.storedVersions of the CRD before the migration.
+	StoredVersions []string `json:"storedVersions"`
+
+	// Migrated is true if the CRs have been migrated to the storage version.
+	Migrated bool `json:"migrated"`
 }
 
 // crdMigrator migrates CRs to the storage version of new CRDs.
@@ -90,20 +131,12 @@ func (m *crdMigrator) run(ctx context.Context, newCRD *apiextensionsv1.CustomRes
 	}
 
 	// Get the current CRD.
-	currentCRD := &apiextensionsv1.CustomResourceDefinition{}
-	crdNotFound := false
-	if err := retryWithExponentialBackoff(ctx, newReadBackoff(), func(ctx context.Context) error {
-		err := m.Client.Get(ctx, client.ObjectKeyFromObject(newCRD), currentCRD)
-		if apierrors.IsNotFound(err) {
-			crdNotFound = true
-			return nil
-		}
-		return err
-	}); err != nil {
+	currentCRD, err := m.getCurrentCRD(ctx, newCRD)
+	if err != nil {
 		return false, err
 	}
 	// Return if the CRD doesn't exist yet. We only have to migrate if the CRD exists already.
-	if crdNotFound {
+	if currentCRD == nil {
 		return false, nil
 	}
 
@@ -147,6 +180,130 @@ func (m *crdMigrator) run(ctx context.Context, newCRD *apiextensionsv1.CustomRes
 	return true, nil
 }
 
+// Check verifies that the CRDs in objs can be applied on top of the CRDs currently installed.
+// All the CRDs are checked before returning, so all the issues are reported at once.
+func (m *crdMigrator) Check(ctx context.Context, objs []unstructured.Unstructured, migrate bool) error {
+	var errs []error
+	for i := range objs {
+		obj := objs[i]
+		if obj.GetKind() != "CustomResourceDefinition" {
+			continue
+		}
+
+		newCRD := &apiextensionsv1.CustomResourceDefinition{}
+		if err := scheme.Scheme.Convert(&obj, newCRD, nil); err != nil {
+			return errors.Wrapf(err, "failed to convert CRD %q", obj.GetName())
+		}
+		if err := m.check(ctx, newCRD, migrate); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// check verifies that a new CRD can be applied on top of the current CRD.
+func (m *crdMigrator) check(ctx context.Context, newCRD *apiextensionsv1.CustomResourceDefinition, migrate bool) error {
+	currentCRD, err := m.getCurrentCRD(ctx, newCRD)
+	if err != nil {
+		return err
+	}
+	if currentCRD == nil {
+		return nil
+	}
+
+	newVersions := sets.Set[string]{}
+	for _, version := range newCRD.Spec.Versions {
+		newVersions.Insert(version.Name)
+	}
+
+	currentStorageVersion, err := storageVersionForCRD(currentCRD)
+	if err != nil {
+		return err
+	}
+	if !newVersions.Has(currentStorageVersion) {
+		return errors.Errorf("unable to upgrade CRD %q because the new CRD does not contain the storage version %q of the current CRD, thus not allowing CR migration", newCRD.Name, currentStorageVersion)
+	}
+
+	// Note: the API server rejects CRDs dropping versions which are still listed in status.storedVersions,
+	// so those versions must be removed by migrating CRs before the new CRD is applied.
+	droppedStoredVersions := sets.New(currentCRD.Status.StoredVersions...).Difference(newVersions)
+	if !migrate && droppedStoredVersions.Len() > 0 {
+		return errors.Errorf("unable to upgrade CRD %q because the new CRD does not contain the versions %q listed in status.storedVersions of the current CRD; "+
+			"enable CRD storage version migration or run \"clusterctl alpha migrate-storage\" before upgrading", newCRD.Name, strings.Join(sets.List(droppedStoredVersions), ","))
+	}
+	return nil
+}
+
+// MigrateInstalled migrates CRs of the CRDs installed by clusterctl to their storage version.
+func (m *crdMigrator) MigrateInstalled(ctx context.Context, options CRDMigrationOptions) ([]CRDMigration, error) {
+	crdList := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := retryWithExponentialBackoff(ctx, newReadBackoff(), func(ctx context.Context) error {
+		return m.Client.List(ctx, crdList, client.HasLabels{clusterctlv1.ClusterctlLabel})
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to list CRDs")
+	}
+
+	providers := sets.New(options.Providers...)
+	migrations := []CRDMigration{}
+	for i := range crdList.Items {
+		crd := &crdList.Items[i]
+		provider := crd.Labels[clusterv1.ProviderNameLabel]
+		if providers.Len() > 0 && !providers.Has(provider) {
+			continue
+		}
+
+		storageVersion, err := storageVersionForCRD(crd)
+		if err != nil {
+			return nil, err
+		}
+		if len(crd.Status.StoredVersions) == 1 && crd.Status.StoredVersions[0] == storageVersion {
+			continue
+		}
+
+		migration := CRDMigration{
+			CRD:            crd.Name,
+			Provider:       provider,
+			StorageVersion: storageVersion,
+			StoredVersions: crd.Status.StoredVersions,
+		}
+		if !options.DryRun {
+			if err := m.migrateResourcesForCRD(ctx, crd, storageVersion); err != nil {
+				return nil, err
+			}
+			if err := m.patchCRDStoredVersions(ctx, crd, storageVersion); err != nil {
+				return nil, err
+			}
+			migration.Migrated = true
+		}
+		migrations = append(migrations, migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].CRD < migrations[j].CRD
+	})
+	return migrations, nil
+}
+
+// getCurrentCRD returns the CRD currently installed with the same name as the new CRD, or nil if it does not exist.
+func (m *crdMigrator) getCurrentCRD(ctx context.Context, newCRD *apiextensionsv1.CustomResourceDefinition) (*apiextensionsv1.CustomResourceDefinition, error) {
+	currentCRD := &apiextensionsv1.CustomResourceDefinition{}
+	crdNotFound := false
+	if err := retryWithExponentialBackoff(ctx, newReadBackoff(), func(ctx context.Context) error {
+		err := m.Client.Get(ctx, client.ObjectKeyFromObject(newCRD), currentCRD)
+		if apierrors.IsNotFound(err) {
+			crdNotFound = true
+			return nil
+		}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if crdNotFound {
+		return nil, nil
+	}
+	return currentCRD, nil
+}
+
 func (m *crdMigrator) migrateResourcesForCRD(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition, currentStorageVersion string) error {
 	log := logf.Log.WithValues("CustomResourceDefinition", klog.KObj(crd))
 	log.Info("Migrating CRs, this operation may take a while...")
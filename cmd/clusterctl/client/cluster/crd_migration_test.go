@@ -25,8 +25,11 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
 )
 
@@ -236,6 +239,185 @@ func Test_CRDMigrator(t *testing.T) {
 	}
 }
 
+func Test_CRDMigrator_Check(t *testing.T) {
+	currentCRD := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1beta1", Storage: true, Served: true},
+				{Name: "v1alpha1", Served: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: []string{"v1beta1", "v1alpha1"}},
+	}
+	newCRD := func(versions ...string) unstructured.Unstructured {
+		crd := &apiextensionsv1.CustomResourceDefinition{
+			TypeMeta:   metav1.TypeMeta{APIVersion: apiextensionsv1.SchemeGroupVersion.String(), Kind: "CustomResourceDefinition"},
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		}
+		for i, v := range versions {
+			crd.Spec.Versions = append(crd.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{Name: v, Served: true, Storage: i == 0})
+		}
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(crd)
+		if err != nil {
+			panic(err)
+		}
+		return unstructured.Unstructured{Object: u}
+	}
+
+	tests := []struct {
+		name    string
+		objs    []unstructured.Unstructured
+		migrate bool
+		wantErr string
+	}{
+		{
+			name: "Pass if the new CRD contains all the stored versions",
+			objs: []unstructured.Unstructured{newCRD("v1", "v1beta1", "v1alpha1")},
+		},
+		{
+			name: "Pass if the CRD does not exist yet",
+			objs: []unstructured.Unstructured{func() unstructured.Unstructured {
+				u := newCRD("v1")
+				u.SetName("bar")
+				return u
+			}()},
+		},
+		{
+			name:    "Fail if the new CRD drops a stored version and CRs are not going to be migrated",
+			objs:    []unstructured.Unstructured{newCRD("v1", "v1beta1")},
+			wantErr: `does not contain the versions "v1alpha1" listed in status.storedVersions`,
+		},
+		{
+			name:    "Pass if the new CRD drops a stored version and CRs are going to be migrated",
+			objs:    []unstructured.Unstructured{newCRD("v1", "v1beta1")},
+			migrate: true,
+		},
+		{
+			name:    "Fail if the new CRD drops the storage version, even if CRs are going to be migrated",
+			objs:    []unstructured.Unstructured{newCRD("v1")},
+			migrate: true,
+			wantErr: `does not contain the storage version "v1beta1"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c, err := test.NewFakeProxy().WithObjs(currentCRD.DeepCopy()).NewClient(context.Background())
+			g.Expect(err).ToNot(HaveOccurred())
+
+			err = NewCRDMigrator(c).Check(context.Background(), tt.objs, tt.migrate)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func Test_CRDMigrator_MigrateInstalled(t *testing.T) {
+	newCRD := func(name, provider string, storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{
+				clusterctlv1.ClusterctlLabel: "",
+				clusterv1.ProviderNameLabel:  provider,
+			}},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: name,
+				Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Foo", ListKind: "FooList"},
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{Name: "v1beta1", Storage: true, Served: true},
+					{Name: "v1alpha1", Served: true},
+				},
+			},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+		}
+	}
+	newCR := func(group, name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": group + "/v1beta1",
+			"kind":       "Foo",
+			"metadata":   map[string]interface{}{"name": name, "namespace": metav1.NamespaceDefault},
+		}}
+	}
+	notManagedCRD := newCRD("not-managed", "", "v1beta1", "v1alpha1")
+	delete(notManagedCRD.Labels, clusterctlv1.ClusterctlLabel)
+
+	objs := []client.Object{
+		newCRD("migrated", "infrastructure-foo", "v1beta1"),
+		newCRD("foo", "infrastructure-foo", "v1beta1", "v1alpha1"),
+		newCR("foo", "cr1"),
+		newCR("foo", "cr2"),
+		newCRD("bar", "bootstrap-bar", "v1alpha1"),
+		newCR("bar", "cr1"),
+		notManagedCRD,
+	}
+
+	tests := []struct {
+		name               string
+		options            CRDMigrationOptions
+		want               []CRDMigration
+		wantMigratedCounts map[string]int
+	}{
+		{
+			name:    "Report CRDs requiring migration",
+			options: CRDMigrationOptions{DryRun: true},
+			want: []CRDMigration{
+				{CRD: "bar", Provider: "bootstrap-bar", StorageVersion: "v1beta1", StoredVersions: []string{"v1alpha1"}},
+				{CRD: "foo", Provider: "infrastructure-foo", StorageVersion: "v1beta1", StoredVersions: []string{"v1beta1", "v1alpha1"}},
+			},
+			wantMigratedCounts: map[string]int{},
+		},
+		{
+			name:    "Migrate CRDs of all the providers",
+			options: CRDMigrationOptions{},
+			want: []CRDMigration{
+				{CRD: "bar", Provider: "bootstrap-bar", StorageVersion: "v1beta1", StoredVersions: []string{"v1alpha1"}, Migrated: true},
+				{CRD: "foo", Provider: "infrastructure-foo", StorageVersion: "v1beta1", StoredVersions: []string{"v1beta1", "v1alpha1"}, Migrated: true},
+			},
+			wantMigratedCounts: map[string]int{"bar/v1beta1, Kind=Foo": 1, "foo/v1beta1, Kind=Foo": 2},
+		},
+		{
+			name:    "Migrate CRDs of a provider",
+			options: CRDMigrationOptions{Providers: []string{"infrastructure-foo"}},
+			want: []CRDMigration{
+				{CRD: "foo", Provider: "infrastructure-foo", StorageVersion: "v1beta1", StoredVersions: []string{"v1beta1", "v1alpha1"}, Migrated: true},
+			},
+			wantMigratedCounts: map[string]int{"foo/v1beta1, Kind=Foo": 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			testObjs := make([]client.Object, 0, len(objs))
+			for _, o := range objs {
+				testObjs = append(testObjs, o.DeepCopyObject().(client.Object))
+			}
+			c, err := test.NewFakeProxy().WithObjs(testObjs...).NewClient(context.Background())
+			g.Expect(err).ToNot(HaveOccurred())
+			countingClient := newUpgradeCountingClient(c)
+
+			got, err := NewCRDMigrator(countingClient).MigrateInstalled(context.Background(), tt.options)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(BeComparableTo(tt.want))
+			g.Expect(countingClient.count).To(Equal(tt.wantMigratedCounts))
+
+			for _, m := range got {
+				crd := &apiextensionsv1.CustomResourceDefinition{}
+				g.Expect(c.Get(context.Background(), client.ObjectKey{Name: m.CRD}, crd)).To(Succeed())
+				if m.Migrated {
+					g.Expect(crd.Status.StoredVersions).To(Equal([]string{"v1beta1"}))
+				} else {
+					g.Expect(crd.Status.StoredVersions).To(Equal(m.StoredVersions))
+				}
+			}
+		})
+	}
+}
+
 type UpgradeCountingClient struct {
 	count map[string]int
 	client.Client
@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/noderefutil"
+	"sigs.k8s.io/cluster-api/internal/controllers/machine/drain"
+)
+
+// DrainExplainOptions carries the options supported by DrainExplainer.Explain.
+type DrainExplainOptions struct {
+	// Namespace where the Machine lives.
+	Namespace string
+
+	// MachineName is the name of the Machine to explain the drain for.
+	MachineName string
+}
+
+// DrainExplanation explains how the Node of a Machine would be drained.
+type DrainExplanation struct {
+	// Namespace of the Machine.
+	Namespace string `json:"namespace"`
+
+	// Machine is the name of the Machine.
+	Machine string `json:"machine"`
+
+	// Cluster is the name of the Cluster the Machine belongs to.
+	Cluster string `json:"cluster"`
+
+	drain.Explanation `json:",inline"`
+}
+
+// DrainExplainer has methods for explaining how the Nodes of the Machines in a management cluster would be drained.
+type DrainExplainer interface {
+	// Explain evaluates the MachineDrainRules matching a Machine against the Pods running on its Node
+	// and returns which Pods would be evicted, skipped or would block the drain, and in which order.
+	Explain(ctx context.Context, options DrainExplainOptions) (*DrainExplanation, error)
+}
+
+// drainExplainer implements DrainExplainer.
+type drainExplainer struct {
+	proxy Proxy
+
+	// workloadClusterClient returns a client for the workload cluster; it can be overridden for testing.
+	workloadClusterClient func(ctx context.Context, c client.Client, cluster *clusterv1.Cluster) (client.Client, error)
+}
+
+// ensure drainExplainer implements DrainExplainer.
+var _ DrainExplainer = &drainExplainer{}
+
+// newDrainExplainer returns a drainExplainer.
+func newDrainExplainer(proxy Proxy) *drainExplainer {
+	return &drainExplainer{
+		proxy:                 proxy,
+		workloadClusterClient: workloadClusterClient,
+	}
+}
+
+func (e *drainExplainer) Explain(ctx context.Context, options DrainExplainOptions) (*DrainExplanation, error) {
+	c, err := e.proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	machine := &clusterv1.Machine{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: options.Namespace, Name: options.MachineName}, machine); err != nil {
+		return nil, errors.Wrapf(err, "failed to get Machine %s/%s", options.Namespace, options.MachineName)
+	}
+	if !machine.Status.NodeRef.IsDefined() {
+		return nil, errors.Errorf("Machine %s/%s doesn't have a Node yet", machine.Namespace, machine.Name)
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: machine.Namespace, Name: machine.Spec.ClusterName}, cluster); err != nil {
+		return nil, errors.Wrapf(err, "failed to get Cluster %s/%s", machine.Namespace, machine.Spec.ClusterName)
+	}
+
+	remoteClient, err := e.workloadClusterClient(ctx, c, cluster)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get a client for the workload cluster of Cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+
+	nodeName := machine.Status.NodeRef.Name
+	node := &corev1.Node{}
+	if err := remoteClient.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return nil, errors.Wrapf(err, "failed to get Node %s", nodeName)
+	}
+
+	// Use the same settings the Machine controller uses when draining the Node.
+	drainer := &drain.Helper{
+		Client:       c,
+		RemoteClient: remoteClient,
+	}
+	if noderefutil.IsNodeUnreachable(node) {
+		drainer.SkipWaitForDeleteTimeoutSeconds = 1
+	}
+
+	explanation, err := drainer.ExplainDrain(ctx, cluster, machine, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DrainExplanation{
+		Namespace:   machine.Namespace,
+		Machine:     machine.Name,
+		Cluster:     cluster.Name,
+		Explanation: *explanation,
+	}, nil
+}
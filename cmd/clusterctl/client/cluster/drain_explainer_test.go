@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	"sigs.k8s.io/cluster-api/internal/controllers/machine/drain"
+)
+
+func Test_DrainExplainer_Explain(t *testing.T) {
+	objs := []client.Object{
+		&clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "cluster1"},
+		},
+		&clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "machine1"},
+			Spec:       clusterv1.MachineSpec{ClusterName: "cluster1"},
+			Status:     clusterv1.MachineStatus{NodeRef: clusterv1.MachineNodeReference{Name: "node1"}},
+		},
+		&clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "machine2"},
+			Spec:       clusterv1.MachineSpec{ClusterName: "cluster1"},
+		},
+		&clusterv1.MachineDrainRule{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "mdr1"},
+			Spec: clusterv1.MachineDrainRuleSpec{
+				Drain: clusterv1.MachineDrainRuleDrainConfig{
+					Behavior: clusterv1.MachineDrainRuleDrainBehaviorSkip,
+				},
+				Pods: []clusterv1.MachineDrainRulePodSelector{
+					{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "monitoring"}}},
+				},
+			},
+		},
+	}
+
+	newPod := func(name string, labels map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      name,
+				Labels:    labels,
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs", Controller: ptr.To(true)},
+				},
+			},
+			Spec: corev1.PodSpec{NodeName: "node1"},
+		}
+	}
+	workloadClient := fake.NewClientBuilder().WithObjects(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault}},
+		newPod("app", nil),
+		newPod("monitoring", map[string]string{"app": "monitoring"}),
+	).WithIndex(&corev1.Pod{}, "spec.nodeName", func(o client.Object) []string {
+		return []string{o.(*corev1.Pod).Spec.NodeName}
+	}).Build()
+
+	tests := []struct {
+		name    string
+		options DrainExplainOptions
+		want    *DrainExplanation
+		wantErr bool
+	}{
+		{
+			name:    "explains the drain of a Machine",
+			options: DrainExplainOptions{Namespace: "ns1", MachineName: "machine1"},
+			want: &DrainExplanation{
+				Namespace: "ns1",
+				Machine:   "machine1",
+				Cluster:   "cluster1",
+				Explanation: drain.Explanation{
+					Node:              "node1",
+					MachineDrainRules: []string{"mdr1"},
+					Pods: []drain.PodExplanation{
+						{Namespace: metav1.NamespaceDefault, Name: "app", Action: drain.PodActionEvict, Order: ptr.To[int32](0)},
+						{Namespace: metav1.NamespaceDefault, Name: "monitoring", Action: drain.PodActionSkip, MachineDrainRule: "mdr1"},
+					},
+				},
+			},
+		},
+		{
+			name:    "fails for a Machine without a Node",
+			options: DrainExplainOptions{Namespace: "ns1", MachineName: "machine2"},
+			wantErr: true,
+		},
+		{
+			name:    "fails for a Machine which does not exist",
+			options: DrainExplainOptions{Namespace: "ns1", MachineName: "does-not-exist"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			e := newDrainExplainer(test.NewFakeProxy().WithObjs(objs...))
+			e.workloadClusterClient = func(_ context.Context, _ client.Client, _ *clusterv1.Cluster) (client.Client, error) {
+				return workloadClient, nil
+			}
+
+			got, err := e.Explain(context.Background(), tt.options)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(BeComparableTo(tt.want))
+		})
+	}
+}
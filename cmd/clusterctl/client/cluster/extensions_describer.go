@@ -0,0 +1,212 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ExtensionsDescribeOptions carries the options supported by ExtensionsDescriber.Describe.
+type ExtensionsDescribeOptions struct {
+	// Name is the name of the ExtensionConfig to describe. If empty, all the ExtensionConfigs are described.
+	Name string
+}
+
+// ExtensionsDescription describes the Runtime Extensions registered in a management cluster.
+type ExtensionsDescription struct {
+	Extensions []ExtensionDescription `json:"extensions"`
+}
+
+// ExtensionDescription describes a Runtime Extension registered via an ExtensionConfig.
+type ExtensionDescription struct {
+	// Name of the ExtensionConfig.
+	Name string `json:"name"`
+
+	// Endpoint is the URL or the Service used to reach the Extension server.
+	Endpoint string `json:"endpoint"`
+
+	// NamespaceSelector is the selector for the namespaces of the objects for which the Extension is called.
+	NamespaceSelector string `json:"namespaceSelector,omitempty"`
+
+	// Paused is true if the reconciliation of the ExtensionConfig is paused.
+	Paused bool `json:"paused,omitempty"`
+
+	// Discovered is the status of the Discovered condition of the ExtensionConfig.
+	Discovered metav1.ConditionStatus `json:"discovered"`
+
+	// DiscoveredReason is the reason of the Discovered condition of the ExtensionConfig.
+	DiscoveredReason string `json:"discoveredReason,omitempty"`
+
+	// DiscoveredMessage is the message of the Discovered condition of the ExtensionConfig;
+	// it surfaces the error of the last failed discovery, if any.
+	DiscoveredMessage string `json:"discoveredMessage,omitempty"`
+
+	// LastDiscoveryTransitionTime is the last time the Discovered condition of the ExtensionConfig changed.
+	LastDiscoveryTransitionTime *metav1.Time `json:"lastDiscoveryTransitionTime,omitempty"`
+
+	// Handlers are the handlers discovered for the Extension.
+	Handlers []ExtensionHandlerDescription `json:"handlers,omitempty"`
+}
+
+// ExtensionHandlerDescription describes a handler discovered for a Runtime Extension.
+type ExtensionHandlerDescription struct {
+	// Name of the handler.
+	Name string `json:"name"`
+
+	// Hook is the name of the runtime hook served by the handler.
+	Hook string `json:"hook"`
+
+	// APIVersion is the group and version of the runtime hook served by the handler.
+	APIVersion string `json:"apiVersion"`
+
+	// FailurePolicy defines how failures in calls to the handler are handled.
+	FailurePolicy runtimev1.FailurePolicy `json:"failurePolicy"`
+
+	// TimeoutSeconds is the timeout for calls to the handler.
+	TimeoutSeconds int32 `json:"timeoutSeconds"`
+}
+
+// ExtensionsDescriber has methods for describing the Runtime Extensions registered in a management cluster.
+type ExtensionsDescriber interface {
+	// Describe returns the description of the ExtensionConfigs matching the given options.
+	Describe(ctx context.Context, options ExtensionsDescribeOptions) (*ExtensionsDescription, error)
+}
+
+// extensionsDescriber implements ExtensionsDescriber.
+type extensionsDescriber struct {
+	proxy Proxy
+}
+
+// ensure extensionsDescriber implements ExtensionsDescriber.
+var _ ExtensionsDescriber = &extensionsDescriber{}
+
+// newExtensionsDescriber returns an extensionsDescriber.
+func newExtensionsDescriber(proxy Proxy) *extensionsDescriber {
+	return &extensionsDescriber{
+		proxy: proxy,
+	}
+}
+
+func (d *extensionsDescriber) Describe(ctx context.Context, options ExtensionsDescribeOptions) (*ExtensionsDescription, error) {
+	c, err := d.proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	extensionConfigs := []runtimev1.ExtensionConfig{}
+	if options.Name != "" {
+		extensionConfig := &runtimev1.ExtensionConfig{}
+		if err := c.Get(ctx, client.ObjectKey{Name: options.Name}, extensionConfig); err != nil {
+			return nil, errors.Wrapf(err, "failed to get ExtensionConfig %s", options.Name)
+		}
+		extensionConfigs = append(extensionConfigs, *extensionConfig)
+	} else {
+		extensionConfigList := &runtimev1.ExtensionConfigList{}
+		if err := c.List(ctx, extensionConfigList); err != nil {
+			// Note: the ExtensionConfig CRD is not installed if the management cluster doesn't use the RuntimeSDK.
+			if meta.IsNoMatchError(err) {
+				return &ExtensionsDescription{Extensions: []ExtensionDescription{}}, nil
+			}
+			return nil, errors.Wrap(err, "failed to list ExtensionConfigs")
+		}
+		extensionConfigs = extensionConfigList.Items
+	}
+
+	sort.Slice(extensionConfigs, func(i, j int) bool {
+		return extensionConfigs[i].Name < extensionConfigs[j].Name
+	})
+
+	description := &ExtensionsDescription{Extensions: []ExtensionDescription{}}
+	for i := range extensionConfigs {
+		description.Extensions = append(description.Extensions, describeExtension(&extensionConfigs[i]))
+	}
+	return description, nil
+}
+
+// describeExtension computes the description of an ExtensionConfig.
+func describeExtension(extensionConfig *runtimev1.ExtensionConfig) ExtensionDescription {
+	description := ExtensionDescription{
+		Name:       extensionConfig.Name,
+		Endpoint:   extensionEndpoint(extensionConfig.Spec.ClientConfig),
+		Paused:     conditions.IsTrue(extensionConfig, clusterv1.PausedCondition),
+		Discovered: metav1.ConditionUnknown,
+	}
+
+	if extensionConfig.Spec.NamespaceSelector != nil {
+		if selector, err := metav1.LabelSelectorAsSelector(extensionConfig.Spec.NamespaceSelector); err == nil && !selector.Empty() {
+			description.NamespaceSelector = selector.String()
+		}
+	}
+
+	if discovered := conditions.Get(extensionConfig, runtimev1.ExtensionConfigDiscoveredCondition); discovered != nil {
+		description.Discovered = discovered.Status
+		description.DiscoveredReason = discovered.Reason
+		description.DiscoveredMessage = discovered.Message
+		description.LastDiscoveryTransitionTime = ptr.To(discovered.LastTransitionTime)
+	}
+
+	for _, handler := range extensionConfig.Status.Handlers {
+		handlerDescription := ExtensionHandlerDescription{
+			Name:           handler.Name,
+			Hook:           handler.RequestHook.Hook,
+			APIVersion:     handler.RequestHook.APIVersion,
+			FailurePolicy:  handler.FailurePolicy,
+			TimeoutSeconds: handler.TimeoutSeconds,
+		}
+		// Surface the defaults applied by the runtime client when calling the handler.
+		if handlerDescription.FailurePolicy == "" {
+			handlerDescription.FailurePolicy = runtimev1.FailurePolicyFail
+		}
+		if handlerDescription.TimeoutSeconds == 0 {
+			handlerDescription.TimeoutSeconds = runtimehooksv1.DefaultHandlersTimeoutSeconds
+		}
+		description.Handlers = append(description.Handlers, handlerDescription)
+	}
+	sort.Slice(description.Handlers, func(i, j int) bool {
+		return description.Handlers[i].Name < description.Handlers[j].Name
+	})
+
+	return description
+}
+
+// extensionEndpoint returns a human readable representation of the endpoint of an Extension server.
+func extensionEndpoint(clientConfig runtimev1.ClientConfig) string {
+	if clientConfig.URL != "" {
+		return clientConfig.URL
+	}
+	if !clientConfig.Service.IsDefined() {
+		return ""
+	}
+	port := int32(443)
+	if clientConfig.Service.Port != nil {
+		port = *clientConfig.Service.Port
+	}
+	return fmt.Sprintf("service/%s/%s:%d%s", clientConfig.Service.Namespace, clientConfig.Service.Name, port, clientConfig.Service.Path)
+}
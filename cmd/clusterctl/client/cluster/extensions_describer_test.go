@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+)
+
+func Test_ExtensionsDescriber_Describe(t *testing.T) {
+	lastTransitionTime := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	extension1 := &runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "extension1"},
+		Spec: runtimev1.ExtensionConfigSpec{
+			ClientConfig: runtimev1.ClientConfig{
+				Service: runtimev1.ServiceReference{Namespace: "test-extension", Name: "webhook-service", Path: "/hooks"},
+			},
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+		},
+		Status: runtimev1.ExtensionConfigStatus{
+			Conditions: []metav1.Condition{
+				{Type: runtimev1.ExtensionConfigDiscoveredCondition, Status: metav1.ConditionTrue, Reason: runtimev1.ExtensionConfigDiscoveredReason, LastTransitionTime: lastTransitionTime},
+			},
+			Handlers: []runtimev1.ExtensionHandler{
+				{
+					Name:        "before-cluster-upgrade.extension1",
+					RequestHook: runtimev1.GroupVersionHook{APIVersion: "hooks.runtime.cluster.x-k8s.io/v1alpha1", Hook: "BeforeClusterUpgrade"},
+				},
+				{
+					Name:           "after-cluster-upgrade.extension1",
+					RequestHook:    runtimev1.GroupVersionHook{APIVersion: "hooks.runtime.cluster.x-k8s.io/v1alpha1", Hook: "AfterClusterUpgrade"},
+					FailurePolicy:  runtimev1.FailurePolicyIgnore,
+					TimeoutSeconds: 5,
+				},
+			},
+		},
+	}
+	extension2 := &runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "extension2"},
+		Spec: runtimev1.ExtensionConfigSpec{
+			ClientConfig: runtimev1.ClientConfig{
+				URL: "https://extension2.example.com",
+			},
+		},
+		Status: runtimev1.ExtensionConfigStatus{
+			Conditions: []metav1.Condition{
+				{Type: clusterv1.PausedCondition, Status: metav1.ConditionTrue, Reason: clusterv1.PausedReason},
+				{Type: runtimev1.ExtensionConfigDiscoveredCondition, Status: metav1.ConditionFalse, Reason: runtimev1.ExtensionConfigNotDiscoveredReason, Message: "connection refused", LastTransitionTime: lastTransitionTime},
+			},
+		},
+	}
+	extension3 := &runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "extension3"},
+	}
+
+	extension1Description := ExtensionDescription{
+		Name:                        "extension1",
+		Endpoint:                    "service/test-extension/webhook-service:443/hooks",
+		NamespaceSelector:           "foo=bar",
+		Discovered:                  metav1.ConditionTrue,
+		DiscoveredReason:            runtimev1.ExtensionConfigDiscoveredReason,
+		LastDiscoveryTransitionTime: ptr.To(lastTransitionTime),
+		Handlers: []ExtensionHandlerDescription{
+			{
+				Name:           "after-cluster-upgrade.extension1",
+				Hook:           "AfterClusterUpgrade",
+				APIVersion:     "hooks.runtime.cluster.x-k8s.io/v1alpha1",
+				FailurePolicy:  runtimev1.FailurePolicyIgnore,
+				TimeoutSeconds: 5,
+			},
+			{
+				Name:           "before-cluster-upgrade.extension1",
+				Hook:           "BeforeClusterUpgrade",
+				APIVersion:     "hooks.runtime.cluster.x-k8s.io/v1alpha1",
+				FailurePolicy:  runtimev1.FailurePolicyFail,
+				TimeoutSeconds: 10,
+			},
+		},
+	}
+	extension2Description := ExtensionDescription{
+		Name:                        "extension2",
+		Endpoint:                    "https://extension2.example.com",
+		Paused:                      true,
+		Discovered:                  metav1.ConditionFalse,
+		DiscoveredReason:            runtimev1.ExtensionConfigNotDiscoveredReason,
+		DiscoveredMessage:           "connection refused",
+		LastDiscoveryTransitionTime: ptr.To(lastTransitionTime),
+	}
+	extension3Description := ExtensionDescription{
+		Name:       "extension3",
+		Discovered: metav1.ConditionUnknown,
+	}
+
+	tests := []struct {
+		name      string
+		options   ExtensionsDescribeOptions
+		want      *ExtensionsDescription
+		expectErr bool
+	}{
+		{
+			name:    "describe all the ExtensionConfigs",
+			options: ExtensionsDescribeOptions{},
+			want:    &ExtensionsDescription{Extensions: []ExtensionDescription{extension1Description, extension2Description, extension3Description}},
+		},
+		{
+			name:    "describe an ExtensionConfig",
+			options: ExtensionsDescribeOptions{Name: "extension2"},
+			want:    &ExtensionsDescription{Extensions: []ExtensionDescription{extension2Description}},
+		},
+		{
+			name:      "fails if the ExtensionConfig does not exist",
+			options:   ExtensionsDescribeOptions{Name: "does-not-exist"},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			d := newExtensionsDescriber(test.NewFakeProxy().WithObjs(extension3, extension1, extension2))
+			got, err := d.Describe(context.Background(), tt.options)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(BeComparableTo(tt.want))
+		})
+	}
+}
@@ -226,27 +226,41 @@ func (p *inventoryClient) EnsureCustomResourceDefinitions(ctx context.Context) e
 	return nil
 }
 
+// inventoryCRDs is the list of the resources of the CRDs in the clusterctl inventory.
+var inventoryCRDs = []string{"providers", "managementclusters"}
+
 // checkInventoryCRDs checks if the inventory CRDs are installed in the cluster.
+// NOTE: If any of the inventory CRDs is missing, e.g. when the inventory was created by an older version of clusterctl,
+// false is returned so the missing CRDs are installed.
 func checkInventoryCRDs(ctx context.Context, proxy Proxy) (bool, error) {
 	c, err := proxy.NewClient(ctx)
 	if err != nil {
 		return false, err
 	}
 
-	crd := &apiextensionsv1.CustomResourceDefinition{}
-	if err := c.Get(ctx, client.ObjectKey{Name: fmt.Sprintf("providers.%s", clusterctlv1.GroupVersion.Group)}, crd); err != nil {
-		if apierrors.IsNotFound(err) {
-			return false, nil
+	for _, resource := range inventoryCRDs {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := c.Get(ctx, client.ObjectKey{Name: fmt.Sprintf("%s.%s", resource, clusterctlv1.GroupVersion.Group)}, crd); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, errors.Wrap(err, "failed to check if the clusterctl inventory CRD exists")
+		}
+
+		if !crdDefinesVersion(crd, clusterctlv1.GroupVersion.Version) {
+			return true, errors.Errorf("clusterctl inventory CRD %s does not defines the %s version", crd.Name, clusterctlv1.GroupVersion.Version)
 		}
-		return false, errors.Wrap(err, "failed to check if the clusterctl inventory CRD exists")
 	}
+	return true, nil
+}
 
-	for _, version := range crd.Spec.Versions {
-		if version.Name == clusterctlv1.GroupVersion.Version {
-			return true, nil
+func crdDefinesVersion(crd *apiextensionsv1.CustomResourceDefinition, version string) bool {
+	for _, v := range crd.Spec.Versions {
+		if v.Name == version {
+			return true
 		}
 	}
-	return true, errors.Errorf("clusterctl inventory CRD does not defines the %s version", clusterctlv1.GroupVersion.Version)
+	return false
 }
 
 func (p *inventoryClient) createObj(ctx context.Context, o unstructured.Unstructured) error {
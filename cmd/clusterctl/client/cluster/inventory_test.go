@@ -37,7 +37,8 @@ func fakePollImmediateWaiter(_ context.Context, _, _ time.Duration, _ wait.Condi
 
 func Test_inventoryClient_CheckInventoryCRDs(t *testing.T) {
 	type fields struct {
-		alreadyHasCRD bool
+		alreadyHasCRD       bool
+		hasProvidersCRDOnly bool
 	}
 	tests := []struct {
 		name    string
@@ -61,6 +62,14 @@ func Test_inventoryClient_CheckInventoryCRDs(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "Has only the providers CRD",
+			fields: fields{
+				hasProvidersCRDOnly: true,
+			},
+			want:    false,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -69,6 +78,14 @@ func Test_inventoryClient_CheckInventoryCRDs(t *testing.T) {
 			ctx := context.Background()
 
 			proxy := test.NewFakeProxy()
+			if tt.fields.hasProvidersCRDOnly {
+				proxy = proxy.WithObjs(&apiextensionsv1.CustomResourceDefinition{
+					ObjectMeta: metav1.ObjectMeta{Name: "providers." + clusterctlv1.GroupVersion.Group},
+					Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+						Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: clusterctlv1.GroupVersion.Version}},
+					},
+				})
+			}
 			p := newInventoryClient(proxy, fakePollImmediateWaiter, currentContractVersion)
 			if tt.fields.alreadyHasCRD {
 				// forcing creation of metadata before test
@@ -0,0 +1,559 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/controllers/external"
+	"sigs.k8s.io/cluster-api/internal/contract"
+	utilcontract "sigs.k8s.io/cluster-api/util/contract"
+	utilkubeconfig "sigs.k8s.io/cluster-api/util/kubeconfig"
+)
+
+const (
+	// ManagedComponentsConfigMapSuffix is the suffix of the name of the ConfigMap storing the managed components of a Cluster.
+	ManagedComponentsConfigMapSuffix = "-managed-components"
+
+	// ManagedComponentsConfigMapKey is the key of the ConfigMap data storing the managed components of a Cluster.
+	ManagedComponentsConfigMapKey = "managed-components.yaml"
+)
+
+// ManagedComponentsReportOptions carries the options supported by ManagedComponentsReporter.Report.
+type ManagedComponentsReportOptions struct {
+	// Namespace where the Clusters live. If empty, Clusters in all the namespaces are reported.
+	Namespace string
+
+	// ClusterName is the name of the Cluster to report. If empty, all the Clusters are reported.
+	ClusterName string
+
+	// InspectWorkloadClusters enables reading the images of the etcd and CoreDNS components
+	// actually running in the workload clusters.
+	InspectWorkloadClusters bool
+}
+
+// ManagedComponentsReport is the inventory of the components managed by Cluster API for a set of Clusters.
+type ManagedComponentsReport struct {
+	Clusters []ClusterManagedComponents `json:"clusters"`
+}
+
+// ClusterManagedComponents is the inventory of the components managed by Cluster API for a Cluster.
+type ClusterManagedComponents struct {
+	// Namespace of the Cluster.
+	Namespace string `json:"namespace"`
+
+	// Name of the Cluster.
+	Name string `json:"name"`
+
+	// KubernetesVersion is the version defined in the control plane spec.
+	// If the control plane does not define a version, the version defined in the Cluster topology is used.
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// Infrastructure is the infrastructure cluster of the Cluster.
+	Infrastructure *ManagedComponent `json:"infrastructure,omitempty"`
+
+	// ControlPlane is the control plane of the Cluster.
+	ControlPlane *ManagedComponent `json:"controlPlane,omitempty"`
+
+	// Kubeadm lists the kubeadm components of the Cluster, if the control plane is a KubeadmControlPlane.
+	Kubeadm *KubeadmComponents `json:"kubeadm,omitempty"`
+
+	// Workers are the MachineDeployments and MachinePools of the Cluster.
+	Workers []ManagedComponent `json:"workers,omitempty"`
+
+	// Nodes lists the components reported in the NodeInfo of the Machines of the Cluster.
+	Nodes []NodeComponents `json:"nodes,omitempty"`
+
+	// Providers are the providers managing the Cluster, as recorded in the clusterctl inventory.
+	Providers []ProviderComponent `json:"providers,omitempty"`
+}
+
+// ManagedComponent is a component of a Cluster managed by Cluster API.
+type ManagedComponent struct {
+	// Kind of the object.
+	Kind string `json:"kind"`
+
+	// Name of the object.
+	Name string `json:"name"`
+
+	// Version is the Kubernetes version of the object, if any.
+	Version string `json:"version,omitempty"`
+
+	// Provider is the provider of the object, e.g. infrastructure-docker.
+	Provider string `json:"provider,omitempty"`
+
+	// Bootstrap is the kind of the bootstrap config (template) of the object, if any.
+	Bootstrap string `json:"bootstrap,omitempty"`
+
+	// BootstrapFormat is the format of the bootstrap data, e.g. cloud-config or ignition, if known.
+	BootstrapFormat string `json:"bootstrapFormat,omitempty"`
+
+	// Infrastructure is the kind of the infrastructure machine (template) of the object, if any.
+	Infrastructure string `json:"infrastructure,omitempty"`
+}
+
+// KubeadmComponents lists the components of a Cluster managed by kubeadm.
+type KubeadmComponents struct {
+	// ImageRepository is the image repository used to pull the control plane images.
+	// Empty if the kubeadm default is used.
+	ImageRepository string `json:"imageRepository,omitempty"`
+
+	// EtcdVersion is the image tag of etcd, if set in the KubeadmControlPlane.
+	EtcdVersion string `json:"etcdVersion,omitempty"`
+
+	// CoreDNSVersion is the image tag of CoreDNS, if set in the KubeadmControlPlane.
+	CoreDNSVersion string `json:"coreDNSVersion,omitempty"`
+
+	// EtcdImages are the etcd images running in the workload cluster.
+	EtcdImages []string `json:"etcdImages,omitempty"`
+
+	// CoreDNSImages are the CoreDNS images running in the workload cluster.
+	CoreDNSImages []string `json:"coreDNSImages,omitempty"`
+}
+
+// NodeComponents lists the components reported in the NodeInfo of a Machine.
+type NodeComponents struct {
+	// Machine is the name of the Machine.
+	Machine string `json:"machine"`
+
+	// Node is the name of the Node.
+	Node string `json:"node,omitempty"`
+
+	KubeletVersion          string `json:"kubeletVersion,omitempty"`
+	ContainerRuntimeVersion string `json:"containerRuntimeVersion,omitempty"`
+	OSImage                 string `json:"osImage,omitempty"`
+	KernelVersion           string `json:"kernelVersion,omitempty"`
+	Architecture            string `json:"architecture,omitempty"`
+}
+
+// ProviderComponent is a provider managing a Cluster.
+type ProviderComponent struct {
+	// Name of the provider, e.g. infrastructure-docker.
+	Name string `json:"name"`
+
+	// Namespace where the provider is installed.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Version of the provider.
+	Version string `json:"version,omitempty"`
+}
+
+// ManagedComponentsReporter has methods for reporting the components managed by Cluster API for the Clusters in a management cluster.
+type ManagedComponentsReporter interface {
+	// Report returns the managed components of the Clusters matching the given options.
+	Report(ctx context.Context, options ManagedComponentsReportOptions) (*ManagedComponentsReport, error)
+
+	// Store stores the managed components of a Cluster in a ConfigMap in the namespace of the Cluster,
+	// named after the Cluster with the ManagedComponentsConfigMapSuffix and owned by the Cluster.
+	Store(ctx context.Context, components ClusterManagedComponents) error
+}
+
+// managedComponentsReporter implements ManagedComponentsReporter.
+type managedComponentsReporter struct {
+	proxy Proxy
+
+	// workloadClusterClient returns a client for the workload cluster; it can be overridden for testing.
+	workloadClusterClient func(ctx context.Context, c client.Client, cluster *clusterv1.Cluster) (client.Client, error)
+}
+
+// ensure managedComponentsReporter implements ManagedComponentsReporter.
+var _ ManagedComponentsReporter = &managedComponentsReporter{}
+
+// newManagedComponentsReporter returns a managedComponentsReporter.
+func newManagedComponentsReporter(proxy Proxy) *managedComponentsReporter {
+	return &managedComponentsReporter{
+		proxy:                 proxy,
+		workloadClusterClient: workloadClusterClient,
+	}
+}
+
+func (r *managedComponentsReporter) Report(ctx context.Context, options ManagedComponentsReportOptions) (*ManagedComponentsReport, error) {
+	c, err := r.proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := []clusterv1.Cluster{}
+	if options.ClusterName != "" {
+		cluster := &clusterv1.Cluster{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: options.Namespace, Name: options.ClusterName}, cluster); err != nil {
+			return nil, errors.Wrapf(err, "failed to get Cluster %s/%s", options.Namespace, options.ClusterName)
+		}
+		clusters = append(clusters, *cluster)
+	} else {
+		clusterList := &clusterv1.ClusterList{}
+		if err := c.List(ctx, clusterList, client.InNamespace(options.Namespace)); err != nil {
+			return nil, errors.Wrap(err, "failed to list Clusters")
+		}
+		clusters = clusterList.Items
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Namespace != clusters[j].Namespace {
+			return clusters[i].Namespace < clusters[j].Namespace
+		}
+		return clusters[i].Name < clusters[j].Name
+	})
+
+	providerList := &clusterctlv1.ProviderList{}
+	if err := c.List(ctx, providerList); err != nil {
+		return nil, errors.Wrap(err, "failed to list providers from the clusterctl inventory")
+	}
+
+	report := &ManagedComponentsReport{Clusters: []ClusterManagedComponents{}}
+	for i := range clusters {
+		clusterComponents, err := r.reportCluster(ctx, c, &clusters[i], providerList.Items, options)
+		if err != nil {
+			return nil, err
+		}
+		report.Clusters = append(report.Clusters, *clusterComponents)
+	}
+	return report, nil
+}
+
+// reportCluster computes the managed components of a Cluster.
+func (r *managedComponentsReporter) reportCluster(ctx context.Context, c client.Client, cluster *clusterv1.Cluster, providers []clusterctlv1.Provider, options ManagedComponentsReportOptions) (*ClusterManagedComponents, error) {
+	components := &ClusterManagedComponents{
+		Namespace: cluster.Namespace,
+		Name:      cluster.Name,
+	}
+	providerNames := map[string]bool{
+		clusterctlv1.ManifestLabel(config.ClusterAPIProviderName, clusterctlv1.CoreProviderType): true,
+	}
+	crds := map[schema.GroupKind]*apiextensionsv1.CustomResourceDefinition{}
+
+	// newComponent returns a ManagedComponent for an object, and records its provider.
+	newComponent := func(obj *unstructured.Unstructured) (*ManagedComponent, error) {
+		gk := obj.GroupVersionKind().GroupKind()
+		crd, ok := crds[gk]
+		if !ok {
+			var err error
+			if crd, err = getCRDForGroupKind(ctx, c, gk); err != nil {
+				return nil, err
+			}
+			crds[gk] = crd
+		}
+		component := &ManagedComponent{Kind: obj.GetKind(), Name: obj.GetName()}
+		if crd != nil {
+			component.Provider = crd.Labels[clusterv1.ProviderNameLabel]
+		}
+		if component.Provider != "" {
+			providerNames[component.Provider] = true
+		}
+		return component, nil
+	}
+
+	if cluster.Spec.InfrastructureRef.IsDefined() {
+		infraCluster, err := external.GetObjectFromContractVersionedRef(ctx, c, cluster.Spec.InfrastructureRef, cluster.Namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get infrastructure cluster for Cluster %s/%s", cluster.Namespace, cluster.Name)
+		}
+		if components.Infrastructure, err = newComponent(infraCluster); err != nil {
+			return nil, err
+		}
+	}
+
+	if cluster.Spec.ControlPlaneRef.IsDefined() {
+		controlPlane, err := external.GetObjectFromContractVersionedRef(ctx, c, cluster.Spec.ControlPlaneRef, cluster.Namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get control plane for Cluster %s/%s", cluster.Namespace, cluster.Name)
+		}
+		if components.ControlPlane, err = newComponent(controlPlane); err != nil {
+			return nil, err
+		}
+		// Note: not all the control planes define a version, e.g. managed control planes.
+		if controlPlaneVersion, err := contract.ControlPlane().Version().Get(controlPlane); err == nil {
+			components.ControlPlane.Version = *controlPlaneVersion
+			components.KubernetesVersion = *controlPlaneVersion
+		}
+		if controlPlane.GetKind() == "KubeadmControlPlane" {
+			components.ControlPlane.BootstrapFormat = bootstrapFormat(controlPlane, "spec", "kubeadmConfigSpec", "format")
+			components.Kubeadm = kubeadmComponents(controlPlane)
+		}
+	}
+	if components.KubernetesVersion == "" {
+		components.KubernetesVersion = cluster.Spec.Topology.Version
+	}
+
+	listOptions := []client.ListOption{
+		client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name},
+	}
+
+	// workerComponent returns a ManagedComponent for a MachineDeployment or a MachinePool.
+	workerComponent := func(kind, name string, spec clusterv1.MachineSpec) (*ManagedComponent, error) {
+		component := &ManagedComponent{Kind: kind, Name: name, Version: spec.Version}
+		if spec.Bootstrap.ConfigRef.IsDefined() {
+			bootstrap, err := external.GetObjectFromContractVersionedRef(ctx, c, spec.Bootstrap.ConfigRef, cluster.Namespace)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get bootstrap config for %s %s/%s", kind, cluster.Namespace, name)
+			}
+			bootstrapComponent, err := newComponent(bootstrap)
+			if err != nil {
+				return nil, err
+			}
+			component.Bootstrap = bootstrap.GetKind()
+			switch bootstrap.GetKind() {
+			case "KubeadmConfigTemplate":
+				component.BootstrapFormat = bootstrapFormat(bootstrap, "spec", "template", "spec", "format")
+			case "KubeadmConfig":
+				component.BootstrapFormat = bootstrapFormat(bootstrap, "spec", "format")
+			}
+			if component.Provider == "" {
+				component.Provider = bootstrapComponent.Provider
+			}
+		}
+		if spec.InfrastructureRef.IsDefined() {
+			infraMachine, err := external.GetObjectFromContractVersionedRef(ctx, c, spec.InfrastructureRef, cluster.Namespace)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get infrastructure for %s %s/%s", kind, cluster.Namespace, name)
+			}
+			infraComponent, err := newComponent(infraMachine)
+			if err != nil {
+				return nil, err
+			}
+			component.Infrastructure = infraMachine.GetKind()
+			component.Provider = infraComponent.Provider
+		}
+		return component, nil
+	}
+
+	machineDeploymentList := &clusterv1.MachineDeploymentList{}
+	if err := c.List(ctx, machineDeploymentList, listOptions...); err != nil {
+		return nil, errors.Wrapf(err, "failed to list MachineDeployments for Cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+	for _, md := range machineDeploymentList.Items {
+		component, err := workerComponent("MachineDeployment", md.Name, md.Spec.Template.Spec)
+		if err != nil {
+			return nil, err
+		}
+		components.Workers = append(components.Workers, *component)
+	}
+
+	machinePoolList := &clusterv1.MachinePoolList{}
+	if err := c.List(ctx, machinePoolList, listOptions...); err != nil {
+		return nil, errors.Wrapf(err, "failed to list MachinePools for Cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+	for _, mp := range machinePoolList.Items {
+		component, err := workerComponent("MachinePool", mp.Name, mp.Spec.Template.Spec)
+		if err != nil {
+			return nil, err
+		}
+		components.Workers = append(components.Workers, *component)
+	}
+
+	machineList := &clusterv1.MachineList{}
+	if err := c.List(ctx, machineList, listOptions...); err != nil {
+		return nil, errors.Wrapf(err, "failed to list Machines for Cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+	for _, m := range machineList.Items {
+		if m.Status.NodeInfo == nil {
+			continue
+		}
+		components.Nodes = append(components.Nodes, NodeComponents{
+			Machine:                 m.Name,
+			Node:                    m.Status.NodeRef.Name,
+			KubeletVersion:          m.Status.NodeInfo.KubeletVersion,
+			ContainerRuntimeVersion: m.Status.NodeInfo.ContainerRuntimeVersion,
+			OSImage:                 m.Status.NodeInfo.OSImage,
+			KernelVersion:           m.Status.NodeInfo.KernelVersion,
+			Architecture:            m.Status.NodeInfo.Architecture,
+		})
+	}
+
+	for _, p := range providers {
+		if providerNames[p.ManifestLabel()] {
+			components.Providers = append(components.Providers, ProviderComponent{Name: p.ManifestLabel(), Namespace: p.Namespace, Version: p.Version})
+		}
+	}
+
+	if options.InspectWorkloadClusters && components.Kubeadm != nil {
+		if err := r.inspectWorkloadCluster(ctx, c, cluster, components.Kubeadm); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(components.Workers, func(i, j int) bool {
+		if components.Workers[i].Kind != components.Workers[j].Kind {
+			return components.Workers[i].Kind < components.Workers[j].Kind
+		}
+		return components.Workers[i].Name < components.Workers[j].Name
+	})
+	sort.Slice(components.Nodes, func(i, j int) bool {
+		return components.Nodes[i].Machine < components.Nodes[j].Machine
+	})
+	sort.Slice(components.Providers, func(i, j int) bool {
+		if components.Providers[i].Name != components.Providers[j].Name {
+			return components.Providers[i].Name < components.Providers[j].Name
+		}
+		return components.Providers[i].Namespace < components.Providers[j].Namespace
+	})
+	return components, nil
+}
+
+// inspectWorkloadCluster reads the images of the etcd and CoreDNS components running in the workload cluster.
+func (r *managedComponentsReporter) inspectWorkloadCluster(ctx context.Context, c client.Client, cluster *clusterv1.Cluster, kubeadm *KubeadmComponents) error {
+	workloadClient, err := r.workloadClusterClient(ctx, c, cluster)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create client for workload cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+
+	etcdPods := &corev1.PodList{}
+	if err := workloadClient.List(ctx, etcdPods, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{"component": "etcd", "tier": "control-plane"}); err != nil {
+		return errors.Wrapf(err, "failed to list etcd Pods in workload cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+	images := map[string]bool{}
+	for _, pod := range etcdPods.Items {
+		for _, container := range pod.Spec.Containers {
+			images[container.Image] = true
+		}
+	}
+	kubeadm.EtcdImages = sortedKeys(images)
+
+	coreDNS := &appsv1.Deployment{}
+	if err := workloadClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceSystem, Name: "coredns"}, coreDNS); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get CoreDNS Deployment in workload cluster %s/%s", cluster.Namespace, cluster.Name)
+		}
+		return nil
+	}
+	images = map[string]bool{}
+	for _, container := range coreDNS.Spec.Template.Spec.Containers {
+		images[container.Image] = true
+	}
+	kubeadm.CoreDNSImages = sortedKeys(images)
+	return nil
+}
+
+func (r *managedComponentsReporter) Store(ctx context.Context, components ClusterManagedComponents) error {
+	c, err := r.proxy.NewClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: components.Namespace, Name: components.Name}, cluster); err != nil {
+		return errors.Wrapf(err, "failed to get Cluster %s/%s", components.Namespace, components.Name)
+	}
+
+	data, err := yaml.Marshal(components)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal managed components of Cluster %s/%s", components.Namespace, components.Name)
+	}
+
+	configMap := &corev1.ConfigMap{}
+	key := client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Name + ManagedComponentsConfigMapSuffix}
+	if err := c.Get(ctx, key, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get ConfigMap %s", key)
+		}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: key.Namespace,
+				Name:      key.Name,
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: cluster.Name},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: clusterv1.GroupVersion.String(),
+					Kind:       "Cluster",
+					Name:       cluster.Name,
+					UID:        cluster.UID,
+				}},
+			},
+			Data: map[string]string{ManagedComponentsConfigMapKey: string(data)},
+		}
+		return errors.Wrapf(c.Create(ctx, configMap), "failed to create ConfigMap %s", key)
+	}
+
+	configMap.Data = map[string]string{ManagedComponentsConfigMapKey: string(data)}
+	return errors.Wrapf(c.Update(ctx, configMap), "failed to update ConfigMap %s", key)
+}
+
+// getCRDForGroupKind returns the CRD for a GroupKind, or nil if it does not exist.
+func getCRDForGroupKind(ctx context.Context, c client.Client, gk schema.GroupKind) (*apiextensionsv1.CustomResourceDefinition, error) {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := c.Get(ctx, client.ObjectKey{Name: utilcontract.CalculateCRDName(gk.Group, gk.Kind)}, crd); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get CRD for %s", gk)
+	}
+	return crd, nil
+}
+
+// bootstrapFormat returns the bootstrap format at the given path, defaulting to cloud-config as kubeadm does.
+func bootstrapFormat(obj *unstructured.Unstructured, fields ...string) string {
+	format, _, _ := unstructured.NestedString(obj.Object, fields...)
+	if format == "" {
+		return "cloud-config"
+	}
+	return format
+}
+
+// kubeadmComponents returns the kubeadm components defined in a KubeadmControlPlane.
+func kubeadmComponents(controlPlane *unstructured.Unstructured) *KubeadmComponents {
+	clusterConfiguration := []string{"spec", "kubeadmConfigSpec", "clusterConfiguration"}
+	get := func(fields ...string) string {
+		value, _, _ := unstructured.NestedString(controlPlane.Object, append(clusterConfiguration, fields...)...)
+		return value
+	}
+	return &KubeadmComponents{
+		ImageRepository: get("imageRepository"),
+		EtcdVersion:     get("etcd", "local", "imageTag"),
+		CoreDNSVersion:  get("dns", "imageTag"),
+	}
+}
+
+// workloadClusterClient returns a client for the workload cluster using the admin kubeconfig stored in the management cluster.
+func workloadClusterClient(ctx context.Context, c client.Client, cluster *clusterv1.Cluster) (client.Client, error) {
+	data, err := utilkubeconfig.FromSecret(ctx, c, client.ObjectKeyFromObject(cluster))
+	if err != nil {
+		return nil, err
+	}
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(data)
+	if err != nil {
+		return nil, err
+	}
+	return client.New(restConfig, client.Options{})
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if len(keys) == 0 {
+		return nil
+	}
+	return keys
+}
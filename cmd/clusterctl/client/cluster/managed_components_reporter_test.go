@@ -0,0 +1,294 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	fakebootstrap "sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test/providers/bootstrap"
+	fakeinfrastructure "sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test/providers/infrastructure"
+)
+
+func Test_ManagedComponentsReporter_Report(t *testing.T) {
+	cluster1 := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "cluster1"},
+		Spec: clusterv1.ClusterSpec{
+			InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+				APIGroup: fakeinfrastructure.GroupVersion.Group,
+				Kind:     "GenericInfrastructureCluster",
+				Name:     "infra1",
+			},
+			ControlPlaneRef: clusterv1.ContractVersionedObjectReference{
+				APIGroup: controlplanev1.GroupVersion.Group,
+				Kind:     "KubeadmControlPlane",
+				Name:     "cp1",
+			},
+		},
+	}
+	infraCluster1 := &fakeinfrastructure.GenericInfrastructureCluster{
+		TypeMeta:   metav1.TypeMeta{APIVersion: fakeinfrastructure.GroupVersion.String(), Kind: "GenericInfrastructureCluster"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "infra1"},
+	}
+	controlPlane1 := &controlplanev1.KubeadmControlPlane{
+		TypeMeta:   metav1.TypeMeta{APIVersion: controlplanev1.GroupVersion.String(), Kind: "KubeadmControlPlane"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "cp1"},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.33.1",
+			KubeadmConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					ImageRepository: "registry.example.com",
+					Etcd:            bootstrapv1.Etcd{Local: bootstrapv1.LocalEtcd{ImageTag: "3.5.21-0"}},
+					DNS:             bootstrapv1.DNS{ImageTag: "v1.12.0"},
+				},
+			},
+		},
+	}
+	cluster2 := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "cluster2"},
+		Spec: clusterv1.ClusterSpec{
+			Topology: clusterv1.Topology{
+				ClassRef: clusterv1.ClusterClassRef{Name: "class"},
+				Version:  "v1.32.0",
+			},
+		},
+	}
+
+	bootstrapTemplate := &fakebootstrap.GenericBootstrapConfigTemplate{
+		TypeMeta:   metav1.TypeMeta{APIVersion: fakebootstrap.GroupVersion.String(), Kind: "GenericBootstrapConfigTemplate"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "bootstrap"},
+	}
+	infraTemplate := &fakeinfrastructure.GenericInfrastructureMachineTemplate{
+		TypeMeta:   metav1.TypeMeta{APIVersion: fakeinfrastructure.GroupVersion.String(), Kind: "GenericInfrastructureMachineTemplate"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "infra"},
+	}
+	md := &clusterv1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "md", Labels: map[string]string{clusterv1.ClusterNameLabel: "cluster1"}},
+		Spec: clusterv1.MachineDeploymentSpec{
+			Template: clusterv1.MachineTemplateSpec{Spec: clusterv1.MachineSpec{
+				Version: "v1.33.0",
+				Bootstrap: clusterv1.Bootstrap{ConfigRef: clusterv1.ContractVersionedObjectReference{
+					APIGroup: fakebootstrap.GroupVersion.Group,
+					Kind:     "GenericBootstrapConfigTemplate",
+					Name:     "bootstrap",
+				}},
+				InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+					APIGroup: fakeinfrastructure.GroupVersion.Group,
+					Kind:     "GenericInfrastructureMachineTemplate",
+					Name:     "infra",
+				},
+			}},
+		},
+	}
+	m1 := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "m1", Labels: map[string]string{clusterv1.ClusterNameLabel: "cluster1"}},
+		Status: clusterv1.MachineStatus{
+			NodeRef: clusterv1.MachineNodeReference{Name: "node1"},
+			NodeInfo: &corev1.NodeSystemInfo{
+				KubeletVersion:          "v1.33.1",
+				ContainerRuntimeVersion: "containerd://2.0.5",
+				OSImage:                 "Ubuntu 24.04.2 LTS",
+				KernelVersion:           "6.8.0-60-generic",
+				Architecture:            "amd64",
+			},
+		},
+	}
+	m2 := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "m2", Labels: map[string]string{clusterv1.ClusterNameLabel: "cluster1"}},
+	}
+
+	objs := []client.Object{cluster1, infraCluster1, controlPlane1, cluster2, bootstrapTemplate, infraTemplate, md, m1, m2}
+	for _, crd := range test.FakeCRDList() {
+		switch crd.Spec.Group {
+		case fakeinfrastructure.GroupVersion.Group:
+			crd.Labels[clusterv1.ProviderNameLabel] = "infrastructure-generic"
+		case fakebootstrap.GroupVersion.Group:
+			crd.Labels[clusterv1.ProviderNameLabel] = "bootstrap-generic"
+		}
+		objs = append(objs, crd)
+	}
+	kcpCRD := test.FakeNamespacedCustomResourceDefinition(controlplanev1.GroupVersion.Group, "KubeadmControlPlane", clusterv1.GroupVersion.Version)
+	kcpCRD.Labels[clusterv1.ProviderNameLabel] = "control-plane-kubeadm"
+	objs = append(objs, kcpCRD)
+
+	cluster1Components := ClusterManagedComponents{
+		Namespace:         "ns1",
+		Name:              "cluster1",
+		KubernetesVersion: "v1.33.1",
+		Infrastructure:    &ManagedComponent{Kind: "GenericInfrastructureCluster", Name: "infra1", Provider: "infrastructure-generic"},
+		ControlPlane: &ManagedComponent{
+			Kind:            "KubeadmControlPlane",
+			Name:            "cp1",
+			Version:         "v1.33.1",
+			Provider:        "control-plane-kubeadm",
+			BootstrapFormat: "cloud-config",
+		},
+		Kubeadm: &KubeadmComponents{
+			ImageRepository: "registry.example.com",
+			EtcdVersion:     "3.5.21-0",
+			CoreDNSVersion:  "v1.12.0",
+		},
+		Workers: []ManagedComponent{
+			{
+				Kind:           "MachineDeployment",
+				Name:           "md",
+				Version:        "v1.33.0",
+				Provider:       "infrastructure-generic",
+				Bootstrap:      "GenericBootstrapConfigTemplate",
+				Infrastructure: "GenericInfrastructureMachineTemplate",
+			},
+		},
+		Nodes: []NodeComponents{
+			{
+				Machine:                 "m1",
+				Node:                    "node1",
+				KubeletVersion:          "v1.33.1",
+				ContainerRuntimeVersion: "containerd://2.0.5",
+				OSImage:                 "Ubuntu 24.04.2 LTS",
+				KernelVersion:           "6.8.0-60-generic",
+				Architecture:            "amd64",
+			},
+		},
+		Providers: []ProviderComponent{
+			{Name: "bootstrap-generic", Namespace: "bootstrap-system", Version: "v1.1.0"},
+			{Name: "cluster-api", Namespace: "capi-system", Version: "v1.11.0"},
+			{Name: "control-plane-kubeadm", Namespace: "capi-kubeadm-control-plane-system", Version: "v1.11.0"},
+			{Name: "infrastructure-generic", Namespace: "infra-system", Version: "v2.0.0"},
+		},
+	}
+	cluster2Components := ClusterManagedComponents{
+		Namespace:         "ns2",
+		Name:              "cluster2",
+		KubernetesVersion: "v1.32.0",
+		Providers: []ProviderComponent{
+			{Name: "cluster-api", Namespace: "capi-system", Version: "v1.11.0"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		options   ManagedComponentsReportOptions
+		want      *ManagedComponentsReport
+		expectErr bool
+	}{
+		{
+			name:    "report all the Clusters",
+			options: ManagedComponentsReportOptions{},
+			want:    &ManagedComponentsReport{Clusters: []ClusterManagedComponents{cluster1Components, cluster2Components}},
+		},
+		{
+			name:    "report a Cluster",
+			options: ManagedComponentsReportOptions{Namespace: "ns2", ClusterName: "cluster2"},
+			want:    &ManagedComponentsReport{Clusters: []ClusterManagedComponents{cluster2Components}},
+		},
+		{
+			name:      "fails if the Cluster does not exist",
+			options:   ManagedComponentsReportOptions{Namespace: "ns1", ClusterName: "does-not-exist"},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			proxy := test.NewFakeProxy().WithObjs(objs...).
+				WithProviderInventory("cluster-api", clusterctlv1.CoreProviderType, "v1.11.0", "capi-system").
+				WithProviderInventory("kubeadm", clusterctlv1.ControlPlaneProviderType, "v1.11.0", "capi-kubeadm-control-plane-system").
+				WithProviderInventory("generic", clusterctlv1.BootstrapProviderType, "v1.1.0", "bootstrap-system").
+				WithProviderInventory("generic", clusterctlv1.InfrastructureProviderType, "v2.0.0", "infra-system").
+				WithProviderInventory("unused", clusterctlv1.InfrastructureProviderType, "v3.0.0", "unused-system")
+			r := newManagedComponentsReporter(proxy)
+			got, err := r.Report(context.Background(), tt.options)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(BeComparableTo(tt.want))
+		})
+	}
+
+	t.Run("inspects workload clusters", func(t *testing.T) {
+		g := NewWithT(t)
+
+		workloadClient := fake.NewClientBuilder().WithObjects(
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "etcd-node1", Labels: map[string]string{"component": "etcd", "tier": "control-plane"}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "etcd", Image: "registry.example.com/etcd:3.5.21-0"}}},
+			},
+			&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "coredns"},
+				Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "coredns", Image: "registry.example.com/coredns/coredns:v1.12.0"}},
+				}}},
+			},
+		).Build()
+
+		r := newManagedComponentsReporter(test.NewFakeProxy().WithObjs(objs...))
+		r.workloadClusterClient = func(_ context.Context, _ client.Client, _ *clusterv1.Cluster) (client.Client, error) {
+			return workloadClient, nil
+		}
+		got, err := r.Report(context.Background(), ManagedComponentsReportOptions{Namespace: "ns1", ClusterName: "cluster1", InspectWorkloadClusters: true})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got.Clusters).To(HaveLen(1))
+		g.Expect(got.Clusters[0].Kubeadm.EtcdImages).To(Equal([]string{"registry.example.com/etcd:3.5.21-0"}))
+		g.Expect(got.Clusters[0].Kubeadm.CoreDNSImages).To(Equal([]string{"registry.example.com/coredns/coredns:v1.12.0"}))
+	})
+}
+
+func Test_ManagedComponentsReporter_Store(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster1 := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "cluster1", UID: "cluster1-uid"},
+	}
+	proxy := test.NewFakeProxy().WithObjs(cluster1)
+	r := newManagedComponentsReporter(proxy)
+
+	components := ClusterManagedComponents{Namespace: "ns1", Name: "cluster1", KubernetesVersion: "v1.33.0"}
+	g.Expect(r.Store(context.Background(), components)).To(Succeed())
+
+	// Storing again updates the existing ConfigMap.
+	components.KubernetesVersion = "v1.33.1"
+	g.Expect(r.Store(context.Background(), components)).To(Succeed())
+
+	c, err := proxy.NewClient(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+	configMap := &corev1.ConfigMap{}
+	g.Expect(c.Get(context.Background(), client.ObjectKey{Namespace: "ns1", Name: "cluster1" + ManagedComponentsConfigMapSuffix}, configMap)).To(Succeed())
+	g.Expect(configMap.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, "cluster1"))
+	g.Expect(configMap.OwnerReferences).To(ConsistOf(HaveField("UID", cluster1.UID)))
+
+	stored := ClusterManagedComponents{}
+	g.Expect(yaml.Unmarshal([]byte(configMap.Data[ManagedComponentsConfigMapKey]), &stored)).To(Succeed())
+	g.Expect(stored).To(BeComparableTo(components))
+
+	g.Expect(r.Store(context.Background(), ClusterManagedComponents{Namespace: "ns1", Name: "does-not-exist"})).ToNot(Succeed())
+}
@@ -29,6 +29,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -51,13 +52,30 @@ import (
 // ResourceMutatorFunc holds the type for mutators to be applied on resources during a move operation.
 type ResourceMutatorFunc func(u *unstructured.Unstructured) error
 
+// MoveFilter defines which of the Cluster API objects existing in a namespace should be considered by move.
+type MoveFilter struct {
+	// ClusterSelector restricts move to the Clusters matching the selector and to the objects belonging to them.
+	// If nil, all the Clusters are moved.
+	ClusterSelector labels.Selector
+
+	// ExcludeKinds lists the kinds of the objects to be excluded from move, in the Kind or Kind.group format.
+	// Excluded objects are neither moved nor deleted from the source cluster.
+	ExcludeKinds []string
+}
+
 // ObjectMover defines methods for moving Cluster API objects to another management cluster.
 type ObjectMover interface {
-	// Move moves all the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target management cluster.
-	Move(ctx context.Context, namespace string, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error
+	// Move moves all the Cluster API objects existing in a namespace (or from all the namespaces if empty) and not excluded by the filter
+	// to a target management cluster.
+	// If stateFile is set, the progress of the move is recorded in the file, and a move which failed midway is resumed from it.
+	Move(ctx context.Context, namespace string, filter MoveFilter, toCluster Client, dryRun bool, stateFile string, mutators ...ResourceMutatorFunc) error
+
+	// Cleanup reverts the pauses applied to the source management cluster by a move recorded in stateFile, e.g. after aborting it.
+	Cleanup(ctx context.Context, stateFile string) error
 
-	// ToDirectory writes all the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target directory.
-	ToDirectory(ctx context.Context, namespace string, directory string) error
+	// ToDirectory writes all the Cluster API objects existing in a namespace (or from all the namespaces if empty) and not excluded by the filter
+	// to a target directory.
+	ToDirectory(ctx context.Context, namespace string, filter MoveFilter, directory string) error
 
 	// FromDirectory reads all the Cluster API objects existing in a configured directory to a target management cluster.
 	FromDirectory(ctx context.Context, toCluster Client, directory string) error
@@ -68,12 +86,15 @@ type objectMover struct {
 	fromProxy             Proxy
 	fromProviderInventory InventoryClient
 	dryRun                bool
+
+	// state records the progress of the move, if a state file is used.
+	state *moveState
 }
 
 // ensure objectMover implements the ObjectMover interface.
 var _ ObjectMover = &objectMover{}
 
-func (o *objectMover) Move(ctx context.Context, namespace string, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error {
+func (o *objectMover) Move(ctx context.Context, namespace string, filter MoveFilter, toCluster Client, dryRun bool, stateFile string, mutators ...ResourceMutatorFunc) error {
 	log := logf.Log
 	log.Info("Performing move...")
 	o.dryRun = dryRun
@@ -90,7 +111,18 @@ func (o *objectMover) Move(ctx context.Context, namespace string, toCluster Clie
 		}
 	}
 
-	objectGraph, err := o.getObjectGraph(ctx, namespace)
+	if stateFile != "" && !o.dryRun {
+		state, err := loadMoveState(stateFile)
+		if err != nil {
+			return err
+		}
+		if !state.isEmpty() {
+			log.Info("Resuming move from state file", "stateFile", stateFile)
+		}
+		o.state = state
+	}
+
+	objectGraph, err := o.getObjectGraph(ctx, namespace, filter)
 	if err != nil {
 		return errors.Wrap(err, "failed to get object graph")
 	}
@@ -104,11 +136,11 @@ func (o *objectMover) Move(ctx context.Context, namespace string, toCluster Clie
 	return o.move(ctx, objectGraph, proxy, mutators...)
 }
 
-func (o *objectMover) ToDirectory(ctx context.Context, namespace string, directory string) error {
+func (o *objectMover) ToDirectory(ctx context.Context, namespace string, filter MoveFilter, directory string) error {
 	log := logf.Log
 	log.Info("Moving to directory...")
 
-	objectGraph, err := o.getObjectGraph(ctx, namespace)
+	objectGraph, err := o.getObjectGraph(ctx, namespace, filter)
 	if err != nil {
 		return errors.Wrap(err, "failed to get object graph")
 	}
@@ -187,7 +219,7 @@ func (o *objectMover) filesToObjs(dir string) ([]unstructured.Unstructured, erro
 	return objs, nil
 }
 
-func (o *objectMover) getObjectGraph(ctx context.Context, namespace string) (*objectGraph, error) {
+func (o *objectMover) getObjectGraph(ctx context.Context, namespace string, filter MoveFilter) (*objectGraph, error) {
 	objectGraph := newObjectGraph(o.fromProxy, o.fromProviderInventory)
 
 	// Gets all the types defined by the CRDs installed by clusterctl plus the ConfigMap/Secret core types.
@@ -203,6 +235,11 @@ func (o *objectMover) getObjectGraph(ctx context.Context, namespace string) (*ob
 		return nil, errors.Wrap(err, "failed to discover the object graph")
 	}
 
+	// Remove from the object graph the objects excluded by the filter, e.g. Clusters not matching the cluster selector.
+	if err := objectGraph.applyFilter(filter); err != nil {
+		return nil, errors.Wrap(err, "failed to filter the object graph")
+	}
+
 	// Checks if Cluster API has already completed the provisioning of the infrastructure for the objects involved in the move/toDirectory operation.
 	// This is required because if the infrastructure is provisioned, then we can reasonably assume that the objects we are moving/backing up are
 	// not currently waiting for long-running reconciliation loops, and so we can safely rely on the pause field on the Cluster object
@@ -339,15 +376,28 @@ func getMachineObj(ctx context.Context, proxy Proxy, machine *node, machineObj *
 func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Proxy, mutators ...ResourceMutatorFunc) error {
 	log := logf.Log
 
+	// Clusters and ClusterClasses paused by a previous attempt of the same move are expected to be paused.
 	clusters := graph.getClusters()
-	if err := checkClustersNotPaused(ctx, o.fromProxy, clusters); err != nil {
+	clustersToCheck := []*node{}
+	for _, cluster := range clusters {
+		if !o.state.isPausedCluster(cluster) {
+			clustersToCheck = append(clustersToCheck, cluster)
+		}
+	}
+	if err := checkClustersNotPaused(ctx, o.fromProxy, clustersToCheck); err != nil {
 		return err
 	}
 
 	log.Info("Moving Cluster API objects", "Clusters", len(clusters))
 
 	clusterClasses := graph.getClusterClasses()
-	if err := checkClusterClassesNotPaused(ctx, o.fromProxy, clusterClasses); err != nil {
+	clusterClassesToCheck := []*node{}
+	for _, clusterClass := range clusterClasses {
+		if !o.state.isPausedClusterClass(clusterClass) {
+			clusterClassesToCheck = append(clusterClassesToCheck, clusterClass)
+		}
+	}
+	if err := checkClusterClassesNotPaused(ctx, o.fromProxy, clusterClassesToCheck); err != nil {
 		return err
 	}
 
@@ -358,11 +408,17 @@ func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Prox
 	if err := setClusterPause(ctx, o.fromProxy, clusters, true, o.dryRun); err != nil {
 		return err
 	}
+	if err := o.state.recordPausedClusters(clusters); err != nil {
+		return err
+	}
 
 	log.V(1).Info("Pausing the source ClusterClasses")
 	if err := setClusterClassPause(ctx, o.fromProxy, clusterClasses, true, o.dryRun); err != nil {
 		return errors.Wrap(err, "error pausing ClusterClasses")
 	}
+	if err := o.state.recordPausedClusterClasses(clusterClasses); err != nil {
+		return err
+	}
 
 	log.Info("Waiting for all resources to be ready to move")
 	// exponential backoff configuration which returns durations for a total time of ~2m.
@@ -409,14 +465,58 @@ func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Prox
 	}
 
 	// Resume the ClusterClasses in the target management cluster, so the controllers start reconciling it.
+	// Nb. When resuming a move, this includes ClusterClasses already deleted from the source cluster by a previous attempt.
 	log.V(1).Info("Resuming the target ClusterClasses")
-	if err := setClusterClassPause(ctx, toProxy, clusterClasses, false, o.dryRun, mutators...); err != nil {
+	if err := setClusterClassPause(ctx, toProxy, o.state.pausedClusterClasses(clusterClasses), false, o.dryRun, mutators...); err != nil {
 		return errors.Wrap(err, "error resuming ClusterClasses")
 	}
 
 	// Reset the pause field on the Cluster object in the target management cluster, so the controllers start reconciling it.
+	// Nb. When resuming a move, this includes Clusters already deleted from the source cluster by a previous attempt.
 	log.V(1).Info("Resuming the target cluster")
-	return setClusterPause(ctx, toProxy, clusters, false, o.dryRun, mutators...)
+	if err := setClusterPause(ctx, toProxy, o.state.pausedClusters(clusters), false, o.dryRun, mutators...); err != nil {
+		return err
+	}
+
+	// The move is completed, drop the state.
+	return o.state.remove()
+}
+
+// Cleanup reverts the pauses applied to the source management cluster by a move recorded in stateFile.
+func (o *objectMover) Cleanup(ctx context.Context, stateFile string) error {
+	log := logf.Log
+
+	state, err := loadMoveState(stateFile)
+	if err != nil {
+		return err
+	}
+	if state.isEmpty() {
+		log.Info("Nothing to clean up, no move recorded in state file", "stateFile", stateFile)
+		return state.remove()
+	}
+
+	// Once objects have been deleted from the source management cluster, the move can only be completed.
+	if len(state.DeletedObjects) > 0 {
+		return errors.Errorf("cannot clean up the move recorded in state file %q: %d objects have been already deleted from the source management cluster, run move again with the same state file to complete it", stateFile, len(state.DeletedObjects))
+	}
+
+	clusterClasses := state.pausedClusterClasses(nil)
+	log.Info("Resuming the source ClusterClasses", "ClusterClasses", len(clusterClasses))
+	if err := setClusterClassPause(ctx, o.fromProxy, clusterClasses, false, false); err != nil {
+		return errors.Wrap(err, "error resuming ClusterClasses")
+	}
+
+	clusters := state.pausedClusters(nil)
+	log.Info("Resuming the source Clusters", "Clusters", len(clusters))
+	if err := setClusterPause(ctx, o.fromProxy, clusters, false, false); err != nil {
+		return err
+	}
+
+	if len(state.CreatedObjects) > 0 {
+		log.Info("Objects created in the target management cluster have not been deleted, the Clusters there are paused", "objects", len(state.CreatedObjects))
+	}
+
+	return state.remove()
 }
 
 func (o *objectMover) toDirectory(ctx context.Context, graph *objectGraph, directory string) error {
@@ -1037,6 +1137,17 @@ func (o *objectMover) createTargetObject(ctx context.Context, nodeToCreate *node
 	// Rebuild the owner reference chain
 	o.buildOwnerChain(obj, nodeToCreate)
 
+	// Mark Clusters as moved, so the Cluster controller in the target management cluster can record the move
+	// in the Cluster's status.lastOperations.
+	if nodeToCreate.identity.GroupVersionKind().GroupKind() == clusterv1.GroupVersion.WithKind("Cluster").GroupKind() {
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[clusterv1.ClusterMovedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+		obj.SetAnnotations(annotations)
+	}
+
 	// TODO Workaround for https://github.com/kubernetes/kubernetes/issues/32220. Remove when the issue is fixed.
 	// If the resource already exists, the API server ordinarily returns an AlreadyExists error. Due to the above issue, if the resource has a non-empty metadata.generateName field, the API server returns a ServerTimeoutError. To ensure that the API server returns an AlreadyExists error, we set the metadata.generateName field to an empty string.
 	if obj.GetName() != "" && obj.GetGenerateName() != "" {
@@ -1099,7 +1210,7 @@ func (o *objectMover) createTargetObject(ctx context.Context, nodeToCreate *node
 		return errors.Wrap(err, "error patching the managed fields")
 	}
 
-	return nil
+	return o.state.recordCreated(nodeToCreate)
 }
 
 func (o *objectMover) backupTargetObject(ctx context.Context, nodeToCreate *node, directory string) error {
@@ -1314,7 +1425,7 @@ func (o *objectMover) deleteSourceObject(ctx context.Context, nodeToDelete *node
 				sourceObj.GroupVersionKind(), sourceObj.GetNamespace(), sourceObj.GetName())
 		}
 	}
-	return nil
+	return o.state.recordDeleted(nodeToDelete)
 }
 
 // checkTargetProviders checks that all the providers installed in the source cluster exists in the target cluster as well (with a version >= of the current version).
@@ -0,0 +1,235 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// moveState is a journal of a move operation, which is persisted to a local file after every step
+// so a move that failed midway can be resumed, or its pauses reverted.
+// A nil moveState is valid and records nothing.
+type moveState struct {
+	path string
+
+	// PausedClusters are the Clusters paused in the source management cluster by the move.
+	PausedClusters []moveStateObject `json:"pausedClusters,omitempty"`
+
+	// PausedClusterClasses are the ClusterClasses paused in the source management cluster by the move.
+	PausedClusterClasses []moveStateObject `json:"pausedClusterClasses,omitempty"`
+
+	// CreatedObjects are the objects created in the target management cluster by the move.
+	CreatedObjects []moveStateObject `json:"createdObjects,omitempty"`
+
+	// DeletedObjects are the objects deleted from the source management cluster by the move.
+	DeletedObjects []moveStateObject `json:"deletedObjects,omitempty"`
+}
+
+// moveStateObject identifies an object recorded in the move state.
+type moveStateObject struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+func newMoveStateObject(n *node) moveStateObject {
+	return moveStateObject{
+		APIVersion: n.identity.APIVersion,
+		Kind:       n.identity.Kind,
+		Namespace:  n.identity.Namespace,
+		Name:       n.identity.Name,
+	}
+}
+
+// toNode returns a node for the object, to be used when the object is not part of the object graph anymore,
+// e.g. because it has been already deleted from the source management cluster.
+func (o moveStateObject) toNode() *node {
+	return &node{
+		identity: corev1.ObjectReference{
+			APIVersion: o.APIVersion,
+			Kind:       o.Kind,
+			Namespace:  o.Namespace,
+			Name:       o.Name,
+		},
+	}
+}
+
+// loadMoveState reads the move state from path; if the file does not exist an empty state is returned.
+func loadMoveState(path string) (*moveState, error) {
+	s := &moveState{path: path}
+
+	data, err := os.ReadFile(path) //nolint:gosec // The path is provided by the user.
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read move state file %q", path)
+	}
+	if err := yaml.Unmarshal(data, s); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse move state file %q", path)
+	}
+	return s, nil
+}
+
+// isEmpty returns true if nothing has been recorded yet.
+func (s *moveState) isEmpty() bool {
+	return s == nil || (len(s.PausedClusters) == 0 && len(s.PausedClusterClasses) == 0 && len(s.CreatedObjects) == 0 && len(s.DeletedObjects) == 0)
+}
+
+// isPausedCluster returns true if the Cluster corresponding to the node has been paused by the move.
+func (s *moveState) isPausedCluster(n *node) bool {
+	return s != nil && containsMoveStateObject(s.PausedClusters, newMoveStateObject(n))
+}
+
+// isPausedClusterClass returns true if the ClusterClass corresponding to the node has been paused by the move.
+func (s *moveState) isPausedClusterClass(n *node) bool {
+	return s != nil && containsMoveStateObject(s.PausedClusterClasses, newMoveStateObject(n))
+}
+
+// recordPausedClusters records the Clusters paused by the move.
+func (s *moveState) recordPausedClusters(nodes []*node) error {
+	if s == nil {
+		return nil
+	}
+	for _, n := range nodes {
+		s.PausedClusters = appendMoveStateObject(s.PausedClusters, newMoveStateObject(n))
+	}
+	return s.save()
+}
+
+// recordPausedClusterClasses records the ClusterClasses paused by the move.
+func (s *moveState) recordPausedClusterClasses(nodes []*node) error {
+	if s == nil {
+		return nil
+	}
+	for _, n := range nodes {
+		s.PausedClusterClasses = appendMoveStateObject(s.PausedClusterClasses, newMoveStateObject(n))
+	}
+	return s.save()
+}
+
+// recordCreated records an object created in the target management cluster.
+func (s *moveState) recordCreated(n *node) error {
+	if s == nil {
+		return nil
+	}
+	s.CreatedObjects = appendMoveStateObject(s.CreatedObjects, newMoveStateObject(n))
+	return s.save()
+}
+
+// recordDeleted records an object deleted from the source management cluster.
+func (s *moveState) recordDeleted(n *node) error {
+	if s == nil {
+		return nil
+	}
+	s.DeletedObjects = appendMoveStateObject(s.DeletedObjects, newMoveStateObject(n))
+	return s.save()
+}
+
+// pausedClusters returns the nodes for the given Clusters, plus the nodes for the Clusters paused by the move
+// which are not part of the given list anymore.
+func (s *moveState) pausedClusters(clusters []*node) []*node {
+	if s == nil {
+		return clusters
+	}
+	return mergeMoveStateObjects(clusters, s.PausedClusters)
+}
+
+// pausedClusterClasses returns the nodes for the given ClusterClasses, plus the nodes for the ClusterClasses paused
+// by the move which are not part of the given list anymore.
+func (s *moveState) pausedClusterClasses(clusterClasses []*node) []*node {
+	if s == nil {
+		return clusterClasses
+	}
+	return mergeMoveStateObjects(clusterClasses, s.PausedClusterClasses)
+}
+
+// save writes the move state to file; the file is replaced atomically so an interrupted write does not corrupt the state.
+func (s *moveState) save() error {
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal move state")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return errors.Wrapf(err, "failed to write move state file %q", s.path)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return errors.Wrapf(err, "failed to write move state file %q", s.path)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrapf(err, "failed to write move state file %q", s.path)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return errors.Wrapf(err, "failed to write move state file %q", s.path)
+	}
+	return nil
+}
+
+// remove deletes the move state file, e.g. once the move is completed.
+func (s *moveState) remove() error {
+	if s == nil {
+		return nil
+	}
+	if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to delete move state file %q", s.path)
+	}
+	return nil
+}
+
+func containsMoveStateObject(objs []moveStateObject, obj moveStateObject) bool {
+	for _, o := range objs {
+		if o == obj {
+			return true
+		}
+	}
+	return false
+}
+
+func appendMoveStateObject(objs []moveStateObject, obj moveStateObject) []moveStateObject {
+	if containsMoveStateObject(objs, obj) {
+		return objs
+	}
+	return append(objs, obj)
+}
+
+func mergeMoveStateObjects(nodes []*node, objs []moveStateObject) []*node {
+	ret := append([]*node{}, nodes...)
+	for _, obj := range objs {
+		found := false
+		for _, n := range nodes {
+			if newMoveStateObject(n) == obj {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ret = append(ret, obj.toNode())
+		}
+	}
+	return ret
+}
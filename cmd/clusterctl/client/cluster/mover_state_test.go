@@ -0,0 +1,78 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_moveState(t *testing.T) {
+	g := NewWithT(t)
+
+	newNode := func(kind, name string) *node {
+		return &node{identity: corev1.ObjectReference{APIVersion: "cluster.x-k8s.io/v1beta2", Kind: kind, Namespace: "ns1", Name: name}}
+	}
+	cluster1 := newNode("Cluster", "cluster1")
+	cluster2 := newNode("Cluster", "cluster2")
+	clusterClass1 := newNode("ClusterClass", "class1")
+	machine1 := newNode("Machine", "machine1")
+
+	// A nil state records nothing.
+	var nilState *moveState
+	g.Expect(nilState.isEmpty()).To(BeTrue())
+	g.Expect(nilState.recordPausedClusters([]*node{cluster1})).To(Succeed())
+	g.Expect(nilState.isPausedCluster(cluster1)).To(BeFalse())
+	g.Expect(nilState.pausedClusters([]*node{cluster1})).To(ConsistOf(cluster1))
+	g.Expect(nilState.remove()).To(Succeed())
+
+	// A missing state file is an empty state.
+	stateFile := filepath.Join(t.TempDir(), "move-state.yaml")
+	state, err := loadMoveState(stateFile)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(state.isEmpty()).To(BeTrue())
+
+	g.Expect(state.recordPausedClusters([]*node{cluster1, cluster2})).To(Succeed())
+	g.Expect(state.recordPausedClusterClasses([]*node{clusterClass1})).To(Succeed())
+	g.Expect(state.recordCreated(machine1)).To(Succeed())
+	g.Expect(state.recordCreated(machine1)).To(Succeed())
+	g.Expect(state.recordDeleted(machine1)).To(Succeed())
+
+	// The state is persisted to the state file.
+	state, err = loadMoveState(stateFile)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(state.isEmpty()).To(BeFalse())
+	g.Expect(state.isPausedCluster(cluster1)).To(BeTrue())
+	g.Expect(state.isPausedCluster(machine1)).To(BeFalse())
+	g.Expect(state.isPausedClusterClass(clusterClass1)).To(BeTrue())
+	g.Expect(state.CreatedObjects).To(HaveLen(1))
+	g.Expect(state.DeletedObjects).To(HaveLen(1))
+
+	// Paused objects not in the list anymore, e.g. because they have been already deleted from the source cluster, are added.
+	clusters := state.pausedClusters([]*node{cluster1})
+	g.Expect(clusters).To(HaveLen(2))
+	g.Expect(clusters[0]).To(Equal(cluster1))
+	g.Expect(clusters[1].identity).To(Equal(cluster2.identity))
+
+	g.Expect(state.remove()).To(Succeed())
+	state, err = loadMoveState(stateFile)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(state.isEmpty()).To(BeTrue())
+}
@@ -2129,7 +2129,9 @@ func Test_createTargetObject(t *testing.T) {
 					Name:      "foo",
 				}
 				g.Expect(toClient.Get(context.Background(), key, c)).ToNot(HaveOccurred())
-				g.Expect(c.Annotations).To(BeEmpty())
+				// Existing annotations are overwritten; only the annotation recording the move is expected.
+				g.Expect(c.Annotations).To(HaveLen(1))
+				g.Expect(c.Annotations).To(HaveKey(clusterv1.ClusterMovedAnnotation))
 			},
 		},
 		{
@@ -2175,7 +2177,9 @@ func Test_createTargetObject(t *testing.T) {
 					Name:      "foo",
 				}
 				g.Expect(toClient.Get(context.Background(), key, c)).ToNot(HaveOccurred())
-				g.Expect(c.Annotations).To(BeEmpty())
+				// Existing annotations are overwritten; only the annotation recording the move is expected.
+				g.Expect(c.Annotations).To(HaveLen(1))
+				g.Expect(c.Annotations).To(HaveKey(clusterv1.ClusterMovedAnnotation))
 			},
 		},
 		{
@@ -2556,3 +2560,103 @@ func Test_applyMutators(t *testing.T) {
 		})
 	}
 }
+
+func Test_objectMover_move_resume(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+
+	// The source Cluster has been paused by a previous attempt of the same move, which failed midway.
+	graph := getObjectGraphWithObjs(test.NewFakeCluster("ns1", "foo").WithPaused().Objs())
+	g.Expect(graph.getDiscoveryTypes(ctx)).To(Succeed())
+	g.Expect(graph.Discovery(ctx, "")).To(Succeed())
+
+	stateFile := filepath.Join(t.TempDir(), "move-state.yaml")
+	state, err := loadMoveState(stateFile)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(state.recordPausedClusters(graph.getClusters())).To(Succeed())
+
+	toProxy := getFakeProxyWithCRDs()
+
+	// Without the state, move refuses to move paused Clusters.
+	mover := objectMover{
+		fromProxy: graph.proxy,
+	}
+	g.Expect(mover.move(ctx, graph, toProxy)).ToNot(Succeed())
+
+	// With the state, move is resumed.
+	state, err = loadMoveState(stateFile)
+	g.Expect(err).ToNot(HaveOccurred())
+	mover = objectMover{
+		fromProxy: graph.proxy,
+		state:     state,
+	}
+	g.Expect(mover.move(ctx, graph, toProxy)).To(Succeed())
+
+	// The Cluster is moved and resumed in the target cluster, and the state file is deleted.
+	csTo, err := toProxy.NewClient(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	cluster := &clusterv1.Cluster{}
+	g.Expect(csTo.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "foo"}, cluster)).To(Succeed())
+	g.Expect(cluster.Spec.Paused).To(BeNil())
+
+	_, err = os.Stat(stateFile)
+	g.Expect(os.IsNotExist(err)).To(BeTrue())
+}
+
+func Test_objectMover_Cleanup(t *testing.T) {
+	t.Run("resumes the Clusters paused by the move", func(t *testing.T) {
+		g := NewWithT(t)
+
+		ctx := context.Background()
+
+		graph := getObjectGraphWithObjs(test.NewFakeCluster("ns1", "foo").WithPaused().Objs())
+		g.Expect(graph.getDiscoveryTypes(ctx)).To(Succeed())
+		g.Expect(graph.Discovery(ctx, "")).To(Succeed())
+
+		stateFile := filepath.Join(t.TempDir(), "move-state.yaml")
+		state, err := loadMoveState(stateFile)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(state.recordPausedClusters(graph.getClusters())).To(Succeed())
+		g.Expect(state.recordCreated(graph.getClusters()[0])).To(Succeed())
+
+		mover := objectMover{
+			fromProxy: graph.proxy,
+		}
+		g.Expect(mover.Cleanup(ctx, stateFile)).To(Succeed())
+
+		csFrom, err := graph.proxy.NewClient(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		cluster := &clusterv1.Cluster{}
+		g.Expect(csFrom.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "foo"}, cluster)).To(Succeed())
+		g.Expect(cluster.Spec.Paused).To(BeNil())
+
+		_, err = os.Stat(stateFile)
+		g.Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+	t.Run("fails if objects have been already deleted from the source cluster", func(t *testing.T) {
+		g := NewWithT(t)
+
+		ctx := context.Background()
+
+		graph := getObjectGraphWithObjs(test.NewFakeCluster("ns1", "foo").WithPaused().Objs())
+		g.Expect(graph.getDiscoveryTypes(ctx)).To(Succeed())
+		g.Expect(graph.Discovery(ctx, "")).To(Succeed())
+
+		stateFile := filepath.Join(t.TempDir(), "move-state.yaml")
+		state, err := loadMoveState(stateFile)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(state.recordPausedClusters(graph.getClusters())).To(Succeed())
+		g.Expect(state.recordDeleted(graph.getMoveNodes()[0])).To(Succeed())
+
+		mover := objectMover{
+			fromProxy: graph.proxy,
+		}
+		err = mover.Cleanup(ctx, stateFile)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("run move again with the same state file"))
+
+		_, err = os.Stat(stateFile)
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+}
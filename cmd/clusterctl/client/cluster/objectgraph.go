@@ -28,6 +28,8 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -46,6 +48,8 @@ import (
 const clusterTopologyNameKey = "cluster.spec.topology.class"
 const clusterTopologyNamespaceKey = "cluster.spec.topology.classNamespace"
 const clusterResourceSetBindingClusterNameKey = "clusterresourcesetbinding.spec.clustername"
+const clusterLabelsKey = "cluster.metadata.labels"
+const clusterIdentityRefKey = "cluster.spec.identityRef"
 
 type empty struct{}
 
@@ -154,13 +158,19 @@ func (n *node) captureAdditionalInformation(obj *unstructured.Unstructured) erro
 		if err := localScheme.Convert(obj, cluster, nil); err != nil {
 			return errors.Wrapf(err, "failed to convert object %s to Cluster", n.identityStr())
 		}
+		if n.additionalInfo == nil {
+			n.additionalInfo = map[string]interface{}{}
+		}
+		// Capture the labels of the cluster, so it is possible to filter clusters using a label selector.
+		n.additionalInfo[clusterLabelsKey] = labels.Set(cluster.GetLabels())
 		if cluster.Spec.Topology.IsDefined() {
-			if n.additionalInfo == nil {
-				n.additionalInfo = map[string]interface{}{}
-			}
 			n.additionalInfo[clusterTopologyNameKey] = cluster.GetClassKey().Name
 			n.additionalInfo[clusterTopologyNamespaceKey] = cluster.GetClassKey().Namespace
 		}
+		// Capture the identity of the cluster, so the credentials used by infrastructure providers are moved with the cluster.
+		if cluster.Spec.IdentityRef.IsDefined() {
+			n.additionalInfo[clusterIdentityRefKey] = cluster.Spec.IdentityRef
+		}
 	}
 
 	// If the node is a ClusterResourceSetBinding capture the name of the cluster it is referencing to.
@@ -741,6 +751,26 @@ func (o *objectGraph) setSoftOwnership() {
 		}
 	}
 
+	// Objects referenced by spec.identityRef of a Cluster are soft owned by that Cluster.
+	// NOTE: Only objects in the same namespace of the Cluster are considered; global identities are
+	// moved according to the move-hierarchy label of their CRD.
+	for _, cluster := range clusters {
+		identityRef, ok := cluster.additionalInfo[clusterIdentityRefKey].(clusterv1.ClusterIdentityReference)
+		if !ok {
+			continue
+		}
+
+		for _, node := range o.getNodes() {
+			if node.isGlobal || node.identity.Namespace != cluster.identity.Namespace {
+				continue
+			}
+			if node.identity.GroupVersionKind().GroupKind() == (schema.GroupKind{Group: identityRef.APIGroup, Kind: identityRef.Kind}) &&
+				node.identity.Name == identityRef.Name {
+				node.addSoftOwner(cluster)
+			}
+		}
+	}
+
 	crsBindings := o.getClusterResourceSetBinding()
 	// ClusterResourceSetBinding that refers to a Cluster are soft owned by that Cluster.
 	for _, binding := range crsBindings {
@@ -852,3 +882,234 @@ func (o *objectGraph) setShouldNotDeleteHierarchy(node *node) {
 		}
 	}
 }
+
+// isCluster returns true if the node is a Cluster.
+func (n *node) isCluster() bool {
+	return n.identity.GroupVersionKind().GroupKind() == clusterv1.GroupVersion.WithKind("Cluster").GroupKind()
+}
+
+// isClusterClass returns true if the node is a ClusterClass.
+func (n *node) isClusterClass() bool {
+	return n.identity.GroupVersionKind().GroupKind() == clusterv1.GroupVersion.WithKind("ClusterClass").GroupKind()
+}
+
+// matchesKind returns true if the node kind matches one of the given kinds, each one expressed as Kind or Kind.group.
+func (n *node) matchesKind(kinds []schema.GroupKind) bool {
+	gk := n.identity.GroupVersionKind().GroupKind()
+	for _, kind := range kinds {
+		if strings.EqualFold(kind.Kind, gk.Kind) && (kind.Group == "" || strings.EqualFold(kind.Group, gk.Group)) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyFilter removes from the graph the nodes excluded by the filter, so they are neither moved nor deleted from the source cluster:
+//   - When a cluster selector is set, the Clusters not matching the selector are excluded together with the objects belonging to them,
+//     and the same applies to ClusterClasses not used by any of the selected Clusters.
+//     Objects still used by the Clusters not selected for move, e.g. ClusterClasses or ClusterResourceSets, are moved but not deleted
+//     from the source cluster.
+//   - Objects with one of the excluded kinds are excluded, together with the objects belonging only to them.
+//
+// An error is returned if excluding objects by kind breaks the ownership chain of the objects being moved.
+func (o *objectGraph) applyFilter(filter MoveFilter) error {
+	excluded := map[*node]empty{}
+
+	if filter.ClusterSelector != nil {
+		o.filterClusters(filter.ClusterSelector, excluded)
+	}
+
+	excludedByKind := map[*node]empty{}
+	if len(filter.ExcludeKinds) > 0 {
+		excludeKinds := make([]schema.GroupKind, 0, len(filter.ExcludeKinds))
+		for _, kind := range filter.ExcludeKinds {
+			excludeKinds = append(excludeKinds, schema.ParseGroupKind(kind))
+		}
+		for _, node := range o.getNodes() {
+			if _, ok := excluded[node]; ok {
+				continue
+			}
+			if node.matchesKind(excludeKinds) {
+				excludedByKind[node] = empty{}
+				excluded[node] = empty{}
+			}
+		}
+
+		// Objects belonging only to excluded objects, e.g. the Secrets of an excluded identity, are excluded as well.
+		for _, node := range o.getNodes() {
+			if _, ok := excluded[node]; ok || len(node.tenant) == 0 {
+				continue
+			}
+			allTenantsExcluded := true
+			for tenant := range node.tenant {
+				if _, ok := excludedByKind[tenant]; !ok {
+					allTenantsExcluded = false
+					break
+				}
+			}
+			if allTenantsExcluded {
+				excludedByKind[node] = empty{}
+				excluded[node] = empty{}
+			}
+		}
+	}
+
+	if err := o.checkExcludedNodes(excludedByKind, excluded); err != nil {
+		return err
+	}
+
+	for node := range excluded {
+		o.removeNode(node)
+	}
+	return nil
+}
+
+// filterClusters adds to excluded the nodes belonging only to Clusters not matching the selector, and the ClusterClasses not used by
+// any of the selected Clusters together with their dependents.
+func (o *objectGraph) filterClusters(selector labels.Selector, excluded map[*node]empty) {
+	selectedClusters := map[*node]empty{}
+	for _, cluster := range o.getClusters() {
+		clusterLabels, _ := cluster.additionalInfo[clusterLabelsKey].(labels.Set)
+		if selector.Matches(clusterLabels) {
+			selectedClusters[cluster] = empty{}
+		}
+	}
+	hasUnselectedClusters := len(selectedClusters) < len(o.getClusters())
+
+	// ClusterClasses are soft owners of the Clusters using them.
+	excludedClusterClasses := map[*node]empty{}
+	sharedClusterClasses := map[*node]empty{}
+	for _, clusterClass := range o.getClusterClasses() {
+		usedBySelected, usedByUnselected := false, false
+		for _, cluster := range o.getClusters() {
+			if !cluster.isSoftOwnedBy(clusterClass) {
+				continue
+			}
+			if _, ok := selectedClusters[cluster]; ok {
+				usedBySelected = true
+			} else {
+				usedByUnselected = true
+			}
+		}
+		switch {
+		case !usedBySelected:
+			excludedClusterClasses[clusterClass] = empty{}
+		case usedByUnselected:
+			sharedClusterClasses[clusterClass] = empty{}
+		}
+	}
+
+	for _, node := range o.getNodes() {
+		// If the node belongs to Clusters, it is excluded when none of those Clusters is selected;
+		// nodes shared between selected and not selected Clusters are moved but not deleted.
+		belongsToSelectedCluster, belongsToUnselectedCluster := false, false
+		for tenant := range node.tenant {
+			if !tenant.isCluster() {
+				continue
+			}
+			if _, ok := selectedClusters[tenant]; ok {
+				belongsToSelectedCluster = true
+			} else {
+				belongsToUnselectedCluster = true
+			}
+		}
+		if belongsToSelectedCluster || belongsToUnselectedCluster {
+			switch {
+			case !belongsToSelectedCluster:
+				excluded[node] = empty{}
+			case belongsToUnselectedCluster:
+				node.shouldNotDelete = true
+			}
+			continue
+		}
+
+		// If the node belongs only to ClusterClasses not used by the selected Clusters, it is excluded;
+		// nodes still used by the Clusters not selected are moved but not deleted.
+		if len(node.tenant) > 0 {
+			belongsToExcluded, belongsToMoved, shared := false, false, false
+			for tenant := range node.tenant {
+				if _, ok := excludedClusterClasses[tenant]; ok {
+					belongsToExcluded = true
+					continue
+				}
+				belongsToMoved = true
+				if _, ok := sharedClusterClasses[tenant]; ok {
+					shared = true
+				}
+				// Tenants other than ClusterClasses, e.g. ClusterResourceSets, might be used by the Clusters not selected.
+				if !tenant.isClusterClass() && hasUnselectedClusters {
+					shared = true
+				}
+			}
+			switch {
+			case !belongsToMoved:
+				excluded[node] = empty{}
+			case belongsToExcluded || shared:
+				node.shouldNotDelete = true
+			}
+			continue
+		}
+
+		// Objects labeled for force move might be used by the Clusters not selected.
+		if node.forceMove && hasUnselectedClusters {
+			node.shouldNotDelete = true
+		}
+	}
+}
+
+// checkExcludedNodes checks that the nodes excluded by kind are not required by the nodes being moved, and that they
+// are not garbage collected when the nodes being moved are deleted from the source cluster.
+func (o *objectGraph) checkExcludedNodes(excludedByKind, excluded map[*node]empty) error {
+	isMovedAndDeleted := func(n *node) bool {
+		if _, ok := excluded[n]; ok {
+			return false
+		}
+		return (len(n.tenant) > 0 || n.forceMove) && !n.isGlobal && !n.isGlobalHierarchy && !n.shouldNotDelete
+	}
+
+	errList := []error{}
+	for _, node := range o.getMoveNodes() {
+		if _, ok := excluded[node]; ok {
+			continue
+		}
+		for owner := range node.owners {
+			if _, ok := excludedByKind[owner]; ok {
+				errList = append(errList, errors.Errorf("cannot exclude %s from move: it is an owner of %s", owner.identityStr(), node.identityStr()))
+			}
+		}
+		for owner := range node.softOwners {
+			if _, ok := excludedByKind[owner]; ok {
+				errList = append(errList, errors.Errorf("cannot exclude %s from move: %s depends on it", owner.identityStr(), node.identityStr()))
+			}
+		}
+	}
+
+	// Excluded nodes are left in the source cluster, so they must not be owned only by nodes deleted by move,
+	// otherwise they get garbage collected.
+	for node := range excludedByKind {
+		if len(node.owners) == 0 {
+			continue
+		}
+		ownedByDeletedNodesOnly := true
+		for owner := range node.owners {
+			if !isMovedAndDeleted(owner) {
+				ownedByDeletedNodesOnly = false
+				break
+			}
+		}
+		if ownedByDeletedNodesOnly {
+			errList = append(errList, errors.Errorf("cannot exclude %s from move: it would be garbage collected when its owners are deleted from the source cluster", node.identityStr()))
+		}
+	}
+	return kerrors.NewAggregate(errList)
+}
+
+// removeNode removes a node from the graph, including all the references to it from other nodes.
+func (o *objectGraph) removeNode(n *node) {
+	delete(o.uidToNode, n.identity.UID)
+	for _, other := range o.uidToNode {
+		delete(other.owners, n)
+		delete(other.softOwners, n)
+		delete(other.tenant, n)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -2245,6 +2246,40 @@ func Test_objectGraph_setSoftOwnership(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "A cluster with a soft owned identity secret",
+			fields: fields{
+				objs: test.NewFakeCluster("ns1", "cluster1").WithIdentitySecret("credentials").Objs(),
+			},
+			want: wantGraph{
+				nodes: map[string]wantGraphItem{
+					clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/cluster1": {
+						forceMove:          true,
+						forceMoveHierarchy: true,
+					},
+					clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureCluster, ns1/cluster1": {
+						owners: []string{
+							clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/cluster1",
+						},
+					},
+					"/v1, Kind=Secret, ns1/cluster1-ca": {
+						softOwners: []string{
+							clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/cluster1",
+						},
+					},
+					"/v1, Kind=Secret, ns1/cluster1-kubeconfig": {
+						owners: []string{
+							clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/cluster1",
+						},
+					},
+					"/v1, Kind=Secret, ns1/credentials": { // the identity secret does not follow the naming convention, but it is referenced by spec.identityRef, so it should be identified as a soft ownership
+						softOwners: []string{
+							clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/cluster1",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "A ClusterClass with a soft owned Cluster",
 			fields: fields{
@@ -2763,6 +2798,216 @@ func Test_objectGraph_setGlobalIdentityTenants(t *testing.T) {
 	}
 }
 
+func Test_objectGraph_applyFilter(t *testing.T) {
+	prod := map[string]string{"env": "prod"}
+	dev := map[string]string{"env": "dev"}
+
+	tests := []struct {
+		name                string
+		objs                func() []client.Object
+		filter              MoveFilter
+		wantMoveNodes       []string
+		wantShouldNotDelete []string
+		wantErr             bool
+	}{
+		{
+			name: "No filter",
+			objs: func() []client.Object {
+				return test.NewFakeCluster("ns1", "foo").Objs()
+			},
+			filter: MoveFilter{},
+			wantMoveNodes: []string{
+				clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/foo",
+				clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureCluster, ns1/foo",
+				"/v1, Kind=Secret, ns1/foo-ca",
+				"/v1, Kind=Secret, ns1/foo-kubeconfig",
+			},
+		},
+		{
+			name: "Cluster selector, only the matching Clusters are moved",
+			objs: func() []client.Object {
+				objs := test.NewFakeCluster("ns1", "foo").WithLabels(prod).Objs()
+				objs = append(objs, test.NewFakeCluster("ns1", "bar").WithLabels(dev).Objs()...)
+				return objs
+			},
+			filter: MoveFilter{ClusterSelector: labels.SelectorFromSet(prod)},
+			wantMoveNodes: []string{
+				clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/foo",
+				clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureCluster, ns1/foo",
+				"/v1, Kind=Secret, ns1/foo-ca",
+				"/v1, Kind=Secret, ns1/foo-kubeconfig",
+			},
+		},
+		{
+			name: "Cluster selector, objects shared with Clusters not selected are moved but not deleted",
+			objs: func() []client.Object {
+				sharedInfrastructureTemplate := test.NewFakeInfrastructureTemplate("shared")
+				objs := []client.Object{sharedInfrastructureTemplate}
+				objs = append(objs, test.NewFakeCluster("ns1", "cluster1").WithLabels(prod).
+					WithMachineSets(
+						test.NewFakeMachineSet("cluster1-ms1").
+							WithInfrastructureTemplate(sharedInfrastructureTemplate).
+							WithMachines(test.NewFakeMachine("cluster1-m1")),
+					).Objs()...)
+				objs = append(objs, test.NewFakeCluster("ns1", "cluster2").WithLabels(dev).
+					WithMachineSets(
+						test.NewFakeMachineSet("cluster2-ms1").
+							WithInfrastructureTemplate(sharedInfrastructureTemplate).
+							WithMachines(test.NewFakeMachine("cluster2-m1")),
+					).Objs()...)
+				return objs
+			},
+			filter: MoveFilter{ClusterSelector: labels.SelectorFromSet(prod)},
+			wantMoveNodes: []string{
+				clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/cluster1",
+				clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureCluster, ns1/cluster1",
+				"/v1, Kind=Secret, ns1/cluster1-ca",
+				"/v1, Kind=Secret, ns1/cluster1-kubeconfig",
+				clusterv1.GroupVersion.String() + ", Kind=MachineSet, ns1/cluster1-ms1",
+				clusterv1.GroupVersionBootstrap.String() + ", Kind=GenericBootstrapConfigTemplate, ns1/cluster1-ms1",
+				clusterv1.GroupVersion.String() + ", Kind=Machine, ns1/cluster1-m1",
+				clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureMachine, ns1/cluster1-m1",
+				clusterv1.GroupVersionBootstrap.String() + ", Kind=GenericBootstrapConfig, ns1/cluster1-m1",
+				"/v1, Kind=Secret, ns1/cluster1-m1",
+				clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureMachineTemplate, ns1/shared",
+			},
+			wantShouldNotDelete: []string{
+				clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureMachineTemplate, ns1/shared",
+			},
+		},
+		{
+			name: "Cluster selector, ClusterClasses used by Clusters not selected are moved but not deleted, unused ClusterClasses are not moved",
+			objs: func() []client.Object {
+				objs := test.NewFakeClusterClass("ns1", "class1").Objs()
+				objs = append(objs, test.NewFakeClusterClass("ns1", "class2").Objs()...)
+				objs = append(objs, test.NewFakeCluster("ns1", "foo").WithLabels(prod).WithTopologyClass("class1").Objs()...)
+				objs = append(objs, test.NewFakeCluster("ns1", "bar").WithLabels(dev).WithTopologyClass("class1").Objs()...)
+				objs = append(objs, test.NewFakeCluster("ns1", "baz").WithLabels(dev).WithTopologyClass("class2").Objs()...)
+				return deduplicateObjects(objs)
+			},
+			filter: MoveFilter{ClusterSelector: labels.SelectorFromSet(prod)},
+			wantMoveNodes: []string{
+				clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/foo",
+				clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureCluster, ns1/foo",
+				"/v1, Kind=Secret, ns1/foo-ca",
+				"/v1, Kind=Secret, ns1/foo-kubeconfig",
+				clusterv1.GroupVersion.String() + ", Kind=ClusterClass, ns1/class1",
+				clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureClusterTemplate, ns1/class1",
+				clusterv1.GroupVersionControlPlane.String() + ", Kind=GenericControlPlaneTemplate, ns1/class1",
+			},
+			wantShouldNotDelete: []string{
+				clusterv1.GroupVersion.String() + ", Kind=ClusterClass, ns1/class1",
+				clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureClusterTemplate, ns1/class1",
+				clusterv1.GroupVersionControlPlane.String() + ", Kind=GenericControlPlaneTemplate, ns1/class1",
+			},
+		},
+		{
+			name: "Cluster selector, ClusterResourceSets are moved but not deleted",
+			objs: func() []client.Object {
+				objs := test.NewFakeCluster("ns1", "foo").WithLabels(prod).Objs()
+				objs = append(objs, test.NewFakeCluster("ns1", "bar").WithLabels(dev).Objs()...)
+				objs = append(objs, test.NewFakeClusterResourceSet("ns1", "crs1").
+					WithSecret("resource-s1").
+					ApplyToCluster(test.SelectClusterObj(objs, "ns1", "foo")).
+					ApplyToCluster(test.SelectClusterObj(objs, "ns1", "bar")).
+					Objs()...)
+				return objs
+			},
+			filter: MoveFilter{ClusterSelector: labels.SelectorFromSet(prod)},
+			wantMoveNodes: []string{
+				clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/foo",
+				clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureCluster, ns1/foo",
+				"/v1, Kind=Secret, ns1/foo-ca",
+				"/v1, Kind=Secret, ns1/foo-kubeconfig",
+				addonsv1.GroupVersion.String() + ", Kind=ClusterResourceSet, ns1/crs1",
+				addonsv1.GroupVersion.String() + ", Kind=ClusterResourceSetBinding, ns1/foo",
+				"/v1, Kind=Secret, ns1/resource-s1",
+			},
+			wantShouldNotDelete: []string{
+				addonsv1.GroupVersion.String() + ", Kind=ClusterResourceSet, ns1/crs1",
+				"/v1, Kind=Secret, ns1/resource-s1",
+			},
+		},
+		{
+			name: "Exclude kinds, objects belonging only to excluded objects are excluded as well",
+			objs: func() []client.Object {
+				objs := test.NewFakeCluster("ns1", "foo").Objs()
+				objs = append(objs, test.NewFakeClusterInfrastructureIdentity("infra1-identity").WithSecretIn("infra1-system").Objs()...)
+				return objs
+			},
+			filter: MoveFilter{ExcludeKinds: []string{"GenericClusterInfrastructureIdentity"}},
+			wantMoveNodes: []string{
+				clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/foo",
+				clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureCluster, ns1/foo",
+				"/v1, Kind=Secret, ns1/foo-ca",
+				"/v1, Kind=Secret, ns1/foo-kubeconfig",
+			},
+		},
+		{
+			name: "Exclude kinds, fails if excluded objects own objects being moved",
+			objs: func() []client.Object {
+				return test.NewFakeCluster("ns1", "foo").
+					WithMachineSets(
+						test.NewFakeMachineSet("foo-ms1").
+							WithMachines(test.NewFakeMachine("foo-m1")),
+					).Objs()
+			},
+			filter:  MoveFilter{ExcludeKinds: []string{"MachineSet.cluster.x-k8s.io"}},
+			wantErr: true,
+		},
+		{
+			name: "Exclude kinds, fails if excluded objects are owned only by objects being moved",
+			objs: func() []client.Object {
+				return test.NewFakeCluster("ns1", "foo").Objs()
+			},
+			filter:  MoveFilter{ExcludeKinds: []string{"GenericInfrastructureCluster"}},
+			wantErr: true,
+		},
+		{
+			name: "Exclude kinds, kinds in other groups are not excluded",
+			objs: func() []client.Object {
+				return test.NewFakeCluster("ns1", "foo").Objs()
+			},
+			filter: MoveFilter{ExcludeKinds: []string{"GenericInfrastructureCluster.example.com"}},
+			wantMoveNodes: []string{
+				clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/foo",
+				clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureCluster, ns1/foo",
+				"/v1, Kind=Secret, ns1/foo-ca",
+				"/v1, Kind=Secret, ns1/foo-kubeconfig",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			graph := getObjectGraphWithObjs(tt.objs())
+			g.Expect(graph.getDiscoveryTypes(ctx)).To(Succeed())
+			g.Expect(graph.Discovery(ctx, "")).To(Succeed())
+
+			err := graph.applyFilter(tt.filter)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			gotMoveNodes := []string{}
+			gotShouldNotDelete := []string{}
+			for _, node := range graph.getMoveNodes() {
+				gotMoveNodes = append(gotMoveNodes, string(node.identity.UID))
+				if node.shouldNotDelete {
+					gotShouldNotDelete = append(gotShouldNotDelete, string(node.identity.UID))
+				}
+			}
+			g.Expect(gotMoveNodes).To(ConsistOf(tt.wantMoveNodes))
+			g.Expect(gotShouldNotDelete).To(ConsistOf(tt.wantShouldNotDelete))
+		})
+	}
+}
+
 func deduplicateObjects(objs []client.Object) []client.Object {
 	res := []client.Object{}
 	uniqueObjectKeys := sets.Set[string]{}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/ownerrefs"
+)
+
+// OwnerRefsRepairOptions carries the options supported by OwnerRefsRepairer.Repair.
+type OwnerRefsRepairOptions struct {
+	// Namespace where the Clusters live. If empty, Clusters in all the namespaces are checked.
+	Namespace string
+
+	// ClusterName is the name of the Cluster to check. If empty, all the Clusters are checked.
+	ClusterName string
+
+	// DryRun defines if inconsistencies should only be reported, without repairing them.
+	DryRun bool
+}
+
+// OwnerRefsReport is the report of the ownerReferences and finalizers inconsistencies for a set of Clusters.
+type OwnerRefsReport struct {
+	Clusters []ClusterOwnerRefsReport `json:"clusters"`
+}
+
+// ClusterOwnerRefsReport is the report of the ownerReferences and finalizers inconsistencies for a Cluster.
+type ClusterOwnerRefsReport struct {
+	// Namespace of the Cluster.
+	Namespace string `json:"namespace"`
+
+	// Name of the Cluster.
+	Name string `json:"name"`
+
+	// Issues lists the inconsistencies found in the objects of the Cluster.
+	Issues []ownerrefs.Issue `json:"issues,omitempty"`
+}
+
+// OwnerRefsRepairer has methods for checking and repairing the ownerReferences and finalizers
+// of the objects of the Clusters in a management cluster.
+type OwnerRefsRepairer interface {
+	// Repair checks the Clusters matching the given options and repairs the inconsistencies
+	// that can be repaired automatically, unless DryRun is set.
+	Repair(ctx context.Context, options OwnerRefsRepairOptions) (*OwnerRefsReport, error)
+}
+
+// ownerRefsRepairer implements OwnerRefsRepairer.
+type ownerRefsRepairer struct {
+	proxy Proxy
+}
+
+// ensure ownerRefsRepairer implements OwnerRefsRepairer.
+var _ OwnerRefsRepairer = &ownerRefsRepairer{}
+
+// newOwnerRefsRepairer returns an ownerRefsRepairer.
+func newOwnerRefsRepairer(proxy Proxy) *ownerRefsRepairer {
+	return &ownerRefsRepairer{
+		proxy: proxy,
+	}
+}
+
+func (r *ownerRefsRepairer) Repair(ctx context.Context, options OwnerRefsRepairOptions) (*OwnerRefsReport, error) {
+	c, err := r.proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := []clusterv1.Cluster{}
+	if options.ClusterName != "" {
+		cluster := &clusterv1.Cluster{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: options.Namespace, Name: options.ClusterName}, cluster); err != nil {
+			return nil, errors.Wrapf(err, "failed to get Cluster %s/%s", options.Namespace, options.ClusterName)
+		}
+		clusters = append(clusters, *cluster)
+	} else {
+		clusterList := &clusterv1.ClusterList{}
+		if err := c.List(ctx, clusterList, client.InNamespace(options.Namespace)); err != nil {
+			return nil, errors.Wrap(err, "failed to list Clusters")
+		}
+		clusters = clusterList.Items
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Namespace != clusters[j].Namespace {
+			return clusters[i].Namespace < clusters[j].Namespace
+		}
+		return clusters[i].Name < clusters[j].Name
+	})
+
+	checker := &ownerrefs.Checker{Client: c, Repair: !options.DryRun}
+	report := &OwnerRefsReport{Clusters: []ClusterOwnerRefsReport{}}
+	for i := range clusters {
+		issues, err := checker.CheckCluster(ctx, &clusters[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to check Cluster %s/%s", clusters[i].Namespace, clusters[i].Name)
+		}
+		report.Clusters = append(report.Clusters, ClusterOwnerRefsReport{
+			Namespace: clusters[i].Namespace,
+			Name:      clusters[i].Name,
+			Issues:    issues,
+		})
+	}
+	return report, nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	"sigs.k8s.io/cluster-api/internal/ownerrefs"
+)
+
+func Test_OwnerRefsRepairer_Repair(t *testing.T) {
+	newObjs := func() []client.Object {
+		return []client.Object{
+			&clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "cluster1", UID: "cluster1-uid", Finalizers: []string{clusterv1.ClusterFinalizer}},
+			},
+			&clusterv1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns1",
+					Name:      "md1",
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: "cluster1"},
+				},
+			},
+			&clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "cluster2", UID: "cluster2-uid", Finalizers: []string{clusterv1.ClusterFinalizer}},
+			},
+		}
+	}
+	md1Issues := []ownerrefs.Issue{
+		{Kind: "MachineDeployment", Name: "md1", Message: "ownerReference to Cluster cluster1 is missing", Repairable: true},
+		{Kind: "MachineDeployment", Name: "md1", Message: "finalizer cluster.x-k8s.io/machinedeployment is missing", Repairable: true},
+	}
+	repairedMD1Issues := []ownerrefs.Issue{
+		{Kind: "MachineDeployment", Name: "md1", Message: "ownerReference to Cluster cluster1 is missing", Repairable: true, Repaired: true},
+		{Kind: "MachineDeployment", Name: "md1", Message: "finalizer cluster.x-k8s.io/machinedeployment is missing", Repairable: true, Repaired: true},
+	}
+
+	tests := []struct {
+		name    string
+		options OwnerRefsRepairOptions
+		want    *OwnerRefsReport
+		wantErr bool
+	}{
+		{
+			name:    "reports inconsistencies in all the Clusters in dry run",
+			options: OwnerRefsRepairOptions{DryRun: true},
+			want: &OwnerRefsReport{Clusters: []ClusterOwnerRefsReport{
+				{Namespace: "ns1", Name: "cluster1", Issues: md1Issues},
+				{Namespace: "ns2", Name: "cluster2"},
+			}},
+		},
+		{
+			name:    "repairs inconsistencies in a Cluster",
+			options: OwnerRefsRepairOptions{Namespace: "ns1", ClusterName: "cluster1"},
+			want: &OwnerRefsReport{Clusters: []ClusterOwnerRefsReport{
+				{Namespace: "ns1", Name: "cluster1", Issues: repairedMD1Issues},
+			}},
+		},
+		{
+			name:    "fails if the Cluster does not exist",
+			options: OwnerRefsRepairOptions{Namespace: "ns1", ClusterName: "cluster3"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := newOwnerRefsRepairer(test.NewFakeProxy().WithObjs(newObjs()...))
+			got, err := r.Repair(context.Background(), tt.options)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(BeComparableTo(tt.want))
+		})
+	}
+}
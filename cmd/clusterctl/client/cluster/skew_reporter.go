@@ -0,0 +1,258 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/blang/semver/v4"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/external"
+	"sigs.k8s.io/cluster-api/internal/contract"
+	"sigs.k8s.io/cluster-api/util/version"
+)
+
+// MaxKubeletVersionSkew is the max number of minor versions a kubelet can be older than the control plane,
+// as defined by the Kubernetes version skew policy (https://kubernetes.io/releases/version-skew-policy/).
+const MaxKubeletVersionSkew = 3
+
+// SkewReportOptions carries the options supported by SkewReporter.Report.
+type SkewReportOptions struct {
+	// Namespace where the Clusters live. If empty, Clusters in all the namespaces are reported.
+	Namespace string
+
+	// ClusterName is the name of the Cluster to report. If empty, all the Clusters are reported.
+	ClusterName string
+}
+
+// SkewReport is the Kubernetes version skew report for a set of Clusters.
+type SkewReport struct {
+	Clusters []ClusterSkewReport `json:"clusters"`
+}
+
+// ClusterSkewReport is the Kubernetes version skew report for a Cluster.
+type ClusterSkewReport struct {
+	// Namespace of the Cluster.
+	Namespace string `json:"namespace"`
+
+	// Name of the Cluster.
+	Name string `json:"name"`
+
+	// ControlPlaneVersion is the version defined in the control plane spec.
+	// If the control plane does not define a version, the version defined in the Cluster topology is used.
+	ControlPlaneVersion string `json:"controlPlaneVersion,omitempty"`
+
+	// WorkerVersions are the versions defined by the MachineDeployments and MachinePools of the Cluster.
+	WorkerVersions []ObjectVersion `json:"workerVersions,omitempty"`
+
+	// KubeletVersions are the kubelet versions reported in the NodeInfo of the Machines of the Cluster.
+	KubeletVersions []ObjectVersion `json:"kubeletVersions,omitempty"`
+
+	// Violations lists the versions that are not within the supported skew from the control plane version.
+	Violations []SkewViolation `json:"violations,omitempty"`
+}
+
+// ObjectVersion is the Kubernetes version of an object.
+type ObjectVersion struct {
+	// Kind of the object, e.g. MachineDeployment, MachinePool or Machine.
+	Kind string `json:"kind"`
+
+	// Name of the object.
+	Name string `json:"name"`
+
+	// Version of the object.
+	Version string `json:"version"`
+}
+
+// SkewViolation describes a version which is not within the supported skew from the control plane version.
+type SkewViolation struct {
+	ObjectVersion `json:",inline"`
+
+	// Message describes the violation.
+	Message string `json:"message"`
+}
+
+// SkewReporter has methods for reporting the Kubernetes version skew of the Clusters in a management cluster.
+type SkewReporter interface {
+	// Report returns the version skew report for the Clusters matching the given options.
+	Report(ctx context.Context, options SkewReportOptions) (*SkewReport, error)
+}
+
+// skewReporter implements SkewReporter.
+type skewReporter struct {
+	proxy Proxy
+}
+
+// ensure skewReporter implements SkewReporter.
+var _ SkewReporter = &skewReporter{}
+
+// newSkewReporter returns a skewReporter.
+func newSkewReporter(proxy Proxy) *skewReporter {
+	return &skewReporter{
+		proxy: proxy,
+	}
+}
+
+func (r *skewReporter) Report(ctx context.Context, options SkewReportOptions) (*SkewReport, error) {
+	c, err := r.proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := []clusterv1.Cluster{}
+	if options.ClusterName != "" {
+		cluster := &clusterv1.Cluster{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: options.Namespace, Name: options.ClusterName}, cluster); err != nil {
+			return nil, errors.Wrapf(err, "failed to get Cluster %s/%s", options.Namespace, options.ClusterName)
+		}
+		clusters = append(clusters, *cluster)
+	} else {
+		clusterList := &clusterv1.ClusterList{}
+		if err := c.List(ctx, clusterList, client.InNamespace(options.Namespace)); err != nil {
+			return nil, errors.Wrap(err, "failed to list Clusters")
+		}
+		clusters = clusterList.Items
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Namespace != clusters[j].Namespace {
+			return clusters[i].Namespace < clusters[j].Namespace
+		}
+		return clusters[i].Name < clusters[j].Name
+	})
+
+	report := &SkewReport{Clusters: []ClusterSkewReport{}}
+	for i := range clusters {
+		clusterReport, err := reportClusterSkew(ctx, c, &clusters[i])
+		if err != nil {
+			return nil, err
+		}
+		report.Clusters = append(report.Clusters, *clusterReport)
+	}
+	return report, nil
+}
+
+// reportClusterSkew computes the version skew report for a Cluster.
+func reportClusterSkew(ctx context.Context, c client.Client, cluster *clusterv1.Cluster) (*ClusterSkewReport, error) {
+	report := &ClusterSkewReport{
+		Namespace: cluster.Namespace,
+		Name:      cluster.Name,
+	}
+
+	if cluster.Spec.ControlPlaneRef.IsDefined() {
+		controlPlane, err := external.GetObjectFromContractVersionedRef(ctx, c, cluster.Spec.ControlPlaneRef, cluster.Namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get control plane for Cluster %s/%s", cluster.Namespace, cluster.Name)
+		}
+		// Note: not all the control planes define a version, e.g. managed control planes.
+		if controlPlaneVersion, err := contract.ControlPlane().Version().Get(controlPlane); err == nil {
+			report.ControlPlaneVersion = *controlPlaneVersion
+		}
+	}
+	if report.ControlPlaneVersion == "" {
+		report.ControlPlaneVersion = cluster.Spec.Topology.Version
+	}
+
+	listOptions := []client.ListOption{
+		client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name},
+	}
+
+	machineDeploymentList := &clusterv1.MachineDeploymentList{}
+	if err := c.List(ctx, machineDeploymentList, listOptions...); err != nil {
+		return nil, errors.Wrapf(err, "failed to list MachineDeployments for Cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+	for _, md := range machineDeploymentList.Items {
+		if md.Spec.Template.Spec.Version == "" {
+			continue
+		}
+		report.WorkerVersions = append(report.WorkerVersions, ObjectVersion{Kind: "MachineDeployment", Name: md.Name, Version: md.Spec.Template.Spec.Version})
+	}
+
+	machinePoolList := &clusterv1.MachinePoolList{}
+	if err := c.List(ctx, machinePoolList, listOptions...); err != nil {
+		return nil, errors.Wrapf(err, "failed to list MachinePools for Cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+	for _, mp := range machinePoolList.Items {
+		if mp.Spec.Template.Spec.Version == "" {
+			continue
+		}
+		report.WorkerVersions = append(report.WorkerVersions, ObjectVersion{Kind: "MachinePool", Name: mp.Name, Version: mp.Spec.Template.Spec.Version})
+	}
+
+	machineList := &clusterv1.MachineList{}
+	if err := c.List(ctx, machineList, listOptions...); err != nil {
+		return nil, errors.Wrapf(err, "failed to list Machines for Cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+	for _, m := range machineList.Items {
+		if m.Status.NodeInfo == nil || m.Status.NodeInfo.KubeletVersion == "" {
+			continue
+		}
+		report.KubeletVersions = append(report.KubeletVersions, ObjectVersion{Kind: "Machine", Name: m.Name, Version: m.Status.NodeInfo.KubeletVersion})
+	}
+
+	sortObjectVersions(report.WorkerVersions)
+	sortObjectVersions(report.KubeletVersions)
+
+	if report.ControlPlaneVersion == "" {
+		return report, nil
+	}
+	controlPlaneVersion, err := version.ParseMajorMinorPatchTolerant(report.ControlPlaneVersion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse control plane version %q for Cluster %s/%s", report.ControlPlaneVersion, cluster.Namespace, cluster.Name)
+	}
+	for _, objectVersions := range [][]ObjectVersion{report.WorkerVersions, report.KubeletVersions} {
+		for _, objectVersion := range objectVersions {
+			if message := checkVersionSkew(controlPlaneVersion, objectVersion.Version); message != "" {
+				report.Violations = append(report.Violations, SkewViolation{ObjectVersion: objectVersion, Message: message})
+			}
+		}
+	}
+	return report, nil
+}
+
+// checkVersionSkew returns a message if the given version is not within the supported skew from the control plane version.
+func checkVersionSkew(controlPlaneVersion semver.Version, v string) string {
+	objectVersion, err := version.ParseMajorMinorPatchTolerant(v)
+	if err != nil {
+		return fmt.Sprintf("version %q cannot be parsed", v)
+	}
+	if objectVersion.Major != controlPlaneVersion.Major {
+		return fmt.Sprintf("version %s has a different major version than control plane version v%s", v, controlPlaneVersion)
+	}
+	if objectVersion.Minor > controlPlaneVersion.Minor {
+		return fmt.Sprintf("version %s is newer than control plane version v%s", v, controlPlaneVersion)
+	}
+	if controlPlaneVersion.Minor-objectVersion.Minor > MaxKubeletVersionSkew {
+		return fmt.Sprintf("version %s is more than %d minor versions older than control plane version v%s", v, MaxKubeletVersionSkew, controlPlaneVersion)
+	}
+	return ""
+}
+
+func sortObjectVersions(objectVersions []ObjectVersion) {
+	sort.Slice(objectVersions, func(i, j int) bool {
+		if objectVersions[i].Kind != objectVersions[j].Kind {
+			return objectVersions[i].Kind < objectVersions[j].Kind
+		}
+		return objectVersions[i].Name < objectVersions[j].Name
+	})
+}
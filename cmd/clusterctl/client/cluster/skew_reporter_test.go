@@ -0,0 +1,211 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	fakecontrolplane "sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test/providers/controlplane"
+)
+
+func Test_SkewReporter_Report(t *testing.T) {
+	cluster1 := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "cluster1"},
+		Spec: clusterv1.ClusterSpec{
+			ControlPlaneRef: clusterv1.ContractVersionedObjectReference{
+				APIGroup: fakecontrolplane.GroupVersion.Group,
+				Kind:     "GenericControlPlane",
+				Name:     "cp1",
+			},
+			Topology: clusterv1.Topology{
+				ClassRef: clusterv1.ClusterClassRef{Name: "class"},
+				Version:  "v1.33.1",
+			},
+		},
+	}
+	controlPlane1 := &fakecontrolplane.GenericControlPlane{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: fakecontrolplane.GroupVersion.String(),
+			Kind:       "GenericControlPlane",
+		},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "cp1"},
+	}
+	cluster2 := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "cluster2"},
+	}
+
+	md := &clusterv1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "md", Labels: map[string]string{clusterv1.ClusterNameLabel: "cluster1"}},
+		Spec: clusterv1.MachineDeploymentSpec{
+			Template: clusterv1.MachineTemplateSpec{Spec: clusterv1.MachineSpec{Version: "v1.29.0"}},
+		},
+	}
+	mp := &clusterv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "mp", Labels: map[string]string{clusterv1.ClusterNameLabel: "cluster1"}},
+		Spec: clusterv1.MachinePoolSpec{
+			Template: clusterv1.MachineTemplateSpec{Spec: clusterv1.MachineSpec{Version: "v1.33.0"}},
+		},
+	}
+	m1 := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "m1", Labels: map[string]string{clusterv1.ClusterNameLabel: "cluster1"}},
+		Status:     clusterv1.MachineStatus{NodeInfo: &corev1.NodeSystemInfo{KubeletVersion: "v1.34.0"}},
+	}
+	m2 := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "m2", Labels: map[string]string{clusterv1.ClusterNameLabel: "cluster1"}},
+		Status:     clusterv1.MachineStatus{NodeInfo: &corev1.NodeSystemInfo{KubeletVersion: "v1.33.1"}},
+	}
+	m3 := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "m3", Labels: map[string]string{clusterv1.ClusterNameLabel: "cluster1"}},
+	}
+
+	objs := []client.Object{cluster1, controlPlane1, cluster2, md, mp, m1, m2, m3}
+	for _, crd := range test.FakeCRDList() {
+		objs = append(objs, crd)
+	}
+
+	cluster1Report := ClusterSkewReport{
+		Namespace:           "ns1",
+		Name:                "cluster1",
+		ControlPlaneVersion: "v1.33.1",
+		WorkerVersions: []ObjectVersion{
+			{Kind: "MachineDeployment", Name: "md", Version: "v1.29.0"},
+			{Kind: "MachinePool", Name: "mp", Version: "v1.33.0"},
+		},
+		KubeletVersions: []ObjectVersion{
+			{Kind: "Machine", Name: "m1", Version: "v1.34.0"},
+			{Kind: "Machine", Name: "m2", Version: "v1.33.1"},
+		},
+		Violations: []SkewViolation{
+			{
+				ObjectVersion: ObjectVersion{Kind: "MachineDeployment", Name: "md", Version: "v1.29.0"},
+				Message:       "version v1.29.0 is more than 3 minor versions older than control plane version v1.33.1",
+			},
+			{
+				ObjectVersion: ObjectVersion{Kind: "Machine", Name: "m1", Version: "v1.34.0"},
+				Message:       "version v1.34.0 is newer than control plane version v1.33.1",
+			},
+		},
+	}
+	cluster2Report := ClusterSkewReport{
+		Namespace: "ns2",
+		Name:      "cluster2",
+	}
+
+	tests := []struct {
+		name      string
+		options   SkewReportOptions
+		want      *SkewReport
+		expectErr bool
+	}{
+		{
+			name:    "report all the Clusters",
+			options: SkewReportOptions{},
+			want:    &SkewReport{Clusters: []ClusterSkewReport{cluster1Report, cluster2Report}},
+		},
+		{
+			name:    "report Clusters in a namespace",
+			options: SkewReportOptions{Namespace: "ns2"},
+			want:    &SkewReport{Clusters: []ClusterSkewReport{cluster2Report}},
+		},
+		{
+			name:    "report a Cluster",
+			options: SkewReportOptions{Namespace: "ns1", ClusterName: "cluster1"},
+			want:    &SkewReport{Clusters: []ClusterSkewReport{cluster1Report}},
+		},
+		{
+			name:      "fails if the Cluster does not exist",
+			options:   SkewReportOptions{Namespace: "ns1", ClusterName: "does-not-exist"},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := newSkewReporter(test.NewFakeProxy().WithObjs(objs...))
+			got, err := r.Report(context.Background(), tt.options)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(BeComparableTo(tt.want))
+		})
+	}
+}
+
+func Test_checkVersionSkew(t *testing.T) {
+	controlPlaneVersion := semver.MustParse("1.33.2")
+
+	tests := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{
+			name:    "same version",
+			version: "v1.33.2",
+			want:    "",
+		},
+		{
+			name:    "older patch version",
+			version: "v1.33.0",
+			want:    "",
+		},
+		{
+			name:    "3 minor versions older",
+			version: "v1.30.5",
+			want:    "",
+		},
+		{
+			name:    "more than 3 minor versions older",
+			version: "v1.29.5",
+			want:    "version v1.29.5 is more than 3 minor versions older than control plane version v1.33.2",
+		},
+		{
+			name:    "newer minor version",
+			version: "v1.34.0",
+			want:    "version v1.34.0 is newer than control plane version v1.33.2",
+		},
+		{
+			name:    "different major version",
+			version: "v2.33.0",
+			want:    "version v2.33.0 has a different major version than control plane version v1.33.2",
+		},
+		{
+			name:    "invalid version",
+			version: "foo",
+			want:    "version \"foo\" cannot be parsed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(checkVersionSkew(controlPlaneVersion, tt.version)).To(Equal(tt.want))
+		})
+	}
+}
@@ -32,9 +32,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/repository"
 	yaml "sigs.k8s.io/cluster-api/cmd/clusterctl/client/yamlprocessor"
+	"sigs.k8s.io/cluster-api/internal/clustertemplate"
 )
 
 // TemplateClient has methods to work with templates stored in the cluster/out of the provider repository.
@@ -42,6 +45,12 @@ type TemplateClient interface {
 	// GetFromConfigMap returns a workload cluster template from the given ConfigMap.
 	GetFromConfigMap(ctx context.Context, namespace, name, dataKey, targetNamespace string, skipTemplateProcess bool) (repository.Template, error)
 
+	// GetFromClusterTemplate returns a workload cluster template from the given ClusterTemplate.
+	GetFromClusterTemplate(ctx context.Context, namespace, name, targetNamespace string, skipTemplateProcess bool) (repository.Template, error)
+
+	// GetFromClusterClass returns a workload cluster template with a Cluster using the given ClusterClass.
+	GetFromClusterClass(ctx context.Context, input GetFromClusterClassInput) (repository.Template, error)
+
 	// GetFromURL returns a workload cluster template from the given URL.
 	GetFromURL(ctx context.Context, templateURL, targetNamespace string, skipTemplateProcess bool) (repository.Template, error)
 }
@@ -113,6 +122,70 @@ func (t *templateClient) GetFromConfigMap(ctx context.Context, configMapNamespac
 	})
 }
 
+func (t *templateClient) GetFromClusterTemplate(ctx context.Context, clusterTemplateNamespace, clusterTemplateName, targetNamespace string, skipTemplateProcess bool) (repository.Template, error) {
+	if clusterTemplateNamespace == "" {
+		return nil, errors.New("invalid GetFromClusterTemplate operation: missing clusterTemplateNamespace value")
+	}
+	if clusterTemplateName == "" {
+		return nil, errors.New("invalid GetFromClusterTemplate operation: missing clusterTemplateName value")
+	}
+
+	c, err := t.proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterTemplate := &clusterv1.ClusterTemplate{}
+	key := client.ObjectKey{
+		Namespace: clusterTemplateNamespace,
+		Name:      clusterTemplateName,
+	}
+
+	if err := c.Get(ctx, key, clusterTemplate); err != nil {
+		return nil, errors.Wrapf(err, "error reading ClusterTemplate %s/%s", clusterTemplateNamespace, clusterTemplateName)
+	}
+
+	variablesClient := t.configClient.Variables()
+	if !skipTemplateProcess {
+		// Validates the parameters of the ClusterTemplate against the values from the clusterctl config variables,
+		// and uses the resolved values (including defaults) when processing the template.
+		values := map[string]string{}
+		for _, p := range clusterTemplate.Spec.Parameters {
+			if value, err := variablesClient.Get(p.Name); err == nil {
+				values[p.Name] = value
+			}
+		}
+		resolved, err := clustertemplate.ResolveParameters(clusterTemplate.Spec.Parameters, values)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid parameters for ClusterTemplate %s/%s", clusterTemplateNamespace, clusterTemplateName)
+		}
+		resolved[clusterv1.ClusterTemplateNamespaceParameter] = targetNamespace
+		variablesClient = &clusterTemplateVariablesClient{VariablesClient: variablesClient, values: resolved}
+	}
+
+	return repository.NewTemplate(repository.TemplateInput{
+		RawArtifact:           []byte(clusterTemplate.Spec.Template),
+		ConfigVariablesClient: variablesClient,
+		Processor:             t.processor,
+		TargetNamespace:       targetNamespace,
+		SkipTemplateProcess:   skipTemplateProcess,
+	})
+}
+
+// clusterTemplateVariablesClient is a config.VariablesClient which returns the resolved values of the
+// ClusterTemplate parameters, falling back to the clusterctl config variables for all the other variables.
+type clusterTemplateVariablesClient struct {
+	config.VariablesClient
+	values map[string]string
+}
+
+func (c *clusterTemplateVariablesClient) Get(key string) (string, error) {
+	if value, ok := c.values[key]; ok {
+		return value, nil
+	}
+	return c.VariablesClient.Get(key)
+}
+
 func (t *templateClient) GetFromURL(ctx context.Context, templateURL, targetNamespace string, skipTemplateProcess bool) (repository.Template, error) {
 	if templateURL == "" {
 		return nil, errors.New("invalid GetFromURL operation: missing templateURL value")
@@ -157,7 +230,19 @@ func (t *templateClient) getURLContent(ctx context.Context, templateURL string)
 		return t.getLocalFileContent(rURL)
 	}
 
-	return nil, errors.Errorf("unable to read content from %q. Only reading from GitHub and local file system is supported", templateURL)
+	if rURL.Scheme == repository.OCIScheme {
+		return t.getOCIFileContent(ctx, templateURL)
+	}
+
+	return nil, errors.Errorf("unable to read content from %q. Only reading from GitHub, OCI registries and local file system is supported", templateURL)
+}
+
+func (t *templateClient) getOCIFileContent(ctx context.Context, templateURL string) ([]byte, error) {
+	repo, err := repository.NewOCIRepository(ctx, config.NewProvider("", templateURL, clusterctlv1.ProviderTypeUnknown), t.configClient.Variables())
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetFile(ctx, repo.DefaultVersion(), repo.ComponentsPath())
 }
 
 func (t *templateClient) getLocalFileContent(rURL *url.URL) ([]byte, error) {
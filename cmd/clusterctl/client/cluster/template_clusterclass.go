@@ -0,0 +1,246 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/repository"
+	"sigs.k8s.io/cluster-api/internal/topology/variables"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// maxClusterClassVariablePrompts is the maximum number of times a value is prompted for a single variable.
+const maxClusterClassVariablePrompts = 3
+
+// ClusterClassVariablePromptFunc returns the value for a required ClusterClass variable which is not set.
+// validationErr is the validation error of the value returned by the previous call for the same variable, if any.
+type ClusterClassVariablePromptFunc func(variable clusterv1.ClusterClassStatusVariable, validationErr error) (*apiextensionsv1.JSON, error)
+
+// GetFromClusterClassInput is the input for GetFromClusterClass.
+type GetFromClusterClassInput struct {
+	// Namespace where the ClusterClass exists.
+	Namespace string
+
+	// Name of the ClusterClass.
+	Name string
+
+	// ClusterName is the name of the Cluster to generate.
+	ClusterName string
+
+	// TargetNamespace is the namespace of the Cluster to generate.
+	TargetNamespace string
+
+	// Values of the ClusterClass variables, by variable name.
+	Values map[string]apiextensionsv1.JSON
+
+	// Prompt is used to get the values of required variables which are not set in Values, if any.
+	Prompt ClusterClassVariablePromptFunc
+
+	// SkipTemplateProcess returns only the variables of the ClusterClass, without generating the Cluster.
+	SkipTemplateProcess bool
+}
+
+// GetFromClusterClass returns a workload cluster template with a Cluster using the given ClusterClass.
+// Values of the ClusterClass variables are validated against the variable schemas discovered by the ClusterClass controller,
+// so invalid values are detected before the Cluster is created.
+func (t *templateClient) GetFromClusterClass(ctx context.Context, input GetFromClusterClassInput) (repository.Template, error) {
+	if input.Namespace == "" {
+		return nil, errors.New("invalid GetFromClusterClass operation: missing namespace value")
+	}
+	if input.Name == "" {
+		return nil, errors.New("invalid GetFromClusterClass operation: missing name value")
+	}
+
+	c, err := t.proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterClass := &clusterv1.ClusterClass{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: input.Namespace, Name: input.Name}, clusterClass); err != nil {
+		return nil, errors.Wrapf(err, "error reading ClusterClass %s/%s", input.Namespace, input.Name)
+	}
+	if !conditions.IsTrue(clusterClass, clusterv1.ClusterClassVariablesReadyCondition) {
+		return nil, errors.Errorf("variables of ClusterClass %s/%s are not ready yet, please check the %s condition", input.Namespace, input.Name, clusterv1.ClusterClassVariablesReadyCondition)
+	}
+	definitions := clusterClass.Status.Variables
+
+	variableMap := map[string]*string{}
+	for _, definition := range definitions {
+		variableMap[definition.Name] = clusterClassVariableDefault(definition)
+	}
+	if input.SkipTemplateProcess {
+		return repository.NewTemplateFromObjects(nil, variableMap, input.TargetNamespace), nil
+	}
+
+	values := map[string]apiextensionsv1.JSON{}
+	for name, value := range input.Values {
+		if !slices.ContainsFunc(definitions, func(d clusterv1.ClusterClassStatusVariable) bool { return d.Name == name }) {
+			return nil, errors.Errorf("variable %q is not defined in ClusterClass %s/%s", name, input.Namespace, input.Name)
+		}
+		values[name] = value
+	}
+
+	// Prompt for required variables without a value and without a default.
+	if input.Prompt != nil {
+		for _, definition := range definitions {
+			if _, ok := values[definition.Name]; ok || variableMap[definition.Name] != nil || !ptr.Deref(definition.Definitions[0].Required, false) {
+				continue
+			}
+			value, err := promptClusterClassVariable(ctx, definition, input.Prompt)
+			if err != nil {
+				return nil, err
+			}
+			values[definition.Name] = *value
+		}
+	}
+
+	clusterVariables := []clusterv1.ClusterVariable{}
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		clusterVariables = append(clusterVariables, clusterv1.ClusterVariable{Name: name, Value: values[name]})
+	}
+	if err := validateClusterClassVariables(ctx, clusterVariables, definitions); err != nil {
+		return nil, errors.Wrapf(err, "invalid variables for ClusterClass %s/%s", input.Namespace, input.Name)
+	}
+
+	cluster, err := t.clusterFromClusterClass(clusterClass, input, clusterVariables)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cluster)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert Cluster to unstructured")
+	}
+	u := unstructured.Unstructured{Object: obj}
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(u.Object, "status")
+
+	return repository.NewTemplateFromObjects([]unstructured.Unstructured{u}, variableMap, input.TargetNamespace), nil
+}
+
+// clusterFromClusterClass returns a Cluster using the ClusterClass, with the Kubernetes version and the number of
+// machines from the clusterctl config variables.
+func (t *templateClient) clusterFromClusterClass(clusterClass *clusterv1.ClusterClass, input GetFromClusterClassInput, clusterVariables []clusterv1.ClusterVariable) (*clusterv1.Cluster, error) {
+	kubernetesVersion, err := t.configClient.Variables().Get("KUBERNETES_VERSION")
+	if err != nil {
+		return nil, errors.New("missing Kubernetes version. Please specify a Kubernetes version")
+	}
+
+	cluster := &clusterv1.Cluster{
+		TypeMeta: metav1.TypeMeta{APIVersion: clusterv1.GroupVersion.String(), Kind: "Cluster"},
+	}
+	cluster.Name = input.ClusterName
+	cluster.Namespace = input.TargetNamespace
+	cluster.Spec.Topology.ClassRef.Name = clusterClass.Name
+	if clusterClass.Namespace != input.TargetNamespace {
+		cluster.Spec.Topology.ClassRef.Namespace = clusterClass.Namespace
+	}
+	cluster.Spec.Topology.Version = kubernetesVersion
+	cluster.Spec.Topology.Variables = clusterVariables
+
+	if v, err := t.configClient.Variables().Get("CONTROL_PLANE_MACHINE_COUNT"); err == nil {
+		replicas, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return nil, errors.Errorf("invalid value for CONTROL_PLANE_MACHINE_COUNT set")
+		}
+		cluster.Spec.Topology.ControlPlane.Replicas = ptr.To(int32(replicas))
+	}
+
+	// Workers are added to a MachineDeployment using the first MachineDeployment class of the ClusterClass.
+	if v, err := t.configClient.Variables().Get("WORKER_MACHINE_COUNT"); err == nil {
+		replicas, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return nil, errors.Errorf("invalid value for WORKER_MACHINE_COUNT set")
+		}
+		if replicas > 0 {
+			if len(clusterClass.Spec.Workers.MachineDeployments) == 0 {
+				return nil, errors.Errorf("ClusterClass %s/%s does not define MachineDeployment classes, WORKER_MACHINE_COUNT must be 0", clusterClass.Namespace, clusterClass.Name)
+			}
+			cluster.Spec.Topology.Workers.MachineDeployments = []clusterv1.MachineDeploymentTopology{
+				{
+					Class:    clusterClass.Spec.Workers.MachineDeployments[0].Class,
+					Name:     "md-0",
+					Replicas: ptr.To(int32(replicas)),
+				},
+			}
+		}
+	}
+	return cluster, nil
+}
+
+// promptClusterClassVariable prompts for the value of a variable until the value is valid.
+func promptClusterClassVariable(ctx context.Context, definition clusterv1.ClusterClassStatusVariable, prompt ClusterClassVariablePromptFunc) (*apiextensionsv1.JSON, error) {
+	var validationErr error
+	for range maxClusterClassVariablePrompts {
+		value, err := prompt(definition, validationErr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get value for variable %q", definition.Name)
+		}
+		if value == nil {
+			validationErr = errors.New("a value is required")
+			continue
+		}
+		validationErr = validateClusterClassVariables(ctx, []clusterv1.ClusterVariable{{Name: definition.Name, Value: *value}}, []clusterv1.ClusterClassStatusVariable{definition})
+		if validationErr == nil {
+			return value, nil
+		}
+	}
+	return nil, errors.Wrapf(validationErr, "invalid value for variable %q", definition.Name)
+}
+
+// validateClusterClassVariables validates the values of variables against their definitions, after defaulting them
+// like the Cluster webhook does.
+func validateClusterClassVariables(ctx context.Context, values []clusterv1.ClusterVariable, definitions []clusterv1.ClusterClassStatusVariable) error {
+	fldPath := field.NewPath("spec", "topology", "variables")
+	defaulted, errs := variables.DefaultClusterVariables(values, definitions, fldPath)
+	if len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	if errs := variables.ValidateClusterVariables(ctx, defaulted, nil, definitions, fldPath); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	return nil
+}
+
+// clusterClassVariableDefault returns the default value of a variable, nil if the variable is required and it
+// does not have a default, an empty string if the variable is optional and it does not have a default.
+func clusterClassVariableDefault(definition clusterv1.ClusterClassStatusVariable) *string {
+	if len(definition.Definitions) == 0 {
+		return ptr.To("")
+	}
+	if d := definition.Definitions[0].Schema.OpenAPIV3Schema.Default; d != nil {
+		return ptr.To(string(d.Raw))
+	}
+	if ptr.Deref(definition.Definitions[0].Required, false) {
+		return nil
+	}
+	return ptr.To("")
+}
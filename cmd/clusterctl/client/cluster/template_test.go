@@ -31,8 +31,12 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/repository"
 	yaml "sigs.k8s.io/cluster-api/cmd/clusterctl/client/yamlprocessor"
@@ -160,6 +164,304 @@ func Test_templateClient_GetFromConfigMap(t *testing.T) {
 	}
 }
 
+func Test_templateClient_GetFromClusterTemplate(t *testing.T) {
+	clusterTemplate := &clusterv1.ClusterTemplate{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterTemplate",
+			APIVersion: clusterv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      "my-template",
+		},
+		Spec: clusterv1.ClusterTemplateSpec{
+			Parameters: []clusterv1.ClusterTemplateParameter{
+				{Name: "KUBERNETES_VERSION", Type: clusterv1.ClusterTemplateParameterTypeString, Required: ptr.To(true)},
+				{Name: "WORKER_MACHINE_COUNT", Type: clusterv1.ClusterTemplateParameterTypeInteger, Default: "1"},
+			},
+			Template: `apiVersion: cluster.x-k8s.io/v1beta2
+kind: MachineDeployment
+metadata:
+  name: ${CLUSTER_NAME}-md-0
+  namespace: ${NAMESPACE}
+spec:
+  replicas: ${WORKER_MACHINE_COUNT}
+  template:
+    spec:
+      version: ${KUBERNETES_VERSION}`,
+		},
+	}
+
+	type args struct {
+		clusterTemplateName string
+		skipTemplateProcess bool
+	}
+	tests := []struct {
+		name          string
+		reader        *test.FakeReader
+		args          args
+		wantVariables []string
+		wantReplicas  float64
+		wantErr       bool
+	}{
+		{
+			name:          "Return template with defaults for missing parameters",
+			reader:        test.NewFakeReader().WithVar("CLUSTER_NAME", "my-cluster").WithVar("KUBERNETES_VERSION", "v1.33.0"),
+			args:          args{clusterTemplateName: "my-template"},
+			wantVariables: []string{"CLUSTER_NAME", "KUBERNETES_VERSION", "NAMESPACE", "WORKER_MACHINE_COUNT"},
+			wantReplicas:  1,
+		},
+		{
+			name: "Return template with values from variables",
+			reader: test.NewFakeReader().WithVar("CLUSTER_NAME", "my-cluster").WithVar("KUBERNETES_VERSION", "v1.33.0").
+				WithVar("WORKER_MACHINE_COUNT", "3"),
+			args:          args{clusterTemplateName: "my-template"},
+			wantVariables: []string{"CLUSTER_NAME", "KUBERNETES_VERSION", "NAMESPACE", "WORKER_MACHINE_COUNT"},
+			wantReplicas:  3,
+		},
+		{
+			name:          "Return only variables when skipping template processing",
+			reader:        test.NewFakeReader(),
+			args:          args{clusterTemplateName: "my-template", skipTemplateProcess: true},
+			wantVariables: []string{"CLUSTER_NAME", "KUBERNETES_VERSION", "NAMESPACE", "WORKER_MACHINE_COUNT"},
+		},
+		{
+			name:    "Required parameter is missing",
+			reader:  test.NewFakeReader().WithVar("CLUSTER_NAME", "my-cluster"),
+			args:    args{clusterTemplateName: "my-template"},
+			wantErr: true,
+		},
+		{
+			name: "Parameter has an invalid value",
+			reader: test.NewFakeReader().WithVar("CLUSTER_NAME", "my-cluster").WithVar("KUBERNETES_VERSION", "v1.33.0").
+				WithVar("WORKER_MACHINE_COUNT", "many"),
+			args:    args{clusterTemplateName: "my-template"},
+			wantErr: true,
+		},
+		{
+			name:    "ClusterTemplate does not exists",
+			reader:  test.NewFakeReader(),
+			args:    args{clusterTemplateName: "something-else"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			configClient, err := config.New(ctx, "", config.InjectReader(tt.reader))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			tc := newTemplateClient(TemplateClientInput{test.NewFakeProxy().WithObjs(clusterTemplate), configClient, yaml.NewSimpleProcessor()})
+			got, err := tc.GetFromClusterTemplate(ctx, "ns1", tt.args.clusterTemplateName, "ns2", tt.args.skipTemplateProcess)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got.Variables()).To(Equal(tt.wantVariables))
+
+			if tt.args.skipTemplateProcess {
+				g.Expect(got.Objs()).To(BeEmpty())
+				return
+			}
+			g.Expect(got.Objs()).To(HaveLen(1))
+			obj := got.Objs()[0]
+			g.Expect(obj.GetName()).To(Equal("my-cluster-md-0"))
+			g.Expect(obj.GetNamespace()).To(Equal("ns2"))
+			g.Expect(obj.Object["spec"]).To(HaveKeyWithValue("replicas", tt.wantReplicas))
+		})
+	}
+}
+
+func Test_templateClient_GetFromClusterClass(t *testing.T) {
+	variable := func(name, schemaType string, required bool, defaultValue string) clusterv1.ClusterClassStatusVariable {
+		v := clusterv1.ClusterClassStatusVariable{
+			Name: name,
+			Definitions: []clusterv1.ClusterClassStatusVariableDefinition{
+				{
+					From:     clusterv1.VariableDefinitionFromInline,
+					Required: ptr.To(required),
+					Schema: clusterv1.VariableSchema{
+						OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: schemaType},
+					},
+				},
+			},
+		}
+		if defaultValue != "" {
+			v.Definitions[0].Schema.OpenAPIV3Schema.Default = &apiextensionsv1.JSON{Raw: []byte(defaultValue)}
+		}
+		return v
+	}
+	clusterClass := &clusterv1.ClusterClass{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterClass",
+			APIVersion: clusterv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      "my-class",
+		},
+		Spec: clusterv1.ClusterClassSpec{
+			Workers: clusterv1.WorkersClass{
+				MachineDeployments: []clusterv1.MachineDeploymentClass{{Class: "default-worker"}},
+			},
+		},
+		Status: clusterv1.ClusterClassStatus{
+			Conditions: []metav1.Condition{
+				{Type: clusterv1.ClusterClassVariablesReadyCondition, Status: metav1.ConditionTrue},
+			},
+			Variables: []clusterv1.ClusterClassStatusVariable{
+				variable("region", "string", true, ""),
+				variable("replicas", "integer", true, "1"),
+				variable("imageRepository", "string", false, ""),
+			},
+		},
+	}
+	notReadyClusterClass := clusterClass.DeepCopy()
+	notReadyClusterClass.Name = "not-ready-class"
+	notReadyClusterClass.Status.Conditions[0].Status = metav1.ConditionFalse
+
+	// prompt returns the given values, one per call.
+	prompt := func(values ...string) ClusterClassVariablePromptFunc {
+		return func(_ clusterv1.ClusterClassStatusVariable, _ error) (*apiextensionsv1.JSON, error) {
+			if len(values) == 0 {
+				return nil, errors.New("no more values")
+			}
+			value := values[0]
+			values = values[1:]
+			return &apiextensionsv1.JSON{Raw: []byte(value)}, nil
+		}
+	}
+
+	tests := []struct {
+		name                string
+		clusterClassName    string
+		values              map[string]apiextensionsv1.JSON
+		prompt              ClusterClassVariablePromptFunc
+		skipTemplateProcess bool
+		wantVariables       []clusterv1.ClusterVariable
+		wantErr             bool
+	}{
+		{
+			name:             "Return Cluster with values",
+			clusterClassName: "my-class",
+			values: map[string]apiextensionsv1.JSON{
+				"region":   {Raw: []byte(`"us-east-1"`)},
+				"replicas": {Raw: []byte(`3`)},
+			},
+			wantVariables: []clusterv1.ClusterVariable{
+				{Name: "region", Value: apiextensionsv1.JSON{Raw: []byte(`"us-east-1"`)}},
+				{Name: "replicas", Value: apiextensionsv1.JSON{Raw: []byte(`3`)}},
+			},
+		},
+		{
+			name:             "Return Cluster with values from prompt, retrying after invalid values",
+			clusterClassName: "my-class",
+			prompt:           prompt(`1`, `"us-east-1"`),
+			wantVariables: []clusterv1.ClusterVariable{
+				{Name: "region", Value: apiextensionsv1.JSON{Raw: []byte(`"us-east-1"`)}},
+			},
+		},
+		{
+			name:                "Return only variables when skipping template processing",
+			clusterClassName:    "my-class",
+			prompt:              prompt(),
+			skipTemplateProcess: true,
+		},
+		{
+			name:             "Required variable is missing",
+			clusterClassName: "my-class",
+			wantErr:          true,
+		},
+		{
+			name:             "Variable has an invalid value",
+			clusterClassName: "my-class",
+			values: map[string]apiextensionsv1.JSON{
+				"region":   {Raw: []byte(`"us-east-1"`)},
+				"replicas": {Raw: []byte(`"many"`)},
+			},
+			wantErr: true,
+		},
+		{
+			name:             "Prompt returns invalid values",
+			clusterClassName: "my-class",
+			prompt:           prompt(`1`, `2`, `3`),
+			wantErr:          true,
+		},
+		{
+			name:             "Variable is not defined",
+			clusterClassName: "my-class",
+			values: map[string]apiextensionsv1.JSON{
+				"region": {Raw: []byte(`"us-east-1"`)},
+				"zone":   {Raw: []byte(`"a"`)},
+			},
+			wantErr: true,
+		},
+		{
+			name:             "Variables of the ClusterClass are not ready",
+			clusterClassName: "not-ready-class",
+			wantErr:          true,
+		},
+		{
+			name:             "ClusterClass does not exists",
+			clusterClassName: "something-else",
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			reader := test.NewFakeReader().WithVar("KUBERNETES_VERSION", "v1.33.0").
+				WithVar("CONTROL_PLANE_MACHINE_COUNT", "3").WithVar("WORKER_MACHINE_COUNT", "2")
+			configClient, err := config.New(ctx, "", config.InjectReader(reader))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			tc := newTemplateClient(TemplateClientInput{test.NewFakeProxy().WithObjs(clusterClass, notReadyClusterClass), configClient, yaml.NewSimpleProcessor()})
+			got, err := tc.GetFromClusterClass(ctx, GetFromClusterClassInput{
+				Namespace:           "ns1",
+				Name:                tt.clusterClassName,
+				ClusterName:         "my-cluster",
+				TargetNamespace:     "ns2",
+				Values:              tt.values,
+				Prompt:              tt.prompt,
+				SkipTemplateProcess: tt.skipTemplateProcess,
+			})
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got.Variables()).To(Equal([]string{"imageRepository", "region", "replicas"}))
+			g.Expect(got.VariableMap()).To(HaveKeyWithValue("region", BeNil()))
+			g.Expect(got.VariableMap()).To(HaveKeyWithValue("replicas", HaveValue(Equal("1"))))
+			g.Expect(got.VariableMap()).To(HaveKeyWithValue("imageRepository", HaveValue(BeEmpty())))
+
+			if tt.skipTemplateProcess {
+				g.Expect(got.Objs()).To(BeEmpty())
+				return
+			}
+			g.Expect(got.Objs()).To(HaveLen(1))
+			cluster := &clusterv1.Cluster{}
+			g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(got.Objs()[0].Object, cluster)).To(Succeed())
+			g.Expect(cluster.Name).To(Equal("my-cluster"))
+			g.Expect(cluster.Namespace).To(Equal("ns2"))
+			g.Expect(cluster.Spec.Topology.ClassRef).To(Equal(clusterv1.ClusterClassRef{Name: "my-class", Namespace: "ns1"}))
+			g.Expect(cluster.Spec.Topology.Version).To(Equal("v1.33.0"))
+			g.Expect(cluster.Spec.Topology.ControlPlane.Replicas).To(Equal(ptr.To[int32](3)))
+			g.Expect(cluster.Spec.Topology.Workers.MachineDeployments).To(Equal([]clusterv1.MachineDeploymentTopology{
+				{Class: "default-worker", Name: "md-0", Replicas: ptr.To[int32](2)},
+			}))
+			g.Expect(cluster.Spec.Topology.Variables).To(Equal(tt.wantVariables))
+		})
+	}
+}
+
 func Test_templateClient_getGitHubFileContent(t *testing.T) {
 	g := NewWithT(t)
 
@@ -411,6 +411,29 @@ func (u *providerUpgrader) doUpgrade(ctx context.Context, upgradePlan *UpgradePl
 		return providers[a].GetProviderType().Order() < providers[b].GetProviderType().Order()
 	})
 
+	// Check the CRDs of all the providers can be upgraded before changing anything in the management cluster.
+	for _, upgradeItem := range providers {
+		// If there is not a specified next version, skip it (we are already up-to-date).
+		if upgradeItem.NextVersion == "" {
+			continue
+		}
+
+		// Gets the provider components for the target version.
+		components, err := u.getUpgradeComponents(ctx, upgradeItem)
+		if err != nil {
+			return err
+		}
+
+		c, err := u.proxy.NewClient(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := NewCRDMigrator(c).Check(ctx, components.Objs(), opts.EnableCRDStorageVersionMigration); err != nil {
+			return errors.Wrapf(err, "failed to upgrade %s provider", upgradeItem.InstanceName())
+		}
+	}
+
 	if opts.EnableCRDStorageVersionMigration {
 		// Migrate CRs to latest CRD storage version, if necessary.
 		// Note: We have to do this before the providers are scaled down or deleted
@@ -17,18 +17,60 @@ limitations under the License.
 package cluster
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"sort"
+	gotemplate "text/template"
 
 	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	utilkubeconfig "sigs.k8s.io/cluster-api/util/kubeconfig"
 )
 
+// DefaultExecCredentialAPIVersion is the default API version of the ExecCredential objects
+// exchanged with an exec credential plugin.
+const DefaultExecCredentialAPIVersion = "client.authentication.k8s.io/v1"
+
 // WorkloadCluster has methods for fetching kubeconfig of workload cluster from management cluster.
 type WorkloadCluster interface {
 	// GetKubeconfig returns the kubeconfig of the workload cluster.
 	GetKubeconfig(ctx context.Context, workloadClusterName string, namespace string) (string, error)
+
+	// GetUserKubeconfig returns a kubeconfig of the workload cluster authenticating the given user
+	// instead of the admin user of the workload cluster.
+	GetUserKubeconfig(ctx context.Context, workloadClusterName string, namespace string, user KubeconfigUser) (string, error)
+}
+
+// KubeconfigUser defines a user authenticating to a workload cluster via an exec credential plugin.
+// All the string values can be Go templates, which are rendered using the Cluster object
+// as input, e.g. `{{ .Name }}` or `{{ index .Annotations "example.com/oidc-client-id" }}`.
+type KubeconfigUser struct {
+	// Name is the name of the user in the kubeconfig.
+	Name string
+
+	// Exec defines the exec credential plugin used to get credentials for the user.
+	Exec ExecCredentialPlugin
+}
+
+// ExecCredentialPlugin defines an exec credential plugin.
+type ExecCredentialPlugin struct {
+	// Command is the command to execute.
+	Command string
+
+	// Args are the arguments to pass to the command.
+	Args []string
+
+	// Env are additional environment variables to expose to the command.
+	Env map[string]string
+
+	// APIVersion is the API version of the ExecCredential objects exchanged with the plugin.
+	// If empty, DefaultExecCredentialAPIVersion is used.
+	APIVersion string
 }
 
 // workloadCluster implements WorkloadCluster.
@@ -59,3 +101,114 @@ func (p *workloadCluster) GetKubeconfig(ctx context.Context, workloadClusterName
 	}
 	return string(dataBytes), nil
 }
+
+func (p *workloadCluster) GetUserKubeconfig(ctx context.Context, workloadClusterName string, namespace string, user KubeconfigUser) (string, error) {
+	adminKubeconfig, err := p.GetKubeconfig(ctx, workloadClusterName, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	cs, err := p.proxy.NewClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := cs.Get(ctx, client.ObjectKey{Namespace: namespace, Name: workloadClusterName}, cluster); err != nil {
+		return "", errors.Wrapf(err, "failed to get Cluster %s/%s", namespace, workloadClusterName)
+	}
+
+	authInfo, userName, err := renderKubeconfigUser(cluster, user)
+	if err != nil {
+		return "", err
+	}
+
+	config, err := clientcmd.Load([]byte(adminKubeconfig))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse kubeconfig for Cluster %s/%s", namespace, workloadClusterName)
+	}
+
+	// Replace the admin user with the given user, and re-create all the contexts accordingly.
+	// NOTE: The clusters defined in the kubeconfig, including the CA of the workload cluster, are preserved.
+	contexts := map[string]*clientcmdapi.Context{}
+	currentContext := ""
+	for name, c := range config.Contexts {
+		newName := fmt.Sprintf("%s@%s", userName, c.Cluster)
+		newContext := c.DeepCopy()
+		newContext.AuthInfo = userName
+		contexts[newName] = newContext
+		if name == config.CurrentContext {
+			currentContext = newName
+		}
+	}
+	config.Contexts = contexts
+	config.CurrentContext = currentContext
+	config.AuthInfos = map[string]*clientcmdapi.AuthInfo{userName: authInfo}
+
+	out, err := clientcmd.Write(*config)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to write kubeconfig for Cluster %s/%s", namespace, workloadClusterName)
+	}
+	return string(out), nil
+}
+
+// renderKubeconfigUser returns the AuthInfo and the name for a KubeconfigUser, rendering all the templates using
+// the Cluster as input.
+func renderKubeconfigUser(cluster *clusterv1.Cluster, user KubeconfigUser) (*clientcmdapi.AuthInfo, string, error) {
+	render := func(field, value string) (string, error) {
+		tpl, err := gotemplate.New(field).Option("missingkey=error").Parse(value)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to parse template for %s", field)
+		}
+		var out bytes.Buffer
+		if err := tpl.Execute(&out, cluster); err != nil {
+			return "", errors.Wrapf(err, "failed to render template for %s", field)
+		}
+		return out.String(), nil
+	}
+
+	if user.Exec.Command == "" {
+		return nil, "", errors.New("exec credential plugin command must be set")
+	}
+
+	name, err := render("user name", user.Name)
+	if err != nil {
+		return nil, "", err
+	}
+	if name == "" {
+		name = fmt.Sprintf("%s-user", cluster.Name)
+	}
+
+	exec := &clientcmdapi.ExecConfig{
+		APIVersion:      user.Exec.APIVersion,
+		InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+	}
+	if exec.APIVersion == "" {
+		exec.APIVersion = DefaultExecCredentialAPIVersion
+	}
+	if exec.Command, err = render("exec command", user.Exec.Command); err != nil {
+		return nil, "", err
+	}
+	for i, arg := range user.Exec.Args {
+		renderedArg, err := render(fmt.Sprintf("exec arg %d", i), arg)
+		if err != nil {
+			return nil, "", err
+		}
+		exec.Args = append(exec.Args, renderedArg)
+	}
+
+	envNames := make([]string, 0, len(user.Exec.Env))
+	for envName := range user.Exec.Env {
+		envNames = append(envNames, envName)
+	}
+	sort.Strings(envNames)
+	for _, envName := range envNames {
+		value, err := render(fmt.Sprintf("exec env %s", envName), user.Exec.Env[envName])
+		if err != nil {
+			return nil, "", err
+		}
+		exec.Env = append(exec.Env, clientcmdapi.ExecEnvVar{Name: envName, Value: value})
+	}
+
+	return &clientcmdapi.AuthInfo{Exec: exec}, name, nil
+}
@@ -23,6 +23,8 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
@@ -100,3 +102,158 @@ users:
 		})
 	}
 }
+
+func Test_WorkloadCluster_GetUserKubeconfig(t *testing.T) {
+	var (
+		validKubeConfig = `
+clusters:
+- cluster:
+    certificate-authority-data: c3R1ZmY=
+    server: https://test-cluster-api:6443
+  name: test1
+contexts:
+- context:
+    cluster: test1
+    user: test1-admin
+  name: test1-admin@test1
+current-context: test1-admin@test1
+kind: Config
+preferences: {}
+users:
+- name: test1-admin
+  user:
+    client-certificate-data: c3R1ZmYtY2VydC1kYXRh
+    client-key-data: c3R1ZmYta2V5LWRhdGE=
+`
+
+		validSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test1-kubeconfig",
+				Namespace: "test",
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: "test1"},
+			},
+			Data: map[string][]byte{
+				secret.KubeconfigDataName: []byte(validKubeConfig),
+			},
+		}
+
+		validCluster = &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test1",
+				Namespace: "test",
+				Annotations: map[string]string{
+					"example.com/oidc-client-id": "test1-client",
+				},
+			},
+		}
+	)
+
+	tests := []struct {
+		name             string
+		proxy            Proxy
+		user             KubeconfigUser
+		expectErr        bool
+		expectUserName   string
+		expectExecConfig *clientcmdapi.ExecConfig
+	}{
+		{
+			name:  "return kubeconfig with exec credential plugin",
+			proxy: test.NewFakeProxy().WithObjs(validSecret, validCluster),
+			user: KubeconfigUser{
+				Exec: ExecCredentialPlugin{
+					Command: "get-token",
+					Args:    []string{"--cluster={{ .Namespace }}/{{ .Name }}", `--client-id={{ index .Annotations "example.com/oidc-client-id" }}`},
+					Env:     map[string]string{"B": "b", "A": "{{ .Name }}"},
+				},
+			},
+			expectUserName: "test1-user",
+			expectExecConfig: &clientcmdapi.ExecConfig{
+				Command:         "get-token",
+				Args:            []string{"--cluster=test/test1", "--client-id=test1-client"},
+				Env:             []clientcmdapi.ExecEnvVar{{Name: "A", Value: "test1"}, {Name: "B", Value: "b"}},
+				APIVersion:      DefaultExecCredentialAPIVersion,
+				InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+			},
+		},
+		{
+			name:  "return kubeconfig with a custom user name",
+			proxy: test.NewFakeProxy().WithObjs(validSecret, validCluster),
+			user: KubeconfigUser{
+				Name: "{{ .Name }}-developer",
+				Exec: ExecCredentialPlugin{
+					Command:    "get-token",
+					APIVersion: "client.authentication.k8s.io/v1beta1",
+				},
+			},
+			expectUserName: "test1-developer",
+			expectExecConfig: &clientcmdapi.ExecConfig{
+				Command:         "get-token",
+				APIVersion:      "client.authentication.k8s.io/v1beta1",
+				InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+			},
+		},
+		{
+			name:  "return error if a template is invalid",
+			proxy: test.NewFakeProxy().WithObjs(validSecret, validCluster),
+			user: KubeconfigUser{
+				Exec: ExecCredentialPlugin{
+					Command: "get-token",
+					Args:    []string{"{{ .DoesNotExist }}"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:  "return error if cannot find cluster",
+			proxy: test.NewFakeProxy().WithObjs(validSecret),
+			user: KubeconfigUser{
+				Exec: ExecCredentialPlugin{
+					Command: "get-token",
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:  "return error if cannot find secret",
+			proxy: test.NewFakeProxy().WithObjs(validCluster),
+			user: KubeconfigUser{
+				Exec: ExecCredentialPlugin{
+					Command: "get-token",
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			wc := newWorkloadCluster(tt.proxy)
+			data, err := wc.GetUserKubeconfig(ctx, "test1", "test", tt.user)
+
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			config, err := clientcmd.Load([]byte(data))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			g.Expect(config.AuthInfos).To(HaveLen(1))
+			g.Expect(config.AuthInfos).To(HaveKey(tt.expectUserName))
+			g.Expect(config.AuthInfos[tt.expectUserName].ClientCertificateData).To(BeEmpty())
+			g.Expect(config.AuthInfos[tt.expectUserName].ClientKeyData).To(BeEmpty())
+			g.Expect(config.AuthInfos[tt.expectUserName].Exec).To(BeComparableTo(tt.expectExecConfig))
+
+			g.Expect(config.CurrentContext).To(Equal(tt.expectUserName + "@test1"))
+			g.Expect(config.Contexts).To(HaveLen(1))
+			g.Expect(config.Contexts[config.CurrentContext].AuthInfo).To(Equal(tt.expectUserName))
+			g.Expect(config.Contexts[config.CurrentContext].Cluster).To(Equal("test1"))
+			g.Expect(config.Clusters).To(HaveKey("test1"))
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"strconv"
 
 	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/utils/ptr"
 
@@ -127,6 +128,12 @@ type GetClusterTemplateOptions struct {
 	// ConfigMapSource to be used for reading the workload cluster template; only one template source can be used at time.
 	ConfigMapSource *ConfigMapSourceOptions
 
+	// ClusterTemplateSource to be used for reading the workload cluster template; only one template source can be used at time.
+	ClusterTemplateSource *ClusterTemplateSourceOptions
+
+	// ClusterClassSource to be used for generating the workload cluster template; only one template source can be used at time.
+	ClusterClassSource *ClusterClassSourceOptions
+
 	// TargetNamespace where the objects describing the workload cluster should be deployed. If unspecified,
 	// the current namespace will be used.
 	TargetNamespace string
@@ -167,6 +174,12 @@ func (o *GetClusterTemplateOptions) numSources() int {
 	if o.URLSource != nil {
 		numSources++
 	}
+	if o.ClusterTemplateSource != nil {
+		numSources++
+	}
+	if o.ClusterClassSource != nil {
+		numSources++
+	}
 	return numSources
 }
 
@@ -204,6 +217,32 @@ type ConfigMapSourceOptions struct {
 	DataKey string
 }
 
+// ClusterTemplateSourceOptions defines the options to be used when reading a workload cluster template from a ClusterTemplate.
+type ClusterTemplateSourceOptions struct {
+	// Namespace where the ClusterTemplate exists. If unspecified, the current namespace will be used.
+	Namespace string
+
+	// Name of the ClusterTemplate to read the workload cluster template from.
+	Name string
+}
+
+// ClusterClassSourceOptions defines the options to be used when generating a workload cluster template with a Cluster
+// using a ClusterClass.
+type ClusterClassSourceOptions struct {
+	// Namespace where the ClusterClass exists. If unspecified, the current namespace will be used.
+	Namespace string
+
+	// Name of the ClusterClass to be used by the Cluster.
+	Name string
+
+	// Values of the ClusterClass variables, by variable name.
+	Values map[string]apiextensionsv1.JSON
+
+	// Prompt is used to get the values of required variables which are not set in Values and which do not have a default.
+	// If unspecified, generating the workload cluster template fails if values for required variables are missing.
+	Prompt ClusterClassVariablePromptFunc
+}
+
 func (c *clusterctlClient) GetClusterTemplate(ctx context.Context, options GetClusterTemplateOptions) (Template, error) {
 	// Checks that no more than on source is set
 	numsSource := options.numSources()
@@ -262,6 +301,12 @@ func (c *clusterctlClient) GetClusterTemplate(ctx context.Context, options GetCl
 	if options.URLSource != nil {
 		return c.getTemplateFromURL(ctx, clusterClient, *options.URLSource, options.TargetNamespace, options.ListVariablesOnly)
 	}
+	if options.ClusterTemplateSource != nil {
+		return c.getTemplateFromClusterTemplate(ctx, clusterClient, *options.ClusterTemplateSource, options.TargetNamespace, options.ListVariablesOnly)
+	}
+	if options.ClusterClassSource != nil {
+		return c.getTemplateFromClusterClass(ctx, clusterClient, *options.ClusterClassSource, options.ClusterName, options.TargetNamespace, options.ListVariablesOnly)
+	}
 
 	return nil, errors.New("unable to read custom template. Please specify a template source")
 }
@@ -371,6 +416,42 @@ func (c *clusterctlClient) getTemplateFromConfigMap(ctx context.Context, cluster
 	return cluster.Template().GetFromConfigMap(ctx, source.Namespace, source.Name, source.DataKey, targetNamespace, listVariablesOnly)
 }
 
+// getTemplateFromClusterTemplate returns a workload cluster template from a ClusterTemplate.
+func (c *clusterctlClient) getTemplateFromClusterTemplate(ctx context.Context, cluster cluster.Client, source ClusterTemplateSourceOptions, targetNamespace string, listVariablesOnly bool) (Template, error) {
+	// If the option specifying the namespace of the ClusterTemplate is empty, default it to the current namespace.
+	if source.Namespace == "" {
+		currentNamespace, err := cluster.Proxy().CurrentNamespace()
+		if err != nil {
+			return nil, err
+		}
+		source.Namespace = currentNamespace
+	}
+
+	return cluster.Template().GetFromClusterTemplate(ctx, source.Namespace, source.Name, targetNamespace, listVariablesOnly)
+}
+
+// getTemplateFromClusterClass returns a workload cluster template with a Cluster using a ClusterClass.
+func (c *clusterctlClient) getTemplateFromClusterClass(ctx context.Context, clusterClient cluster.Client, source ClusterClassSourceOptions, clusterName, targetNamespace string, listVariablesOnly bool) (Template, error) {
+	// If the option specifying the namespace of the ClusterClass is empty, default it to the current namespace.
+	if source.Namespace == "" {
+		currentNamespace, err := clusterClient.Proxy().CurrentNamespace()
+		if err != nil {
+			return nil, err
+		}
+		source.Namespace = currentNamespace
+	}
+
+	return clusterClient.Template().GetFromClusterClass(ctx, cluster.GetFromClusterClassInput{
+		Namespace:           source.Namespace,
+		Name:                source.Name,
+		ClusterName:         clusterName,
+		TargetNamespace:     targetNamespace,
+		Values:              source.Values,
+		Prompt:              cluster.ClusterClassVariablePromptFunc(source.Prompt),
+		SkipTemplateProcess: listVariablesOnly,
+	})
+}
+
 // getTemplateFromURL returns a workload cluster template from an URL.
 func (c *clusterctlClient) getTemplateFromURL(ctx context.Context, cluster cluster.Client, source URLSourceOptions, targetNamespace string, listVariablesOnly bool) (Template, error) {
 	return cluster.Template().GetFromURL(ctx, source.URL, targetNamespace, listVariablesOnly)
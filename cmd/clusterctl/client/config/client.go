@@ -44,7 +44,9 @@ type Client interface {
 
 // configClient implements Client.
 type configClient struct {
-	reader Reader
+	reader              Reader
+	providerOverrides   []Provider
+	certManagerOverride CertManager
 }
 
 // ensure configClient implements Client.
@@ -76,6 +78,16 @@ func InjectReader(reader Reader) Option {
 	}
 }
 
+// InjectRepositoryOverrides allows to override the repository URL of the given providers and of cert-manager, taking
+// precedence over both the hard-coded and the user-defined configuration; e.g. it is used to read providers from a
+// clusterctl bundle. If certManager is nil, the cert-manager configuration is not overridden.
+func InjectRepositoryOverrides(providers []Provider, certManager CertManager) Option {
+	return func(c *configClient) {
+		c.providerOverrides = providers
+		c.certManagerOverride = certManager
+	}
+}
+
 // New returns a Client for interacting with the clusterctl configuration.
 func New(ctx context.Context, path string, options ...Option) (Client, error) {
 	return newConfigClient(ctx, path, options...)
@@ -98,6 +110,10 @@ func newConfigClient(ctx context.Context, path string, options ...Option) (*conf
 		}
 	}
 
+	if len(client.providerOverrides) > 0 || client.certManagerOverride != nil {
+		client.reader = newOverridesReader(client.reader, client.providerOverrides, client.certManagerOverride)
+	}
+
 	return client, nil
 }
 
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// overridesReader is a Reader overriding the providers and the cert-manager configuration read from another Reader.
+type overridesReader struct {
+	Reader
+	providers   []configProvider
+	certManager *configCertManager
+}
+
+var _ Reader = &overridesReader{}
+
+// newOverridesReader returns a Reader overriding the repository URL of the given providers and of cert-manager.
+func newOverridesReader(reader Reader, providers []Provider, certManager CertManager) *overridesReader {
+	r := &overridesReader{
+		Reader: reader,
+	}
+	for _, p := range providers {
+		r.providers = append(r.providers, configProvider{
+			Name: p.Name(),
+			URL:  p.URL(),
+			Type: p.Type(),
+		})
+	}
+	if certManager != nil {
+		r.certManager = &configCertManager{
+			URL:     certManager.URL(),
+			Version: certManager.Version(),
+			Timeout: certManager.Timeout(),
+		}
+	}
+	return r
+}
+
+// UnmarshalKey reads a configuration value and unmarshals it into the provided value object, applying overrides
+// for the providers and the cert-manager configuration.
+func (r *overridesReader) UnmarshalKey(key string, value interface{}) error {
+	var overridden interface{}
+	switch {
+	case key == ProvidersConfigKey && len(r.providers) > 0:
+		providers := []configProvider{}
+		if err := r.Reader.UnmarshalKey(key, &providers); err != nil {
+			return err
+		}
+		// NOTE: Provider configurations appended last take precedence over previous configurations for the same provider.
+		overridden = append(providers, r.providers...)
+	case key == CertManagerConfigKey && r.certManager != nil:
+		certManager := &configCertManager{}
+		if err := r.Reader.UnmarshalKey(key, &certManager); err != nil {
+			return err
+		}
+		certManager.URL = r.certManager.URL
+		certManager.Version = r.certManager.Version
+		if r.certManager.Timeout != "" {
+			certManager.Timeout = r.certManager.Timeout
+		}
+		overridden = certManager
+	default:
+		return r.Reader.UnmarshalKey(key, value)
+	}
+
+	data, err := yaml.Marshal(overridden)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %q with overrides", key)
+	}
+	return yaml.Unmarshal(data, value)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+)
+
+func TestRepositoryOverrides(t *testing.T) {
+	g := NewWithT(t)
+
+	reader := test.NewFakeReader().
+		WithVar("foo", "bar").
+		WithProvider("infra", clusterctlv1.InfrastructureProviderType, "https://example.com/infra/latest/components.yaml").
+		WithProvider("other", clusterctlv1.InfrastructureProviderType, "https://example.com/other/latest/components.yaml").
+		WithCertManager("https://example.com/cert-manager/latest/cert-manager.yaml", "v1.0.0", "5m")
+
+	c, err := New(context.Background(), "", InjectReader(reader), InjectRepositoryOverrides(
+		[]Provider{
+			NewProvider(ClusterAPIProviderName, "file:///bundle/cluster-api/v1.0.0/components.yaml", clusterctlv1.CoreProviderType),
+			NewProvider("infra", "file:///bundle/infrastructure-infra/v1.0.0/components.yaml", clusterctlv1.InfrastructureProviderType),
+		},
+		NewCertManager("file:///bundle/cert-manager/v1.1.0/cert-manager.yaml", "v1.1.0", ""),
+	))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Overrides take precedence over both hard-coded and user-defined providers.
+	provider, err := c.Providers().Get(ClusterAPIProviderName, clusterctlv1.CoreProviderType)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(provider.URL()).To(Equal("file:///bundle/cluster-api/v1.0.0/components.yaml"))
+
+	provider, err = c.Providers().Get("infra", clusterctlv1.InfrastructureProviderType)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(provider.URL()).To(Equal("file:///bundle/infrastructure-infra/v1.0.0/components.yaml"))
+
+	// Providers without overrides are not affected.
+	provider, err = c.Providers().Get("other", clusterctlv1.InfrastructureProviderType)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(provider.URL()).To(Equal("https://example.com/other/latest/components.yaml"))
+
+	// The cert-manager timeout is preserved, if not overridden.
+	certManager, err := c.CertManager().Get()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(certManager).To(Equal(NewCertManager("file:///bundle/cert-manager/v1.1.0/cert-manager.yaml", "v1.1.0", "5m")))
+
+	// Other configurations are not affected.
+	value, err := c.Variables().Get("foo")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(value).To(Equal("bar"))
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+// DrainExplainOptions carries the options supported by DrainExplain.
+type DrainExplainOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Resource is the Machine to explain the drain for, in the machine/<name> form.
+	Resource string
+
+	// Namespace where the Machine lives. If unspecified, the namespace name will be inferred
+	// from the current configuration.
+	Namespace string
+}
+
+func (c *clusterctlClient) DrainExplain(ctx context.Context, options DrainExplainOptions) (*cluster.DrainExplanation, error) {
+	// gets access to the management cluster
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure this command only runs against management clusters with the current Cluster API contract.
+	if err := clusterClient.ProviderInventory().CheckCAPIContract(ctx); err != nil {
+		return nil, err
+	}
+
+	objRefs, err := getObjectRefs(clusterClient, options.Namespace, []string{options.Resource})
+	if err != nil {
+		return nil, err
+	}
+	ref := objRefs[0]
+	if ref.Kind != "machine" {
+		return nil, errors.Errorf("invalid resource type %q, only machine/<name> is supported", ref.Kind)
+	}
+
+	return clusterClient.DrainExplainer().Explain(ctx, cluster.DrainExplainOptions{
+		Namespace:   ref.Namespace,
+		MachineName: ref.Name,
+	})
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
 )
 
 // GetKubeconfigOptions carries all the options supported by GetKubeconfig.
@@ -33,6 +35,58 @@ type GetKubeconfigOptions struct {
 
 	// WorkloadClusterName is the name of the workload cluster.
 	WorkloadClusterName string
+
+	// User defines the user to generate the kubeconfig for. If nil, the kubeconfig of the
+	// admin user of the workload cluster is returned.
+	User *KubeconfigUserOptions
+}
+
+// KubeconfigUserOptions defines a user authenticating to the workload cluster via an exec credential plugin
+// or via OIDC; exactly one of Exec or OIDC must be set.
+// All the string values can be Go templates, which are rendered using the Cluster object
+// as input, e.g. `{{ .Name }}` or `{{ index .Annotations "example.com/oidc-client-id" }}`.
+type KubeconfigUserOptions struct {
+	// Name is the name of the user in the kubeconfig. If empty, "<cluster name>-user" is used.
+	Name string
+
+	// Exec defines the exec credential plugin used to get credentials for the user.
+	Exec *ExecCredentialPluginOptions
+
+	// OIDC defines the OIDC settings used to get credentials for the user.
+	OIDC *OIDCOptions
+}
+
+// ExecCredentialPluginOptions defines an exec credential plugin.
+type ExecCredentialPluginOptions struct {
+	// Command is the command to execute.
+	Command string
+
+	// Args are the arguments to pass to the command.
+	Args []string
+
+	// Env are additional environment variables to expose to the command.
+	Env map[string]string
+
+	// APIVersion is the API version of the ExecCredential objects exchanged with the plugin.
+	// If empty, client.authentication.k8s.io/v1 is used.
+	APIVersion string
+}
+
+// OIDCOptions defines the OIDC settings for a user.
+// NOTE: Credentials are obtained using the kubectl oidc-login plugin (https://github.com/int128/kubelogin),
+// which must be installed on the machine using the kubeconfig.
+type OIDCOptions struct {
+	// IssuerURL is the URL of the OIDC issuer.
+	IssuerURL string
+
+	// ClientID is the OIDC client ID.
+	ClientID string
+
+	// ClientSecret is the OIDC client secret, if any.
+	ClientSecret string
+
+	// ExtraScopes are additional scopes to request to the OIDC issuer.
+	ExtraScopes []string
 }
 
 func (c *clusterctlClient) GetKubeconfig(ctx context.Context, options GetKubeconfigOptions) (string, error) {
@@ -58,5 +112,58 @@ func (c *clusterctlClient) GetKubeconfig(ctx context.Context, options GetKubecon
 		options.Namespace = currentNamespace
 	}
 
-	return clusterClient.WorkloadCluster().GetKubeconfig(ctx, options.WorkloadClusterName, options.Namespace)
+	if options.User == nil {
+		return clusterClient.WorkloadCluster().GetKubeconfig(ctx, options.WorkloadClusterName, options.Namespace)
+	}
+
+	user, err := options.User.toKubeconfigUser()
+	if err != nil {
+		return "", err
+	}
+	return clusterClient.WorkloadCluster().GetUserKubeconfig(ctx, options.WorkloadClusterName, options.Namespace, user)
+}
+
+// toKubeconfigUser converts KubeconfigUserOptions into a cluster.KubeconfigUser; OIDC settings are
+// translated into the corresponding exec credential plugin.
+func (o *KubeconfigUserOptions) toKubeconfigUser() (cluster.KubeconfigUser, error) {
+	user := cluster.KubeconfigUser{Name: o.Name}
+
+	switch {
+	case o.Exec != nil && o.OIDC != nil:
+		return user, errors.New("only one of exec credential plugin or OIDC settings can be specified")
+	case o.Exec != nil:
+		if o.Exec.Command == "" {
+			return user, errors.New("exec credential plugin command must be specified")
+		}
+		user.Exec = cluster.ExecCredentialPlugin{
+			Command:    o.Exec.Command,
+			Args:       o.Exec.Args,
+			Env:        o.Exec.Env,
+			APIVersion: o.Exec.APIVersion,
+		}
+	case o.OIDC != nil:
+		if o.OIDC.IssuerURL == "" || o.OIDC.ClientID == "" {
+			return user, errors.New("OIDC issuer URL and client ID must be specified")
+		}
+		args := []string{
+			"oidc-login",
+			"get-token",
+			"--oidc-issuer-url=" + o.OIDC.IssuerURL,
+			"--oidc-client-id=" + o.OIDC.ClientID,
+		}
+		if o.OIDC.ClientSecret != "" {
+			args = append(args, "--oidc-client-secret="+o.OIDC.ClientSecret)
+		}
+		for _, scope := range o.OIDC.ExtraScopes {
+			args = append(args, "--oidc-extra-scope="+scope)
+		}
+		user.Exec = cluster.ExecCredentialPlugin{
+			Command: "kubectl",
+			Args:    args,
+		}
+	default:
+		return user, errors.New("either exec credential plugin or OIDC settings must be specified")
+	}
+
+	return user, nil
 }
@@ -70,3 +70,100 @@ func Test_clusterctlClient_GetKubeconfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_KubeconfigUserOptions_toKubeconfigUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		options   KubeconfigUserOptions
+		want      cluster.KubeconfigUser
+		expectErr bool
+	}{
+		{
+			name: "exec credential plugin",
+			options: KubeconfigUserOptions{
+				Name: "developer",
+				Exec: &ExecCredentialPluginOptions{
+					Command:    "get-token",
+					Args:       []string{"--cluster={{ .Name }}"},
+					Env:        map[string]string{"FOO": "bar"},
+					APIVersion: "client.authentication.k8s.io/v1beta1",
+				},
+			},
+			want: cluster.KubeconfigUser{
+				Name: "developer",
+				Exec: cluster.ExecCredentialPlugin{
+					Command:    "get-token",
+					Args:       []string{"--cluster={{ .Name }}"},
+					Env:        map[string]string{"FOO": "bar"},
+					APIVersion: "client.authentication.k8s.io/v1beta1",
+				},
+			},
+		},
+		{
+			name: "OIDC",
+			options: KubeconfigUserOptions{
+				OIDC: &OIDCOptions{
+					IssuerURL:    "https://issuer.example.com",
+					ClientID:     "{{ .Name }}",
+					ClientSecret: "secret",
+					ExtraScopes:  []string{"email", "groups"},
+				},
+			},
+			want: cluster.KubeconfigUser{
+				Exec: cluster.ExecCredentialPlugin{
+					Command: "kubectl",
+					Args: []string{
+						"oidc-login",
+						"get-token",
+						"--oidc-issuer-url=https://issuer.example.com",
+						"--oidc-client-id={{ .Name }}",
+						"--oidc-client-secret=secret",
+						"--oidc-extra-scope=email",
+						"--oidc-extra-scope=groups",
+					},
+				},
+			},
+		},
+		{
+			name: "returns error if both exec credential plugin and OIDC are set",
+			options: KubeconfigUserOptions{
+				Exec: &ExecCredentialPluginOptions{Command: "get-token"},
+				OIDC: &OIDCOptions{IssuerURL: "https://issuer.example.com", ClientID: "client"},
+			},
+			expectErr: true,
+		},
+		{
+			name:      "returns error if neither exec credential plugin nor OIDC are set",
+			options:   KubeconfigUserOptions{},
+			expectErr: true,
+		},
+		{
+			name: "returns error if the exec credential plugin command is empty",
+			options: KubeconfigUserOptions{
+				Exec: &ExecCredentialPluginOptions{},
+			},
+			expectErr: true,
+		},
+		{
+			name: "returns error if the OIDC client ID is empty",
+			options: KubeconfigUserOptions{
+				OIDC: &OIDCOptions{IssuerURL: "https://issuer.example.com"},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := tt.options.toKubeconfigUser()
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(BeComparableTo(tt.want))
+		})
+	}
+}
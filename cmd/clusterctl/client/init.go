@@ -119,8 +119,18 @@ func (c *clusterctlClient) Init(ctx context.Context, options InitOptions) ([]Com
 	// if not we consider this the first time init is executed, and thus we enforce the installation of a core provider,
 	// a bootstrap provider and a control-plane provider (if not already explicitly requested by the user)
 	log.Info("Fetching providers")
+	if c.bundle != nil {
+		c.bundle.setInitProviders(&options)
+	}
 	firstRun := c.addDefaultProviders(ctx, clusterClient, &options)
 
+	// If initializing from a bundle, ensure all the providers to install are included in the bundle.
+	if c.bundle != nil {
+		if err := c.bundle.validateInitProviders(options); err != nil {
+			return nil, err
+		}
+	}
+
 	// create an installer service, add the requested providers to the install queue and then perform validation
 	// of the target state of the management cluster before starting the installation.
 	installer, err := c.setupInstaller(ctx, clusterClient, options)
@@ -190,8 +200,18 @@ func (c *clusterctlClient) InitImages(ctx context.Context, options InitOptions)
 	// checks if the cluster already contains a Core provider.
 	// if not we consider this the first time init is executed, and thus we enforce the installation of a core provider,
 	// a bootstrap provider and a control-plane provider (if not already explicitly requested by the user)
+	if c.bundle != nil {
+		c.bundle.setInitProviders(&options)
+	}
 	c.addDefaultProviders(ctx, clusterClient, &options)
 
+	// If listing images from a bundle, ensure all the providers to install are included in the bundle.
+	if c.bundle != nil {
+		if err := c.bundle.validateInitProviders(options); err != nil {
+			return nil, err
+		}
+	}
+
 	// skip variable parsing when listing images
 	options.skipTemplateProcess = true
 
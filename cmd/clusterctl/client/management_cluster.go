@@ -0,0 +1,446 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ApplyManagementClusterOptions carries the options supported by ApplyManagementCluster.
+type ApplyManagementClusterOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// CoreProvider version (e.g. cluster-api:v1.1.5) to add to the ManagementCluster. If unspecified and
+	// the core provider is not installed yet, the cluster-api core provider is added.
+	CoreProvider string
+
+	// BootstrapProviders and versions (e.g. kubeadm:v1.1.5) to add to the ManagementCluster.
+	// If unspecified and the core provider is not installed yet, the kubeadm bootstrap provider is added.
+	BootstrapProviders []string
+
+	// InfrastructureProviders and versions (e.g. aws:v0.5.0) to add to the ManagementCluster.
+	InfrastructureProviders []string
+
+	// ControlPlaneProviders and versions (e.g. kubeadm:v1.1.5) to add to the ManagementCluster.
+	// If unspecified and the core provider is not installed yet, the kubeadm control plane provider is added.
+	ControlPlaneProviders []string
+
+	// IPAMProviders and versions (e.g. infoblox:v0.0.1) to add to the ManagementCluster.
+	IPAMProviders []string
+
+	// RuntimeExtensionProviders and versions (e.g. test:v0.0.1) to add to the ManagementCluster.
+	RuntimeExtensionProviders []string
+
+	// AddonProviders and versions (e.g. helm:v0.1.0) to add to the ManagementCluster.
+	AddonProviders []string
+
+	// TargetNamespace defines the namespace where the providers should be deployed. If unspecified, the
+	// TargetNamespace of the ManagementCluster is preserved.
+	TargetNamespace string
+}
+
+// ReconcileManagementClusterOptions carries the options supported by ReconcileManagementCluster.
+type ReconcileManagementClusterOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// WaitProviders instructs the reconcile to wait till the providers are installed or upgraded.
+	WaitProviders bool
+
+	// WaitProviderTimeout sets the timeout per provider install or upgrade.
+	WaitProviderTimeout time.Duration
+}
+
+// ApplyManagementCluster records the given providers in the ManagementCluster object of a management cluster,
+// creating it if it does not exist yet.
+// When the ManagementCluster is created, the providers already installed in the management cluster are recorded with
+// their current version, so they are adopted by the declarative mode.
+func (c *clusterctlClient) ApplyManagementCluster(ctx context.Context, options ApplyManagementClusterOptions) (*clusterctlv1.ManagementCluster, error) {
+	log := logf.Log
+
+	// gets access to the management cluster
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure the custom resource definitions required by clusterctl are in place
+	if err := clusterClient.ProviderInventory().EnsureCustomResourceDefinitions(ctx); err != nil {
+		return nil, err
+	}
+
+	// Ensure this command only runs against management clusters with the current Cluster API contract.
+	if err := clusterClient.ProviderInventory().CheckCAPIContract(ctx, cluster.AllowCAPINotInstalled{}); err != nil {
+		return nil, err
+	}
+
+	// If the core provider is not installed yet, add the default providers like init does.
+	initOptions := InitOptions{
+		CoreProvider:              options.CoreProvider,
+		BootstrapProviders:        options.BootstrapProviders,
+		ControlPlaneProviders:     options.ControlPlaneProviders,
+		InfrastructureProviders:   options.InfrastructureProviders,
+		IPAMProviders:             options.IPAMProviders,
+		RuntimeExtensionProviders: options.RuntimeExtensionProviders,
+		AddonProviders:            options.AddonProviders,
+	}
+	c.addDefaultProviders(ctx, clusterClient, &initOptions)
+
+	providers, err := managementClusterProvidersFromInitOptions(initOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := clusterClient.Proxy().NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	managementCluster := &clusterctlv1.ManagementCluster{}
+	if err := cl.Get(ctx, client.ObjectKey{Name: clusterctlv1.ManagementClusterName}, managementCluster); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "failed to get ManagementCluster %s", clusterctlv1.ManagementClusterName)
+		}
+
+		installedProviders, err := clusterClient.ProviderInventory().List(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		managementCluster = &clusterctlv1.ManagementCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   clusterctlv1.ManagementClusterName,
+				Labels: map[string]string{clusterctlv1.ClusterctlCoreLabel: clusterctlv1.ClusterctlCoreLabelInventoryValue},
+			},
+			Spec: clusterctlv1.ManagementClusterSpec{
+				TargetNamespace: options.TargetNamespace,
+			},
+		}
+		for _, p := range installedProviders.Items {
+			managementCluster.Spec.Providers = setManagementClusterProvider(managementCluster.Spec.Providers, clusterctlv1.ManagementClusterProvider{
+				Type:    p.GetProviderType(),
+				Name:    p.ProviderName,
+				Version: p.Version,
+			})
+		}
+		for _, p := range providers {
+			managementCluster.Spec.Providers = setManagementClusterProvider(managementCluster.Spec.Providers, p)
+		}
+
+		log.Info("Creating ManagementCluster", "name", managementCluster.Name)
+		if err := cl.Create(ctx, managementCluster); err != nil {
+			return nil, errors.Wrapf(err, "failed to create ManagementCluster %s", managementCluster.Name)
+		}
+		return managementCluster, nil
+	}
+
+	for _, p := range providers {
+		managementCluster.Spec.Providers = setManagementClusterProvider(managementCluster.Spec.Providers, p)
+	}
+	if options.TargetNamespace != "" {
+		managementCluster.Spec.TargetNamespace = options.TargetNamespace
+	}
+
+	log.Info("Updating ManagementCluster", "name", managementCluster.Name)
+	if err := cl.Update(ctx, managementCluster); err != nil {
+		return nil, errors.Wrapf(err, "failed to update ManagementCluster %s", managementCluster.Name)
+	}
+	return managementCluster, nil
+}
+
+// ReconcileManagementCluster installs and upgrades the providers in a management cluster according to its ManagementCluster object.
+// NOTE: Providers which are installed in the management cluster but not listed in the ManagementCluster are never deleted.
+func (c *clusterctlClient) ReconcileManagementCluster(ctx context.Context, options ReconcileManagementClusterOptions) (*clusterctlv1.ManagementCluster, error) {
+	// gets access to the management cluster
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure the custom resource definitions required by clusterctl are in place
+	if err := clusterClient.ProviderInventory().EnsureCustomResourceDefinitions(ctx); err != nil {
+		return nil, err
+	}
+
+	cl, err := clusterClient.Proxy().NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	managementCluster := &clusterctlv1.ManagementCluster{}
+	if err := cl.Get(ctx, client.ObjectKey{Name: clusterctlv1.ManagementClusterName}, managementCluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, errors.Errorf("ManagementCluster %s does not exist, use clusterctl init --declarative to create it", clusterctlv1.ManagementClusterName)
+		}
+		return nil, errors.Wrapf(err, "failed to get ManagementCluster %s", clusterctlv1.ManagementClusterName)
+	}
+
+	reconcileErr := c.reconcileManagementClusterProviders(ctx, clusterClient, managementCluster, options)
+
+	if err := cl.Status().Update(ctx, managementCluster); err != nil {
+		return nil, kerrors.NewAggregate([]error{reconcileErr, errors.Wrapf(err, "failed to update ManagementCluster %s status", managementCluster.Name)})
+	}
+	if reconcileErr != nil {
+		return nil, reconcileErr
+	}
+	return managementCluster, nil
+}
+
+// reconcileManagementClusterProviders installs and upgrades providers and sets the ManagementCluster status accordingly.
+func (c *clusterctlClient) reconcileManagementClusterProviders(ctx context.Context, clusterClient cluster.Client, managementCluster *clusterctlv1.ManagementCluster, options ReconcileManagementClusterOptions) error {
+	log := logf.Log
+
+	managementCluster.Status.ObservedGeneration = managementCluster.Generation
+
+	installedProviders, err := clusterClient.ProviderInventory().List(ctx)
+	if err != nil {
+		setManagementClusterNotReady(managementCluster, clusterctlv1.ManagementClusterInternalErrorReason, "Please check clusterctl logs for errors")
+		return err
+	}
+
+	installOptions, upgradeOptions, err := planManagementCluster(managementCluster.Spec, installedProviders)
+	if err != nil {
+		setManagementClusterNotReady(managementCluster, clusterctlv1.ManagementClusterInternalErrorReason, err.Error())
+		return err
+	}
+
+	if installOptions != nil {
+		installOptions.Kubeconfig = options.Kubeconfig
+		installOptions.WaitProviders = options.WaitProviders
+		installOptions.WaitProviderTimeout = options.WaitProviderTimeout
+
+		log.Info("Installing providers defined in the ManagementCluster", "name", managementCluster.Name)
+		if _, err := c.Init(ctx, *installOptions); err != nil {
+			setManagementClusterNotReady(managementCluster, clusterctlv1.ManagementClusterInstallFailedReason, err.Error())
+			return err
+		}
+	}
+
+	if upgradeOptions != nil {
+		upgradeOptions.Kubeconfig = options.Kubeconfig
+		upgradeOptions.WaitProviders = options.WaitProviders
+		upgradeOptions.WaitProviderTimeout = options.WaitProviderTimeout
+
+		log.Info("Upgrading providers defined in the ManagementCluster", "name", managementCluster.Name)
+		if err := c.ApplyUpgrade(ctx, *upgradeOptions); err != nil {
+			setManagementClusterNotReady(managementCluster, clusterctlv1.ManagementClusterUpgradeFailedReason, err.Error())
+			return err
+		}
+	}
+
+	installedProviders, err = clusterClient.ProviderInventory().List(ctx)
+	if err != nil {
+		setManagementClusterNotReady(managementCluster, clusterctlv1.ManagementClusterInternalErrorReason, "Please check clusterctl logs for errors")
+		return err
+	}
+	managementCluster.Status.Providers = managementClusterProviderStatuses(installedProviders)
+
+	conditions.Set(managementCluster, metav1.Condition{
+		Type:               clusterctlv1.ManagementClusterReadyCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             clusterctlv1.ManagementClusterReadyReason,
+		ObservedGeneration: managementCluster.Generation,
+	})
+	return nil
+}
+
+func setManagementClusterNotReady(managementCluster *clusterctlv1.ManagementCluster, reason, message string) {
+	conditions.Set(managementCluster, metav1.Condition{
+		Type:               clusterctlv1.ManagementClusterReadyCondition,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: managementCluster.Generation,
+	})
+}
+
+// planManagementCluster compares the providers in the ManagementCluster spec with the installed providers and
+// returns the options for installing the missing providers and for upgrading the providers with a different version.
+// nil options are returned when there is nothing to install or to upgrade.
+func planManagementCluster(spec clusterctlv1.ManagementClusterSpec, installedProviders *clusterctlv1.ProviderList) (*InitOptions, *ApplyUpgradeOptions, error) {
+	install := &InitOptions{TargetNamespace: spec.TargetNamespace}
+	upgrade := &ApplyUpgradeOptions{}
+	needsInstall, needsUpgrade := false, false
+
+	for _, p := range spec.Providers {
+		installed := installedProviders.FilterByProviderNameAndType(p.Name, p.Type)
+		if len(installed) == 0 {
+			if err := addProviderToOptions(p, &install.CoreProvider, install.providerLists()); err != nil {
+				return nil, nil, err
+			}
+			needsInstall = true
+			continue
+		}
+
+		if p.Version == "" {
+			continue
+		}
+		for _, i := range installed {
+			if i.Version == p.Version {
+				continue
+			}
+			if err := addProviderToOptions(p, &upgrade.CoreProvider, upgrade.providerLists()); err != nil {
+				return nil, nil, err
+			}
+			needsUpgrade = true
+			break
+		}
+	}
+
+	if !needsInstall {
+		install = nil
+	}
+	if !needsUpgrade {
+		upgrade = nil
+	}
+	return install, upgrade, nil
+}
+
+// addProviderToOptions adds a provider in the name[:version] format to the option field corresponding to its type.
+func addProviderToOptions(p clusterctlv1.ManagementClusterProvider, coreProvider *string, providerLists map[clusterctlv1.ProviderType]*[]string) error {
+	ref := p.Name
+	if p.Version != "" {
+		ref = fmt.Sprintf("%s:%s", p.Name, p.Version)
+	}
+
+	if p.Type == clusterctlv1.CoreProviderType {
+		if *coreProvider != "" {
+			return errors.Errorf("invalid ManagementCluster: only one %s can be defined", clusterctlv1.CoreProviderType)
+		}
+		*coreProvider = ref
+		return nil
+	}
+
+	list, ok := providerLists[p.Type]
+	if !ok {
+		return errors.Errorf("invalid ManagementCluster: provider %s has an unknown type %q", p.Name, p.Type)
+	}
+	*list = append(*list, ref)
+	return nil
+}
+
+func (o *InitOptions) providerLists() map[clusterctlv1.ProviderType]*[]string {
+	return map[clusterctlv1.ProviderType]*[]string{
+		clusterctlv1.BootstrapProviderType:        &o.BootstrapProviders,
+		clusterctlv1.ControlPlaneProviderType:     &o.ControlPlaneProviders,
+		clusterctlv1.InfrastructureProviderType:   &o.InfrastructureProviders,
+		clusterctlv1.IPAMProviderType:             &o.IPAMProviders,
+		clusterctlv1.RuntimeExtensionProviderType: &o.RuntimeExtensionProviders,
+		clusterctlv1.AddonProviderType:            &o.AddonProviders,
+	}
+}
+
+func (o *ApplyUpgradeOptions) providerLists() map[clusterctlv1.ProviderType]*[]string {
+	return map[clusterctlv1.ProviderType]*[]string{
+		clusterctlv1.BootstrapProviderType:        &o.BootstrapProviders,
+		clusterctlv1.ControlPlaneProviderType:     &o.ControlPlaneProviders,
+		clusterctlv1.InfrastructureProviderType:   &o.InfrastructureProviders,
+		clusterctlv1.IPAMProviderType:             &o.IPAMProviders,
+		clusterctlv1.RuntimeExtensionProviderType: &o.RuntimeExtensionProviders,
+		clusterctlv1.AddonProviderType:            &o.AddonProviders,
+	}
+}
+
+// managementClusterProvidersFromInitOptions converts the providers in the init options into ManagementCluster providers.
+func managementClusterProvidersFromInitOptions(options InitOptions) ([]clusterctlv1.ManagementClusterProvider, error) {
+	providers := []clusterctlv1.ManagementClusterProvider{}
+	add := func(providerType clusterctlv1.ProviderType, refs ...string) error {
+		for _, ref := range refs {
+			// It is possible to opt-out from automatic installation of bootstrap/control-plane providers using '-' as a provider name (NoopProvider).
+			if ref == NoopProvider {
+				if providerType == clusterctlv1.CoreProviderType {
+					return errors.New("the '-' value can not be used for the core provider")
+				}
+				continue
+			}
+			name, version, err := parseProviderName(ref)
+			if err != nil {
+				return err
+			}
+			providers = append(providers, clusterctlv1.ManagementClusterProvider{Type: providerType, Name: name, Version: version})
+		}
+		return nil
+	}
+
+	if options.CoreProvider != "" {
+		if err := add(clusterctlv1.CoreProviderType, options.CoreProvider); err != nil {
+			return nil, err
+		}
+	}
+	for _, providerType := range []clusterctlv1.ProviderType{
+		clusterctlv1.BootstrapProviderType,
+		clusterctlv1.ControlPlaneProviderType,
+		clusterctlv1.InfrastructureProviderType,
+		clusterctlv1.IPAMProviderType,
+		clusterctlv1.RuntimeExtensionProviderType,
+		clusterctlv1.AddonProviderType,
+	} {
+		if err := add(providerType, *options.providerLists()[providerType]...); err != nil {
+			return nil, err
+		}
+	}
+	return providers, nil
+}
+
+// setManagementClusterProvider adds a provider to the list, or replaces the provider with the same type and name;
+// when replacing a provider, a core provider with a different name is replaced as well given that there can be only one.
+func setManagementClusterProvider(providers []clusterctlv1.ManagementClusterProvider, provider clusterctlv1.ManagementClusterProvider) []clusterctlv1.ManagementClusterProvider {
+	for i := range providers {
+		if providers[i].Type == provider.Type && (providers[i].Name == provider.Name || provider.Type == clusterctlv1.CoreProviderType) {
+			providers[i] = provider
+			return providers
+		}
+	}
+	return append(providers, provider)
+}
+
+// managementClusterProviderStatuses returns the installed providers sorted by type and name.
+func managementClusterProviderStatuses(installedProviders *clusterctlv1.ProviderList) []clusterctlv1.ManagementClusterProviderStatus {
+	statuses := make([]clusterctlv1.ManagementClusterProviderStatus, 0, len(installedProviders.Items))
+	for _, p := range installedProviders.Items {
+		statuses = append(statuses, clusterctlv1.ManagementClusterProviderStatus{
+			Type:      p.GetProviderType(),
+			Name:      p.ProviderName,
+			Namespace: p.Namespace,
+			Version:   p.Version,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].Type.Order() != statuses[j].Type.Order() {
+			return statuses[i].Type.Order() < statuses[j].Type.Order()
+		}
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
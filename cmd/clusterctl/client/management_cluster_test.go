@@ -0,0 +1,224 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+func Test_planManagementCluster(t *testing.T) {
+	installedProviders := &clusterctlv1.ProviderList{
+		Items: []clusterctlv1.Provider{
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "capi-system", Name: "cluster-api"}, ProviderName: "cluster-api", Type: string(clusterctlv1.CoreProviderType), Version: "v1.0.0"},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "capi-kubeadm-bootstrap-system", Name: "bootstrap-kubeadm"}, ProviderName: "kubeadm", Type: string(clusterctlv1.BootstrapProviderType), Version: "v1.0.0"},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "capa-system", Name: "infrastructure-aws"}, ProviderName: "aws", Type: string(clusterctlv1.InfrastructureProviderType), Version: "v2.0.0"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		spec        clusterctlv1.ManagementClusterSpec
+		wantInstall *InitOptions
+		wantUpgrade *ApplyUpgradeOptions
+		wantErr     bool
+	}{
+		{
+			name: "nothing to do if all the providers are installed with the desired version",
+			spec: clusterctlv1.ManagementClusterSpec{
+				Providers: []clusterctlv1.ManagementClusterProvider{
+					{Type: clusterctlv1.CoreProviderType, Name: "cluster-api", Version: "v1.0.0"},
+					{Type: clusterctlv1.BootstrapProviderType, Name: "kubeadm", Version: "v1.0.0"},
+					{Type: clusterctlv1.InfrastructureProviderType, Name: "aws"},
+				},
+			},
+		},
+		{
+			name: "install providers which are not installed",
+			spec: clusterctlv1.ManagementClusterSpec{
+				Providers: []clusterctlv1.ManagementClusterProvider{
+					{Type: clusterctlv1.CoreProviderType, Name: "cluster-api", Version: "v1.0.0"},
+					{Type: clusterctlv1.ControlPlaneProviderType, Name: "kubeadm", Version: "v1.0.0"},
+					{Type: clusterctlv1.InfrastructureProviderType, Name: "vsphere"},
+					{Type: clusterctlv1.AddonProviderType, Name: "helm", Version: "v0.1.0"},
+				},
+				TargetNamespace: "capi",
+			},
+			wantInstall: &InitOptions{
+				ControlPlaneProviders:   []string{"kubeadm:v1.0.0"},
+				InfrastructureProviders: []string{"vsphere"},
+				AddonProviders:          []string{"helm:v0.1.0"},
+				TargetNamespace:         "capi",
+			},
+		},
+		{
+			name: "upgrade providers installed with a different version",
+			spec: clusterctlv1.ManagementClusterSpec{
+				Providers: []clusterctlv1.ManagementClusterProvider{
+					{Type: clusterctlv1.CoreProviderType, Name: "cluster-api", Version: "v1.1.0"},
+					{Type: clusterctlv1.BootstrapProviderType, Name: "kubeadm", Version: "v1.0.0"},
+					{Type: clusterctlv1.InfrastructureProviderType, Name: "aws", Version: "v2.1.0"},
+				},
+			},
+			wantUpgrade: &ApplyUpgradeOptions{
+				CoreProvider:            "cluster-api:v1.1.0",
+				InfrastructureProviders: []string{"aws:v2.1.0"},
+			},
+		},
+		{
+			name: "install and upgrade providers",
+			spec: clusterctlv1.ManagementClusterSpec{
+				Providers: []clusterctlv1.ManagementClusterProvider{
+					{Type: clusterctlv1.BootstrapProviderType, Name: "kubeadm", Version: "v1.1.0"},
+					{Type: clusterctlv1.IPAMProviderType, Name: "in-cluster", Version: "v0.1.0"},
+				},
+			},
+			wantInstall: &InitOptions{
+				IPAMProviders: []string{"in-cluster:v0.1.0"},
+			},
+			wantUpgrade: &ApplyUpgradeOptions{
+				BootstrapProviders: []string{"kubeadm:v1.1.0"},
+			},
+		},
+		{
+			name: "fail if the type of a provider is unknown",
+			spec: clusterctlv1.ManagementClusterSpec{
+				Providers: []clusterctlv1.ManagementClusterProvider{
+					{Type: clusterctlv1.ProviderType("FooProvider"), Name: "foo"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail if there is more than one core provider",
+			spec: clusterctlv1.ManagementClusterSpec{
+				Providers: []clusterctlv1.ManagementClusterProvider{
+					{Type: clusterctlv1.CoreProviderType, Name: "foo"},
+					{Type: clusterctlv1.CoreProviderType, Name: "bar"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			gotInstall, gotUpgrade, err := planManagementCluster(tt.spec, installedProviders)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(gotInstall).To(Equal(tt.wantInstall))
+			g.Expect(gotUpgrade).To(Equal(tt.wantUpgrade))
+		})
+	}
+}
+
+func Test_clusterctlClient_ApplyManagementCluster(t *testing.T) {
+	tests := []struct {
+		name                string
+		existing            *clusterctlv1.ManagementCluster
+		options             ApplyManagementClusterOptions
+		wantProviders       []clusterctlv1.ManagementClusterProvider
+		wantTargetNamespace string
+		wantErr             bool
+	}{
+		{
+			name: "create the ManagementCluster adopting the installed providers",
+			options: ApplyManagementClusterOptions{
+				InfrastructureProviders: []string{"infra:v1.1.0", "foo"},
+				TargetNamespace:         "capi",
+			},
+			wantProviders: []clusterctlv1.ManagementClusterProvider{
+				{Type: clusterctlv1.BootstrapProviderType, Name: "kubeadm", Version: providerVersion},
+				{Type: clusterctlv1.CoreProviderType, Name: "cluster-api", Version: providerVersion},
+				{Type: clusterctlv1.ControlPlaneProviderType, Name: "kubeadm", Version: providerVersion},
+				{Type: clusterctlv1.InfrastructureProviderType, Name: "infra", Version: "v1.1.0"},
+				{Type: clusterctlv1.InfrastructureProviderType, Name: "foo"},
+			},
+			wantTargetNamespace: "capi",
+		},
+		{
+			name: "update the ManagementCluster",
+			existing: &clusterctlv1.ManagementCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterctlv1.ManagementClusterName},
+				Spec: clusterctlv1.ManagementClusterSpec{
+					Providers: []clusterctlv1.ManagementClusterProvider{
+						{Type: clusterctlv1.CoreProviderType, Name: "cluster-api", Version: providerVersion},
+						{Type: clusterctlv1.InfrastructureProviderType, Name: "infra", Version: providerVersion},
+					},
+					TargetNamespace: "capi",
+				},
+			},
+			options: ApplyManagementClusterOptions{
+				CoreProvider:  "cluster-api:v1.1.0",
+				IPAMProviders: []string{"in-cluster:v0.1.0"},
+			},
+			wantProviders: []clusterctlv1.ManagementClusterProvider{
+				{Type: clusterctlv1.CoreProviderType, Name: "cluster-api", Version: "v1.1.0"},
+				{Type: clusterctlv1.InfrastructureProviderType, Name: "infra", Version: providerVersion},
+				{Type: clusterctlv1.IPAMProviderType, Name: "in-cluster", Version: "v0.1.0"},
+			},
+			wantTargetNamespace: "capi",
+		},
+		{
+			name: "fail if the core provider is '-'",
+			options: ApplyManagementClusterOptions{
+				CoreProvider: NoopProvider,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			c := fakeClusterForDelete()
+			kubeconfig := cluster.Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"}
+			if tt.existing != nil {
+				c.clusters[kubeconfig].(*fakeClusterClient).WithObjs(tt.existing)
+			}
+
+			tt.options.Kubeconfig = Kubeconfig(kubeconfig)
+			_, err := c.ApplyManagementCluster(ctx, tt.options)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			cl, err := c.clusters[kubeconfig].Proxy().NewClient(ctx)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			managementCluster := &clusterctlv1.ManagementCluster{}
+			g.Expect(cl.Get(ctx, client.ObjectKey{Name: clusterctlv1.ManagementClusterName}, managementCluster)).To(Succeed())
+			g.Expect(managementCluster.Spec.Providers).To(ConsistOf(tt.wantProviders))
+			g.Expect(managementCluster.Spec.TargetNamespace).To(Equal(tt.wantTargetNamespace))
+		})
+	}
+}
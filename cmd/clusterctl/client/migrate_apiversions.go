@@ -0,0 +1,50 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+// MigrateAPIVersionsOptions carries the options supported by MigrateAPIVersions.
+type MigrateAPIVersionsOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// DryRun defines if objects stored at deprecated API versions should only be reported, without migrating them.
+	DryRun bool
+}
+
+func (c *clusterctlClient) MigrateAPIVersions(ctx context.Context, options MigrateAPIVersionsOptions) (*cluster.APIVersionMigrationReport, error) {
+	// gets access to the management cluster
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	proxyClient, err := clusterClient.Proxy().NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster.NewAPIVersionMigrator(proxyClient).Migrate(ctx, cluster.APIVersionMigrationOptions{
+		DryRun: options.DryRun,
+	})
+}
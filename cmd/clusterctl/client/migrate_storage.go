@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+// MigrateStorageOptions carries the options supported by MigrateStorage.
+type MigrateStorageOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Providers limits the migration to the CRDs of the given providers, e.g. infrastructure-docker.
+	// If empty, the CRDs of all the providers are migrated.
+	Providers []string
+
+	// DryRun defines if CRDs requiring migration should only be reported, without migrating them.
+	DryRun bool
+}
+
+func (c *clusterctlClient) MigrateStorage(ctx context.Context, options MigrateStorageOptions) ([]cluster.CRDMigration, error) {
+	// gets access to the management cluster
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure this command only runs against management clusters with the current Cluster API contract.
+	if err := clusterClient.ProviderInventory().CheckCAPIContract(ctx); err != nil {
+		return nil, err
+	}
+
+	proxyClient, err := clusterClient.Proxy().NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster.NewCRDMigrator(proxyClient).MigrateInstalled(ctx, cluster.CRDMigrationOptions{
+		Providers: options.Providers,
+		DryRun:    options.DryRun,
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"os"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
 )
@@ -39,6 +40,14 @@ type MoveOptions struct {
 	// namespace will be used.
 	Namespace string
 
+	// ClusterSelector is a label selector restricting move to the matching Clusters and to the objects belonging to them.
+	// If unspecified, all the Clusters in the namespace will be moved.
+	ClusterSelector string
+
+	// ExcludeKinds lists the kinds of the objects to be excluded from move, in the Kind or Kind.group format,
+	// e.g. AWSClusterStaticIdentity.infrastructure.cluster.x-k8s.io.
+	ExcludeKinds []string
+
 	// ExperimentalResourceMutatorFn accepts any number of resource mutator functions that are applied on all resources being moved.
 	// This is an experimental feature and is exposed only from the library and not (yet) through the CLI.
 	ExperimentalResourceMutators []cluster.ResourceMutatorFunc
@@ -51,6 +60,15 @@ type MoveOptions struct {
 
 	// DryRun means the move action is a dry run, no real action will be performed.
 	DryRun bool
+
+	// StateFile is the path of a local file where the progress of the move is recorded. If the file already exists,
+	// e.g. because a previous move failed midway, the move is resumed from the recorded state.
+	// The file is deleted once the move is completed.
+	StateFile string
+
+	// Cleanup reverts the pauses applied to the source management cluster by the move recorded in StateFile,
+	// e.g. after aborting it, instead of moving objects.
+	Cleanup bool
 }
 
 func (c *clusterctlClient) Move(ctx context.Context, options MoveOptions) error {
@@ -59,6 +77,20 @@ func (c *clusterctlClient) Move(ctx context.Context, options MoveOptions) error
 		return errors.Errorf("can't set both FromDirectory and ToDirectory")
 	}
 
+	if options.StateFile != "" && (options.FromDirectory != "" || options.ToDirectory != "") {
+		return errors.Errorf("can't set StateFile together with FromDirectory or ToDirectory")
+	}
+
+	if options.Cleanup {
+		if options.StateFile == "" {
+			return errors.Errorf("StateFile must be set when using Cleanup")
+		}
+		if options.DryRun || options.FromDirectory != "" || options.ToDirectory != "" {
+			return errors.Errorf("can't set Cleanup together with DryRun, FromDirectory or ToDirectory")
+		}
+		return c.cleanupMove(ctx, options)
+	}
+
 	if !options.DryRun &&
 		options.FromDirectory == "" &&
 		options.ToDirectory == "" &&
@@ -99,7 +131,22 @@ func (c *clusterctlClient) move(ctx context.Context, options MoveOptions) error
 		}
 	}
 
-	return fromCluster.ObjectMover().Move(ctx, options.Namespace, toCluster, options.DryRun, options.ExperimentalResourceMutators...)
+	filter, err := getMoveFilter(options)
+	if err != nil {
+		return err
+	}
+
+	return fromCluster.ObjectMover().Move(ctx, options.Namespace, filter, toCluster, options.DryRun, options.StateFile, options.ExperimentalResourceMutators...)
+}
+
+func (c *clusterctlClient) cleanupMove(ctx context.Context, options MoveOptions) error {
+	// Get the client for interacting with the source management cluster.
+	fromCluster, err := c.getClusterClient(ctx, options.FromKubeconfig)
+	if err != nil {
+		return err
+	}
+
+	return fromCluster.ObjectMover().Cleanup(ctx, options.StateFile)
 }
 
 func (c *clusterctlClient) fromDirectory(ctx context.Context, options MoveOptions) error {
@@ -134,7 +181,27 @@ func (c *clusterctlClient) toDirectory(ctx context.Context, options MoveOptions)
 		return err
 	}
 
-	return fromCluster.ObjectMover().ToDirectory(ctx, options.Namespace, options.ToDirectory)
+	filter, err := getMoveFilter(options)
+	if err != nil {
+		return err
+	}
+
+	return fromCluster.ObjectMover().ToDirectory(ctx, options.Namespace, filter, options.ToDirectory)
+}
+
+// getMoveFilter returns the filter for the objects to be moved.
+func getMoveFilter(options MoveOptions) (cluster.MoveFilter, error) {
+	filter := cluster.MoveFilter{
+		ExcludeKinds: options.ExcludeKinds,
+	}
+	if options.ClusterSelector != "" {
+		selector, err := labels.Parse(options.ClusterSelector)
+		if err != nil {
+			return cluster.MoveFilter{}, errors.Wrapf(err, "invalid cluster selector %q", options.ClusterSelector)
+		}
+		filter.ClusterSelector = selector
+	}
+	return filter, nil
 }
 
 func (c *clusterctlClient) getClusterClient(ctx context.Context, kubeconfig Kubeconfig) (cluster.Client, error) {
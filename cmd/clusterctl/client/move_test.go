@@ -79,6 +79,20 @@ func Test_clusterctlClient_Move(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "returns an error if the cluster selector is not valid",
+			fields: fields{
+				client: fakeClientForMove(), // core v1.0.0 (v1.0.1 available), infra v2.0.0 (v2.0.1 available)
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig:  Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:    Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					ClusterSelector: "env in (",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "returns an error if both move ToDirectory and FromDirectory is set",
 			fields: fields{
@@ -122,6 +136,47 @@ func Test_clusterctlClient_Move(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "does not return an error if Cleanup and StateFile are set",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					StateFile:      "/var/cache/move-state.yaml",
+					Cleanup:        true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "returns an error if Cleanup is set without StateFile",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					Cleanup:        true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if StateFile and ToDirectory are set",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToDirectory:    "/var/cache/toDirectory",
+					StateFile:      "/var/cache/move-state.yaml",
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -293,15 +348,20 @@ func fakeClientForMove() *fakeClient {
 
 type fakeObjectMover struct {
 	moveErr          error
+	cleanupErr       error
 	toDirectoryErr   error
 	fromDirectoryErr error
 }
 
-func (f *fakeObjectMover) Move(_ context.Context, _ string, _ cluster.Client, _ bool, _ ...cluster.ResourceMutatorFunc) error {
+func (f *fakeObjectMover) Move(_ context.Context, _ string, _ cluster.MoveFilter, _ cluster.Client, _ bool, _ string, _ ...cluster.ResourceMutatorFunc) error {
 	return f.moveErr
 }
 
-func (f *fakeObjectMover) ToDirectory(_ context.Context, _ string, _ string) error {
+func (f *fakeObjectMover) Cleanup(_ context.Context, _ string) error {
+	return f.cleanupErr
+}
+
+func (f *fakeObjectMover) ToDirectory(_ context.Context, _ string, _ cluster.MoveFilter, _ string) error {
 	return f.toDirectoryErr
 }
 
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+// RepairOwnerRefsOptions carries the options supported by RepairOwnerRefs.
+type RepairOwnerRefsOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Namespace where the Clusters live. If empty, Clusters in all the namespaces are checked,
+	// unless ClusterName is set; in this case the namespace will be inferred from the current configuration.
+	Namespace string
+
+	// ClusterName is the name of the Cluster to check. If empty, all the Clusters are checked.
+	ClusterName string
+
+	// DryRun defines if inconsistencies should only be reported, without repairing them.
+	DryRun bool
+}
+
+func (c *clusterctlClient) RepairOwnerRefs(ctx context.Context, options RepairOwnerRefsOptions) (*cluster.OwnerRefsReport, error) {
+	// gets access to the management cluster
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure this command only runs against management clusters with the current Cluster API contract.
+	if err := clusterClient.ProviderInventory().CheckCAPIContract(ctx); err != nil {
+		return nil, err
+	}
+
+	// If checking a single Cluster and the option specifying the Namespace is empty, try to detect it.
+	if options.ClusterName != "" && options.Namespace == "" {
+		currentNamespace, err := clusterClient.Proxy().CurrentNamespace()
+		if err != nil {
+			return nil, err
+		}
+		options.Namespace = currentNamespace
+	}
+
+	return clusterClient.OwnerRefsRepairer().Repair(ctx, cluster.OwnerRefsRepairOptions{
+		Namespace:   options.Namespace,
+		ClusterName: options.ClusterName,
+		DryRun:      options.DryRun,
+	})
+}
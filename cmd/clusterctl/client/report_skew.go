@@ -0,0 +1,64 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+// ReportSkewOptions carries the options supported by ReportSkew.
+type ReportSkewOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Namespace where the Clusters live. If empty, Clusters in all the namespaces are reported,
+	// unless ClusterName is set; in this case the namespace will be inferred from the current configuration.
+	Namespace string
+
+	// ClusterName is the name of the Cluster to report. If empty, all the Clusters are reported.
+	ClusterName string
+}
+
+func (c *clusterctlClient) ReportSkew(ctx context.Context, options ReportSkewOptions) (*cluster.SkewReport, error) {
+	// gets access to the management cluster
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure this command only runs against management clusters with the current Cluster API contract.
+	if err := clusterClient.ProviderInventory().CheckCAPIContract(ctx); err != nil {
+		return nil, err
+	}
+
+	// If reporting a single Cluster and the option specifying the Namespace is empty, try to detect it.
+	if options.ClusterName != "" && options.Namespace == "" {
+		currentNamespace, err := clusterClient.Proxy().CurrentNamespace()
+		if err != nil {
+			return nil, err
+		}
+		options.Namespace = currentNamespace
+	}
+
+	return clusterClient.SkewReporter().Report(ctx, cluster.SkewReportOptions{
+		Namespace:   options.Namespace,
+		ClusterName: options.ClusterName,
+	})
+}
@@ -0,0 +1,350 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/internal/util/helm"
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+const (
+	// HelmScheme is the scheme of the URL of repositories hosted on Helm chart repositories.
+	HelmScheme = "helm+https"
+
+	// helmValuesVariableSuffix is the suffix of the variable hosting the values used for rendering the chart of a provider,
+	// e.g. INFRASTRUCTURE_FOO_HELM_VALUES.
+	helmValuesVariableSuffix = "_HELM_VALUES"
+
+	// certManagerChartName is the name of the cert-manager chart; cert-manager is installed by clusterctl,
+	// so it is ignored when it is a dependency of a provider chart.
+	certManagerChartName = "cert-manager"
+)
+
+// helmRepository provides support for providers packaged as Helm charts hosted on Helm chart repositories.
+//
+// The repository URL must be in the form helm+https://{repository}/{chart}:{version}/{componentsPath}, e.g.
+// helm+https://charts.example.com/capi/infrastructure-foo:v1.0.0/infrastructure-components.yaml, where
+// https://charts.example.com/capi is the URL of the chart repository hosting the index.yaml file;
+// "latest" is also an acceptable value for version, and it is resolved to the latest version of the chart in the repository.
+//
+// The provider components yaml is served under componentsPath by rendering the chart with the values defined in the
+// {PROVIDER_LABEL}_HELM_VALUES variable, e.g. INFRASTRUCTURE_FOO_HELM_VALUES; the resulting yaml is then processed
+// like any other provider components yaml, e.g. for variable substitution.
+// All the other files, e.g. metadata.yaml or cluster templates, are read from the top level directory of the chart.
+type helmRepository struct {
+	providerConfig        config.Provider
+	configVariablesClient config.VariablesClient
+	httpClient            *http.Client
+	repositoryURL         *url.URL
+	chartName             string
+	defaultVersion        string
+	rootPath              string
+	componentsPath        string
+	index                 *helmRepositoryIndex
+	charts                map[string]*helm.Chart
+}
+
+var _ Repository = &helmRepository{}
+
+// helmRepositoryIndex is the subset of the index.yaml file of a Helm chart repository used by clusterctl.
+type helmRepositoryIndex struct {
+	Entries map[string][]helmRepositoryIndexEntry `json:"entries"`
+}
+
+// helmRepositoryIndexEntry is a chart version in the index.yaml file of a Helm chart repository.
+type helmRepositoryIndexEntry struct {
+	Version string   `json:"version"`
+	URLs    []string `json:"urls"`
+	Digest  string   `json:"digest,omitempty"`
+}
+
+type helmRepositoryOption func(*helmRepository)
+
+func injectHelmHTTPClient(httpClient *http.Client) helmRepositoryOption {
+	return func(r *helmRepository) {
+		r.httpClient = httpClient
+	}
+}
+
+// NewHelmRepository returns a helmRepository implementation.
+func NewHelmRepository(ctx context.Context, providerConfig config.Provider, configVariablesClient config.VariablesClient, opts ...helmRepositoryOption) (Repository, error) {
+	if configVariablesClient == nil {
+		return nil, errors.New("invalid arguments: configVariablesClient can't be nil")
+	}
+
+	rURL, err := url.Parse(providerConfig.URL())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url")
+	}
+
+	invalidURLError := errors.New("invalid url: a Helm repository url should be in the form helm+https://{repository}/{chart}:{version}/{componentsPath}")
+	if rURL.Scheme != HelmScheme || rURL.Host == "" {
+		return nil, invalidURLError
+	}
+
+	// Split the path in {repository}/{chart}:{version}/{componentsPath}.
+	path := strings.TrimPrefix(rURL.Path, "/")
+	i := strings.LastIndex(path, ":")
+	if i <= 0 {
+		return nil, invalidURLError
+	}
+	repositoryPath, chartName := "", path[:i]
+	if j := strings.LastIndex(chartName, "/"); j >= 0 {
+		repositoryPath, chartName = chartName[:j], chartName[j+1:]
+	}
+	versionAndComponentsPath := strings.SplitN(path[i+1:], "/", 2)
+	if chartName == "" || len(versionAndComponentsPath) != 2 || versionAndComponentsPath[0] == "" || versionAndComponentsPath[1] == "" {
+		return nil, invalidURLError
+	}
+
+	// Note: The repository URL ends with a slash, so relative chart URLs in the index are resolved like Helm does.
+	repositoryURL := &url.URL{Scheme: httpsScheme, User: rURL.User, Host: rURL.Host, Path: strings.TrimSuffix("/"+repositoryPath, "/") + "/"}
+
+	repo := &helmRepository{
+		providerConfig:        providerConfig,
+		configVariablesClient: configVariablesClient,
+		httpClient:            http.DefaultClient,
+		repositoryURL:         repositoryURL,
+		chartName:             chartName,
+		defaultVersion:        versionAndComponentsPath[0],
+		rootPath:              ".",
+		componentsPath:        versionAndComponentsPath[1],
+		charts:                map[string]*helm.Chart{},
+	}
+
+	// Process helmRepositoryOptions.
+	for _, o := range opts {
+		o(repo)
+	}
+
+	if repo.defaultVersion == latestVersionTag {
+		repo.defaultVersion, err = latestContractRelease(ctx, repo, clusterv1.GroupVersion.Version)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get latest release")
+		}
+	}
+
+	return repo, nil
+}
+
+// DefaultVersion returns defaultVersion field of helmRepository struct.
+func (r *helmRepository) DefaultVersion() string {
+	return r.defaultVersion
+}
+
+// RootPath returns rootPath field of helmRepository struct.
+func (r *helmRepository) RootPath() string {
+	return r.rootPath
+}
+
+// ComponentsPath returns componentsPath field of helmRepository struct.
+func (r *helmRepository) ComponentsPath() string {
+	return r.componentsPath
+}
+
+// GetVersions returns the list of versions of the chart that are available in the Helm repository.
+func (r *helmRepository) GetVersions(ctx context.Context) ([]string, error) {
+	index, err := r.getIndex(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the list of versions from %q", r.repositoryURL)
+	}
+
+	entries, ok := index.Entries[r.chartName]
+	if !ok {
+		return nil, errors.Wrapf(errNotFound, "failed to get the list of versions from %q: chart %q not found", r.repositoryURL, r.chartName)
+	}
+	versions := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		versions = append(versions, entry.Version)
+	}
+	return versions, nil
+}
+
+// GetFile returns a file for a given provider version; the components yaml is generated by rendering the chart.
+func (r *helmRepository) GetFile(ctx context.Context, version, path string) ([]byte, error) {
+	chart, err := r.getChart(ctx, version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get file %q with version %q from %q", path, version, r.repositoryURL)
+	}
+
+	fileName := strings.TrimPrefix(path, "./")
+	if fileName == r.componentsPath {
+		content, err := r.renderChart(chart)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get file %q with version %q from %q", path, version, r.repositoryURL)
+		}
+		return content, nil
+	}
+
+	content, ok := chart.File(fileName)
+	if !ok {
+		return nil, errors.Wrapf(errNotFound, "failed to get file %q with version %q from %q: file not found in the chart", path, version, r.repositoryURL)
+	}
+	return content, nil
+}
+
+// renderChart renders the chart with the values defined for the provider.
+// The release name is the name of the provider, while the release namespace is the default namespace for the provider;
+// if the chart does not contain a Namespace object, one is added, so the default namespace can be overridden
+// during init like for any other provider.
+func (r *helmRepository) renderChart(chart *helm.Chart) ([]byte, error) {
+	values := map[string]interface{}{}
+	if valuesYAML, err := r.configVariablesClient.Get(r.helmValuesVariable()); err == nil {
+		if err := yaml.Unmarshal([]byte(valuesYAML), &values); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse values from the %s variable", r.helmValuesVariable())
+		}
+	}
+
+	releaseNamespace := fmt.Sprintf("%s-system", r.providerConfig.ManifestLabel())
+	content, err := chart.Render(r.providerConfig.Name(), releaseNamespace, values)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to render chart %q", chart.Name())
+	}
+
+	objs, err := utilyaml.ToUnstructured(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse rendered chart %q", chart.Name())
+	}
+	for _, o := range objs {
+		if o.GetKind() == namespaceKind {
+			return content, nil
+		}
+	}
+	namespace := unstructured.Unstructured{}
+	namespace.SetAPIVersion("v1")
+	namespace.SetKind(namespaceKind)
+	namespace.SetName(releaseNamespace)
+	return utilyaml.FromUnstructured(append([]unstructured.Unstructured{namespace}, objs...))
+}
+
+// helmValuesVariable returns the name of the variable hosting the values used for rendering the chart,
+// e.g. INFRASTRUCTURE_FOO_HELM_VALUES.
+func (r *helmRepository) helmValuesVariable() string {
+	return strings.ToUpper(strings.ReplaceAll(r.providerConfig.ManifestLabel(), "-", "_")) + helmValuesVariableSuffix
+}
+
+// getChart returns the chart for a given version.
+func (r *helmRepository) getChart(ctx context.Context, version string) (*helm.Chart, error) {
+	if chart, ok := r.charts[version]; ok {
+		return chart, nil
+	}
+
+	index, err := r.getIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var entry *helmRepositoryIndexEntry
+	for i := range index.Entries[r.chartName] {
+		if index.Entries[r.chartName][i].Version == version {
+			entry = &index.Entries[r.chartName][i]
+			break
+		}
+	}
+	if entry == nil || len(entry.URLs) == 0 {
+		return nil, errors.Wrapf(errNotFound, "chart %q with version %q not found", r.chartName, version)
+	}
+
+	chartURL, err := r.repositoryURL.Parse(entry.URLs[0])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid url %q for chart %q with version %q", entry.URLs[0], r.chartName, version)
+	}
+	if chartURL.Host == r.repositoryURL.Host {
+		chartURL.User = r.repositoryURL.User
+	}
+	archive, ok := cacheFiles[chartURL.String()]
+	if !ok {
+		archive, err = r.download(ctx, chartURL)
+		if err != nil {
+			return nil, err
+		}
+		if entry.Digest != "" {
+			digest := sha256.Sum256(archive)
+			if hex.EncodeToString(digest[:]) != entry.Digest {
+				return nil, errors.Errorf("digest of chart %q with version %q does not match the digest in the repository index", r.chartName, version)
+			}
+		}
+		cacheFiles[chartURL.String()] = archive
+	}
+
+	chart, err := helm.Load(archive, helm.IgnoreDependencies(certManagerChartName))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load chart %q with version %q", r.chartName, version)
+	}
+	r.charts[version] = chart
+	return chart, nil
+}
+
+// getIndex returns the index of the Helm repository.
+func (r *helmRepository) getIndex(ctx context.Context) (*helmRepositoryIndex, error) {
+	if r.index != nil {
+		return r.index, nil
+	}
+
+	indexURL := r.repositoryURL.JoinPath("index.yaml")
+	content, err := r.download(ctx, indexURL)
+	if err != nil {
+		return nil, err
+	}
+	index := &helmRepositoryIndex{}
+	if err := yaml.Unmarshal(content, index); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %q", indexURL.Redacted())
+	}
+	r.index = index
+	return index, nil
+}
+
+// download downloads a file from the Helm repository.
+func (r *helmRepository) download(ctx context.Context, u *url.URL) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download %q: failed to create request", u.Redacted())
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download %q", u.Redacted())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errors.Wrapf(errNotFound, "failed to download %q", u.Redacted())
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to download %q: got status code %d", u.Redacted(), resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download %q", u.Redacted())
+	}
+	return content, nil
+}
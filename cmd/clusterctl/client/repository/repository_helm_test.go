@@ -0,0 +1,250 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	"sigs.k8s.io/cluster-api/internal/util/helm/helmtest"
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+// newFakeHelmRepository returns a Helm chart repository serving the given chart archives, indexed by version.
+func newFakeHelmRepository(charts map[string][]byte) *httptest.Server {
+	versions := make([]string, 0, len(charts))
+	for version := range charts {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+
+	index := "apiVersion: v1\nentries:\n  infrastructure-foo:\n"
+	for _, version := range versions {
+		digest := sha256.Sum256(charts[version])
+		if version == "v0.9.0" {
+			// Corrupt the digest to test digest verification.
+			digest = sha256.Sum256([]byte("corrupted"))
+		}
+		index += fmt.Sprintf("  - version: %s\n    urls:\n    - charts/infrastructure-foo-%s.tgz\n    digest: %s\n", version, version, hex.EncodeToString(digest[:]))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/capi/index.yaml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(index))
+	})
+	mux.HandleFunc("/capi/charts/", func(w http.ResponseWriter, req *http.Request) {
+		version := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/capi/charts/infrastructure-foo-"), ".tgz")
+		archive, ok := charts[version]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(archive)
+	})
+	return httptest.NewTLSServer(mux)
+}
+
+func testHelmChartFiles(version string) map[string]string {
+	return map[string]string{
+		"Chart.yaml":    fmt.Sprintf("apiVersion: v2\nname: infrastructure-foo\nversion: %s\n", version),
+		"values.yaml":   "replicas: 1\n",
+		"metadata.yaml": "apiVersion: clusterctl.cluster.x-k8s.io/v1alpha3\nkind: Metadata\n",
+		"templates/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}-controller-manager
+  namespace: {{ .Release.Namespace }}
+spec:
+  replicas: {{ .Values.replicas }}
+  template:
+    spec:
+      containers:
+      - name: manager
+        image: registry.example.com/foo:{{ .Chart.Version }}
+        args:
+        - --feature-gates=MachinePool=${EXP_MACHINE_POOL:=false}
+`,
+		"charts/cert-manager-v1.16.0.tgz": "",
+	}
+}
+
+func Test_helmRepository_newHelmRepository(t *testing.T) {
+	tests := []struct {
+		name               string
+		url                string
+		wantRepositoryURL  string
+		wantChartName      string
+		wantDefaultVersion string
+		wantComponentsPath string
+		wantErr            bool
+	}{
+		{
+			name:               "valid url",
+			url:                "helm+https://charts.example.com/capi/infrastructure-foo:v1.0.0/infrastructure-components.yaml",
+			wantRepositoryURL:  "https://charts.example.com/capi/",
+			wantChartName:      "infrastructure-foo",
+			wantDefaultVersion: "v1.0.0",
+			wantComponentsPath: "infrastructure-components.yaml",
+		},
+		{
+			name:               "valid url with the repository at the root",
+			url:                "helm+https://charts.example.com/infrastructure-foo:v1.0.0/infrastructure-components.yaml",
+			wantRepositoryURL:  "https://charts.example.com/",
+			wantChartName:      "infrastructure-foo",
+			wantDefaultVersion: "v1.0.0",
+			wantComponentsPath: "infrastructure-components.yaml",
+		},
+		{
+			name:    "invalid url: missing version",
+			url:     "helm+https://charts.example.com/capi/infrastructure-foo/infrastructure-components.yaml",
+			wantErr: true,
+		},
+		{
+			name:    "invalid url: missing components path",
+			url:     "helm+https://charts.example.com/capi/infrastructure-foo:v1.0.0",
+			wantErr: true,
+		},
+		{
+			name:    "invalid url: wrong scheme",
+			url:     "https://charts.example.com/capi/infrastructure-foo:v1.0.0/infrastructure-components.yaml",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			providerConfig := config.NewProvider("foo", tt.url, clusterctlv1.InfrastructureProviderType)
+			got, err := NewHelmRepository(context.Background(), providerConfig, test.NewFakeVariableClient())
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			repo := got.(*helmRepository)
+			g.Expect(repo.repositoryURL.String()).To(Equal(tt.wantRepositoryURL))
+			g.Expect(repo.chartName).To(Equal(tt.wantChartName))
+			g.Expect(repo.DefaultVersion()).To(Equal(tt.wantDefaultVersion))
+			g.Expect(repo.ComponentsPath()).To(Equal(tt.wantComponentsPath))
+			g.Expect(repo.RootPath()).To(Equal("."))
+		})
+	}
+}
+
+func Test_helmRepository(t *testing.T) {
+	cacheFiles = map[string][]byte{}
+
+	server := newFakeHelmRepository(map[string][]byte{
+		"v0.9.0": helmtest.NewChartArchive(t, "infrastructure-foo", testHelmChartFiles("v0.9.0")),
+		"v1.0.0": helmtest.NewChartArchive(t, "infrastructure-foo", testHelmChartFiles("v1.0.0")),
+		"v1.1.0": helmtest.NewChartArchive(t, "infrastructure-foo", testHelmChartFiles("v1.1.0")),
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	url := fmt.Sprintf("helm+%s/capi/infrastructure-foo:latest/infrastructure-components.yaml", server.URL)
+	providerConfig := config.NewProvider("foo", url, clusterctlv1.InfrastructureProviderType)
+	variables := test.NewFakeVariableClient().WithVar("INFRASTRUCTURE_FOO_HELM_VALUES", "replicas: 3\n")
+	repo, err := NewHelmRepository(ctx, providerConfig, variables, injectHelmHTTPClient(server.Client()))
+
+	t.Run("resolves latest to the latest version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(repo.DefaultVersion()).To(Equal("v1.1.0"))
+	})
+
+	t.Run("gets versions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		versions, err := repo.GetVersions(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(versions).To(ConsistOf("v0.9.0", "v1.0.0", "v1.1.0"))
+	})
+
+	t.Run("renders the chart with values as components yaml", func(t *testing.T) {
+		g := NewWithT(t)
+
+		content, err := repo.GetFile(ctx, "v1.0.0", "infrastructure-components.yaml")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		objs, err := utilyaml.ToUnstructured(content)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(objs).To(HaveLen(2))
+		g.Expect(objs[0].GetKind()).To(Equal("Namespace"))
+		g.Expect(objs[0].GetName()).To(Equal("infrastructure-foo-system"))
+		g.Expect(objs[1].GetKind()).To(Equal("Deployment"))
+		g.Expect(objs[1].GetName()).To(Equal("foo-controller-manager"))
+		g.Expect(objs[1].GetNamespace()).To(Equal("infrastructure-foo-system"))
+		g.Expect(objs[1].Object["spec"]).To(HaveKeyWithValue("replicas", BeNumerically("==", 3)))
+		// Variables are left in place for clusterctl variable substitution.
+		g.Expect(string(content)).To(ContainSubstring("${EXP_MACHINE_POOL:=false}"))
+		g.Expect(string(content)).To(ContainSubstring("registry.example.com/foo:v1.0.0"))
+	})
+
+	t.Run("gets other files from the chart", func(t *testing.T) {
+		g := NewWithT(t)
+
+		content, err := repo.GetFile(ctx, "v1.1.0", "metadata.yaml")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(content)).To(ContainSubstring("kind: Metadata"))
+	})
+
+	t.Run("fails with not found for missing files and versions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := repo.GetFile(ctx, "v1.0.0", "cluster-template.yaml")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(errors.Is(err, errNotFound)).To(BeTrue())
+
+		_, err = repo.GetFile(ctx, "v2.0.0", "infrastructure-components.yaml")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(errors.Is(err, errNotFound)).To(BeTrue())
+	})
+
+	t.Run("fails if the digest does not match", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := repo.GetFile(ctx, "v0.9.0", "infrastructure-components.yaml")
+		g.Expect(err).To(MatchError(ContainSubstring("does not match the digest in the repository index")))
+	})
+
+	t.Run("fails if values are not valid", func(t *testing.T) {
+		g := NewWithT(t)
+
+		variables := test.NewFakeVariableClient().WithVar("INFRASTRUCTURE_FOO_HELM_VALUES", "- invalid")
+		repo, err := NewHelmRepository(ctx, providerConfig, variables, injectHelmHTTPClient(server.Client()))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = repo.GetFile(ctx, "v1.0.0", "infrastructure-components.yaml")
+		g.Expect(err).To(MatchError(ContainSubstring("failed to parse values from the INFRASTRUCTURE_FOO_HELM_VALUES variable")))
+	})
+}
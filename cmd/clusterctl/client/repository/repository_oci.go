@@ -0,0 +1,294 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/internal/util/oci"
+)
+
+const (
+	// OCIScheme is the scheme of the URL of repositories hosted on OCI registries.
+	OCIScheme = oci.Scheme
+
+	dockerConfigEnvVariable    = "DOCKER_CONFIG"
+	dockerCredentialHelperName = "docker-credential-"
+	dockerIdentityTokenUser    = "<token>"
+)
+
+// ociRepository provides support for providers hosted on OCI registries.
+//
+// The repository URL must be in the form oci://{registry}/{repository}:{version}/{componentsPath}, e.g.
+// oci://registry.example.com/capi/infrastructure-foo:v1.0.0/infrastructure-components.yaml;
+// "latest" is also an acceptable value for version, and it is resolved to the latest version tagged in the repository.
+//
+// Each version of the provider must be pushed to the registry as an OCI artifact tagged with the version,
+// containing one layer for each file of the release (e.g. components yaml, metadata.yaml, cluster templates);
+// layers are identified by the org.opencontainers.image.title annotation, like e.g. when using
+// `oras push registry.example.com/capi/infrastructure-foo:v1.0.0 infrastructure-components.yaml metadata.yaml`.
+//
+// Credentials for accessing the registry are read from the docker config file, including credential helpers.
+type ociRepository struct {
+	providerConfig        config.Provider
+	configVariablesClient config.VariablesClient
+	httpClient            *http.Client
+	client                *oci.Client
+	registry              string
+	repository            string
+	defaultVersion        string
+	rootPath              string
+	componentsPath        string
+	manifests             map[string]*oci.Manifest
+}
+
+var _ Repository = &ociRepository{}
+
+type ociRepositoryOption func(*ociRepository)
+
+func injectOCIHTTPClient(httpClient *http.Client) ociRepositoryOption {
+	return func(r *ociRepository) {
+		r.httpClient = httpClient
+	}
+}
+
+// NewOCIRepository returns an ociRepository implementation.
+func NewOCIRepository(ctx context.Context, providerConfig config.Provider, configVariablesClient config.VariablesClient, opts ...ociRepositoryOption) (Repository, error) {
+	if configVariablesClient == nil {
+		return nil, errors.New("invalid arguments: configVariablesClient can't be nil")
+	}
+
+	rURL, err := url.Parse(providerConfig.URL())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url")
+	}
+
+	invalidURLError := errors.New("invalid url: an OCI repository url should be in the form oci://{registry}/{repository}:{version}/{componentsPath}")
+	if rURL.Scheme != OCIScheme || rURL.Host == "" {
+		return nil, invalidURLError
+	}
+
+	// Split the path in {repository}:{version}/{componentsPath}.
+	path := strings.TrimPrefix(rURL.Path, "/")
+	i := strings.LastIndex(path, ":")
+	if i <= 0 {
+		return nil, invalidURLError
+	}
+	repository := path[:i]
+	versionAndComponentsPath := strings.SplitN(path[i+1:], "/", 2)
+	if len(versionAndComponentsPath) != 2 || versionAndComponentsPath[0] == "" || versionAndComponentsPath[1] == "" {
+		return nil, invalidURLError
+	}
+
+	repo := &ociRepository{
+		providerConfig:        providerConfig,
+		configVariablesClient: configVariablesClient,
+		httpClient:            http.DefaultClient,
+		defaultVersion:        versionAndComponentsPath[0],
+		rootPath:              ".",
+		componentsPath:        versionAndComponentsPath[1],
+		manifests:             map[string]*oci.Manifest{},
+	}
+
+	// Process ociRepositoryOptions.
+	for _, o := range opts {
+		o(repo)
+	}
+
+	repo.client = oci.NewClient(rURL.Host, repository,
+		oci.WithHTTPClient(repo.httpClient),
+		oci.WithCredentials(getDockerCredentials),
+		oci.WithClientID("clusterctl"),
+	)
+	repo.registry = repo.client.Registry()
+	repo.repository = repo.client.Repository()
+
+	if repo.defaultVersion == latestVersionTag {
+		repo.defaultVersion, err = latestContractRelease(ctx, repo, clusterv1.GroupVersion.Version)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get latest release")
+		}
+	}
+
+	return repo, nil
+}
+
+// DefaultVersion returns defaultVersion field of ociRepository struct.
+func (r *ociRepository) DefaultVersion() string {
+	return r.defaultVersion
+}
+
+// RootPath returns rootPath field of ociRepository struct.
+func (r *ociRepository) RootPath() string {
+	return r.rootPath
+}
+
+// ComponentsPath returns componentsPath field of ociRepository struct.
+func (r *ociRepository) ComponentsPath() string {
+	return r.componentsPath
+}
+
+// GetVersions returns the list of tags that are available in the OCI repository.
+func (r *ociRepository) GetVersions(ctx context.Context) ([]string, error) {
+	versions, err := r.client.ListTags(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(toRepositoryError(err), "failed to get the list of versions from %q", r.repository)
+	}
+	return versions, nil
+}
+
+// GetFile returns a file for a given provider version.
+func (r *ociRepository) GetFile(ctx context.Context, version, path string) ([]byte, error) {
+	fileURL := fmt.Sprintf("%s://%s/%s:%s/%s", OCIScheme, r.registry, r.repository, version, path)
+	if content, ok := cacheFiles[fileURL]; ok {
+		return content, nil
+	}
+
+	manifest, err := r.getManifest(ctx, version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get file %q with version %q from %q", path, version, r.repository)
+	}
+
+	fileName := strings.TrimPrefix(path, "./")
+	var layer *oci.Descriptor
+	for i := range manifest.Layers {
+		if manifest.Layers[i].Annotations[oci.TitleAnnotation] == fileName {
+			layer = &manifest.Layers[i]
+			break
+		}
+	}
+	if layer == nil {
+		return nil, errors.Wrapf(errNotFound, "failed to get file %q with version %q from %q: file not found in the OCI artifact", path, version, r.repository)
+	}
+
+	content, err := r.client.GetBlob(ctx, layer.Digest)
+	if err != nil {
+		return nil, errors.Wrapf(toRepositoryError(err), "failed to get file %q with version %q from %q", path, version, r.repository)
+	}
+
+	cacheFiles[fileURL] = content
+	return content, nil
+}
+
+// getManifest returns the manifest of the OCI artifact for a given version.
+func (r *ociRepository) getManifest(ctx context.Context, version string) (*oci.Manifest, error) {
+	if manifest, ok := r.manifests[version]; ok {
+		return manifest, nil
+	}
+
+	manifest, _, err := r.client.GetManifest(ctx, version)
+	if err != nil {
+		return nil, toRepositoryError(err)
+	}
+	r.manifests[version] = manifest
+	return manifest, nil
+}
+
+// toRepositoryError translates not found errors from the OCI client into errNotFound, which is used
+// across repository implementations to detect missing versions and files.
+func toRepositoryError(err error) error {
+	if errors.Is(err, oci.ErrNotFound) {
+		return errors.Wrap(errNotFound, err.Error())
+	}
+	return err
+}
+
+// getDockerCredentials returns the credentials for a registry from the docker config file,
+// or nil if credentials for the registry are not defined.
+func getDockerCredentials(ctx context.Context, registry string) (*oci.Credentials, error) {
+	configDir := os.Getenv(dockerConfigEnvVariable)
+	if configDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, nil //nolint:nilerr // If the home directory cannot be determined, we proceed without credentials.
+		}
+		configDir = filepath.Join(homeDir, ".docker")
+	}
+
+	content, err := os.ReadFile(filepath.Join(configDir, "config.json")) //nolint:gosec // The path of the docker config file is controlled by the user.
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to read the docker config file")
+	}
+
+	dockerConfig, err := oci.ParseDockerConfig(content)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the docker config file")
+	}
+
+	configKey := oci.ConfigKey(registry)
+	if helper, ok := dockerConfig.CredHelpers[configKey]; ok {
+		return getDockerCredentialsFromHelper(ctx, helper, configKey)
+	}
+
+	credentials, err := dockerConfig.Credentials(registry)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the docker config file")
+	}
+	if credentials != nil {
+		return credentials, nil
+	}
+
+	if dockerConfig.CredsStore != "" {
+		return getDockerCredentialsFromHelper(ctx, dockerConfig.CredsStore, configKey)
+	}
+	return nil, nil
+}
+
+// getDockerCredentialsFromHelper returns the credentials for a registry using a docker credential helper.
+// See https://github.com/docker/docker-credential-helpers.
+func getDockerCredentialsFromHelper(ctx context.Context, helper, serverURL string) (*oci.Credentials, error) {
+	helperName := dockerCredentialHelperName + helper
+	cmd := exec.CommandContext(ctx, helperName, "get") //nolint:gosec // The credential helper is defined by the user in the docker config file.
+	cmd.Stdin = strings.NewReader(serverURL)
+	stdout := &bytes.Buffer{}
+	cmd.Stdout = stdout
+	if err := cmd.Run(); err != nil {
+		// Credential helpers print "credentials not found in native keychain" when there are no credentials for the server.
+		if strings.Contains(stdout.String(), "credentials not found") {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get credentials for %q using %s", serverURL, helperName)
+	}
+
+	helperCredentials := struct {
+		Username string `json:"Username"`
+		Secret   string `json:"Secret"`
+	}{}
+	if err := json.Unmarshal(stdout.Bytes(), &helperCredentials); err != nil {
+		return nil, errors.Wrapf(err, "failed to get credentials for %q using %s: failed to parse output", serverURL, helperName)
+	}
+	if helperCredentials.Username == dockerIdentityTokenUser {
+		return &oci.Credentials{IdentityToken: helperCredentials.Secret}, nil
+	}
+	return &oci.Credentials{Username: helperCredentials.Username, Password: helperCredentials.Secret}, nil
+}
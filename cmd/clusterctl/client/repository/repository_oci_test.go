@@ -0,0 +1,281 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	"sigs.k8s.io/cluster-api/internal/util/oci"
+	"sigs.k8s.io/cluster-api/internal/util/oci/ocitest"
+)
+
+// ociLayerMediaType is the media type of the layers of the artifacts used for testing, like the ones pushed by oras.
+const ociLayerMediaType = "application/vnd.oci.image.layer.v1.tar"
+
+// setDockerConfig writes a docker config file with the given content and points DOCKER_CONFIG to it.
+func setDockerConfig(t *testing.T, content string) {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "config.json"), []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(dockerConfigEnvVariable, dir)
+}
+
+func Test_ociRepository_newOCIRepository(t *testing.T) {
+	tests := []struct {
+		name               string
+		url                string
+		wantRegistry       string
+		wantRepository     string
+		wantDefaultVersion string
+		wantComponentsPath string
+		wantErr            bool
+	}{
+		{
+			name:               "valid url",
+			url:                "oci://registry.example.com/capi/infrastructure-foo:v1.0.0/infrastructure-components.yaml",
+			wantRegistry:       "registry.example.com",
+			wantRepository:     "capi/infrastructure-foo",
+			wantDefaultVersion: "v1.0.0",
+			wantComponentsPath: "infrastructure-components.yaml",
+		},
+		{
+			name:               "valid url with port",
+			url:                "oci://registry.example.com:5000/infrastructure-foo:v1.0.0/infrastructure-components.yaml",
+			wantRegistry:       "registry.example.com:5000",
+			wantRepository:     "infrastructure-foo",
+			wantDefaultVersion: "v1.0.0",
+			wantComponentsPath: "infrastructure-components.yaml",
+		},
+		{
+			name:               "valid url on Docker Hub",
+			url:                "oci://docker.io/infrastructure-foo:v1.0.0/infrastructure-components.yaml",
+			wantRegistry:       "registry-1.docker.io",
+			wantRepository:     "library/infrastructure-foo",
+			wantDefaultVersion: "v1.0.0",
+			wantComponentsPath: "infrastructure-components.yaml",
+		},
+		{
+			name:    "invalid url: missing version",
+			url:     "oci://registry.example.com/capi/infrastructure-foo/infrastructure-components.yaml",
+			wantErr: true,
+		},
+		{
+			name:    "invalid url: missing components path",
+			url:     "oci://registry.example.com/capi/infrastructure-foo:v1.0.0",
+			wantErr: true,
+		},
+		{
+			name:    "invalid url: wrong scheme",
+			url:     "https://registry.example.com/capi/infrastructure-foo:v1.0.0/infrastructure-components.yaml",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			providerConfig := config.NewProvider("test", tt.url, clusterctlv1.InfrastructureProviderType)
+			got, err := NewOCIRepository(context.Background(), providerConfig, test.NewFakeVariableClient())
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			repo := got.(*ociRepository)
+			g.Expect(repo.registry).To(Equal(tt.wantRegistry))
+			g.Expect(repo.repository).To(Equal(tt.wantRepository))
+			g.Expect(repo.DefaultVersion()).To(Equal(tt.wantDefaultVersion))
+			g.Expect(repo.ComponentsPath()).To(Equal(tt.wantComponentsPath))
+			g.Expect(repo.RootPath()).To(Equal("."))
+		})
+	}
+}
+
+func Test_ociRepository(t *testing.T) {
+	cacheFiles = map[string][]byte{}
+
+	registry := ocitest.NewRegistry(ocitest.WithBearerToken("token"))
+	defer registry.Close()
+	registry.AddArtifact("capi/infrastructure-foo", "v1.0.0",
+		registry.AddLayer("infrastructure-components.yaml", ociLayerMediaType, []byte("components-v1.0.0")),
+		registry.AddLayer("metadata.yaml", ociLayerMediaType, []byte("apiVersion: clusterctl.cluster.x-k8s.io/v1alpha3\nkind: Metadata\n")),
+	)
+	corrupted := registry.AddLayer("corrupted.yaml", ociLayerMediaType, []byte("original"))
+	registry.Blobs[corrupted.Digest] = []byte("corrupted")
+	registry.AddArtifact("capi/infrastructure-foo", "v1.1.0",
+		registry.AddLayer("infrastructure-components.yaml", ociLayerMediaType, []byte("components-v1.1.0")),
+		registry.AddLayer("metadata.yaml", ociLayerMediaType, []byte("apiVersion: clusterctl.cluster.x-k8s.io/v1alpha3\nkind: Metadata\n")),
+		registry.AddLayer("cluster-template.yaml", ociLayerMediaType, []byte("template-v1.1.0")),
+		corrupted,
+	)
+	providerURL := func(versionAndPath string) string {
+		return fmt.Sprintf("oci://%s/capi/infrastructure-foo:%s", registry.Host(), versionAndPath)
+	}
+
+	setDockerConfig(t, fmt.Sprintf(`{"auths": {"https://%s": {"auth": %q}}}`,
+		registry.Host(), base64.StdEncoding.EncodeToString([]byte(ocitest.Username+":"+ocitest.Password))))
+
+	ctx := context.Background()
+	providerConfig := config.NewProvider("test", providerURL("latest/infrastructure-components.yaml"), clusterctlv1.InfrastructureProviderType)
+	repo, err := NewOCIRepository(ctx, providerConfig, test.NewFakeVariableClient(), injectOCIHTTPClient(registry.Client()))
+
+	t.Run("resolves latest to the latest version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(repo.DefaultVersion()).To(Equal("v1.1.0"))
+	})
+
+	t.Run("gets versions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		versions, err := repo.GetVersions(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(versions).To(ConsistOf("v1.0.0", "v1.1.0"))
+	})
+
+	t.Run("gets files", func(t *testing.T) {
+		g := NewWithT(t)
+
+		content, err := repo.GetFile(ctx, "v1.0.0", "infrastructure-components.yaml")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(content)).To(Equal("components-v1.0.0"))
+
+		content, err = repo.GetFile(ctx, "v1.1.0", "cluster-template.yaml")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(content)).To(Equal("template-v1.1.0"))
+	})
+
+	t.Run("fails with not found for missing files and versions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := repo.GetFile(ctx, "v1.0.0", "cluster-template.yaml")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(errors.Is(err, errNotFound)).To(BeTrue())
+
+		_, err = repo.GetFile(ctx, "v2.0.0", "infrastructure-components.yaml")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(errors.Is(err, errNotFound)).To(BeTrue())
+	})
+
+	t.Run("fails if the digest does not match", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := repo.GetFile(ctx, "v1.1.0", "corrupted.yaml")
+		g.Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+	})
+
+	t.Run("fails with wrong credentials", func(t *testing.T) {
+		g := NewWithT(t)
+
+		setDockerConfig(t, fmt.Sprintf(`{"auths": {%q: {"username": "user", "password": "wrong"}}}`,
+			registry.Host()))
+
+		providerConfig := config.NewProvider("test", providerURL("v1.0.0/infrastructure-components.yaml"), clusterctlv1.InfrastructureProviderType)
+		repo, err := NewOCIRepository(ctx, providerConfig, test.NewFakeVariableClient(), injectOCIHTTPClient(registry.Client()))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = repo.GetVersions(ctx)
+		g.Expect(err).To(MatchError(ContainSubstring("please check your credentials")))
+	})
+}
+
+func Test_getDockerCredentials(t *testing.T) {
+	tests := []struct {
+		name         string
+		dockerConfig string
+		registry     string
+		want         *oci.Credentials
+		wantErr      bool
+	}{
+		{
+			name:         "no docker config",
+			dockerConfig: "",
+			registry:     "registry.example.com",
+			want:         nil,
+		},
+		{
+			name:         "credentials from auth",
+			dockerConfig: fmt.Sprintf(`{"auths": {"registry.example.com": {"auth": %q}}}`, base64.StdEncoding.EncodeToString([]byte("user:pass"))),
+			registry:     "registry.example.com",
+			want:         &oci.Credentials{Username: "user", Password: "pass"},
+		},
+		{
+			name:         "credentials from username and password, with scheme in the key",
+			dockerConfig: `{"auths": {"https://registry.example.com/v1/": {"username": "user", "password": "pass"}}}`,
+			registry:     "registry.example.com",
+			want:         &oci.Credentials{Username: "user", Password: "pass"},
+		},
+		{
+			name:         "identity token",
+			dockerConfig: `{"auths": {"registry.example.com": {"identitytoken": "token"}}}`,
+			registry:     "registry.example.com",
+			want:         &oci.Credentials{IdentityToken: "token"},
+		},
+		{
+			name:         "credentials for Docker Hub",
+			dockerConfig: `{"auths": {"https://index.docker.io/v1/": {"username": "user", "password": "pass"}}}`,
+			registry:     "registry-1.docker.io",
+			want:         &oci.Credentials{Username: "user", Password: "pass"},
+		},
+		{
+			name:         "no credentials for the registry",
+			dockerConfig: `{"auths": {"other.example.com": {"username": "user", "password": "pass"}}}`,
+			registry:     "registry.example.com",
+			want:         nil,
+		},
+		{
+			name:         "invalid auth",
+			dockerConfig: `{"auths": {"registry.example.com": {"auth": "not-base64!"}}}`,
+			registry:     "registry.example.com",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			if tt.dockerConfig == "" {
+				t.Setenv(dockerConfigEnvVariable, t.TempDir())
+			} else {
+				setDockerConfig(t, tt.dockerConfig)
+			}
+
+			got, err := getDockerCredentials(context.Background(), tt.registry)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package repository
 
 import (
+	"sort"
+
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -140,6 +142,23 @@ func NewTemplate(input TemplateInput) (Template, error) {
 	}, nil
 }
 
+// NewTemplateFromObjects returns a new Template with the given objects, e.g. objects generated by clusterctl itself.
+// The variableMap lists the variables which have been used to generate the objects, with their default values.
+func NewTemplateFromObjects(objs []unstructured.Unstructured, variableMap map[string]*string, targetNamespace string) Template {
+	variables := make([]string, 0, len(variableMap))
+	for name := range variableMap {
+		variables = append(variables, name)
+	}
+	sort.Strings(variables)
+
+	return &template{
+		variables:       variables,
+		variableMap:     variableMap,
+		targetNamespace: targetNamespace,
+		objs:            objs,
+	}
+}
+
 // MergeTemplates merges the provided Templates into one Template.
 // Notes on the merge operation:
 //   - The merge operation sets targetNamespace empty if all the templates do not share the same TargetNamespace.
@@ -30,6 +30,9 @@ import (
 // Templates are yaml files to be used for creating a guest cluster.
 type TemplateClient interface {
 	Get(ctx context.Context, flavor, targetNamespace string, listVariablesOnly bool) (Template, error)
+
+	// Raw returns the YAML file of the template for the flavor specified, without any processing.
+	Raw(ctx context.Context, flavor string) ([]byte, error)
 }
 
 // templateClient implements TemplateClient.
@@ -69,12 +72,29 @@ func newTemplateClient(input TemplateClientInput) *templateClient {
 // In case the template does not exists, an error is returned.
 // Get assumes the following naming convention for templates: cluster-template[-<flavor_name>].yaml.
 func (c *templateClient) Get(ctx context.Context, flavor, targetNamespace string, skipTemplateProcess bool) (Template, error) {
-	log := logf.Log
-
 	if targetNamespace == "" {
 		return nil, errors.New("invalid arguments: please provide a targetNamespace")
 	}
 
+	rawArtifact, err := c.Raw(ctx, flavor)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTemplate(TemplateInput{
+		rawArtifact,
+		c.configVariablesClient,
+		c.processor,
+		targetNamespace,
+		skipTemplateProcess,
+	})
+}
+
+// Raw returns the YAML file of the template for the flavor specified, without any processing.
+// In case the template does not exists, an error is returned.
+func (c *templateClient) Raw(ctx context.Context, flavor string) ([]byte, error) {
+	log := logf.Log
+
 	version := c.version
 	name := c.processor.GetTemplateName(version, flavor)
 
@@ -98,12 +118,5 @@ func (c *templateClient) Get(ctx context.Context, flavor, targetNamespace string
 	} else {
 		log.V(1).Info("Using", "override", name, "provider", c.provider.ManifestLabel(), "version", version)
 	}
-
-	return NewTemplate(TemplateInput{
-		rawArtifact,
-		c.configVariablesClient,
-		c.processor,
-		targetNamespace,
-		skipTemplateProcess,
-	})
+	return rawArtifact, nil
 }
@@ -30,6 +30,12 @@ var alphaCmd = &cobra.Command{
 func init() {
 	// Alpha commands should be added here.
 	alphaCmd.AddCommand(rolloutCmd)
+	alphaCmd.AddCommand(reportCmd)
+	alphaCmd.AddCommand(repairCmd)
+	alphaCmd.AddCommand(managementClusterCmd)
+	alphaCmd.AddCommand(migrateStorageCmd)
+	alphaCmd.AddCommand(migrateCmd)
+	alphaCmd.AddCommand(drainCmd)
 
 	RootCmd.AddCommand(alphaCmd)
 }
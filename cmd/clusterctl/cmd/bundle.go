@@ -0,0 +1,78 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+var bundleCmd = &cobra.Command{
+	Use:     "bundle",
+	GroupID: groupManagement,
+	Short:   "Export and import clusterctl bundles for air-gapped environments",
+	Long: templates.LongDesc(`
+		Export and import clusterctl bundles for air-gapped environments.
+
+		A clusterctl bundle is a portable archive with the components of a set of providers and of cert-manager,
+		the cluster templates of the infrastructure providers and the list of the required container images,
+		which allows to initialize a management cluster without network access.`),
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Help()
+	},
+}
+
+func init() {
+	bundleCmd.AddCommand(bundleExportCmd)
+	bundleCmd.AddCommand(bundleImportCmd)
+	RootCmd.AddCommand(bundleCmd)
+}
+
+// newClientFromBundle extracts a clusterctl bundle archive into a directory, or into a temporary directory if the directory
+// is empty, and returns a client reading the providers and cert-manager from the bundle.
+// The returned func removes the temporary directory, if any.
+func newClientFromBundle(ctx context.Context, archive, directory string) (client.Client, func(), error) {
+	cleanup := func() {}
+	if directory == "" {
+		tmpDir, err := os.MkdirTemp("", "clusterctl-bundle")
+		if err != nil {
+			return nil, cleanup, errors.Wrap(err, "failed to create a temporary directory for extracting the bundle")
+		}
+		directory = tmpDir
+		cleanup = func() {
+			_ = os.RemoveAll(tmpDir)
+		}
+	}
+
+	bundle, err := client.ExtractBundle(archive, directory)
+	if err != nil {
+		return nil, cleanup, err
+	}
+
+	c, err := client.New(ctx, cfgFile, client.InjectBundle(bundle))
+	if err != nil {
+		return nil, cleanup, err
+	}
+	return c, cleanup, nil
+}
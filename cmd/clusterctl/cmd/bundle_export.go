@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type bundleExportOptions struct {
+	coreProvider              string
+	bootstrapProviders        []string
+	controlPlaneProviders     []string
+	infrastructureProviders   []string
+	ipamProviders             []string
+	runtimeExtensionProviders []string
+	addonProviders            []string
+	flavors                   []string
+	outputFile                string
+}
+
+var bundleExportOpts = &bundleExportOptions{}
+
+var bundleExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a clusterctl bundle for initializing a management cluster without network access",
+	Long: templates.LongDesc(`
+		Export a clusterctl bundle for initializing a management cluster without network access.
+
+		Downloads the components of the selected providers and of cert-manager, the cluster templates of the
+		infrastructure providers and the list of the required container images into a portable archive.
+		Providers are selected like in 'clusterctl init'; if unspecified, the Cluster API core provider,
+		the kubeadm bootstrap provider and the kubeadm control plane provider are exported.
+
+		The container images listed in the images.txt file of the bundle must be mirrored to a registry reachable
+		from the management cluster, e.g. by using image overrides in the clusterctl configuration file.`),
+
+	Example: templates.Examples(`
+		# Export a bundle with the given infrastructure provider, in addition to the default providers.
+		clusterctl bundle export --infrastructure=aws:v2.9.0 --output=bundle.tar.gz
+
+		# Export a bundle with specific versions of the default providers.
+		clusterctl bundle export --core cluster-api:v1.12.0 --bootstrap kubeadm:v1.12.0 --control-plane kubeadm:v1.12.0
+
+		# Export a bundle with the default and the "machinepool" cluster templates of the given infrastructure provider.
+		clusterctl bundle export --infrastructure=docker --flavor "",machinepool`),
+	Args: cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return runBundleExport()
+	},
+}
+
+func init() {
+	bundleExportCmd.Flags().StringVar(&bundleExportOpts.coreProvider, "core", "",
+		"Core provider version (e.g. cluster-api:v1.1.5) to add to the bundle. If unspecified, Cluster API's latest release is used.")
+	bundleExportCmd.Flags().StringSliceVarP(&bundleExportOpts.infrastructureProviders, "infrastructure", "i", nil,
+		"Infrastructure providers and versions (e.g. aws:v0.5.0) to add to the bundle.")
+	bundleExportCmd.Flags().StringSliceVarP(&bundleExportOpts.bootstrapProviders, "bootstrap", "b", nil,
+		"Bootstrap providers and versions (e.g. kubeadm:v1.1.5) to add to the bundle. If unspecified, Kubeadm bootstrap provider's latest release is used.")
+	bundleExportCmd.Flags().StringSliceVarP(&bundleExportOpts.controlPlaneProviders, "control-plane", "c", nil,
+		"Control plane providers and versions (e.g. kubeadm:v1.1.5) to add to the bundle. If unspecified, the Kubeadm control plane provider's latest release is used.")
+	bundleExportCmd.Flags().StringSliceVar(&bundleExportOpts.ipamProviders, "ipam", nil,
+		"IPAM providers and versions (e.g. in-cluster:v0.1.0) to add to the bundle.")
+	bundleExportCmd.Flags().StringSliceVar(&bundleExportOpts.runtimeExtensionProviders, "runtime-extension", nil,
+		"Runtime extension providers and versions to add to the bundle.")
+	bundleExportCmd.Flags().StringSliceVar(&bundleExportOpts.addonProviders, "addon", nil,
+		"Add-on providers and versions (e.g. helm:v0.1.0) to add to the bundle.")
+	bundleExportCmd.Flags().StringSliceVarP(&bundleExportOpts.flavors, "flavor", "f", nil,
+		"Flavors of the cluster templates of the infrastructure providers to add to the bundle; use an empty value for the default cluster template. If unspecified, the default cluster template is added, if it exists.")
+	bundleExportCmd.Flags().StringVarP(&bundleExportOpts.outputFile, "output", "o", "clusterctl-bundle.tar.gz",
+		"Path of the bundle archive.")
+}
+
+func runBundleExport() error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	bundle, err := c.ExportBundle(ctx, client.ExportBundleOptions{
+		CoreProvider:              bundleExportOpts.coreProvider,
+		BootstrapProviders:        bundleExportOpts.bootstrapProviders,
+		ControlPlaneProviders:     bundleExportOpts.controlPlaneProviders,
+		InfrastructureProviders:   bundleExportOpts.infrastructureProviders,
+		IPAMProviders:             bundleExportOpts.ipamProviders,
+		RuntimeExtensionProviders: bundleExportOpts.runtimeExtensionProviders,
+		AddonProviders:            bundleExportOpts.addonProviders,
+		Flavors:                   bundleExportOpts.flavors,
+		OutputFile:                bundleExportOpts.outputFile,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Bundle exported to %s\n\n", bundleExportOpts.outputFile)
+	fmt.Printf("Container images required by the bundle (also listed in the %s file of the bundle):\n", client.BundleImagesFile)
+	for _, image := range bundle.Images {
+		fmt.Printf("  - %s\n", image)
+	}
+	return nil
+}
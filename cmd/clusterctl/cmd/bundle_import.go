@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type bundleImportOptions struct {
+	kubeconfig          string
+	kubeconfigContext   string
+	directory           string
+	targetNamespace     string
+	validate            bool
+	waitProviders       bool
+	waitProviderTimeout int
+}
+
+var bundleImportOpts = &bundleImportOptions{}
+
+var bundleImportCmd = &cobra.Command{
+	Use:   "import ARCHIVE",
+	Short: "Initialize a management cluster from a clusterctl bundle",
+	Long: templates.LongDesc(`
+		Initialize a management cluster from a clusterctl bundle, without network access.
+
+		Installs cert-manager and all the providers in the bundle; use 'clusterctl init --from-bundle'
+		for installing only some of the providers in the bundle.
+
+		The container images required by the bundle must be available in a registry reachable from the management cluster.
+
+		If a directory is specified, the bundle is extracted into it together with a clusterctl configuration file,
+		which can be used for generating workload clusters from the cluster templates in the bundle, e.g.
+		'clusterctl generate cluster --config [directory]/clusterctl.yaml'.`),
+
+	Example: templates.Examples(`
+		# Initialize a management cluster from a bundle.
+		clusterctl bundle import bundle.tar.gz
+
+		# Initialize a management cluster from a bundle, keeping the extracted bundle for generating workload clusters.
+		clusterctl bundle import bundle.tar.gz --directory ./bundle`),
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return runBundleImport(args[0])
+	},
+}
+
+func init() {
+	bundleImportCmd.Flags().StringVar(&bundleImportOpts.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig for the management cluster. If unspecified, default discovery rules apply.")
+	bundleImportCmd.Flags().StringVar(&bundleImportOpts.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	bundleImportCmd.Flags().StringVarP(&bundleImportOpts.directory, "directory", "d", "",
+		"The directory where the bundle is extracted. If unspecified, the bundle is extracted into a temporary directory, which is removed afterwards.")
+	bundleImportCmd.Flags().StringVarP(&bundleImportOpts.targetNamespace, "target-namespace", "n", "",
+		"The target namespace where the providers should be deployed. If unspecified, the provider components' default namespace is used.")
+	bundleImportCmd.Flags().BoolVar(&bundleImportOpts.waitProviders, "wait-providers", false,
+		"Wait for providers to be installed.")
+	bundleImportCmd.Flags().IntVar(&bundleImportOpts.waitProviderTimeout, "wait-provider-timeout", 5*60,
+		"Wait timeout per provider installation in seconds. This value is ignored if --wait-providers is false")
+	bundleImportCmd.Flags().BoolVar(&bundleImportOpts.validate, "validate", true,
+		"If true, clusterctl will validate that the deployments will succeed on the management cluster.")
+}
+
+func runBundleImport(archive string) error {
+	ctx := context.Background()
+
+	c, cleanup, err := newClientFromBundle(ctx, archive, bundleImportOpts.directory)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
+
+	// NOTE: When no providers are specified, all the providers in the bundle are installed.
+	_, err = c.Init(ctx, client.InitOptions{
+		Kubeconfig:             client.Kubeconfig{Path: bundleImportOpts.kubeconfig, Context: bundleImportOpts.kubeconfigContext},
+		TargetNamespace:        bundleImportOpts.targetNamespace,
+		LogUsageInstructions:   true,
+		WaitProviders:          bundleImportOpts.waitProviders,
+		WaitProviderTimeout:    time.Duration(bundleImportOpts.waitProviderTimeout) * time.Second,
+		IgnoreValidationErrors: !bundleImportOpts.validate,
+	})
+	return err
+}
@@ -23,8 +23,8 @@ import (
 var describeCmd = &cobra.Command{
 	Use:     "describe",
 	GroupID: groupDebug,
-	Short:   "Describe workload clusters",
-	Long:    `Describe the status of workload clusters.`,
+	Short:   "Describe workload clusters and Runtime Extensions",
+	Long:    `Describe the status of workload clusters and of the Runtime Extensions registered in a management cluster.`,
 }
 
 func init() {
@@ -0,0 +1,212 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+const (
+	// DescribeExtensionsOutputText is an option used to print the description of the Runtime Extensions in text format.
+	DescribeExtensionsOutputText = "text"
+	// DescribeExtensionsOutputYaml is an option used to print the description of the Runtime Extensions in yaml format.
+	DescribeExtensionsOutputYaml = "yaml"
+	// DescribeExtensionsOutputJSON is an option used to print the description of the Runtime Extensions in json format.
+	DescribeExtensionsOutputJSON = "json"
+)
+
+var (
+	// DescribeExtensionsOutputs is a list of valid outputs for the description of the Runtime Extensions.
+	DescribeExtensionsOutputs = []string{DescribeExtensionsOutputText, DescribeExtensionsOutputYaml, DescribeExtensionsOutputJSON}
+)
+
+type describeExtensionsOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	output            string
+}
+
+var deo = &describeExtensionsOptions{}
+
+var describeExtensionsCmd = &cobra.Command{
+	Use:   "extensions [NAME]",
+	Args:  cobra.MaximumNArgs(1),
+	Short: "Describe the Runtime Extensions registered in a management cluster",
+	Long: templates.LongDesc(`
+		Describe the Runtime Extensions registered in a management cluster.
+
+		For each ExtensionConfig, the command shows the endpoint of the Extension server, the status
+		of the last discovery, and the discovered handlers with the hook they serve, the hook version,
+		the failure policy and the timeout.`),
+
+	Example: templates.Examples(`
+		# Describe all the Runtime Extensions registered in the management cluster.
+		clusterctl describe extensions
+
+		# Describe the Runtime Extension registered by the ExtensionConfig named test-extension in yaml format.
+		clusterctl describe extensions test-extension -o yaml`),
+
+	RunE: func(_ *cobra.Command, args []string) error {
+		name := ""
+		if len(args) > 0 {
+			name = args[0]
+		}
+		return runDescribeExtensions(name, os.Stdout)
+	},
+}
+
+func init() {
+	describeExtensionsCmd.Flags().StringVar(&deo.kubeconfig, "kubeconfig", "",
+		"Path to a kubeconfig file to use for the management cluster. If empty, default discovery rules apply.")
+	describeExtensionsCmd.Flags().StringVar(&deo.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	describeExtensionsCmd.Flags().StringVarP(&deo.output, "output", "o", DescribeExtensionsOutputText,
+		fmt.Sprintf("Output format. Valid values: %v.", DescribeExtensionsOutputs))
+
+	// completions
+	describeExtensionsCmd.ValidArgsFunction = resourceNameCompletionFunc(
+		describeExtensionsCmd.Flags().Lookup("kubeconfig"),
+		describeExtensionsCmd.Flags().Lookup("kubeconfig-context"),
+		nil,
+		runtimev1.GroupVersion.String(),
+		"extensionconfig",
+	)
+
+	describeCmd.AddCommand(describeExtensionsCmd)
+}
+
+func runDescribeExtensions(name string, out io.Writer) error {
+	if deo.output != DescribeExtensionsOutputText && deo.output != DescribeExtensionsOutputYaml && deo.output != DescribeExtensionsOutputJSON {
+		return errors.Errorf("invalid output format %q, valid values: %v", deo.output, DescribeExtensionsOutputs)
+	}
+
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	description, err := c.DescribeExtensions(ctx, client.DescribeExtensionsOptions{
+		Kubeconfig: client.Kubeconfig{Path: deo.kubeconfig, Context: deo.kubeconfigContext},
+		Name:       name,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printExtensionsDescription(description, deo.output, out)
+}
+
+func printExtensionsDescription(description *cluster.ExtensionsDescription, output string, out io.Writer) error {
+	switch output {
+	case DescribeExtensionsOutputYaml:
+		y, err := yaml.Marshal(description)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, string(y))
+		return nil
+	case DescribeExtensionsOutputJSON:
+		j, err := json.MarshalIndent(description, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(j))
+		return nil
+	}
+
+	if len(description.Extensions) == 0 {
+		fmt.Fprintln(out, "No ExtensionConfigs found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tENDPOINT\tPAUSED\tDISCOVERED\tLAST TRANSITION\tHANDLERS")
+	discoveryErrors := []string{}
+	for _, e := range description.Extensions {
+		fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\t%d\n", e.Name, prettifyValue(e.Endpoint), e.Paused, e.Discovered,
+			prettifyTime(e.LastDiscoveryTransitionTime), len(e.Handlers))
+		if e.Discovered != metav1.ConditionTrue && e.DiscoveredMessage != "" {
+			discoveryErrors = append(discoveryErrors, fmt.Sprintf("ExtensionConfig %s: %s", e.Name, e.DiscoveredMessage))
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	hasHandlers := false
+	for _, e := range description.Extensions {
+		if len(e.Handlers) > 0 {
+			hasHandlers = true
+			break
+		}
+	}
+	if hasHandlers {
+		fmt.Fprintln(out, "")
+		w = tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+		fmt.Fprintln(w, "EXTENSION\tHANDLER\tHOOK\tAPI VERSION\tFAILURE POLICY\tTIMEOUT")
+		for _, e := range description.Extensions {
+			for _, h := range e.Handlers {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", e.Name, h.Name, h.Hook, h.APIVersion, h.FailurePolicy,
+					time.Duration(h.TimeoutSeconds)*time.Second)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if len(discoveryErrors) > 0 {
+		fmt.Fprintln(out, "")
+		fmt.Fprintln(out, "Discovery errors:")
+		for _, e := range discoveryErrors {
+			fmt.Fprintf(out, "- %s\n", e)
+		}
+	}
+	return nil
+}
+
+func prettifyValue(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func prettifyTime(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
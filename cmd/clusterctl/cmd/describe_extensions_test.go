@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+func Test_printExtensionsDescription(t *testing.T) {
+	lastTransitionTime := ptr.To(metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	description := &cluster.ExtensionsDescription{
+		Extensions: []cluster.ExtensionDescription{
+			{
+				Name:                        "extension1",
+				Endpoint:                    "service/test-extension/webhook-service:443",
+				Discovered:                  metav1.ConditionTrue,
+				LastDiscoveryTransitionTime: lastTransitionTime,
+				Handlers: []cluster.ExtensionHandlerDescription{
+					{
+						Name:           "before-cluster-upgrade.extension1",
+						Hook:           "BeforeClusterUpgrade",
+						APIVersion:     "hooks.runtime.cluster.x-k8s.io/v1alpha1",
+						FailurePolicy:  runtimev1.FailurePolicyFail,
+						TimeoutSeconds: 10,
+					},
+				},
+			},
+			{
+				Name:                        "extension2",
+				Endpoint:                    "https://extension2.example.com",
+				Paused:                      true,
+				Discovered:                  metav1.ConditionFalse,
+				DiscoveredMessage:           "connection refused",
+				LastDiscoveryTransitionTime: lastTransitionTime,
+			},
+			{
+				Name:       "extension3",
+				Discovered: metav1.ConditionUnknown,
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		description *cluster.ExtensionsDescription
+		output      string
+		want        string
+	}{
+		{
+			name:        "text",
+			description: description,
+			output:      DescribeExtensionsOutputText,
+			want: `NAME         ENDPOINT                                     PAUSED    DISCOVERED   LAST TRANSITION        HANDLERS
+extension1   service/test-extension/webhook-service:443   false     True         2025-01-01T00:00:00Z   1
+extension2   https://extension2.example.com               true      False        2025-01-01T00:00:00Z   0
+extension3   -                                            false     Unknown      -                      0
+
+EXTENSION    HANDLER                             HOOK                   API VERSION                               FAILURE POLICY   TIMEOUT
+extension1   before-cluster-upgrade.extension1   BeforeClusterUpgrade   hooks.runtime.cluster.x-k8s.io/v1alpha1   Fail             10s
+
+Discovery errors:
+- ExtensionConfig extension2: connection refused
+`,
+		},
+		{
+			name:        "text without ExtensionConfigs",
+			description: &cluster.ExtensionsDescription{},
+			output:      DescribeExtensionsOutputText,
+			want:        "No ExtensionConfigs found\n",
+		},
+		{
+			name:        "yaml",
+			description: &cluster.ExtensionsDescription{Extensions: []cluster.ExtensionDescription{{Name: "extension3", Discovered: metav1.ConditionUnknown}}},
+			output:      DescribeExtensionsOutputYaml,
+			want: `extensions:
+- discovered: Unknown
+  endpoint: ""
+  name: extension3
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			buf := &bytes.Buffer{}
+			g.Expect(printExtensionsDescription(tt.description, tt.output, buf)).To(Succeed())
+			g.Expect(buf.String()).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type drainExplainOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	namespace         string
+	output            string
+}
+
+var dxo = &drainExplainOptions{}
+
+var drainExplainCmd = &cobra.Command{
+	Use:   "explain machine/NAME",
+	Args:  cobra.ExactArgs(1),
+	Short: "Explain how the Node of a Machine would be drained",
+	Long: templates.LongDesc(`
+		Explain how the Node of a Machine would be drained.
+
+		The MachineDrainRules matching the Machine are evaluated against the Pods currently running on its Node,
+		and for every Pod the command reports if it would be evicted, skipped, waited for until completion,
+		or if it would block the drain and thus the deletion of the Machine. Pods which are evicted or waited for
+		are listed in the order they are drained.
+
+		The Node is neither cordoned nor drained.`),
+
+	Example: templates.Examples(`
+		# Explain how the Node of the Machine my-machine would be drained.
+		clusterctl alpha drain explain machine/my-machine
+
+		# Explain how the Node of the Machine my-machine in the foo namespace would be drained and print the result in yaml format.
+		clusterctl alpha drain explain machine/my-machine --namespace foo -o yaml`),
+
+	RunE: func(_ *cobra.Command, args []string) error {
+		return runDrainExplain(args[0], os.Stdout)
+	},
+}
+
+func init() {
+	drainExplainCmd.Flags().StringVar(&dxo.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	drainExplainCmd.Flags().StringVar(&dxo.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	drainExplainCmd.Flags().StringVarP(&dxo.namespace, "namespace", "n", "",
+		"Namespace where the Machine exists. If unspecified, the current namespace will be used.")
+	drainExplainCmd.Flags().StringVarP(&dxo.output, "output", "o", SkewReportOutputText,
+		fmt.Sprintf("Output format. Valid values: %v.", SkewReportOutputs))
+
+	drainCmd.AddCommand(drainExplainCmd)
+}
+
+func runDrainExplain(resource string, out io.Writer) error {
+	if dxo.output != SkewReportOutputText && dxo.output != SkewReportOutputYaml && dxo.output != SkewReportOutputJSON {
+		return errors.Errorf("invalid output format %q, valid values: %v", dxo.output, SkewReportOutputs)
+	}
+
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	explanation, err := c.DrainExplain(ctx, client.DrainExplainOptions{
+		Kubeconfig: client.Kubeconfig{Path: dxo.kubeconfig, Context: dxo.kubeconfigContext},
+		Resource:   strings.ToLower(resource),
+		Namespace:  dxo.namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printDrainExplanation(explanation, dxo.output, out)
+}
+
+func printDrainExplanation(explanation *cluster.DrainExplanation, output string, out io.Writer) error {
+	switch output {
+	case SkewReportOutputYaml:
+		y, err := yaml.Marshal(explanation)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, string(y))
+		return nil
+	case SkewReportOutputJSON:
+		j, err := json.MarshalIndent(explanation, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(j))
+		return nil
+	}
+
+	fmt.Fprintf(out, "Machine %s/%s, Node %s\n", explanation.Namespace, explanation.Machine, explanation.Node)
+	if len(explanation.MachineDrainRules) > 0 {
+		fmt.Fprintf(out, "Matching MachineDrainRules: %s\n", strings.Join(explanation.MachineDrainRules, ", "))
+	} else {
+		fmt.Fprintln(out, "Matching MachineDrainRules: none")
+	}
+	fmt.Fprintln(out, "")
+
+	if len(explanation.Pods) == 0 {
+		fmt.Fprintln(out, "No Pods found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "ORDER\tACTION\tNAMESPACE\tNAME\tMACHINEDRAINRULE\tREASON")
+	for _, p := range explanation.Pods {
+		order := "-"
+		if p.Order != nil {
+			order = fmt.Sprintf("%d", *p.Order)
+		}
+		rule := p.MachineDrainRule
+		if rule == "" {
+			rule = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", order, p.Action, p.Namespace, p.Name, rule, p.Reason)
+	}
+	return w.Flush()
+}
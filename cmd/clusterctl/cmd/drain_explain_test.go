@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/internal/controllers/machine/drain"
+)
+
+func Test_printDrainExplanation(t *testing.T) {
+	explanation := &cluster.DrainExplanation{
+		Namespace: "ns1",
+		Machine:   "machine1",
+		Cluster:   "cluster1",
+		Explanation: drain.Explanation{
+			Node:              "node1",
+			MachineDrainRules: []string{"mdr-database", "mdr-monitoring"},
+			Pods: []drain.PodExplanation{
+				{Namespace: "default", Name: "app", Action: drain.PodActionEvict, Order: ptr.To[int32](0)},
+				{Namespace: "default", Name: "database", Action: drain.PodActionEvict, Order: ptr.To[int32](10), MachineDrainRule: "mdr-database"},
+				{Namespace: "default", Name: "monitoring", Action: drain.PodActionSkip, MachineDrainRule: "mdr-monitoring"},
+				{Namespace: "foo", Name: "orphan", Action: drain.PodActionBlock, Reason: "Pod Namespace does not exist"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		explanation *cluster.DrainExplanation
+		output      string
+		want        string
+	}{
+		{
+			name:        "text",
+			explanation: explanation,
+			output:      SkewReportOutputText,
+			want: `Machine ns1/machine1, Node node1
+Matching MachineDrainRules: mdr-database, mdr-monitoring
+
+ORDER     ACTION    NAMESPACE   NAME         MACHINEDRAINRULE   REASON
+0         Evict     default     app          -                  
+10        Evict     default     database     mdr-database       
+-         Skip      default     monitoring   mdr-monitoring     
+-         Block     foo         orphan       -                  Pod Namespace does not exist
+`,
+		},
+		{
+			name: "text without Pods",
+			explanation: &cluster.DrainExplanation{
+				Namespace:   "ns1",
+				Machine:     "machine1",
+				Explanation: drain.Explanation{Node: "node1"},
+			},
+			output: SkewReportOutputText,
+			want: `Machine ns1/machine1, Node node1
+Matching MachineDrainRules: none
+
+No Pods found
+`,
+		},
+		{
+			name: "yaml",
+			explanation: &cluster.DrainExplanation{
+				Namespace:   "ns1",
+				Machine:     "machine1",
+				Cluster:     "cluster1",
+				Explanation: drain.Explanation{Node: "node1"},
+			},
+			output: SkewReportOutputYaml,
+			want: `cluster: cluster1
+machine: machine1
+namespace: ns1
+node: node1
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			buf := &bytes.Buffer{}
+			g.Expect(printDrainExplanation(tt.explanation, tt.output, buf)).To(Succeed())
+			g.Expect(buf.String()).To(Equal(tt.want))
+		})
+	}
+}
@@ -17,12 +17,20 @@ limitations under the License.
 package cmd
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
 
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
 )
@@ -43,6 +51,14 @@ type generateClusterOptions struct {
 	configMapName      string
 	configMapDataKey   string
 
+	clusterTemplateName      string
+	clusterTemplateNamespace string
+
+	clusterClassName      string
+	clusterClassNamespace string
+	valuesFile            string
+	interactive           bool
+
 	listVariables bool
 
 	output string
@@ -84,6 +100,14 @@ var generateClusterClusterCmd = &cobra.Command{
 		# Generates a yaml file for creating workload clusters using a template stored in a ConfigMap.
 		clusterctl generate cluster my-cluster --from-config-map MyTemplates
 
+		# Generates a yaml file for creating workload clusters using a ClusterTemplate
+		# (requires the ClusterTemplate feature to be enabled in the management cluster).
+		clusterctl generate cluster my-cluster --from-cluster-template my-template
+
+		# Generates a yaml file for creating workload clusters using a ClusterClass, reading the values
+		# of the ClusterClass variables from a file and prompting for required variables not set in the file.
+		clusterctl generate cluster my-cluster --from-cluster-class quick-start --values values.yaml --interactive
+
 		# Generates a yaml file for creating workload clusters using a template from a specific URL.
 		clusterctl generate cluster my-cluster --from https://github.com/foo-org/foo-repository/blob/main/cluster-template.yaml
 
@@ -139,6 +163,22 @@ func init() {
 	generateClusterClusterCmd.Flags().StringVar(&gc.configMapDataKey, "from-config-map-key", "",
 		fmt.Sprintf("The ConfigMap.Data key where the workload cluster template is hosted. If unspecified, %q will be used", client.DefaultCustomTemplateConfigMapKey))
 
+	// flags for the cluster template source
+	generateClusterClusterCmd.Flags().StringVar(&gc.clusterTemplateName, "from-cluster-template", "",
+		"The ClusterTemplate to read the workload cluster template from. This requires the ClusterTemplate feature to be enabled in the management cluster")
+	generateClusterClusterCmd.Flags().StringVar(&gc.clusterTemplateNamespace, "from-cluster-template-namespace", "",
+		"The namespace where the ClusterTemplate exists. If unspecified, the current namespace will be used")
+
+	// flags for the cluster class source
+	generateClusterClusterCmd.Flags().StringVar(&gc.clusterClassName, "from-cluster-class", "",
+		"The ClusterClass to be used by the workload cluster. The values of the ClusterClass variables are validated against the variable schemas of the ClusterClass")
+	generateClusterClusterCmd.Flags().StringVar(&gc.clusterClassNamespace, "from-cluster-class-namespace", "",
+		"The namespace where the ClusterClass exists. If unspecified, the current namespace will be used")
+	generateClusterClusterCmd.Flags().StringVar(&gc.valuesFile, "values", "",
+		"Path to a yaml file with the values of the ClusterClass variables, by variable name. Can only be used with --from-cluster-class")
+	generateClusterClusterCmd.Flags().BoolVar(&gc.interactive, "interactive", false,
+		"Prompt for the values of the required ClusterClass variables which are not set and do not have a default. Can only be used with --from-cluster-class")
+
 	// other flags
 	generateClusterClusterCmd.Flags().BoolVar(&gc.listVariables, "list-variables", false,
 		"Returns the list of variables expected by the template instead of the template yaml")
@@ -184,6 +224,32 @@ func runGenerateClusterTemplate(cmd *cobra.Command, name string) error {
 		}
 	}
 
+	if gc.clusterTemplateNamespace != "" || gc.clusterTemplateName != "" {
+		templateOptions.ClusterTemplateSource = &client.ClusterTemplateSourceOptions{
+			Namespace: gc.clusterTemplateNamespace,
+			Name:      gc.clusterTemplateName,
+		}
+	}
+
+	if gc.clusterClassNamespace != "" || gc.clusterClassName != "" {
+		templateOptions.ClusterClassSource = &client.ClusterClassSourceOptions{
+			Namespace: gc.clusterClassNamespace,
+			Name:      gc.clusterClassName,
+		}
+		if gc.valuesFile != "" {
+			values, err := readClusterClassVariableValues(gc.valuesFile)
+			if err != nil {
+				return err
+			}
+			templateOptions.ClusterClassSource.Values = values
+		}
+		if gc.interactive {
+			templateOptions.ClusterClassSource.Prompt = newClusterClassVariablePrompt(os.Stdin, os.Stderr)
+		}
+	} else if gc.valuesFile != "" || gc.interactive {
+		return errors.New("--values and --interactive can only be used with --from-cluster-class")
+	}
+
 	if gc.infrastructureProvider != "" || gc.flavor != "" {
 		templateOptions.ProviderRepositorySource = &client.ProviderRepositorySourceOptions{
 			InfrastructureProvider: gc.infrastructureProvider,
@@ -202,3 +268,69 @@ func runGenerateClusterTemplate(cmd *cobra.Command, name string) error {
 
 	return printYamlOutput(template, gc.output)
 }
+
+// readClusterClassVariableValues reads the values of ClusterClass variables from a yaml file with a value for each variable name.
+func readClusterClassVariableValues(path string) (map[string]apiextensionsv1.JSON, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read values from %s", path)
+	}
+	raw := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse values from %s", path)
+	}
+	values := map[string]apiextensionsv1.JSON{}
+	for name, value := range raw {
+		valueJSON, err := json.Marshal(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse value for variable %q from %s", name, path)
+		}
+		values[name] = apiextensionsv1.JSON{Raw: valueJSON}
+	}
+	return values, nil
+}
+
+// newClusterClassVariablePrompt returns a ClusterClassVariablePromptFunc reading values from in, one per line.
+// Values are parsed as yaml, except for variables of type string which are used as is.
+func newClusterClassVariablePrompt(in io.Reader, out io.Writer) client.ClusterClassVariablePromptFunc {
+	reader := bufio.NewReader(in)
+	return func(variable clusterv1.ClusterClassStatusVariable, validationErr error) (*apiextensionsv1.JSON, error) {
+		if validationErr != nil {
+			fmt.Fprintf(out, "Invalid value: %v\n", validationErr)
+		}
+
+		var schema clusterv1.JSONSchemaProps
+		if len(variable.Definitions) > 0 {
+			schema = variable.Definitions[0].Schema.OpenAPIV3Schema
+		}
+		fmt.Fprintf(out, "%s", variable.Name)
+		if schema.Type != "" {
+			fmt.Fprintf(out, " (%s)", schema.Type)
+		}
+		if schema.Description != "" {
+			fmt.Fprintf(out, ": %s", schema.Description)
+		}
+		fmt.Fprint(out, "\n> ")
+
+		line, err := reader.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			return nil, errors.Wrap(err, "failed to read value")
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			return nil, nil
+		}
+
+		var value interface{} = line
+		if schema.Type != "string" {
+			if err := yaml.Unmarshal([]byte(line), &value); err != nil {
+				return nil, errors.Wrap(err, "failed to parse value")
+			}
+		}
+		valueJSON, err := json.Marshal(value)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse value")
+		}
+		return &apiextensionsv1.JSON{Raw: valueJSON}, nil
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func Test_readClusterClassVariableValues(t *testing.T) {
+	g := NewWithT(t)
+
+	path, cleanup := createTempFile(g, `region: us-east-1
+replicas: 3
+tags:
+  team: foo
+`)
+	defer cleanup()
+
+	values, err := readClusterClassVariableValues(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(values).To(Equal(map[string]apiextensionsv1.JSON{
+		"region":   {Raw: []byte(`"us-east-1"`)},
+		"replicas": {Raw: []byte(`3`)},
+		"tags":     {Raw: []byte(`{"team":"foo"}`)},
+	}))
+
+	_, err = readClusterClassVariableValues("does-not-exist.yaml")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func Test_newClusterClassVariablePrompt(t *testing.T) {
+	g := NewWithT(t)
+
+	variable := func(name, schemaType string) clusterv1.ClusterClassStatusVariable {
+		return clusterv1.ClusterClassStatusVariable{
+			Name: name,
+			Definitions: []clusterv1.ClusterClassStatusVariableDefinition{
+				{
+					Schema: clusterv1.VariableSchema{
+						OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: schemaType, Description: "The " + name + "."},
+					},
+				},
+			},
+		}
+	}
+
+	out := &bytes.Buffer{}
+	prompt := newClusterClassVariablePrompt(strings.NewReader("3\n\nus-east-1\n{team: foo}"), out)
+
+	value, err := prompt(variable("replicas", "integer"), nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(value).To(Equal(&apiextensionsv1.JSON{Raw: []byte(`3`)}))
+	g.Expect(out.String()).To(Equal("replicas (integer): The replicas.\n> "))
+
+	// Empty lines do not return a value.
+	value, err = prompt(variable("region", "string"), nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(value).To(BeNil())
+
+	// Values of string variables are used as is; validation errors of the previous value are shown.
+	out.Reset()
+	value, err = prompt(variable("region", "string"), errors.New("a value is required"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(value).To(Equal(&apiextensionsv1.JSON{Raw: []byte(`"us-east-1"`)}))
+	g.Expect(out.String()).To(HavePrefix("Invalid value: a value is required\n"))
+
+	value, err = prompt(variable("tags", "object"), nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(value).To(Equal(&apiextensionsv1.JSON{Raw: []byte(`{"team":"foo"}`)}))
+
+	// Fails when there is nothing left to read.
+	_, err = prompt(variable("tags", "object"), nil)
+	g.Expect(err).To(HaveOccurred())
+}
@@ -32,6 +32,16 @@ type getKubeconfigOptions struct {
 	kubeconfig        string
 	kubeconfigContext string
 	namespace         string
+
+	user             string
+	execCommand      string
+	execArgs         []string
+	execEnv          map[string]string
+	execAPIVersion   string
+	oidcIssuerURL    string
+	oidcClientID     string
+	oidcClientSecret string
+	oidcExtraScopes  []string
 }
 
 var gk = &getKubeconfigOptions{}
@@ -40,14 +50,25 @@ var getKubeconfigCmd = &cobra.Command{
 	Use:   "kubeconfig NAME",
 	Short: "Gets the kubeconfig file for accessing a workload cluster",
 	Long: templates.LongDesc(`
-		Gets the kubeconfig file for accessing a workload cluster`),
+		Gets the kubeconfig file for accessing a workload cluster.
+
+		By default the kubeconfig of the admin user of the workload cluster is returned; use the
+		exec credential plugin or the OIDC flags to generate a kubeconfig for a different user instead.
+		The values of those flags can be Go templates, which are rendered using the Cluster object as input,
+		e.g. {{ .Name }} or {{ index .Annotations "example.com/oidc-client-id" }}.`),
 
 	Example: templates.Examples(`
 		# Get the workload cluster's kubeconfig.
 		clusterctl get kubeconfig <name of workload cluster>
 
 		# Get the workload cluster's kubeconfig in a particular namespace.
-		clusterctl get kubeconfig <name of workload cluster> --namespace foo`),
+		clusterctl get kubeconfig <name of workload cluster> --namespace foo
+
+		# Get a kubeconfig for the workload cluster using an exec credential plugin.
+		clusterctl get kubeconfig <name of workload cluster> --exec-command aws --exec-arg eks --exec-arg get-token --exec-arg=--cluster-name --exec-arg '{{ .Name }}'
+
+		# Get a kubeconfig for the workload cluster using OIDC, with the client ID read from a Cluster annotation.
+		clusterctl get kubeconfig <name of workload cluster> --oidc-issuer-url https://issuer.example.com --oidc-client-id '{{ index .Annotations "example.com/oidc-client-id" }}'`),
 
 	Args: func(_ *cobra.Command, args []string) error {
 		if len(args) != 1 {
@@ -68,6 +89,28 @@ func init() {
 	getKubeconfigCmd.Flags().StringVar(&gk.kubeconfigContext, "kubeconfig-context", "",
 		"Context to be used within the kubeconfig file. If empty, current context will be used.")
 
+	getKubeconfigCmd.Flags().StringVar(&gk.user, "user", "",
+		"Name of the user in the generated kubeconfig when using an exec credential plugin or OIDC; it can only be used together with --exec-command or --oidc-issuer-url. If empty, <cluster name>-user will be used.")
+	getKubeconfigCmd.Flags().StringVar(&gk.execCommand, "exec-command", "",
+		"Command of the exec credential plugin used to authenticate to the workload cluster instead of the admin client certificate.")
+	getKubeconfigCmd.Flags().StringArrayVar(&gk.execArgs, "exec-arg", nil,
+		"Argument to pass to the exec credential plugin. Can be repeated.")
+	getKubeconfigCmd.Flags().StringToStringVar(&gk.execEnv, "exec-env", nil,
+		"Environment variables to expose to the exec credential plugin, e.g. KEY1=VALUE1,KEY2=VALUE2.")
+	getKubeconfigCmd.Flags().StringVar(&gk.execAPIVersion, "exec-api-version", "",
+		"API version of the ExecCredential objects exchanged with the exec credential plugin. If empty, client.authentication.k8s.io/v1 will be used.")
+	getKubeconfigCmd.Flags().StringVar(&gk.oidcIssuerURL, "oidc-issuer-url", "",
+		"URL of the OIDC issuer used to authenticate to the workload cluster instead of the admin client certificate. Requires the kubectl oidc-login plugin.")
+	getKubeconfigCmd.Flags().StringVar(&gk.oidcClientID, "oidc-client-id", "",
+		"OIDC client ID.")
+	getKubeconfigCmd.Flags().StringVar(&gk.oidcClientSecret, "oidc-client-secret", "",
+		"OIDC client secret, if any.")
+	getKubeconfigCmd.Flags().StringSliceVar(&gk.oidcExtraScopes, "oidc-extra-scope", nil,
+		"Additional scopes to request to the OIDC issuer.")
+
+	getKubeconfigCmd.MarkFlagsMutuallyExclusive("exec-command", "oidc-issuer-url")
+	getKubeconfigCmd.MarkFlagsRequiredTogether("oidc-issuer-url", "oidc-client-id")
+
 	// completions
 	getKubeconfigCmd.ValidArgsFunction = resourceNameCompletionFunc(
 		getKubeconfigCmd.Flags().Lookup("kubeconfig"),
@@ -83,6 +126,11 @@ func init() {
 func runGetKubeconfig(workloadClusterName string) error {
 	ctx := context.Background()
 
+	user, err := gk.userOptions()
+	if err != nil {
+		return err
+	}
+
 	c, err := client.New(ctx, cfgFile)
 	if err != nil {
 		return err
@@ -92,6 +140,7 @@ func runGetKubeconfig(workloadClusterName string) error {
 		Kubeconfig:          client.Kubeconfig{Path: gk.kubeconfig, Context: gk.kubeconfigContext},
 		WorkloadClusterName: workloadClusterName,
 		Namespace:           gk.namespace,
+		User:                user,
 	}
 
 	out, err := c.GetKubeconfig(ctx, options)
@@ -101,3 +150,34 @@ func runGetKubeconfig(workloadClusterName string) error {
 	fmt.Println(out)
 	return nil
 }
+
+// userOptions returns the options for generating a kubeconfig for a user authenticating with an exec
+// credential plugin or with OIDC, or nil if the kubeconfig of the admin user should be returned.
+func (o *getKubeconfigOptions) userOptions() (*client.KubeconfigUserOptions, error) {
+	switch {
+	case o.execCommand != "":
+		return &client.KubeconfigUserOptions{
+			Name: o.user,
+			Exec: &client.ExecCredentialPluginOptions{
+				Command:    o.execCommand,
+				Args:       o.execArgs,
+				Env:        o.execEnv,
+				APIVersion: o.execAPIVersion,
+			},
+		}, nil
+	case o.oidcIssuerURL != "":
+		return &client.KubeconfigUserOptions{
+			Name: o.user,
+			OIDC: &client.OIDCOptions{
+				IssuerURL:    o.oidcIssuerURL,
+				ClientID:     o.oidcClientID,
+				ClientSecret: o.oidcClientSecret,
+				ExtraScopes:  o.oidcExtraScopes,
+			},
+		}, nil
+	case o.user != "":
+		return nil, errors.New("--user can only be used with --exec-command or --oidc-issuer-url")
+	default:
+		return nil, nil
+	}
+}
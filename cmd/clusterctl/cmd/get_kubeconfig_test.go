@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+)
+
+func Test_getKubeconfigOptions_userOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		options getKubeconfigOptions
+		want    *client.KubeconfigUserOptions
+		wantErr bool
+	}{
+		{
+			name:    "admin kubeconfig",
+			options: getKubeconfigOptions{},
+			want:    nil,
+		},
+		{
+			name:    "exec credential plugin",
+			options: getKubeconfigOptions{user: "dev", execCommand: "aws", execArgs: []string{"eks", "get-token"}},
+			want: &client.KubeconfigUserOptions{
+				Name: "dev",
+				Exec: &client.ExecCredentialPluginOptions{Command: "aws", Args: []string{"eks", "get-token"}},
+			},
+		},
+		{
+			name:    "OIDC",
+			options: getKubeconfigOptions{oidcIssuerURL: "https://issuer.example.com", oidcClientID: "client"},
+			want: &client.KubeconfigUserOptions{
+				OIDC: &client.OIDCOptions{IssuerURL: "https://issuer.example.com", ClientID: "client"},
+			},
+		},
+		{
+			name:    "user without exec credential plugin or OIDC",
+			options: getKubeconfigOptions{user: "dev"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := tt.options.userOptions()
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
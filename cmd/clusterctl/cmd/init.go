@@ -40,6 +40,8 @@ type initOptions struct {
 	validate                  bool
 	waitProviders             bool
 	waitProviderTimeout       int
+	declarative               bool
+	fromBundle                string
 }
 
 var initOpts = &initOptions{}
@@ -83,7 +85,15 @@ var initCmd = &cobra.Command{
 		clusterctl init --infrastructure=aws,vsphere
 
 		# Initialize a management cluster with a custom target namespace for the provider resources.
-		clusterctl init --infrastructure aws --target-namespace foo`),
+		clusterctl init --infrastructure aws --target-namespace foo
+
+		# Record the providers in the ManagementCluster object and initialize the management cluster accordingly;
+		# the ManagementCluster object can then be reconciled with 'clusterctl alpha management-cluster reconcile'.
+		clusterctl init --infrastructure aws:v2.9.0 --declarative
+
+		# Initialize a management cluster without network access, by installing all the providers in a bundle
+		# exported with 'clusterctl bundle export'.
+		clusterctl init --from-bundle bundle.tar.gz`),
 	Args: cobra.NoArgs,
 	RunE: func(*cobra.Command, []string) error {
 		return runInit()
@@ -117,6 +127,11 @@ func init() {
 		"Wait timeout per provider installation in seconds. This value is ignored if --wait-providers is false")
 	initCmd.Flags().BoolVar(&initOpts.validate, "validate", true,
 		"If true, clusterctl will validate that the deployments will succeed on the management cluster.")
+	initCmd.Flags().BoolVar(&initOpts.declarative, "declarative", false,
+		"If true, the providers are recorded in the ManagementCluster object of the management cluster, which is then reconciled; see 'clusterctl alpha management-cluster reconcile'.")
+	initCmd.Flags().StringVar(&initOpts.fromBundle, "from-bundle", "",
+		"Path to a bundle archive exported with 'clusterctl bundle export'; providers and cert-manager are installed from the bundle without network access. If no providers are specified, all the providers in the bundle are installed.")
+	initCmd.MarkFlagsMutuallyExclusive("from-bundle", "declarative")
 
 	initCmd.AddCommand(initListImagesCmd)
 	RootCmd.AddCommand(initCmd)
@@ -125,11 +140,23 @@ func init() {
 func runInit() error {
 	ctx := context.Background()
 
-	c, err := client.New(ctx, cfgFile)
+	var c client.Client
+	var err error
+	if initOpts.fromBundle != "" {
+		var cleanup func()
+		c, cleanup, err = newClientFromBundle(ctx, initOpts.fromBundle, "")
+		defer cleanup()
+	} else {
+		c, err = client.New(ctx, cfgFile)
+	}
 	if err != nil {
 		return err
 	}
 
+	if initOpts.declarative {
+		return runInitDeclarative(ctx, c)
+	}
+
 	options := client.InitOptions{
 		Kubeconfig:                client.Kubeconfig{Path: initOpts.kubeconfig, Context: initOpts.kubeconfigContext},
 		CoreProvider:              initOpts.coreProvider,
@@ -151,3 +178,28 @@ func runInit() error {
 	}
 	return nil
 }
+
+func runInitDeclarative(ctx context.Context, c client.Client) error {
+	kubeconfig := client.Kubeconfig{Path: initOpts.kubeconfig, Context: initOpts.kubeconfigContext}
+
+	if _, err := c.ApplyManagementCluster(ctx, client.ApplyManagementClusterOptions{
+		Kubeconfig:                kubeconfig,
+		CoreProvider:              initOpts.coreProvider,
+		BootstrapProviders:        initOpts.bootstrapProviders,
+		ControlPlaneProviders:     initOpts.controlPlaneProviders,
+		InfrastructureProviders:   initOpts.infrastructureProviders,
+		IPAMProviders:             initOpts.ipamProviders,
+		RuntimeExtensionProviders: initOpts.runtimeExtensionProviders,
+		AddonProviders:            initOpts.addonProviders,
+		TargetNamespace:           initOpts.targetNamespace,
+	}); err != nil {
+		return err
+	}
+
+	_, err := c.ReconcileManagementCluster(ctx, client.ReconcileManagementClusterOptions{
+		Kubeconfig:          kubeconfig,
+		WaitProviders:       initOpts.waitProviders,
+		WaitProviderTimeout: time.Duration(initOpts.waitProviderTimeout) * time.Second,
+	})
+	return err
+}
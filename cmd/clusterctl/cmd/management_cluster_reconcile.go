@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+)
+
+type managementClusterReconcileOptions struct {
+	kubeconfig          string
+	kubeconfigContext   string
+	watch               bool
+	syncPeriod          time.Duration
+	waitProviders       bool
+	waitProviderTimeout int
+}
+
+var mcro = &managementClusterReconcileOptions{}
+
+var managementClusterReconcileCmd = &cobra.Command{
+	Use:   "reconcile",
+	Args:  cobra.NoArgs,
+	Short: "Install and upgrade the providers of a management cluster according to its ManagementCluster object",
+	Long: templates.LongDesc(`
+		Install and upgrade the providers of a management cluster according to its ManagementCluster object.
+
+		Providers listed in the ManagementCluster which are not installed are installed, and providers
+		installed with a version different from the one in the ManagementCluster are upgraded.
+		Providers which are not listed in the ManagementCluster are never deleted.
+
+		With --watch, the management cluster is reconciled continuously; this allows e.g. to run clusterctl
+		in the management cluster and to manage the ManagementCluster object with GitOps tools.`),
+
+	Example: templates.Examples(`
+		# Reconcile the providers of the management cluster once.
+		clusterctl alpha management-cluster reconcile
+
+		# Reconcile the providers of the management cluster every minute.
+		clusterctl alpha management-cluster reconcile --watch --sync-period 1m`),
+
+	RunE: func(*cobra.Command, []string) error {
+		return runManagementClusterReconcile()
+	},
+}
+
+func init() {
+	managementClusterReconcileCmd.Flags().StringVar(&mcro.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	managementClusterReconcileCmd.Flags().StringVar(&mcro.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	managementClusterReconcileCmd.Flags().BoolVar(&mcro.watch, "watch", false,
+		"Reconcile the management cluster continuously, until the command is interrupted.")
+	managementClusterReconcileCmd.Flags().DurationVar(&mcro.syncPeriod, "sync-period", 5*time.Minute,
+		"Interval between two reconciles of the management cluster. This value is ignored if --watch is false")
+	managementClusterReconcileCmd.Flags().BoolVar(&mcro.waitProviders, "wait-providers", false,
+		"Wait for providers to be installed or upgraded.")
+	managementClusterReconcileCmd.Flags().IntVar(&mcro.waitProviderTimeout, "wait-provider-timeout", 5*60,
+		"Wait timeout per provider installation or upgrade in seconds. This value is ignored if --wait-providers is false")
+
+	managementClusterCmd.AddCommand(managementClusterReconcileCmd)
+}
+
+func runManagementClusterReconcile() error {
+	if mcro.watch && mcro.syncPeriod <= 0 {
+		return errors.Errorf("invalid sync period %s, the sync period must be greater than zero", mcro.syncPeriod)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	options := client.ReconcileManagementClusterOptions{
+		Kubeconfig:          client.Kubeconfig{Path: mcro.kubeconfig, Context: mcro.kubeconfigContext},
+		WaitProviders:       mcro.waitProviders,
+		WaitProviderTimeout: time.Duration(mcro.waitProviderTimeout) * time.Second,
+	}
+
+	if !mcro.watch {
+		_, err := c.ReconcileManagementCluster(ctx, options)
+		return err
+	}
+
+	log := logf.Log
+	for {
+		if _, err := c.ReconcileManagementCluster(ctx, options); err != nil {
+			// Errors are surfaced in the ManagementCluster conditions; keep reconciling so transient errors are recovered.
+			log.Error(err, "Failed to reconcile the management cluster")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(mcro.syncPeriod):
+		}
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type migrateAPIVersionsOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	dryRun            bool
+	output            string
+}
+
+var mao = &migrateAPIVersionsOptions{}
+
+var migrateAPIVersionsCmd = &cobra.Command{
+	Use:   "apiversions",
+	Args:  cobra.NoArgs,
+	Short: "Migrate the Cluster API objects stored at deprecated API versions to the current storage versions",
+	Long: templates.LongDesc(`
+		Migrate the Cluster API objects stored at deprecated API versions to the current storage versions.
+
+		The objects of the CRDs which have deprecated API versions, e.g. v1alpha3 or v1alpha4, in status.storedVersions
+		are rewritten at the hub version and their conversion data annotation is removed; then status.storedVersions
+		is updated. This is required before upgrading to a Cluster API release which removes those API versions.`),
+
+	Example: templates.Examples(`
+		# Migrate the Cluster API objects stored at deprecated API versions.
+		clusterctl alpha migrate apiversions
+
+		# Report the objects stored at deprecated API versions per namespace, without migrating them.
+		clusterctl alpha migrate apiversions --dry-run`),
+
+	RunE: func(*cobra.Command, []string) error {
+		return runMigrateAPIVersions(os.Stdout)
+	},
+}
+
+func init() {
+	migrateAPIVersionsCmd.Flags().StringVar(&mao.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	migrateAPIVersionsCmd.Flags().StringVar(&mao.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	migrateAPIVersionsCmd.Flags().BoolVar(&mao.dryRun, "dry-run", false,
+		"Only report the objects requiring migration, without migrating them.")
+	migrateAPIVersionsCmd.Flags().StringVarP(&mao.output, "output", "o", SkewReportOutputText,
+		fmt.Sprintf("Output format. Valid values: %v.", SkewReportOutputs))
+
+	migrateCmd.AddCommand(migrateAPIVersionsCmd)
+}
+
+func runMigrateAPIVersions(out io.Writer) error {
+	if mao.output != SkewReportOutputText && mao.output != SkewReportOutputYaml && mao.output != SkewReportOutputJSON {
+		return errors.Errorf("invalid output format %q, valid values: %v", mao.output, SkewReportOutputs)
+	}
+
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	report, err := c.MigrateAPIVersions(ctx, client.MigrateAPIVersionsOptions{
+		Kubeconfig: client.Kubeconfig{Path: mao.kubeconfig, Context: mao.kubeconfigContext},
+		DryRun:     mao.dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printAPIVersionMigrationReport(report, mao.output, out)
+}
+
+func printAPIVersionMigrationReport(report *cluster.APIVersionMigrationReport, output string, out io.Writer) error {
+	switch output {
+	case SkewReportOutputYaml:
+		y, err := yaml.Marshal(report)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, string(y))
+		return nil
+	case SkewReportOutputJSON:
+		j, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(j))
+		return nil
+	}
+
+	if len(report.CRDs) == 0 {
+		fmt.Fprintln(out, "No objects are stored at deprecated API versions")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "CRD\tDEPRECATED VERSIONS\tSTORAGE VERSION\tMIGRATED")
+	for _, c := range report.CRDs {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%t\n", c.CRD, strings.Join(c.DeprecatedVersions, ","), c.StorageVersion, c.Migrated)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(report.Namespaces) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(out, "")
+	w = tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tKIND\tOBJECTS\tCONVERSION DATA ANNOTATIONS")
+	for _, n := range report.Namespaces {
+		for _, r := range n.Resources {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\n", n.Namespace, r.Kind, r.Objects, r.ConversionDataAnnotations)
+		}
+	}
+	return w.Flush()
+}
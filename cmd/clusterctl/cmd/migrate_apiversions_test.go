@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+func Test_printAPIVersionMigrationReport(t *testing.T) {
+	tests := []struct {
+		name   string
+		report *cluster.APIVersionMigrationReport
+		output string
+		want   string
+	}{
+		{
+			name: "text",
+			report: &cluster.APIVersionMigrationReport{
+				CRDs: []cluster.APIVersionMigrationCRD{
+					{CRD: "machines.cluster.x-k8s.io", DeprecatedVersions: []string{"v1alpha3", "v1alpha4"}, StorageVersion: "v1beta2", Migrated: true},
+				},
+				Namespaces: []cluster.APIVersionMigrationNamespace{
+					{Namespace: "default", Resources: []cluster.APIVersionMigrationResource{{Kind: "Machine", Objects: 3, ConversionDataAnnotations: 1}}},
+				},
+			},
+			output: SkewReportOutputText,
+			want: `CRD                         DEPRECATED VERSIONS   STORAGE VERSION   MIGRATED
+machines.cluster.x-k8s.io   v1alpha3,v1alpha4     v1beta2           true
+
+NAMESPACE   KIND      OBJECTS   CONVERSION DATA ANNOTATIONS
+default     Machine   3         1
+`,
+		},
+		{
+			name:   "text without objects to migrate",
+			report: &cluster.APIVersionMigrationReport{},
+			output: SkewReportOutputText,
+			want:   "No objects are stored at deprecated API versions\n",
+		},
+		{
+			name: "yaml",
+			report: &cluster.APIVersionMigrationReport{
+				CRDs: []cluster.APIVersionMigrationCRD{
+					{CRD: "machines.cluster.x-k8s.io", DeprecatedVersions: []string{"v1alpha4"}, StorageVersion: "v1beta2"},
+				},
+			},
+			output: SkewReportOutputYaml,
+			want: `crds:
+- crd: machines.cluster.x-k8s.io
+  deprecatedVersions:
+  - v1alpha4
+  migrated: false
+  storageVersion: v1beta2
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			buf := &bytes.Buffer{}
+			g.Expect(printAPIVersionMigrationReport(tt.report, tt.output, buf)).To(Succeed())
+			g.Expect(buf.String()).To(Equal(tt.want))
+		})
+	}
+}
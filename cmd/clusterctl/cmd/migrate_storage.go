@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type migrateStorageOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	providers         []string
+	dryRun            bool
+	output            string
+}
+
+var mso = &migrateStorageOptions{}
+
+var migrateStorageCmd = &cobra.Command{
+	Use:   "migrate-storage",
+	Args:  cobra.NoArgs,
+	Short: "Migrate the CRs of the providers in a management cluster to the storage version of their CRDs",
+	Long: templates.LongDesc(`
+		Migrate the CRs of the providers in a management cluster to the storage version of their CRDs.
+
+		All the objects of the CRDs which have versions other than the storage version in status.storedVersions
+		are rewritten, so they are stored in the storage version, and status.storedVersions is updated.
+		This is required before upgrading to a provider version which removes old API versions.`),
+
+	Example: templates.Examples(`
+		# Migrate the CRs of all the providers in the management cluster.
+		clusterctl alpha migrate-storage
+
+		# Report the CRDs of a provider requiring migration, without migrating them.
+		clusterctl alpha migrate-storage --provider infrastructure-docker --dry-run`),
+
+	RunE: func(*cobra.Command, []string) error {
+		return runMigrateStorage(os.Stdout)
+	},
+}
+
+func init() {
+	migrateStorageCmd.Flags().StringVar(&mso.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	migrateStorageCmd.Flags().StringVar(&mso.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	migrateStorageCmd.Flags().StringSliceVar(&mso.providers, "provider", nil,
+		"Providers whose CRDs should be migrated, e.g. infrastructure-docker. If unspecified, the CRDs of all the providers are migrated.")
+	migrateStorageCmd.Flags().BoolVar(&mso.dryRun, "dry-run", false,
+		"Only report the CRDs requiring migration, without migrating them.")
+	migrateStorageCmd.Flags().StringVarP(&mso.output, "output", "o", SkewReportOutputText,
+		fmt.Sprintf("Output format. Valid values: %v.", SkewReportOutputs))
+}
+
+func runMigrateStorage(out io.Writer) error {
+	if mso.output != SkewReportOutputText && mso.output != SkewReportOutputYaml && mso.output != SkewReportOutputJSON {
+		return errors.Errorf("invalid output format %q, valid values: %v", mso.output, SkewReportOutputs)
+	}
+
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	migrations, err := c.MigrateStorage(ctx, client.MigrateStorageOptions{
+		Kubeconfig: client.Kubeconfig{Path: mso.kubeconfig, Context: mso.kubeconfigContext},
+		Providers:  mso.providers,
+		DryRun:     mso.dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printCRDMigrations(migrations, mso.output, out)
+}
+
+func printCRDMigrations(migrations []cluster.CRDMigration, output string, out io.Writer) error {
+	switch output {
+	case SkewReportOutputYaml:
+		y, err := yaml.Marshal(migrations)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, string(y))
+		return nil
+	case SkewReportOutputJSON:
+		j, err := json.MarshalIndent(migrations, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(j))
+		return nil
+	}
+
+	if len(migrations) == 0 {
+		fmt.Fprintln(out, "No CRDs require migration")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "CRD\tPROVIDER\tSTORED VERSIONS\tSTORAGE VERSION\tMIGRATED")
+	for _, m := range migrations {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\n", m.CRD, prettifyVersion(m.Provider), strings.Join(m.StoredVersions, ","), m.StorageVersion, m.Migrated)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+func Test_printCRDMigrations(t *testing.T) {
+	tests := []struct {
+		name       string
+		migrations []cluster.CRDMigration
+		output     string
+		want       string
+	}{
+		{
+			name: "text",
+			migrations: []cluster.CRDMigration{
+				{CRD: "dockerclusters.infrastructure.cluster.x-k8s.io", Provider: "infrastructure-docker", StorageVersion: "v1beta2", StoredVersions: []string{"v1beta1", "v1beta2"}, Migrated: true},
+			},
+			output: SkewReportOutputText,
+			want: `CRD                                              PROVIDER                STORED VERSIONS   STORAGE VERSION   MIGRATED
+dockerclusters.infrastructure.cluster.x-k8s.io   infrastructure-docker   v1beta1,v1beta2   v1beta2           true
+`,
+		},
+		{
+			name:   "text without migrations",
+			output: SkewReportOutputText,
+			want:   "No CRDs require migration\n",
+		},
+		{
+			name: "yaml",
+			migrations: []cluster.CRDMigration{
+				{CRD: "foo", StorageVersion: "v1beta2", StoredVersions: []string{"v1beta1"}},
+			},
+			output: SkewReportOutputYaml,
+			want: `- crd: foo
+  migrated: false
+  storageVersion: v1beta2
+  storedVersions:
+  - v1beta1
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			buf := &bytes.Buffer{}
+			g.Expect(printCRDMigrations(tt.migrations, tt.output, buf)).To(Succeed())
+			g.Expect(buf.String()).To(Equal(tt.want))
+		})
+	}
+}
@@ -38,9 +38,13 @@ type moveOptions struct {
 	toKubeconfig          string
 	toKubeconfigContext   string
 	namespace             string
+	clusterSelector       string
+	excludeKinds          []string
 	fromDirectory         string
 	toDirectory           string
 	dryRun                bool
+	stateFile             string
+	cleanup               bool
 	hideAPIWarnings       string
 }
 
@@ -64,6 +68,19 @@ var moveCmd = &cobra.Command{
 
 		Read Cluster API objects and all dependencies from a directory into a management cluster.
 		clusterctl move --from-directory /tmp/backup-directory
+
+		Move only the Clusters with the env=prod label and all their dependencies between management clusters.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --cluster-selector env=prod
+
+		Move Cluster API objects and all dependencies between management clusters, excluding objects of a given kind.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --exclude-kinds AWSClusterStaticIdentity.infrastructure.cluster.x-k8s.io
+
+		Move Cluster API objects and all dependencies between management clusters, recording the progress in a state file;
+		if the move fails midway, run the same command again to resume it.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --state-file move-state.yaml
+
+		Revert the pauses applied to the source management cluster by a move recorded in a state file, e.g. after aborting it.
+		clusterctl move --cleanup --state-file move-state.yaml
 	`),
 	Args: cobra.NoArgs,
 	RunE: func(*cobra.Command, []string) error {
@@ -82,18 +99,32 @@ func init() {
 		"Context to be used within the kubeconfig file for the destination management cluster. If empty, current context will be used.")
 	moveCmd.Flags().StringVarP(&mo.namespace, "namespace", "n", "",
 		"The namespace where the workload cluster is hosted. If unspecified, the current context's namespace is used.")
+	moveCmd.Flags().StringVar(&mo.clusterSelector, "cluster-selector", "",
+		"Label selector restricting move to the matching Clusters and to the objects belonging to them. If unspecified, all the Clusters in the namespace are moved.")
+	moveCmd.Flags().StringSliceVar(&mo.excludeKinds, "exclude-kinds", nil,
+		"Comma separated list of kinds, in the Kind or Kind.group format, of the objects to be excluded from move. Excluded objects are neither moved nor deleted from the source management cluster.")
 	moveCmd.Flags().BoolVar(&mo.dryRun, "dry-run", false,
 		"Enable dry run, don't really perform the move actions")
 	moveCmd.Flags().StringVar(&mo.toDirectory, "to-directory", "",
 		"Write Cluster API objects and all dependencies from a management cluster to directory.")
 	moveCmd.Flags().StringVar(&mo.fromDirectory, "from-directory", "",
 		"Read Cluster API objects and all dependencies from a directory into a management cluster.")
+	moveCmd.Flags().StringVar(&mo.stateFile, "state-file", "",
+		"Path to a local file where the progress of the move is recorded. If the file exists, e.g. because a previous move failed midway, the move is resumed from it. The file is deleted once the move is completed.")
+	moveCmd.Flags().BoolVar(&mo.cleanup, "cleanup", false,
+		"Revert the pauses applied to the source management cluster by the move recorded in --state-file, e.g. after aborting it, instead of moving objects.")
 	moveCmd.Flags().StringVar(&mo.hideAPIWarnings, "hide-api-warnings", "default",
 		"Set of API server warnings to hide. Valid sets are \"default\" (includes metadata.finalizer warnings), \"all\" , and \"none\".")
 
 	moveCmd.MarkFlagsMutuallyExclusive("to-directory", "to-kubeconfig")
 	moveCmd.MarkFlagsMutuallyExclusive("from-directory", "to-directory")
 	moveCmd.MarkFlagsMutuallyExclusive("from-directory", "kubeconfig")
+	moveCmd.MarkFlagsMutuallyExclusive("from-directory", "cluster-selector")
+	moveCmd.MarkFlagsMutuallyExclusive("from-directory", "exclude-kinds")
+	moveCmd.MarkFlagsMutuallyExclusive("state-file", "to-directory")
+	moveCmd.MarkFlagsMutuallyExclusive("state-file", "from-directory")
+	moveCmd.MarkFlagsMutuallyExclusive("cleanup", "to-kubeconfig")
+	moveCmd.MarkFlagsMutuallyExclusive("cleanup", "dry-run")
 
 	RootCmd.AddCommand(moveCmd)
 }
@@ -104,7 +135,8 @@ func runMove() error {
 	if mo.toDirectory == "" &&
 		mo.fromDirectory == "" &&
 		mo.toKubeconfig == "" &&
-		!mo.dryRun {
+		!mo.dryRun &&
+		!mo.cleanup {
 		return errors.New("please specify a target cluster using the --to-kubeconfig flag when not using --dry-run, --to-directory or --from-directory")
 	}
 
@@ -162,11 +194,15 @@ func runMove() error {
 	}
 
 	return c.Move(ctx, client.MoveOptions{
-		FromKubeconfig: client.Kubeconfig{Path: mo.fromKubeconfig, Context: mo.fromKubeconfigContext},
-		ToKubeconfig:   client.Kubeconfig{Path: mo.toKubeconfig, Context: mo.toKubeconfigContext},
-		FromDirectory:  mo.fromDirectory,
-		ToDirectory:    mo.toDirectory,
-		Namespace:      mo.namespace,
-		DryRun:         mo.dryRun,
+		FromKubeconfig:  client.Kubeconfig{Path: mo.fromKubeconfig, Context: mo.fromKubeconfigContext},
+		ToKubeconfig:    client.Kubeconfig{Path: mo.toKubeconfig, Context: mo.toKubeconfigContext},
+		FromDirectory:   mo.fromDirectory,
+		ToDirectory:     mo.toDirectory,
+		Namespace:       mo.namespace,
+		ClusterSelector: mo.clusterSelector,
+		ExcludeKinds:    mo.excludeKinds,
+		DryRun:          mo.dryRun,
+		StateFile:       mo.stateFile,
+		Cleanup:         mo.cleanup,
 	})
 }
@@ -0,0 +1,172 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type repairOwnerRefsOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	namespace         string
+	dryRun            bool
+	output            string
+}
+
+var rpo = &repairOwnerRefsOptions{}
+
+var repairOwnerRefsCmd = &cobra.Command{
+	Use:   "ownerrefs [NAME]",
+	Args:  cobra.MaximumNArgs(1),
+	Short: "Check and repair the ownerReferences of the objects of the Clusters in a management cluster",
+	Long: templates.LongDesc(`
+		Check and repair the ownerReferences and finalizers of the objects of the Clusters in a management cluster.
+
+		Missing ownerReferences and finalizers, and ownerReferences with a stale UID or apiVersion, e.g. after
+		restoring a backup, are repaired. ownerReferences to objects which don't exist or don't belong to the
+		Cluster, and objects controlled by an unexpected owner, are only reported and must be fixed manually.
+
+		Paused Clusters are checked as well; it is recommended to not run this command while clusterctl move
+		is in progress.`),
+
+	Example: templates.Examples(`
+		# Check and repair the ownerReferences of all the Clusters in the management cluster.
+		clusterctl alpha repair ownerrefs
+
+		# Check the ownerReferences of all the Clusters in a namespace, without repairing them.
+		clusterctl alpha repair ownerrefs --namespace foo --dry-run
+
+		# Check and repair the ownerReferences of a Cluster and print the result in yaml format.
+		clusterctl alpha repair ownerrefs my-cluster --namespace foo -o yaml`),
+
+	RunE: func(_ *cobra.Command, args []string) error {
+		clusterName := ""
+		if len(args) > 0 {
+			clusterName = args[0]
+		}
+		return runRepairOwnerRefs(clusterName, os.Stdout)
+	},
+}
+
+func init() {
+	repairOwnerRefsCmd.Flags().StringVar(&rpo.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	repairOwnerRefsCmd.Flags().StringVar(&rpo.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	repairOwnerRefsCmd.Flags().StringVarP(&rpo.namespace, "namespace", "n", "",
+		"Namespace where the Clusters exist. If unspecified, Clusters in all the namespaces are checked, or the current namespace is used when a Cluster name is specified.")
+	repairOwnerRefsCmd.Flags().BoolVar(&rpo.dryRun, "dry-run", false,
+		"Only report the inconsistencies, without repairing them.")
+	repairOwnerRefsCmd.Flags().StringVarP(&rpo.output, "output", "o", SkewReportOutputText,
+		fmt.Sprintf("Output format. Valid values: %v.", SkewReportOutputs))
+
+	repairCmd.AddCommand(repairOwnerRefsCmd)
+}
+
+func runRepairOwnerRefs(clusterName string, out io.Writer) error {
+	if rpo.output != SkewReportOutputText && rpo.output != SkewReportOutputYaml && rpo.output != SkewReportOutputJSON {
+		return errors.Errorf("invalid output format %q, valid values: %v", rpo.output, SkewReportOutputs)
+	}
+
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	report, err := c.RepairOwnerRefs(ctx, client.RepairOwnerRefsOptions{
+		Kubeconfig:  client.Kubeconfig{Path: rpo.kubeconfig, Context: rpo.kubeconfigContext},
+		Namespace:   rpo.namespace,
+		ClusterName: clusterName,
+		DryRun:      rpo.dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printOwnerRefsReport(report, rpo.output, out)
+}
+
+func printOwnerRefsReport(report *cluster.OwnerRefsReport, output string, out io.Writer) error {
+	switch output {
+	case SkewReportOutputYaml:
+		y, err := yaml.Marshal(report)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, string(y))
+		return nil
+	case SkewReportOutputJSON:
+		j, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(j))
+		return nil
+	}
+
+	if len(report.Clusters) == 0 {
+		fmt.Fprintln(out, "No Clusters found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tNAME\tISSUES\tREPAIRED")
+	issues := []string{}
+	for _, c := range report.Clusters {
+		repaired := 0
+		for _, i := range c.Issues {
+			status := "not repairable"
+			switch {
+			case i.Repaired:
+				status = "repaired"
+				repaired++
+			case i.Repairable:
+				status = "repairable"
+			}
+			issues = append(issues, fmt.Sprintf("Cluster %s/%s, %s %s: %s (%s)", c.Namespace, c.Name, i.Kind, i.Name, i.Message, status))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\n", c.Namespace, c.Name, len(c.Issues), repaired)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(issues) > 0 {
+		fmt.Fprintln(out, "")
+		fmt.Fprintln(out, "ownerReferences and finalizers issues:")
+		for _, i := range issues {
+			fmt.Fprintf(out, "- %s\n", i)
+		}
+	}
+	return nil
+}
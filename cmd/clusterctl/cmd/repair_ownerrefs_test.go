@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/internal/ownerrefs"
+)
+
+func Test_printOwnerRefsReport(t *testing.T) {
+	report := &cluster.OwnerRefsReport{
+		Clusters: []cluster.ClusterOwnerRefsReport{
+			{
+				Namespace: "ns1",
+				Name:      "cluster1",
+				Issues: []ownerrefs.Issue{
+					{Kind: "MachineDeployment", Name: "md1", Message: "ownerReference to Cluster cluster1 is missing", Repairable: true, Repaired: true},
+					{Kind: "MachineSet", Name: "ms1", Message: "finalizer cluster.x-k8s.io/machineset is missing", Repairable: true},
+					{Kind: "Machine", Name: "m1", Message: "it is controlled by MachineSet ms0, but it should be controlled by MachineSet ms1"},
+				},
+			},
+			{
+				Namespace: "ns2",
+				Name:      "cluster2",
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		report *cluster.OwnerRefsReport
+		output string
+		want   string
+	}{
+		{
+			name:   "text",
+			report: report,
+			output: SkewReportOutputText,
+			want: `NAMESPACE   NAME       ISSUES    REPAIRED
+ns1         cluster1   3         1
+ns2         cluster2   0         0
+
+ownerReferences and finalizers issues:
+- Cluster ns1/cluster1, MachineDeployment md1: ownerReference to Cluster cluster1 is missing (repaired)
+- Cluster ns1/cluster1, MachineSet ms1: finalizer cluster.x-k8s.io/machineset is missing (repairable)
+- Cluster ns1/cluster1, Machine m1: it is controlled by MachineSet ms0, but it should be controlled by MachineSet ms1 (not repairable)
+`,
+		},
+		{
+			name:   "text without Clusters",
+			report: &cluster.OwnerRefsReport{},
+			output: SkewReportOutputText,
+			want:   "No Clusters found\n",
+		},
+		{
+			name:   "yaml",
+			report: &cluster.OwnerRefsReport{Clusters: []cluster.ClusterOwnerRefsReport{{Namespace: "ns2", Name: "cluster2"}}},
+			output: SkewReportOutputYaml,
+			want: `clusters:
+- name: cluster2
+  namespace: ns2
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			buf := &bytes.Buffer{}
+			g.Expect(printOwnerRefsReport(tt.report, tt.output, buf)).To(Succeed())
+			g.Expect(buf.String()).To(Equal(tt.want))
+		})
+	}
+}
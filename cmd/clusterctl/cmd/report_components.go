@@ -0,0 +1,190 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type reportComponentsOptions struct {
+	kubeconfig              string
+	kubeconfigContext       string
+	namespace               string
+	output                  string
+	store                   bool
+	inspectWorkloadClusters bool
+}
+
+var rco = &reportComponentsOptions{}
+
+var reportComponentsCmd = &cobra.Command{
+	Use:   "components [NAME]",
+	Args:  cobra.MaximumNArgs(1),
+	Short: "Report the components managed by Cluster API for the Clusters in a management cluster",
+	Long: templates.LongDesc(`
+		Report the components managed by Cluster API for the Clusters in a management cluster.
+
+		For each Cluster, the report lists the Kubernetes version, the control plane, infrastructure and
+		worker objects with their bootstrap format, the kubeadm components like etcd and CoreDNS, the
+		components reported by the Nodes, and the versions of the providers managing the Cluster.
+
+		The report can be stored in a ConfigMap named <cluster>-managed-components in the namespace
+		of each Cluster, e.g. to be collected by compliance tooling.`),
+
+	Example: templates.Examples(`
+		# Report the managed components of all the Clusters in the management cluster.
+		clusterctl alpha report components
+
+		# Report the managed components of a Cluster in yaml format.
+		clusterctl alpha report components my-cluster --namespace foo -o yaml
+
+		# Report the managed components of all the Clusters in a namespace, including the etcd and CoreDNS
+		# images running in the workload clusters, and store them in a ConfigMap for each Cluster.
+		clusterctl alpha report components --namespace foo --inspect-workload-clusters --store`),
+
+	RunE: func(_ *cobra.Command, args []string) error {
+		clusterName := ""
+		if len(args) > 0 {
+			clusterName = args[0]
+		}
+		return runReportComponents(clusterName, os.Stdout)
+	},
+}
+
+func init() {
+	reportComponentsCmd.Flags().StringVar(&rco.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	reportComponentsCmd.Flags().StringVar(&rco.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	reportComponentsCmd.Flags().StringVarP(&rco.namespace, "namespace", "n", "",
+		"Namespace where the Clusters exist. If unspecified, Clusters in all the namespaces are reported, or the current namespace is used when a Cluster name is specified.")
+	reportComponentsCmd.Flags().StringVarP(&rco.output, "output", "o", SkewReportOutputText,
+		fmt.Sprintf("Output format. Valid values: %v.", SkewReportOutputs))
+	reportComponentsCmd.Flags().BoolVar(&rco.store, "store", false,
+		"Store the managed components of each Cluster in a ConfigMap in the namespace of the Cluster.")
+	reportComponentsCmd.Flags().BoolVar(&rco.inspectWorkloadClusters, "inspect-workload-clusters", false,
+		"Read the images of the etcd and CoreDNS components running in the workload clusters.")
+
+	reportCmd.AddCommand(reportComponentsCmd)
+}
+
+func runReportComponents(clusterName string, out io.Writer) error {
+	if rco.output != SkewReportOutputText && rco.output != SkewReportOutputYaml && rco.output != SkewReportOutputJSON {
+		return errors.Errorf("invalid output format %q, valid values: %v", rco.output, SkewReportOutputs)
+	}
+
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	report, err := c.ReportManagedComponents(ctx, client.ReportManagedComponentsOptions{
+		Kubeconfig:              client.Kubeconfig{Path: rco.kubeconfig, Context: rco.kubeconfigContext},
+		Namespace:               rco.namespace,
+		ClusterName:             clusterName,
+		InspectWorkloadClusters: rco.inspectWorkloadClusters,
+		Store:                   rco.store,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printManagedComponentsReport(report, rco.output, out)
+}
+
+func printManagedComponentsReport(report *cluster.ManagedComponentsReport, output string, out io.Writer) error {
+	switch output {
+	case SkewReportOutputYaml:
+		y, err := yaml.Marshal(report)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, string(y))
+		return nil
+	case SkewReportOutputJSON:
+		j, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(j))
+		return nil
+	}
+
+	if len(report.Clusters) == 0 {
+		fmt.Fprintln(out, "No Clusters found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tNAME\tKUBERNETES\tCONTROL PLANE\tBOOTSTRAP FORMATS\tETCD\tCOREDNS\tPROVIDERS")
+	for _, c := range report.Clusters {
+		controlPlane, etcd, coreDNS := "-", "-", "-"
+		formats := map[string]bool{}
+		if c.ControlPlane != nil {
+			controlPlane = c.ControlPlane.Kind
+			if c.ControlPlane.BootstrapFormat != "" {
+				formats[c.ControlPlane.BootstrapFormat] = true
+			}
+		}
+		for _, worker := range c.Workers {
+			if worker.BootstrapFormat != "" {
+				formats[worker.BootstrapFormat] = true
+			}
+		}
+		if c.Kubeadm != nil {
+			etcd = prettifyVersion(c.Kubeadm.EtcdVersion)
+			coreDNS = prettifyVersion(c.Kubeadm.CoreDNSVersion)
+		}
+		providers := make([]string, 0, len(c.Providers))
+		for _, p := range c.Providers {
+			providers = append(providers, fmt.Sprintf("%s:%s", p.Name, p.Version))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", c.Namespace, c.Name, prettifyVersion(c.KubernetesVersion),
+			controlPlane, prettifyList(formats), etcd, coreDNS, prettifyVersion(strings.Join(providers, ",")))
+	}
+	return w.Flush()
+}
+
+// prettifyList returns the sorted list of the keys of a set.
+func prettifyList(set map[string]bool) string {
+	if len(set) == 0 {
+		return "-"
+	}
+	list := make([]string, 0, len(set))
+	for v := range set {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return strings.Join(list, ",")
+}
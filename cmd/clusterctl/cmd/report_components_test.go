@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+func Test_printManagedComponentsReport(t *testing.T) {
+	report := &cluster.ManagedComponentsReport{
+		Clusters: []cluster.ClusterManagedComponents{
+			{
+				Namespace:         "ns1",
+				Name:              "cluster1",
+				KubernetesVersion: "v1.33.1",
+				ControlPlane:      &cluster.ManagedComponent{Kind: "KubeadmControlPlane", Name: "cp1", BootstrapFormat: "cloud-config"},
+				Kubeadm:           &cluster.KubeadmComponents{EtcdVersion: "3.5.21-0"},
+				Workers: []cluster.ManagedComponent{
+					{Kind: "MachineDeployment", Name: "md1", BootstrapFormat: "ignition"},
+					{Kind: "MachineDeployment", Name: "md2", BootstrapFormat: "cloud-config"},
+				},
+				Providers: []cluster.ProviderComponent{
+					{Name: "cluster-api", Version: "v1.11.0"},
+					{Name: "control-plane-kubeadm", Version: "v1.11.0"},
+				},
+			},
+			{
+				Namespace: "ns2",
+				Name:      "cluster2",
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		report *cluster.ManagedComponentsReport
+		output string
+		want   string
+	}{
+		{
+			name:   "text",
+			report: report,
+			output: SkewReportOutputText,
+			want: `NAMESPACE   NAME       KUBERNETES   CONTROL PLANE         BOOTSTRAP FORMATS       ETCD       COREDNS   PROVIDERS
+ns1         cluster1   v1.33.1      KubeadmControlPlane   cloud-config,ignition   3.5.21-0   -         cluster-api:v1.11.0,control-plane-kubeadm:v1.11.0
+ns2         cluster2   -            -                     -                       -          -         -
+`,
+		},
+		{
+			name:   "text without Clusters",
+			report: &cluster.ManagedComponentsReport{},
+			output: SkewReportOutputText,
+			want:   "No Clusters found\n",
+		},
+		{
+			name:   "yaml",
+			report: &cluster.ManagedComponentsReport{Clusters: []cluster.ClusterManagedComponents{{Namespace: "ns2", Name: "cluster2", KubernetesVersion: "v1.32.0"}}},
+			output: SkewReportOutputYaml,
+			want: `clusters:
+- kubernetesVersion: v1.32.0
+  name: cluster2
+  namespace: ns2
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			buf := &bytes.Buffer{}
+			g.Expect(printManagedComponentsReport(tt.report, tt.output, buf)).To(Succeed())
+			g.Expect(buf.String()).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+const (
+	// SkewReportOutputText is an option used to print the skew report in text format.
+	SkewReportOutputText = "text"
+	// SkewReportOutputYaml is an option used to print the skew report in yaml format.
+	SkewReportOutputYaml = "yaml"
+	// SkewReportOutputJSON is an option used to print the skew report in json format.
+	SkewReportOutputJSON = "json"
+)
+
+var (
+	// SkewReportOutputs is a list of valid skew report outputs.
+	SkewReportOutputs = []string{SkewReportOutputText, SkewReportOutputYaml, SkewReportOutputJSON}
+)
+
+type reportSkewOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	namespace         string
+	output            string
+}
+
+var rso = &reportSkewOptions{}
+
+var reportSkewCmd = &cobra.Command{
+	Use:   "skew [NAME]",
+	Args:  cobra.MaximumNArgs(1),
+	Short: "Report the Kubernetes version skew of the Clusters in a management cluster",
+	Long: templates.LongDesc(`
+		Report the Kubernetes version skew of the Clusters in a management cluster.
+
+		For each Cluster, the report lists the control plane version, the versions of MachineDeployments
+		and MachinePools, the kubelet versions reported by the Machines, and flags the versions that are
+		not within the skew supported by the Kubernetes version skew policy, i.e. versions newer than
+		the control plane version or more than 3 minor versions older than the control plane version.`),
+
+	Example: templates.Examples(`
+		# Report the version skew of all the Clusters in the management cluster.
+		clusterctl alpha report skew
+
+		# Report the version skew of all the Clusters in a namespace.
+		clusterctl alpha report skew --namespace foo
+
+		# Report the version skew of a Cluster in yaml format.
+		clusterctl alpha report skew my-cluster --namespace foo -o yaml`),
+
+	RunE: func(_ *cobra.Command, args []string) error {
+		clusterName := ""
+		if len(args) > 0 {
+			clusterName = args[0]
+		}
+		return runReportSkew(clusterName, os.Stdout)
+	},
+}
+
+func init() {
+	reportSkewCmd.Flags().StringVar(&rso.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	reportSkewCmd.Flags().StringVar(&rso.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	reportSkewCmd.Flags().StringVarP(&rso.namespace, "namespace", "n", "",
+		"Namespace where the Clusters exist. If unspecified, Clusters in all the namespaces are reported, or the current namespace is used when a Cluster name is specified.")
+	reportSkewCmd.Flags().StringVarP(&rso.output, "output", "o", SkewReportOutputText,
+		fmt.Sprintf("Output format. Valid values: %v.", SkewReportOutputs))
+
+	reportCmd.AddCommand(reportSkewCmd)
+}
+
+func runReportSkew(clusterName string, out io.Writer) error {
+	if rso.output != SkewReportOutputText && rso.output != SkewReportOutputYaml && rso.output != SkewReportOutputJSON {
+		return errors.Errorf("invalid output format %q, valid values: %v", rso.output, SkewReportOutputs)
+	}
+
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	report, err := c.ReportSkew(ctx, client.ReportSkewOptions{
+		Kubeconfig:  client.Kubeconfig{Path: rso.kubeconfig, Context: rso.kubeconfigContext},
+		Namespace:   rso.namespace,
+		ClusterName: clusterName,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printSkewReport(report, rso.output, out)
+}
+
+func printSkewReport(report *cluster.SkewReport, output string, out io.Writer) error {
+	switch output {
+	case SkewReportOutputYaml:
+		y, err := yaml.Marshal(report)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, string(y))
+		return nil
+	case SkewReportOutputJSON:
+		j, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(j))
+		return nil
+	}
+
+	if len(report.Clusters) == 0 {
+		fmt.Fprintln(out, "No Clusters found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tNAME\tCONTROL PLANE\tWORKERS\tKUBELETS\tVIOLATIONS")
+	violations := []string{}
+	for _, c := range report.Clusters {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\n", c.Namespace, c.Name, prettifyVersion(c.ControlPlaneVersion),
+			prettifyVersions(c.WorkerVersions), prettifyVersions(c.KubeletVersions), len(c.Violations))
+		for _, v := range c.Violations {
+			violations = append(violations, fmt.Sprintf("Cluster %s/%s, %s %s: %s", c.Namespace, c.Name, v.Kind, v.Name, v.Message))
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(violations) > 0 {
+		fmt.Fprintln(out, "")
+		fmt.Fprintln(out, "Version skew violations:")
+		for _, v := range violations {
+			fmt.Fprintf(out, "- %s\n", v)
+		}
+	}
+	return nil
+}
+
+// prettifyVersions returns the sorted list of distinct versions.
+func prettifyVersions(objectVersions []cluster.ObjectVersion) string {
+	versions := map[string]bool{}
+	for _, v := range objectVersions {
+		versions[v.Version] = true
+	}
+	if len(versions) == 0 {
+		return "-"
+	}
+	list := make([]string, 0, len(versions))
+	for v := range versions {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return strings.Join(list, ",")
+}
+
+func prettifyVersion(version string) string {
+	if version == "" {
+		return "-"
+	}
+	return version
+}
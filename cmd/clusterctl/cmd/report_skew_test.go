@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+func Test_printSkewReport(t *testing.T) {
+	report := &cluster.SkewReport{
+		Clusters: []cluster.ClusterSkewReport{
+			{
+				Namespace:           "ns1",
+				Name:                "cluster1",
+				ControlPlaneVersion: "v1.33.1",
+				WorkerVersions: []cluster.ObjectVersion{
+					{Kind: "MachineDeployment", Name: "md1", Version: "v1.33.1"},
+					{Kind: "MachineDeployment", Name: "md2", Version: "v1.29.0"},
+				},
+				KubeletVersions: []cluster.ObjectVersion{
+					{Kind: "Machine", Name: "m1", Version: "v1.33.1"},
+					{Kind: "Machine", Name: "m2", Version: "v1.33.1"},
+				},
+				Violations: []cluster.SkewViolation{
+					{
+						ObjectVersion: cluster.ObjectVersion{Kind: "MachineDeployment", Name: "md2", Version: "v1.29.0"},
+						Message:       "version v1.29.0 is more than 3 minor versions older than control plane version v1.33.1",
+					},
+				},
+			},
+			{
+				Namespace: "ns2",
+				Name:      "cluster2",
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		report *cluster.SkewReport
+		output string
+		want   string
+	}{
+		{
+			name:   "text",
+			report: report,
+			output: SkewReportOutputText,
+			want: `NAMESPACE   NAME       CONTROL PLANE   WORKERS           KUBELETS   VIOLATIONS
+ns1         cluster1   v1.33.1         v1.29.0,v1.33.1   v1.33.1    1
+ns2         cluster2   -               -                 -          0
+
+Version skew violations:
+- Cluster ns1/cluster1, MachineDeployment md2: version v1.29.0 is more than 3 minor versions older than control plane version v1.33.1
+`,
+		},
+		{
+			name:   "text without Clusters",
+			report: &cluster.SkewReport{},
+			output: SkewReportOutputText,
+			want:   "No Clusters found\n",
+		},
+		{
+			name:   "yaml",
+			report: &cluster.SkewReport{Clusters: []cluster.ClusterSkewReport{{Namespace: "ns2", Name: "cluster2"}}},
+			output: SkewReportOutputYaml,
+			want: `clusters:
+- name: cluster2
+  namespace: ns2
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			buf := &bytes.Buffer{}
+			g.Expect(printSkewReport(tt.report, tt.output, buf)).To(Succeed())
+			g.Expect(buf.String()).To(Equal(tt.want))
+		})
+	}
+}
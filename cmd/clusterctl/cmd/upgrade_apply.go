@@ -95,9 +95,7 @@ func init() {
 	upgradeApplyCmd.Flags().IntVar(&ua.waitProviderTimeout, "wait-provider-timeout", 5*60,
 		"Wait timeout per provider upgrade in seconds. This value is ignored if --wait-providers is false")
 	upgradeApplyCmd.Flags().BoolVar(&ua.enableCRDStorageVersionMigration, "enable-crd-storage-version-migration", false,
-		"Enable CRD storage version migration")
-	_ = upgradeApplyCmd.Flags().MarkDeprecated("enable-crd-storage-version-migration",
-		"Storage version migration during upgrades has been deprecated and will be removed in Cluster API v1.13")
+		"Enable CRD storage version migration. If disabled, the upgrade fails before changing the management cluster if a new CRD drops a version listed in status.storedVersions.")
 }
 
 func runUpgradeApply() error {
@@ -48,6 +48,7 @@ import (
 type FakeCluster struct {
 	namespace              string
 	name                   string
+	labels                 map[string]string
 	paused                 bool
 	controlPlane           *FakeControlPlane
 	machinePools           []*FakeMachinePool
@@ -56,6 +57,7 @@ type FakeCluster struct {
 	machines               []*FakeMachine
 	withCloudConfigSecret  bool
 	withCredentialSecret   bool
+	identitySecretName     *string
 	topologyClass          *string
 	topologyClassNamespace *string
 }
@@ -93,6 +95,12 @@ func (f *FakeCluster) WithCredentialSecret() *FakeCluster {
 	return f
 }
 
+// WithIdentitySecret sets spec.identityRef of the cluster to a user provided Secret with the given name.
+func (f *FakeCluster) WithIdentitySecret(name string) *FakeCluster {
+	f.identitySecretName = &name
+	return f
+}
+
 func (f *FakeCluster) WithMachineDeployments(fakeMachineDeployment ...*FakeMachineDeployment) *FakeCluster {
 	f.machineDeployments = append(f.machineDeployments, fakeMachineDeployment...)
 	return f
@@ -123,6 +131,11 @@ func (f *FakeCluster) WithPaused() *FakeCluster {
 	return f
 }
 
+func (f *FakeCluster) WithLabels(labels map[string]string) *FakeCluster {
+	f.labels = labels
+	return f
+}
+
 func (f *FakeCluster) Objs() []client.Object {
 	clusterInfrastructure := &fakeinfrastructure.GenericInfrastructureCluster{
 		TypeMeta: metav1.TypeMeta{
@@ -145,6 +158,7 @@ func (f *FakeCluster) Objs() []client.Object {
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      f.name,
 			Namespace: f.namespace,
+			Labels:    f.labels,
 			// Labels: cluster.x-k8s.io/cluster-name=cluster MISSING??
 		},
 		Spec: clusterv1.ClusterSpec{
@@ -171,6 +185,13 @@ func (f *FakeCluster) Objs() []client.Object {
 		cluster.Spec.Paused = ptr.To(true)
 	}
 
+	if f.identitySecretName != nil {
+		cluster.Spec.IdentityRef = clusterv1.ClusterIdentityReference{
+			Kind: "Secret",
+			Name: *f.identitySecretName,
+		}
+	}
+
 	// Ensure the cluster gets a UID to be used by dependant objects for creating OwnerReferences.
 	setUID(cluster)
 
@@ -221,6 +242,20 @@ func (f *FakeCluster) Objs() []client.Object {
 		objs = append(objs, cloudSecret)
 	}
 
+	if f.identitySecretName != nil {
+		identitySecret := &corev1.Secret{ // provided by the user -- ** NOT RECONCILED **
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      *f.identitySecretName,
+				Namespace: f.namespace,
+			},
+		}
+		objs = append(objs, identitySecret)
+	}
+
 	if f.withCredentialSecret {
 		credentialSecret := &corev1.Secret{ // provided by the user -- ** NOT RECONCILED **
 			TypeMeta: metav1.TypeMeta{
@@ -34,12 +34,14 @@ import (
 	clusterclasscontroller "sigs.k8s.io/cluster-api/internal/controllers/clusterclass"
 	"sigs.k8s.io/cluster-api/internal/controllers/clusterresourceset"
 	"sigs.k8s.io/cluster-api/internal/controllers/clusterresourcesetbinding"
+	clustertemplateinstancecontroller "sigs.k8s.io/cluster-api/internal/controllers/clustertemplateinstance"
 	extensionconfigcontroller "sigs.k8s.io/cluster-api/internal/controllers/extensionconfig"
 	machinecontroller "sigs.k8s.io/cluster-api/internal/controllers/machine"
 	machinedeploymentcontroller "sigs.k8s.io/cluster-api/internal/controllers/machinedeployment"
 	machinehealthcheckcontroller "sigs.k8s.io/cluster-api/internal/controllers/machinehealthcheck"
 	machinepoolcontroller "sigs.k8s.io/cluster-api/internal/controllers/machinepool"
 	machinesetcontroller "sigs.k8s.io/cluster-api/internal/controllers/machineset"
+	ownerrefscontroller "sigs.k8s.io/cluster-api/internal/controllers/ownerrefs"
 	clustertopologycontroller "sigs.k8s.io/cluster-api/internal/controllers/topology/cluster"
 	machinedeploymenttopologycontroller "sigs.k8s.io/cluster-api/internal/controllers/topology/machinedeployment"
 	machinesettopologycontroller "sigs.k8s.io/cluster-api/internal/controllers/topology/machineset"
@@ -80,10 +82,19 @@ type MachineReconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// ResyncPeriod is the period after which all Machines are requeued, in addition to the resyncs of the cache.
+	ResyncPeriod time.Duration
+
 	RemoteConditionsGracePeriod time.Duration
 
 	AdditionalSyncMachineLabels      []*regexp.Regexp
 	AdditionalSyncMachineAnnotations []*regexp.Regexp
+
+	// DisableNodeWatch disables watching Nodes in workload clusters.
+	DisableNodeWatch bool
+
+	// SyncMachineConditionsToNode are the types of the Machine conditions mirrored as conditions of the corresponding Node.
+	SyncMachineConditionsToNode []string
 }
 
 func (r *MachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -93,9 +104,12 @@ func (r *MachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manag
 		ClusterCache:                     r.ClusterCache,
 		RuntimeClient:                    r.RuntimeClient,
 		WatchFilterValue:                 r.WatchFilterValue,
+		ResyncPeriod:                     r.ResyncPeriod,
 		RemoteConditionsGracePeriod:      r.RemoteConditionsGracePeriod,
 		AdditionalSyncMachineLabels:      r.AdditionalSyncMachineLabels,
 		AdditionalSyncMachineAnnotations: r.AdditionalSyncMachineAnnotations,
+		DisableNodeWatch:                 r.DisableNodeWatch,
+		SyncMachineConditionsToNode:      r.SyncMachineConditionsToNode,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
@@ -105,10 +119,17 @@ type MachineSetReconciler struct {
 	APIReader    client.Reader
 	ClusterCache clustercache.ClusterCache
 
+	// TemplateReader is used to read templates, e.g. from a cache shared across controllers.
+	// If not set, Client is used.
+	TemplateReader client.Reader
+
 	PreflightChecks sets.Set[clusterv1.MachineSetPreflightCheck]
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// ResyncPeriod is the period after which all MachineSets are requeued, in addition to the resyncs of the cache.
+	ResyncPeriod time.Duration
 }
 
 func (r *MachineSetReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -116,8 +137,10 @@ func (r *MachineSetReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 		Client:           r.Client,
 		APIReader:        r.APIReader,
 		ClusterCache:     r.ClusterCache,
+		TemplateReader:   r.TemplateReader,
 		PreflightChecks:  r.PreflightChecks,
 		WatchFilterValue: r.WatchFilterValue,
+		ResyncPeriod:     r.ResyncPeriod,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
@@ -126,9 +149,17 @@ type MachineDeploymentReconciler struct {
 	Client        client.Client
 	APIReader     client.Reader
 	RuntimeClient runtimeclient.Client
+	ClusterCache  clustercache.ClusterCache
+
+	// TemplateReader is used to read templates, e.g. from a cache shared across controllers.
+	// If not set, Client is used.
+	TemplateReader client.Reader
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// ResyncPeriod is the period after which all MachineDeployments are requeued, in addition to the resyncs of the cache.
+	ResyncPeriod time.Duration
 }
 
 func (r *MachineDeploymentReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -136,24 +167,37 @@ func (r *MachineDeploymentReconciler) SetupWithManager(ctx context.Context, mgr
 		Client:           r.Client,
 		APIReader:        r.APIReader,
 		RuntimeClient:    r.RuntimeClient,
+		ClusterCache:     r.ClusterCache,
+		TemplateReader:   r.TemplateReader,
 		WatchFilterValue: r.WatchFilterValue,
+		ResyncPeriod:     r.ResyncPeriod,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
 // MachineHealthCheckReconciler reconciles a MachineHealthCheck object.
 type MachineHealthCheckReconciler struct {
-	Client       client.Client
-	ClusterCache clustercache.ClusterCache
+	Client        client.Client
+	ClusterCache  clustercache.ClusterCache
+	RuntimeClient runtimeclient.Client
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// ResyncPeriod is the period after which all MachineHealthChecks are requeued, in addition to the resyncs of the cache.
+	ResyncPeriod time.Duration
+
+	// DisableNodeWatch disables watching Nodes in workload clusters.
+	DisableNodeWatch bool
 }
 
 func (r *MachineHealthCheckReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
 	return (&machinehealthcheckcontroller.Reconciler{
 		Client:           r.Client,
 		ClusterCache:     r.ClusterCache,
+		RuntimeClient:    r.RuntimeClient,
 		WatchFilterValue: r.WatchFilterValue,
+		ResyncPeriod:     r.ResyncPeriod,
+		DisableNodeWatch: r.DisableNodeWatch,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
@@ -165,19 +209,28 @@ type ClusterTopologyReconciler struct {
 	// race conditions caused by an outdated cache.
 	APIReader client.Reader
 
+	// TemplateReader is used to read ClusterClasses and templates, e.g. from a cache shared across controllers.
+	// If not set, Client is used.
+	TemplateReader client.Reader
+
 	RuntimeClient runtimeclient.Client
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// ResyncPeriod is the period after which all Clusters are requeued, in addition to the resyncs of the cache.
+	ResyncPeriod time.Duration
 }
 
 func (r *ClusterTopologyReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
 	return (&clustertopologycontroller.Reconciler{
 		Client:           r.Client,
 		APIReader:        r.APIReader,
+		TemplateReader:   r.TemplateReader,
 		ClusterCache:     r.ClusterCache,
 		RuntimeClient:    r.RuntimeClient,
 		WatchFilterValue: r.WatchFilterValue,
+		ResyncPeriod:     r.ResyncPeriod,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
@@ -315,6 +368,9 @@ type ExtensionConfigReconciler struct {
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// ResyncPeriod is the period after which all ExtensionConfigs are requeued, in addition to the resyncs of the cache.
+	ResyncPeriod time.Duration
 }
 
 func (r *ExtensionConfigReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -325,5 +381,40 @@ func (r *ExtensionConfigReconciler) SetupWithManager(ctx context.Context, mgr ct
 		PartialSecretCache: r.PartialSecretCache,
 		ReadOnly:           r.ReadOnly,
 		WatchFilterValue:   r.WatchFilterValue,
+		ResyncPeriod:       r.ResyncPeriod,
+	}).SetupWithManager(ctx, mgr, options)
+}
+
+// ClusterTemplateInstanceReconciler reconciles a ClusterTemplateInstance object.
+type ClusterTemplateInstanceReconciler struct {
+	Client client.Client
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+
+	// AllowSecretsAndClusterResourceSets allows ClusterTemplates to contain Secrets and ClusterResourceSets.
+	AllowSecretsAndClusterResourceSets bool
+}
+
+func (r *ClusterTemplateInstanceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	return (&clustertemplateinstancecontroller.Reconciler{
+		Client:                             r.Client,
+		WatchFilterValue:                   r.WatchFilterValue,
+		AllowSecretsAndClusterResourceSets: r.AllowSecretsAndClusterResourceSets,
+	}).SetupWithManager(ctx, mgr, options)
+}
+
+// OwnerReferencesReconciler checks and repairs the ownerReferences and finalizers of the objects of a Cluster.
+type OwnerReferencesReconciler struct {
+	Client client.Client
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+}
+
+func (r *OwnerReferencesReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	return (&ownerrefscontroller.Reconciler{
+		Client:           r.Client,
+		WatchFilterValue: r.WatchFilterValue,
 	}).SetupWithManager(ctx, mgr, options)
 }
@@ -220,6 +220,10 @@ type clusterAccessorLockedHealthCheckingState struct {
 
 	// consecutiveFailures is the number of consecutive health probe failures.
 	consecutiveFailures int
+
+	// clientCertificateExpiryTime is the expiry time of the client certificate of the kubeconfig used
+	// for the current connection. It is zero if the kubeconfig does not use a client certificate.
+	clientCertificateExpiryTime time.Time
 }
 
 // newClusterAccessor creates a new clusterAccessor.
@@ -276,7 +280,12 @@ func (ca *clusterAccessor) Connect(ctx context.Context) (retErr error) {
 			ca.lockedState.healthChecking.consecutiveFailures++
 		} else {
 			connectionUp.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(1)
+			lastHealthCheckSuccessTime.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(float64(ca.lockedState.healthChecking.lastProbeSuccessTime.Unix()))
+			if !ca.lockedState.healthChecking.clientCertificateExpiryTime.IsZero() {
+				clientCertificateExpiryTime.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(float64(ca.lockedState.healthChecking.clientCertificateExpiryTime.Unix()))
+			}
 		}
+		healthCheckConsecutiveFailures.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(float64(ca.lockedState.healthChecking.consecutiveFailures))
 	}()
 
 	if err != nil {
@@ -300,9 +309,10 @@ func (ca *clusterAccessor) Connect(ctx context.Context) (retErr error) {
 	now := time.Now()
 	ca.lockedState.healthChecking = clusterAccessorLockedHealthCheckingState{
 		// A client was just created successfully, so let's set the last probe times.
-		lastProbeTime:        now,
-		lastProbeSuccessTime: now,
-		consecutiveFailures:  0,
+		lastProbeTime:               now,
+		lastProbeSuccessTime:        now,
+		consecutiveFailures:         0,
+		clientCertificateExpiryTime: clientCertificateExpiry(ctx, connection.RESTConfig),
 	}
 	ca.lockedState.connection = &clusterAccessorLockedConnectionState{
 		restConfig:     connection.RESTConfig,
@@ -388,7 +398,9 @@ func (ca *clusterAccessor) HealthCheck(ctx context.Context) (bool, bool) {
 		log.V(6).Info("Health probe succeeded")
 		healthCheck.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(1)
 		healthChecksTotal.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace, "success").Inc()
+		lastHealthCheckSuccessTime.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(float64(ca.lockedState.healthChecking.lastProbeSuccessTime.Unix()))
 	}
+	healthCheckConsecutiveFailures.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(float64(ca.lockedState.healthChecking.consecutiveFailures))
 
 	tooManyConsecutiveFailures := ca.lockedState.healthChecking.consecutiveFailures >= ca.config.HealthProbe.FailureThreshold
 	return tooManyConsecutiveFailures, unauthorizedErrorOccurred
@@ -492,10 +504,24 @@ func (ca *clusterAccessor) GetHealthCheckingState(ctx context.Context) HealthChe
 	defer ca.rUnlock(ctx)
 
 	return HealthCheckingState{
-		LastProbeTime:        ca.lockedState.healthChecking.lastProbeTime,
-		LastProbeSuccessTime: ca.lockedState.healthChecking.lastProbeSuccessTime,
-		ConsecutiveFailures:  ca.lockedState.healthChecking.consecutiveFailures,
+		LastProbeTime:               ca.lockedState.healthChecking.lastProbeTime,
+		LastProbeSuccessTime:        ca.lockedState.healthChecking.lastProbeSuccessTime,
+		ConsecutiveFailures:         ca.lockedState.healthChecking.consecutiveFailures,
+		ClientCertificateExpiryTime: ca.lockedState.healthChecking.clientCertificateExpiryTime,
+	}
+}
+
+// clientCertificateExpiry returns the expiry time of the client certificate in the REST config, if any.
+func clientCertificateExpiry(ctx context.Context, restConfig *rest.Config) time.Time {
+	if restConfig == nil || len(restConfig.CertData) == 0 {
+		return time.Time{}
+	}
+	cert, err := certs.DecodeCertPEM(restConfig.CertData)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).V(6).Info(fmt.Sprintf("Failed to parse client certificate of the kubeconfig: %v", err))
+		return time.Time{}
 	}
+	return cert.NotAfter
 }
 
 func (ca *clusterAccessor) GetLastConnectionCreationErrorTime(ctx context.Context) time.Time {
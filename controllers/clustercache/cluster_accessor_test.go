@@ -19,8 +19,12 @@ package clustercache
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
 	"io"
+	"math/big"
 	"net/http"
 	"testing"
 	"time"
@@ -32,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/rest/fake"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,6 +45,7 @@ import (
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/remote"
+	"sigs.k8s.io/cluster-api/util/certs"
 	"sigs.k8s.io/cluster-api/util/kubeconfig"
 	"sigs.k8s.io/cluster-api/util/test/builder"
 )
@@ -434,3 +440,26 @@ func objBody(obj runtime.Object) io.ReadCloser {
 	corev1Codec := scheme.Codecs.CodecForVersions(scheme.Codecs.LegacyCodec(corev1GV), scheme.Codecs.UniversalDecoder(corev1GV), corev1GV, corev1GV)
 	return io.NopCloser(bytes.NewReader([]byte(runtime.EncodeOrDie(corev1Codec, obj))))
 }
+
+func TestClientCertificateExpiry(t *testing.T) {
+	g := NewWithT(t)
+
+	key, err := certs.NewPrivateKey()
+	g.Expect(err).ToNot(HaveOccurred())
+	notAfter := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	g.Expect(err).ToNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(certDER)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(clientCertificateExpiry(ctx, nil).IsZero()).To(BeTrue())
+	g.Expect(clientCertificateExpiry(ctx, &rest.Config{}).IsZero()).To(BeTrue())
+	g.Expect(clientCertificateExpiry(ctx, &rest.Config{TLSClientConfig: rest.TLSClientConfig{CertData: []byte("invalid")}}).IsZero()).To(BeTrue())
+	g.Expect(clientCertificateExpiry(ctx, &rest.Config{TLSClientConfig: rest.TLSClientConfig{CertData: certs.EncodeCertPEM(cert)}})).To(Equal(notAfter))
+}
@@ -186,6 +186,10 @@ type HealthCheckingState struct {
 	// ConsecutiveFailures is the number of consecutive health probe failures.
 	// Note: client creations are also counted as probes.
 	ConsecutiveFailures int
+
+	// ClientCertificateExpiryTime is the expiry time of the client certificate of the kubeconfig used to
+	// connect to the workload cluster. It is zero if the kubeconfig does not use a client certificate.
+	ClientCertificateExpiryTime time.Time
 }
 
 // ErrClusterNotConnected is returned by the ClusterCache when e.g. a Client cannot be returned
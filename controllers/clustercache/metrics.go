@@ -26,6 +26,9 @@ func init() {
 	ctrlmetrics.Registry.MustRegister(healthCheck)
 	ctrlmetrics.Registry.MustRegister(connectionUp)
 	ctrlmetrics.Registry.MustRegister(healthChecksTotal)
+	ctrlmetrics.Registry.MustRegister(healthCheckConsecutiveFailures)
+	ctrlmetrics.Registry.MustRegister(lastHealthCheckSuccessTime)
+	ctrlmetrics.Registry.MustRegister(clientCertificateExpiryTime)
 }
 
 var (
@@ -53,4 +56,28 @@ var (
 			"cluster_name", "cluster_namespace",
 		},
 	)
+	healthCheckConsecutiveFailures = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_cluster_cache_healthcheck_consecutive_failures",
+			Help: "Number of consecutive failed clustercache healthchecks for a cluster.",
+		}, []string{
+			"cluster_name", "cluster_namespace",
+		},
+	)
+	lastHealthCheckSuccessTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_cluster_cache_healthcheck_last_success_timestamp_seconds",
+			Help: "Unix time of the last successful clustercache healthcheck or connection for a cluster.",
+		}, []string{
+			"cluster_name", "cluster_namespace",
+		},
+	)
+	clientCertificateExpiryTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_cluster_cache_client_certificate_expiry_timestamp_seconds",
+			Help: "Unix time when the client certificate of the kubeconfig used to connect to a cluster expires.",
+		}, []string{
+			"cluster_name", "cluster_namespace",
+		},
+	)
 )
@@ -45,6 +45,9 @@ type KubeadmControlPlaneReconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// ResyncPeriod is the period after which all KubeadmControlPlanes are requeued, in addition to the resyncs of the cache.
+	ResyncPeriod time.Duration
+
 	RemoteConditionsGracePeriod time.Duration
 }
 
@@ -60,6 +63,7 @@ func (r *KubeadmControlPlaneReconciler) SetupWithManager(ctx context.Context, mg
 		EtcdCallTimeout:             r.EtcdCallTimeout,
 		EtcdLogger:                  r.EtcdLogger,
 		WatchFilterValue:            r.WatchFilterValue,
+		ResyncPeriod:                r.ResyncPeriod,
 		RemoteConditionsGracePeriod: r.RemoteConditionsGracePeriod,
 	}).SetupWithManager(ctx, mgr, options)
 }
@@ -35,8 +35,10 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/cluster-api/controllers/external"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/desiredstate"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/etcd"
 	"sigs.k8s.io/cluster-api/internal/hooks"
+	"sigs.k8s.io/cluster-api/internal/util/fastpath"
 	"sigs.k8s.io/cluster-api/internal/util/inplace"
 	"sigs.k8s.io/cluster-api/util/collections"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -103,8 +105,14 @@ type PreflightCheckResults struct {
 	TopologyVersionMismatch bool
 }
 
+// UpToDateCache caches the UpToDateResult of Machines, so it is not necessary to recompute it if neither the
+// Machine nor the KubeadmControlPlane, the Cluster, the InfraMachine and the KubeadmConfig changed.
+type UpToDateCache = fastpath.Cache[UpToDateResult]
+
 // NewControlPlane returns an instantiated ControlPlane.
-func NewControlPlane(ctx context.Context, managementCluster ManagementCluster, client client.Client, cluster *clusterv1.Cluster, kcp *controlplanev1.KubeadmControlPlane, ownedMachines collections.Machines) (*ControlPlane, error) {
+// clusterCAHash is the hash of the current cluster CA certificates; it is used to detect Machines that have been
+// bootstrapped with different cluster CA certificates, e.g. during a CA rotation. If empty, this check is skipped.
+func NewControlPlane(ctx context.Context, managementCluster ManagementCluster, client client.Client, cluster *clusterv1.Cluster, kcp *controlplanev1.KubeadmControlPlane, ownedMachines collections.Machines, clusterCAHash string, upToDateCache UpToDateCache) (*ControlPlane, error) {
 	infraMachines, err := getInfraMachines(ctx, client, ownedMachines)
 	if err != nil {
 		return nil, err
@@ -127,7 +135,7 @@ func NewControlPlane(ctx context.Context, managementCluster ManagementCluster, c
 	machinesNotUptoDate := make(collections.Machines, len(ownedMachines))
 	machinesUpToDateResults := map[string]UpToDateResult{}
 	for _, m := range ownedMachines {
-		upToDate, upToDateResult, err := UpToDate(ctx, client, cluster, m, kcp, &reconciliationTime, infraMachines, kubeadmConfigs)
+		upToDate, upToDateResult, err := cachedUpToDate(ctx, client, upToDateCache, cluster, m, kcp, &reconciliationTime, clusterCAHash, infraMachines, kubeadmConfigs)
 		if err != nil {
 			return nil, err
 		}
@@ -153,6 +161,49 @@ func NewControlPlane(ctx context.Context, managementCluster ManagementCluster, c
 	}, nil
 }
 
+// cachedUpToDate returns the result of UpToDate for a Machine, using upToDateCache if possible.
+// Note: the cache is not used when rolloutBefore or rolloutAfter are set, because in this case the result of UpToDate
+// also depends on the reconciliation time.
+// Note: the name of the desired EncryptionConfiguration Secret is part of the fingerprint, because it depends on
+// status.encryptionAtRest, which changes during key rotations without increasing the generation of the KubeadmControlPlane.
+func cachedUpToDate(
+	ctx context.Context,
+	c client.Client,
+	upToDateCache UpToDateCache,
+	cluster *clusterv1.Cluster,
+	machine *clusterv1.Machine,
+	kcp *controlplanev1.KubeadmControlPlane,
+	reconciliationTime *metav1.Time,
+	clusterCAHash string,
+	infraMachines map[string]*unstructured.Unstructured,
+	kubeadmConfigs map[string]*bootstrapv1.KubeadmConfig,
+) (bool, *UpToDateResult, error) {
+	if upToDateCache == nil || kcp.Spec.Rollout.Before.CertificatesExpiryDays != 0 || !kcp.Spec.Rollout.After.IsZero() {
+		return UpToDate(ctx, c, cluster, machine, kcp, reconciliationTime, clusterCAHash, infraMachines, kubeadmConfigs)
+	}
+
+	key := client.ObjectKeyFromObject(machine).String()
+	fingerprint := fastpath.Fingerprint(kcp, cluster, machine, infraMachines[machine.Name], kubeadmConfigs[machine.Name]) + clusterCAHash
+	if len(kcp.Spec.EncryptionAtRest.Keys) > 0 {
+		encryptionConfigurationSecretName, err := desiredstate.EncryptionConfigurationSecretName(kcp)
+		if err != nil {
+			return false, nil, err
+		}
+		fingerprint += encryptionConfigurationSecretName
+	}
+	if res, ok := upToDateCache.Get(key, fingerprint); ok {
+		return len(res.LogMessages) == 0 && len(res.ConditionMessages) == 0, &res, nil
+	}
+
+	upToDate, res, err := UpToDate(ctx, c, cluster, machine, kcp, reconciliationTime, clusterCAHash, infraMachines, kubeadmConfigs)
+	if err != nil {
+		upToDateCache.Invalidate(key)
+		return false, nil, err
+	}
+	upToDateCache.Add(key, fingerprint, *res)
+	return upToDate, res, nil
+}
+
 // FailureDomains returns a slice of failure domain objects synced from the infrastructure provider into Cluster.Status.
 func (c *ControlPlane) FailureDomains() []clusterv1.FailureDomain {
 	if c.Cluster.Status.FailureDomains == nil {
@@ -19,16 +19,20 @@ package internal
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/desiredstate"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/etcd"
+	"sigs.k8s.io/cluster-api/internal/util/fastpath"
 	"sigs.k8s.io/cluster-api/util/collections"
 )
 
@@ -118,7 +122,7 @@ func TestControlPlane(t *testing.T) {
 					InfrastructureRef: clusterv1.ContractVersionedObjectReference{Kind: "GenericInfrastructureMachine", APIGroup: clusterv1.GroupVersionInfrastructure.Group, Name: "m5"},
 				}},
 		}
-		controlPlane, err := NewControlPlane(ctx, nil, env.GetClient(), cluster, kcp, machines)
+		controlPlane, err := NewControlPlane(ctx, nil, env.GetClient(), cluster, kcp, machines, "", nil)
 		g.Expect(err).NotTo(HaveOccurred())
 
 		g.Expect(controlPlane.Machines).To(HaveLen(5))
@@ -147,6 +151,117 @@ func TestControlPlane(t *testing.T) {
 	})
 }
 
+func TestCachedUpToDate(t *testing.T) {
+	g := NewWithT(t)
+
+	reconciliationTime := metav1.Now()
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "cluster", Generation: 1}}
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "kcp", Generation: 1},
+		Spec:       controlplanev1.KubeadmControlPlaneSpec{Version: "v1.31.0"},
+	}
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine", Generation: 1},
+		Spec:       clusterv1.MachineSpec{Version: "v1.30.0"},
+	}
+	upToDateCache := fastpath.NewCache[UpToDateResult]("test")
+
+	upToDate, res, err := cachedUpToDate(ctx, nil, upToDateCache, cluster, machine, kcp, &reconciliationTime, "", nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(upToDate).To(BeFalse())
+	g.Expect(res.RolloutReasons).To(ConsistOf("spec.version"))
+
+	// Status changes do not invalidate the cached result.
+	kcp.Status.Version = "v1.30.0"
+	fingerprint := fastpath.Fingerprint(kcp, cluster, machine, nil, nil)
+	_, ok := upToDateCache.Get("default/machine", fingerprint)
+	g.Expect(ok).To(BeTrue())
+
+	// Spec changes invalidate the cached result.
+	kcp.Spec.Version = "v1.30.0"
+	kcp.Generation++
+	upToDate, res, err = cachedUpToDate(ctx, nil, upToDateCache, cluster, machine, kcp, &reconciliationTime, "", nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(upToDate).To(BeTrue())
+	g.Expect(res.RolloutReasons).To(BeEmpty())
+
+	// The cache is not used if rolloutAfter is set.
+	kcp.Spec.Rollout.After = metav1.Time{Time: reconciliationTime.Add(-time.Hour)}
+	kcp.Generation++
+	upToDate, _, err = cachedUpToDate(ctx, nil, upToDateCache, cluster, machine, kcp, &reconciliationTime, "", nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(upToDate).To(BeFalse())
+	_, ok = upToDateCache.Get("default/machine", fastpath.Fingerprint(kcp, cluster, machine, nil, nil))
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestCachedUpToDateEncryptionKeyRotation(t *testing.T) {
+	g := NewWithT(t)
+
+	reconciliationTime := metav1.Now()
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "cluster", Generation: 1}}
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "kcp", Generation: 1},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.31.0",
+			EncryptionAtRest: controlplanev1.KubeadmControlPlaneEncryptionAtRestSpec{
+				Provider: controlplanev1.KubeadmControlPlaneEncryptionProviderAESCBC,
+				Keys: []controlplanev1.KubeadmControlPlaneEncryptionKey{
+					{Name: "key2", Secret: controlplanev1.KubeadmControlPlaneEncryptionKeySecretReference{Name: "keys", Key: "key2"}},
+					{Name: "key1", Secret: controlplanev1.KubeadmControlPlaneEncryptionKeySecretReference{Name: "keys", Key: "key1"}},
+				},
+			},
+		},
+		Status: controlplanev1.KubeadmControlPlaneStatus{
+			EncryptionAtRest: &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key1"},
+		},
+	}
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine", Generation: 1},
+		Spec: clusterv1.MachineSpec{
+			Version: "v1.31.0",
+			Bootstrap: clusterv1.Bootstrap{
+				ConfigRef: clusterv1.ContractVersionedObjectReference{Kind: "KubeadmConfig", APIGroup: bootstrapv1.GroupVersion.Group, Name: "machine"},
+			},
+		},
+	}
+	kubeadmConfigFor := func(kcp *controlplanev1.KubeadmControlPlane) map[string]*bootstrapv1.KubeadmConfig {
+		kubeadmConfig, err := desiredstate.ComputeDesiredKubeadmConfig(kcp, cluster, true, machine.Name, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		return map[string]*bootstrapv1.KubeadmConfig{machine.Name: kubeadmConfig}
+	}
+	upToDateCache := fastpath.NewCache[UpToDateResult]("test")
+
+	// The Machine uses the EncryptionConfiguration which can decrypt resources with the new key.
+	kubeadmConfigs := kubeadmConfigFor(kcp)
+	upToDate, _, err := cachedUpToDate(ctx, nil, upToDateCache, cluster, machine, kcp, &reconciliationTime, "", nil, kubeadmConfigs)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(upToDate).To(BeTrue())
+
+	// Setting the pending write key changes only the status of the KubeadmControlPlane, but the Machine must be rolled out
+	// to encrypt resources with the new key.
+	kcp.Status.EncryptionAtRest.PendingWriteKeyName = "key2"
+	upToDate, res, err := cachedUpToDate(ctx, nil, upToDateCache, cluster, machine, kcp, &reconciliationTime, "", nil, kubeadmConfigs)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(upToDate).To(BeFalse())
+	g.Expect(res.LogMessages).ToNot(BeEmpty())
+
+	// Once the Machine is replaced by a Machine encrypting resources with the new key, the new Machine is up-to-date.
+	machine = machine.DeepCopy()
+	machine.Name = "machine-new"
+	machine.Spec.Bootstrap.ConfigRef.Name = "machine-new"
+	kubeadmConfigs = kubeadmConfigFor(kcp)
+	upToDate, _, err = cachedUpToDate(ctx, nil, upToDateCache, cluster, machine, kcp, &reconciliationTime, "", nil, kubeadmConfigs)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(upToDate).To(BeTrue())
+
+	// Completing the rotation does not change the EncryptionConfiguration, so the Machine is still up-to-date.
+	kcp.Status.EncryptionAtRest = &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key2"}
+	upToDate, _, err = cachedUpToDate(ctx, nil, upToDateCache, cluster, machine, kcp, &reconciliationTime, "", nil, kubeadmConfigs)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(upToDate).To(BeTrue())
+}
+
 func TestHasMachinesToBeRemediated(t *testing.T) {
 	// healthy machine (without MachineHealthCheckSucceded condition)
 	healthyMachineNotProvisioned := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "healthyMachine1"}}
@@ -30,4 +30,10 @@ const (
 	// dependentCertRequeueAfter is how long to wait before checking again to see if
 	// dependent certificates have been created.
 	dependentCertRequeueAfter = 30 * time.Second
+
+	// maxRolloutReasonsMachines is the max number of Machines surfaced in KCP's status.rolloutReasons.
+	maxRolloutReasonsMachines = 32
+
+	// maxRolloutReasonsPerMachine is the max number of reasons surfaced for a Machine in KCP's status.rolloutReasons.
+	maxRolloutReasonsPerMachine = 32
 )
@@ -49,7 +49,9 @@ import (
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
 	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
 	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/util/fastpath"
 	"sigs.k8s.io/cluster-api/internal/util/inplace"
+	"sigs.k8s.io/cluster-api/internal/util/resync"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/cache"
@@ -72,7 +74,7 @@ const (
 )
 
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
-// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io;bootstrap.cluster.x-k8s.io;controlplane.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch;create;update;patch;delete
@@ -96,11 +98,16 @@ type KubeadmControlPlaneReconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// ResyncPeriod is the period after which all KubeadmControlPlanes are requeued, in addition to the resyncs of the cache.
+	// If 0, KubeadmControlPlanes are only requeued when the cache is resynced.
+	ResyncPeriod time.Duration
+
 	RemoteConditionsGracePeriod time.Duration
 
 	managementCluster         internal.ManagementCluster
 	managementClusterUncached internal.ManagementCluster
 	ssaCache                  ssa.Cache
+	upToDateCache             internal.UpToDateCache
 
 	// Only used for testing.
 	overrideTryInPlaceUpdateFunc       func(ctx context.Context, controlPlane *internal.ControlPlane, machineToInPlaceUpdate *clusterv1.Machine, machineUpToDateResult internal.UpToDateResult) (bool, ctrl.Result, error)
@@ -127,8 +134,8 @@ func (r *KubeadmControlPlaneReconciler) SetupWithManager(ctx context.Context, mg
 			"EtcdDialTimeout and EtcdCallTimeout must not be 0 and " +
 			"RemoteConditionsGracePeriod must not be < 2m")
 	}
-	if feature.Gates.Enabled(feature.InPlaceUpdates) && r.RuntimeClient == nil {
-		return errors.New("RuntimeClient must not be nil when InPlaceUpdates feature gate is enabled")
+	if (feature.Gates.Enabled(feature.RuntimeSDK) || feature.Gates.Enabled(feature.InPlaceUpdates)) && r.RuntimeClient == nil {
+		return errors.New("RuntimeClient must not be nil when RuntimeSDK or InPlaceUpdates feature gate is enabled")
 	}
 
 	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "kubeadmcontrolplane")
@@ -137,6 +144,7 @@ func (r *KubeadmControlPlaneReconciler) SetupWithManager(ctx context.Context, mg
 		Owns(&clusterv1.Machine{}, builder.WithPredicates(predicates.ResourceIsChanged(mgr.GetScheme(), predicateLog))).
 		WithOptions(options).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		WatchesRawSource(resync.Source(mgr.GetClient(), &controlplanev1.KubeadmControlPlaneList{}, r.ResyncPeriod, r.WatchFilterValue)).
 		Watches(
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(r.ClusterToKubeadmControlPlane),
@@ -147,6 +155,7 @@ func (r *KubeadmControlPlaneReconciler) SetupWithManager(ctx context.Context, mg
 					predicates.Any(mgr.GetScheme(), predicateLog,
 						predicates.ClusterPausedTransitionsOrInfrastructureProvisioned(mgr.GetScheme(), predicateLog),
 						predicates.ClusterTopologyVersionChanged(mgr.GetScheme(), predicateLog),
+						predicates.ClusterDegradedFreezeTransitions(mgr.GetScheme(), predicateLog),
 					),
 				),
 			),
@@ -161,6 +170,7 @@ func (r *KubeadmControlPlaneReconciler) SetupWithManager(ctx context.Context, mg
 	r.controller = c
 	r.recorder = mgr.GetEventRecorderFor("kubeadmcontrolplane-controller")
 	r.ssaCache = ssa.NewCache("kubeadmcontrolplane")
+	r.upToDateCache = fastpath.NewCache[internal.UpToDateResult]("kubeadmcontrolplane")
 
 	if r.managementCluster == nil {
 		r.managementCluster = &internal.Management{
@@ -309,7 +319,7 @@ func (r *KubeadmControlPlaneReconciler) initControlPlaneScope(ctx context.Contex
 
 	// Return early if the cluster is not yet in a state where control plane machines exists
 	if !ptr.Deref(cluster.Status.Initialization.InfrastructureProvisioned, false) || !cluster.Spec.ControlPlaneEndpoint.IsValid() {
-		controlPlane, err := internal.NewControlPlane(ctx, r.managementCluster, r.Client, cluster, kcp, collections.Machines{})
+		controlPlane, err := internal.NewControlPlane(ctx, r.managementCluster, r.Client, cluster, kcp, collections.Machines{}, "", nil)
 		if err != nil {
 			log.Error(err, "Failed to initialize control plane scope")
 			return nil, false, err
@@ -337,7 +347,19 @@ func (r *KubeadmControlPlaneReconciler) initControlPlaneScope(ctx context.Contex
 		return nil, false, err
 	}
 
-	controlPlane, err := internal.NewControlPlane(ctx, r.managementCluster, r.Client, cluster, kcp, ownedMachines)
+	clusterCAHash, err := r.getClusterCAHash(ctx, cluster)
+	if err != nil {
+		log.Error(err, "Failed to compute cluster CA certificates hash")
+		return nil, false, err
+	}
+
+	// Note: The up-to-date cache is not used with in-place updates, because in this case also the desired and
+	// current objects computed when checking if Machines are up-to-date are used.
+	upToDateCache := r.upToDateCache
+	if feature.Gates.Enabled(feature.InPlaceUpdates) {
+		upToDateCache = nil
+	}
+	controlPlane, err := internal.NewControlPlane(ctx, r.managementCluster, r.Client, cluster, kcp, ownedMachines, clusterCAHash, upToDateCache)
 	if err != nil {
 		log.Error(err, "Failed to initialize control plane scope")
 		return nil, false, err
@@ -345,6 +367,19 @@ func (r *KubeadmControlPlaneReconciler) initControlPlaneScope(ctx context.Contex
 	return controlPlane, false, nil
 }
 
+// getClusterCAHash returns the hash of the cluster CA certificates, or an empty string if the cluster CA doesn't exist yet.
+func (r *KubeadmControlPlaneReconciler) getClusterCAHash(ctx context.Context, cluster *clusterv1.Cluster) (string, error) {
+	certificates := secret.Certificates{&secret.Certificate{Purpose: secret.ClusterCA}}
+	if err := certificates.LookupCached(ctx, r.SecretCachingClient, r.Client, util.ObjectKey(cluster)); err != nil {
+		return "", err
+	}
+	clusterCA := certificates.GetByPurpose(secret.ClusterCA)
+	if clusterCA.KeyPair == nil {
+		return "", nil
+	}
+	return clusterCA.BundleHash()
+}
+
 func patchKubeadmControlPlane(ctx context.Context, patchHelper *patch.Helper, kcp *controlplanev1.KubeadmControlPlane, options ...patch.Option) error {
 	// Always update the readyCondition by summarizing the state of other conditions.
 	v1beta1conditions.SetSummary(kcp,
@@ -377,6 +412,8 @@ func patchKubeadmControlPlane(ctx context.Context, patchHelper *patch.Helper, kc
 			controlplanev1.KubeadmControlPlaneInitializedCondition,
 			controlplanev1.KubeadmControlPlaneCertificatesAvailableCondition,
 			controlplanev1.KubeadmControlPlaneEtcdClusterHealthyCondition,
+			controlplanev1.KubeadmControlPlaneEtcdDefragmentedCondition,
+			controlplanev1.KubeadmControlPlaneEtcdSnapshottedCondition,
 			controlplanev1.KubeadmControlPlaneControlPlaneComponentsHealthyCondition,
 			controlplanev1.KubeadmControlPlaneMachinesReadyCondition,
 			controlplanev1.KubeadmControlPlaneMachinesUpToDateCondition,
@@ -516,6 +553,18 @@ func (r *KubeadmControlPlaneReconciler) reconcile(ctx context.Context, controlPl
 		return ctrl.Result{}, nil // Note: Changes to Machines trigger another reconcile.
 	}
 
+	// Promotes etcd learners to voting members once they are in sync with the leader; rollout and scale operations
+	// are blocked until all the learners are promoted to avoid changing the etcd cluster while a member is still syncing.
+	// Note: Learners are promoted after remediation, so a Machine hosting a learner that never catches up can be remediated.
+	if result, err := r.reconcileEtcdLearners(ctx, controlPlane); err != nil || !result.IsZero() {
+		return result, err
+	}
+
+	// Ensures the Secret with the EncryptionConfiguration exists before creating Machines using it.
+	if err := r.reconcileEncryptionConfiguration(ctx, controlPlane); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Control plane machines rollout due to configuration changes (e.g. upgrades) takes precedence over other operations.
 	machinesNeedingRollout, machinesUpToDateResults := controlPlane.MachinesNeedingRollout()
 	switch {
@@ -527,6 +576,13 @@ func (r *KubeadmControlPlaneReconciler) reconcile(ctx context.Context, controlPl
 			allMessages = append(allMessages, fmt.Sprintf("Machine %s needs rollout: %s", name, strings.Join(machinesUpToDateResults[name].LogMessages, ", ")))
 		}
 		log.Info(fmt.Sprintf("Machines need rollout: %s", strings.Join(machinesNeedingRolloutNames, ",")), "reason", strings.Join(allMessages, ", "))
+
+		// Do not roll out while automated disruptive actions on the Cluster are frozen.
+		if conditions.IsTrue(controlPlane.Cluster, clusterv1.ClusterDegradedFreezeCondition) {
+			log.Info(fmt.Sprintf("Skipping rollout, Cluster has %s condition set to True", clusterv1.ClusterDegradedFreezeCondition))
+			return ctrl.Result{}, nil
+		}
+
 		v1beta1conditions.MarkFalse(controlPlane.KCP, controlplanev1.MachinesSpecUpToDateV1Beta1Condition, controlplanev1.RollingUpdateInProgressV1Beta1Reason, clusterv1.ConditionSeverityWarning, "Rolling %d replicas with outdated spec (%d replicas up to date)", len(machinesNeedingRollout), len(controlPlane.Machines)-len(machinesNeedingRollout))
 		return r.updateControlPlane(ctx, controlPlane, machinesNeedingRollout, machinesUpToDateResults)
 	default:
@@ -538,6 +594,11 @@ func (r *KubeadmControlPlaneReconciler) reconcile(ctx context.Context, controlPl
 		}
 	}
 
+	// Completes the steps of encryption key rotations which require all the control plane Machines to be up-to-date.
+	if result, err := r.reconcileEncryptionKeyRotation(ctx, controlPlane); err != nil || !result.IsZero() {
+		return result, err
+	}
+
 	// If we've made it this far, we can assume that all ownedMachines are up to date
 	numMachines := len(controlPlane.Machines)
 	desiredReplicas := int(*controlPlane.KCP.Spec.Replicas)
@@ -591,7 +652,10 @@ func (r *KubeadmControlPlaneReconciler) reconcile(ctx context.Context, controlPl
 	if err := r.reconcileCertificateExpiries(ctx, controlPlane); err != nil {
 		return ctrl.Result{}, err
 	}
-	return ctrl.Result{}, nil
+
+	// Runs the periodic etcd defragmentation and snapshots; this happens only when the control plane is stable,
+	// i.e. all the control plane Machines are up-to-date and no scale operation is in progress.
+	return r.reconcileEtcdMaintenance(ctx, controlPlane)
 }
 
 // reconcileClusterCertificates ensures that all the cluster certificates exists and
@@ -1210,6 +1274,51 @@ func (r *KubeadmControlPlaneReconciler) reconcileEtcdMembers(ctx context.Context
 	return nil
 }
 
+// reconcileEtcdLearners promotes etcd learner members to voting members when KCP is configured
+// to join new etcd members as learners.
+func (r *KubeadmControlPlaneReconciler) reconcileEtcdLearners(ctx context.Context, controlPlane *internal.ControlPlane) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// If etcd is not managed by KCP or new members are not joining as learners this is a no-op.
+	if !controlPlane.IsEtcdManaged() || !ptr.Deref(controlPlane.KCP.Spec.Etcd.JoinAsLearner, false) {
+		return ctrl.Result{}, nil
+	}
+
+	// No op if there are no learners in the list of etcd members read while computing the etcd conditions.
+	hasLearners := false
+	for _, member := range controlPlane.EtcdMembers {
+		if member.IsLearner {
+			hasLearners = true
+			break
+		}
+	}
+	if !hasLearners {
+		return ctrl.Result{}, nil
+	}
+
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		// Failing at connecting to the workload cluster can mean workload cluster is unhealthy for a variety of reasons such as etcd quorum loss.
+		return ctrl.Result{}, errors.Wrap(err, "cannot get remote client to workload cluster")
+	}
+
+	promotedMembers, notReadyMembers, err := workloadCluster.PromoteEtcdLearners(ctx)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed attempt to promote etcd learners")
+	}
+
+	if len(promotedMembers) > 0 {
+		log.Info("Etcd learners promoted to voting members", "members", promotedMembers)
+	}
+
+	if len(notReadyMembers) > 0 {
+		log.Info("Waiting for etcd learners to be in sync with the leader before promoting them", "members", notReadyMembers)
+		return ctrl.Result{RequeueAfter: preflightFailedRequeueAfter}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
 func (r *KubeadmControlPlaneReconciler) reconcilePreTerminateHook(ctx context.Context, controlPlane *internal.ControlPlane) (ctrl.Result, error) {
 	if !controlPlane.HasDeletingMachine() {
 		return ctrl.Result{}, nil
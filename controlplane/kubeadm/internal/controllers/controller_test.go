@@ -55,6 +55,7 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/desiredstate"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/etcd"
 	controlplanev1webhooks "sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/webhooks"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
@@ -1163,7 +1164,7 @@ func TestReconcileCertificateExpiries(t *testing.T) {
 		managementCluster:   managementCluster,
 	}
 
-	controlPlane, err := internal.NewControlPlane(ctx, managementCluster, fakeClient, cluster, kcp, ownedMachines)
+	controlPlane, err := internal.NewControlPlane(ctx, managementCluster, fakeClient, cluster, kcp, ownedMachines, "", nil)
 	g.Expect(err).ToNot(HaveOccurred())
 
 	err = r.reconcileCertificateExpiries(ctx, controlPlane)
@@ -2249,7 +2250,7 @@ func TestKubeadmControlPlaneReconciler_reconcileControlPlaneAndMachinesCondition
 			controlPlane: func() *internal.ControlPlane {
 				controlPlane, err := internal.NewControlPlane(ctx, nil, env.GetClient(), defaultCluster, defaultKCP.DeepCopy(), collections.FromMachines(
 					defaultMachine1.DeepCopy(),
-				))
+				), "", nil)
 				if err != nil {
 					panic(err)
 				}
@@ -2333,7 +2334,7 @@ func TestKubeadmControlPlaneReconciler_reconcileControlPlaneAndMachinesCondition
 						}
 						return m
 					}(),
-				))
+				), "", nil)
 				if err != nil {
 					panic(err)
 				}
@@ -2412,7 +2413,7 @@ func TestKubeadmControlPlaneReconciler_reconcileControlPlaneAndMachinesCondition
 			controlPlane: func() *internal.ControlPlane {
 				controlPlane, err := internal.NewControlPlane(ctx, nil, env.GetClient(), defaultCluster, defaultKCP.DeepCopy(), collections.FromMachines(
 					defaultMachine1NotUpToDate.DeepCopy(),
-				))
+				), "", nil)
 				if err != nil {
 					panic(err)
 				}
@@ -2574,7 +2575,7 @@ func TestKubeadmControlPlaneReconciler_reconcileControlPlaneAndMachinesCondition
 			controlPlane: func() *internal.ControlPlane {
 				controlPlane, err := internal.NewControlPlane(ctx, nil, env.GetClient(), defaultCluster, defaultKCP.DeepCopy(), collections.FromMachines(
 					defaultMachine1.DeepCopy(),
-				))
+				), "", nil)
 				if err != nil {
 					panic(err)
 				}
@@ -3409,6 +3410,80 @@ func TestKubeadmControlPlaneReconciler_reconcilePreTerminateHook(t *testing.T) {
 	}
 }
 
+func TestKubeadmControlPlaneReconciler_reconcileEtcdLearners(t *testing.T) {
+	kcpJoiningAsLearner := &controlplanev1.KubeadmControlPlane{
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Etcd: controlplanev1.KubeadmControlPlaneEtcdSpec{
+				JoinAsLearner: ptr.To(true),
+			},
+		},
+	}
+	learners := []*etcd.Member{
+		{Name: "machine-1", ID: 1},
+		{Name: "machine-2", ID: 2, IsLearner: true},
+	}
+
+	tests := []struct {
+		name                          string
+		kcp                           *controlplanev1.KubeadmControlPlane
+		etcdMembers                   []*etcd.Member
+		notReadyLearners              []string
+		wantResult                    ctrl.Result
+		wantPromoteEtcdLearnersCalled int
+	}{
+		{
+			name:        "Do nothing if new etcd members are not joining as learners",
+			kcp:         &controlplanev1.KubeadmControlPlane{},
+			etcdMembers: learners,
+			wantResult:  ctrl.Result{},
+		},
+		{
+			name:        "Do nothing if there are no learners",
+			kcp:         kcpJoiningAsLearner,
+			etcdMembers: []*etcd.Member{{Name: "machine-1", ID: 1}},
+			wantResult:  ctrl.Result{},
+		},
+		{
+			name:                          "Promote learners",
+			kcp:                           kcpJoiningAsLearner,
+			etcdMembers:                   learners,
+			wantResult:                    ctrl.Result{},
+			wantPromoteEtcdLearnersCalled: 1,
+		},
+		{
+			name:                          "Requeue if learners are not in sync with the leader",
+			kcp:                           kcpJoiningAsLearner,
+			etcdMembers:                   learners,
+			notReadyLearners:              []string{"machine-2"},
+			wantResult:                    ctrl.Result{RequeueAfter: preflightFailedRequeueAfter},
+			wantPromoteEtcdLearnersCalled: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := &KubeadmControlPlaneReconciler{}
+
+			controlPlane := &internal.ControlPlane{
+				KCP:         tt.kcp,
+				EtcdMembers: tt.etcdMembers,
+			}
+			workloadCluster := fakeWorkloadCluster{
+				promoteEtcdLearnersNotReady: tt.notReadyLearners,
+			}
+			controlPlane.InjectTestManagementCluster(&fakeManagementCluster{
+				Workload: &workloadCluster,
+			})
+
+			res, err := r.reconcileEtcdLearners(ctx, controlPlane)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res).To(Equal(tt.wantResult))
+			g.Expect(workloadCluster.promoteEtcdLearnersCalled).To(Equal(tt.wantPromoteEtcdLearnersCalled))
+		})
+	}
+}
+
 func TestKubeadmControlPlaneReconciler_machineHasOtherPreTerminateHooks(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,233 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/desiredstate"
+)
+
+// reconcileEncryptionConfiguration ensures the Secret with the EncryptionConfiguration used by the control plane Machines
+// exists, and deletes the Secrets with EncryptionConfigurations not used anymore by any control plane Machine.
+// NOTE: The Secret must exist before creating control plane Machines, given that the bootstrap provider reads
+// the EncryptionConfiguration from it.
+func (r *KubeadmControlPlaneReconciler) reconcileEncryptionConfiguration(ctx context.Context, controlPlane *internal.ControlPlane) error {
+	log := ctrl.LoggerFrom(ctx)
+	kcp := controlPlane.KCP
+
+	desiredSecretName := ""
+	if len(kcp.Spec.EncryptionAtRest.Keys) > 0 {
+		var err error
+		desiredSecretName, err = desiredstate.EncryptionConfigurationSecretName(kcp)
+		if err != nil {
+			return err
+		}
+
+		if err := r.ensureEncryptionConfigurationSecret(ctx, controlPlane, desiredSecretName); err != nil {
+			return err
+		}
+	}
+
+	// Delete EncryptionConfigurations which are not used anymore, so keys removed from the KubeadmControlPlane
+	// do not linger in the management cluster.
+	secretsInUse := sets.Set[string]{}
+	for _, kubeadmConfig := range controlPlane.KubeadmConfigs {
+		for _, file := range kubeadmConfig.Spec.Files {
+			if file.ContentFrom.Secret.Key == desiredstate.EncryptionConfigurationSecretKey {
+				secretsInUse.Insert(file.ContentFrom.Secret.Name)
+			}
+		}
+	}
+	secrets := &corev1.SecretList{}
+	if err := r.Client.List(ctx, secrets, client.InNamespace(kcp.Namespace), client.MatchingLabels{controlplanev1.EncryptionConfigurationLabel: kcp.Name}); err != nil {
+		return errors.Wrap(err, "failed to list EncryptionConfiguration Secrets")
+	}
+	for i := range secrets.Items {
+		s := &secrets.Items[i]
+		if s.Name == desiredSecretName || secretsInUse.Has(s.Name) {
+			continue
+		}
+		log.Info("Deleting EncryptionConfiguration Secret not used anymore", "Secret", klog.KObj(s))
+		if err := r.Client.Delete(ctx, s); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete EncryptionConfiguration Secret %s", klog.KObj(s))
+		}
+	}
+	return nil
+}
+
+// ensureEncryptionConfigurationSecret creates the Secret with the EncryptionConfiguration, if it does not exist.
+// NOTE: The name of the Secret changes when the EncryptionConfiguration changes, so existing Secrets are never updated.
+func (r *KubeadmControlPlaneReconciler) ensureEncryptionConfigurationSecret(ctx context.Context, controlPlane *internal.ControlPlane, name string) error {
+	kcp := controlPlane.KCP
+
+	existing := &corev1.Secret{}
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: kcp.Namespace, Name: name}, existing)
+	switch {
+	case err == nil:
+		return nil
+	case !apierrors.IsNotFound(err):
+		return errors.Wrapf(err, "failed to get EncryptionConfiguration Secret %s", name)
+	}
+
+	keyData := map[string][]byte{}
+	for _, key := range kcp.Spec.EncryptionAtRest.Keys {
+		keySecret := &corev1.Secret{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: kcp.Namespace, Name: key.Secret.Name}, keySecret); err != nil {
+			return errors.Wrapf(err, "failed to get Secret %s for encryption key %q", key.Secret.Name, key.Name)
+		}
+		data, ok := keySecret.Data[key.Secret.Key]
+		if !ok {
+			return errors.Errorf("failed to get encryption key %q: Secret %s does not have key %q", key.Name, key.Secret.Name, key.Secret.Key)
+		}
+		keyData[key.Name] = data
+	}
+
+	configuration, err := desiredstate.ComputeEncryptionConfiguration(kcp, keyData)
+	if err != nil {
+		return err
+	}
+
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: kcp.Namespace,
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel:                  controlPlane.Cluster.Name,
+				controlplanev1.EncryptionConfigurationLabel: kcp.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(kcp, controlplanev1.GroupVersion.WithKind(kubeadmControlPlaneKind)),
+			},
+		},
+		Type: clusterv1.ClusterSecretType,
+		Data: map[string][]byte{
+			desiredstate.EncryptionConfigurationSecretKey: configuration,
+		},
+	}
+	if err := r.Client.Create(ctx, s); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "failed to create EncryptionConfiguration Secret %s", name)
+	}
+	return nil
+}
+
+// reconcileEncryptionKeyRotation completes the steps of a key rotation once all the control plane Machines use the current
+// EncryptionConfiguration:
+//   - when all the API servers can decrypt resources with the new key, it sets status.encryptionAtRest.pendingWriteKeyName,
+//     thus triggering a rollout of control plane Machines encrypting resources with the new key.
+//   - when all the API servers encrypt resources with the new key, it rewrites all the encrypted resources in the workload
+//     cluster and then it sets status.encryptionAtRest.writeKeyName.
+//
+// The same flow applies when encryption at rest is enabled, with the exception of the first step.
+func (r *KubeadmControlPlaneReconciler) reconcileEncryptionKeyRotation(ctx context.Context, controlPlane *internal.ControlPlane) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	kcp := controlPlane.KCP
+
+	keys := kcp.Spec.EncryptionAtRest.Keys
+	if len(keys) == 0 || !ptr.Deref(kcp.Status.Initialization.ControlPlaneInitialized, false) || len(controlPlane.Machines) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	status := kcp.Status.EncryptionAtRest
+	if status == nil {
+		status = &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{}
+	}
+	newWriteKeyName := keys[0].Name
+	if status.WriteKeyName == newWriteKeyName {
+		return ctrl.Result{}, nil
+	}
+
+	// Wait for deleting Machines to go away, given that they might still run API servers with a previous EncryptionConfiguration.
+	if controlPlane.HasDeletingMachine() {
+		log.Info("Waiting for control plane Machines to be deleted before continuing the encryption key rotation", "key", newWriteKeyName)
+		return ctrl.Result{RequeueAfter: deleteRequeueAfter}, nil
+	}
+
+	// Do not rely only on Machines being up-to-date; before moving to the next step of the rotation, all the
+	// KubeadmConfigs must actually reference the current EncryptionConfiguration.
+	secretName, err := desiredstate.EncryptionConfigurationSecretName(kcp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if machines := machinesNotUsingEncryptionConfiguration(controlPlane, secretName); len(machines) > 0 {
+		log.Info("Waiting for control plane Machines to use the current EncryptionConfiguration before continuing the encryption key rotation", "key", newWriteKeyName, "Machines", strings.Join(machines, ","))
+		return ctrl.Result{RequeueAfter: preflightFailedRequeueAfter}, nil
+	}
+
+	// If resources are encrypted with a key which is still in use, all the API servers must be able to decrypt resources
+	// with the new key before starting to encrypt with it.
+	writeKeyInUse := false
+	for _, key := range keys {
+		if key.Name == status.WriteKeyName {
+			writeKeyInUse = true
+			break
+		}
+	}
+	if writeKeyInUse && status.PendingWriteKeyName != newWriteKeyName {
+		log.Info("All control plane Machines can decrypt resources with the new encryption key, rolling out control plane Machines encrypting resources with it", "key", newWriteKeyName)
+		status.PendingWriteKeyName = newWriteKeyName
+		kcp.Status.EncryptionAtRest = status
+		return ctrl.Result{RequeueAfter: preflightFailedRequeueAfter}, nil
+	}
+
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to rewrite encrypted resources: cannot get remote client to workload cluster")
+	}
+	resources := desiredstate.EncryptedResources(kcp)
+	if err := workloadCluster.RewriteEncryptedResources(ctx, resources); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to rewrite encrypted resources")
+	}
+	log.Info("Rewrote encrypted resources with the new encryption key", "key", newWriteKeyName, "resources", resources)
+
+	kcp.Status.EncryptionAtRest = &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{
+		WriteKeyName: newWriteKeyName,
+	}
+	return ctrl.Result{}, nil
+}
+
+// machinesNotUsingEncryptionConfiguration returns the names of the control plane Machines whose KubeadmConfig does not
+// reference the EncryptionConfiguration Secret with the given name.
+func machinesNotUsingEncryptionConfiguration(controlPlane *internal.ControlPlane, secretName string) []string {
+	names := []string{}
+	for _, m := range controlPlane.Machines {
+		kubeadmConfig, ok := controlPlane.KubeadmConfigs[m.Name]
+		if !ok || !slices.ContainsFunc(kubeadmConfig.Spec.Files, func(file bootstrapv1.File) bool {
+			return file.ContentFrom.Secret.Name == secretName && file.ContentFrom.Secret.Key == desiredstate.EncryptionConfigurationSecretKey
+		}) {
+			names = append(names, m.Name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/desiredstate"
+	"sigs.k8s.io/cluster-api/util/collections"
+)
+
+func TestKubeadmControlPlaneReconciler_reconcileEncryptionConfiguration(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: metav1.NamespaceDefault}}
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "kcp", Namespace: metav1.NamespaceDefault},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			EncryptionAtRest: controlplanev1.KubeadmControlPlaneEncryptionAtRestSpec{
+				Provider: controlplanev1.KubeadmControlPlaneEncryptionProviderAESCBC,
+				Keys: []controlplanev1.KubeadmControlPlaneEncryptionKey{
+					{Name: "key1", Secret: controlplanev1.KubeadmControlPlaneEncryptionKeySecretReference{Name: "keys", Key: "key1"}},
+				},
+			},
+		},
+	}
+	keys := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "keys", Namespace: metav1.NamespaceDefault},
+		Data: map[string][]byte{
+			"key1": bytes.Repeat([]byte("a"), 32),
+		},
+	}
+	encryptionConfigurationSecret := func(name string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+				Labels:    map[string]string{controlplanev1.EncryptionConfigurationLabel: kcp.Name},
+			},
+		}
+	}
+	// inUse is referenced by the KubeadmConfig of a Machine, notInUse is not referenced anymore.
+	inUse := encryptionConfigurationSecret("kcp-encryption-in-use")
+	notInUse := encryptionConfigurationSecret("kcp-encryption-not-in-use")
+
+	fakeClient := newFakeClient(keys, inUse, notInUse)
+	r := &KubeadmControlPlaneReconciler{Client: fakeClient}
+	controlPlane := &internal.ControlPlane{
+		Cluster: cluster,
+		KCP:     kcp,
+		KubeadmConfigs: map[string]*bootstrapv1.KubeadmConfig{
+			"machine": {
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Files: []bootstrapv1.File{
+						{
+							Path: "/etc/kubernetes/encryption/encryption-configuration.yaml",
+							ContentFrom: bootstrapv1.FileSource{
+								Secret: bootstrapv1.SecretFileSource{Name: inUse.Name, Key: desiredstate.EncryptionConfigurationSecretKey},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	g.Expect(r.reconcileEncryptionConfiguration(ctx, controlPlane)).To(Succeed())
+
+	// The desired EncryptionConfiguration Secret is created.
+	secretName, err := desiredstate.EncryptionConfigurationSecretName(kcp)
+	g.Expect(err).ToNot(HaveOccurred())
+	s := &corev1.Secret{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: secretName}, s)).To(Succeed())
+	g.Expect(s.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, cluster.Name))
+	g.Expect(s.Labels).To(HaveKeyWithValue(controlplanev1.EncryptionConfigurationLabel, kcp.Name))
+	g.Expect(s.Type).To(Equal(clusterv1.ClusterSecretType))
+	g.Expect(s.OwnerReferences).To(HaveLen(1))
+	g.Expect(s.OwnerReferences[0].Name).To(Equal(kcp.Name))
+	g.Expect(string(s.Data[desiredstate.EncryptionConfigurationSecretKey])).To(ContainSubstring("name: key1"))
+
+	// EncryptionConfiguration Secrets not in use anymore are deleted.
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(inUse), &corev1.Secret{})).To(Succeed())
+	err = fakeClient.Get(ctx, client.ObjectKeyFromObject(notInUse), &corev1.Secret{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	// Fails if a key cannot be read.
+	kcp.Spec.EncryptionAtRest.Keys = append(kcp.Spec.EncryptionAtRest.Keys, controlplanev1.KubeadmControlPlaneEncryptionKey{
+		Name: "key2", Secret: controlplanev1.KubeadmControlPlaneEncryptionKeySecretReference{Name: "keys", Key: "key2"},
+	})
+	g.Expect(r.reconcileEncryptionConfiguration(ctx, controlPlane)).ToNot(Succeed())
+}
+
+func TestKubeadmControlPlaneReconciler_reconcileEncryptionKeyRotation(t *testing.T) {
+	keys := []controlplanev1.KubeadmControlPlaneEncryptionKey{
+		{Name: "key2", Secret: controlplanev1.KubeadmControlPlaneEncryptionKeySecretReference{Name: "keys", Key: "key2"}},
+		{Name: "key1", Secret: controlplanev1.KubeadmControlPlaneEncryptionKeySecretReference{Name: "keys", Key: "key1"}},
+	}
+	machines := collections.FromMachines(&clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine"}})
+	deletingMachines := collections.FromMachines(&clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{
+		Name:              "machine",
+		DeletionTimestamp: ptr.To(metav1.Now()),
+		Finalizers:        []string{"test"},
+	}})
+
+	tests := []struct {
+		name                                string
+		keys                                []controlplanev1.KubeadmControlPlaneEncryptionKey
+		status                              *controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus
+		machines                            collections.Machines
+		outdatedKubeadmConfigs              bool
+		wantResult                          ctrl.Result
+		wantStatus                          *controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus
+		wantRewriteEncryptedResourcesCalled int
+	}{
+		{
+			name:     "Do nothing if encryption at rest is not configured",
+			machines: machines,
+		},
+		{
+			name:       "Do nothing if resources are already encrypted with the first key",
+			keys:       keys,
+			status:     &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key2"},
+			machines:   machines,
+			wantStatus: &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key2"},
+		},
+		{
+			name:                                "Rewrite resources when encryption at rest is enabled",
+			keys:                                keys,
+			machines:                            machines,
+			wantStatus:                          &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key2"},
+			wantRewriteEncryptedResourcesCalled: 1,
+		},
+		{
+			name:       "Wait for deleting Machines",
+			keys:       keys,
+			status:     &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key1"},
+			machines:   deletingMachines,
+			wantResult: ctrl.Result{RequeueAfter: deleteRequeueAfter},
+			wantStatus: &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key1"},
+		},
+		{
+			name:       "Set the pending write key once all the control plane Machines can decrypt with the new key",
+			keys:       keys,
+			status:     &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key1"},
+			machines:   machines,
+			wantResult: ctrl.Result{RequeueAfter: preflightFailedRequeueAfter},
+			wantStatus: &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key1", PendingWriteKeyName: "key2"},
+		},
+		{
+			name:                   "Wait for control plane Machines to use the current EncryptionConfiguration",
+			keys:                   keys,
+			status:                 &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key1", PendingWriteKeyName: "key2"},
+			machines:               machines,
+			outdatedKubeadmConfigs: true,
+			wantResult:             ctrl.Result{RequeueAfter: preflightFailedRequeueAfter},
+			wantStatus:             &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key1", PendingWriteKeyName: "key2"},
+		},
+		{
+			name:                                "Rewrite resources once all the control plane Machines encrypt with the new key",
+			keys:                                keys,
+			status:                              &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key1", PendingWriteKeyName: "key2"},
+			machines:                            machines,
+			wantStatus:                          &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key2"},
+			wantRewriteEncryptedResourcesCalled: 1,
+		},
+		{
+			name:                                "Rewrite resources if the previous write key has been removed",
+			keys:                                keys[:1],
+			status:                              &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key1"},
+			machines:                            machines,
+			wantStatus:                          &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key2"},
+			wantRewriteEncryptedResourcesCalled: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := &KubeadmControlPlaneReconciler{}
+
+			kcp := &controlplanev1.KubeadmControlPlane{
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					EncryptionAtRest: controlplanev1.KubeadmControlPlaneEncryptionAtRestSpec{
+						Provider: controlplanev1.KubeadmControlPlaneEncryptionProviderAESCBC,
+						Keys:     tt.keys,
+					},
+				},
+				Status: controlplanev1.KubeadmControlPlaneStatus{
+					Initialization:   controlplanev1.KubeadmControlPlaneInitializationStatus{ControlPlaneInitialized: ptr.To(true)},
+					EncryptionAtRest: tt.status,
+				},
+			}
+			kubeadmConfigs := map[string]*bootstrapv1.KubeadmConfig{}
+			if len(tt.keys) > 0 {
+				secretName, err := desiredstate.EncryptionConfigurationSecretName(kcp)
+				g.Expect(err).ToNot(HaveOccurred())
+				if tt.outdatedKubeadmConfigs {
+					secretName = "outdated"
+				}
+				for _, m := range tt.machines {
+					kubeadmConfigs[m.Name] = &bootstrapv1.KubeadmConfig{
+						Spec: bootstrapv1.KubeadmConfigSpec{
+							Files: []bootstrapv1.File{{
+								ContentFrom: bootstrapv1.FileSource{
+									Secret: bootstrapv1.SecretFileSource{Name: secretName, Key: desiredstate.EncryptionConfigurationSecretKey},
+								},
+							}},
+						},
+					}
+				}
+			}
+			controlPlane := &internal.ControlPlane{
+				KCP:            kcp,
+				Machines:       tt.machines,
+				KubeadmConfigs: kubeadmConfigs,
+			}
+			workloadCluster := fakeWorkloadCluster{}
+			controlPlane.InjectTestManagementCluster(&fakeManagementCluster{
+				Workload: &workloadCluster,
+			})
+
+			res, err := r.reconcileEncryptionKeyRotation(ctx, controlPlane)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res).To(Equal(tt.wantResult))
+			g.Expect(kcp.Status.EncryptionAtRest).To(Equal(tt.wantStatus))
+			g.Expect(workloadCluster.rewriteEncryptedResourcesCalled).To(Equal(tt.wantRewriteEncryptedResourcesCalled))
+		})
+	}
+}
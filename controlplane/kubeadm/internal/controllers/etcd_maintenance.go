@@ -0,0 +1,394 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// defaultEtcdDefragmentationThresholdPercentage is the default for spec.etcd.defragmentation.thresholdPercentage.
+	defaultEtcdDefragmentationThresholdPercentage = int32(50)
+
+	// defaultEtcdSnapshotRetention is the default for spec.etcd.snapshot.retention.
+	defaultEtcdSnapshotRetention = int32(3)
+
+	// defaultEtcdSnapshotMaxSizeMiB is the default for spec.etcd.snapshot.maxSizeMiB.
+	defaultEtcdSnapshotMaxSizeMiB = int32(64)
+
+	// etcdSnapshotSecretKey is the key of the Secrets storing a chunk of a gzip compressed etcd snapshot.
+	etcdSnapshotSecretKey = "snapshot.db.gz"
+
+	// etcdSnapshotChunkSize is the maximum size of the chunk of an etcd snapshot stored in a single Secret;
+	// it must be below the maximum size of a Secret (1MiB).
+	etcdSnapshotChunkSize = 768 * 1024
+)
+
+// reconcileEtcdMaintenance runs the periodic defragmentation and snapshots of the local (stacked) etcd cluster
+// configured in spec.etcd, and it requeues for the next scheduled run.
+// NOTE: This func is called only when all the control plane Machines are up-to-date and no scale operation is in progress.
+func (r *KubeadmControlPlaneReconciler) reconcileEtcdMaintenance(ctx context.Context, controlPlane *internal.ControlPlane) (ctrl.Result, error) {
+	kcp := controlPlane.KCP
+
+	defragmentationInterval := time.Duration(ptr.Deref(kcp.Spec.Etcd.Defragmentation.IntervalSeconds, 0)) * time.Second
+	snapshotInterval := time.Duration(ptr.Deref(kcp.Spec.Etcd.Snapshot.IntervalSeconds, 0)) * time.Second
+	if !controlPlane.IsEtcdManaged() {
+		defragmentationInterval, snapshotInterval = 0, 0
+	}
+	if defragmentationInterval == 0 && conditions.Has(kcp, controlplanev1.KubeadmControlPlaneEtcdDefragmentedCondition) {
+		conditions.Delete(kcp, controlplanev1.KubeadmControlPlaneEtcdDefragmentedCondition)
+	}
+	if snapshotInterval == 0 && conditions.Has(kcp, controlplanev1.KubeadmControlPlaneEtcdSnapshottedCondition) {
+		conditions.Delete(kcp, controlplanev1.KubeadmControlPlaneEtcdSnapshottedCondition)
+	}
+	if defragmentationInterval == 0 && snapshotInterval == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now().UTC()
+
+	var errs []error
+	var requeueAfter time.Duration
+	if defragmentationInterval > 0 {
+		result, err := r.reconcileEtcdDefragmentation(ctx, controlPlane, defragmentationInterval, now)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		requeueAfter = minRequeueAfter(requeueAfter, result.RequeueAfter)
+	}
+	if snapshotInterval > 0 {
+		result, err := r.reconcileEtcdSnapshot(ctx, controlPlane, snapshotInterval, now)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		requeueAfter = minRequeueAfter(requeueAfter, result.RequeueAfter)
+	}
+	if len(errs) > 0 {
+		return ctrl.Result{}, kerrors.NewAggregate(errs)
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// reconcileEtcdDefragmentation defragments the etcd members exceeding spec.etcd.defragmentation.thresholdPercentage
+// when the defragmentation interval elapsed since status.etcd.lastDefragmentationTime.
+func (r *KubeadmControlPlaneReconciler) reconcileEtcdDefragmentation(ctx context.Context, controlPlane *internal.ControlPlane, interval time.Duration, now time.Time) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	kcp := controlPlane.KCP
+
+	if next := ptr.Deref(kcp.Status.Etcd, controlplanev1.KubeadmControlPlaneEtcdStatus{}).LastDefragmentationTime.Add(interval); now.Before(next) {
+		return ctrl.Result{RequeueAfter: next.Sub(now)}, nil
+	}
+
+	// Defragmentation makes etcd members temporarily unavailable, so it runs only when all the members are healthy
+	// and automated disruptive actions on the Cluster are not frozen.
+	if !conditions.IsTrue(kcp, controlplanev1.KubeadmControlPlaneEtcdClusterHealthyCondition) {
+		log.Info("Waiting for the etcd cluster to be healthy before running defragmentation")
+		return ctrl.Result{RequeueAfter: preflightFailedRequeueAfter}, nil
+	}
+	if conditions.IsTrue(controlPlane.Cluster, clusterv1.ClusterDegradedFreezeCondition) {
+		log.Info(fmt.Sprintf("Skipping etcd defragmentation, Cluster has %s condition set to True", clusterv1.ClusterDegradedFreezeCondition))
+		return ctrl.Result{RequeueAfter: preflightFailedRequeueAfter}, nil
+	}
+
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to defragment etcd members: cannot get remote client to workload cluster")
+	}
+
+	thresholdPercentage := ptr.Deref(kcp.Spec.Etcd.Defragmentation.ThresholdPercentage, defaultEtcdDefragmentationThresholdPercentage)
+	defragmented, err := workloadCluster.DefragmentEtcdMembers(ctx, thresholdPercentage)
+	if err != nil {
+		conditions.Set(kcp, metav1.Condition{
+			Type:    controlplanev1.KubeadmControlPlaneEtcdDefragmentedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  controlplanev1.KubeadmControlPlaneEtcdDefragmentationFailedReason,
+			Message: "Please check controller logs for errors",
+		})
+		return ctrl.Result{}, errors.Wrap(err, "failed to defragment etcd members")
+	}
+	if len(defragmented) > 0 {
+		log.Info("Defragmented etcd members", "members", defragmented)
+	}
+
+	if kcp.Status.Etcd == nil {
+		kcp.Status.Etcd = &controlplanev1.KubeadmControlPlaneEtcdStatus{}
+	}
+	kcp.Status.Etcd.LastDefragmentationTime = metav1.NewTime(now)
+	message := "No etcd members exceeding the defragmentation threshold"
+	if len(defragmented) > 0 {
+		message = fmt.Sprintf("Defragmented etcd members: %s", strings.Join(defragmented, ", "))
+	}
+	conditions.Set(kcp, metav1.Condition{
+		Type:    controlplanev1.KubeadmControlPlaneEtcdDefragmentedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  controlplanev1.KubeadmControlPlaneEtcdDefragmentedReason,
+		Message: fmt.Sprintf("%s at %s", message, now.Format(time.RFC3339)),
+	})
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// reconcileEtcdSnapshot takes a snapshot of the etcd database when the snapshot interval elapsed since
+// the last snapshot attempt, and it deletes the snapshots exceeding spec.etcd.snapshot.retention.
+func (r *KubeadmControlPlaneReconciler) reconcileEtcdSnapshot(ctx context.Context, controlPlane *internal.ControlPlane, interval time.Duration, now time.Time) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	kcp := controlPlane.KCP
+
+	// Note: Discarded snapshot attempts are taken into account, so snapshots exceeding the maximum size
+	// are not streamed from the workload cluster on every reconcile.
+	etcdStatus := ptr.Deref(kcp.Status.Etcd, controlplanev1.KubeadmControlPlaneEtcdStatus{})
+	last := etcdStatus.LastSnapshotTime.Time
+	if etcdStatus.LastSnapshotAttemptTime.After(last) {
+		last = etcdStatus.LastSnapshotAttemptTime.Time
+	}
+	if next := last.Add(interval); now.Before(next) {
+		return ctrl.Result{RequeueAfter: next.Sub(now)}, nil
+	}
+
+	name := fmt.Sprintf("%s-etcd-snapshot-%s", kcp.Name, now.Format("20060102150405"))
+	maxSizeMiB := ptr.Deref(kcp.Spec.Etcd.Snapshot.MaxSizeMiB, defaultEtcdSnapshotMaxSizeMiB)
+	err := r.createEtcdSnapshot(ctx, controlPlane, name, int64(maxSizeMiB)*1024*1024)
+	if err != nil && !errors.Is(err, errEtcdSnapshotTooLarge) {
+		conditions.Set(kcp, metav1.Condition{
+			Type:    controlplanev1.KubeadmControlPlaneEtcdSnapshottedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  controlplanev1.KubeadmControlPlaneEtcdSnapshotFailedReason,
+			Message: "Please check controller logs for errors",
+		})
+		return ctrl.Result{}, err
+	}
+
+	if kcp.Status.Etcd == nil {
+		kcp.Status.Etcd = &controlplanev1.KubeadmControlPlaneEtcdStatus{}
+	}
+	kcp.Status.Etcd.LastSnapshotAttemptTime = metav1.NewTime(now)
+
+	if err != nil {
+		log.Info(fmt.Sprintf("Discarded etcd snapshot exceeding the maximum size of %d MiB", maxSizeMiB), "snapshot", name)
+		conditions.Set(kcp, metav1.Condition{
+			Type:   controlplanev1.KubeadmControlPlaneEtcdSnapshottedCondition,
+			Status: metav1.ConditionFalse,
+			Reason: controlplanev1.KubeadmControlPlaneEtcdSnapshotTooLargeReason,
+			Message: fmt.Sprintf("Discarded snapshot %s at %s: the compressed snapshot exceeds the maximum size of %d MiB; "+
+				"increase spec.etcd.snapshot.maxSizeMiB or use a backup solution storing snapshots outside of the management cluster",
+				name, now.Format(time.RFC3339), maxSizeMiB),
+		})
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+	log.Info("Created etcd snapshot", "snapshot", name)
+
+	kcp.Status.Etcd.LastSnapshotTime = metav1.NewTime(now)
+	kcp.Status.Etcd.LastSnapshotName = name
+	conditions.Set(kcp, metav1.Condition{
+		Type:    controlplanev1.KubeadmControlPlaneEtcdSnapshottedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  controlplanev1.KubeadmControlPlaneEtcdSnapshottedReason,
+		Message: fmt.Sprintf("Created snapshot %s at %s", name, now.Format(time.RFC3339)),
+	})
+
+	retention := ptr.Deref(kcp.Spec.Etcd.Snapshot.Retention, defaultEtcdSnapshotRetention)
+	if err := r.deleteEtcdSnapshots(ctx, kcp, int(retention)); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// createEtcdSnapshot takes a gzip compressed snapshot of the etcd database and stores it in one or more Secrets,
+// each one with a chunk of the snapshot; the snapshot is streamed from the workload cluster to the Secrets, so it
+// is never fully buffered in memory.
+// If the compressed snapshot exceeds maxSize, the snapshot is discarded and errEtcdSnapshotTooLarge is returned.
+// NOTE: Secrets do not have an owner reference to the KubeadmControlPlane, so snapshots are not garbage collected
+// when the KubeadmControlPlane or the Cluster are deleted.
+func (r *KubeadmControlPlaneReconciler) createEtcdSnapshot(ctx context.Context, controlPlane *internal.ControlPlane, name string, maxSize int64) error {
+	kcp := controlPlane.KCP
+
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to create etcd snapshot: cannot get remote client to workload cluster")
+	}
+
+	chunkWriter := &etcdSnapshotChunkWriter{
+		ctx:     ctx,
+		client:  r.Client,
+		maxSize: maxSize,
+		newSecret: func(chunk int, data []byte) *corev1.Secret {
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("%s-%d", name, chunk),
+					Namespace: kcp.Namespace,
+					Labels: map[string]string{
+						clusterv1.ClusterNameLabel:       controlPlane.Cluster.Name,
+						controlplanev1.EtcdSnapshotLabel: kcp.Name,
+					},
+					Annotations: map[string]string{
+						controlplanev1.EtcdSnapshotNameAnnotation:  name,
+						controlplanev1.EtcdSnapshotChunkAnnotation: strconv.Itoa(chunk),
+					},
+				},
+				Type: clusterv1.ClusterSecretType,
+				Data: map[string][]byte{
+					etcdSnapshotSecretKey: data,
+				},
+			}
+		},
+	}
+	gzipWriter := gzip.NewWriter(chunkWriter)
+	err = workloadCluster.SnapshotEtcd(ctx, gzipWriter)
+	if err == nil {
+		err = gzipWriter.Close()
+	}
+	if err == nil {
+		err = chunkWriter.Flush()
+	}
+	if err != nil {
+		// Delete the chunks already created, so an incomplete snapshot is not left behind.
+		if deleteErr := chunkWriter.DeleteChunks(); deleteErr != nil {
+			return errors.Wrap(kerrors.NewAggregate([]error{err, deleteErr}), "failed to create etcd snapshot")
+		}
+		return errors.Wrap(err, "failed to create etcd snapshot")
+	}
+	return nil
+}
+
+// errEtcdSnapshotTooLarge is returned when a compressed etcd snapshot exceeds spec.etcd.snapshot.maxSizeMiB.
+var errEtcdSnapshotTooLarge = errors.New("snapshot exceeds the maximum size")
+
+// etcdSnapshotChunkWriter is an io.Writer storing the data written to it in Secrets, each one with a chunk
+// of at most etcdSnapshotChunkSize bytes.
+type etcdSnapshotChunkWriter struct {
+	ctx       context.Context
+	client    client.Client
+	newSecret func(chunk int, data []byte) *corev1.Secret
+	maxSize   int64
+
+	size    int64
+	buffer  []byte
+	created []*corev1.Secret
+}
+
+// Write implements io.Writer.
+func (w *etcdSnapshotChunkWriter) Write(p []byte) (int, error) {
+	if w.size+int64(len(p)) > w.maxSize {
+		return 0, errEtcdSnapshotTooLarge
+	}
+	w.size += int64(len(p))
+
+	written := 0
+	for written < len(p) {
+		n := min(etcdSnapshotChunkSize-len(w.buffer), len(p)-written)
+		w.buffer = append(w.buffer, p[written:written+n]...)
+		written += n
+		if len(w.buffer) == etcdSnapshotChunkSize {
+			if err := w.Flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// Flush stores the buffered data in a new Secret.
+func (w *etcdSnapshotChunkWriter) Flush() error {
+	if len(w.buffer) == 0 {
+		return nil
+	}
+	s := w.newSecret(len(w.created), w.buffer)
+	if err := w.client.Create(w.ctx, s); err != nil {
+		return errors.Wrapf(err, "failed to create Secret %s", s.Name)
+	}
+	w.created = append(w.created, s)
+	w.buffer = make([]byte, 0, etcdSnapshotChunkSize)
+	return nil
+}
+
+// DeleteChunks deletes the Secrets created by the writer.
+func (w *etcdSnapshotChunkWriter) DeleteChunks() error {
+	var errs []error
+	for _, s := range w.created {
+		if err := w.client.Delete(w.ctx, s); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, errors.Wrapf(err, "failed to delete Secret %s", s.Name))
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// deleteEtcdSnapshots deletes the oldest etcd snapshots, keeping the given number of snapshots.
+func (r *KubeadmControlPlaneReconciler) deleteEtcdSnapshots(ctx context.Context, kcp *controlplanev1.KubeadmControlPlane, retention int) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Note: Only the metadata of the Secrets is read, given that the Secrets storing snapshots can be big.
+	secrets := &metav1.PartialObjectMetadataList{}
+	secrets.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("SecretList"))
+	if err := r.Client.List(ctx, secrets, client.InNamespace(kcp.Namespace), client.MatchingLabels{controlplanev1.EtcdSnapshotLabel: kcp.Name}); err != nil {
+		return errors.Wrap(err, "failed to list etcd snapshot Secrets")
+	}
+
+	secretsBySnapshot := map[string][]*metav1.PartialObjectMetadata{}
+	for i := range secrets.Items {
+		s := &secrets.Items[i]
+		name := s.Annotations[controlplanev1.EtcdSnapshotNameAnnotation]
+		secretsBySnapshot[name] = append(secretsBySnapshot[name], s)
+	}
+	if len(secretsBySnapshot) <= retention {
+		return nil
+	}
+
+	// Note: Snapshot names end with the time of the snapshot, so sorting by name sorts snapshots from the oldest.
+	snapshots := make([]string, 0, len(secretsBySnapshot))
+	for name := range secretsBySnapshot {
+		snapshots = append(snapshots, name)
+	}
+	sort.Strings(snapshots)
+
+	var errs []error
+	for _, name := range snapshots[:len(snapshots)-retention] {
+		log.Info("Deleting etcd snapshot exceeding retention", "snapshot", name)
+		for _, s := range secretsBySnapshot[name] {
+			if err := r.Client.Delete(ctx, s); err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, errors.Wrapf(err, "failed to delete etcd snapshot Secret %s", s.Name))
+			}
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// minRequeueAfter returns the shortest of two requeue durations, ignoring zero values.
+func minRequeueAfter(a, b time.Duration) time.Duration {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"io"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestKubeadmControlPlaneReconciler_reconcileEtcdDefragmentation(t *testing.T) {
+	interval := time.Hour
+	etcdHealthy := metav1.Condition{
+		Type:   controlplanev1.KubeadmControlPlaneEtcdClusterHealthyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: controlplanev1.KubeadmControlPlaneEtcdClusterHealthyReason,
+	}
+	etcdNotHealthy := metav1.Condition{
+		Type:   controlplanev1.KubeadmControlPlaneEtcdClusterHealthyCondition,
+		Status: metav1.ConditionFalse,
+		Reason: controlplanev1.KubeadmControlPlaneEtcdClusterNotHealthyReason,
+	}
+
+	tests := []struct {
+		name                            string
+		externalEtcd                    bool
+		defragmentation                 controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec
+		lastDefragmentationTime         time.Time
+		etcdCondition                   metav1.Condition
+		defragmentEtcdMembersErr        error
+		wantErr                         bool
+		wantRequeueAfter                time.Duration
+		wantDefragmentEtcdMembersCalled int
+		wantCondition                   *metav1.Condition
+	}{
+		{
+			name:          "Do nothing if defragmentation is not configured",
+			etcdCondition: etcdHealthy,
+		},
+		{
+			name:            "Do nothing if using external etcd",
+			externalEtcd:    true,
+			defragmentation: controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{IntervalSeconds: ptr.To(int32(interval.Seconds()))},
+			etcdCondition:   etcdHealthy,
+		},
+		{
+			name:                    "Requeue if the defragmentation interval did not elapse yet",
+			defragmentation:         controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{IntervalSeconds: ptr.To(int32(interval.Seconds()))},
+			lastDefragmentationTime: time.Now().Add(-10 * time.Minute),
+			etcdCondition:           etcdHealthy,
+			wantRequeueAfter:        50 * time.Minute,
+		},
+		{
+			name:             "Wait for the etcd cluster to be healthy",
+			defragmentation:  controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{IntervalSeconds: ptr.To(int32(interval.Seconds()))},
+			etcdCondition:    etcdNotHealthy,
+			wantRequeueAfter: preflightFailedRequeueAfter,
+		},
+		{
+			name:                            "Defragment etcd members",
+			defragmentation:                 controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{IntervalSeconds: ptr.To(int32(interval.Seconds()))},
+			lastDefragmentationTime:         time.Now().Add(-2 * interval),
+			etcdCondition:                   etcdHealthy,
+			wantRequeueAfter:                interval,
+			wantDefragmentEtcdMembersCalled: 1,
+			wantCondition: &metav1.Condition{
+				Type:   controlplanev1.KubeadmControlPlaneEtcdDefragmentedCondition,
+				Status: metav1.ConditionTrue,
+				Reason: controlplanev1.KubeadmControlPlaneEtcdDefragmentedReason,
+			},
+		},
+		{
+			name:                            "Report defragmentation failures",
+			defragmentation:                 controlplanev1.KubeadmControlPlaneEtcdDefragmentationSpec{IntervalSeconds: ptr.To(int32(interval.Seconds()))},
+			etcdCondition:                   etcdHealthy,
+			defragmentEtcdMembersErr:        errors.New("failed to defragment"),
+			wantErr:                         true,
+			wantDefragmentEtcdMembersCalled: 1,
+			wantCondition: &metav1.Condition{
+				Type:   controlplanev1.KubeadmControlPlaneEtcdDefragmentedCondition,
+				Status: metav1.ConditionFalse,
+				Reason: controlplanev1.KubeadmControlPlaneEtcdDefragmentationFailedReason,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := &KubeadmControlPlaneReconciler{}
+
+			kcp := &controlplanev1.KubeadmControlPlane{
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					Etcd: controlplanev1.KubeadmControlPlaneEtcdSpec{
+						Defragmentation: tt.defragmentation,
+					},
+				},
+				Status: controlplanev1.KubeadmControlPlaneStatus{
+					Conditions: []metav1.Condition{tt.etcdCondition},
+				},
+			}
+			if tt.externalEtcd {
+				kcp.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.External = bootstrapv1.ExternalEtcd{Endpoints: []string{"1.2.3.4"}}
+			}
+			if !tt.lastDefragmentationTime.IsZero() {
+				kcp.Status.Etcd = &controlplanev1.KubeadmControlPlaneEtcdStatus{LastDefragmentationTime: metav1.NewTime(tt.lastDefragmentationTime)}
+			}
+			controlPlane := &internal.ControlPlane{
+				Cluster: &clusterv1.Cluster{},
+				KCP:     kcp,
+			}
+			workloadCluster := fakeWorkloadCluster{
+				defragmentEtcdMembersResult: []string{"node-1"},
+				defragmentEtcdMembersErr:    tt.defragmentEtcdMembersErr,
+			}
+			controlPlane.InjectTestManagementCluster(&fakeManagementCluster{
+				Workload: &workloadCluster,
+			})
+
+			res, err := r.reconcileEtcdMaintenance(ctx, controlPlane)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(res.RequeueAfter).To(BeNumerically("~", tt.wantRequeueAfter, time.Minute))
+			g.Expect(workloadCluster.defragmentEtcdMembersCalled).To(Equal(tt.wantDefragmentEtcdMembersCalled))
+
+			condition := conditions.Get(kcp, controlplanev1.KubeadmControlPlaneEtcdDefragmentedCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+			g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+			if tt.wantCondition.Status == metav1.ConditionTrue {
+				g.Expect(condition.Message).To(ContainSubstring("node-1"))
+				g.Expect(kcp.Status.Etcd.LastDefragmentationTime.Time).To(BeTemporally("~", time.Now(), time.Minute))
+			}
+		})
+	}
+}
+
+func TestKubeadmControlPlaneReconciler_reconcileEtcdSnapshot(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: metav1.NamespaceDefault}}
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "kcp", Namespace: metav1.NamespaceDefault},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Etcd: controlplanev1.KubeadmControlPlaneEtcdSpec{
+				Snapshot: controlplanev1.KubeadmControlPlaneEtcdSnapshotSpec{
+					IntervalSeconds: ptr.To(int32(3600)),
+					Retention:       ptr.To(int32(2)),
+				},
+			},
+		},
+	}
+	snapshotSecret := func(snapshot string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        snapshot + "-0",
+				Namespace:   metav1.NamespaceDefault,
+				Labels:      map[string]string{controlplanev1.EtcdSnapshotLabel: kcp.Name},
+				Annotations: map[string]string{controlplanev1.EtcdSnapshotNameAnnotation: snapshot},
+			},
+		}
+	}
+	oldest := snapshotSecret("kcp-etcd-snapshot-20250101000000")
+	previous := snapshotSecret("kcp-etcd-snapshot-20250102000000")
+
+	// Note: Random data cannot be compressed, so the snapshot is stored in more than one Secret.
+	snapshot := make([]byte, etcdSnapshotChunkSize+1024)
+	_, err := rand.Read(snapshot)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	fakeClient := newFakeClient(oldest, previous)
+	r := &KubeadmControlPlaneReconciler{Client: fakeClient}
+	controlPlane := &internal.ControlPlane{
+		Cluster: cluster,
+		KCP:     kcp,
+	}
+	workloadCluster := fakeWorkloadCluster{etcdSnapshot: snapshot}
+	controlPlane.InjectTestManagementCluster(&fakeManagementCluster{
+		Workload: &workloadCluster,
+	})
+
+	res, err := r.reconcileEtcdMaintenance(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res).To(Equal(ctrl.Result{RequeueAfter: time.Hour}))
+
+	// The snapshot is reported in the status.
+	g.Expect(kcp.Status.Etcd).ToNot(BeNil())
+	g.Expect(kcp.Status.Etcd.LastSnapshotTime.Time).To(BeTemporally("~", time.Now(), time.Minute))
+	name := kcp.Status.Etcd.LastSnapshotName
+	g.Expect(name).To(HavePrefix("kcp-etcd-snapshot-"))
+	condition := conditions.Get(kcp, controlplanev1.KubeadmControlPlaneEtcdSnapshottedCondition)
+	g.Expect(condition).ToNot(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(condition.Message).To(ContainSubstring(name))
+
+	// The snapshot is stored in Secrets, the oldest snapshot exceeding retention is deleted.
+	secrets := &corev1.SecretList{}
+	g.Expect(fakeClient.List(ctx, secrets, client.MatchingLabels{controlplanev1.EtcdSnapshotLabel: kcp.Name})).To(Succeed())
+	chunks := []corev1.Secret{}
+	names := []string{}
+	for _, s := range secrets.Items {
+		names = append(names, s.Name)
+		if s.Annotations[controlplanev1.EtcdSnapshotNameAnnotation] == name {
+			chunks = append(chunks, s)
+		}
+	}
+	g.Expect(names).To(ConsistOf(previous.Name, name+"-0", name+"-1"))
+	g.Expect(chunks).To(HaveLen(2))
+	sort.Slice(chunks, func(i, j int) bool {
+		return chunks[i].Annotations[controlplanev1.EtcdSnapshotChunkAnnotation] < chunks[j].Annotations[controlplanev1.EtcdSnapshotChunkAnnotation]
+	})
+	data := &bytes.Buffer{}
+	for i, s := range chunks {
+		g.Expect(s.Annotations).To(HaveKeyWithValue(controlplanev1.EtcdSnapshotChunkAnnotation, strconv.Itoa(i)))
+		g.Expect(s.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, cluster.Name))
+		g.Expect(s.Type).To(Equal(clusterv1.ClusterSecretType))
+		g.Expect(s.OwnerReferences).To(BeEmpty())
+		data.Write(s.Data[etcdSnapshotSecretKey])
+	}
+	gzipReader, err := gzip.NewReader(data)
+	g.Expect(err).ToNot(HaveOccurred())
+	restored, err := io.ReadAll(gzipReader)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(restored).To(Equal(snapshot))
+
+	// No new snapshot is taken before the interval elapses.
+	res, err = r.reconcileEtcdMaintenance(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+	g.Expect(kcp.Status.Etcd.LastSnapshotName).To(Equal(name))
+
+	// Snapshot failures are reported.
+	kcp.Status.Etcd.LastSnapshotTime = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	kcp.Status.Etcd.LastSnapshotAttemptTime = kcp.Status.Etcd.LastSnapshotTime
+	workloadCluster.snapshotEtcdErr = errors.New("failed to snapshot")
+	_, err = r.reconcileEtcdMaintenance(ctx, controlPlane)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(kcp.Status.Etcd.LastSnapshotName).To(Equal(name))
+	condition = conditions.Get(kcp, controlplanev1.KubeadmControlPlaneEtcdSnapshottedCondition)
+	g.Expect(condition).ToNot(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(controlplanev1.KubeadmControlPlaneEtcdSnapshotFailedReason))
+}
+
+func TestKubeadmControlPlaneReconciler_reconcileEtcdSnapshotTooLarge(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: metav1.NamespaceDefault}}
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "kcp", Namespace: metav1.NamespaceDefault},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Etcd: controlplanev1.KubeadmControlPlaneEtcdSpec{
+				Snapshot: controlplanev1.KubeadmControlPlaneEtcdSnapshotSpec{
+					IntervalSeconds: ptr.To(int32(3600)),
+					MaxSizeMiB:      ptr.To(int32(1)),
+				},
+			},
+		},
+	}
+
+	// Note: Random data cannot be compressed, so the compressed snapshot exceeds 1MiB.
+	snapshot := make([]byte, 2*1024*1024)
+	_, err := rand.Read(snapshot)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	fakeClient := newFakeClient()
+	r := &KubeadmControlPlaneReconciler{Client: fakeClient}
+	controlPlane := &internal.ControlPlane{
+		Cluster: cluster,
+		KCP:     kcp,
+	}
+	workloadCluster := fakeWorkloadCluster{etcdSnapshot: snapshot}
+	controlPlane.InjectTestManagementCluster(&fakeManagementCluster{
+		Workload: &workloadCluster,
+	})
+
+	res, err := r.reconcileEtcdMaintenance(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res).To(Equal(ctrl.Result{RequeueAfter: time.Hour}))
+
+	// The snapshot is discarded and reported in the condition.
+	g.Expect(kcp.Status.Etcd).ToNot(BeNil())
+	g.Expect(kcp.Status.Etcd.LastSnapshotTime.IsZero()).To(BeTrue())
+	g.Expect(kcp.Status.Etcd.LastSnapshotName).To(BeEmpty())
+	g.Expect(kcp.Status.Etcd.LastSnapshotAttemptTime.Time).To(BeTemporally("~", time.Now(), time.Minute))
+	condition := conditions.Get(kcp, controlplanev1.KubeadmControlPlaneEtcdSnapshottedCondition)
+	g.Expect(condition).ToNot(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(controlplanev1.KubeadmControlPlaneEtcdSnapshotTooLargeReason))
+	g.Expect(condition.Message).To(ContainSubstring("exceeds the maximum size of 1 MiB"))
+
+	// The chunks already stored are deleted.
+	secrets := &corev1.SecretList{}
+	g.Expect(fakeClient.List(ctx, secrets, client.MatchingLabels{controlplanev1.EtcdSnapshotLabel: kcp.Name})).To(Succeed())
+	g.Expect(secrets.Items).To(BeEmpty())
+
+	// No new snapshot is attempted before the interval elapses.
+	workloadCluster.snapshotEtcdErr = errors.New("snapshot should not be attempted")
+	res, err = r.reconcileEtcdMaintenance(ctx, controlPlane)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+}
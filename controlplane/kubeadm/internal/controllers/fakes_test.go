@@ -18,6 +18,7 @@ package controllers
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/blang/semver/v4"
@@ -75,6 +76,14 @@ type fakeWorkloadCluster struct {
 
 	forwardEtcdLeadershipCalled      int
 	removeEtcdMemberForMachineCalled int
+	promoteEtcdLearnersCalled        int
+	promoteEtcdLearnersNotReady      []string
+	rewriteEncryptedResourcesCalled  int
+	defragmentEtcdMembersCalled      int
+	defragmentEtcdMembersResult      []string
+	defragmentEtcdMembersErr         error
+	etcdSnapshot                     []byte
+	snapshotEtcdErr                  error
 }
 
 func (f *fakeWorkloadCluster) ForwardEtcdLeadership(_ context.Context, _ *clusterv1.Machine, leaderCandidate *clusterv1.Machine) error {
@@ -85,6 +94,29 @@ func (f *fakeWorkloadCluster) ForwardEtcdLeadership(_ context.Context, _ *cluste
 	return nil
 }
 
+func (f *fakeWorkloadCluster) PromoteEtcdLearners(_ context.Context) ([]string, []string, error) {
+	f.promoteEtcdLearnersCalled++
+	return nil, f.promoteEtcdLearnersNotReady, nil
+}
+
+func (f *fakeWorkloadCluster) RewriteEncryptedResources(_ context.Context, _ []string) error {
+	f.rewriteEncryptedResourcesCalled++
+	return nil
+}
+
+func (f *fakeWorkloadCluster) DefragmentEtcdMembers(_ context.Context, _ int32) ([]string, error) {
+	f.defragmentEtcdMembersCalled++
+	return f.defragmentEtcdMembersResult, f.defragmentEtcdMembersErr
+}
+
+func (f *fakeWorkloadCluster) SnapshotEtcd(_ context.Context, writer io.Writer) error {
+	if f.snapshotEtcdErr != nil {
+		return f.snapshotEtcdErr
+	}
+	_, err := writer.Write(f.etcdSnapshot)
+	return err
+}
+
 func (f *fakeWorkloadCluster) ReconcileEtcdMembersAndControlPlaneNodes(_ context.Context, _ []*etcd.Member, _ []string) ([]string, error) {
 	return nil, nil
 }
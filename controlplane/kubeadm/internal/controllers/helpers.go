@@ -88,8 +88,27 @@ func (r *KubeadmControlPlaneReconciler) reconcileKubeconfig(ctx context.Context,
 		return ctrl.Result{}, err
 	}
 
-	if needsRotation {
-		log.Info("Rotating kubeconfig secret")
+	// Regenerate the kubeconfig if the cluster CA certificates changed, e.g. during a CA rotation.
+	needsCABundleUpdate := false
+	clusterCA, err := secret.GetFromNamespacedName(ctx, r.SecretCachingClient, clusterName, secret.ClusterCA)
+	switch {
+	case apierrors.IsNotFound(err):
+		// Nothing to compare with, the cluster CA Secret is created later in the reconcile loop.
+	case err != nil:
+		return ctrl.Result{}, errors.Wrap(err, "failed to retrieve cluster CA Secret")
+	default:
+		needsCABundleUpdate, err = kubeconfig.NeedsCABundleUpdate(configSecret, clusterCA.Data[secret.TLSCrtDataName])
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if needsRotation || needsCABundleUpdate {
+		if needsCABundleUpdate {
+			log.Info("Regenerating kubeconfig secret, cluster CA certificates changed")
+		} else {
+			log.Info("Rotating kubeconfig secret")
+		}
 		if err := kubeconfig.RegenerateSecret(ctx, r.Client, configSecret, kubeconfig.KeyEncryptionAlgorithm(controlPlane.GetKeyEncryptionAlgorithm())); err != nil {
 			return ctrl.Result{}, errors.Wrap(err, "failed to regenerate kubeconfig")
 		}
@@ -312,7 +312,7 @@ func Test_canExtensionsUpdateMachine(t *testing.T) {
 +     Version:           "v1.31.0",
       ProviderID:        "",
       FailureDomain:     "",
-      ... // 4 identical fields
+      ... // 9 identical fields
     },
     Status: {},
   }`,
@@ -33,8 +33,11 @@ import (
 
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
 	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/hooks"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/collections"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -114,6 +117,21 @@ func (r *KubeadmControlPlaneReconciler) reconcileUnhealthyMachines(ctx context.C
 		return ctrl.Result{}, nil
 	}
 
+	// Returns if automated disruptive actions on the Cluster are frozen after failures.
+	if conditions.IsTrue(controlPlane.Cluster, clusterv1.ClusterDegradedFreezeCondition) {
+		message := fmt.Sprintf("KubeadmControlPlane can't remediate while Cluster has %s condition set to True", clusterv1.ClusterDegradedFreezeCondition)
+		log.Info(fmt.Sprintf("A control plane machine needs remediation, but %s. Skipping remediation", message), "Machine", klog.KObj(machineToBeRemediated))
+		v1beta1conditions.MarkFalse(machineToBeRemediated, clusterv1.MachineOwnerRemediatedV1Beta1Condition, clusterv1.WaitingForRemediationV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", message)
+
+		conditions.Set(machineToBeRemediated, metav1.Condition{
+			Type:    clusterv1.MachineOwnerRemediatedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  controlplanev1.KubeadmControlPlaneMachineRemediationDeferredReason,
+			Message: message,
+		})
+		return ctrl.Result{}, nil
+	}
+
 	var initialized bool
 	if ptr.Deref(controlPlane.KCP.Status.Initialization.ControlPlaneInitialized, false) {
 		initialized = true
@@ -282,7 +300,37 @@ func (r *KubeadmControlPlaneReconciler) reconcileUnhealthyMachines(ctx context.C
 				return ctrl.Result{}, nil
 			}
 		}
+	}
+
+	// Before starting remediation, call the BeforeMachineRemediation hook so external systems can delay remediation.
+	if feature.Gates.Enabled(feature.RuntimeSDK) {
+		unhealthyConditions := []metav1.Condition{}
+		if c := conditions.Get(machineToBeRemediated, clusterv1.MachineHealthCheckSucceededCondition); c != nil {
+			unhealthyConditions = append(unhealthyConditions, *c)
+		}
+		hookResponse, err := hooks.CallBeforeMachineRemediationHook(ctx, r.RuntimeClient, controlPlane.Cluster, machineToBeRemediated, unhealthyConditions, runtimehooksv1.MachineRemediationActionDelete)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "failed to call %s hook", runtimecatalog.HookName(runtimehooksv1.BeforeMachineRemediation))
+		}
+		if hookResponse.RetryAfterSeconds != 0 {
+			message := fmt.Sprintf("KubeadmControlPlane can't remediate while blocked by %s hook", runtimecatalog.HookName(runtimehooksv1.BeforeMachineRemediation))
+			if hookResponse.Message != "" {
+				message = fmt.Sprintf("%s: %s", message, hookResponse.Message)
+			}
+			log.Info(fmt.Sprintf("A control plane machine needs remediation, but %s. Skipping remediation", message))
+			v1beta1conditions.MarkFalse(machineToBeRemediated, clusterv1.MachineOwnerRemediatedV1Beta1Condition, clusterv1.WaitingForRemediationV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", message)
+
+			conditions.Set(machineToBeRemediated, metav1.Condition{
+				Type:    clusterv1.MachineOwnerRemediatedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  controlplanev1.KubeadmControlPlaneMachineRemediationDeferredReason,
+				Message: message,
+			})
+			return ctrl.Result{RequeueAfter: time.Duration(hookResponse.RetryAfterSeconds) * time.Second}, nil
+		}
+	}
 
+	if ptr.Deref(controlPlane.KCP.Status.Initialization.ControlPlaneInitialized, false) {
 		// Start remediating the unhealthy control plane machine by deleting it.
 		// A new machine will come up completing the operation as part of the regular reconcile.
 
@@ -35,8 +35,11 @@ import (
 
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
 	"sigs.k8s.io/cluster-api/feature"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
 	"sigs.k8s.io/cluster-api/util/collections"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
@@ -384,6 +387,76 @@ func TestReconcileUnhealthyMachines(t *testing.T) {
 		removeFinalizer(g, m1)
 		g.Expect(env.Cleanup(ctx, m1, m2, m3)).To(Succeed())
 	})
+	t.Run("Remediation is deferred if the BeforeMachineRemediation hook is blocking", func(t *testing.T) {
+		utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+		g := NewWithT(t)
+
+		catalog := runtimecatalog.New()
+		_ = runtimehooksv1.AddToCatalog(catalog)
+		beforeMachineRemediationGVH, err := catalog.GroupVersionHook(runtimehooksv1.BeforeMachineRemediation)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		m1 := createMachine(ctx, g, ns.Name, "m1-unhealthy-", withMachineHealthCheckFailed())
+		m2 := createMachine(ctx, g, ns.Name, "m2-healthy-", withHealthyEtcdMember())
+		m3 := createMachine(ctx, g, ns.Name, "m3-healthy-", withHealthyEtcdMember())
+
+		controlPlane := &internal.ControlPlane{
+			KCP: &controlplanev1.KubeadmControlPlane{
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					Replicas: utilptr.To[int32](3),
+					Version:  "v1.19.1",
+				},
+			},
+			Cluster:  &clusterv1.Cluster{},
+			Machines: collections.FromMachines(m1, m2, m3),
+		}
+
+		runtimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+			WithCatalog(catalog).
+			WithGetAllExtensionResponses(map[runtimecatalog.GroupVersionHook][]string{
+				beforeMachineRemediationGVH: {"test-extension"},
+			}).
+			WithCallAllExtensionResponses(map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
+				beforeMachineRemediationGVH: &runtimehooksv1.BeforeMachineRemediationResponse{
+					CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+						CommonResponse: runtimehooksv1.CommonResponse{
+							Status:  runtimehooksv1.ResponseStatusSuccess,
+							Message: "change freeze",
+						},
+						RetryAfterSeconds: 60,
+					},
+				},
+			}).
+			Build()
+
+		r := &KubeadmControlPlaneReconciler{
+			Client:        env.GetClient(),
+			recorder:      record.NewFakeRecorder(32),
+			RuntimeClient: runtimeClient,
+			managementCluster: &fakeManagementCluster{
+				Workload: &fakeWorkloadCluster{
+					EtcdMembersResult: nodes(controlPlane.Machines),
+				},
+			},
+		}
+
+		ret, err := r.reconcileUnhealthyMachines(ctx, controlPlane)
+
+		g.Expect(ret.RequeueAfter).To(Equal(60 * time.Second)) // Remediation deferred, requeue
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(runtimeClient.CallAllCount(runtimehooksv1.BeforeMachineRemediation)).To(Equal(1))
+
+		g.Expect(controlPlane.KCP.Annotations).ToNot(HaveKey(controlplanev1.RemediationInProgressAnnotation))
+
+		assertMachineV1beta1Condition(ctx, g, m1, clusterv1.MachineOwnerRemediatedV1Beta1Condition, corev1.ConditionFalse, clusterv1.WaitingForRemediationV1Beta1Reason, clusterv1.ConditionSeverityWarning, "KubeadmControlPlane can't remediate while blocked by BeforeMachineRemediation hook: change freeze")
+		assertMachineCondition(ctx, g, m1, clusterv1.MachineOwnerRemediatedCondition, metav1.ConditionFalse, controlplanev1.KubeadmControlPlaneMachineRemediationDeferredReason, "KubeadmControlPlane can't remediate while blocked by BeforeMachineRemediation hook: change freeze")
+
+		err = env.Get(ctx, client.ObjectKey{Namespace: m1.Namespace, Name: m1.Name}, m1)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(m1.ObjectMeta.DeletionTimestamp.IsZero()).To(BeTrue())
+
+		g.Expect(env.Cleanup(ctx, m1, m2, m3)).To(Succeed())
+	})
 	t.Run("Retry history is ignored if min healthy period is expired, default min healthy period", func(t *testing.T) {
 		g := NewWithT(t)
 
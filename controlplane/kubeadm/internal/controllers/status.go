@@ -147,6 +147,8 @@ func (r *KubeadmControlPlaneReconciler) updateStatus(ctx context.Context, contro
 	if err := setLastRemediation(ctx, controlPlane); err != nil {
 		allErrors = append(allErrors, err)
 	}
+	notUpToDateMachines, machinesUpToDateResults := controlPlane.NotUpToDateMachines()
+	setRolloutReasons(ctx, controlPlane.KCP, notUpToDateMachines, machinesUpToDateResults)
 	return kerrors.NewAggregate(allErrors)
 }
 
@@ -812,6 +814,28 @@ func setLastRemediation(_ context.Context, controlPlane *internal.ControlPlane)
 	return nil
 }
 
+// setRolloutReasons surface in status the reasons why Machines which are not up-to-date must be rolled out.
+func setRolloutReasons(_ context.Context, kcp *controlplanev1.KubeadmControlPlane, machines collections.Machines, machinesUpToDateResults map[string]internal.UpToDateResult) {
+	var rolloutReasons []controlplanev1.KubeadmControlPlaneMachineRolloutReasons
+	for _, machine := range machines.SortedByCreationTimestamp() {
+		reasons := machinesUpToDateResults[machine.Name].RolloutReasons
+		if len(reasons) == 0 {
+			continue
+		}
+		if len(reasons) > maxRolloutReasonsPerMachine {
+			reasons = reasons[:maxRolloutReasonsPerMachine]
+		}
+		rolloutReasons = append(rolloutReasons, controlplanev1.KubeadmControlPlaneMachineRolloutReasons{
+			MachineName: machine.Name,
+			Reasons:     reasons,
+		})
+		if len(rolloutReasons) == maxRolloutReasonsMachines {
+			break
+		}
+	}
+	kcp.Status.RolloutReasons = rolloutReasons
+}
+
 // shouldSurfaceWhenAvailableTrue defines when a control plane components/etcd issue should surface when
 // Available condition is true.
 // The main goal of this check is to avoid to surface false negatives/flakes, and thus it requires that
@@ -2190,6 +2190,54 @@ func TestKubeadmControlPlaneReconciler_setLastRemediation(t *testing.T) {
 	})
 }
 
+func Test_setRolloutReasons(t *testing.T) {
+	now := time.Now()
+	m1 := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "m1", CreationTimestamp: metav1.Time{Time: now.Add(-2 * time.Minute)}}}
+	m2 := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "m2", CreationTimestamp: metav1.Time{Time: now.Add(-3 * time.Minute)}}}
+	m3 := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "m3", CreationTimestamp: metav1.Time{Time: now.Add(-1 * time.Minute)}}}
+
+	tests := []struct {
+		name                    string
+		machines                collections.Machines
+		machinesUpToDateResults map[string]internal.UpToDateResult
+		expectRolloutReasons    []controlplanev1.KubeadmControlPlaneMachineRolloutReasons
+	}{
+		{
+			name:                 "No machines",
+			machines:             collections.FromMachines(),
+			expectRolloutReasons: nil,
+		},
+		{
+			name:     "Machines not up-to-date, oldest first",
+			machines: collections.FromMachines(m1, m2, m3),
+			machinesUpToDateResults: map[string]internal.UpToDateResult{
+				"m1": {RolloutReasons: []string{"spec.version"}},
+				"m2": {RolloutReasons: []string{"spec.rollout.after", "spec.kubeadmConfigSpec.clusterConfiguration.apiServer.extraArgs"}},
+				"m3": {RolloutReasons: nil},
+			},
+			expectRolloutReasons: []controlplanev1.KubeadmControlPlaneMachineRolloutReasons{
+				{MachineName: "m2", Reasons: []string{"spec.rollout.after", "spec.kubeadmConfigSpec.clusterConfiguration.apiServer.extraArgs"}},
+				{MachineName: "m1", Reasons: []string{"spec.version"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			kcp := &controlplanev1.KubeadmControlPlane{
+				Status: controlplanev1.KubeadmControlPlaneStatus{
+					RolloutReasons: []controlplanev1.KubeadmControlPlaneMachineRolloutReasons{
+						{MachineName: "old", Reasons: []string{"spec.version"}},
+					},
+				},
+			}
+			setRolloutReasons(ctx, kcp, tt.machines, tt.machinesUpToDateResults)
+			g.Expect(kcp.Status.RolloutReasons).To(BeComparableTo(tt.expectRolloutReasons))
+		})
+	}
+}
+
 func TestKubeadmControlPlaneReconciler_updateStatusAllMachinesNotReady(t *testing.T) {
 	g := NewWithT(t)
 
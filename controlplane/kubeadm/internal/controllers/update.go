@@ -22,11 +22,13 @@ import (
 	"github.com/blang/semver/v4"
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/desiredstate"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/util/collections"
 )
@@ -59,14 +61,19 @@ func (r *KubeadmControlPlaneReconciler) updateControlPlane(
 
 	kubeadmCMMutators := make([]func(*bootstrapv1.ClusterConfiguration), 0)
 
+	// Note: The API server flag and volume for the EncryptionConfiguration must be stored in the kubeadm-config ConfigMap,
+	// given that it is used when joining control plane Machines.
+	apiServer := controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.APIServer.DeepCopy()
+	desiredstate.ApplyEncryptionAtRestToAPIServer(apiServer, controlPlane.KCP)
+
 	if controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.IsDefined() {
 		// Get the imageRepository or the correct value if nothing is set and a migration is necessary.
 		imageRepository := internal.ImageRepositoryFromClusterConfig(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration)
 
 		kubeadmCMMutators = append(kubeadmCMMutators,
 			workloadCluster.UpdateImageRepositoryInKubeadmConfigMap(imageRepository),
-			workloadCluster.UpdateFeatureGatesInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec, parsedVersion),
-			workloadCluster.UpdateAPIServerInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.APIServer),
+			workloadCluster.UpdateFeatureGatesInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec, parsedVersion, ptr.Deref(controlPlane.KCP.Spec.Etcd.JoinAsLearner, false)),
+			workloadCluster.UpdateAPIServerInKubeadmConfigMap(*apiServer),
 			workloadCluster.UpdateControllerManagerInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.ControllerManager),
 			workloadCluster.UpdateSchedulerInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.Scheduler),
 			workloadCluster.UpdateCertificateValidityPeriodDays(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.CertificateValidityPeriodDays),
@@ -80,6 +87,8 @@ func (r *KubeadmControlPlaneReconciler) updateControlPlane(
 			kubeadmCMMutators = append(kubeadmCMMutators,
 				workloadCluster.UpdateEtcdExternalInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.External))
 		}
+	} else if len(controlPlane.KCP.Spec.EncryptionAtRest.Keys) > 0 {
+		kubeadmCMMutators = append(kubeadmCMMutators, workloadCluster.UpdateAPIServerInKubeadmConfigMap(*apiServer))
 	}
 
 	// collectively update Kubeadm config map
@@ -19,6 +19,7 @@ package desiredstate
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 
 	"github.com/blang/semver/v4"
@@ -28,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
@@ -52,8 +54,22 @@ var (
 	// ControlPlaneKubeletLocalMode is a feature gate of kubeadm that ensures
 	// kubelets only communicate with the local apiserver.
 	ControlPlaneKubeletLocalMode = "ControlPlaneKubeletLocalMode"
+
+	// minKubernetesVersionEtcdLearnerMode is the min version from which
+	// the EtcdLearnerMode kubeadm feature gate is available.
+	minKubernetesVersionEtcdLearnerMode = semver.MustParse("1.27.0")
+
+	// EtcdLearnerMode is a feature gate of kubeadm that ensures
+	// new local etcd members join the etcd cluster as learners.
+	EtcdLearnerMode = "EtcdLearnerMode"
 )
 
+// ControlPlaneTaint is the taint applied by kubeadm to control plane nodes by default.
+var ControlPlaneTaint = corev1.Taint{
+	Key:    "node-role.kubernetes.io/control-plane",
+	Effect: corev1.TaintEffectNoSchedule,
+}
+
 // MandatoryMachineReadinessGates are readinessGates KCP enforces to be set on machine it owns.
 var MandatoryMachineReadinessGates = []clusterv1.MachineReadinessGate{
 	{ConditionType: controlplanev1.KubeadmControlPlaneMachineAPIServerPodHealthyCondition},
@@ -95,19 +111,30 @@ func ComputeDesiredMachine(kcp *controlplanev1.KubeadmControlPlane, cluster *clu
 				return nil, errors.New("failed to compute desired Machine: cannot generate Machine name: {{ .random }} is missing in machineNaming.template")
 			}
 		}
-		generatedMachineName, err := topologynames.KCPMachineNameGenerator(nameTemplate, cluster.Name, kcp.Name).GenerateName()
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to compute desired Machine: failed to generate Machine name")
-		}
-		machineName = generatedMachineName
-		version = kcp.Spec.Version
 
 		// In case this machine is being created as a consequence of a remediation, then add an annotation
-		// tracking remediating data.
+		// tracking remediating data and expose the name of the remediated Machine to the name template.
 		// NOTE: This is required in order to track remediation retries.
+		var predecessorName string
 		if remediationData, ok := kcp.Annotations[controlplanev1.RemediationInProgressAnnotation]; ok {
 			annotations[controlplanev1.RemediationForAnnotation] = remediationData
+
+			// NOTE: Only the machine field of the RemediationData stored in the annotation is relevant here.
+			predecessor := struct {
+				Machine string `json:"machine"`
+			}{}
+			if err := json.Unmarshal([]byte(remediationData), &predecessor); err != nil {
+				return nil, errors.Wrapf(err, "failed to compute desired Machine: failed to unmarshal value of %s annotation", controlplanev1.RemediationInProgressAnnotation)
+			}
+			predecessorName = predecessor.Machine
+		}
+
+		generatedMachineName, err := topologynames.KCPMachineNameGenerator(nameTemplate, cluster.Name, kcp.Name, predecessorName).GenerateName()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to compute desired Machine: failed to generate Machine name")
 		}
+		machineName = generatedMachineName
+		version = kcp.Spec.Version
 	} else {
 		// Updating an existing machine
 		machineName = existingMachine.Name
@@ -219,11 +246,24 @@ func ComputeDesiredKubeadmConfig(kcp *controlplanev1.KubeadmControlPlane, cluste
 		spec.JoinConfiguration = bootstrapv1.JoinConfiguration{}
 	}
 
+	// Apply the taint policy to the nodeRegistration used by the Machine.
+	if isJoin {
+		ApplyTaintPolicy(&spec.JoinConfiguration.NodeRegistration, kcp.Spec.MachineTemplate.Spec.TaintPolicy)
+	} else {
+		ApplyTaintPolicy(&spec.InitConfiguration.NodeRegistration, kcp.Spec.MachineTemplate.Spec.TaintPolicy)
+	}
+
 	parsedVersion, err := semver.ParseTolerant(kcp.Spec.Version)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to compute desired KubeadmConfig: failed to parse Kubernetes version %q", kcp.Spec.Version)
 	}
-	DefaultFeatureGates(spec, parsedVersion)
+	DefaultFeatureGates(spec, parsedVersion, ptr.Deref(kcp.Spec.Etcd.JoinAsLearner, false))
+	if err := ApplyEncryptionAtRest(spec, kcp); err != nil {
+		return nil, errors.Wrap(err, "failed to compute desired KubeadmConfig")
+	}
+	if err := ApplyEndpointManager(spec, kcp, cluster, isJoin, parsedVersion); err != nil {
+		return nil, errors.Wrap(err, "failed to compute desired KubeadmConfig")
+	}
 
 	kubeadmConfig := &bootstrapv1.KubeadmConfig{
 		ObjectMeta: metav1.ObjectMeta{
@@ -241,6 +281,44 @@ func ComputeDesiredKubeadmConfig(kcp *controlplanev1.KubeadmControlPlane, cluste
 	return kubeadmConfig, nil
 }
 
+// ApplyTaintPolicy sets the taints in nodeRegistration according to the taint policy.
+// With the NoSchedule policy the control plane taint is added if taints are set, while if taints are not set
+// they are left empty, given that kubeadm applies the control plane taint by default; with the None policy
+// the control plane taint is removed, and taints are set to an empty list if they are not set to prevent kubeadm defaulting.
+func ApplyTaintPolicy(nodeRegistration *bootstrapv1.NodeRegistrationOptions, taintPolicy controlplanev1.KubeadmControlPlaneTaintPolicy) {
+	switch taintPolicy {
+	case controlplanev1.KubeadmControlPlaneTaintPolicyNoSchedule:
+		if nodeRegistration.Taints != nil && !HasControlPlaneTaint(*nodeRegistration.Taints) {
+			taints := append(append([]corev1.Taint{}, *nodeRegistration.Taints...), ControlPlaneTaint)
+			nodeRegistration.Taints = &taints
+		}
+	case controlplanev1.KubeadmControlPlaneTaintPolicyNone:
+		taints := []corev1.Taint{}
+		if nodeRegistration.Taints != nil {
+			for _, taint := range *nodeRegistration.Taints {
+				if !isControlPlaneTaint(taint) {
+					taints = append(taints, taint)
+				}
+			}
+		}
+		nodeRegistration.Taints = &taints
+	}
+}
+
+// HasControlPlaneTaint returns true if taints include the control plane taint.
+func HasControlPlaneTaint(taints []corev1.Taint) bool {
+	for _, taint := range taints {
+		if isControlPlaneTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+func isControlPlaneTaint(taint corev1.Taint) bool {
+	return taint.Key == ControlPlaneTaint.Key && taint.Effect == ControlPlaneTaint.Effect
+}
+
 // ComputeDesiredInfraMachine computes the desired InfraMachine.
 func ComputeDesiredInfraMachine(ctx context.Context, c client.Client, kcp *controlplanev1.KubeadmControlPlane, cluster *clusterv1.Cluster, name string, existingInfraMachine *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	// Create an owner reference without a controller reference because the owning controller is the machine controller
@@ -290,17 +368,26 @@ func ComputeDesiredInfraMachine(ctx context.Context, c client.Client, kcp *contr
 }
 
 // DefaultFeatureGates defaults the feature gates field.
-func DefaultFeatureGates(kubeadmConfigSpec *bootstrapv1.KubeadmConfigSpec, kubernetesVersion semver.Version) {
-	if version.Compare(kubernetesVersion, minKubernetesVersionControlPlaneKubeletLocalMode, version.WithoutPreReleases()) < 0 {
-		return
+// Note: etcdJoinAsLearner enables the EtcdLearnerMode feature gate when using local etcd.
+func DefaultFeatureGates(kubeadmConfigSpec *bootstrapv1.KubeadmConfigSpec, kubernetesVersion semver.Version, etcdJoinAsLearner bool) {
+	if version.Compare(kubernetesVersion, minKubernetesVersionControlPlaneKubeletLocalMode, version.WithoutPreReleases()) >= 0 {
+		setFeatureGateIfNotSet(kubeadmConfigSpec, ControlPlaneKubeletLocalMode)
+	}
+
+	if etcdJoinAsLearner && !kubeadmConfigSpec.ClusterConfiguration.Etcd.External.IsDefined() &&
+		version.Compare(kubernetesVersion, minKubernetesVersionEtcdLearnerMode, version.WithoutPreReleases()) >= 0 {
+		setFeatureGateIfNotSet(kubeadmConfigSpec, EtcdLearnerMode)
 	}
+}
 
+// setFeatureGateIfNotSet enables a feature gate, unless it is already explicitly set.
+func setFeatureGateIfNotSet(kubeadmConfigSpec *bootstrapv1.KubeadmConfigSpec, featureGate string) {
 	if kubeadmConfigSpec.ClusterConfiguration.FeatureGates == nil {
 		kubeadmConfigSpec.ClusterConfiguration.FeatureGates = map[string]bool{}
 	}
 
-	if _, ok := kubeadmConfigSpec.ClusterConfiguration.FeatureGates[ControlPlaneKubeletLocalMode]; !ok {
-		kubeadmConfigSpec.ClusterConfiguration.FeatureGates[ControlPlaneKubeletLocalMode] = true
+	if _, ok := kubeadmConfigSpec.ClusterConfiguration.FeatureGates[featureGate]; !ok {
+		kubeadmConfigSpec.ClusterConfiguration.FeatureGates[featureGate] = true
 	}
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/blang/semver/v4"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -535,6 +536,77 @@ func Test_ComputeDesiredMachine(t *testing.T) {
 	}
 }
 
+func Test_ComputeDesiredMachineWithPredecessor(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	nameTemplate := "{{ if .predecessor.name }}{{ .predecessor.name }}-r{{ else }}{{ .kubeadmControlPlane.name }}{{ end }}-{{ .random }}"
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        []gomegatypes.GomegaMatcher
+		wantErr     bool
+	}{
+		{
+			name: "should use the KCP name when not replacing a remediated Machine",
+			want: []gomegatypes.GomegaMatcher{
+				HavePrefix("kcp-foo-"),
+				HaveLen(len("kcp-foo-") + 5),
+			},
+		},
+		{
+			name: "should use the predecessor name when replacing a remediated Machine",
+			annotations: map[string]string{
+				controlplanev1.RemediationInProgressAnnotation: `{"machine":"kcp-foo-abcde","timestamp":"2025-01-01T00:00:00Z","retryCount":0}`,
+			},
+			want: []gomegatypes.GomegaMatcher{
+				HavePrefix("kcp-foo-abcde-r-"),
+				HaveLen(len("kcp-foo-abcde-r-") + 5),
+			},
+		},
+		{
+			name: "should return error when the remediation data is invalid",
+			annotations: map[string]string{
+				controlplanev1.RemediationInProgressAnnotation: "invalid",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			kcp := &controlplanev1.KubeadmControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "kcp-foo",
+					Namespace:   cluster.Namespace,
+					Annotations: tt.annotations,
+				},
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					Version: "v1.16.6",
+					MachineNaming: controlplanev1.MachineNamingSpec{
+						Template: nameTemplate,
+					},
+				},
+			}
+
+			desiredMachine, err := ComputeDesiredMachine(kcp, cluster, "", nil)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			for _, matcher := range tt.want {
+				g.Expect(desiredMachine.Name).To(matcher)
+			}
+		})
+	}
+}
+
 func Test_ComputeDesiredKubeadmConfig(t *testing.T) {
 	g := NewWithT(t)
 
@@ -767,6 +839,7 @@ func TestDefaultFeatureGates(t *testing.T) {
 	tests := []struct {
 		name                  string
 		kubernetesVersion     semver.Version
+		etcdJoinAsLearner     bool
 		kubeadmConfigSpec     *bootstrapv1.KubeadmConfigSpec
 		wantKubeadmConfigSpec *bootstrapv1.KubeadmConfigSpec
 	}{
@@ -871,14 +944,180 @@ func TestDefaultFeatureGates(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:              "default EtcdLearnerMode when joining etcd members as learners",
+			kubernetesVersion: semver.MustParse("1.31.0"),
+			etcdJoinAsLearner: true,
+			kubeadmConfigSpec: &bootstrapv1.KubeadmConfigSpec{},
+			wantKubeadmConfigSpec: &bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					FeatureGates: map[string]bool{
+						ControlPlaneKubeletLocalMode: true,
+						EtcdLearnerMode:              true,
+					},
+				},
+			},
+		},
+		{
+			name:              "don't default EtcdLearnerMode when joining etcd members as learners if already set to false",
+			kubernetesVersion: semver.MustParse("1.31.0"),
+			etcdJoinAsLearner: true,
+			kubeadmConfigSpec: &bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					FeatureGates: map[string]bool{
+						EtcdLearnerMode: false,
+					},
+				},
+			},
+			wantKubeadmConfigSpec: &bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					FeatureGates: map[string]bool{
+						ControlPlaneKubeletLocalMode: true,
+						EtcdLearnerMode:              false,
+					},
+				},
+			},
+		},
+		{
+			name:                  "don't default EtcdLearnerMode when joining etcd members as learners for 1.26",
+			kubernetesVersion:     semver.MustParse("1.26.0"),
+			etcdJoinAsLearner:     true,
+			kubeadmConfigSpec:     &bootstrapv1.KubeadmConfigSpec{},
+			wantKubeadmConfigSpec: &bootstrapv1.KubeadmConfigSpec{},
+		},
+		{
+			name:              "don't default EtcdLearnerMode when joining etcd members as learners with external etcd",
+			kubernetesVersion: semver.MustParse("1.30.0"),
+			etcdJoinAsLearner: true,
+			kubeadmConfigSpec: &bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					Etcd: bootstrapv1.Etcd{
+						External: bootstrapv1.ExternalEtcd{
+							Endpoints: []string{"https://etcd:2379"},
+						},
+					},
+				},
+			},
+			wantKubeadmConfigSpec: &bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					Etcd: bootstrapv1.Etcd{
+						External: bootstrapv1.ExternalEtcd{
+							Endpoints: []string{"https://etcd:2379"},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			DefaultFeatureGates(tt.kubeadmConfigSpec, tt.kubernetesVersion)
+			DefaultFeatureGates(tt.kubeadmConfigSpec, tt.kubernetesVersion, tt.etcdJoinAsLearner)
 			g.Expect(tt.wantKubeadmConfigSpec).Should(BeComparableTo(tt.kubeadmConfigSpec))
 		})
 	}
 }
+
+func TestApplyTaintPolicy(t *testing.T) {
+	otherTaint := corev1.Taint{Key: "example.com/foo", Effect: corev1.TaintEffectNoSchedule}
+
+	tests := []struct {
+		name        string
+		taintPolicy controlplanev1.KubeadmControlPlaneTaintPolicy
+		taints      *[]corev1.Taint
+		wantTaints  *[]corev1.Taint
+	}{
+		{
+			name:        "no taint policy, taints not set",
+			taintPolicy: "",
+			taints:      nil,
+			wantTaints:  nil,
+		},
+		{
+			name:        "no taint policy, taints set",
+			taintPolicy: "",
+			taints:      &[]corev1.Taint{otherTaint},
+			wantTaints:  &[]corev1.Taint{otherTaint},
+		},
+		{
+			name:        "NoSchedule taint policy, taints not set (kubeadm applies the control plane taint by default)",
+			taintPolicy: controlplanev1.KubeadmControlPlaneTaintPolicyNoSchedule,
+			taints:      nil,
+			wantTaints:  nil,
+		},
+		{
+			name:        "NoSchedule taint policy, taints set without the control plane taint",
+			taintPolicy: controlplanev1.KubeadmControlPlaneTaintPolicyNoSchedule,
+			taints:      &[]corev1.Taint{otherTaint},
+			wantTaints:  &[]corev1.Taint{otherTaint, ControlPlaneTaint},
+		},
+		{
+			name:        "NoSchedule taint policy, taints set with the control plane taint",
+			taintPolicy: controlplanev1.KubeadmControlPlaneTaintPolicyNoSchedule,
+			taints:      &[]corev1.Taint{ControlPlaneTaint, otherTaint},
+			wantTaints:  &[]corev1.Taint{ControlPlaneTaint, otherTaint},
+		},
+		{
+			name:        "None taint policy, taints not set",
+			taintPolicy: controlplanev1.KubeadmControlPlaneTaintPolicyNone,
+			taints:      nil,
+			wantTaints:  &[]corev1.Taint{},
+		},
+		{
+			name:        "None taint policy, taints set with the control plane taint",
+			taintPolicy: controlplanev1.KubeadmControlPlaneTaintPolicyNone,
+			taints:      &[]corev1.Taint{otherTaint, ControlPlaneTaint},
+			wantTaints:  &[]corev1.Taint{otherTaint},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			nodeRegistration := &bootstrapv1.NodeRegistrationOptions{Taints: tt.taints}
+			ApplyTaintPolicy(nodeRegistration, tt.taintPolicy)
+			g.Expect(nodeRegistration.Taints).To(Equal(tt.wantTaints))
+		})
+	}
+}
+
+func Test_ComputeDesiredKubeadmConfigWithTaintPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kcp-foo",
+			Namespace: cluster.Namespace,
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			MachineTemplate: controlplanev1.KubeadmControlPlaneMachineTemplate{
+				Spec: controlplanev1.KubeadmControlPlaneMachineTemplateSpec{
+					TaintPolicy: controlplanev1.KubeadmControlPlaneTaintPolicyNone,
+				},
+			},
+			Version: "v1.31.0",
+		},
+	}
+
+	// The taint policy is applied only to the configuration used by the Machine, so the other configuration stays empty.
+	kubeadmConfig, err := ComputeDesiredKubeadmConfig(kcp, cluster, true, "machine-1", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(kubeadmConfig.Spec.JoinConfiguration.NodeRegistration.Taints).To(Equal(&[]corev1.Taint{}))
+	g.Expect(kubeadmConfig.Spec.InitConfiguration.IsDefined()).To(BeFalse())
+
+	kubeadmConfig, err = ComputeDesiredKubeadmConfig(kcp, cluster, false, "machine-1", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(kubeadmConfig.Spec.InitConfiguration.NodeRegistration.Taints).To(Equal(&[]corev1.Taint{}))
+	g.Expect(kubeadmConfig.Spec.JoinConfiguration.IsDefined()).To(BeFalse())
+
+	// The KubeadmControlPlane must not be modified.
+	g.Expect(kcp.Spec.KubeadmConfigSpec.JoinConfiguration.NodeRegistration.Taints).To(BeNil())
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package desiredstate
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/util/hash"
+)
+
+const (
+	// EncryptionConfigurationSecretKey is the key of the EncryptionConfiguration in the Secret generated by KCP.
+	EncryptionConfigurationSecretKey = "encryption-configuration.yaml"
+
+	// encryptionConfigurationDir is the directory of the EncryptionConfiguration on control plane Machines.
+	encryptionConfigurationDir = "/etc/kubernetes/encryption"
+
+	// encryptionConfigurationVolumeName is the name of the API server volume with the EncryptionConfiguration.
+	encryptionConfigurationVolumeName = "encryption-configuration"
+
+	// EncryptionProviderConfigArg is the API server flag to set the path of the EncryptionConfiguration.
+	EncryptionProviderConfigArg = "encryption-provider-config"
+)
+
+// encryptionConfigurationPath is the path of the EncryptionConfiguration on control plane Machines.
+var encryptionConfigurationPath = encryptionConfigurationDir + "/" + EncryptionConfigurationSecretKey
+
+// defaultEncryptedResources are the resources encrypted if encryptionAtRest.resources is not set.
+var defaultEncryptedResources = []string{"secrets"}
+
+// EncryptedResources returns the resources encrypted by the EncryptionConfiguration of a KubeadmControlPlane.
+func EncryptedResources(kcp *controlplanev1.KubeadmControlPlane) []string {
+	if len(kcp.Spec.EncryptionAtRest.Resources) == 0 {
+		return defaultEncryptedResources
+	}
+	return kcp.Spec.EncryptionAtRest.Resources
+}
+
+// EncryptionKeys returns the keys of a KubeadmControlPlane in the order they must be listed in the EncryptionConfiguration,
+// i.e. the first key is the key used to encrypt resources.
+// While a key rotation is in progress, resources are encrypted with the current write key until all the control plane
+// Machines can decrypt resources with the new key, i.e. until status.encryptionAtRest.pendingWriteKeyName is set.
+func EncryptionKeys(kcp *controlplanev1.KubeadmControlPlane) []controlplanev1.KubeadmControlPlaneEncryptionKey {
+	keys := kcp.Spec.EncryptionAtRest.Keys
+	if len(keys) == 0 || kcp.Status.EncryptionAtRest == nil {
+		return keys
+	}
+
+	writeKeyName := kcp.Status.EncryptionAtRest.WriteKeyName
+	if writeKeyName == "" || writeKeyName == keys[0].Name || kcp.Status.EncryptionAtRest.PendingWriteKeyName == keys[0].Name {
+		return keys
+	}
+	for i, key := range keys {
+		if key.Name != writeKeyName {
+			continue
+		}
+		ordered := append([]controlplanev1.KubeadmControlPlaneEncryptionKey{key}, keys[:i]...)
+		return append(ordered, keys[i+1:]...)
+	}
+	// The current write key has been removed from the spec, resources can only be encrypted with the new key.
+	return keys
+}
+
+// EncryptionConfigurationSecretName returns the name of the Secret with the EncryptionConfiguration of a KubeadmControlPlane.
+// The name changes whenever the EncryptionConfiguration changes, so control plane Machines are rolled out to pick up the change.
+func EncryptionConfigurationSecretName(kcp *controlplanev1.KubeadmControlPlane) (string, error) {
+	configurationHash, err := hash.Compute(struct {
+		Resources []string
+		Provider  controlplanev1.KubeadmControlPlaneEncryptionProvider
+		Keys      []controlplanev1.KubeadmControlPlaneEncryptionKey
+	}{
+		Resources: EncryptedResources(kcp),
+		Provider:  kcp.Spec.EncryptionAtRest.Provider,
+		Keys:      EncryptionKeys(kcp),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to compute the name of the EncryptionConfiguration Secret")
+	}
+	return fmt.Sprintf("%s-encryption-%s", kcp.Name, rand.SafeEncodeString(fmt.Sprint(configurationHash))), nil
+}
+
+// ApplyEncryptionAtRest adds the EncryptionConfiguration file and the corresponding API server flag and volume
+// to a KubeadmConfigSpec, if encryption at rest is configured for the KubeadmControlPlane.
+func ApplyEncryptionAtRest(kubeadmConfigSpec *bootstrapv1.KubeadmConfigSpec, kcp *controlplanev1.KubeadmControlPlane) error {
+	if len(kcp.Spec.EncryptionAtRest.Keys) == 0 {
+		return nil
+	}
+
+	secretName, err := EncryptionConfigurationSecretName(kcp)
+	if err != nil {
+		return err
+	}
+	kubeadmConfigSpec.Files = append(kubeadmConfigSpec.Files, bootstrapv1.File{
+		Path:        encryptionConfigurationPath,
+		Owner:       "root:root",
+		Permissions: "0600",
+		ContentFrom: bootstrapv1.FileSource{
+			Secret: bootstrapv1.SecretFileSource{
+				Name: secretName,
+				Key:  EncryptionConfigurationSecretKey,
+			},
+		},
+	})
+	ApplyEncryptionAtRestToAPIServer(&kubeadmConfigSpec.ClusterConfiguration.APIServer, kcp)
+	return nil
+}
+
+// ApplyEncryptionAtRestToAPIServer adds the API server flag and volume for the EncryptionConfiguration,
+// if encryption at rest is configured for the KubeadmControlPlane.
+// NOTE: The flag and the volume do not change across key rotations, so they can be safely stored in the kubeadm-config ConfigMap.
+func ApplyEncryptionAtRestToAPIServer(apiServer *bootstrapv1.APIServer, kcp *controlplanev1.KubeadmControlPlane) {
+	if len(kcp.Spec.EncryptionAtRest.Keys) == 0 {
+		return
+	}
+
+	hasArg := false
+	for _, arg := range apiServer.ExtraArgs {
+		if arg.Name == EncryptionProviderConfigArg {
+			hasArg = true
+			break
+		}
+	}
+	if !hasArg {
+		apiServer.ExtraArgs = append(apiServer.ExtraArgs, bootstrapv1.Arg{
+			Name:  EncryptionProviderConfigArg,
+			Value: ptr.To(encryptionConfigurationPath),
+		})
+	}
+
+	for _, volume := range apiServer.ExtraVolumes {
+		if volume.Name == encryptionConfigurationVolumeName {
+			return
+		}
+	}
+	apiServer.ExtraVolumes = append(apiServer.ExtraVolumes, bootstrapv1.HostPathMount{
+		Name:      encryptionConfigurationVolumeName,
+		HostPath:  encryptionConfigurationDir,
+		MountPath: encryptionConfigurationDir,
+		ReadOnly:  ptr.To(true),
+		PathType:  corev1.HostPathDirectoryOrCreate,
+	})
+}
+
+// ComputeEncryptionConfiguration computes the EncryptionConfiguration of a KubeadmControlPlane, given the content
+// of the keys by key name.
+// The identity provider is always added as the last provider, so resources which are not encrypted yet, e.g. when
+// encryption at rest is enabled on an existing cluster, can still be read.
+func ComputeEncryptionConfiguration(kcp *controlplanev1.KubeadmControlPlane, keyData map[string][]byte) ([]byte, error) {
+	provider := kcp.Spec.EncryptionAtRest.Provider
+
+	keys := []apiserverv1.Key{}
+	for _, key := range EncryptionKeys(kcp) {
+		data, ok := keyData[key.Name]
+		if !ok {
+			return nil, errors.Errorf("failed to compute EncryptionConfiguration: content of key %q not found", key.Name)
+		}
+		if err := validateEncryptionKey(provider, data); err != nil {
+			return nil, errors.Wrapf(err, "failed to compute EncryptionConfiguration: invalid key %q", key.Name)
+		}
+		keys = append(keys, apiserverv1.Key{
+			Name:   key.Name,
+			Secret: base64.StdEncoding.EncodeToString(data),
+		})
+	}
+
+	providerConfiguration := apiserverv1.ProviderConfiguration{}
+	switch provider {
+	case controlplanev1.KubeadmControlPlaneEncryptionProviderAESCBC:
+		providerConfiguration.AESCBC = &apiserverv1.AESConfiguration{Keys: keys}
+	case controlplanev1.KubeadmControlPlaneEncryptionProviderAESGCM:
+		providerConfiguration.AESGCM = &apiserverv1.AESConfiguration{Keys: keys}
+	case controlplanev1.KubeadmControlPlaneEncryptionProviderSecretbox:
+		providerConfiguration.Secretbox = &apiserverv1.SecretboxConfiguration{Keys: keys}
+	default:
+		return nil, errors.Errorf("failed to compute EncryptionConfiguration: unknown provider %q", provider)
+	}
+
+	configuration := &apiserverv1.EncryptionConfiguration{
+		Resources: []apiserverv1.ResourceConfiguration{
+			{
+				Resources: EncryptedResources(kcp),
+				Providers: []apiserverv1.ProviderConfiguration{
+					providerConfiguration,
+					{Identity: &apiserverv1.IdentityConfiguration{}},
+				},
+			},
+		},
+	}
+	configuration.APIVersion = apiserverv1.SchemeGroupVersion.String()
+	configuration.Kind = "EncryptionConfiguration"
+
+	data, err := yaml.Marshal(configuration)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute EncryptionConfiguration")
+	}
+	return data, nil
+}
+
+// validateEncryptionKey validates the length of a key for the given provider.
+func validateEncryptionKey(provider controlplanev1.KubeadmControlPlaneEncryptionProvider, key []byte) error {
+	switch provider {
+	case controlplanev1.KubeadmControlPlaneEncryptionProviderAESCBC, controlplanev1.KubeadmControlPlaneEncryptionProviderAESGCM:
+		if len(key) != 16 && len(key) != 24 && len(key) != 32 {
+			return errors.Errorf("%s keys must be 16, 24 or 32 bytes long, got %d bytes", provider, len(key))
+		}
+	case controlplanev1.KubeadmControlPlaneEncryptionProviderSecretbox:
+		if len(key) != 32 {
+			return errors.Errorf("%s keys must be 32 bytes long, got %d bytes", provider, len(key))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package desiredstate
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+)
+
+func encryptionKey(name string) controlplanev1.KubeadmControlPlaneEncryptionKey {
+	return controlplanev1.KubeadmControlPlaneEncryptionKey{
+		Name:   name,
+		Secret: controlplanev1.KubeadmControlPlaneEncryptionKeySecretReference{Name: "keys", Key: name},
+	}
+}
+
+func TestEncryptionKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []controlplanev1.KubeadmControlPlaneEncryptionKey
+		status   *controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus
+		expected []string
+	}{
+		{
+			name:     "Keys in spec order if encryption at rest is being enabled",
+			keys:     []controlplanev1.KubeadmControlPlaneEncryptionKey{encryptionKey("key1")},
+			expected: []string{"key1"},
+		},
+		{
+			name:     "Keys in spec order if the first key is the write key",
+			keys:     []controlplanev1.KubeadmControlPlaneEncryptionKey{encryptionKey("key2"), encryptionKey("key1")},
+			status:   &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key2"},
+			expected: []string{"key2", "key1"},
+		},
+		{
+			name:     "Write key first while a key rotation is starting",
+			keys:     []controlplanev1.KubeadmControlPlaneEncryptionKey{encryptionKey("key3"), encryptionKey("key1"), encryptionKey("key2")},
+			status:   &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key2"},
+			expected: []string{"key2", "key3", "key1"},
+		},
+		{
+			name:     "Keys in spec order once the new key is the pending write key",
+			keys:     []controlplanev1.KubeadmControlPlaneEncryptionKey{encryptionKey("key3"), encryptionKey("key1"), encryptionKey("key2")},
+			status:   &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key2", PendingWriteKeyName: "key3"},
+			expected: []string{"key3", "key1", "key2"},
+		},
+		{
+			name:     "Keys in spec order if the write key has been removed",
+			keys:     []controlplanev1.KubeadmControlPlaneEncryptionKey{encryptionKey("key3")},
+			status:   &controlplanev1.KubeadmControlPlaneEncryptionAtRestStatus{WriteKeyName: "key2"},
+			expected: []string{"key3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			kcp := &controlplanev1.KubeadmControlPlane{
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					EncryptionAtRest: controlplanev1.KubeadmControlPlaneEncryptionAtRestSpec{Keys: tt.keys},
+				},
+				Status: controlplanev1.KubeadmControlPlaneStatus{EncryptionAtRest: tt.status},
+			}
+
+			names := []string{}
+			for _, key := range EncryptionKeys(kcp) {
+				names = append(names, key.Name)
+			}
+			g.Expect(names).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestEncryptionConfigurationSecretName(t *testing.T) {
+	g := NewWithT(t)
+
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "kcp"},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			EncryptionAtRest: controlplanev1.KubeadmControlPlaneEncryptionAtRestSpec{
+				Provider: controlplanev1.KubeadmControlPlaneEncryptionProviderAESCBC,
+				Keys:     []controlplanev1.KubeadmControlPlaneEncryptionKey{encryptionKey("key1")},
+			},
+		},
+	}
+	name, err := EncryptionConfigurationSecretName(kcp)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(name).To(HavePrefix("kcp-encryption-"))
+
+	// The name is stable.
+	sameName, err := EncryptionConfigurationSecretName(kcp.DeepCopy())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(sameName).To(Equal(name))
+
+	// The name changes when keys change.
+	kcp.Spec.EncryptionAtRest.Keys = append([]controlplanev1.KubeadmControlPlaneEncryptionKey{encryptionKey("key2")}, kcp.Spec.EncryptionAtRest.Keys...)
+	newName, err := EncryptionConfigurationSecretName(kcp)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(newName).ToNot(Equal(name))
+}
+
+func TestApplyEncryptionAtRest(t *testing.T) {
+	t.Run("No-op if encryption at rest is not configured", func(t *testing.T) {
+		g := NewWithT(t)
+
+		spec := &bootstrapv1.KubeadmConfigSpec{}
+		g.Expect(ApplyEncryptionAtRest(spec, &controlplanev1.KubeadmControlPlane{})).To(Succeed())
+		g.Expect(spec).To(Equal(&bootstrapv1.KubeadmConfigSpec{}))
+	})
+	t.Run("Adds file, API server flag and volume", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := &controlplanev1.KubeadmControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "kcp"},
+			Spec: controlplanev1.KubeadmControlPlaneSpec{
+				EncryptionAtRest: controlplanev1.KubeadmControlPlaneEncryptionAtRestSpec{
+					Provider: controlplanev1.KubeadmControlPlaneEncryptionProviderAESCBC,
+					Keys:     []controlplanev1.KubeadmControlPlaneEncryptionKey{encryptionKey("key1")},
+				},
+			},
+		}
+		secretName, err := EncryptionConfigurationSecretName(kcp)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		spec := &bootstrapv1.KubeadmConfigSpec{}
+		g.Expect(ApplyEncryptionAtRest(spec, kcp)).To(Succeed())
+
+		g.Expect(spec.Files).To(ConsistOf(bootstrapv1.File{
+			Path:        "/etc/kubernetes/encryption/encryption-configuration.yaml",
+			Owner:       "root:root",
+			Permissions: "0600",
+			ContentFrom: bootstrapv1.FileSource{
+				Secret: bootstrapv1.SecretFileSource{Name: secretName, Key: EncryptionConfigurationSecretKey},
+			},
+		}))
+		g.Expect(spec.ClusterConfiguration.APIServer.ExtraArgs).To(ConsistOf(bootstrapv1.Arg{
+			Name:  EncryptionProviderConfigArg,
+			Value: ptr.To("/etc/kubernetes/encryption/encryption-configuration.yaml"),
+		}))
+		g.Expect(spec.ClusterConfiguration.APIServer.ExtraVolumes).To(HaveLen(1))
+		g.Expect(spec.ClusterConfiguration.APIServer.ExtraVolumes[0].HostPath).To(Equal("/etc/kubernetes/encryption"))
+		g.Expect(spec.ClusterConfiguration.APIServer.ExtraVolumes[0].ReadOnly).To(Equal(ptr.To(true)))
+
+		// Applying to the API server again does not add duplicates.
+		ApplyEncryptionAtRestToAPIServer(&spec.ClusterConfiguration.APIServer, kcp)
+		g.Expect(spec.ClusterConfiguration.APIServer.ExtraArgs).To(HaveLen(1))
+		g.Expect(spec.ClusterConfiguration.APIServer.ExtraVolumes).To(HaveLen(1))
+	})
+}
+
+func TestComputeEncryptionConfiguration(t *testing.T) {
+	key1 := bytes.Repeat([]byte("1"), 32)
+	key2 := bytes.Repeat([]byte("2"), 32)
+
+	tests := []struct {
+		name     string
+		provider controlplanev1.KubeadmControlPlaneEncryptionProvider
+		keyData  map[string][]byte
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "aescbc",
+			provider: controlplanev1.KubeadmControlPlaneEncryptionProviderAESCBC,
+			keyData:  map[string][]byte{"key1": key1, "key2": key2},
+			expected: `apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+- providers:
+  - aescbc:
+      keys:
+      - name: key2
+        secret: MjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjI=
+      - name: key1
+        secret: MTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTE=
+  - identity: {}
+  resources:
+  - secrets
+`,
+		},
+		{
+			name:     "secretbox",
+			provider: controlplanev1.KubeadmControlPlaneEncryptionProviderSecretbox,
+			keyData:  map[string][]byte{"key1": key1, "key2": key2},
+			expected: `apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+- providers:
+  - secretbox:
+      keys:
+      - name: key2
+        secret: MjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjI=
+      - name: key1
+        secret: MTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTE=
+  - identity: {}
+  resources:
+  - secrets
+`,
+		},
+		{
+			name:     "Fails if a key is missing",
+			provider: controlplanev1.KubeadmControlPlaneEncryptionProviderAESCBC,
+			keyData:  map[string][]byte{"key1": key1},
+			wantErr:  true,
+		},
+		{
+			name:     "Fails if a key has an invalid length",
+			provider: controlplanev1.KubeadmControlPlaneEncryptionProviderSecretbox,
+			keyData:  map[string][]byte{"key1": key1, "key2": key2[:16]},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			kcp := &controlplanev1.KubeadmControlPlane{
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					EncryptionAtRest: controlplanev1.KubeadmControlPlaneEncryptionAtRestSpec{
+						Provider: tt.provider,
+						Keys:     []controlplanev1.KubeadmControlPlaneEncryptionKey{encryptionKey("key2"), encryptionKey("key1")},
+					},
+				},
+			}
+
+			data, err := ComputeEncryptionConfiguration(kcp, tt.keyData)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(tt.expected))
+		})
+	}
+}
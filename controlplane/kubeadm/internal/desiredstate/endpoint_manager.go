@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package desiredstate
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/blang/semver/v4"
+	"github.com/pkg/errors"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/version"
+)
+
+const (
+	// staticPodManifestsDir is the directory of the static pod manifests on control plane Machines.
+	staticPodManifestsDir = "/etc/kubernetes/manifests"
+
+	// adminKubeconfigPath is the path of the kubeconfig file generated by kubeadm with admin credentials.
+	adminKubeconfigPath = "/etc/kubernetes/admin.conf"
+
+	// superAdminKubeconfigPath is the path of the kubeconfig file generated by kubeadm init with credentials
+	// bypassing RBAC, for Kubernetes versions >= v1.29.
+	superAdminKubeconfigPath = "/etc/kubernetes/super-admin.conf"
+)
+
+// minKubernetesVersionSuperAdminKubeconfig is the min version from which kubeadm init generates the super-admin.conf
+// kubeconfig file, and the credentials in admin.conf are authorized via RBAC only after the control plane is initialized.
+var minKubernetesVersionSuperAdminKubeconfig = semver.MustParse("1.29.0")
+
+// EndpointManagerManifestPath returns the path of the static pod manifest of the endpoint manager of a KubeadmControlPlane.
+func EndpointManagerManifestPath(staticPod controlplanev1.KubeadmControlPlaneEndpointManagerStaticPod) string {
+	return fmt.Sprintf("%s/%s.yaml", staticPodManifestsDir, staticPod.Name)
+}
+
+// EndpointManagerKubeconfigPath returns the path of the kubeconfig file the endpoint manager can use on a control plane Machine.
+// The first control plane Machine must use super-admin.conf for Kubernetes versions >= v1.29, given that kubeadm init
+// waits for the API server to be reachable through the control plane endpoint before authorizing the credentials in admin.conf.
+func EndpointManagerKubeconfigPath(isJoin bool, kubernetesVersion semver.Version) string {
+	if !isJoin && version.Compare(kubernetesVersion, minKubernetesVersionSuperAdminKubeconfig, version.WithoutPreReleases()) >= 0 {
+		return superAdminKubeconfigPath
+	}
+	return adminKubeconfigPath
+}
+
+// RenderEndpointManagerManifest renders the template of the static pod manifest of the endpoint manager.
+func RenderEndpointManagerManifest(manifestTemplate string, controlPlaneEndpoint clusterv1.APIEndpoint, kubeconfigPath string) (string, error) {
+	tpl, err := template.New("endpoint manager").Option("missingkey=error").Parse(manifestTemplate)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse endpoint manager template")
+	}
+
+	data := map[string]interface{}{
+		"controlPlaneEndpoint": map[string]interface{}{
+			"host": controlPlaneEndpoint.Host,
+			"port": controlPlaneEndpoint.Port,
+		},
+		"kubeconfigPath": kubeconfigPath,
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return "", errors.Wrap(err, "failed to render endpoint manager template")
+	}
+	return buf.String(), nil
+}
+
+// ApplyEndpointManager adds the static pod manifest of the endpoint manager to a KubeadmConfigSpec,
+// if an endpoint manager is configured for the KubeadmControlPlane.
+func ApplyEndpointManager(kubeadmConfigSpec *bootstrapv1.KubeadmConfigSpec, kcp *controlplanev1.KubeadmControlPlane, cluster *clusterv1.Cluster, isJoin bool, kubernetesVersion semver.Version) error {
+	staticPod := kcp.Spec.EndpointManager.StaticPod
+	if staticPod.Name == "" {
+		return nil
+	}
+
+	manifest, err := RenderEndpointManagerManifest(staticPod.Template, cluster.Spec.ControlPlaneEndpoint, EndpointManagerKubeconfigPath(isJoin, kubernetesVersion))
+	if err != nil {
+		return err
+	}
+	kubeadmConfigSpec.Files = append(kubeadmConfigSpec.Files, bootstrapv1.File{
+		Path:        EndpointManagerManifestPath(staticPod),
+		Owner:       "root:root",
+		Permissions: "0600",
+		Content:     manifest,
+	})
+	return nil
+}
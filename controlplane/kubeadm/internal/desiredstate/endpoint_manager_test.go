@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package desiredstate
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+	. "github.com/onsi/gomega"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const endpointManagerTemplate = `apiVersion: v1
+kind: Pod
+metadata:
+  name: kube-vip
+  namespace: kube-system
+spec:
+  containers:
+  - name: kube-vip
+    args: ["manager"]
+    env:
+    - name: address
+      value: "{{ .controlPlaneEndpoint.host }}"
+    - name: port
+      value: "{{ .controlPlaneEndpoint.port }}"
+    volumeMounts:
+    - mountPath: /etc/kubernetes/admin.conf
+      name: kubeconfig
+  hostNetwork: true
+  volumes:
+  - hostPath:
+      path: {{ .kubeconfigPath }}
+    name: kubeconfig
+`
+
+func TestEndpointManagerKubeconfigPath(t *testing.T) {
+	tests := []struct {
+		name              string
+		isJoin            bool
+		kubernetesVersion semver.Version
+		expected          string
+	}{
+		{
+			name:              "super-admin.conf for the first control plane Machine with Kubernetes >= v1.29",
+			isJoin:            false,
+			kubernetesVersion: semver.MustParse("1.29.0"),
+			expected:          "/etc/kubernetes/super-admin.conf",
+		},
+		{
+			name:              "super-admin.conf for the first control plane Machine with a pre-release of Kubernetes v1.29",
+			isJoin:            false,
+			kubernetesVersion: semver.MustParse("1.29.0-rc.0"),
+			expected:          "/etc/kubernetes/super-admin.conf",
+		},
+		{
+			name:              "admin.conf for the first control plane Machine with Kubernetes < v1.29",
+			isJoin:            false,
+			kubernetesVersion: semver.MustParse("1.28.5"),
+			expected:          "/etc/kubernetes/admin.conf",
+		},
+		{
+			name:              "admin.conf for joining control plane Machines",
+			isJoin:            true,
+			kubernetesVersion: semver.MustParse("1.33.0"),
+			expected:          "/etc/kubernetes/admin.conf",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(EndpointManagerKubeconfigPath(tt.isJoin, tt.kubernetesVersion)).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestRenderEndpointManagerManifest(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:     "Renders all the variables",
+			template: "{{ .controlPlaneEndpoint.host }}:{{ .controlPlaneEndpoint.port }} {{ .kubeconfigPath }}",
+			expected: "10.0.0.100:6443 /etc/kubernetes/admin.conf",
+		},
+		{
+			name:     "Renders a template without variables",
+			template: "kind: Pod",
+			expected: "kind: Pod",
+		},
+		{
+			name:        "Fails with an invalid template",
+			template:    "{{ .controlPlaneEndpoint.host ",
+			expectedErr: true,
+		},
+		{
+			name:        "Fails with an unknown variable",
+			template:    "{{ .cluster.name }}",
+			expectedErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			manifest, err := RenderEndpointManagerManifest(tt.template, clusterv1.APIEndpoint{Host: "10.0.0.100", Port: 6443}, "/etc/kubernetes/admin.conf")
+			if tt.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(manifest).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestApplyEndpointManager(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		Spec: clusterv1.ClusterSpec{
+			ControlPlaneEndpoint: clusterv1.APIEndpoint{Host: "10.0.0.100", Port: 6443},
+		},
+	}
+
+	t.Run("No changes if the endpoint manager is not configured", func(t *testing.T) {
+		g := NewWithT(t)
+
+		spec := &bootstrapv1.KubeadmConfigSpec{}
+		g.Expect(ApplyEndpointManager(spec, &controlplanev1.KubeadmControlPlane{}, cluster, false, semver.MustParse("1.33.0"))).To(Succeed())
+		g.Expect(spec.Files).To(BeEmpty())
+	})
+
+	t.Run("Adds the static pod manifest", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := &controlplanev1.KubeadmControlPlane{
+			Spec: controlplanev1.KubeadmControlPlaneSpec{
+				EndpointManager: controlplanev1.KubeadmControlPlaneEndpointManagerSpec{
+					StaticPod: controlplanev1.KubeadmControlPlaneEndpointManagerStaticPod{
+						Name:     "kube-vip",
+						Template: endpointManagerTemplate,
+					},
+				},
+			},
+		}
+		spec := &bootstrapv1.KubeadmConfigSpec{
+			Files: []bootstrapv1.File{{Path: "/etc/foo", Content: "foo"}},
+		}
+		g.Expect(ApplyEndpointManager(spec, kcp, cluster, false, semver.MustParse("1.33.0"))).To(Succeed())
+		g.Expect(spec.Files).To(HaveLen(2))
+		g.Expect(spec.Files[1].Path).To(Equal("/etc/kubernetes/manifests/kube-vip.yaml"))
+		g.Expect(spec.Files[1].Owner).To(Equal("root:root"))
+		g.Expect(spec.Files[1].Permissions).To(Equal("0600"))
+		g.Expect(spec.Files[1].Content).To(ContainSubstring(`value: "10.0.0.100"`))
+		g.Expect(spec.Files[1].Content).To(ContainSubstring(`value: "6443"`))
+		g.Expect(spec.Files[1].Content).To(ContainSubstring("path: /etc/kubernetes/super-admin.conf"))
+
+		spec = &bootstrapv1.KubeadmConfigSpec{}
+		g.Expect(ApplyEndpointManager(spec, kcp, cluster, true, semver.MustParse("1.33.0"))).To(Succeed())
+		g.Expect(spec.Files).To(HaveLen(1))
+		g.Expect(spec.Files[0].Content).To(ContainSubstring("path: /etc/kubernetes/admin.conf"))
+	})
+}
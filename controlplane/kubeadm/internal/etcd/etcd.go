@@ -19,6 +19,7 @@ package etcd
 import (
 	"context"
 	"crypto/tls"
+	"io"
 	"net"
 	"time"
 
@@ -40,10 +41,13 @@ type GRPCDial func(ctx context.Context, addr string) (net.Conn, error)
 type etcd interface {
 	AlarmList(ctx context.Context) (*clientv3.AlarmResponse, error)
 	Close() error
+	Defragment(ctx context.Context, endpoint string) (*clientv3.DefragmentResponse, error)
 	Endpoints() []string
 	MemberList(ctx context.Context, opts ...clientv3.OpOption) (*clientv3.MemberListResponse, error)
+	MemberPromote(ctx context.Context, id uint64) (*clientv3.MemberPromoteResponse, error)
 	MemberRemove(ctx context.Context, id uint64) (*clientv3.MemberRemoveResponse, error)
 	MoveLeader(ctx context.Context, id uint64) (*clientv3.MoveLeaderResponse, error)
+	Snapshot(ctx context.Context) (io.ReadCloser, error)
 	Status(ctx context.Context, endpoint string) (*clientv3.StatusResponse, error)
 }
 
@@ -83,6 +87,15 @@ const (
 // for read and write operations to etcd.
 const DefaultCallTimeout = 15 * time.Second
 
+// DefaultDefragmentTimeout represents the duration that the etcd client waits at most
+// for the defragmentation of an etcd member.
+// NOTE: Defragmentation is slower than other operations, given that it rewrites the entire database.
+const DefaultDefragmentTimeout = 5 * time.Minute
+
+// DefaultSnapshotTimeout represents the duration that the etcd client waits at most
+// for streaming a snapshot of the etcd database.
+const DefaultSnapshotTimeout = 10 * time.Minute
+
 // AlarmTypeName provides a text translation for AlarmType codes.
 var AlarmTypeName = map[AlarmType]string{
 	AlarmOK:      "NONE",
@@ -114,6 +127,22 @@ type Member struct {
 	IsLearner bool
 }
 
+// MemberStatus contains the status of the etcd member a client is connected to.
+type MemberStatus struct {
+	// ID is the ID of the member.
+	ID uint64
+
+	// IsLeader is true if the member is the leader of the etcd cluster.
+	IsLeader bool
+
+	// DBSize is the size of the database allocated on disk, in bytes.
+	DBSize int64
+
+	// DBSizeInUse is the size of the database logically in use, in bytes.
+	// The difference with DBSize is the space that can be reclaimed by defragmentation.
+	DBSizeInUse int64
+}
+
 // pbMemberToMember converts the protobuf representation of a cluster member to a Member struct.
 func pbMemberToMember(m *etcdserverpb.Member) *Member {
 	return &Member{
@@ -235,6 +264,15 @@ func (c *Client) RemoveMember(ctx context.Context, id uint64) error {
 	return errors.Wrapf(err, "failed to remove etcd member: %v", id)
 }
 
+// PromoteMember promotes a given learner member to a voting member.
+func (c *Client) PromoteMember(ctx context.Context, id uint64) error {
+	ctx, cancel := context.WithTimeoutCause(ctx, c.CallTimeout, errors.New("call timeout expired"))
+	defer cancel()
+
+	_, err := c.EtcdClient.MemberPromote(ctx, id)
+	return errors.Wrapf(err, "failed to promote etcd member: %v", id)
+}
+
 // Alarms retrieves all alarms on a cluster.
 func (c *Client) Alarms(ctx context.Context) ([]MemberAlarm, error) {
 	ctx, cancel := context.WithTimeoutCause(ctx, c.CallTimeout, errors.New("call timeout expired"))
@@ -255,3 +293,49 @@ func (c *Client) Alarms(ctx context.Context) ([]MemberAlarm, error) {
 
 	return memberAlarms, nil
 }
+
+// MemberStatus retrieves the status of the etcd member the client is connected to.
+func (c *Client) MemberStatus(ctx context.Context) (*MemberStatus, error) {
+	ctx, cancel := context.WithTimeoutCause(ctx, c.CallTimeout, errors.New("call timeout expired"))
+	defer cancel()
+
+	response, err := c.EtcdClient.Status(ctx, c.Endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get etcd status")
+	}
+
+	memberID := response.Header.GetMemberId()
+	return &MemberStatus{
+		ID:          memberID,
+		IsLeader:    response.Leader == memberID,
+		DBSize:      response.DbSize,
+		DBSizeInUse: response.DbSizeInUse,
+	}, nil
+}
+
+// Defragment defragments the database of the etcd member the client is connected to.
+// NOTE: The member does not serve requests while being defragmented.
+func (c *Client) Defragment(ctx context.Context) error {
+	ctx, cancel := context.WithTimeoutCause(ctx, DefaultDefragmentTimeout, errors.New("defragment timeout expired"))
+	defer cancel()
+
+	_, err := c.EtcdClient.Defragment(ctx, c.Endpoint)
+	return errors.Wrapf(err, "failed to defragment etcd member %s", c.Endpoint)
+}
+
+// Snapshot streams a snapshot of the etcd database to the given writer.
+func (c *Client) Snapshot(ctx context.Context, w io.Writer) error {
+	ctx, cancel := context.WithTimeoutCause(ctx, DefaultSnapshotTimeout, errors.New("snapshot timeout expired"))
+	defer cancel()
+
+	snapshot, err := c.EtcdClient.Snapshot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get etcd snapshot")
+	}
+	defer snapshot.Close()
+
+	if _, err := io.Copy(w, snapshot); err != nil {
+		return errors.Wrap(err, "failed to read etcd snapshot")
+	}
+	return nil
+}
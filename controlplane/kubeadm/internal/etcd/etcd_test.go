@@ -17,6 +17,7 @@ limitations under the License.
 package etcd
 
 import (
+	"bytes"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -61,6 +62,15 @@ func TestEtcdMembers_WithErrors(t *testing.T) {
 
 	err = client.RemoveMember(ctx, 1234)
 	g.Expect(err).To(HaveOccurred())
+
+	err = client.PromoteMember(ctx, 1234)
+	g.Expect(err).To(HaveOccurred())
+
+	err = client.Defragment(ctx)
+	g.Expect(err).To(HaveOccurred())
+
+	err = client.Snapshot(ctx, &bytes.Buffer{})
+	g.Expect(err).To(HaveOccurred())
 }
 
 func TestEtcdMembers_WithSuccess(t *testing.T) {
@@ -74,10 +84,17 @@ func TestEtcdMembers_WithSuccess(t *testing.T) {
 				{ID: 1234, Name: "foo", PeerURLs: []string{"https://1.2.3.4:2000"}},
 			},
 		},
-		MoveLeaderResponse:   &clientv3.MoveLeaderResponse{},
-		MemberRemoveResponse: &clientv3.MemberRemoveResponse{},
-		AlarmResponse:        &clientv3.AlarmResponse{},
-		StatusResponse:       &clientv3.StatusResponse{},
+		MoveLeaderResponse:    &clientv3.MoveLeaderResponse{},
+		MemberRemoveResponse:  &clientv3.MemberRemoveResponse{},
+		MemberPromoteResponse: &clientv3.MemberPromoteResponse{},
+		AlarmResponse:         &clientv3.AlarmResponse{},
+		StatusResponse: &clientv3.StatusResponse{
+			Header:      &etcdserverpb.ResponseHeader{MemberId: 1234},
+			Leader:      1234,
+			DbSize:      100,
+			DbSizeInUse: 60,
+		},
+		SnapshotResponse: []byte("snapshot"),
 	}
 
 	client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
@@ -92,4 +109,21 @@ func TestEtcdMembers_WithSuccess(t *testing.T) {
 
 	err = client.RemoveMember(ctx, 1234)
 	g.Expect(err).ToNot(HaveOccurred())
+
+	err = client.PromoteMember(ctx, 1234)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(fakeEtcdClient.PromotedMembers).To(ConsistOf(uint64(1234)))
+
+	status, err := client.MemberStatus(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(status).To(Equal(&MemberStatus{ID: 1234, IsLeader: true, DBSize: 100, DBSizeInUse: 60}))
+
+	err = client.Defragment(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(fakeEtcdClient.DefragmentedEndpoints).To(ConsistOf("https://etcd-instance:2379"))
+
+	snapshot := &bytes.Buffer{}
+	err = client.Snapshot(ctx, snapshot)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(snapshot.String()).To(Equal("snapshot"))
 }
@@ -18,21 +18,29 @@ limitations under the License.
 package fake
 
 import (
+	"bytes"
 	"context"
+	"io"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 type FakeEtcdClient struct { //nolint:revive
-	AlarmResponse        *clientv3.AlarmResponse
-	EtcdEndpoints        []string
-	MemberListResponse   *clientv3.MemberListResponse
-	MemberRemoveResponse *clientv3.MemberRemoveResponse
-	MoveLeaderResponse   *clientv3.MoveLeaderResponse
-	StatusResponse       *clientv3.StatusResponse
-	ErrorResponse        error
-	MovedLeader          uint64
-	RemovedMember        uint64
+	AlarmResponse         *clientv3.AlarmResponse
+	EtcdEndpoints         []string
+	MemberListResponse    *clientv3.MemberListResponse
+	MemberPromoteResponse *clientv3.MemberPromoteResponse
+	MemberRemoveResponse  *clientv3.MemberRemoveResponse
+	MoveLeaderResponse    *clientv3.MoveLeaderResponse
+	StatusResponse        *clientv3.StatusResponse
+	SnapshotResponse      []byte
+	ErrorResponse         error
+	MemberPromoteError    error
+	DefragmentError       error
+	DefragmentedEndpoints []string
+	MovedLeader           uint64
+	PromotedMembers       []uint64
+	RemovedMember         uint64
 }
 
 func (c *FakeEtcdClient) Endpoints() []string {
@@ -55,6 +63,13 @@ func (c *FakeEtcdClient) AlarmList(_ context.Context) (*clientv3.AlarmResponse,
 func (c *FakeEtcdClient) MemberList(_ context.Context, _ ...clientv3.OpOption) (*clientv3.MemberListResponse, error) {
 	return c.MemberListResponse, c.ErrorResponse
 }
+func (c *FakeEtcdClient) MemberPromote(_ context.Context, i uint64) (*clientv3.MemberPromoteResponse, error) {
+	if c.MemberPromoteError != nil {
+		return nil, c.MemberPromoteError
+	}
+	c.PromotedMembers = append(c.PromotedMembers, i)
+	return c.MemberPromoteResponse, c.ErrorResponse
+}
 func (c *FakeEtcdClient) MemberRemove(_ context.Context, i uint64) (*clientv3.MemberRemoveResponse, error) {
 	c.RemovedMember = i
 	return c.MemberRemoveResponse, c.ErrorResponse
@@ -62,3 +77,16 @@ func (c *FakeEtcdClient) MemberRemove(_ context.Context, i uint64) (*clientv3.Me
 func (c *FakeEtcdClient) Status(_ context.Context, _ string) (*clientv3.StatusResponse, error) {
 	return c.StatusResponse, nil
 }
+func (c *FakeEtcdClient) Defragment(_ context.Context, endpoint string) (*clientv3.DefragmentResponse, error) {
+	if c.DefragmentError != nil {
+		return nil, c.DefragmentError
+	}
+	c.DefragmentedEndpoints = append(c.DefragmentedEndpoints, endpoint)
+	return &clientv3.DefragmentResponse{}, c.ErrorResponse
+}
+func (c *FakeEtcdClient) Snapshot(_ context.Context) (io.ReadCloser, error) {
+	if c.ErrorResponse != nil {
+		return nil, c.ErrorResponse
+	}
+	return io.NopCloser(bytes.NewReader(c.SnapshotResponse)), nil
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
@@ -39,6 +41,7 @@ import (
 type UpToDateResult struct {
 	LogMessages              []string
 	ConditionMessages        []string
+	RolloutReasons           []string
 	EligibleForInPlaceUpdate bool
 	DesiredMachine           *clusterv1.Machine
 	CurrentInfraMachine      *unstructured.Unstructured
@@ -56,6 +59,7 @@ func UpToDate(
 	machine *clusterv1.Machine,
 	kcp *controlplanev1.KubeadmControlPlane,
 	reconciliationTime *metav1.Time,
+	clusterCAHash string,
 	infraMachines map[string]*unstructured.Unstructured,
 	kubeadmConfigs map[string]*bootstrapv1.KubeadmConfig,
 ) (bool, *UpToDateResult, error) {
@@ -79,6 +83,7 @@ func UpToDate(
 	if collections.ShouldRolloutBefore(reconciliationTime, kcp.Spec.Rollout.Before)(machine) {
 		res.LogMessages = append(res.LogMessages, "certificates will expire soon, rolloutBefore expired")
 		res.ConditionMessages = append(res.ConditionMessages, "Certificates will expire soon")
+		res.RolloutReasons = append(res.RolloutReasons, "spec.rollout.before")
 		res.EligibleForInPlaceUpdate = false
 	}
 
@@ -87,9 +92,21 @@ func UpToDate(
 	if collections.ShouldRolloutAfter(reconciliationTime, kcp.Spec.Rollout.After)(machine) {
 		res.LogMessages = append(res.LogMessages, "rolloutAfter expired")
 		res.ConditionMessages = append(res.ConditionMessages, "KubeadmControlPlane spec.rolloutAfter expired")
+		res.RolloutReasons = append(res.RolloutReasons, "spec.rollout.after")
 		res.EligibleForInPlaceUpdate = false
 	}
 
+	// Machines bootstrapped with cluster CA certificates different from the current ones, e.g. during a CA rotation.
+	// Note: Machines bootstrapped before CABPK started to track the cluster CA certificates hash are not considered.
+	if kubeadmConfig, ok := kubeadmConfigs[machine.Name]; ok && clusterCAHash != "" {
+		if machineCAHash, ok := kubeadmConfig.Annotations[bootstrapv1.ClusterCAHashAnnotation]; ok && machineCAHash != clusterCAHash {
+			res.LogMessages = append(res.LogMessages, "cluster CA certificates changed")
+			res.ConditionMessages = append(res.ConditionMessages, "Cluster CA certificates changed")
+			res.RolloutReasons = append(res.RolloutReasons, "cluster CA certificates")
+			res.EligibleForInPlaceUpdate = false
+		}
+	}
+
 	// Machines that do not match with KCP config.
 	// Note: matchesMachineSpec will update res with desired and current objects if necessary.
 	matches, specLogMessages, specConditionMessages, err := matchesMachineSpec(ctx, c, infraMachines, kubeadmConfigs, kcp, cluster, machine, res)
@@ -145,6 +162,7 @@ func matchesMachineSpec(
 		logMessages = append(logMessages, fmt.Sprintf("Machine version %q is not equal to KCP version %q", machine.Spec.Version, desiredMachine.Spec.Version))
 		// Note: the code computing the message for KCP's RolloutOut condition is making assumptions on the format/content of this message.
 		conditionMessages = append(conditionMessages, fmt.Sprintf("Version %s, %s required", machine.Spec.Version, desiredMachine.Spec.Version))
+		res.RolloutReasons = append(res.RolloutReasons, "spec.version")
 	}
 
 	reason, currentKubeadmConfig, desiredKubeadmConfig, matches, err := matchesKubeadmConfig(kubeadmConfigs, kcp, cluster, machine)
@@ -156,6 +174,11 @@ func matchesMachineSpec(
 	if !matches {
 		logMessages = append(logMessages, reason)
 		conditionMessages = append(conditionMessages, "KubeadmConfig is not up-to-date")
+		kubeadmConfigRolloutReasons, err := kubeadmConfigDiffPaths(kcp, cluster, machine, currentKubeadmConfig, desiredKubeadmConfig)
+		if err != nil {
+			return false, nil, nil, errors.Wrapf(err, "failed to match Machine")
+		}
+		res.RolloutReasons = append(res.RolloutReasons, kubeadmConfigRolloutReasons...)
 	}
 
 	reason, currentInfraMachine, desiredInfraMachine, matches, err := matchesInfraMachine(ctx, c, infraMachines, kcp, cluster, machine)
@@ -167,6 +190,7 @@ func matchesMachineSpec(
 	if !matches {
 		logMessages = append(logMessages, reason)
 		conditionMessages = append(conditionMessages, fmt.Sprintf("%s is not up-to-date", machine.Spec.InfrastructureRef.Kind))
+		res.RolloutReasons = append(res.RolloutReasons, "spec.machineTemplate.spec.infrastructureRef")
 	}
 
 	if len(logMessages) > 0 || len(conditionMessages) > 0 {
@@ -294,6 +318,70 @@ func matchesKubeadmConfig(
 	return "", currentKubeadmConfig, desiredKubeadmConfigWithJoin, true, nil
 }
 
+// kubeadmConfigDiffPaths returns the paths of the KubeadmControlPlane spec.kubeadmConfigSpec fields that diverged
+// from the Machine's KubeadmConfig, e.g. spec.kubeadmConfigSpec.clusterConfiguration.apiServer.extraArgs.
+// Note: The comparison is done with the same assumptions of matchesKubeadmConfig, i.e. a KubeadmConfig for init
+// is compared against a desired KubeadmConfig computed for init.
+func kubeadmConfigDiffPaths(
+	kcp *controlplanev1.KubeadmControlPlane,
+	cluster *clusterv1.Cluster,
+	machine *clusterv1.Machine,
+	currentKubeadmConfig, desiredKubeadmConfigWithJoin *bootstrapv1.KubeadmConfig,
+) ([]string, error) {
+	desiredKubeadmConfigForDiff, currentKubeadmConfigForDiff := PrepareKubeadmConfigsForDiff(desiredKubeadmConfigWithJoin, currentKubeadmConfig, true)
+	if isKubeadmConfigForInit(currentKubeadmConfig) {
+		desiredKubeadmConfigWithInit, err := desiredstate.ComputeDesiredKubeadmConfig(kcp, cluster, false, machine.Name, currentKubeadmConfig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compute KubeadmConfig diff")
+		}
+		desiredKubeadmConfigForDiff, currentKubeadmConfigForDiff = PrepareKubeadmConfigsForDiff(desiredKubeadmConfigWithInit, currentKubeadmConfig, false)
+	}
+
+	current, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&currentKubeadmConfigForDiff.Spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to compute KubeadmConfig diff")
+	}
+	desired, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&desiredKubeadmConfigForDiff.Spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to compute KubeadmConfig diff")
+	}
+
+	paths := appendDiffPaths(nil, "spec.kubeadmConfigSpec", current, desired, 3)
+	if len(paths) == 0 {
+		// Note: this should never happen, but in case the diff cannot be narrowed down to specific fields
+		// surface the entire kubeadmConfigSpec as a reason.
+		paths = append(paths, "spec.kubeadmConfigSpec")
+	}
+	return paths, nil
+}
+
+// appendDiffPaths appends to paths the path of the fields which are different between current and desired,
+// descending into nested objects for at most depth levels.
+func appendDiffPaths(paths []string, path string, current, desired map[string]interface{}, depth int) []string {
+	keys := sets.New[string]()
+	for k := range current {
+		keys.Insert(k)
+	}
+	for k := range desired {
+		keys.Insert(k)
+	}
+
+	for _, k := range sets.List(keys) {
+		if reflect.DeepEqual(current[k], desired[k]) {
+			continue
+		}
+		fieldPath := fmt.Sprintf("%s.%s", path, k)
+		currentField, currentIsObject := current[k].(map[string]interface{})
+		desiredField, desiredIsObject := desired[k].(map[string]interface{})
+		if depth > 1 && (currentIsObject || current[k] == nil) && (desiredIsObject || desired[k] == nil) {
+			paths = appendDiffPaths(paths, fieldPath, currentField, desiredField, depth-1)
+			continue
+		}
+		paths = append(paths, fieldPath)
+	}
+	return paths
+}
+
 // PrepareKubeadmConfigsForDiff cleans up all fields that are not relevant for the comparison.
 func PrepareKubeadmConfigsForDiff(desiredKubeadmConfig, currentKubeadmConfig *bootstrapv1.KubeadmConfig, convertCurrentInitConfigurationToJoinConfiguration bool) (desired, current *bootstrapv1.KubeadmConfig) {
 	// DeepCopy to ensure the passed in KubeadmConfigs are not modified.
@@ -328,7 +328,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
     },
     InitConfiguration: {NodeRegistration: {ImagePullPolicy: "IfNotPresent"}},
     JoinConfiguration: {NodeRegistration: {ImagePullPolicy: "IfNotPresent"}},
-    ... // 11 identical fields
+    ... // 15 identical fields
   }`))
 	})
 	t.Run("returns true if InitConfiguration is equal after conversion to JoinConfiguration", func(t *testing.T) {
@@ -555,7 +555,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
     },
     JoinConfiguration: {NodeRegistration: {ImagePullPolicy: "IfNotPresent"}},
     Files:             nil,
-    ... // 10 identical fields
+    ... // 14 identical fields
   }`))
 	})
 	t.Run("returns true if JoinConfiguration is equal", func(t *testing.T) {
@@ -799,7 +799,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
     },
     JoinConfiguration: {NodeRegistration: {ImagePullPolicy: "IfNotPresent"}},
     Files:             nil,
-    ... // 10 identical fields
+    ... // 14 identical fields
   }`))
 	})
 	t.Run("returns false if JoinConfiguration has other differences in ControlPlane", func(t *testing.T) {
@@ -878,7 +878,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
     },
     Files:     nil,
     DiskSetup: {},
-    ... // 9 identical fields
+    ... // 13 identical fields
   }`))
 	})
 	t.Run("returns false if JoinConfiguration is NOT equal", func(t *testing.T) {
@@ -950,7 +950,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
     },
     Files:     nil,
     DiskSetup: {},
-    ... // 9 identical fields
+    ... // 13 identical fields
   }`))
 	})
 	t.Run("returns false if JoinConfiguration is NOT equal", func(t *testing.T) {
@@ -1018,7 +1018,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
     },
     Files:     nil,
     DiskSetup: {},
-    ... // 9 identical fields
+    ... // 13 identical fields
   }`))
 	})
 	t.Run("returns true if only omittable configurations are not equal", func(t *testing.T) {
@@ -1201,7 +1201,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
 +   Files:                []v1beta2.File{{Path: "/tmp/foo"}},
     DiskSetup:            {},
     Mounts:               nil,
-    ... // 8 identical fields
+    ... // 12 identical fields
   }`))
 	})
 	t.Run("should match on labels and annotations", func(t *testing.T) {
@@ -1549,12 +1549,14 @@ func TestUpToDate(t *testing.T) {
 		name                           string
 		kcp                            *controlplanev1.KubeadmControlPlane
 		machine                        *clusterv1.Machine
+		clusterCAHash                  string
 		infraConfigs                   map[string]*unstructured.Unstructured
 		machineConfigs                 map[string]*bootstrapv1.KubeadmConfig
 		expectUptoDate                 bool
 		expectEligibleForInPlaceUpdate bool
 		expectLogMessages              []string
 		expectConditionMessages        []string
+		expectRolloutReasons           []string
 	}{
 		{
 			name:                           "machine up-to-date",
@@ -1566,6 +1568,7 @@ func TestUpToDate(t *testing.T) {
 			expectEligibleForInPlaceUpdate: false,
 			expectLogMessages:              nil,
 			expectConditionMessages:        nil,
+			expectRolloutReasons:           nil,
 		},
 		{
 			name: "certificate are expiring soon",
@@ -1581,6 +1584,7 @@ func TestUpToDate(t *testing.T) {
 			expectEligibleForInPlaceUpdate: false,
 			expectLogMessages:              []string{"certificates will expire soon, rolloutBefore expired"},
 			expectConditionMessages:        []string{"Certificates will expire soon"},
+			expectRolloutReasons:           []string{"spec.rollout.before"},
 		},
 		{
 			name: "rollout after expired",
@@ -1596,6 +1600,41 @@ func TestUpToDate(t *testing.T) {
 			expectEligibleForInPlaceUpdate: false,
 			expectLogMessages:              []string{"rolloutAfter expired"},
 			expectConditionMessages:        []string{"KubeadmControlPlane spec.rolloutAfter expired"},
+			expectRolloutReasons:           []string{"spec.rollout.after"},
+		},
+		{
+			name:          "cluster CA certificates changed",
+			kcp:           defaultKcp,
+			machine:       defaultMachine,
+			clusterCAHash: "new-ca-hash",
+			infraConfigs:  defaultInfraConfigs,
+			machineConfigs: func() map[string]*bootstrapv1.KubeadmConfig {
+				machineConfigs := map[string]*bootstrapv1.KubeadmConfig{
+					defaultMachine.Name: defaultMachineConfigs[defaultMachine.Name].DeepCopy(),
+				}
+				machineConfigs[defaultMachine.Name].Annotations = map[string]string{
+					bootstrapv1.ClusterCAHashAnnotation: "old-ca-hash",
+				}
+				return machineConfigs
+			}(),
+			expectUptoDate:                 false,
+			expectEligibleForInPlaceUpdate: false,
+			expectLogMessages:              []string{"cluster CA certificates changed"},
+			expectConditionMessages:        []string{"Cluster CA certificates changed"},
+			expectRolloutReasons:           []string{"cluster CA certificates"},
+		},
+		{
+			name:                           "cluster CA certificates not tracked on the KubeadmConfig",
+			kcp:                            defaultKcp,
+			machine:                        defaultMachine,
+			clusterCAHash:                  "new-ca-hash",
+			infraConfigs:                   defaultInfraConfigs,
+			machineConfigs:                 defaultMachineConfigs,
+			expectUptoDate:                 true,
+			expectEligibleForInPlaceUpdate: false,
+			expectLogMessages:              nil,
+			expectConditionMessages:        nil,
+			expectRolloutReasons:           nil,
 		},
 		{
 			name: "kubernetes version does not match",
@@ -1615,6 +1654,7 @@ func TestUpToDate(t *testing.T) {
 			expectEligibleForInPlaceUpdate: true,
 			expectLogMessages:              []string{"Machine version \"v1.30.0\" is not equal to KCP version \"v1.30.2\""},
 			expectConditionMessages:        []string{"Version v1.30.0, v1.30.2 required"},
+			expectRolloutReasons:           []string{"spec.version"},
 		},
 		{
 			name: "kubernetes version does not match + delete annotation",
@@ -1637,6 +1677,7 @@ func TestUpToDate(t *testing.T) {
 			expectEligibleForInPlaceUpdate: false, // Not eligible for in-place update because of delete annotation.
 			expectLogMessages:              []string{"Machine version \"v1.30.0\" is not equal to KCP version \"v1.30.2\""},
 			expectConditionMessages:        []string{"Version v1.30.0, v1.30.2 required"},
+			expectRolloutReasons:           []string{"spec.version"},
 		},
 		{
 			name: "kubernetes version does not match + remediate annotation",
@@ -1659,6 +1700,7 @@ func TestUpToDate(t *testing.T) {
 			expectEligibleForInPlaceUpdate: false, // Not eligible for in-place update because of remediate annotation.
 			expectLogMessages:              []string{"Machine version \"v1.30.0\" is not equal to KCP version \"v1.30.2\""},
 			expectConditionMessages:        []string{"Version v1.30.0, v1.30.2 required"},
+			expectRolloutReasons:           []string{"spec.version"},
 		},
 		{
 			name: "KubeadmConfig is not up-to-date",
@@ -1685,9 +1727,10 @@ func TestUpToDate(t *testing.T) {
     },
     InitConfiguration: {NodeRegistration: {ImagePullPolicy: "IfNotPresent"}},
     JoinConfiguration: {NodeRegistration: {ImagePullPolicy: "IfNotPresent"}},
-    ... // 11 identical fields
+    ... // 15 identical fields
   }`},
 			expectConditionMessages: []string{"KubeadmConfig is not up-to-date"},
+			expectRolloutReasons:    []string{"spec.kubeadmConfigSpec.clusterConfiguration.certificatesDir"},
 		},
 		{
 			name: "InfraMachine is not up-to-date",
@@ -1709,6 +1752,7 @@ func TestUpToDate(t *testing.T) {
 				"TestInfrastructureMachineTemplate.infrastructure.cluster.x-k8s.io infra-machine-template1 to " +
 				"TestInfrastructureMachineTemplate.infrastructure.cluster.x-k8s.io infra-machine-template2"},
 			expectConditionMessages: []string{"TestInfrastructureMachine is not up-to-date"},
+			expectRolloutReasons:    []string{"spec.machineTemplate.spec.infrastructureRef"},
 		},
 	}
 
@@ -1720,7 +1764,7 @@ func TestUpToDate(t *testing.T) {
 			_ = apiextensionsv1.AddToScheme(scheme)
 			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(builder.TestInfrastructureMachineTemplateCRD, infraMachineTemplate1, infraMachineTemplate2).Build()
 
-			upToDate, res, err := UpToDate(t.Context(), c, &clusterv1.Cluster{}, tt.machine, tt.kcp, &reconciliationTime, tt.infraConfigs, tt.machineConfigs)
+			upToDate, res, err := UpToDate(t.Context(), c, &clusterv1.Cluster{}, tt.machine, tt.kcp, &reconciliationTime, tt.clusterCAHash, tt.infraConfigs, tt.machineConfigs)
 			g.Expect(err).ToNot(HaveOccurred())
 			g.Expect(upToDate).To(Equal(tt.expectUptoDate))
 			g.Expect(res).ToNot(BeNil())
@@ -1734,14 +1778,51 @@ func TestUpToDate(t *testing.T) {
 			if upToDate {
 				g.Expect(res.LogMessages).To(BeEmpty())
 				g.Expect(res.ConditionMessages).To(BeEmpty())
+				g.Expect(res.RolloutReasons).To(BeEmpty())
 			} else {
 				g.Expect(res.LogMessages).To(BeComparableTo(tt.expectLogMessages))
 				g.Expect(res.ConditionMessages).To(Equal(tt.expectConditionMessages))
+				g.Expect(res.RolloutReasons).To(Equal(tt.expectRolloutReasons))
 			}
 		})
 	}
 }
 
+func TestAppendDiffPaths(t *testing.T) {
+	g := NewWithT(t)
+
+	current := map[string]interface{}{
+		"a": "foo",
+		"b": map[string]interface{}{
+			"c": "foo",
+			"d": map[string]interface{}{
+				"e": map[string]interface{}{"f": "foo"},
+			},
+		},
+		"g": []interface{}{"foo"},
+		"h": "foo",
+	}
+	desired := map[string]interface{}{
+		"a": "bar",
+		"b": map[string]interface{}{
+			"c": "foo",
+			"d": map[string]interface{}{
+				"e": map[string]interface{}{"f": "bar"},
+			},
+		},
+		"g": []interface{}{"bar"},
+		"i": map[string]interface{}{"j": "bar"},
+	}
+
+	g.Expect(appendDiffPaths(nil, "spec", current, desired, 3)).To(Equal([]string{
+		"spec.a",
+		"spec.b.d.e", // depth is limited to 3 levels.
+		"spec.g",
+		"spec.h",
+		"spec.i.j",
+	}))
+}
+
 func TestOmittableFieldsClusterConfiguration(t *testing.T) {
 	tests := []struct {
 		name string
@@ -27,10 +27,10 @@ import (
 	"github.com/coredns/corefile-migration/migration"
 	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -39,6 +39,7 @@ import (
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/desiredstate"
 	topologynames "sigs.k8s.io/cluster-api/internal/topology/names"
 	"sigs.k8s.io/cluster-api/util/container"
 	"sigs.k8s.io/cluster-api/util/secret"
@@ -189,6 +190,8 @@ func (webhook *KubeadmControlPlane) ValidateUpdate(_ context.Context, oldObj, ne
 		{spec, kubeadmConfigSpec, files},
 		{spec, kubeadmConfigSpec, "verbosity"},
 		{spec, kubeadmConfigSpec, users},
+		{spec, kubeadmConfigSpec, "trustedCertificateAuthorities"},
+		{spec, kubeadmConfigSpec, "additionalUserData"},
 		{spec, kubeadmConfigSpec, ntp},
 		{spec, kubeadmConfigSpec, ntp, "*"},
 		{spec, kubeadmConfigSpec, ignition},
@@ -197,6 +200,10 @@ func (webhook *KubeadmControlPlane) ValidateUpdate(_ context.Context, oldObj, ne
 		{spec, kubeadmConfigSpec, diskSetup, "*"},
 		{spec, kubeadmConfigSpec, "format"},
 		{spec, kubeadmConfigSpec, "mounts"},
+		{spec, kubeadmConfigSpec, "diagnostics"},
+		{spec, kubeadmConfigSpec, "diagnostics", "*"},
+		{spec, kubeadmConfigSpec, "templating"},
+		{spec, kubeadmConfigSpec, "templating", "*"},
 		// spec.machineTemplate
 		{spec, "machineTemplate", "metadata"},
 		{spec, "machineTemplate", "metadata", "*"},
@@ -211,6 +218,12 @@ func (webhook *KubeadmControlPlane) ValidateUpdate(_ context.Context, oldObj, ne
 		{spec, "machineNaming", "*"},
 		{spec, "rollout"},
 		{spec, "rollout", "*"},
+		{spec, "etcd"},
+		{spec, "etcd", "*"},
+		{spec, "encryptionAtRest"},
+		{spec, "encryptionAtRest", "*"},
+		{spec, "endpointManager"},
+		{spec, "endpointManager", "*"},
 	}
 
 	oldK, ok := oldObj.(*controlplanev1.KubeadmControlPlane)
@@ -262,6 +275,7 @@ func (webhook *KubeadmControlPlane) ValidateUpdate(_ context.Context, oldObj, ne
 	allErrs = append(allErrs, webhook.validateVersion(oldK, newK)...)
 	allErrs = append(allErrs, validateClusterConfiguration(&oldK.Spec.KubeadmConfigSpec.ClusterConfiguration, &newK.Spec.KubeadmConfigSpec.ClusterConfiguration, field.NewPath("spec", "kubeadmConfigSpec", "clusterConfiguration"))...)
 	allErrs = append(allErrs, webhook.validateCoreDNSVersion(oldK, newK)...)
+	allErrs = append(allErrs, validateEncryptionAtRestUpdate(oldK.Spec.EncryptionAtRest, newK.Spec.EncryptionAtRest, field.NewPath("spec", "encryptionAtRest"))...)
 	allErrs = append(allErrs, newK.Spec.KubeadmConfigSpec.Validate(true, field.NewPath("spec", "kubeadmConfigSpec"))...)
 
 	if len(allErrs) > 0 {
@@ -348,6 +362,121 @@ func validateKubeadmControlPlaneSpec(s controlplanev1.KubeadmControlPlaneSpec, p
 
 	allErrs = append(allErrs, validateRolloutAndCertValidityFields(s.Rollout, s.KubeadmConfigSpec.ClusterConfiguration, s.Replicas, pathPrefix)...)
 	allErrs = append(allErrs, validateNaming(s.MachineNaming, pathPrefix.Child("machineNaming"))...)
+	allErrs = append(allErrs, validateTaintPolicy(s.MachineTemplate.Spec.TaintPolicy, s.KubeadmConfigSpec, pathPrefix)...)
+	allErrs = append(allErrs, validateEncryptionAtRest(s.EncryptionAtRest, s.KubeadmConfigSpec, pathPrefix)...)
+	allErrs = append(allErrs, validateEndpointManager(s.EndpointManager, s.KubeadmConfigSpec, pathPrefix)...)
+	return allErrs
+}
+
+// validateEndpointManager validates that the template of the endpoint manager can be rendered, and that the
+// static pod manifest managed by KCP does not conflict with the files in the kubeadmConfigSpec.
+func validateEndpointManager(endpointManager controlplanev1.KubeadmControlPlaneEndpointManagerSpec, kubeadmConfigSpec bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path) field.ErrorList {
+	staticPod := endpointManager.StaticPod
+	if staticPod.Name == "" {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	if _, err := desiredstate.RenderEndpointManagerManifest(staticPod.Template, clusterv1.APIEndpoint{Host: "10.0.0.1", Port: 6443}, "/etc/kubernetes/admin.conf"); err != nil {
+		allErrs = append(allErrs,
+			field.Invalid(
+				pathPrefix.Child("endpointManager", "staticPod", "template"),
+				staticPod.Template,
+				fmt.Sprintf("invalid template: %v", err),
+			),
+		)
+	}
+
+	manifestPath := desiredstate.EndpointManagerManifestPath(staticPod)
+	for i, file := range kubeadmConfigSpec.Files {
+		if file.Path == manifestPath {
+			allErrs = append(allErrs,
+				field.Forbidden(
+					pathPrefix.Child("kubeadmConfigSpec", "files").Index(i),
+					fmt.Sprintf("path %s cannot be set when endpointManager is set", manifestPath),
+				),
+			)
+		}
+	}
+	return allErrs
+}
+
+// validateEncryptionAtRest validates that the EncryptionConfiguration managed by KCP does not conflict with the kubeadmConfigSpec.
+func validateEncryptionAtRest(encryptionAtRest controlplanev1.KubeadmControlPlaneEncryptionAtRestSpec, kubeadmConfigSpec bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path) field.ErrorList {
+	if len(encryptionAtRest.Keys) == 0 {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	for i, arg := range kubeadmConfigSpec.ClusterConfiguration.APIServer.ExtraArgs {
+		if arg.Name == desiredstate.EncryptionProviderConfigArg {
+			allErrs = append(allErrs,
+				field.Forbidden(
+					pathPrefix.Child("kubeadmConfigSpec", "clusterConfiguration", "apiServer", "extraArgs").Index(i),
+					fmt.Sprintf("%s cannot be set when encryptionAtRest is set", desiredstate.EncryptionProviderConfigArg),
+				),
+			)
+		}
+	}
+	return allErrs
+}
+
+// validateEncryptionAtRestUpdate validates that encryption at rest is not disabled and that its provider is not changed,
+// given that resources already encrypted could not be decrypted anymore.
+func validateEncryptionAtRestUpdate(oldEncryptionAtRest, newEncryptionAtRest controlplanev1.KubeadmControlPlaneEncryptionAtRestSpec, pathPrefix *field.Path) field.ErrorList {
+	if len(oldEncryptionAtRest.Keys) == 0 {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	if len(newEncryptionAtRest.Keys) == 0 {
+		allErrs = append(allErrs, field.Forbidden(pathPrefix, "cannot be unset"))
+		return allErrs
+	}
+	if oldEncryptionAtRest.Provider != newEncryptionAtRest.Provider {
+		allErrs = append(allErrs, field.Invalid(pathPrefix.Child("provider"), newEncryptionAtRest.Provider, "cannot be changed"))
+	}
+	return allErrs
+}
+
+// validateTaintPolicy validates that taints explicitly set in nodeRegistration are consistent with the taint policy.
+func validateTaintPolicy(taintPolicy controlplanev1.KubeadmControlPlaneTaintPolicy, kubeadmConfigSpec bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path) field.ErrorList {
+	if taintPolicy == "" {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	nodeRegistrations := []struct {
+		taints *[]corev1.Taint
+		path   *field.Path
+	}{
+		{kubeadmConfigSpec.InitConfiguration.NodeRegistration.Taints, pathPrefix.Child("kubeadmConfigSpec", "initConfiguration", "nodeRegistration", "taints")},
+		{kubeadmConfigSpec.JoinConfiguration.NodeRegistration.Taints, pathPrefix.Child("kubeadmConfigSpec", "joinConfiguration", "nodeRegistration", "taints")},
+	}
+	for _, nodeRegistration := range nodeRegistrations {
+		if nodeRegistration.taints == nil {
+			continue
+		}
+		hasControlPlaneTaint := desiredstate.HasControlPlaneTaint(*nodeRegistration.taints)
+		switch {
+		case taintPolicy == controlplanev1.KubeadmControlPlaneTaintPolicyNoSchedule && !hasControlPlaneTaint:
+			allErrs = append(allErrs,
+				field.Invalid(
+					nodeRegistration.path,
+					*nodeRegistration.taints,
+					fmt.Sprintf("must include the %s:%s taint when machineTemplate.spec.taintPolicy is %s", desiredstate.ControlPlaneTaint.Key, desiredstate.ControlPlaneTaint.Effect, taintPolicy),
+				),
+			)
+		case taintPolicy == controlplanev1.KubeadmControlPlaneTaintPolicyNone && hasControlPlaneTaint:
+			allErrs = append(allErrs,
+				field.Invalid(
+					nodeRegistration.path,
+					*nodeRegistration.taints,
+					fmt.Sprintf("must not include the %s:%s taint when machineTemplate.spec.taintPolicy is %s", desiredstate.ControlPlaneTaint.Key, desiredstate.ControlPlaneTaint.Effect, taintPolicy),
+				),
+			)
+		}
+	}
 	return allErrs
 }
 
@@ -440,24 +569,9 @@ func validateNaming(machineNaming controlplanev1.MachineNamingSpec, pathPrefix *
 					"invalid template, {{ .random }} is missing",
 				))
 		}
-		name, err := topologynames.KCPMachineNameGenerator(machineNaming.Template, "cluster", "kubeadmcontrolplane").GenerateName()
-		if err != nil {
-			allErrs = append(allErrs,
-				field.Invalid(
-					pathPrefix.Child("template"),
-					machineNaming.Template,
-					fmt.Sprintf("invalid template: %v", err),
-				))
-		} else {
-			for _, err := range validation.IsDNS1123Subdomain(name) {
-				allErrs = append(allErrs,
-					field.Invalid(
-						pathPrefix.Child("template"),
-						machineNaming.Template,
-						fmt.Sprintf("invalid template, generated names would not be valid Kubernetes object names: %v", err),
-					))
-			}
-		}
+		allErrs = append(allErrs, topologynames.ValidateMachineNameTemplate(machineNaming.Template, func(predecessorName string) topologynames.NameGenerator {
+			return topologynames.KCPMachineNameGenerator(machineNaming.Template, "cluster", "kubeadmcontrolplane", predecessorName)
+		}, pathPrefix.Child("template"))...)
 	}
 
 	return allErrs
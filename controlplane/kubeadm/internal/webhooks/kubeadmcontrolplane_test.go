@@ -22,6 +22,7 @@ import (
 	"time"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilfeature "k8s.io/component-base/featuregate/testing"
@@ -188,6 +189,55 @@ func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
 	invalidRolloutBeforeCertificatesExpiryDays.Spec.Rollout.Before.CertificatesExpiryDays = 8
 	invalidRolloutBeforeCertificatesExpiryDays.Spec.KubeadmConfigSpec.ClusterConfiguration.CertificateValidityPeriodDays = 7
 
+	controlPlaneTaint := corev1.Taint{Key: "node-role.kubernetes.io/control-plane", Effect: corev1.TaintEffectNoSchedule}
+	otherTaint := corev1.Taint{Key: "example.com/foo", Effect: corev1.TaintEffectNoSchedule}
+
+	taintPolicyNone := valid.DeepCopy()
+	taintPolicyNone.Spec.MachineTemplate.Spec.TaintPolicy = controlplanev1.KubeadmControlPlaneTaintPolicyNone
+
+	taintPolicyNoneWithOtherTaints := taintPolicyNone.DeepCopy()
+	taintPolicyNoneWithOtherTaints.Spec.KubeadmConfigSpec.JoinConfiguration.NodeRegistration.Taints = &[]corev1.Taint{otherTaint}
+
+	invalidTaintPolicyNoneWithControlPlaneTaint := taintPolicyNone.DeepCopy()
+	invalidTaintPolicyNoneWithControlPlaneTaint.Spec.KubeadmConfigSpec.InitConfiguration.NodeRegistration.Taints = &[]corev1.Taint{otherTaint, controlPlaneTaint}
+
+	taintPolicyNoScheduleWithControlPlaneTaint := valid.DeepCopy()
+	taintPolicyNoScheduleWithControlPlaneTaint.Spec.MachineTemplate.Spec.TaintPolicy = controlplanev1.KubeadmControlPlaneTaintPolicyNoSchedule
+	taintPolicyNoScheduleWithControlPlaneTaint.Spec.KubeadmConfigSpec.JoinConfiguration.NodeRegistration.Taints = &[]corev1.Taint{controlPlaneTaint, otherTaint}
+
+	invalidTaintPolicyNoScheduleWithEmptyTaints := valid.DeepCopy()
+	invalidTaintPolicyNoScheduleWithEmptyTaints.Spec.MachineTemplate.Spec.TaintPolicy = controlplanev1.KubeadmControlPlaneTaintPolicyNoSchedule
+	invalidTaintPolicyNoScheduleWithEmptyTaints.Spec.KubeadmConfigSpec.JoinConfiguration.NodeRegistration.Taints = &[]corev1.Taint{}
+
+	encryptionAtRest := valid.DeepCopy()
+	encryptionAtRest.Spec.EncryptionAtRest = controlplanev1.KubeadmControlPlaneEncryptionAtRestSpec{
+		Provider: controlplanev1.KubeadmControlPlaneEncryptionProviderAESCBC,
+		Keys: []controlplanev1.KubeadmControlPlaneEncryptionKey{
+			{Name: "key1", Secret: controlplanev1.KubeadmControlPlaneEncryptionKeySecretReference{Name: "keys", Key: "key1"}},
+		},
+	}
+
+	invalidEncryptionAtRestWithEncryptionProviderConfigArg := encryptionAtRest.DeepCopy()
+	invalidEncryptionAtRestWithEncryptionProviderConfigArg.Spec.KubeadmConfigSpec.ClusterConfiguration.APIServer.ExtraArgs = []bootstrapv1.Arg{
+		{Name: "encryption-provider-config", Value: ptr.To("/etc/kubernetes/encryption.yaml")},
+	}
+
+	endpointManager := valid.DeepCopy()
+	endpointManager.Spec.EndpointManager = controlplanev1.KubeadmControlPlaneEndpointManagerSpec{
+		StaticPod: controlplanev1.KubeadmControlPlaneEndpointManagerStaticPod{
+			Name:     "kube-vip",
+			Template: "address: {{ .controlPlaneEndpoint.host }}\nkubeconfig: {{ .kubeconfigPath }}",
+		},
+	}
+
+	invalidEndpointManagerTemplate := endpointManager.DeepCopy()
+	invalidEndpointManagerTemplate.Spec.EndpointManager.StaticPod.Template = "address: {{ .vip }}"
+
+	invalidEndpointManagerWithManifestFile := endpointManager.DeepCopy()
+	invalidEndpointManagerWithManifestFile.Spec.KubeadmConfigSpec.Files = []bootstrapv1.File{
+		{Path: "/etc/kubernetes/manifests/kube-vip.yaml", Content: "kind: Pod"},
+	}
+
 	tests := []struct {
 		name                  string
 		enableIgnitionFeature bool
@@ -291,6 +341,51 @@ func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
 			expectErr: true,
 			kcp:       invalidRolloutBeforeCertificatesExpiryDays,
 		},
+		{
+			name: "should pass with taintPolicy None",
+			kcp:  taintPolicyNone,
+		},
+		{
+			name: "should pass with taintPolicy None and taints without the control plane taint",
+			kcp:  taintPolicyNoneWithOtherTaints,
+		},
+		{
+			name:      "should return error with taintPolicy None and taints with the control plane taint",
+			expectErr: true,
+			kcp:       invalidTaintPolicyNoneWithControlPlaneTaint,
+		},
+		{
+			name: "should pass with taintPolicy NoSchedule and taints with the control plane taint",
+			kcp:  taintPolicyNoScheduleWithControlPlaneTaint,
+		},
+		{
+			name:      "should return error with taintPolicy NoSchedule and empty taints",
+			expectErr: true,
+			kcp:       invalidTaintPolicyNoScheduleWithEmptyTaints,
+		},
+		{
+			name: "should pass with encryptionAtRest",
+			kcp:  encryptionAtRest,
+		},
+		{
+			name:      "should return error with encryptionAtRest and the encryption-provider-config API server flag",
+			expectErr: true,
+			kcp:       invalidEncryptionAtRestWithEncryptionProviderConfigArg,
+		},
+		{
+			name: "should pass with endpointManager",
+			kcp:  endpointManager,
+		},
+		{
+			name:      "should return error with an endpointManager template which cannot be rendered",
+			expectErr: true,
+			kcp:       invalidEndpointManagerTemplate,
+		},
+		{
+			name:      "should return error with endpointManager and a file with the same path of the static pod manifest",
+			expectErr: true,
+			kcp:       invalidEndpointManagerWithManifestFile,
+		},
 	}
 
 	for _, tt := range tests {
@@ -477,7 +572,15 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 		MinHealthyPeriodSeconds: ptr.To(int32(10 * 60 * 60)),
 		RetryPeriodSeconds:      ptr.To[int32](10 * 60),
 	}
+	validUpdate.Spec.Etcd.JoinAsLearner = ptr.To(true)
 	validUpdate.Spec.KubeadmConfigSpec.Format = bootstrapv1.CloudConfig
+	validUpdate.Spec.KubeadmConfigSpec.Diagnostics = bootstrapv1.BootstrapDiagnostics{
+		Enabled:         ptr.To(true),
+		OutputTailLines: ptr.To[int32](50),
+	}
+	validUpdate.Spec.KubeadmConfigSpec.Templating = bootstrapv1.BootstrapTemplating{
+		Enabled: ptr.To(true),
+	}
 
 	scaleToZero := before.DeepCopy()
 	scaleToZero.Spec.Replicas = ptr.To[int32](0)
@@ -673,6 +776,26 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 	disableNTPServers := before.DeepCopy()
 	disableNTPServers.Spec.KubeadmConfigSpec.NTP.Enabled = ptr.To(false)
 
+	updateTrustedCertificateAuthorities := before.DeepCopy()
+	updateTrustedCertificateAuthorities.Spec.KubeadmConfigSpec.TrustedCertificateAuthorities = []bootstrapv1.TrustedCertificateAuthority{
+		{
+			ConfigMap: bootstrapv1.TrustedCertificateAuthoritySource{
+				Name: "ca",
+				Key:  "ca.crt",
+			},
+		},
+	}
+
+	updateAdditionalUserData := before.DeepCopy()
+	updateAdditionalUserData.Spec.KubeadmConfigSpec.AdditionalUserData = []bootstrapv1.AdditionalUserData{
+		{
+			Secret: bootstrapv1.AdditionalUserDataSource{
+				Name: "user-data",
+				Key:  "value",
+			},
+		},
+	}
+
 	unsetRolloutBefore := before.DeepCopy()
 	unsetRolloutBefore.Spec.Rollout.Before = controlplanev1.KubeadmControlPlaneRolloutBeforeSpec{}
 
@@ -759,6 +882,30 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 	validEncryptionAlgorithm := before.DeepCopy()
 	validEncryptionAlgorithm.Spec.KubeadmConfigSpec.ClusterConfiguration.EncryptionAlgorithm = bootstrapv1.EncryptionAlgorithmRSA3072
 
+	enableEncryptionAtRest := before.DeepCopy()
+	enableEncryptionAtRest.Spec.EncryptionAtRest = controlplanev1.KubeadmControlPlaneEncryptionAtRestSpec{
+		Provider: controlplanev1.KubeadmControlPlaneEncryptionProviderAESCBC,
+		Keys: []controlplanev1.KubeadmControlPlaneEncryptionKey{
+			{Name: "key1", Secret: controlplanev1.KubeadmControlPlaneEncryptionKeySecretReference{Name: "keys", Key: "key1"}},
+		},
+	}
+
+	rotateEncryptionKey := enableEncryptionAtRest.DeepCopy()
+	rotateEncryptionKey.Spec.EncryptionAtRest.Keys = append([]controlplanev1.KubeadmControlPlaneEncryptionKey{
+		{Name: "key2", Secret: controlplanev1.KubeadmControlPlaneEncryptionKeySecretReference{Name: "keys", Key: "key2"}},
+	}, rotateEncryptionKey.Spec.EncryptionAtRest.Keys...)
+
+	invalidChangeEncryptionProvider := enableEncryptionAtRest.DeepCopy()
+	invalidChangeEncryptionProvider.Spec.EncryptionAtRest.Provider = controlplanev1.KubeadmControlPlaneEncryptionProviderSecretbox
+
+	setEndpointManager := before.DeepCopy()
+	setEndpointManager.Spec.EndpointManager = controlplanev1.KubeadmControlPlaneEndpointManagerSpec{
+		StaticPod: controlplanev1.KubeadmControlPlaneEndpointManagerStaticPod{
+			Name:     "kube-vip",
+			Template: "address: {{ .controlPlaneEndpoint.host }}",
+		},
+	}
+
 	tests := []struct {
 		name                  string
 		enableIgnitionFeature bool
@@ -1028,6 +1175,18 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 			before:    before,
 			kcp:       disableNTPServers,
 		},
+		{
+			name:      "should pass if trustedCertificateAuthorities are updated",
+			expectErr: false,
+			before:    before,
+			kcp:       updateTrustedCertificateAuthorities,
+		},
+		{
+			name:      "should pass if additionalUserData are updated",
+			expectErr: false,
+			before:    before,
+			kcp:       updateAdditionalUserData,
+		},
 		{
 			name:      "should allow changes to initConfiguration.patches",
 			expectErr: false,
@@ -1127,6 +1286,38 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 			before: before,
 			kcp:    validEncryptionAlgorithm,
 		},
+		{
+			name:   "should allow to enable encryptionAtRest",
+			before: before,
+			kcp:    enableEncryptionAtRest,
+		},
+		{
+			name:   "should allow to add encryptionAtRest keys",
+			before: enableEncryptionAtRest,
+			kcp:    rotateEncryptionKey,
+		},
+		{
+			name:      "should return error when trying to unset encryptionAtRest",
+			expectErr: true,
+			before:    enableEncryptionAtRest,
+			kcp:       before,
+		},
+		{
+			name:      "should return error when trying to change the encryptionAtRest provider",
+			expectErr: true,
+			before:    enableEncryptionAtRest,
+			kcp:       invalidChangeEncryptionProvider,
+		},
+		{
+			name:   "should allow to set endpointManager",
+			before: before,
+			kcp:    setEndpointManager,
+		},
+		{
+			name:   "should allow to unset endpointManager",
+			before: setEndpointManager,
+			kcp:    before,
+		},
 	}
 
 	for _, tt := range tests {
@@ -136,6 +136,8 @@ func validateKubeadmControlPlaneTemplateResourceSpec(s controlplanev1.KubeadmCon
 
 	allErrs = append(allErrs, validateRolloutAndCertValidityFields(s.Rollout, s.KubeadmConfigSpec.ClusterConfiguration, nil, pathPrefix)...)
 	allErrs = append(allErrs, validateNaming(s.MachineNaming, pathPrefix.Child("machineNaming"))...)
+	allErrs = append(allErrs, validateTaintPolicy(s.MachineTemplate.Spec.TaintPolicy, s.KubeadmConfigSpec, pathPrefix)...)
+	allErrs = append(allErrs, validateEndpointManager(s.EndpointManager, s.KubeadmConfigSpec, pathPrefix)...)
 
 	// Validate the metadata of the MachineTemplate
 	allErrs = append(allErrs, s.MachineTemplate.ObjectMeta.Validate(pathPrefix.Child("machineTemplate", "metadata"))...)
@@ -24,6 +24,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"fmt"
+	"io"
 	"math/big"
 	"reflect"
 	"time"
@@ -80,7 +81,7 @@ type WorkloadCluster interface {
 
 	// Upgrade related tasks.
 	UpdateImageRepositoryInKubeadmConfigMap(imageRepository string) func(*bootstrapv1.ClusterConfiguration)
-	UpdateFeatureGatesInKubeadmConfigMap(kubeadmConfigSpec bootstrapv1.KubeadmConfigSpec, kubernetesVersion semver.Version) func(*bootstrapv1.ClusterConfiguration)
+	UpdateFeatureGatesInKubeadmConfigMap(kubeadmConfigSpec bootstrapv1.KubeadmConfigSpec, kubernetesVersion semver.Version, etcdJoinAsLearner bool) func(*bootstrapv1.ClusterConfiguration)
 	UpdateEtcdLocalInKubeadmConfigMap(localEtcd bootstrapv1.LocalEtcd) func(*bootstrapv1.ClusterConfiguration)
 	UpdateEtcdExternalInKubeadmConfigMap(externalEtcd bootstrapv1.ExternalEtcd) func(*bootstrapv1.ClusterConfiguration)
 	UpdateAPIServerInKubeadmConfigMap(apiServer bootstrapv1.APIServer) func(*bootstrapv1.ClusterConfiguration)
@@ -92,8 +93,12 @@ type WorkloadCluster interface {
 	UpdateCoreDNS(ctx context.Context, kcp *controlplanev1.KubeadmControlPlane) error
 	RemoveEtcdMemberForMachine(ctx context.Context, machine *clusterv1.Machine) error
 	ForwardEtcdLeadership(ctx context.Context, machine *clusterv1.Machine, leaderCandidate *clusterv1.Machine) error
+	PromoteEtcdLearners(ctx context.Context) (promoted, notReady []string, err error)
+	DefragmentEtcdMembers(ctx context.Context, thresholdPercentage int32) ([]string, error)
+	SnapshotEtcd(ctx context.Context, writer io.Writer) error
 	AllowClusterAdminPermissions(ctx context.Context, version semver.Version) error
 	UpdateClusterConfiguration(ctx context.Context, version semver.Version, mutators ...func(*bootstrapv1.ClusterConfiguration)) error
+	RewriteEncryptedResources(ctx context.Context, resources []string) error
 
 	// State recovery tasks.
 	ReconcileEtcdMembersAndControlPlaneNodes(ctx context.Context, members []*etcd.Member, nodeNames []string) ([]string, error)
@@ -155,11 +160,11 @@ func (w *Workload) UpdateImageRepositoryInKubeadmConfigMap(imageRepository strin
 }
 
 // UpdateFeatureGatesInKubeadmConfigMap updates the feature gates in the kubeadm config map.
-func (w *Workload) UpdateFeatureGatesInKubeadmConfigMap(kubeadmConfigSpec bootstrapv1.KubeadmConfigSpec, kubernetesVersion semver.Version) func(*bootstrapv1.ClusterConfiguration) {
+func (w *Workload) UpdateFeatureGatesInKubeadmConfigMap(kubeadmConfigSpec bootstrapv1.KubeadmConfigSpec, kubernetesVersion semver.Version, etcdJoinAsLearner bool) func(*bootstrapv1.ClusterConfiguration) {
 	return func(c *bootstrapv1.ClusterConfiguration) {
 		// We use DeepCopy here to avoid modifying the KCP object in the apiserver.
 		kubeadmConfigSpec := kubeadmConfigSpec.DeepCopy()
-		desiredstate.DefaultFeatureGates(kubeadmConfigSpec, kubernetesVersion)
+		desiredstate.DefaultFeatureGates(kubeadmConfigSpec, kubernetesVersion, etcdJoinAsLearner)
 
 		// Even if featureGates is nil, reset it to ClusterConfiguration
 		// to override any previously set feature gates.
@@ -0,0 +1,67 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// rewriteEncryptedResourcesPageSize is the page size used when listing the objects to rewrite.
+const rewriteEncryptedResourcesPageSize = 500
+
+// RewriteEncryptedResources rewrites all the objects of the given resources, in the resource or resource.group format,
+// so they are stored in etcd encrypted with the current write key of the API server EncryptionConfiguration.
+// NOTE: An update without changes is persisted by the API server only if the object is stored with a key
+// (or provider) which is not the current write key, so objects already encrypted with the current write key are not rewritten.
+func (w *Workload) RewriteEncryptedResources(ctx context.Context, resources []string) error {
+	for _, resource := range resources {
+		gvk, err := w.Client.RESTMapper().KindFor(schema.ParseGroupResource(resource).WithVersion(""))
+		if err != nil {
+			return errors.Wrapf(err, "failed to rewrite %s: failed to get kind", resource)
+		}
+
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		for {
+			if err := w.Client.List(ctx, list, ctrlclient.Limit(rewriteEncryptedResourcesPageSize), ctrlclient.Continue(list.GetContinue())); err != nil {
+				return errors.Wrapf(err, "failed to rewrite %s: failed to list objects", resource)
+			}
+
+			for i := range list.Items {
+				obj := &list.Items[i]
+				if err := w.Client.Update(ctx, obj); err != nil {
+					// Objects deleted or changed in the meantime have already been rewritten.
+					if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+						continue
+					}
+					return errors.Wrapf(err, "failed to rewrite %s %s", resource, ctrlclient.ObjectKeyFromObject(obj))
+				}
+			}
+
+			if list.GetContinue() == "" {
+				break
+			}
+		}
+	}
+	return nil
+}
@@ -18,8 +18,11 @@ package internal
 
 import (
 	"context"
+	"fmt"
+	"io"
 
 	"github.com/pkg/errors"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
@@ -182,6 +185,139 @@ func (w *Workload) ForwardEtcdLeadership(ctx context.Context, machine *clusterv1
 	return nil
 }
 
+// PromoteEtcdLearners promotes the etcd learner members to voting members.
+// It returns the names of the promoted members and the names of the learners that cannot be
+// promoted yet because they are not in sync with the leader.
+func (w *Workload) PromoteEtcdLearners(ctx context.Context) (promoted, notReady []string, _ error) {
+	nodes, err := w.getControlPlaneNodes(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list control plane nodes")
+	}
+	nodeNames := make([]string, 0, len(nodes.Items))
+	for _, node := range nodes.Items {
+		nodeNames = append(nodeNames, node.Name)
+	}
+	etcdClient, err := w.etcdClientGenerator.forLeader(ctx, nodeNames)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create etcd client")
+	}
+	defer etcdClient.Close()
+
+	members, err := etcdClient.Members(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list etcd members using etcd client")
+	}
+
+	errs := []error{}
+	for _, member := range members {
+		if !member.IsLearner {
+			continue
+		}
+
+		// Note: a learner that just joined has an empty name until the etcd pod starts.
+		name := member.Name
+		if name == "" {
+			name = fmt.Sprintf("%x", member.ID)
+		}
+
+		if err := etcdClient.PromoteMember(ctx, member.ID); err != nil {
+			if errors.Is(err, rpctypes.ErrMemberLearnerNotReady) {
+				notReady = append(notReady, name)
+				continue
+			}
+			errs = append(errs, errors.Wrapf(err, "failed to promote etcd member %s", name))
+			continue
+		}
+		promoted = append(promoted, name)
+	}
+
+	return promoted, notReady, kerrors.NewAggregate(errs)
+}
+
+// DefragmentEtcdMembers defragments the etcd members whose reclaimable database space, i.e. the database size allocated
+// on disk minus the size in use, is at least thresholdPercentage of the database size.
+// Members are defragmented one at a time, given that a member does not serve requests while being defragmented,
+// and the leader is defragmented last. It returns the names of the defragmented members.
+func (w *Workload) DefragmentEtcdMembers(ctx context.Context, thresholdPercentage int32) ([]string, error) {
+	nodes, err := w.getControlPlaneNodes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list control plane nodes")
+	}
+
+	nodeNames := []string{}
+	leaderNodeName := ""
+	for _, node := range nodes.Items {
+		status, err := w.etcdMemberStatus(ctx, node.Name)
+		if err != nil {
+			return nil, err
+		}
+		if status.DBSize == 0 || (status.DBSize-status.DBSizeInUse)*100 < int64(thresholdPercentage)*status.DBSize {
+			continue
+		}
+		if status.IsLeader {
+			leaderNodeName = node.Name
+			continue
+		}
+		nodeNames = append(nodeNames, node.Name)
+	}
+	if leaderNodeName != "" {
+		nodeNames = append(nodeNames, leaderNodeName)
+	}
+
+	defragmented := []string{}
+	for _, name := range nodeNames {
+		// Stop at the first failure, so a problem affecting defragmentation does not hit all the members.
+		if err := w.defragmentEtcdMember(ctx, name); err != nil {
+			return defragmented, err
+		}
+		defragmented = append(defragmented, name)
+	}
+	return defragmented, nil
+}
+
+func (w *Workload) etcdMemberStatus(ctx context.Context, nodeName string) (*etcd.MemberStatus, error) {
+	etcdClient, err := w.etcdClientGenerator.forFirstAvailableNode(ctx, []string{nodeName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create etcd client for node %s", nodeName)
+	}
+	defer etcdClient.Close()
+
+	status, err := etcdClient.MemberStatus(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get status of etcd member on node %s", nodeName)
+	}
+	return status, nil
+}
+
+func (w *Workload) defragmentEtcdMember(ctx context.Context, nodeName string) error {
+	etcdClient, err := w.etcdClientGenerator.forFirstAvailableNode(ctx, []string{nodeName})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create etcd client for node %s", nodeName)
+	}
+	defer etcdClient.Close()
+
+	return errors.Wrapf(etcdClient.Defragment(ctx), "failed to defragment etcd member on node %s", nodeName)
+}
+
+// SnapshotEtcd streams a snapshot of the etcd database, taken from the etcd leader, to the given writer.
+func (w *Workload) SnapshotEtcd(ctx context.Context, writer io.Writer) error {
+	nodes, err := w.getControlPlaneNodes(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list control plane nodes")
+	}
+	nodeNames := make([]string, 0, len(nodes.Items))
+	for _, node := range nodes.Items {
+		nodeNames = append(nodeNames, node.Name)
+	}
+	etcdClient, err := w.etcdClientGenerator.forLeader(ctx, nodeNames)
+	if err != nil {
+		return errors.Wrap(err, "failed to create etcd client")
+	}
+	defer etcdClient.Close()
+
+	return etcdClient.Snapshot(ctx, writer)
+}
+
 // EtcdMemberStatus contains status information for a single etcd member.
 type EtcdMemberStatus struct {
 	Name       string
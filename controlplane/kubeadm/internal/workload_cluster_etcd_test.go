@@ -17,7 +17,10 @@ limitations under the License.
 package internal
 
 import (
+	"bytes"
 	"context"
+	"maps"
+	"slices"
 	"testing"
 
 	"github.com/blang/semver/v4"
@@ -25,6 +28,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -553,6 +557,191 @@ func TestForwardEtcdLeadership(t *testing.T) {
 	})
 }
 
+func TestPromoteEtcdLearners(t *testing.T) {
+	tests := []struct {
+		name                    string
+		members                 []*pb.Member
+		memberPromoteError      error
+		expectedPromoted        []string
+		expectedNotReady        []string
+		expectedPromotedMembers []uint64
+		expectErr               bool
+	}{
+		{
+			name: "does nothing if there are no learners",
+			members: []*pb.Member{
+				{Name: "node-1", ID: uint64(1)},
+				{Name: "node-2", ID: uint64(2)},
+			},
+		},
+		{
+			name: "promotes learners",
+			members: []*pb.Member{
+				{Name: "node-1", ID: uint64(1)},
+				{Name: "node-2", ID: uint64(2), IsLearner: true},
+			},
+			expectedPromoted:        []string{"node-2"},
+			expectedPromotedMembers: []uint64{2},
+		},
+		{
+			name: "reports learners not in sync with the leader",
+			members: []*pb.Member{
+				{Name: "node-1", ID: uint64(1)},
+				{Name: "node-2", ID: uint64(2), IsLearner: true},
+			},
+			memberPromoteError: rpctypes.ErrMemberLearnerNotReady,
+			expectedNotReady:   []string{"node-2"},
+		},
+		{
+			name: "returns error if promoting a learner fails",
+			members: []*pb.Member{
+				{Name: "node-1", ID: uint64(1)},
+				{Name: "node-2", ID: uint64(2), IsLearner: true},
+			},
+			memberPromoteError: errors.New("failed to promote"),
+			expectErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fakeEtcdClient := &fake2.FakeEtcdClient{
+				MemberListResponse: &clientv3.MemberListResponse{
+					Members: tt.members,
+				},
+				MemberPromoteError: tt.memberPromoteError,
+			}
+			w := &Workload{
+				Client: &fakeClient{list: &corev1.NodeList{
+					Items: []corev1.Node{nodeNamed("node-1"), nodeNamed("node-2")},
+				}},
+				etcdClientGenerator: &fakeEtcdClientGenerator{
+					forLeaderClient: &etcd.Client{EtcdClient: fakeEtcdClient},
+				},
+			}
+
+			promoted, notReady, err := w.PromoteEtcdLearners(ctx)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(promoted).To(Equal(tt.expectedPromoted))
+			g.Expect(notReady).To(Equal(tt.expectedNotReady))
+			g.Expect(fakeEtcdClient.PromotedMembers).To(Equal(tt.expectedPromotedMembers))
+		})
+	}
+}
+
+func TestDefragmentEtcdMembers(t *testing.T) {
+	memberStatus := func(id uint64, isLeader bool, dbSize, dbSizeInUse int64) *clientv3.StatusResponse {
+		leader := uint64(0)
+		if isLeader {
+			leader = id
+		}
+		return &clientv3.StatusResponse{
+			Header:      &pb.ResponseHeader{MemberId: id},
+			Leader:      leader,
+			DbSize:      dbSize,
+			DbSizeInUse: dbSizeInUse,
+		}
+	}
+
+	tests := []struct {
+		name                 string
+		statuses             map[string]*clientv3.StatusResponse
+		defragmentError      error
+		expectedDefragmented []string
+		expectErr            bool
+	}{
+		{
+			name: "does nothing if no member exceeds the threshold",
+			statuses: map[string]*clientv3.StatusResponse{
+				"node-1": memberStatus(1, true, 100, 90),
+				"node-2": memberStatus(2, false, 100, 60),
+				"node-3": memberStatus(3, false, 0, 0),
+			},
+			expectedDefragmented: []string{},
+		},
+		{
+			name: "defragments members exceeding the threshold, with the leader last",
+			statuses: map[string]*clientv3.StatusResponse{
+				"node-1": memberStatus(1, true, 100, 10),
+				"node-2": memberStatus(2, false, 100, 50),
+				"node-3": memberStatus(3, false, 100, 90),
+			},
+			expectedDefragmented: []string{"node-2", "node-1"},
+		},
+		{
+			name: "returns error if defragmenting a member fails",
+			statuses: map[string]*clientv3.StatusResponse{
+				"node-1": memberStatus(1, true, 100, 10),
+			},
+			defragmentError: errors.New("failed to defragment"),
+			expectErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			nodes := []corev1.Node{}
+			fakeEtcdClients := map[string]*fake2.FakeEtcdClient{}
+			for _, name := range slices.Sorted(maps.Keys(tt.statuses)) {
+				nodes = append(nodes, nodeNamed(name))
+				fakeEtcdClients[name] = &fake2.FakeEtcdClient{
+					StatusResponse:  tt.statuses[name],
+					DefragmentError: tt.defragmentError,
+				}
+			}
+			w := &Workload{
+				Client: &fakeClient{list: &corev1.NodeList{Items: nodes}},
+				etcdClientGenerator: &fakeEtcdClientGenerator{
+					forNodesClientFunc: func(n []string) (*etcd.Client, error) {
+						return &etcd.Client{EtcdClient: fakeEtcdClients[n[0]], Endpoint: n[0], CallTimeout: etcd.DefaultCallTimeout}, nil
+					},
+				},
+			}
+
+			defragmented, err := w.DefragmentEtcdMembers(ctx, 50)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(defragmented).To(Equal(tt.expectedDefragmented))
+			for name, c := range fakeEtcdClients {
+				if slices.Contains(tt.expectedDefragmented, name) {
+					g.Expect(c.DefragmentedEndpoints).To(ConsistOf(name))
+					continue
+				}
+				g.Expect(c.DefragmentedEndpoints).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestSnapshotEtcd(t *testing.T) {
+	g := NewWithT(t)
+
+	w := &Workload{
+		Client: &fakeClient{list: &corev1.NodeList{
+			Items: []corev1.Node{nodeNamed("node-1")},
+		}},
+		etcdClientGenerator: &fakeEtcdClientGenerator{
+			forLeaderClient: &etcd.Client{EtcdClient: &fake2.FakeEtcdClient{SnapshotResponse: []byte("snapshot")}},
+		},
+	}
+
+	snapshot := &bytes.Buffer{}
+	g.Expect(w.SnapshotEtcd(ctx, snapshot)).To(Succeed())
+	g.Expect(snapshot.String()).To(Equal("snapshot"))
+
+	w.etcdClientGenerator = &fakeEtcdClientGenerator{forLeaderErr: errors.New("no leader")}
+	g.Expect(w.SnapshotEtcd(ctx, &bytes.Buffer{})).ToNot(Succeed())
+}
+
 func TestReconcileEtcdMembersAndControlPlaneNodes(t *testing.T) {
 	node1 := &corev1.Node{
 		ObjectMeta: metav1.ObjectMeta{
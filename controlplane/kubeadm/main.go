@@ -74,6 +74,7 @@ import (
 	internalruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
 	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
 	"sigs.k8s.io/cluster-api/util/apiwarnings"
+	"sigs.k8s.io/cluster-api/util/controllerstatus"
 	"sigs.k8s.io/cluster-api/util/flags"
 	"sigs.k8s.io/cluster-api/version"
 )
@@ -110,6 +111,7 @@ var (
 	// KCP specific flags.
 	remoteConditionsGracePeriod    time.Duration
 	kubeadmControlPlaneConcurrency int
+	kubeadmControlPlaneOptions     = flags.ControllerOptions{}
 	clusterCacheConcurrency        int
 	skipCRDMigrationPhases         []string
 	etcdDialTimeout                time.Duration
@@ -167,6 +169,8 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&kubeadmControlPlaneConcurrency, "kubeadmcontrolplane-concurrency", 10,
 		"Number of kubeadm control planes to process simultaneously")
 
+	flags.AddControllerOptions(fs, "kubeadmcontrolplane", &kubeadmControlPlaneOptions)
+
 	fs.StringSliceVar(&skipCRDMigrationPhases, "skip-crd-migration-phases", []string{},
 		"List of CRD migration phases to skip. Valid values are: StorageVersionMigration, CleanupManagedFields.")
 
@@ -350,6 +354,10 @@ func main() {
 	ctx := ctrl.SetupSignalHandler()
 
 	setupChecks(mgr)
+	if err := controllerstatus.AddToManager(mgr, controllerstatus.NewFromFlags(controllerName, feature.MutableGates, pflag.CommandLine)); err != nil {
+		setupLog.Error(err, "unable to expose controller status")
+		os.Exit(1)
+	}
 	setupReconcilers(ctx, mgr)
 	setupWebhooks(ctx, mgr)
 
@@ -462,7 +470,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 	}
 
 	var runtimeClient runtimeclient.Client
-	if feature.Gates.Enabled(feature.InPlaceUpdates) {
+	if feature.Gates.Enabled(feature.RuntimeSDK) || feature.Gates.Enabled(feature.InPlaceUpdates) {
 		// This is the creation of the runtimeClient for the controllers, embedding a shared catalog and registry instance.
 		runtimeClient = internalruntimeclient.New(internalruntimeclient.Options{
 			CertFile: runtimeExtensionCertFile,
@@ -490,12 +498,13 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 		SecretCachingClient:         secretCachingClient,
 		ClusterCache:                clusterCache,
 		WatchFilterValue:            watchFilterValue,
+		ResyncPeriod:                kubeadmControlPlaneOptions.ResyncPeriod,
 		EtcdDialTimeout:             etcdDialTimeout,
 		EtcdCallTimeout:             etcdCallTimeout,
 		EtcdLogger:                  etcdLogger,
 		RemoteConditionsGracePeriod: remoteConditionsGracePeriod,
 		RuntimeClient:               runtimeClient,
-	}).SetupWithManager(ctx, mgr, concurrency(kubeadmControlPlaneConcurrency)); err != nil {
+	}).SetupWithManager(ctx, mgr, controllerOptions(kubeadmControlPlaneConcurrency, kubeadmControlPlaneOptions)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KubeadmControlPlane")
 		os.Exit(1)
 	}
@@ -531,3 +540,12 @@ func setupWebhooks(ctx context.Context, mgr ctrl.Manager) {
 func concurrency(c int) controller.Options {
 	return controller.Options{MaxConcurrentReconciles: c}
 }
+
+func controllerOptions(c int, options flags.ControllerOptions) controller.Options {
+	o, err := flags.GetControllerOptions(options, c)
+	if err != nil {
+		setupLog.Error(err, "Unable to get controller options")
+		os.Exit(1)
+	}
+	return o
+}
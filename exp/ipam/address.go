@@ -0,0 +1,76 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+)
+
+// ProtectAddressFinalizer is the finalizer IPAM providers add to IPAddresses to prevent accidental deletion.
+const ProtectAddressFinalizer = "ipam.cluster.x-k8s.io/protect-address"
+
+// ReferencesPool returns true if an IPAddressClaim references a pool of the given group and kind.
+// IPAM providers can use it to skip IPAddressClaims for pools managed by other providers.
+func ReferencesPool(claim *ipamv1.IPAddressClaim, gk schema.GroupKind) bool {
+	return claim.Spec.PoolRef.APIGroup == gk.Group && claim.Spec.PoolRef.Kind == gk.Kind
+}
+
+// NewIPAddress returns an IPAddress fulfilling an IPAddressClaim with an address allocated from a pool.
+// The IPAddress has the same name as the IPAddressClaim, it has a controller owner reference to the IPAddressClaim,
+// an owner reference to the pool and the ProtectAddressFinalizer, as defined by the IPAM contract.
+func NewIPAddress(scheme *runtime.Scheme, claim *ipamv1.IPAddressClaim, pool client.Object, address string, prefix int32, gateway string) (*ipamv1.IPAddress, error) {
+	ipAddress := &ipamv1.IPAddress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       claim.Name,
+			Namespace:  claim.Namespace,
+			Finalizers: []string{ProtectAddressFinalizer},
+		},
+		Spec: ipamv1.IPAddressSpec{
+			ClaimRef: ipamv1.IPAddressClaimReference{Name: claim.Name},
+			PoolRef:  claim.Spec.PoolRef,
+			Address:  address,
+			Prefix:   ptr.To(prefix),
+			Gateway:  gateway,
+		},
+	}
+	if err := controllerutil.SetControllerReference(claim, ipAddress, scheme, controllerutil.WithBlockOwnerDeletion(true)); err != nil {
+		return nil, errors.Wrapf(err, "failed to set owner reference to IPAddressClaim on IPAddress %s", klog.KObj(ipAddress))
+	}
+	if err := controllerutil.SetOwnerReference(pool, ipAddress, scheme, controllerutil.WithBlockOwnerDeletion(true)); err != nil {
+		return nil, errors.Wrapf(err, "failed to set owner reference to pool on IPAddress %s", klog.KObj(ipAddress))
+	}
+
+	// Propagate the cluster name label, so the IPAddress is moved alongside the Cluster by clusterctl move.
+	clusterName := claim.Spec.ClusterName
+	if clusterName == "" {
+		clusterName = claim.Labels[clusterv1.ClusterNameLabel]
+	}
+	if clusterName != "" {
+		ipAddress.Labels = map[string]string{clusterv1.ClusterNameLabel: clusterName}
+	}
+	return ipAddress, nil
+}
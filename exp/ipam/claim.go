@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ipam contains utils for infrastructure providers consuming IP addresses via IPAddressClaims
+// and for IPAM providers fulfilling them, as defined by the IPAM contract.
+package ipam
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+)
+
+// NewIPAddressClaim returns an IPAddressClaim for an IP address from the pool referenced by poolRef.
+// The IPAddressClaim is created in the namespace of the owner, it has a controller owner reference
+// to the owner and it belongs to the Cluster with the given name.
+func NewIPAddressClaim(c client.Client, owner client.Object, name, clusterName string, poolRef ipamv1.IPPoolReference) (*ipamv1.IPAddressClaim, error) {
+	claim := &ipamv1.IPAddressClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: owner.GetNamespace(),
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: clusterName,
+			},
+		},
+		Spec: ipamv1.IPAddressClaimSpec{
+			ClusterName: clusterName,
+			PoolRef:     poolRef,
+		},
+	}
+	if err := controllerutil.SetControllerReference(owner, claim, c.Scheme(), controllerutil.WithBlockOwnerDeletion(true)); err != nil {
+		return nil, errors.Wrapf(err, "failed to set owner reference on IPAddressClaim %s", klog.KObj(claim))
+	}
+	return claim, nil
+}
+
+// EnsureIPAddressClaim creates an IPAddressClaim for an IP address from the pool referenced by poolRef,
+// if it does not exist yet, and returns it; see NewIPAddressClaim for details.
+func EnsureIPAddressClaim(ctx context.Context, c client.Client, owner client.Object, name, clusterName string, poolRef ipamv1.IPPoolReference) (*ipamv1.IPAddressClaim, error) {
+	claim := &ipamv1.IPAddressClaim{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: owner.GetNamespace(), Name: name}, claim)
+	if err == nil {
+		return claim, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "failed to get IPAddressClaim %s", klog.KRef(owner.GetNamespace(), name))
+	}
+
+	claim, err = NewIPAddressClaim(c, owner, name, clusterName, poolRef)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Create(ctx, claim); err != nil {
+		return nil, errors.Wrapf(err, "failed to create IPAddressClaim %s", klog.KObj(claim))
+	}
+	return claim, nil
+}
+
+// GetIPAddress returns the IPAddress allocated for an IPAddressClaim, or nil if the
+// IPAddressClaim is not fulfilled yet.
+func GetIPAddress(ctx context.Context, c client.Reader, claim *ipamv1.IPAddressClaim) (*ipamv1.IPAddress, error) {
+	if claim.Status.AddressRef.Name == "" {
+		return nil, nil
+	}
+
+	address := &ipamv1.IPAddress{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: claim.Namespace, Name: claim.Status.AddressRef.Name}, address); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get IPAddress for IPAddressClaim %s", klog.KObj(claim))
+	}
+	return address, nil
+}
+
+// WaitForIPAddress waits until the IPAddressClaim with the given key is fulfilled, and then it returns
+// the allocated IPAddress.
+// NOTE: Controllers should not use WaitForIPAddress, they should rather watch IPAddressClaims and use GetIPAddress.
+func WaitForIPAddress(ctx context.Context, c client.Reader, key client.ObjectKey, interval time.Duration) (*ipamv1.IPAddress, error) {
+	var address *ipamv1.IPAddress
+	err := wait.PollUntilContextCancel(ctx, interval, true, func(ctx context.Context) (bool, error) {
+		claim := &ipamv1.IPAddressClaim{}
+		if err := c.Get(ctx, key, claim); err != nil {
+			return false, errors.Wrapf(err, "failed to get IPAddressClaim %s", key)
+		}
+
+		var err error
+		address, err = GetIPAddress(ctx, c, claim)
+		if err != nil {
+			return false, err
+		}
+		return address != nil, nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed waiting for IPAddressClaim %s to be fulfilled", key)
+	}
+	return address, nil
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+)
+
+var (
+	poolRef = ipamv1.IPPoolReference{APIGroup: "ipam.example.com", Kind: "ExamplePool", Name: "pool"}
+
+	// NOTE: Machines are used both as owner of claims and as pool in tests, to avoid defining additional types.
+	machine = &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine", UID: "machine-uid"}}
+)
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+	_ = ipamv1.AddToScheme(scheme)
+	return scheme
+}
+
+func TestEnsureIPAddressClaim(t *testing.T) {
+	t.Run("creates the IPAddressClaim if it does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := fake.NewClientBuilder().WithScheme(newScheme()).Build()
+
+		claim, err := EnsureIPAddressClaim(t.Context(), c, machine, "machine-0", "cluster", poolRef)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		got := &ipamv1.IPAddressClaim{}
+		g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(claim), got)).To(Succeed())
+		g.Expect(got.Spec.ClusterName).To(Equal("cluster"))
+		g.Expect(got.Spec.PoolRef).To(Equal(poolRef))
+		g.Expect(got.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, "cluster"))
+		g.Expect(got.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+			APIVersion:         clusterv1.GroupVersion.String(),
+			Kind:               "Machine",
+			Name:               machine.Name,
+			UID:                machine.UID,
+			Controller:         ptr.To(true),
+			BlockOwnerDeletion: ptr.To(true),
+		}))
+	})
+	t.Run("returns the IPAddressClaim if it already exists", func(t *testing.T) {
+		g := NewWithT(t)
+
+		existing := &ipamv1.IPAddressClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine-0"},
+			Spec:       ipamv1.IPAddressClaimSpec{ClusterName: "cluster", PoolRef: poolRef},
+			Status:     ipamv1.IPAddressClaimStatus{AddressRef: ipamv1.IPAddressReference{Name: "machine-0"}},
+		}
+		c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(existing).Build()
+
+		claim, err := EnsureIPAddressClaim(t.Context(), c, machine, "machine-0", "cluster", poolRef)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(claim.Status.AddressRef.Name).To(Equal("machine-0"))
+	})
+}
+
+func TestGetIPAddress(t *testing.T) {
+	address := &ipamv1.IPAddress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine-0"},
+		Spec:       ipamv1.IPAddressSpec{Address: "10.0.0.1", Prefix: ptr.To[int32](24)},
+	}
+
+	tests := []struct {
+		name        string
+		claim       *ipamv1.IPAddressClaim
+		objs        []client.Object
+		wantAddress string
+	}{
+		{
+			name:  "no IPAddress if the claim is not fulfilled",
+			claim: &ipamv1.IPAddressClaim{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine-0"}},
+			objs:  []client.Object{address},
+		},
+		{
+			name: "no IPAddress if the IPAddress does not exist yet",
+			claim: &ipamv1.IPAddressClaim{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine-0"},
+				Status:     ipamv1.IPAddressClaimStatus{AddressRef: ipamv1.IPAddressReference{Name: "machine-0"}},
+			},
+		},
+		{
+			name: "IPAddress if the claim is fulfilled",
+			claim: &ipamv1.IPAddressClaim{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine-0"},
+				Status:     ipamv1.IPAddressClaimStatus{AddressRef: ipamv1.IPAddressReference{Name: "machine-0"}},
+			},
+			objs:        []client.Object{address},
+			wantAddress: "10.0.0.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(tt.objs...).Build()
+
+			got, err := GetIPAddress(t.Context(), c, tt.claim)
+			g.Expect(err).ToNot(HaveOccurred())
+			if tt.wantAddress == "" {
+				g.Expect(got).To(BeNil())
+				return
+			}
+			g.Expect(got).ToNot(BeNil())
+			g.Expect(got.Spec.Address).To(Equal(tt.wantAddress))
+		})
+	}
+}
+
+func TestWaitForIPAddress(t *testing.T) {
+	g := NewWithT(t)
+
+	claim := &ipamv1.IPAddressClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine-0"},
+		Spec:       ipamv1.IPAddressClaimSpec{PoolRef: poolRef},
+	}
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(claim).WithStatusSubresource(claim).Build()
+
+	// Fulfill the claim after some time.
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		_ = c.Create(t.Context(), &ipamv1.IPAddress{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine-0"},
+			Spec:       ipamv1.IPAddressSpec{Address: "10.0.0.1", Prefix: ptr.To[int32](24)},
+		})
+		claim.Status.AddressRef.Name = "machine-0"
+		_ = c.Status().Update(t.Context(), claim)
+	}()
+
+	address, err := WaitForIPAddress(t.Context(), c, client.ObjectKeyFromObject(claim), 10*time.Millisecond)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(address.Spec.Address).To(Equal("10.0.0.1"))
+}
+
+func TestNewIPAddress(t *testing.T) {
+	g := NewWithT(t)
+
+	claim := &ipamv1.IPAddressClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine-0", UID: "claim-uid"},
+		Spec:       ipamv1.IPAddressClaimSpec{ClusterName: "cluster", PoolRef: poolRef},
+	}
+
+	address, err := NewIPAddress(newScheme(), claim, machine, "10.0.0.1", 24, "10.0.0.254")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(address.Name).To(Equal(claim.Name))
+	g.Expect(address.Namespace).To(Equal(claim.Namespace))
+	g.Expect(address.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, "cluster"))
+	g.Expect(address.Finalizers).To(ConsistOf(ProtectAddressFinalizer))
+	g.Expect(address.Spec).To(Equal(ipamv1.IPAddressSpec{
+		ClaimRef: ipamv1.IPAddressClaimReference{Name: claim.Name},
+		PoolRef:  poolRef,
+		Address:  "10.0.0.1",
+		Prefix:   ptr.To[int32](24),
+		Gateway:  "10.0.0.254",
+	}))
+	g.Expect(address.OwnerReferences).To(ConsistOf(
+		metav1.OwnerReference{
+			APIVersion:         ipamv1.GroupVersion.String(),
+			Kind:               "IPAddressClaim",
+			Name:               claim.Name,
+			UID:                claim.UID,
+			Controller:         ptr.To(true),
+			BlockOwnerDeletion: ptr.To(true),
+		},
+		metav1.OwnerReference{
+			APIVersion:         clusterv1.GroupVersion.String(),
+			Kind:               "Machine",
+			Name:               machine.Name,
+			UID:                machine.UID,
+			BlockOwnerDeletion: ptr.To(true),
+		},
+	))
+}
+
+func TestReferencesPool(t *testing.T) {
+	g := NewWithT(t)
+
+	claim := &ipamv1.IPAddressClaim{Spec: ipamv1.IPAddressClaimSpec{PoolRef: poolRef}}
+	g.Expect(ReferencesPool(claim, schema.GroupKind{Group: "ipam.example.com", Kind: "ExamplePool"})).To(BeTrue())
+	g.Expect(ReferencesPool(claim, schema.GroupKind{Group: "ipam.example.com", Kind: "OtherPool"})).To(BeFalse())
+	g.Expect(ReferencesPool(claim, schema.GroupKind{Group: "other.example.com", Kind: "ExamplePool"})).To(BeFalse())
+}
@@ -385,3 +385,12 @@ func GVHToPath(gvh GroupVersionHook, name string) string {
 	}
 	return fmt.Sprintf("/%s/%s/%s/%s", gvh.Group, gvh.Version, strings.ToLower(gvh.Hook), strings.ToLower(name))
 }
+
+// OpenAPIPath is the path a Runtime Extension serves the OpenAPI spec of the hooks it implements on.
+const OpenAPIPath = "/openapi/v3"
+
+// GroupToPath calculates the path a Runtime Extension serves the versions of a given group it implements on.
+// This func is aligned with Kubernetes paths for API group discovery, e.g.: /apis/storage.k8s.io.
+func GroupToPath(group string) string {
+	return fmt.Sprintf("/%s", group)
+}
@@ -33,7 +33,36 @@ import (
 
 // OpenAPI generates and returns the OpenAPI spec.
 func (c *Catalog) OpenAPI(version string) (*spec3.OpenAPI, error) {
-	openAPI := &spec3.OpenAPI{
+	openAPI := newOpenAPI(version)
+	for gvh, hookDescriptor := range c.gvhToHookDescriptor {
+		err := addHookAndTypesToOpenAPI(openAPI, c, gvh, hookDescriptor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return openAPI, nil
+}
+
+// OpenAPIForHooks generates and returns the OpenAPI spec only for the given hooks.
+// This is e.g. used by Runtime Extensions to serve the OpenAPI spec for the hooks they implement.
+func (c *Catalog) OpenAPIForHooks(version string, gvhs ...GroupVersionHook) (*spec3.OpenAPI, error) {
+	openAPI := newOpenAPI(version)
+	for _, gvh := range gvhs {
+		hookDescriptor, ok := c.gvhToHookDescriptor[gvh]
+		if !ok {
+			return nil, errors.Errorf("failed to generate OpenAPI for hook %s: hook is not registered in catalog %q", gvh, c.catalogName)
+		}
+		if err := addHookAndTypesToOpenAPI(openAPI, c, gvh, hookDescriptor); err != nil {
+			return nil, err
+		}
+	}
+
+	return openAPI, nil
+}
+
+func newOpenAPI(version string) *spec3.OpenAPI {
+	return &spec3.OpenAPI{
 		Version: "3.0.0",
 		Info: &spec.Info{
 			InfoProps: spec.InfoProps{
@@ -60,15 +89,6 @@ func (c *Catalog) OpenAPI(version string) (*spec3.OpenAPI, error) {
 			Schemas: map[string]*spec.Schema{},
 		},
 	}
-
-	for gvh, hookDescriptor := range c.gvhToHookDescriptor {
-		err := addHookAndTypesToOpenAPI(openAPI, c, gvh, hookDescriptor)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return openAPI, nil
 }
 
 func addHookAndTypesToOpenAPI(openAPI *spec3.OpenAPI, c *Catalog, gvh GroupVersionHook, hookDescriptor hookDescriptor) error {
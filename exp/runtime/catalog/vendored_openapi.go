@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package main
+package catalog
 
 import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -24,11 +24,11 @@ import (
 	"k8s.io/kube-openapi/pkg/validation/spec"
 )
 
-// GetOpenAPIDefinitions returns OpenAPIDefinitions for external types.
+// GetVendoredOpenAPIDefinitions returns OpenAPIDefinitions for external types.
 // We need them when we want to generate OpenAPI for a type embedding one of those external types.
 // Those OpenAPIDefinitions are all vendored from: https://github.com/kubernetes/kubernetes/blob/master/pkg/generated/openapi/zz_generated.openapi.go
 // Prior art: https://github.com/kubernetes-sigs/controller-tools/blob/master/pkg/crd/known_types.go.
-func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
+func GetVendoredOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
 		"k8s.io/api/core/v1.NodeSwapStatus":                             schema_k8sio_api_core_v1_NodeSwapStatus(ref),
 		"k8s.io/api/core/v1.NodeSystemInfo":                             schema_k8sio_api_core_v1_NodeSystemInfo(ref),
@@ -47,9 +47,13 @@ func (c CallExtensionCacheEntry) Key() string {
 }
 
 // WithCaching enables caching for the CallExtension call.
+// Cached responses are removed from the cache when the corresponding ExtensionConfig is changed or removed;
+// additionally, entries expire according to the ttl of the cache.
 type WithCaching struct {
-	Cache        cache.Cache[CallExtensionCacheEntry]
-	CacheKeyFunc func(extensionName, extensionConfigResourceVersion string, request runtimehooksv1.RequestObject) string
+	Cache cache.Cache[CallExtensionCacheEntry]
+	// CacheKeyFunc computes the cache key for a call; the key should include the generation of the ExtensionConfig
+	// and the settings of the request, so responses are not shared across different configurations.
+	CacheKeyFunc func(extensionName string, extensionConfigGeneration int64, request runtimehooksv1.RequestObject) string
 }
 
 // ApplyToOptions applies WithCaching to the given CallExtensionOptions.
@@ -59,11 +63,29 @@ func (w WithCaching) ApplyToOptions(in *CallExtensionOptions) {
 	in.CacheKeyFunc = w.CacheKeyFunc
 }
 
+// WithPartialRequests enables sending GeneratePatches requests larger than MaxRequestSizeBytes in multiple partial
+// requests, each with a subset of the items; the responses of the partial requests are merged into a single response.
+// Partial requests are also used when the extension rejects a request with 413 Request Entity Too Large;
+// in this case the items are split further, down to a single item per request.
+// NOTE: The option is ignored for hooks other than GeneratePatches.
+type WithPartialRequests struct {
+	MaxRequestSizeBytes int64
+}
+
+// ApplyToOptions applies WithPartialRequests to the given CallExtensionOptions.
+func (w WithPartialRequests) ApplyToOptions(in *CallExtensionOptions) {
+	in.WithPartialRequests = true
+	in.MaxRequestSizeBytes = w.MaxRequestSizeBytes
+}
+
 // CallExtensionOptions contains the options for the CallExtension call.
 type CallExtensionOptions struct {
 	WithCaching  bool
 	Cache        cache.Cache[CallExtensionCacheEntry]
-	CacheKeyFunc func(extensionName, extensionConfigResourceVersion string, request runtimehooksv1.RequestObject) string
+	CacheKeyFunc func(extensionName string, extensionConfigGeneration int64, request runtimehooksv1.RequestObject) string
+
+	WithPartialRequests bool
+	MaxRequestSizeBytes int64
 }
 
 // Client is the runtime client to interact with extensions.
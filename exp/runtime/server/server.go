@@ -18,6 +18,8 @@ limitations under the License.
 package server
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/tls"
 	"encoding/json"
@@ -27,25 +29,42 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"slices"
+	"sort"
 
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	"sigs.k8s.io/cluster-api/internal/runtime/contentencoding"
 )
 
 // DefaultPort is the default port that the webhook server serves.
 var DefaultPort = 9443
 
+// DefaultMaxRequestBodyBytes is the default maximum size of the body of a request, after decoding its content encoding.
+var DefaultMaxRequestBodyBytes int64 = 32 * 1024 * 1024
+
+const (
+	// minGzipResponseSizeBytes is the minimum size of a response to be gzip-encoded; smaller responses are not worth
+	// the compression overhead.
+	minGzipResponseSizeBytes = 4 * 1024
+)
+
 // Server is a runtime webhook server.
 type Server struct {
 	webhook.Server
-	catalog  *runtimecatalog.Catalog
-	handlers map[string]ExtensionHandler
+	catalog             *runtimecatalog.Catalog
+	handlers            map[string]ExtensionHandler
+	maxRequestBodyBytes int64
+	version             string
+	settingsSchema      *runtimehooksv1.SettingsSchema
 }
 
 // Options are the options for the Server.
@@ -87,6 +106,22 @@ type Options struct {
 	// TLSOpts is used to allow configuring the TLS config used for the server.
 	// This also allows providing a certificate via GetCertificate.
 	TLSOpts []func(*tls.Config)
+
+	// MaxRequestBodyBytes is the maximum size of the body of a request, after decoding its content encoding.
+	// Requests exceeding it are rejected with 413 Request Entity Too Large; for GeneratePatches requests,
+	// Cluster API then retries sending the items in multiple partial requests.
+	// It will be defaulted to DefaultMaxRequestBodyBytes if unspecified.
+	MaxRequestBodyBytes int64
+
+	// Version is the version of the Runtime Extension.
+	// It is used as the version of the OpenAPI spec of the registered hooks, which is served at
+	// runtimecatalog.OpenAPIPath.
+	Version string
+
+	// SettingsSchema is the schema of the settings accepted by the Runtime Extension.
+	// If set, it is returned in the response of the Discovery hook, and Cluster API validates
+	// the settings of the corresponding ExtensionConfig against it.
+	SettingsSchema *runtimehooksv1.SettingsSchema
 }
 
 // New creates a new runtime webhook server based on the given Options.
@@ -106,6 +141,9 @@ func New(options Options) (*Server, error) {
 	if options.KeyName == "" {
 		options.KeyName = "tls.key"
 	}
+	if options.MaxRequestBodyBytes <= 0 {
+		options.MaxRequestBodyBytes = DefaultMaxRequestBodyBytes
+	}
 
 	webhookServer := webhook.NewServer(
 		webhook.Options{
@@ -121,9 +159,12 @@ func New(options Options) (*Server, error) {
 	)
 
 	return &Server{
-		Server:   webhookServer,
-		catalog:  options.Catalog,
-		handlers: map[string]ExtensionHandler{},
+		Server:              webhookServer,
+		catalog:             options.Catalog,
+		handlers:            map[string]ExtensionHandler{},
+		maxRequestBodyBytes: options.MaxRequestBodyBytes,
+		version:             options.Version,
+		settingsSchema:      options.SettingsSchema,
 	}, nil
 }
 
@@ -244,7 +285,7 @@ func (s *Server) Start(ctx context.Context) error {
 	// Add discovery handler.
 	err := s.AddExtensionHandler(ExtensionHandler{
 		Hook:        runtimehooksv1.Discovery,
-		HandlerFunc: discoveryHandler(s.handlers),
+		HandlerFunc: discoveryHandler(s.handlers, s.settingsSchema),
 	})
 	if err != nil {
 		return err
@@ -258,11 +299,94 @@ func (s *Server) Start(ctx context.Context) error {
 		s.Register(handlerPath, http.HandlerFunc(wrappedHandler))
 	}
 
+	// Add OpenAPI and version discovery handlers for the registered hooks.
+	openAPIBody, err := s.openAPI()
+	if err != nil {
+		return err
+	}
+	s.Register(runtimecatalog.OpenAPIPath, getHandler(openAPIBody))
+	for group, apiGroup := range s.apiGroups() {
+		apiGroupBody, err := json.Marshal(apiGroup)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal versions of group %q", group)
+		}
+		s.Register(runtimecatalog.GroupToPath(group), getHandler(apiGroupBody))
+	}
+
 	return s.Server.Start(ctx)
 }
 
-// discoveryHandler generates a discovery handler based on a list of handlers.
-func discoveryHandler(handlers map[string]ExtensionHandler) func(context.Context, *runtimehooksv1.DiscoveryRequest, *runtimehooksv1.DiscoveryResponse) {
+// openAPI generates the OpenAPI spec for the hooks of the registered handlers.
+func (s *Server) openAPI() ([]byte, error) {
+	gvhs := []runtimecatalog.GroupVersionHook{}
+	seen := map[runtimecatalog.GroupVersionHook]bool{}
+	for _, handler := range s.handlers {
+		if seen[handler.gvh] {
+			continue
+		}
+		seen[handler.gvh] = true
+		gvhs = append(gvhs, handler.gvh)
+	}
+
+	openAPI, err := s.catalog.OpenAPIForHooks(s.version, gvhs...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate OpenAPI spec")
+	}
+	openAPIBody, err := json.Marshal(openAPI)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal OpenAPI spec")
+	}
+	return openAPIBody, nil
+}
+
+// apiGroups returns the groups and versions of the hooks of the registered handlers.
+// Note: The preferred version of a group is the highest version, given that hook versions of the same
+// group are served at the same time by a Runtime Extension.
+func (s *Server) apiGroups() map[string]*metav1.APIGroup {
+	apiGroups := map[string]*metav1.APIGroup{}
+	for _, handler := range s.handlers {
+		group := handler.gvh.Group
+		if _, ok := apiGroups[group]; !ok {
+			apiGroups[group] = &metav1.APIGroup{
+				TypeMeta: metav1.TypeMeta{Kind: "APIGroup", APIVersion: "v1"},
+				Name:     group,
+			}
+		}
+		apiGroup := apiGroups[group]
+
+		groupVersion := metav1.GroupVersionForDiscovery{
+			GroupVersion: handler.gvh.GroupVersion().String(),
+			Version:      handler.gvh.Version,
+		}
+		if !slices.Contains(apiGroup.Versions, groupVersion) {
+			apiGroup.Versions = append(apiGroup.Versions, groupVersion)
+		}
+	}
+
+	for _, apiGroup := range apiGroups {
+		sort.Slice(apiGroup.Versions, func(i, j int) bool {
+			return version.CompareKubeAwareVersionStrings(apiGroup.Versions[i].Version, apiGroup.Versions[j].Version) > 0
+		})
+		apiGroup.PreferredVersion = apiGroup.Versions[0]
+	}
+	return apiGroups
+}
+
+// getHandler returns a handler which responds to GET requests with the given JSON body.
+func getHandler(body []byte) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(body)
+	})
+}
+
+// discoveryHandler generates a discovery handler based on a list of handlers and the settings schema.
+func discoveryHandler(handlers map[string]ExtensionHandler, settingsSchema *runtimehooksv1.SettingsSchema) func(context.Context, *runtimehooksv1.DiscoveryRequest, *runtimehooksv1.DiscoveryResponse) {
 	cachedHandlers := []runtimehooksv1.ExtensionHandler{}
 	for _, handler := range handlers {
 		cachedHandlers = append(cachedHandlers, runtimehooksv1.ExtensionHandler{
@@ -279,12 +403,27 @@ func discoveryHandler(handlers map[string]ExtensionHandler) func(context.Context
 	return func(_ context.Context, _ *runtimehooksv1.DiscoveryRequest, response *runtimehooksv1.DiscoveryResponse) {
 		response.SetStatus(runtimehooksv1.ResponseStatusSuccess)
 		response.Handlers = cachedHandlers
+		response.SettingsSchema = settingsSchema
 	}
 }
 
 func (s *Server) wrapHandler(handler ExtensionHandler) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
-		response := s.callHandler(handler, r)
+		// Advertise that gzip-encoded requests are accepted, as defined in RFC 7694.
+		w.Header().Set("Accept-Encoding", contentencoding.Gzip)
+
+		var response runtimehooksv1.ResponseObject
+		requestBody, statusCode, err := s.readRequestBody(r)
+		switch {
+		case statusCode != 0:
+			w.WriteHeader(statusCode)
+			_, _ = fmt.Fprint(w, err.Error())
+			return
+		case err != nil:
+			response = failureResponse(handler, fmt.Sprintf("error reading request: %v", err))
+		default:
+			response = s.callHandler(r.Context(), handler, requestBody)
+		}
 
 		responseBody, err := json.Marshal(response)
 		if err != nil {
@@ -293,31 +432,56 @@ func (s *Server) wrapHandler(handler ExtensionHandler) func(w http.ResponseWrite
 			return
 		}
 
+		if len(responseBody) >= minGzipResponseSizeBytes && contentencoding.AcceptsGzip(r.Header.Values("Accept-Encoding")) {
+			if gzipped, err := gzipBody(responseBody); err == nil {
+				w.Header().Set("Content-Encoding", contentencoding.Gzip)
+				responseBody = gzipped
+			}
+		}
+
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write(responseBody)
 	}
 }
 
-func (s *Server) callHandler(handler ExtensionHandler, r *http.Request) runtimehooksv1.ResponseObject {
-	request := handler.requestObject.DeepCopyObject()
-	response := handler.responseObject.DeepCopyObject().(runtimehooksv1.ResponseObject)
+// readRequestBody reads the body of a request, decoding its content encoding.
+// If the request must be rejected, the HTTP status code to respond with is returned together with the error.
+func (s *Server) readRequestBody(r *http.Request) ([]byte, int, error) {
+	var body io.Reader = r.Body
+	switch encoding := r.Header.Get("Content-Encoding"); encoding {
+	case "", "identity":
+	case contentencoding.Gzip:
+		gzipReader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, 0, err
+		}
+		defer gzipReader.Close()
+		body = gzipReader
+	default:
+		return nil, http.StatusUnsupportedMediaType, errors.Errorf("unsupported content encoding %q", encoding)
+	}
 
-	requestBody, err := io.ReadAll(r.Body)
+	requestBody, err := io.ReadAll(io.LimitReader(body, s.maxRequestBodyBytes+1))
 	if err != nil {
-		response.SetStatus(runtimehooksv1.ResponseStatusFailure)
-		response.SetMessage(fmt.Sprintf("error reading request: %v", err))
-		return response
+		return nil, 0, err
+	}
+	if int64(len(requestBody)) > s.maxRequestBodyBytes {
+		return nil, http.StatusRequestEntityTooLarge, errors.Errorf("request body exceeds the maximum size of %d bytes", s.maxRequestBodyBytes)
 	}
+	return requestBody, 0, nil
+}
+
+func (s *Server) callHandler(ctx context.Context, handler ExtensionHandler, requestBody []byte) runtimehooksv1.ResponseObject {
+	request := handler.requestObject.DeepCopyObject()
+	response := handler.responseObject.DeepCopyObject().(runtimehooksv1.ResponseObject)
 
 	if err := json.Unmarshal(requestBody, request); err != nil {
-		response.SetStatus(runtimehooksv1.ResponseStatusFailure)
-		response.SetMessage(fmt.Sprintf("error unmarshalling request: %v", err))
-		return response
+		return failureResponse(handler, fmt.Sprintf("error unmarshalling request: %v", err))
 	}
 
 	// log.Log is the logger previously set via ctrl.SetLogger.
 	// This implemented analog to the logger in the controller-runtime manager.
-	ctx := ctrl.LoggerInto(r.Context(), log.Log)
+	ctx = ctrl.LoggerInto(ctx, log.Log)
 
 	reflect.ValueOf(handler.HandlerFunc).Call([]reflect.Value{
 		reflect.ValueOf(ctx),
@@ -327,3 +491,23 @@ func (s *Server) callHandler(handler ExtensionHandler, r *http.Request) runtimeh
 
 	return response
 }
+
+// failureResponse returns a failure response of the extension handler with the given message.
+func failureResponse(handler ExtensionHandler, message string) runtimehooksv1.ResponseObject {
+	response := handler.responseObject.DeepCopyObject().(runtimehooksv1.ResponseObject)
+	response.SetStatus(runtimehooksv1.ResponseStatusFailure)
+	response.SetMessage(message)
+	return response
+}
+
+func gzipBody(body []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"maps"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -221,7 +222,8 @@ func (g *generator) Generate(ctx context.Context, s *scope.Scope) (*scope.Cluste
 	// are preserved during patching. When desired objects are computed their spec is copied from a template, in some cases
 	// further modifications to the spec are made afterwards. In those cases we have to make sure those fields are not overwritten
 	// in apply patches. Some examples are .spec.machineTemplate and .spec.version in control planes.
-	if err := g.patchEngine.Apply(ctx, s.Blueprint, desiredState); err != nil {
+	s.AppliedPatches, err = g.patchEngine.Apply(ctx, s.Blueprint, desiredState)
+	if err != nil {
 		return nil, errors.Wrap(err, "failed to apply patches")
 	}
 
@@ -302,7 +304,7 @@ func (g *generator) computeControlPlaneInfrastructureMachineTemplate(ctx context
 		template:              template,
 		templateClonedFromRef: templateClonedFromRef,
 		cluster:               cluster,
-		nameGenerator:         topologynames.SimpleNameGenerator(topologynames.ControlPlaneInfrastructureMachineTemplateNamePrefix(cluster.Name)),
+		nameGenerator:         topologynames.ControlPlaneInfrastructureMachineTemplateNameGenerator(s.Blueprint.ClusterClass.Spec.ControlPlane.Naming.MachineInfrastructureTemplate, cluster.Name),
 		currentObjectName:     currentObjectName,
 		// Note: we are adding an ownerRef to Cluster so the template will be automatically garbage collected
 		// in case of errors in between creating this template and updating the Cluster object
@@ -502,6 +504,15 @@ func (g *generator) computeControlPlane(ctx context.Context, s *scope.Scope, inf
 		return nil, errors.Wrapf(err, "failed to set %s in the ControlPlane object", contract.ControlPlane().Version().Path())
 	}
 
+	// If a rollout is requested in the topology, set the corresponding field.
+	// NOTE: If the Topology.RolloutAfter value is not set, it is assumed that the control plane controller
+	// might not implement support for this field and the ControlPlane object is generated without rolloutAfter.
+	if !s.Blueprint.Topology.RolloutAfter.IsZero() {
+		if err := contract.ControlPlane().RolloutAfter(contractVersion).Set(controlPlane, s.Blueprint.Topology.RolloutAfter); err != nil {
+			return nil, errors.Wrapf(err, "failed to set %s in the ControlPlane object", contract.ControlPlane().RolloutAfter(contractVersion).Path())
+		}
+	}
+
 	return controlPlane, nil
 }
 
@@ -819,7 +830,7 @@ func (g *generator) computeMachineDeployment(ctx context.Context, s *scope.Scope
 		template:              machineDeploymentBlueprint.BootstrapTemplate,
 		templateClonedFromRef: contract.ObjToRef(machineDeploymentBlueprint.BootstrapTemplate),
 		cluster:               s.Current.Cluster,
-		nameGenerator:         topologynames.SimpleNameGenerator(topologynames.BootstrapTemplateNamePrefix(s.Current.Cluster.Name, machineDeploymentTopology.Name)),
+		nameGenerator:         topologynames.BootstrapTemplateNameGenerator(machineDeploymentClass.Naming.BootstrapTemplate, s.Current.Cluster.Name, machineDeploymentTopology.Name),
 		currentObjectName:     currentBootstrapTemplateRef.Name,
 		// Note: we are adding an ownerRef to Cluster so the template will be automatically garbage collected
 		// in case of errors in between creating this template and creating/updating the MachineDeployment object
@@ -847,7 +858,7 @@ func (g *generator) computeMachineDeployment(ctx context.Context, s *scope.Scope
 		template:              machineDeploymentBlueprint.InfrastructureMachineTemplate,
 		templateClonedFromRef: contract.ObjToRef(machineDeploymentBlueprint.InfrastructureMachineTemplate),
 		cluster:               s.Current.Cluster,
-		nameGenerator:         topologynames.SimpleNameGenerator(topologynames.InfrastructureMachineTemplateNamePrefix(s.Current.Cluster.Name, machineDeploymentTopology.Name)),
+		nameGenerator:         topologynames.InfrastructureMachineTemplateNameGenerator(machineDeploymentClass.Naming.InfrastructureTemplate, s.Current.Cluster.Name, machineDeploymentTopology.Name),
 		currentObjectName:     ptr.Deref(currentInfraMachineTemplateRef, clusterv1.ContractVersionedObjectReference{}).Name,
 		// Note: we are adding an ownerRef to Cluster so the template will be automatically garbage collected
 		// in case of errors in between creating this template and creating/updating the MachineDeployment object
@@ -899,6 +910,10 @@ func (g *generator) computeMachineDeployment(ctx context.Context, s *scope.Scope
 			},
 		}
 	}
+	rollout.After, err = g.computeMachineDeploymentRolloutAfter(ctx, s, currentMachineDeployment)
+	if err != nil {
+		return nil, err
+	}
 
 	remediationMaxInFlight := machineDeploymentClass.HealthCheck.Remediation.MaxInFlight
 	if machineDeploymentTopology.HealthCheck.Remediation.MaxInFlight != nil {
@@ -910,6 +925,14 @@ func (g *generator) computeMachineDeployment(ctx context.Context, s *scope.Scope
 		failureDomain = machineDeploymentTopology.FailureDomain
 	}
 
+	architecture := machineDeploymentClass.Architecture
+	if machineDeploymentTopology.Architecture != "" {
+		architecture = machineDeploymentTopology.Architecture
+	}
+	if err := validateArchitecture(machineDeploymentBlueprint.InfrastructureMachineTemplate, architecture); err != nil {
+		return nil, err
+	}
+
 	deletionOrder := machineDeploymentClass.Deletion.Order
 	if machineDeploymentTopology.Deletion.Order != "" {
 		deletionOrder = machineDeploymentTopology.Deletion.Order
@@ -997,8 +1020,15 @@ func (g *generator) computeMachineDeployment(ctx context.Context, s *scope.Scope
 	// Ensure the annotations used to control the upgrade sequence are never propagated.
 	delete(machineDeploymentAnnotations, clusterv1.ClusterTopologyHoldUpgradeSequenceAnnotation)
 	delete(machineDeploymentAnnotations, clusterv1.ClusterTopologyDeferUpgradeAnnotation)
-	desiredMachineDeploymentObj.SetAnnotations(machineDeploymentAnnotations)
 	desiredMachineDeploymentObj.Spec.Template.Annotations = machineDeploymentAnnotations
+	if architecture != "" {
+		// Surface the architecture to the cluster autoscaler, so it is considered when scaling from zero.
+		// NOTE: The annotation is set only on the MachineDeployment, it is not relevant for Machines.
+		machineDeploymentAnnotations = util.MergeMap(map[string]string{
+			clusterv1.AutoscalerCapacityLabelsAnnotation: setAutoscalerCapacityLabel(machineDeploymentAnnotations[clusterv1.AutoscalerCapacityLabelsAnnotation], corev1.LabelArchStable, string(architecture)),
+		}, machineDeploymentAnnotations)
+	}
+	desiredMachineDeploymentObj.SetAnnotations(machineDeploymentAnnotations)
 
 	// Apply Labels
 	// NOTE: On top of all the labels applied to managed objects we are applying the ClusterTopologyMachineDeploymentLabel
@@ -1010,6 +1040,9 @@ func (g *generator) computeMachineDeployment(ctx context.Context, s *scope.Scope
 	machineDeploymentLabels[clusterv1.ClusterNameLabel] = s.Current.Cluster.Name
 	machineDeploymentLabels[clusterv1.ClusterTopologyOwnedLabel] = ""
 	machineDeploymentLabels[clusterv1.ClusterTopologyMachineDeploymentNameLabel] = machineDeploymentTopology.Name
+	if architecture != "" {
+		machineDeploymentLabels[clusterv1.MachineArchitectureLabel] = string(architecture)
+	}
 	desiredMachineDeploymentObj.SetLabels(machineDeploymentLabels)
 
 	// Also set the labels in .spec.template.labels so that they are propagated to
@@ -1044,6 +1077,39 @@ func (g *generator) computeMachineDeployment(ctx context.Context, s *scope.Scope
 	return desiredMachineDeployment, nil
 }
 
+// validateArchitecture validates that the architecture of the machines is supported by the InfrastructureMachineTemplate,
+// if the InfrastructureMachineTemplate reports the architecture it supports via status.nodeInfo.architecture.
+func validateArchitecture(infrastructureMachineTemplate *unstructured.Unstructured, architecture clusterv1.MachineArchitecture) error {
+	if architecture == "" || infrastructureMachineTemplate == nil {
+		return nil
+	}
+	templateArchitecture, err := contract.InfrastructureMachineTemplate().NodeInfoArchitecture().Get(infrastructureMachineTemplate)
+	if err != nil {
+		if errors.Is(err, contract.ErrFieldNotFound) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get architecture from %s %s", infrastructureMachineTemplate.GetKind(), klog.KObj(infrastructureMachineTemplate))
+	}
+	if *templateArchitecture != "" && *templateArchitecture != string(architecture) {
+		return errors.Errorf("architecture %s is not supported by %s %s, which supports %s", architecture, infrastructureMachineTemplate.GetKind(), klog.KObj(infrastructureMachineTemplate), *templateArchitecture)
+	}
+	return nil
+}
+
+// setAutoscalerCapacityLabel sets a label in the value of the capacity.cluster-autoscaler.kubernetes.io/labels annotation,
+// i.e. a comma separated list of key=value pairs, preserving all the other labels.
+func setAutoscalerCapacityLabel(labels, key, value string) string {
+	pairs := []string{}
+	for _, pair := range strings.Split(labels, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" || strings.HasPrefix(pair, key+"=") {
+			continue
+		}
+		pairs = append(pairs, pair)
+	}
+	return strings.Join(append(pairs, fmt.Sprintf("%s=%s", key, value)), ",")
+}
+
 // computeMachineDeploymentVersion calculates the version of the desired machine deployment.
 // The version is calculated using the state of the current machine deployments,
 // the current control plane and the version defined in the topology.
@@ -1130,6 +1196,108 @@ func (g *generator) computeMachineDeploymentVersion(ctx context.Context, s *scop
 	return nextVersion, nil
 }
 
+// computeMachineDeploymentRolloutAfter calculates the rolloutAfter of the desired MachineDeployment.
+// The rolloutAfter defined in the topology is propagated to existing MachineDeployments only after
+// the control plane completed its own rollout, so the control plane is always rolled out first, like for upgrades.
+func (g *generator) computeMachineDeploymentRolloutAfter(ctx context.Context, s *scope.Scope, currentMDState *scope.MachineDeploymentState) (metav1.Time, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	rolloutAfter := s.Blueprint.Topology.RolloutAfter
+	if rolloutAfter.IsZero() {
+		return metav1.Time{}, nil
+	}
+
+	// If the MachineDeployment is being created, set rolloutAfter only if it is not in the future.
+	// NOTE: Machines of a new MachineDeployment are created after rolloutAfter, so they are not rolled out.
+	// If rolloutAfter is in the future, it will be picked up after the control plane completed its rollout.
+	if currentMDState == nil || currentMDState.Object == nil {
+		if rolloutAfter.After(time.Now()) {
+			return metav1.Time{}, nil
+		}
+		return rolloutAfter, nil
+	}
+
+	// If the MachineDeployment already picked up rolloutAfter, there is nothing to do.
+	currentRolloutAfter := currentMDState.Object.Spec.Rollout.After
+	if currentRolloutAfter.Equal(&rolloutAfter) {
+		return rolloutAfter, nil
+	}
+
+	// Otherwise, defer the rollout of the MachineDeployment until the control plane completed its rollout.
+	controlPlaneRolledOut, err := g.isControlPlaneRolledOut(ctx, s)
+	if err != nil {
+		return metav1.Time{}, err
+	}
+	if !controlPlaneRolledOut {
+		log.V(3).Info(fmt.Sprintf("MachineDeployment %s rollout deferred, waiting for the control plane to complete its rollout", currentMDState.Object.Name))
+		return currentRolloutAfter, nil
+	}
+	return rolloutAfter, nil
+}
+
+// isControlPlaneRolledOut returns true if the control plane completed the rollout requested by rolloutAfter in the topology.
+func (g *generator) isControlPlaneRolledOut(ctx context.Context, s *scope.Scope) (bool, error) {
+	rolloutAfter := s.Blueprint.Topology.RolloutAfter
+
+	// The rollout of the control plane doesn't start before rolloutAfter.
+	if rolloutAfter.After(time.Now()) {
+		return false, nil
+	}
+
+	// If the control plane is provisioning or upgrading, it is not considered rolled out.
+	if !s.UpgradeTracker.ControlPlane.IsControlPlaneStable() {
+		return false, nil
+	}
+
+	// If the control plane doesn't have machines, there is nothing to roll out.
+	if !s.Blueprint.HasControlPlaneInfrastructureMachine() || s.Current.ControlPlane == nil || s.Current.ControlPlane.Object == nil {
+		return true, nil
+	}
+
+	controlPlane := s.Current.ControlPlane.Object
+	contractVersion, err := contract.GetContractVersionForVersion(ctx, g.Client, controlPlane.GroupVersionKind().GroupKind(), controlPlane.GroupVersionKind().Version)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get contract version for the ControlPlane object")
+	}
+
+	// The control plane must have picked up rolloutAfter.
+	currentRolloutAfter, err := contract.ControlPlane().RolloutAfter(contractVersion).Get(controlPlane)
+	if err != nil {
+		if errors.Is(err, contract.ErrFieldNotFound) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get %s from the ControlPlane object", contract.ControlPlane().RolloutAfter(contractVersion).Path())
+	}
+	if !currentRolloutAfter.Equal(&rolloutAfter) {
+		return false, nil
+	}
+
+	// All the control plane machines must be up-to-date.
+	// NOTE: If the control plane doesn't manage replicas, there is no way to determine if the rollout is completed.
+	replicas, err := contract.ControlPlane().Replicas().Get(controlPlane)
+	if err != nil {
+		if errors.Is(err, contract.ErrFieldNotFound) {
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "failed to get %s from the ControlPlane object", contract.ControlPlane().Replicas().Path())
+	}
+	statusReplicas, err := contract.ControlPlane().StatusReplicas().Get(controlPlane)
+	if err != nil {
+		if errors.Is(err, contract.ErrFieldNotFound) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get %s from the ControlPlane object", contract.ControlPlane().StatusReplicas().Path())
+	}
+	upToDateReplicas, err := contract.ControlPlane().UpToDateReplicas(contractVersion).Get(controlPlane)
+	if err != nil {
+		if errors.Is(err, contract.ErrFieldNotFound) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get %s from the ControlPlane object", contract.ControlPlane().UpToDateReplicas(contractVersion).Path())
+	}
+	return *statusReplicas == *replicas && *upToDateReplicas == *replicas, nil
+}
+
 // isMachineDeploymentDeferred returns true if the upgrade for the mdTopology is deferred.
 // This is the case when either:
 //   - the mdTopology has the ClusterTopologyDeferUpgradeAnnotation annotation.
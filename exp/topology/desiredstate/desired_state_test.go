@@ -525,10 +525,56 @@ func TestComputeControlPlane(t *testing.T) {
 		assertNestedField(g, obj, int64(topologyDuration), contract.ControlPlane().MachineTemplate().NodeVolumeDetachTimeoutSeconds().Path()...)
 		assertNestedField(g, obj, int64(topologyDuration), contract.ControlPlane().MachineTemplate().NodeDeletionTimeoutSeconds().Path()...)
 		assertNestedFieldUnset(g, obj, contract.ControlPlane().MachineTemplate().InfrastructureRef().Path()...)
+		assertNestedFieldUnset(g, obj, contract.ControlPlane().RolloutAfter("v1beta2").Path()...)
 
 		// Ensure no ownership is added to generated ControlPlane.
 		g.Expect(obj.GetOwnerReferences()).To(BeEmpty())
 	})
+	t.Run("Generates the ControlPlane with rolloutAfter from the topology", func(t *testing.T) {
+		rolloutAfter := metav1.Date(2025, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+		tests := []struct {
+			name            string
+			client          client.Client
+			contractVersion string
+		}{
+			{
+				name:            "v1beta1 contract",
+				client:          clientWithV1Beta1ContractCRD,
+				contractVersion: "v1beta1",
+			},
+			{
+				name:            "v1beta2 contract",
+				client:          clientWithV1Beta2ContractCRD,
+				contractVersion: "v1beta2",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				g := NewWithT(t)
+
+				topology := cluster.Spec.Topology.DeepCopy()
+				topology.RolloutAfter = rolloutAfter
+				blueprint := &scope.ClusterBlueprint{
+					Topology:     *topology,
+					ClusterClass: clusterClass,
+					ControlPlane: &scope.ControlPlaneBlueprint{
+						Template: controlPlaneTemplate,
+					},
+				}
+
+				// aggregating current cluster objects into ClusterState (simulating getCurrentState)
+				scope := scope.New(cluster)
+				scope.Blueprint = blueprint
+
+				obj, err := (&generator{Client: tt.client}).computeControlPlane(ctx, scope, nil)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(obj).ToNot(BeNil())
+
+				assertNestedField(g, obj, "2025-03-01T10:00:00Z", contract.ControlPlane().RolloutAfter(tt.contractVersion).Path()...)
+			})
+		}
+	})
 	t.Run("Generates the ControlPlane from the template using ClusterClass defaults (v1beta1 contract)", func(t *testing.T) {
 		g := NewWithT(t)
 
@@ -2113,6 +2159,62 @@ func TestComputeMachineDeployment(t *testing.T) {
 		g.Expect(actualMd.Spec.Template.Spec.ReadinessGates).To(BeNil())
 	})
 
+	t.Run("Propagates the architecture to labels and to the autoscaler annotation", func(t *testing.T) {
+		g := NewWithT(t)
+
+		clusterClassWithArchitecture := fakeClass.DeepCopy()
+		clusterClassWithArchitecture.Spec.Workers.MachineDeployments[0].Architecture = clusterv1.MachineArchitectureArm64
+
+		blueprint := &scope.ClusterBlueprint{
+			Topology:     cluster.Spec.Topology,
+			ClusterClass: clusterClassWithArchitecture,
+			MachineDeployments: map[string]*scope.MachineDeploymentBlueprint{
+				"linux-worker": {
+					Metadata: clusterv1.ObjectMeta{
+						Annotations: map[string]string{
+							clusterv1.AutoscalerCapacityLabelsAnnotation: "foo=bar,kubernetes.io/arch=amd64",
+						},
+					},
+					BootstrapTemplate:             workerBootstrapTemplate,
+					InfrastructureMachineTemplate: workerInfrastructureMachineTemplate,
+				},
+			},
+		}
+
+		scope := scope.New(cluster)
+		scope.Blueprint = blueprint
+
+		mdTopology := clusterv1.MachineDeploymentTopology{
+			Class:    "linux-worker",
+			Name:     "big-pool-of-machines",
+			Replicas: &replicas,
+		}
+
+		e := generator{}
+
+		actual, err := e.computeMachineDeployment(ctx, scope, mdTopology)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		actualMd := actual.Object
+		g.Expect(actualMd.Labels).To(HaveKeyWithValue(clusterv1.MachineArchitectureLabel, "arm64"))
+		g.Expect(actualMd.Spec.Template.Labels).To(HaveKeyWithValue(clusterv1.MachineArchitectureLabel, "arm64"))
+		g.Expect(actualMd.Annotations).To(HaveKeyWithValue(clusterv1.AutoscalerCapacityLabelsAnnotation, "foo=bar,kubernetes.io/arch=arm64"))
+		g.Expect(actualMd.Spec.Template.Annotations).To(HaveKeyWithValue(clusterv1.AutoscalerCapacityLabelsAnnotation, "foo=bar,kubernetes.io/arch=amd64"))
+
+		// The architecture from the topology overrides the architecture from the ClusterClass.
+		mdTopology.Architecture = clusterv1.MachineArchitectureAmd64
+		actual, err = e.computeMachineDeployment(ctx, scope, mdTopology)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(actual.Object.Labels).To(HaveKeyWithValue(clusterv1.MachineArchitectureLabel, "amd64"))
+
+		// The architecture must be supported by the InfrastructureMachineTemplate, if declared.
+		infrastructureMachineTemplate := workerInfrastructureMachineTemplate.DeepCopy()
+		g.Expect(contract.InfrastructureMachineTemplate().NodeInfoArchitecture().Set(infrastructureMachineTemplate, "arm64")).To(Succeed())
+		blueprint.MachineDeployments["linux-worker"].InfrastructureMachineTemplate = infrastructureMachineTemplate
+		_, err = e.computeMachineDeployment(ctx, scope, mdTopology)
+		g.Expect(err).To(MatchError(ContainSubstring("architecture amd64 is not supported by GenericInfrastructureMachineTemplate default/linux-worker-inframachinetemplate, which supports arm64")))
+	})
+
 	t.Run("If there is already a machine deployment, it preserves the object name and the reference names", func(t *testing.T) {
 		g := NewWithT(t)
 		s := scope.New(cluster)
@@ -2357,6 +2459,37 @@ func TestComputeMachineDeployment(t *testing.T) {
 	})
 }
 
+func TestSetAutoscalerCapacityLabel(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels string
+		want   string
+	}{
+		{
+			name:   "adds the label if there are no labels",
+			labels: "",
+			want:   "kubernetes.io/arch=arm64",
+		},
+		{
+			name:   "adds the label preserving other labels",
+			labels: "foo=bar, bar=baz",
+			want:   "foo=bar,bar=baz,kubernetes.io/arch=arm64",
+		},
+		{
+			name:   "replaces the label if already set",
+			labels: "kubernetes.io/arch=amd64,foo=bar",
+			want:   "foo=bar,kubernetes.io/arch=arm64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(setAutoscalerCapacityLabel(tt.labels, corev1.LabelArchStable, "arm64")).To(Equal(tt.want))
+		})
+	}
+}
+
 func TestComputeMachinePool(t *testing.T) {
 	workerInfrastructureMachinePool := builder.InfrastructureMachinePoolTemplate(metav1.NamespaceDefault, "linux-worker-inframachinepool").
 		Build()
@@ -2986,6 +3119,143 @@ func TestComputeMachineDeploymentVersion(t *testing.T) {
 	}
 }
 
+func TestComputeMachineDeploymentRolloutAfter(t *testing.T) {
+	pastRolloutAfter := metav1.NewTime(time.Now().Add(-1 * time.Hour).Truncate(time.Second))
+	futureRolloutAfter := metav1.NewTime(time.Now().Add(1 * time.Hour).Truncate(time.Second))
+	previousRolloutAfter := metav1.NewTime(time.Now().Add(-24 * time.Hour).Truncate(time.Second))
+
+	scheme := runtime.NewScheme()
+	_ = apiextensionsv1.AddToScheme(scheme)
+	crd := builder.GenericControlPlaneCRD.DeepCopy()
+	crd.Labels = map[string]string{
+		fmt.Sprintf("%s/%s", clusterv1.GroupVersion.Group, "v1beta2"): clusterv1.GroupVersionControlPlane.Version,
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(crd).Build()
+
+	clusterClass := builder.ClusterClass(metav1.NamespaceDefault, "class1").
+		WithControlPlaneInfrastructureMachineTemplate(builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "cpinfra1").Build()).
+		Build()
+
+	controlPlane := func(rolloutAfter *metav1.Time, upToDateReplicas int64) *unstructured.Unstructured {
+		specFields := map[string]interface{}{
+			"spec.replicas": int64(3),
+		}
+		if rolloutAfter != nil {
+			specFields["spec.rollout.after"] = rolloutAfter.UTC().Format(time.RFC3339)
+		}
+		return builder.ControlPlane(metav1.NamespaceDefault, "cp1").
+			WithSpecFields(specFields).
+			WithStatusFields(map[string]interface{}{
+				"status.replicas":         int64(3),
+				"status.upToDateReplicas": upToDateReplicas,
+			}).
+			Build()
+	}
+	machineDeployment := func(rolloutAfter metav1.Time) *scope.MachineDeploymentState {
+		md := builder.MachineDeployment(metav1.NamespaceDefault, "md1").Build()
+		md.Spec.Rollout.After = rolloutAfter
+		return &scope.MachineDeploymentState{Object: md}
+	}
+
+	tests := []struct {
+		name                          string
+		topologyRolloutAfter          metav1.Time
+		currentControlPlane           *unstructured.Unstructured
+		currentMachineDeploymentState *scope.MachineDeploymentState
+		controlPlaneUpgrading         bool
+		expectedRolloutAfter          metav1.Time
+	}{
+		{
+			name:                          "should not set rolloutAfter if not set in the topology",
+			currentControlPlane:           controlPlane(nil, 3),
+			currentMachineDeploymentState: machineDeployment(metav1.Time{}),
+			expectedRolloutAfter:          metav1.Time{},
+		},
+		{
+			name:                          "should set rolloutAfter if creating a new MachineDeployment and rolloutAfter is in the past",
+			topologyRolloutAfter:          pastRolloutAfter,
+			currentControlPlane:           controlPlane(nil, 3),
+			currentMachineDeploymentState: nil,
+			expectedRolloutAfter:          pastRolloutAfter,
+		},
+		{
+			name:                          "should not set rolloutAfter if creating a new MachineDeployment and rolloutAfter is in the future",
+			topologyRolloutAfter:          futureRolloutAfter,
+			currentControlPlane:           controlPlane(nil, 3),
+			currentMachineDeploymentState: nil,
+			expectedRolloutAfter:          metav1.Time{},
+		},
+		{
+			name:                          "should keep rolloutAfter if already picked up by the MachineDeployment",
+			topologyRolloutAfter:          pastRolloutAfter,
+			currentControlPlane:           controlPlane(nil, 0),
+			currentMachineDeploymentState: machineDeployment(pastRolloutAfter),
+			expectedRolloutAfter:          pastRolloutAfter,
+		},
+		{
+			name:                          "should keep the current rolloutAfter if rolloutAfter is in the future",
+			topologyRolloutAfter:          futureRolloutAfter,
+			currentControlPlane:           controlPlane(&futureRolloutAfter, 3),
+			currentMachineDeploymentState: machineDeployment(previousRolloutAfter),
+			expectedRolloutAfter:          previousRolloutAfter,
+		},
+		{
+			name:                          "should keep the current rolloutAfter if the control plane is upgrading",
+			topologyRolloutAfter:          pastRolloutAfter,
+			currentControlPlane:           controlPlane(&pastRolloutAfter, 3),
+			currentMachineDeploymentState: machineDeployment(previousRolloutAfter),
+			controlPlaneUpgrading:         true,
+			expectedRolloutAfter:          previousRolloutAfter,
+		},
+		{
+			name:                          "should keep the current rolloutAfter if the control plane did not pick up rolloutAfter yet",
+			topologyRolloutAfter:          pastRolloutAfter,
+			currentControlPlane:           controlPlane(&previousRolloutAfter, 3),
+			currentMachineDeploymentState: machineDeployment(previousRolloutAfter),
+			expectedRolloutAfter:          previousRolloutAfter,
+		},
+		{
+			name:                          "should keep the current rolloutAfter if the control plane is rolling out",
+			topologyRolloutAfter:          pastRolloutAfter,
+			currentControlPlane:           controlPlane(&pastRolloutAfter, 2),
+			currentMachineDeploymentState: machineDeployment(metav1.Time{}),
+			expectedRolloutAfter:          metav1.Time{},
+		},
+		{
+			name:                          "should set rolloutAfter if the control plane completed its rollout",
+			topologyRolloutAfter:          pastRolloutAfter,
+			currentControlPlane:           controlPlane(&pastRolloutAfter, 3),
+			currentMachineDeploymentState: machineDeployment(previousRolloutAfter),
+			expectedRolloutAfter:          pastRolloutAfter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s := &scope.Scope{
+				Blueprint: &scope.ClusterBlueprint{
+					Topology: clusterv1.Topology{
+						RolloutAfter: tt.topologyRolloutAfter,
+					},
+					ClusterClass: clusterClass,
+				},
+				Current: &scope.ClusterState{
+					ControlPlane: &scope.ControlPlaneState{Object: tt.currentControlPlane},
+				},
+				UpgradeTracker: scope.NewUpgradeTracker(),
+			}
+			s.UpgradeTracker.ControlPlane.IsUpgrading = tt.controlPlaneUpgrading
+
+			e := generator{Client: fakeClient}
+
+			rolloutAfter, err := e.computeMachineDeploymentRolloutAfter(ctx, s, tt.currentMachineDeploymentState)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(rolloutAfter.Equal(&tt.expectedRolloutAfter)).To(BeTrue(), "expected %v, got %v", tt.expectedRolloutAfter, rolloutAfter)
+		})
+	}
+}
+
 func TestComputeMachinePoolVersion(t *testing.T) {
 	controlPlaneObj := builder.ControlPlane("test1", "cp1").
 		Build()
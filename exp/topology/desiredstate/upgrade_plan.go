@@ -203,7 +203,7 @@ func DefaultAndValidateUpgradePlans(desiredVersion string, controlPlaneVersion s
 			// Check that the workers upgrade plan only includes the same versions considered for the control plane upgrade plan,
 			// plus the control plane version to handle the case that CP already completed its upgrade.
 			if diff := sets.New(workersUpgradePlan...).Difference(sets.New(controlPlaneUpgradePlan...).Insert(controlPlaneVersion)); len(diff) > 0 {
-				return nil, errors.Errorf("invalid workers upgrade plan: versions %s doesn't match any versions in the control plane upgrade plan nor the control plane version", strings.Join(diff.UnsortedList(), ","))
+				return nil, errors.Errorf("invalid workers upgrade plan: versions %s doesn't match any versions in the control plane upgrade plan nor the control plane version", strings.Join(sets.List(diff), ","))
 			}
 		}
 
@@ -116,31 +116,39 @@ func (b *ClusterBlueprint) IsControlPlaneMachineHealthCheckEnabled() bool {
 
 // ControlPlaneMachineHealthCheckClass returns the MachineHealthCheckClass that should be used to create the MachineHealthCheck object.
 func (b *ClusterBlueprint) ControlPlaneMachineHealthCheckClass() (clusterv1.MachineHealthCheckChecks, clusterv1.MachineHealthCheckRemediation) {
-	if b.Topology.ControlPlane.HealthCheck.IsDefined() {
-		return clusterv1.MachineHealthCheckChecks{
-				NodeStartupTimeoutSeconds:  b.Topology.ControlPlane.HealthCheck.Checks.NodeStartupTimeoutSeconds,
-				UnhealthyNodeConditions:    b.Topology.ControlPlane.HealthCheck.Checks.UnhealthyNodeConditions,
-				UnhealthyMachineConditions: b.Topology.ControlPlane.HealthCheck.Checks.UnhealthyMachineConditions,
-			}, clusterv1.MachineHealthCheckRemediation{
-				TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
-					UnhealthyLessThanOrEqualTo: b.Topology.ControlPlane.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
-					UnhealthyInRange:           b.Topology.ControlPlane.HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
-				},
-				TemplateRef: b.Topology.ControlPlane.HealthCheck.Remediation.TemplateRef,
-			}
-	}
-
-	return clusterv1.MachineHealthCheckChecks{
-			NodeStartupTimeoutSeconds:  b.ControlPlane.HealthCheck.Checks.NodeStartupTimeoutSeconds,
-			UnhealthyNodeConditions:    b.ControlPlane.HealthCheck.Checks.UnhealthyNodeConditions,
-			UnhealthyMachineConditions: b.ControlPlane.HealthCheck.Checks.UnhealthyMachineConditions,
-		}, clusterv1.MachineHealthCheckRemediation{
-			TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
-				UnhealthyLessThanOrEqualTo: b.ControlPlane.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
-				UnhealthyInRange:           b.ControlPlane.HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
-			},
-			TemplateRef: b.ControlPlane.HealthCheck.Remediation.TemplateRef,
-		}
+	topologyHealthCheck := b.Topology.ControlPlane.HealthCheck
+	classChecks := clusterv1.MachineHealthCheckChecks{
+		NodeStartupTimeoutSeconds:  b.ControlPlane.HealthCheck.Checks.NodeStartupTimeoutSeconds,
+		UnhealthyNodeConditions:    b.ControlPlane.HealthCheck.Checks.UnhealthyNodeConditions,
+		UnhealthyMachineConditions: b.ControlPlane.HealthCheck.Checks.UnhealthyMachineConditions,
+	}
+	classRemediation := clusterv1.MachineHealthCheckRemediation{
+		TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+			UnhealthyLessThanOrEqualTo: b.ControlPlane.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
+			UnhealthyInRange:           b.ControlPlane.HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
+		},
+		TemplateRef: b.ControlPlane.HealthCheck.Remediation.TemplateRef,
+	}
+	if !topologyHealthCheck.IsDefined() {
+		return classChecks, classRemediation
+	}
+
+	topologyChecks := clusterv1.MachineHealthCheckChecks{
+		NodeStartupTimeoutSeconds:  topologyHealthCheck.Checks.NodeStartupTimeoutSeconds,
+		UnhealthyNodeConditions:    topologyHealthCheck.Checks.UnhealthyNodeConditions,
+		UnhealthyMachineConditions: topologyHealthCheck.Checks.UnhealthyMachineConditions,
+	}
+	topologyRemediation := clusterv1.MachineHealthCheckRemediation{
+		TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+			UnhealthyLessThanOrEqualTo: topologyHealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
+			UnhealthyInRange:           topologyHealthCheck.Remediation.TriggerIf.UnhealthyInRange,
+		},
+		TemplateRef: topologyHealthCheck.Remediation.TemplateRef,
+	}
+	if topologyHealthCheck.OverrideStrategy != clusterv1.TopologyHealthCheckOverrideStrategyMerge {
+		return topologyChecks, topologyRemediation
+	}
+	return mergeMachineHealthCheckClass(classChecks, classRemediation, topologyChecks, topologyRemediation)
 }
 
 // HasControlPlaneMachineHealthCheck returns true if the ControlPlaneClass has both MachineInfrastructure and a MachineHealthCheck defined.
@@ -165,31 +173,63 @@ func (b *ClusterBlueprint) IsMachineDeploymentMachineHealthCheckEnabled(md *clus
 
 // MachineDeploymentMachineHealthCheckClass return the MachineHealthCheckClass that should be used to create the MachineHealthCheck object.
 func (b *ClusterBlueprint) MachineDeploymentMachineHealthCheckClass(md *clusterv1.MachineDeploymentTopology) (clusterv1.MachineHealthCheckChecks, clusterv1.MachineHealthCheckRemediation) {
-	if md.HealthCheck.IsDefined() {
-		return clusterv1.MachineHealthCheckChecks{
-				NodeStartupTimeoutSeconds:  md.HealthCheck.Checks.NodeStartupTimeoutSeconds,
-				UnhealthyNodeConditions:    md.HealthCheck.Checks.UnhealthyNodeConditions,
-				UnhealthyMachineConditions: md.HealthCheck.Checks.UnhealthyMachineConditions,
-			}, clusterv1.MachineHealthCheckRemediation{
-				TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
-					UnhealthyLessThanOrEqualTo: md.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
-					UnhealthyInRange:           md.HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
-				},
-				TemplateRef: md.HealthCheck.Remediation.TemplateRef,
-			}
-	}
-
-	return clusterv1.MachineHealthCheckChecks{
-			NodeStartupTimeoutSeconds:  b.MachineDeployments[md.Class].HealthCheck.Checks.NodeStartupTimeoutSeconds,
-			UnhealthyNodeConditions:    b.MachineDeployments[md.Class].HealthCheck.Checks.UnhealthyNodeConditions,
-			UnhealthyMachineConditions: b.MachineDeployments[md.Class].HealthCheck.Checks.UnhealthyMachineConditions,
-		}, clusterv1.MachineHealthCheckRemediation{
-			TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
-				UnhealthyLessThanOrEqualTo: b.MachineDeployments[md.Class].HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
-				UnhealthyInRange:           b.MachineDeployments[md.Class].HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
-			},
-			TemplateRef: b.MachineDeployments[md.Class].HealthCheck.Remediation.TemplateRef,
-		}
+	classHealthCheck := b.MachineDeployments[md.Class].HealthCheck
+	classChecks := clusterv1.MachineHealthCheckChecks{
+		NodeStartupTimeoutSeconds:  classHealthCheck.Checks.NodeStartupTimeoutSeconds,
+		UnhealthyNodeConditions:    classHealthCheck.Checks.UnhealthyNodeConditions,
+		UnhealthyMachineConditions: classHealthCheck.Checks.UnhealthyMachineConditions,
+	}
+	classRemediation := clusterv1.MachineHealthCheckRemediation{
+		TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+			UnhealthyLessThanOrEqualTo: classHealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
+			UnhealthyInRange:           classHealthCheck.Remediation.TriggerIf.UnhealthyInRange,
+		},
+		TemplateRef: classHealthCheck.Remediation.TemplateRef,
+	}
+	if !md.HealthCheck.IsDefined() {
+		return classChecks, classRemediation
+	}
+
+	topologyChecks := clusterv1.MachineHealthCheckChecks{
+		NodeStartupTimeoutSeconds:  md.HealthCheck.Checks.NodeStartupTimeoutSeconds,
+		UnhealthyNodeConditions:    md.HealthCheck.Checks.UnhealthyNodeConditions,
+		UnhealthyMachineConditions: md.HealthCheck.Checks.UnhealthyMachineConditions,
+	}
+	topologyRemediation := clusterv1.MachineHealthCheckRemediation{
+		TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+			UnhealthyLessThanOrEqualTo: md.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
+			UnhealthyInRange:           md.HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
+		},
+		TemplateRef: md.HealthCheck.Remediation.TemplateRef,
+	}
+	if md.HealthCheck.OverrideStrategy != clusterv1.TopologyHealthCheckOverrideStrategyMerge {
+		return topologyChecks, topologyRemediation
+	}
+	return mergeMachineHealthCheckClass(classChecks, classRemediation, topologyChecks, topologyRemediation)
+}
+
+// mergeMachineHealthCheckClass overrides each field of the checks and remediation defined in the ClusterClass
+// with the corresponding field defined in the Cluster topology, if set.
+func mergeMachineHealthCheckClass(classChecks clusterv1.MachineHealthCheckChecks, classRemediation clusterv1.MachineHealthCheckRemediation, topologyChecks clusterv1.MachineHealthCheckChecks, topologyRemediation clusterv1.MachineHealthCheckRemediation) (clusterv1.MachineHealthCheckChecks, clusterv1.MachineHealthCheckRemediation) {
+	checks, remediation := classChecks, classRemediation
+	if topologyChecks.NodeStartupTimeoutSeconds != nil {
+		checks.NodeStartupTimeoutSeconds = topologyChecks.NodeStartupTimeoutSeconds
+	}
+	if len(topologyChecks.UnhealthyNodeConditions) > 0 {
+		checks.UnhealthyNodeConditions = topologyChecks.UnhealthyNodeConditions
+	}
+	if len(topologyChecks.UnhealthyMachineConditions) > 0 {
+		checks.UnhealthyMachineConditions = topologyChecks.UnhealthyMachineConditions
+	}
+	// NOTE: unhealthyLessThanOrEqualTo and unhealthyInRange are overridden together, given that unhealthyInRange
+	// takes precedence over unhealthyLessThanOrEqualTo.
+	if topologyRemediation.TriggerIf.UnhealthyLessThanOrEqualTo != nil || topologyRemediation.TriggerIf.UnhealthyInRange != "" {
+		remediation.TriggerIf = topologyRemediation.TriggerIf
+	}
+	if topologyRemediation.TemplateRef.IsDefined() {
+		remediation.TemplateRef = topologyRemediation.TemplateRef
+	}
+	return checks, remediation
 }
 
 // HasMachineDeployments checks whether the topology has MachineDeployments.
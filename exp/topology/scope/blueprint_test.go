@@ -378,6 +378,55 @@ func TestControlPlaneMachineHealthCheckClass(t *testing.T) {
 			},
 			wantRemediation: clusterv1.MachineHealthCheckRemediation{},
 		},
+		{
+			name: "should merge the MachineHealthCheck from cluster topology into the MachineHealthCheck from ClusterClass if overrideStrategy is Merge",
+			blueprint: &ClusterBlueprint{
+				Topology: *builder.ClusterTopology().
+					WithControlPlaneMachineHealthCheck(clusterv1.ControlPlaneTopologyHealthCheck{
+						Remediation: clusterv1.ControlPlaneTopologyHealthCheckRemediation{
+							TriggerIf: clusterv1.ControlPlaneTopologyHealthCheckRemediationTriggerIf{
+								UnhealthyLessThanOrEqualTo: ptr.To(intstr.FromString("50%")),
+							},
+						},
+						OverrideStrategy: clusterv1.TopologyHealthCheckOverrideStrategyMerge,
+					}).
+					Build(),
+				ControlPlane: &ControlPlaneBlueprint{
+					HealthCheck: clusterv1.ControlPlaneClassHealthCheck{
+						Checks: clusterv1.ControlPlaneClassHealthCheckChecks{
+							NodeStartupTimeoutSeconds: ptr.To(int32(15 * 60)),
+							UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+								{
+									Type:           corev1.NodeReady,
+									Status:         corev1.ConditionFalse,
+									TimeoutSeconds: ptr.To(int32(10 * 60)),
+								},
+							},
+						},
+						Remediation: clusterv1.ControlPlaneClassHealthCheckRemediation{
+							TriggerIf: clusterv1.ControlPlaneClassHealthCheckRemediationTriggerIf{
+								UnhealthyInRange: "[1-3]",
+							},
+						},
+					},
+				},
+			},
+			wantChecks: clusterv1.MachineHealthCheckChecks{
+				NodeStartupTimeoutSeconds: ptr.To(int32(15 * 60)),
+				UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+					{
+						Type:           corev1.NodeReady,
+						Status:         corev1.ConditionFalse,
+						TimeoutSeconds: ptr.To(int32(10 * 60)),
+					},
+				},
+			},
+			wantRemediation: clusterv1.MachineHealthCheckRemediation{
+				TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+					UnhealthyLessThanOrEqualTo: ptr.To(intstr.FromString("50%")),
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -740,6 +789,55 @@ func TestMachineDeploymentMachineHealthCheckClass(t *testing.T) {
 			},
 			wantRemediation: clusterv1.MachineHealthCheckRemediation{},
 		},
+		{
+			name: "should merge the MachineHealthCheck from cluster topology into the MachineHealthCheck from ClusterClass if overrideStrategy is Merge",
+			blueprint: &ClusterBlueprint{
+				MachineDeployments: map[string]*MachineDeploymentBlueprint{
+					"worker-class": {
+						HealthCheck: clusterv1.MachineDeploymentClassHealthCheck{
+							Checks: clusterv1.MachineDeploymentClassHealthCheckChecks{
+								UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+									{
+										Type:           corev1.NodeReady,
+										Status:         corev1.ConditionFalse,
+										TimeoutSeconds: ptr.To(int32(10 * 60)),
+									},
+								},
+							},
+							Remediation: clusterv1.MachineDeploymentClassHealthCheckRemediation{
+								TriggerIf: clusterv1.MachineDeploymentClassHealthCheckRemediationTriggerIf{
+									UnhealthyLessThanOrEqualTo: ptr.To(intstr.FromString("40%")),
+								},
+							},
+						},
+					},
+				},
+			},
+			mdTopology: &clusterv1.MachineDeploymentTopology{
+				Class: "worker-class",
+				HealthCheck: clusterv1.MachineDeploymentTopologyHealthCheck{
+					Checks: clusterv1.MachineDeploymentTopologyHealthCheckChecks{
+						NodeStartupTimeoutSeconds: ptr.To(int32(30 * 60)),
+					},
+					OverrideStrategy: clusterv1.TopologyHealthCheckOverrideStrategyMerge,
+				},
+			},
+			wantChecks: clusterv1.MachineHealthCheckChecks{
+				NodeStartupTimeoutSeconds: ptr.To(int32(30 * 60)),
+				UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+					{
+						Type:           corev1.NodeReady,
+						Status:         corev1.ConditionFalse,
+						TimeoutSeconds: ptr.To(int32(10 * 60)),
+					},
+				},
+			},
+			wantRemediation: clusterv1.MachineHealthCheckRemediation{
+				TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+					UnhealthyLessThanOrEqualTo: ptr.To(intstr.FromString("40%")),
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
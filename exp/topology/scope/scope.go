@@ -39,6 +39,9 @@ type Scope struct {
 	// HookResponseTracker holds the hook responses that will be used to
 	// calculate a combined reconcile result.
 	HookResponseTracker *HookResponseTracker
+
+	// AppliedPatches holds the outcome of the external patches called while computing the desired state.
+	AppliedPatches []clusterv1.ClusterTopologyAppliedPatch
 }
 
 // New returns a new Scope with only the cluster; while processing a request in the topology/ClusterReconciler controller
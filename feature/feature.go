@@ -77,6 +77,22 @@ const (
 	//
 	// alpha: v1.12
 	MachineTaintPropagation featuregate.Feature = "MachineTaintPropagation"
+
+	// ClusterTemplate is a feature gate for the ClusterTemplate and ClusterTemplateInstance functionality.
+	//
+	// alpha: v1.12
+	ClusterTemplate featuregate.Feature = "ClusterTemplate"
+
+	// OwnerReferenceRepair is a feature gate for the controller which repairs the ownerReferences and finalizers
+	// of the objects of a Cluster.
+	//
+	// alpha: v1.12
+	OwnerReferenceRepair featuregate.Feature = "OwnerReferenceRepair"
+
+	// MachineSetStandby is a feature gate for the standby Machines functionality of MachineSets and MachineDeployments.
+	//
+	// alpha: v1.12
+	MachineSetStandby featuregate.Feature = "MachineSetStandby"
 )
 
 func init() {
@@ -96,4 +112,7 @@ var defaultClusterAPIFeatureGates = map[featuregate.Feature]featuregate.FeatureS
 	RuntimeSDK:                     {Default: false, PreRelease: featuregate.Alpha},
 	InPlaceUpdates:                 {Default: false, PreRelease: featuregate.Alpha},
 	MachineTaintPropagation:        {Default: false, PreRelease: featuregate.Alpha},
+	ClusterTemplate:                {Default: false, PreRelease: featuregate.Alpha},
+	OwnerReferenceRepair:           {Default: false, PreRelease: featuregate.Alpha},
+	MachineSetStandby:              {Default: false, PreRelease: featuregate.Alpha},
 }
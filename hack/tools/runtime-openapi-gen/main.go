@@ -26,8 +26,6 @@ import (
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/yaml"
 
-	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
-	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
 )
@@ -55,10 +53,6 @@ func main() {
 	c := runtimecatalog.New()
 	_ = runtimehooksv1.AddToCatalog(c)
 
-	c.AddOpenAPIDefinitions(clusterv1.GetOpenAPIDefinitions)
-	c.AddOpenAPIDefinitions(clusterv1beta1.GetOpenAPIDefinitions)
-	c.AddOpenAPIDefinitions(GetOpenAPIDefinitions)
-
 	openAPI, err := c.OpenAPI(*version)
 	if err != nil {
 		klog.Exitf("Failed to generate OpenAPI specification: %v", err)
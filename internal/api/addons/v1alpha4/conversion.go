@@ -54,6 +54,7 @@ func (src *ClusterResourceSet) ConvertTo(dstRaw conversion.Hub) error {
 		return err
 	}
 	dst.Status.Conditions = restored.Status.Conditions
+	dst.Spec.Sources = restored.Spec.Sources
 
 	return nil
 }
@@ -94,6 +95,8 @@ func (src *ClusterResourceSetBinding) ConvertTo(dstRaw conversion.Hub) error {
 		return err
 	}
 	dst.Spec.ClusterName = restored.Spec.ClusterName
+	restoreResourceBindings(&dst.Spec, &restored.Spec)
+	dst.Status = restored.Status
 	return nil
 }
 
@@ -112,6 +115,12 @@ func (dst *ClusterResourceSetBinding) ConvertFrom(srcRaw conversion.Hub) error {
 	return nil
 }
 
+// Convert_v1beta2_ClusterResourceSetBinding_To_v1alpha4_ClusterResourceSetBinding is a conversion function.
+func Convert_v1beta2_ClusterResourceSetBinding_To_v1alpha4_ClusterResourceSetBinding(in *addonsv1.ClusterResourceSetBinding, out *ClusterResourceSetBinding, s apimachineryconversion.Scope) error {
+	// Status does not exist in ClusterResourceSetBinding v1alpha4 API.
+	return autoConvert_v1beta2_ClusterResourceSetBinding_To_v1alpha4_ClusterResourceSetBinding(in, out, s)
+}
+
 // Convert_v1beta2_ClusterResourceSetBindingSpec_To_v1alpha4_ClusterResourceSetBindingSpec is a conversion function.
 func Convert_v1beta2_ClusterResourceSetBindingSpec_To_v1alpha4_ClusterResourceSetBindingSpec(in *addonsv1.ClusterResourceSetBindingSpec, out *ClusterResourceSetBindingSpec, s apimachineryconversion.Scope) error {
 	// Spec.ClusterName does not exist in ClusterResourceSetBinding v1alpha4 API.
@@ -167,3 +176,23 @@ func Convert_v1_Condition_To_v1alpha4_Condition(in *metav1.Condition, out *clust
 func Convert_v1alpha4_Condition_To_v1_Condition(in *clusterv1alpha4.Condition, out *metav1.Condition, s apimachineryconversion.Scope) error {
 	return clusterv1alpha4.Convert_v1alpha4_Condition_To_v1_Condition(in, out, s)
 }
+
+func Convert_v1beta2_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec(in *addonsv1.ClusterResourceSetSpec, out *ClusterResourceSetSpec, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec(in, out, s)
+}
+
+// restoreResourceBindings restores the reason and message of the resource bindings, which do not exist in the v1alpha4 API.
+func restoreResourceBindings(dst, restored *addonsv1.ClusterResourceSetBindingSpec) {
+	for i := range dst.Bindings {
+		if i >= len(restored.Bindings) || dst.Bindings[i].ClusterResourceSetName != restored.Bindings[i].ClusterResourceSetName {
+			continue
+		}
+		for j := range dst.Bindings[i].Resources {
+			if j >= len(restored.Bindings[i].Resources) || !reflect.DeepEqual(dst.Bindings[i].Resources[j].ResourceRef, restored.Bindings[i].Resources[j].ResourceRef) {
+				continue
+			}
+			dst.Bindings[i].Resources[j].Reason = restored.Bindings[i].Resources[j].Reason
+			dst.Bindings[i].Resources[j].Message = restored.Bindings[i].Resources[j].Message
+		}
+	}
+}
@@ -1066,9 +1066,13 @@ func autoConvert_v1beta2_KubeadmConfigSpec_To_v1alpha3_KubeadmConfigSpec(in *v1b
 		out.Users = nil
 	}
 	// WARNING: in.NTP requires manual conversion: inconvertible types (sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2.NTP vs *sigs.k8s.io/cluster-api/internal/api/bootstrap/kubeadm/v1alpha3.NTP)
+	// WARNING: in.TrustedCertificateAuthorities requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalUserData requires manual conversion: does not exist in peer-type
 	out.Format = Format(in.Format)
 	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	// WARNING: in.Ignition requires manual conversion: does not exist in peer-type
+	// WARNING: in.Diagnostics requires manual conversion: does not exist in peer-type
+	// WARNING: in.Templating requires manual conversion: does not exist in peer-type
 	return nil
 }
 
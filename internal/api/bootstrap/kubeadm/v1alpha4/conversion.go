@@ -94,6 +94,10 @@ func RestoreKubeadmConfigSpec(dst *bootstrapv1.KubeadmConfigSpec, restored *boot
 
 	dst.BootCommands = restored.BootCommands
 	dst.Ignition = restored.Ignition
+	dst.TrustedCertificateAuthorities = restored.TrustedCertificateAuthorities
+	dst.AdditionalUserData = restored.AdditionalUserData
+	dst.Diagnostics = restored.Diagnostics
+	dst.Templating = restored.Templating
 
 	dst.ClusterConfiguration.APIServer.ExtraEnvs = restored.ClusterConfiguration.APIServer.ExtraEnvs
 	dst.ClusterConfiguration.ControllerManager.ExtraEnvs = restored.ClusterConfiguration.ControllerManager.ExtraEnvs
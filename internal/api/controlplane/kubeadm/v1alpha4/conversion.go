@@ -97,12 +97,19 @@ func (src *KubeadmControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.MachineTemplate.Spec.ReadinessGates = restored.Spec.MachineTemplate.Spec.ReadinessGates
 		dst.Spec.MachineTemplate.Spec.Deletion.NodeDeletionTimeoutSeconds = restored.Spec.MachineTemplate.Spec.Deletion.NodeDeletionTimeoutSeconds
 		dst.Spec.MachineTemplate.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = restored.Spec.MachineTemplate.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
+		dst.Spec.MachineTemplate.Spec.TaintPolicy = restored.Spec.MachineTemplate.Spec.TaintPolicy
 		dst.Spec.Rollout = restored.Spec.Rollout
 
 		dst.Spec.Remediation = restored.Spec.Remediation
 		dst.Status.LastRemediation = restored.Status.LastRemediation
+		dst.Status.RolloutReasons = restored.Status.RolloutReasons
 
 		dst.Spec.MachineNaming = restored.Spec.MachineNaming
+		dst.Spec.Etcd = restored.Spec.Etcd
+		dst.Spec.EndpointManager = restored.Spec.EndpointManager
+		dst.Spec.EncryptionAtRest = restored.Spec.EncryptionAtRest
+		dst.Status.EncryptionAtRest = restored.Status.EncryptionAtRest
+		dst.Status.Etcd = restored.Status.Etcd
 
 		bootstrapv1alpha4.RestoreKubeadmConfigSpec(&dst.Spec.KubeadmConfigSpec, &restored.Spec.KubeadmConfigSpec)
 		dst.Status.Conditions = restored.Status.Conditions
@@ -193,6 +200,8 @@ func (src *KubeadmControlPlaneTemplate) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.Template.Spec.Remediation = restored.Spec.Template.Spec.Remediation
 
 		dst.Spec.Template.Spec.MachineNaming = restored.Spec.Template.Spec.MachineNaming
+		dst.Spec.Template.Spec.Etcd = restored.Spec.Template.Spec.Etcd
+		dst.Spec.Template.Spec.EndpointManager = restored.Spec.Template.Spec.EndpointManager
 
 		bootstrapv1alpha4.RestoreKubeadmConfigSpec(&dst.Spec.Template.Spec.KubeadmConfigSpec, &restored.Spec.Template.Spec.KubeadmConfigSpec)
 	}
@@ -131,9 +131,17 @@ func (src *Cluster) ConvertTo(dstRaw conversion.Hub) error {
 	if ok {
 		dst.Spec.AvailabilityGates = restored.Spec.AvailabilityGates
 		dst.Spec.Topology = restored.Spec.Topology
+		dst.Spec.Pause = restored.Spec.Pause
+		dst.Spec.DegradedFreeze = restored.Spec.DegradedFreeze
+		dst.Spec.Deletion = restored.Spec.Deletion
+		dst.Spec.IdentityRef = restored.Spec.IdentityRef
 		dst.Status.Conditions = restored.Status.Conditions
 		dst.Status.ControlPlane = restored.Status.ControlPlane
 		dst.Status.Workers = restored.Status.Workers
+		dst.Status.FailureDomainsSummary = restored.Status.FailureDomainsSummary
+		dst.Status.Topology = restored.Status.Topology
+		dst.Status.LastOperations = restored.Status.LastOperations
+		dst.Status.Deletion = restored.Status.Deletion
 	}
 
 	return nil
@@ -247,11 +255,18 @@ func (src *Machine) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.MinReadySeconds = restored.Spec.MinReadySeconds
 		dst.Spec.ReadinessGates = restored.Spec.ReadinessGates
 		dst.Spec.Taints = restored.Spec.Taints
+		dst.Spec.Deletion.NodeVolumeDetachExclusions = restored.Spec.Deletion.NodeVolumeDetachExclusions
+		dst.Spec.KubeletServingCertificateVerification = restored.Spec.KubeletServingCertificateVerification
+		dst.Spec.NodeReadinessChecks = restored.Spec.NodeReadinessChecks
+		dst.Spec.PowerState = restored.Spec.PowerState
+		dst.Spec.InfrastructureReadyTimeoutSeconds = restored.Spec.InfrastructureReadyTimeoutSeconds
+		dst.Spec.InfrastructureOverrides = restored.Spec.InfrastructureOverrides
 		dst.Spec.Deletion.NodeDeletionTimeoutSeconds = restored.Spec.Deletion.NodeDeletionTimeoutSeconds
 		dst.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = restored.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
 		dst.Status.NodeInfo = restored.Status.NodeInfo
 		dst.Status.CertificatesExpiryDate = restored.Status.CertificatesExpiryDate
 		dst.Status.Deletion = restored.Status.Deletion
+		dst.Status.LastProvisioningFailure = restored.Status.LastProvisioningFailure
 		dst.Status.Conditions = restored.Status.Conditions
 	}
 
@@ -335,6 +350,15 @@ func (src *MachineSet) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	dst.Spec.Template.Spec.ReadinessGates = restored.Spec.Template.Spec.ReadinessGates
 	dst.Spec.Template.Spec.Taints = restored.Spec.Template.Spec.Taints
+	dst.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions
+	dst.Spec.Template.Spec.KubeletServingCertificateVerification = restored.Spec.Template.Spec.KubeletServingCertificateVerification
+	dst.Spec.Template.Spec.NodeReadinessChecks = restored.Spec.Template.Spec.NodeReadinessChecks
+	dst.Spec.Template.Spec.PowerState = restored.Spec.Template.Spec.PowerState
+	dst.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds = restored.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds
+	dst.Spec.Template.Spec.InfrastructureOverrides = restored.Spec.Template.Spec.InfrastructureOverrides
+	dst.Spec.Rebalance = restored.Spec.Rebalance
+	dst.Spec.Standby = restored.Spec.Standby
+	dst.Status.StoppedReplicas = restored.Status.StoppedReplicas
 	dst.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds
 	dst.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
 	if restored.Status.Deprecated != nil && restored.Status.Deprecated.V1Beta1 != nil {
@@ -428,9 +452,24 @@ func (src *MachineDeployment) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.MachineNaming = restored.Spec.MachineNaming
 		dst.Spec.Template.Spec.ReadinessGates = restored.Spec.Template.Spec.ReadinessGates
 		dst.Spec.Template.Spec.Taints = restored.Spec.Template.Spec.Taints
+		dst.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions
+		dst.Spec.Template.Spec.KubeletServingCertificateVerification = restored.Spec.Template.Spec.KubeletServingCertificateVerification
+		dst.Spec.Template.Spec.NodeReadinessChecks = restored.Spec.Template.Spec.NodeReadinessChecks
+		dst.Spec.Template.Spec.PowerState = restored.Spec.Template.Spec.PowerState
+		dst.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds = restored.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds
+		dst.Spec.Template.Spec.InfrastructureOverrides = restored.Spec.Template.Spec.InfrastructureOverrides
+		dst.Spec.Rebalance = restored.Spec.Rebalance
+		dst.Spec.Standby = restored.Spec.Standby
+		dst.Spec.PowerStateSchedule = restored.Spec.PowerStateSchedule
+		dst.Spec.MinReplicas = restored.Spec.MinReplicas
+		dst.Spec.MaxReplicas = restored.Spec.MaxReplicas
+		dst.Status.StoppedReplicas = restored.Status.StoppedReplicas
 		dst.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds
 		dst.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
 		dst.Spec.Rollout.After = restored.Spec.Rollout.After
+		dst.Spec.Rollout.MachineSetReadyTimeoutSeconds = restored.Spec.Rollout.MachineSetReadyTimeoutSeconds
+		dst.Spec.Rollout.NodeReadinessChecks = restored.Spec.Rollout.NodeReadinessChecks
+		dst.Spec.Rollout.Rollback = restored.Spec.Rollout.Rollback
 		if restored.Status.Deprecated != nil && restored.Status.Deprecated.V1Beta1 != nil {
 			dst.Status.Deprecated.V1Beta1.Conditions = restored.Status.Deprecated.V1Beta1.Conditions
 		}
@@ -519,6 +558,10 @@ func (src *MachineHealthCheck) ConvertTo(dstRaw conversion.Hub) error {
 	if restored.Spec.Remediation.TriggerIf.UnhealthyInRange != "" {
 		dst.Spec.Remediation.TriggerIf.UnhealthyInRange = restored.Spec.Remediation.TriggerIf.UnhealthyInRange
 	}
+	dst.Spec.Remediation.Reboot = restored.Spec.Remediation.Reboot
+	dst.Spec.Remediation.Cordon = restored.Spec.Remediation.Cordon
+	dst.Spec.Checks.NodeProbe = restored.Spec.Checks.NodeProbe
+	clusterv1.RestoreUnhealthyNodeConditionsSlidingWindow(restored.Spec.Checks.UnhealthyNodeConditions, dst.Spec.Checks.UnhealthyNodeConditions)
 	dst.Status.Conditions = restored.Status.Conditions
 
 	return nil
@@ -607,10 +650,20 @@ func (src *MachinePool) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds
 		dst.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
 		dst.Spec.Template.Spec.Taints = restored.Spec.Template.Spec.Taints
+		dst.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions
+		dst.Spec.Template.Spec.KubeletServingCertificateVerification = restored.Spec.Template.Spec.KubeletServingCertificateVerification
+		dst.Spec.Template.Spec.NodeReadinessChecks = restored.Spec.Template.Spec.NodeReadinessChecks
+		dst.Spec.Template.Spec.PowerState = restored.Spec.Template.Spec.PowerState
+		dst.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds = restored.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds
+		dst.Spec.Template.Spec.InfrastructureOverrides = restored.Spec.Template.Spec.InfrastructureOverrides
+		dst.Spec.Machines = restored.Spec.Machines
+		dst.Spec.MinReplicas = restored.Spec.MinReplicas
+		dst.Spec.MaxReplicas = restored.Spec.MaxReplicas
 		dst.Status.Conditions = restored.Status.Conditions
 		dst.Status.AvailableReplicas = restored.Status.AvailableReplicas
 		dst.Status.ReadyReplicas = restored.Status.ReadyReplicas
 		dst.Status.UpToDateReplicas = restored.Status.UpToDateReplicas
+		dst.Status.Selector = restored.Status.Selector
 	}
 
 	return nil
@@ -984,6 +1037,10 @@ func Convert_v1alpha3_MachinePoolSpec_To_v1beta2_MachinePoolSpec(in *MachinePool
 	return autoConvert_v1alpha3_MachinePoolSpec_To_v1beta2_MachinePoolSpec(in, out, s)
 }
 
+func Convert_v1beta2_MachinePoolSpec_To_v1alpha3_MachinePoolSpec(in *clusterv1.MachinePoolSpec, out *MachinePoolSpec, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_MachinePoolSpec_To_v1alpha3_MachinePoolSpec(in, out, s)
+}
+
 func Convert_v1alpha3_MachinePool_To_v1beta2_MachinePool(in *MachinePool, out *clusterv1.MachinePool, s apimachineryconversion.Scope) error {
 	if err := autoConvert_v1alpha3_MachinePool_To_v1beta2_MachinePool(in, out, s); err != nil {
 		return err
@@ -117,6 +117,11 @@ func hubMachineSpec(in *clusterv1.MachineSpec, c randfill.Continue) {
 		in.Bootstrap.ConfigRef.APIGroup = gvk.Group
 		in.Bootstrap.ConfigRef.Kind = gvk.Kind
 	}
+
+	// Ensure infrastructureOverrides have a valid JSON spec.
+	for i := range in.InfrastructureOverrides {
+		in.InfrastructureOverrides[i].Spec = apiextensionsv1.JSON{Raw: []byte(`{"instanceType":"test"}`)}
+	}
 }
 
 func hubMachineStatus(in *clusterv1.MachineStatus, c randfill.Continue) {
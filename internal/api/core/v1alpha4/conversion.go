@@ -123,8 +123,13 @@ func (src *Cluster) ConvertTo(dstRaw conversion.Hub) error {
 	// Recover other values
 	if ok {
 		dst.Spec.AvailabilityGates = restored.Spec.AvailabilityGates
+		dst.Spec.Pause = restored.Spec.Pause
+		dst.Spec.DegradedFreeze = restored.Spec.DegradedFreeze
+		dst.Spec.Deletion = restored.Spec.Deletion
+		dst.Spec.IdentityRef = restored.Spec.IdentityRef
 		dst.Spec.Topology.ClassRef.Namespace = restored.Spec.Topology.ClassRef.Namespace
 		dst.Spec.Topology.Variables = restored.Spec.Topology.Variables
+		dst.Spec.Topology.FailureDomains = restored.Spec.Topology.FailureDomains
 		dst.Spec.Topology.ControlPlane.Variables = restored.Spec.Topology.ControlPlane.Variables
 
 		dst.Spec.Topology.ControlPlane.HealthCheck = restored.Spec.Topology.ControlPlane.HealthCheck
@@ -153,6 +158,7 @@ func (src *Cluster) ConvertTo(dstRaw conversion.Hub) error {
 			dst.Spec.Topology.Workers.MachineDeployments[i].MinReadySeconds = restored.Spec.Topology.Workers.MachineDeployments[i].MinReadySeconds
 			dst.Spec.Topology.Workers.MachineDeployments[i].Rollout.Strategy = restored.Spec.Topology.Workers.MachineDeployments[i].Rollout.Strategy
 			dst.Spec.Topology.Workers.MachineDeployments[i].HealthCheck = restored.Spec.Topology.Workers.MachineDeployments[i].HealthCheck
+			dst.Spec.Topology.Workers.MachineDeployments[i].Architecture = restored.Spec.Topology.Workers.MachineDeployments[i].Architecture
 		}
 
 		dst.Spec.Topology.Workers.MachinePools = restored.Spec.Topology.Workers.MachinePools
@@ -160,6 +166,10 @@ func (src *Cluster) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Conditions = restored.Status.Conditions
 		dst.Status.ControlPlane = restored.Status.ControlPlane
 		dst.Status.Workers = restored.Status.Workers
+		dst.Status.FailureDomainsSummary = restored.Status.FailureDomainsSummary
+		dst.Status.Topology = restored.Status.Topology
+		dst.Status.LastOperations = restored.Status.LastOperations
+		dst.Status.Deletion = restored.Status.Deletion
 	}
 
 	return nil
@@ -261,6 +271,7 @@ func (src *ClusterClass) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.Workers.MachineDeployments[i].Deletion.NodeDeletionTimeoutSeconds = restored.Spec.Workers.MachineDeployments[i].Deletion.NodeDeletionTimeoutSeconds
 		dst.Spec.Workers.MachineDeployments[i].MinReadySeconds = restored.Spec.Workers.MachineDeployments[i].MinReadySeconds
 		dst.Spec.Workers.MachineDeployments[i].Rollout.Strategy = restored.Spec.Workers.MachineDeployments[i].Rollout.Strategy
+		dst.Spec.Workers.MachineDeployments[i].Architecture = restored.Spec.Workers.MachineDeployments[i].Architecture
 	}
 	dst.Status = restored.Status
 	dst.Spec.Upgrade.External.GenerateUpgradePlanExtension = restored.Spec.Upgrade.External.GenerateUpgradePlanExtension
@@ -334,7 +345,14 @@ func (src *Machine) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.CertificatesExpiryDate = restored.Status.CertificatesExpiryDate
 		dst.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = restored.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
 		dst.Spec.Taints = restored.Spec.Taints
+		dst.Spec.Deletion.NodeVolumeDetachExclusions = restored.Spec.Deletion.NodeVolumeDetachExclusions
+		dst.Spec.KubeletServingCertificateVerification = restored.Spec.KubeletServingCertificateVerification
+		dst.Spec.NodeReadinessChecks = restored.Spec.NodeReadinessChecks
+		dst.Spec.PowerState = restored.Spec.PowerState
+		dst.Spec.InfrastructureReadyTimeoutSeconds = restored.Spec.InfrastructureReadyTimeoutSeconds
+		dst.Spec.InfrastructureOverrides = restored.Spec.InfrastructureOverrides
 		dst.Status.Deletion = restored.Status.Deletion
+		dst.Status.LastProvisioningFailure = restored.Status.LastProvisioningFailure
 		dst.Status.Conditions = restored.Status.Conditions
 	}
 
@@ -424,6 +442,15 @@ func (src *MachineSet) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds
 	dst.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
 	dst.Spec.Template.Spec.Taints = restored.Spec.Template.Spec.Taints
+	dst.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions
+	dst.Spec.Template.Spec.KubeletServingCertificateVerification = restored.Spec.Template.Spec.KubeletServingCertificateVerification
+	dst.Spec.Template.Spec.NodeReadinessChecks = restored.Spec.Template.Spec.NodeReadinessChecks
+	dst.Spec.Template.Spec.PowerState = restored.Spec.Template.Spec.PowerState
+	dst.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds = restored.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds
+	dst.Spec.Template.Spec.InfrastructureOverrides = restored.Spec.Template.Spec.InfrastructureOverrides
+	dst.Spec.Rebalance = restored.Spec.Rebalance
+	dst.Spec.Standby = restored.Spec.Standby
+	dst.Status.StoppedReplicas = restored.Status.StoppedReplicas
 	dst.Status.Conditions = restored.Status.Conditions
 	dst.Status.AvailableReplicas = restored.Status.AvailableReplicas
 	dst.Status.ReadyReplicas = restored.Status.ReadyReplicas
@@ -518,9 +545,24 @@ func (src *MachineDeployment) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds
 		dst.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
 		dst.Spec.Rollout.After = restored.Spec.Rollout.After
+		dst.Spec.Rollout.MachineSetReadyTimeoutSeconds = restored.Spec.Rollout.MachineSetReadyTimeoutSeconds
+		dst.Spec.Rollout.NodeReadinessChecks = restored.Spec.Rollout.NodeReadinessChecks
+		dst.Spec.Rollout.Rollback = restored.Spec.Rollout.Rollback
 		dst.Spec.Remediation = restored.Spec.Remediation
 		dst.Spec.MachineNaming = restored.Spec.MachineNaming
 		dst.Spec.Template.Spec.Taints = restored.Spec.Template.Spec.Taints
+		dst.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions
+		dst.Spec.Template.Spec.KubeletServingCertificateVerification = restored.Spec.Template.Spec.KubeletServingCertificateVerification
+		dst.Spec.Template.Spec.NodeReadinessChecks = restored.Spec.Template.Spec.NodeReadinessChecks
+		dst.Spec.Template.Spec.PowerState = restored.Spec.Template.Spec.PowerState
+		dst.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds = restored.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds
+		dst.Spec.Template.Spec.InfrastructureOverrides = restored.Spec.Template.Spec.InfrastructureOverrides
+		dst.Spec.Rebalance = restored.Spec.Rebalance
+		dst.Spec.Standby = restored.Spec.Standby
+		dst.Spec.PowerStateSchedule = restored.Spec.PowerStateSchedule
+		dst.Spec.MinReplicas = restored.Spec.MinReplicas
+		dst.Spec.MaxReplicas = restored.Spec.MaxReplicas
+		dst.Status.StoppedReplicas = restored.Status.StoppedReplicas
 		dst.Status.Conditions = restored.Status.Conditions
 		dst.Status.AvailableReplicas = restored.Status.AvailableReplicas
 		dst.Status.ReadyReplicas = restored.Status.ReadyReplicas
@@ -606,6 +648,10 @@ func (src *MachineHealthCheck) ConvertTo(dstRaw conversion.Hub) error {
 		return nil
 	}
 
+	dst.Spec.Remediation.Reboot = restored.Spec.Remediation.Reboot
+	dst.Spec.Remediation.Cordon = restored.Spec.Remediation.Cordon
+	dst.Spec.Checks.NodeProbe = restored.Spec.Checks.NodeProbe
+	clusterv1.RestoreUnhealthyNodeConditionsSlidingWindow(restored.Spec.Checks.UnhealthyNodeConditions, dst.Spec.Checks.UnhealthyNodeConditions)
 	dst.Status.Conditions = restored.Status.Conditions
 
 	return nil
@@ -690,10 +736,20 @@ func (src *MachinePool) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds
 		dst.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
 		dst.Spec.Template.Spec.Taints = restored.Spec.Template.Spec.Taints
+		dst.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions = restored.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions
+		dst.Spec.Template.Spec.KubeletServingCertificateVerification = restored.Spec.Template.Spec.KubeletServingCertificateVerification
+		dst.Spec.Template.Spec.NodeReadinessChecks = restored.Spec.Template.Spec.NodeReadinessChecks
+		dst.Spec.Template.Spec.PowerState = restored.Spec.Template.Spec.PowerState
+		dst.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds = restored.Spec.Template.Spec.InfrastructureReadyTimeoutSeconds
+		dst.Spec.Template.Spec.InfrastructureOverrides = restored.Spec.Template.Spec.InfrastructureOverrides
+		dst.Spec.Machines = restored.Spec.Machines
+		dst.Spec.MinReplicas = restored.Spec.MinReplicas
+		dst.Spec.MaxReplicas = restored.Spec.MaxReplicas
 		dst.Status.Conditions = restored.Status.Conditions
 		dst.Status.AvailableReplicas = restored.Status.AvailableReplicas
 		dst.Status.ReadyReplicas = restored.Status.ReadyReplicas
 		dst.Status.UpToDateReplicas = restored.Status.UpToDateReplicas
+		dst.Status.Selector = restored.Status.Selector
 	}
 
 	return nil
@@ -941,6 +997,9 @@ func Convert_v1beta2_Topology_To_v1alpha4_Topology(in *clusterv1.Topology, out *
 		}
 	}
 	out.Class = in.ClassRef.Name
+	if !reflect.DeepEqual(in.RolloutAfter, metav1.Time{}) {
+		out.RolloutAfter = ptr.To(in.RolloutAfter)
+	}
 	return nil
 }
 
@@ -1008,6 +1067,9 @@ func Convert_v1alpha4_Topology_To_v1beta2_Topology(in *Topology, out *clusterv1.
 		}
 	}
 	out.ClassRef.Name = in.Class
+	if in.RolloutAfter != nil && !reflect.DeepEqual(in.RolloutAfter, &metav1.Time{}) {
+		out.RolloutAfter = *in.RolloutAfter
+	}
 	return nil
 }
 
@@ -1304,6 +1366,10 @@ func Convert_v1alpha4_MachinePoolSpec_To_v1beta2_MachinePoolSpec(in *MachinePool
 	return autoConvert_v1alpha4_MachinePoolSpec_To_v1beta2_MachinePoolSpec(in, out, s)
 }
 
+func Convert_v1beta2_MachinePoolSpec_To_v1alpha4_MachinePoolSpec(in *clusterv1.MachinePoolSpec, out *MachinePoolSpec, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_MachinePoolSpec_To_v1alpha4_MachinePoolSpec(in, out, s)
+}
+
 func Convert_v1alpha4_MachineRollingUpdateDeployment_To_v1beta2_MachineDeploymentRolloutStrategyRollingUpdate(in *MachineRollingUpdateDeployment, out *clusterv1.MachineDeploymentRolloutStrategyRollingUpdate, _ apimachineryconversion.Scope) error {
 	out.MaxUnavailable = in.MaxUnavailable
 	out.MaxSurge = in.MaxSurge
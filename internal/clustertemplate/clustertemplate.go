@@ -0,0 +1,268 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clustertemplate implements the validation of ClusterTemplate parameters and objects
+// and the rendering of ClusterTemplates.
+package clustertemplate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/drone/envsubst/v2"
+	"github.com/drone/envsubst/v2/parse"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+// BuiltinParameters are the names of the parameters that are always available in a ClusterTemplate.
+var BuiltinParameters = sets.New[string](
+	clusterv1.ClusterTemplateClusterNameParameter,
+	clusterv1.ClusterTemplateNamespaceParameter,
+)
+
+// ReferencedParameters returns the names of the parameters referenced in the template.
+func ReferencedParameters(template string) (sets.Set[string], error) {
+	tree, err := parse.Parse(template)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse template")
+	}
+	referenced := sets.New[string]()
+	collectParameters(tree.Root, referenced)
+	return referenced, nil
+}
+
+func collectParameters(node parse.Node, referenced sets.Set[string]) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		for _, child := range n.Nodes {
+			collectParameters(child, referenced)
+		}
+	case *parse.FuncNode:
+		referenced.Insert(n.Param)
+		for _, arg := range n.Args {
+			collectParameters(arg, referenced)
+		}
+	}
+}
+
+// ValidateParameterValue validates that value is compatible with the given parameter type.
+func ValidateParameterValue(parameterType clusterv1.ClusterTemplateParameterType, value string) error {
+	switch parameterType {
+	case clusterv1.ClusterTemplateParameterTypeString:
+		return nil
+	case clusterv1.ClusterTemplateParameterTypeInteger:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return errors.Errorf("value %q is not a valid integer", value)
+		}
+		return nil
+	case clusterv1.ClusterTemplateParameterTypeBoolean:
+		if value != "true" && value != "false" {
+			return errors.Errorf("value %q is not a valid boolean, must be either true or false", value)
+		}
+		return nil
+	default:
+		return errors.Errorf("unknown parameter type %q", parameterType)
+	}
+}
+
+// ResolveParameters computes the value of all the parameters of a ClusterTemplate.
+// The value of a parameter is taken from values if set, otherwise from the default of the parameter;
+// optional parameters without a default are resolved to an empty string.
+// An error is returned if values contains unknown parameters, if a required parameter does not have a value,
+// or if a value is not compatible with the type of the parameter.
+func ResolveParameters(parameters []clusterv1.ClusterTemplateParameter, values map[string]string) (map[string]string, error) {
+	var errs []string
+
+	known := sets.New[string]()
+	for _, p := range parameters {
+		known.Insert(p.Name)
+	}
+	for _, name := range sets.List(sets.KeySet(values).Difference(known)) {
+		errs = append(errs, fmt.Sprintf("parameter %q is not defined in the ClusterTemplate", name))
+	}
+
+	resolved := map[string]string{}
+	for _, p := range parameters {
+		value, ok := values[p.Name]
+		if !ok {
+			if ptr.Deref(p.Required, false) {
+				errs = append(errs, fmt.Sprintf("parameter %q is required", p.Name))
+				continue
+			}
+			value = p.Default
+		}
+		if value != "" {
+			if err := ValidateParameterValue(p.Type, value); err != nil {
+				errs = append(errs, fmt.Sprintf("parameter %q: %v", p.Name, err))
+				continue
+			}
+		}
+		resolved[p.Name] = value
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, "; "))
+	}
+	return resolved, nil
+}
+
+// Render renders the template with the given parameter values and the built-in parameters,
+// and returns the resulting objects, all of them in the given namespace.
+// An error is returned if the template references parameters without a value, if an object
+// belongs to a different namespace, or if the template doesn't contain a Cluster named clusterName.
+func Render(template, clusterName, namespace string, values map[string]string) ([]unstructured.Unstructured, error) {
+	referenced, err := ReferencedParameters(template)
+	if err != nil {
+		return nil, err
+	}
+
+	allValues := map[string]string{
+		clusterv1.ClusterTemplateClusterNameParameter: clusterName,
+		clusterv1.ClusterTemplateNamespaceParameter:   namespace,
+	}
+	for name, value := range values {
+		allValues[name] = value
+	}
+	if missing := sets.List(referenced.Difference(sets.KeySet(allValues))); len(missing) > 0 {
+		return nil, errors.Errorf("template references undefined parameters: %s", strings.Join(missing, ", "))
+	}
+
+	rendered, err := envsubst.Eval(template, func(name string) string {
+		return allValues[name]
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to render template")
+	}
+
+	objs, err := utilyaml.ToUnstructured([]byte(rendered))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse rendered template")
+	}
+
+	hasCluster := false
+	for i := range objs {
+		obj := &objs[i]
+		if obj.GetNamespace() != "" && obj.GetNamespace() != namespace {
+			return nil, errors.Errorf("%s %s must not set a namespace different from %s", obj.GetKind(), obj.GetName(), namespace)
+		}
+		obj.SetNamespace(namespace)
+
+		if obj.GroupVersionKind().GroupKind() == clusterv1.GroupVersion.WithKind("Cluster").GroupKind() && obj.GetName() == clusterName {
+			hasCluster = true
+		}
+	}
+	if !hasCluster {
+		return nil, errors.Errorf("template must contain a Cluster named %s", clusterName)
+	}
+
+	return objs, nil
+}
+
+// allowedGroupKinds are the kinds of objects that can always be part of a ClusterTemplate.
+var allowedGroupKinds = sets.New[schema.GroupKind](
+	clusterv1.GroupVersion.WithKind("Cluster").GroupKind(),
+	clusterv1.GroupVersion.WithKind("MachineDeployment").GroupKind(),
+	clusterv1.GroupVersion.WithKind("MachineHealthCheck").GroupKind(),
+	clusterv1.GroupVersion.WithKind("MachinePool").GroupKind(),
+	corev1.SchemeGroupVersion.WithKind("ConfigMap").GroupKind(),
+)
+
+// allowedGroups are the API groups of the provider objects that can be part of a ClusterTemplate, of any kind.
+var allowedGroups = sets.New[string](
+	"infrastructure.cluster.x-k8s.io",
+	"bootstrap.cluster.x-k8s.io",
+	"controlplane.cluster.x-k8s.io",
+)
+
+// sensitiveGroupKinds are the kinds of objects that can be part of a ClusterTemplate only if explicitly enabled.
+// Secrets can carry credentials and ClusterResourceSets apply arbitrary objects to workload clusters.
+var sensitiveGroupKinds = sets.New[schema.GroupKind](
+	corev1.SchemeGroupVersion.WithKind("Secret").GroupKind(),
+	addonsv1.GroupVersion.WithKind("ClusterResourceSet").GroupKind(),
+)
+
+// ValidateObjects validates that all the objects of a ClusterTemplate are of an allowed kind.
+// Objects of a ClusterTemplate are created with the credentials of the Cluster API controller, so only
+// Cluster API objects, provider objects and ConfigMaps are allowed; Secrets and ClusterResourceSets are
+// only allowed if allowSecretsAndClusterResourceSets is true.
+func ValidateObjects(objs []unstructured.Unstructured, allowSecretsAndClusterResourceSets bool) error {
+	var errs []string
+	for i := range objs {
+		obj := &objs[i]
+		gk := obj.GroupVersionKind().GroupKind()
+		if gk.Kind == "" {
+			errs = append(errs, fmt.Sprintf("object %s must set apiVersion and kind", obj.GetName()))
+			continue
+		}
+		if allowedGroupKinds.Has(gk) || allowedGroups.Has(gk.Group) {
+			continue
+		}
+		if sensitiveGroupKinds.Has(gk) {
+			if !allowSecretsAndClusterResourceSets {
+				errs = append(errs, fmt.Sprintf("%s %s is not allowed: Secrets and ClusterResourceSets must be explicitly enabled", gk, obj.GetName()))
+			}
+			continue
+		}
+		errs = append(errs, fmt.Sprintf("%s %s is not allowed: only Cluster API objects, provider objects and ConfigMaps are allowed", gk, obj.GetName()))
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// ParseWithPlaceholders parses the template replacing all the parameters with a placeholder value of
+// the corresponding type; it can be used to validate a ClusterTemplate before it is instantiated.
+func ParseWithPlaceholders(template string, parameters []clusterv1.ClusterTemplateParameter) ([]unstructured.Unstructured, error) {
+	placeholders := map[string]string{}
+	for _, p := range parameters {
+		switch p.Type {
+		case clusterv1.ClusterTemplateParameterTypeInteger:
+			placeholders[p.Name] = "0"
+		case clusterv1.ClusterTemplateParameterTypeBoolean:
+			placeholders[p.Name] = "false"
+		default:
+			placeholders[p.Name] = "placeholder"
+		}
+	}
+
+	rendered, err := envsubst.Eval(template, func(name string) string {
+		if value, ok := placeholders[name]; ok {
+			return value
+		}
+		return "placeholder"
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to render template")
+	}
+
+	objs, err := utilyaml.ToUnstructured([]byte(rendered))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse rendered template")
+	}
+	return objs, nil
+}
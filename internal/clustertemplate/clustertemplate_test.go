@@ -0,0 +1,313 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertemplate
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReferencedParameters(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     sets.Set[string]
+		wantErr  bool
+	}{
+		{
+			name:     "no parameters",
+			template: "kind: Cluster",
+			want:     sets.New[string](),
+		},
+		{
+			name:     "parameters with defaults and nested references",
+			template: "name: ${CLUSTER_NAME}\nreplicas: ${REPLICAS:=3}\nimage: ${IMAGE:-${DEFAULT_IMAGE}}",
+			want:     sets.New[string]("CLUSTER_NAME", "REPLICAS", "IMAGE", "DEFAULT_IMAGE"),
+		},
+		{
+			name:     "invalid template",
+			template: "name: ${CLUSTER_NAME",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := ReferencedParameters(tt.template)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestValidateParameterValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		parameterType clusterv1.ClusterTemplateParameterType
+		value         string
+		wantErr       bool
+	}{
+		{name: "any string", parameterType: clusterv1.ClusterTemplateParameterTypeString, value: "foo"},
+		{name: "valid integer", parameterType: clusterv1.ClusterTemplateParameterTypeInteger, value: "-3"},
+		{name: "invalid integer", parameterType: clusterv1.ClusterTemplateParameterTypeInteger, value: "three", wantErr: true},
+		{name: "valid boolean", parameterType: clusterv1.ClusterTemplateParameterTypeBoolean, value: "false"},
+		{name: "invalid boolean", parameterType: clusterv1.ClusterTemplateParameterTypeBoolean, value: "yes", wantErr: true},
+		{name: "unknown type", parameterType: "Float", value: "1.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := ValidateParameterValue(tt.parameterType, tt.value)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestResolveParameters(t *testing.T) {
+	parameters := []clusterv1.ClusterTemplateParameter{
+		{Name: "KUBERNETES_VERSION", Type: clusterv1.ClusterTemplateParameterTypeString, Required: ptr.To(true)},
+		{Name: "WORKER_MACHINE_COUNT", Type: clusterv1.ClusterTemplateParameterTypeInteger, Default: "1"},
+		{Name: "ENABLE_AUDIT", Type: clusterv1.ClusterTemplateParameterTypeBoolean},
+	}
+
+	tests := []struct {
+		name    string
+		values  map[string]string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name:   "applies defaults",
+			values: map[string]string{"KUBERNETES_VERSION": "v1.33.0"},
+			want: map[string]string{
+				"KUBERNETES_VERSION":   "v1.33.0",
+				"WORKER_MACHINE_COUNT": "1",
+				"ENABLE_AUDIT":         "",
+			},
+		},
+		{
+			name: "uses values",
+			values: map[string]string{
+				"KUBERNETES_VERSION":   "v1.33.0",
+				"WORKER_MACHINE_COUNT": "5",
+				"ENABLE_AUDIT":         "true",
+			},
+			want: map[string]string{
+				"KUBERNETES_VERSION":   "v1.33.0",
+				"WORKER_MACHINE_COUNT": "5",
+				"ENABLE_AUDIT":         "true",
+			},
+		},
+		{
+			name:    "missing required parameter",
+			values:  map[string]string{},
+			wantErr: `parameter "KUBERNETES_VERSION" is required`,
+		},
+		{
+			name:    "unknown parameter",
+			values:  map[string]string{"KUBERNETES_VERSION": "v1.33.0", "FOO": "bar"},
+			wantErr: `parameter "FOO" is not defined in the ClusterTemplate`,
+		},
+		{
+			name:    "invalid type",
+			values:  map[string]string{"KUBERNETES_VERSION": "v1.33.0", "WORKER_MACHINE_COUNT": "many"},
+			wantErr: `parameter "WORKER_MACHINE_COUNT": value "many" is not a valid integer`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := ResolveParameters(parameters, tt.values)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	template := `apiVersion: cluster.x-k8s.io/v1beta2
+kind: Cluster
+metadata:
+  name: ${CLUSTER_NAME}
+  namespace: ${NAMESPACE}
+spec:
+  topology:
+    version: ${KUBERNETES_VERSION}
+---
+apiVersion: cluster.x-k8s.io/v1beta2
+kind: MachineDeployment
+metadata:
+  name: ${CLUSTER_NAME}-md-0
+spec:
+  clusterName: ${CLUSTER_NAME}
+`
+
+	t.Run("renders the template", func(t *testing.T) {
+		g := NewWithT(t)
+
+		objs, err := Render(template, "my-cluster", "ns1", map[string]string{"KUBERNETES_VERSION": "v1.33.0"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(objs).To(HaveLen(2))
+
+		g.Expect(objs[0].GetKind()).To(Equal("Cluster"))
+		g.Expect(objs[0].GetName()).To(Equal("my-cluster"))
+		g.Expect(objs[0].GetNamespace()).To(Equal("ns1"))
+		g.Expect(objs[0].Object["spec"]).To(HaveKeyWithValue("topology", HaveKeyWithValue("version", "v1.33.0")))
+
+		g.Expect(objs[1].GetName()).To(Equal("my-cluster-md-0"))
+		g.Expect(objs[1].GetNamespace()).To(Equal("ns1"))
+	})
+	t.Run("fails for undefined parameters", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := Render(template, "my-cluster", "ns1", nil)
+		g.Expect(err).To(MatchError(ContainSubstring("template references undefined parameters: KUBERNETES_VERSION")))
+	})
+	t.Run("fails for objects in a different namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := Render(`apiVersion: cluster.x-k8s.io/v1beta2
+kind: Cluster
+metadata:
+  name: ${CLUSTER_NAME}
+  namespace: other
+`, "my-cluster", "ns1", nil)
+		g.Expect(err).To(MatchError(ContainSubstring("must not set a namespace different from ns1")))
+	})
+	t.Run("fails if the template doesn't contain the Cluster", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := Render(`apiVersion: cluster.x-k8s.io/v1beta2
+kind: Cluster
+metadata:
+  name: another-cluster
+`, "my-cluster", "ns1", nil)
+		g.Expect(err).To(MatchError(ContainSubstring("template must contain a Cluster named my-cluster")))
+	})
+}
+
+func TestValidateObjects(t *testing.T) {
+	newObj := func(apiVersion, kind string) unstructured.Unstructured {
+		obj := unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetName("obj")
+		return obj
+	}
+
+	tests := []struct {
+		name                               string
+		objs                               []unstructured.Unstructured
+		allowSecretsAndClusterResourceSets bool
+		wantErr                            bool
+	}{
+		{
+			name: "Cluster API objects, provider objects and ConfigMaps are allowed",
+			objs: []unstructured.Unstructured{
+				newObj("cluster.x-k8s.io/v1beta2", "Cluster"),
+				newObj("cluster.x-k8s.io/v1beta2", "MachineDeployment"),
+				newObj("cluster.x-k8s.io/v1beta2", "MachineHealthCheck"),
+				newObj("cluster.x-k8s.io/v1beta2", "MachinePool"),
+				newObj("infrastructure.cluster.x-k8s.io/v1beta2", "DockerCluster"),
+				newObj("bootstrap.cluster.x-k8s.io/v1beta2", "KubeadmConfigTemplate"),
+				newObj("controlplane.cluster.x-k8s.io/v1beta2", "KubeadmControlPlane"),
+				newObj("v1", "ConfigMap"),
+			},
+		},
+		{
+			name:    "Secrets are not allowed by default",
+			objs:    []unstructured.Unstructured{newObj("v1", "Secret")},
+			wantErr: true,
+		},
+		{
+			name:    "ClusterResourceSets are not allowed by default",
+			objs:    []unstructured.Unstructured{newObj("addons.cluster.x-k8s.io/v1beta2", "ClusterResourceSet")},
+			wantErr: true,
+		},
+		{
+			name: "Secrets and ClusterResourceSets are allowed if enabled",
+			objs: []unstructured.Unstructured{
+				newObj("v1", "Secret"),
+				newObj("addons.cluster.x-k8s.io/v1beta2", "ClusterResourceSet"),
+			},
+			allowSecretsAndClusterResourceSets: true,
+		},
+		{
+			name:                               "other Cluster API kinds are not allowed",
+			objs:                               []unstructured.Unstructured{newObj("cluster.x-k8s.io/v1beta2", "ClusterClass")},
+			allowSecretsAndClusterResourceSets: true,
+			wantErr:                            true,
+		},
+		{
+			name:                               "other kinds are not allowed",
+			objs:                               []unstructured.Unstructured{newObj("rbac.authorization.k8s.io/v1", "ClusterRoleBinding")},
+			allowSecretsAndClusterResourceSets: true,
+			wantErr:                            true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := ValidateObjects(tt.objs, tt.allowSecretsAndClusterResourceSets)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestParseWithPlaceholders(t *testing.T) {
+	g := NewWithT(t)
+
+	objs, err := ParseWithPlaceholders(`apiVersion: cluster.x-k8s.io/v1beta2
+kind: MachineDeployment
+metadata:
+  name: ${CLUSTER_NAME}-md-0
+spec:
+  replicas: ${WORKER_MACHINE_COUNT}
+`, []clusterv1.ClusterTemplateParameter{
+		{Name: "WORKER_MACHINE_COUNT", Type: clusterv1.ClusterTemplateParameterTypeInteger},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(objs).To(HaveLen(1))
+	g.Expect(objs[0].GetKind()).To(Equal("MachineDeployment"))
+	g.Expect(objs[0].Object["spec"]).To(HaveKeyWithValue("replicas", BeNumerically("==", 0)))
+}
@@ -91,6 +91,22 @@ func (c *ControlPlaneContract) Version() *String {
 	}
 }
 
+// RolloutAfter provide access to the rollout after field in a ControlPlane object, if any.
+// NOTE: When working with unstructured there is no way to understand if the ControlPlane provider
+// do support a field in the type definition from the fact that a field is not set in a given instance.
+// This is why the topology reconciler sets this field only if rolloutAfter is set in the Cluster topology.
+func (c *ControlPlaneContract) RolloutAfter(contractVersion string) *Time {
+	if contractVersion == "v1beta1" {
+		return &Time{
+			path: []string{"spec", "rolloutAfter"},
+		}
+	}
+
+	return &Time{
+		path: []string{"spec", "rollout", "after"},
+	}
+}
+
 // StatusVersion provide access to the version field in a ControlPlane object status, if any.
 func (c *ControlPlaneContract) StatusVersion() *String {
 	return &String{
@@ -57,6 +57,32 @@ func TestControlPlane(t *testing.T) {
 		g.Expect(got).ToNot(BeNil())
 		g.Expect(*got).To(Equal("1.2.3"))
 	})
+	t.Run("Manages spec.rollout.after", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rolloutAfter := metav1.Date(2025, time.March, 1, 10, 0, 0, 0, time.UTC)
+		g.Expect(ControlPlane().RolloutAfter("v1beta2").Path()).To(Equal(Path{"spec", "rollout", "after"}))
+
+		err := ControlPlane().RolloutAfter("v1beta2").Set(obj, rolloutAfter)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		got, err := ControlPlane().RolloutAfter("v1beta2").Get(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).ToNot(BeNil())
+		g.Expect(got.Equal(&rolloutAfter)).To(BeTrue())
+
+		// Check that the literal string value of the time is correctly formatted.
+		timeString, found, err := unstructured.NestedString(obj.UnstructuredContent(), "spec", "rollout", "after")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(found).To(BeTrue())
+		g.Expect(timeString).To(Equal("2025-03-01T10:00:00Z"))
+
+		g.Expect(ControlPlane().RolloutAfter("v1beta1").Path()).To(Equal(Path{"spec", "rolloutAfter"}))
+
+		objV1beta1 := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		_, err = ControlPlane().RolloutAfter("v1beta1").Get(objV1beta1)
+		g.Expect(err).To(MatchError(ContainSubstring("field not found")))
+	})
 	t.Run("Manages status.initialization.controlPlaneInitialized", func(t *testing.T) {
 		g := NewWithT(t)
 
@@ -99,6 +99,54 @@ func (m *InfrastructureMachineContract) FailureDomain() *String {
 	}
 }
 
+// BootstrapProgressPhase provides access to the status.bootstrapProgress.phase field in an InfrastructureMachine object.
+// Note that this field is optional.
+func (m *InfrastructureMachineContract) BootstrapProgressPhase() *String {
+	return &String{
+		path: []string{"status", "bootstrapProgress", "phase"},
+	}
+}
+
+// BootstrapProgressMessage provides access to the status.bootstrapProgress.message field in an InfrastructureMachine object.
+// Note that this field is optional.
+func (m *InfrastructureMachineContract) BootstrapProgressMessage() *String {
+	return &String{
+		path: []string{"status", "bootstrapProgress", "message"},
+	}
+}
+
+// BootstrapDiagnosticsFailedCommand provides access to the status.bootstrapDiagnostics.failedCommand field in an
+// InfrastructureMachine object. Note that this field is optional.
+func (m *InfrastructureMachineContract) BootstrapDiagnosticsFailedCommand() *String {
+	return &String{
+		path: []string{"status", "bootstrapDiagnostics", "failedCommand"},
+	}
+}
+
+// BootstrapDiagnosticsExitCode provides access to the status.bootstrapDiagnostics.exitCode field in an
+// InfrastructureMachine object. Note that this field is optional.
+func (m *InfrastructureMachineContract) BootstrapDiagnosticsExitCode() *Int32 {
+	return &Int32{
+		path: []string{"status", "bootstrapDiagnostics", "exitCode"},
+	}
+}
+
+// BootstrapDiagnosticsOutputTail provides access to the status.bootstrapDiagnostics.outputTail field in an
+// InfrastructureMachine object. Note that this field is optional.
+func (m *InfrastructureMachineContract) BootstrapDiagnosticsOutputTail() *String {
+	return &String{
+		path: []string{"status", "bootstrapDiagnostics", "outputTail"},
+	}
+}
+
+// PowerState provides access to the status.powerState field in an InfrastructureMachine object.
+// Note that this field is optional.
+func (m *InfrastructureMachineContract) PowerState() *String {
+	return &String{
+		path: []string{"status", "powerState"},
+	}
+}
+
 // MachineAddresses represents an accessor to a []clusterv1.MachineAddress path value.
 type MachineAddresses struct {
 	path Path
@@ -49,3 +49,11 @@ func (c *InfrastructureMachineTemplateTemplate) Metadata() *Metadata {
 		path: Path{"spec", "template", "metadata"},
 	}
 }
+
+// NodeInfoArchitecture provides access to the status.nodeInfo.architecture field in an InfrastructureMachineTemplate object.
+// Note that this field is optional.
+func (c *InfrastructureMachineTemplateContract) NodeInfoArchitecture() *String {
+	return &String{
+		path: []string{"status", "nodeInfo", "architecture"},
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contract
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestInfrastructureMachineTemplate(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+
+	t.Run("Manages optional status.nodeInfo.architecture", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(InfrastructureMachineTemplate().NodeInfoArchitecture().Path()).To(Equal(Path{"status", "nodeInfo", "architecture"}))
+
+		got, err := InfrastructureMachineTemplate().NodeInfoArchitecture().Get(obj)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(got).To(BeNil())
+
+		err = InfrastructureMachineTemplate().NodeInfoArchitecture().Set(obj, "arm64")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		got, err = InfrastructureMachineTemplate().NodeInfoArchitecture().Get(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).ToNot(BeNil())
+		g.Expect(*got).To(Equal("arm64"))
+	})
+}
@@ -127,4 +127,64 @@ func TestInfrastructureMachine(t *testing.T) {
 		g.Expect(got).ToNot(BeNil())
 		g.Expect(*got).To(Equal("fake-failure-domain"))
 	})
+	t.Run("Manages optional status.bootstrapProgress.phase", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(InfrastructureMachine().BootstrapProgressPhase().Path()).To(Equal(Path{"status", "bootstrapProgress", "phase"}))
+
+		err := InfrastructureMachine().BootstrapProgressPhase().Set(obj, "KubeadmStarted")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		got, err := InfrastructureMachine().BootstrapProgressPhase().Get(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).ToNot(BeNil())
+		g.Expect(*got).To(Equal("KubeadmStarted"))
+	})
+	t.Run("Manages optional status.bootstrapProgress.message", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(InfrastructureMachine().BootstrapProgressMessage().Path()).To(Equal(Path{"status", "bootstrapProgress", "message"}))
+
+		err := InfrastructureMachine().BootstrapProgressMessage().Set(obj, "fake-message")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		got, err := InfrastructureMachine().BootstrapProgressMessage().Get(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).ToNot(BeNil())
+		g.Expect(*got).To(Equal("fake-message"))
+	})
+	t.Run("Manages optional status.bootstrapDiagnostics", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(InfrastructureMachine().BootstrapDiagnosticsFailedCommand().Path()).To(Equal(Path{"status", "bootstrapDiagnostics", "failedCommand"}))
+		g.Expect(InfrastructureMachine().BootstrapDiagnosticsExitCode().Path()).To(Equal(Path{"status", "bootstrapDiagnostics", "exitCode"}))
+		g.Expect(InfrastructureMachine().BootstrapDiagnosticsOutputTail().Path()).To(Equal(Path{"status", "bootstrapDiagnostics", "outputTail"}))
+
+		g.Expect(InfrastructureMachine().BootstrapDiagnosticsFailedCommand().Set(obj, "preKubeadmCommands[0]")).To(Succeed())
+		g.Expect(InfrastructureMachine().BootstrapDiagnosticsExitCode().Set(obj, 127)).To(Succeed())
+		g.Expect(InfrastructureMachine().BootstrapDiagnosticsOutputTail().Set(obj, "command not found")).To(Succeed())
+
+		failedCommand, err := InfrastructureMachine().BootstrapDiagnosticsFailedCommand().Get(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*failedCommand).To(Equal("preKubeadmCommands[0]"))
+		exitCode, err := InfrastructureMachine().BootstrapDiagnosticsExitCode().Get(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*exitCode).To(Equal(int32(127)))
+		outputTail, err := InfrastructureMachine().BootstrapDiagnosticsOutputTail().Get(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*outputTail).To(Equal("command not found"))
+	})
+	t.Run("Manages optional status.powerState", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(InfrastructureMachine().PowerState().Path()).To(Equal(Path{"status", "powerState"}))
+
+		err := InfrastructureMachine().PowerState().Set(obj, "Stopped")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		got, err := InfrastructureMachine().PowerState().Get(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).ToNot(BeNil())
+		g.Expect(*got).To(Equal("Stopped"))
+	})
 }
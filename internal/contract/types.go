@@ -19,6 +19,7 @@ package contract
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -229,3 +230,39 @@ func (i *Duration) Set(obj *unstructured.Unstructured, value metav1.Duration) er
 	}
 	return nil
 }
+
+// Time represents an accessor to a metav1.Time path value.
+type Time struct {
+	path Path
+}
+
+// Path returns the path to the metav1.Time value.
+func (i *Time) Path() Path {
+	return i.path
+}
+
+// Get gets the metav1.Time value.
+func (i *Time) Get(obj *unstructured.Unstructured) (*metav1.Time, error) {
+	timeString, ok, err := unstructured.NestedString(obj.UnstructuredContent(), i.path...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s from object", "."+strings.Join(i.path, "."))
+	}
+	if !ok {
+		return nil, errors.Wrapf(ErrFieldNotFound, "path %s", "."+strings.Join(i.path, "."))
+	}
+
+	t := &metav1.Time{}
+	if err := t.UnmarshalJSON([]byte(strconv.Quote(timeString))); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal time %s from object", "."+strings.Join(i.path, "."))
+	}
+
+	return t, nil
+}
+
+// Set sets the metav1.Time value in the path.
+func (i *Time) Set(obj *unstructured.Unstructured, value metav1.Time) error {
+	if err := unstructured.SetNestedField(obj.UnstructuredContent(), value.UTC().Format(time.RFC3339), i.path...); err != nil {
+		return errors.Wrapf(err, "failed to set path %s of object %v", "."+strings.Join(i.path, "."), obj.GroupVersionKind())
+	}
+	return nil
+}
@@ -27,12 +27,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -157,6 +159,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retRes ct
 		return ctrl.Result{}, err
 	}
 
+	// Clear the pause if it is expired; if the pause is not expired yet, requeue for when it expires.
+	pauseRequeueAfter, err := r.reconcilePauseExpiry(ctx, cluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Initialize the patch helper.
 	patchHelper, err := patch.NewHelper(cluster, r.Client)
 	if err != nil {
@@ -164,7 +172,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retRes ct
 	}
 
 	if isPaused, requeue, err := paused.EnsurePausedCondition(ctx, r.Client, cluster, cluster); err != nil || isPaused || requeue {
-		return ctrl.Result{}, err
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: pauseRequeueAfter}, nil
 	}
 
 	s := &scope{
@@ -233,6 +244,61 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retRes ct
 	return doReconcile(ctx, reconcileNormal, s)
 }
 
+// reconcilePauseExpiry clears the pause of a Cluster once spec.pause.expireAfter is expired, by setting
+// spec.paused to false and by removing spec.pause.
+// If the pause is not expired yet, it returns the time after which the Cluster has to be reconciled again.
+// NOTE: spec.pause is also removed if the Cluster is not paused, so a stale spec.pause.expireAfter does not clear
+// a later pause, e.g. the one set by clusterctl move; the Cluster webhook already removes spec.pause when unpausing,
+// this covers Clusters unpaused before.
+func (r *Reconciler) reconcilePauseExpiry(ctx context.Context, cluster *clusterv1.Cluster) (time.Duration, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if !ptr.Deref(cluster.Spec.Paused, false) {
+		if cluster.Spec.Pause == (clusterv1.ClusterPauseSpec{}) {
+			return 0, nil
+		}
+
+		patchHelper, err := patch.NewHelper(cluster, r.Client)
+		if err != nil {
+			return 0, err
+		}
+		cluster.Spec.Pause = clusterv1.ClusterPauseSpec{}
+		if err := patchHelper.Patch(ctx, cluster); err != nil {
+			return 0, errors.Wrapf(err, "failed to clear pause of a Cluster which is not paused")
+		}
+		log.Info("Cleared pause of a Cluster which is not paused")
+		return 0, nil
+	}
+
+	if cluster.Spec.Pause.ExpireAfter.IsZero() {
+		return 0, nil
+	}
+
+	if now := time.Now(); now.Before(cluster.Spec.Pause.ExpireAfter.Time) {
+		return cluster.Spec.Pause.ExpireAfter.Sub(now), nil
+	}
+
+	patchHelper, err := patch.NewHelper(cluster, r.Client)
+	if err != nil {
+		return 0, err
+	}
+
+	pause := cluster.Spec.Pause
+	cluster.Spec.Paused = ptr.To(false)
+	cluster.Spec.Pause = clusterv1.ClusterPauseSpec{}
+	if err := patchHelper.Patch(ctx, cluster); err != nil {
+		return 0, errors.Wrapf(err, "failed to clear expired pause")
+	}
+
+	message := fmt.Sprintf("Cleared pause expired at %s", pause.ExpireAfter.UTC().Format(time.RFC3339))
+	if pause.Reason != "" {
+		message += fmt.Sprintf(", pause reason was %q", pause.Reason)
+	}
+	log.Info(message)
+	r.recorder.Event(cluster, corev1.EventTypeNormal, "PauseExpired", message)
+	return 0, nil
+}
+
 func patchCluster(ctx context.Context, patchHelper *patch.Helper, cluster *clusterv1.Cluster, options ...patch.Option) error {
 	// Always update the readyCondition by summarizing the state of other conditions.
 	v1beta1conditions.SetSummary(cluster,
@@ -266,6 +332,7 @@ func patchCluster(ctx context.Context, patchHelper *patch.Helper, cluster *clust
 			clusterv1.ClusterScalingUpCondition,
 			clusterv1.ClusterScalingDownCondition,
 			clusterv1.ClusterRemediatingCondition,
+			clusterv1.ClusterDegradedFreezeCondition,
 			clusterv1.ClusterDeletingCondition,
 			clusterv1.ClusterAvailableCondition,
 		}},
@@ -335,6 +402,9 @@ type scope struct {
 }
 
 // reconcileDelete handles cluster deletion.
+// The Cluster is deleted in phases: first MachinePools, MachineDeployments, MachineSets and worker Machines are deleted,
+// then the control plane (or the stand-alone control plane Machines) and finally the infrastructure cluster.
+// The progress of the deletion is reported in status.deletion.
 func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (reconcile.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	cluster := s.cluster
@@ -345,7 +415,7 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (reconcile.R
 		if cluster.Spec.Topology.IsDefined() && !hooks.IsOkToDelete(cluster) {
 			s.deletingReason = clusterv1.ClusterDeletingWaitingForBeforeDeleteHookReason
 			s.deletingMessage = "Waiting for BeforeClusterDelete hook"
-			return ctrl.Result{}, nil
+			return r.setDeletionPhase(s, clusterv1.ClusterDeletionPhaseWaitingForBeforeDeleteHook, nil), nil
 		}
 	}
 
@@ -356,6 +426,7 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (reconcile.R
 		return reconcile.Result{}, nil
 	}
 
+	// Delete the workers.
 	children, err := s.descendants.filterOwnedDescendants(cluster)
 	if err != nil {
 		s.deletingReason = clusterv1.ClusterDeletingInternalErrorReason
@@ -365,53 +436,31 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (reconcile.R
 
 	if len(children) > 0 {
 		log.Info("Cluster still has children - deleting them first", "count", len(children))
-
-		var errs []error
-
-		for _, child := range children {
-			if !child.GetDeletionTimestamp().IsZero() {
-				// Don't handle deleted child
-				continue
-			}
-
-			gvk, err := apiutil.GVKForObject(child, r.Client.Scheme())
-			if err != nil {
-				errs = append(errs, errors.Wrapf(err, "error getting gvk for child object"))
-				continue
-			}
-
-			log := log.WithValues(gvk.Kind, klog.KObj(child))
-			log.Info("Deleting child object")
-			if err := r.Client.Delete(ctx, child); err != nil {
-				err = errors.Wrapf(err, "error deleting cluster %s/%s: failed to delete %s %s", cluster.Namespace, cluster.Name, gvk, child.GetName())
-				log.Error(err, "Error deleting resource")
-				errs = append(errs, err)
-			}
-		}
-
-		if len(errs) > 0 {
+		if err := r.deleteChildren(ctx, cluster, children); err != nil {
 			s.deletingReason = clusterv1.ClusterDeletingInternalErrorReason
 			s.deletingMessage = "Please check controller logs for errors"
-			return ctrl.Result{}, kerrors.NewAggregate(errs)
+			return ctrl.Result{}, err
 		}
 	}
 
-	if descendantCount := s.descendants.objectsPendingDeleteCount(cluster); descendantCount > 0 {
+	if descendantCount := s.descendants.workersPendingDeleteCount(); descendantCount > 0 {
 		indirect := descendantCount - len(children)
-		names := s.descendants.objectsPendingDeleteNames(cluster)
+		names := s.descendants.workersPendingDeleteNames()
 
 		log.Info("Cluster still has descendants - need to requeue", "descendants", strings.Join(names, "; "), "indirect descendants count", indirect)
 
 		s.deletingReason = clusterv1.ClusterDeletingWaitingForWorkersDeletionReason
+		messages := make([]string, len(names))
 		for i := range names {
-			names[i] = "* " + names[i]
+			messages[i] = "* " + names[i]
 		}
-		s.deletingMessage = strings.Join(names, "\n")
+		s.deletingMessage = strings.Join(messages, "\n")
 
 		// Requeue so we can check the next time to see if there are still any descendants left.
-		return ctrl.Result{RequeueAfter: deleteRequeueAfter}, nil
+		return util.LowestNonZeroResult(ctrl.Result{RequeueAfter: deleteRequeueAfter}, r.setDeletionPhase(s, clusterv1.ClusterDeletionPhaseDeletingWorkers, names)), nil
 	}
 
+	// Delete the control plane, only after all the workers have been deleted.
 	if cluster.Spec.ControlPlaneRef.IsDefined() {
 		if s.controlPlane == nil {
 			if !s.controlPlaneIsNotFound {
@@ -448,10 +497,34 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (reconcile.R
 			// We are watching it, will try again when it is deleted.
 			ref := cluster.Spec.ControlPlaneRef
 			log.Info("Cluster still has descendants - waiting for control plane deletion", ref.Kind, klog.KRef(cluster.Namespace, ref.Name))
-			return ctrl.Result{}, nil
+			return r.setDeletionPhase(s, clusterv1.ClusterDeletionPhaseDeletingControlPlane, []string{fmt.Sprintf("%s: %s", ref.Kind, ref.Name)}), nil
+		}
+	} else {
+		controlPlaneMachines, err := s.descendants.filterOwnedControlPlaneMachines(cluster)
+		if err != nil {
+			s.deletingReason = clusterv1.ClusterDeletingInternalErrorReason
+			s.deletingMessage = "Please check controller logs for errors"
+			return reconcile.Result{}, errors.Wrapf(err, "failed to extract control plane Machines")
+		}
+		if err := r.deleteChildren(ctx, cluster, controlPlaneMachines); err != nil {
+			s.deletingReason = clusterv1.ClusterDeletingInternalErrorReason
+			s.deletingMessage = "Please check controller logs for errors"
+			return ctrl.Result{}, err
+		}
+
+		if len(s.descendants.controlPlaneMachines) > 0 {
+			names := []string{"Control plane Machines: " + s.descendants.controlPlaneMachineNames()}
+			log.Info("Cluster still has descendants - need to requeue", "descendants", names[0])
+
+			s.deletingReason = clusterv1.ClusterDeletingWaitingForControlPlaneDeletionReason
+			s.deletingMessage = "* " + names[0]
+
+			// Requeue so we can check the next time to see if there are still any control plane Machines left.
+			return util.LowestNonZeroResult(ctrl.Result{RequeueAfter: deleteRequeueAfter}, r.setDeletionPhase(s, clusterv1.ClusterDeletionPhaseDeletingControlPlane, names)), nil
 		}
 	}
 
+	// Delete the infrastructure cluster, only after the control plane has been deleted.
 	if cluster.Spec.InfrastructureRef.IsDefined() {
 		if s.infraCluster == nil {
 			if !s.infraClusterIsNotFound {
@@ -488,18 +561,86 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (reconcile.R
 			// We are watching it, will try again when it is deleted.
 			ref := cluster.Spec.InfrastructureRef
 			log.Info("Cluster still has descendants - waiting for infrastructure cluster deletion", ref.Kind, klog.KRef(cluster.Namespace, ref.Name))
-			return ctrl.Result{}, nil
+			return r.setDeletionPhase(s, clusterv1.ClusterDeletionPhaseDeletingInfrastructure, []string{fmt.Sprintf("%s: %s", ref.Kind, ref.Name)}), nil
 		}
 	}
 
 	s.deletingReason = clusterv1.ClusterDeletingDeletionCompletedReason
 	s.deletingMessage = "Deletion completed"
+	r.setDeletionPhase(s, clusterv1.ClusterDeletionPhaseCompleted, nil)
 
 	controllerutil.RemoveFinalizer(cluster, clusterv1.ClusterFinalizer)
 	r.recorder.Eventf(cluster, corev1.EventTypeNormal, "Deleted", "Cluster %s has been deleted", cluster.Name)
 	return ctrl.Result{}, nil
 }
 
+// deleteChildren issues a deletion request for the children of the Cluster which are not yet being deleted.
+func (r *Reconciler) deleteChildren(ctx context.Context, cluster *clusterv1.Cluster, children []client.Object) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	var errs []error
+	for _, child := range children {
+		if !child.GetDeletionTimestamp().IsZero() {
+			// Don't handle deleted child
+			continue
+		}
+
+		gvk, err := apiutil.GVKForObject(child, r.Client.Scheme())
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "error getting gvk for child object"))
+			continue
+		}
+
+		log := log.WithValues(gvk.Kind, klog.KObj(child))
+		log.Info("Deleting child object")
+		if err := r.Client.Delete(ctx, child); err != nil {
+			err = errors.Wrapf(err, "error deleting cluster %s/%s: failed to delete %s %s", cluster.Namespace, cluster.Name, gvk, child.GetName())
+			log.Error(err, "Error deleting resource")
+			errs = append(errs, err)
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// setDeletionPhase records the current phase of the Cluster deletion and the objects the phase is waiting for in
+// status.deletion; if the phase takes longer than spec.deletion.phaseTimeoutSeconds, the phase is reported as timed out.
+// It returns the result to be used to check the phase timeout again, if required.
+func (r *Reconciler) setDeletionPhase(s *scope, phase clusterv1.ClusterDeletionPhase, pendingObjects []string) ctrl.Result {
+	cluster := s.cluster
+
+	if cluster.Status.Deletion == nil || cluster.Status.Deletion.Phase != phase {
+		cluster.Status.Deletion = &clusterv1.ClusterDeletionStatus{
+			Phase:          phase,
+			PhaseStartTime: metav1.Now(),
+		}
+	}
+	deletion := cluster.Status.Deletion
+
+	deletion.PendingObjects = nil
+	if len(pendingObjects) > 0 {
+		deletion.PendingObjects = pendingObjects[:min(len(pendingObjects), 10)]
+	}
+
+	phaseTimeoutSeconds := ptr.Deref(cluster.Spec.Deletion.PhaseTimeoutSeconds, 0)
+	if phaseTimeoutSeconds == 0 || phase == clusterv1.ClusterDeletionPhaseCompleted {
+		deletion.PhaseTimedOut = nil
+		return ctrl.Result{}
+	}
+
+	phaseTimeout := time.Duration(phaseTimeoutSeconds) * time.Second
+	if elapsed := time.Since(deletion.PhaseStartTime.Time); elapsed < phaseTimeout {
+		deletion.PhaseTimedOut = nil
+		return ctrl.Result{RequeueAfter: phaseTimeout - elapsed}
+	}
+
+	if !ptr.Deref(deletion.PhaseTimedOut, false) {
+		r.recorder.Eventf(cluster, corev1.EventTypeWarning, "DeletionPhaseTimedOut", "Cluster deletion phase %s is taking longer than %s", phase, phaseTimeout)
+	}
+	deletion.PhaseTimedOut = ptr.To(true)
+	s.deletingMessage += fmt.Sprintf("\nDeletion phase %s is taking longer than %s", phase, phaseTimeout)
+	return ctrl.Result{}
+}
+
 type clusterDescendants struct {
 	machineDeployments     clusterv1.MachineDeploymentList
 	machineSets            clusterv1.MachineSetList
@@ -511,35 +652,29 @@ type clusterDescendants struct {
 	machinePools           clusterv1.MachinePoolList
 }
 
-// objectsPendingDeleteCount returns the number of descendants pending delete.
-// Note: infrastructure cluster, control plane object and its controlled machines are not included.
-func (c *clusterDescendants) objectsPendingDeleteCount(cluster *clusterv1.Cluster) int {
-	n := len(c.machinePools.Items) +
+// workersPendingDeleteCount returns the number of worker descendants pending delete.
+// Note: infrastructure cluster, control plane object and control plane machines are not included.
+func (c *clusterDescendants) workersPendingDeleteCount() int {
+	return len(c.machinePools.Items) +
 		len(c.machineDeployments.Items) +
 		len(c.machineSets.Items) +
 		len(c.workerMachines)
+}
 
-	if !cluster.Spec.ControlPlaneRef.IsDefined() {
-		n += len(c.controlPlaneMachines)
+// controlPlaneMachineNames returns the names of the control plane machines.
+func (c *clusterDescendants) controlPlaneMachineNames() string {
+	controlPlaneMachineNames := make([]string, len(c.controlPlaneMachines))
+	for i, controlPlaneMachine := range c.controlPlaneMachines.UnsortedList() {
+		controlPlaneMachineNames[i] = controlPlaneMachine.Name
 	}
-
-	return n
+	sort.Strings(controlPlaneMachineNames)
+	return clog.StringListToString(controlPlaneMachineNames)
 }
 
-// objectsPendingDeleteNames return the names of descendants pending delete.
-// Note: infrastructure cluster, control plane object and its controlled machines are not included.
-func (c *clusterDescendants) objectsPendingDeleteNames(cluster *clusterv1.Cluster) []string {
+// workersPendingDeleteNames return the names of worker descendants pending delete.
+// Note: infrastructure cluster, control plane object and control plane machines are not included.
+func (c *clusterDescendants) workersPendingDeleteNames() []string {
 	descendants := make([]string, 0)
-	if !cluster.Spec.ControlPlaneRef.IsDefined() {
-		controlPlaneMachineNames := make([]string, len(c.controlPlaneMachines))
-		for i, controlPlaneMachine := range c.controlPlaneMachines.UnsortedList() {
-			controlPlaneMachineNames[i] = controlPlaneMachine.Name
-		}
-		if len(controlPlaneMachineNames) > 0 {
-			sort.Strings(controlPlaneMachineNames)
-			descendants = append(descendants, "Control plane Machines: "+clog.StringListToString(controlPlaneMachineNames))
-		}
-	}
 	machineDeploymentNames := make([]string, len(c.machineDeployments.Items))
 	for i, machineDeployment := range c.machineDeployments.Items {
 		machineDeploymentNames[i] = machineDeployment.Name
@@ -618,11 +753,36 @@ func (r *Reconciler) getDescendants(ctx context.Context, s *scope) (reconcile.Re
 	return reconcile.Result{}, nil
 }
 
-// filterOwnedDescendants returns an array of runtime.Objects containing only those descendants that have the cluster
-// as an owner reference, with control plane machines sorted last.
+// filterOwnedDescendants returns an array of runtime.Objects containing only those worker descendants that have the cluster
+// as an owner reference.
 // Note: this list must include stand-alone MachineSets and stand-alone Machines; instead MachineSets or Machines controlled
 // by higher level abstractions like e.g. MachineDeployment are not be included (if owner references are properly set on those machines).
+// Note: control plane machines are not included, so the control plane is deleted only after all the worker machines are done.
 func (c *clusterDescendants) filterOwnedDescendants(cluster *clusterv1.Cluster) ([]client.Object, error) {
+	lists := []client.ObjectList{
+		&c.machineDeployments,
+		&c.machineSets,
+		machinesToObjectList(c.workerMachines),
+	}
+	if feature.Gates.Enabled(feature.MachinePool) {
+		lists = append([]client.ObjectList{&c.machinePools}, lists...)
+	}
+	return filterOwnedByCluster(cluster, lists...)
+}
+
+// filterOwnedControlPlaneMachines returns the control plane machines that have the cluster as an owner reference.
+// Note: control plane machines are included only if there is no control plane object responsible to manage them.
+// Note: Using stand-alone control plane machines is not yet officially deprecated, however this approach
+// has well known limitations that have been address by the introduction of control plane objects. One of those
+// limitation is about the deletion workflow, which is governed by this function.
+func (c *clusterDescendants) filterOwnedControlPlaneMachines(cluster *clusterv1.Cluster) ([]client.Object, error) {
+	if cluster.Spec.ControlPlaneRef.IsDefined() {
+		return nil, nil
+	}
+	return filterOwnedByCluster(cluster, machinesToObjectList(c.controlPlaneMachines))
+}
+
+func filterOwnedByCluster(cluster *clusterv1.Cluster, lists ...client.ObjectList) ([]client.Object, error) {
 	var ownedDescendants []client.Object
 	eachFunc := func(o runtime.Object) error {
 		obj := o.(client.Object)
@@ -638,39 +798,6 @@ func (c *clusterDescendants) filterOwnedDescendants(cluster *clusterv1.Cluster)
 		return nil
 	}
 
-	toObjectList := func(c collections.Machines) client.ObjectList {
-		l := &clusterv1.MachineList{}
-		for _, m := range c.UnsortedList() {
-			l.Items = append(l.Items, *m)
-		}
-		sort.Slice(l.Items, func(i, j int) bool {
-			return l.Items[i].Name < l.Items[j].Name
-		})
-		return l
-	}
-
-	lists := []client.ObjectList{
-		&c.machineDeployments,
-		&c.machineSets,
-		toObjectList(c.workerMachines),
-	}
-	if feature.Gates.Enabled(feature.MachinePool) {
-		lists = append([]client.ObjectList{&c.machinePools}, lists...)
-	}
-
-	// Make sure that control plane machines are included only if there is no control plane object
-	// responsible to manage them.
-	// Note: Excluding machines controlled by a control plane object is an additional safeguard to ensure
-	// that the control plane is deleted only after all the workers machine are done.
-	// Note: Using stand-alone control plane machines is not yet officially deprecated, however this approach
-	// has well known limitations that have been address by the introduction of control plane objects. One of those
-	// limitation is about the deletion workflow, which is governed by this function.
-	// More specifically, when deleting a Cluster with stand-alone control plane machines, stand-alone control plane machines
-	// will be decommissioned in parallel with other machines, no matter of them being added as last in this list.
-	if !cluster.Spec.ControlPlaneRef.IsDefined() {
-		lists = append(lists, toObjectList(c.controlPlaneMachines))
-	}
-
 	for _, list := range lists {
 		if err := meta.EachListItem(list, eachFunc); err != nil {
 			return nil, errors.Wrapf(err, "error finding owned descendants of cluster %s/%s", cluster.Namespace, cluster.Name)
@@ -680,6 +807,17 @@ func (c *clusterDescendants) filterOwnedDescendants(cluster *clusterv1.Cluster)
 	return ownedDescendants, nil
 }
 
+func machinesToObjectList(c collections.Machines) client.ObjectList {
+	l := &clusterv1.MachineList{}
+	for _, m := range c.UnsortedList() {
+		l.Items = append(l.Items, *m)
+	}
+	sort.Slice(l.Items, func(i, j int) bool {
+		return l.Items[i].Name < l.Items[j].Name
+	})
+	return l
+}
+
 func (r *Reconciler) reconcileV1Beta1ControlPlaneInitialized(ctx context.Context, s *scope) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	cluster := s.cluster
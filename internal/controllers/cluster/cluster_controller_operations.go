@@ -0,0 +1,176 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/contract"
+	"sigs.k8s.io/cluster-api/util/collections"
+)
+
+// maxLastOperations is the maximum number of operations kept in status.lastOperations.
+// NOTE: This value must be kept in sync with the MaxItems validation of the field.
+const maxLastOperations = 20
+
+// lastOperationsInput is the input of setLastOperations.
+type lastOperationsInput struct {
+	controlPlane            *unstructured.Unstructured
+	controlPlaneIsNotFound  bool
+	machinesToBeRemediated  collections.Machines
+	getDescendantsSucceeded bool
+
+	// previousControlPlaneDesiredReplicas and previousWorkersDesiredReplicas are the desired replicas
+	// reported in the Cluster status before the current reconcile.
+	previousControlPlaneDesiredReplicas *int32
+	previousWorkersDesiredReplicas      *int32
+}
+
+// setLastOperations records the significant operations observed on the Cluster in status.lastOperations.
+// NOTE: This func must be called after the replica counters have been computed.
+func setLastOperations(ctx context.Context, cluster *clusterv1.Cluster, in lastOperationsInput, now time.Time) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Record the move of the Cluster; the annotation is set by clusterctl move on the Clusters created
+	// in the target management cluster.
+	if value, ok := cluster.Annotations[clusterv1.ClusterMovedAnnotation]; ok {
+		movedAt, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			log.Error(err, fmt.Sprintf("Failed to parse %s annotation", clusterv1.ClusterMovedAnnotation))
+		} else if !hasLastOperation(cluster, func(op clusterv1.ClusterOperation) bool {
+			return op.Type == clusterv1.ClusterOperationMoved && op.Time.Time.Equal(movedAt)
+		}) {
+			addLastOperation(cluster, clusterv1.ClusterOperation{
+				Type:    clusterv1.ClusterOperationMoved,
+				Message: "Cluster moved by clusterctl",
+				Time:    metav1.NewTime(movedAt),
+			})
+		}
+	}
+
+	// Record control plane upgrades; an upgrade is considered started when the control plane reports spec.version
+	// greater than status.version, and completed when the two versions match again.
+	if in.controlPlane != nil && !in.controlPlaneIsNotFound {
+		if specVersion, err := contract.ControlPlane().Version().Get(in.controlPlane); err == nil {
+			isUpgrading, err := contract.ControlPlane().IsUpgrading(in.controlPlane)
+			if err != nil {
+				log.Error(err, "Failed to check if the control plane is upgrading")
+			} else {
+				startedMessage := fmt.Sprintf("Control plane upgrade to %s started", *specVersion)
+				last := lastUpgradeOperation(cluster)
+				switch {
+				case isUpgrading && (last == nil || last.Type == clusterv1.ClusterOperationControlPlaneUpgradeCompleted || last.Message != startedMessage):
+					addLastOperation(cluster, clusterv1.ClusterOperation{
+						Type:    clusterv1.ClusterOperationControlPlaneUpgradeStarted,
+						Message: startedMessage,
+						Time:    metav1.NewTime(now),
+					})
+				case !isUpgrading && last != nil && last.Type == clusterv1.ClusterOperationControlPlaneUpgradeStarted:
+					addLastOperation(cluster, clusterv1.ClusterOperation{
+						Type:    clusterv1.ClusterOperationControlPlaneUpgradeCompleted,
+						Message: fmt.Sprintf("Control plane upgrade to %s completed", *specVersion),
+						Time:    metav1.NewTime(now),
+					})
+				}
+			}
+		}
+	}
+
+	// Record scale events; changes from or to an unknown number of replicas, e.g. when the Cluster is created, are not recorded.
+	if cluster.Status.ControlPlane != nil {
+		if message, changed := replicasChangedMessage("Control plane", in.previousControlPlaneDesiredReplicas, cluster.Status.ControlPlane.DesiredReplicas); changed {
+			addLastOperation(cluster, clusterv1.ClusterOperation{
+				Type:    clusterv1.ClusterOperationControlPlaneScaled,
+				Message: message,
+				Time:    metav1.NewTime(now),
+			})
+		}
+	}
+	if cluster.Status.Workers != nil {
+		if message, changed := replicasChangedMessage("Workers", in.previousWorkersDesiredReplicas, cluster.Status.Workers.DesiredReplicas); changed {
+			addLastOperation(cluster, clusterv1.ClusterOperation{
+				Type:    clusterv1.ClusterOperationWorkersScaled,
+				Message: message,
+				Time:    metav1.NewTime(now),
+			})
+		}
+	}
+
+	// Record remediations; each Machine is recorded once, when it is first observed as being remediated.
+	if in.getDescendantsSucceeded {
+		names := in.machinesToBeRemediated.Names()
+		sort.Strings(names)
+		for _, name := range names {
+			message := fmt.Sprintf("Machine %s is being remediated", name)
+			if hasLastOperation(cluster, func(op clusterv1.ClusterOperation) bool {
+				return op.Type == clusterv1.ClusterOperationMachineRemediated && op.Message == message
+			}) {
+				continue
+			}
+			addLastOperation(cluster, clusterv1.ClusterOperation{
+				Type:    clusterv1.ClusterOperationMachineRemediated,
+				Message: message,
+				Time:    metav1.NewTime(now),
+			})
+		}
+	}
+}
+
+// replicasChangedMessage returns a message describing a change of the desired replicas, if any.
+func replicasChangedMessage(subject string, previous, current *int32) (string, bool) {
+	if previous == nil || current == nil || *previous == *current {
+		return "", false
+	}
+	return fmt.Sprintf("%s scaled from %d to %d replicas", subject, *previous, *current), true
+}
+
+// lastUpgradeOperation returns the most recent control plane upgrade operation in status.lastOperations, if any.
+func lastUpgradeOperation(cluster *clusterv1.Cluster) *clusterv1.ClusterOperation {
+	for i := len(cluster.Status.LastOperations) - 1; i >= 0; i-- {
+		op := cluster.Status.LastOperations[i]
+		if op.Type == clusterv1.ClusterOperationControlPlaneUpgradeStarted || op.Type == clusterv1.ClusterOperationControlPlaneUpgradeCompleted {
+			return &op
+		}
+	}
+	return nil
+}
+
+func hasLastOperation(cluster *clusterv1.Cluster, matches func(op clusterv1.ClusterOperation) bool) bool {
+	for _, op := range cluster.Status.LastOperations {
+		if matches(op) {
+			return true
+		}
+	}
+	return false
+}
+
+// addLastOperation appends an operation to status.lastOperations, dropping the oldest operations
+// if the history is full.
+func addLastOperation(cluster *clusterv1.Cluster, op clusterv1.ClusterOperation) {
+	cluster.Status.LastOperations = append(cluster.Status.LastOperations, op)
+	if overflow := len(cluster.Status.LastOperations) - maxLastOperations; overflow > 0 {
+		cluster.Status.LastOperations = cluster.Status.LastOperations[overflow:]
+	}
+}
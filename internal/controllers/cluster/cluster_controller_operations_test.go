@@ -0,0 +1,204 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/collections"
+)
+
+func TestSetLastOperations(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	movedAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	controlPlaneWithVersions := func(specVersion, statusVersion string) *unstructured.Unstructured {
+		cp := fakeControlPlane("cp1")
+		_ = unstructured.SetNestedField(cp.Object, specVersion, "spec", "version")
+		if statusVersion != "" {
+			_ = unstructured.SetNestedField(cp.Object, statusVersion, "status", "version")
+		}
+		return cp
+	}
+
+	tests := []struct {
+		name                   string
+		cluster                *clusterv1.Cluster
+		in                     lastOperationsInput
+		expectedLastOperations []clusterv1.ClusterOperation
+	}{
+		{
+			name:                   "no operations",
+			cluster:                fakeCluster("c"),
+			in:                     lastOperationsInput{getDescendantsSucceeded: true},
+			expectedLastOperations: nil,
+		},
+		{
+			name: "moved",
+			cluster: func() *clusterv1.Cluster {
+				c := fakeCluster("c")
+				c.Annotations = map[string]string{clusterv1.ClusterMovedAnnotation: movedAt.Format(time.RFC3339)}
+				return c
+			}(),
+			expectedLastOperations: []clusterv1.ClusterOperation{
+				{Type: clusterv1.ClusterOperationMoved, Message: "Cluster moved by clusterctl", Time: metav1.NewTime(movedAt)},
+			},
+		},
+		{
+			name: "move already recorded",
+			cluster: func() *clusterv1.Cluster {
+				c := fakeCluster("c")
+				c.Annotations = map[string]string{clusterv1.ClusterMovedAnnotation: movedAt.Format(time.RFC3339)}
+				c.Status.LastOperations = []clusterv1.ClusterOperation{
+					{Type: clusterv1.ClusterOperationMoved, Message: "Cluster moved by clusterctl", Time: metav1.NewTime(movedAt)},
+				}
+				return c
+			}(),
+			expectedLastOperations: []clusterv1.ClusterOperation{
+				{Type: clusterv1.ClusterOperationMoved, Message: "Cluster moved by clusterctl", Time: metav1.NewTime(movedAt)},
+			},
+		},
+		{
+			name:    "control plane provisioning is not an upgrade",
+			cluster: fakeCluster("c"),
+			in: lastOperationsInput{
+				controlPlane: controlPlaneWithVersions("v1.34.0", ""),
+			},
+			expectedLastOperations: nil,
+		},
+		{
+			name:    "control plane upgrade started",
+			cluster: fakeCluster("c"),
+			in: lastOperationsInput{
+				controlPlane: controlPlaneWithVersions("v1.34.0", "v1.33.0"),
+			},
+			expectedLastOperations: []clusterv1.ClusterOperation{
+				{Type: clusterv1.ClusterOperationControlPlaneUpgradeStarted, Message: "Control plane upgrade to v1.34.0 started", Time: metav1.NewTime(now)},
+			},
+		},
+		{
+			name: "control plane upgrade already recorded as started",
+			cluster: func() *clusterv1.Cluster {
+				c := fakeCluster("c")
+				c.Status.LastOperations = []clusterv1.ClusterOperation{
+					{Type: clusterv1.ClusterOperationControlPlaneUpgradeStarted, Message: "Control plane upgrade to v1.34.0 started", Time: metav1.NewTime(movedAt)},
+				}
+				return c
+			}(),
+			in: lastOperationsInput{
+				controlPlane: controlPlaneWithVersions("v1.34.0", "v1.33.0"),
+			},
+			expectedLastOperations: []clusterv1.ClusterOperation{
+				{Type: clusterv1.ClusterOperationControlPlaneUpgradeStarted, Message: "Control plane upgrade to v1.34.0 started", Time: metav1.NewTime(movedAt)},
+			},
+		},
+		{
+			name: "control plane upgrade completed",
+			cluster: func() *clusterv1.Cluster {
+				c := fakeCluster("c")
+				c.Status.LastOperations = []clusterv1.ClusterOperation{
+					{Type: clusterv1.ClusterOperationControlPlaneUpgradeStarted, Message: "Control plane upgrade to v1.34.0 started", Time: metav1.NewTime(movedAt)},
+				}
+				return c
+			}(),
+			in: lastOperationsInput{
+				controlPlane: controlPlaneWithVersions("v1.34.0", "v1.34.0"),
+			},
+			expectedLastOperations: []clusterv1.ClusterOperation{
+				{Type: clusterv1.ClusterOperationControlPlaneUpgradeStarted, Message: "Control plane upgrade to v1.34.0 started", Time: metav1.NewTime(movedAt)},
+				{Type: clusterv1.ClusterOperationControlPlaneUpgradeCompleted, Message: "Control plane upgrade to v1.34.0 completed", Time: metav1.NewTime(now)},
+			},
+		},
+		{
+			name: "scale events",
+			cluster: func() *clusterv1.Cluster {
+				c := fakeCluster("c")
+				c.Status.ControlPlane = &clusterv1.ClusterControlPlaneStatus{DesiredReplicas: ptr.To[int32](3)}
+				c.Status.Workers = &clusterv1.WorkersStatus{DesiredReplicas: ptr.To[int32](5)}
+				return c
+			}(),
+			in: lastOperationsInput{
+				previousControlPlaneDesiredReplicas: ptr.To[int32](1),
+				previousWorkersDesiredReplicas:      ptr.To[int32](5),
+			},
+			expectedLastOperations: []clusterv1.ClusterOperation{
+				{Type: clusterv1.ClusterOperationControlPlaneScaled, Message: "Control plane scaled from 1 to 3 replicas", Time: metav1.NewTime(now)},
+			},
+		},
+		{
+			name: "desired replicas observed for the first time are not a scale event",
+			cluster: func() *clusterv1.Cluster {
+				c := fakeCluster("c")
+				c.Status.ControlPlane = &clusterv1.ClusterControlPlaneStatus{DesiredReplicas: ptr.To[int32](3)}
+				c.Status.Workers = &clusterv1.WorkersStatus{DesiredReplicas: ptr.To[int32](5)}
+				return c
+			}(),
+			expectedLastOperations: nil,
+		},
+		{
+			name: "remediations are recorded once per Machine",
+			cluster: func() *clusterv1.Cluster {
+				c := fakeCluster("c")
+				c.Status.LastOperations = []clusterv1.ClusterOperation{
+					{Type: clusterv1.ClusterOperationMachineRemediated, Message: "Machine m1 is being remediated", Time: metav1.NewTime(movedAt)},
+				}
+				return c
+			}(),
+			in: lastOperationsInput{
+				machinesToBeRemediated:  collections.FromMachines(fakeMachine("m2"), fakeMachine("m1")),
+				getDescendantsSucceeded: true,
+			},
+			expectedLastOperations: []clusterv1.ClusterOperation{
+				{Type: clusterv1.ClusterOperationMachineRemediated, Message: "Machine m1 is being remediated", Time: metav1.NewTime(movedAt)},
+				{Type: clusterv1.ClusterOperationMachineRemediated, Message: "Machine m2 is being remediated", Time: metav1.NewTime(now)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setLastOperations(ctrl.LoggerInto(t.Context(), ctrl.Log), tt.cluster, tt.in, now)
+			g.Expect(tt.cluster.Status.LastOperations).To(BeComparableTo(tt.expectedLastOperations))
+		})
+	}
+}
+
+func TestAddLastOperation(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := fakeCluster("c")
+	for i := range maxLastOperations + 2 {
+		addLastOperation(cluster, clusterv1.ClusterOperation{
+			Type:    clusterv1.ClusterOperationWorkersScaled,
+			Message: fmt.Sprintf("operation %d", i),
+		})
+	}
+
+	g.Expect(cluster.Status.LastOperations).To(HaveLen(maxLastOperations))
+	g.Expect(cluster.Status.LastOperations[0].Message).To(Equal("operation 2"))
+	g.Expect(cluster.Status.LastOperations[maxLastOperations-1].Message).To(Equal(fmt.Sprintf("operation %d", maxLastOperations+1)))
+}
@@ -69,9 +69,20 @@ func (r *Reconciler) updateStatus(ctx context.Context, s *scope) error {
 	machinesToBeRemediated := s.descendants.machinesToBeRemediated.Filter(collections.Not(isMachinePoolMachine))
 	unhealthyMachines := s.descendants.unhealthyMachines.Filter(collections.Not(isMachinePoolMachine))
 
+	// Keep track of the desired replicas before computing replica counters, so it is possible to detect scale events.
+	var previousControlPlaneDesiredReplicas, previousWorkersDesiredReplicas *int32
+	if s.cluster.Status.ControlPlane != nil {
+		previousControlPlaneDesiredReplicas = s.cluster.Status.ControlPlane.DesiredReplicas
+	}
+	if s.cluster.Status.Workers != nil {
+		previousWorkersDesiredReplicas = s.cluster.Status.Workers.DesiredReplicas
+	}
+
 	// replica counters
 	setControlPlaneReplicas(ctx, s.cluster, s.controlPlane, controlPlaneContractVersion, s.descendants.controlPlaneMachines, s.controlPlaneIsNotFound, s.getDescendantsSucceeded)
 	setWorkersReplicas(ctx, s.cluster, clusterv1.MachinePoolList{}, s.descendants.machineDeployments, s.descendants.machineSets, workerMachines, s.getDescendantsSucceeded)
+	setControlPlaneVersion(ctx, s.cluster, s.controlPlane, s.controlPlaneIsNotFound)
+	setFailureDomainsSummary(ctx, s.cluster, s.descendants.controlPlaneMachines, s.descendants.workerMachines, s.getDescendantsSucceeded)
 
 	// conditions
 	healthCheckingState := r.ClusterCache.GetHealthCheckingState(ctx, client.ObjectKeyFromObject(s.cluster))
@@ -88,9 +99,20 @@ func (r *Reconciler) updateStatus(ctx context.Context, s *scope) error {
 	setScalingUpCondition(ctx, s.cluster, s.controlPlane, clusterv1.MachinePoolList{}, s.descendants.machineDeployments, s.descendants.machineSets, s.controlPlaneIsNotFound, s.getDescendantsSucceeded)
 	setScalingDownCondition(ctx, s.cluster, s.controlPlane, clusterv1.MachinePoolList{}, s.descendants.machineDeployments, s.descendants.machineSets, s.controlPlaneIsNotFound, s.getDescendantsSucceeded)
 	setRemediatingCondition(ctx, s.cluster, machinesToBeRemediated, unhealthyMachines, s.getDescendantsSucceeded)
+	setDegradedFreezeCondition(ctx, s.cluster, s.descendants.unhealthyMachines, s.getDescendantsSucceeded)
 	setDeletingCondition(ctx, s.cluster, s.deletingReason, s.deletingMessage)
 	setAvailableCondition(ctx, s.cluster, s.clusterClass)
 
+	// operations history
+	setLastOperations(ctx, s.cluster, lastOperationsInput{
+		controlPlane:                        s.controlPlane,
+		controlPlaneIsNotFound:              s.controlPlaneIsNotFound,
+		machinesToBeRemediated:              s.descendants.machinesToBeRemediated,
+		getDescendantsSucceeded:             s.getDescendantsSucceeded,
+		previousControlPlaneDesiredReplicas: previousControlPlaneDesiredReplicas,
+		previousWorkersDesiredReplicas:      previousWorkersDesiredReplicas,
+	}, time.Now())
+
 	return nil
 }
 
@@ -198,6 +220,20 @@ func setControlPlaneReplicas(_ context.Context, cluster *clusterv1.Cluster, cont
 	cluster.Status.ControlPlane.DesiredReplicas = replicas
 }
 
+// setControlPlaneVersion surfaces the Kubernetes version reported by the control plane, so it is possible
+// to detect version drift e.g. with respect to spec.topology.version.
+// NOTE: This func must be called after setControlPlaneReplicas, which initializes status.controlPlane.
+func setControlPlaneVersion(_ context.Context, cluster *clusterv1.Cluster, controlPlane *unstructured.Unstructured, controlPlaneIsNotFound bool) {
+	cluster.Status.ControlPlane.Version = ""
+	if controlPlane == nil || controlPlaneIsNotFound {
+		return
+	}
+
+	if version, err := contract.ControlPlane().StatusVersion().Get(controlPlane); err == nil && version != nil {
+		cluster.Status.ControlPlane.Version = *version
+	}
+}
+
 func setWorkersReplicas(_ context.Context, cluster *clusterv1.Cluster, machinePools clusterv1.MachinePoolList, machineDeployments clusterv1.MachineDeploymentList, machineSets clusterv1.MachineSetList, workerMachines collections.Machines, getDescendantsSucceeded bool) {
 	if cluster.Status.Workers == nil {
 		cluster.Status.Workers = &clusterv1.WorkersStatus{}
@@ -293,6 +329,101 @@ func setWorkersReplicas(_ context.Context, cluster *clusterv1.Cluster, machinePo
 	cluster.Status.Workers.UpToDateReplicas = upToDateReplicas
 }
 
+// maxFailureDomainsSummaryItems is the maximum number of items in status.failureDomainsSummary.failureDomains.
+const maxFailureDomainsSummaryItems = 100
+
+func setFailureDomainsSummary(_ context.Context, cluster *clusterv1.Cluster, controlPlaneMachines, workerMachines collections.Machines, getDescendantsSucceeded bool) {
+	// If there was some unexpected errors in listing descendants (this should never happen), do not update the summary.
+	if !getDescendantsSucceeded {
+		return
+	}
+
+	// Count machines in the failure domains reported in status.failureDomains first, so failure domains without machines are surfaced too.
+	summaries := map[string]*clusterv1.ClusterFailureDomainSummary{}
+	summaryFor := func(name string) *clusterv1.ClusterFailureDomainSummary {
+		if summary, ok := summaries[name]; ok {
+			return summary
+		}
+		summary := &clusterv1.ClusterFailureDomainSummary{
+			Name:                      name,
+			Machines:                  ptr.To[int32](0),
+			ReadyMachines:             ptr.To[int32](0),
+			ControlPlaneMachines:      ptr.To[int32](0),
+			ReadyControlPlaneMachines: ptr.To[int32](0),
+		}
+		summaries[name] = summary
+		return summary
+	}
+	for _, fd := range cluster.Status.FailureDomains {
+		summaryFor(fd.Name)
+	}
+
+	for _, machine := range controlPlaneMachines.UnsortedList() {
+		if machine.Spec.FailureDomain == "" {
+			continue
+		}
+		summary := summaryFor(machine.Spec.FailureDomain)
+		*summary.Machines++
+		*summary.ControlPlaneMachines++
+		if conditions.IsTrue(machine, clusterv1.MachineReadyCondition) {
+			*summary.ReadyMachines++
+			*summary.ReadyControlPlaneMachines++
+		}
+	}
+	for _, machine := range workerMachines.UnsortedList() {
+		if machine.Spec.FailureDomain == "" {
+			continue
+		}
+		summary := summaryFor(machine.Spec.FailureDomain)
+		*summary.Machines++
+		if conditions.IsTrue(machine, clusterv1.MachineReadyCondition) {
+			*summary.ReadyMachines++
+		}
+	}
+
+	if len(summaries) == 0 {
+		cluster.Status.FailureDomainsSummary = nil
+		return
+	}
+
+	failureDomainsSummary := &clusterv1.ClusterFailureDomainsSummary{}
+	for _, summary := range summaries {
+		failureDomainsSummary.FailureDomains = append(failureDomainsSummary.FailureDomains, *summary)
+	}
+	sort.Slice(failureDomainsSummary.FailureDomains, func(i, j int) bool {
+		return failureDomainsSummary.FailureDomains[i].Name < failureDomainsSummary.FailureDomains[j].Name
+	})
+	// Note: this should never happen, but the number of failure domains in the summary is capped to prevent failures when patching the Cluster.
+	if len(failureDomainsSummary.FailureDomains) > maxFailureDomainsSummaryItems {
+		failureDomainsSummary.FailureDomains = failureDomainsSummary.FailureDomains[:maxFailureDomainsSummaryItems]
+	}
+
+	// The control plane skew is computed across the failure domains suitable for control plane machines,
+	// because those are the failure domains control plane providers are expected to spread machines across.
+	var minControlPlaneMachines, maxControlPlaneMachines *int32
+	for _, fd := range cluster.Status.FailureDomains {
+		if !ptr.Deref(fd.ControlPlane, false) {
+			continue
+		}
+		count := *summaries[fd.Name].ControlPlaneMachines
+		if minControlPlaneMachines == nil || count < *minControlPlaneMachines {
+			minControlPlaneMachines = ptr.To(count)
+		}
+		if maxControlPlaneMachines == nil || count > *maxControlPlaneMachines {
+			maxControlPlaneMachines = ptr.To(count)
+		}
+	}
+	if maxControlPlaneMachines != nil && *maxControlPlaneMachines > 0 {
+		failureDomainsSummary.ControlPlaneSkew = ptr.To(*maxControlPlaneMachines - *minControlPlaneMachines)
+	}
+
+	cluster.Status.FailureDomainsSummary = failureDomainsSummary
+}
+
+// clientCertificateExpiryWarningPeriod is the period before the expiry of the client certificate of the kubeconfig
+// during which the expiry is surfaced in the RemoteConnectionProbe condition.
+const clientCertificateExpiryWarningPeriod = 7 * 24 * time.Hour
+
 func setRemoteConnectionProbeCondition(_ context.Context, cluster *clusterv1.Cluster, healthCheckingState clustercache.HealthCheckingState, remoteConnectionGracePeriod time.Duration) {
 	// ClusterCache did not try to connect often enough yet, either during controller startup or when a new Cluster is created.
 	if healthCheckingState.LastProbeSuccessTime.IsZero() && healthCheckingState.ConsecutiveFailures < 5 {
@@ -309,11 +440,12 @@ func setRemoteConnectionProbeCondition(_ context.Context, cluster *clusterv1.Clu
 	}
 
 	if time.Since(healthCheckingState.LastProbeSuccessTime) > remoteConnectionGracePeriod {
-		var msg string
-		if healthCheckingState.LastProbeSuccessTime.IsZero() {
-			msg = "Remote connection probe failed"
-		} else {
-			msg = fmt.Sprintf("Remote connection probe failed, probe last succeeded at %s", healthCheckingState.LastProbeSuccessTime.Format(time.RFC3339))
+		msg := "Remote connection probe failed"
+		if healthCheckingState.ConsecutiveFailures > 0 {
+			msg += fmt.Sprintf(" (%d consecutive failures)", healthCheckingState.ConsecutiveFailures)
+		}
+		if !healthCheckingState.LastProbeSuccessTime.IsZero() {
+			msg += fmt.Sprintf(", probe last succeeded at %s", healthCheckingState.LastProbeSuccessTime.Format(time.RFC3339))
 		}
 		conditions.Set(cluster, metav1.Condition{
 			Type:    clusterv1.ClusterRemoteConnectionProbeCondition,
@@ -324,10 +456,17 @@ func setRemoteConnectionProbeCondition(_ context.Context, cluster *clusterv1.Clu
 		return
 	}
 
+	// Surface client certificates about to expire, so they can be rotated before the connection breaks.
+	var msg string
+	if expiry := healthCheckingState.ClientCertificateExpiryTime; !expiry.IsZero() && time.Until(expiry) < clientCertificateExpiryWarningPeriod {
+		msg = fmt.Sprintf("Client certificate of the kubeconfig expires at %s", expiry.Format(time.RFC3339))
+	}
+
 	conditions.Set(cluster, metav1.Condition{
-		Type:   clusterv1.ClusterRemoteConnectionProbeCondition,
-		Status: metav1.ConditionTrue,
-		Reason: clusterv1.ClusterRemoteConnectionProbeSucceededReason,
+		Type:    clusterv1.ClusterRemoteConnectionProbeCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  clusterv1.ClusterRemoteConnectionProbeSucceededReason,
+		Message: msg,
 	})
 }
 
@@ -873,6 +1012,77 @@ func setRemediatingCondition(ctx context.Context, cluster *clusterv1.Cluster, ma
 	})
 }
 
+// setDegradedFreezeCondition sets the DegradedFreeze condition, which is used to freeze automated disruptive actions
+// on the Cluster when the number of unhealthy Machines reaches spec.degradedFreeze.unhealthyMachinesThreshold.
+// Note: Once set to True, the condition stays True until the freeze is acknowledged via annotation, even if the
+// number of unhealthy Machines drops below the threshold in the meantime.
+func setDegradedFreezeCondition(_ context.Context, cluster *clusterv1.Cluster, unhealthyMachines collections.Machines, getDescendantsSucceeded bool) {
+	threshold := cluster.Spec.DegradedFreeze.UnhealthyMachinesThreshold
+	if threshold == nil {
+		conditions.Delete(cluster, clusterv1.ClusterDegradedFreezeCondition)
+		return
+	}
+
+	if !getDescendantsSucceeded {
+		// Keep the freeze if it is already active; it is not possible to determine if it has been lifted.
+		if conditions.IsTrue(cluster, clusterv1.ClusterDegradedFreezeCondition) {
+			return
+		}
+		conditions.Set(cluster, metav1.Condition{
+			Type:    clusterv1.ClusterDegradedFreezeCondition,
+			Status:  metav1.ConditionUnknown,
+			Reason:  clusterv1.ClusterDegradedFreezeInternalErrorReason,
+			Message: "Please check controller logs for errors",
+		})
+		return
+	}
+
+	unhealthyCount := int32(len(unhealthyMachines))
+	_, acknowledged := cluster.Annotations[clusterv1.DegradedFreezeAcknowledgedAnnotation]
+	frozenMessage := fmt.Sprintf("Unhealthy Machines reached the threshold of %d (currently %d), automated rollouts and remediation are frozen until the %s annotation is set",
+		*threshold, unhealthyCount, clusterv1.DegradedFreezeAcknowledgedAnnotation)
+
+	if unhealthyCount < *threshold {
+		// Remove the acknowledgment as soon as the Cluster recovered, so a new freeze can be triggered by future failures.
+		if acknowledged {
+			delete(cluster.Annotations, clusterv1.DegradedFreezeAcknowledgedAnnotation)
+		} else if conditions.IsTrue(cluster, clusterv1.ClusterDegradedFreezeCondition) {
+			conditions.Set(cluster, metav1.Condition{
+				Type:    clusterv1.ClusterDegradedFreezeCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  clusterv1.ClusterDegradedFreezeReason,
+				Message: frozenMessage,
+			})
+			return
+		}
+
+		conditions.Set(cluster, metav1.Condition{
+			Type:   clusterv1.ClusterDegradedFreezeCondition,
+			Status: metav1.ConditionFalse,
+			Reason: clusterv1.ClusterNotDegradedFreezeReason,
+		})
+		return
+	}
+
+	if acknowledged {
+		conditions.Set(cluster, metav1.Condition{
+			Type:   clusterv1.ClusterDegradedFreezeCondition,
+			Status: metav1.ConditionFalse,
+			Reason: clusterv1.ClusterDegradedFreezeAcknowledgedReason,
+			Message: fmt.Sprintf("Unhealthy Machines reached the threshold of %d (currently %d), the freeze has been acknowledged via the %s annotation",
+				*threshold, unhealthyCount, clusterv1.DegradedFreezeAcknowledgedAnnotation),
+		})
+		return
+	}
+
+	conditions.Set(cluster, metav1.Condition{
+		Type:    clusterv1.ClusterDegradedFreezeCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  clusterv1.ClusterDegradedFreezeReason,
+		Message: frozenMessage,
+	})
+}
+
 func setRollingOutCondition(ctx context.Context, cluster *clusterv1.Cluster, controlPlane *unstructured.Unstructured, machinePools clusterv1.MachinePoolList, machineDeployments clusterv1.MachineDeploymentList, controlPlaneIsNotFound bool, getDescendantsSucceeded bool) {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -246,6 +246,57 @@ func TestSetControlPlaneReplicas(t *testing.T) {
 	}
 }
 
+func TestSetControlPlaneVersion(t *testing.T) {
+	tests := []struct {
+		name                   string
+		cluster                *clusterv1.Cluster
+		controlPlane           *unstructured.Unstructured
+		controlPlaneIsNotFound bool
+		expectVersion          string
+	}{
+		{
+			name:                   "version should be empty if the control plane does not exist",
+			cluster:                fakeCluster("c", controlPlaneRef{Name: "cp"}),
+			controlPlaneIsNotFound: true,
+		},
+		{
+			name:         "version should be empty if the control plane does not report a version",
+			cluster:      fakeCluster("c", controlPlaneRef{Name: "cp"}),
+			controlPlane: fakeControlPlane("cp"),
+		},
+		{
+			name: "version should be reset if the control plane does not report a version anymore",
+			cluster: func() *clusterv1.Cluster {
+				c := fakeCluster("c", controlPlaneRef{Name: "cp"})
+				c.Status.ControlPlane = &clusterv1.ClusterControlPlaneStatus{Version: "v1.33.0"}
+				return c
+			}(),
+			controlPlane: fakeControlPlane("cp"),
+		},
+		{
+			name:    "version should be set if the control plane reports a version",
+			cluster: fakeCluster("c", controlPlaneRef{Name: "cp"}),
+			controlPlane: func() *unstructured.Unstructured {
+				cp := fakeControlPlane("cp")
+				_ = unstructured.SetNestedField(cp.Object, "v1.34.0", "status", "version")
+				return cp
+			}(),
+			expectVersion: "v1.34.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setControlPlaneReplicas(ctx, tt.cluster, tt.controlPlane, "v1beta2", nil, tt.controlPlaneIsNotFound, true)
+			setControlPlaneVersion(ctx, tt.cluster, tt.controlPlane, tt.controlPlaneIsNotFound)
+
+			g.Expect(tt.cluster.Status.ControlPlane).ToNot(BeNil())
+			g.Expect(tt.cluster.Status.ControlPlane.Version).To(Equal(tt.expectVersion))
+		})
+	}
+}
+
 func TestSetWorkersReplicas(t *testing.T) {
 	tests := []struct {
 		name                    string
@@ -334,6 +385,110 @@ func TestSetWorkersReplicas(t *testing.T) {
 	}
 }
 
+func TestSetFailureDomainsSummary(t *testing.T) {
+	ready := condition{Type: clusterv1.MachineReadyCondition, Status: metav1.ConditionTrue}
+	notReady := condition{Type: clusterv1.MachineReadyCondition, Status: metav1.ConditionFalse}
+	summary := func(name string, machines, readyMachines, controlPlaneMachines, readyControlPlaneMachines int32) clusterv1.ClusterFailureDomainSummary {
+		return clusterv1.ClusterFailureDomainSummary{
+			Name:                      name,
+			Machines:                  ptr.To(machines),
+			ReadyMachines:             ptr.To(readyMachines),
+			ControlPlaneMachines:      ptr.To(controlPlaneMachines),
+			ReadyControlPlaneMachines: ptr.To(readyControlPlaneMachines),
+		}
+	}
+
+	tests := []struct {
+		name                        string
+		cluster                     *clusterv1.Cluster
+		controlPlaneMachines        collections.Machines
+		workerMachines              collections.Machines
+		getDescendantsSucceeded     bool
+		expectFailureDomainsSummary *clusterv1.ClusterFailureDomainsSummary
+	}{
+		{
+			name:                    "summary should be nil if failed to get descendants",
+			cluster:                 fakeCluster("c", failureDomains{{Name: "fd1", ControlPlane: ptr.To(true)}}),
+			getDescendantsSucceeded: false,
+		},
+		{
+			name:    "summary should be nil if there are no failure domains",
+			cluster: fakeCluster("c"),
+			controlPlaneMachines: collections.FromMachines(
+				fakeMachine("cp1", ready),
+			),
+			workerMachines: collections.FromMachines(
+				fakeMachine("m1", ready),
+			),
+			getDescendantsSucceeded: true,
+		},
+		{
+			name:                    "summary should report failure domains without machines",
+			cluster:                 fakeCluster("c", failureDomains{{Name: "fd2", ControlPlane: ptr.To(true)}, {Name: "fd1", ControlPlane: ptr.To(true)}}),
+			getDescendantsSucceeded: true,
+			expectFailureDomainsSummary: &clusterv1.ClusterFailureDomainsSummary{
+				FailureDomains: []clusterv1.ClusterFailureDomainSummary{
+					summary("fd1", 0, 0, 0, 0),
+					summary("fd2", 0, 0, 0, 0),
+				},
+			},
+		},
+		{
+			name:    "summary should count machines per failure domain",
+			cluster: fakeCluster("c", failureDomains{{Name: "fd1", ControlPlane: ptr.To(true)}, {Name: "fd2", ControlPlane: ptr.To(true)}, {Name: "fd3", ControlPlane: ptr.To(true)}, {Name: "fd4"}}),
+			controlPlaneMachines: collections.FromMachines(
+				fakeMachine("cp1", failureDomain("fd1"), ready),
+				fakeMachine("cp2", failureDomain("fd1"), notReady),
+				fakeMachine("cp3", failureDomain("fd2"), ready),
+			),
+			workerMachines: collections.FromMachines(
+				fakeMachine("m1", failureDomain("fd1"), ready),
+				fakeMachine("m2", failureDomain("fd4"), ready),
+				fakeMachine("m3", failureDomain("fd4"), notReady),
+				fakeMachine("m4", failureDomain("fd5"), ready), // failure domain not in status.failureDomains
+				fakeMachine("m5", ready),                       // no failure domain
+			),
+			getDescendantsSucceeded: true,
+			expectFailureDomainsSummary: &clusterv1.ClusterFailureDomainsSummary{
+				// fd1 has two control plane machines, fd3 none; fd4 is not suitable for control plane machines.
+				ControlPlaneSkew: ptr.To[int32](2),
+				FailureDomains: []clusterv1.ClusterFailureDomainSummary{
+					summary("fd1", 3, 2, 2, 1),
+					summary("fd2", 1, 1, 1, 1),
+					summary("fd3", 0, 0, 0, 0),
+					summary("fd4", 2, 1, 0, 0),
+					summary("fd5", 1, 1, 0, 0),
+				},
+			},
+		},
+		{
+			name:    "control plane skew should be zero if control plane machines are evenly spread",
+			cluster: fakeCluster("c", failureDomains{{Name: "fd1", ControlPlane: ptr.To(true)}, {Name: "fd2", ControlPlane: ptr.To(true)}}),
+			controlPlaneMachines: collections.FromMachines(
+				fakeMachine("cp1", failureDomain("fd1"), ready),
+				fakeMachine("cp2", failureDomain("fd2"), ready),
+			),
+			getDescendantsSucceeded: true,
+			expectFailureDomainsSummary: &clusterv1.ClusterFailureDomainsSummary{
+				ControlPlaneSkew: ptr.To[int32](0),
+				FailureDomains: []clusterv1.ClusterFailureDomainSummary{
+					summary("fd1", 1, 1, 1, 1),
+					summary("fd2", 1, 1, 1, 1),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setFailureDomainsSummary(ctx, tt.cluster, tt.controlPlaneMachines, tt.workerMachines, tt.getDescendantsSucceeded)
+
+			g.Expect(tt.cluster.Status.FailureDomainsSummary).To(BeComparableTo(tt.expectFailureDomainsSummary))
+		})
+	}
+}
+
 func TestSetRemoteConnectionProbeCondition(t *testing.T) {
 	now := time.Now()
 	remoteConnectionGracePeriod := 5 * time.Minute
@@ -408,7 +563,7 @@ func TestSetRemoteConnectionProbeCondition(t *testing.T) {
 				Type:    clusterv1.ClusterRemoteConnectionProbeCondition,
 				Status:  metav1.ConditionFalse,
 				Reason:  clusterv1.ClusterRemoteConnectionProbeFailedReason,
-				Message: "Remote connection probe failed",
+				Message: "Remote connection probe failed (5 consecutive failures)",
 			},
 		},
 		{
@@ -423,7 +578,7 @@ func TestSetRemoteConnectionProbeCondition(t *testing.T) {
 				Type:    clusterv1.ClusterRemoteConnectionProbeCondition,
 				Status:  metav1.ConditionFalse,
 				Reason:  clusterv1.ClusterRemoteConnectionProbeFailedReason,
-				Message: fmt.Sprintf("Remote connection probe failed, probe last succeeded at %s", (now.Add(-remoteConnectionGracePeriod - time.Second)).Format(time.RFC3339)),
+				Message: fmt.Sprintf("Remote connection probe failed (2 consecutive failures), probe last succeeded at %s", (now.Add(-remoteConnectionGracePeriod - time.Second)).Format(time.RFC3339)),
 			},
 		},
 		{
@@ -440,6 +595,35 @@ func TestSetRemoteConnectionProbeCondition(t *testing.T) {
 				Reason: clusterv1.ClusterRemoteConnectionProbeSucceededReason,
 			},
 		},
+		{
+			name:    "connection up, client certificate not expiring soon",
+			cluster: fakeCluster("c"),
+			healthCheckingState: clustercache.HealthCheckingState{
+				LastProbeTime:               time.Now(),
+				LastProbeSuccessTime:        now,
+				ClientCertificateExpiryTime: now.Add(clientCertificateExpiryWarningPeriod + time.Hour),
+			},
+			expectCondition: metav1.Condition{
+				Type:   clusterv1.ClusterRemoteConnectionProbeCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.ClusterRemoteConnectionProbeSucceededReason,
+			},
+		},
+		{
+			name:    "connection up, client certificate expiring soon",
+			cluster: fakeCluster("c"),
+			healthCheckingState: clustercache.HealthCheckingState{
+				LastProbeTime:               time.Now(),
+				LastProbeSuccessTime:        now,
+				ClientCertificateExpiryTime: now.Add(time.Hour),
+			},
+			expectCondition: metav1.Condition{
+				Type:    clusterv1.ClusterRemoteConnectionProbeCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  clusterv1.ClusterRemoteConnectionProbeSucceededReason,
+				Message: fmt.Sprintf("Client certificate of the kubeconfig expires at %s", now.Add(time.Hour).Format(time.RFC3339)),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2368,6 +2552,139 @@ func TestSetRemediatingCondition(t *testing.T) {
 	}
 }
 
+func TestSetDegradedFreezeCondition(t *testing.T) {
+	healthCheckNotSucceeded := metav1.Condition{Type: clusterv1.MachineHealthCheckSucceededCondition, Status: metav1.ConditionFalse}
+	frozen := metav1.Condition{Type: clusterv1.ClusterDegradedFreezeCondition, Status: metav1.ConditionTrue, Reason: clusterv1.ClusterDegradedFreezeReason}
+
+	cluster := func(threshold *int32, acknowledged bool, conds ...metav1.Condition) *clusterv1.Cluster {
+		c := fakeCluster("c")
+		c.Spec.DegradedFreeze.UnhealthyMachinesThreshold = threshold
+		if acknowledged {
+			c.Annotations = map[string]string{clusterv1.DegradedFreezeAcknowledgedAnnotation: ""}
+		}
+		c.Status.Conditions = conds
+		return c
+	}
+	unhealthyMachines := []*clusterv1.Machine{
+		fakeMachine("m1", condition(healthCheckNotSucceeded)),
+		fakeMachine("m2", condition(healthCheckNotSucceeded)),
+	}
+
+	tests := []struct {
+		name                    string
+		cluster                 *clusterv1.Cluster
+		machines                []*clusterv1.Machine
+		getDescendantsSucceeded bool
+		expectCondition         *metav1.Condition
+		expectAcknowledged      bool
+	}{
+		{
+			name:                    "threshold not set",
+			cluster:                 cluster(nil, false, frozen),
+			machines:                unhealthyMachines,
+			getDescendantsSucceeded: true,
+			expectCondition:         nil,
+		},
+		{
+			name:                    "get descendant failed",
+			cluster:                 cluster(ptr.To[int32](2), false),
+			getDescendantsSucceeded: false,
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.ClusterDegradedFreezeCondition,
+				Status:  metav1.ConditionUnknown,
+				Reason:  clusterv1.ClusterDegradedFreezeInternalErrorReason,
+				Message: "Please check controller logs for errors",
+			},
+		},
+		{
+			name:                    "get descendant failed, keep the freeze",
+			cluster:                 cluster(ptr.To[int32](2), false, frozen),
+			getDescendantsSucceeded: false,
+			expectCondition:         &frozen,
+		},
+		{
+			name:                    "below threshold",
+			cluster:                 cluster(ptr.To[int32](3), false),
+			machines:                unhealthyMachines,
+			getDescendantsSucceeded: true,
+			expectCondition: &metav1.Condition{
+				Type:   clusterv1.ClusterDegradedFreezeCondition,
+				Status: metav1.ConditionFalse,
+				Reason: clusterv1.ClusterNotDegradedFreezeReason,
+			},
+		},
+		{
+			name:                    "threshold reached",
+			cluster:                 cluster(ptr.To[int32](2), false),
+			machines:                unhealthyMachines,
+			getDescendantsSucceeded: true,
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.ClusterDegradedFreezeCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  clusterv1.ClusterDegradedFreezeReason,
+				Message: "Unhealthy Machines reached the threshold of 2 (currently 2), automated rollouts and remediation are frozen until the cluster.x-k8s.io/degraded-freeze-acknowledged annotation is set",
+			},
+		},
+		{
+			name:                    "below threshold, freeze is kept until acknowledged",
+			cluster:                 cluster(ptr.To[int32](2), false, frozen),
+			machines:                unhealthyMachines[:1],
+			getDescendantsSucceeded: true,
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.ClusterDegradedFreezeCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  clusterv1.ClusterDegradedFreezeReason,
+				Message: "Unhealthy Machines reached the threshold of 2 (currently 1), automated rollouts and remediation are frozen until the cluster.x-k8s.io/degraded-freeze-acknowledged annotation is set",
+			},
+		},
+		{
+			name:                    "threshold reached, freeze acknowledged",
+			cluster:                 cluster(ptr.To[int32](2), true, frozen),
+			machines:                unhealthyMachines,
+			getDescendantsSucceeded: true,
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.ClusterDegradedFreezeCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.ClusterDegradedFreezeAcknowledgedReason,
+				Message: "Unhealthy Machines reached the threshold of 2 (currently 2), the freeze has been acknowledged via the cluster.x-k8s.io/degraded-freeze-acknowledged annotation",
+			},
+			expectAcknowledged: true,
+		},
+		{
+			name:                    "below threshold, acknowledgment is removed",
+			cluster:                 cluster(ptr.To[int32](2), true, frozen),
+			machines:                unhealthyMachines[:1],
+			getDescendantsSucceeded: true,
+			expectCondition: &metav1.Condition{
+				Type:   clusterv1.ClusterDegradedFreezeCondition,
+				Status: metav1.ConditionFalse,
+				Reason: clusterv1.ClusterNotDegradedFreezeReason,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var unHealthyMachines collections.Machines
+			if tt.getDescendantsSucceeded {
+				unHealthyMachines = collections.FromMachines(tt.machines...).Filter(collections.IsUnhealthy)
+			}
+			setDegradedFreezeCondition(ctx, tt.cluster, unHealthyMachines, tt.getDescendantsSucceeded)
+
+			condition := conditions.Get(tt.cluster, clusterv1.ClusterDegradedFreezeCondition)
+			if tt.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+			} else {
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(*condition).To(conditions.MatchCondition(*tt.expectCondition, conditions.IgnoreLastTransitionTime(true)))
+			}
+			_, acknowledged := tt.cluster.Annotations[clusterv1.DegradedFreezeAcknowledgedAnnotation]
+			g.Expect(acknowledged).To(Equal(tt.expectAcknowledged))
+		})
+	}
+}
+
 func TestDeletingCondition(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -3372,6 +3689,18 @@ func (r initialized) ApplyToControlPlane(cp *unstructured.Unstructured) {
 	_ = contract.ControlPlane().Initialized("v1beta2").Set(cp, bool(r))
 }
 
+type failureDomains []clusterv1.FailureDomain
+
+func (f failureDomains) ApplyToCluster(c *clusterv1.Cluster) {
+	c.Status.FailureDomains = f
+}
+
+type failureDomain string
+
+func (f failureDomain) ApplyToMachine(m *clusterv1.Machine) {
+	m.Spec.FailureDomain = string(f)
+}
+
 type creationTimestamp metav1.Time
 
 func (t creationTimestamp) ApplyToMachine(m *clusterv1.Machine) {
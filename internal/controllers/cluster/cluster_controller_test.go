@@ -18,6 +18,7 @@ package cluster
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -554,6 +555,258 @@ func TestClusterReconciler_reconcileDelete(t *testing.T) {
 	}
 }
 
+func TestClusterReconciler_reconcileDeletePhases(t *testing.T) {
+	controlPlane := builder.ControlPlane("test-ns", "test-cp").Build()
+	infraCluster := builder.InfrastructureCluster("test-ns", "test-infra").Build()
+	newCluster := func() *clusterv1.Cluster {
+		cluster := builder.Cluster("test-ns", "test-cluster").WithControlPlane(controlPlane).WithInfrastructureCluster(infraCluster).Build()
+		cluster.Finalizers = []string{clusterv1.ClusterFinalizer}
+		return cluster
+	}
+	newMachineDeployment := func(cluster *clusterv1.Cluster) *clusterv1.MachineDeployment {
+		md := newMachineDeploymentBuilder().named("md1").ownedBy(cluster).build()
+		md.Namespace = cluster.Namespace
+		return &md
+	}
+	newControlPlaneMachine := func(cluster *clusterv1.Cluster) *clusterv1.Machine {
+		m := newMachineBuilder().named("cp1").ownedBy(cluster).controlPlane().build()
+		m.Namespace = cluster.Namespace
+		return &m
+	}
+
+	t.Run("Deletes workers first", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cluster := newCluster()
+		md := newMachineDeployment(cluster)
+		fakeClient := fake.NewClientBuilder().WithObjects(cluster, md, controlPlane.DeepCopy(), infraCluster.DeepCopy()).Build()
+		r := &Reconciler{Client: fakeClient, APIReader: fakeClient, recorder: record.NewFakeRecorder(10)}
+		s := &scope{
+			cluster:                 cluster,
+			controlPlane:            controlPlane.DeepCopy(),
+			infraCluster:            infraCluster.DeepCopy(),
+			descendants:             clusterDescendants{machineDeployments: clusterv1.MachineDeploymentList{Items: []clusterv1.MachineDeployment{*md}}},
+			getDescendantsSucceeded: true,
+		}
+
+		res, err := r.reconcileDelete(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(res.RequeueAfter).To(Equal(deleteRequeueAfter))
+		g.Expect(s.deletingReason).To(Equal(clusterv1.ClusterDeletingWaitingForWorkersDeletionReason))
+		g.Expect(cluster.Status.Deletion).ToNot(BeNil())
+		g.Expect(cluster.Status.Deletion.Phase).To(Equal(clusterv1.ClusterDeletionPhaseDeletingWorkers))
+		g.Expect(cluster.Status.Deletion.PhaseStartTime.IsZero()).To(BeFalse())
+		g.Expect(cluster.Status.Deletion.PendingObjects).To(Equal([]string{"MachineDeployments: md1"}))
+		g.Expect(cluster.Status.Deletion.PhaseTimedOut).To(BeNil())
+
+		// The MachineDeployment is deleted, the control plane is not deleted yet.
+		g.Expect(apierrors.IsNotFound(fakeClient.Get(ctx, client.ObjectKeyFromObject(md), &clusterv1.MachineDeployment{}))).To(BeTrue())
+		g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(controlPlane), builder.ControlPlane("", "").Build())).To(Succeed())
+	})
+
+	t.Run("Deletes the control plane after the workers", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cluster := newCluster()
+		fakeClient := fake.NewClientBuilder().WithObjects(cluster, controlPlane.DeepCopy(), infraCluster.DeepCopy()).Build()
+		r := &Reconciler{Client: fakeClient, APIReader: fakeClient, recorder: record.NewFakeRecorder(10)}
+		s := &scope{
+			cluster:                 cluster,
+			controlPlane:            controlPlane.DeepCopy(),
+			infraCluster:            infraCluster.DeepCopy(),
+			getDescendantsSucceeded: true,
+		}
+
+		_, err := r.reconcileDelete(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(s.deletingReason).To(Equal(clusterv1.ClusterDeletingWaitingForControlPlaneDeletionReason))
+		g.Expect(cluster.Status.Deletion.Phase).To(Equal(clusterv1.ClusterDeletionPhaseDeletingControlPlane))
+		g.Expect(cluster.Status.Deletion.PendingObjects).To(Equal([]string{"GenericControlPlane: test-cp"}))
+
+		// The control plane is deleted, the infrastructure cluster is not deleted yet.
+		g.Expect(apierrors.IsNotFound(fakeClient.Get(ctx, client.ObjectKeyFromObject(controlPlane), builder.ControlPlane("", "").Build()))).To(BeTrue())
+		g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(infraCluster), builder.InfrastructureCluster("", "").Build())).To(Succeed())
+	})
+
+	t.Run("Deletes stand-alone control plane Machines after the workers", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cluster := newCluster()
+		cluster.Spec.ControlPlaneRef = clusterv1.ContractVersionedObjectReference{}
+		m := newControlPlaneMachine(cluster)
+		fakeClient := fake.NewClientBuilder().WithObjects(cluster, m, infraCluster.DeepCopy()).Build()
+		r := &Reconciler{Client: fakeClient, APIReader: fakeClient, recorder: record.NewFakeRecorder(10)}
+		s := &scope{
+			cluster:                 cluster,
+			infraCluster:            infraCluster.DeepCopy(),
+			descendants:             clusterDescendants{controlPlaneMachines: collections.FromMachines(m)},
+			getDescendantsSucceeded: true,
+		}
+
+		res, err := r.reconcileDelete(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(res.RequeueAfter).To(Equal(deleteRequeueAfter))
+		g.Expect(s.deletingReason).To(Equal(clusterv1.ClusterDeletingWaitingForControlPlaneDeletionReason))
+		g.Expect(s.deletingMessage).To(Equal("* Control plane Machines: cp1"))
+		g.Expect(cluster.Status.Deletion.Phase).To(Equal(clusterv1.ClusterDeletionPhaseDeletingControlPlane))
+		g.Expect(cluster.Status.Deletion.PendingObjects).To(Equal([]string{"Control plane Machines: cp1"}))
+
+		// The control plane Machine is deleted, the infrastructure cluster is not deleted yet.
+		g.Expect(apierrors.IsNotFound(fakeClient.Get(ctx, client.ObjectKeyFromObject(m), &clusterv1.Machine{}))).To(BeTrue())
+		g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(infraCluster), builder.InfrastructureCluster("", "").Build())).To(Succeed())
+	})
+
+	t.Run("Reports the phase as timed out", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cluster := newCluster()
+		cluster.Spec.Deletion.PhaseTimeoutSeconds = ptr.To[int32](600)
+		startTime := metav1.NewTime(time.Now().Add(-20 * time.Minute))
+		cluster.Status.Deletion = &clusterv1.ClusterDeletionStatus{
+			Phase:          clusterv1.ClusterDeletionPhaseDeletingInfrastructure,
+			PhaseStartTime: startTime,
+		}
+		fakeClient := fake.NewClientBuilder().WithObjects(cluster, infraCluster.DeepCopy()).Build()
+		recorder := record.NewFakeRecorder(10)
+		r := &Reconciler{Client: fakeClient, APIReader: fakeClient, recorder: recorder}
+		s := &scope{
+			cluster:                 cluster,
+			controlPlaneIsNotFound:  true,
+			infraCluster:            infraCluster.DeepCopy(),
+			getDescendantsSucceeded: true,
+		}
+
+		res, err := r.reconcileDelete(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(res.IsZero()).To(BeTrue())
+		g.Expect(s.deletingReason).To(Equal(clusterv1.ClusterDeletingWaitingForInfrastructureDeletionReason))
+		g.Expect(s.deletingMessage).To(Equal("Waiting for GenericInfrastructureCluster to be deleted\nDeletion phase DeletingInfrastructure is taking longer than 10m0s"))
+		g.Expect(cluster.Status.Deletion.Phase).To(Equal(clusterv1.ClusterDeletionPhaseDeletingInfrastructure))
+		g.Expect(cluster.Status.Deletion.PhaseStartTime).To(Equal(startTime))
+		g.Expect(cluster.Status.Deletion.PhaseTimedOut).To(Equal(ptr.To(true)))
+		g.Expect(recorder.Events).To(Receive(ContainSubstring("DeletionPhaseTimedOut")))
+
+		// The event is not recorded again.
+		_, err = r.reconcileDelete(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(recorder.Events).ToNot(Receive())
+	})
+
+	t.Run("Requeues when the phase timeout expires", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cluster := newCluster()
+		cluster.Spec.Deletion.PhaseTimeoutSeconds = ptr.To[int32](600)
+		fakeClient := fake.NewClientBuilder().WithObjects(cluster, infraCluster.DeepCopy()).Build()
+		r := &Reconciler{Client: fakeClient, APIReader: fakeClient, recorder: record.NewFakeRecorder(10)}
+		s := &scope{
+			cluster:                 cluster,
+			controlPlaneIsNotFound:  true,
+			infraCluster:            infraCluster.DeepCopy(),
+			getDescendantsSucceeded: true,
+		}
+
+		res, err := r.reconcileDelete(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(res.RequeueAfter).To(BeNumerically("~", 10*time.Minute, time.Minute))
+		g.Expect(cluster.Status.Deletion.PhaseTimedOut).To(BeNil())
+	})
+
+	t.Run("Completes the deletion", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cluster := newCluster()
+		fakeClient := fake.NewClientBuilder().WithObjects(cluster).Build()
+		r := &Reconciler{Client: fakeClient, APIReader: fakeClient, recorder: record.NewFakeRecorder(10)}
+		s := &scope{
+			cluster:                 cluster,
+			controlPlaneIsNotFound:  true,
+			infraClusterIsNotFound:  true,
+			getDescendantsSucceeded: true,
+		}
+
+		_, err := r.reconcileDelete(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(s.deletingReason).To(Equal(clusterv1.ClusterDeletingDeletionCompletedReason))
+		g.Expect(cluster.Status.Deletion.Phase).To(Equal(clusterv1.ClusterDeletionPhaseCompleted))
+		g.Expect(cluster.Finalizers).To(BeEmpty())
+	})
+}
+
+func TestClusterReconciler_reconcilePauseExpiry(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name             string
+		paused           bool
+		pause            clusterv1.ClusterPauseSpec
+		wantPaused       bool
+		wantPause        clusterv1.ClusterPauseSpec
+		wantRequeueAfter bool
+	}{
+		{
+			name:       "should not clear pause without expireAfter",
+			paused:     true,
+			pause:      clusterv1.ClusterPauseSpec{Reason: "Maintenance"},
+			wantPaused: true,
+			wantPause:  clusterv1.ClusterPauseSpec{Reason: "Maintenance"},
+		},
+		{
+			name:       "should clear the pause if the Cluster is not paused",
+			paused:     false,
+			pause:      clusterv1.ClusterPauseSpec{Reason: "Maintenance", ExpireAfter: metav1.NewTime(now.Add(-time.Hour))},
+			wantPaused: false,
+			wantPause:  clusterv1.ClusterPauseSpec{},
+		},
+		{
+			name:             "should requeue if the pause is not expired yet",
+			paused:           true,
+			pause:            clusterv1.ClusterPauseSpec{Reason: "Maintenance", ExpireAfter: metav1.NewTime(now.Add(time.Hour))},
+			wantPaused:       true,
+			wantPause:        clusterv1.ClusterPauseSpec{Reason: "Maintenance", ExpireAfter: metav1.NewTime(now.Add(time.Hour))},
+			wantRequeueAfter: true,
+		},
+		{
+			name:       "should clear the pause if it is expired",
+			paused:     true,
+			pause:      clusterv1.ClusterPauseSpec{Reason: "Maintenance", ExpireAfter: metav1.NewTime(now.Add(-time.Hour))},
+			wantPaused: false,
+			wantPause:  clusterv1.ClusterPauseSpec{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cluster := builder.Cluster("test-ns", "test-cluster").Build()
+			cluster.Spec.Paused = ptr.To(tt.paused)
+			cluster.Spec.Pause = tt.pause
+
+			fakeClient := fake.NewClientBuilder().WithObjects(cluster).Build()
+			r := &Reconciler{
+				Client:    fakeClient,
+				APIReader: fakeClient,
+				recorder:  record.NewFakeRecorder(1),
+			}
+
+			requeueAfter, err := r.reconcilePauseExpiry(ctx, cluster)
+			g.Expect(err).ToNot(HaveOccurred())
+			if tt.wantRequeueAfter {
+				g.Expect(requeueAfter).To(BeNumerically(">", 0))
+			} else {
+				g.Expect(requeueAfter).To(BeZero())
+			}
+
+			got := &clusterv1.Cluster{}
+			g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cluster), got)).To(Succeed())
+			g.Expect(ptr.Deref(got.Spec.Paused, false)).To(Equal(tt.wantPaused))
+			g.Expect(got.Spec.Pause.Reason).To(Equal(tt.wantPause.Reason))
+			g.Expect(got.Spec.Pause.ExpireAfter.Unix()).To(Equal(tt.wantPause.ExpireAfter.Unix()))
+		})
+	}
+}
+
 func TestClusterReconcilerNodeRef(t *testing.T) {
 	t.Run("machine to cluster", func(t *testing.T) {
 		cluster := &clusterv1.Cluster{
@@ -863,6 +1116,12 @@ func TestFilterOwnedDescendants(t *testing.T) {
 			&ms4OwnedByCluster,
 			&m2OwnedByCluster,
 			&m5OwnedByCluster,
+		))
+
+		actual, err = d.filterOwnedControlPlaneMachines(&c)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(actual).To(ConsistOf(
 			&m3ControlPlaneOwnedByCluster,
 			&m6ControlPlaneOwnedByCluster,
 		))
@@ -889,10 +1148,14 @@ func TestFilterOwnedDescendants(t *testing.T) {
 			&m2OwnedByCluster,
 			&m5OwnedByCluster,
 		))
+
+		actual, err = d.filterOwnedControlPlaneMachines(cWithCP)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(actual).To(BeEmpty())
 	})
 }
 
-func TestObjectsPendingDelete(t *testing.T) {
+func TestWorkersPendingDelete(t *testing.T) {
 	// Note: Intentionally using random order to validate sorting.
 	d := clusterDescendants{
 		machineDeployments: clusterv1.MachineDeploymentList{
@@ -934,21 +1197,11 @@ func TestObjectsPendingDelete(t *testing.T) {
 		},
 	}
 
-	t.Run("Without a control plane object", func(t *testing.T) {
-		g := NewWithT(t)
-
-		c := &clusterv1.Cluster{}
-		g.Expect(d.objectsPendingDeleteCount(c)).To(Equal(17))
-		g.Expect(d.objectsPendingDeleteNames(c)).To(Equal([]string{"Control plane Machines: cp1, cp2, cp3", "MachineDeployments: md1, md2", "MachineSets: ms1, ms2", "MachinePools: mp1, mp2", "Worker Machines: w1, w2, w3, w4, w5, ... (3 more)"}))
-	})
-
-	t.Run("With a control plane object", func(t *testing.T) {
-		g := NewWithT(t)
+	g := NewWithT(t)
 
-		c := &clusterv1.Cluster{Spec: clusterv1.ClusterSpec{ControlPlaneRef: clusterv1.ContractVersionedObjectReference{Kind: "SomeKind"}}}
-		g.Expect(d.objectsPendingDeleteCount(c)).To(Equal(14))
-		g.Expect(d.objectsPendingDeleteNames(c)).To(Equal([]string{"MachineDeployments: md1, md2", "MachineSets: ms1, ms2", "MachinePools: mp1, mp2", "Worker Machines: w1, w2, w3, w4, w5, ... (3 more)"}))
-	})
+	g.Expect(d.workersPendingDeleteCount()).To(Equal(14))
+	g.Expect(d.workersPendingDeleteNames()).To(Equal([]string{"MachineDeployments: md1, md2", "MachineSets: ms1, ms2", "MachinePools: mp1, mp2", "Worker Machines: w1, w2, w3, w4, w5, ... (3 more)"}))
+	g.Expect(d.controlPlaneMachineNames()).To(Equal("cp1, cp2, cp3"))
 }
 
 func TestReconcileV1Beta1ControlPlaneInitializedControlPlaneRef(t *testing.T) {
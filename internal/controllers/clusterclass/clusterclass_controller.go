@@ -303,8 +303,10 @@ func (r *Reconciler) reconcileVariables(ctx context.Context, s *scope) (ctrl.Res
 
 			// We temporarily cache the response of a DiscoveryVariables call to improve performance in case there are
 			// many ClusterClasses using the same runtime extension/settings combination.
-			// This also mitigates spikes when ClusterClass re-syncs happen or when changes to the ExtensionConfig are applied.
+			// This also mitigates spikes when ClusterClass re-syncs happen.
 			// DiscoverVariables is expected to return a "static" response and usually there are few ExtensionConfigs in a mgmt cluster.
+			// Cached responses are dropped by the RuntimeClient as soon as the ExtensionConfig is changed, and the watch on
+			// ExtensionConfigs ensures the variables are discovered again.
 			resp := &runtimehooksv1.DiscoverVariablesResponse{}
 			err := r.RuntimeClient.CallExtension(ctx, runtimehooksv1.DiscoverVariables, clusterClass, patch.External.DiscoverVariablesExtension, req, resp,
 				runtimeclient.WithCaching{Cache: r.discoverVariablesCache, CacheKeyFunc: cacheKeyFunc})
@@ -559,11 +561,18 @@ func matchNamespace(ctx context.Context, c client.Client, selector labels.Select
 	return selector.Matches(labels.Set(ns.GetLabels()))
 }
 
-func cacheKeyFunc(extensionName, extensionConfigResourceVersion string, request runtimehooksv1.RequestObject) string {
+func cacheKeyFunc(extensionName string, extensionConfigGeneration int64, request runtimehooksv1.RequestObject) string {
 	// Note: registration.Name is identical to the value of the patch.External.DiscoverVariablesExtension field in the ClusterClass.
-	s := fmt.Sprintf("%s-%s", extensionName, extensionConfigResourceVersion)
-	for k, v := range request.GetSettings() {
-		s += fmt.Sprintf(",%s=%s", k, v)
+	s := fmt.Sprintf("%s-%d", extensionName, extensionConfigGeneration)
+	// Note: Settings are sorted by key so the same settings always result in the same cache key.
+	settings := request.GetSettings()
+	keys := make([]string, 0, len(settings))
+	for k := range settings {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s += fmt.Sprintf(",%s=%s", k, settings[k])
 	}
 	return s
 }
@@ -1276,3 +1276,24 @@ func TestReconciler_extensionConfigToClusterClass(t *testing.T) {
 		}
 	})
 }
+
+func TestCacheKeyFunc(t *testing.T) {
+	g := NewWithT(t)
+
+	newRequest := func(settings map[string]string) *runtimehooksv1.DiscoverVariablesRequest {
+		req := &runtimehooksv1.DiscoverVariablesRequest{}
+		req.Settings = settings
+		return req
+	}
+	settings := map[string]string{"a": "1", "b": "2", "c": "3", "d": "4"}
+
+	// The key includes the extension name, the generation of the ExtensionConfig and the settings sorted by key.
+	key := cacheKeyFunc("discover-variables.runtime1", 3, newRequest(settings))
+	g.Expect(key).To(Equal("discover-variables.runtime1-3,a=1,b=2,c=3,d=4"))
+	for range 10 {
+		g.Expect(cacheKeyFunc("discover-variables.runtime1", 3, newRequest(settings))).To(Equal(key))
+	}
+
+	g.Expect(cacheKeyFunc("discover-variables.runtime1", 4, newRequest(settings))).ToNot(Equal(key))
+	g.Expect(cacheKeyFunc("discover-variables.runtime1", 3, newRequest(map[string]string{"a": "1"}))).ToNot(Equal(key))
+}
@@ -19,6 +19,7 @@ package clusterresourceset
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/pkg/errors"
@@ -46,6 +47,7 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	resourcepredicates "sigs.k8s.io/cluster-api/internal/controllers/clusterresourceset/predicates"
 	"sigs.k8s.io/cluster-api/util"
+	utilcache "sigs.k8s.io/cluster-api/util/cache"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 	"sigs.k8s.io/cluster-api/util/finalizers"
@@ -57,10 +59,14 @@ import (
 // ErrSecretTypeNotSupported signals that a Secret is not supported.
 var ErrSecretTypeNotSupported = errors.New("unsupported secret type")
 
+// sourcesResyncInterval is the interval after which ClusterResourceSets with sources and the Reconcile
+// strategy are reconciled again, to detect changes to OCI artifacts and Helm charts.
+const sourcesResyncInterval = 10 * time.Minute
+
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;patch;update
 // +kubebuilder:rbac:groups=addons.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=addons.cluster.x-k8s.io,resources=clusterresourcesets/status;clusterresourcesets/finalizers,verbs=get;update;patch
+// +kubebuilder:rbac:groups=addons.cluster.x-k8s.io,resources=clusterresourcesets/status;clusterresourcesets/finalizers;clusterresourcesetbindings/status,verbs=get;update;patch
 
 // Reconciler reconciles a ClusterResourceSet object.
 type Reconciler struct {
@@ -69,6 +75,9 @@ type Reconciler struct {
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	sourceCache utilcache.Cache[sourceContentEntry]
+	httpClient  *http.Client
 }
 
 func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options, partialSecretCache cache.Cache) error {
@@ -120,6 +129,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		return errors.Wrap(err, "failed setting up with a controller manager")
 	}
 
+	r.sourceCache = utilcache.New[sourceContentEntry](utilcache.DefaultTTL)
 	return nil
 }
 
@@ -216,6 +226,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 		return ctrl.Result{}, kerrors.NewAggregate(errs)
 	}
 
+	// Periodically re-render sources, given that changes to OCI artifacts and Helm charts cannot be watched.
+	if len(clusterResourceSet.Spec.Sources) > 0 && addonsv1.ClusterResourceSetStrategy(clusterResourceSet.Spec.Strategy) == addonsv1.ClusterResourceSetStrategyReconcile {
+		return ctrl.Result{RequeueAfter: sourcesResyncInterval}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
@@ -256,7 +271,13 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, clusters []*clusterv1.
 			if r.Client.Delete(ctx, clusterResourceSetBinding) != nil {
 				log.Error(err, "Failed to delete empty ClusterResourceSetBinding")
 			}
-		} else if err := patchHelper.Patch(ctx, clusterResourceSetBinding); err != nil {
+			continue
+		}
+
+		setResourcesAppliedCondition(clusterResourceSetBinding)
+		if err := patchHelper.Patch(ctx, clusterResourceSetBinding, patch.WithOwnedConditions{Conditions: []string{
+			addonsv1.ClusterResourceSetBindingResourcesAppliedCondition,
+		}}); err != nil {
 			return err
 		}
 	}
@@ -311,7 +332,27 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 	// possible to connect to the remote cluster.
 	errList := []error{}
 	objList := make([]*unstructured.Unstructured, len(clusterResourceSet.Spec.Resources))
+	sourceList := make([]*renderedSource, len(clusterResourceSet.Spec.Resources))
 	for i, resource := range clusterResourceSet.Spec.Resources {
+		// Resources of kind OCIArtifact and HelmChart are rendered from the corresponding source.
+		if isSourceKind(resource.Kind) {
+			rendered, err := r.renderSource(ctx, clusterResourceSet, resource)
+			if err != nil {
+				log.Error(err, "Failed to render ClusterResourceSet source", resource.Kind, resource.Name)
+				v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.RetrievingResourceFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+				conditions.Set(clusterResourceSet, metav1.Condition{
+					Type:    addonsv1.ClusterResourceSetResourcesAppliedCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  addonsv1.ClusterResourceSetResourcesNotAppliedReason,
+					Message: fmt.Sprintf("Failed to render %s %s", resource.Kind, resource.Name),
+				})
+				errList = append(errList, err)
+				continue
+			}
+			sourceList[i] = rendered
+			continue
+		}
+
 		unstructuredObj, err := r.getResource(ctx, resource, cluster.GetNamespace())
 		if err != nil {
 			if errors.Is(err, ErrSecretTypeNotSupported) {
@@ -362,10 +403,19 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 
 	defer func() {
 		// Always attempt to Patch the ClusterResourceSetBinding object after each reconciliation.
-		// Note only the ClusterResourceSetBinding spec will be patched as it does not have a status field, and so
-		// using the patch helper is unnecessary.
+		// NOTE: The patch helper is not used, because the spec must be patched with optimistic locking given that the
+		// ClusterResourceSetBinding is shared by all the ClusterResourceSets matching the cluster.
 		if err := r.Client.Patch(ctx, clusterResourceSetBinding, patch); err != nil {
 			rerr = kerrors.NewAggregate([]error{rerr, errors.Wrapf(err, "failed to patch ClusterResourceSetBinding %s", klog.KObj(clusterResourceSetBinding))})
+			return
+		}
+
+		// Patch the status of the ClusterResourceSetBinding, computing the ResourcesApplied condition from the
+		// resources of all the ClusterResourceSets as of the spec just patched.
+		statusPatch := client.MergeFrom(clusterResourceSetBinding.DeepCopy())
+		setResourcesAppliedCondition(clusterResourceSetBinding)
+		if err := r.Client.Status().Patch(ctx, clusterResourceSetBinding, statusPatch); err != nil {
+			rerr = kerrors.NewAggregate([]error{rerr, errors.Wrapf(err, "failed to patch status of ClusterResourceSetBinding %s", klog.KObj(clusterResourceSetBinding))})
 		}
 	}()
 
@@ -400,19 +450,31 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 
 	// Iterate all resources and apply them to the cluster and update the resource status in the ClusterResourceSetBinding object.
 	for i, resource := range clusterResourceSet.Spec.Resources {
-		unstructuredObj := objList[i]
-		if unstructuredObj == nil {
+		var resourceScope resourceReconcileScope
+		switch {
+		case sourceList[i] != nil:
+			resourceScope, err = reconcileScopeForSource(clusterResourceSet, resource, resourceSetBinding, sourceList[i])
+		case objList[i] != nil:
+			resourceScope, err = reconcileScopeForResource(clusterResourceSet, resource, resourceSetBinding, objList[i])
+		default:
 			// Continue without adding the error to the aggregate if we can't find the resource.
 			continue
 		}
+		// NOTE: lastAppliedTime is preserved when applying the resource fails, so it always reports when the resource
+		// was last successfully applied to the cluster.
+		var lastAppliedTime metav1.Time
+		if previous := resourceSetBinding.GetResource(resource); previous != nil {
+			lastAppliedTime = previous.LastAppliedTime
+		}
 
-		resourceScope, err := reconcileScopeForResource(clusterResourceSet, resource, resourceSetBinding, unstructuredObj)
 		if err != nil {
 			resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
 				ResourceRef:     resource,
 				Hash:            "",
 				Applied:         ptr.To(false),
-				LastAppliedTime: metav1.Time{Time: time.Now().UTC()},
+				LastAppliedTime: lastAppliedTime,
+				Reason:          addonsv1.ResourceBindingInvalidResourceReason,
+				Message:         resourceBindingMessage(err),
 			})
 
 			errList = append(errList, err)
@@ -423,20 +485,9 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 			continue
 		}
 
-		// Set status in ClusterResourceSetBinding in case of early continue due to a failure.
-		// Set only when resource is retrieved successfully.
-		resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
-			ResourceRef:     resource,
-			Hash:            "",
-			Applied:         ptr.To(false),
-			LastAppliedTime: metav1.Time{Time: time.Now().UTC()},
-		})
-
 		// Apply all values in the key-value pair of the resource to the cluster.
 		// As there can be multiple key-value pairs in a resource, each value may have multiple objects in it.
-		isSuccessful := true
 		if err := resourceScope.apply(ctx, remoteClient); err != nil {
-			isSuccessful = false
 			log.Error(err, "Failed to apply ClusterResourceSet resource", resource.Kind, klog.KRef(clusterResourceSet.Namespace, resource.Name))
 			v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.ApplyFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
 			conditions.Set(clusterResourceSet, metav1.Condition{
@@ -446,13 +497,24 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 				Message: "Failed to apply ClusterResourceSet resources to Cluster",
 			})
 			errList = append(errList, err)
+
+			resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
+				ResourceRef:     resource,
+				Hash:            resourceScope.hash(),
+				Applied:         ptr.To(false),
+				LastAppliedTime: lastAppliedTime,
+				Reason:          addonsv1.ResourceBindingApplyFailedReason,
+				Message:         resourceBindingMessage(err),
+			})
+			continue
 		}
 
 		resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
 			ResourceRef:     resource,
 			Hash:            resourceScope.hash(),
-			Applied:         ptr.To(isSuccessful),
+			Applied:         ptr.To(true),
 			LastAppliedTime: metav1.Time{Time: time.Now().UTC()},
+			Reason:          addonsv1.ResourceBindingAppliedReason,
 		})
 	}
 	if len(errList) > 0 {
@@ -469,6 +531,16 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 	return nil
 }
 
+// isSourceKind returns true if the resource kind refers to an entry in spec.sources.
+func isSourceKind(kind string) bool {
+	switch addonsv1.ClusterResourceSetResourceKind(kind) {
+	case addonsv1.OCIArtifactClusterResourceSetResourceKind, addonsv1.HelmChartClusterResourceSetResourceKind:
+		return true
+	default:
+		return false
+	}
+}
+
 // getResource retrieves the requested resource and convert it to unstructured type.
 // Unsupported resource kinds are not denied by validation webhook, hence no need to check here.
 // Only supports Secrets/Configmaps as resource types and allow using resources in the same namespace with the cluster.
@@ -593,15 +665,31 @@ func resourceToClusterResourceSetFunc[T client.Object](ctrlClient client.Client)
 			return nil
 		}
 		for _, crs := range crsList.Items {
-			for _, resource := range crs.Spec.Resources {
-				if resource.Kind == objKind.Kind && resource.Name == o.GetName() {
-					name := client.ObjectKey{Namespace: o.GetNamespace(), Name: crs.Name}
-					result = append(result, ctrl.Request{NamespacedName: name})
-					break
-				}
+			if referencesResource(&crs, objKind.Kind, o.GetName()) {
+				name := client.ObjectKey{Namespace: o.GetNamespace(), Name: crs.Name}
+				result = append(result, ctrl.Request{NamespacedName: name})
 			}
 		}
 
 		return result
 	}
 }
+
+// referencesResource returns true if a ClusterResourceSet references a Secret or ConfigMap, either as a resource
+// or as the values of a Helm chart source.
+func referencesResource(crs *addonsv1.ClusterResourceSet, kind, name string) bool {
+	for _, resource := range crs.Spec.Resources {
+		if resource.Kind == kind && resource.Name == name {
+			return true
+		}
+	}
+	if kind != string(addonsv1.ConfigMapClusterResourceSetResourceKind) {
+		return false
+	}
+	for _, source := range crs.Spec.Sources {
+		if source.HelmChart != nil && source.HelmChart.ValuesFrom.ConfigMapName == name {
+			return true
+		}
+	}
+	return false
+}
@@ -905,10 +905,19 @@ metadata:
 				switch r.ResourceRef.Name {
 				case testConfigmap.Name:
 					g.Expect(ptr.Deref(r.Applied, false)).To(BeFalse(), "test-configmap should be not applied bc of missing namespace")
+					g.Expect(r.Reason).To(Equal(addonsv1.ResourceBindingApplyFailedReason))
+					g.Expect(r.Message).To(ContainSubstring("creating object /v1, Kind=ConfigMap %s/cm-missing-namespace", missingNamespace))
 				case secretName:
 					g.Expect(ptr.Deref(r.Applied, false)).To(BeTrue(), "test-secret should be applied")
+					g.Expect(r.Reason).To(Equal(addonsv1.ResourceBindingAppliedReason))
 				}
 			}
+
+			appliedCondition := conditions.Get(binding, addonsv1.ClusterResourceSetBindingResourcesAppliedCondition)
+			g.Expect(appliedCondition).NotTo(BeNil())
+			g.Expect(appliedCondition.Status).To(Equal(metav1.ConditionFalse))
+			g.Expect(appliedCondition.Reason).To(Equal(addonsv1.ClusterResourceSetBindingResourcesNotAppliedReason))
+			g.Expect(appliedCondition.Message).To(ContainSubstring("* ConfigMap %s from ClusterResourceSet %s", testConfigmap.Name, clusterResourceSet.Name))
 		}, timeout).Should(Succeed())
 
 		t.Log("Verifying CRS has a false ResourcesApplied condition")
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 	"unicode"
 
 	"github.com/pkg/errors"
@@ -34,11 +35,13 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	utilresource "sigs.k8s.io/cluster-api/util/resource"
 	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
 )
@@ -225,3 +228,62 @@ func ensureKubernetesServiceCreated(ctx context.Context, client client.Client) e
 	}
 	return nil
 }
+
+const (
+	// maxResourceBindingMessageLength is the max length of the message of a ResourceBinding.
+	maxResourceBindingMessageLength = 1024
+
+	// maxNotAppliedResourcesInMessage is the max number of resources which are not applied listed
+	// in the message of the ResourcesApplied condition of a ClusterResourceSetBinding.
+	maxNotAppliedResourcesInMessage = 10
+)
+
+// resourceBindingMessage returns the message for a ResourceBinding from an error, truncated to
+// the max length allowed by the API.
+func resourceBindingMessage(err error) string {
+	msg := []rune(err.Error())
+	if len(msg) <= maxResourceBindingMessageLength {
+		return string(msg)
+	}
+	return string(msg[:maxResourceBindingMessageLength-3]) + "..."
+}
+
+// setResourcesAppliedCondition sets the ResourcesApplied condition on a ClusterResourceSetBinding, surfacing which
+// resources of the ClusterResourceSets in the binding are not applied to the cluster, and why.
+func setResourcesAppliedCondition(clusterResourceSetBinding *addonsv1.ClusterResourceSetBinding) {
+	notApplied := []string{}
+	for _, binding := range clusterResourceSetBinding.Spec.Bindings {
+		for _, resource := range binding.Resources {
+			if ptr.Deref(resource.Applied, false) {
+				continue
+			}
+			details := resource.Message
+			if details == "" {
+				details = resource.Reason
+			}
+			if details == "" {
+				details = "Not applied yet"
+			}
+			notApplied = append(notApplied, fmt.Sprintf("* %s %s from ClusterResourceSet %s: %s", resource.Kind, resource.Name, binding.ClusterResourceSetName, details))
+		}
+	}
+
+	if len(notApplied) == 0 {
+		conditions.Set(clusterResourceSetBinding, metav1.Condition{
+			Type:   addonsv1.ClusterResourceSetBindingResourcesAppliedCondition,
+			Status: metav1.ConditionTrue,
+			Reason: addonsv1.ClusterResourceSetBindingResourcesAppliedReason,
+		})
+		return
+	}
+
+	if len(notApplied) > maxNotAppliedResourcesInMessage {
+		notApplied = append(notApplied[:maxNotAppliedResourcesInMessage], fmt.Sprintf("* ... (%d more)", len(notApplied)-maxNotAppliedResourcesInMessage))
+	}
+	conditions.Set(clusterResourceSetBinding, metav1.Condition{
+		Type:    addonsv1.ClusterResourceSetBindingResourcesAppliedCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  addonsv1.ClusterResourceSetBindingResourcesNotAppliedReason,
+		Message: strings.Join(notApplied, "\n"),
+	})
+}
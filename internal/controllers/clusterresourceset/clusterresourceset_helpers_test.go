@@ -18,10 +18,12 @@ package clusterresourceset
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -32,6 +34,7 @@ import (
 
 	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 const (
@@ -269,3 +272,119 @@ func TestEnsureKubernetesServiceCreated(t *testing.T) {
 		})
 	}
 }
+
+func TestSetResourcesAppliedCondition(t *testing.T) {
+	tests := []struct {
+		name            string
+		bindings        []addonsv1.ResourceSetBinding
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:           "True if there are no resources",
+			bindings:       nil,
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: addonsv1.ClusterResourceSetBindingResourcesAppliedReason,
+		},
+		{
+			name: "True if all the resources are applied",
+			bindings: []addonsv1.ResourceSetBinding{
+				{
+					ClusterResourceSetName: "crs1",
+					Resources: []addonsv1.ResourceBinding{
+						{ResourceRef: addonsv1.ResourceRef{Kind: "ConfigMap", Name: "cm1"}, Applied: ptr.To(true), Reason: addonsv1.ResourceBindingAppliedReason},
+					},
+				},
+				{
+					ClusterResourceSetName: "crs2",
+					Resources: []addonsv1.ResourceBinding{
+						{ResourceRef: addonsv1.ResourceRef{Kind: "Secret", Name: "s1"}, Applied: ptr.To(true), Reason: addonsv1.ResourceBindingAppliedReason},
+					},
+				},
+			},
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: addonsv1.ClusterResourceSetBindingResourcesAppliedReason,
+		},
+		{
+			name: "False with the resources which are not applied",
+			bindings: []addonsv1.ResourceSetBinding{
+				{
+					ClusterResourceSetName: "crs1",
+					Resources: []addonsv1.ResourceBinding{
+						{ResourceRef: addonsv1.ResourceRef{Kind: "ConfigMap", Name: "cm1"}, Applied: ptr.To(true), Reason: addonsv1.ResourceBindingAppliedReason},
+						{ResourceRef: addonsv1.ResourceRef{Kind: "ConfigMap", Name: "cm2"}, Applied: ptr.To(false), Reason: addonsv1.ResourceBindingApplyFailedReason, Message: "failed to create ConfigMap"},
+					},
+				},
+				{
+					ClusterResourceSetName: "crs2",
+					Resources: []addonsv1.ResourceBinding{
+						{ResourceRef: addonsv1.ResourceRef{Kind: "Secret", Name: "s1"}, Applied: ptr.To(false), Reason: addonsv1.ResourceBindingInvalidResourceReason},
+						{ResourceRef: addonsv1.ResourceRef{Kind: "Secret", Name: "s2"}, Applied: ptr.To(false)},
+					},
+				},
+			},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: addonsv1.ClusterResourceSetBindingResourcesNotAppliedReason,
+			expectedMessage: "* ConfigMap cm2 from ClusterResourceSet crs1: failed to create ConfigMap\n" +
+				"* Secret s1 from ClusterResourceSet crs2: InvalidResource\n" +
+				"* Secret s2 from ClusterResourceSet crs2: Not applied yet",
+		},
+		{
+			name: "False with a limited list of the resources which are not applied",
+			bindings: func() []addonsv1.ResourceSetBinding {
+				binding := addonsv1.ResourceSetBinding{ClusterResourceSetName: "crs1"}
+				for _, name := range []string{"cm01", "cm02", "cm03", "cm04", "cm05", "cm06", "cm07", "cm08", "cm09", "cm10", "cm11", "cm12"} {
+					binding.Resources = append(binding.Resources, addonsv1.ResourceBinding{
+						ResourceRef: addonsv1.ResourceRef{Kind: "ConfigMap", Name: name},
+						Applied:     ptr.To(false),
+						Reason:      addonsv1.ResourceBindingApplyFailedReason,
+						Message:     "failed",
+					})
+				}
+				return []addonsv1.ResourceSetBinding{binding}
+			}(),
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: addonsv1.ClusterResourceSetBindingResourcesNotAppliedReason,
+			expectedMessage: "* ConfigMap cm01 from ClusterResourceSet crs1: failed\n" +
+				"* ConfigMap cm02 from ClusterResourceSet crs1: failed\n" +
+				"* ConfigMap cm03 from ClusterResourceSet crs1: failed\n" +
+				"* ConfigMap cm04 from ClusterResourceSet crs1: failed\n" +
+				"* ConfigMap cm05 from ClusterResourceSet crs1: failed\n" +
+				"* ConfigMap cm06 from ClusterResourceSet crs1: failed\n" +
+				"* ConfigMap cm07 from ClusterResourceSet crs1: failed\n" +
+				"* ConfigMap cm08 from ClusterResourceSet crs1: failed\n" +
+				"* ConfigMap cm09 from ClusterResourceSet crs1: failed\n" +
+				"* ConfigMap cm10 from ClusterResourceSet crs1: failed\n" +
+				"* ... (2 more)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			clusterResourceSetBinding := &addonsv1.ClusterResourceSetBinding{
+				Spec: addonsv1.ClusterResourceSetBindingSpec{
+					Bindings: tt.bindings,
+				},
+			}
+			setResourcesAppliedCondition(clusterResourceSetBinding)
+
+			condition := conditions.Get(clusterResourceSetBinding, addonsv1.ClusterResourceSetBindingResourcesAppliedCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.expectedStatus))
+			g.Expect(condition.Reason).To(Equal(tt.expectedReason))
+			g.Expect(condition.Message).To(Equal(tt.expectedMessage))
+		})
+	}
+}
+
+func TestResourceBindingMessage(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(resourceBindingMessage(errors.New("failed to apply"))).To(Equal("failed to apply"))
+
+	msg := resourceBindingMessage(errors.New(strings.Repeat("a", 2000)))
+	g.Expect(msg).To(HaveLen(maxResourceBindingMessageLength))
+	g.Expect(msg).To(HaveSuffix("..."))
+}
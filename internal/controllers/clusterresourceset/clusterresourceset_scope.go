@@ -62,6 +62,30 @@ func reconcileScopeForResource(
 	return newResourceReconcileScope(crs, resourceRef, resourceSetBinding, normalizedData, objs)
 }
 
+func reconcileScopeForSource(
+	crs *addonsv1.ClusterResourceSet,
+	resourceRef addonsv1.ResourceRef,
+	resourceSetBinding *addonsv1.ResourceSetBinding,
+	source *renderedSource,
+) (resourceReconcileScope, error) {
+	objs, err := objsFromYamlData(source.data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the default namespace for objects without a namespace, e.g. for objects rendered from a Helm chart.
+	// Note: the namespace is ignored for cluster-scoped objects.
+	if source.defaultNamespace != "" {
+		for i := range objs {
+			if objs[i].GetNamespace() == "" {
+				objs[i].SetNamespace(source.defaultNamespace)
+			}
+		}
+	}
+
+	return newResourceReconcileScope(crs, resourceRef, resourceSetBinding, source.data, objs)
+}
+
 func newResourceReconcileScope(
 	clusterResourceSet *addonsv1.ClusterResourceSet,
 	resourceRef addonsv1.ResourceRef,
@@ -0,0 +1,294 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/util/helm"
+	"sigs.k8s.io/cluster-api/internal/util/oci"
+	"sigs.k8s.io/cluster-api/util/cache"
+)
+
+const (
+	// helmChartLayerMediaType is the media type of the layer containing the chart archive
+	// when a Helm chart is pushed to an OCI registry.
+	helmChartLayerMediaType = "application/vnd.cncf.helm.chart.content.v1.tar.gzip"
+
+	// defaultHelmValuesKey is the default key of the values in the ConfigMap referenced by a HelmChart source.
+	defaultHelmValuesKey = "values.yaml"
+
+	// defaultHelmReleaseNamespace is the default namespace of the release used when rendering a HelmChart source.
+	defaultHelmReleaseNamespace = "default"
+
+	// maxSourceContentSize is the maximum size of content downloaded via https.
+	maxSourceContentSize = 20 * 1024 * 1024
+)
+
+// sourceContentEntry is an entry of the cache used to avoid downloading the content of sources at every reconcile.
+// Entries for content pulled from OCI registries are keyed by digest; entries for content downloaded via https
+// are keyed by URL, and thus changes to this content are detected only after the entry expires.
+type sourceContentEntry struct {
+	key     string
+	content []byte
+}
+
+// Key returns the cache key of a sourceContentEntry.
+func (e sourceContentEntry) Key() string {
+	return e.key
+}
+
+var _ cache.Entry = sourceContentEntry{}
+
+// renderedSource is the result of rendering a source.
+type renderedSource struct {
+	// data contains the resources of the source, one entry for each file.
+	data [][]byte
+
+	// defaultNamespace is the namespace for namespaced resources without a namespace, if any.
+	defaultNamespace string
+}
+
+// renderSource fetches and renders the source referenced by a resource of kind OCIArtifact or HelmChart.
+func (r *Reconciler) renderSource(ctx context.Context, clusterResourceSet *addonsv1.ClusterResourceSet, resourceRef addonsv1.ResourceRef) (*renderedSource, error) {
+	source := clusterResourceSet.Spec.GetSource(resourceRef.Name)
+	if source == nil {
+		return nil, errors.Errorf("source %q not found in spec.sources", resourceRef.Name)
+	}
+
+	switch addonsv1.ClusterResourceSetResourceKind(resourceRef.Kind) {
+	case addonsv1.OCIArtifactClusterResourceSetResourceKind:
+		if source.OCIArtifact == nil {
+			return nil, errors.Errorf("source %q is not an OCI artifact", resourceRef.Name)
+		}
+		data, err := r.renderOCIArtifact(ctx, clusterResourceSet.Namespace, source.OCIArtifact)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to render source %q", resourceRef.Name)
+		}
+		return &renderedSource{data: data}, nil
+	case addonsv1.HelmChartClusterResourceSetResourceKind:
+		if source.HelmChart == nil {
+			return nil, errors.Errorf("source %q is not a Helm chart", resourceRef.Name)
+		}
+		data, err := r.renderHelmChart(ctx, clusterResourceSet.Namespace, source.HelmChart)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to render source %q", resourceRef.Name)
+		}
+		return &renderedSource{data: [][]byte{data}, defaultNamespace: helmReleaseNamespace(source.HelmChart)}, nil
+	default:
+		return nil, errors.Errorf("unsupported source kind %q", resourceRef.Kind)
+	}
+}
+
+// renderOCIArtifact returns the content of the files in an OCI artifact.
+func (r *Reconciler) renderOCIArtifact(ctx context.Context, namespace string, source *addonsv1.OCIArtifactSource) ([][]byte, error) {
+	client, reference, err := r.ociClient(ctx, namespace, source.URL, source.PullSecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, _, err := client.GetManifest(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	layers := manifest.Layers
+	if len(source.Files) > 0 {
+		layers = make([]oci.Descriptor, 0, len(source.Files))
+		for _, file := range source.Files {
+			found := false
+			for _, layer := range manifest.Layers {
+				if layer.Annotations[oci.TitleAnnotation] == file {
+					layers = append(layers, layer)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, errors.Errorf("file %q not found in the OCI artifact %q", file, source.URL)
+			}
+		}
+	}
+
+	data := make([][]byte, 0, len(layers))
+	for _, layer := range layers {
+		content, err := r.getBlob(ctx, client, layer.Digest)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, content)
+	}
+	return data, nil
+}
+
+// renderHelmChart returns the resources of a Helm chart rendered with the values from the referenced ConfigMap.
+func (r *Reconciler) renderHelmChart(ctx context.Context, namespace string, source *addonsv1.HelmChartSource) ([]byte, error) {
+	values := map[string]interface{}{}
+	if source.ValuesFrom.IsDefined() {
+		configMap, err := getConfigMap(ctx, r.Client, types.NamespacedName{Namespace: namespace, Name: source.ValuesFrom.ConfigMapName})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get values from ConfigMap %s", source.ValuesFrom.ConfigMapName)
+		}
+		key := source.ValuesFrom.Key
+		if key == "" {
+			key = defaultHelmValuesKey
+		}
+		valuesYAML, ok := configMap.Data[key]
+		if !ok {
+			return nil, errors.Errorf("failed to get values from ConfigMap %s: key %q not found", source.ValuesFrom.ConfigMapName, key)
+		}
+		if err := yaml.Unmarshal([]byte(valuesYAML), &values); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse values from ConfigMap %s", source.ValuesFrom.ConfigMapName)
+		}
+	}
+
+	archive, err := r.getHelmChartArchive(ctx, namespace, source)
+	if err != nil {
+		return nil, err
+	}
+
+	chart, err := helm.Load(archive)
+	if err != nil {
+		return nil, err
+	}
+	return chart.Render(source.ReleaseName, helmReleaseNamespace(source), values)
+}
+
+// getHelmChartArchive returns the chart archive of a Helm chart pushed to an OCI registry or served over https.
+func (r *Reconciler) getHelmChartArchive(ctx context.Context, namespace string, source *addonsv1.HelmChartSource) ([]byte, error) {
+	if !strings.HasPrefix(source.URL, oci.Scheme+"://") {
+		return r.download(ctx, source.URL)
+	}
+
+	client, reference, err := r.ociClient(ctx, namespace, source.URL, source.PullSecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, _, err := client.GetManifest(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == helmChartLayerMediaType {
+			return r.getBlob(ctx, client, layer.Digest)
+		}
+	}
+	return nil, errors.Errorf("%q is not a Helm chart: layer with media type %s not found", source.URL, helmChartLayerMediaType)
+}
+
+// ociClient returns a client for the repository of an OCI reference, using the credentials from the pull secret, if any,
+// and the tag or digest from the reference.
+func (r *Reconciler) ociClient(ctx context.Context, namespace, reference, pullSecretName string) (*oci.Client, string, error) {
+	registry, repository, tagOrDigest, err := oci.ParseReference(reference)
+	if err != nil {
+		return nil, "", err
+	}
+
+	opts := []oci.Option{}
+	if r.httpClient != nil {
+		opts = append(opts, oci.WithHTTPClient(r.httpClient))
+	}
+	if pullSecretName != "" {
+		secret, err := getSecret(ctx, r.Client, types.NamespacedName{Namespace: namespace, Name: pullSecretName})
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "failed to get pull secret %s", pullSecretName)
+		}
+		if secret.Type != corev1.SecretTypeDockerConfigJson {
+			return nil, "", errors.Errorf("pull secret %s must be of type %s", pullSecretName, corev1.SecretTypeDockerConfigJson)
+		}
+		dockerConfig, err := oci.ParseDockerConfig(secret.Data[corev1.DockerConfigJsonKey])
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "failed to read pull secret %s", pullSecretName)
+		}
+		opts = append(opts, oci.WithCredentials(func(_ context.Context, registry string) (*oci.Credentials, error) {
+			return dockerConfig.Credentials(registry)
+		}))
+	}
+	return oci.NewClient(registry, repository, opts...), tagOrDigest, nil
+}
+
+// getBlob returns the content of a blob, using the cache when possible.
+func (r *Reconciler) getBlob(ctx context.Context, client *oci.Client, digest string) ([]byte, error) {
+	key := fmt.Sprintf("%s://%s/%s@%s", oci.Scheme, client.Registry(), client.Repository(), digest)
+	if entry, ok := r.sourceCache.Has(key); ok {
+		return entry.content, nil
+	}
+
+	content, err := client.GetBlob(ctx, digest)
+	if err != nil {
+		return nil, err
+	}
+	r.sourceCache.Add(sourceContentEntry{key: key, content: content})
+	return content, nil
+}
+
+// download returns the content served at an https URL, using the cache when possible.
+func (r *Reconciler) download(ctx context.Context, url string) ([]byte, error) {
+	if entry, ok := r.sourceCache.Has(url); ok {
+		return entry.content, nil
+	}
+
+	timeoutctx, cancel := context.WithTimeoutCause(ctx, 30*time.Second, errors.New("http request timeout expired"))
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(timeoutctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %q: failed to create request", url)
+	}
+	httpClient := r.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %q", url)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to get %q, got %d", url, response.StatusCode)
+	}
+	content, err := io.ReadAll(io.LimitReader(response.Body, maxSourceContentSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %q", url)
+	}
+	if len(content) > maxSourceContentSize {
+		return nil, errors.Errorf("failed to get %q: content exceeds the maximum size of %d bytes", url, maxSourceContentSize)
+	}
+
+	r.sourceCache.Add(sourceContentEntry{key: url, content: content})
+	return content, nil
+}
+
+func helmReleaseNamespace(source *addonsv1.HelmChartSource) string {
+	if source.ReleaseNamespace != "" {
+		return source.ReleaseNamespace
+	}
+	return defaultHelmReleaseNamespace
+}
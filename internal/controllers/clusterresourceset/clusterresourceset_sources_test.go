@@ -0,0 +1,272 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/util/helm/helmtest"
+	"sigs.k8s.io/cluster-api/internal/util/oci/ocitest"
+	utilcache "sigs.k8s.io/cluster-api/util/cache"
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+// testHelmChartFiles are the files of a chart used for testing, relative to the chart directory.
+var testHelmChartFiles = map[string]string{
+	"Chart.yaml":  "apiVersion: v2\nname: test-chart\nversion: 1.0.0\nappVersion: v2.0.0\n",
+	"values.yaml": "image:\n  repository: registry.example.com/app\n  tag: \"\"\n",
+	"templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-config
+  namespace: {{ .Release.Namespace }}
+data:
+  image: {{ .Values.image.repository }}:{{ .Values.image.tag | default .Chart.AppVersion }}
+`,
+	"crds/crd.yaml": "apiVersion: apiextensions.k8s.io/v1\nkind: CustomResourceDefinition\nmetadata:\n  name: foos.example.com\n",
+}
+
+func TestRenderSource(t *testing.T) {
+	server := ocitest.NewRegistry()
+	defer server.Close()
+	server.AddArtifact("addons/cni", "v1.0.0",
+		server.AddLayer("namespace.yaml", "application/vnd.oci.image.layer.v1.tar", []byte("apiVersion: v1\nkind: Namespace\nmetadata:\n  name: cni\n")),
+		server.AddLayer("configmap.yaml", "application/vnd.oci.image.layer.v1.tar", []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cni\n  namespace: cni\n")),
+	)
+	server.AddArtifact("charts/test-chart", "1.0.0",
+		server.AddLayer("test-chart-1.0.0.tgz", helmChartLayerMediaType, helmtest.NewChartArchive(t, "test-chart", testHelmChartFiles)),
+	)
+	registry := server.Host()
+
+	pullSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: metav1.NamespaceDefault},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(fmt.Sprintf(`{"auths": {%q: {"auth": %q}}}`, registry, base64.StdEncoding.EncodeToString([]byte(ocitest.Username+":"+ocitest.Password)))),
+		},
+	}
+	opaqueSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "opaque-secret", Namespace: metav1.NamespaceDefault},
+		Type:       corev1.SecretTypeOpaque,
+	}
+	valuesConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: metav1.NamespaceDefault},
+		Data: map[string]string{
+			"values.yaml": "image:\n  tag: v2.1.0\n",
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		sources              []addonsv1.ClusterResourceSetSource
+		resourceRef          addonsv1.ResourceRef
+		wantKinds            []string
+		wantDefaultNamespace string
+		wantData             string
+		wantErr              string
+	}{
+		{
+			name: "all the files of an OCI artifact",
+			sources: []addonsv1.ClusterResourceSetSource{
+				{Name: "cni", OCIArtifact: &addonsv1.OCIArtifactSource{URL: fmt.Sprintf("oci://%s/addons/cni:v1.0.0", registry), PullSecretName: pullSecret.Name}},
+			},
+			resourceRef: addonsv1.ResourceRef{Name: "cni", Kind: string(addonsv1.OCIArtifactClusterResourceSetResourceKind)},
+			wantKinds:   []string{"Namespace", "ConfigMap"},
+		},
+		{
+			name: "selected files of an OCI artifact",
+			sources: []addonsv1.ClusterResourceSetSource{
+				{Name: "cni", OCIArtifact: &addonsv1.OCIArtifactSource{URL: fmt.Sprintf("oci://%s/addons/cni:v1.0.0", registry), Files: []string{"configmap.yaml"}, PullSecretName: pullSecret.Name}},
+			},
+			resourceRef: addonsv1.ResourceRef{Name: "cni", Kind: string(addonsv1.OCIArtifactClusterResourceSetResourceKind)},
+			wantKinds:   []string{"ConfigMap"},
+		},
+		{
+			name: "Helm chart with values from a ConfigMap",
+			sources: []addonsv1.ClusterResourceSetSource{
+				{Name: "app", HelmChart: &addonsv1.HelmChartSource{
+					URL:              fmt.Sprintf("oci://%s/charts/test-chart:1.0.0", registry),
+					ReleaseName:      "app",
+					ReleaseNamespace: "apps",
+					ValuesFrom:       addonsv1.HelmChartValuesReference{ConfigMapName: valuesConfigMap.Name},
+					PullSecretName:   pullSecret.Name,
+				}},
+			},
+			resourceRef:          addonsv1.ResourceRef{Name: "app", Kind: string(addonsv1.HelmChartClusterResourceSetResourceKind)},
+			wantKinds:            []string{"CustomResourceDefinition", "ConfigMap"},
+			wantDefaultNamespace: "apps",
+			wantData:             "image: registry.example.com/app:v2.1.0",
+		},
+		{
+			name: "Helm chart with default release namespace",
+			sources: []addonsv1.ClusterResourceSetSource{
+				{Name: "app", HelmChart: &addonsv1.HelmChartSource{
+					URL:            fmt.Sprintf("oci://%s/charts/test-chart:1.0.0", registry),
+					ReleaseName:    "app",
+					PullSecretName: pullSecret.Name,
+				}},
+			},
+			resourceRef:          addonsv1.ResourceRef{Name: "app", Kind: string(addonsv1.HelmChartClusterResourceSetResourceKind)},
+			wantKinds:            []string{"CustomResourceDefinition", "ConfigMap"},
+			wantDefaultNamespace: metav1.NamespaceDefault,
+			wantData:             "image: registry.example.com/app:v2.0.0",
+		},
+		{
+			name: "fails if the source does not exist",
+			sources: []addonsv1.ClusterResourceSetSource{
+				{Name: "cni", OCIArtifact: &addonsv1.OCIArtifactSource{URL: fmt.Sprintf("oci://%s/addons/cni:v1.0.0", registry)}},
+			},
+			resourceRef: addonsv1.ResourceRef{Name: "other", Kind: string(addonsv1.OCIArtifactClusterResourceSetResourceKind)},
+			wantErr:     `source "other" not found`,
+		},
+		{
+			name: "fails if the source does not match the kind",
+			sources: []addonsv1.ClusterResourceSetSource{
+				{Name: "cni", OCIArtifact: &addonsv1.OCIArtifactSource{URL: fmt.Sprintf("oci://%s/addons/cni:v1.0.0", registry)}},
+			},
+			resourceRef: addonsv1.ResourceRef{Name: "cni", Kind: string(addonsv1.HelmChartClusterResourceSetResourceKind)},
+			wantErr:     `source "cni" is not a Helm chart`,
+		},
+		{
+			name: "fails if a file does not exist in the OCI artifact",
+			sources: []addonsv1.ClusterResourceSetSource{
+				{Name: "cni", OCIArtifact: &addonsv1.OCIArtifactSource{URL: fmt.Sprintf("oci://%s/addons/cni:v1.0.0", registry), Files: []string{"missing.yaml"}, PullSecretName: pullSecret.Name}},
+			},
+			resourceRef: addonsv1.ResourceRef{Name: "cni", Kind: string(addonsv1.OCIArtifactClusterResourceSetResourceKind)},
+			wantErr:     `file "missing.yaml" not found`,
+		},
+		{
+			name: "fails without credentials",
+			sources: []addonsv1.ClusterResourceSetSource{
+				{Name: "cni", OCIArtifact: &addonsv1.OCIArtifactSource{URL: fmt.Sprintf("oci://%s/addons/cni:v1.0.0", registry)}},
+			},
+			resourceRef: addonsv1.ResourceRef{Name: "cni", Kind: string(addonsv1.OCIArtifactClusterResourceSetResourceKind)},
+			wantErr:     "credentials not found",
+		},
+		{
+			name: "fails if the pull secret is not a docker config",
+			sources: []addonsv1.ClusterResourceSetSource{
+				{Name: "cni", OCIArtifact: &addonsv1.OCIArtifactSource{URL: fmt.Sprintf("oci://%s/addons/cni:v1.0.0", registry), PullSecretName: opaqueSecret.Name}},
+			},
+			resourceRef: addonsv1.ResourceRef{Name: "cni", Kind: string(addonsv1.OCIArtifactClusterResourceSetResourceKind)},
+			wantErr:     "must be of type kubernetes.io/dockerconfigjson",
+		},
+		{
+			name: "fails if the values ConfigMap does not have the key",
+			sources: []addonsv1.ClusterResourceSetSource{
+				{Name: "app", HelmChart: &addonsv1.HelmChartSource{
+					URL:            fmt.Sprintf("oci://%s/charts/test-chart:1.0.0", registry),
+					ReleaseName:    "app",
+					ValuesFrom:     addonsv1.HelmChartValuesReference{ConfigMapName: valuesConfigMap.Name, Key: "missing"},
+					PullSecretName: pullSecret.Name,
+				}},
+			},
+			resourceRef: addonsv1.ResourceRef{Name: "app", Kind: string(addonsv1.HelmChartClusterResourceSetResourceKind)},
+			wantErr:     `key "missing" not found`,
+		},
+		{
+			name: "fails if the OCI artifact is not a Helm chart",
+			sources: []addonsv1.ClusterResourceSetSource{
+				{Name: "app", HelmChart: &addonsv1.HelmChartSource{
+					URL:            fmt.Sprintf("oci://%s/addons/cni:v1.0.0", registry),
+					ReleaseName:    "app",
+					PullSecretName: pullSecret.Name,
+				}},
+			},
+			resourceRef: addonsv1.ResourceRef{Name: "app", Kind: string(addonsv1.HelmChartClusterResourceSetResourceKind)},
+			wantErr:     "is not a Helm chart",
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := &Reconciler{
+				Client:      fake.NewClientBuilder().WithScheme(scheme).WithObjects(pullSecret, opaqueSecret, valuesConfigMap).Build(),
+				sourceCache: utilcache.New[sourceContentEntry](utilcache.DefaultTTL),
+				httpClient:  server.Client(),
+			}
+			crs := &addonsv1.ClusterResourceSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "crs", Namespace: metav1.NamespaceDefault},
+				Spec: addonsv1.ClusterResourceSetSpec{
+					Resources: []addonsv1.ResourceRef{tt.resourceRef},
+					Sources:   tt.sources,
+				},
+			}
+
+			source, err := r.renderSource(ctx, crs, tt.resourceRef)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(source.defaultNamespace).To(Equal(tt.wantDefaultNamespace))
+
+			kinds := []string{}
+			for _, data := range source.data {
+				objs, err := utilyaml.ToUnstructured(data)
+				g.Expect(err).ToNot(HaveOccurred())
+				for _, obj := range objs {
+					kinds = append(kinds, obj.GetKind())
+				}
+			}
+			g.Expect(kinds).To(Equal(tt.wantKinds))
+			if tt.wantData != "" {
+				g.Expect(string(source.data[0])).To(ContainSubstring(tt.wantData))
+			}
+		})
+	}
+}
+
+func TestDownloadUsesCache(t *testing.T) {
+	g := NewWithT(t)
+
+	requests := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		_, _ = w.Write([]byte("content"))
+	}))
+	defer server.Close()
+
+	r := &Reconciler{
+		Client:      fake.NewClientBuilder().Build(),
+		sourceCache: utilcache.New[sourceContentEntry](utilcache.DefaultTTL),
+		httpClient:  server.Client(),
+	}
+
+	for range 2 {
+		content, err := r.download(ctx, server.URL+"/chart.tgz")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(content).To(Equal([]byte("content")))
+	}
+	g.Expect(requests).To(Equal(1))
+}
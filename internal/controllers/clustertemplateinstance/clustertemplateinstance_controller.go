@@ -0,0 +1,275 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertemplateinstance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/clustertemplate"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/paused"
+	"sigs.k8s.io/cluster-api/util/predicates"
+)
+
+// NOTE: Objects of a ClusterTemplate are created with the credentials of the Cluster API controller and not with the
+// ones of the user creating the ClusterTemplateInstance, so the kinds that can be part of a ClusterTemplate are
+// restricted to an allowlist (see clustertemplate.ValidateObjects), enforced both by the ClusterTemplate webhook and
+// by this controller. Secrets and ClusterResourceSets are only allowed if explicitly enabled.
+
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clustertemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clustertemplateinstances;clustertemplateinstances/status,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;machinedeployments;machinehealthchecks;machinepools,verbs=get;create
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io;bootstrap.cluster.x-k8s.io;controlplane.cluster.x-k8s.io,resources=*,verbs=get;create
+// +kubebuilder:rbac:groups=addons.cluster.x-k8s.io,resources=clusterresourcesets,verbs=get;create
+// +kubebuilder:rbac:groups=core,resources=secrets;configmaps,verbs=get;create
+
+// clusterTemplateNameField is the field used to index ClusterTemplateInstances by the name of their ClusterTemplate.
+const clusterTemplateNameField = "spec.clusterTemplateName"
+
+// Reconciler reconciles a ClusterTemplateInstance object.
+type Reconciler struct {
+	Client client.Client
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+
+	// AllowSecretsAndClusterResourceSets allows ClusterTemplates to contain Secrets and ClusterResourceSets.
+	AllowSecretsAndClusterResourceSets bool
+}
+
+func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	if r.Client == nil {
+		return errors.New("Client must not be nil")
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &clusterv1.ClusterTemplateInstance{}, clusterTemplateNameField, func(o client.Object) []string {
+		instance, ok := o.(*clusterv1.ClusterTemplateInstance)
+		if !ok {
+			return nil
+		}
+		return []string{instance.Spec.ClusterTemplateName}
+	}); err != nil {
+		return errors.Wrap(err, "failed to add index for ClusterTemplateInstance")
+	}
+
+	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "clustertemplateinstance")
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&clusterv1.ClusterTemplateInstance{}).
+		WithOptions(options).
+		Watches(
+			&clusterv1.ClusterTemplate{},
+			handler.EnqueueRequestsFromMapFunc(r.clusterTemplateToClusterTemplateInstances),
+		).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		Complete(r)
+	if err != nil {
+		return errors.Wrap(err, "failed setting up with a controller manager")
+	}
+	return nil
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	instance := &clusterv1.ClusterTemplateInstance{}
+	if err := r.Client.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	patchHelper, err := patch.NewHelper(instance, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if isPaused, requeue, err := paused.EnsurePausedCondition(ctx, r.Client, nil, instance); err != nil || isPaused || requeue {
+		return ctrl.Result{}, err
+	}
+
+	// Objects created from a ClusterTemplateInstance are not deleted together with it, so there is nothing to do on deletion.
+	if !instance.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		patchOpts := []patch.Option{
+			patch.WithOwnedConditions{Conditions: []string{
+				clusterv1.PausedCondition,
+				clusterv1.ClusterTemplateInstanceInstantiatedCondition,
+			}},
+		}
+		// Patch ObservedGeneration only if the reconciliation completed successfully.
+		if reterr == nil {
+			patchOpts = append(patchOpts, patch.WithStatusObservedGeneration{})
+		}
+		if err := patchHelper.Patch(ctx, instance, patchOpts...); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	return ctrl.Result{}, r.reconcile(ctx, instance)
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, instance *clusterv1.ClusterTemplateInstance) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	// A ClusterTemplate is instantiated only once; after the objects have been created they are managed
+	// independently of the ClusterTemplateInstance.
+	if conditions.IsTrue(instance, clusterv1.ClusterTemplateInstanceInstantiatedCondition) {
+		return nil
+	}
+
+	clusterTemplate := &clusterv1.ClusterTemplate{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: instance.Namespace, Name: instance.Spec.ClusterTemplateName}, clusterTemplate); err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.Set(instance, metav1.Condition{
+				Type:    clusterv1.ClusterTemplateInstanceInstantiatedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.ClusterTemplateInstanceClusterTemplateNotFoundReason,
+				Message: fmt.Sprintf("ClusterTemplate %s does not exist", instance.Spec.ClusterTemplateName),
+			})
+			return nil
+		}
+		setInstantiatedInternalErrorCondition(instance)
+		return errors.Wrapf(err, "failed to get ClusterTemplate %s", klog.KRef(instance.Namespace, instance.Spec.ClusterTemplateName))
+	}
+
+	objs, err := renderClusterTemplate(clusterTemplate, instance)
+	if err != nil {
+		// Invalid parameters or templates can only be fixed by changing the ClusterTemplate or the
+		// ClusterTemplateInstance, so there is no need to return an error and requeue.
+		conditions.Set(instance, metav1.Condition{
+			Type:    clusterv1.ClusterTemplateInstanceInstantiatedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.ClusterTemplateInstanceInvalidParametersReason,
+			Message: fmt.Sprintf("Failed to render ClusterTemplate %s: %v", clusterTemplate.Name, err),
+		})
+		return nil
+	}
+
+	// Validate the rendered objects again, the webhook can't validate templates that can be parsed only
+	// after the parameters are set, and the ClusterTemplate might have been created before the allowlist was enforced.
+	if err := clustertemplate.ValidateObjects(objs, r.AllowSecretsAndClusterResourceSets); err != nil {
+		conditions.Set(instance, metav1.Condition{
+			Type:    clusterv1.ClusterTemplateInstanceInstantiatedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.ClusterTemplateInstanceObjectNotAllowedReason,
+			Message: fmt.Sprintf("ClusterTemplate %s contains objects which are not allowed: %v", clusterTemplate.Name, err),
+		})
+		return nil
+	}
+
+	for i := range objs {
+		obj := &objs[i]
+		if err := r.createObject(ctx, instance, obj); err != nil {
+			setInstantiatedInternalErrorCondition(instance)
+			return err
+		}
+		log.V(4).Info(fmt.Sprintf("Created %s", obj.GetKind()), obj.GetKind(), klog.KObj(obj))
+	}
+
+	conditions.Set(instance, metav1.Condition{
+		Type:   clusterv1.ClusterTemplateInstanceInstantiatedCondition,
+		Status: metav1.ConditionTrue,
+		Reason: clusterv1.ClusterTemplateInstanceInstantiatedReason,
+	})
+	return nil
+}
+
+// renderClusterTemplate renders the ClusterTemplate with the parameters of the ClusterTemplateInstance.
+func renderClusterTemplate(clusterTemplate *clusterv1.ClusterTemplate, instance *clusterv1.ClusterTemplateInstance) ([]unstructured.Unstructured, error) {
+	values := map[string]string{}
+	for _, p := range instance.Spec.Parameters {
+		values[p.Name] = p.Value
+	}
+	resolved, err := clustertemplate.ResolveParameters(clusterTemplate.Spec.Parameters, values)
+	if err != nil {
+		return nil, err
+	}
+	return clustertemplate.Render(clusterTemplate.Spec.Template, instance.Spec.ClusterName, instance.Namespace, resolved)
+}
+
+// createObject creates an object of the ClusterTemplate, if it does not exist yet.
+// Objects which already exist are only accepted if they have been created for the same ClusterTemplateInstance,
+// e.g. by a previous reconcile that failed before all objects were created.
+func (r *Reconciler) createObject(ctx context.Context, instance *clusterv1.ClusterTemplateInstance, obj *unstructured.Unstructured) error {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[clusterv1.ClusterTemplateInstanceLabel] = instance.Name
+	obj.SetLabels(labels)
+
+	if err := r.Client.Create(ctx, obj); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return errors.Wrapf(err, "failed to create %s %s", obj.GetKind(), klog.KObj(obj))
+		}
+
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(obj.GroupVersionKind())
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+			return errors.Wrapf(err, "failed to get %s %s", obj.GetKind(), klog.KObj(obj))
+		}
+		if existing.GetLabels()[clusterv1.ClusterTemplateInstanceLabel] != instance.Name {
+			return errors.Errorf("failed to create %s %s: object already exists and it has not been created by ClusterTemplateInstance %s", obj.GetKind(), klog.KObj(obj), instance.Name)
+		}
+	}
+	return nil
+}
+
+func setInstantiatedInternalErrorCondition(instance *clusterv1.ClusterTemplateInstance) {
+	conditions.Set(instance, metav1.Condition{
+		Type:    clusterv1.ClusterTemplateInstanceInstantiatedCondition,
+		Status:  metav1.ConditionUnknown,
+		Reason:  clusterv1.ClusterTemplateInstanceInstantiatedInternalErrorReason,
+		Message: "Please check controller logs for errors",
+	})
+}
+
+// clusterTemplateToClusterTemplateInstances is a handler.ToRequestsFunc to be used to enqueue requests for reconciliation
+// for ClusterTemplateInstances referencing a ClusterTemplate.
+func (r *Reconciler) clusterTemplateToClusterTemplateInstances(ctx context.Context, o client.Object) []reconcile.Request {
+	instanceList := &clusterv1.ClusterTemplateInstanceList{}
+	if err := r.Client.List(ctx, instanceList,
+		client.InNamespace(o.GetNamespace()),
+		client.MatchingFields{clusterTemplateNameField: o.GetName()},
+	); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(instanceList.Items))
+	for _, instance := range instanceList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&instance)})
+	}
+	return requests
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertemplateinstance
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const testTemplate = `apiVersion: cluster.x-k8s.io/v1beta2
+kind: Cluster
+metadata:
+  name: ${CLUSTER_NAME}
+spec:
+  topology:
+    version: ${KUBERNETES_VERSION}
+---
+apiVersion: cluster.x-k8s.io/v1beta2
+kind: MachineDeployment
+metadata:
+  name: ${CLUSTER_NAME}-md-0
+spec:
+  clusterName: ${CLUSTER_NAME}
+  replicas: ${WORKER_MACHINE_COUNT}
+`
+
+func TestReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+
+	clusterTemplate := &clusterv1.ClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "ct", Namespace: metav1.NamespaceDefault},
+		Spec: clusterv1.ClusterTemplateSpec{
+			Parameters: []clusterv1.ClusterTemplateParameter{
+				{Name: "KUBERNETES_VERSION", Type: clusterv1.ClusterTemplateParameterTypeString, Required: ptr.To(true)},
+				{Name: "WORKER_MACHINE_COUNT", Type: clusterv1.ClusterTemplateParameterTypeInteger, Default: "1"},
+			},
+			Template: testTemplate,
+		},
+	}
+	clusterTemplateWithSecret := clusterTemplate.DeepCopy()
+	clusterTemplateWithSecret.Spec.Template += `---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: ${CLUSTER_NAME}-credentials
+`
+	newInstance := func(parameters ...clusterv1.ClusterTemplateInstanceParameter) *clusterv1.ClusterTemplateInstance {
+		return &clusterv1.ClusterTemplateInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "cti", Namespace: metav1.NamespaceDefault},
+			Spec: clusterv1.ClusterTemplateInstanceSpec{
+				ClusterTemplateName: clusterTemplate.Name,
+				ClusterName:         "my-cluster",
+				Parameters:          parameters,
+			},
+			Status: clusterv1.ClusterTemplateInstanceStatus{
+				// Set the Paused condition so the first reconcile doesn't return early after setting it.
+				Conditions: []metav1.Condition{
+					{Type: clusterv1.PausedCondition, Status: metav1.ConditionFalse, Reason: clusterv1.NotPausedReason},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		instance        *clusterv1.ClusterTemplateInstance
+		existingObjs    []client.Object
+		wantErr         bool
+		wantStatus      metav1.ConditionStatus
+		wantReason      string
+		wantClusterName string
+		wantNoCluster   bool
+	}{
+		{
+			name:            "creates the objects of the ClusterTemplate",
+			instance:        newInstance(clusterv1.ClusterTemplateInstanceParameter{Name: "KUBERNETES_VERSION", Value: "v1.33.0"}),
+			existingObjs:    []client.Object{clusterTemplate},
+			wantStatus:      metav1.ConditionTrue,
+			wantReason:      clusterv1.ClusterTemplateInstanceInstantiatedReason,
+			wantClusterName: "my-cluster",
+		},
+		{
+			name:     "accepts objects already created for the same ClusterTemplateInstance",
+			instance: newInstance(clusterv1.ClusterTemplateInstanceParameter{Name: "KUBERNETES_VERSION", Value: "v1.33.0"}),
+			existingObjs: []client.Object{
+				clusterTemplate,
+				&clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-cluster",
+					Namespace: metav1.NamespaceDefault,
+					Labels:    map[string]string{clusterv1.ClusterTemplateInstanceLabel: "cti"},
+				}},
+			},
+			wantStatus:      metav1.ConditionTrue,
+			wantReason:      clusterv1.ClusterTemplateInstanceInstantiatedReason,
+			wantClusterName: "my-cluster",
+		},
+		{
+			name:     "fails if an object already exists and it has not been created by the ClusterTemplateInstance",
+			instance: newInstance(clusterv1.ClusterTemplateInstanceParameter{Name: "KUBERNETES_VERSION", Value: "v1.33.0"}),
+			existingObjs: []client.Object{
+				clusterTemplate,
+				&clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: metav1.NamespaceDefault}},
+			},
+			wantErr:    true,
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: clusterv1.ClusterTemplateInstanceInstantiatedInternalErrorReason,
+		},
+		{
+			name:       "reports a missing ClusterTemplate",
+			instance:   newInstance(clusterv1.ClusterTemplateInstanceParameter{Name: "KUBERNETES_VERSION", Value: "v1.33.0"}),
+			wantStatus: metav1.ConditionFalse,
+			wantReason: clusterv1.ClusterTemplateInstanceClusterTemplateNotFoundReason,
+		},
+		{
+			name:         "reports invalid parameters",
+			instance:     newInstance(clusterv1.ClusterTemplateInstanceParameter{Name: "WORKER_MACHINE_COUNT", Value: "many"}),
+			existingObjs: []client.Object{clusterTemplate},
+			wantStatus:   metav1.ConditionFalse,
+			wantReason:   clusterv1.ClusterTemplateInstanceInvalidParametersReason,
+		},
+		{
+			name:          "reports objects which are not allowed without creating any object",
+			instance:      newInstance(clusterv1.ClusterTemplateInstanceParameter{Name: "KUBERNETES_VERSION", Value: "v1.33.0"}),
+			existingObjs:  []client.Object{clusterTemplateWithSecret},
+			wantStatus:    metav1.ConditionFalse,
+			wantReason:    clusterv1.ClusterTemplateInstanceObjectNotAllowedReason,
+			wantNoCluster: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tt.existingObjs, tt.instance)...).
+				WithStatusSubresource(&clusterv1.ClusterTemplateInstance{}).
+				Build()
+			r := &Reconciler{Client: c}
+
+			_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tt.instance)})
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+
+			instance := &clusterv1.ClusterTemplateInstance{}
+			g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(tt.instance), instance)).To(Succeed())
+			condition := conditions.Get(instance, clusterv1.ClusterTemplateInstanceInstantiatedCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantStatus))
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+
+			if tt.wantNoCluster {
+				g.Expect(apierrors.IsNotFound(c.Get(t.Context(), client.ObjectKey{Namespace: instance.Namespace, Name: "my-cluster"}, &clusterv1.Cluster{}))).To(BeTrue())
+			}
+			if tt.wantClusterName == "" {
+				return
+			}
+			cluster := &clusterv1.Cluster{}
+			g.Expect(c.Get(t.Context(), client.ObjectKey{Namespace: instance.Namespace, Name: tt.wantClusterName}, cluster)).To(Succeed())
+			g.Expect(cluster.Labels).To(HaveKeyWithValue(clusterv1.ClusterTemplateInstanceLabel, instance.Name))
+
+			md := &clusterv1.MachineDeployment{}
+			g.Expect(c.Get(t.Context(), client.ObjectKey{Namespace: instance.Namespace, Name: tt.wantClusterName + "-md-0"}, md)).To(Succeed())
+			g.Expect(md.Labels).To(HaveKeyWithValue(clusterv1.ClusterTemplateInstanceLabel, instance.Name))
+			g.Expect(md.Spec.Replicas).To(Equal(ptr.To[int32](1)))
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -28,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -40,6 +42,8 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
 	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
+	"sigs.k8s.io/cluster-api/internal/runtime/settings"
+	"sigs.k8s.io/cluster-api/internal/util/resync"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 	"sigs.k8s.io/cluster-api/util/patch"
@@ -67,6 +71,10 @@ type Reconciler struct {
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// ResyncPeriod is the period after which all ExtensionConfigs are requeued, in addition to the resyncs of the cache.
+	// If 0, ExtensionConfigs are only requeued when the cache is resynced.
+	ResyncPeriod time.Duration
 }
 
 func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -84,7 +92,8 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 	b := ctrl.NewControllerManagedBy(mgr).
 		For(&runtimev1.ExtensionConfig{}).
 		WithOptions(options).
-		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue))
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		WatchesRawSource(resync.Source(mgr.GetClient(), &runtimev1.ExtensionConfigList{}, r.ResyncPeriod, r.WatchFilterValue))
 
 	if !r.ReadOnly {
 		// The watch on Secrets is only needed when reconciling caBundle (readOnly mode doesn't do that).
@@ -126,8 +135,6 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	log := ctrl.LoggerFrom(ctx)
-
 	// Requeue events when the registry is not ready.
 	// The registry will become ready after it is 'warmed up' by warmupRunnable.
 	if !r.RuntimeClient.IsReady() {
@@ -165,9 +172,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 
 		// Register the ExtensionConfig if it is valid.
-		log.V(4).Info("Registering ExtensionConfig information into registry")
-		if err = r.RuntimeClient.Register(extensionConfig); err != nil {
-			return ctrl.Result{}, errors.Wrapf(err, "failed to register ExtensionConfig %s/%s", extensionConfig.Namespace, extensionConfig.Name)
+		if err := r.register(ctx, extensionConfig); err != nil {
+			return ctrl.Result{}, err
 		}
 	} else {
 		// Preserve original, EnsurePausedCondition might bump observedGeneration of the Paused condition without requeuing.
@@ -183,15 +189,35 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 
 		// Register the ExtensionConfig if it was found and patched without error.
-		log.V(4).Info("Registering ExtensionConfig information into registry")
-		if err = r.RuntimeClient.Register(extensionConfig); err != nil {
-			return ctrl.Result{}, errors.Wrapf(err, "failed to register ExtensionConfig %s/%s", extensionConfig.Namespace, extensionConfig.Name)
+		if err := r.register(ctx, extensionConfig); err != nil {
+			return ctrl.Result{}, err
 		}
 	}
 
 	return ctrl.Result{}, nil
 }
 
+// register registers the ExtensionConfig into the registry.
+// If the settings of the ExtensionConfig are not valid according to the settings schema of the Extension,
+// the ExtensionConfig is unregistered instead, so that ExtensionHandlers are never called with invalid settings.
+func (r *Reconciler) register(ctx context.Context, extensionConfig *runtimev1.ExtensionConfig) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if err := validateExtensionConfigSettings(extensionConfig); err != nil {
+		log.Info("Unregistering ExtensionConfig information from registry", "reason", err.Error())
+		if err := r.RuntimeClient.Unregister(extensionConfig); err != nil {
+			return errors.Wrapf(err, "failed to unregister ExtensionConfig %s", klog.KObj(extensionConfig))
+		}
+		return nil
+	}
+
+	log.V(4).Info("Registering ExtensionConfig information into registry")
+	if err := r.RuntimeClient.Register(extensionConfig); err != nil {
+		return errors.Wrapf(err, "failed to register ExtensionConfig %s/%s", extensionConfig.Namespace, extensionConfig.Name)
+	}
+	return nil
+}
+
 func patchExtensionConfig(ctx context.Context, client client.Client, original, modified *runtimev1.ExtensionConfig, options ...patch.Option) error {
 	patchHelper, err := patch.NewHelper(original, client)
 	if err != nil {
@@ -205,6 +231,7 @@ func patchExtensionConfig(ctx context.Context, client client.Client, original, m
 		patch.WithOwnedConditions{Conditions: []string{
 			clusterv1.PausedCondition,
 			runtimev1.ExtensionConfigDiscoveredCondition,
+			runtimev1.ExtensionConfigSettingsValidCondition,
 		}},
 	)
 	return patchHelper.Patch(ctx, modified, options...)
@@ -270,6 +297,43 @@ func discoverExtensionConfig(ctx context.Context, runtimeClient runtimeclient.Cl
 	return discoveredExtension, nil
 }
 
+// setSettingsValidCondition sets the SettingsValid condition on the ExtensionConfig by validating
+// spec.settings against the settings schema returned by the Extension during discovery.
+func setSettingsValidCondition(extensionConfig *runtimev1.ExtensionConfig) {
+	if extensionConfig.Status.SettingsSchema == nil {
+		conditions.Set(extensionConfig, metav1.Condition{
+			Type:   runtimev1.ExtensionConfigSettingsValidCondition,
+			Status: metav1.ConditionTrue,
+			Reason: runtimev1.ExtensionConfigSettingsSchemaNotDefinedReason,
+		})
+		return
+	}
+
+	if err := validateExtensionConfigSettings(extensionConfig); err != nil {
+		conditions.Set(extensionConfig, metav1.Condition{
+			Type:    runtimev1.ExtensionConfigSettingsValidCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  runtimev1.ExtensionConfigSettingsInvalidReason,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	conditions.Set(extensionConfig, metav1.Condition{
+		Type:   runtimev1.ExtensionConfigSettingsValidCondition,
+		Status: metav1.ConditionTrue,
+		Reason: runtimev1.ExtensionConfigSettingsValidReason,
+	})
+}
+
+// validateExtensionConfigSettings validates spec.settings against the settings schema returned by the Extension during discovery.
+func validateExtensionConfigSettings(extensionConfig *runtimev1.ExtensionConfig) error {
+	if errs := settings.Validate(extensionConfig.Status.SettingsSchema, extensionConfig.Spec.Settings, field.NewPath("spec", "settings")); len(errs) > 0 {
+		return errors.Errorf("settings are not valid: %s", errs.ToAggregate().Error())
+	}
+	return nil
+}
+
 // reconcileCABundle reconciles the CA bundle for the ExtensionConfig.
 // Note: This was implemented to behave similar to the cert-manager cainjector.
 // We couldn't use the cert-manager cainjector because it doesn't work with CustomResources.
@@ -359,6 +423,9 @@ func reconcileExtensionConfig(ctx context.Context, c client.Client, runtimeClien
 		errs = append(errs, err)
 	}
 
+	// Note: SettingsValid is computed even if discovery failed, using the settings schema from the last successful discovery.
+	setSettingsValidCondition(extensionConfig)
+
 	// Note: Intentionally always patching ExtensionConfig even if discoverExtensionConfig failed.
 	if err := patchExtensionConfig(ctx, c, original, extensionConfig); err != nil {
 		errs = append(errs, err)
@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/admission/plugin/webhook/testcerts"
 	utilfeature "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -500,6 +501,65 @@ func Test_validateExtensionConfig(t *testing.T) {
 	}
 }
 
+func Test_setSettingsValidCondition(t *testing.T) {
+	settingsSchema := &runtimev1.ExtensionSettingsSchema{
+		Settings: []runtimev1.ExtensionSettingSchema{
+			{
+				Name:     "mode",
+				Required: ptr.To(true),
+				Enum:     []string{"fast", "safe"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		schema     *runtimev1.ExtensionSettingsSchema
+		settings   map[string]string
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name:       "settings schema not defined",
+			schema:     nil,
+			settings:   map[string]string{"foo": "bar"},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: runtimev1.ExtensionConfigSettingsSchemaNotDefinedReason,
+		},
+		{
+			name:       "settings valid",
+			schema:     settingsSchema,
+			settings:   map[string]string{"mode": "fast"},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: runtimev1.ExtensionConfigSettingsValidReason,
+		},
+		{
+			name:       "settings invalid",
+			schema:     settingsSchema,
+			settings:   map[string]string{"foo": "bar"},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: runtimev1.ExtensionConfigSettingsInvalidReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			config := extensionConfig([]byte("caBundle"))
+			config.Spec.Settings = tt.settings
+			config.Status.SettingsSchema = tt.schema
+
+			setSettingsValidCondition(config)
+
+			condition := conditions.Get(config, runtimev1.ExtensionConfigSettingsValidCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantStatus))
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+			g.Expect(validateExtensionConfigSettings(config) == nil).To(Equal(tt.wantStatus == metav1.ConditionTrue))
+		})
+	}
+}
+
 func discoveryHandler(handlerList ...string) func(http.ResponseWriter, *http.Request) {
 	handlers := []runtimehooksv1.ExtensionHandler{}
 	for _, name := range handlerList {
@@ -18,6 +18,7 @@ package extensionconfig
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/pkg/errors"
@@ -127,6 +128,15 @@ func (r *warmupRunnable) warmupRegistry(ctx context.Context) error {
 		return kerrors.NewAggregate(errs)
 	}
 
+	// Do not register ExtensionConfigs with invalid settings, so that their ExtensionHandlers are never called with invalid settings.
+	extensionConfigList.Items = slices.DeleteFunc(extensionConfigList.Items, func(extensionConfig runtimev1.ExtensionConfig) bool {
+		if err := validateExtensionConfigSettings(&extensionConfig); err != nil {
+			log.Info("Skipping registration of ExtensionConfig", "ExtensionConfig", klog.KObj(&extensionConfig), "reason", err.Error())
+			return true
+		}
+		return false
+	})
+
 	if err := r.RuntimeClient.WarmUp(&extensionConfigList); err != nil {
 		return err
 	}
@@ -82,9 +82,43 @@ func (d *Helper) CordonNode(ctx context.Context, node *corev1.Node) error {
 	return nil
 }
 
+// UncordonNode uncordons a Node.
+func (d *Helper) UncordonNode(ctx context.Context, node *corev1.Node) error {
+	if !node.Spec.Unschedulable {
+		// Node is already uncordoned, nothing to do.
+		return nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Uncordoning Node")
+
+	patch := client.MergeFrom(node.DeepCopy())
+	node.Spec.Unschedulable = false
+	if err := d.RemoteClient.Patch(ctx, node, patch); err != nil {
+		return errors.Wrapf(err, "failed to uncordon Node")
+	}
+
+	return nil
+}
+
 // GetPodsForEviction gets Pods running on a Node and then filters and returns them as PodDeleteList,
 // or error if it cannot list Pods or get DaemonSets. All Pods that have to go away can be obtained with .Pods().
 func (d *Helper) GetPodsForEviction(ctx context.Context, cluster *clusterv1.Cluster, machine *clusterv1.Machine, nodeName string) (*PodDeleteList, error) {
+	list, _, err := d.filterPodsOnNode(ctx, cluster, machine, nodeName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get Pods for eviction")
+	}
+	if errs := list.errors(); len(errs) > 0 {
+		return nil, errors.Wrapf(kerrors.NewAggregate(errs), "failed to get Pods for eviction")
+	}
+
+	return list, nil
+}
+
+// filterPodsOnNode gets Pods running on a Node and runs them through the drain filters. It also returns
+// the MachineDrainRules matching the Machine and Cluster. Pods for which a filter returned an error are
+// included in the returned PodDeleteList.
+func (d *Helper) filterPodsOnNode(ctx context.Context, cluster *clusterv1.Cluster, machine *clusterv1.Machine, nodeName string) (*PodDeleteList, []*clusterv1.MachineDrainRule, error) {
 	allPods := []*corev1.Pod{}
 	podList := &corev1.PodList{}
 	for {
@@ -95,7 +129,7 @@ func (d *Helper) GetPodsForEviction(ctx context.Context, cluster *clusterv1.Clus
 			client.Limit(100),
 		}
 		if err := d.RemoteClient.List(ctx, podList, listOpts...); err != nil {
-			return nil, errors.Wrapf(err, "failed to get Pods for eviction")
+			return nil, nil, errors.Wrapf(err, "failed to list Pods")
 		}
 
 		for _, pod := range podList.Items {
@@ -108,13 +142,13 @@ func (d *Helper) GetPodsForEviction(ctx context.Context, cluster *clusterv1.Clus
 	}
 
 	if len(allPods) == 0 {
-		return &PodDeleteList{}, nil
+		return &PodDeleteList{}, nil, nil
 	}
 
 	// Get MachineDrainRules matching the Machine and Cluster.
 	machineDrainRulesMatchingMachine, err := d.getMatchingMachineDrainRules(ctx, cluster, machine)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get Pods for eviction")
+		return nil, nil, err
 	}
 
 	// List all Namespaces.
@@ -123,7 +157,7 @@ func (d *Helper) GetPodsForEviction(ctx context.Context, cluster *clusterv1.Clus
 	podNamespaces := map[string]*corev1.Namespace{}
 	namespaceList := &corev1.NamespaceList{}
 	if err := d.RemoteClient.List(ctx, namespaceList); err != nil {
-		return nil, errors.Wrapf(err, "failed to get Pods for eviction: failed to list Namespaces")
+		return nil, nil, errors.Wrapf(err, "failed to list Namespaces")
 	}
 	for _, ns := range namespaceList.Items {
 		podNamespaces[ns.Name] = &ns
@@ -158,11 +192,8 @@ func (d *Helper) GetPodsForEviction(ctx context.Context, cluster *clusterv1.Clus
 		// If there is no matching MachineDrainRule, use behavior: "Drain" and order: 0
 		d.machineDrainRulesFilter(machineDrainRulesMatchingMachine, podNamespaces),
 	})
-	if errs := list.errors(); len(errs) > 0 {
-		return nil, errors.Wrapf(kerrors.NewAggregate(errs), "failed to get Pods for eviction")
-	}
 
-	return list, nil
+	return list, machineDrainRulesMatchingMachine, nil
 }
 
 func (d *Helper) getMatchingMachineDrainRules(ctx context.Context, cluster *clusterv1.Cluster, machine *clusterv1.Machine) ([]*clusterv1.MachineDrainRule, error) {
@@ -86,6 +86,30 @@ func TestRunCordonOrUncordon(t *testing.T) {
 	}
 }
 
+func TestUncordonNode(t *testing.T) {
+	g := NewWithT(t)
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-1",
+		},
+		Spec: corev1.NodeSpec{
+			Unschedulable: true,
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithObjects(node).Build()
+
+	drainer := &Helper{
+		RemoteClient: fakeClient,
+	}
+
+	g.Expect(drainer.UncordonNode(context.Background(), node)).To(Succeed())
+
+	gotNode := node.DeepCopy()
+	g.Expect(fakeClient.Get(context.Background(), client.ObjectKeyFromObject(gotNode), gotNode)).To(Succeed())
+	g.Expect(gotNode.Spec.Unschedulable).To(BeFalse())
+}
+
 func TestGetPodsForEviction(t *testing.T) {
 	mdrBehaviorDrain := &clusterv1.MachineDrainRule{
 		ObjectMeta: metav1.ObjectMeta{
@@ -782,8 +806,9 @@ func TestGetPodsForEviction(t *testing.T) {
 						},
 					},
 					Status: PodDeleteStatus{
-						DrainBehavior: clusterv1.MachineDrainRuleDrainBehaviorDrain,
-						DrainOrder:    ptr.To[int32](11),
+						DrainBehavior:    clusterv1.MachineDrainRuleDrainBehaviorDrain,
+						DrainOrder:       ptr.To[int32](11),
+						MachineDrainRule: "mdr-behavior-drain",
 						// Preserve warning from other filters.
 						Reason:  PodDeleteStatusTypeWarning,
 						Message: "evicting Pod that has no controller",
@@ -797,8 +822,9 @@ func TestGetPodsForEviction(t *testing.T) {
 						},
 					},
 					Status: PodDeleteStatus{
-						DrainBehavior: clusterv1.MachineDrainRuleDrainBehaviorSkip,
-						Reason:        PodDeleteStatusTypeSkip,
+						DrainBehavior:    clusterv1.MachineDrainRuleDrainBehaviorSkip,
+						MachineDrainRule: "mdr-behavior-skip",
+						Reason:           PodDeleteStatusTypeSkip,
 					},
 				},
 				{
@@ -809,9 +835,10 @@ func TestGetPodsForEviction(t *testing.T) {
 						},
 					},
 					Status: PodDeleteStatus{
-						DrainBehavior: clusterv1.MachineDrainRuleDrainBehaviorWaitCompleted,
-						DrainOrder:    ptr.To[int32](0),
-						Reason:        PodDeleteStatusTypeWaitCompleted,
+						DrainBehavior:    clusterv1.MachineDrainRuleDrainBehaviorWaitCompleted,
+						DrainOrder:       ptr.To[int32](0),
+						MachineDrainRule: "mdr-behavior-wait-completed",
+						Reason:           PodDeleteStatusTypeWaitCompleted,
 					},
 				},
 			}},
@@ -0,0 +1,189 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// PodAction is the action taken on a Pod when draining a Node.
+type PodAction string
+
+const (
+	// PodActionEvict is used for Pods that are evicted.
+	PodActionEvict PodAction = "Evict"
+
+	// PodActionWaitCompleted is used for Pods that are not evicted, but the drain waits for them to complete.
+	PodActionWaitCompleted PodAction = "WaitCompleted"
+
+	// PodActionSkip is used for Pods that are ignored by the drain.
+	PodActionSkip PodAction = "Skip"
+
+	// PodActionBlock is used for Pods that block the drain, and thus the deletion of the Machine,
+	// because the drain behavior for them can't be determined.
+	PodActionBlock PodAction = "Block"
+)
+
+// Explanation explains how a Node would be drained.
+type Explanation struct {
+	// Node is the name of the Node.
+	Node string `json:"node"`
+
+	// MachineDrainRules are the names of the MachineDrainRules matching the Machine and Cluster,
+	// in the order they are evaluated for every Pod.
+	MachineDrainRules []string `json:"machineDrainRules,omitempty"`
+
+	// Pods explains how every Pod on the Node would be handled.
+	// Pods to evict or to wait for are listed first, by drain order, followed by the Pods to skip
+	// and the Pods blocking the drain.
+	Pods []PodExplanation `json:"pods,omitempty"`
+}
+
+// PodExplanation explains how a Pod would be handled when draining a Node.
+type PodExplanation struct {
+	// Namespace of the Pod.
+	Namespace string `json:"namespace"`
+
+	// Name of the Pod.
+	Name string `json:"name"`
+
+	// Action is the action taken on the Pod.
+	Action PodAction `json:"action"`
+
+	// Order is the drain order of the Pod. Pods with a lower order are drained first.
+	// Order is only set if Action is Evict or WaitCompleted.
+	Order *int32 `json:"order,omitempty"`
+
+	// MachineDrainRule is the name of the MachineDrainRule that determined the action, if any.
+	MachineDrainRule string `json:"machineDrainRule,omitempty"`
+
+	// Reason explains why the action is taken, if it was not determined by a MachineDrainRule,
+	// or the warnings for Pods that are evicted.
+	Reason string `json:"reason,omitempty"`
+}
+
+// ExplainDrain evaluates the drain filters and the MachineDrainRules matching the Machine and Cluster against
+// the Pods running on a Node, and returns how every Pod would be handled when draining the Node.
+// Contrary to GetPodsForEviction, Pods for which the drain behavior can't be determined are returned
+// with the Block action instead of failing.
+// Note: ExplainDrain doesn't cordon the Node nor evict Pods.
+func (d *Helper) ExplainDrain(ctx context.Context, cluster *clusterv1.Cluster, machine *clusterv1.Machine, nodeName string) (*Explanation, error) {
+	list, machineDrainRules, err := d.filterPodsOnNode(ctx, cluster, machine, nodeName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to explain drain of Node %s", nodeName)
+	}
+
+	explanation := &Explanation{
+		Node: nodeName,
+	}
+	for _, mdr := range machineDrainRules {
+		explanation.MachineDrainRules = append(explanation.MachineDrainRules, mdr.Name)
+	}
+	for _, pd := range list.items {
+		explanation.Pods = append(explanation.Pods, d.explainPod(pd))
+	}
+
+	sort.SliceStable(explanation.Pods, func(i, j int) bool {
+		a, b := explanation.Pods[i], explanation.Pods[j]
+		if podActionPriority(a.Action) != podActionPriority(b.Action) {
+			return podActionPriority(a.Action) < podActionPriority(b.Action)
+		}
+		if ptr.Deref(a.Order, 0) != ptr.Deref(b.Order, 0) {
+			return ptr.Deref(a.Order, 0) < ptr.Deref(b.Order, 0)
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return explanation, nil
+}
+
+// explainPod converts a PodDelete into a PodExplanation.
+func (d *Helper) explainPod(pd PodDelete) PodExplanation {
+	e := PodExplanation{
+		Namespace:        pd.Pod.Namespace,
+		Name:             pd.Pod.Name,
+		MachineDrainRule: pd.Status.MachineDrainRule,
+	}
+
+	if pd.Status.Reason == PodDeleteStatusTypeError {
+		e.Action = PodActionBlock
+		e.Reason = pd.Status.Message
+		if e.Reason == "" {
+			e.Reason = "unexpected error"
+		}
+		return e
+	}
+
+	switch pd.Status.DrainBehavior {
+	case clusterv1.MachineDrainRuleDrainBehaviorDrain:
+		e.Action = PodActionEvict
+		e.Order = ptr.To(ptr.Deref(pd.Status.DrainOrder, 0))
+		// Warnings are only reported for Pods that are evicted.
+		e.Reason = pd.Status.Message
+		return e
+	case clusterv1.MachineDrainRuleDrainBehaviorWaitCompleted:
+		e.Action = PodActionWaitCompleted
+		e.Order = ptr.To(ptr.Deref(pd.Status.DrainOrder, 0))
+	default:
+		e.Action = PodActionSkip
+	}
+
+	if e.MachineDrainRule == "" {
+		e.Reason = d.skipReason(pd)
+	}
+	return e
+}
+
+// skipReason returns why a Pod is not evicted, if this was not determined by a MachineDrainRule.
+// Note: The checks are evaluated in the same order as the corresponding filters in filterPodsOnNode.
+func (d *Helper) skipReason(pd PodDelete) string {
+	switch {
+	case shouldSkipPod(pd.Pod, d.SkipWaitForDeleteTimeoutSeconds):
+		return fmt.Sprintf("Pod deletionTimestamp is more than %ds ago", d.SkipWaitForDeleteTimeoutSeconds)
+	case pd.Status.Message != "":
+		return pd.Status.Message
+	}
+	if _, found := pd.Pod.Annotations[corev1.MirrorPodAnnotationKey]; found {
+		return "static Pod"
+	}
+	if labelValue, found := pd.Pod.Labels[clusterv1.PodDrainLabel]; found {
+		return fmt.Sprintf("Pod has %s label with %s value", clusterv1.PodDrainLabel, labelValue)
+	}
+	return ""
+}
+
+// podActionPriority defines the order in which Pods are listed in an Explanation.
+func podActionPriority(action PodAction) int {
+	switch action {
+	case PodActionEvict, PodActionWaitCompleted:
+		return 0
+	case PodActionSkip:
+		return 1
+	default:
+		return 2
+	}
+}
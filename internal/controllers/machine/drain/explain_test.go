@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drain
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestExplainDrain(t *testing.T) {
+	g := NewWithT(t)
+
+	newMachineDrainRule := func(name string, behavior clusterv1.MachineDrainRuleDrainBehavior, order *int32, app string) *clusterv1.MachineDrainRule {
+		return &clusterv1.MachineDrainRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+			},
+			Spec: clusterv1.MachineDrainRuleSpec{
+				Drain: clusterv1.MachineDrainRuleDrainConfig{
+					Behavior: behavior,
+					Order:    order,
+				},
+				Pods: []clusterv1.MachineDrainRulePodSelector{
+					{
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+					},
+				},
+			},
+		}
+	}
+	newPod := func(namespace, name string, labels map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    labels,
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs", Controller: ptr.To(true)},
+				},
+			},
+			Spec: corev1.PodSpec{NodeName: "node-1"},
+		}
+	}
+
+	daemonSetPod := newPod("test-namespace", "daemonset-pod", nil)
+	daemonSetPod.OwnerReferences = []metav1.OwnerReference{
+		{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "daemonset", Controller: ptr.To(true)},
+	}
+	unreplicatedPod := newPod("test-namespace", "unreplicated-pod", nil)
+	unreplicatedPod.OwnerReferences = nil
+
+	remoteObjects := []client.Object{
+		newPod("test-namespace", "app-pod", nil),
+		unreplicatedPod,
+		newPod("test-namespace", "database-pod", map[string]string{"app": "database"}),
+		newPod("test-namespace", "monitoring-pod", map[string]string{"app": "monitoring"}),
+		newPod("test-namespace", "batch-pod", map[string]string{"app": "batch"}),
+		newPod("test-namespace", "labeled-pod", map[string]string{clusterv1.PodDrainLabel: "skip"}),
+		newPod("non-existing-namespace", "orphan-pod", nil),
+		daemonSetPod,
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "daemonset", Namespace: "test-namespace"},
+		},
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"},
+		},
+	}
+	fakeRemoteClient := fake.NewClientBuilder().
+		WithObjects(remoteObjects...).
+		WithIndex(&corev1.Pod{}, "spec.nodeName", podByNodeName).
+		Build()
+
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+	fakeClient := fake.NewClientBuilder().
+		WithObjects(
+			newMachineDrainRule("mdr-database", clusterv1.MachineDrainRuleDrainBehaviorDrain, ptr.To[int32](10), "database"),
+			newMachineDrainRule("mdr-monitoring", clusterv1.MachineDrainRuleDrainBehaviorSkip, nil, "monitoring"),
+			newMachineDrainRule("mdr-batch", clusterv1.MachineDrainRuleDrainBehaviorWaitCompleted, nil, "batch"),
+		).
+		WithScheme(scheme).
+		Build()
+
+	drainer := &Helper{
+		Client:       fakeClient,
+		RemoteClient: fakeRemoteClient,
+	}
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-namespace"}}
+	machine := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "test-machine", Namespace: "test-namespace"}}
+
+	got, err := drainer.ExplainDrain(context.Background(), cluster, machine, "node-1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got).To(BeComparableTo(&Explanation{
+		Node:              "node-1",
+		MachineDrainRules: []string{"mdr-batch", "mdr-database", "mdr-monitoring"},
+		Pods: []PodExplanation{
+			{Namespace: "test-namespace", Name: "app-pod", Action: PodActionEvict, Order: ptr.To[int32](0)},
+			{Namespace: "test-namespace", Name: "batch-pod", Action: PodActionWaitCompleted, Order: ptr.To[int32](0), MachineDrainRule: "mdr-batch"},
+			{Namespace: "test-namespace", Name: "unreplicated-pod", Action: PodActionEvict, Order: ptr.To[int32](0), Reason: unmanagedWarning},
+			{Namespace: "test-namespace", Name: "database-pod", Action: PodActionEvict, Order: ptr.To[int32](10), MachineDrainRule: "mdr-database"},
+			{Namespace: "test-namespace", Name: "daemonset-pod", Action: PodActionSkip, Reason: daemonSetWarning},
+			{Namespace: "test-namespace", Name: "labeled-pod", Action: PodActionSkip, Reason: "Pod has cluster.x-k8s.io/drain label with skip value"},
+			{Namespace: "test-namespace", Name: "monitoring-pod", Action: PodActionSkip, MachineDrainRule: "mdr-monitoring"},
+			{Namespace: "non-existing-namespace", Name: "orphan-pod", Action: PodActionBlock, Reason: "Pod Namespace does not exist"},
+		},
+	}))
+}
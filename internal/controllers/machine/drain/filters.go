@@ -113,6 +113,9 @@ type PodDeleteStatus struct {
 	// DrainOrder is only used if DrainBehavior is "Drain".
 	DrainOrder *int32
 
+	// MachineDrainRule is the name of the MachineDrainRule that determined DrainBehavior and DrainOrder, if any.
+	MachineDrainRule string
+
 	Reason  string
 	Message string
 }
@@ -314,20 +317,23 @@ func (d *Helper) machineDrainRulesFilter(machineDrainRules []*clusterv1.MachineD
 
 			// If the pod selector matches, use the drain behavior from the MachineDrainRule.
 			log := ctrl.LoggerFrom(ctx, "Pod", klog.KObj(pod))
+			var status PodDeleteStatus
 			switch mdr.Spec.Drain.Behavior {
 			case clusterv1.MachineDrainRuleDrainBehaviorDrain:
-				return MakePodDeleteStatusOkayWithOrder(mdr.Spec.Drain.Order)
+				status = MakePodDeleteStatusOkayWithOrder(mdr.Spec.Drain.Order)
 			case clusterv1.MachineDrainRuleDrainBehaviorSkip:
 				log.V(4).Info(fmt.Sprintf("Skip evicting Pod, because MachineDrainRule %s with behavior %s applies to the Pod", mdr.Name, clusterv1.MachineDrainRuleDrainBehaviorSkip))
-				return MakePodDeleteStatusSkip()
+				status = MakePodDeleteStatusSkip()
 			case clusterv1.MachineDrainRuleDrainBehaviorWaitCompleted:
 				log.V(4).Info(fmt.Sprintf("Skip evicting Pod, because MachineDrainRule %s with behavior %s applies to the Pod", mdr.Name, clusterv1.MachineDrainRuleDrainBehaviorWaitCompleted))
-				return MakePodDeleteStatusWaitCompleted()
+				status = MakePodDeleteStatusWaitCompleted()
 			default:
 				return MakePodDeleteStatusWithError(
 					fmt.Sprintf("MachineDrainRule %q has unknown spec.drain.behavior: %q",
 						mdr.Name, mdr.Spec.Drain.Behavior))
 			}
+			status.MachineDrainRule = mdr.Name
+			return status
 		}
 
 		// If no MachineDrainRule matches, use behavior: "Drain" and order: 0
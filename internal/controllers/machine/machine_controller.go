@@ -56,6 +56,7 @@ import (
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/controllers/machine/drain"
+	"sigs.k8s.io/cluster-api/internal/util/resync"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/cache"
@@ -102,11 +103,22 @@ type Reconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// ResyncPeriod is the period after which all Machines are requeued, in addition to the resyncs of the cache.
+	// If 0, Machines are only requeued when the cache is resynced.
+	ResyncPeriod time.Duration
+
 	RemoteConditionsGracePeriod time.Duration
 
 	AdditionalSyncMachineLabels      []*regexp.Regexp
 	AdditionalSyncMachineAnnotations []*regexp.Regexp
 
+	// DisableNodeWatch disables watching Nodes in workload clusters via the ClusterCache.
+	// If set, Node changes are only picked up when Machines are requeued or resynced.
+	DisableNodeWatch bool
+
+	// SyncMachineConditionsToNode are the types of the Machine conditions mirrored as conditions of the corresponding Node.
+	SyncMachineConditionsToNode []string
+
 	controller      controller.Controller
 	recorder        record.EventRecorder
 	externalTracker external.ObjectTracker
@@ -156,6 +168,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		For(&clusterv1.Machine{}).
 		WithOptions(options).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), *r.predicateLog, r.WatchFilterValue)).
+		WatchesRawSource(resync.Source(mgr.GetClient(), &clusterv1.MachineList{}, r.ResyncPeriod, r.WatchFilterValue)).
 		Watches(
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(clusterToMachines),
@@ -302,6 +315,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ct
 	// Handle normal reconciliation loop.
 	reconcileNormal := append(
 		alwaysReconcile,
+		r.reconcileKubeletServingCertificate,
+		r.reconcileNodeReadinessChecks,
+		r.reconcileCordon,
+		r.reconcileRebootstrap,
 		r.reconcileInPlaceUpdate,
 	)
 
@@ -377,8 +394,10 @@ func patchMachine(ctx context.Context, patchHelper *patch.Helper, machine *clust
 			clusterv1.MachineInfrastructureReadyCondition,
 			clusterv1.MachineNodeReadyCondition,
 			clusterv1.MachineNodeHealthyCondition,
+			clusterv1.MachineNodeBootstrappedCondition,
 			clusterv1.MachineDeletingCondition,
 			clusterv1.MachineUpdatingCondition,
+			clusterv1.MachineCordonedCondition,
 		}},
 	)
 
@@ -1007,7 +1026,9 @@ func (r *Reconciler) shouldWaitForNodeVolumes(ctx context.Context, s *scope) (ct
 	// Get two sets of information about volumes currently attached to the node:
 	// * VolumesAttached names from node.Status.VolumesAttached
 	// * PersistentVolume names from VolumeAttachments with status.Attached set to true
-	attachedNodeVolumeNames, attachedPVNames, err := getAttachedVolumeInformation(ctx, remoteClient, node)
+	// Volumes of CSI drivers excluded in machine.spec.deletion.nodeVolumeDetachExclusions are skipped.
+	exclusions := machine.Spec.Deletion.NodeVolumeDetachExclusions
+	attachedNodeVolumeNames, attachedPVNames, err := getAttachedVolumeInformation(ctx, remoteClient, node, exclusions)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -1024,7 +1045,7 @@ func (r *Reconciler) shouldWaitForNodeVolumes(ctx context.Context, s *scope) (ct
 	}
 
 	// List all PersistentVolumes and return the ones we want to wait for.
-	attachedVolumeInformation, err := getPersistentVolumesWaitingForDetach(ctx, remoteClient, attachedNodeVolumeNames, attachedPVNames, pvcsToIgnoreFromPods)
+	attachedVolumeInformation, err := getPersistentVolumesWaitingForDetach(ctx, remoteClient, attachedNodeVolumeNames, attachedPVNames, pvcsToIgnoreFromPods, exclusions)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -1128,6 +1149,10 @@ func (r *Reconciler) shouldAdopt(m *clusterv1.Machine) bool {
 func (r *Reconciler) watchClusterNodes(ctx context.Context, cluster *clusterv1.Cluster) error {
 	log := ctrl.LoggerFrom(ctx)
 
+	if r.DisableNodeWatch {
+		return nil
+	}
+
 	if !conditions.IsTrue(cluster, clusterv1.ClusterControlPlaneInitializedCondition) {
 		log.V(5).Info("Skipping node watching setup because control plane is not initialized")
 		return nil
@@ -1148,62 +1173,26 @@ func (r *Reconciler) nodeToMachine(ctx context.Context, o client.Object) []recon
 		panic(fmt.Sprintf("Expected a Node but got a %T", o))
 	}
 
-	var filters []client.ListOption
-	// Match by clusterName when the node has the annotation.
-	if clusterName, ok := node.GetAnnotations()[clusterv1.ClusterNameAnnotation]; ok {
-		filters = append(filters, client.MatchingLabels{
-			clusterv1.ClusterNameLabel: clusterName,
-		})
-	}
-
-	// Match by namespace when the node has the annotation.
-	if namespace, ok := node.GetAnnotations()[clusterv1.ClusterNamespaceAnnotation]; ok {
-		filters = append(filters, client.InNamespace(namespace))
-	}
-
-	// Match by nodeName and status.nodeRef.name.
-	machineList := &clusterv1.MachineList{}
-	if err := r.Client.List(
-		ctx,
-		machineList,
-		append(filters, client.MatchingFields{index.MachineNodeNameField: node.Name})...); err != nil {
+	machine, err := index.GetMachineForNode(ctx, r.Client, node)
+	if machine == nil || err != nil {
 		return nil
 	}
-
-	// There should be exactly 1 Machine for the node.
-	if len(machineList.Items) == 1 {
-		return []reconcile.Request{{NamespacedName: util.ObjectKey(&machineList.Items[0])}}
-	}
-
-	// Otherwise let's match by providerID. This is useful when e.g the NodeRef has not been set yet.
-	// Match by providerID
-	if node.Spec.ProviderID == "" {
-		return nil
-	}
-	machineList = &clusterv1.MachineList{}
-	if err := r.Client.List(
-		ctx,
-		machineList,
-		append(filters, client.MatchingFields{index.MachineProviderIDField: node.Spec.ProviderID})...); err != nil {
-		return nil
-	}
-
-	// There should be exactly 1 Machine for the node.
-	if len(machineList.Items) == 1 {
-		return []reconcile.Request{{NamespacedName: util.ObjectKey(&machineList.Items[0])}}
-	}
-
-	return nil
+	return []reconcile.Request{{NamespacedName: util.ObjectKey(machine)}}
 }
 
 // getAttachedVolumeInformation returns information about volumes attached to the node:
 // * VolumesAttached names from node.Status.VolumesAttached.
 // * PersistentVolume names from VolumeAttachments with status.Attached set to true.
-func getAttachedVolumeInformation(ctx context.Context, remoteClient client.Client, node *corev1.Node) (sets.Set[string], sets.Set[string], error) {
+// Volumes of excluded CSI drivers are skipped.
+func getAttachedVolumeInformation(ctx context.Context, remoteClient client.Client, node *corev1.Node, exclusions clusterv1.MachineNodeVolumeDetachExclusions) (sets.Set[string], sets.Set[string], error) {
 	attachedVolumeName := sets.Set[string]{}
 	attachedPVNames := sets.Set[string]{}
 
 	for _, attachedVolume := range node.Status.VolumesAttached {
+		// Names of volumes attached by CSI drivers have the format kubernetes.io/csi/<driver>^<volume handle>.
+		if driver, _, ok := strings.Cut(strings.TrimPrefix(string(attachedVolume.Name), "kubernetes.io/csi/"), "^"); ok && slices.Contains(exclusions.CSIDrivers, driver) {
+			continue
+		}
 		attachedVolumeName.Insert(string(attachedVolume.Name))
 	}
 
@@ -1214,6 +1203,10 @@ func getAttachedVolumeInformation(ctx context.Context, remoteClient client.Clien
 		}
 
 		for _, va := range volumeAttachments {
+			// Skip VolumeAttachments of excluded CSI drivers.
+			if slices.Contains(exclusions.CSIDrivers, va.Spec.Attacher) {
+				continue
+			}
 			// Return an error if a VolumeAttachments does not refer a PersistentVolume.
 			if va.Spec.Source.PersistentVolumeName == nil {
 				return nil, nil, errors.Errorf("spec.source.persistentVolumeName for VolumeAttachment %s is not set", va.GetName())
@@ -1368,8 +1361,9 @@ func (a *attachedVolumeInformation) conditionMessage(machine *clusterv1.Machine)
 
 // getPersistentVolumesWaitingForDetach returns information about attached volumes
 // that correspond either to attachedVolumeNames or attachedPVNames.
-// Volumes which refer a PersistentVolumeClaim contained in pvcsToIgnore are filtered out.
-func getPersistentVolumesWaitingForDetach(ctx context.Context, c client.Client, attachedNodeVolumeNames, attachedPVNames, pvcsToIgnore sets.Set[string]) (*attachedVolumeInformation, error) {
+// Volumes which refer a PersistentVolumeClaim contained in pvcsToIgnore are filtered out, as well as
+// volumes of excluded StorageClasses or CSI drivers.
+func getPersistentVolumesWaitingForDetach(ctx context.Context, c client.Client, attachedNodeVolumeNames, attachedPVNames, pvcsToIgnore sets.Set[string], exclusions clusterv1.MachineNodeVolumeDetachExclusions) (*attachedVolumeInformation, error) {
 	attachedPVCs := sets.Set[string]{}
 	attachedPVsWithoutPVCClaimRef := []string{}
 	foundAttachedNodeVolumeNames := sets.Set[string]{}
@@ -1411,6 +1405,12 @@ func getPersistentVolumesWaitingForDetach(ctx context.Context, c client.Client,
 				continue
 			}
 
+			// PersistentVolumes of excluded StorageClasses or CSI drivers are not waited for.
+			if slices.Contains(exclusions.StorageClassNames, persistentVolume.Spec.StorageClassName) ||
+				(persistentVolume.Spec.CSI != nil && slices.Contains(exclusions.CSIDrivers, persistentVolume.Spec.CSI.Driver)) {
+				continue
+			}
+
 			// The ClaimRef should only be nil for unbound volumes and these should not be able to be attached.
 			// Also we're unable to map references which are not of Kind PersistentVolumeClaim so we record the PersistentVolume instead.
 			if persistentVolume.Spec.ClaimRef == nil || persistentVolume.Spec.ClaimRef.Kind != "PersistentVolumeClaim" {
@@ -0,0 +1,161 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/noderefutil"
+	"sigs.k8s.io/cluster-api/internal/controllers/machine/drain"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// reconcileCordon handles requests to cordon and drain the Machine's Node while keeping the Machine, e.g. to investigate
+// a failure, and surfaces the result in the Cordoned condition.
+// When the request is removed, the Machine's Node is uncordoned.
+// NOTE: Draining the Node while the Machine is deleted is handled by reconcileDelete.
+func (r *Reconciler) reconcileCordon(ctx context.Context, s *scope) (ctrl.Result, error) {
+	machine := s.machine
+
+	requestedAt, requested := machine.GetAnnotations()[clusterv1.MachineCordonRequestedAnnotation]
+	if !requested {
+		return r.reconcileUncordon(ctx, s)
+	}
+
+	if s.node == nil {
+		conditions.Set(machine, metav1.Condition{
+			Type:   clusterv1.MachineCordonedCondition,
+			Status: metav1.ConditionFalse,
+			Reason: clusterv1.MachineCordonedWaitingForNodeReason,
+		})
+		return ctrl.Result{}, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx, "Node", klog.KObj(s.node))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	remoteClient, err := r.ClusterCache.GetClient(ctx, util.ObjectKey(s.cluster))
+	if err != nil {
+		setCordonedInternalError(machine)
+		return ctrl.Result{}, errors.Wrapf(err, "failed to cordon Node %s", s.node.Name)
+	}
+
+	drainer := &drain.Helper{
+		Client:             r.Client,
+		RemoteClient:       remoteClient,
+		GracePeriodSeconds: -1,
+	}
+	if noderefutil.IsNodeUnreachable(s.node) {
+		// Kubelet is unreachable, pods will never disappear; see drainNode for more details.
+		drainer.SkipWaitForDeleteTimeoutSeconds = 1
+		drainer.GracePeriodSeconds = 1
+	}
+
+	if err := drainer.CordonNode(ctx, s.node); err != nil {
+		setCordonedInternalError(machine)
+		return ctrl.Result{}, errors.Wrapf(err, "failed to cordon Node %s", s.node.Name)
+	}
+
+	podDeleteList, err := drainer.GetPodsForEviction(ctx, s.cluster, machine, s.node.Name)
+	if err != nil {
+		setCordonedInternalError(machine)
+		return ctrl.Result{}, err
+	}
+
+	if len(podDeleteList.Pods()) > 0 {
+		evictionResult := drainer.EvictPods(ctx, podDeleteList)
+		if !evictionResult.DrainCompleted() {
+			// Note: The drain start time is approximated with the time of the request; if the annotation has an
+			// invalid value, the time of the last transition of the Cordoned condition is used.
+			drainStartTime := metav1.Now()
+			if t, err := time.Parse(time.RFC3339, requestedAt); err == nil {
+				drainStartTime = metav1.NewTime(t)
+			} else if c := conditions.Get(machine, clusterv1.MachineCordonedCondition); c != nil {
+				drainStartTime = c.LastTransitionTime
+			}
+
+			conditions.Set(machine, metav1.Condition{
+				Type:    clusterv1.MachineCordonedCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  clusterv1.MachineCordonedDrainingNodeReason,
+				Message: evictionResult.ConditionMessage(drainStartTime),
+			})
+			log.Info(fmt.Sprintf("Drain of cordoned Node not completed yet, requeuing in %s", drainRetryInterval))
+			return ctrl.Result{RequeueAfter: drainRetryInterval}, nil
+		}
+	}
+
+	if conditions.GetReason(machine, clusterv1.MachineCordonedCondition) != clusterv1.MachineCordonedReason {
+		log.Info("Node cordoned and drained, keeping Machine for investigation")
+	}
+	conditions.Set(machine, metav1.Condition{
+		Type:    clusterv1.MachineCordonedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  clusterv1.MachineCordonedReason,
+		Message: fmt.Sprintf("Node %s has been cordoned and drained as requested by the %s annotation", s.node.Name, clusterv1.MachineCordonRequestedAnnotation),
+	})
+	return ctrl.Result{}, nil
+}
+
+// reconcileUncordon uncordons the Machine's Node if it has been cordoned by reconcileCordon.
+func (r *Reconciler) reconcileUncordon(ctx context.Context, s *scope) (ctrl.Result, error) {
+	machine := s.machine
+
+	// Note: The Cordoned condition is used to track that the Node has been cordoned by reconcileCordon,
+	// so Nodes cordoned for other reasons, e.g. by users, are not uncordoned.
+	if conditions.Get(machine, clusterv1.MachineCordonedCondition) == nil {
+		return ctrl.Result{}, nil
+	}
+
+	if s.node != nil {
+		log := ctrl.LoggerFrom(ctx, "Node", klog.KObj(s.node))
+		ctx = ctrl.LoggerInto(ctx, log)
+
+		remoteClient, err := r.ClusterCache.GetClient(ctx, util.ObjectKey(s.cluster))
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "failed to uncordon Node %s", s.node.Name)
+		}
+
+		drainer := &drain.Helper{
+			RemoteClient: remoteClient,
+		}
+		if err := drainer.UncordonNode(ctx, s.node); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "failed to uncordon Node %s", s.node.Name)
+		}
+	}
+
+	conditions.Delete(machine, clusterv1.MachineCordonedCondition)
+	return ctrl.Result{}, nil
+}
+
+func setCordonedInternalError(machine *clusterv1.Machine) {
+	conditions.Set(machine, metav1.Condition{
+		Type:    clusterv1.MachineCordonedCondition,
+		Status:  metav1.ConditionUnknown,
+		Reason:  clusterv1.MachineCordonedInternalErrorReason,
+		Message: "Please check controller logs for errors",
+	})
+}
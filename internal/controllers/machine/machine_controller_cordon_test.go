@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileCordon(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "cluster1"}}
+	requested := map[string]string{clusterv1.MachineCordonRequestedAnnotation: time.Now().UTC().Format(time.RFC3339)}
+	cordoned := []metav1.Condition{{Type: clusterv1.MachineCordonedCondition, Status: metav1.ConditionTrue, Reason: clusterv1.MachineCordonedReason}}
+
+	tests := []struct {
+		name              string
+		annotations       map[string]string
+		conditions        []metav1.Condition
+		unschedulable     bool
+		noNode            bool
+		wantUnschedulable bool
+		wantNoCondition   bool
+		wantStatus        metav1.ConditionStatus
+		wantReason        string
+	}{
+		{
+			name:            "no-op if cordon is not requested",
+			wantNoCondition: true,
+		},
+		{
+			name:              "Nodes cordoned for other reasons are not uncordoned",
+			unschedulable:     true,
+			wantUnschedulable: true,
+			wantNoCondition:   true,
+		},
+		{
+			name:        "waiting for Node if the Machine does not have a Node yet",
+			annotations: requested,
+			noNode:      true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  clusterv1.MachineCordonedWaitingForNodeReason,
+		},
+		{
+			name:              "Node is cordoned and drained if cordon is requested",
+			annotations:       requested,
+			wantUnschedulable: true,
+			wantStatus:        metav1.ConditionTrue,
+			wantReason:        clusterv1.MachineCordonedReason,
+		},
+		{
+			name:            "Node is uncordoned once the cordon request is removed",
+			conditions:      cordoned,
+			unschedulable:   true,
+			wantNoCondition: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+				Spec:       corev1.NodeSpec{Unschedulable: tt.unschedulable},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(node).
+				WithIndex(&corev1.Pod{}, "spec.nodeName", podByNodeName).
+				Build()
+			r := &Reconciler{
+				Client:       c,
+				ClusterCache: clustercache.NewFakeClusterCache(c, client.ObjectKeyFromObject(cluster)),
+			}
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine1", Annotations: tt.annotations},
+				Spec:       clusterv1.MachineSpec{ClusterName: cluster.Name},
+				Status:     clusterv1.MachineStatus{Conditions: tt.conditions},
+			}
+			s := &scope{cluster: cluster, machine: machine, node: node.DeepCopy()}
+			if tt.noNode {
+				s.node = nil
+			}
+
+			_, err := r.reconcileCordon(t.Context(), s)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			gotNode := &corev1.Node{}
+			g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(node), gotNode)).To(Succeed())
+			g.Expect(gotNode.Spec.Unschedulable).To(Equal(tt.wantUnschedulable))
+
+			condition := conditions.Get(machine, clusterv1.MachineCordonedCondition)
+			if tt.wantNoCondition {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantStatus))
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+		})
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/certs"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+// kubeletServingCertificateRequeueAfter is the interval after which the kubelet serving certificate is verified again
+// when it is not issued yet or when it is invalid. CertificateSigningRequests in the workload cluster are not watched.
+const kubeletServingCertificateRequeueAfter = 20 * time.Second
+
+// reconcileKubeletServingCertificate verifies the serving certificate of the kubelet running on the Machine's Node,
+// if spec.kubeletServingCertificateVerification is Enabled, and surfaces the result in the KubeletServingCertificateVerified condition.
+// NOTE: The certificate is verified only once, i.e. until the condition is true for the first time; this is enough to
+// prevent rollouts from completing onto Nodes where the kubelet serving certificate bootstrap is broken.
+func (r *Reconciler) reconcileKubeletServingCertificate(ctx context.Context, s *scope) (ctrl.Result, error) {
+	machine := s.machine
+
+	if machine.Spec.KubeletServingCertificateVerification != clusterv1.MachineKubeletServingCertificateVerificationEnabled {
+		conditions.Delete(machine, clusterv1.MachineKubeletServingCertificateVerifiedCondition)
+		return ctrl.Result{}, nil
+	}
+
+	if conditions.IsTrue(machine, clusterv1.MachineKubeletServingCertificateVerifiedCondition) || !machine.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	if s.node == nil {
+		conditions.Set(machine, metav1.Condition{
+			Type:   clusterv1.MachineKubeletServingCertificateVerifiedCondition,
+			Status: metav1.ConditionFalse,
+			Reason: clusterv1.MachineKubeletServingCertificateWaitingForNodeReason,
+		})
+		return ctrl.Result{}, nil
+	}
+
+	caCert, err := r.getClusterCACertificate(ctx, s.cluster)
+	if err != nil {
+		setKubeletServingCertificateInternalError(machine)
+		return ctrl.Result{}, err
+	}
+
+	remoteClient, err := r.ClusterCache.GetUncachedClient(ctx, util.ObjectKey(s.cluster))
+	if err != nil {
+		setKubeletServingCertificateInternalError(machine)
+		return ctrl.Result{}, err
+	}
+
+	cert, err := getKubeletServingCertificate(ctx, remoteClient, s.node.Name)
+	if err != nil {
+		setKubeletServingCertificateInternalError(machine)
+		return ctrl.Result{}, err
+	}
+	if cert == nil {
+		conditions.Set(machine, metav1.Condition{
+			Type:    clusterv1.MachineKubeletServingCertificateVerifiedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.MachineKubeletServingCertificateNotIssuedReason,
+			Message: fmt.Sprintf("Waiting for an approved CertificateSigningRequest with signer %s for Node %s", certificatesv1.KubeletServingSignerName, s.node.Name),
+		})
+		return ctrl.Result{RequeueAfter: kubeletServingCertificateRequeueAfter}, nil
+	}
+
+	if err := verifyKubeletServingCertificate(cert, caCert, s.node.Name, time.Now()); err != nil {
+		conditions.Set(machine, metav1.Condition{
+			Type:    clusterv1.MachineKubeletServingCertificateVerifiedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.MachineKubeletServingCertificateInvalidReason,
+			Message: fmt.Sprintf("Kubelet serving certificate for Node %s is invalid: %v", s.node.Name, err),
+		})
+		return ctrl.Result{RequeueAfter: kubeletServingCertificateRequeueAfter}, nil
+	}
+
+	conditions.Set(machine, metav1.Condition{
+		Type:   clusterv1.MachineKubeletServingCertificateVerifiedCondition,
+		Status: metav1.ConditionTrue,
+		Reason: clusterv1.MachineKubeletServingCertificateVerifiedReason,
+	})
+	return ctrl.Result{}, nil
+}
+
+func setKubeletServingCertificateInternalError(machine *clusterv1.Machine) {
+	conditions.Set(machine, metav1.Condition{
+		Type:    clusterv1.MachineKubeletServingCertificateVerifiedCondition,
+		Status:  metav1.ConditionUnknown,
+		Reason:  clusterv1.MachineKubeletServingCertificateInternalErrorReason,
+		Message: "Please check controller logs for errors",
+	})
+}
+
+// getClusterCACertificate returns the cluster CA certificate stored in the management cluster.
+func (r *Reconciler) getClusterCACertificate(ctx context.Context, cluster *clusterv1.Cluster) (*x509.Certificate, error) {
+	caSecret, err := secret.Get(ctx, r.Client, util.ObjectKey(cluster), secret.ClusterCA)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get cluster CA Secret for Cluster %s", cluster.Name)
+	}
+	caCert, err := certs.DecodeCertPEM(caSecret.Data[secret.TLSCrtDataName])
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode cluster CA certificate for Cluster %s", cluster.Name)
+	}
+	if caCert == nil {
+		return nil, errors.Errorf("failed to decode cluster CA certificate for Cluster %s: no certificate found", cluster.Name)
+	}
+	return caCert, nil
+}
+
+// getKubeletServingCertificate returns the certificate issued by the most recent approved kubelet serving
+// CertificateSigningRequest of a Node, or nil if there is none.
+func getKubeletServingCertificate(ctx context.Context, c client.Reader, nodeName string) (*x509.Certificate, error) {
+	csrList := &certificatesv1.CertificateSigningRequestList{}
+	if err := c.List(ctx, csrList); err != nil {
+		return nil, errors.Wrapf(err, "failed to list CertificateSigningRequests for Node %s", nodeName)
+	}
+
+	var latest *certificatesv1.CertificateSigningRequest
+	for i := range csrList.Items {
+		csr := &csrList.Items[i]
+		if csr.Spec.SignerName != certificatesv1.KubeletServingSignerName ||
+			csr.Spec.Username != kubeletUserName(nodeName) ||
+			len(csr.Status.Certificate) == 0 ||
+			!isCertificateSigningRequestApproved(csr) {
+			continue
+		}
+		if latest == nil || latest.CreationTimestamp.Before(&csr.CreationTimestamp) {
+			latest = csr
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+
+	cert, err := certs.DecodeCertPEM(latest.Status.Certificate)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode certificate of CertificateSigningRequest %s", latest.Name)
+	}
+	if cert == nil {
+		return nil, errors.Errorf("failed to decode certificate of CertificateSigningRequest %s: no certificate found", latest.Name)
+	}
+	return cert, nil
+}
+
+func isCertificateSigningRequestApproved(csr *certificatesv1.CertificateSigningRequest) bool {
+	approved := false
+	for _, c := range csr.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case certificatesv1.CertificateDenied, certificatesv1.CertificateFailed:
+			return false
+		case certificatesv1.CertificateApproved:
+			approved = true
+		}
+	}
+	return approved
+}
+
+// verifyKubeletServingCertificate checks that the kubelet serving certificate is issued to the Node,
+// it is signed by the cluster CA and it is valid at the given time.
+func verifyKubeletServingCertificate(cert, caCert *x509.Certificate, nodeName string, now time.Time) error {
+	if cert.Subject.CommonName != kubeletUserName(nodeName) {
+		return errors.Errorf("certificate is issued to %q instead of %q", cert.Subject.CommonName, kubeletUserName(nodeName))
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:       roots,
+		CurrentTime: now,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func kubeletUserName(nodeName string) string {
+	return fmt.Sprintf("system:node:%s", nodeName)
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
+	"sigs.k8s.io/cluster-api/util/certs"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+func TestReconcileKubeletServingCertificate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+
+	caCert, caKey := newTestCA(t, "cluster-ca")
+	otherCACert, otherCAKey := newTestCA(t, "other-ca")
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "cluster1"}}
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: secret.Name(cluster.Name, secret.ClusterCA)},
+		Data:       map[string][]byte{secret.TLSCrtDataName: certs.EncodeCertPEM(caCert)},
+	}
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+
+	newCSR := func(name string, cert *x509.Certificate, approved bool) *certificatesv1.CertificateSigningRequest {
+		csr := &certificatesv1.CertificateSigningRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: certificatesv1.CertificateSigningRequestSpec{
+				SignerName: certificatesv1.KubeletServingSignerName,
+				Username:   "system:node:node1",
+			},
+			Status: certificatesv1.CertificateSigningRequestStatus{
+				Certificate: certs.EncodeCertPEM(cert),
+			},
+		}
+		if approved {
+			csr.Status.Conditions = []certificatesv1.CertificateSigningRequestCondition{
+				{Type: certificatesv1.CertificateApproved, Status: corev1.ConditionTrue},
+			}
+		}
+		return csr
+	}
+
+	tests := []struct {
+		name            string
+		verification    clusterv1.MachineKubeletServingCertificateVerification
+		conditions      []metav1.Condition
+		node            *corev1.Node
+		objs            []client.Object
+		wantErr         bool
+		wantRequeue     bool
+		wantNoCondition bool
+		wantStatus      metav1.ConditionStatus
+		wantReason      string
+		wantMessage     string
+	}{
+		{
+			name:            "no condition if verification is not enabled",
+			conditions:      []metav1.Condition{{Type: clusterv1.MachineKubeletServingCertificateVerifiedCondition, Status: metav1.ConditionFalse, Reason: clusterv1.MachineKubeletServingCertificateNotIssuedReason}},
+			node:            node,
+			wantNoCondition: true,
+		},
+		{
+			name:         "waiting for Node",
+			verification: clusterv1.MachineKubeletServingCertificateVerificationEnabled,
+			wantStatus:   metav1.ConditionFalse,
+			wantReason:   clusterv1.MachineKubeletServingCertificateWaitingForNodeReason,
+		},
+		{
+			name:         "internal error if the cluster CA does not exist",
+			verification: clusterv1.MachineKubeletServingCertificateVerificationEnabled,
+			node:         node,
+			wantErr:      true,
+			wantStatus:   metav1.ConditionUnknown,
+			wantReason:   clusterv1.MachineKubeletServingCertificateInternalErrorReason,
+		},
+		{
+			name:         "not issued if there are no approved CertificateSigningRequests for the Node",
+			verification: clusterv1.MachineKubeletServingCertificateVerificationEnabled,
+			node:         node,
+			objs: []client.Object{
+				caSecret,
+				newCSR("csr1", newTestKubeletServingCert(t, "system:node:node1", caCert, caKey, time.Hour), false),
+			},
+			wantRequeue: true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  clusterv1.MachineKubeletServingCertificateNotIssuedReason,
+			wantMessage: "Waiting for an approved CertificateSigningRequest with signer kubernetes.io/kubelet-serving for Node node1",
+		},
+		{
+			name:         "invalid if the certificate is not issued by the cluster CA",
+			verification: clusterv1.MachineKubeletServingCertificateVerificationEnabled,
+			node:         node,
+			objs: []client.Object{
+				caSecret,
+				newCSR("csr1", newTestKubeletServingCert(t, "system:node:node1", otherCACert, otherCAKey, time.Hour), true),
+			},
+			wantRequeue: true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  clusterv1.MachineKubeletServingCertificateInvalidReason,
+			wantMessage: "certificate signed by unknown authority",
+		},
+		{
+			name:         "invalid if the certificate is expired",
+			verification: clusterv1.MachineKubeletServingCertificateVerificationEnabled,
+			node:         node,
+			objs: []client.Object{
+				caSecret,
+				newCSR("csr1", newTestKubeletServingCert(t, "system:node:node1", caCert, caKey, -time.Hour), true),
+			},
+			wantRequeue: true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  clusterv1.MachineKubeletServingCertificateInvalidReason,
+			wantMessage: "certificate has expired",
+		},
+		{
+			name:         "invalid if the certificate is issued to another Node",
+			verification: clusterv1.MachineKubeletServingCertificateVerificationEnabled,
+			node:         node,
+			objs: []client.Object{
+				caSecret,
+				newCSR("csr1", newTestKubeletServingCert(t, "system:node:node2", caCert, caKey, time.Hour), true),
+			},
+			wantRequeue: true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  clusterv1.MachineKubeletServingCertificateInvalidReason,
+			wantMessage: `certificate is issued to "system:node:node2" instead of "system:node:node1"`,
+		},
+		{
+			name:         "verified if the certificate is issued by the cluster CA and it is not expired",
+			verification: clusterv1.MachineKubeletServingCertificateVerificationEnabled,
+			node:         node,
+			objs: []client.Object{
+				caSecret,
+				newCSR("csr1", newTestKubeletServingCert(t, "system:node:node1", caCert, caKey, time.Hour), true),
+			},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: clusterv1.MachineKubeletServingCertificateVerifiedReason,
+		},
+		{
+			name:         "certificate is not verified again after it has been verified",
+			verification: clusterv1.MachineKubeletServingCertificateVerificationEnabled,
+			conditions:   []metav1.Condition{{Type: clusterv1.MachineKubeletServingCertificateVerifiedCondition, Status: metav1.ConditionTrue, Reason: clusterv1.MachineKubeletServingCertificateVerifiedReason}},
+			node:         node,
+			wantStatus:   metav1.ConditionTrue,
+			wantReason:   clusterv1.MachineKubeletServingCertificateVerifiedReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objs...).Build()
+			r := &Reconciler{
+				Client:       c,
+				ClusterCache: clustercache.NewFakeClusterCache(c, client.ObjectKeyFromObject(cluster)),
+			}
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine1"},
+				Spec: clusterv1.MachineSpec{
+					ClusterName:                           cluster.Name,
+					KubeletServingCertificateVerification: tt.verification,
+				},
+				Status: clusterv1.MachineStatus{Conditions: tt.conditions},
+			}
+			s := &scope{cluster: cluster, machine: machine, node: tt.node}
+
+			res, err := r.reconcileKubeletServingCertificate(t.Context(), s)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(res.RequeueAfter > 0).To(Equal(tt.wantRequeue))
+
+			condition := conditions.Get(machine, clusterv1.MachineKubeletServingCertificateVerifiedCondition)
+			if tt.wantNoCondition {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantStatus))
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+			if tt.wantMessage != "" {
+				g.Expect(condition.Message).To(ContainSubstring(tt.wantMessage))
+			}
+		})
+	}
+}
+
+func newTestCA(t *testing.T, commonName string) (*x509.Certificate, *rsa.PrivateKey) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-24 * time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	b, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func newTestKubeletServingCert(t *testing.T, commonName string, caCert *x509.Certificate, caKey *rsa.PrivateKey, validFor time.Duration) *x509.Certificate {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-2 * time.Hour),
+		NotAfter:     time.Now().Add(validFor),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	b, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, key.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
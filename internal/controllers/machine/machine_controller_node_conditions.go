@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// patchNodeConditions mirrors the Machine conditions selected by SyncMachineConditionsToNode as conditions of the Node,
+// so in-cluster tooling can react to the state of the Machine, e.g. to a Machine being remediated or deleted.
+// Node conditions mirroring Machine conditions which are not selected anymore, or not set on the Machine, are removed.
+// NOTE: Machine conditions are mirrored as of the beginning of the reconcile; changes to Machine conditions trigger
+// another reconcile, which mirrors them.
+func (r *Reconciler) patchNodeConditions(ctx context.Context, remoteClient client.Client, node *corev1.Node, m *clusterv1.Machine) error {
+	nodeConditions, changed := computeNodeConditions(node.Status.Conditions, m, r.SyncMachineConditionsToNode)
+	if !changed {
+		return nil
+	}
+
+	newNode := node.DeepCopy()
+	newNode.Status.Conditions = nodeConditions
+
+	// NOTE: Optimistic locking is not used, because the kubelet updates Node conditions frequently; the strategic
+	// merge patch only touches the conditions mirrored from the Machine, given that conditions are merged by type.
+	if err := remoteClient.Status().Patch(ctx, newNode, client.StrategicMergeFrom(node)); err != nil {
+		return errors.Wrapf(err, "failed to patch conditions of Node %s", klog.KObj(node))
+	}
+	return nil
+}
+
+// computeNodeConditions returns the Node conditions with the Machine conditions of the given types mirrored as
+// Node conditions with the NodeConditionFromMachinePrefix, and true if the Node conditions have been changed.
+// NOTE: The heartbeat time of the Node conditions is set to the last transition time of the Machine conditions, so
+// Node conditions are only changed when the corresponding Machine conditions change.
+func computeNodeConditions(current []corev1.NodeCondition, m *clusterv1.Machine, conditionTypes []string) ([]corev1.NodeCondition, bool) {
+	desired := map[corev1.NodeConditionType]corev1.NodeCondition{}
+	for _, conditionType := range conditionTypes {
+		machineCondition := conditions.Get(m, conditionType)
+		if machineCondition == nil {
+			continue
+		}
+		nodeConditionType := corev1.NodeConditionType(clusterv1.NodeConditionFromMachinePrefix + conditionType)
+		desired[nodeConditionType] = corev1.NodeCondition{
+			Type:               nodeConditionType,
+			Status:             corev1.ConditionStatus(machineCondition.Status),
+			LastHeartbeatTime:  machineCondition.LastTransitionTime,
+			LastTransitionTime: machineCondition.LastTransitionTime,
+			Reason:             machineCondition.Reason,
+			Message:            machineCondition.Message,
+		}
+	}
+
+	changed := false
+	nodeConditions := []corev1.NodeCondition{}
+	for _, c := range current {
+		if !strings.HasPrefix(string(c.Type), clusterv1.NodeConditionFromMachinePrefix) {
+			nodeConditions = append(nodeConditions, c)
+			continue
+		}
+		d, ok := desired[c.Type]
+		if !ok {
+			// Drop Node conditions which are not mirroring a Machine condition anymore.
+			changed = true
+			continue
+		}
+		if !nodeConditionEqual(c, d) {
+			changed = true
+		}
+		nodeConditions = append(nodeConditions, d)
+		delete(desired, c.Type)
+	}
+
+	// Add Node conditions not yet set, preserving the order of conditionTypes.
+	for _, conditionType := range conditionTypes {
+		d, ok := desired[corev1.NodeConditionType(clusterv1.NodeConditionFromMachinePrefix+conditionType)]
+		if !ok {
+			continue
+		}
+		nodeConditions = append(nodeConditions, d)
+		delete(desired, d.Type)
+		changed = true
+	}
+	return nodeConditions, changed
+}
+
+// nodeConditionEqual returns true if two Node conditions are equal.
+func nodeConditionEqual(a, b corev1.NodeCondition) bool {
+	return a.Type == b.Type &&
+		a.Status == b.Status &&
+		a.LastHeartbeatTime.Equal(&b.LastHeartbeatTime) &&
+		a.LastTransitionTime.Equal(&b.LastTransitionTime) &&
+		a.Reason == b.Reason &&
+		a.Message == b.Message
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestComputeNodeConditions(t *testing.T) {
+	transitionTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	heartbeatTime := metav1.NewTime(time.Now().Truncate(time.Second))
+
+	machine := &clusterv1.Machine{
+		Status: clusterv1.MachineStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:               clusterv1.MachineUpToDateCondition,
+					Status:             metav1.ConditionFalse,
+					Reason:             clusterv1.MachineNotUpToDateReason,
+					Message:            "* Version v1.31.0, v1.32.0 required",
+					LastTransitionTime: transitionTime,
+				},
+				{
+					Type:               clusterv1.MachineDeletingCondition,
+					Status:             metav1.ConditionFalse,
+					Reason:             clusterv1.MachineNotDeletingReason,
+					LastTransitionTime: transitionTime,
+				},
+			},
+		},
+	}
+
+	kubeletReady := corev1.NodeCondition{
+		Type:              corev1.NodeReady,
+		Status:            corev1.ConditionTrue,
+		LastHeartbeatTime: heartbeatTime,
+		Reason:            "KubeletReady",
+	}
+	nodeUpToDate := corev1.NodeCondition{
+		Type:               "cluster.x-k8s.io/UpToDate",
+		Status:             corev1.ConditionFalse,
+		LastHeartbeatTime:  transitionTime,
+		LastTransitionTime: transitionTime,
+		Reason:             clusterv1.MachineNotUpToDateReason,
+		Message:            "* Version v1.31.0, v1.32.0 required",
+	}
+	nodeDeleting := corev1.NodeCondition{
+		Type:               "cluster.x-k8s.io/Deleting",
+		Status:             corev1.ConditionFalse,
+		LastHeartbeatTime:  transitionTime,
+		LastTransitionTime: transitionTime,
+		Reason:             clusterv1.MachineNotDeletingReason,
+	}
+
+	tests := []struct {
+		name            string
+		current         []corev1.NodeCondition
+		conditionTypes  []string
+		expected        []corev1.NodeCondition
+		expectedChanged bool
+	}{
+		{
+			name:            "no changes if no conditions are mirrored",
+			current:         []corev1.NodeCondition{kubeletReady},
+			conditionTypes:  nil,
+			expected:        []corev1.NodeCondition{kubeletReady},
+			expectedChanged: false,
+		},
+		{
+			name:            "add mirrored conditions",
+			current:         []corev1.NodeCondition{kubeletReady},
+			conditionTypes:  []string{clusterv1.MachineUpToDateCondition, clusterv1.MachineDeletingCondition},
+			expected:        []corev1.NodeCondition{kubeletReady, nodeUpToDate, nodeDeleting},
+			expectedChanged: true,
+		},
+		{
+			name:            "no changes if mirrored conditions are up to date",
+			current:         []corev1.NodeCondition{kubeletReady, nodeUpToDate, nodeDeleting},
+			conditionTypes:  []string{clusterv1.MachineUpToDateCondition, clusterv1.MachineDeletingCondition},
+			expected:        []corev1.NodeCondition{kubeletReady, nodeUpToDate, nodeDeleting},
+			expectedChanged: false,
+		},
+		{
+			name: "update mirrored conditions",
+			current: []corev1.NodeCondition{kubeletReady, func() corev1.NodeCondition {
+				c := nodeUpToDate.DeepCopy()
+				c.Status = corev1.ConditionTrue
+				c.Reason = clusterv1.MachineUpToDateReason
+				c.Message = ""
+				return *c
+			}()},
+			conditionTypes:  []string{clusterv1.MachineUpToDateCondition},
+			expected:        []corev1.NodeCondition{kubeletReady, nodeUpToDate},
+			expectedChanged: true,
+		},
+		{
+			name:            "remove conditions which are not mirrored anymore",
+			current:         []corev1.NodeCondition{kubeletReady, nodeUpToDate, nodeDeleting},
+			conditionTypes:  []string{clusterv1.MachineDeletingCondition},
+			expected:        []corev1.NodeCondition{kubeletReady, nodeDeleting},
+			expectedChanged: true,
+		},
+		{
+			name:            "skip conditions not set on the Machine",
+			current:         []corev1.NodeCondition{kubeletReady},
+			conditionTypes:  []string{clusterv1.MachineOwnerRemediatedCondition},
+			expected:        []corev1.NodeCondition{kubeletReady},
+			expectedChanged: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, changed := computeNodeConditions(tt.current, machine, tt.conditionTypes)
+			g.Expect(changed).To(Equal(tt.expectedChanged))
+			g.Expect(got).To(Equal(tt.expected))
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// nodeReadinessChecksRequeueAfter is the interval after which the node readiness checks are performed again
+// when they do not succeed yet. DaemonSets and Pods in the workload cluster are not watched.
+const nodeReadinessChecksRequeueAfter = 15 * time.Second
+
+// reconcileNodeReadinessChecks checks that the DaemonSets selected by spec.nodeReadinessChecks have a ready Pod
+// on the Machine's Node, and surfaces the result in the NodeReadinessChecksSucceeded condition.
+// NOTE: The checks are performed only once, i.e. until the condition is true for the first time; this is enough to
+// prevent rollouts from proceeding before the DaemonSets are running on the new Machines, without making
+// Machines unready when e.g. a DaemonSet is updated later on.
+func (r *Reconciler) reconcileNodeReadinessChecks(ctx context.Context, s *scope) (ctrl.Result, error) {
+	machine := s.machine
+
+	if len(machine.Spec.NodeReadinessChecks) == 0 {
+		conditions.Delete(machine, clusterv1.MachineNodeReadinessChecksSucceededCondition)
+		return ctrl.Result{}, nil
+	}
+
+	if conditions.IsTrue(machine, clusterv1.MachineNodeReadinessChecksSucceededCondition) || !machine.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	if s.node == nil {
+		conditions.Set(machine, metav1.Condition{
+			Type:   clusterv1.MachineNodeReadinessChecksSucceededCondition,
+			Status: metav1.ConditionFalse,
+			Reason: clusterv1.MachineNodeReadinessChecksWaitingForNodeReason,
+		})
+		return ctrl.Result{}, nil
+	}
+
+	remoteClient, err := r.ClusterCache.GetUncachedClient(ctx, util.ObjectKey(s.cluster))
+	if err != nil {
+		setNodeReadinessChecksInternalError(machine)
+		return ctrl.Result{}, err
+	}
+
+	pending, err := getPendingNodeReadinessChecks(ctx, remoteClient, machine.Spec.NodeReadinessChecks, s.node)
+	if err != nil {
+		setNodeReadinessChecksInternalError(machine)
+		return ctrl.Result{}, err
+	}
+	if len(pending) > 0 {
+		conditions.Set(machine, metav1.Condition{
+			Type:    clusterv1.MachineNodeReadinessChecksSucceededCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.MachineNodeReadinessChecksPendingReason,
+			Message: strings.Join(pending, "\n"),
+		})
+		return ctrl.Result{RequeueAfter: nodeReadinessChecksRequeueAfter}, nil
+	}
+
+	conditions.Set(machine, metav1.Condition{
+		Type:   clusterv1.MachineNodeReadinessChecksSucceededCondition,
+		Status: metav1.ConditionTrue,
+		Reason: clusterv1.MachineNodeReadinessChecksSucceededReason,
+	})
+	return ctrl.Result{}, nil
+}
+
+func setNodeReadinessChecksInternalError(machine *clusterv1.Machine) {
+	conditions.Set(machine, metav1.Condition{
+		Type:    clusterv1.MachineNodeReadinessChecksSucceededCondition,
+		Status:  metav1.ConditionUnknown,
+		Reason:  clusterv1.MachineNodeReadinessChecksInternalErrorReason,
+		Message: "Please check controller logs for errors",
+	})
+}
+
+// getPendingNodeReadinessChecks returns a message for each check which does not succeed yet on the given Node.
+func getPendingNodeReadinessChecks(ctx context.Context, c client.Reader, checks []clusterv1.MachineNodeReadinessCheck, node *corev1.Node) ([]string, error) {
+	podList := &corev1.PodList{}
+	if err := c.List(ctx, podList, client.MatchingFields{"spec.nodeName": node.Name}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list Pods on Node %s", node.Name)
+	}
+	readyPodOwners := map[string]bool{}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if !pod.DeletionTimestamp.IsZero() || !isPodReady(pod) {
+			continue
+		}
+		if owner := metav1.GetControllerOf(pod); owner != nil && owner.Kind == "DaemonSet" {
+			readyPodOwners[string(owner.UID)] = true
+		}
+	}
+
+	var pending []string
+	for _, check := range checks {
+		selector, err := metav1.LabelSelectorAsSelector(&check.Selector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert selector of node readiness check %s", check.Name)
+		}
+
+		dsList := &appsv1.DaemonSetList{}
+		listOpts := []client.ListOption{client.MatchingLabelsSelector{Selector: selector}}
+		if check.Namespace != "" {
+			listOpts = append(listOpts, client.InNamespace(check.Namespace))
+		}
+		if err := c.List(ctx, dsList, listOpts...); err != nil {
+			return nil, errors.Wrapf(err, "failed to list DaemonSets for node readiness check %s", check.Name)
+		}
+		if len(dsList.Items) == 0 {
+			pending = append(pending, fmt.Sprintf("* %s: waiting for DaemonSets matching the selector to exist", check.Name))
+			continue
+		}
+
+		var notReady []string
+		for i := range dsList.Items {
+			ds := &dsList.Items[i]
+			if !labels.SelectorFromSet(ds.Spec.Template.Spec.NodeSelector).Matches(labels.Set(node.Labels)) {
+				continue
+			}
+			if !readyPodOwners[string(ds.UID)] {
+				notReady = append(notReady, ds.Namespace+"/"+ds.Name)
+			}
+		}
+		if len(notReady) > 0 {
+			sort.Strings(notReady)
+			pending = append(pending, fmt.Sprintf("* %s: waiting for Pods of DaemonSets %s to be ready on Node %s", check.Name, strings.Join(notReady, ", "), node.Name))
+		}
+	}
+	return pending, nil
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileNodeReadinessChecks(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "cluster1"}}
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{"gpu": "true"}}}
+
+	checks := []clusterv1.MachineNodeReadinessCheck{
+		{Name: "gpu", Namespace: "gpu-operator", Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "gpu-driver"}}},
+	}
+	newDaemonSet := func(name string, nodeSelector map[string]string) *appsv1.DaemonSet {
+		return &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "gpu-operator", Name: name, UID: types.UID(name), Labels: map[string]string{"app": "gpu-driver"}},
+			Spec: appsv1.DaemonSetSpec{
+				Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{NodeSelector: nodeSelector}},
+			},
+		}
+	}
+	newPod := func(name string, ds *appsv1.DaemonSet, nodeName string, ready bool) *corev1.Pod {
+		status := corev1.ConditionFalse
+		if ready {
+			status = corev1.ConditionTrue
+		}
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ds.Namespace,
+				Name:      name,
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "apps/v1", Kind: "DaemonSet", Name: ds.Name, UID: ds.UID, Controller: ptr.To(true)},
+				},
+			},
+			Spec: corev1.PodSpec{NodeName: nodeName},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+			},
+		}
+	}
+	driver := newDaemonSet("driver", nil)
+	otherDriver := newDaemonSet("other-driver", map[string]string{"gpu": "false"})
+
+	tests := []struct {
+		name            string
+		checks          []clusterv1.MachineNodeReadinessCheck
+		conditions      []metav1.Condition
+		node            *corev1.Node
+		objs            []client.Object
+		wantRequeue     bool
+		wantNoCondition bool
+		wantStatus      metav1.ConditionStatus
+		wantReason      string
+		wantMessage     string
+	}{
+		{
+			name:            "no condition if there are no checks",
+			conditions:      []metav1.Condition{{Type: clusterv1.MachineNodeReadinessChecksSucceededCondition, Status: metav1.ConditionFalse, Reason: clusterv1.MachineNodeReadinessChecksPendingReason}},
+			node:            node,
+			wantNoCondition: true,
+		},
+		{
+			name:       "waiting for Node",
+			checks:     checks,
+			wantStatus: metav1.ConditionFalse,
+			wantReason: clusterv1.MachineNodeReadinessChecksWaitingForNodeReason,
+		},
+		{
+			name:        "pending if there are no DaemonSets matching the selector",
+			checks:      checks,
+			node:        node,
+			wantRequeue: true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  clusterv1.MachineNodeReadinessChecksPendingReason,
+			wantMessage: "* gpu: waiting for DaemonSets matching the selector to exist",
+		},
+		{
+			name:        "pending if the Pod of a DaemonSet is not ready on the Node",
+			checks:      checks,
+			node:        node,
+			objs:        []client.Object{driver, newPod("driver-1", driver, "node1", false)},
+			wantRequeue: true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  clusterv1.MachineNodeReadinessChecksPendingReason,
+			wantMessage: "* gpu: waiting for Pods of DaemonSets gpu-operator/driver to be ready on Node node1",
+		},
+		{
+			name:        "pending if the Pod of a DaemonSet is ready on another Node",
+			checks:      checks,
+			node:        node,
+			objs:        []client.Object{driver, newPod("driver-1", driver, "node2", true)},
+			wantRequeue: true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  clusterv1.MachineNodeReadinessChecksPendingReason,
+			wantMessage: "* gpu: waiting for Pods of DaemonSets gpu-operator/driver to be ready on Node node1",
+		},
+		{
+			name:       "succeeded if the Pods of all the DaemonSets are ready on the Node, ignoring DaemonSets not targeting the Node",
+			checks:     checks,
+			node:       node,
+			objs:       []client.Object{driver, otherDriver, newPod("driver-1", driver, "node1", true)},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: clusterv1.MachineNodeReadinessChecksSucceededReason,
+		},
+		{
+			name:       "checks are not performed again after they succeeded",
+			checks:     checks,
+			conditions: []metav1.Condition{{Type: clusterv1.MachineNodeReadinessChecksSucceededCondition, Status: metav1.ConditionTrue, Reason: clusterv1.MachineNodeReadinessChecksSucceededReason}},
+			node:       node,
+			wantStatus: metav1.ConditionTrue,
+			wantReason: clusterv1.MachineNodeReadinessChecksSucceededReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objs...).
+				WithIndex(&corev1.Pod{}, "spec.nodeName", func(o client.Object) []string {
+					return []string{o.(*corev1.Pod).Spec.NodeName}
+				}).
+				Build()
+			r := &Reconciler{
+				Client:       c,
+				ClusterCache: clustercache.NewFakeClusterCache(c, client.ObjectKeyFromObject(cluster)),
+			}
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine1"},
+				Spec: clusterv1.MachineSpec{
+					ClusterName:         cluster.Name,
+					NodeReadinessChecks: tt.checks,
+				},
+				Status: clusterv1.MachineStatus{Conditions: tt.conditions},
+			}
+			s := &scope{cluster: cluster, machine: machine, node: tt.node}
+
+			res, err := r.reconcileNodeReadinessChecks(t.Context(), s)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res.RequeueAfter > 0).To(Equal(tt.wantRequeue))
+
+			condition := conditions.Get(machine, clusterv1.MachineNodeReadinessChecksSucceededCondition)
+			if tt.wantNoCondition {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantStatus))
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+			g.Expect(condition.Message).To(Equal(tt.wantMessage))
+		})
+	}
+}
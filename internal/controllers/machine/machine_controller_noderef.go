@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -43,6 +44,10 @@ import (
 	"sigs.k8s.io/cluster-api/util/labels"
 )
 
+// nodeProvisioningWait is the interval after which a Machine waiting for its Node is reconciled again
+// when Nodes in the workload cluster are not watched.
+var nodeProvisioningWait = 20 * time.Second
+
 var (
 	// ErrNodeNotFound signals that a corev1.Node could not be found for the given provider id.
 	ErrNodeNotFound = errors.New("cannot find node with matching ProviderID")
@@ -96,7 +101,10 @@ func (r *Reconciler) reconcileNode(ctx context.Context, s *scope) (ctrl.Result,
 			}
 			v1beta1conditions.MarkFalse(machine, clusterv1.MachineNodeHealthyV1Beta1Condition, clusterv1.NodeProvisioningV1Beta1Reason, clusterv1.ConditionSeverityWarning, "Waiting for a node with matching ProviderID to exist")
 			log.Info("Infrastructure provider reporting spec.providerID, matching Kubernetes Node is not yet available", machine.Spec.InfrastructureRef.Kind, klog.KRef(machine.Namespace, machine.Spec.InfrastructureRef.Name), "providerID", machine.Spec.ProviderID)
-			// No need to requeue here. Nodes emit an event that triggers reconciliation.
+			// No need to requeue here if Nodes are watched. Nodes emit an event that triggers reconciliation.
+			if r.DisableNodeWatch {
+				return ctrl.Result{RequeueAfter: nodeProvisioningWait}, nil
+			}
 			return ctrl.Result{}, nil
 		}
 		s.nodeGetError = err
@@ -146,6 +154,9 @@ func (r *Reconciler) reconcileNode(ctx context.Context, s *scope) (ctrl.Result,
 	if err := r.patchNode(ctx, remoteClient, s.node, nodeLabels, nodeAnnotations, machine, s.owningMachineSet, s.owningMachineDeployment); err != nil {
 		return ctrl.Result{}, errors.Wrapf(err, "failed to reconcile Node %s", klog.KObj(s.node))
 	}
+	if err := r.patchNodeConditions(ctx, remoteClient, s.node, machine); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to reconcile Node %s", klog.KObj(s.node))
+	}
 	if !nodeHadInterruptibleLabel && interruptible {
 		// If the interruptible label is added to the node then record the event.
 		// Nb. Only record the event if the node previously did not have the label to avoid recording
@@ -355,6 +366,13 @@ func (r *Reconciler) patchNode(ctx context.Context, remoteClient client.Client,
 		hasTaintChanges = taints.RemoveNodeTaint(newNode, clusterv1.NodeOutdatedRevisionTaint) || hasTaintChanges
 	}
 
+	// Set Taint to a node of a standby Machine and unset Taint once the Machine has been promoted.
+	if _, ok := m.Labels[clusterv1.MachineStandbyLabel]; ok {
+		hasTaintChanges = taints.EnsureNodeTaint(newNode, clusterv1.NodeStandbyTaint) || hasTaintChanges
+	} else {
+		hasTaintChanges = taints.RemoveNodeTaint(newNode, clusterv1.NodeStandbyTaint) || hasTaintChanges
+	}
+
 	if !hasAnnotationChanges && !hasLabelChanges && !hasTaintChanges && !propagateTaintsChanges {
 		return nil
 	}
@@ -1283,6 +1283,52 @@ func TestPatchNode(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Ensure NodeStandbyTaint to be set if a node is associated to a standby machine",
+			oldNode: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf("node-%s", util.RandomString(6)),
+				},
+			},
+			expectedAnnotations: map[string]string{
+				clusterv1.AnnotationsFromMachineAnnotation: "",
+				clusterv1.LabelsFromMachineAnnotation:      "",
+			},
+			expectedTaints: []corev1.Taint{
+				{Key: "node.kubernetes.io/not-ready", Effect: "NoSchedule"}, // Added by the API server
+				clusterv1.NodeStandbyTaint,
+			},
+			machine: func() *clusterv1.Machine {
+				m := newFakeMachine(metav1.NamespaceDefault, clusterName)
+				m.Labels = map[string]string{clusterv1.MachineStandbyLabel: ""}
+				return m
+			}(),
+			ms: newFakeMachineSet(metav1.NamespaceDefault, clusterName),
+			md: newFakeMachineDeployment(metav1.NamespaceDefault, clusterName),
+		},
+		{
+			name: "Removes NodeStandbyTaint if a node is associated to a promoted machine",
+			oldNode: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf("node-%s", util.RandomString(6)),
+				},
+				Spec: corev1.NodeSpec{
+					Taints: []corev1.Taint{
+						clusterv1.NodeStandbyTaint,
+					},
+				},
+			},
+			expectedAnnotations: map[string]string{
+				clusterv1.AnnotationsFromMachineAnnotation: "",
+				clusterv1.LabelsFromMachineAnnotation:      "",
+			},
+			expectedTaints: []corev1.Taint{
+				{Key: "node.kubernetes.io/not-ready", Effect: "NoSchedule"}, // Added by the API server
+			},
+			machine: newFakeMachine(metav1.NamespaceDefault, clusterName),
+			ms:      newFakeMachineSet(metav1.NamespaceDefault, clusterName),
+			md:      newFakeMachineDeployment(metav1.NamespaceDefault, clusterName),
+		},
 	}
 
 	r := Reconciler{
@@ -319,6 +319,13 @@ func (r *Reconciler) reconcileInfrastructure(ctx context.Context, s *scope) (ctr
 				contract.InfrastructureMachine().Provisioned(contractVersion).Path().String(), s.infraMachine.GetKind()),
 				s.infraMachine.GetKind(), klog.KObj(s.infraMachine))
 		}
+		// Requeue when the infrastructure ready timeout expires, so the InfrastructureProvisioningFailed condition is surfaced timely.
+		if m.Spec.InfrastructureReadyTimeoutSeconds != nil {
+			timeout := time.Duration(*m.Spec.InfrastructureReadyTimeoutSeconds) * time.Second
+			if remaining := timeout - time.Since(m.CreationTimestamp.Time); remaining > 0 {
+				return ctrl.Result{RequeueAfter: remaining}, nil
+			}
+		}
 		return ctrl.Result{}, nil
 	}
 
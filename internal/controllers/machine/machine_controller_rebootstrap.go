@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/patch"
+)
+
+// reconcileRebootstrap handles requests to regenerate the bootstrap data of a Machine.
+// The request is first propagated to the BootstrapConfig, then, once the bootstrap provider reports the bootstrap data
+// has been regenerated, it is propagated to the InfraMachine so infrastructure providers can re-inject the bootstrap data.
+func (r *Reconciler) reconcileRebootstrap(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	m := s.machine
+
+	requestID, ok := m.GetAnnotations()[clusterv1.MachineRebootstrapRequestedAnnotation]
+	if !ok || m.GetAnnotations()[clusterv1.MachineRebootstrapCompletedAnnotation] == requestID {
+		return ctrl.Result{}, nil
+	}
+
+	// Re-bootstrap is only possible for Machines using a BootstrapConfig and before the Node joined the cluster.
+	if m.Status.NodeRef.IsDefined() || s.bootstrapConfig == nil || s.infraMachine == nil {
+		return ctrl.Result{}, nil
+	}
+
+	// Request the bootstrap provider to regenerate the bootstrap data.
+	if s.bootstrapConfig.GetAnnotations()[clusterv1.MachineRebootstrapRequestedAnnotation] != requestID {
+		if err := r.setRebootstrapAnnotation(ctx, s.bootstrapConfig, clusterv1.MachineRebootstrapRequestedAnnotation, requestID); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.Info("Requested regeneration of bootstrap data", s.bootstrapConfig.GetKind(), klog.KObj(s.bootstrapConfig), "request", requestID)
+		return ctrl.Result{}, nil
+	}
+
+	// Wait for the bootstrap provider to regenerate the bootstrap data.
+	// Note: The BootstrapConfig is watched, so the Machine will be reconciled when the annotation is set.
+	if s.bootstrapConfig.GetAnnotations()[clusterv1.MachineRebootstrapCompletedAnnotation] != requestID {
+		log.V(4).Info("Waiting for bootstrap provider to regenerate bootstrap data", s.bootstrapConfig.GetKind(), klog.KObj(s.bootstrapConfig), "request", requestID)
+		return ctrl.Result{}, nil
+	}
+
+	// Signal the infrastructure provider that it can re-inject the bootstrap data.
+	if s.infraMachine.GetAnnotations()[clusterv1.MachineRebootstrapRequestedAnnotation] != requestID {
+		if err := r.setRebootstrapAnnotation(ctx, s.infraMachine, clusterv1.MachineRebootstrapRequestedAnnotation, requestID); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	annotations.AddAnnotations(m, map[string]string{clusterv1.MachineRebootstrapCompletedAnnotation: requestID})
+	log.Info("Bootstrap data regenerated", s.infraMachine.GetKind(), klog.KObj(s.infraMachine), "request", requestID)
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) setRebootstrapAnnotation(ctx context.Context, obj *unstructured.Unstructured, annotation, requestID string) error {
+	patchHelper, err := patch.NewHelper(obj, r.Client)
+	if err != nil {
+		return err
+	}
+	annotations.AddAnnotations(obj, map[string]string{annotation: requestID})
+	if err := patchHelper.Patch(ctx, obj); err != nil {
+		return errors.Wrapf(err, "failed to set %s annotation on %s %s", annotation, obj.GetKind(), klog.KObj(obj))
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileRebootstrap(t *testing.T) {
+	tests := []struct {
+		name                        string
+		machineAnnotations          map[string]string
+		nodeRef                     bool
+		bootstrapAnnotations        map[string]string
+		wantBootstrapAnnotations    map[string]string
+		wantInfraMachineRequested   bool
+		wantMachineCompletedRequest string
+	}{
+		{
+			name:                     "no request",
+			machineAnnotations:       map[string]string{},
+			wantBootstrapAnnotations: map[string]string{},
+		},
+		{
+			name: "request already completed",
+			machineAnnotations: map[string]string{
+				clusterv1.MachineRebootstrapRequestedAnnotation: "1",
+				clusterv1.MachineRebootstrapCompletedAnnotation: "1",
+			},
+			wantBootstrapAnnotations:    map[string]string{},
+			wantMachineCompletedRequest: "1",
+		},
+		{
+			name:                     "request ignored if the Node already exists",
+			machineAnnotations:       map[string]string{clusterv1.MachineRebootstrapRequestedAnnotation: "1"},
+			nodeRef:                  true,
+			wantBootstrapAnnotations: map[string]string{},
+		},
+		{
+			name:               "request is propagated to the BootstrapConfig",
+			machineAnnotations: map[string]string{clusterv1.MachineRebootstrapRequestedAnnotation: "1"},
+			wantBootstrapAnnotations: map[string]string{
+				clusterv1.MachineRebootstrapRequestedAnnotation: "1",
+			},
+		},
+		{
+			name: "new request is propagated to the BootstrapConfig",
+			machineAnnotations: map[string]string{
+				clusterv1.MachineRebootstrapRequestedAnnotation: "2",
+				clusterv1.MachineRebootstrapCompletedAnnotation: "1",
+			},
+			bootstrapAnnotations: map[string]string{
+				clusterv1.MachineRebootstrapRequestedAnnotation: "1",
+				clusterv1.MachineRebootstrapCompletedAnnotation: "1",
+			},
+			wantBootstrapAnnotations: map[string]string{
+				clusterv1.MachineRebootstrapRequestedAnnotation: "2",
+				clusterv1.MachineRebootstrapCompletedAnnotation: "1",
+			},
+			wantMachineCompletedRequest: "1",
+		},
+		{
+			name:               "wait for the bootstrap provider to regenerate bootstrap data",
+			machineAnnotations: map[string]string{clusterv1.MachineRebootstrapRequestedAnnotation: "1"},
+			bootstrapAnnotations: map[string]string{
+				clusterv1.MachineRebootstrapRequestedAnnotation: "1",
+			},
+			wantBootstrapAnnotations: map[string]string{
+				clusterv1.MachineRebootstrapRequestedAnnotation: "1",
+			},
+		},
+		{
+			name:               "request is propagated to the InfraMachine once bootstrap data has been regenerated",
+			machineAnnotations: map[string]string{clusterv1.MachineRebootstrapRequestedAnnotation: "1"},
+			bootstrapAnnotations: map[string]string{
+				clusterv1.MachineRebootstrapRequestedAnnotation: "1",
+				clusterv1.MachineRebootstrapCompletedAnnotation: "1",
+			},
+			wantBootstrapAnnotations: map[string]string{
+				clusterv1.MachineRebootstrapRequestedAnnotation: "1",
+				clusterv1.MachineRebootstrapCompletedAnnotation: "1",
+			},
+			wantInfraMachineRequested:   true,
+			wantMachineCompletedRequest: "1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+			machine := newTestMachine()
+			machine.Annotations = tt.machineAnnotations
+			if tt.nodeRef {
+				machine.Status.NodeRef = clusterv1.MachineNodeReference{Name: "node"}
+			}
+
+			infra := newTestUnstructured("GenericInfrastructureMachine", "infrastructure.cluster.x-k8s.io/v1beta2", "infra")
+			bootstrap := newTestUnstructured("GenericBootstrapConfig", "bootstrap.cluster.x-k8s.io/v1beta2", "bootstrap")
+			if tt.bootstrapAnnotations != nil {
+				bootstrap.SetAnnotations(tt.bootstrapAnnotations)
+			}
+
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(machine, infra, bootstrap).Build()
+			r := &Reconciler{Client: c}
+			s := &scope{
+				machine:         machine,
+				infraMachine:    infra,
+				bootstrapConfig: bootstrap,
+			}
+
+			res, err := r.reconcileRebootstrap(ctx, s)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res.IsZero()).To(BeTrue())
+
+			updatedBootstrap := &unstructured.Unstructured{}
+			updatedBootstrap.SetGroupVersionKind(bootstrap.GroupVersionKind())
+			g.Expect(c.Get(ctx, ctrlclient.ObjectKeyFromObject(bootstrap), updatedBootstrap)).To(Succeed())
+			g.Expect(updatedBootstrap.GetAnnotations()).To(Equal(tt.wantBootstrapAnnotations))
+
+			updatedInfra := &unstructured.Unstructured{}
+			updatedInfra.SetGroupVersionKind(infra.GroupVersionKind())
+			g.Expect(c.Get(ctx, ctrlclient.ObjectKeyFromObject(infra), updatedInfra)).To(Succeed())
+			if tt.wantInfraMachineRequested {
+				g.Expect(updatedInfra.GetAnnotations()).To(HaveKeyWithValue(clusterv1.MachineRebootstrapRequestedAnnotation, tt.machineAnnotations[clusterv1.MachineRebootstrapRequestedAnnotation]))
+			} else {
+				g.Expect(updatedInfra.GetAnnotations()).ToNot(HaveKey(clusterv1.MachineRebootstrapRequestedAnnotation))
+			}
+
+			// Note: the Machine is patched at the end of the reconcile, so only the in-memory object is checked here.
+			if tt.wantMachineCompletedRequest != "" {
+				g.Expect(s.machine.Annotations).To(HaveKeyWithValue(clusterv1.MachineRebootstrapCompletedAnnotation, tt.wantMachineCompletedRequest))
+			} else {
+				g.Expect(s.machine.Annotations).ToNot(HaveKey(clusterv1.MachineRebootstrapCompletedAnnotation))
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
+	capierrors "sigs.k8s.io/cluster-api/errors"
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/controllers/machinedeployment/mdutil"
 	"sigs.k8s.io/cluster-api/internal/util/inplace"
@@ -54,6 +55,10 @@ func (r *Reconciler) updateStatus(ctx context.Context, s *scope) ctrl.Result {
 	// Note: some of the status fields derived from the InfraMachine are managed in reconcileInfrastructure, e.g. status.InfrastructureReady, etc.
 	// here we are taking care only of the delta (condition).
 	setInfrastructureReadyCondition(ctx, s.machine, s.infraMachine, s.infraMachineIsNotFound)
+	setInfrastructureProvisioningFailedCondition(ctx, s.machine, s.infraMachine)
+	setLastProvisioningFailure(ctx, s.machine, s.infraMachine)
+	setNodeBootstrappedCondition(ctx, s.machine, s.infraMachine)
+	setStoppedCondition(ctx, s.machine, s.infraMachine)
 
 	// Update status from the Node external resource.
 	// Note: some of the status fields are managed in reconcileNode, e.g. status.NodeRef, etc.
@@ -259,6 +264,238 @@ func infrastructureReadyFallBackMessage(kind string, ready bool) string {
 	return fmt.Sprintf("%s status.initialization.provisioned is %t", kind, ready)
 }
 
+func setInfrastructureProvisioningFailedCondition(_ context.Context, machine *clusterv1.Machine, infraMachine *unstructured.Unstructured) {
+	// Surface the InfrastructureProvisioningFailed condition only for Machines with an infrastructure ready timeout.
+	if machine.Spec.InfrastructureReadyTimeoutSeconds == nil {
+		if conditions.Has(machine, clusterv1.MachineInfrastructureProvisioningFailedCondition) {
+			conditions.Delete(machine, clusterv1.MachineInfrastructureProvisioningFailedCondition)
+		}
+		return
+	}
+
+	timeout := time.Duration(*machine.Spec.InfrastructureReadyTimeoutSeconds) * time.Second
+	if ptr.Deref(machine.Status.Initialization.InfrastructureProvisioned, false) || time.Since(machine.CreationTimestamp.Time) < timeout {
+		conditions.Set(machine, metav1.Condition{
+			Type:   clusterv1.MachineInfrastructureProvisioningFailedCondition,
+			Status: metav1.ConditionFalse,
+			Reason: clusterv1.MachineInfrastructureProvisioningNotFailedReason,
+		})
+		return
+	}
+
+	msg := fmt.Sprintf("%s not provisioned after %s", machine.Spec.InfrastructureRef.Kind, timeout)
+	if infrastructureReady := conditions.Get(machine, clusterv1.MachineInfrastructureReadyCondition); infrastructureReady != nil && infrastructureReady.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, infrastructureReady.Message)
+	}
+	conditions.Set(machine, metav1.Condition{
+		Type:    clusterv1.MachineInfrastructureProvisioningFailedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  infrastructureProvisioningFailureReason(machine, infraMachine),
+		Message: msg,
+	})
+}
+
+// infrastructureProvisioningFailureReason classifies why the InfrastructureMachine is not provisioned, based on the
+// reason of its Ready condition or, for InfrastructureMachines still implementing the v1beta1 contract, on status.failureReason.
+func infrastructureProvisioningFailureReason(machine *clusterv1.Machine, infraMachine *unstructured.Unstructured) string {
+	var reasons []string
+	if infrastructureReady := conditions.Get(machine, clusterv1.MachineInfrastructureReadyCondition); infrastructureReady != nil {
+		reasons = append(reasons, infrastructureReady.Reason)
+	}
+	if infraMachine != nil {
+		if failureReason, err := contract.InfrastructureMachine().FailureReason().Get(infraMachine); err == nil {
+			reasons = append(reasons, *failureReason)
+		}
+	}
+
+	for _, reason := range reasons {
+		switch reason {
+		case clusterv1.MachineInfrastructureQuotaExceededReason, string(capierrors.InsufficientResourcesMachineError):
+			return clusterv1.MachineInfrastructureQuotaExceededReason
+		case clusterv1.MachineInfrastructureImageNotFoundReason:
+			return clusterv1.MachineInfrastructureImageNotFoundReason
+		}
+	}
+	return clusterv1.MachineInfrastructureProvisioningFailedUnknownReason
+}
+
+// setLastProvisioningFailure surfaces the failures reported by the InfrastructureMachine while provisioning the Machine
+// in status.lastProvisioningFailure, classifying them as retryable or terminal.
+// NOTE: This func must be called after setInfrastructureReadyCondition.
+func setLastProvisioningFailure(_ context.Context, machine *clusterv1.Machine, infraMachine *unstructured.Unstructured) {
+	if ptr.Deref(machine.Status.Initialization.InfrastructureProvisioned, false) {
+		machine.Status.LastProvisioningFailure = nil
+		return
+	}
+
+	// Keep the last failure if the InfrastructureMachine cannot be read or if it does not report a failure anymore,
+	// e.g. because the infrastructure provider is retrying after a transient failure.
+	if infraMachine == nil {
+		return
+	}
+	failure := infrastructureProvisioningFailure(machine, infraMachine)
+	if failure == nil {
+		return
+	}
+
+	// Preserve the time of the last failure if the InfrastructureMachine is still reporting it.
+	if last := machine.Status.LastProvisioningFailure; last != nil &&
+		last.Code == failure.Code && last.Message == failure.Message && ptr.Equal(last.Retryable, failure.Retryable) {
+		return
+	}
+	failure.Time = metav1.Now()
+	machine.Status.LastProvisioningFailure = failure
+}
+
+// infrastructureProvisioningFailure returns the failure reported by the InfrastructureMachine, if any.
+// InfrastructureMachines still implementing the v1beta1 contract report terminal failures in status.failureReason and
+// status.failureMessage; otherwise failures are identified by the reason of the InfrastructureMachine's Ready condition.
+func infrastructureProvisioningFailure(machine *clusterv1.Machine, infraMachine *unstructured.Unstructured) *clusterv1.MachineProvisioningFailure {
+	failureReason, _ := contract.InfrastructureMachine().FailureReason().Get(infraMachine)
+	failureMessage, _ := contract.InfrastructureMachine().FailureMessage().Get(infraMachine)
+	if failureReason != nil || failureMessage != nil {
+		return &clusterv1.MachineProvisioningFailure{
+			Retryable: ptr.To(false),
+			Code:      ptr.Deref(failureReason, ""),
+			Message:   ptr.Deref(failureMessage, ""),
+		}
+	}
+
+	infrastructureReady := conditions.Get(machine, clusterv1.MachineInfrastructureReadyCondition)
+	if infrastructureReady == nil || infrastructureReady.Status != metav1.ConditionFalse {
+		return nil
+	}
+	var retryable bool
+	switch infrastructureReady.Reason {
+	case clusterv1.MachineInfrastructureQuotaExceededReason, string(capierrors.InsufficientResourcesMachineError):
+		retryable = true
+	case clusterv1.MachineInfrastructureImageNotFoundReason:
+		retryable = false
+	default:
+		return nil
+	}
+	return &clusterv1.MachineProvisioningFailure{
+		Retryable: ptr.To(retryable),
+		Code:      infrastructureReady.Reason,
+		Message:   infrastructureReady.Message,
+	}
+}
+
+func setNodeBootstrappedCondition(_ context.Context, machine *clusterv1.Machine, infraMachine *unstructured.Unstructured) {
+	var phase, message, failedCommand, outputTail string
+	var exitCode int32
+	if infraMachine != nil {
+		// Note: status.bootstrapProgress is an optional field of the InfrastructureMachine contract.
+		if v, err := contract.InfrastructureMachine().BootstrapProgressPhase().Get(infraMachine); err == nil {
+			phase = *v
+		}
+		if v, err := contract.InfrastructureMachine().BootstrapProgressMessage().Get(infraMachine); err == nil {
+			message = *v
+		}
+		// Note: status.bootstrapDiagnostics is an optional field of the InfrastructureMachine contract.
+		if v, err := contract.InfrastructureMachine().BootstrapDiagnosticsFailedCommand().Get(infraMachine); err == nil {
+			failedCommand = *v
+		}
+		if v, err := contract.InfrastructureMachine().BootstrapDiagnosticsExitCode().Get(infraMachine); err == nil {
+			exitCode = *v
+		}
+		if v, err := contract.InfrastructureMachine().BootstrapDiagnosticsOutputTail().Get(infraMachine); err == nil {
+			outputTail = *v
+		}
+	}
+
+	// Surface the NodeBootstrapped condition only for InfrastructureMachines reporting bootstrap progress or bootstrap diagnostics.
+	if phase == "" && failedCommand == "" && !conditions.Has(machine, clusterv1.MachineNodeBootstrappedCondition) {
+		return
+	}
+
+	// A failed bootstrap command is surfaced even if the Node registered to the workload cluster, given that
+	// commands like postKubeadmCommands run after the Node is registered.
+	if failedCommand != "" {
+		msg := fmt.Sprintf("Bootstrap command %s failed with exit code %d", failedCommand, exitCode)
+		if outputTail != "" {
+			msg = fmt.Sprintf("%s, output:\n%s", msg, outputTail)
+		}
+		conditions.Set(machine, metav1.Condition{
+			Type:    clusterv1.MachineNodeBootstrappedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.MachineNodeBootstrapCommandFailedReason,
+			Message: msg,
+		})
+		return
+	}
+
+	if machine.Status.NodeRef.IsDefined() {
+		conditions.Set(machine, metav1.Condition{
+			Type:   clusterv1.MachineNodeBootstrappedCondition,
+			Status: metav1.ConditionTrue,
+			Reason: clusterv1.MachineNodeBootstrappedReason,
+		})
+		return
+	}
+
+	// Keep the last reported phase if the InfrastructureMachine does not report bootstrap progress anymore,
+	// e.g. because it has been deleted.
+	if phase == "" {
+		return
+	}
+
+	msg := fmt.Sprintf("Bootstrap phase is %s", phase)
+	if message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, message)
+	}
+	conditions.Set(machine, metav1.Condition{
+		Type:    clusterv1.MachineNodeBootstrappedCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  clusterv1.MachineNodeBootstrappingReason,
+		Message: msg,
+	})
+}
+
+func setStoppedCondition(_ context.Context, machine *clusterv1.Machine, infraMachine *unstructured.Unstructured) {
+	var powerState clusterv1.MachinePowerState
+	if infraMachine != nil {
+		// Note: status.powerState is an optional field of the InfrastructureMachine contract.
+		if v, err := contract.InfrastructureMachine().PowerState().Get(infraMachine); err == nil {
+			powerState = clusterv1.MachinePowerState(*v)
+		}
+	}
+
+	// Surface the Stopped condition only for Machines with a power state.
+	if machine.Spec.PowerState == "" && powerState == "" && !conditions.Has(machine, clusterv1.MachineStoppedCondition) {
+		return
+	}
+
+	switch {
+	case machine.Spec.PowerState == clusterv1.MachinePowerStateStopped && powerState == clusterv1.MachinePowerStateStopped:
+		conditions.Set(machine, metav1.Condition{
+			Type:   clusterv1.MachineStoppedCondition,
+			Status: metav1.ConditionTrue,
+			Reason: clusterv1.MachineStoppedReason,
+		})
+	case machine.Spec.PowerState == clusterv1.MachinePowerStateStopped:
+		conditions.Set(machine, metav1.Condition{
+			Type:    clusterv1.MachineStoppedCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  clusterv1.MachineStoppingReason,
+			Message: fmt.Sprintf("Waiting for %s to report status.powerState %s", machine.Spec.InfrastructureRef.Kind, clusterv1.MachinePowerStateStopped),
+		})
+	case powerState == clusterv1.MachinePowerStateStopped:
+		conditions.Set(machine, metav1.Condition{
+			Type:    clusterv1.MachineStoppedCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  clusterv1.MachineStartingReason,
+			Message: fmt.Sprintf("Waiting for %s to report status.powerState %s", machine.Spec.InfrastructureRef.Kind, clusterv1.MachinePowerStateRunning),
+		})
+	default:
+		conditions.Set(machine, metav1.Condition{
+			Type:   clusterv1.MachineStoppedCondition,
+			Status: metav1.ConditionFalse,
+			Reason: clusterv1.MachineNotStoppedReason,
+		})
+	}
+}
+
 func setNodeHealthyAndReadyConditions(ctx context.Context, cluster *clusterv1.Cluster, machine *clusterv1.Machine, node *corev1.Node, nodeGetErr error, healthCheckingState clustercache.HealthCheckingState, remoteConditionsGracePeriod time.Duration) {
 	if !ptr.Deref(cluster.Status.Initialization.InfrastructureProvisioned, false) {
 		setNodeConditions(machine, metav1.ConditionUnknown,
@@ -529,6 +766,10 @@ func (c machineConditionCustomMergeStrategy) Merge(operation conditions.MergeOpe
 				}
 				// Note: MachineNodeReadyCondition is not relevant for the summary.
 			}
+			// While machine is stopped, treat the Node not being healthy as info (it is expected that the Node is not ready at this stage).
+			if conditions.IsTrue(c.machine, clusterv1.MachineStoppedCondition) && condition.Type == clusterv1.MachineNodeHealthyCondition {
+				return conditions.InfoMergePriority
+			}
 			return conditions.GetDefaultMergePriorityFunc(c.negativePolarityConditionTypes...)(condition)
 		}),
 		// Group readiness gates for control plane and etcd conditions when they have the same messages.
@@ -748,8 +989,15 @@ func setReadyCondition(ctx context.Context, machine *clusterv1.Machine) {
 		clusterv1.MachineInfrastructureReadyCondition,
 		clusterv1.MachineNodeHealthyCondition,
 		clusterv1.MachineHealthCheckSucceededCondition,
+		clusterv1.MachineStoppedCondition,
+	}
+	if machine.Spec.KubeletServingCertificateVerification == clusterv1.MachineKubeletServingCertificateVerificationEnabled {
+		forConditionTypes = append(forConditionTypes, clusterv1.MachineKubeletServingCertificateVerifiedCondition)
+	}
+	if len(machine.Spec.NodeReadinessChecks) > 0 {
+		forConditionTypes = append(forConditionTypes, clusterv1.MachineNodeReadinessChecksSucceededCondition)
 	}
-	negativePolarityConditionTypes := []string{clusterv1.MachineDeletingCondition, clusterv1.MachineUpdatingCondition}
+	negativePolarityConditionTypes := []string{clusterv1.MachineDeletingCondition, clusterv1.MachineUpdatingCondition, clusterv1.MachineStoppedCondition}
 	for _, g := range machine.Spec.ReadinessGates {
 		forConditionTypes = append(forConditionTypes, g.ConditionType)
 		if g.Polarity == clusterv1.NegativePolarityCondition {
@@ -759,9 +1007,10 @@ func setReadyCondition(ctx context.Context, machine *clusterv1.Machine) {
 
 	summaryOpts := []conditions.SummaryOption{
 		forConditionTypes,
-		// Tolerate HealthCheckSucceeded to not exist.
+		// Tolerate HealthCheckSucceeded and Stopped to not exist.
 		conditions.IgnoreTypesIfMissing{
 			clusterv1.MachineHealthCheckSucceededCondition,
+			clusterv1.MachineStoppedCondition,
 		},
 		// Using a custom merge strategy to override reasons applied during merge and to ignore some
 		// info message so the ready condition aggregation in other resources is less noisy.
@@ -772,10 +1021,11 @@ func setReadyCondition(ctx context.Context, machine *clusterv1.Machine) {
 				negativePolarityConditionTypes: negativePolarityConditionTypes,
 			},
 		},
-		// Instruct summary to consider Deleting and updating condition with negative polarity.
+		// Instruct summary to consider Deleting, Updating and Stopped condition with negative polarity.
 		conditions.NegativePolarityConditionTypes{
 			clusterv1.MachineDeletingCondition,
 			clusterv1.MachineUpdatingCondition,
+			clusterv1.MachineStoppedCondition,
 		},
 	}
 
@@ -35,6 +35,7 @@ import (
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -535,6 +536,517 @@ func TestSetInfrastructureReadyCondition(t *testing.T) {
 	}
 }
 
+func TestSetNodeBootstrappedCondition(t *testing.T) {
+	infraMachine := func(bootstrapProgress map[string]interface{}) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{
+			"kind":       "GenericInfrastructureMachine",
+			"apiVersion": clusterv1.GroupVersionInfrastructure.String(),
+			"metadata": map[string]interface{}{
+				"name":      "infra-machine1",
+				"namespace": metav1.NamespaceDefault,
+			},
+		}}
+		if bootstrapProgress != nil {
+			u.Object["status"] = map[string]interface{}{"bootstrapProgress": bootstrapProgress}
+		}
+		return u
+	}
+	bootstrapping := metav1.Condition{
+		Type:    clusterv1.MachineNodeBootstrappedCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  clusterv1.MachineNodeBootstrappingReason,
+		Message: "Bootstrap phase is KubeadmStarted",
+	}
+	bootstrapCommandFailed := metav1.Condition{
+		Type:    clusterv1.MachineNodeBootstrappedCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  clusterv1.MachineNodeBootstrapCommandFailedReason,
+		Message: "Bootstrap command postKubeadmCommands[0] failed with exit code 127, output:\nbash: foo: command not found",
+	}
+	withBootstrapDiagnostics := func(u *unstructured.Unstructured) *unstructured.Unstructured {
+		g := NewWithT(t)
+		g.Expect(contract.InfrastructureMachine().BootstrapDiagnosticsFailedCommand().Set(u, "postKubeadmCommands[0]")).To(Succeed())
+		g.Expect(contract.InfrastructureMachine().BootstrapDiagnosticsExitCode().Set(u, 127)).To(Succeed())
+		g.Expect(contract.InfrastructureMachine().BootstrapDiagnosticsOutputTail().Set(u, "bash: foo: command not found")).To(Succeed())
+		return u
+	}
+
+	testCases := []struct {
+		name            string
+		machine         *clusterv1.Machine
+		infraMachine    *unstructured.Unstructured
+		expectCondition *metav1.Condition
+	}{
+		{
+			name:            "infra machine does not report bootstrap progress",
+			machine:         &clusterv1.Machine{},
+			infraMachine:    infraMachine(nil),
+			expectCondition: nil,
+		},
+		{
+			name:            "infra machine not found",
+			machine:         &clusterv1.Machine{},
+			infraMachine:    nil,
+			expectCondition: nil,
+		},
+		{
+			name:            "infra machine reports bootstrap phase",
+			machine:         &clusterv1.Machine{},
+			infraMachine:    infraMachine(map[string]interface{}{"phase": "KubeadmStarted"}),
+			expectCondition: &bootstrapping,
+		},
+		{
+			name:         "infra machine reports bootstrap phase and message",
+			machine:      &clusterv1.Machine{},
+			infraMachine: infraMachine(map[string]interface{}{"phase": "KubeadmStarted", "message": "waiting for the API server"}),
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.MachineNodeBootstrappedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.MachineNodeBootstrappingReason,
+				Message: "Bootstrap phase is KubeadmStarted: waiting for the API server",
+			},
+		},
+		{
+			name: "infra machine does not report bootstrap progress anymore, keep the last reported phase",
+			machine: &clusterv1.Machine{
+				Status: clusterv1.MachineStatus{Conditions: []metav1.Condition{bootstrapping}},
+			},
+			infraMachine:    nil,
+			expectCondition: &bootstrapping,
+		},
+		{
+			name: "node registered",
+			machine: &clusterv1.Machine{
+				Status: clusterv1.MachineStatus{NodeRef: clusterv1.MachineNodeReference{Name: "node1"}},
+			},
+			infraMachine: infraMachine(map[string]interface{}{"phase": "KubeadmFinished"}),
+			expectCondition: &metav1.Condition{
+				Type:   clusterv1.MachineNodeBootstrappedCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.MachineNodeBootstrappedReason,
+			},
+		},
+		{
+			name: "node registered, infra machine does not report bootstrap progress anymore",
+			machine: &clusterv1.Machine{
+				Status: clusterv1.MachineStatus{
+					NodeRef:    clusterv1.MachineNodeReference{Name: "node1"},
+					Conditions: []metav1.Condition{bootstrapping},
+				},
+			},
+			infraMachine: infraMachine(nil),
+			expectCondition: &metav1.Condition{
+				Type:   clusterv1.MachineNodeBootstrappedCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.MachineNodeBootstrappedReason,
+			},
+		},
+		{
+			name:            "infra machine reports a failed bootstrap command",
+			machine:         &clusterv1.Machine{},
+			infraMachine:    withBootstrapDiagnostics(infraMachine(nil)),
+			expectCondition: &bootstrapCommandFailed,
+		},
+		{
+			name: "node registered, infra machine reports a failed bootstrap command",
+			machine: &clusterv1.Machine{
+				Status: clusterv1.MachineStatus{NodeRef: clusterv1.MachineNodeReference{Name: "node1"}},
+			},
+			infraMachine:    withBootstrapDiagnostics(infraMachine(map[string]interface{}{"phase": "KubeadmFinished"})),
+			expectCondition: &bootstrapCommandFailed,
+		},
+		{
+			name: "node registered, infra machine never reported bootstrap progress",
+			machine: &clusterv1.Machine{
+				Status: clusterv1.MachineStatus{NodeRef: clusterv1.MachineNodeReference{Name: "node1"}},
+			},
+			infraMachine:    infraMachine(nil),
+			expectCondition: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setNodeBootstrappedCondition(ctx, tc.machine, tc.infraMachine)
+
+			condition := conditions.Get(tc.machine, clusterv1.MachineNodeBootstrappedCondition)
+			if tc.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(*tc.expectCondition, conditions.IgnoreLastTransitionTime(true)))
+		})
+	}
+}
+
+func TestSetStoppedCondition(t *testing.T) {
+	infraMachine := func(powerState string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{
+			"kind":       "GenericInfrastructureMachine",
+			"apiVersion": clusterv1.GroupVersionInfrastructure.String(),
+			"metadata": map[string]interface{}{
+				"name":      "infra-machine1",
+				"namespace": metav1.NamespaceDefault,
+			},
+		}}
+		if powerState != "" {
+			u.Object["status"] = map[string]interface{}{"powerState": powerState}
+		}
+		return u
+	}
+	machine := func(powerState clusterv1.MachinePowerState, conds ...metav1.Condition) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			Spec: clusterv1.MachineSpec{
+				InfrastructureRef: clusterv1.ContractVersionedObjectReference{Kind: "GenericInfrastructureMachine"},
+				PowerState:        powerState,
+			},
+			Status: clusterv1.MachineStatus{Conditions: conds},
+		}
+	}
+	notStopped := metav1.Condition{
+		Type:   clusterv1.MachineStoppedCondition,
+		Status: metav1.ConditionFalse,
+		Reason: clusterv1.MachineNotStoppedReason,
+	}
+
+	testCases := []struct {
+		name            string
+		machine         *clusterv1.Machine
+		infraMachine    *unstructured.Unstructured
+		expectCondition *metav1.Condition
+	}{
+		{
+			name:            "power state not set and not reported by the infra machine",
+			machine:         machine(""),
+			infraMachine:    infraMachine(""),
+			expectCondition: nil,
+		},
+		{
+			name:            "power state Running",
+			machine:         machine(clusterv1.MachinePowerStateRunning),
+			infraMachine:    infraMachine(""),
+			expectCondition: &notStopped,
+		},
+		{
+			name:            "power state reported by the infra machine",
+			machine:         machine(""),
+			infraMachine:    infraMachine("Running"),
+			expectCondition: &notStopped,
+		},
+		{
+			name:         "stopping",
+			machine:      machine(clusterv1.MachinePowerStateStopped),
+			infraMachine: infraMachine("Running"),
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.MachineStoppedCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  clusterv1.MachineStoppingReason,
+				Message: "Waiting for GenericInfrastructureMachine to report status.powerState Stopped",
+			},
+		},
+		{
+			name:         "stopped",
+			machine:      machine(clusterv1.MachinePowerStateStopped),
+			infraMachine: infraMachine("Stopped"),
+			expectCondition: &metav1.Condition{
+				Type:   clusterv1.MachineStoppedCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.MachineStoppedReason,
+			},
+		},
+		{
+			name:         "starting",
+			machine:      machine(clusterv1.MachinePowerStateRunning),
+			infraMachine: infraMachine("Stopped"),
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.MachineStoppedCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  clusterv1.MachineStartingReason,
+				Message: "Waiting for GenericInfrastructureMachine to report status.powerState Running",
+			},
+		},
+		{
+			name:            "power state not set anymore, keep surfacing the condition",
+			machine:         machine("", metav1.Condition{Type: clusterv1.MachineStoppedCondition, Status: metav1.ConditionTrue, Reason: clusterv1.MachineStoppedReason}),
+			infraMachine:    infraMachine(""),
+			expectCondition: &notStopped,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setStoppedCondition(ctx, tc.machine, tc.infraMachine)
+
+			condition := conditions.Get(tc.machine, clusterv1.MachineStoppedCondition)
+			if tc.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(*tc.expectCondition, conditions.IgnoreLastTransitionTime(true)))
+		})
+	}
+}
+
+func TestSetInfrastructureProvisioningFailedCondition(t *testing.T) {
+	infraMachine := func(failureReason string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{
+			"kind":       "GenericInfrastructureMachine",
+			"apiVersion": clusterv1.GroupVersionInfrastructure.String(),
+			"metadata": map[string]interface{}{
+				"name":      "infra-machine1",
+				"namespace": metav1.NamespaceDefault,
+			},
+		}}
+		if failureReason != "" {
+			u.Object["status"] = map[string]interface{}{"failureReason": failureReason}
+		}
+		return u
+	}
+	machine := func(timeoutSeconds *int32, createdAgo time.Duration, provisioned bool, conds ...metav1.Condition) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(time.Now().Add(-createdAgo))},
+			Spec: clusterv1.MachineSpec{
+				InfrastructureRef:                 clusterv1.ContractVersionedObjectReference{Kind: "GenericInfrastructureMachine"},
+				InfrastructureReadyTimeoutSeconds: timeoutSeconds,
+			},
+			Status: clusterv1.MachineStatus{
+				Initialization: clusterv1.MachineInitializationStatus{InfrastructureProvisioned: ptr.To(provisioned)},
+				Conditions:     conds,
+			},
+		}
+	}
+	infrastructureNotReady := func(reason, message string) metav1.Condition {
+		return metav1.Condition{Type: clusterv1.MachineInfrastructureReadyCondition, Status: metav1.ConditionFalse, Reason: reason, Message: message}
+	}
+	notFailed := metav1.Condition{
+		Type:   clusterv1.MachineInfrastructureProvisioningFailedCondition,
+		Status: metav1.ConditionFalse,
+		Reason: clusterv1.MachineInfrastructureProvisioningNotFailedReason,
+	}
+
+	testCases := []struct {
+		name            string
+		machine         *clusterv1.Machine
+		infraMachine    *unstructured.Unstructured
+		expectCondition *metav1.Condition
+	}{
+		{
+			name:            "timeout not set",
+			machine:         machine(nil, time.Hour, false),
+			infraMachine:    infraMachine(""),
+			expectCondition: nil,
+		},
+		{
+			name:            "timeout not set anymore, drop the condition",
+			machine:         machine(nil, time.Hour, false, notFailed),
+			infraMachine:    infraMachine(""),
+			expectCondition: nil,
+		},
+		{
+			name:            "timeout not expired",
+			machine:         machine(ptr.To[int32](600), time.Minute, false),
+			infraMachine:    infraMachine(""),
+			expectCondition: &notFailed,
+		},
+		{
+			name:            "infrastructure provisioned",
+			machine:         machine(ptr.To[int32](600), time.Hour, true),
+			infraMachine:    infraMachine(""),
+			expectCondition: &notFailed,
+		},
+		{
+			name:         "timeout expired, quota exceeded reported in the Ready condition",
+			machine:      machine(ptr.To[int32](600), time.Hour, false, infrastructureNotReady(clusterv1.MachineInfrastructureQuotaExceededReason, "vCPU quota exceeded")),
+			infraMachine: infraMachine(""),
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.MachineInfrastructureProvisioningFailedCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  clusterv1.MachineInfrastructureQuotaExceededReason,
+				Message: "GenericInfrastructureMachine not provisioned after 10m0s: vCPU quota exceeded",
+			},
+		},
+		{
+			name:         "timeout expired, image not found reported in the Ready condition",
+			machine:      machine(ptr.To[int32](600), time.Hour, false, infrastructureNotReady(clusterv1.MachineInfrastructureImageNotFoundReason, "")),
+			infraMachine: infraMachine(""),
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.MachineInfrastructureProvisioningFailedCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  clusterv1.MachineInfrastructureImageNotFoundReason,
+				Message: "GenericInfrastructureMachine not provisioned after 10m0s",
+			},
+		},
+		{
+			name:         "timeout expired, insufficient resources reported in the v1beta1 failureReason",
+			machine:      machine(ptr.To[int32](600), time.Hour, false),
+			infraMachine: infraMachine("InsufficientResources"),
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.MachineInfrastructureProvisioningFailedCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  clusterv1.MachineInfrastructureQuotaExceededReason,
+				Message: "GenericInfrastructureMachine not provisioned after 10m0s",
+			},
+		},
+		{
+			name:         "timeout expired, unknown failure",
+			machine:      machine(ptr.To[int32](600), time.Hour, false, infrastructureNotReady(clusterv1.MachineInfrastructureNotReadyReason, "Waiting for instance")),
+			infraMachine: nil,
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.MachineInfrastructureProvisioningFailedCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  clusterv1.MachineInfrastructureProvisioningFailedUnknownReason,
+				Message: "GenericInfrastructureMachine not provisioned after 10m0s: Waiting for instance",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setInfrastructureProvisioningFailedCondition(ctx, tc.machine, tc.infraMachine)
+
+			condition := conditions.Get(tc.machine, clusterv1.MachineInfrastructureProvisioningFailedCondition)
+			if tc.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(*tc.expectCondition, conditions.IgnoreLastTransitionTime(true)))
+		})
+	}
+}
+
+func TestSetLastProvisioningFailure(t *testing.T) {
+	infraMachine := func(status map[string]interface{}) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{
+			"kind":       "GenericInfrastructureMachine",
+			"apiVersion": clusterv1.GroupVersionInfrastructure.String(),
+			"metadata": map[string]interface{}{
+				"name":      "infra-machine1",
+				"namespace": metav1.NamespaceDefault,
+			},
+		}}
+		if status != nil {
+			u.Object["status"] = status
+		}
+		return u
+	}
+	machine := func(provisioned bool, lastFailure *clusterv1.MachineProvisioningFailure, conds ...metav1.Condition) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			Status: clusterv1.MachineStatus{
+				Initialization:          clusterv1.MachineInitializationStatus{InfrastructureProvisioned: ptr.To(provisioned)},
+				Conditions:              conds,
+				LastProvisioningFailure: lastFailure,
+			},
+		}
+	}
+	infrastructureNotReady := func(reason, message string) metav1.Condition {
+		return metav1.Condition{Type: clusterv1.MachineInfrastructureReadyCondition, Status: metav1.ConditionFalse, Reason: reason, Message: message}
+	}
+	lastFailureTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	quotaExceeded := &clusterv1.MachineProvisioningFailure{
+		Time:      lastFailureTime,
+		Retryable: ptr.To(true),
+		Code:      clusterv1.MachineInfrastructureQuotaExceededReason,
+		Message:   "vCPU quota exceeded",
+	}
+
+	testCases := []struct {
+		name              string
+		machine           *clusterv1.Machine
+		infraMachine      *unstructured.Unstructured
+		expectFailure     *clusterv1.MachineProvisioningFailure
+		expectFailureTime *metav1.Time
+	}{
+		{
+			name:          "no failure",
+			machine:       machine(false, nil, infrastructureNotReady(clusterv1.MachineInfrastructureNotReadyReason, "Waiting for instance")),
+			infraMachine:  infraMachine(nil),
+			expectFailure: nil,
+		},
+		{
+			name:         "retryable failure reported in the Ready condition",
+			machine:      machine(false, nil, infrastructureNotReady(clusterv1.MachineInfrastructureQuotaExceededReason, "vCPU quota exceeded")),
+			infraMachine: infraMachine(nil),
+			expectFailure: &clusterv1.MachineProvisioningFailure{
+				Retryable: ptr.To(true),
+				Code:      clusterv1.MachineInfrastructureQuotaExceededReason,
+				Message:   "vCPU quota exceeded",
+			},
+		},
+		{
+			name:         "terminal failure reported in the Ready condition",
+			machine:      machine(false, nil, infrastructureNotReady(clusterv1.MachineInfrastructureImageNotFoundReason, "image foo not found")),
+			infraMachine: infraMachine(nil),
+			expectFailure: &clusterv1.MachineProvisioningFailure{
+				Retryable: ptr.To(false),
+				Code:      clusterv1.MachineInfrastructureImageNotFoundReason,
+				Message:   "image foo not found",
+			},
+		},
+		{
+			name:         "terminal failure reported in the v1beta1 failureReason and failureMessage",
+			machine:      machine(false, nil),
+			infraMachine: infraMachine(map[string]interface{}{"failureReason": "InvalidConfiguration", "failureMessage": "invalid instance type"}),
+			expectFailure: &clusterv1.MachineProvisioningFailure{
+				Retryable: ptr.To(false),
+				Code:      "InvalidConfiguration",
+				Message:   "invalid instance type",
+			},
+		},
+		{
+			name:              "time of the last failure is preserved if the failure is still reported",
+			machine:           machine(false, quotaExceeded.DeepCopy(), infrastructureNotReady(clusterv1.MachineInfrastructureQuotaExceededReason, "vCPU quota exceeded")),
+			infraMachine:      infraMachine(nil),
+			expectFailure:     quotaExceeded,
+			expectFailureTime: &lastFailureTime,
+		},
+		{
+			name:              "last failure is preserved if the failure is not reported anymore",
+			machine:           machine(false, quotaExceeded.DeepCopy(), infrastructureNotReady(clusterv1.MachineInfrastructureNotReadyReason, "Waiting for instance")),
+			infraMachine:      infraMachine(nil),
+			expectFailure:     quotaExceeded,
+			expectFailureTime: &lastFailureTime,
+		},
+		{
+			name:          "last failure is removed once the infrastructure is provisioned",
+			machine:       machine(true, quotaExceeded.DeepCopy()),
+			infraMachine:  infraMachine(nil),
+			expectFailure: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setLastProvisioningFailure(ctx, tc.machine, tc.infraMachine)
+
+			failure := tc.machine.Status.LastProvisioningFailure
+			if tc.expectFailure == nil {
+				g.Expect(failure).To(BeNil())
+				return
+			}
+			g.Expect(failure).ToNot(BeNil())
+			g.Expect(failure.Retryable).To(Equal(tc.expectFailure.Retryable))
+			g.Expect(failure.Code).To(Equal(tc.expectFailure.Code))
+			g.Expect(failure.Message).To(Equal(tc.expectFailure.Message))
+			if tc.expectFailureTime != nil {
+				g.Expect(failure.Time).To(Equal(*tc.expectFailureTime))
+			} else {
+				g.Expect(failure.Time.IsZero()).To(BeFalse())
+			}
+		})
+	}
+}
+
 func TestSummarizeNodeV1Beta2Conditions(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -2122,6 +2634,59 @@ func TestSetReadyCondition(t *testing.T) {
 					"* MyReadinessGateGateWithNegativePolarity: Some other message",
 			},
 		},
+		{
+			name: "Takes into account KubeletServingCertificateVerified when kubelet serving certificate verification is enabled",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "machine-test",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: clusterv1.MachineSpec{
+					KubeletServingCertificateVerification: clusterv1.MachineKubeletServingCertificateVerificationEnabled,
+				},
+				Status: clusterv1.MachineStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   clusterv1.MachineBootstrapConfigReadyCondition,
+							Status: metav1.ConditionTrue,
+							Reason: "Foo",
+						},
+						{
+							Type:   clusterv1.InfrastructureReadyCondition,
+							Status: metav1.ConditionTrue,
+							Reason: "Foo",
+						},
+						{
+							Type:   clusterv1.MachineNodeHealthyCondition,
+							Status: metav1.ConditionTrue,
+							Reason: "Foo",
+						},
+						{
+							Type:    clusterv1.MachineKubeletServingCertificateVerifiedCondition,
+							Status:  metav1.ConditionFalse,
+							Reason:  clusterv1.MachineKubeletServingCertificateInvalidReason,
+							Message: "Kubelet serving certificate for Node node1 is invalid",
+						},
+						{
+							Type:   clusterv1.MachineDeletingCondition,
+							Status: metav1.ConditionFalse,
+							Reason: clusterv1.MachineNotDeletingReason,
+						},
+						{
+							Type:   clusterv1.MachineUpdatingCondition,
+							Status: metav1.ConditionFalse,
+							Reason: clusterv1.MachineNotUpdatingReason,
+						},
+					},
+				},
+			},
+			expectCondition: metav1.Condition{
+				Type:    clusterv1.MachineReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.MachineNotReadyReason,
+				Message: "* KubeletServingCertificateVerified: Kubelet serving certificate for Node node1 is invalid",
+			},
+		},
 		{
 			name: "Groups readiness gates for control plane components and etcd member when possible and there is more than one condition for each category",
 			machine: &clusterv1.Machine{
@@ -2168,11 +2168,15 @@ func TestShouldWaitForNodeVolumes(t *testing.T) {
 	persistentVolumeWithoutClaim := persistentVolume.DeepCopy()
 	persistentVolumeWithoutClaim.Spec.ClaimRef.Kind = "NotAPVC"
 
+	persistentVolumeWithStorageClass := persistentVolume.DeepCopy()
+	persistentVolumeWithStorageClass.Spec.StorageClassName = "local"
+
 	volumeAttachment := &storagev1.VolumeAttachment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "test-va",
 		},
 		Spec: storagev1.VolumeAttachmentSpec{
+			Attacher: persistentVolume.Spec.CSI.Driver,
 			NodeName: nodeName,
 			Source: storagev1.VolumeAttachmentSource{
 				PersistentVolumeName: &persistentVolume.Name,
@@ -2205,6 +2209,7 @@ func TestShouldWaitForNodeVolumes(t *testing.T) {
 		name                    string
 		node                    *corev1.Node
 		remoteObjects           []client.Object
+		exclusions              clusterv1.MachineNodeVolumeDetachExclusions
 		featureGateDisabled     bool
 		expected                ctrl.Result
 		expectedDeletingReason  string
@@ -2333,6 +2338,77 @@ func TestShouldWaitForNodeVolumes(t *testing.T) {
 			},
 			expected: ctrl.Result{},
 		},
+		{
+			name: "Node has volumes attached according to node status but the CSI driver is excluded",
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:   corev1.NodeReady,
+							Status: corev1.ConditionTrue,
+						},
+					},
+					VolumesAttached: attachedVolumes,
+				},
+			},
+			remoteObjects: []client.Object{},
+			exclusions: clusterv1.MachineNodeVolumeDetachExclusions{
+				CSIDrivers: []string{"dummy"},
+			},
+			expected: ctrl.Result{},
+		},
+		{
+			name: "Node has volumes attached according to volumeattachments but the CSI driver is excluded",
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:   corev1.NodeReady,
+							Status: corev1.ConditionTrue,
+						},
+					},
+				},
+			},
+			remoteObjects: []client.Object{
+				volumeAttachment,
+				persistentVolume,
+			},
+			exclusions: clusterv1.MachineNodeVolumeDetachExclusions{
+				CSIDrivers: []string{"dummy"},
+			},
+			expected: ctrl.Result{},
+		},
+		{
+			name: "Node has volumes attached according to node status and volumeattachments but the StorageClass is excluded",
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:   corev1.NodeReady,
+							Status: corev1.ConditionTrue,
+						},
+					},
+					VolumesAttached: attachedVolumes,
+				},
+			},
+			remoteObjects: []client.Object{
+				volumeAttachment,
+				persistentVolumeWithStorageClass,
+			},
+			exclusions: clusterv1.MachineNodeVolumeDetachExclusions{
+				StorageClassNames: []string{"local"},
+			},
+			expected: ctrl.Result{},
+		},
 		{
 			name: "Node has volumes attached according to volumeattachments",
 			node: &corev1.Node{
@@ -2589,6 +2665,7 @@ func TestShouldWaitForNodeVolumes(t *testing.T) {
 			testMachine.Status.NodeRef = clusterv1.MachineNodeReference{
 				Name: tt.node.GetName(),
 			}
+			testMachine.Spec.Deletion.NodeVolumeDetachExclusions = tt.exclusions
 
 			s := &scope{
 				cluster: testCluster,
@@ -0,0 +1,256 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinedeployment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// autoscalerStatusConfigMapName is the default name of the ConfigMap where the cluster autoscaler writes its status.
+	autoscalerStatusConfigMapName = "cluster-autoscaler-status"
+
+	// autoscalerStatusConfigMapKey is the key of the status in the cluster autoscaler status ConfigMap.
+	autoscalerStatusConfigMapKey = "status"
+
+	// autoscalerStatusResyncPeriod is the interval at which the cluster autoscaler status is read again
+	// for autoscaled MachineDeployments; the status ConfigMap is not watched.
+	autoscalerStatusResyncPeriod = 1 * time.Minute
+)
+
+// Values used by the cluster autoscaler in its status ConfigMap.
+const (
+	autoscalerHealthStatusHealthy  = "Healthy"
+	autoscalerScaleUpStatusBackoff = "Backoff"
+)
+
+// autoscalerStatus is the subset of the cluster autoscaler status ConfigMap used by Cluster API.
+// Note: this is the YAML format used by the cluster autoscaler starting from v1.30; the human-readable format
+// used by previous versions is not supported.
+type autoscalerStatus struct {
+	AutoscalerStatus string                      `json:"autoscalerStatus"`
+	NodeGroups       []autoscalerNodeGroupStatus `json:"nodeGroups,omitempty"`
+}
+
+// autoscalerNodeGroupStatus is the status of a node group as reported by the cluster autoscaler.
+type autoscalerNodeGroupStatus struct {
+	Name    string                     `json:"name"`
+	Health  autoscalerNodeGroupHealth  `json:"health"`
+	ScaleUp autoscalerNodeGroupScaleUp `json:"scaleUp"`
+}
+
+type autoscalerNodeGroupHealth struct {
+	Status string `json:"status"`
+}
+
+type autoscalerNodeGroupScaleUp struct {
+	Status      string                 `json:"status"`
+	BackoffInfo *autoscalerBackoffInfo `json:"backoffInfo,omitempty"`
+}
+
+type autoscalerBackoffInfo struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// isAutoscaled returns true if the MachineDeployment is managed by the cluster autoscaler.
+func isAutoscaled(md *clusterv1.MachineDeployment) bool {
+	_, hasMinSize := md.Annotations[clusterv1.AutoscalerMinSizeAnnotation]
+	_, hasMaxSize := md.Annotations[clusterv1.AutoscalerMaxSizeAnnotation]
+	return hasMinSize && hasMaxSize
+}
+
+// autoscalerNodeGroupName returns the name used by the Cluster API provider of the cluster autoscaler
+// for the node group corresponding to a MachineDeployment.
+func autoscalerNodeGroupName(md *clusterv1.MachineDeployment) string {
+	return fmt.Sprintf("%s/%s/%s", machineDeploymentKind.Kind, md.Namespace, md.Name)
+}
+
+// getAutoscalerStatus reads the status of the node group corresponding to the MachineDeployment from the
+// cluster autoscaler status ConfigMap.
+// The ConfigMap is read from the MachineDeployment namespace in the management cluster first, because this is where the
+// cluster autoscaler writes it when running in the management cluster, and then from the kube-system namespace in
+// the workload cluster.
+// Note: Errors are not returned, because they must not block the reconciliation of the MachineDeployment; instead they are logged,
+// and the AutoscalerHealthy condition is kept as it is.
+func (r *Reconciler) getAutoscalerStatus(ctx context.Context, s *scope) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if !isAutoscaled(s.machineDeployment) {
+		return
+	}
+
+	configMap, err := r.getAutoscalerStatusConfigMap(ctx, s.cluster, s.machineDeployment.Namespace)
+	if err != nil {
+		if !errors.Is(err, clustercache.ErrClusterNotConnected) {
+			log.Error(err, "Failed to get cluster autoscaler status")
+		}
+		return
+	}
+	s.getAutoscalerStatusSucceeded = true
+	if configMap == nil {
+		return
+	}
+
+	status, err := parseAutoscalerStatus(configMap)
+	if err != nil {
+		s.autoscalerStatusInvalid = true
+		s.autoscalerStatusMessage = err.Error()
+		return
+	}
+
+	nodeGroupName := autoscalerNodeGroupName(s.machineDeployment)
+	for i := range status.NodeGroups {
+		if status.NodeGroups[i].Name == nodeGroupName {
+			s.autoscalerNodeGroupStatus = &status.NodeGroups[i]
+			return
+		}
+	}
+}
+
+// getAutoscalerStatusConfigMap returns the cluster autoscaler status ConfigMap, or nil if it does not exist.
+func (r *Reconciler) getAutoscalerStatusConfigMap(ctx context.Context, cluster *clusterv1.Cluster, namespace string) (*corev1.ConfigMap, error) {
+	// Note: ConfigMaps are not cached by the management cluster client.
+	configMap := &corev1.ConfigMap{}
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: autoscalerStatusConfigMapName}, configMap)
+	if err == nil {
+		return configMap, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "failed to get ConfigMap %s/%s", namespace, autoscalerStatusConfigMapName)
+	}
+
+	// The workload cluster cannot be read if the ClusterCache is not configured or before the control plane is initialized.
+	if r.ClusterCache == nil || !conditions.IsTrue(cluster, clusterv1.ClusterControlPlaneInitializedCondition) {
+		return nil, nil
+	}
+
+	// Note: ConfigMaps are not cached by the workload cluster client.
+	remoteClient, err := r.ClusterCache.GetClient(ctx, util.ObjectKey(cluster))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get client for the workload cluster")
+	}
+	configMap = &corev1.ConfigMap{}
+	if err := remoteClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceSystem, Name: autoscalerStatusConfigMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get ConfigMap %s/%s from the workload cluster", metav1.NamespaceSystem, autoscalerStatusConfigMapName)
+	}
+	return configMap, nil
+}
+
+// parseAutoscalerStatus parses the status reported in the cluster autoscaler status ConfigMap.
+func parseAutoscalerStatus(configMap *corev1.ConfigMap) (*autoscalerStatus, error) {
+	data, ok := configMap.Data[autoscalerStatusConfigMapKey]
+	if !ok {
+		return nil, errors.Errorf("ConfigMap %s/%s does not have the %s key", configMap.Namespace, configMap.Name, autoscalerStatusConfigMapKey)
+	}
+
+	status := &autoscalerStatus{}
+	if err := yaml.Unmarshal([]byte(data), status); err != nil || status.AutoscalerStatus == "" {
+		return nil, errors.Errorf("ConfigMap %s/%s has a status in a format that is not supported, cluster autoscaler v1.30 or later is required", configMap.Namespace, configMap.Name)
+	}
+	return status, nil
+}
+
+// setAutoscalerHealthyCondition sets the AutoscalerHealthy condition from the status of the node group
+// reported by the cluster autoscaler.
+func setAutoscalerHealthyCondition(_ context.Context, machineDeployment *clusterv1.MachineDeployment, nodeGroupStatus *autoscalerNodeGroupStatus, statusInvalid bool, statusMessage string, getAutoscalerStatusSucceeded bool) {
+	// The AutoscalerHealthy condition is surfaced only if the MachineDeployment is autoscaled.
+	if !isAutoscaled(machineDeployment) {
+		conditions.Delete(machineDeployment, clusterv1.MachineDeploymentAutoscalerHealthyCondition)
+		return
+	}
+
+	// If we got errors in reading the cluster autoscaler status, keep the condition as it is.
+	if !getAutoscalerStatusSucceeded {
+		return
+	}
+
+	if statusInvalid {
+		conditions.Set(machineDeployment, metav1.Condition{
+			Type:    clusterv1.MachineDeploymentAutoscalerHealthyCondition,
+			Status:  metav1.ConditionUnknown,
+			Reason:  clusterv1.MachineDeploymentAutoscalerStatusInvalidReason,
+			Message: statusMessage,
+		})
+		return
+	}
+
+	// If the cluster autoscaler does not report a status for the node group, e.g. because it is not deployed
+	// or it is not yet aware of the MachineDeployment, there is nothing to surface.
+	if nodeGroupStatus == nil {
+		conditions.Delete(machineDeployment, clusterv1.MachineDeploymentAutoscalerHealthyCondition)
+		return
+	}
+
+	if nodeGroupStatus.ScaleUp.Status == autoscalerScaleUpStatusBackoff {
+		message := "Scale up is in backoff due to previous failures"
+		if info := nodeGroupStatus.ScaleUp.BackoffInfo; info != nil {
+			details := []string{}
+			if info.ErrorCode != "" {
+				details = append(details, info.ErrorCode)
+			}
+			if info.ErrorMessage != "" {
+				details = append(details, info.ErrorMessage)
+			}
+			if len(details) > 0 {
+				message = fmt.Sprintf("%s: %s", message, strings.Join(details, ": "))
+			}
+		}
+		conditions.Set(machineDeployment, metav1.Condition{
+			Type:    clusterv1.MachineDeploymentAutoscalerHealthyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.MachineDeploymentAutoscalerScaleUpBackoffReason,
+			Message: message,
+		})
+		return
+	}
+
+	if nodeGroupStatus.Health.Status != "" && nodeGroupStatus.Health.Status != autoscalerHealthStatusHealthy {
+		conditions.Set(machineDeployment, metav1.Condition{
+			Type:    clusterv1.MachineDeploymentAutoscalerHealthyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.MachineDeploymentAutoscalerNodeGroupUnhealthyReason,
+			Message: fmt.Sprintf("Node group health status is %s", nodeGroupStatus.Health.Status),
+		})
+		return
+	}
+
+	conditions.Set(machineDeployment, metav1.Condition{
+		Type:   clusterv1.MachineDeploymentAutoscalerHealthyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: clusterv1.MachineDeploymentAutoscalerHealthyReason,
+	})
+}
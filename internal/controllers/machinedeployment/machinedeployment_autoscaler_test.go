@@ -0,0 +1,316 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinedeployment
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const testAutoscalerStatus = `time: 2025-06-01 10:00:00.000000000 +0000 UTC
+autoscalerStatus: Running
+clusterWide:
+  health:
+    status: Healthy
+nodeGroups:
+- name: MachineDeployment/default/md-healthy
+  health:
+    status: Healthy
+    minSize: 1
+    maxSize: 5
+  scaleUp:
+    status: NoActivity
+- name: MachineDeployment/default/md-backoff
+  health:
+    status: Healthy
+  scaleUp:
+    status: Backoff
+    backoffInfo:
+      errorCode: OutOfResource
+      errorMessage: 'instance type m5.large is not available in zone a'
+- name: MachineDeployment/default/md-unhealthy
+  health:
+    status: Unhealthy
+  scaleUp:
+    status: NoActivity
+`
+
+func Test_getAutoscalerStatus(t *testing.T) {
+	autoscaledMachineDeployment := func(name string) *clusterv1.MachineDeployment {
+		return &clusterv1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+				Annotations: map[string]string{
+					clusterv1.AutoscalerMinSizeAnnotation: "1",
+					clusterv1.AutoscalerMaxSizeAnnotation: "5",
+				},
+			},
+		}
+	}
+	statusConfigMap := func(namespace, status string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      autoscalerStatusConfigMapName,
+				Namespace: namespace,
+			},
+			Data: map[string]string{autoscalerStatusConfigMapKey: status},
+		}
+	}
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Status: clusterv1.ClusterStatus{
+			Conditions: []metav1.Condition{{
+				Type:   clusterv1.ClusterControlPlaneInitializedCondition,
+				Status: metav1.ConditionTrue,
+			}},
+		},
+	}
+
+	tests := []struct {
+		name                      string
+		machineDeployment         *clusterv1.MachineDeployment
+		objs                      []client.Object
+		workloadObjs              []client.Object
+		expectSucceeded           bool
+		expectInvalid             bool
+		expectNodeGroupScaleUp    string
+		expectNodeGroupNotPresent bool
+	}{
+		{
+			name: "MachineDeployment not autoscaled",
+			machineDeployment: &clusterv1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "md-healthy", Namespace: metav1.NamespaceDefault},
+			},
+			objs:                      []client.Object{statusConfigMap(metav1.NamespaceDefault, testAutoscalerStatus)},
+			expectSucceeded:           false,
+			expectNodeGroupNotPresent: true,
+		},
+		{
+			name:                      "status ConfigMap does not exist",
+			machineDeployment:         autoscaledMachineDeployment("md-healthy"),
+			expectSucceeded:           true,
+			expectNodeGroupNotPresent: true,
+		},
+		{
+			name:                   "status ConfigMap in the management cluster",
+			machineDeployment:      autoscaledMachineDeployment("md-backoff"),
+			objs:                   []client.Object{statusConfigMap(metav1.NamespaceDefault, testAutoscalerStatus)},
+			expectSucceeded:        true,
+			expectNodeGroupScaleUp: "Backoff",
+		},
+		{
+			name:                   "status ConfigMap in the workload cluster",
+			machineDeployment:      autoscaledMachineDeployment("md-healthy"),
+			workloadObjs:           []client.Object{statusConfigMap(metav1.NamespaceSystem, testAutoscalerStatus)},
+			expectSucceeded:        true,
+			expectNodeGroupScaleUp: "NoActivity",
+		},
+		{
+			name:                      "node group not reported in the status ConfigMap",
+			machineDeployment:         autoscaledMachineDeployment("md-other"),
+			objs:                      []client.Object{statusConfigMap(metav1.NamespaceDefault, testAutoscalerStatus)},
+			expectSucceeded:           true,
+			expectNodeGroupNotPresent: true,
+		},
+		{
+			name:                      "status ConfigMap in a format that is not supported",
+			machineDeployment:         autoscaledMachineDeployment("md-healthy"),
+			objs:                      []client.Object{statusConfigMap(metav1.NamespaceDefault, "Cluster-autoscaler status at 2025-06-01 10:00:00 +0000 UTC:\nCluster-wide:\n  Health:      Healthy (ready=3 unready=0)")},
+			expectSucceeded:           true,
+			expectInvalid:             true,
+			expectNodeGroupNotPresent: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := &Reconciler{
+				Client:       fake.NewClientBuilder().WithObjects(tt.objs...).Build(),
+				ClusterCache: clustercache.NewFakeClusterCache(fake.NewClientBuilder().WithObjects(tt.workloadObjs...).Build(), client.ObjectKeyFromObject(cluster)),
+			}
+			s := &scope{
+				machineDeployment: tt.machineDeployment,
+				cluster:           cluster,
+			}
+
+			r.getAutoscalerStatus(ctx, s)
+
+			g.Expect(s.getAutoscalerStatusSucceeded).To(Equal(tt.expectSucceeded))
+			g.Expect(s.autoscalerStatusInvalid).To(Equal(tt.expectInvalid))
+			if tt.expectNodeGroupNotPresent {
+				g.Expect(s.autoscalerNodeGroupStatus).To(BeNil())
+				return
+			}
+			g.Expect(s.autoscalerNodeGroupStatus).ToNot(BeNil())
+			g.Expect(s.autoscalerNodeGroupStatus.Name).To(Equal(autoscalerNodeGroupName(tt.machineDeployment)))
+			g.Expect(s.autoscalerNodeGroupStatus.ScaleUp.Status).To(Equal(tt.expectNodeGroupScaleUp))
+		})
+	}
+}
+
+func Test_setAutoscalerHealthyCondition(t *testing.T) {
+	autoscalerHealthy := metav1.Condition{
+		Type:   clusterv1.MachineDeploymentAutoscalerHealthyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: clusterv1.MachineDeploymentAutoscalerHealthyReason,
+	}
+	autoscaledMachineDeployment := func(conditions ...metav1.Condition) *clusterv1.MachineDeployment {
+		return &clusterv1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					clusterv1.AutoscalerMinSizeAnnotation: "1",
+					clusterv1.AutoscalerMaxSizeAnnotation: "5",
+				},
+			},
+			Status: clusterv1.MachineDeploymentStatus{Conditions: conditions},
+		}
+	}
+
+	tests := []struct {
+		name                         string
+		machineDeployment            *clusterv1.MachineDeployment
+		nodeGroupStatus              *autoscalerNodeGroupStatus
+		statusInvalid                bool
+		statusMessage                string
+		getAutoscalerStatusSucceeded bool
+		expectCondition              *metav1.Condition
+	}{
+		{
+			name: "condition is removed when the MachineDeployment is not autoscaled",
+			machineDeployment: &clusterv1.MachineDeployment{
+				Status: clusterv1.MachineDeploymentStatus{Conditions: []metav1.Condition{autoscalerHealthy}},
+			},
+			getAutoscalerStatusSucceeded: true,
+			expectCondition:              nil,
+		},
+		{
+			name:                         "condition is kept as it is when failing to read the status",
+			machineDeployment:            autoscaledMachineDeployment(autoscalerHealthy),
+			getAutoscalerStatusSucceeded: false,
+			expectCondition:              &autoscalerHealthy,
+		},
+		{
+			name:                         "condition is removed when the node group is not reported",
+			machineDeployment:            autoscaledMachineDeployment(autoscalerHealthy),
+			getAutoscalerStatusSucceeded: true,
+			expectCondition:              nil,
+		},
+		{
+			name:                         "status in a format that is not supported",
+			machineDeployment:            autoscaledMachineDeployment(),
+			statusInvalid:                true,
+			statusMessage:                "ConfigMap default/cluster-autoscaler-status has a status in a format that is not supported",
+			getAutoscalerStatusSucceeded: true,
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.MachineDeploymentAutoscalerHealthyCondition,
+				Status:  metav1.ConditionUnknown,
+				Reason:  clusterv1.MachineDeploymentAutoscalerStatusInvalidReason,
+				Message: "ConfigMap default/cluster-autoscaler-status has a status in a format that is not supported",
+			},
+		},
+		{
+			name:              "node group healthy",
+			machineDeployment: autoscaledMachineDeployment(),
+			nodeGroupStatus: &autoscalerNodeGroupStatus{
+				Health:  autoscalerNodeGroupHealth{Status: "Healthy"},
+				ScaleUp: autoscalerNodeGroupScaleUp{Status: "InProgress"},
+			},
+			getAutoscalerStatusSucceeded: true,
+			expectCondition:              &autoscalerHealthy,
+		},
+		{
+			name:              "scale up in backoff",
+			machineDeployment: autoscaledMachineDeployment(autoscalerHealthy),
+			nodeGroupStatus: &autoscalerNodeGroupStatus{
+				Health: autoscalerNodeGroupHealth{Status: "Healthy"},
+				ScaleUp: autoscalerNodeGroupScaleUp{
+					Status: "Backoff",
+					BackoffInfo: &autoscalerBackoffInfo{
+						ErrorCode:    "OutOfResource",
+						ErrorMessage: "instance type m5.large is not available in zone a",
+					},
+				},
+			},
+			getAutoscalerStatusSucceeded: true,
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.MachineDeploymentAutoscalerHealthyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.MachineDeploymentAutoscalerScaleUpBackoffReason,
+				Message: "Scale up is in backoff due to previous failures: OutOfResource: instance type m5.large is not available in zone a",
+			},
+		},
+		{
+			name:              "scale up in backoff without details",
+			machineDeployment: autoscaledMachineDeployment(),
+			nodeGroupStatus: &autoscalerNodeGroupStatus{
+				Health:  autoscalerNodeGroupHealth{Status: "Unhealthy"},
+				ScaleUp: autoscalerNodeGroupScaleUp{Status: "Backoff"},
+			},
+			getAutoscalerStatusSucceeded: true,
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.MachineDeploymentAutoscalerHealthyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.MachineDeploymentAutoscalerScaleUpBackoffReason,
+				Message: "Scale up is in backoff due to previous failures",
+			},
+		},
+		{
+			name:              "node group unhealthy",
+			machineDeployment: autoscaledMachineDeployment(),
+			nodeGroupStatus: &autoscalerNodeGroupStatus{
+				Health:  autoscalerNodeGroupHealth{Status: "Unhealthy"},
+				ScaleUp: autoscalerNodeGroupScaleUp{Status: "NoActivity"},
+			},
+			getAutoscalerStatusSucceeded: true,
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.MachineDeploymentAutoscalerHealthyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.MachineDeploymentAutoscalerNodeGroupUnhealthyReason,
+				Message: "Node group health status is Unhealthy",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setAutoscalerHealthyCondition(ctx, tt.machineDeployment, tt.nodeGroupStatus, tt.statusInvalid, tt.statusMessage, tt.getAutoscalerStatusSucceeded)
+
+			condition := conditions.Get(tt.machineDeployment, clusterv1.MachineDeploymentAutoscalerHealthyCondition)
+			if tt.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(*tt.expectCondition, conditions.IgnoreLastTransitionTime(true)))
+		})
+	}
+}
@@ -410,11 +410,12 @@ func Test_canExtensionsUpdateMachineSet(t *testing.T) {
 +         Version:           "v1.31.0",
           ProviderID:        "",
           FailureDomain:     "",
-          ... // 4 identical fields
+          ... // 9 identical fields
         },
       },
       MachineNaming: {},
       Deletion:      {},
+      ... // 2 identical fields
     },
     Status: {},
   }`,
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -39,13 +40,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
 	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/controllers/machinedeployment/mdutil"
 	clientutil "sigs.k8s.io/cluster-api/internal/util/client"
+	"sigs.k8s.io/cluster-api/internal/util/resync"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 	"sigs.k8s.io/cluster-api/util/finalizers"
 	clog "sigs.k8s.io/cluster-api/util/log"
@@ -68,6 +73,7 @@ const machineDeploymentManagerName = "capi-machinedeployment"
 //
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io;bootstrap.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedeployments;machinedeployments/status;machinedeployments/finalizers,verbs=get;list;watch;create;update;patch;delete
 
@@ -77,9 +83,21 @@ type Reconciler struct {
 	APIReader     client.Reader
 	RuntimeClient runtimeclient.Client
 
+	// ClusterCache is used to read the cluster autoscaler status from the workload cluster.
+	// If not set, the cluster autoscaler status is only read from the management cluster.
+	ClusterCache clustercache.ClusterCache
+
+	// TemplateReader is used to read templates, e.g. from a cache shared across controllers.
+	// If not set, Client is used.
+	TemplateReader client.Reader
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// ResyncPeriod is the period after which all MachineDeployments are requeued, in addition to the resyncs of the cache.
+	// If 0, MachineDeployments are only requeued when the cache is resynced.
+	ResyncPeriod time.Duration
+
 	recorder record.EventRecorder
 	ssaCache ssa.Cache
 }
@@ -109,12 +127,16 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		).
 		WithOptions(options).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		WatchesRawSource(resync.Source(mgr.GetClient(), &clusterv1.MachineDeploymentList{}, r.ResyncPeriod, r.WatchFilterValue)).
 		Watches(
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(clusterToMachineDeployments),
 			builder.WithPredicates(predicates.All(mgr.GetScheme(), predicateLog,
 				predicates.ResourceIsChanged(mgr.GetScheme(), predicateLog),
-				predicates.ClusterPausedTransitions(mgr.GetScheme(), predicateLog),
+				predicates.Any(mgr.GetScheme(), predicateLog,
+					predicates.ClusterPausedTransitions(mgr.GetScheme(), predicateLog),
+					predicates.ClusterDegradedFreezeTransitions(mgr.GetScheme(), predicateLog),
+				),
 			)),
 			// TODO: should this wait for Cluster.Status.InfrastructureReady similar to Infra Machine resources?
 		).Complete(r)
@@ -206,7 +228,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ct
 		return ctrl.Result{}, r.reconcileDelete(ctx, s)
 	}
 
-	return ctrl.Result{}, r.reconcile(ctx, s)
+	if err := r.reconcile(ctx, s); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Periodically read the cluster autoscaler status again, given that the status ConfigMap is not watched.
+	if isAutoscaled(deployment) && (s.requeueAfter == 0 || s.requeueAfter > autoscalerStatusResyncPeriod) {
+		s.requeueAfter = autoscalerStatusResyncPeriod
+	}
+
+	// Reconcile again at the next transition of the power state schedule, given that it is not triggered by any event.
+	// Note: errors are surfaced when computing the desired MachineSets.
+	if _, next, err := mdutil.DesiredPowerState(deployment, time.Now()); err == nil && next > 0 && (s.requeueAfter == 0 || s.requeueAfter > next) {
+		s.requeueAfter = next
+	}
+	return ctrl.Result{RequeueAfter: s.requeueAfter}, nil
 }
 
 type scope struct {
@@ -219,6 +255,11 @@ type scope struct {
 	infrastructureTemplateNotFound               bool
 	infrastructureTemplateExists                 bool
 	getAndAdoptMachineSetsForDeploymentSucceeded bool
+	autoscalerNodeGroupStatus                    *autoscalerNodeGroupStatus
+	autoscalerStatusInvalid                      bool
+	autoscalerStatusMessage                      string
+	getAutoscalerStatusSucceeded                 bool
+	requeueAfter                                 time.Duration
 }
 
 func patchMachineDeployment(ctx context.Context, patchHelper *patch.Helper, md *clusterv1.MachineDeployment, options ...patch.Option) error {
@@ -244,6 +285,8 @@ func patchMachineDeployment(ctx context.Context, patchHelper *patch.Helper, md *
 			clusterv1.MachineDeploymentScalingDownCondition,
 			clusterv1.MachineDeploymentScalingUpCondition,
 			clusterv1.MachineDeploymentRemediatingCondition,
+			clusterv1.MachineDeploymentRollbackPerformedCondition,
+			clusterv1.MachineDeploymentAutoscalerHealthyCondition,
 			clusterv1.MachineDeploymentDeletingCondition,
 		}},
 	)
@@ -278,6 +321,8 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope) error {
 		UID:        cluster.UID,
 	}))
 
+	r.getAutoscalerStatus(ctx, s)
+
 	if err := r.getTemplatesAndSetOwner(ctx, s); err != nil {
 		return err
 	}
@@ -313,7 +358,14 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope) error {
 		return r.sync(ctx, md, s.machineSets, s.machines, templateExists)
 	}
 
+	// Do not roll out while automated disruptive actions on the Cluster are frozen, but keep syncing the MachineDeployment.
+	if conditions.IsTrue(cluster, clusterv1.ClusterDegradedFreezeCondition) {
+		log.Info(fmt.Sprintf("Skipping rollout, Cluster has %s condition set to True", clusterv1.ClusterDegradedFreezeCondition))
+		return r.sync(ctx, md, s.machineSets, s.machines, templateExists)
+	}
+
 	if md.Spec.Rollout.Strategy.Type == clusterv1.RollingUpdateMachineDeploymentStrategyType {
+		r.reconcileRollback(ctx, s)
 		return r.rolloutRollingUpdate(ctx, md, s.machineSets, s.machines, templateExists)
 	}
 
@@ -32,6 +32,7 @@ import (
 	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
 	"sigs.k8s.io/cluster-api/internal/controllers/machinedeployment/mdutil"
 	"sigs.k8s.io/cluster-api/internal/util/hash"
+	"sigs.k8s.io/cluster-api/internal/util/inplace"
 	"sigs.k8s.io/cluster-api/util/annotations"
 )
 
@@ -39,6 +40,9 @@ type rolloutPlanner struct {
 	Client        client.Client
 	RuntimeClient runtimeclient.Client
 
+	// TemplateReader is used to read templates; if not set, Client is used.
+	TemplateReader client.Reader
+
 	md       *clusterv1.MachineDeployment
 	revision string
 
@@ -327,12 +331,24 @@ func computeDesiredMS(ctx context.Context, deployment *clusterv1.MachineDeployme
 	// Set all other in-place mutable fields.
 	desiredMS.Spec.Deletion.Order = deployment.Spec.Deletion.Order
 	desiredMS.Spec.MachineNaming = deployment.Spec.MachineNaming
-	desiredMS.Spec.Template.Spec.MinReadySeconds = deployment.Spec.Template.Spec.MinReadySeconds
-	desiredMS.Spec.Template.Spec.ReadinessGates = deployment.Spec.Template.Spec.ReadinessGates
-	desiredMS.Spec.Template.Spec.Deletion.NodeDrainTimeoutSeconds = deployment.Spec.Template.Spec.Deletion.NodeDrainTimeoutSeconds
-	desiredMS.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds = deployment.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds
-	desiredMS.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = deployment.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
-	desiredMS.Spec.Template.Spec.Taints = deployment.Spec.Template.Spec.Taints
+	desiredMS.Spec.Rebalance = clusterv1.MachineSetRebalanceSpec{
+		Policy:         deployment.Spec.Rebalance.Policy,
+		MaxUnavailable: deployment.Spec.Rebalance.MaxUnavailable,
+	}
+	desiredMS.Spec.Standby = clusterv1.MachineSetStandbySpec{
+		Replicas: deployment.Spec.Standby.Replicas,
+	}
+	inplace.CopyMutableMachineSpecFields(&desiredMS.Spec.Template.Spec, &deployment.Spec.Template.Spec)
+	if len(deployment.Spec.Rollout.NodeReadinessChecks) > 0 {
+		desiredMS.Spec.Template.Spec.NodeReadinessChecks = deployment.Spec.Rollout.NodeReadinessChecks
+	}
+
+	// Set the power state, taking into account the power state schedule of the MachineDeployment.
+	powerState, _, err := mdutil.DesiredPowerState(deployment, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute desired MachineSet")
+	}
+	desiredMS.Spec.Template.Spec.PowerState = powerState
 
 	return desiredMS, nil
 }
@@ -258,6 +258,13 @@ func TestComputeDesiredMS(t *testing.T) {
 			Deletion: clusterv1.MachineDeploymentDeletionSpec{
 				Order: clusterv1.RandomMachineSetDeletionOrder,
 			},
+			Rebalance: clusterv1.MachineDeploymentRebalanceSpec{
+				Policy:         clusterv1.AutomaticMachineSetRebalancePolicy,
+				MaxUnavailable: ptr.To(intstr.FromInt32(2)),
+			},
+			Standby: clusterv1.MachineDeploymentStandbySpec{
+				Replicas: ptr.To[int32](1),
+			},
 			MachineNaming: clusterv1.MachineNamingSpec{
 				Template: "{{ .machineSet.name }}" + namingTemplateKey + "-{{ .random }}",
 			},
@@ -309,6 +316,13 @@ func TestComputeDesiredMS(t *testing.T) {
 			Deletion: clusterv1.MachineSetDeletionSpec{
 				Order: deployment.Spec.Deletion.Order,
 			},
+			Rebalance: clusterv1.MachineSetRebalanceSpec{
+				Policy:         deployment.Spec.Rebalance.Policy,
+				MaxUnavailable: deployment.Spec.Rebalance.MaxUnavailable,
+			},
+			Standby: clusterv1.MachineSetStandbySpec{
+				Replicas: deployment.Spec.Standby.Replicas,
+			},
 			Selector:      deployment.Spec.Selector,
 			Template:      *deployment.Spec.Template.DeepCopy(),
 			MachineNaming: deployment.Spec.MachineNaming,
@@ -326,6 +340,26 @@ func TestComputeDesiredMS(t *testing.T) {
 		assertDesiredMS(g, deployment, actualMS, expectedMS)
 	})
 
+	t.Run("should set nodeReadinessChecks from spec.rollout when defined", func(t *testing.T) {
+		deployment := deployment.DeepCopy()
+		deployment.Spec.Template.Spec.NodeReadinessChecks = []clusterv1.MachineNodeReadinessCheck{
+			{Name: "csi", Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "csi-node"}}},
+		}
+		deployment.Spec.Rollout.NodeReadinessChecks = []clusterv1.MachineNodeReadinessCheck{
+			{Name: "gpu", Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "gpu-driver"}}},
+		}
+
+		g := NewWithT(t)
+		actualMS, err := computeDesiredMS(ctx, deployment, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(actualMS.Spec.Template.Spec.NodeReadinessChecks).To(BeComparableTo(deployment.Spec.Rollout.NodeReadinessChecks))
+
+		deployment.Spec.Rollout.NodeReadinessChecks = nil
+		actualMS, err = computeDesiredMS(ctx, deployment, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(actualMS.Spec.Template.Spec.NodeReadinessChecks).To(BeComparableTo(deployment.Spec.Template.Spec.NodeReadinessChecks))
+	})
+
 	t.Run("should compute the updated MachineSet when current MS is not nil", func(t *testing.T) {
 		uid := apirand.String(5)
 		name := "foo"
@@ -478,6 +512,12 @@ func assertDesiredMS(g *WithT, md *clusterv1.MachineDeployment, actualMS *cluste
 	// Check Order
 	g.Expect(actualMS.Spec.Deletion.Order).Should(Equal(expectedMS.Spec.Deletion.Order))
 
+	// Check Rebalance
+	g.Expect(actualMS.Spec.Rebalance).Should(BeComparableTo(expectedMS.Spec.Rebalance))
+
+	// Check Standby
+	g.Expect(actualMS.Spec.Standby).Should(BeComparableTo(expectedMS.Spec.Standby))
+
 	// Check MachineTemplateSpec
 	g.Expect(actualMS.Spec.Template.Spec).Should(BeComparableTo(expectedMS.Spec.Template.Spec))
 
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinedeployment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/controllers/machinedeployment/mdutil"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/labels"
+)
+
+// reconcileRollback rolls back a MachineDeployment to the previous MachineSet when the new MachineSet did not become
+// available within spec.rollout.machineSetReadyTimeoutSeconds and spec.rollout.rollback.policy is Automatic.
+// The rollback is performed by restoring the fields of the MachineDeployment's template triggering a rollout from the
+// previous MachineSet; as a consequence the previous MachineSet becomes again the current MachineSet, and the
+// rollout planner scales down the failed MachineSet like in any other rollout.
+// Note: If the timeout is not yet expired, a requeue is requested for when the timeout expires.
+// Note: MachineDeployments managed by a ClusterClass are never rolled back.
+func (r *Reconciler) reconcileRollback(ctx context.Context, s *scope) {
+	log := ctrl.LoggerFrom(ctx)
+	md := s.machineDeployment
+
+	if md.Spec.Rollout.Rollback.Policy != clusterv1.AutomaticMachineDeploymentRollbackPolicy || md.Spec.Rollout.MachineSetReadyTimeoutSeconds == nil {
+		return
+	}
+
+	// Rollbacks are not performed for MachineDeployments managed by a ClusterClass, because spec.template is owned
+	// by the topology controller, which would revert the rollback and trigger the failed rollout again.
+	if labels.IsTopologyOwned(md) {
+		return
+	}
+
+	now := metav1.Now()
+	newMS, oldMSs, _, _ := mdutil.FindNewAndOldMachineSets(md, s.machineSets, now)
+	failedMS, previousMS, deadline := rollbackCandidates(md, newMS, oldMSs)
+	if failedMS == nil {
+		return
+	}
+
+	if now.Before(&metav1.Time{Time: deadline}) {
+		s.requeueAfter = deadline.Sub(now.Time)
+		return
+	}
+
+	// Restore the fields triggering a rollout from the previous MachineSet, and check the previous MachineSet
+	// becomes the current one; this is not the case e.g. if the rollout has been triggered by spec.rollout.after.
+	rolledBackMD := md.DeepCopy()
+	restoreMachineTemplateRolloutFields(&rolledBackMD.Spec.Template, &previousMS.Spec.Template)
+	restoredMS, _, _, _ := mdutil.FindNewAndOldMachineSets(rolledBackMD, s.machineSets, now)
+	if restoredMS == nil || restoredMS.Name != previousMS.Name {
+		log.Info(fmt.Sprintf("MachineSet %s did not become available within %ds, but it is not possible to roll back to MachineSet %s", failedMS.Name, *md.Spec.Rollout.MachineSetReadyTimeoutSeconds, previousMS.Name))
+		return
+	}
+
+	md.Spec.Template = rolledBackMD.Spec.Template
+	message := fmt.Sprintf("Rolled back from MachineSet %s to MachineSet %s because MachineSet %s did not become available within %ds", failedMS.Name, previousMS.Name, failedMS.Name, *md.Spec.Rollout.MachineSetReadyTimeoutSeconds)
+	log.Info(message, "failedMachineSet", klog.KObj(failedMS), "restoredMachineSet", klog.KObj(previousMS))
+	r.recorder.Event(md, corev1.EventTypeWarning, "RollbackPerformed", message)
+
+	conditions.Set(md, metav1.Condition{
+		Type:    clusterv1.MachineDeploymentRollbackPerformedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  clusterv1.MachineDeploymentRollbackPerformedReason,
+		Message: message,
+	})
+}
+
+// rollbackCandidates returns the current MachineSet if it is failing to become available during a rollout, the previous
+// MachineSet to roll back to, and the time when the rollout has to be considered failed.
+func rollbackCandidates(md *clusterv1.MachineDeployment, newMS *clusterv1.MachineSet, oldMSs []*clusterv1.MachineSet) (failedMS, previousMS *clusterv1.MachineSet, deadline time.Time) {
+	if newMS == nil || !newMS.DeletionTimestamp.IsZero() {
+		return nil, nil, time.Time{}
+	}
+
+	// Rollbacks are only possible while a rollout is in progress, i.e. when there are still replicas on old MachineSets.
+	if mdutil.TotalMachineSetsReplicaSum(oldMSs) == 0 {
+		return nil, nil, time.Time{}
+	}
+
+	// The rollout is completed once all the desired replicas are available on the new MachineSet.
+	if ptr.Deref(newMS.Status.AvailableReplicas, 0) >= ptr.Deref(md.Spec.Replicas, 0) {
+		return nil, nil, time.Time{}
+	}
+
+	// The previous MachineSet is the old MachineSet with the highest revision.
+	var previousRevision int64
+	for _, ms := range oldMSs {
+		if !ms.DeletionTimestamp.IsZero() {
+			continue
+		}
+		revision, err := mdutil.Revision(ms)
+		if err != nil {
+			continue
+		}
+		if previousMS == nil || revision > previousRevision {
+			previousMS = ms
+			previousRevision = revision
+		}
+	}
+	if previousMS == nil {
+		return nil, nil, time.Time{}
+	}
+
+	// Only MachineSets created after the previous MachineSet can be rolled back.
+	// Note: This prevents rolling back again to the failed MachineSet after a rollback has been performed.
+	if !newMS.CreationTimestamp.After(previousMS.CreationTimestamp.Time) {
+		return nil, nil, time.Time{}
+	}
+
+	timeout := time.Duration(*md.Spec.Rollout.MachineSetReadyTimeoutSeconds) * time.Second
+	return newMS, previousMS, newMS.CreationTimestamp.Add(timeout)
+}
+
+// restoreMachineTemplateRolloutFields sets the fields of a MachineTemplateSpec triggering a rollout to the values in source.
+// Note: Fields propagated in-place are not changed; please keep this func in sync with mdutil.MachineTemplateUpToDate.
+func restoreMachineTemplateRolloutFields(template, source *clusterv1.MachineTemplateSpec) {
+	template.Spec.Version = source.Spec.Version
+	template.Spec.Bootstrap = *source.Spec.Bootstrap.DeepCopy()
+	template.Spec.InfrastructureRef = source.Spec.InfrastructureRef
+	template.Spec.FailureDomain = source.Spec.FailureDomain
+}
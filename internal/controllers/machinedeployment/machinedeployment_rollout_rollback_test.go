@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinedeployment
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func Test_reconcileRollback(t *testing.T) {
+	now := time.Now()
+
+	rollbackMachineDeployment := func(policy clusterv1.MachineDeploymentRollbackPolicy) *clusterv1.MachineDeployment {
+		return &clusterv1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "md", Namespace: metav1.NamespaceDefault},
+			Spec: clusterv1.MachineDeploymentSpec{
+				Replicas: ptr.To[int32](3),
+				Rollout: clusterv1.MachineDeploymentRolloutSpec{
+					MachineSetReadyTimeoutSeconds: ptr.To[int32](600),
+					Rollback: clusterv1.MachineDeploymentRolloutRollbackSpec{
+						Policy: policy,
+					},
+				},
+				Template: clusterv1.MachineTemplateSpec{
+					Spec: clusterv1.MachineSpec{
+						Version: "v1.31.0",
+					},
+				},
+			},
+		}
+	}
+	rollbackMachineSet := func(name, version, revision string, created time.Time, replicas, availableReplicas int32) *clusterv1.MachineSet {
+		return &clusterv1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         metav1.NamespaceDefault,
+				CreationTimestamp: metav1.Time{Time: created},
+				Annotations: map[string]string{
+					clusterv1.RevisionAnnotation: revision,
+				},
+			},
+			Spec: clusterv1.MachineSetSpec{
+				Replicas: ptr.To(replicas),
+				Template: clusterv1.MachineTemplateSpec{
+					Spec: clusterv1.MachineSpec{
+						Version: version,
+					},
+				},
+			},
+			Status: clusterv1.MachineSetStatus{
+				Replicas:          ptr.To(replicas),
+				AvailableReplicas: ptr.To(availableReplicas),
+			},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		machineDeployment *clusterv1.MachineDeployment
+		machineSets       []*clusterv1.MachineSet
+		expectVersion     string
+		expectRollback    bool
+		expectRequeue     bool
+	}{
+		{
+			name:              "no rollback if the policy is not Automatic",
+			machineDeployment: rollbackMachineDeployment(clusterv1.NeverMachineDeploymentRollbackPolicy),
+			machineSets: []*clusterv1.MachineSet{
+				rollbackMachineSet("ms1", "v1.30.0", "1", now.Add(-2*time.Hour), 2, 2),
+				rollbackMachineSet("ms2", "v1.31.0", "2", now.Add(-1*time.Hour), 2, 0),
+			},
+			expectVersion: "v1.31.0",
+		},
+		{
+			name:              "no rollback if the new MachineSet is available",
+			machineDeployment: rollbackMachineDeployment(clusterv1.AutomaticMachineDeploymentRollbackPolicy),
+			machineSets: []*clusterv1.MachineSet{
+				rollbackMachineSet("ms1", "v1.30.0", "1", now.Add(-2*time.Hour), 1, 1),
+				rollbackMachineSet("ms2", "v1.31.0", "2", now.Add(-1*time.Hour), 3, 3),
+			},
+			expectVersion: "v1.31.0",
+		},
+		{
+			name:              "no rollback if the rollout is completed",
+			machineDeployment: rollbackMachineDeployment(clusterv1.AutomaticMachineDeploymentRollbackPolicy),
+			machineSets: []*clusterv1.MachineSet{
+				rollbackMachineSet("ms1", "v1.30.0", "1", now.Add(-2*time.Hour), 0, 0),
+				rollbackMachineSet("ms2", "v1.31.0", "2", now.Add(-1*time.Hour), 3, 2),
+			},
+			expectVersion: "v1.31.0",
+		},
+		{
+			name:              "requeue if the timeout is not expired yet",
+			machineDeployment: rollbackMachineDeployment(clusterv1.AutomaticMachineDeploymentRollbackPolicy),
+			machineSets: []*clusterv1.MachineSet{
+				rollbackMachineSet("ms1", "v1.30.0", "1", now.Add(-2*time.Hour), 2, 2),
+				rollbackMachineSet("ms2", "v1.31.0", "2", now.Add(-1*time.Minute), 2, 0),
+			},
+			expectVersion: "v1.31.0",
+			expectRequeue: true,
+		},
+		{
+			name:              "no rollback if the new MachineSet is older than the previous MachineSet",
+			machineDeployment: rollbackMachineDeployment(clusterv1.AutomaticMachineDeploymentRollbackPolicy),
+			machineSets: []*clusterv1.MachineSet{
+				rollbackMachineSet("ms1", "v1.30.0", "1", now.Add(-1*time.Hour), 2, 0),
+				rollbackMachineSet("ms2", "v1.31.0", "3", now.Add(-2*time.Hour), 2, 0),
+			},
+			expectVersion: "v1.31.0",
+		},
+		{
+			name: "no rollback if the MachineDeployment is managed by a ClusterClass",
+			machineDeployment: func() *clusterv1.MachineDeployment {
+				md := rollbackMachineDeployment(clusterv1.AutomaticMachineDeploymentRollbackPolicy)
+				md.Labels = map[string]string{clusterv1.ClusterTopologyOwnedLabel: ""}
+				return md
+			}(),
+			machineSets: []*clusterv1.MachineSet{
+				rollbackMachineSet("ms1", "v1.30.0", "1", now.Add(-2*time.Hour), 2, 2),
+				rollbackMachineSet("ms2", "v1.31.0", "2", now.Add(-1*time.Hour), 2, 0),
+			},
+			expectVersion: "v1.31.0",
+		},
+		{
+			name:              "rollback to the previous MachineSet if the timeout is expired",
+			machineDeployment: rollbackMachineDeployment(clusterv1.AutomaticMachineDeploymentRollbackPolicy),
+			machineSets: []*clusterv1.MachineSet{
+				rollbackMachineSet("ms0", "v1.29.0", "1", now.Add(-3*time.Hour), 0, 0),
+				rollbackMachineSet("ms1", "v1.30.0", "2", now.Add(-2*time.Hour), 2, 2),
+				rollbackMachineSet("ms2", "v1.31.0", "3", now.Add(-1*time.Hour), 2, 0),
+			},
+			expectVersion:  "v1.30.0",
+			expectRollback: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := &Reconciler{
+				recorder: record.NewFakeRecorder(32),
+			}
+			s := &scope{
+				machineDeployment: tt.machineDeployment,
+				machineSets:       tt.machineSets,
+			}
+			r.reconcileRollback(ctx, s)
+
+			g.Expect(s.machineDeployment.Spec.Template.Spec.Version).To(Equal(tt.expectVersion))
+			g.Expect(conditions.IsTrue(s.machineDeployment, clusterv1.MachineDeploymentRollbackPerformedCondition)).To(Equal(tt.expectRollback))
+			if tt.expectRequeue {
+				g.Expect(s.requeueAfter).To(BeNumerically(">", 0))
+			} else {
+				g.Expect(s.requeueAfter).To(BeZero())
+			}
+		})
+	}
+}
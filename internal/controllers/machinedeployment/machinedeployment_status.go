@@ -67,6 +67,10 @@ func (r *Reconciler) updateStatus(ctx context.Context, s *scope) (retErr error)
 
 	setRemediatingCondition(ctx, s.machineDeployment, machinesToBeRemediated, unhealthyMachines)
 
+	setRollbackPerformedCondition(ctx, s.machineDeployment, s.machineSets, s.getAndAdoptMachineSetsForDeploymentSucceeded)
+
+	setAutoscalerHealthyCondition(ctx, s.machineDeployment, s.autoscalerNodeGroupStatus, s.autoscalerStatusInvalid, s.autoscalerStatusMessage, s.getAutoscalerStatusSucceeded)
+
 	setDeletingCondition(ctx, s.machineDeployment, s.machineSets, s.machines, s.getAndAdoptMachineSetsForDeploymentSucceeded)
 
 	return retErr
@@ -83,6 +87,7 @@ func setReplicas(machineDeployment *clusterv1.MachineDeployment, machineSets []*
 	machineDeployment.Status.ReadyReplicas = mdutil.GetReadyReplicaCountForMachineSets(machineSets)
 	machineDeployment.Status.AvailableReplicas = mdutil.GetAvailableReplicaCountForMachineSets(machineSets)
 	machineDeployment.Status.UpToDateReplicas = mdutil.GetUptoDateReplicaCountForMachineSets(machineSets)
+	machineDeployment.Status.StoppedReplicas = mdutil.GetStoppedReplicaCountForMachineSets(machineSets)
 }
 
 func setPhase(_ context.Context, machineDeployment *clusterv1.MachineDeployment, machineSets []*clusterv1.MachineSet, getAndAdoptMachineSetsForDeploymentSucceeded bool) {
@@ -463,6 +468,39 @@ func setRemediatingCondition(ctx context.Context, machineDeployment *clusterv1.M
 	})
 }
 
+func setRollbackPerformedCondition(_ context.Context, machineDeployment *clusterv1.MachineDeployment, machineSets []*clusterv1.MachineSet, getAndAdoptMachineSetsForDeploymentSucceeded bool) {
+	// The RollbackPerformed condition is surfaced only if automatic rollbacks are enabled.
+	if machineDeployment.Spec.Rollout.Rollback.Policy != clusterv1.AutomaticMachineDeploymentRollbackPolicy {
+		conditions.Delete(machineDeployment, clusterv1.MachineDeploymentRollbackPerformedCondition)
+		return
+	}
+
+	// If we got unexpected errors in listing the machines sets (this should never happen), keep the condition as it is.
+	if !getAndAdoptMachineSetsForDeploymentSucceeded {
+		return
+	}
+
+	// Keep surfacing a rollback until a new rollout starts, i.e. until a MachineSet is created after the rollback.
+	if rollbackCondition := conditions.Get(machineDeployment, clusterv1.MachineDeploymentRollbackPerformedCondition); rollbackCondition != nil && rollbackCondition.Status == metav1.ConditionTrue {
+		newRolloutStarted := false
+		for _, ms := range machineSets {
+			if ms.CreationTimestamp.After(rollbackCondition.LastTransitionTime.Time) {
+				newRolloutStarted = true
+				break
+			}
+		}
+		if !newRolloutStarted {
+			return
+		}
+	}
+
+	conditions.Set(machineDeployment, metav1.Condition{
+		Type:   clusterv1.MachineDeploymentRollbackPerformedCondition,
+		Status: metav1.ConditionFalse,
+		Reason: clusterv1.MachineDeploymentRollbackNotPerformedReason,
+	})
+}
+
 func setDeletingCondition(_ context.Context, machineDeployment *clusterv1.MachineDeployment, machineSets []*clusterv1.MachineSet, machines collections.Machines, getAndAdoptMachineSetsForDeploymentSucceeded bool) {
 	// If we got unexpected errors in listing the machines sets or machines (this should never happen), surface them.
 	if !getAndAdoptMachineSetsForDeploymentSucceeded {
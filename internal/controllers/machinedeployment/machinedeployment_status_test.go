@@ -1214,6 +1214,95 @@ func Test_setDeletingCondition(t *testing.T) {
 	}
 }
 
+func Test_setRollbackPerformedCondition(t *testing.T) {
+	rollbackTime := time.Now().Add(-10 * time.Minute)
+	automaticRollback := clusterv1.MachineDeploymentRolloutSpec{
+		MachineSetReadyTimeoutSeconds: ptr.To[int32](300),
+		Rollback: clusterv1.MachineDeploymentRolloutRollbackSpec{
+			Policy: clusterv1.AutomaticMachineDeploymentRollbackPolicy,
+		},
+	}
+	rollbackPerformed := metav1.Condition{
+		Type:               clusterv1.MachineDeploymentRollbackPerformedCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             clusterv1.MachineDeploymentRollbackPerformedReason,
+		Message:            "Rolled back from MachineSet ms2 to MachineSet ms1 because MachineSet ms2 did not become available within 300s",
+		LastTransitionTime: metav1.Time{Time: rollbackTime},
+	}
+
+	tests := []struct {
+		name              string
+		machineDeployment *clusterv1.MachineDeployment
+		machineSets       []*clusterv1.MachineSet
+		expectCondition   *metav1.Condition
+	}{
+		{
+			name: "condition is removed when automatic rollback is not enabled",
+			machineDeployment: &clusterv1.MachineDeployment{
+				Status: clusterv1.MachineDeploymentStatus{Conditions: []metav1.Condition{rollbackPerformed}},
+			},
+			expectCondition: nil,
+		},
+		{
+			name: "no rollback performed",
+			machineDeployment: &clusterv1.MachineDeployment{
+				Spec: clusterv1.MachineDeploymentSpec{Rollout: automaticRollback},
+			},
+			machineSets: []*clusterv1.MachineSet{
+				fakeMachineSet("ms1"),
+			},
+			expectCondition: &metav1.Condition{
+				Type:   clusterv1.MachineDeploymentRollbackPerformedCondition,
+				Status: metav1.ConditionFalse,
+				Reason: clusterv1.MachineDeploymentRollbackNotPerformedReason,
+			},
+		},
+		{
+			name: "rollback performed, no new rollout",
+			machineDeployment: &clusterv1.MachineDeployment{
+				Spec:   clusterv1.MachineDeploymentSpec{Rollout: automaticRollback},
+				Status: clusterv1.MachineDeploymentStatus{Conditions: []metav1.Condition{rollbackPerformed}},
+			},
+			machineSets: []*clusterv1.MachineSet{
+				fakeMachineSet("ms1", withMachineSetCreationTimestamp(rollbackTime.Add(-1*time.Hour))),
+				fakeMachineSet("ms2", withMachineSetCreationTimestamp(rollbackTime.Add(-5*time.Minute))),
+			},
+			expectCondition: &rollbackPerformed,
+		},
+		{
+			name: "rollback performed, new rollout started afterwards",
+			machineDeployment: &clusterv1.MachineDeployment{
+				Spec:   clusterv1.MachineDeploymentSpec{Rollout: automaticRollback},
+				Status: clusterv1.MachineDeploymentStatus{Conditions: []metav1.Condition{rollbackPerformed}},
+			},
+			machineSets: []*clusterv1.MachineSet{
+				fakeMachineSet("ms1", withMachineSetCreationTimestamp(rollbackTime.Add(-1*time.Hour))),
+				fakeMachineSet("ms3", withMachineSetCreationTimestamp(rollbackTime.Add(5*time.Minute))),
+			},
+			expectCondition: &metav1.Condition{
+				Type:   clusterv1.MachineDeploymentRollbackPerformedCondition,
+				Status: metav1.ConditionFalse,
+				Reason: clusterv1.MachineDeploymentRollbackNotPerformedReason,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setRollbackPerformedCondition(ctx, tt.machineDeployment, tt.machineSets, true)
+
+			condition := conditions.Get(tt.machineDeployment, clusterv1.MachineDeploymentRollbackPerformedCondition)
+			if tt.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(*tt.expectCondition, conditions.IgnoreLastTransitionTime(true)))
+		})
+	}
+}
+
 type fakeMachineSetOption func(ms *clusterv1.MachineSet)
 
 func fakeMachineSet(name string, options ...fakeMachineSetOption) *clusterv1.MachineSet {
@@ -1228,6 +1317,12 @@ func fakeMachineSet(name string, options ...fakeMachineSetOption) *clusterv1.Mac
 	return p
 }
 
+func withMachineSetCreationTimestamp(t time.Time) fakeMachineSetOption {
+	return func(ms *clusterv1.MachineSet) {
+		ms.CreationTimestamp = metav1.Time{Time: t}
+	}
+}
+
 func withStatusReplicas(n int32) fakeMachineSetOption {
 	return func(ms *clusterv1.MachineSet) {
 		ms.Status.Replicas = ptr.To(n)
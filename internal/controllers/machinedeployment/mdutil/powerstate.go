@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mdutil
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// DesiredPowerState returns the power state the Machines of a MachineDeployment should have at the given time,
+// according to spec.powerStateSchedule and spec.template.spec.powerState.
+// It also returns the duration until the next schedule transition, or zero if there is no schedule.
+func DesiredPowerState(md *clusterv1.MachineDeployment, now time.Time) (clusterv1.MachinePowerState, time.Duration, error) {
+	schedule := md.Spec.PowerStateSchedule
+	if len(schedule.StoppedWindows) == 0 {
+		return md.Spec.Template.Spec.PowerState, 0, nil
+	}
+
+	loc := time.UTC
+	if schedule.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(schedule.TimeZone); err != nil {
+			return "", 0, errors.Wrapf(err, "failed to load time zone %q", schedule.TimeZone)
+		}
+	}
+	now = now.In(loc)
+
+	var nextStart, nextEnd time.Time
+	for _, window := range schedule.StoppedWindows {
+		startHour, startMinute, err := parseTimeOfDay(window.Start)
+		if err != nil {
+			return "", 0, err
+		}
+		endHour, endMinute, err := parseTimeOfDay(window.End)
+		if err != nil {
+			return "", 0, err
+		}
+
+		// Windows which started yesterday might still be active; look up to a week ahead for the next start.
+		for offset := -1; offset <= 7; offset++ {
+			year, month, day := now.AddDate(0, 0, offset).Date()
+			start := time.Date(year, month, day, startHour, startMinute, 0, 0, loc)
+			if !windowStartsOn(window, start.Weekday()) {
+				continue
+			}
+			end := time.Date(year, month, day, endHour, endMinute, 0, 0, loc)
+			if !end.After(start) {
+				end = time.Date(year, month, day+1, endHour, endMinute, 0, 0, loc)
+			}
+
+			if !now.Before(start) && now.Before(end) {
+				if nextEnd.IsZero() || end.Before(nextEnd) {
+					nextEnd = end
+				}
+				continue
+			}
+			if start.After(now) && (nextStart.IsZero() || start.Before(nextStart)) {
+				nextStart = start
+			}
+		}
+	}
+
+	if !nextEnd.IsZero() {
+		return clusterv1.MachinePowerStateStopped, nextEnd.Sub(now), nil
+	}
+	var next time.Duration
+	if !nextStart.IsZero() {
+		next = nextStart.Sub(now)
+	}
+	return md.Spec.Template.Spec.PowerState, next, nil
+}
+
+func windowStartsOn(window clusterv1.MachinePowerStateWindow, weekday time.Weekday) bool {
+	if len(window.Days) == 0 {
+		return true
+	}
+	for _, day := range window.Days {
+		if string(day) == weekday.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTimeOfDay(s string) (int, int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "invalid time of the day %q", s)
+	}
+	return t.Hour(), t.Minute(), nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mdutil
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestDesiredPowerState(t *testing.T) {
+	nightly := clusterv1.MachinePowerStateWindow{Start: "20:00", End: "07:00"}
+	weekend := clusterv1.MachinePowerStateWindow{Start: "00:00", End: "00:00", Days: []clusterv1.Weekday{"Saturday", "Sunday"}}
+
+	tests := []struct {
+		name           string
+		templateState  clusterv1.MachinePowerState
+		schedule       clusterv1.MachineDeploymentPowerStateScheduleSpec
+		now            time.Time
+		wantPowerState clusterv1.MachinePowerState
+		wantNext       time.Duration
+		wantErr        bool
+	}{
+		{
+			name:           "no schedule uses the template power state",
+			templateState:  clusterv1.MachinePowerStateStopped,
+			now:            time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC), // Monday
+			wantPowerState: clusterv1.MachinePowerStateStopped,
+		},
+		{
+			name:           "before the window",
+			schedule:       clusterv1.MachineDeploymentPowerStateScheduleSpec{StoppedWindows: []clusterv1.MachinePowerStateWindow{nightly}},
+			now:            time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC),
+			wantPowerState: "",
+			wantNext:       8 * time.Hour,
+		},
+		{
+			name:           "in a window starting today",
+			schedule:       clusterv1.MachineDeploymentPowerStateScheduleSpec{StoppedWindows: []clusterv1.MachinePowerStateWindow{nightly}},
+			now:            time.Date(2025, 6, 2, 22, 0, 0, 0, time.UTC),
+			wantPowerState: clusterv1.MachinePowerStateStopped,
+			wantNext:       9 * time.Hour,
+		},
+		{
+			name:           "in a window started yesterday",
+			schedule:       clusterv1.MachineDeploymentPowerStateScheduleSpec{StoppedWindows: []clusterv1.MachinePowerStateWindow{nightly}},
+			now:            time.Date(2025, 6, 3, 6, 30, 0, 0, time.UTC),
+			wantPowerState: clusterv1.MachinePowerStateStopped,
+			wantNext:       30 * time.Minute,
+		},
+		{
+			name:           "window end is exclusive",
+			templateState:  clusterv1.MachinePowerStateRunning,
+			schedule:       clusterv1.MachineDeploymentPowerStateScheduleSpec{StoppedWindows: []clusterv1.MachinePowerStateWindow{nightly}},
+			now:            time.Date(2025, 6, 3, 7, 0, 0, 0, time.UTC),
+			wantPowerState: clusterv1.MachinePowerStateRunning,
+			wantNext:       13 * time.Hour,
+		},
+		{
+			name:           "windows only start on the given days",
+			schedule:       clusterv1.MachineDeploymentPowerStateScheduleSpec{StoppedWindows: []clusterv1.MachinePowerStateWindow{weekend}},
+			now:            time.Date(2025, 6, 5, 12, 0, 0, 0, time.UTC), // Thursday
+			wantPowerState: "",
+			wantNext:       36 * time.Hour,
+		},
+		{
+			name:           "in a window on the given days",
+			schedule:       clusterv1.MachineDeploymentPowerStateScheduleSpec{StoppedWindows: []clusterv1.MachinePowerStateWindow{weekend}},
+			now:            time.Date(2025, 6, 8, 12, 0, 0, 0, time.UTC), // Sunday
+			wantPowerState: clusterv1.MachinePowerStateStopped,
+			wantNext:       12 * time.Hour,
+		},
+		{
+			name: "windows are interpreted in the time zone",
+			schedule: clusterv1.MachineDeploymentPowerStateScheduleSpec{
+				TimeZone:       "Europe/Rome",
+				StoppedWindows: []clusterv1.MachinePowerStateWindow{nightly},
+			},
+			now:            time.Date(2025, 6, 2, 18, 30, 0, 0, time.UTC), // 20:30 in Rome
+			wantPowerState: clusterv1.MachinePowerStateStopped,
+			wantNext:       10*time.Hour + 30*time.Minute,
+		},
+		{
+			name: "invalid time zone",
+			schedule: clusterv1.MachineDeploymentPowerStateScheduleSpec{
+				TimeZone:       "Not/AZone",
+				StoppedWindows: []clusterv1.MachinePowerStateWindow{nightly},
+			},
+			now:     time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			md := &clusterv1.MachineDeployment{}
+			md.Spec.Template.Spec.PowerState = tt.templateState
+			md.Spec.PowerStateSchedule = tt.schedule
+
+			powerState, next, err := DesiredPowerState(md, tt.now)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(powerState).To(Equal(tt.wantPowerState))
+			g.Expect(next).To(Equal(tt.wantNext))
+		})
+	}
+}
@@ -39,6 +39,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/util/inplace"
 	"sigs.k8s.io/cluster-api/util/conversion"
 )
 
@@ -400,6 +401,13 @@ func MachineTemplateUpToDate(current, desired *clusterv1.MachineTemplateSpec) (b
 		res.ConditionMessages = append(res.ConditionMessages, fmt.Sprintf("Failure domain %s, %s required", currentCopy.Spec.FailureDomain, desiredCopy.Spec.FailureDomain))
 	}
 
+	if !reflect.DeepEqual(currentCopy.Spec.InfrastructureOverrides, desiredCopy.Spec.InfrastructureOverrides) {
+		res.LogMessages = append(res.LogMessages, "spec.infrastructureOverrides changed")
+		res.ConditionMessages = append(res.ConditionMessages, "Infrastructure overrides are not up-to-date")
+		// Note: infrastructureOverrides are not visible to in-place update extensions, so changes always require a rollout.
+		res.EligibleForInPlaceUpdate = false
+	}
+
 	if len(res.LogMessages) > 0 || len(res.ConditionMessages) > 0 {
 		return false, res
 	}
@@ -415,7 +423,7 @@ func MachineTemplateUpToDate(current, desired *clusterv1.MachineTemplateSpec) (b
 // Note: Please update inplace.CleanupMachineSpecForDiff accordingly if necessary.
 func MachineTemplateDeepCopyRolloutFields(template *clusterv1.MachineTemplateSpec) *clusterv1.MachineTemplateSpec {
 	templateCopy := template.DeepCopy()
-	spec := templateCopy.Spec
+	spec := &templateCopy.Spec
 
 	// The following fields are set to their zero value so they are omitted from the comparison,
 	// because they should never be the reason for a rollout.
@@ -432,15 +440,10 @@ func MachineTemplateDeepCopyRolloutFields(template *clusterv1.MachineTemplateSpe
 	// Should not be set.
 	spec.ProviderID = ""
 
-	// Version & FailureDomain should be compared.
+	// Version, FailureDomain & InfrastructureOverrides should be compared.
 
 	// Fields that are mutated in-place without a rollout.
-	spec.MinReadySeconds = nil
-	spec.ReadinessGates = nil
-	spec.Deletion.NodeDrainTimeoutSeconds = nil
-	spec.Deletion.NodeVolumeDetachTimeoutSeconds = nil
-	spec.Deletion.NodeDeletionTimeoutSeconds = nil
-	spec.Taints = nil
+	inplace.ClearMutableMachineSpecFields(spec)
 
 	return templateCopy
 }
@@ -640,6 +643,17 @@ func GetUptoDateReplicaCountForMachineSets(machineSets []*clusterv1.MachineSet)
 	return totalUpToDateReplicas
 }
 
+// GetStoppedReplicaCountForMachineSets returns the number of stopped machines corresponding to the given machine sets.
+func GetStoppedReplicaCountForMachineSets(machineSets []*clusterv1.MachineSet) *int32 {
+	var totalStoppedReplicas *int32
+	for _, ms := range machineSets {
+		if ms != nil && ms.Status.StoppedReplicas != nil {
+			totalStoppedReplicas = ptr.To(ptr.Deref(totalStoppedReplicas, 0) + *ms.Status.StoppedReplicas)
+		}
+	}
+	return totalStoppedReplicas
+}
+
 // IsRollingUpdate returns true if the strategy type is a rolling update.
 func IsRollingUpdate(deployment *clusterv1.MachineDeployment) bool {
 	return deployment.Spec.Rollout.Strategy.Type == clusterv1.RollingUpdateMachineDeploymentStrategyType
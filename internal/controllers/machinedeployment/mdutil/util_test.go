@@ -26,6 +26,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -255,14 +256,20 @@ func TestMachineTemplateUpToDate(t *testing.T) {
 	machineTemplateWithDifferentBootstrapConfigRef := machineTemplate.DeepCopy()
 	machineTemplateWithDifferentBootstrapConfigRef.Spec.Bootstrap.ConfigRef.Name = "bootstrap2"
 
+	machineTemplateWithInfrastructureOverrides := machineTemplate.DeepCopy()
+	machineTemplateWithInfrastructureOverrides.Spec.InfrastructureOverrides = []clusterv1.MachineInfrastructureOverride{
+		{FailureDomain: "failure-domain1", Spec: apiextensionsv1.JSON{Raw: []byte(`{"instanceType":"large"}`)}},
+	}
+
 	tests := []struct {
-		Name                       string
-		current, desired           *clusterv1.MachineTemplateSpec
-		expectedUpToDate           bool
-		expectedLogMessages1       []string
-		expectedLogMessages2       []string
-		expectedConditionMessages1 []string
-		expectedConditionMessages2 []string
+		Name                                string
+		current, desired                    *clusterv1.MachineTemplateSpec
+		expectedUpToDate                    bool
+		expectedNotEligibleForInPlaceUpdate bool
+		expectedLogMessages1                []string
+		expectedLogMessages2                []string
+		expectedConditionMessages1          []string
+		expectedConditionMessages2          []string
 	}{
 		{
 			Name: "Same spec",
@@ -361,6 +368,17 @@ func TestMachineTemplateUpToDate(t *testing.T) {
 			expectedConditionMessages1: []string{"BootstrapConfig is not up-to-date"},
 			expectedConditionMessages2: []string{"spec.bootstrap.dataSecretName data-secret1, nil required"},
 		},
+		{
+			Name:                                "Spec changes, desired has different InfrastructureOverrides",
+			current:                             machineTemplate,
+			desired:                             machineTemplateWithInfrastructureOverrides,
+			expectedUpToDate:                    false,
+			expectedNotEligibleForInPlaceUpdate: true,
+			expectedLogMessages1:                []string{"spec.infrastructureOverrides changed"},
+			expectedLogMessages2:                []string{"spec.infrastructureOverrides changed"},
+			expectedConditionMessages1:          []string{"Infrastructure overrides are not up-to-date"},
+			expectedConditionMessages2:          []string{"Infrastructure overrides are not up-to-date"},
+		},
 	}
 
 	for _, test := range tests {
@@ -378,7 +396,7 @@ func TestMachineTemplateUpToDate(t *testing.T) {
 					g.Expect(upToDateResult.ConditionMessages).To(BeEmpty())
 				} else {
 					g.Expect(upToDateResult).ToNot(BeNil())
-					g.Expect(upToDateResult.EligibleForInPlaceUpdate).To(BeTrue())
+					g.Expect(upToDateResult.EligibleForInPlaceUpdate).To(Equal(!test.expectedNotEligibleForInPlaceUpdate))
 					g.Expect(upToDateResult.LogMessages).To(Equal(expectedLogMessages))
 					g.Expect(upToDateResult.ConditionMessages).To(Equal(expectedConditionMessages))
 				}
@@ -46,11 +46,18 @@ import (
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/api/core/v1beta2/index"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/external"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
+	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/controllers/machine"
+	"sigs.k8s.io/cluster-api/internal/hooks"
+	"sigs.k8s.io/cluster-api/internal/util/resync"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/cache"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 	"sigs.k8s.io/cluster-api/util/patch"
@@ -65,6 +72,10 @@ const (
 	// is restricted by remediation circuit shorting logic.
 	EventRemediationRestricted string = "RemediationRestricted"
 
+	// EventRemediationBlocked is emitted when remediation of a machine is blocked
+	// by the BeforeMachineRemediation hook.
+	EventRemediationBlocked string = "RemediationBlocked"
+
 	maxUnhealthyKeyLog     = "maxUnhealthy"
 	unhealthyTargetsKeyLog = "unhealthyTargets"
 	unhealthyRangeKeyLog   = "unhealthyRange"
@@ -82,15 +93,35 @@ var (
 
 // Reconciler reconciles a MachineHealthCheck object.
 type Reconciler struct {
-	Client       client.Client
-	ClusterCache clustercache.ClusterCache
+	Client        client.Client
+	ClusterCache  clustercache.ClusterCache
+	RuntimeClient runtimeclient.Client
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// ResyncPeriod is the period after which all MachineHealthChecks are requeued, in addition to the resyncs of the cache.
+	// If 0, MachineHealthChecks are only requeued when the cache is resynced.
+	ResyncPeriod time.Duration
+
+	// DisableNodeWatch disables watching Nodes in workload clusters via the ClusterCache.
+	// If set, Node changes are only picked up when MachineHealthChecks are requeued or resynced.
+	DisableNodeWatch bool
+
 	controller controller.Controller
 	recorder   record.EventRecorder
 
+	// nodeProber is used to probe Nodes if a MachineHealthCheck has a node probe.
+	nodeProber nodeProber
+
+	// nodeProbeResults stores the results of the last Node probes, so Nodes are probed at most once per
+	// probe period and it is possible to determine since when a probe is failing.
+	nodeProbeResults cache.Cache[nodeProbeResult]
+
+	// nodeConditionHistories stores the histories of node conditions, so it is possible to determine for how long
+	// a node condition has been unhealthy within the sliding window of an unhealthy node condition.
+	nodeConditionHistories cache.Cache[nodeConditionHistory]
+
 	predicateLog *logr.Logger
 }
 
@@ -98,6 +129,9 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 	if r.Client == nil || r.ClusterCache == nil {
 		return errors.New("Client and ClusterCache must not be nil")
 	}
+	if feature.Gates.Enabled(feature.RuntimeSDK) && r.RuntimeClient == nil {
+		return errors.New("RuntimeClient must not be nil when RuntimeSDK feature gate is enabled")
+	}
 
 	r.predicateLog = ptr.To(ctrl.LoggerFrom(ctx).WithValues("controller", "machinehealthcheck"))
 	c, err := ctrl.NewControllerManagedBy(mgr).
@@ -109,6 +143,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		).
 		WithOptions(options).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), *r.predicateLog, r.WatchFilterValue)).
+		WatchesRawSource(resync.Source(mgr.GetClient(), &clusterv1.MachineHealthCheckList{}, r.ResyncPeriod, r.WatchFilterValue)).
 		Watches(
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(r.clusterToMachineHealthCheck),
@@ -116,7 +151,10 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 				// TODO: should this wait for Cluster.Status.InfrastructureReady similar to Infra Machine resources?
 				predicates.All(mgr.GetScheme(), *r.predicateLog,
 					predicates.ResourceIsChanged(mgr.GetScheme(), *r.predicateLog),
-					predicates.ClusterPausedTransitions(mgr.GetScheme(), *r.predicateLog),
+					predicates.Any(mgr.GetScheme(), *r.predicateLog,
+						predicates.ClusterPausedTransitions(mgr.GetScheme(), *r.predicateLog),
+						predicates.ClusterDegradedFreezeTransitions(mgr.GetScheme(), *r.predicateLog),
+					),
 					predicates.ResourceHasFilterLabel(mgr.GetScheme(), *r.predicateLog, r.WatchFilterValue),
 				),
 			),
@@ -129,6 +167,9 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 
 	r.controller = c
 	r.recorder = mgr.GetEventRecorderFor("machinehealthcheck-controller")
+	r.nodeProber = &networkNodeProber{clusterCache: r.ClusterCache}
+	r.nodeProbeResults = cache.New[nodeProbeResult](cache.DefaultTTL)
+	r.nodeConditionHistories = cache.New[nodeConditionHistory](nodeConditionHistoryTTL)
 	return nil
 }
 
@@ -225,6 +266,8 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 	if err != nil {
 		return ctrl.Result{}, errors.Wrapf(err, "failed to fetch targets from MachineHealthCheck")
 	}
+	r.probeNodes(ctx, logger, cluster, m, targets)
+	r.recordNodeConditionHistories(m, targets)
 	totalTargets := len(targets)
 	m.Status.ExpectedMachines = ptr.To(int32(totalTargets))
 	m.Status.Targets = make([]string, totalTargets)
@@ -243,16 +286,34 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 	healthy, unhealthy, nextCheckTimes := r.healthCheckTargets(targets, logger, metav1.Duration{Duration: time.Duration(*nodeStartupTimeout) * time.Second})
 	m.Status.CurrentHealthy = ptr.To(int32(len(healthy)))
 
+	// Ensure a requeue happens to probe Nodes again, given that there are no events if a Node becomes unreachable.
+	nextCheckTimes = append(nextCheckTimes, nodeProbeNextCheckTimes(targets, m)...)
+
 	// check MHC current health against UnhealthyLessThanOrEqualTo
 	remediationAllowed, remediationCount, err := isAllowedRemediation(m)
 	if err != nil {
 		return ctrl.Result{}, errors.Wrapf(err, "error checking if remediation is allowed")
 	}
 
-	if !remediationAllowed {
+	degradedFreeze := conditions.IsTrue(cluster, clusterv1.ClusterDegradedFreezeCondition)
+	if !remediationAllowed || degradedFreeze {
 		var message string
+		v1beta1Reason := clusterv1.TooManyUnhealthyV1Beta1Reason
+		reason := clusterv1.MachineHealthCheckTooManyUnhealthyReason
 
-		if m.Spec.Remediation.TriggerIf.UnhealthyInRange == "" {
+		switch {
+		case degradedFreeze:
+			logger.V(3).Info(
+				"Short-circuiting remediation, Cluster has DegradedFreeze condition set to True",
+				totalTargetKeyLog, totalTargets,
+				unhealthyTargetsKeyLog, len(unhealthy),
+			)
+			message = fmt.Sprintf("Remediation is not allowed, automated disruptive actions on the Cluster are frozen (total: %v, unhealthy: %v)",
+				totalTargets,
+				len(unhealthy))
+			v1beta1Reason = clusterv1.MachineHealthCheckClusterDegradedFreezeReason
+			reason = clusterv1.MachineHealthCheckClusterDegradedFreezeReason
+		case m.Spec.Remediation.TriggerIf.UnhealthyInRange == "":
 			maxUnhealthyValue := ptr.To(ptr.Deref(m.Spec.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo, defaultMaxUnhealthy)).String()
 			logger.V(3).Info(
 				"Short-circuiting remediation",
@@ -264,7 +325,7 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 				totalTargets,
 				len(unhealthy),
 				maxUnhealthyValue)
-		} else {
+		default:
 			logger.V(3).Info(
 				"Short-circuiting remediation",
 				totalTargetKeyLog, totalTargets,
@@ -277,20 +338,21 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 				m.Spec.Remediation.TriggerIf.UnhealthyInRange)
 		}
 
-		// Remediation not allowed, the number of not started or unhealthy machines either exceeds maxUnhealthy (or) not within unhealthyRange
+		// Remediation not allowed, the number of not started or unhealthy machines either exceeds maxUnhealthy (or) not within unhealthyRange,
+		// or the Cluster is frozen after failures.
 		m.Status.RemediationsAllowed = ptr.To[int32](0)
 		v1beta1conditions.Set(m, &clusterv1.Condition{
 			Type:     clusterv1.RemediationAllowedV1Beta1Condition,
 			Status:   corev1.ConditionFalse,
 			Severity: clusterv1.ConditionSeverityWarning,
-			Reason:   clusterv1.TooManyUnhealthyV1Beta1Reason,
+			Reason:   v1beta1Reason,
 			Message:  message,
 		})
 
 		conditions.Set(m, metav1.Condition{
 			Type:    clusterv1.MachineHealthCheckRemediationAllowedCondition,
 			Status:  metav1.ConditionFalse,
-			Reason:  clusterv1.MachineHealthCheckTooManyUnhealthyReason,
+			Reason:  reason,
 			Message: message,
 		})
 
@@ -353,9 +415,18 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 		Reason: clusterv1.MachineHealthCheckRemediationAllowedReason,
 	})
 
-	errList := r.patchUnhealthyTargets(ctx, logger, unhealthy, cluster, m)
+	blockedNextCheckTimes, errList := r.patchUnhealthyTargets(ctx, logger, unhealthy, cluster, m)
 	errList = append(errList, r.patchHealthyTargets(ctx, logger, healthy, m)...)
 
+	// Ensure a requeue happens when remediations blocked by the BeforeMachineRemediation hook should be retried.
+	nextCheckTimes = append(nextCheckTimes, blockedNextCheckTimes...)
+
+	// Ensure a requeue happens when pending reboot requests time out.
+	nextCheckTimes = append(nextCheckTimes, rebootNextCheckTimes(unhealthy, m)...)
+
+	// Ensure a requeue happens when Machines kept cordoned for investigation should be remediated.
+	nextCheckTimes = append(nextCheckTimes, cordonNextCheckTimes(unhealthy, m)...)
+
 	// handle update errors
 	if len(errList) > 0 {
 		logger.V(3).Info("Error(s) marking machine, requeuing")
@@ -396,6 +467,14 @@ func (r *Reconciler) patchHealthyTargets(ctx context.Context, logger logr.Logger
 			}
 		}
 
+		// Reset the reboot state once the Machine is healthy again, so reboots are counted from scratch
+		// the next time the Machine fails the health check.
+		delete(t.Machine.Annotations, clusterv1.MachineRebootRequestedAnnotation)
+		delete(t.Machine.Annotations, clusterv1.MachineRebootAttemptsAnnotation)
+
+		// Remove the cordon request once the Machine is healthy again, so the Machine controller uncordons the Node.
+		delete(t.Machine.Annotations, clusterv1.MachineCordonRequestedAnnotation)
+
 		patchOpts := []patch.Option{
 			patch.WithOwnedV1Beta1Conditions{Conditions: []clusterv1.ConditionType{
 				clusterv1.MachineHealthCheckSucceededV1Beta1Condition,
@@ -416,21 +495,37 @@ func (r *Reconciler) patchHealthyTargets(ctx context.Context, logger logr.Logger
 }
 
 // patchUnhealthyTargets patches machines with MachineOwnerRemediatedCondition for remediation.
-func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logger, unhealthy []healthCheckTarget, cluster *clusterv1.Cluster, m *clusterv1.MachineHealthCheck) []error {
+// It returns the durations after which remediations blocked by the BeforeMachineRemediation hook should be retried.
+func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logger, unhealthy []healthCheckTarget, cluster *clusterv1.Cluster, m *clusterv1.MachineHealthCheck) ([]time.Duration, []error) {
 	// mark for remediation
 	errList := []error{}
+	nextCheckTimes := []time.Duration{}
 	for _, t := range unhealthy {
 		logger := logger.WithValues("Machine", klog.KObj(t.Machine), "Node", klog.KObj(t.Node))
 		condition := conditions.Get(t.Machine, clusterv1.MachineHealthCheckSucceededCondition)
 
 		if annotations.IsPaused(cluster, t.Machine) {
 			logger.Info("Machine has failed health check, but machine is paused so skipping remediation", "reason", condition.Reason, "message", condition.Message)
+		} else if hookResponse, err := r.callBeforeMachineRemediationHook(ctx, t, m); err != nil {
+			errList = append(errList, errors.Wrapf(err, "failed to call %s hook for machine %q in namespace %q within cluster %q", runtimecatalog.HookName(runtimehooksv1.BeforeMachineRemediation), t.Machine.Name, t.Machine.Namespace, t.Machine.Spec.ClusterName))
+		} else if hookResponse.RetryAfterSeconds != 0 {
+			logger.Info(fmt.Sprintf("Machine has failed health check, but remediation is blocked by %s hook", runtimecatalog.HookName(runtimehooksv1.BeforeMachineRemediation)), "reason", condition.Reason, "message", condition.Message)
+			r.recorder.Eventf(
+				t.Machine,
+				corev1.EventTypeNormal,
+				EventRemediationBlocked,
+				"Remediation of Machine %s is blocked by %s hook: %s",
+				klog.KObj(t.Machine),
+				runtimecatalog.HookName(runtimehooksv1.BeforeMachineRemediation),
+				hookResponse.Message,
+			)
+			nextCheckTimes = append(nextCheckTimes, time.Duration(hookResponse.RetryAfterSeconds)*time.Second)
 		} else {
 			if m.Spec.Remediation.TemplateRef.IsDefined() {
 				// If external remediation request already exists,
 				// return early
 				if r.externalRemediationRequestExists(ctx, m, t.Machine.Name) {
-					return errList
+					return nextCheckTimes, errList
 				}
 
 				cloneOwnerRef := &metav1.OwnerReference{
@@ -451,7 +546,7 @@ func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logg
 						Message: fmt.Sprintf("Error retrieving remediation template %s %s", m.Spec.Remediation.TemplateRef.Kind, klog.KRef(m.Namespace, m.Spec.Remediation.TemplateRef.Name)),
 					})
 					errList = append(errList, errors.Wrapf(err, "error retrieving remediation template %v %q for machine %q in namespace %q within cluster %q", m.Spec.Remediation.TemplateRef.GroupVersionKind(), m.Spec.Remediation.TemplateRef.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName))
-					return errList
+					return nextCheckTimes, errList
 				}
 
 				generateTemplateInput := &external.GenerateTemplateInput{
@@ -464,7 +559,7 @@ func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logg
 				to, err := external.GenerateTemplate(generateTemplateInput)
 				if err != nil {
 					errList = append(errList, errors.Wrapf(err, "failed to create template for remediation request %v %q for machine %q in namespace %q within cluster %q", m.Spec.Remediation.TemplateRef.GroupVersionKind(), m.Spec.Remediation.TemplateRef.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName))
-					return errList
+					return nextCheckTimes, errList
 				}
 
 				// Set the Remediation Request to match the Machine name, the name is used to
@@ -487,7 +582,7 @@ func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logg
 						Message: "Please check controller logs for errors",
 					})
 					errList = append(errList, errors.Wrapf(err, "error creating remediation request for machine %q in namespace %q within cluster %q", t.Machine.Name, t.Machine.Namespace, t.Machine.Spec.ClusterName))
-					return errList
+					return nextCheckTimes, errList
 				}
 
 				conditions.Set(t.Machine, metav1.Condition{
@@ -495,6 +590,38 @@ func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logg
 					Status: metav1.ConditionFalse,
 					Reason: clusterv1.MachineExternallyRemediatedWaitingForRemediationReason,
 				})
+			} else if t.Machine.DeletionTimestamp.IsZero() && m.Spec.Remediation.Reboot.IsDefined() && !rebootAttemptsExhausted(t.Machine, m) {
+				// NOTE: Rebooting a Machine is attempted before triggering remediation via the owner of the Machine;
+				// MHC only requests a new reboot after the previous request timed out.
+				if requestMachineReboot(t.Machine, m) {
+					attempts, _ := getMachineRebootState(t.Machine)
+					logger.Info("Machine has failed health check, requesting reboot", "reason", condition.Reason, "message", condition.Message, "attempt", attempts)
+					r.recorder.Eventf(
+						t.Machine,
+						corev1.EventTypeNormal,
+						EventMachineRebootRequested,
+						"Reboot %d of %d has been requested for Machine %s by %s",
+						attempts,
+						*m.Spec.Remediation.Reboot.MaxAttempts,
+						klog.KObj(t.Machine),
+						klog.KObj(t.MHC),
+					)
+				}
+			} else if t.Machine.DeletionTimestamp.IsZero() && m.Spec.Remediation.Cordon.IsDefined() && !cordonExpired(t.Machine, m) {
+				// NOTE: Cordoning a Machine is attempted before triggering remediation via the owner of the Machine;
+				// the Machine is kept with its Node cordoned and drained until the cordon duration expires.
+				if requestMachineCordon(t.Machine) {
+					logger.Info("Machine has failed health check, requesting cordon", "reason", condition.Reason, "message", condition.Message)
+					r.recorder.Eventf(
+						t.Machine,
+						corev1.EventTypeNormal,
+						EventMachineCordonRequested,
+						"Cordon has been requested for Machine %s by %s, remediation will be triggered in %s",
+						klog.KObj(t.Machine),
+						klog.KObj(t.MHC),
+						cordonDuration(m),
+					)
+				}
 			} else if t.Machine.DeletionTimestamp.IsZero() { // Only setting the OwnerRemediated conditions when machine is not already in deletion.
 				logger.Info("Machine has failed health check, marking for remediation", "reason", condition.Reason, "message", condition.Message)
 				// NOTE: MHC is responsible for creating MachineOwnerRemediatedCondition if missing or to trigger another remediation if the previous one is completed;
@@ -538,7 +665,168 @@ func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logg
 			klog.KObj(t.MHC),
 		)
 	}
-	return errList
+	return nextCheckTimes, errList
+}
+
+// callBeforeMachineRemediationHook calls the BeforeMachineRemediation hook if the MachineHealthCheck is going to
+// perform a new remediation action for an unhealthy target; if no new remediation action is going to be performed,
+// e.g. because a previous remediation is still in progress, the hook is not called and an empty response is returned.
+func (r *Reconciler) callBeforeMachineRemediationHook(ctx context.Context, t healthCheckTarget, m *clusterv1.MachineHealthCheck) (*runtimehooksv1.BeforeMachineRemediationResponse, error) {
+	if !feature.Gates.Enabled(feature.RuntimeSDK) {
+		return &runtimehooksv1.BeforeMachineRemediationResponse{}, nil
+	}
+
+	var action runtimehooksv1.MachineRemediationAction
+	switch {
+	case m.Spec.Remediation.TemplateRef.IsDefined():
+		if r.externalRemediationRequestExists(ctx, m, t.Machine.Name) {
+			return &runtimehooksv1.BeforeMachineRemediationResponse{}, nil
+		}
+		action = runtimehooksv1.MachineRemediationActionExternalRemediation
+	case !t.Machine.DeletionTimestamp.IsZero():
+		return &runtimehooksv1.BeforeMachineRemediationResponse{}, nil
+	case m.Spec.Remediation.Reboot.IsDefined() && !rebootAttemptsExhausted(t.Machine, m):
+		if _, requestedAt := getMachineRebootState(t.Machine); !requestedAt.IsZero() && rebootInProgress(requestedAt, m) {
+			return &runtimehooksv1.BeforeMachineRemediationResponse{}, nil
+		}
+		action = runtimehooksv1.MachineRemediationActionReboot
+	case m.Spec.Remediation.Cordon.IsDefined() && !cordonExpired(t.Machine, m):
+		if !getMachineCordonRequestedAt(t.Machine).IsZero() {
+			return &runtimehooksv1.BeforeMachineRemediationResponse{}, nil
+		}
+		action = runtimehooksv1.MachineRemediationActionCordon
+	default:
+		if ownerRemediatedCondition := conditions.Get(t.Machine, clusterv1.MachineOwnerRemediatedCondition); ownerRemediatedCondition != nil && ownerRemediatedCondition.Status != metav1.ConditionTrue {
+			return &runtimehooksv1.BeforeMachineRemediationResponse{}, nil
+		}
+		action = runtimehooksv1.MachineRemediationActionOwnerRemediation
+	}
+
+	return hooks.CallBeforeMachineRemediationHook(ctx, r.RuntimeClient, t.Cluster, t.Machine, t.unhealthyConditions(), action)
+}
+
+// getMachineRebootState returns the number of reboots requested for a Machine and the time of the last request.
+// Invalid annotation values are treated as if the annotations were not set.
+func getMachineRebootState(machine *clusterv1.Machine) (int32, time.Time) {
+	var attempts int32
+	if value, ok := machine.GetAnnotations()[clusterv1.MachineRebootAttemptsAnnotation]; ok {
+		if v, err := strconv.ParseInt(value, 10, 32); err == nil && v > 0 {
+			attempts = int32(v)
+		}
+	}
+
+	var requestedAt time.Time
+	if value, ok := machine.GetAnnotations()[clusterv1.MachineRebootRequestedAnnotation]; ok {
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			requestedAt = t
+		}
+	}
+	return attempts, requestedAt
+}
+
+// rebootTimeout returns the time to wait for a Machine to become healthy after a reboot was requested.
+func rebootTimeout(mhc *clusterv1.MachineHealthCheck) time.Duration {
+	return time.Duration(ptr.Deref(mhc.Spec.Remediation.Reboot.TimeoutSeconds, clusterv1.DefaultMachineRebootTimeoutSeconds)) * time.Second
+}
+
+// rebootInProgress returns true if a reboot requested at the given time has not timed out yet.
+func rebootInProgress(requestedAt time.Time, mhc *clusterv1.MachineHealthCheck) bool {
+	return time.Now().Before(requestedAt.Add(rebootTimeout(mhc)))
+}
+
+// rebootAttemptsExhausted returns true if all the reboots allowed for a Machine have been requested
+// and the last one timed out without the Machine becoming healthy again.
+func rebootAttemptsExhausted(machine *clusterv1.Machine, mhc *clusterv1.MachineHealthCheck) bool {
+	attempts, requestedAt := getMachineRebootState(machine)
+	if attempts < ptr.Deref(mhc.Spec.Remediation.Reboot.MaxAttempts, 0) {
+		return false
+	}
+	return requestedAt.IsZero() || !rebootInProgress(requestedAt, mhc)
+}
+
+// requestMachineReboot requests a new reboot for a Machine by setting the corresponding annotations,
+// unless a previous reboot request is still in progress.
+// Returns true if a new reboot has been requested.
+func requestMachineReboot(machine *clusterv1.Machine, mhc *clusterv1.MachineHealthCheck) bool {
+	attempts, requestedAt := getMachineRebootState(machine)
+	if !requestedAt.IsZero() && rebootInProgress(requestedAt, mhc) {
+		return false
+	}
+
+	annotations.AddAnnotations(machine, map[string]string{
+		clusterv1.MachineRebootRequestedAnnotation: time.Now().UTC().Format(time.RFC3339),
+		clusterv1.MachineRebootAttemptsAnnotation:  strconv.Itoa(int(attempts + 1)),
+	})
+	return true
+}
+
+// rebootNextCheckTimes returns the durations after which pending reboot requests for unhealthy Machines time out.
+func rebootNextCheckTimes(unhealthy []healthCheckTarget, mhc *clusterv1.MachineHealthCheck) []time.Duration {
+	if !mhc.Spec.Remediation.Reboot.IsDefined() || mhc.Spec.Remediation.TemplateRef.IsDefined() {
+		return nil
+	}
+
+	nextCheckTimes := []time.Duration{}
+	for _, t := range unhealthy {
+		if _, requestedAt := getMachineRebootState(t.Machine); !requestedAt.IsZero() && rebootInProgress(requestedAt, mhc) {
+			nextCheckTimes = append(nextCheckTimes, time.Until(requestedAt.Add(rebootTimeout(mhc))))
+		}
+	}
+	return nextCheckTimes
+}
+
+// getMachineCordonRequestedAt returns the time cordoning a Machine has been requested.
+// Invalid annotation values are treated as if the annotation was not set.
+func getMachineCordonRequestedAt(machine *clusterv1.Machine) time.Time {
+	if value, ok := machine.GetAnnotations()[clusterv1.MachineCordonRequestedAnnotation]; ok {
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// cordonDuration returns the time an unhealthy Machine is kept cordoned before triggering remediation.
+func cordonDuration(mhc *clusterv1.MachineHealthCheck) time.Duration {
+	return time.Duration(ptr.Deref(mhc.Spec.Remediation.Cordon.DurationSeconds, 0)) * time.Second
+}
+
+// cordonExpired returns true if cordoning a Machine has been requested and the cordon duration expired.
+func cordonExpired(machine *clusterv1.Machine, mhc *clusterv1.MachineHealthCheck) bool {
+	requestedAt := getMachineCordonRequestedAt(machine)
+	if requestedAt.IsZero() {
+		return false
+	}
+	return !time.Now().Before(requestedAt.Add(cordonDuration(mhc)))
+}
+
+// requestMachineCordon requests to cordon a Machine by setting the corresponding annotation,
+// unless it has been already requested.
+// Returns true if cordoning the Machine has been requested.
+func requestMachineCordon(machine *clusterv1.Machine) bool {
+	if !getMachineCordonRequestedAt(machine).IsZero() {
+		return false
+	}
+
+	annotations.AddAnnotations(machine, map[string]string{
+		clusterv1.MachineCordonRequestedAnnotation: time.Now().UTC().Format(time.RFC3339),
+	})
+	return true
+}
+
+// cordonNextCheckTimes returns the durations after which unhealthy Machines kept cordoned should be remediated.
+func cordonNextCheckTimes(unhealthy []healthCheckTarget, mhc *clusterv1.MachineHealthCheck) []time.Duration {
+	if !mhc.Spec.Remediation.Cordon.IsDefined() || mhc.Spec.Remediation.TemplateRef.IsDefined() {
+		return nil
+	}
+
+	nextCheckTimes := []time.Duration{}
+	for _, t := range unhealthy {
+		if requestedAt := getMachineCordonRequestedAt(t.Machine); !requestedAt.IsZero() && !cordonExpired(t.Machine, mhc) {
+			nextCheckTimes = append(nextCheckTimes, time.Until(requestedAt.Add(cordonDuration(mhc))))
+		}
+	}
+	return nextCheckTimes
 }
 
 // clusterToMachineHealthCheck maps events from Cluster objects to
@@ -603,15 +891,18 @@ func (r *Reconciler) nodeToMachineHealthCheck(ctx context.Context, o client.Obje
 		panic(fmt.Sprintf("Expected a corev1.Node, got %T", o))
 	}
 
-	machine, err := getMachineFromNode(ctx, r.Client, node.Name)
-	if machine == nil || err != nil {
+	m, err := index.GetMachineForNode(ctx, r.Client, node)
+	if m == nil || err != nil {
 		return nil
 	}
 
-	return r.machineToMachineHealthCheck(ctx, machine)
+	return r.machineToMachineHealthCheck(ctx, m)
 }
 
 func (r *Reconciler) watchClusterNodes(ctx context.Context, cluster *clusterv1.Cluster) error {
+	if r.DisableNodeWatch {
+		return nil
+	}
 	return r.ClusterCache.Watch(ctx, util.ObjectKey(cluster), clustercache.NewWatcher(clustercache.WatcherOptions{
 		Name:         "machinehealthcheck-watchClusterNodes",
 		Watcher:      r.controller,
@@ -621,40 +912,6 @@ func (r *Reconciler) watchClusterNodes(ctx context.Context, cluster *clusterv1.C
 	}))
 }
 
-// getMachineFromNode retrieves the machine with a nodeRef to nodeName
-// There should at most one machine with a given nodeRef, returns an error otherwise.
-func getMachineFromNode(ctx context.Context, c client.Client, nodeName string) (*clusterv1.Machine, error) {
-	machineList := &clusterv1.MachineList{}
-	if err := c.List(
-		ctx,
-		machineList,
-		client.MatchingFields{index.MachineNodeNameField: nodeName},
-	); err != nil {
-		return nil, errors.Wrap(err, "failed getting machine list")
-	}
-	// TODO(vincepri): Remove this loop once controller runtime fake client supports
-	// adding indexes on objects.
-	items := []*clusterv1.Machine{}
-	for i := range machineList.Items {
-		machine := &machineList.Items[i]
-		if machine.Status.NodeRef.IsDefined() && machine.Status.NodeRef.Name == nodeName {
-			items = append(items, machine)
-		}
-	}
-	if len(items) != 1 {
-		return nil, errors.Errorf("expecting one machine for node %v, got %v", nodeName, machineNames(items))
-	}
-	return items[0], nil
-}
-
-func machineNames(machines []*clusterv1.Machine) []string {
-	result := make([]string, 0, len(machines))
-	for _, m := range machines {
-		result = append(result, m.Name)
-	}
-	return result
-}
-
 // isAllowedRemediation checks the value of the UnhealthyLessThanOrEqualTo field to determine
 // returns whether remediation should be allowed or not, the remediation count, and error if any.
 func isAllowedRemediation(mhc *clusterv1.MachineHealthCheck) (bool, int32, error) {
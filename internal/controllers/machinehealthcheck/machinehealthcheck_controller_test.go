@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/tools/record"
+	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -44,7 +45,11 @@ import (
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/api/core/v1beta2/index"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	"sigs.k8s.io/cluster-api/feature"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
 	"sigs.k8s.io/cluster-api/internal/webhooks"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -2960,7 +2965,8 @@ func TestPatchTargets(t *testing.T) {
 	}
 
 	// Target with wrong patch helper will fail but the other one will be patched.
-	g.Expect(r.patchUnhealthyTargets(context.TODO(), logr.New(log.NullLogSink{}), []healthCheckTarget{target1, target3}, defaultCluster, mhc)).ToNot(BeEmpty())
+	_, errList := r.patchUnhealthyTargets(context.TODO(), logr.New(log.NullLogSink{}), []healthCheckTarget{target1, target3}, defaultCluster, mhc)
+	g.Expect(errList).ToNot(BeEmpty())
 	g.Expect(cl.Get(ctx, client.ObjectKey{Name: machine2.Name, Namespace: machine2.Namespace}, machine2)).ToNot(HaveOccurred())
 	g.Expect(v1beta1conditions.Get(machine2, clusterv1.MachineOwnerRemediatedV1Beta1Condition).Status).To(Equal(corev1.ConditionFalse))
 	g.Expect(conditions.Get(machine2, clusterv1.MachineOwnerRemediatedCondition).Status).To(Equal(metav1.ConditionFalse))
@@ -2968,3 +2974,283 @@ func TestPatchTargets(t *testing.T) {
 	// Target with wrong patch helper will fail but the other one will be patched.
 	g.Expect(r.patchHealthyTargets(context.TODO(), logr.New(log.NullLogSink{}), []healthCheckTarget{target1, target3}, mhc)).ToNot(BeEmpty())
 }
+
+func TestPatchUnhealthyTargetsWithReboot(t *testing.T) {
+	g := NewWithT(t)
+
+	namespace := metav1.NamespaceDefault
+	clusterName := testClusterName
+	defaultCluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clusterName,
+			Namespace: namespace,
+		},
+	}
+	labels := map[string]string{"cluster": "foo", "nodepool": "bar"}
+
+	mhc := newMachineHealthCheckWithLabels("mhc", namespace, clusterName, labels)
+	mhc.Spec.Remediation.Reboot = clusterv1.MachineHealthCheckRemediationReboot{
+		MaxAttempts:    ptr.To[int32](2),
+		TimeoutSeconds: ptr.To[int32](60),
+	}
+	machine := newTestMachine("machine1", namespace, clusterName, "nodeName", labels)
+	conditions.Set(machine, metav1.Condition{
+		Type:   clusterv1.MachineHealthCheckSucceededCondition,
+		Status: metav1.ConditionFalse,
+		Reason: clusterv1.MachineHealthCheckNodeDeletedReason,
+	})
+
+	cl := fake.NewClientBuilder().WithObjects(machine, mhc).WithStatusSubresource(&clusterv1.MachineHealthCheck{}, &clusterv1.Machine{}).Build()
+	r := &Reconciler{
+		Client:   cl,
+		recorder: record.NewFakeRecorder(32),
+	}
+
+	patchUnhealthy := func() *clusterv1.Machine {
+		t.Helper()
+		m := &clusterv1.Machine{}
+		g.Expect(cl.Get(ctx, client.ObjectKeyFromObject(machine), m)).To(Succeed())
+		patchHelper, err := patch.NewHelper(m, cl)
+		g.Expect(err).ToNot(HaveOccurred())
+		target := healthCheckTarget{MHC: mhc, Machine: m, patchHelper: patchHelper, Node: &corev1.Node{}}
+		_, errList := r.patchUnhealthyTargets(ctx, logr.New(log.NullLogSink{}), []healthCheckTarget{target}, defaultCluster, mhc)
+		g.Expect(errList).To(BeEmpty())
+		g.Expect(cl.Get(ctx, client.ObjectKeyFromObject(machine), m)).To(Succeed())
+		return m
+	}
+	expireRebootRequest := func(m *clusterv1.Machine) {
+		t.Helper()
+		m.Annotations[clusterv1.MachineRebootRequestedAnnotation] = time.Now().Add(-2 * time.Minute).UTC().Format(time.RFC3339)
+		g.Expect(cl.Update(ctx, m)).To(Succeed())
+	}
+
+	// First reconcile requests a reboot instead of triggering owner remediation.
+	m := patchUnhealthy()
+	g.Expect(m.Annotations).To(HaveKeyWithValue(clusterv1.MachineRebootAttemptsAnnotation, "1"))
+	g.Expect(m.Annotations).To(HaveKey(clusterv1.MachineRebootRequestedAnnotation))
+	g.Expect(conditions.Get(m, clusterv1.MachineOwnerRemediatedCondition)).To(BeNil())
+	g.Expect(rebootNextCheckTimes([]healthCheckTarget{{Machine: m}}, mhc)).To(HaveLen(1))
+
+	// While the reboot is in progress no further reboots are requested.
+	requestedAt := m.Annotations[clusterv1.MachineRebootRequestedAnnotation]
+	m = patchUnhealthy()
+	g.Expect(m.Annotations).To(HaveKeyWithValue(clusterv1.MachineRebootAttemptsAnnotation, "1"))
+	g.Expect(m.Annotations).To(HaveKeyWithValue(clusterv1.MachineRebootRequestedAnnotation, requestedAt))
+
+	// Once the reboot request timed out, another reboot is requested.
+	expireRebootRequest(m)
+	m = patchUnhealthy()
+	g.Expect(m.Annotations).To(HaveKeyWithValue(clusterv1.MachineRebootAttemptsAnnotation, "2"))
+	g.Expect(conditions.Get(m, clusterv1.MachineOwnerRemediatedCondition)).To(BeNil())
+
+	// After all the reboot attempts timed out, remediation is triggered via the owner.
+	expireRebootRequest(m)
+	m = patchUnhealthy()
+	g.Expect(m.Annotations).To(HaveKeyWithValue(clusterv1.MachineRebootAttemptsAnnotation, "2"))
+	g.Expect(conditions.Get(m, clusterv1.MachineOwnerRemediatedCondition).Status).To(Equal(metav1.ConditionFalse))
+
+	// Reboot state is reset once the Machine is healthy.
+	patchHealthy := func(m *clusterv1.Machine) *clusterv1.Machine {
+		t.Helper()
+		patchHelper, err := patch.NewHelper(m, cl)
+		g.Expect(err).ToNot(HaveOccurred())
+		target := healthCheckTarget{MHC: mhc, Machine: m, patchHelper: patchHelper, Node: &corev1.Node{}}
+		g.Expect(r.patchHealthyTargets(ctx, logr.New(log.NullLogSink{}), []healthCheckTarget{target}, mhc)).To(BeEmpty())
+		g.Expect(cl.Get(ctx, client.ObjectKeyFromObject(machine), m)).To(Succeed())
+		return m
+	}
+	m = patchHealthy(m)
+	g.Expect(m.Annotations).ToNot(HaveKey(clusterv1.MachineRebootAttemptsAnnotation))
+	g.Expect(m.Annotations).ToNot(HaveKey(clusterv1.MachineRebootRequestedAnnotation))
+
+	// Reboot state is reset also if the Machine is healthy before the reboot request timed out.
+	m = patchUnhealthy()
+	g.Expect(m.Annotations).To(HaveKeyWithValue(clusterv1.MachineRebootAttemptsAnnotation, "1"))
+	g.Expect(m.Annotations).To(HaveKey(clusterv1.MachineRebootRequestedAnnotation))
+	m = patchHealthy(m)
+	g.Expect(m.Annotations).ToNot(HaveKey(clusterv1.MachineRebootAttemptsAnnotation))
+	g.Expect(m.Annotations).ToNot(HaveKey(clusterv1.MachineRebootRequestedAnnotation))
+}
+
+func TestPatchUnhealthyTargetsWithCordon(t *testing.T) {
+	g := NewWithT(t)
+
+	namespace := metav1.NamespaceDefault
+	clusterName := testClusterName
+	defaultCluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clusterName,
+			Namespace: namespace,
+		},
+	}
+	labels := map[string]string{"cluster": "foo", "nodepool": "bar"}
+
+	mhc := newMachineHealthCheckWithLabels("mhc", namespace, clusterName, labels)
+	mhc.Spec.Remediation.Cordon = clusterv1.MachineHealthCheckRemediationCordon{
+		DurationSeconds: ptr.To[int32](60),
+	}
+	machine := newTestMachine("machine1", namespace, clusterName, "nodeName", labels)
+	conditions.Set(machine, metav1.Condition{
+		Type:   clusterv1.MachineHealthCheckSucceededCondition,
+		Status: metav1.ConditionFalse,
+		Reason: clusterv1.MachineHealthCheckNodeDeletedReason,
+	})
+
+	cl := fake.NewClientBuilder().WithObjects(machine, mhc).WithStatusSubresource(&clusterv1.MachineHealthCheck{}, &clusterv1.Machine{}).Build()
+	r := &Reconciler{
+		Client:   cl,
+		recorder: record.NewFakeRecorder(32),
+	}
+
+	patchUnhealthy := func() *clusterv1.Machine {
+		t.Helper()
+		m := &clusterv1.Machine{}
+		g.Expect(cl.Get(ctx, client.ObjectKeyFromObject(machine), m)).To(Succeed())
+		patchHelper, err := patch.NewHelper(m, cl)
+		g.Expect(err).ToNot(HaveOccurred())
+		target := healthCheckTarget{MHC: mhc, Machine: m, patchHelper: patchHelper, Node: &corev1.Node{}}
+		_, errList := r.patchUnhealthyTargets(ctx, logr.New(log.NullLogSink{}), []healthCheckTarget{target}, defaultCluster, mhc)
+		g.Expect(errList).To(BeEmpty())
+		g.Expect(cl.Get(ctx, client.ObjectKeyFromObject(machine), m)).To(Succeed())
+		return m
+	}
+
+	// First reconcile requests to cordon the Machine instead of triggering owner remediation.
+	m := patchUnhealthy()
+	g.Expect(m.Annotations).To(HaveKey(clusterv1.MachineCordonRequestedAnnotation))
+	g.Expect(conditions.Get(m, clusterv1.MachineOwnerRemediatedCondition)).To(BeNil())
+	g.Expect(cordonNextCheckTimes([]healthCheckTarget{{Machine: m}}, mhc)).To(HaveLen(1))
+
+	// While the cordon duration did not expire, the Machine is kept and the request is not changed.
+	requestedAt := m.Annotations[clusterv1.MachineCordonRequestedAnnotation]
+	m = patchUnhealthy()
+	g.Expect(m.Annotations).To(HaveKeyWithValue(clusterv1.MachineCordonRequestedAnnotation, requestedAt))
+	g.Expect(conditions.Get(m, clusterv1.MachineOwnerRemediatedCondition)).To(BeNil())
+
+	// Once the cordon duration expired, remediation is triggered via the owner.
+	m.Annotations[clusterv1.MachineCordonRequestedAnnotation] = time.Now().Add(-2 * time.Minute).UTC().Format(time.RFC3339)
+	g.Expect(cl.Update(ctx, m)).To(Succeed())
+	m = patchUnhealthy()
+	g.Expect(conditions.Get(m, clusterv1.MachineOwnerRemediatedCondition).Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(cordonNextCheckTimes([]healthCheckTarget{{Machine: m}}, mhc)).To(BeEmpty())
+
+	// The cordon request is removed once the Machine is healthy.
+	patchHelper, err := patch.NewHelper(m, cl)
+	g.Expect(err).ToNot(HaveOccurred())
+	target := healthCheckTarget{MHC: mhc, Machine: m, patchHelper: patchHelper, Node: &corev1.Node{}}
+	g.Expect(r.patchHealthyTargets(ctx, logr.New(log.NullLogSink{}), []healthCheckTarget{target}, mhc)).To(BeEmpty())
+	g.Expect(cl.Get(ctx, client.ObjectKeyFromObject(machine), m)).To(Succeed())
+	g.Expect(m.Annotations).ToNot(HaveKey(clusterv1.MachineCordonRequestedAnnotation))
+}
+
+func TestPatchUnhealthyTargetsWithBeforeMachineRemediationHook(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+
+	catalog := runtimecatalog.New()
+	_ = runtimehooksv1.AddToCatalog(catalog)
+	beforeMachineRemediationGVH, err := catalog.GroupVersionHook(runtimehooksv1.BeforeMachineRemediation)
+	if err != nil {
+		panic("unable to compute GVH")
+	}
+
+	namespace := metav1.NamespaceDefault
+	clusterName := testClusterName
+	defaultCluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clusterName,
+			Namespace: namespace,
+		},
+	}
+	labels := map[string]string{"cluster": "foo", "nodepool": "bar"}
+
+	tests := []struct {
+		name                      string
+		hookResponse              *runtimehooksv1.BeforeMachineRemediationResponse
+		wantNextCheckTimes        []time.Duration
+		wantOwnerRemediatedStatus *metav1.ConditionStatus
+	}{
+		{
+			name: "remediation is triggered if the hook is not blocking",
+			hookResponse: &runtimehooksv1.BeforeMachineRemediationResponse{
+				CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+					CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess},
+				},
+			},
+			wantNextCheckTimes:        []time.Duration{},
+			wantOwnerRemediatedStatus: ptr.To(metav1.ConditionFalse),
+		},
+		{
+			name: "remediation is blocked if the hook is blocking",
+			hookResponse: &runtimehooksv1.BeforeMachineRemediationResponse{
+				CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+					CommonResponse:    runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess},
+					RetryAfterSeconds: 30,
+				},
+			},
+			wantNextCheckTimes:        []time.Duration{30 * time.Second},
+			wantOwnerRemediatedStatus: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mhc := newMachineHealthCheckWithLabels("mhc", namespace, clusterName, labels)
+			machine := newTestMachine("machine1", namespace, clusterName, "nodeName", labels)
+			conditions.Set(machine, metav1.Condition{
+				Type:   clusterv1.MachineHealthCheckSucceededCondition,
+				Status: metav1.ConditionFalse,
+				Reason: clusterv1.MachineHealthCheckNodeDeletedReason,
+			})
+
+			cl := fake.NewClientBuilder().WithObjects(machine, mhc).WithStatusSubresource(&clusterv1.MachineHealthCheck{}, &clusterv1.Machine{}).Build()
+			runtimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+				WithCatalog(catalog).
+				WithGetAllExtensionResponses(map[runtimecatalog.GroupVersionHook][]string{
+					beforeMachineRemediationGVH: {"foo"},
+				}).
+				WithCallAllExtensionResponses(map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
+					beforeMachineRemediationGVH: tt.hookResponse,
+				}).
+				WithCallAllExtensionValidations(func(object runtimehooksv1.RequestObject) error {
+					req, ok := object.(*runtimehooksv1.BeforeMachineRemediationRequest)
+					if !ok {
+						return errors.New("unexpected request type")
+					}
+					if req.Machine.Name != machine.Name || req.Action != runtimehooksv1.MachineRemediationActionOwnerRemediation {
+						return errors.New("unexpected request")
+					}
+					if len(req.UnhealthyConditions) != 1 || req.UnhealthyConditions[0].Type != clusterv1.MachineHealthCheckSucceededCondition {
+						return errors.New("unexpected unhealthy conditions")
+					}
+					return nil
+				}).
+				Build()
+			r := &Reconciler{
+				Client:        cl,
+				RuntimeClient: runtimeClient,
+				recorder:      record.NewFakeRecorder(32),
+			}
+
+			m := &clusterv1.Machine{}
+			g.Expect(cl.Get(ctx, client.ObjectKeyFromObject(machine), m)).To(Succeed())
+			patchHelper, err := patch.NewHelper(m, cl)
+			g.Expect(err).ToNot(HaveOccurred())
+			target := healthCheckTarget{Cluster: defaultCluster, MHC: mhc, Machine: m, patchHelper: patchHelper, Node: &corev1.Node{}}
+
+			nextCheckTimes, errList := r.patchUnhealthyTargets(ctx, logr.New(log.NullLogSink{}), []healthCheckTarget{target}, defaultCluster, mhc)
+			g.Expect(errList).To(BeEmpty())
+			g.Expect(nextCheckTimes).To(Equal(tt.wantNextCheckTimes))
+			g.Expect(runtimeClient.CallAllCount(runtimehooksv1.BeforeMachineRemediation)).To(Equal(1))
+
+			g.Expect(cl.Get(ctx, client.ObjectKeyFromObject(machine), m)).To(Succeed())
+			ownerRemediatedCondition := conditions.Get(m, clusterv1.MachineOwnerRemediatedCondition)
+			if tt.wantOwnerRemediatedStatus == nil {
+				g.Expect(ownerRemediatedCondition).To(BeNil())
+				return
+			}
+			g.Expect(ownerRemediatedCondition).ToNot(BeNil())
+			g.Expect(ownerRemediatedCondition.Status).To(Equal(*tt.wantOwnerRemediatedStatus))
+		})
+	}
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinehealthcheck
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
+	"sigs.k8s.io/cluster-api/util"
+)
+
+// nodeProber probes Nodes of workload clusters from the management cluster.
+type nodeProber interface {
+	// Probe returns an error if the Node cannot be reached with the given probe.
+	Probe(ctx context.Context, cluster *clusterv1.Cluster, node *corev1.Node, probe clusterv1.MachineHealthCheckNodeProbe) error
+}
+
+// nodeProbeResult is the result of the last probe of the Node of a target.
+type nodeProbeResult struct {
+	key string
+
+	// probedAt is the time of the last probe.
+	probedAt time.Time
+
+	// failingSince is the time of the first of a sequence of failed probes, it is zero if the last probe succeeded.
+	failingSince time.Time
+
+	// err is the error of the last probe, if any.
+	err error
+}
+
+// Key returns the cache key of a nodeProbeResult.
+func (r nodeProbeResult) Key() string {
+	return r.key
+}
+
+func nodeProbeResultKey(mhc *clusterv1.MachineHealthCheck, machine *clusterv1.Machine, node *corev1.Node) string {
+	return fmt.Sprintf("%s/%s/%s/%s", mhc.Namespace, mhc.Name, machine.Name, node.Name)
+}
+
+// probeNodes probes the Nodes of the targets if the MachineHealthCheck has a node probe, and records
+// in the targets since when the probe is failing. Nodes are probed at most once per probe period, in between
+// the result of the last probe is used.
+func (r *Reconciler) probeNodes(ctx context.Context, logger logr.Logger, cluster *clusterv1.Cluster, mhc *clusterv1.MachineHealthCheck, targets []healthCheckTarget) {
+	probe := mhc.Spec.Checks.NodeProbe
+	if !probe.IsDefined() {
+		return
+	}
+	period := time.Duration(ptr.Deref(probe.PeriodSeconds, clusterv1.DefaultNodeProbePeriodSeconds)) * time.Second
+	timeout := time.Duration(ptr.Deref(probe.TimeoutSeconds, clusterv1.DefaultNodeProbeTimeoutSeconds)) * time.Second
+
+	var wg sync.WaitGroup
+	for i := range targets {
+		t := &targets[i]
+		if t.Node == nil {
+			continue
+		}
+
+		now := time.Now()
+		key := nodeProbeResultKey(mhc, t.Machine, t.Node)
+		last, ok := r.nodeProbeResults.Has(key)
+		if ok && now.Sub(last.probedAt) < period {
+			t.nodeProbeResult = &last
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			probeCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			result := nodeProbeResult{key: key, probedAt: now}
+			if err := r.nodeProber.Probe(probeCtx, cluster, t.Node, probe); err != nil {
+				logger.V(3).Info("Node probe failed", "Node", t.Node.Name, "probe", probe.Type, "err", err.Error())
+				result.err = err
+				result.failingSince = now
+				if ok && !last.failingSince.IsZero() {
+					result.failingSince = last.failingSince
+				}
+			}
+			r.nodeProbeResults.Add(result)
+			t.nodeProbeResult = &result
+		}()
+	}
+	wg.Wait()
+}
+
+// nodeProbeNextCheckTimes returns when Nodes of the targets should be probed again.
+func nodeProbeNextCheckTimes(targets []healthCheckTarget, mhc *clusterv1.MachineHealthCheck) []time.Duration {
+	probe := mhc.Spec.Checks.NodeProbe
+	if !probe.IsDefined() {
+		return nil
+	}
+	period := time.Duration(ptr.Deref(probe.PeriodSeconds, clusterv1.DefaultNodeProbePeriodSeconds)) * time.Second
+
+	var nextCheckTimes []time.Duration
+	for _, t := range targets {
+		if t.nodeProbeResult == nil {
+			continue
+		}
+		if nextCheck := period - time.Since(t.nodeProbeResult.probedAt); nextCheck > 0 {
+			nextCheckTimes = append(nextCheckTimes, nextCheck)
+		}
+	}
+	return nextCheckTimes
+}
+
+// networkNodeProber probes Nodes via the network or via the API server of the workload cluster.
+type networkNodeProber struct {
+	clusterCache clustercache.ClusterCache
+}
+
+// Probe returns an error if the Node cannot be reached with the given probe.
+func (p *networkNodeProber) Probe(ctx context.Context, cluster *clusterv1.Cluster, node *corev1.Node, probe clusterv1.MachineHealthCheckNodeProbe) error {
+	switch probe.Type {
+	case clusterv1.MachineHealthCheckNodeProbeTypeTCP:
+		address, err := nodeAddress(node, probe.AddressType)
+		if err != nil {
+			return err
+		}
+		port := strconv.Itoa(int(ptr.Deref(probe.Port, clusterv1.DefaultNodeProbePort)))
+		conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", net.JoinHostPort(address, port))
+		if err != nil {
+			return errors.Wrapf(err, "failed to connect to Node %s", node.Name)
+		}
+		return conn.Close()
+	case clusterv1.MachineHealthCheckNodeProbeTypeICMP:
+		address, err := nodeAddress(node, probe.AddressType)
+		if err != nil {
+			return err
+		}
+		return errors.Wrapf(ping(ctx, address), "failed to ping Node %s", node.Name)
+	case clusterv1.MachineHealthCheckNodeProbeTypeKubeletHealthz:
+		restConfig, err := p.clusterCache.GetRESTConfig(ctx, util.ObjectKey(cluster))
+		if err != nil {
+			return errors.Wrapf(err, "failed to get REST config for Cluster %s", klog.KObj(cluster))
+		}
+		return errors.Wrapf(kubeletHealthz(ctx, restConfig, node.Name), "failed to check kubelet health of Node %s", node.Name)
+	default:
+		return errors.Errorf("unknown node probe type %q", probe.Type)
+	}
+}
+
+// nodeAddress returns the first address of the Node with the given type, InternalIP if no type is given.
+func nodeAddress(node *corev1.Node, addressType clusterv1.MachineAddressType) (string, error) {
+	if addressType == "" {
+		addressType = clusterv1.MachineInternalIP
+	}
+	for _, address := range node.Status.Addresses {
+		if string(address.Type) == string(addressType) && address.Address != "" {
+			return address.Address, nil
+		}
+	}
+	return "", errors.Errorf("Node %s has no %s address", node.Name, addressType)
+}
+
+// ping sends an ICMP echo request to the given address and waits for the echo reply.
+// Note: this uses unprivileged ICMP sockets, which must be allowed via the net.ipv4.ping_group_range sysctl.
+func ping(ctx context.Context, address string) error {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return errors.Errorf("invalid IP address %q", address)
+	}
+	network, protocol := "udp4", 1 // ICMP for IPv4
+	var echoRequest, echoReply icmp.Type = ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply
+	if ip.To4() == nil {
+		network, protocol = "udp6", 58 // ICMP for IPv6
+		echoRequest, echoReply = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply
+	}
+
+	conn, err := icmp.ListenPacket(network, "")
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	request, err := (&icmp.Message{
+		Type: echoRequest,
+		Body: &icmp.Echo{Seq: 1, Data: []byte("cluster-api")},
+	}).Marshal(nil)
+	if err != nil {
+		return err
+	}
+	if _, err := conn.WriteTo(request, &net.UDPAddr{IP: ip}); err != nil {
+		return err
+	}
+
+	reply := make([]byte, 1500)
+	for {
+		n, _, err := conn.ReadFrom(reply)
+		if err != nil {
+			return err
+		}
+		message, err := icmp.ParseMessage(protocol, reply[:n])
+		if err != nil {
+			return err
+		}
+		if message.Type == echoReply {
+			return nil
+		}
+	}
+}
+
+// kubeletHealthz checks the healthz endpoint of the kubelet via the Node proxy of the workload cluster API server.
+func kubeletHealthz(ctx context.Context, restConfig *rest.Config, nodeName string) error {
+	httpClient, err := rest.HTTPClientFor(restConfig)
+	if err != nil {
+		return err
+	}
+	u, err := url.JoinPath(restConfig.Host, "api", "v1", "nodes", nodeName, "proxy", "healthz")
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinehealthcheck
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/cache"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+type fakeNodeProber struct {
+	err    error
+	probes int
+}
+
+func (p *fakeNodeProber) Probe(_ context.Context, _ *clusterv1.Cluster, _ *corev1.Node, _ clusterv1.MachineHealthCheckNodeProbe) error {
+	p.probes++
+	return p.err
+}
+
+func TestProbeNodes(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "test-cluster"}}
+	mhc := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "test-mhc"},
+		Spec: clusterv1.MachineHealthCheckSpec{
+			ClusterName: cluster.Name,
+			Checks: clusterv1.MachineHealthCheckChecks{
+				NodeProbe: clusterv1.MachineHealthCheckNodeProbe{
+					Type:          clusterv1.MachineHealthCheckNodeProbeTypeTCP,
+					PeriodSeconds: ptr.To[int32](60),
+				},
+			},
+		},
+	}
+	newTargets := func() []healthCheckTarget {
+		return []healthCheckTarget{
+			{Cluster: cluster, MHC: mhc, Machine: newTestMachine("machine1", metav1.NamespaceDefault, cluster.Name, "node1", nil), Node: newTestNode("node1")},
+			// Machines without a Node are not probed.
+			{Cluster: cluster, MHC: mhc, Machine: newTestMachine("machine2", metav1.NamespaceDefault, cluster.Name, "", nil)},
+		}
+	}
+
+	prober := &fakeNodeProber{err: errors.New("connection refused")}
+	r := &Reconciler{
+		nodeProber:       prober,
+		nodeProbeResults: cache.New[nodeProbeResult](cache.DefaultTTL),
+	}
+
+	targets := newTargets()
+	r.probeNodes(ctx, ctrl.Log, cluster, mhc, targets)
+	g.Expect(prober.probes).To(Equal(1))
+	g.Expect(targets[0].nodeProbeResult).ToNot(BeNil())
+	g.Expect(targets[0].nodeProbeResult.err).To(MatchError("connection refused"))
+	g.Expect(targets[0].nodeProbeResult.failingSince).ToNot(BeZero())
+	g.Expect(targets[1].nodeProbeResult).To(BeNil())
+	g.Expect(nodeProbeNextCheckTimes(targets, mhc)).To(HaveLen(1))
+
+	// Nodes are not probed again within the probe period.
+	firstResult := *targets[0].nodeProbeResult
+	targets = newTargets()
+	r.probeNodes(ctx, ctrl.Log, cluster, mhc, targets)
+	g.Expect(prober.probes).To(Equal(1))
+	g.Expect(targets[0].nodeProbeResult.failingSince).To(Equal(firstResult.failingSince))
+
+	// Failing probes after the probe period preserve since when the probe is failing.
+	firstResult.probedAt = firstResult.probedAt.Add(-time.Hour)
+	r.nodeProbeResults.Add(firstResult)
+	targets = newTargets()
+	r.probeNodes(ctx, ctrl.Log, cluster, mhc, targets)
+	g.Expect(prober.probes).To(Equal(2))
+	g.Expect(targets[0].nodeProbeResult.failingSince).To(Equal(firstResult.failingSince))
+
+	// Successful probes reset the failure.
+	prober.err = nil
+	result := *targets[0].nodeProbeResult
+	result.probedAt = result.probedAt.Add(-time.Hour)
+	r.nodeProbeResults.Add(result)
+	targets = newTargets()
+	r.probeNodes(ctx, ctrl.Log, cluster, mhc, targets)
+	g.Expect(prober.probes).To(Equal(3))
+	g.Expect(targets[0].nodeProbeResult.err).ToNot(HaveOccurred())
+	g.Expect(targets[0].nodeProbeResult.failingSince).To(BeZero())
+}
+
+func TestNodeProbeNeedsRemediation(t *testing.T) {
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "test-cluster"}}
+	conditions.Set(cluster, metav1.Condition{Type: clusterv1.ClusterInfrastructureReadyCondition, Status: metav1.ConditionTrue})
+	conditions.Set(cluster, metav1.Condition{Type: clusterv1.ClusterControlPlaneInitializedCondition, Status: metav1.ConditionTrue})
+
+	mhc := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "test-mhc"},
+		Spec: clusterv1.MachineHealthCheckSpec{
+			ClusterName: cluster.Name,
+			Checks: clusterv1.MachineHealthCheckChecks{
+				NodeProbe: clusterv1.MachineHealthCheckNodeProbe{
+					Type:                    clusterv1.MachineHealthCheckNodeProbeTypeTCP,
+					UnhealthyTimeoutSeconds: ptr.To[int32](300),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                   string
+		nodeProbeResult        *nodeProbeResult
+		wantNeedsRemediation   bool
+		wantNextCheck          bool
+		wantConditionReason    string
+		wantV1Beta1Reason      string
+		wantConditionSubstring string
+	}{
+		{
+			name: "healthy if the probe is not configured or did not run",
+		},
+		{
+			name:            "healthy if the probe succeeds",
+			nodeProbeResult: &nodeProbeResult{probedAt: time.Now()},
+		},
+		{
+			name:            "not yet unhealthy if the probe is failing for less than the unhealthy timeout",
+			nodeProbeResult: &nodeProbeResult{probedAt: time.Now(), failingSince: time.Now().Add(-time.Minute), err: errors.New("connection refused")},
+			wantNextCheck:   true,
+		},
+		{
+			name:                   "unhealthy if the probe is failing for more than the unhealthy timeout",
+			nodeProbeResult:        &nodeProbeResult{probedAt: time.Now(), failingSince: time.Now().Add(-10 * time.Minute), err: errors.New("connection refused")},
+			wantNeedsRemediation:   true,
+			wantConditionReason:    clusterv1.MachineHealthCheckNodeUnreachableReason,
+			wantV1Beta1Reason:      clusterv1.NodeUnreachableV1Beta1Reason,
+			wantConditionSubstring: "Node is not reachable by the TCP probe for more than 5m0s: connection refused",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := healthCheckTarget{
+				Cluster:         cluster,
+				MHC:             mhc,
+				Machine:         newTestMachine("machine1", metav1.NamespaceDefault, cluster.Name, "node1", nil),
+				Node:            newTestNode("node1"),
+				nodeProbeResult: tt.nodeProbeResult,
+			}
+
+			needsRemediation, nextCheck := target.needsRemediation(ctrl.Log, metav1.Duration{Duration: 10 * time.Minute})
+			g.Expect(needsRemediation).To(Equal(tt.wantNeedsRemediation))
+			g.Expect(nextCheck > 0).To(Equal(tt.wantNextCheck))
+			if !tt.wantNeedsRemediation {
+				return
+			}
+			condition := conditions.Get(target.Machine, clusterv1.MachineHealthCheckSucceededCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Reason).To(Equal(tt.wantConditionReason))
+			g.Expect(condition.Message).To(ContainSubstring(tt.wantConditionSubstring))
+			g.Expect(target.Machine.GetV1Beta1Conditions()).To(ContainElement(HaveField("Reason", tt.wantV1Beta1Reason)))
+		})
+	}
+}
+
+func TestNetworkNodeProberTCP(t *testing.T) {
+	g := NewWithT(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ToNot(HaveOccurred())
+	defer listener.Close()
+	port := int32(listener.Addr().(*net.TCPAddr).Port)
+
+	closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ToNot(HaveOccurred())
+	closedPort := int32(closedListener.Addr().(*net.TCPAddr).Port)
+	g.Expect(closedListener.Close()).To(Succeed())
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeHostName, Address: "node1"},
+				{Type: corev1.NodeInternalIP, Address: "127.0.0.1"},
+			},
+		},
+	}
+
+	prober := &networkNodeProber{}
+	probeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	g.Expect(prober.Probe(probeCtx, nil, node, clusterv1.MachineHealthCheckNodeProbe{
+		Type: clusterv1.MachineHealthCheckNodeProbeTypeTCP,
+		Port: ptr.To(port),
+	})).To(Succeed())
+
+	err = prober.Probe(probeCtx, nil, node, clusterv1.MachineHealthCheckNodeProbe{
+		Type: clusterv1.MachineHealthCheckNodeProbeTypeTCP,
+		Port: ptr.To(closedPort),
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("failed to connect to Node node1")))
+	g.Expect(err.Error()).To(ContainSubstring(net.JoinHostPort("127.0.0.1", strconv.Itoa(int(closedPort)))))
+
+	err = prober.Probe(probeCtx, nil, node, clusterv1.MachineHealthCheckNodeProbe{
+		Type:        clusterv1.MachineHealthCheckNodeProbeTypeTCP,
+		AddressType: clusterv1.MachineExternalIP,
+		Port:        ptr.To(port),
+	})
+	g.Expect(err).To(MatchError("Node node1 has no ExternalIP address"))
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinehealthcheck
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// nodeConditionHistoryTTL is the duration for which the history of a node condition is kept if it is not evaluated anymore.
+// NOTE: This matches the maximum windowSeconds of an unhealthy node condition sliding window.
+const nodeConditionHistoryTTL = 24 * time.Hour
+
+// nodeConditionInterval is an interval during which a node condition has been in the status of an unhealthy node condition.
+type nodeConditionInterval struct {
+	start time.Time
+
+	// end is zero if the node condition is still in the status of the unhealthy node condition.
+	end time.Time
+}
+
+// nodeConditionHistory is the history of the intervals during which a node condition has been in the status of
+// an unhealthy node condition with a sliding window.
+type nodeConditionHistory struct {
+	key string
+
+	intervals []nodeConditionInterval
+}
+
+// Key returns the cache key of a nodeConditionHistory.
+func (h nodeConditionHistory) Key() string {
+	return h.key
+}
+
+func nodeConditionHistoryKey(mhc *clusterv1.MachineHealthCheck, machine *clusterv1.Machine, node *corev1.Node, c clusterv1.UnhealthyNodeCondition) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s", mhc.Namespace, mhc.Name, machine.Name, node.Name, c.Type, c.Status)
+}
+
+// observe records the current state of the node condition in the history.
+// Intervals are derived from the lastTransitionTime of the node condition; when a transition is missed, e.g. because the
+// node condition flipped twice in between two reconciles, the previous interval is considered as ongoing until the new one started.
+func (h *nodeConditionHistory) observe(nodeCondition *corev1.NodeCondition, status corev1.ConditionStatus, now time.Time) {
+	var last *nodeConditionInterval
+	if len(h.intervals) > 0 {
+		last = &h.intervals[len(h.intervals)-1]
+	}
+
+	if nodeCondition != nil && nodeCondition.Status == status {
+		start := nodeCondition.LastTransitionTime.Time
+		if last != nil && last.start.Equal(start) {
+			return
+		}
+		if last != nil && last.end.IsZero() {
+			last.end = start
+		}
+		h.intervals = append(h.intervals, nodeConditionInterval{start: start})
+		return
+	}
+
+	if last != nil && last.end.IsZero() {
+		last.end = now
+		if nodeCondition != nil && nodeCondition.LastTransitionTime.After(last.start) && nodeCondition.LastTransitionTime.Time.Before(now) {
+			last.end = nodeCondition.LastTransitionTime.Time
+		}
+	}
+}
+
+// prune drops the intervals which ended before the given time.
+func (h *nodeConditionHistory) prune(windowStart time.Time) {
+	intervals := []nodeConditionInterval{}
+	for _, i := range h.intervals {
+		if !i.end.IsZero() && i.end.Before(windowStart) {
+			continue
+		}
+		intervals = append(intervals, i)
+	}
+	h.intervals = intervals
+}
+
+// cumulativeDuration returns for how long the node condition has been in the status of the unhealthy node condition
+// within the given window, and whether it is still in that status.
+func (h *nodeConditionHistory) cumulativeDuration(windowStart, now time.Time) (time.Duration, bool) {
+	var total time.Duration
+	ongoing := false
+	for _, i := range h.intervals {
+		start, end := i.start, i.end
+		if end.IsZero() {
+			end = now
+			ongoing = true
+		}
+		if start.Before(windowStart) {
+			start = windowStart
+		}
+		if end.After(start) {
+			total += end.Sub(start)
+		}
+	}
+	return total, ongoing
+}
+
+// recordNodeConditionHistories records the state of the node conditions of the targets for all the unhealthy node
+// conditions with a sliding window, so it is possible to determine for how long a node condition has been in the status
+// of an unhealthy node condition within the window.
+// NOTE: Histories are kept in memory, so after a restart of the controller only the current state of the node conditions is known.
+func (r *Reconciler) recordNodeConditionHistories(mhc *clusterv1.MachineHealthCheck, targets []healthCheckTarget) {
+	now := time.Now()
+	for _, c := range mhc.Spec.Checks.UnhealthyNodeConditions {
+		if !c.SlidingWindow.IsDefined() {
+			continue
+		}
+		windowStart := now.Add(-time.Duration(ptr.Deref(c.SlidingWindow.WindowSeconds, 0)) * time.Second)
+
+		for i := range targets {
+			t := &targets[i]
+			if t.Node == nil {
+				continue
+			}
+
+			key := nodeConditionHistoryKey(mhc, t.Machine, t.Node, c)
+			history, ok := r.nodeConditionHistories.Has(key)
+			if !ok {
+				history = nodeConditionHistory{key: key}
+			}
+			// Copy the intervals, given that the history stored in the cache must not be modified in place.
+			history.intervals = append([]nodeConditionInterval{}, history.intervals...)
+			history.observe(getNodeCondition(t.Node, c.Type), c.Status, now)
+			history.prune(windowStart)
+			r.nodeConditionHistories.Add(history)
+
+			if t.nodeConditionHistories == nil {
+				t.nodeConditionHistories = map[string]*nodeConditionHistory{}
+			}
+			t.nodeConditionHistories[key] = &history
+		}
+	}
+}
+
+// slidingWindowCheck checks for how long the node condition has been in the status of the unhealthy node condition within
+// its sliding window; it returns a message if the node is unhealthy, otherwise when the node should be checked again.
+func (t *healthCheckTarget) slidingWindowCheck(logger logr.Logger, c clusterv1.UnhealthyNodeCondition, now time.Time) (string, time.Duration) {
+	if !c.SlidingWindow.IsDefined() {
+		return "", time.Duration(0)
+	}
+	history, ok := t.nodeConditionHistories[nodeConditionHistoryKey(t.MHC, t.Machine, t.Node, c)]
+	if !ok {
+		return "", time.Duration(0)
+	}
+
+	windowDuration := time.Duration(ptr.Deref(c.SlidingWindow.WindowSeconds, 0)) * time.Second
+	cumulativeTimeoutDuration := time.Duration(ptr.Deref(c.SlidingWindow.CumulativeTimeoutSeconds, 0)) * time.Second
+
+	durationUnhealthy, ongoing := history.cumulativeDuration(now.Add(-windowDuration), now)
+	if durationUnhealthy >= cumulativeTimeoutDuration {
+		logger.V(3).Info(fmt.Sprintf("Target is unhealthy: Node condition has been in unhealthy state for a cumulative %s within %s", durationUnhealthy.Round(time.Second).String(), windowDuration.String()),
+			"condition", c.Type, "state", c.Status)
+		return fmt.Sprintf("Condition %s on Node has been reporting status %s for a cumulative %s within the last %s, more than %s",
+			c.Type, c.Status, durationUnhealthy.Round(time.Second).String(), windowDuration.String(), cumulativeTimeoutDuration.String()), time.Duration(0)
+	}
+
+	// The cumulative duration can only grow while the node condition is in the status of the unhealthy node condition;
+	// otherwise the next check will be triggered by a change of the node.
+	if !ongoing {
+		return "", time.Duration(0)
+	}
+	return "", cumulativeTimeoutDuration - durationUnhealthy + time.Second
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinehealthcheck
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/cache"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestNodeConditionHistory(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Now()
+	notReady := func(since time.Duration) *corev1.NodeCondition {
+		return &corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionFalse, LastTransitionTime: metav1.NewTime(now.Add(-since))}
+	}
+	ready := func(since time.Duration) *corev1.NodeCondition {
+		return &corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(now.Add(-since))}
+	}
+
+	h := &nodeConditionHistory{}
+
+	// Healthy node conditions do not add intervals.
+	h.observe(ready(time.Hour), corev1.ConditionFalse, now.Add(-50*time.Minute))
+	g.Expect(h.intervals).To(BeEmpty())
+
+	// The node is NotReady for 9 minutes, observed twice.
+	h.observe(notReady(50*time.Minute), corev1.ConditionFalse, now.Add(-50*time.Minute))
+	h.observe(notReady(50*time.Minute), corev1.ConditionFalse, now.Add(-45*time.Minute))
+	g.Expect(h.intervals).To(HaveLen(1))
+	h.observe(ready(41*time.Minute), corev1.ConditionFalse, now.Add(-41*time.Minute))
+	g.Expect(h.intervals).To(HaveLen(1))
+	g.Expect(h.intervals[0].end).To(Equal(now.Add(-41 * time.Minute)))
+
+	// The node is NotReady for 9 minutes again, the transition to Ready is not observed.
+	h.observe(notReady(30*time.Minute), corev1.ConditionFalse, now.Add(-30*time.Minute))
+	h.observe(notReady(10*time.Minute), corev1.ConditionFalse, now.Add(-10*time.Minute))
+	g.Expect(h.intervals).To(HaveLen(3))
+	g.Expect(h.intervals[1].end).To(Equal(now.Add(-10 * time.Minute)))
+	g.Expect(h.intervals[2].end).To(BeZero())
+
+	// Cumulative duration within the last hour: 9m + 20m (upper bound for the missed transition) + 10m (ongoing).
+	d, ongoing := h.cumulativeDuration(now.Add(-time.Hour), now)
+	g.Expect(d).To(Equal(39 * time.Minute))
+	g.Expect(ongoing).To(BeTrue())
+
+	// Only the part of the intervals within the window is considered.
+	d, _ = h.cumulativeDuration(now.Add(-45*time.Minute), now)
+	g.Expect(d).To(Equal(34 * time.Minute))
+
+	// Intervals which ended before the window are pruned.
+	h.prune(now.Add(-40 * time.Minute))
+	g.Expect(h.intervals).To(HaveLen(2))
+
+	// A node condition which is not found anymore closes the ongoing interval.
+	h.observe(nil, corev1.ConditionFalse, now)
+	d, ongoing = h.cumulativeDuration(now.Add(-time.Hour), now)
+	g.Expect(d).To(Equal(30 * time.Minute))
+	g.Expect(ongoing).To(BeFalse())
+}
+
+func TestRecordNodeConditionHistories(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "test-cluster"}}
+	mhc := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "test-mhc"},
+		Spec: clusterv1.MachineHealthCheckSpec{
+			ClusterName: cluster.Name,
+			Checks: clusterv1.MachineHealthCheckChecks{
+				UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+					{
+						Type:           corev1.NodeReady,
+						Status:         corev1.ConditionFalse,
+						TimeoutSeconds: ptr.To[int32](600),
+						SlidingWindow: clusterv1.UnhealthyNodeConditionSlidingWindow{
+							WindowSeconds:            ptr.To[int32](3600),
+							CumulativeTimeoutSeconds: ptr.To[int32](1200),
+						},
+					},
+					// Unhealthy node conditions without a sliding window are not recorded.
+					{
+						Type:           corev1.NodeReady,
+						Status:         corev1.ConditionUnknown,
+						TimeoutSeconds: ptr.To[int32](600),
+					},
+				},
+			},
+		},
+	}
+	newTargets := func(node *corev1.Node) []healthCheckTarget {
+		return []healthCheckTarget{
+			{Cluster: cluster, MHC: mhc, Machine: newTestMachine("machine1", metav1.NamespaceDefault, cluster.Name, "node1", nil), Node: node},
+			// Machines without a Node are not recorded.
+			{Cluster: cluster, MHC: mhc, Machine: newTestMachine("machine2", metav1.NamespaceDefault, cluster.Name, "", nil)},
+		}
+	}
+
+	r := &Reconciler{
+		nodeConditionHistories: cache.New[nodeConditionHistory](nodeConditionHistoryTTL),
+	}
+
+	targets := newTargets(newTestUnhealthyNode("node1", corev1.NodeReady, corev1.ConditionFalse, "NotReady", 9*time.Minute))
+	r.recordNodeConditionHistories(mhc, targets)
+	g.Expect(targets[0].nodeConditionHistories).To(HaveLen(1))
+	g.Expect(targets[1].nodeConditionHistories).To(BeEmpty())
+
+	// The history is preserved across reconciles.
+	targets = newTargets(newTestUnhealthyNode("node1", corev1.NodeReady, corev1.ConditionTrue, "Ready", time.Second))
+	r.recordNodeConditionHistories(mhc, targets)
+	targets = newTargets(newTestUnhealthyNode("node1", corev1.NodeReady, corev1.ConditionFalse, "NotReady", 9*time.Minute))
+	r.recordNodeConditionHistories(mhc, targets)
+	key := nodeConditionHistoryKey(mhc, targets[0].Machine, targets[0].Node, mhc.Spec.Checks.UnhealthyNodeConditions[0])
+	g.Expect(targets[0].nodeConditionHistories).To(HaveKey(key))
+	g.Expect(targets[0].nodeConditionHistories[key].intervals).To(HaveLen(2))
+}
+
+func TestSlidingWindowNeedsRemediation(t *testing.T) {
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "test-cluster"}}
+	conditions.Set(cluster, metav1.Condition{Type: clusterv1.ClusterInfrastructureReadyCondition, Status: metav1.ConditionTrue})
+	conditions.Set(cluster, metav1.Condition{Type: clusterv1.ClusterControlPlaneInitializedCondition, Status: metav1.ConditionTrue})
+
+	unhealthyNodeCondition := clusterv1.UnhealthyNodeCondition{
+		Type:           corev1.NodeReady,
+		Status:         corev1.ConditionFalse,
+		TimeoutSeconds: ptr.To[int32](600),
+		SlidingWindow: clusterv1.UnhealthyNodeConditionSlidingWindow{
+			WindowSeconds:            ptr.To[int32](3600),
+			CumulativeTimeoutSeconds: ptr.To[int32](1200),
+		},
+	}
+	mhc := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "test-mhc"},
+		Spec: clusterv1.MachineHealthCheckSpec{
+			ClusterName: cluster.Name,
+			Checks: clusterv1.MachineHealthCheckChecks{
+				UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{unhealthyNodeCondition},
+			},
+		},
+	}
+
+	now := time.Now()
+	tests := []struct {
+		name                   string
+		node                   *corev1.Node
+		intervals              []nodeConditionInterval
+		wantNeedsRemediation   bool
+		wantNextCheck          bool
+		wantConditionSubstring string
+	}{
+		{
+			name: "healthy if the history is not recorded",
+			node: newTestUnhealthyNode("node1", corev1.NodeReady, corev1.ConditionTrue, "Ready", time.Minute),
+		},
+		{
+			name: "healthy if the cumulative duration is less than the cumulative timeout",
+			node: newTestUnhealthyNode("node1", corev1.NodeReady, corev1.ConditionTrue, "Ready", time.Minute),
+			intervals: []nodeConditionInterval{
+				{start: now.Add(-30 * time.Minute), end: now.Add(-21 * time.Minute)},
+			},
+		},
+		{
+			name: "not yet unhealthy if the node condition is unhealthy and the cumulative duration is less than the cumulative timeout",
+			node: newTestUnhealthyNode("node1", corev1.NodeReady, corev1.ConditionFalse, "NotReady", 5*time.Minute),
+			intervals: []nodeConditionInterval{
+				{start: now.Add(-30 * time.Minute), end: now.Add(-21 * time.Minute)},
+				{start: now.Add(-5 * time.Minute)},
+			},
+			wantNextCheck: true,
+		},
+		{
+			name: "unhealthy if the cumulative duration within the window is more than the cumulative timeout",
+			node: newTestUnhealthyNode("node1", corev1.NodeReady, corev1.ConditionTrue, "Ready", time.Minute),
+			intervals: []nodeConditionInterval{
+				{start: now.Add(-50 * time.Minute), end: now.Add(-41 * time.Minute)},
+				{start: now.Add(-30 * time.Minute), end: now.Add(-21 * time.Minute)},
+				{start: now.Add(-10 * time.Minute), end: now.Add(-time.Minute)},
+			},
+			wantNeedsRemediation:   true,
+			wantConditionSubstring: "Condition Ready on Node has been reporting status False for a cumulative 27m0s within the last 1h0m0s, more than 20m0s",
+		},
+		{
+			name: "healthy if intervals are outside the window",
+			node: newTestUnhealthyNode("node1", corev1.NodeReady, corev1.ConditionTrue, "Ready", time.Minute),
+			intervals: []nodeConditionInterval{
+				{start: now.Add(-3 * time.Hour), end: now.Add(-2 * time.Hour)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := healthCheckTarget{
+				Cluster: cluster,
+				MHC:     mhc,
+				Machine: newTestMachine("machine1", metav1.NamespaceDefault, cluster.Name, "node1", nil),
+				Node:    tt.node,
+			}
+			if tt.intervals != nil {
+				key := nodeConditionHistoryKey(mhc, target.Machine, target.Node, unhealthyNodeCondition)
+				target.nodeConditionHistories = map[string]*nodeConditionHistory{
+					key: {key: key, intervals: tt.intervals},
+				}
+			}
+
+			needsRemediation, nextCheck := target.needsRemediation(ctrl.Log, metav1.Duration{Duration: 10 * time.Minute})
+			g.Expect(needsRemediation).To(Equal(tt.wantNeedsRemediation))
+			g.Expect(nextCheck > 0).To(Equal(tt.wantNextCheck))
+			if !tt.wantNeedsRemediation {
+				return
+			}
+			condition := conditions.Get(target.Machine, clusterv1.MachineHealthCheckSucceededCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Reason).To(Equal(clusterv1.MachineHealthCheckUnhealthyNodeReason))
+			g.Expect(condition.Message).To(ContainSubstring(tt.wantConditionSubstring))
+		})
+	}
+}
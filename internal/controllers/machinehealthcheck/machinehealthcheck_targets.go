@@ -45,6 +45,12 @@ const (
 
 	// EventMachineMarkedUnhealthy is emitted when machine was successfully marked as unhealthy.
 	EventMachineMarkedUnhealthy string = "MachineMarkedUnhealthy"
+
+	// EventMachineRebootRequested is emitted when a reboot was requested for an unhealthy machine.
+	EventMachineRebootRequested string = "MachineRebootRequested"
+
+	// EventMachineCordonRequested is emitted when cordoning the Node of an unhealthy machine was requested.
+	EventMachineCordonRequested string = "MachineCordonRequested"
 )
 
 var (
@@ -61,6 +67,13 @@ type healthCheckTarget struct {
 	MHC         *clusterv1.MachineHealthCheck
 	patchHelper *patch.Helper
 	nodeMissing bool
+
+	// nodeProbeResult is the result of the last probe of the Node, if the MachineHealthCheck has a node probe.
+	nodeProbeResult *nodeProbeResult
+
+	// nodeConditionHistories are the histories of the node conditions, by key, for the unhealthy node conditions
+	// of the MachineHealthCheck with a sliding window.
+	nodeConditionHistories map[string]*nodeConditionHistory
 }
 
 // needsRemediation determines whether a given target needs remediation.
@@ -106,6 +119,21 @@ func (t *healthCheckTarget) needsRemediation(logger logr.Logger, timeoutForMachi
 		return false, 0
 	}
 
+	// Don't penalize Machines which are stopped or being stopped, nor Machines which are being started.
+	if t.Machine.Spec.PowerState == clusterv1.MachinePowerStateStopped || conditions.IsTrue(t.Machine, clusterv1.MachineStoppedCondition) {
+		logger.V(5).Info("Not evaluating target health because the machine is stopped")
+		// Return a nextCheck time of 0 because we'll get requeued when the Machine is updated.
+		return false, 0
+	}
+
+	// Give Machines which have been started again the same time to get a healthy Node as new Machines.
+	if stopped := conditions.Get(t.Machine, clusterv1.MachineStoppedCondition); stopped != nil && stopped.Status == metav1.ConditionFalse && timeoutForMachineToHaveNode != disabledNodeStartupTimeout {
+		if startupDeadline := stopped.LastTransitionTime.Add(timeoutForMachineToHaveNode.Duration); startupDeadline.After(time.Now()) {
+			logger.V(5).Info("Not evaluating target health because the machine has been started recently")
+			return false, time.Until(startupDeadline) + time.Second
+		}
+	}
+
 	// Check machine conditions
 	unhealthyMachineMessages, nextMachineCheck := t.machineChecks(logger)
 
@@ -263,6 +291,17 @@ func (t *healthCheckTarget) nodeChecks(logger logr.Logger, timeoutForMachineToHa
 	for _, c := range t.MHC.Spec.Checks.UnhealthyNodeConditions {
 		nodeCondition := getNodeCondition(t.Node, c.Type)
 
+		// If the node condition has been in the unhealthy state for longer than the cumulative timeout
+		// within the sliding window, mark as unhealthy and collect the message.
+		slidingWindowMessage, nextSlidingWindowCheck := t.slidingWindowCheck(logger, c, now)
+		if slidingWindowMessage != "" {
+			unhealthyNodeMessages = append(unhealthyNodeMessages, slidingWindowMessage)
+			continue
+		}
+		if nextSlidingWindowCheck > 0 {
+			nextCheckTimes = append(nextCheckTimes, nextSlidingWindowCheck)
+		}
+
 		// Skip when current node condition is different from the one reported
 		// in the MachineHealthCheck.
 		if nodeCondition == nil || nodeCondition.Status != c.Status {
@@ -288,12 +327,69 @@ func (t *healthCheckTarget) nodeChecks(logger logr.Logger, timeoutForMachineToHa
 		}
 	}
 
+	// check the result of the node probe (only when the MachineHealthCheck has a node probe)
+	var unreachableNodeMessage string
+	if t.nodeProbeResult != nil && t.nodeProbeResult.err != nil {
+		timeoutSecondsDuration := time.Duration(ptr.Deref(t.MHC.Spec.Checks.NodeProbe.UnhealthyTimeoutSeconds, clusterv1.DefaultNodeProbeUnhealthyTimeoutSeconds)) * time.Second
+
+		if t.nodeProbeResult.failingSince.Add(timeoutSecondsDuration).Before(now) {
+			unreachableNodeMessage = fmt.Sprintf("Node is not reachable by the %s probe for more than %s: %s",
+				t.MHC.Spec.Checks.NodeProbe.Type, timeoutSecondsDuration.String(), t.nodeProbeResult.err.Error())
+			logger.V(3).Info(fmt.Sprintf("Target is unhealthy: Node is not reachable for more than %s", timeoutSecondsDuration.String()),
+				"probe", t.MHC.Spec.Checks.NodeProbe.Type, "err", t.nodeProbeResult.err.Error())
+		} else {
+			durationUnhealthy := now.Sub(t.nodeProbeResult.failingSince)
+			nextCheck := timeoutSecondsDuration - durationUnhealthy + time.Second
+			if nextCheck > 0 {
+				nextCheckTimes = append(nextCheckTimes, nextCheck)
+			}
+		}
+	}
+
 	if len(unhealthyNodeMessages) > 0 {
+		if unreachableNodeMessage != "" {
+			unhealthyNodeMessages = append(unhealthyNodeMessages, unreachableNodeMessage)
+		}
 		return clusterv1.MachineHealthCheckUnhealthyNodeReason, clusterv1.UnhealthyNodeConditionV1Beta1Reason, unhealthyNodeMessages, time.Duration(0)
 	}
+	if unreachableNodeMessage != "" {
+		return clusterv1.MachineHealthCheckNodeUnreachableReason, clusterv1.NodeUnreachableV1Beta1Reason, []string{unreachableNodeMessage}, time.Duration(0)
+	}
 	return "", "", nil, minDuration(nextCheckTimes)
 }
 
+// unhealthyConditions returns the conditions surfacing why the target is unhealthy, i.e. the HealthCheckSucceeded
+// condition of the Machine as well as the Machine and Node conditions matching the checks of the MachineHealthCheck.
+func (t *healthCheckTarget) unhealthyConditions() []metav1.Condition {
+	var unhealthyConditions []metav1.Condition
+
+	if c := conditions.Get(t.Machine, clusterv1.MachineHealthCheckSucceededCondition); c != nil {
+		unhealthyConditions = append(unhealthyConditions, *c)
+	}
+
+	for _, c := range t.MHC.Spec.Checks.UnhealthyMachineConditions {
+		if machineCondition := getMachineCondition(t.Machine, c.Type); machineCondition != nil && machineCondition.Status == c.Status {
+			unhealthyConditions = append(unhealthyConditions, *machineCondition)
+		}
+	}
+
+	if t.Node != nil {
+		for _, c := range t.MHC.Spec.Checks.UnhealthyNodeConditions {
+			if nodeCondition := getNodeCondition(t.Node, c.Type); nodeCondition != nil && nodeCondition.Status == c.Status {
+				unhealthyConditions = append(unhealthyConditions, metav1.Condition{
+					Type:               string(nodeCondition.Type),
+					Status:             metav1.ConditionStatus(nodeCondition.Status),
+					LastTransitionTime: nodeCondition.LastTransitionTime,
+					Reason:             nodeCondition.Reason,
+					Message:            nodeCondition.Message,
+				})
+			}
+		}
+	}
+
+	return unhealthyConditions
+}
+
 // getTargetsFromMHC uses the MachineHealthCheck's selector to fetch machines
 // and their nodes targeted by the health check, ready for health checking.
 func (r *Reconciler) getTargetsFromMHC(ctx context.Context, logger logr.Logger, clusterClient client.Reader, cluster *clusterv1.Cluster, mhc *clusterv1.MachineHealthCheck) ([]healthCheckTarget, error) {
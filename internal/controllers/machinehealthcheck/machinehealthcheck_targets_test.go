@@ -448,6 +448,30 @@ func TestHealthCheckTargets(t *testing.T) {
 	machineAnnotationRemediationCondition := newFailedHealthCheckV1Beta1Condition(clusterv1.HasRemediateMachineAnnotationV1Beta1Reason, annotationRemediationMsg)
 	machineAnnotationRemediationV1Beta2Condition := newFailedHealthCheckCondition(clusterv1.MachineHealthCheckHasRemediateAnnotationReason, annotationRemediationV1Beta2Msg)
 
+	// Target for when the machine is stopped and the node has been in an unknown state for longer than the timeout
+	testMachineStopped := testMachine.DeepCopy()
+	testMachineStopped.Spec.PowerState = clusterv1.MachinePowerStateStopped
+	conditions.Set(testMachineStopped, metav1.Condition{Type: clusterv1.MachineStoppedCondition, Status: metav1.ConditionTrue, Reason: clusterv1.MachineStoppedReason})
+	machineStopped := healthCheckTarget{
+		Cluster:     cluster,
+		MHC:         testMHC,
+		Machine:     testMachineStopped,
+		Node:        testNodeUnknown400,
+		nodeMissing: false,
+	}
+
+	// Target for when the machine has been started shorter than the node startup timeout and the node has been in an unknown state for longer than the timeout
+	testMachineStarted200s := testMachine.DeepCopy()
+	testMachineStarted200s.Spec.PowerState = clusterv1.MachinePowerStateRunning
+	conditions.Set(testMachineStarted200s, metav1.Condition{Type: clusterv1.MachineStoppedCondition, Status: metav1.ConditionFalse, Reason: clusterv1.MachineNotStoppedReason, LastTransitionTime: metav1.NewTime(time.Now().Add(-200 * time.Second))})
+	machineStarted200s := healthCheckTarget{
+		Cluster:     cluster,
+		MHC:         testMHC,
+		Machine:     testMachineStarted200s,
+		Node:        testNodeUnknown400,
+		nodeMissing: false,
+	}
+
 	testCases := []struct {
 		desc                                     string
 		targets                                  []healthCheckTarget
@@ -555,6 +579,20 @@ func TestHealthCheckTargets(t *testing.T) {
 			expectedNeedsRemediationV1Beta2Condition: []metav1.Condition{machineAnnotationRemediationV1Beta2Condition},
 			expectedNextCheckTimes:                   []time.Duration{},
 		},
+		{
+			desc:                     "when the machine is stopped",
+			targets:                  []healthCheckTarget{machineStopped},
+			expectedHealthy:          []healthCheckTarget{machineStopped},
+			expectedNeedsRemediation: []healthCheckTarget{},
+			expectedNextCheckTimes:   []time.Duration{},
+		},
+		{
+			desc:                     "when the machine has been started for shorter than the node startup timeout",
+			targets:                  []healthCheckTarget{machineStarted200s},
+			expectedHealthy:          []healthCheckTarget{},
+			expectedNeedsRemediation: []healthCheckTarget{},
+			expectedNextCheckTimes:   []time.Duration{timeoutForMachineToHaveNode - 200*time.Second},
+		},
 		{
 			desc:                                     "health check with empty unhealthy conditions and missing node",
 			targets:                                  []healthCheckTarget{nodeGoneAwayEmptyConditions},
@@ -46,6 +46,7 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/cache"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 	"sigs.k8s.io/cluster-api/util/finalizers"
@@ -86,6 +87,8 @@ type Reconciler struct {
 	externalTracker external.ObjectTracker
 
 	predicateLog *logr.Logger
+
+	bootstrapDataHashCache cache.Cache[bootstrapDataHashEntry]
 }
 
 func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -130,6 +133,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		PredicateLogger: r.predicateLog,
 	}
 	r.ssaCache = ssa.NewCache("machinepool")
+	r.bootstrapDataHashCache = cache.New[bootstrapDataHashEntry](cache.DefaultTTL)
 
 	return nil
 }
@@ -207,6 +211,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 			}},
 			patch.WithOwnedConditions{Conditions: []string{
 				clusterv1.PausedCondition,
+				clusterv1.MachinePoolMachinesSupportedCondition,
 			}},
 		}
 		if reterr == nil {
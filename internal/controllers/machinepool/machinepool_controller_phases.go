@@ -18,6 +18,7 @@ package machinepool
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"reflect"
 	"time"
@@ -43,6 +44,7 @@ import (
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/cache"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 	"sigs.k8s.io/cluster-api/util/labels"
 	"sigs.k8s.io/cluster-api/util/labels/format"
@@ -233,20 +235,109 @@ func (r *Reconciler) reconcileBootstrap(ctx context.Context, s *scope) (ctrl.Res
 
 		m.Spec.Template.Spec.Bootstrap.DataSecretName = secretName
 		m.Status.Initialization.BootstrapDataSecretCreated = ptr.To(true)
-		return ctrl.Result{}, nil
+		return r.computeBootstrapDataHash(ctx, s)
 	}
 
 	// If dataSecretName is set without a ConfigRef, this means the user brought their own bootstrap data.
 	if m.Spec.Template.Spec.Bootstrap.DataSecretName != nil {
 		m.Status.Initialization.BootstrapDataSecretCreated = ptr.To(true)
 		v1beta1conditions.MarkTrue(m, clusterv1.BootstrapReadyV1Beta1Condition)
-		return ctrl.Result{}, nil
+		return r.computeBootstrapDataHash(ctx, s)
 	}
 
 	// This should never happen because the MachinePool webhook would not allow neither ConfigRef nor DataSecretName to be set.
 	return ctrl.Result{}, errors.Errorf("neither .spec.bootstrap.configRef nor .spec.bootstrap.dataSecretName are set for MachinePool %q in namespace %q", m.Name, m.Namespace)
 }
 
+// bootstrapDataHashEntry is an entry of the cache used to avoid hashing the bootstrap data of a MachinePool
+// at every reconcile; entries are keyed by the namespace and the name of the bootstrap data secret.
+// Note: Bootstrap data secrets without the cluster name label are not in the controller-runtime cache, and they are read
+// from the API server only when the entry expires, so changes to these secrets are detected only after the entry expires.
+type bootstrapDataHashEntry struct {
+	key             string
+	resourceVersion string
+	hash            string
+}
+
+// Key returns the cache key of a bootstrapDataHashEntry.
+func (e bootstrapDataHashEntry) Key() string {
+	return e.key
+}
+
+var _ cache.Entry = bootstrapDataHashEntry{}
+
+// computeBootstrapDataHash computes the hash of the bootstrap data of a MachinePool.
+// Note: The hash is later surfaced on the InfraMachinePool, so infrastructure providers are notified when the bootstrap data
+// changes, e.g. because the bootstrap provider rotated the bootstrap token embedded in the bootstrap data.
+func (r *Reconciler) computeBootstrapDataHash(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	m := s.machinePool
+
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: m.Namespace, Name: *m.Spec.Template.Spec.Bootstrap.DataSecretName}
+	entry, cached := r.bootstrapDataHashCache.Has(key.String())
+
+	err := r.Client.Get(ctx, key, secret)
+	if err == nil {
+		if !cached || entry.resourceVersion != secret.ResourceVersion {
+			entry = bootstrapDataHashEntry{key: key.String(), resourceVersion: secret.ResourceVersion, hash: fmt.Sprintf("%x", sha256.Sum256(secret.Data["value"]))}
+			r.bootstrapDataHashCache.Add(entry)
+		}
+		s.bootstrapDataHash = entry.hash
+		return ctrl.Result{}, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to get bootstrap data secret %s", klog.KRef(key.Namespace, key.Name))
+	}
+
+	// Note: Only Secrets with the cluster name label are cached; fall back to the live client to read bootstrap data
+	// secrets provided by users without the label, but only when the cache entry expired, and requeue to detect changes.
+	if !cached {
+		if err := r.APIReader.Get(ctx, key, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.V(4).Info("Bootstrap data secret not found, skipping computation of the bootstrap data hash", "Secret", klog.KRef(key.Namespace, key.Name))
+				return ctrl.Result{}, nil
+			}
+			return ctrl.Result{}, errors.Wrapf(err, "failed to get bootstrap data secret %s", klog.KRef(key.Namespace, key.Name))
+		}
+		entry = bootstrapDataHashEntry{key: key.String(), resourceVersion: secret.ResourceVersion, hash: fmt.Sprintf("%x", sha256.Sum256(secret.Data["value"]))}
+		r.bootstrapDataHashCache.Add(entry)
+	}
+	s.bootstrapDataHash = entry.hash
+	return ctrl.Result{RequeueAfter: cache.DefaultTTL}, nil
+}
+
+// reconcileBootstrapDataHash surfaces the hash of the bootstrap data of a MachinePool on the InfraMachinePool.
+func (r *Reconciler) reconcileBootstrapDataHash(ctx context.Context, s *scope, infraMachinePool *unstructured.Unstructured) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if s.bootstrapDataHash == "" {
+		return nil
+	}
+
+	currentHash := infraMachinePool.GetAnnotations()[clusterv1.MachinePoolBootstrapDataHashAnnotation]
+	if currentHash == s.bootstrapDataHash {
+		return nil
+	}
+
+	patchHelper, err := patch.NewHelper(infraMachinePool, r.Client)
+	if err != nil {
+		return err
+	}
+	annotations.AddAnnotations(infraMachinePool, map[string]string{
+		clusterv1.MachinePoolBootstrapDataHashAnnotation: s.bootstrapDataHash,
+	})
+	if err := patchHelper.Patch(ctx, infraMachinePool); err != nil {
+		return errors.Wrapf(err, "failed to set %s annotation on %s %s", clusterv1.MachinePoolBootstrapDataHashAnnotation, infraMachinePool.GetKind(), klog.KObj(infraMachinePool))
+	}
+
+	// Note: Do not log when setting the hash for the first time, e.g. when the MachinePool is created.
+	if currentHash != "" {
+		log.Info("Bootstrap data changed, notifying the infrastructure provider", infraMachinePool.GetKind(), klog.KObj(infraMachinePool))
+	}
+	return nil
+}
+
 // reconcileInfrastructure reconciles the Spec.InfrastructureRef object on a MachinePool.
 func (r *Reconciler) reconcileInfrastructure(ctx context.Context, s *scope) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -281,6 +372,10 @@ func (r *Reconciler) reconcileInfrastructure(ctx context.Context, s *scope) (ctr
 		return ctrl.Result{}, nil
 	}
 
+	if err := r.reconcileBootstrapDataHash(ctx, s, infraConfig); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	ready, err := external.IsReady(infraConfig)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -500,6 +595,11 @@ func (r *Reconciler) computeDesiredMachine(mp *clusterv1.MachinePool, infraMachi
 			},
 			InfrastructureRef: infraRef,
 			Version:           kubernetesVersion,
+			// Propagate fields from the MachinePool's template which are relevant for the Machine's conditions
+			// and for the Machine deletion workflow (e.g. Node drain).
+			MinReadySeconds: mp.Spec.Template.Spec.MinReadySeconds,
+			ReadinessGates:  mp.Spec.Template.Spec.ReadinessGates,
+			Deletion:        mp.Spec.Template.Spec.Deletion,
 		},
 	}
 
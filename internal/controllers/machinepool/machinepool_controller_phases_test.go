@@ -41,6 +41,7 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/external"
 	externalfake "sigs.k8s.io/cluster-api/controllers/external/fake"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
+	"sigs.k8s.io/cluster-api/util/cache"
 	"sigs.k8s.io/cluster-api/util/kubeconfig"
 	"sigs.k8s.io/cluster-api/util/labels/format"
 	"sigs.k8s.io/cluster-api/util/test/builder"
@@ -130,8 +131,10 @@ func TestReconcileMachinePoolPhases(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(defaultCluster, defaultKubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -173,8 +176,10 @@ func TestReconcileMachinePoolPhases(t *testing.T) {
 		fakeClient := fake.NewClientBuilder().WithObjects(defaultCluster, defaultKubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -213,8 +218,10 @@ func TestReconcileMachinePoolPhases(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(defaultCluster, defaultKubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -269,8 +276,10 @@ func TestReconcileMachinePoolPhases(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(defaultCluster, defaultKubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -341,8 +350,10 @@ func TestReconcileMachinePoolPhases(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(defaultCluster, defaultKubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -391,8 +402,10 @@ func TestReconcileMachinePoolPhases(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(defaultCluster, defaultKubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -444,8 +457,10 @@ func TestReconcileMachinePoolPhases(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(defaultCluster, defaultKubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -514,8 +529,10 @@ func TestReconcileMachinePoolPhases(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(defaultCluster, defaultKubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -590,8 +607,10 @@ func TestReconcileMachinePoolPhases(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(defaultCluster, defaultKubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -664,8 +683,10 @@ func TestReconcileMachinePoolPhases(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(defaultCluster, defaultKubeconfigSecret, machinePool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -760,8 +781,10 @@ func TestReconcileMachinePoolPhases(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(defaultCluster, defaultKubeconfigSecret, machinePool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -1100,7 +1123,9 @@ func TestReconcileMachinePoolBootstrap(t *testing.T) {
 			bootstrapConfig := &unstructured.Unstructured{Object: tc.bootstrapConfig}
 			fakeClient := fake.NewClientBuilder().WithObjects(tc.machinepool, bootstrapConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 			r := &Reconciler{
-				Client: fakeClient,
+				Client:                 fakeClient,
+				APIReader:              fakeClient,
+				bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
 				externalTracker: external.ObjectTracker{
 					Controller:      externalfake.Controller{},
 					Cache:           &informertest.FakeInformers{},
@@ -1308,8 +1333,10 @@ func TestReconcileMachinePoolInfrastructure(t *testing.T) {
 			infraConfig := &unstructured.Unstructured{Object: tc.infraConfig}
 			fakeClient := fake.NewClientBuilder().WithObjects(tc.machinepool, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 			r := &Reconciler{
-				Client:       fakeClient,
-				ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
+				Client:                 fakeClient,
+				APIReader:              fakeClient,
+				bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+				ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: defaultCluster.Name, Namespace: defaultCluster.Namespace}),
 				externalTracker: external.ObjectTracker{
 					Controller:      externalfake.Controller{},
 					Cache:           &informertest.FakeInformers{},
@@ -1343,6 +1370,208 @@ func TestReconcileMachinePoolInfrastructure(t *testing.T) {
 	}
 }
 
+func TestReconcileMachinePoolBootstrapDataHash(t *testing.T) {
+	machinePool := &clusterv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "machinepool-test",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: clusterv1.MachinePoolSpec{
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To("bootstrap-data"),
+					},
+				},
+			},
+		},
+	}
+	bootstrapDataSecret := func(data string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bootstrap-data",
+				Namespace: metav1.NamespaceDefault,
+			},
+			Data: map[string][]byte{
+				"value": []byte(data),
+			},
+		}
+	}
+	infraMachinePool := func(annotations map[string]string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(builder.InfrastructureGroupVersion.String())
+		u.SetKind(builder.TestInfrastructureMachineTemplateKind)
+		u.SetName("infra-config1")
+		u.SetNamespace(metav1.NamespaceDefault)
+		u.SetAnnotations(annotations)
+		return u
+	}
+
+	tests := []struct {
+		name               string
+		secret             *corev1.Secret
+		uncachedSecret     *corev1.Secret
+		infraMachinePool   *unstructured.Unstructured
+		expectHash         string
+		expectRequeueAfter time.Duration
+	}{
+		{
+			name:             "does not set the annotation if the bootstrap data secret does not exist",
+			infraMachinePool: infraMachinePool(nil),
+			expectHash:       "",
+		},
+		{
+			name:               "sets the annotation if the bootstrap data secret is not cached",
+			uncachedSecret:     bootstrapDataSecret("foo"),
+			infraMachinePool:   infraMachinePool(nil),
+			expectHash:         "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+			expectRequeueAfter: cache.DefaultTTL,
+		},
+		{
+			name:             "sets the annotation if it is not set yet",
+			secret:           bootstrapDataSecret("foo"),
+			infraMachinePool: infraMachinePool(nil),
+			expectHash:       "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+		},
+		{
+			name:   "updates the annotation if the bootstrap data changed",
+			secret: bootstrapDataSecret("bar"),
+			infraMachinePool: infraMachinePool(map[string]string{
+				clusterv1.MachinePoolBootstrapDataHashAnnotation: "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+			}),
+			expectHash: "fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			objs := []client.Object{tt.infraMachinePool, builder.TestInfrastructureMachineTemplateCRD}
+			if tt.secret != nil {
+				objs = append(objs, tt.secret)
+			}
+			fakeClient := fake.NewClientBuilder().WithObjects(objs...).Build()
+			apiReaderObjs := []client.Object{}
+			if tt.uncachedSecret != nil {
+				apiReaderObjs = append(apiReaderObjs, tt.uncachedSecret)
+			}
+			r := &Reconciler{
+				Client:                 fakeClient,
+				APIReader:              fake.NewClientBuilder().WithObjects(apiReaderObjs...).Build(),
+				bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			}
+			s := &scope{
+				machinePool: machinePool.DeepCopy(),
+			}
+
+			res, err := r.computeBootstrapDataHash(ctx, s)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res.RequeueAfter).To(Equal(tt.expectRequeueAfter))
+			g.Expect(s.bootstrapDataHash).To(Equal(tt.expectHash))
+
+			g.Expect(r.reconcileBootstrapDataHash(ctx, s, tt.infraMachinePool)).To(Succeed())
+
+			got := infraMachinePool(nil)
+			g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(got), got)).To(Succeed())
+			if tt.expectHash == "" {
+				g.Expect(got.GetAnnotations()).ToNot(HaveKey(clusterv1.MachinePoolBootstrapDataHashAnnotation))
+			} else {
+				g.Expect(got.GetAnnotations()).To(HaveKeyWithValue(clusterv1.MachinePoolBootstrapDataHashAnnotation, tt.expectHash))
+			}
+		})
+	}
+}
+
+func TestComputeBootstrapDataHashCache(t *testing.T) {
+	machinePool := &clusterv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "machinepool-test",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: clusterv1.MachinePoolSpec{
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To("bootstrap-data"),
+					},
+				},
+			},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bootstrap-data",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Data: map[string][]byte{
+			"value": []byte("foo"),
+		},
+	}
+	fooHash := "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+	barHash := "fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9"
+
+	t.Run("recomputes the hash when the resourceVersion of a cached secret changes", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeClient := fake.NewClientBuilder().WithObjects(secret.DeepCopy()).Build()
+		r := &Reconciler{
+			Client:                 fakeClient,
+			APIReader:              fake.NewClientBuilder().Build(),
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+		}
+
+		s := &scope{machinePool: machinePool.DeepCopy()}
+		_, err := r.computeBootstrapDataHash(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(s.bootstrapDataHash).To(Equal(fooHash))
+
+		updated := &corev1.Secret{}
+		g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), updated)).To(Succeed())
+		updated.Data["value"] = []byte("bar")
+		g.Expect(fakeClient.Update(ctx, updated)).To(Succeed())
+
+		s = &scope{machinePool: machinePool.DeepCopy()}
+		_, err = r.computeBootstrapDataHash(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(s.bootstrapDataHash).To(Equal(barHash))
+	})
+
+	t.Run("does not read a secret which is not cached from the API server until the cache entry expires", func(t *testing.T) {
+		g := NewWithT(t)
+
+		apiReader := fake.NewClientBuilder().WithObjects(secret.DeepCopy()).Build()
+		r := &Reconciler{
+			Client:                 fake.NewClientBuilder().Build(),
+			APIReader:              apiReader,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+		}
+
+		s := &scope{machinePool: machinePool.DeepCopy()}
+		res, err := r.computeBootstrapDataHash(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(res.RequeueAfter).To(Equal(cache.DefaultTTL))
+		g.Expect(s.bootstrapDataHash).To(Equal(fooHash))
+
+		// Delete the secret from the API server: the hash is still read from the cache.
+		g.Expect(apiReader.Delete(ctx, secret.DeepCopy())).To(Succeed())
+
+		s = &scope{machinePool: machinePool.DeepCopy()}
+		res, err = r.computeBootstrapDataHash(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(res.RequeueAfter).To(Equal(cache.DefaultTTL))
+		g.Expect(s.bootstrapDataHash).To(Equal(fooHash))
+
+		// Once the cache entry expires, the secret is read again from the API server.
+		r.bootstrapDataHashCache.Delete(client.ObjectKeyFromObject(secret).String())
+
+		s = &scope{machinePool: machinePool.DeepCopy()}
+		res, err = r.computeBootstrapDataHash(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(res.RequeueAfter).To(BeZero())
+		g.Expect(s.bootstrapDataHash).To(BeEmpty())
+	})
+}
+
 func TestReconcileMachinePoolMachines(t *testing.T) {
 	t.Run("Reconcile MachinePool Machines", func(t *testing.T) {
 		g := NewWithT(t)
@@ -1840,9 +2069,11 @@ func TestReconcileMachinePoolScaleToFromZero(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(testCluster, kubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(env.GetClient(), client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
-			recorder:     record.NewFakeRecorder(32),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(env.GetClient(), client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
+			recorder:               record.NewFakeRecorder(32),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -1908,9 +2139,11 @@ func TestReconcileMachinePoolScaleToFromZero(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(testCluster, kubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(env.GetClient(), client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
-			recorder:     record.NewFakeRecorder(32),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(env.GetClient(), client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
+			recorder:               record.NewFakeRecorder(32),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -1959,9 +2192,10 @@ func TestReconcileMachinePoolScaleToFromZero(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(testCluster, kubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			recorder:     record.NewFakeRecorder(32),
-			ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			Client:                 fakeClient,
+			recorder:               record.NewFakeRecorder(32),
+			ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -2006,9 +2240,10 @@ func TestReconcileMachinePoolScaleToFromZero(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(testCluster, kubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			recorder:     record.NewFakeRecorder(32),
-			ClusterCache: clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			Client:                 fakeClient,
+			recorder:               record.NewFakeRecorder(32),
+			ClusterCache:           clustercache.NewFakeClusterCache(fakeClient, client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -2075,9 +2310,11 @@ func TestReconcileMachinePoolScaleToFromZero(t *testing.T) {
 
 		fakeClient := fake.NewClientBuilder().WithObjects(testCluster, kubeconfigSecret, machinepool, bootstrapConfig, infraConfig, builder.TestBootstrapConfigCRD, builder.TestInfrastructureMachineTemplateCRD).Build()
 		r := &Reconciler{
-			Client:       fakeClient,
-			ClusterCache: clustercache.NewFakeClusterCache(env.GetClient(), client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
-			recorder:     record.NewFakeRecorder(32),
+			Client:                 fakeClient,
+			APIReader:              fakeClient,
+			bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+			ClusterCache:           clustercache.NewFakeClusterCache(env.GetClient(), client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
+			recorder:               record.NewFakeRecorder(32),
 			externalTracker: external.ObjectTracker{
 				Controller:      externalfake.Controller{},
 				Cache:           &informertest.FakeInformers{},
@@ -2336,3 +2573,26 @@ func reconcileNormalFuncsForTest(mpr *Reconciler) []machinePoolReconcileFunc {
 		mpr.setMachinesUptoDate,
 	}
 }
+
+func TestComputeDesiredMachine(t *testing.T) {
+	g := NewWithT(t)
+
+	machinePool := getMachinePool(1, "machinepool-test", clusterName, metav1.NamespaceDefault)
+	machinePool.Spec.Template.Spec.MinReadySeconds = ptr.To[int32](10)
+	machinePool.Spec.Template.Spec.ReadinessGates = []clusterv1.MachineReadinessGate{{ConditionType: "Foo"}}
+	machinePool.Spec.Template.Spec.Deletion.NodeDrainTimeoutSeconds = ptr.To[int32](60)
+
+	infraMachine := &unstructured.Unstructured{}
+	infraMachine.SetAPIVersion(clusterv1.GroupVersionInfrastructure.String())
+	infraMachine.SetKind(builder.GenericInfrastructureMachineKind)
+	infraMachine.SetName("infra-machine-1")
+
+	r := &Reconciler{}
+	machine := r.computeDesiredMachine(&machinePool, infraMachine, nil, nil)
+
+	g.Expect(machine.Name).To(Equal("infra-machine-1"))
+	g.Expect(machine.Spec.InfrastructureRef.Name).To(Equal("infra-machine-1"))
+	g.Expect(machine.Spec.MinReadySeconds).To(Equal(ptr.To[int32](10)))
+	g.Expect(machine.Spec.ReadinessGates).To(Equal(machinePool.Spec.Template.Spec.ReadinessGates))
+	g.Expect(machine.Spec.Deletion.NodeDrainTimeoutSeconds).To(Equal(ptr.To[int32](60)))
+}
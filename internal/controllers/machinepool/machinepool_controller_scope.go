@@ -36,6 +36,10 @@ type scope struct {
 	// of the reconcile function.
 	machinePool *clusterv1.MachinePool
 
+	// bootstrapDataHash is the hash of the bootstrap data of the MachinePool. It is set during
+	// the reconcile bootstrap phase, if the bootstrap data secret exists.
+	bootstrapDataHash string
+
 	// infraMachinePool is the infrastructure machinepool object. It is set during
 	// the reconcile infrastructure phase.
 	infraMachinePool *unstructured.Unstructured
@@ -20,21 +20,30 @@ import (
 	"context"
 	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/labels/format"
 )
 
 func (r *Reconciler) updateStatus(ctx context.Context, s *scope) error {
 	log := ctrl.LoggerFrom(ctx)
 
+	// Set the label selector in string format in status.
+	// This is necessary for CRDs including scale subresources.
+	setSelector(s.machinePool)
+
 	if s.infraMachinePool == nil {
 		log.V(4).Info("infra machine pool isn't set, skipping setting status")
 		return nil
 	}
 	hasMachinePoolMachines, err := s.hasMachinePoolMachines()
+	setMachinesSupportedCondition(ctx, s.machinePool, s.infraMachinePool, hasMachinePoolMachines, err)
 	if err != nil {
 		return fmt.Errorf("determining if there are machine pool machines: %w", err)
 	}
@@ -46,6 +55,14 @@ func (r *Reconciler) updateStatus(ctx context.Context, s *scope) error {
 	return nil
 }
 
+// setSelector sets the label selector matching the Machines belonging to the MachinePool.
+func setSelector(mp *clusterv1.MachinePool) {
+	mp.Status.Selector = labels.SelectorFromSet(labels.Set{
+		clusterv1.ClusterNameLabel:     mp.Spec.ClusterName,
+		clusterv1.MachinePoolNameLabel: format.MustFormatValue(mp.Name),
+	}).String()
+}
+
 func setReplicas(mp *clusterv1.MachinePool, hasMachinePoolMachines bool, machines []*clusterv1.Machine) {
 	if !hasMachinePoolMachines {
 		// If we don't have machinepool machine then calculate the values differently
@@ -73,3 +90,37 @@ func setReplicas(mp *clusterv1.MachinePool, hasMachinePoolMachines bool, machine
 	mp.Status.AvailableReplicas = ptr.To(availableReplicas)
 	mp.Status.UpToDateReplicas = ptr.To(upToDateReplicas)
 }
+
+func setMachinesSupportedCondition(_ context.Context, mp *clusterv1.MachinePool, infraMachinePool *unstructured.Unstructured, hasMachinePoolMachines bool, hasMachinePoolMachinesErr error) {
+	// The MachinesSupported condition is surfaced only if MachinePool Machines are required.
+	if mp.Spec.Machines.Policy != clusterv1.RequiredMachinePoolMachinesPolicy {
+		conditions.Delete(mp, clusterv1.MachinePoolMachinesSupportedCondition)
+		return
+	}
+
+	if hasMachinePoolMachinesErr != nil {
+		conditions.Set(mp, metav1.Condition{
+			Type:    clusterv1.MachinePoolMachinesSupportedCondition,
+			Status:  metav1.ConditionUnknown,
+			Reason:  clusterv1.MachinePoolMachinesSupportedInternalErrorReason,
+			Message: "Please check controller logs for errors",
+		})
+		return
+	}
+
+	if !hasMachinePoolMachines {
+		conditions.Set(mp, metav1.Condition{
+			Type:    clusterv1.MachinePoolMachinesSupportedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.MachinePoolMachinesNotSupportedReason,
+			Message: fmt.Sprintf("%s does not report status.infrastructureMachineKind, but MachinePool Machines are required by spec.machines.policy", infraMachinePool.GetKind()),
+		})
+		return
+	}
+
+	conditions.Set(mp, metav1.Condition{
+		Type:   clusterv1.MachinePoolMachinesSupportedCondition,
+		Status: metav1.ConditionTrue,
+		Reason: clusterv1.MachinePoolMachinesSupportedReason,
+	})
+}
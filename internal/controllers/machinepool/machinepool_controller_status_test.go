@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+func Test_setMachinesSupportedCondition(t *testing.T) {
+	infraMachinePool := &unstructured.Unstructured{}
+	infraMachinePool.SetKind(builder.GenericInfrastructureMachinePoolKind)
+
+	requiredMachines := clusterv1.MachinePoolSpec{
+		Machines: clusterv1.MachinePoolMachinesSpec{
+			Policy: clusterv1.RequiredMachinePoolMachinesPolicy,
+		},
+	}
+
+	tests := []struct {
+		name                      string
+		machinePool               *clusterv1.MachinePool
+		hasMachinePoolMachines    bool
+		hasMachinePoolMachinesErr error
+		expectCondition           *metav1.Condition
+	}{
+		{
+			name: "condition is removed when MachinePool Machines are not required",
+			machinePool: &clusterv1.MachinePool{
+				Status: clusterv1.MachinePoolStatus{
+					Conditions: []metav1.Condition{{
+						Type:   clusterv1.MachinePoolMachinesSupportedCondition,
+						Status: metav1.ConditionFalse,
+						Reason: clusterv1.MachinePoolMachinesNotSupportedReason,
+					}},
+				},
+			},
+			expectCondition: nil,
+		},
+		{
+			name:                   "MachinePool Machines are supported",
+			machinePool:            &clusterv1.MachinePool{Spec: requiredMachines},
+			hasMachinePoolMachines: true,
+			expectCondition: &metav1.Condition{
+				Type:   clusterv1.MachinePoolMachinesSupportedCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.MachinePoolMachinesSupportedReason,
+			},
+		},
+		{
+			name:                   "MachinePool Machines are not supported",
+			machinePool:            &clusterv1.MachinePool{Spec: requiredMachines},
+			hasMachinePoolMachines: false,
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.MachinePoolMachinesSupportedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.MachinePoolMachinesNotSupportedReason,
+				Message: "GenericInfrastructureMachinePool does not report status.infrastructureMachineKind, but MachinePool Machines are required by spec.machines.policy",
+			},
+		},
+		{
+			name:                      "failed to determine if MachinePool Machines are supported",
+			machinePool:               &clusterv1.MachinePool{Spec: requiredMachines},
+			hasMachinePoolMachinesErr: errors.New("failed to lookup infrastructureMachineKind"),
+			expectCondition: &metav1.Condition{
+				Type:    clusterv1.MachinePoolMachinesSupportedCondition,
+				Status:  metav1.ConditionUnknown,
+				Reason:  clusterv1.MachinePoolMachinesSupportedInternalErrorReason,
+				Message: "Please check controller logs for errors",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setMachinesSupportedCondition(ctx, tt.machinePool, infraMachinePool, tt.hasMachinePoolMachines, tt.hasMachinePoolMachinesErr)
+
+			condition := conditions.Get(tt.machinePool, clusterv1.MachinePoolMachinesSupportedCondition)
+			if tt.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(*tt.expectCondition, conditions.IgnoreLastTransitionTime(true)))
+		})
+	}
+}
+
+func Test_setSelector(t *testing.T) {
+	g := NewWithT(t)
+
+	mp := &clusterv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mp1",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: clusterv1.MachinePoolSpec{
+			ClusterName: "test-cluster",
+		},
+	}
+
+	setSelector(mp)
+
+	selector, err := labels.Parse(mp.Status.Selector)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(selector.Matches(labels.Set{
+		clusterv1.ClusterNameLabel:     "test-cluster",
+		clusterv1.MachinePoolNameLabel: "mp1",
+	})).To(BeTrue())
+	g.Expect(selector.Matches(labels.Set{
+		clusterv1.ClusterNameLabel:     "test-cluster",
+		clusterv1.MachinePoolNameLabel: "mp2",
+	})).To(BeFalse())
+}
@@ -45,6 +45,7 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/external"
 	externalfake "sigs.k8s.io/cluster-api/controllers/external/fake"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/cache"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 	"sigs.k8s.io/cluster-api/util/test/builder"
 )
@@ -124,6 +125,7 @@ func TestMachinePoolFinalizer(t *testing.T) {
 			g := NewWithT(t)
 
 			mr := &Reconciler{
+				bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
 				Client: fake.NewClientBuilder().WithObjects(
 					clusterCorrectMeta,
 					machinePoolValidCluster,
@@ -257,8 +259,9 @@ func TestMachinePoolOwnerReference(t *testing.T) {
 				machinePoolValidMachinePool,
 			).WithStatusSubresource(&clusterv1.MachinePool{}).Build()
 			mr := &Reconciler{
-				Client:    fakeClient,
-				APIReader: fakeClient,
+				Client:                 fakeClient,
+				APIReader:              fakeClient,
+				bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
 			}
 
 			key := client.ObjectKey{Namespace: tc.m.Namespace, Name: tc.m.Name}
@@ -606,9 +609,10 @@ func TestReconcileMachinePoolRequest(t *testing.T) {
 			}).WithObjects(trackerObjects...).Build()
 
 			r := &Reconciler{
-				Client:       clientFake,
-				APIReader:    clientFake,
-				ClusterCache: clustercache.NewFakeClusterCache(trackerClientFake, client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
+				Client:                 clientFake,
+				APIReader:              clientFake,
+				bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+				ClusterCache:           clustercache.NewFakeClusterCache(trackerClientFake, client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
 				externalTracker: external.ObjectTracker{
 					Controller:      externalfake.Controller{},
 					Cache:           &informertest.FakeInformers{},
@@ -896,8 +900,9 @@ func TestRemoveMachinePoolFinalizerAfterDeleteReconcile(t *testing.T) {
 	key := client.ObjectKey{Namespace: m.Namespace, Name: m.Name}
 	clientFake := fake.NewClientBuilder().WithObjects(testCluster, m, builder.TestInfrastructureMachinePoolCRD).WithStatusSubresource(&clusterv1.MachinePool{}).Build()
 	mr := &Reconciler{
-		Client:       clientFake,
-		ClusterCache: clustercache.NewFakeClusterCache(clientFake, client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
+		bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+		Client:                 clientFake,
+		ClusterCache:           clustercache.NewFakeClusterCache(clientFake, client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
 	}
 	_, err := mr.Reconcile(ctx, reconcile.Request{NamespacedName: key})
 	g.Expect(err).ToNot(HaveOccurred())
@@ -1184,9 +1189,10 @@ func TestMachinePoolConditions(t *testing.T) {
 			).WithStatusSubresource(&clusterv1.MachinePool{}).Build()
 
 			r := &Reconciler{
-				Client:       clientFake,
-				APIReader:    clientFake,
-				ClusterCache: clustercache.NewFakeClusterCache(clientFake, client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
+				Client:                 clientFake,
+				APIReader:              clientFake,
+				bootstrapDataHashCache: cache.New[bootstrapDataHashEntry](cache.DefaultTTL),
+				ClusterCache:           clustercache.NewFakeClusterCache(clientFake, client.ObjectKey{Name: testCluster.Name, Namespace: testCluster.Namespace}),
 				externalTracker: external.ObjectTracker{
 					Controller:      externalfake.Controller{},
 					Cache:           &informertest.FakeInformers{},
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -55,6 +56,7 @@ import (
 	topologynames "sigs.k8s.io/cluster-api/internal/topology/names"
 	clientutil "sigs.k8s.io/cluster-api/internal/util/client"
 	"sigs.k8s.io/cluster-api/internal/util/inplace"
+	"sigs.k8s.io/cluster-api/internal/util/resync"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
@@ -93,11 +95,19 @@ type Reconciler struct {
 	APIReader    client.Reader
 	ClusterCache clustercache.ClusterCache
 
+	// TemplateReader is used to read templates, e.g. from a cache shared across controllers.
+	// If not set, Client is used.
+	TemplateReader client.Reader
+
 	PreflightChecks sets.Set[clusterv1.MachineSetPreflightCheck]
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// ResyncPeriod is the period after which all MachineSets are requeued, in addition to the resyncs of the cache.
+	// If 0, MachineSets are only requeued when the cache is resynced.
+	ResyncPeriod time.Duration
+
 	ssaCache ssa.Cache
 	recorder record.EventRecorder
 
@@ -106,7 +116,7 @@ type Reconciler struct {
 	disableRemoveManagedFieldsForLabelsAndAnnotations bool
 
 	// Those fields are only used for test purposes.
-	overrideCreateMachines func(ctx context.Context, s *scope, machinesToAdd int) (ctrl.Result, error)
+	overrideCreateMachines func(ctx context.Context, s *scope, machinesToAdd int, standby bool) (ctrl.Result, error)
 	overrideMoveMachines   func(ctx context.Context, s *scope, targetMSName string, machinesToMove int) (ctrl.Result, error)
 	overrideDeleteMachines func(ctx context.Context, s *scope, machinesToDelete int) (ctrl.Result, error)
 }
@@ -142,6 +152,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		).
 		WithOptions(options).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		WatchesRawSource(resync.Source(mgr.GetClient(), &clusterv1.MachineSetList{}, r.ResyncPeriod, r.WatchFilterValue)).
 		Watches(
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(clusterToMachineSets),
@@ -267,9 +278,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ct
 
 	reconcileNormal := append(alwaysReconcile,
 		wrapErrMachineSetReconcileFunc(r.reconcileUnhealthyMachines, "failed to reconcile unhealthy machines"),
+		wrapErrMachineSetReconcileFunc(r.promoteStandbyMachines, "failed to promote standby Machines"),
 		wrapErrMachineSetReconcileFunc(r.syncMachines, "failed to sync Machines"),
 		wrapErrMachineSetReconcileFunc(r.triggerInPlaceUpdate, "failed to trigger in-place update"),
 		wrapErrMachineSetReconcileFunc(r.syncReplicas, "failed to sync replicas"),
+		wrapErrMachineSetReconcileFunc(r.syncStandbyReplicas, "failed to sync standby replicas"),
+		wrapErrMachineSetReconcileFunc(r.reconcileRebalance, "failed to rebalance Machines across failure domains"),
 	)
 
 	return doReconcile(ctx, s, reconcileNormal)
@@ -279,6 +293,7 @@ type scope struct {
 	machineSet                                *clusterv1.MachineSet
 	cluster                                   *clusterv1.Cluster
 	machines                                  []*clusterv1.Machine
+	standbyMachines                           []*clusterv1.Machine
 	bootstrapObjectNotFound                   bool
 	infrastructureObjectNotFound              bool
 	getAndAdoptMachinesForMachineSetSucceeded bool
@@ -426,7 +441,7 @@ func (r *Reconciler) triggerInPlaceUpdate(ctx context.Context, s *scope) (ctrl.R
 }
 
 func (r *Reconciler) completeMoveMachine(ctx context.Context, s *scope, currentMachine *clusterv1.Machine) error {
-	desiredMachine, err := r.computeDesiredMachine(s.machineSet, currentMachine)
+	desiredMachine, err := r.computeDesiredMachine(s.machineSet, currentMachine, "")
 	if err != nil {
 		return errors.Wrap(err, "could not compute desired Machine")
 	}
@@ -565,7 +580,7 @@ func (r *Reconciler) reconcileBootstrapConfig(ctx context.Context, s *scope) (ct
 
 func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result, error) {
 	machineSet := s.machineSet
-	machineList := s.machines
+	machineList := slices.Concat(s.machines, s.standbyMachines)
 	if !s.getAndAdoptMachinesForMachineSetSucceeded {
 		return ctrl.Result{}, nil
 	}
@@ -616,6 +631,7 @@ func (r *Reconciler) getAndAdoptMachinesForMachineSet(ctx context.Context, s *sc
 	// Filter out irrelevant machines (i.e. IsControlledBy something else) and claim orphaned machines.
 	// Machines in deleted state are deliberately not excluded https://github.com/kubernetes-sigs/cluster-api/pull/3434.
 	filteredMachines := make([]*clusterv1.Machine, 0, len(allMachines.Items))
+	standbyMachines := []*clusterv1.Machine{}
 	for idx := range allMachines.Items {
 		machine := &allMachines.Items[idx]
 		log := log.WithValues("Machine", klog.KObj(machine))
@@ -635,10 +651,16 @@ func (r *Reconciler) getAndAdoptMachinesForMachineSet(ctx context.Context, s *sc
 			r.recorder.Eventf(machineSet, corev1.EventTypeNormal, "SuccessfulAdopt", "Adopted Machine %q", machine.Name)
 		}
 
+		// Standby Machines are tracked separately, because they are not counted as replicas of the MachineSet.
+		if _, ok := machine.Labels[clusterv1.MachineStandbyLabel]; ok {
+			standbyMachines = append(standbyMachines, machine)
+			continue
+		}
 		filteredMachines = append(filteredMachines, machine)
 	}
 
 	s.machines = filteredMachines
+	s.standbyMachines = standbyMachines
 	s.getAndAdoptMachinesForMachineSetSucceeded = true
 
 	return ctrl.Result{}, nil
@@ -670,6 +692,8 @@ func (r *Reconciler) syncMachines(ctx context.Context, s *scope) (ctrl.Result, e
 			m.Spec.Deletion.NodeDrainTimeoutSeconds = machineSet.Spec.Template.Spec.Deletion.NodeDrainTimeoutSeconds
 			m.Spec.Deletion.NodeDeletionTimeoutSeconds = machineSet.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds
 			m.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = machineSet.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
+			m.Spec.Deletion.NodeVolumeDetachExclusions = machineSet.Spec.Template.Spec.Deletion.NodeVolumeDetachExclusions
+			m.Spec.KubeletServingCertificateVerification = machineSet.Spec.Template.Spec.KubeletServingCertificateVerification
 			m.Spec.MinReadySeconds = machineSet.Spec.Template.Spec.MinReadySeconds
 			m.Spec.Taints = machineSet.Spec.Template.Spec.Taints
 
@@ -680,7 +704,7 @@ func (r *Reconciler) syncMachines(ctx context.Context, s *scope) (ctrl.Result, e
 		}
 
 		// Update Machine to propagate in-place mutable fields from the MachineSet.
-		updatedMachine, err := r.computeDesiredMachine(machineSet, m)
+		updatedMachine, err := r.computeDesiredMachine(machineSet, m, "")
 		if err != nil {
 			return ctrl.Result{}, errors.Wrap(err, "failed to update Machine: failed to compute desired Machine")
 		}
@@ -748,6 +772,18 @@ func (r *Reconciler) syncReplicas(ctx context.Context, s *scope) (ctrl.Result, e
 		return ctrl.Result{}, errors.Errorf("the Replicas field in Spec for MachineSet %v is nil, this should not be allowed", ms.Name)
 	}
 	diff := len(machines) - int(ptr.Deref(ms.Spec.Replicas, 0))
+
+	// If there are no Machines to be created, drop remediated Machines which are already gone, because
+	// they are not going to be replaced (e.g. the MachineSet has been scaled down in the meantime).
+	// Note: Remediated Machines still deleting are preserved, because they are going to be replaced as soon as they are gone.
+	if diff >= 0 {
+		remediatedMachines := remediatedMachineNames(ms)
+		remediatedMachines = slices.DeleteFunc(remediatedMachines, func(name string) bool {
+			return !slices.ContainsFunc(machines, func(m *clusterv1.Machine) bool { return m.Name == name })
+		})
+		setRemediatedMachineNames(ms, remediatedMachines)
+	}
+
 	switch {
 	case diff < 0:
 		// If there are not enough Machines, create missing Machines unless Machine creation is disabled.
@@ -759,7 +795,7 @@ func (r *Reconciler) syncReplicas(ctx context.Context, s *scope) (ctrl.Result, e
 				return ctrl.Result{}, nil
 			}
 		}
-		return r.createMachines(ctx, s, machinesToAdd)
+		return r.createMachines(ctx, s, machinesToAdd, false)
 
 	case diff > 0:
 		// if too many replicas, delete or move exceeding machines.
@@ -800,9 +836,10 @@ func (r *Reconciler) syncReplicas(ctx context.Context, s *scope) (ctrl.Result, e
 	return ctrl.Result{}, nil
 }
 
-func (r *Reconciler) createMachines(ctx context.Context, s *scope, machinesToAdd int) (ctrl.Result, error) {
+// createMachines creates Machines for the MachineSet; if standby is true, standby Machines are created.
+func (r *Reconciler) createMachines(ctx context.Context, s *scope, machinesToAdd int, standby bool) (ctrl.Result, error) {
 	if r.overrideCreateMachines != nil {
-		return r.overrideCreateMachines(ctx, s, machinesToAdd)
+		return r.overrideCreateMachines(ctx, s, machinesToAdd, standby)
 	}
 
 	log := ctrl.LoggerFrom(ctx)
@@ -824,16 +861,36 @@ func (r *Reconciler) createMachines(ctx context.Context, s *scope, machinesToAdd
 		return ctrl.Result{RequeueAfter: preflightFailedRequeueAfter}, nil
 	}
 
+	existingMachines, operation := s.machines, "scale up"
+	if standby {
+		existingMachines, operation = s.standbyMachines, "standby"
+	}
+
 	machinesAdded := []*clusterv1.Machine{}
 	for i := range machinesToAdd {
 		// Create a new logger so the global logger is not modified.
 		log := log
-		machine, computeMachineErr := r.computeDesiredMachine(ms, nil)
+
+		// If there are Machines deleted by remediation and not yet replaced, use the first one as predecessor
+		// of the new Machine, so it can be used in the machineNaming template.
+		// Note: Standby Machines are not considered replacements for remediated Machines.
+		var predecessorName string
+		if remediatedMachines := remediatedMachineNames(ms); !standby && len(remediatedMachines) > 0 {
+			predecessorName = remediatedMachines[0]
+		}
+
+		machine, computeMachineErr := r.computeDesiredMachine(ms, nil, predecessorName)
 		if computeMachineErr != nil {
 			v1beta1conditions.MarkFalse(ms, clusterv1.MachinesCreatedV1Beta1Condition, clusterv1.MachineCreationFailedV1Beta1Reason,
 				clusterv1.ConditionSeverityError, "%s", computeMachineErr.Error())
 			return ctrl.Result{}, errors.Wrap(computeMachineErr, "failed to create Machine: failed to compute desired Machine")
 		}
+		if machine.Spec.FailureDomain == "" {
+			machine.Spec.FailureDomain = failureDomainForNewMachine(ms.Spec.Template.Spec.InfrastructureOverrides, slices.Concat(existingMachines, machinesAdded))
+		}
+		if standby {
+			machine.Labels[clusterv1.MachineStandbyLabel] = ""
+		}
 
 		var (
 			infraRef, bootstrapRef        clusterv1.ContractVersionedObjectReference
@@ -891,7 +948,11 @@ func (r *Reconciler) createMachines(ctx context.Context, s *scope, machinesToAdd
 		}
 
 		machinesAdded = append(machinesAdded, machine)
-		log.Info(fmt.Sprintf("Machine %s created (scale up, creating %d of %d)", machine.Name, i+1, machinesToAdd), "Machine", klog.KObj(machine))
+		if predecessorName != "" {
+			setRemediatedMachineNames(ms, remediatedMachineNames(ms)[1:])
+			log = log.WithValues("predecessor", predecessorName)
+		}
+		log.Info(fmt.Sprintf("Machine %s created (%s, creating %d of %d)", machine.Name, operation, i+1, machinesToAdd), "Machine", klog.KObj(machine))
 		r.recorder.Eventf(ms, corev1.EventTypeNormal, "SuccessfulCreate", "Created Machine %q", machine.Name)
 	}
 
@@ -1075,7 +1136,8 @@ func (r *Reconciler) startMoveMachines(ctx context.Context, s *scope, targetMSNa
 // There are small differences in how we calculate the Machine depending on if it
 // is a create or update. Example: for a new Machine we have to calculate a new name,
 // while for an existing Machine we have to use the name of the existing Machine.
-func (r *Reconciler) computeDesiredMachine(machineSet *clusterv1.MachineSet, existingMachine *clusterv1.Machine) (*clusterv1.Machine, error) {
+// predecessorName is the name of the remediated Machine a new Machine is replacing, if any.
+func (r *Reconciler) computeDesiredMachine(machineSet *clusterv1.MachineSet, existingMachine *clusterv1.Machine, predecessorName string) (*clusterv1.Machine, error) {
 	nameTemplate := "{{ .machineSet.name }}-{{ .random }}"
 	if machineSet.Spec.MachineNaming.Template != "" {
 		nameTemplate = machineSet.Spec.MachineNaming.Template
@@ -1085,7 +1147,7 @@ func (r *Reconciler) computeDesiredMachine(machineSet *clusterv1.MachineSet, exi
 		}
 	}
 
-	generatedMachineName, err := topologynames.MachineSetMachineNameGenerator(nameTemplate, machineSet.Spec.ClusterName, machineSet.Name).GenerateName()
+	generatedMachineName, err := topologynames.MachineSetMachineNameGenerator(nameTemplate, machineSet.Spec.ClusterName, machineSet.Name, predecessorName).GenerateName()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate Machine name")
 	}
@@ -1118,6 +1180,8 @@ func (r *Reconciler) computeDesiredMachine(machineSet *clusterv1.MachineSet, exi
 	// objects are created.
 	desiredMachine.Spec.InfrastructureRef = clusterv1.ContractVersionedObjectReference{}
 	desiredMachine.Spec.Bootstrap.ConfigRef = clusterv1.ContractVersionedObjectReference{}
+	// InfrastructureOverrides are only used by the MachineSet when creating InfraMachines, drop them from the Machine.
+	desiredMachine.Spec.InfrastructureOverrides = nil
 
 	// If we are updating an existing Machine reuse the name, uid, infrastructureRef and bootstrap.configRef
 	// from the existingMachine.
@@ -1152,12 +1216,7 @@ func (r *Reconciler) computeDesiredMachine(machineSet *clusterv1.MachineSet, exi
 	desiredMachine.Annotations = machineAnnotationsFromMachineSet(machineSet)
 
 	// Set all other in-place mutable fields.
-	desiredMachine.Spec.ReadinessGates = machineSet.Spec.Template.Spec.ReadinessGates
-	desiredMachine.Spec.Deletion.NodeDrainTimeoutSeconds = machineSet.Spec.Template.Spec.Deletion.NodeDrainTimeoutSeconds
-	desiredMachine.Spec.Deletion.NodeDeletionTimeoutSeconds = machineSet.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds
-	desiredMachine.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = machineSet.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
-	desiredMachine.Spec.MinReadySeconds = machineSet.Spec.Template.Spec.MinReadySeconds
-	desiredMachine.Spec.Taints = machineSet.Spec.Template.Spec.Taints
+	inplace.CopyMutableMachineSpecFields(&desiredMachine.Spec, &machineSet.Spec.Template.Spec)
 
 	return desiredMachine, nil
 }
@@ -1668,6 +1727,11 @@ func (r *Reconciler) reconcileUnhealthyMachines(ctx context.Context, s *scope) (
 	for _, m := range machinesToRemediate {
 		if err := r.Client.Delete(ctx, m); err != nil && !apierrors.IsNotFound(err) {
 			errs = append(errs, errors.Wrapf(err, "failed to delete Machine %s", klog.KObj(m)))
+			continue
+		}
+		// Keep track of the remediated Machine, so the Machine replacing it can refer to it as predecessor.
+		if remediatedMachines := remediatedMachineNames(ms); !slices.Contains(remediatedMachines, m.Name) {
+			setRemediatedMachineNames(ms, append(remediatedMachines, m.Name))
 		}
 		// Note: We intentionally log after Delete because we want this log line to show up only after DeletionTimestamp has been set.
 		// Also, setting DeletionTimestamp doesn't mean the Machine is actually deleted (deletion takes some time).
@@ -1680,6 +1744,28 @@ func (r *Reconciler) reconcileUnhealthyMachines(ctx context.Context, s *scope) (
 	return ctrl.Result{}, nil
 }
 
+// remediatedMachineNames returns the names of the Machines deleted by remediation and not yet replaced.
+func remediatedMachineNames(ms *clusterv1.MachineSet) []string {
+	value := ms.Annotations[clusterv1.MachineSetRemediatedMachinesAnnotation]
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// setRemediatedMachineNames sets the names of the Machines deleted by remediation and not yet replaced;
+// the annotation is dropped when the list is empty.
+func setRemediatedMachineNames(ms *clusterv1.MachineSet, names []string) {
+	if len(names) == 0 {
+		delete(ms.Annotations, clusterv1.MachineSetRemediatedMachinesAnnotation)
+		return
+	}
+	if ms.Annotations == nil {
+		ms.Annotations = map[string]string{}
+	}
+	ms.Annotations[clusterv1.MachineSetRemediatedMachinesAnnotation] = strings.Join(names, ",")
+}
+
 func patchMachineConditions(ctx context.Context, c client.Client, machines []*clusterv1.Machine, condition metav1.Condition, v1beta1condition *clusterv1.Condition) error {
 	var errs []error
 	for _, m := range machines {
@@ -1810,7 +1896,7 @@ func (r *Reconciler) computeDesiredBootstrapConfig(ctx context.Context, ms *clus
 		Name:       ms.Spec.Template.Spec.Bootstrap.ConfigRef.Name,
 	}
 
-	template, err := external.Get(ctx, r.Client, templateRef)
+	template, err := external.Get(ctx, r.templateReader(), templateRef)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to compute desired BootstrapConfig")
 	}
@@ -1887,7 +1973,7 @@ func (r *Reconciler) computeDesiredInfraMachine(ctx context.Context, ms *cluster
 		Name:       ms.Spec.Template.Spec.InfrastructureRef.Name,
 	}
 
-	template, err := external.Get(ctx, r.Client, templateRef)
+	template, err := external.Get(ctx, r.templateReader(), templateRef)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to compute desired InfraMachine")
 	}
@@ -1905,6 +1991,9 @@ func (r *Reconciler) computeDesiredInfraMachine(ctx context.Context, ms *cluster
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to compute desired InfraMachine")
 	}
+	if err := applyInfrastructureOverrides(infraMachine, ms.Spec.Template.Spec.InfrastructureOverrides, machine.Spec.FailureDomain); err != nil {
+		return nil, errors.Wrap(err, "failed to compute desired InfraMachine")
+	}
 
 	if existingInfraMachine != nil {
 		infraMachine.SetUID(existingInfraMachine.GetUID())
@@ -1931,3 +2020,11 @@ func sortMachinesToRemediate(machines []*clusterv1.Machine) {
 		return machines[i].CreationTimestamp.After(machines[j].CreationTimestamp.Time)
 	})
 }
+
+// templateReader returns the reader to be used to read templates.
+func (r *Reconciler) templateReader() client.Reader {
+	if r.TemplateReader != nil {
+		return r.TemplateReader
+	}
+	return r.Client
+}
@@ -80,8 +80,13 @@ func setReplicas(_ context.Context, ms *clusterv1.MachineSet, machines []*cluste
 		return
 	}
 
-	var readyReplicas, availableReplicas, upToDateReplicas int32
+	var readyReplicas, availableReplicas, upToDateReplicas, stoppedReplicas int32
 	for _, machine := range machines {
+		// Stopped machines are tracked separately, so they can be distinguished from machines which are not ready.
+		if conditions.IsTrue(machine, clusterv1.MachineStoppedCondition) {
+			stoppedReplicas++
+		}
+
 		// If a machine is in-place updating consider it not Ready, not Available and not UpToDate.
 		// Note: We have to check this here because we don't want to rely on an additional Machine controller
 		//       reconcile to set the conditions to be able to update the replica counters here correctly.
@@ -104,6 +109,7 @@ func setReplicas(_ context.Context, ms *clusterv1.MachineSet, machines []*cluste
 	ms.Status.ReadyReplicas = ptr.To(readyReplicas)
 	ms.Status.AvailableReplicas = ptr.To(availableReplicas)
 	ms.Status.UpToDateReplicas = ptr.To(upToDateReplicas)
+	ms.Status.StoppedReplicas = ptr.To(stoppedReplicas)
 }
 
 func setScalingUpCondition(_ context.Context, ms *clusterv1.MachineSet, machines []*clusterv1.Machine, bootstrapObjectNotFound, infrastructureObjectNotFound, getAndAdoptMachinesForMachineSetSucceeded bool, scaleUpPreflightCheckErrMessages []string) {
@@ -53,6 +53,7 @@ func Test_setReplicas(t *testing.T) {
 				ReadyReplicas:     ptr.To[int32](0),
 				AvailableReplicas: ptr.To[int32](0),
 				UpToDateReplicas:  ptr.To[int32](0),
+				StoppedReplicas:   ptr.To[int32](0),
 			}},
 		{
 			name: "should count only ready machines",
@@ -76,6 +77,7 @@ func Test_setReplicas(t *testing.T) {
 				ReadyReplicas:     ptr.To[int32](1),
 				AvailableReplicas: ptr.To[int32](0),
 				UpToDateReplicas:  ptr.To[int32](0),
+				StoppedReplicas:   ptr.To[int32](0),
 			},
 		},
 		{
@@ -100,6 +102,7 @@ func Test_setReplicas(t *testing.T) {
 				ReadyReplicas:     ptr.To[int32](0),
 				AvailableReplicas: ptr.To[int32](1),
 				UpToDateReplicas:  ptr.To[int32](0),
+				StoppedReplicas:   ptr.To[int32](0),
 			},
 		},
 		{
@@ -124,6 +127,29 @@ func Test_setReplicas(t *testing.T) {
 				ReadyReplicas:     ptr.To[int32](0),
 				AvailableReplicas: ptr.To[int32](0),
 				UpToDateReplicas:  ptr.To[int32](1),
+				StoppedReplicas:   ptr.To[int32](0),
+			},
+		},
+		{
+			name: "should count only stopped machines",
+			machines: []*clusterv1.Machine{
+				{Status: clusterv1.MachineStatus{Conditions: []metav1.Condition{{
+					Type:   clusterv1.MachineStoppedCondition,
+					Status: metav1.ConditionTrue,
+				}}}},
+				{Status: clusterv1.MachineStatus{Conditions: []metav1.Condition{{
+					Type:   clusterv1.MachineStoppedCondition,
+					Status: metav1.ConditionFalse,
+				}}}},
+				{Status: clusterv1.MachineStatus{}},
+			},
+			getAndAdoptMachinesForMachineSetSucceeded: true,
+			expectedStatus: clusterv1.MachineSetStatus{
+				Replicas:          ptr.To[int32](3),
+				ReadyReplicas:     ptr.To[int32](0),
+				AvailableReplicas: ptr.To[int32](0),
+				UpToDateReplicas:  ptr.To[int32](0),
+				StoppedReplicas:   ptr.To[int32](1),
 			},
 		},
 		{
@@ -150,6 +176,7 @@ func Test_setReplicas(t *testing.T) {
 				ReadyReplicas:     ptr.To[int32](1),
 				AvailableReplicas: ptr.To[int32](1),
 				UpToDateReplicas:  ptr.To[int32](1),
+				StoppedReplicas:   ptr.To[int32](0),
 			},
 		},
 		{
@@ -232,6 +259,7 @@ func Test_setReplicas(t *testing.T) {
 				ReadyReplicas:     ptr.To[int32](0),
 				AvailableReplicas: ptr.To[int32](0),
 				UpToDateReplicas:  ptr.To[int32](0),
+				StoppedReplicas:   ptr.To[int32](0),
 			},
 		},
 	}
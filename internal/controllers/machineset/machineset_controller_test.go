@@ -1784,6 +1784,9 @@ func TestMachineSetReconciler_reconcileUnhealthyMachines(t *testing.T) {
 		g.Expect(m.DeletionTimestamp.IsZero()).To(BeTrue())
 		g.Expect(v1beta1conditions.Has(m, clusterv1.MachineOwnerRemediatedV1Beta1Condition)).To(BeFalse())
 		g.Expect(conditions.Has(m, clusterv1.MachineOwnerRemediatedCondition)).To(BeFalse())
+
+		// Verify the unhealthy machine is tracked as remediated machine.
+		g.Expect(machineSet.Annotations).To(HaveKeyWithValue(clusterv1.MachineSetRemediatedMachinesAnnotation, "unhealthy-machine"))
 	})
 
 	t.Run("should update the unhealthy machine MachineOwnerRemediated condition if preflight checks did not pass", func(t *testing.T) {
@@ -2380,6 +2383,7 @@ func TestMachineSetReconciler_syncReplicas(t *testing.T) {
 		expectMachinesToDelete                    *int
 		expectedTargetMSName                      *string
 		expectedMachinesToMove                    *int
+		expectedRemediatedMachines                string
 	}{
 		{
 			name: "no op when getAndAdoptMachinesForMachineSetSucceeded is false",
@@ -2476,6 +2480,33 @@ func TestMachineSetReconciler_syncReplicas(t *testing.T) {
 			expectedTargetMSName:   nil,
 			expectedMachinesToMove: nil,
 		},
+		{
+			name: "should keep track of remediated machines still deleting and drop the others when no machines must be created",
+			getAndAdoptMachinesForMachineSetSucceeded: true,
+			machineSet: newMachineSet("ms1", "cluster1", 2, withMachineSetAnnotations(map[string]string{clusterv1.MachineSetRemediatedMachinesAnnotation: "m0,m1"})),
+			machines: []*clusterv1.Machine{
+				fakeMachine("m1"),
+				fakeMachine("m2"),
+			},
+			expectMachinesToAdd:        nil,
+			expectMachinesToDelete:     nil,
+			expectedTargetMSName:       nil,
+			expectedMachinesToMove:     nil,
+			expectedRemediatedMachines: "m1",
+		},
+		{
+			name: "should keep track of remediated machines when machines must be created",
+			getAndAdoptMachinesForMachineSetSucceeded: true,
+			machineSet: newMachineSet("ms1", "cluster1", 2, withMachineSetAnnotations(map[string]string{clusterv1.MachineSetRemediatedMachinesAnnotation: "m0"})),
+			machines: []*clusterv1.Machine{
+				fakeMachine("m2"),
+			},
+			expectMachinesToAdd:        ptr.To(1),
+			expectMachinesToDelete:     nil,
+			expectedTargetMSName:       nil,
+			expectedMachinesToMove:     nil,
+			expectedRemediatedMachines: "m0",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2488,7 +2519,7 @@ func TestMachineSetReconciler_syncReplicas(t *testing.T) {
 			}
 
 			r := &Reconciler{
-				overrideCreateMachines: func(_ context.Context, _ *scope, machinesToAdd int) (ctrl.Result, error) {
+				overrideCreateMachines: func(_ context.Context, _ *scope, machinesToAdd int, _ bool) (ctrl.Result, error) {
 					g.Expect(tt.expectMachinesToAdd).ToNot(BeNil(), "unexpected call to create machines")
 					g.Expect(machinesToAdd).To(Equal(*tt.expectMachinesToAdd), "call to create machines does not have the expected machinesToAdd number")
 					return ctrl.Result{}, nil
@@ -2509,6 +2540,7 @@ func TestMachineSetReconciler_syncReplicas(t *testing.T) {
 			res, err := r.syncReplicas(ctx, s)
 			g.Expect(err).ToNot(HaveOccurred(), "unexpected error when syncing replicas")
 			g.Expect(res.IsZero()).To(BeTrue(), "unexpected non zero result when syncing replicas")
+			g.Expect(tt.machineSet.Annotations[clusterv1.MachineSetRemediatedMachinesAnnotation]).To(Equal(tt.expectedRemediatedMachines))
 		})
 	}
 }
@@ -2554,7 +2586,7 @@ func TestMachineSetReconciler_createMachines_preflightChecks(t *testing.T) {
 		machines:   []*clusterv1.Machine{},
 		getAndAdoptMachinesForMachineSetSucceeded: true,
 	}
-	result, err := r.createMachines(ctx, s, 3)
+	result, err := r.createMachines(ctx, s, 3, false)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(result.IsZero()).To(BeFalse(), "syncReplicas should not return a 'zero' result")
 
@@ -2653,12 +2685,16 @@ func TestMachineSetReconciler_createMachines(t *testing.T) {
 	infraTmpl.SetNamespace(metav1.NamespaceDefault)
 
 	tests := []struct {
-		name             string
-		machinesToAdd    int
-		interceptorFuncs func(i *int) interceptor.Funcs
-		wantMachines     int
-		wantErr          bool
-		wantErrorMessage string
+		name                    string
+		machineSetAnnotations   map[string]string
+		machineNaming           clusterv1.MachineNamingSpec
+		machinesToAdd           int
+		interceptorFuncs        func(i *int) interceptor.Funcs
+		wantMachines            int
+		wantMachineNamePrefixes []string
+		wantRemediatedMachines  string
+		wantErr                 bool
+		wantErrorMessage        string
 	}{
 		{
 			name:             "should create machines",
@@ -2690,6 +2726,36 @@ func TestMachineSetReconciler_createMachines(t *testing.T) {
 			wantMachines: 1,
 			wantErr:      true,
 		},
+		{
+			name: "should use remediated machines as predecessors of the new machines",
+			machineSetAnnotations: map[string]string{
+				clusterv1.MachineSetRemediatedMachinesAnnotation: "machineset1-aaaaa,machineset1-bbbbb",
+			},
+			machineNaming: clusterv1.MachineNamingSpec{
+				Template: "{{ if .predecessor.name }}{{ .predecessor.name }}-r{{ else }}{{ .machineSet.name }}{{ end }}-{{ .random }}",
+			},
+			machinesToAdd:           3,
+			interceptorFuncs:        func(_ *int) interceptor.Funcs { return interceptor.Funcs{} },
+			wantMachines:            3,
+			wantMachineNamePrefixes: []string{"machineset1-aaaaa-r-", "machineset1-bbbbb-r-", "machineset1-"},
+			wantRemediatedMachines:  "",
+			wantErr:                 false,
+		},
+		{
+			name: "should keep track of remediated machines not yet replaced",
+			machineSetAnnotations: map[string]string{
+				clusterv1.MachineSetRemediatedMachinesAnnotation: "machineset1-aaaaa,machineset1-bbbbb",
+			},
+			machineNaming: clusterv1.MachineNamingSpec{
+				Template: "{{ if .predecessor.name }}{{ .predecessor.name }}-r{{ else }}{{ .machineSet.name }}{{ end }}-{{ .random }}",
+			},
+			machinesToAdd:           1,
+			interceptorFuncs:        func(_ *int) interceptor.Funcs { return interceptor.Funcs{} },
+			wantMachines:            1,
+			wantMachineNamePrefixes: []string{"machineset1-aaaaa-r-"},
+			wantRemediatedMachines:  "machineset1-bbbbb",
+			wantErr:                 false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2698,6 +2764,10 @@ func TestMachineSetReconciler_createMachines(t *testing.T) {
 			g.Expect(apiextensionsv1.AddToScheme(scheme)).To(Succeed())
 			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
 
+			machineSet := machineSet.DeepCopy()
+			machineSet.Annotations = tt.machineSetAnnotations
+			machineSet.Spec.MachineNaming = tt.machineNaming
+
 			i := 0
 			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
 				builder.GenericBootstrapConfigTemplateCRD,
@@ -2731,7 +2801,7 @@ func TestMachineSetReconciler_createMachines(t *testing.T) {
 				machines:   []*clusterv1.Machine{},
 				getAndAdoptMachinesForMachineSetSucceeded: true,
 			}
-			res, err := r.createMachines(ctx, s, tt.machinesToAdd)
+			res, err := r.createMachines(ctx, s, tt.machinesToAdd, false)
 			if tt.wantErr {
 				g.Expect(err).To(HaveOccurred(), "expected error when creating machines, got none")
 			} else {
@@ -2744,6 +2814,18 @@ func TestMachineSetReconciler_createMachines(t *testing.T) {
 			g.Expect(r.Client.List(ctx, machineList)).To(Succeed())
 			g.Expect(machineList.Items).To(HaveLen(tt.wantMachines), "Unexpected machine")
 
+			// Verify new Machines are named after their predecessors, if any.
+			if tt.wantMachineNamePrefixes != nil {
+				machineNames := []string{}
+				for _, machine := range machineList.Items {
+					machineNames = append(machineNames, machine.Name)
+				}
+				for _, prefix := range tt.wantMachineNamePrefixes {
+					g.Expect(machineNames).To(ContainElement(HavePrefix(prefix)))
+				}
+			}
+			g.Expect(machineSet.Annotations[clusterv1.MachineSetRemediatedMachinesAnnotation]).To(Equal(tt.wantRemediatedMachines))
+
 			for _, machine := range machineList.Items {
 				// Verify boostrap object created
 				bootstrap := &unstructured.Unstructured{}
@@ -3832,7 +3914,7 @@ func TestComputeDesiredMachine(t *testing.T) {
 				).WithStatusSubresource(&clusterv1.MachineSet{}).Build(),
 				recorder: record.NewFakeRecorder(32),
 			}
-			got, err = msr.computeDesiredMachine(tt.ms, tt.existingMachine)
+			got, err = msr.computeDesiredMachine(tt.ms, tt.existingMachine, "")
 
 			if tt.wantMachine == nil {
 				g.Expect(err).To(HaveOccurred())
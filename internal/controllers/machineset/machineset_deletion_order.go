@@ -37,7 +37,8 @@ const (
 	mustDelete        deletePriority = 100.0
 	shouldDeleteFirst deletePriority = 80.0
 	shouldDelete      deletePriority = 75.0
-	betterDelete      deletePriority = 50.0
+	betterDelete      deletePriority = 60.0
+	goodDelete        deletePriority = 50.0
 	couldDelete       deletePriority = 20.0
 	mustNotDelete     deletePriority = 0.0
 
@@ -65,6 +66,11 @@ func oldestDeletionOrder(machine *clusterv1.Machine) deletePriority {
 	if !isMachineHealthy(machine) {
 		return betterDelete
 	}
+	// If there are machines not up-to-date, e.g. machines still waiting for an in-place update, get rid of them next,
+	// so the system keeps the machines matching the desired state.
+	if !isMachineUpToDate(machine) {
+		return goodDelete
+	}
 	if machine.CreationTimestamp.Time.IsZero() {
 		return mustNotDelete
 	}
@@ -72,7 +78,7 @@ func oldestDeletionOrder(machine *clusterv1.Machine) deletePriority {
 	if d.Seconds() < 0 {
 		return mustNotDelete
 	}
-	return deletePriority(float64(betterDelete) * (1.0 - math.Exp(-d.Seconds()/secondsPerTenDays)))
+	return deletePriority(float64(goodDelete) * (1.0 - math.Exp(-d.Seconds()/secondsPerTenDays)))
 }
 
 func newestDeletionOrder(machine *clusterv1.Machine) deletePriority {
@@ -95,7 +101,12 @@ func newestDeletionOrder(machine *clusterv1.Machine) deletePriority {
 	if !isMachineHealthy(machine) {
 		return betterDelete
 	}
-	return betterDelete - oldestDeletionOrder(machine)
+	// If there are machines not up-to-date, e.g. machines still waiting for an in-place update, get rid of them next,
+	// so the system keeps the machines matching the desired state.
+	if !isMachineUpToDate(machine) {
+		return goodDelete
+	}
+	return goodDelete - oldestDeletionOrder(machine)
 }
 
 func randomDeletionOrder(machine *clusterv1.Machine) deletePriority {
@@ -118,6 +129,11 @@ func randomDeletionOrder(machine *clusterv1.Machine) deletePriority {
 	if !isMachineHealthy(machine) {
 		return betterDelete
 	}
+	// If there are machines not up-to-date, e.g. machines still waiting for an in-place update, get rid of them next,
+	// so the system keeps the machines matching the desired state.
+	if !isMachineUpToDate(machine) {
+		return goodDelete
+	}
 	return couldDelete
 }
 
@@ -189,3 +205,8 @@ func isMachineHealthy(machine *clusterv1.Machine) bool {
 	}
 	return true
 }
+
+func isMachineUpToDate(machine *clusterv1.Machine) bool {
+	// Note: for the sake of prioritization, we are not making any assumption about UpToDate when ConditionUnknown.
+	return !conditions.IsFalse(machine, clusterv1.MachineUpToDateCondition)
+}
@@ -19,6 +19,7 @@ package machineset
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -770,6 +771,97 @@ func TestMachineDeleteMultipleSamePriority(t *testing.T) {
 	}
 }
 
+func TestMachineDeleteUnhealthyAndOutdatedFirst(t *testing.T) {
+	nodeRef := clusterv1.MachineNodeReference{Name: "some-node"}
+	oldCreationTimestamp := metav1.NewTime(time.Now().Add(-100 * 24 * time.Hour))
+	newCreationTimestamp := metav1.NewTime(time.Now().Add(-1 * time.Hour))
+
+	oldUpToDateMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "old-up-to-date", CreationTimestamp: oldCreationTimestamp},
+		Status: clusterv1.MachineStatus{
+			NodeRef: nodeRef,
+			Conditions: []metav1.Condition{
+				{
+					Type:   clusterv1.MachineUpToDateCondition,
+					Status: metav1.ConditionTrue,
+				},
+			},
+		},
+	}
+	newUpToDateMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "new-up-to-date", CreationTimestamp: newCreationTimestamp},
+		Status: clusterv1.MachineStatus{
+			NodeRef: nodeRef,
+			Conditions: []metav1.Condition{
+				{
+					Type:   clusterv1.MachineUpToDateCondition,
+					Status: metav1.ConditionTrue,
+				},
+			},
+		},
+	}
+	outdatedMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "outdated", CreationTimestamp: newCreationTimestamp},
+		Status: clusterv1.MachineStatus{
+			NodeRef: nodeRef,
+			Conditions: []metav1.Condition{
+				{
+					Type:   clusterv1.MachineUpToDateCondition,
+					Status: metav1.ConditionFalse,
+				},
+			},
+		},
+	}
+	unhealthyMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "unhealthy", CreationTimestamp: newCreationTimestamp},
+		Status: clusterv1.MachineStatus{
+			NodeRef: nodeRef,
+			Conditions: []metav1.Condition{
+				{
+					Type:   clusterv1.MachineUpToDateCondition,
+					Status: metav1.ConditionTrue,
+				},
+				{
+					Type:   clusterv1.MachineHealthCheckSucceededCondition,
+					Status: metav1.ConditionFalse,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		desc           string
+		deletePriority deletePriorityFunc
+		expect         []*clusterv1.Machine
+	}{
+		{
+			desc:           "func=randomDeletionOrder",
+			deletePriority: randomDeletionOrder,
+			expect:         []*clusterv1.Machine{unhealthyMachine, outdatedMachine},
+		},
+		{
+			desc:           "func=oldestDeletionOrder",
+			deletePriority: oldestDeletionOrder,
+			expect:         []*clusterv1.Machine{unhealthyMachine, outdatedMachine},
+		},
+		{
+			desc:           "func=newestDeletionOrder",
+			deletePriority: newestDeletionOrder,
+			expect:         []*clusterv1.Machine{unhealthyMachine, outdatedMachine},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machines := []*clusterv1.Machine{oldUpToDateMachine, newUpToDateMachine, outdatedMachine, unhealthyMachine}
+			result := getMachinesToDeletePrioritized(machines, 2, test.deletePriority)
+			g.Expect(result).To(BeComparableTo(test.expect))
+		})
+	}
+}
+
 func TestIsMachineHealthy(t *testing.T) {
 	nodeRef := clusterv1.MachineNodeReference{Name: "some-node"}
 
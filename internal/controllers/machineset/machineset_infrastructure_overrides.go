@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"encoding/json"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// failureDomainForNewMachine returns the failure domain for a new Machine when spec.template.spec.failureDomain
+// is not set; Machines are spread across the failure domains defined in spec.template.spec.infrastructureOverrides
+// by picking the failure domain with the fewest Machines (the first one in the list wins on ties).
+func failureDomainForNewMachine(overrides []clusterv1.MachineInfrastructureOverride, machines []*clusterv1.Machine) string {
+	if len(overrides) == 0 {
+		return ""
+	}
+
+	machinesPerFailureDomain := map[string]int{}
+	for _, m := range machines {
+		if !m.DeletionTimestamp.IsZero() {
+			continue
+		}
+		machinesPerFailureDomain[m.Spec.FailureDomain]++
+	}
+
+	failureDomain := overrides[0].FailureDomain
+	for _, override := range overrides[1:] {
+		if machinesPerFailureDomain[override.FailureDomain] < machinesPerFailureDomain[failureDomain] {
+			failureDomain = override.FailureDomain
+		}
+	}
+	return failureDomain
+}
+
+// applyInfrastructureOverrides merges the spec of the infrastructureOverrides entry matching the failure domain
+// into the spec of the InfraMachine, using JSON merge patch semantics.
+func applyInfrastructureOverrides(infraMachine *unstructured.Unstructured, overrides []clusterv1.MachineInfrastructureOverride, failureDomain string) error {
+	if failureDomain == "" {
+		return nil
+	}
+
+	var override *clusterv1.MachineInfrastructureOverride
+	for i := range overrides {
+		if overrides[i].FailureDomain == failureDomain {
+			override = &overrides[i]
+			break
+		}
+	}
+	if override == nil {
+		return nil
+	}
+
+	spec, _, err := unstructured.NestedFieldNoCopy(infraMachine.Object, "spec")
+	if err != nil {
+		return errors.Wrapf(err, "failed to apply infrastructureOverrides for failure domain %q", failureDomain)
+	}
+	if spec == nil {
+		spec = map[string]interface{}{}
+	}
+	specJSON, err := json.Marshal(spec)
+	if err != nil {
+		return errors.Wrapf(err, "failed to apply infrastructureOverrides for failure domain %q", failureDomain)
+	}
+
+	mergedSpecJSON, err := jsonpatch.MergePatch(specJSON, override.Spec.Raw)
+	if err != nil {
+		return errors.Wrapf(err, "failed to apply infrastructureOverrides for failure domain %q", failureDomain)
+	}
+	mergedSpec := map[string]interface{}{}
+	if err := json.Unmarshal(mergedSpecJSON, &mergedSpec); err != nil {
+		return errors.Wrapf(err, "failed to apply infrastructureOverrides for failure domain %q: spec must be a JSON object", failureDomain)
+	}
+
+	return unstructured.SetNestedField(infraMachine.Object, mergedSpec, "spec")
+}
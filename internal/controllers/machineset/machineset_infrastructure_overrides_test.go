@@ -0,0 +1,208 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestFailureDomainForNewMachine(t *testing.T) {
+	overrides := []clusterv1.MachineInfrastructureOverride{
+		{FailureDomain: "zone-a", Spec: apiextensionsv1.JSON{Raw: []byte(`{}`)}},
+		{FailureDomain: "zone-b", Spec: apiextensionsv1.JSON{Raw: []byte(`{}`)}},
+		{FailureDomain: "zone-c", Spec: apiextensionsv1.JSON{Raw: []byte(`{}`)}},
+	}
+	machineInFailureDomain := func(failureDomain string) *clusterv1.Machine {
+		return &clusterv1.Machine{Spec: clusterv1.MachineSpec{FailureDomain: failureDomain}}
+	}
+	deletingMachineInFailureDomain := func(failureDomain string) *clusterv1.Machine {
+		m := machineInFailureDomain(failureDomain)
+		m.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		return m
+	}
+
+	tests := []struct {
+		name      string
+		overrides []clusterv1.MachineInfrastructureOverride
+		machines  []*clusterv1.Machine
+		want      string
+	}{
+		{
+			name:      "no overrides",
+			overrides: nil,
+			machines:  []*clusterv1.Machine{machineInFailureDomain("zone-a")},
+			want:      "",
+		},
+		{
+			name:      "no machines, pick the first failure domain",
+			overrides: overrides,
+			want:      "zone-a",
+		},
+		{
+			name:      "pick the failure domain with the fewest machines",
+			overrides: overrides,
+			machines: []*clusterv1.Machine{
+				machineInFailureDomain("zone-a"),
+				machineInFailureDomain("zone-b"),
+				machineInFailureDomain("zone-a"),
+			},
+			want: "zone-c",
+		},
+		{
+			name:      "pick the first failure domain on ties",
+			overrides: overrides,
+			machines: []*clusterv1.Machine{
+				machineInFailureDomain("zone-a"),
+				machineInFailureDomain("zone-c"),
+			},
+			want: "zone-b",
+		},
+		{
+			name:      "ignore deleting machines and machines in other failure domains",
+			overrides: overrides,
+			machines: []*clusterv1.Machine{
+				machineInFailureDomain("zone-a"),
+				machineInFailureDomain("zone-b"),
+				deletingMachineInFailureDomain("zone-c"),
+				machineInFailureDomain("zone-d"),
+				machineInFailureDomain(""),
+			},
+			want: "zone-c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(failureDomainForNewMachine(tt.overrides, tt.machines)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestApplyInfrastructureOverrides(t *testing.T) {
+	overrides := []clusterv1.MachineInfrastructureOverride{
+		{FailureDomain: "zone-a", Spec: apiextensionsv1.JSON{Raw: []byte(`{"instanceType":"large","network":{"subnet":"subnet-a"}}`)}},
+		{FailureDomain: "zone-b", Spec: apiextensionsv1.JSON{Raw: []byte(`{"network":{"subnet":null}}`)}},
+	}
+	newInfraMachine := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"kind": "GenericInfrastructureMachine",
+			"spec": map[string]interface{}{
+				"instanceType": "small",
+				"image":        "image-1",
+				"network": map[string]interface{}{
+					"subnet": "subnet-default",
+					"vpc":    "vpc-1",
+				},
+			},
+		}}
+	}
+
+	tests := []struct {
+		name          string
+		infraMachine  *unstructured.Unstructured
+		overrides     []clusterv1.MachineInfrastructureOverride
+		failureDomain string
+		wantSpec      map[string]interface{}
+		wantErr       bool
+	}{
+		{
+			name:          "no failure domain",
+			infraMachine:  newInfraMachine(),
+			overrides:     overrides,
+			failureDomain: "",
+			wantSpec:      newInfraMachine().Object["spec"].(map[string]interface{}),
+		},
+		{
+			name:          "no override for the failure domain",
+			infraMachine:  newInfraMachine(),
+			overrides:     overrides,
+			failureDomain: "zone-c",
+			wantSpec:      newInfraMachine().Object["spec"].(map[string]interface{}),
+		},
+		{
+			name:          "merge the override into the spec",
+			infraMachine:  newInfraMachine(),
+			overrides:     overrides,
+			failureDomain: "zone-a",
+			wantSpec: map[string]interface{}{
+				"instanceType": "large",
+				"image":        "image-1",
+				"network": map[string]interface{}{
+					"subnet": "subnet-a",
+					"vpc":    "vpc-1",
+				},
+			},
+		},
+		{
+			name:          "remove fields set to null",
+			infraMachine:  newInfraMachine(),
+			overrides:     overrides,
+			failureDomain: "zone-b",
+			wantSpec: map[string]interface{}{
+				"instanceType": "small",
+				"image":        "image-1",
+				"network": map[string]interface{}{
+					"vpc": "vpc-1",
+				},
+			},
+		},
+		{
+			name:          "InfraMachine without spec",
+			infraMachine:  &unstructured.Unstructured{Object: map[string]interface{}{"kind": "GenericInfrastructureMachine"}},
+			overrides:     overrides,
+			failureDomain: "zone-a",
+			wantSpec: map[string]interface{}{
+				"instanceType": "large",
+				"network": map[string]interface{}{
+					"subnet": "subnet-a",
+				},
+			},
+		},
+		{
+			name:         "fails if the override is not a JSON object",
+			infraMachine: newInfraMachine(),
+			overrides: []clusterv1.MachineInfrastructureOverride{
+				{FailureDomain: "zone-a", Spec: apiextensionsv1.JSON{Raw: []byte(`"large"`)}},
+			},
+			failureDomain: "zone-a",
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := applyInfrastructureOverrides(tt.infraMachine, tt.overrides, tt.failureDomain)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tt.infraMachine.Object["spec"]).To(BeComparableTo(tt.wantSpec))
+			g.Expect(tt.infraMachine.GetKind()).To(Equal("GenericInfrastructureMachine"))
+		})
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	clientutil "sigs.k8s.io/cluster-api/internal/util/client"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// reconcileRebalance deletes Machines in over-represented failure domains when spec.rebalance.policy is Automatic;
+// deleted Machines are then replaced by syncReplicas with new Machines in the under-represented failure domains.
+func (r *Reconciler) reconcileRebalance(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	ms := s.machineSet
+
+	if ms.Spec.Rebalance.Policy != clusterv1.AutomaticMachineSetRebalancePolicy {
+		return ctrl.Result{}, nil
+	}
+
+	// Rebalancing only applies to MachineSets spreading Machines across the failure domains defined in infrastructureOverrides.
+	if ms.Spec.Template.Spec.FailureDomain != "" || len(ms.Spec.Template.Spec.InfrastructureOverrides) < 2 {
+		return ctrl.Result{}, nil
+	}
+
+	// Rebalancing only happens when the MachineSet is not scaling, and only on the MachineSet with the most current
+	// revision of a MachineDeployment, because Machines of older MachineSets are going to be replaced anyway.
+	if !s.getAndAdoptMachinesForMachineSetSucceeded || len(s.machines) != int(ptr.Deref(ms.Spec.Replicas, 0)) {
+		return ctrl.Result{}, nil
+	}
+	if isDeploymentChild(ms) && !isCurrentMachineSet(ms, s.owningMachineDeployment) {
+		return ctrl.Result{}, nil
+	}
+	if _, ok := ms.Annotations[clusterv1.MachineSetMoveMachinesToMachineSetAnnotation]; ok {
+		return ctrl.Result{}, nil
+	}
+	for _, m := range s.machines {
+		if _, ok := m.Annotations[clusterv1.PendingAcknowledgeMoveAnnotation]; ok {
+			return ctrl.Result{}, nil
+		}
+	}
+
+	maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(ptr.To(ptr.Deref(ms.Spec.Rebalance.MaxUnavailable, intstr.FromInt32(1))), int(ptr.Deref(ms.Spec.Replicas, 0)), false)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to compute maxUnavailable")
+	}
+	// Ensure rebalancing can make progress when a percentage is resolved to 0.
+	if maxUnavailable == 0 {
+		maxUnavailable = 1
+	}
+
+	deletePriorityFunc, err := getDeletePriorityFunc(ms)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	failureDomains := make([]string, 0, len(ms.Spec.Template.Spec.InfrastructureOverrides))
+	for _, override := range ms.Spec.Template.Spec.InfrastructureOverrides {
+		failureDomains = append(failureDomains, override.FailureDomain)
+	}
+	machinesToDelete := getMachinesToRebalance(s.machines, failureDomains, maxUnavailable, deletePriorityFunc)
+	if len(machinesToDelete) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	// Rebalancing deletes Machines, so it does not happen while automated disruptive actions on the Cluster are frozen.
+	if conditions.IsTrue(s.cluster, clusterv1.ClusterDegradedFreezeCondition) {
+		log.Info(fmt.Sprintf("Skipping rebalancing failure domains, Cluster has %s condition set to True", clusterv1.ClusterDegradedFreezeCondition))
+		return ctrl.Result{}, nil
+	}
+
+	var errs []error
+	machinesDeleted := []*clusterv1.Machine{}
+	for _, machine := range machinesToDelete {
+		if err := r.Client.Delete(ctx, machine); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		machinesDeleted = append(machinesDeleted, machine)
+		log.Info(fmt.Sprintf("Machine %s deleting (rebalancing failure domains, Machine is in failure domain %q)", machine.Name, machine.Spec.FailureDomain), "Machine", klog.KObj(machine))
+		r.recorder.Eventf(ms, corev1.EventTypeNormal, "SuccessfulDelete", "Deleted Machine %q in failure domain %q to rebalance failure domains", machine.Name, machine.Spec.FailureDomain)
+	}
+
+	// Wait for cache update to ensure following reconcile gets latest change.
+	if err := clientutil.WaitForObjectsToBeDeletedFromTheCache(ctx, r.Client, "Machine deletion (rebalancing failure domains)", machinesDeleted...); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return ctrl.Result{}, kerrors.NewAggregate(errs)
+	}
+	return ctrl.Result{}, nil
+}
+
+// getMachinesToRebalance returns the Machines to be deleted in order to rebalance Machines across failure domains,
+// without exceeding maxUnavailable.
+// Machines in a failure domain not included in failureDomains are deleted first, then Machines are deleted from the
+// failure domain with the most Machines until the difference with the failure domain with the fewest Machines is at most one.
+// Note: deleted Machines are going to be replaced in the failure domain with the fewest Machines, see failureDomainForNewMachine.
+func getMachinesToRebalance(machines []*clusterv1.Machine, failureDomains []string, maxUnavailable int, deletePriorityFunc deletePriorityFunc) []*clusterv1.Machine {
+	// Machines being deleted or not available count against maxUnavailable.
+	unavailable := 0
+	for _, m := range machines {
+		if !m.DeletionTimestamp.IsZero() || !conditions.IsTrue(m, clusterv1.MachineAvailableCondition) {
+			unavailable++
+		}
+	}
+	if unavailable >= maxUnavailable {
+		return nil
+	}
+
+	machinesPerFailureDomain := map[string]int{}
+	candidatesPerFailureDomain := map[string][]*clusterv1.Machine{}
+	misplaced := []*clusterv1.Machine{}
+	pendingReplacements := 0
+	for _, m := range machines {
+		if !m.DeletionTimestamp.IsZero() {
+			pendingReplacements++
+			continue
+		}
+		if !slices.Contains(failureDomains, m.Spec.FailureDomain) {
+			misplaced = append(misplaced, m)
+			continue
+		}
+		machinesPerFailureDomain[m.Spec.FailureDomain]++
+		candidatesPerFailureDomain[m.Spec.FailureDomain] = append(candidatesPerFailureDomain[m.Spec.FailureDomain], m)
+	}
+
+	// fewest returns the failure domain with the fewest Machines, the first one in the list wins on ties like for new Machines.
+	fewest := func() string {
+		failureDomain := failureDomains[0]
+		for _, fd := range failureDomains[1:] {
+			if machinesPerFailureDomain[fd] < machinesPerFailureDomain[failureDomain] {
+				failureDomain = fd
+			}
+		}
+		return failureDomain
+	}
+	most := func() string {
+		failureDomain := failureDomains[0]
+		for _, fd := range failureDomains[1:] {
+			if machinesPerFailureDomain[fd] > machinesPerFailureDomain[failureDomain] {
+				failureDomain = fd
+			}
+		}
+		return failureDomain
+	}
+	// pick returns the Machine to be deleted first according to the deletion order, and removes it from the candidates.
+	pick := func(candidates *[]*clusterv1.Machine) *clusterv1.Machine {
+		sorted := getMachinesToDeletePrioritized(slices.Clone(*candidates), len(*candidates), deletePriorityFunc)
+		*candidates = slices.DeleteFunc(*candidates, func(m *clusterv1.Machine) bool { return m == sorted[0] })
+		return sorted[0]
+	}
+
+	// Account for the replacements of Machines already being deleted.
+	for range pendingReplacements {
+		machinesPerFailureDomain[fewest()]++
+	}
+
+	machinesToDelete := []*clusterv1.Machine{}
+	for budget := maxUnavailable - unavailable; budget > 0; budget-- {
+		if len(misplaced) > 0 {
+			machinesToDelete = append(machinesToDelete, pick(&misplaced))
+			machinesPerFailureDomain[fewest()]++
+			continue
+		}
+
+		from, to := most(), fewest()
+		if machinesPerFailureDomain[from]-machinesPerFailureDomain[to] <= 1 || len(candidatesPerFailureDomain[from]) == 0 {
+			break
+		}
+		candidates := candidatesPerFailureDomain[from]
+		machinesToDelete = append(machinesToDelete, pick(&candidates))
+		candidatesPerFailureDomain[from] = candidates
+		machinesPerFailureDomain[from]--
+		machinesPerFailureDomain[to]++
+	}
+	return machinesToDelete
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileRebalance(t *testing.T) {
+	machineSet := &clusterv1.MachineSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ms",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: clusterv1.MachineSetSpec{
+			Replicas: ptr.To[int32](3),
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					InfrastructureOverrides: []clusterv1.MachineInfrastructureOverride{
+						{FailureDomain: "zone-a"},
+						{FailureDomain: "zone-b"},
+					},
+				},
+			},
+			Deletion: clusterv1.MachineSetDeletionSpec{
+				Order: clusterv1.NewestMachineSetDeletionOrder,
+			},
+			Rebalance: clusterv1.MachineSetRebalanceSpec{
+				Policy: clusterv1.AutomaticMachineSetRebalancePolicy,
+			},
+		},
+	}
+	machine := func(name string) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: clusterv1.MachineSpec{FailureDomain: "zone-a"},
+			Status: clusterv1.MachineStatus{
+				NodeRef: clusterv1.MachineNodeReference{Name: name},
+				Conditions: []metav1.Condition{
+					{Type: clusterv1.MachineAvailableCondition, Status: metav1.ConditionTrue},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		clusterConditions     []metav1.Condition
+		wantMachinesRemaining int
+	}{
+		{
+			name:                  "Deletes Machines in over-represented failure domains",
+			wantMachinesRemaining: 2,
+		},
+		{
+			name: "Does not delete Machines while the Cluster is frozen",
+			clusterConditions: []metav1.Condition{
+				{Type: clusterv1.ClusterDegradedFreezeCondition, Status: metav1.ConditionTrue, Reason: "Frozen"},
+			},
+			wantMachinesRemaining: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machines := []*clusterv1.Machine{machine("m1"), machine("m2"), machine("m3")}
+			objs := []client.Object{}
+			for _, m := range machines {
+				objs = append(objs, m)
+			}
+			fakeClient := fake.NewClientBuilder().WithObjects(objs...).Build()
+			r := &Reconciler{
+				Client:   fakeClient,
+				recorder: record.NewFakeRecorder(32),
+			}
+			s := &scope{
+				machineSet: machineSet.DeepCopy(),
+				cluster: &clusterv1.Cluster{
+					Status: clusterv1.ClusterStatus{Conditions: tt.clusterConditions},
+				},
+				machines: machines,
+				getAndAdoptMachinesForMachineSetSucceeded: true,
+			}
+
+			_, err := r.reconcileRebalance(ctx, s)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			machineList := &clusterv1.MachineList{}
+			g.Expect(fakeClient.List(ctx, machineList)).To(Succeed())
+			g.Expect(machineList.Items).To(HaveLen(tt.wantMachinesRemaining))
+		})
+	}
+}
+
+func TestGetMachinesToRebalance(t *testing.T) {
+	failureDomains := []string{"zone-a", "zone-b", "zone-c"}
+	now := time.Now()
+
+	machineCount := 0
+	// machine returns an available Machine in the given failure domain; Machines created later are newer.
+	machine := func(failureDomain string) *clusterv1.Machine {
+		machineCount++
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              fmt.Sprintf("m%d-%s", machineCount, failureDomain),
+				CreationTimestamp: metav1.Time{Time: now.Add(-time.Duration(100-machineCount) * time.Hour)},
+			},
+			Spec: clusterv1.MachineSpec{FailureDomain: failureDomain},
+			Status: clusterv1.MachineStatus{
+				NodeRef: clusterv1.MachineNodeReference{Name: fmt.Sprintf("node%d", machineCount)},
+				Conditions: []metav1.Condition{
+					{Type: clusterv1.MachineAvailableCondition, Status: metav1.ConditionTrue},
+				},
+			},
+		}
+	}
+	unavailableMachine := func(failureDomain string) *clusterv1.Machine {
+		m := machine(failureDomain)
+		m.Status.Conditions = nil
+		return m
+	}
+	deletingMachine := func(failureDomain string) *clusterv1.Machine {
+		m := machine(failureDomain)
+		m.DeletionTimestamp = &metav1.Time{Time: now}
+		return m
+	}
+	names := func(machines []*clusterv1.Machine) []string {
+		ret := []string{}
+		for _, m := range machines {
+			ret = append(ret, m.Name)
+		}
+		return ret
+	}
+
+	tests := []struct {
+		name           string
+		machines       func() []*clusterv1.Machine
+		maxUnavailable int
+		want           []string
+	}{
+		{
+			name: "balanced, nothing to do",
+			machines: func() []*clusterv1.Machine {
+				return []*clusterv1.Machine{machine("zone-a"), machine("zone-b"), machine("zone-c"), machine("zone-a")}
+			},
+			maxUnavailable: 1,
+			want:           []string{},
+		},
+		{
+			name: "skewed, delete the newest Machine in the failure domain with the most Machines",
+			machines: func() []*clusterv1.Machine {
+				machineCount = 0
+				return []*clusterv1.Machine{machine("zone-a"), machine("zone-a"), machine("zone-a"), machine("zone-b")}
+			},
+			maxUnavailable: 1,
+			want:           []string{"m3-zone-a"},
+		},
+		{
+			name: "skewed, delete more Machines if maxUnavailable allows it",
+			machines: func() []*clusterv1.Machine {
+				machineCount = 0
+				return []*clusterv1.Machine{machine("zone-a"), machine("zone-a"), machine("zone-a"), machine("zone-a"), machine("zone-a"), machine("zone-a")}
+			},
+			maxUnavailable: 10,
+			want:           []string{"m6-zone-a", "m5-zone-a", "m4-zone-a", "m3-zone-a"},
+		},
+		{
+			name: "skewed, but maxUnavailable is exhausted by unavailable Machines",
+			machines: func() []*clusterv1.Machine {
+				return []*clusterv1.Machine{machine("zone-a"), machine("zone-a"), unavailableMachine("zone-a"), machine("zone-b")}
+			},
+			maxUnavailable: 1,
+			want:           nil,
+		},
+		{
+			name: "skewed, but maxUnavailable is exhausted by deleting Machines",
+			machines: func() []*clusterv1.Machine {
+				return []*clusterv1.Machine{machine("zone-a"), machine("zone-a"), deletingMachine("zone-a"), machine("zone-b")}
+			},
+			maxUnavailable: 1,
+			want:           nil,
+		},
+		{
+			name: "skewed, replacements of deleting Machines are taken into account",
+			machines: func() []*clusterv1.Machine {
+				// The replacement of the deleting Machine is going to be created in zone-c.
+				return []*clusterv1.Machine{machine("zone-a"), machine("zone-a"), machine("zone-b"), deletingMachine("zone-a")}
+			},
+			maxUnavailable: 2,
+			want:           []string{},
+		},
+		{
+			name: "Machines in a failure domain not in the list are deleted first",
+			machines: func() []*clusterv1.Machine {
+				machineCount = 0
+				return []*clusterv1.Machine{machine("zone-a"), machine("zone-a"), machine("zone-a"), machine("zone-d"), machine("zone-b")}
+			},
+			maxUnavailable: 1,
+			want:           []string{"m4-zone-d"},
+		},
+		{
+			name: "Machines in a failure domain not in the list are deleted before rebalancing the others",
+			machines: func() []*clusterv1.Machine {
+				machineCount = 0
+				return []*clusterv1.Machine{machine("zone-a"), machine("zone-a"), machine("zone-a"), machine(""), machine("zone-b")}
+			},
+			maxUnavailable: 2,
+			want:           []string{"m4-", "m3-zone-a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got := getMachinesToRebalance(tt.machines(), failureDomains, tt.maxUnavailable, newestDeletionOrder)
+			if tt.want == nil {
+				g.Expect(got).To(BeEmpty())
+				return
+			}
+			g.Expect(names(got)).To(Equal(tt.want))
+		})
+	}
+}
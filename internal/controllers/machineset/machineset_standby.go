@@ -0,0 +1,189 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/feature"
+	clientutil "sigs.k8s.io/cluster-api/internal/util/client"
+	"sigs.k8s.io/cluster-api/internal/util/ssa"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// promoteStandbyMachines promotes available standby Machines when the MachineSet has less replicas than desired,
+// e.g. because unhealthy Machines have been deleted by remediation; this allows to replace those Machines
+// without waiting for new Machines to be provisioned.
+// A standby Machine is promoted by removing the cluster.x-k8s.io/standby label, then the Machine controller
+// removes the node.cluster.x-k8s.io/standby taint from the Node.
+func (r *Reconciler) promoteStandbyMachines(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	ms := s.machineSet
+
+	if !s.getAndAdoptMachinesForMachineSetSucceeded || len(s.standbyMachines) == 0 || desiredStandbyReplicas(s) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	// Machines being deleted are not counted, because they are going to be replaced.
+	// Note: syncReplicas counts Machines being deleted as well, but given that they are picked first when
+	// scaling down, promoting a standby Machine does not lead to the deletion of another Machine.
+	machines := collections.FromMachines(s.machines...).Filter(collections.Not(collections.HasDeletionTimestamp))
+	missingReplicas := int(ptr.Deref(ms.Spec.Replicas, 0)) - len(machines)
+	machinesToPromote := getStandbyMachinesToPromote(s.standbyMachines, missingReplicas)
+	if len(machinesToPromote) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	var errs []error
+	for _, machine := range machinesToPromote {
+		// Computing the desired Machine drops the standby label; given that the label is owned by the
+		// MachineSet controller, the label is removed from the Machine.
+		promotedMachine, err := r.computeDesiredMachine(ms, machine, "")
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to promote Machine %s: failed to compute desired Machine", klog.KObj(machine)))
+			continue
+		}
+		if err := ssa.Patch(ctx, r.Client, machineSetManagerName, promotedMachine, ssa.WithCachingProxy{Cache: r.ssaCache, Original: machine}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to promote Machine %s", klog.KObj(machine)))
+			continue
+		}
+
+		s.standbyMachines = slices.DeleteFunc(s.standbyMachines, func(m *clusterv1.Machine) bool { return m.Name == machine.Name })
+		s.machines = append(s.machines, promotedMachine)
+		log.Info(fmt.Sprintf("Machine %s promoted (replacing a missing replica)", machine.Name), "Machine", klog.KObj(machine))
+		r.recorder.Eventf(ms, corev1.EventTypeNormal, "SuccessfulPromote", "Promoted standby Machine %q", machine.Name)
+	}
+	if len(errs) > 0 {
+		return ctrl.Result{}, kerrors.NewAggregate(errs)
+	}
+	return ctrl.Result{}, nil
+}
+
+// syncStandbyReplicas creates or deletes standby Machines to match spec.standby.replicas.
+// Standby Machines to be remediated are deleted, so they are replaced with new standby Machines.
+func (r *Reconciler) syncStandbyReplicas(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	ms := s.machineSet
+
+	if !s.getAndAdoptMachinesForMachineSetSucceeded {
+		return ctrl.Result{}, nil
+	}
+
+	desiredReplicas := desiredStandbyReplicas(s)
+	machinesToDelete := getStandbyMachinesToDelete(s.standbyMachines, desiredReplicas)
+	if len(machinesToDelete) > 0 {
+		var errs []error
+		machinesDeleted := []*clusterv1.Machine{}
+		for _, machine := range machinesToDelete {
+			if err := r.Client.Delete(ctx, machine); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			machinesDeleted = append(machinesDeleted, machine)
+			log.Info(fmt.Sprintf("Machine %s deleting (standby)", machine.Name), "Machine", klog.KObj(machine))
+			r.recorder.Eventf(ms, corev1.EventTypeNormal, "SuccessfulDelete", "Deleted standby Machine %q", machine.Name)
+		}
+
+		// Wait for cache update to ensure following reconcile gets latest change.
+		if err := clientutil.WaitForObjectsToBeDeletedFromTheCache(ctx, r.Client, "Machine deletion (standby)", machinesDeleted...); err != nil {
+			errs = append(errs, err)
+		}
+		if len(errs) > 0 {
+			return ctrl.Result{}, kerrors.NewAggregate(errs)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Standby Machines are only created when all the replicas of the MachineSet exist, so they
+	// never delay the creation of replicas.
+	if machinesToAdd := desiredReplicas - len(s.standbyMachines); machinesToAdd > 0 && len(s.machines) >= int(ptr.Deref(ms.Spec.Replicas, 0)) {
+		if value, ok := ms.Annotations[clusterv1.DisableMachineCreateAnnotation]; ok && value == "true" {
+			return ctrl.Result{}, nil
+		}
+		log.Info(fmt.Sprintf("MachineSet is creating %d standby Machines", machinesToAdd), "standbyReplicas", desiredReplicas, "standbyMachineCount", len(s.standbyMachines))
+		return r.createMachines(ctx, s, machinesToAdd, true)
+	}
+	return ctrl.Result{}, nil
+}
+
+// desiredStandbyReplicas returns the number of standby Machines the MachineSet should have.
+// Standby Machines are only kept if the MachineSetStandby feature gate is enabled, and only by the MachineSet with
+// the most current revision of a MachineDeployment, because Machines of older MachineSets are going to be replaced anyway.
+func desiredStandbyReplicas(s *scope) int {
+	if !feature.Gates.Enabled(feature.MachineSetStandby) {
+		return 0
+	}
+	if isDeploymentChild(s.machineSet) && !isCurrentMachineSet(s.machineSet, s.owningMachineDeployment) {
+		return 0
+	}
+	return int(ptr.Deref(s.machineSet.Spec.Standby.Replicas, 0))
+}
+
+// getStandbyMachinesToPromote returns up to missingReplicas standby Machines to be promoted.
+// Only available standby Machines which are not being deleted and are not unhealthy are promoted, oldest first.
+func getStandbyMachinesToPromote(standbyMachines []*clusterv1.Machine, missingReplicas int) []*clusterv1.Machine {
+	if missingReplicas <= 0 {
+		return nil
+	}
+
+	candidates := collections.FromMachines(standbyMachines...).Filter(
+		collections.Not(collections.HasDeletionTimestamp),
+		collections.Not(collections.IsUnhealthy),
+		func(m *clusterv1.Machine) bool { return conditions.IsTrue(m, clusterv1.MachineAvailableCondition) },
+	).SortedByCreationTimestamp()
+	if len(candidates) > missingReplicas {
+		candidates = candidates[:missingReplicas]
+	}
+	return candidates
+}
+
+// getStandbyMachinesToDelete returns the standby Machines to be deleted, i.e. standby Machines to be remediated
+// and the standby Machines exceeding desiredReplicas, newest first.
+// Note: Standby Machines already being deleted are not returned, and they are not counted as standby replicas.
+func getStandbyMachinesToDelete(standbyMachines []*clusterv1.Machine, desiredReplicas int) []*clusterv1.Machine {
+	machinesToDelete := []*clusterv1.Machine{}
+	remaining := []*clusterv1.Machine{}
+	for _, m := range standbyMachines {
+		switch {
+		case !m.DeletionTimestamp.IsZero():
+			continue
+		case collections.IsUnhealthyAndOwnerRemediated(m):
+			machinesToDelete = append(machinesToDelete, m)
+		default:
+			remaining = append(remaining, m)
+		}
+	}
+
+	if exceeding := len(remaining) - desiredReplicas; exceeding > 0 {
+		// Delete the newest standby Machines first, they are the ones less likely to be available.
+		sorted := collections.FromMachines(remaining...).SortedByCreationTimestamp()
+		slices.Reverse(sorted)
+		machinesToDelete = append(machinesToDelete, sorted[:exceeding]...)
+	}
+	return machinesToDelete
+}
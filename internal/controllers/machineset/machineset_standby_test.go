@@ -0,0 +1,282 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/feature"
+)
+
+func TestDesiredStandbyReplicas(t *testing.T) {
+	machineSet := func(revision string) *clusterv1.MachineSet {
+		return &clusterv1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      map[string]string{clusterv1.MachineDeploymentNameLabel: "md"},
+				Annotations: map[string]string{clusterv1.RevisionAnnotation: revision},
+			},
+			Spec: clusterv1.MachineSetSpec{
+				Standby: clusterv1.MachineSetStandbySpec{Replicas: ptr.To[int32](2)},
+			},
+		}
+	}
+	machineDeployment := &clusterv1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{clusterv1.RevisionAnnotation: "2"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		featureEnabled bool
+		s              *scope
+		want           int
+	}{
+		{
+			name:           "no standby Machines if the feature gate is disabled",
+			featureEnabled: false,
+			s:              &scope{machineSet: machineSet("2"), owningMachineDeployment: machineDeployment},
+			want:           0,
+		},
+		{
+			name:           "standby Machines for the current MachineSet",
+			featureEnabled: true,
+			s:              &scope{machineSet: machineSet("2"), owningMachineDeployment: machineDeployment},
+			want:           2,
+		},
+		{
+			name:           "no standby Machines for an old MachineSet",
+			featureEnabled: true,
+			s:              &scope{machineSet: machineSet("1"), owningMachineDeployment: machineDeployment},
+			want:           0,
+		},
+		{
+			name:           "standby Machines for a stand-alone MachineSet",
+			featureEnabled: true,
+			s: &scope{machineSet: &clusterv1.MachineSet{
+				Spec: clusterv1.MachineSetSpec{
+					Standby: clusterv1.MachineSetStandbySpec{Replicas: ptr.To[int32](1)},
+				},
+			}},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachineSetStandby, tt.featureEnabled)
+
+			g.Expect(desiredStandbyReplicas(tt.s)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestGetStandbyMachinesToPromote(t *testing.T) {
+	now := time.Now()
+
+	machineCount := 0
+	// machine returns an available standby Machine; Machines created later are newer.
+	machine := func() *clusterv1.Machine {
+		machineCount++
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              fmt.Sprintf("m%d", machineCount),
+				Labels:            map[string]string{clusterv1.MachineStandbyLabel: ""},
+				CreationTimestamp: metav1.Time{Time: now.Add(-time.Duration(100-machineCount) * time.Hour)},
+			},
+			Status: clusterv1.MachineStatus{
+				Conditions: []metav1.Condition{
+					{Type: clusterv1.MachineAvailableCondition, Status: metav1.ConditionTrue},
+				},
+			},
+		}
+	}
+	unavailableMachine := func() *clusterv1.Machine {
+		m := machine()
+		m.Status.Conditions = nil
+		return m
+	}
+	unhealthyMachine := func() *clusterv1.Machine {
+		m := machine()
+		m.Status.Conditions = append(m.Status.Conditions, metav1.Condition{Type: clusterv1.MachineHealthCheckSucceededCondition, Status: metav1.ConditionFalse})
+		return m
+	}
+	deletingMachine := func() *clusterv1.Machine {
+		m := machine()
+		m.DeletionTimestamp = &metav1.Time{Time: now}
+		return m
+	}
+
+	tests := []struct {
+		name            string
+		machines        func() []*clusterv1.Machine
+		missingReplicas int
+		want            []string
+	}{
+		{
+			name: "no missing replicas, nothing to promote",
+			machines: func() []*clusterv1.Machine {
+				return []*clusterv1.Machine{machine(), machine()}
+			},
+			missingReplicas: 0,
+			want:            []string{},
+		},
+		{
+			name: "promote the oldest standby Machines",
+			machines: func() []*clusterv1.Machine {
+				machineCount = 0
+				return []*clusterv1.Machine{machine(), machine(), machine()}
+			},
+			missingReplicas: 2,
+			want:            []string{"m1", "m2"},
+		},
+		{
+			name: "promote all the standby Machines if there are more missing replicas",
+			machines: func() []*clusterv1.Machine {
+				machineCount = 0
+				return []*clusterv1.Machine{machine(), machine()}
+			},
+			missingReplicas: 3,
+			want:            []string{"m1", "m2"},
+		},
+		{
+			name: "do not promote standby Machines which are not available, unhealthy or deleting",
+			machines: func() []*clusterv1.Machine {
+				machineCount = 0
+				return []*clusterv1.Machine{unavailableMachine(), unhealthyMachine(), deletingMachine(), machine()}
+			},
+			missingReplicas: 3,
+			want:            []string{"m4"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got := getStandbyMachinesToPromote(tt.machines(), tt.missingReplicas)
+			g.Expect(machineNames(got)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestGetStandbyMachinesToDelete(t *testing.T) {
+	now := time.Now()
+
+	machineCount := 0
+	// machine returns a standby Machine; Machines created later are newer.
+	machine := func() *clusterv1.Machine {
+		machineCount++
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              fmt.Sprintf("m%d", machineCount),
+				Labels:            map[string]string{clusterv1.MachineStandbyLabel: ""},
+				CreationTimestamp: metav1.Time{Time: now.Add(-time.Duration(100-machineCount) * time.Hour)},
+			},
+		}
+	}
+	machineToRemediate := func() *clusterv1.Machine {
+		m := machine()
+		m.Status.Conditions = []metav1.Condition{
+			{Type: clusterv1.MachineHealthCheckSucceededCondition, Status: metav1.ConditionFalse},
+			{Type: clusterv1.MachineOwnerRemediatedCondition, Status: metav1.ConditionFalse},
+		}
+		return m
+	}
+	deletingMachine := func() *clusterv1.Machine {
+		m := machine()
+		m.DeletionTimestamp = &metav1.Time{Time: now}
+		return m
+	}
+
+	tests := []struct {
+		name            string
+		machines        func() []*clusterv1.Machine
+		desiredReplicas int
+		want            []string
+	}{
+		{
+			name: "as many standby Machines as desired, nothing to delete",
+			machines: func() []*clusterv1.Machine {
+				machineCount = 0
+				return []*clusterv1.Machine{machine(), machine()}
+			},
+			desiredReplicas: 2,
+			want:            []string{},
+		},
+		{
+			name: "delete the newest exceeding standby Machines",
+			machines: func() []*clusterv1.Machine {
+				machineCount = 0
+				return []*clusterv1.Machine{machine(), machine(), machine()}
+			},
+			desiredReplicas: 1,
+			want:            []string{"m3", "m2"},
+		},
+		{
+			name: "delete all the standby Machines if none is desired",
+			machines: func() []*clusterv1.Machine {
+				machineCount = 0
+				return []*clusterv1.Machine{machine(), machine()}
+			},
+			desiredReplicas: 0,
+			want:            []string{"m2", "m1"},
+		},
+		{
+			name: "delete standby Machines to be remediated",
+			machines: func() []*clusterv1.Machine {
+				machineCount = 0
+				return []*clusterv1.Machine{machine(), machineToRemediate()}
+			},
+			desiredReplicas: 2,
+			want:            []string{"m2"},
+		},
+		{
+			name: "do not delete standby Machines already deleting",
+			machines: func() []*clusterv1.Machine {
+				machineCount = 0
+				return []*clusterv1.Machine{machine(), deletingMachine()}
+			},
+			desiredReplicas: 0,
+			want:            []string{"m1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got := getStandbyMachinesToDelete(tt.machines(), tt.desiredReplicas)
+			g.Expect(machineNames(got)).To(Equal(tt.want))
+		})
+	}
+}
+
+func machineNames(machines []*clusterv1.Machine) []string {
+	ret := []string{}
+	for _, m := range machines {
+		ret = append(ret, m.Name)
+	}
+	return ret
+}
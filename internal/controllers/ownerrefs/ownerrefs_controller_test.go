@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ownerrefs
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+
+	tests := []struct {
+		name           string
+		paused         bool
+		wantOwnerRefs  int
+		wantFinalizers int
+	}{
+		{
+			name:           "repairs the ownerReferences of the objects of a Cluster",
+			wantOwnerRefs:  1,
+			wantFinalizers: 1,
+		},
+		{
+			name:   "skips paused Clusters",
+			paused: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  metav1.NamespaceDefault,
+					Name:       "cluster1",
+					UID:        "cluster-uid",
+					Finalizers: []string{clusterv1.ClusterFinalizer},
+				},
+			}
+			if tt.paused {
+				cluster.Spec.Paused = &tt.paused
+			}
+			md := &clusterv1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: metav1.NamespaceDefault,
+					Name:      "md1",
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: cluster.Name},
+				},
+			}
+
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster, md).Build()
+			r := &Reconciler{Client: c}
+
+			_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(cluster)})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			gotMD := &clusterv1.MachineDeployment{}
+			g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(md), gotMD)).To(Succeed())
+			g.Expect(gotMD.OwnerReferences).To(HaveLen(tt.wantOwnerRefs))
+			g.Expect(gotMD.Finalizers).To(HaveLen(tt.wantFinalizers))
+		})
+	}
+}
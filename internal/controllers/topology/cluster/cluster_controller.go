@@ -55,6 +55,8 @@ import (
 	"sigs.k8s.io/cluster-api/exp/topology/scope"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/hooks"
+	"sigs.k8s.io/cluster-api/internal/util/fastpath"
+	"sigs.k8s.io/cluster-api/internal/util/resync"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/internal/webhooks"
 	"sigs.k8s.io/cluster-api/util"
@@ -82,11 +84,19 @@ type Reconciler struct {
 	// race conditions caused by an outdated cache.
 	APIReader client.Reader
 
+	// TemplateReader is used to read ClusterClasses and templates, e.g. from a cache shared across controllers.
+	// If not set, Client is used.
+	TemplateReader client.Reader
+
 	RuntimeClient runtimeclient.Client
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// ResyncPeriod is the period after which all Clusters are requeued, in addition to the resyncs of the cache.
+	// If 0, Clusters are only requeued when the cache is resynced.
+	ResyncPeriod time.Duration
+
 	externalTracker external.ObjectTracker
 	recorder        record.EventRecorder
 
@@ -94,6 +104,10 @@ type Reconciler struct {
 	desiredStateGenerator desiredstate.Generator
 
 	ssaCache ssa.Cache
+
+	// fastPathCache is used to skip computing the desired state if none of its inputs changed
+	// since the last successful reconcile of a stable Cluster topology.
+	fastPathCache fastpath.Cache[struct{}]
 }
 
 func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -144,6 +158,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		).
 		WithOptions(options).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		WatchesRawSource(resync.Source(mgr.GetClient(), &clusterv1.ClusterList{}, r.ResyncPeriod, r.WatchFilterValue)).
 		Build(r)
 
 	if err != nil {
@@ -163,6 +178,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 
 	r.recorder = mgr.GetEventRecorderFor("topology/cluster-controller")
 	r.ssaCache = ssa.NewCache("topology/cluster")
+	r.fastPathCache = fastpath.NewCache[struct{}]("topology/cluster")
 	return nil
 }
 
@@ -328,7 +344,7 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope.Scope) (ctrl.Result
 	// Get ClusterClass.
 	clusterClass := &clusterv1.ClusterClass{}
 	key := s.Current.Cluster.GetClassKey()
-	if err := r.Client.Get(ctx, key, clusterClass); err != nil {
+	if err := r.templateReader().Get(ctx, key, clusterClass); err != nil {
 		return ctrl.Result{}, errors.Wrapf(err, "failed to retrieve ClusterClass %s", key)
 	}
 
@@ -381,8 +397,23 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope.Scope) (ctrl.Result
 		return ctrl.Result{}, errors.Wrap(err, "error creating dynamic watch")
 	}
 
+	// Skip computing and reconciling the desired state if the Cluster topology was stable at the last reconcile
+	// and none of the inputs of the desired state changed since then, e.g. when only status changed.
+	fastPathKey := client.ObjectKeyFromObject(s.Current.Cluster).String()
+	fingerprint, err := topologyFingerprint(s)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if r.fastPathCache != nil {
+		if _, ok := r.fastPathCache.Get(fastPathKey, fingerprint); ok {
+			return ctrl.Result{}, nil
+		}
+		r.fastPathCache.Invalidate(fastPathKey)
+	}
+
 	// Computes the desired state of the Cluster and store it in the request scope.
 	s.Desired, err = r.desiredStateGenerator.Generate(ctx, s)
+	setTopologyStatus(s.Current.Cluster, s.AppliedPatches)
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "error computing the desired state of the Cluster topology")
 	}
@@ -398,9 +429,25 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope.Scope) (ctrl.Result
 		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
 
+	if r.fastPathCache != nil && canUseFastPath(s) {
+		r.fastPathCache.Add(fastPathKey, fingerprint, struct{}{})
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// setTopologyStatus surfaces in the Cluster status the outcome of the external patches called during the last
+// computation of the desired state, so it is possible to figure out which extensions changed the templates.
+func setTopologyStatus(cluster *clusterv1.Cluster, appliedPatches []clusterv1.ClusterTopologyAppliedPatch) {
+	if len(appliedPatches) == 0 {
+		cluster.Status.Topology = nil
+		return
+	}
+	cluster.Status.Topology = &clusterv1.ClusterTopologyStatus{
+		LastAppliedPatches: appliedPatches,
+	}
+}
+
 // setupDynamicWatches create watches for InfrastructureCluster and ControlPlane CRs when they exist.
 func (r *Reconciler) setupDynamicWatches(ctx context.Context, s *scope.Scope) error {
 	scheme := r.Client.Scheme()
@@ -329,7 +329,7 @@ func dedupNames(t scope.WorkerUpgradeTracker) ([]string, []string, []string) {
 	deferredSet := sets.Set[string]{}.Insert(t.DeferredUpgradeNames()...).Difference(upgradingSet)
 	// upgrading and deferred names are removed from pending names (it is pending if not upgrading or deferred)
 	pendingSet := sets.Set[string]{}.Insert(t.PendingUpgradeNames()...).Difference(upgradingSet).Difference(deferredSet)
-	return upgradingSet.UnsortedList(), pendingSet.UnsortedList(), deferredSet.UnsortedList()
+	return sets.List(upgradingSet), sets.List(pendingSet), sets.List(deferredSet)
 }
 
 // computeNameList computes list of names from the given list to be shown in conditions.
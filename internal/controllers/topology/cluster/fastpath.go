@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+	"sigs.k8s.io/cluster-api/internal/util/fastpath"
+	"sigs.k8s.io/cluster-api/internal/util/hash"
+)
+
+// topologyFingerprint computes the fingerprint of the inputs of the desired state computation, i.e. of the Cluster,
+// the blueprint and the current state of the Cluster topology, ignoring status.
+// NOTE: The failure domains in the Cluster status are part of the fingerprint, because they are used to compute
+// the builtin.cluster.failureDomains variable passed to patches.
+func topologyFingerprint(s *scope.Scope) (string, error) {
+	objs := []client.Object{s.Current.Cluster}
+
+	if s.Blueprint != nil {
+		objs = append(objs, s.Blueprint.ClusterClass, s.Blueprint.InfrastructureClusterTemplate)
+		if s.Blueprint.ControlPlane != nil {
+			objs = append(objs, s.Blueprint.ControlPlane.Template, s.Blueprint.ControlPlane.InfrastructureMachineTemplate)
+		}
+		for _, class := range sortedKeys(s.Blueprint.MachineDeployments) {
+			md := s.Blueprint.MachineDeployments[class]
+			objs = append(objs, md.BootstrapTemplate, md.InfrastructureMachineTemplate)
+		}
+		for _, class := range sortedKeys(s.Blueprint.MachinePools) {
+			mp := s.Blueprint.MachinePools[class]
+			objs = append(objs, mp.BootstrapTemplate, mp.InfrastructureMachinePoolTemplate)
+		}
+	}
+
+	objs = append(objs, s.Current.InfrastructureCluster)
+	if s.Current.ControlPlane != nil {
+		objs = append(objs, s.Current.ControlPlane.Object, s.Current.ControlPlane.InfrastructureMachineTemplate, s.Current.ControlPlane.MachineHealthCheck)
+	}
+	for _, name := range sortedKeys(s.Current.MachineDeployments) {
+		md := s.Current.MachineDeployments[name]
+		objs = append(objs, md.Object, md.BootstrapTemplate, md.InfrastructureMachineTemplate, md.MachineHealthCheck)
+	}
+	for _, name := range sortedKeys(s.Current.MachinePools) {
+		mp := s.Current.MachinePools[name]
+		objs = append(objs, mp.Object, mp.BootstrapObject, mp.InfrastructureMachinePoolObject)
+	}
+	failureDomainsHash, err := hash.Compute(s.Current.Cluster.Status.FailureDomains)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to compute the hash of the Cluster failure domains")
+	}
+	return fmt.Sprintf("%s/%d", fastpath.Fingerprint(objs...), failureDomainsHash), nil
+}
+
+// canUseFastPath returns true if the desired state of the Cluster topology only depends on the objects
+// included in the topology fingerprint, and not on their status.
+// This is the case when there are no upgrades or creations in progress, no pending lifecycle hooks,
+// and no external patches, which could return a different result even if the inputs didn't change.
+func canUseFastPath(s *scope.Scope) bool {
+	if s.Blueprint == nil || s.Blueprint.ClusterClass == nil {
+		return false
+	}
+	for _, patch := range s.Blueprint.ClusterClass.Spec.Patches {
+		if patch.External != nil {
+			return false
+		}
+	}
+	if s.Current.Cluster.Annotations[runtimev1.PendingHooksAnnotation] != "" {
+		return false
+	}
+	if s.HookResponseTracker.AggregateRetryAfter() != 0 {
+		return false
+	}
+	return !s.UpgradeTracker.ControlPlane.IsPendingUpgrade &&
+		!s.UpgradeTracker.ControlPlane.IsStartingUpgrade &&
+		!s.UpgradeTracker.ControlPlane.IsUpgrading &&
+		!s.UpgradeTracker.MachineDeployments.IsAnyPendingUpgrade() &&
+		!s.UpgradeTracker.MachineDeployments.IsAnyUpgrading() &&
+		!s.UpgradeTracker.MachineDeployments.IsAnyUpgradeDeferred() &&
+		!s.UpgradeTracker.MachineDeployments.IsAnyPendingCreate() &&
+		!s.UpgradeTracker.MachinePools.IsAnyPendingUpgrade() &&
+		!s.UpgradeTracker.MachinePools.IsAnyUpgrading() &&
+		!s.UpgradeTracker.MachinePools.IsAnyUpgradeDeferred() &&
+		!s.UpgradeTracker.MachinePools.IsAnyPendingCreate()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+func TestTopologyFingerprint(t *testing.T) {
+	newScope := func() *scope.Scope {
+		cluster := builder.Cluster(metav1.NamespaceDefault, "cluster1").Build()
+		s := scope.New(cluster)
+		s.Blueprint.ClusterClass = builder.ClusterClass(metav1.NamespaceDefault, "class1").Build()
+		s.Current.ControlPlane = &scope.ControlPlaneState{
+			Object: builder.ControlPlane(metav1.NamespaceDefault, "cp1").Build(),
+		}
+		s.Current.MachineDeployments = scope.MachineDeploymentsStateMap{
+			"md1": &scope.MachineDeploymentState{Object: builder.MachineDeployment(metav1.NamespaceDefault, "md1").Build()},
+			"md2": &scope.MachineDeploymentState{Object: builder.MachineDeployment(metav1.NamespaceDefault, "md2").Build()},
+		}
+		return s
+	}
+
+	tests := []struct {
+		name       string
+		mutate     func(s *scope.Scope)
+		wantChange bool
+	}{
+		{
+			name:   "status changes do not change the fingerprint",
+			mutate: func(s *scope.Scope) { s.Current.Cluster.Status.Phase = string(clusterv1.ClusterPhaseProvisioned) },
+		},
+		{
+			name: "failure domain changes change the fingerprint",
+			mutate: func(s *scope.Scope) {
+				s.Current.Cluster.Status.FailureDomains = []clusterv1.FailureDomain{{Name: "fd1", ControlPlane: ptr.To(true)}}
+			},
+			wantChange: true,
+		},
+		{
+			name:       "generation changes change the fingerprint",
+			mutate:     func(s *scope.Scope) { s.Current.MachineDeployments["md2"].Object.Generation++ },
+			wantChange: true,
+		},
+		{
+			name:       "annotation changes change the fingerprint",
+			mutate:     func(s *scope.Scope) { s.Current.ControlPlane.Object.SetAnnotations(map[string]string{"foo": "bar"}) },
+			wantChange: true,
+		},
+		{
+			name:       "deleted objects change the fingerprint",
+			mutate:     func(s *scope.Scope) { delete(s.Current.MachineDeployments, "md1") },
+			wantChange: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s := newScope()
+			before, err := topologyFingerprint(s)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(topologyFingerprint(newScope())).To(Equal(before))
+
+			tt.mutate(s)
+			after, err := topologyFingerprint(s)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(after != before).To(Equal(tt.wantChange))
+		})
+	}
+}
+
+func TestCanUseFastPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(s *scope.Scope)
+		want   bool
+	}{
+		{
+			name: "stable topology",
+			want: true,
+		},
+		{
+			name:   "no ClusterClass",
+			mutate: func(s *scope.Scope) { s.Blueprint.ClusterClass = nil },
+		},
+		{
+			name: "external patches",
+			mutate: func(s *scope.Scope) {
+				s.Blueprint.ClusterClass.Spec.Patches = []clusterv1.ClusterClassPatch{{
+					Name:     "external",
+					External: &clusterv1.ExternalPatchDefinition{GeneratePatchesExtension: "generate"},
+				}}
+			},
+		},
+		{
+			name: "pending hooks",
+			mutate: func(s *scope.Scope) {
+				s.Current.Cluster.Annotations = map[string]string{runtimev1.PendingHooksAnnotation: "AfterClusterUpgrade"}
+			},
+		},
+		{
+			name:   "control plane upgrading",
+			mutate: func(s *scope.Scope) { s.UpgradeTracker.ControlPlane.IsUpgrading = true },
+		},
+		{
+			name:   "MachineDeployment pending create",
+			mutate: func(s *scope.Scope) { s.UpgradeTracker.MachineDeployments.MarkPendingCreate("md1") },
+		},
+		{
+			name:   "MachinePool upgrading",
+			mutate: func(s *scope.Scope) { s.UpgradeTracker.MachinePools.MarkUpgrading("mp1") },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s := scope.New(builder.Cluster(metav1.NamespaceDefault, "cluster1").Build())
+			s.Blueprint.ClusterClass = builder.ClusterClass(metav1.NamespaceDefault, "class1").Build()
+			if tt.mutate != nil {
+				tt.mutate(s)
+			}
+			g.Expect(canUseFastPath(s)).To(Equal(tt.want))
+		})
+	}
+}
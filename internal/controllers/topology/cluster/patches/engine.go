@@ -21,13 +21,16 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"maps"
 	"runtime/debug"
+	"slices"
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/pkg/errors"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -46,7 +49,7 @@ import (
 
 // Engine is a patch engine which applies patches defined in a ClusterBlueprint to a ClusterState.
 type Engine interface {
-	Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, desired *scope.ClusterState) error
+	Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, desired *scope.ClusterState) ([]clusterv1.ClusterTopologyAppliedPatch, error)
 }
 
 // NewEngine creates a new patch engine.
@@ -69,10 +72,12 @@ type engine struct {
 //   - Then for all ClusterClassPatches of a ClusterClass, JSON or JSON merge patches are generated
 //     and successively applied to the templates in the GeneratePatchesRequest.
 //   - Eventually the patched templates are used to update the specs of the desired objects.
-func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, desired *scope.ClusterState) error {
+//
+// Apply returns the outcome of the external patches which have been called, also in case of errors.
+func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, desired *scope.ClusterState) ([]clusterv1.ClusterTopologyAppliedPatch, error) {
 	// Return if there are no patches.
 	if len(blueprint.ClusterClass.Spec.Patches) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	log := ctrl.LoggerFrom(ctx)
@@ -80,15 +85,17 @@ func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, d
 	// Determine contract version used by the ControlPlane.
 	controlPlaneContractVersion, err := contract.GetContractVersionForVersion(ctx, e.client, desired.ControlPlane.Object.GroupVersionKind().GroupKind(), desired.ControlPlane.Object.GroupVersionKind().Version)
 	if err != nil {
-		return errors.Wrapf(err, "failed to generate patch request: failed to get contract version for the ControlPlane object")
+		return nil, errors.Wrapf(err, "failed to generate patch request: failed to get contract version for the ControlPlane object")
 	}
 
 	// Create a patch generation request.
 	req, err := createRequest(blueprint, desired, controlPlaneContractVersion)
 	if err != nil {
-		return errors.Wrapf(err, "failed to generate patch request")
+		return nil, errors.Wrapf(err, "failed to generate patch request")
 	}
 
+	var appliedPatches []clusterv1.ClusterTopologyAppliedPatch
+
 	// Loop over patches in ClusterClass, generate patches and apply them to the request,
 	// respecting the order in which they are defined.
 	for i := range blueprint.ClusterClass.Spec.Patches {
@@ -102,14 +109,14 @@ func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, d
 			definitionFrom = clusterv1.VariableDefinitionFromInline
 		}
 		if err := addVariablesForPatch(blueprint, desired, req, definitionFrom, controlPlaneContractVersion); err != nil {
-			return errors.Wrapf(err, "failed to calculate variables for patch %q", clusterClassPatch.Name)
+			return appliedPatches, errors.Wrapf(err, "failed to calculate variables for patch %q", clusterClassPatch.Name)
 		}
 		log.V(5).Info("Applying patch to templates")
 
 		// Create patch generator for the current patch.
 		generator, err := createPatchGenerator(e.runtimeClient, &clusterClassPatch)
 		if err != nil {
-			return err
+			return appliedPatches, err
 		}
 
 		// Generate patches.
@@ -118,12 +125,20 @@ func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, d
 		// version of the request (including the patched version of the templates).
 		resp, err := generator.Generate(ctx, desired.Cluster, req)
 		if err != nil {
-			return errors.Wrapf(err, "failed to generate patches for patch %q", clusterClassPatch.Name)
+			return appliedPatches, errors.Wrapf(err, "failed to generate patches for patch %q", clusterClassPatch.Name)
 		}
 
 		// Apply patches to the request.
 		if err := applyPatchesToRequest(ctx, req, resp); err != nil {
-			return errors.Wrapf(err, "failed to apply patches for patch %q", clusterClassPatch.Name)
+			return appliedPatches, errors.Wrapf(err, "failed to apply patches for patch %q", clusterClassPatch.Name)
+		}
+
+		if clusterClassPatch.External != nil {
+			appliedPatches = append(appliedPatches, clusterv1.ClusterTopologyAppliedPatch{
+				Name:                     clusterClassPatch.Name,
+				GeneratePatchesExtension: clusterClassPatch.External.GeneratePatchesExtension,
+				AppliedPatches:           ptr.To(int32(len(resp.Items))),
+			})
 		}
 	}
 
@@ -147,20 +162,44 @@ func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, d
 		validator := external.NewValidator(e.runtimeClient, &clusterClassPatch)
 
 		_, err := validator.Validate(ctx, desired.Cluster, validationRequest)
+		appliedPatches = setValidationResult(appliedPatches, clusterClassPatch, err)
 		if err != nil {
-			return errors.Wrapf(err, "validation of patch %q failed", clusterClassPatch.Name)
+			return appliedPatches, errors.Wrapf(err, "validation of patch %q failed", clusterClassPatch.Name)
 		}
 	}
 
 	// Use patched templates to update the desired state objects.
 	log.V(5).Info("Applying patched templates to desired state")
 	if err := updateDesiredState(ctx, req, blueprint, desired, controlPlaneContractVersion); err != nil {
-		return errors.Wrapf(err, "failed to apply patches to desired state")
+		return appliedPatches, errors.Wrapf(err, "failed to apply patches to desired state")
 	}
 
-	return nil
+	return appliedPatches, nil
 }
 
+// setValidationResult records the outcome of the ValidateTopology extension of a patch.
+func setValidationResult(appliedPatches []clusterv1.ClusterTopologyAppliedPatch, patch clusterv1.ClusterClassPatch, err error) []clusterv1.ClusterTopologyAppliedPatch {
+	i := slices.IndexFunc(appliedPatches, func(p clusterv1.ClusterTopologyAppliedPatch) bool { return p.Name == patch.Name })
+	if i < 0 {
+		appliedPatches = append(appliedPatches, clusterv1.ClusterTopologyAppliedPatch{Name: patch.Name})
+		i = len(appliedPatches) - 1
+	}
+
+	appliedPatches[i].ValidateTopologyExtension = patch.External.ValidateTopologyExtension
+	appliedPatches[i].ValidationResult = clusterv1.ClusterTopologyPatchValidationSucceeded
+	if err != nil {
+		appliedPatches[i].ValidationResult = clusterv1.ClusterTopologyPatchValidationFailed
+		appliedPatches[i].ValidationMessage = err.Error()
+		if len(appliedPatches[i].ValidationMessage) > validationMessageMaxLength {
+			appliedPatches[i].ValidationMessage = appliedPatches[i].ValidationMessage[:validationMessageMaxLength-3] + "..."
+		}
+	}
+	return appliedPatches
+}
+
+// validationMessageMaxLength is the maximum length of the validation message in the Cluster status.
+const validationMessageMaxLength = 10240
+
 // addVariablesForPatch adds variables for a given ClusterClassPatch to the items in the PatchRequest.
 func addVariablesForPatch(blueprint *scope.ClusterBlueprint, desired *scope.ClusterState, req *runtimehooksv1.GeneratePatchesRequest, definitionFrom, controlPlaneContractVersion string) error {
 	// If there is no definitionFrom return an error.
@@ -313,7 +352,9 @@ func createRequest(blueprint *scope.ClusterBlueprint, desired *scope.ClusterStat
 	// MachineDeploymentClasses in the ClusterClass because each MachineDeployment in a topology
 	// has its own state, e.g. version or replicas. This state is used to calculate builtin variables,
 	// which can then be used e.g. to compute the machine image for a specific Kubernetes version.
-	for mdTopologyName, md := range desired.MachineDeployments {
+	// NOTE: MachineDeployments are iterated in a stable order, so the request is the same across reconciles.
+	for _, mdTopologyName := range slices.Sorted(maps.Keys(desired.MachineDeployments)) {
+		md := desired.MachineDeployments[mdTopologyName]
 		// Lookup MachineDeploymentTopology definition from cluster.spec.topology.
 		mdTopology, err := lookupMDTopology(blueprint.Topology, mdTopologyName)
 		if err != nil {
@@ -353,7 +394,9 @@ func createRequest(blueprint *scope.ClusterBlueprint, desired *scope.ClusterStat
 	// MachinePoolClasses in the ClusterClass because each MachinePool in a topology
 	// has its own state, e.g. version or replicas. This state is used to calculate builtin variables,
 	// which can then be used e.g. to compute the machine image for a specific Kubernetes version.
-	for mpTopologyName, mp := range desired.MachinePools {
+	// NOTE: MachinePools are iterated in a stable order, so the request is the same across reconciles.
+	for _, mpTopologyName := range slices.Sorted(maps.Keys(desired.MachinePools)) {
+		mp := desired.MachinePools[mpTopologyName]
 		// Lookup MachinePoolTopology definition from cluster.spec.topology.
 		mpTopology, err := lookupMPTopology(blueprint.Topology, mpTopologyName)
 		if err != nil {
@@ -583,7 +626,8 @@ func updateDesiredState(ctx context.Context, req *runtimehooksv1.GeneratePatches
 	}
 
 	// Update the templates for all MachineDeployments.
-	for mdTopologyName, md := range desired.MachineDeployments {
+	for _, mdTopologyName := range slices.Sorted(maps.Keys(desired.MachineDeployments)) {
+		md := desired.MachineDeployments[mdTopologyName]
 		topologyName := requestTopologyName{mdTopologyName: mdTopologyName}
 		// Update the BootstrapConfigTemplate.
 		bootstrapTemplate, err := getTemplateAsUnstructured(req, "MachineDeployment", "spec.template.spec.bootstrap.configRef", topologyName)
@@ -605,7 +649,8 @@ func updateDesiredState(ctx context.Context, req *runtimehooksv1.GeneratePatches
 	}
 
 	// Update the templates for all MachinePools.
-	for mpTopologyName, mp := range desired.MachinePools {
+	for _, mpTopologyName := range slices.Sorted(maps.Keys(desired.MachinePools)) {
+		mp := desired.MachinePools[mpTopologyName]
 		topologyName := requestTopologyName{mpTopologyName: mpTopologyName}
 		// Update the BootstrapConfig.
 		bootstrapTemplate, err := getTemplateAsUnstructured(req, "MachinePool", "spec.template.spec.bootstrap.configRef", topologyName)
@@ -62,6 +62,7 @@ func TestApply(t *testing.T) {
 		varDefinitions         []clusterv1.ClusterClassStatusVariable
 		externalPatchResponses map[string]runtimehooksv1.ResponseObject
 		expectedFields         expectedFields
+		expectedAppliedPatches []clusterv1.ClusterTopologyAppliedPatch
 		wantErr                bool
 	}{
 		{
@@ -442,6 +443,15 @@ func TestApply(t *testing.T) {
 					"spec.resource": "infraCluster",
 				},
 			},
+			expectedAppliedPatches: []clusterv1.ClusterTopologyAppliedPatch{
+				{
+					Name:                      "fake-patch1",
+					GeneratePatchesExtension:  "patch-infrastructureCluster",
+					AppliedPatches:            ptr.To[int32](1),
+					ValidateTopologyExtension: "validate-infrastructureCluster",
+					ValidationResult:          clusterv1.ClusterTopologyPatchValidationSucceeded,
+				},
+			},
 		},
 		{
 			name: "error on failed validation with external jsonPatch",
@@ -478,6 +488,16 @@ func TestApply(t *testing.T) {
 					},
 				},
 			},
+			expectedAppliedPatches: []clusterv1.ClusterTopologyAppliedPatch{
+				{
+					Name:                      "fake-patch1",
+					GeneratePatchesExtension:  "patch-infrastructureCluster",
+					AppliedPatches:            ptr.To[int32](1),
+					ValidateTopologyExtension: "validate-infrastructureCluster",
+					ValidationResult:          clusterv1.ClusterTopologyPatchValidationFailed,
+					ValidationMessage:         "ExtensionHandler validate-infrastructureCluster failed with message not a valid infrastructureCluster",
+				},
+			},
 			wantErr: true,
 		},
 		{
@@ -545,6 +565,18 @@ func TestApply(t *testing.T) {
 					"spec.resource": "controlPlane",
 				},
 			},
+			expectedAppliedPatches: []clusterv1.ClusterTopologyAppliedPatch{
+				{
+					Name:                     "fake-patch1",
+					GeneratePatchesExtension: "patch-infrastructureCluster",
+					AppliedPatches:           ptr.To[int32](2),
+				},
+				{
+					Name:                     "fake-patch2",
+					GeneratePatchesExtension: "patch-controlPlane",
+					AppliedPatches:           ptr.To[int32](1),
+				},
+			},
 		},
 		{
 			name: "Should correctly apply patches with builtin variables",
@@ -1052,7 +1084,9 @@ func TestApply(t *testing.T) {
 				}
 
 				// Apply patches.
-				if err := patchEngine.Apply(context.Background(), blueprint, desired); err != nil {
+				appliedPatches, err := patchEngine.Apply(context.Background(), blueprint, desired)
+				g.Expect(appliedPatches).To(BeComparableTo(tt.expectedAppliedPatches))
+				if err != nil {
 					if !tt.wantErr {
 						t.Fatal(err)
 					}
@@ -1081,6 +1115,36 @@ func TestApply(t *testing.T) {
 	}
 }
 
+func TestCreateRequestIsDeterministic(t *testing.T) {
+	g := NewWithT(t)
+
+	// Replace the package variable uuidGenerator with one that returns an incremented integer,
+	// so that requests computed in different runs can be compared.
+	defer func(generator func() types.UID) { uuidGenerator = generator }(uuidGenerator)
+	var uuid int32
+	uuidGenerator = func() types.UID {
+		uuid++
+		return types.UID(fmt.Sprintf("%d", uuid))
+	}
+
+	var want []byte
+	for range 20 {
+		uuid = 0
+		blueprint, desired := setupTestObjects()
+
+		req, err := createRequest(blueprint, desired, "v1beta2")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		got, err := json.Marshal(req)
+		g.Expect(err).ToNot(HaveOccurred())
+		if want == nil {
+			want = got
+			continue
+		}
+		g.Expect(string(got)).To(Equal(string(want)), "GeneratePatchesRequest must be the same across runs")
+	}
+}
+
 func setupTestObjects() (*scope.ClusterBlueprint, *scope.ClusterState) {
 	infrastructureClusterTemplate := builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infraClusterTemplate1").
 		Build()
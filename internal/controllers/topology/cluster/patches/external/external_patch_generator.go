@@ -30,6 +30,10 @@ import (
 	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/patches/api"
 )
 
+// maxGeneratePatchesRequestSizeBytes is the maximum size of a GeneratePatches request; larger requests are
+// sent to the extension in multiple partial requests, each with a subset of the templates.
+const maxGeneratePatchesRequestSizeBytes = 3 * 1024 * 1024
+
 // externalPatchGenerator generates JSON patches for a GeneratePatchesRequest based on a ClusterClassPatch.
 type externalPatchGenerator struct {
 	runtimeClient runtimeclient.Client
@@ -59,7 +63,8 @@ func (e externalPatchGenerator) Generate(ctx context.Context, forObject client.O
 	}()
 
 	resp := &runtimehooksv1.GeneratePatchesResponse{}
-	err := e.runtimeClient.CallExtension(ctx, runtimehooksv1.GeneratePatches, forObject, e.patch.External.GeneratePatchesExtension, req, resp)
+	err := e.runtimeClient.CallExtension(ctx, runtimehooksv1.GeneratePatches, forObject, e.patch.External.GeneratePatchesExtension, req, resp,
+		runtimeclient.WithPartialRequests{MaxRequestSizeBytes: maxGeneratePatchesRequestSizeBytes})
 	if err != nil {
 		return nil, err
 	}
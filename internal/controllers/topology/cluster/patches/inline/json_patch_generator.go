@@ -26,6 +26,7 @@ import (
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
+	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/pkg/errors"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -39,6 +40,7 @@ import (
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/patches/api"
 	patchvariables "sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/patches/variables"
+	"sigs.k8s.io/cluster-api/internal/topology/patchpath"
 )
 
 // jsonPatchGenerator generates JSON patches for a GeneratePatchesRequest based on a ClusterClassPatch.
@@ -99,6 +101,17 @@ func (j *jsonPatchGenerator) Generate(_ context.Context, _ client.Object, req *r
 		}
 
 		// Loop over all PatchDefinitions.
+		// NOTE: List item selectors are resolved against the template including the changes
+		// from the previous JSON patches, so they match the template at the time the JSON patch is applied.
+		var object []byte
+		resolveSelectors := hasListItemSelectors(matchingPatches)
+		if resolveSelectors {
+			object, err = requestItemObjectJSON(item)
+			if err != nil {
+				errs = append(errs, errors.Wrapf(err, "failed to resolve list item selectors in JSON patches for %q", objectKind))
+				continue
+			}
+		}
 		for _, patch := range matchingPatches {
 			// Generate JSON patches.
 			jsonPatches, err := generateJSONPatches(patch.JSONPatches, variables)
@@ -107,6 +120,15 @@ func (j *jsonPatchGenerator) Generate(_ context.Context, _ client.Object, req *r
 				continue
 			}
 
+			// Resolve list item selectors.
+			if resolveSelectors {
+				jsonPatches, object, err = resolveListItemSelectors(jsonPatches, object)
+				if err != nil {
+					errs = append(errs, errors.Wrapf(err, "failed to resolve list item selectors in JSON patches for %q", objectKind))
+					continue
+				}
+			}
+
 			// Add jsonPatches to the response.
 			resp.Items = append(resp.Items, runtimehooksv1.GeneratePatchesResponseItem{
 				UID:       item.UID,
@@ -278,6 +300,69 @@ func generateJSONPatches(jsonPatches []clusterv1.JSONPatch, variables map[string
 	return resJSON, nil
 }
 
+// hasListItemSelectors returns true if at least one of the JSON patches of the given PatchDefinitions
+// uses list item selectors in its path.
+func hasListItemSelectors(patches []clusterv1.PatchDefinition) bool {
+	for _, patch := range patches {
+		for _, jsonPatch := range patch.JSONPatches {
+			if patchpath.HasListItemSelectors(jsonPatch.Path) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// requestItemObjectJSON returns the JSON representation of the template of a GeneratePatchesRequestItem.
+func requestItemObjectJSON(item *runtimehooksv1.GeneratePatchesRequestItem) ([]byte, error) {
+	if len(item.Object.Raw) > 0 || item.Object.Object == nil {
+		return item.Object.Raw, nil
+	}
+	object, err := json.Marshal(item.Object.Object)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal template")
+	}
+	return object, nil
+}
+
+// resolveListItemSelectors replaces list item selectors in the paths of the given JSON patches
+// with the index of the corresponding list items in object.
+// JSON patches are applied one by one to object, so list item selectors in subsequent JSON patches
+// are resolved against the patched object; the patched object is returned together with the resolved JSON patches.
+func resolveListItemSelectors(jsonPatches, object []byte) ([]byte, []byte, error) {
+	patches := []jsonPatchRFC6902{}
+	if err := json.Unmarshal(jsonPatches, &patches); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to unmarshal JSON patches")
+	}
+
+	for i := range patches {
+		path, err := patchpath.Resolve(patches[i].Path, object)
+		if err != nil {
+			return nil, nil, err
+		}
+		patches[i].Path = path
+
+		patchJSON, err := json.Marshal([]jsonPatchRFC6902{patches[i]})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to marshal JSON patch")
+		}
+		patch, err := jsonpatch.DecodePatch(patchJSON)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to decode JSON patch")
+		}
+		object, err = patch.Apply(object)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to apply JSON patch with path %q", path)
+		}
+	}
+
+	resolvedJSONPatches, err := json.Marshal(patches)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to marshal JSON patches")
+	}
+	return resolvedJSONPatches, object, nil
+}
+
 // calculateValue calculates a value for a JSON patch.
 func calculateValue(patch clusterv1.JSONPatch, variables map[string]apiextensionsv1.JSON) (*apiextensionsv1.JSON, error) {
 	// Return if values are set incorrectly.
@@ -403,6 +403,116 @@ func TestGenerate(t *testing.T) {
 						Patch: toJSONCompact(`[
 {"op":"replace","path":"/spec/template/spec/joinConfiguration/nodeRegistration/kubeletExtraArgs/cluster-name","value":"cluster-name"},
 {"op":"replace","path":"/spec/template/spec/files","value":[{"contentFrom":{"secret":{"key":"worker-node-azure.json","name":"cluster-name-mp-0-azure-json"}},"owner":"root:root"}]}
+]`),
+						PatchType: runtimehooksv1.JSONPatchType,
+					},
+				},
+			},
+		},
+		{
+			name: "Should resolve list item selectors",
+			patch: &clusterv1.ClusterClassPatch{
+				Name: "files",
+				Definitions: []clusterv1.PatchDefinition{
+					{
+						Selector: clusterv1.PatchSelector{
+							APIVersion: clusterv1.GroupVersionBootstrap.String(),
+							Kind:       "BootstrapTemplate",
+							MatchResources: clusterv1.PatchSelectorMatch{
+								MachineDeploymentClass: &clusterv1.PatchSelectorMatchMachineDeploymentClass{
+									Names: []string{"default-worker"},
+								},
+							},
+						},
+						JSONPatches: []clusterv1.JSONPatch{
+							// prepend an item, so the index of the existing items changes.
+							{
+								Op:    "add",
+								Path:  "/spec/template/spec/files/0",
+								Value: &apiextensionsv1.JSON{Raw: []byte(`{"path":"/etc/new","content":"new"}`)},
+							},
+							{
+								Op:    "replace",
+								Path:  "/spec/template/spec/files/[path=/etc/b]/content",
+								Value: &apiextensionsv1.JSON{Raw: []byte(`"patched"`)},
+							},
+						},
+					},
+					{
+						Selector: clusterv1.PatchSelector{
+							APIVersion: clusterv1.GroupVersionBootstrap.String(),
+							Kind:       "BootstrapTemplate",
+							MatchResources: clusterv1.PatchSelectorMatch{
+								MachineDeploymentClass: &clusterv1.PatchSelectorMatchMachineDeploymentClass{
+									Names: []string{"default-worker"},
+								},
+							},
+						},
+						JSONPatches: []clusterv1.JSONPatch{
+							{
+								Op:   "remove",
+								Path: "/spec/template/spec/users/[name=bar]",
+							},
+						},
+					},
+				},
+			},
+			req: &runtimehooksv1.GeneratePatchesRequest{
+				Items: []runtimehooksv1.GeneratePatchesRequestItem{
+					{
+						UID: "1",
+						HolderReference: runtimehooksv1.HolderReference{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "MachineDeployment",
+							Name:       "my-md-0",
+							Namespace:  "default",
+							FieldPath:  "spec.template.spec.bootstrap.configRef",
+						},
+						Variables: []runtimehooksv1.Variable{
+							{
+								Name:  "builtin",
+								Value: apiextensionsv1.JSON{Raw: []byte(`{"machineDeployment":{"class":"default-worker"}}`)},
+							},
+						},
+						Object: runtime.RawExtension{
+							Object: &unstructured.Unstructured{
+								Object: map[string]interface{}{
+									"apiVersion": clusterv1.GroupVersionBootstrap.String(),
+									"kind":       "BootstrapTemplate",
+									"spec": map[string]interface{}{
+										"template": map[string]interface{}{
+											"spec": map[string]interface{}{
+												"files": []interface{}{
+													map[string]interface{}{"path": "/etc/a", "content": "a"},
+													map[string]interface{}{"path": "/etc/b", "content": "b"},
+												},
+												"users": []interface{}{
+													map[string]interface{}{"name": "foo"},
+													map[string]interface{}{"name": "bar"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: &runtimehooksv1.GeneratePatchesResponse{
+				Items: []runtimehooksv1.GeneratePatchesResponseItem{
+					{
+						UID: "1",
+						Patch: toJSONCompact(`[
+{"op":"add","path":"/spec/template/spec/files/0","value":{"path":"/etc/new","content":"new"}},
+{"op":"replace","path":"/spec/template/spec/files/2/content","value":"patched"}
+]`),
+						PatchType: runtimehooksv1.JSONPatchType,
+					},
+					{
+						UID: "1",
+						Patch: toJSONCompact(`[
+{"op":"remove","path":"/spec/template/spec/users/1"}
 ]`),
 						PatchType: runtimehooksv1.JSONPatchType,
 					},
@@ -2264,6 +2374,24 @@ owner: root:root
 			},
 			want: &apiextensionsv1.JSON{Raw: []byte(`"configValue2"`)},
 		},
+		// Compose a list from per-failure-domain fragments.
+		{
+			name: "Should render an array with an item per failure domain (builtin.cluster.topology.failureDomains)",
+			template: `
+[
+{{- range $i, $failureDomain := .builtin.cluster.topology.failureDomains }}
+  {"availabilityZone": "{{ $failureDomain }}", "cidrBlock": "10.0.{{ $i }}.0/24", "name": "{{ $.builtin.cluster.name }}-{{ $failureDomain }}"},
+{{- end }}
+]
+`,
+			variables: map[string]apiextensionsv1.JSON{
+				runtimehooksv1.BuiltinsName: {Raw: []byte(`{"cluster":{"name":"cluster1","topology":{"version":"v1.21.1","failureDomains":["us-east-1a","us-east-1b"]}}}`)},
+			},
+			want: &apiextensionsv1.JSON{Raw: []byte(`[
+{"availabilityZone":"us-east-1a","cidrBlock":"10.0.0.0/24","name":"cluster1-us-east-1a"},
+{"availabilityZone":"us-east-1b","cidrBlock":"10.0.1.0/24","name":"cluster1-us-east-1b"}
+]`)},
+		},
 	}
 
 	for _, tt := range tests {
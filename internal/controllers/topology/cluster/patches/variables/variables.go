@@ -65,6 +65,7 @@ func Global(clusterTopology clusterv1.Topology, cluster *clusterv1.Cluster, patc
 					Name:      cluster.GetClassKey().Name,
 					Namespace: cluster.GetClassKey().Namespace,
 				},
+				FailureDomains: cluster.Spec.Topology.FailureDomains,
 			},
 		},
 	}
@@ -92,6 +93,23 @@ func Global(clusterTopology clusterv1.Topology, cluster *clusterv1.Cluster, patc
 		}
 		builtin.Cluster.Network.Pods = cluster.Spec.ClusterNetwork.Pods.CIDRBlocks
 	}
+	// NOTE: Failure domains are surfaced in the Cluster status after the InfrastructureCluster has been provisioned,
+	// so this variable is not set when computing the templates used to create the Cluster.
+	for _, failureDomain := range cluster.Status.FailureDomains {
+		builtin.Cluster.FailureDomains = append(builtin.Cluster.FailureDomains, runtimehooksv1.ClusterFailureDomainBuiltins{
+			Name:         failureDomain.Name,
+			ControlPlane: failureDomain.ControlPlane,
+			Attributes:   failureDomain.Attributes,
+		})
+	}
+	// NOTE: The control plane endpoint is either set by the user, e.g. when using a virtual IP announced by kube-vip,
+	// or by the InfrastructureCluster before the control plane is created.
+	if cluster.Spec.ControlPlaneEndpoint.Host != "" {
+		builtin.Cluster.ControlPlaneEndpoint = &runtimehooksv1.ClusterControlPlaneEndpointBuiltins{
+			Host: cluster.Spec.ControlPlaneEndpoint.Host,
+			Port: cluster.Spec.ControlPlaneEndpoint.Port,
+		}
+	}
 
 	// Add builtin variables derived from the cluster object.
 	variable, err := toVariable(runtimehooksv1.BuiltinsName, builtin)
@@ -424,6 +424,152 @@ func TestGlobal(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:                        "Should calculate global variables with failure domains",
+			variableDefinitionsForPatch: map[string]bool{},
+			clusterTopology:             clusterv1.Topology{},
+			cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster1",
+					Namespace: metav1.NamespaceDefault,
+					UID:       types.UID(clusterUID),
+				},
+				Spec: clusterv1.ClusterSpec{
+					Topology: clusterv1.Topology{
+						ClassRef: clusterv1.ClusterClassRef{
+							Name: "clusterClass1",
+						},
+						Version: "v1.21.1",
+					},
+				},
+				Status: clusterv1.ClusterStatus{
+					FailureDomains: []clusterv1.FailureDomain{
+						{
+							Name:         "fd1",
+							ControlPlane: ptr.To(true),
+							Attributes:   map[string]string{"zone": "us-central-1a"},
+						},
+						{
+							Name: "fd2",
+						},
+					},
+				},
+			},
+			want: []runtimehooksv1.Variable{
+				{
+					Name: runtimehooksv1.BuiltinsName,
+					Value: toJSONCompact(`{
+					"cluster":{
+  						"name": "cluster1",
+  						"namespace": "default",
+						"uid": "8a35f406-6b9b-4b78-8c93-a7f878d90623",
+  						"topology":{
+							"version": "v1.21.1",
+							"classRef": {
+								"name": "clusterClass1",
+								"namespace": "default"
+							},
+							"class": "clusterClass1",
+							"classNamespace": "default"
+						},
+						"failureDomains": [
+							{"name": "fd1", "controlPlane": true, "attributes": {"zone": "us-central-1a"}},
+							{"name": "fd2"}
+						]
+					}}`),
+				},
+			},
+		},
+		{
+			name:                        "Should calculate global variables with the control plane endpoint",
+			variableDefinitionsForPatch: map[string]bool{},
+			clusterTopology:             clusterv1.Topology{},
+			cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster1",
+					Namespace: metav1.NamespaceDefault,
+					UID:       types.UID(clusterUID),
+				},
+				Spec: clusterv1.ClusterSpec{
+					ControlPlaneEndpoint: clusterv1.APIEndpoint{
+						Host: "10.0.0.100",
+						Port: 6443,
+					},
+					Topology: clusterv1.Topology{
+						ClassRef: clusterv1.ClusterClassRef{
+							Name: "clusterClass1",
+						},
+						Version: "v1.21.1",
+					},
+				},
+			},
+			want: []runtimehooksv1.Variable{
+				{
+					Name: runtimehooksv1.BuiltinsName,
+					Value: toJSONCompact(`{
+					"cluster":{
+  						"name": "cluster1",
+  						"namespace": "default",
+						"uid": "8a35f406-6b9b-4b78-8c93-a7f878d90623",
+  						"topology":{
+							"version": "v1.21.1",
+							"classRef": {
+								"name": "clusterClass1",
+								"namespace": "default"
+							},
+							"class": "clusterClass1",
+							"classNamespace": "default"
+						},
+						"controlPlaneEndpoint": {
+							"host": "10.0.0.100",
+							"port": 6443
+						}
+					}}`),
+				},
+			},
+		},
+		{
+			name:                        "Should calculate global variables with desired failure domains",
+			variableDefinitionsForPatch: map[string]bool{},
+			clusterTopology:             clusterv1.Topology{},
+			cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster1",
+					Namespace: metav1.NamespaceDefault,
+					UID:       types.UID(clusterUID),
+				},
+				Spec: clusterv1.ClusterSpec{
+					Topology: clusterv1.Topology{
+						ClassRef: clusterv1.ClusterClassRef{
+							Name: "clusterClass1",
+						},
+						Version:        "v1.21.1",
+						FailureDomains: []string{"us-central-1a", "us-central-1b"},
+					},
+				},
+			},
+			want: []runtimehooksv1.Variable{
+				{
+					Name: runtimehooksv1.BuiltinsName,
+					Value: toJSONCompact(`{
+					"cluster":{
+  						"name": "cluster1",
+  						"namespace": "default",
+						"uid": "8a35f406-6b9b-4b78-8c93-a7f878d90623",
+  						"topology":{
+							"version": "v1.21.1",
+							"classRef": {
+								"name": "clusterClass1",
+								"namespace": "default"
+							},
+							"class": "clusterClass1",
+							"classNamespace": "default",
+							"failureDomains": ["us-central-1a", "us-central-1b"]
+						}
+					}}`),
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -19,6 +19,7 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -29,7 +30,6 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
-	"k8s.io/apiserver/pkg/storage/names"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -370,7 +370,7 @@ func (r *Reconciler) reconcileControlPlane(ctx context.Context, s *scope.Scope)
 			current:              s.Current.ControlPlane.InfrastructureMachineTemplate,
 			desired:              s.Desired.ControlPlane.InfrastructureMachineTemplate,
 			compatibilityChecker: check.ObjectsAreCompatible,
-			templateNamePrefix:   topologynames.ControlPlaneInfrastructureMachineTemplateNamePrefix(s.Current.Cluster.Name),
+			nameGenerator:        topologynames.ControlPlaneInfrastructureMachineTemplateNameGenerator(s.Blueprint.ClusterClass.Spec.ControlPlane.Naming.MachineInfrastructureTemplate, s.Current.Cluster.Name),
 		})
 		if err != nil {
 			return false, err
@@ -732,6 +732,7 @@ func (r *Reconciler) updateMachineDeployment(ctx context.Context, s *scope.Scope
 	}
 
 	cluster := s.Current.Cluster
+	mdNaming := machineDeploymentClassNaming(s, mdTopologyName)
 	infraLog := log.WithValues(desiredMD.InfrastructureMachineTemplate.GetKind(), klog.KObj(desiredMD.InfrastructureMachineTemplate))
 	infraCtx := ctrl.LoggerInto(ctx, infraLog)
 	infrastructureMachineCleanupFunc := func() {}
@@ -740,7 +741,7 @@ func (r *Reconciler) updateMachineDeployment(ctx context.Context, s *scope.Scope
 		ref:                  &desiredMD.Object.Spec.Template.Spec.InfrastructureRef,
 		current:              currentMD.InfrastructureMachineTemplate,
 		desired:              desiredMD.InfrastructureMachineTemplate,
-		templateNamePrefix:   topologynames.InfrastructureMachineTemplateNamePrefix(cluster.Name, mdTopologyName),
+		nameGenerator:        topologynames.InfrastructureMachineTemplateNameGenerator(mdNaming.InfrastructureTemplate, cluster.Name, mdTopologyName),
 		compatibilityChecker: check.ObjectsAreCompatible,
 	})
 	if err != nil {
@@ -765,7 +766,7 @@ func (r *Reconciler) updateMachineDeployment(ctx context.Context, s *scope.Scope
 		ref:                  &desiredMD.Object.Spec.Template.Spec.Bootstrap.ConfigRef,
 		current:              currentMD.BootstrapTemplate,
 		desired:              desiredMD.BootstrapTemplate,
-		templateNamePrefix:   topologynames.BootstrapTemplateNamePrefix(cluster.Name, mdTopologyName),
+		nameGenerator:        topologynames.BootstrapTemplateNameGenerator(mdNaming.BootstrapTemplate, cluster.Name, mdTopologyName),
 		compatibilityChecker: check.ObjectsAreInTheSameNamespace,
 	})
 	if err != nil {
@@ -1133,6 +1134,11 @@ func calculateMachineDeploymentDiff(current, desired map[string]*scope.MachineDe
 		}
 	}
 
+	// Sort the names so MachineDeployments are always processed in the same order.
+	slices.Sort(diff.toCreate)
+	slices.Sort(diff.toUpdate)
+	slices.Sort(diff.toDelete)
+
 	return diff
 }
 
@@ -1155,6 +1161,11 @@ func calculateMachinePoolDiff(current, desired map[string]*scope.MachinePoolStat
 		}
 	}
 
+	// Sort the names so MachinePools are always processed in the same order.
+	slices.Sort(diff.toCreate)
+	slices.Sort(diff.toUpdate)
+	slices.Sort(diff.toDelete)
+
 	return diff
 }
 
@@ -1240,12 +1251,28 @@ func logUnstructuredVersionChange(current, desired *unstructured.Unstructured, v
 	return ""
 }
 
+// machineDeploymentClassNaming returns the naming strategy of the MachineDeploymentClass used by the
+// MachineDeployment topology with the given name.
+func machineDeploymentClassNaming(s *scope.Scope, mdTopologyName string) clusterv1.MachineDeploymentClassNamingSpec {
+	for _, mdTopology := range s.Blueprint.Topology.Workers.MachineDeployments {
+		if mdTopology.Name != mdTopologyName {
+			continue
+		}
+		for _, mdClass := range s.Blueprint.ClusterClass.Spec.Workers.MachineDeployments {
+			if mdClass.Class == mdTopology.Class {
+				return mdClass.Naming
+			}
+		}
+	}
+	return clusterv1.MachineDeploymentClassNamingSpec{}
+}
+
 type reconcileReferencedTemplateInput struct {
 	cluster              *clusterv1.Cluster
 	ref                  *clusterv1.ContractVersionedObjectReference
 	current              *unstructured.Unstructured
 	desired              *unstructured.Unstructured
-	templateNamePrefix   string
+	nameGenerator        topologynames.NameGenerator
 	compatibilityChecker func(current, desired client.Object) field.ErrorList
 }
 
@@ -1319,7 +1346,10 @@ func (r *Reconciler) reconcileReferencedTemplate(ctx context.Context, in reconci
 
 	// NOTE: it is required to assign a new name, because during compute the desired object name is enforced to be equal to the current one.
 	// TODO: find a way to make side effect more explicit
-	newName := names.SimpleNameGenerator.GenerateName(in.templateNamePrefix)
+	newName, err := in.nameGenerator.GenerateName()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to generate name for %s", in.desired.GetKind())
+	}
 	in.desired.SetName(newName)
 
 	changes := patchHelper.Changes()
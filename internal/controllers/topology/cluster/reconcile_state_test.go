@@ -3012,6 +3012,42 @@ func TestReconcileMachinePoolsCleanup(t *testing.T) {
 	})
 }
 
+func TestCalculateMachineDeploymentAndMachinePoolDiff(t *testing.T) {
+	g := NewWithT(t)
+
+	mdStates := func(names ...string) map[string]*scope.MachineDeploymentState {
+		states := map[string]*scope.MachineDeploymentState{}
+		for _, name := range names {
+			states[name] = &scope.MachineDeploymentState{}
+		}
+		return states
+	}
+	mpStates := func(names ...string) map[string]*scope.MachinePoolState {
+		states := map[string]*scope.MachinePoolState{}
+		for _, name := range names {
+			states[name] = &scope.MachinePoolState{}
+		}
+		return states
+	}
+	want := machineDiff{
+		toCreate: []string{"a-create", "b-create", "c-create", "d-create"},
+		toUpdate: []string{"a-update", "b-update", "c-update", "d-update"},
+		toDelete: []string{"a-delete", "b-delete", "c-delete", "d-delete"},
+	}
+
+	// Run the diff multiple times to verify names are always returned in the same order.
+	for range 20 {
+		g.Expect(calculateMachineDeploymentDiff(
+			mdStates("d-update", "c-update", "b-update", "a-update", "d-delete", "c-delete", "b-delete", "a-delete"),
+			mdStates("d-update", "c-update", "b-update", "a-update", "d-create", "c-create", "b-create", "a-create"),
+		)).To(Equal(want))
+		g.Expect(calculateMachinePoolDiff(
+			mpStates("d-update", "c-update", "b-update", "a-update", "d-delete", "c-delete", "b-delete", "a-delete"),
+			mpStates("d-update", "c-update", "b-update", "a-update", "d-create", "c-create", "b-create", "a-create"),
+		)).To(Equal(want))
+	}
+}
+
 // TestReconcileReferencedObjectSequences tests multiple subsequent calls to reconcileReferencedObject
 // for a control-plane object to verify that the objects are reconciled as expected by tracking managed fields correctly.
 // NOTE: by Extension this tests validates managed field handling in mergePatches, and thus its usage in other parts of the
@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/cluster-api/controllers/external"
 )
@@ -33,9 +34,17 @@ func (r *Reconciler) getReference(ctx context.Context, ref *corev1.ObjectReferen
 		return nil, errors.New("reference is not set")
 	}
 
-	obj, err := external.Get(ctx, r.Client, ref)
+	obj, err := external.Get(ctx, r.templateReader(), ref)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to retrieve %s %s", ref.Kind, klog.KRef(ref.Namespace, ref.Name))
 	}
 	return obj, nil
 }
+
+// templateReader returns the reader to be used to read ClusterClasses and templates.
+func (r *Reconciler) templateReader() client.Reader {
+	if r.TemplateReader != nil {
+		return r.TemplateReader
+	}
+	return r.Client
+}
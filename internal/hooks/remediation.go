@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
+)
+
+// CallBeforeMachineRemediationHook calls the BeforeMachineRemediation hook for a Machine that is going to be remediated
+// with the given action. If no extensions are registered for the hook, an empty response is returned;
+// a response with RetryAfterSeconds > 0 signals that remediation of the Machine is blocked.
+func CallBeforeMachineRemediationHook(ctx context.Context, c runtimeclient.Client, cluster *clusterv1.Cluster, machine *clusterv1.Machine, unhealthyConditions []metav1.Condition, action runtimehooksv1.MachineRemediationAction) (*runtimehooksv1.BeforeMachineRemediationResponse, error) {
+	hookResponse := &runtimehooksv1.BeforeMachineRemediationResponse{}
+
+	// Return quickly if the hook is not defined.
+	extensionHandlers, err := c.GetAllExtensions(ctx, runtimehooksv1.BeforeMachineRemediation, machine)
+	if err != nil {
+		return nil, err
+	}
+	if len(extensionHandlers) == 0 {
+		return hookResponse, nil
+	}
+
+	// Optimize size of the request by not sending managedFields.
+	cluster = cluster.DeepCopy()
+	cluster.SetManagedFields(nil)
+	machine = machine.DeepCopy()
+	machine.SetManagedFields(nil)
+
+	hookRequest := &runtimehooksv1.BeforeMachineRemediationRequest{
+		Cluster:             *cluster,
+		Machine:             *machine,
+		UnhealthyConditions: unhealthyConditions,
+		Action:              action,
+	}
+	if err := c.CallAllExtensions(ctx, runtimehooksv1.BeforeMachineRemediation, machine, hookRequest, hookResponse); err != nil {
+		return nil, err
+	}
+	return hookResponse, nil
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ownerrefs implements consistency checks and repair for the ownerReferences and finalizers
+// of the objects of a Cluster, according to the ownership graph documented in the Cluster API book.
+package ownerrefs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/external"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/labels/format"
+)
+
+// Issue is an inconsistency in the ownerReferences or finalizers of an object.
+type Issue struct {
+	// Kind of the object.
+	Kind string `json:"kind"`
+
+	// Name of the object.
+	Name string `json:"name"`
+
+	// Message describes the inconsistency.
+	Message string `json:"message"`
+
+	// Repairable is true if the inconsistency can be repaired automatically.
+	Repairable bool `json:"repairable"`
+
+	// Repaired is true if the inconsistency has been repaired.
+	Repaired bool `json:"repaired"`
+}
+
+// Checker checks the ownerReferences and finalizers of the objects of a Cluster.
+type Checker struct {
+	Client client.Client
+
+	// Repair defines if the inconsistencies which can be repaired automatically should be repaired.
+	Repair bool
+}
+
+// node is an object of the ownership graph of a Cluster.
+type node struct {
+	apiVersion string
+	uid        types.UID
+}
+
+// graph indexes the objects of a Cluster by GroupKind and name.
+// Owner references to a GroupKind tracked in the graph are expected to point to one of its objects.
+type graph map[schema.GroupKind]map[string]node
+
+func (g graph) add(obj client.Object, apiVersion string, gk schema.GroupKind) {
+	if _, ok := g[gk]; !ok {
+		g[gk] = map[string]node{}
+	}
+	g[gk][obj.GetName()] = node{apiVersion: apiVersion, uid: obj.GetUID()}
+}
+
+// check is an object to be checked with the owners and the finalizer it is expected to have.
+type check struct {
+	obj       client.Object
+	kind      string
+	owners    []metav1.OwnerReference
+	finalizer string
+}
+
+// CheckCluster checks the ownerReferences and finalizers of the Cluster and of the objects belonging to it:
+//   - Owner references to objects of the Cluster must have the UID and apiVersion of the current object;
+//     this is not the case e.g. after a backup has been restored.
+//   - Objects must have the owner references and finalizers set by the Cluster API controllers.
+//
+// Owner references to objects which don't exist and conflicting controller references are reported,
+// but they are never repaired, given that this requires a decision on which object should own the object.
+func (c *Checker) CheckCluster(ctx context.Context, cluster *clusterv1.Cluster) ([]Issue, error) {
+	g := graph{}
+	g.add(cluster, clusterv1.GroupVersion.String(), clusterv1.GroupVersion.WithKind("Cluster").GroupKind())
+	clusterOwner := func(controller bool) metav1.OwnerReference {
+		return ownerRef(clusterv1.GroupVersion.String(), "Cluster", cluster, controller)
+	}
+
+	checks := []check{{obj: cluster, kind: "Cluster", finalizer: clusterv1.ClusterFinalizer}}
+
+	// InfrastructureCluster and ControlPlane are controlled by the Cluster.
+	for _, ref := range []clusterv1.ContractVersionedObjectReference{cluster.Spec.InfrastructureRef, cluster.Spec.ControlPlaneRef} {
+		if !ref.IsDefined() {
+			continue
+		}
+		obj, err := c.getReferencedObject(ctx, ref, cluster.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			continue
+		}
+		g.add(obj, obj.GetAPIVersion(), ref.GroupKind())
+		checks = append(checks, check{obj: obj, kind: ref.Kind, owners: []metav1.OwnerReference{clusterOwner(true)}})
+	}
+
+	listOptions := []client.ListOption{
+		client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name},
+	}
+
+	// MachineDeployments are owned by the Cluster.
+	mdList := &clusterv1.MachineDeploymentList{}
+	if err := c.Client.List(ctx, mdList, listOptions...); err != nil {
+		return nil, errors.Wrapf(err, "failed to list MachineDeployments for Cluster %s", klog.KObj(cluster))
+	}
+	mdGK := clusterv1.GroupVersion.WithKind("MachineDeployment").GroupKind()
+	g[mdGK] = map[string]node{}
+	mds := map[string]*clusterv1.MachineDeployment{}
+	for i := range mdList.Items {
+		md := &mdList.Items[i]
+		g.add(md, clusterv1.GroupVersion.String(), mdGK)
+		mds[md.Name] = md
+		checks = append(checks, check{obj: md, kind: "MachineDeployment", owners: []metav1.OwnerReference{clusterOwner(false)}, finalizer: clusterv1.MachineDeploymentFinalizer})
+	}
+
+	// MachineSets are controlled by their MachineDeployment; stand-alone MachineSets are owned by the Cluster.
+	msList := &clusterv1.MachineSetList{}
+	if err := c.Client.List(ctx, msList, listOptions...); err != nil {
+		return nil, errors.Wrapf(err, "failed to list MachineSets for Cluster %s", klog.KObj(cluster))
+	}
+	msGK := clusterv1.GroupVersion.WithKind("MachineSet").GroupKind()
+	g[msGK] = map[string]node{}
+	for i := range msList.Items {
+		ms := &msList.Items[i]
+		g.add(ms, clusterv1.GroupVersion.String(), msGK)
+		var owners []metav1.OwnerReference
+		if mdName, ok := ms.Labels[clusterv1.MachineDeploymentNameLabel]; ok {
+			if md, ok := mds[mdName]; ok {
+				owners = append(owners, ownerRef(clusterv1.GroupVersion.String(), "MachineDeployment", md, true))
+			}
+		} else {
+			owners = append(owners, clusterOwner(false))
+		}
+		checks = append(checks, check{obj: ms, kind: "MachineSet", owners: owners, finalizer: clusterv1.MachineSetFinalizer})
+	}
+
+	// MachinePools are owned by the Cluster.
+	mpList := &clusterv1.MachinePoolList{}
+	if err := c.Client.List(ctx, mpList, listOptions...); err != nil {
+		return nil, errors.Wrapf(err, "failed to list MachinePools for Cluster %s", klog.KObj(cluster))
+	}
+	mpGK := clusterv1.GroupVersion.WithKind("MachinePool").GroupKind()
+	g[mpGK] = map[string]node{}
+	for i := range mpList.Items {
+		mp := &mpList.Items[i]
+		g.add(mp, clusterv1.GroupVersion.String(), mpGK)
+		checks = append(checks, check{obj: mp, kind: "MachinePool", owners: []metav1.OwnerReference{clusterOwner(false)}, finalizer: clusterv1.MachinePoolFinalizer})
+
+		// InfrastructureMachinePools and bootstrap configs of MachinePools are controlled by the MachinePool.
+		refChecks, err := c.referencedObjectChecks(ctx, g, mp.Namespace,
+			ownerRef(clusterv1.GroupVersion.String(), "MachinePool", mp, true),
+			mp.Spec.Template.Spec.InfrastructureRef, mp.Spec.Template.Spec.Bootstrap.ConfigRef)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, refChecks...)
+	}
+
+	// Machines are controlled by their MachineSet or by the control plane.
+	machineList := &clusterv1.MachineList{}
+	if err := c.Client.List(ctx, machineList, listOptions...); err != nil {
+		return nil, errors.Wrapf(err, "failed to list Machines for Cluster %s", klog.KObj(cluster))
+	}
+	machineGK := clusterv1.GroupVersion.WithKind("Machine").GroupKind()
+	g[machineGK] = map[string]node{}
+	for i := range machineList.Items {
+		machine := &machineList.Items[i]
+		g.add(machine, clusterv1.GroupVersion.String(), machineGK)
+		var owners []metav1.OwnerReference
+		if msLabel, ok := machine.Labels[clusterv1.MachineSetNameLabel]; ok {
+			for j := range msList.Items {
+				ms := &msList.Items[j]
+				if format.MustEqualValue(ms.Name, msLabel) {
+					owners = append(owners, ownerRef(clusterv1.GroupVersion.String(), "MachineSet", ms, true))
+					break
+				}
+			}
+		} else if _, ok := machine.Labels[clusterv1.MachineControlPlaneLabel]; ok && cluster.Spec.ControlPlaneRef.IsDefined() {
+			if cp, ok := g[cluster.Spec.ControlPlaneRef.GroupKind()][cluster.Spec.ControlPlaneRef.Name]; ok {
+				owners = append(owners, metav1.OwnerReference{
+					APIVersion: cp.apiVersion,
+					Kind:       cluster.Spec.ControlPlaneRef.Kind,
+					Name:       cluster.Spec.ControlPlaneRef.Name,
+					UID:        cp.uid,
+					Controller: ptr.To(true),
+				})
+			}
+		}
+		checks = append(checks, check{obj: machine, kind: "Machine", owners: owners, finalizer: clusterv1.MachineFinalizer})
+
+		// InfrastructureMachines and bootstrap configs of Machines are controlled by the Machine.
+		refChecks, err := c.referencedObjectChecks(ctx, g, machine.Namespace,
+			ownerRef(clusterv1.GroupVersion.String(), "Machine", machine, true),
+			machine.Spec.InfrastructureRef, machine.Spec.Bootstrap.ConfigRef)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, refChecks...)
+	}
+
+	// MachineHealthChecks are owned by the Cluster.
+	mhcList := &clusterv1.MachineHealthCheckList{}
+	if err := c.Client.List(ctx, mhcList, client.InNamespace(cluster.Namespace)); err != nil {
+		return nil, errors.Wrapf(err, "failed to list MachineHealthChecks for Cluster %s", klog.KObj(cluster))
+	}
+	for i := range mhcList.Items {
+		mhc := &mhcList.Items[i]
+		if mhc.Spec.ClusterName != cluster.Name {
+			continue
+		}
+		checks = append(checks, check{obj: mhc, kind: "MachineHealthCheck", owners: []metav1.OwnerReference{clusterOwner(false)}})
+	}
+
+	var issues []Issue
+	for _, chk := range checks {
+		objIssues, err := c.checkObject(ctx, g, chk)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, objIssues...)
+	}
+	return issues, nil
+}
+
+// referencedObjectChecks returns the checks for the objects referenced by a Machine or a MachinePool,
+// which are expected to be controlled by it.
+func (c *Checker) referencedObjectChecks(ctx context.Context, g graph, namespace string, owner metav1.OwnerReference, refs ...clusterv1.ContractVersionedObjectReference) ([]check, error) {
+	var checks []check
+	for _, ref := range refs {
+		if !ref.IsDefined() {
+			continue
+		}
+		obj, err := c.getReferencedObject(ctx, ref, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			continue
+		}
+		g.add(obj, obj.GetAPIVersion(), ref.GroupKind())
+		checks = append(checks, check{obj: obj, kind: ref.Kind, owners: []metav1.OwnerReference{owner}})
+	}
+	return checks, nil
+}
+
+// getReferencedObject returns the object referenced by ref, or nil if it doesn't exist.
+func (c *Checker) getReferencedObject(ctx context.Context, ref clusterv1.ContractVersionedObjectReference, namespace string) (*unstructured.Unstructured, error) {
+	obj, err := external.GetObjectFromContractVersionedRef(ctx, c.Client, ref, namespace)
+	if err != nil {
+		if apierrors.IsNotFound(errors.Cause(err)) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+// checkObject checks the ownerReferences and the finalizer of an object, and repairs them if required.
+func (c *Checker) checkObject(ctx context.Context, g graph, chk check) ([]Issue, error) {
+	var issues []Issue
+	addIssue := func(repairable bool, msg string, args ...any) {
+		issues = append(issues, Issue{
+			Kind:       chk.kind,
+			Name:       chk.obj.GetName(),
+			Message:    fmt.Sprintf(msg, args...),
+			Repairable: repairable,
+		})
+	}
+
+	modified, ok := chk.obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return nil, errors.Errorf("failed to copy %s %s", chk.kind, klog.KObj(chk.obj))
+	}
+	ownerRefs := modified.GetOwnerReferences()
+
+	// Owner references to objects of the Cluster must point to the current object.
+	for i, ref := range ownerRefs {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			addIssue(false, "ownerReference to %s %s has an invalid apiVersion %q", ref.Kind, ref.Name, ref.APIVersion)
+			continue
+		}
+		nodes, tracked := g[schema.GroupKind{Group: gv.Group, Kind: ref.Kind}]
+		if !tracked {
+			continue
+		}
+		owner, found := nodes[ref.Name]
+		if !found {
+			addIssue(false, "ownerReference to %s %s refers to an object which does not exist or does not belong to the Cluster", ref.Kind, ref.Name)
+			continue
+		}
+		if ref.UID != owner.uid {
+			addIssue(true, "ownerReference to %s %s has UID %s, but the UID of the %s is %s", ref.Kind, ref.Name, ref.UID, ref.Kind, owner.uid)
+		} else if ref.APIVersion != owner.apiVersion {
+			addIssue(true, "ownerReference to %s %s has apiVersion %s, but it should be %s", ref.Kind, ref.Name, ref.APIVersion, owner.apiVersion)
+		}
+		ownerRefs[i].UID = owner.uid
+		ownerRefs[i].APIVersion = owner.apiVersion
+	}
+
+	// Objects must have the owner references set by the Cluster API controllers.
+	for _, want := range chk.owners {
+		if util.HasOwnerRef(ownerRefs, want) {
+			if ptr.Deref(want.Controller, false) && !hasControllerRef(ownerRefs, want) {
+				if controller := metav1.GetControllerOfNoCopy(modified); controller != nil {
+					addIssue(false, "it is controlled by %s %s, but it should be controlled by %s %s", controller.Kind, controller.Name, want.Kind, want.Name)
+					continue
+				}
+				addIssue(true, "ownerReference to %s %s should be a controller reference", want.Kind, want.Name)
+				ownerRefs = util.EnsureOwnerRef(ownerRefs, want)
+			}
+			continue
+		}
+		if ptr.Deref(want.Controller, false) {
+			if controller := metav1.GetControllerOfNoCopy(modified); controller != nil {
+				addIssue(false, "it is controlled by %s %s, but it should be controlled by %s %s", controller.Kind, controller.Name, want.Kind, want.Name)
+				continue
+			}
+		}
+		addIssue(true, "ownerReference to %s %s is missing", want.Kind, want.Name)
+		ownerRefs = append(ownerRefs, want)
+	}
+	modified.SetOwnerReferences(ownerRefs)
+
+	// Objects must have the finalizers set by the Cluster API controllers.
+	if chk.finalizer != "" && modified.GetDeletionTimestamp().IsZero() && !controllerutil.ContainsFinalizer(modified, chk.finalizer) {
+		addIssue(true, "finalizer %s is missing", chk.finalizer)
+		controllerutil.AddFinalizer(modified, chk.finalizer)
+	}
+
+	if !c.Repair || !hasRepairableIssues(issues) {
+		return issues, nil
+	}
+
+	if err := c.Client.Patch(ctx, modified, client.MergeFromWithOptions(chk.obj, client.MergeFromWithOptimisticLock{})); err != nil {
+		return nil, errors.Wrapf(err, "failed to repair ownerReferences and finalizers of %s %s", chk.kind, klog.KObj(chk.obj))
+	}
+	for i := range issues {
+		issues[i].Repaired = issues[i].Repairable
+	}
+	return issues, nil
+}
+
+func ownerRef(apiVersion, kind string, owner client.Object, controller bool) metav1.OwnerReference {
+	ref := metav1.OwnerReference{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Name:       owner.GetName(),
+		UID:        owner.GetUID(),
+	}
+	if controller {
+		ref.Controller = ptr.To(true)
+	}
+	return ref
+}
+
+// hasControllerRef returns true if ownerRefs contains a controller reference to the same object as ref.
+func hasControllerRef(ownerRefs []metav1.OwnerReference, ref metav1.OwnerReference) bool {
+	for _, r := range ownerRefs {
+		if ptr.Deref(r.Controller, false) && util.HasOwnerRef([]metav1.OwnerReference{r}, ref) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasRepairableIssues(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Repairable {
+			return true
+		}
+	}
+	return false
+}
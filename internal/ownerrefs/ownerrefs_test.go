@@ -0,0 +1,220 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ownerrefs
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+func TestCheckCluster(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = apiextensionsv1.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  metav1.NamespaceDefault,
+			Name:       "cluster1",
+			UID:        "cluster-uid",
+			Finalizers: []string{clusterv1.ClusterFinalizer},
+		},
+		Spec: clusterv1.ClusterSpec{
+			InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+				APIGroup: builder.InfrastructureGroupVersion.Group,
+				Kind:     builder.GenericInfrastructureClusterKind,
+				Name:     "infra-cluster1",
+			},
+			ControlPlaneRef: clusterv1.ContractVersionedObjectReference{
+				APIGroup: builder.ControlPlaneGroupVersion.Group,
+				Kind:     builder.GenericControlPlaneKind,
+				Name:     "cp1",
+			},
+		},
+	}
+	clusterLabels := map[string]string{clusterv1.ClusterNameLabel: cluster.Name}
+
+	// The InfrastructureCluster has an ownerReference with the UID of the Cluster before a restore.
+	infraCluster := builder.InfrastructureCluster(metav1.NamespaceDefault, "infra-cluster1").Build()
+	infraCluster.SetUID("infra-cluster-uid")
+	infraCluster.SetOwnerReferences([]metav1.OwnerReference{
+		{APIVersion: clusterv1.GroupVersion.String(), Kind: "Cluster", Name: cluster.Name, UID: "old-cluster-uid", Controller: ptr.To(true)},
+	})
+	// The ControlPlane doesn't have any ownerReference.
+	controlPlane := builder.ControlPlane(metav1.NamespaceDefault, "cp1").Build()
+	controlPlane.SetUID("cp-uid")
+
+	// The MachineDeployment doesn't have its finalizer.
+	md := &clusterv1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "md1",
+			UID:       "md-uid",
+			Labels:    clusterLabels,
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: clusterv1.GroupVersion.String(), Kind: "Cluster", Name: cluster.Name, UID: cluster.UID},
+			},
+		},
+	}
+	// The MachineSet has an ownerReference to the MachineDeployment using an old apiVersion.
+	ms := &clusterv1.MachineSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  metav1.NamespaceDefault,
+			Name:       "ms1",
+			UID:        "ms-uid",
+			Labels:     map[string]string{clusterv1.ClusterNameLabel: cluster.Name, clusterv1.MachineDeploymentNameLabel: md.Name},
+			Finalizers: []string{clusterv1.MachineSetFinalizer},
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "cluster.x-k8s.io/v1beta1", Kind: "MachineDeployment", Name: md.Name, UID: md.UID, Controller: ptr.To(true)},
+			},
+		},
+	}
+	// The Machine doesn't have the ownerReference to its MachineSet.
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  metav1.NamespaceDefault,
+			Name:       "machine1",
+			UID:        "machine-uid",
+			Labels:     map[string]string{clusterv1.ClusterNameLabel: cluster.Name, clusterv1.MachineSetNameLabel: ms.Name},
+			Finalizers: []string{clusterv1.MachineFinalizer},
+		},
+		Spec: clusterv1.MachineSpec{
+			ClusterName: cluster.Name,
+			InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+				APIGroup: builder.InfrastructureGroupVersion.Group,
+				Kind:     builder.GenericInfrastructureMachineKind,
+				Name:     "infra-machine1",
+			},
+		},
+	}
+	// The InfrastructureMachine is controlled by a Machine which doesn't exist.
+	infraMachine := builder.InfrastructureMachine(metav1.NamespaceDefault, "infra-machine1").Build()
+	infraMachine.SetUID("infra-machine-uid")
+	infraMachine.SetOwnerReferences([]metav1.OwnerReference{
+		{APIVersion: clusterv1.GroupVersion.String(), Kind: "Machine", Name: "machine0", UID: "machine0-uid", Controller: ptr.To(true)},
+	})
+	// The MachineHealthCheck doesn't have any ownerReference.
+	mhc := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "mhc1",
+			UID:       "mhc-uid",
+		},
+		Spec: clusterv1.MachineHealthCheckSpec{
+			ClusterName: cluster.Name,
+		},
+	}
+
+	g := NewWithT(t)
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		builder.GenericInfrastructureClusterCRD.DeepCopy(),
+		builder.GenericControlPlaneCRD.DeepCopy(),
+		builder.GenericInfrastructureMachineCRD.DeepCopy(),
+		cluster, infraCluster, controlPlane, md, ms, machine, infraMachine, mhc,
+	).Build()
+
+	// Check without repairing.
+	issues, err := (&Checker{Client: c}).CheckCluster(t.Context(), cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(issues).To(ConsistOf(
+		Issue{Kind: builder.GenericInfrastructureClusterKind, Name: "infra-cluster1", Repairable: true,
+			Message: "ownerReference to Cluster cluster1 has UID old-cluster-uid, but the UID of the Cluster is cluster-uid"},
+		Issue{Kind: builder.GenericControlPlaneKind, Name: "cp1", Repairable: true,
+			Message: "ownerReference to Cluster cluster1 is missing"},
+		Issue{Kind: "MachineDeployment", Name: "md1", Repairable: true,
+			Message: "finalizer cluster.x-k8s.io/machinedeployment is missing"},
+		Issue{Kind: "MachineSet", Name: "ms1", Repairable: true,
+			Message: "ownerReference to MachineDeployment md1 has apiVersion cluster.x-k8s.io/v1beta1, but it should be cluster.x-k8s.io/v1beta2"},
+		Issue{Kind: "Machine", Name: "machine1", Repairable: true,
+			Message: "ownerReference to MachineSet ms1 is missing"},
+		Issue{Kind: builder.GenericInfrastructureMachineKind, Name: "infra-machine1",
+			Message: "ownerReference to Machine machine0 refers to an object which does not exist or does not belong to the Cluster"},
+		Issue{Kind: builder.GenericInfrastructureMachineKind, Name: "infra-machine1",
+			Message: "it is controlled by Machine machine0, but it should be controlled by Machine machine1"},
+		Issue{Kind: "MachineHealthCheck", Name: "mhc1", Repairable: true,
+			Message: "ownerReference to Cluster cluster1 is missing"},
+	))
+
+	// Objects must not be changed without repairing.
+	gotMachine := &clusterv1.Machine{}
+	g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(machine), gotMachine)).To(Succeed())
+	g.Expect(gotMachine.OwnerReferences).To(BeEmpty())
+
+	// Check and repair.
+	issues, err = (&Checker{Client: c, Repair: true}).CheckCluster(t.Context(), cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(issues).To(HaveLen(8))
+	for _, issue := range issues {
+		g.Expect(issue.Repaired).To(Equal(issue.Repairable))
+	}
+
+	gotInfraCluster := &unstructured.Unstructured{}
+	gotInfraCluster.SetGroupVersionKind(infraCluster.GroupVersionKind())
+	g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(infraCluster), gotInfraCluster)).To(Succeed())
+	g.Expect(gotInfraCluster.GetOwnerReferences()).To(ConsistOf(
+		metav1.OwnerReference{APIVersion: clusterv1.GroupVersion.String(), Kind: "Cluster", Name: cluster.Name, UID: cluster.UID, Controller: ptr.To(true)},
+	))
+
+	gotControlPlane := &unstructured.Unstructured{}
+	gotControlPlane.SetGroupVersionKind(controlPlane.GroupVersionKind())
+	g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(controlPlane), gotControlPlane)).To(Succeed())
+	g.Expect(gotControlPlane.GetOwnerReferences()).To(ConsistOf(
+		metav1.OwnerReference{APIVersion: clusterv1.GroupVersion.String(), Kind: "Cluster", Name: cluster.Name, UID: cluster.UID, Controller: ptr.To(true)},
+	))
+
+	gotMD := &clusterv1.MachineDeployment{}
+	g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(md), gotMD)).To(Succeed())
+	g.Expect(gotMD.Finalizers).To(ConsistOf(clusterv1.MachineDeploymentFinalizer))
+
+	gotMS := &clusterv1.MachineSet{}
+	g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(ms), gotMS)).To(Succeed())
+	g.Expect(gotMS.OwnerReferences).To(ConsistOf(
+		metav1.OwnerReference{APIVersion: clusterv1.GroupVersion.String(), Kind: "MachineDeployment", Name: md.Name, UID: md.UID, Controller: ptr.To(true)},
+	))
+
+	g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(machine), gotMachine)).To(Succeed())
+	g.Expect(gotMachine.OwnerReferences).To(ConsistOf(
+		metav1.OwnerReference{APIVersion: clusterv1.GroupVersion.String(), Kind: "MachineSet", Name: ms.Name, UID: ms.UID, Controller: ptr.To(true)},
+	))
+
+	gotMHC := &clusterv1.MachineHealthCheck{}
+	g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(mhc), gotMHC)).To(Succeed())
+	g.Expect(gotMHC.OwnerReferences).To(ConsistOf(
+		metav1.OwnerReference{APIVersion: clusterv1.GroupVersion.String(), Kind: "Cluster", Name: cluster.Name, UID: cluster.UID},
+	))
+
+	// After repairing, only the issues which can't be repaired are left.
+	issues, err = (&Checker{Client: c, Repair: true}).CheckCluster(t.Context(), cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(issues).To(HaveLen(2))
+	for _, issue := range issues {
+		g.Expect(issue.Kind).To(Equal(builder.GenericInfrastructureMachineKind))
+		g.Expect(issue.Repairable).To(BeFalse())
+	}
+}
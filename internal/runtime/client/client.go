@@ -23,13 +23,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/rand"
 	"net"
 	"net/http"
 	"net/url"
 	"path"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -52,9 +55,11 @@ import (
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
 	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
 	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
+	"sigs.k8s.io/cluster-api/internal/runtime/contentencoding"
 	runtimemetrics "sigs.k8s.io/cluster-api/internal/runtime/metrics"
 	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/cache"
 )
 
 type errCallingExtensionHandler error
@@ -72,13 +77,19 @@ type Options struct {
 
 // New returns a new Client.
 func New(options Options) runtimeclient.Client {
-	return &client{
-		certFile: options.CertFile,
-		keyFile:  options.KeyFile,
-		catalog:  options.Catalog,
-		registry: options.Registry,
-		client:   options.Client,
-	}
+	c := &client{
+		certFile:        options.CertFile,
+		keyFile:         options.KeyFile,
+		catalog:         options.Catalog,
+		registry:        options.Registry,
+		client:          options.Client,
+		cachedResponses: map[string][]cachedResponse{},
+		gzipRequests:    newGzipRequestSupport(),
+	}
+	if c.registry != nil {
+		c.registry.AddChangeListener(c.invalidateCachedResponses)
+	}
+	return c
 }
 
 var _ runtimeclient.Client = &client{}
@@ -89,6 +100,20 @@ type client struct {
 	catalog  *runtimecatalog.Catalog
 	registry runtimeregistry.ExtensionRegistry
 	client   ctrlclient.Client
+
+	// cachedResponses tracks the responses cached via the WithCaching option by ExtensionConfig name,
+	// so they can be removed from the cache when the ExtensionConfig is changed or removed.
+	cachedResponses     map[string][]cachedResponse
+	cachedResponsesLock sync.Mutex
+
+	// gzipRequests tracks the ExtensionConfigs whose Runtime Extensions accept gzip-encoded requests.
+	gzipRequests *gzipRequestSupport
+}
+
+// cachedResponse identifies a response cached via the WithCaching option.
+type cachedResponse struct {
+	cache cache.Cache[runtimeclient.CallExtensionCacheEntry]
+	key   string
 }
 
 func (c *client) WarmUp(extensionConfigList *runtimev1.ExtensionConfigList) error {
@@ -118,8 +143,18 @@ func (c *client) Discover(ctx context.Context, extensionConfig *runtimev1.Extens
 		registrationGVH: hookGVH,
 		hookGVH:         hookGVH,
 		timeout:         defaultDiscoveryTimeout,
+
+		extensionConfigName: extensionConfig.Name,
+		gzipRequests:        c.gzipRequests,
+	}
+	// Note: Discovery is performed against the secondary Extension server only if the call to the primary one fails.
+	clientConfigs := []runtimev1.ClientConfig{extensionConfig.Spec.ClientConfig}
+	if extensionConfig.Spec.SecondaryClientConfig.IsDefined() {
+		clientConfigs = append(clientConfigs, extensionConfig.Spec.SecondaryClientConfig.ClientConfig)
 	}
-	if err := httpCall(ctx, request, response, opts); err != nil {
+	if err := callWithFallback(ctx, clientConfigs, opts, func(opts *httpCallOptions) error {
+		return httpCall(ctx, request, response, opts)
+	}); err != nil {
 		return nil, errors.Wrapf(err, "failed to discover extension %q", extensionConfig.Name)
 	}
 
@@ -133,6 +168,17 @@ func (c *client) Discover(ctx context.Context, extensionConfig *runtimev1.Extens
 		return nil, errors.Wrapf(err, "failed to discover extension %q", extensionConfig.Name)
 	}
 
+	// Check to see if the OpenAPI spec served by the extension matches the catalog, if requested.
+	if extensionConfig.Annotations[runtimev1.ValidateOpenAPIAnnotation] == "true" {
+		openAPI, err := c.getOpenAPI(ctx, extensionConfig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to discover extension %q", extensionConfig.Name)
+		}
+		if err := validateOpenAPI(c.catalog, openAPI, response); err != nil {
+			return nil, errors.Wrapf(err, "failed to discover extension %q", extensionConfig.Name)
+		}
+	}
+
 	modifiedExtensionConfig := extensionConfig.DeepCopy()
 	// Reset the handlers that were previously registered with the ExtensionConfig.
 	modifiedExtensionConfig.Status.Handlers = []runtimev1.ExtensionHandler{}
@@ -156,9 +202,39 @@ func (c *client) Discover(ctx context.Context, extensionConfig *runtimev1.Extens
 		)
 	}
 
+	// Reset the settings schema that was previously registered with the ExtensionConfig.
+	modifiedExtensionConfig.Status.SettingsSchema = convertSettingsSchema(response.SettingsSchema)
+
 	return modifiedExtensionConfig, nil
 }
 
+// convertSettingsSchema converts the settings schema returned by an Extension during discovery
+// into the corresponding ExtensionConfig status field.
+func convertSettingsSchema(schema *runtimehooksv1.SettingsSchema) *runtimev1.ExtensionSettingsSchema {
+	if schema == nil {
+		return nil
+	}
+
+	settingsSchema := &runtimev1.ExtensionSettingsSchema{}
+	if schema.AllowUnknownSettings {
+		settingsSchema.AllowUnknownSettings = ptr.To(true)
+	}
+	for _, setting := range schema.Settings {
+		s := runtimev1.ExtensionSettingSchema{
+			Name:        setting.Name,
+			Description: setting.Description,
+			Enum:        setting.Enum,
+			Pattern:     setting.Pattern,
+			MaxLength:   setting.MaxLength,
+		}
+		if setting.Required {
+			s.Required = ptr.To(true)
+		}
+		settingsSchema.Settings = append(settingsSchema.Settings, s)
+	}
+	return settingsSchema
+}
+
 func (c *client) Register(extensionConfig *runtimev1.ExtensionConfig) error {
 	if err := c.registry.Add(extensionConfig); err != nil {
 		return errors.Wrapf(err, "failed to register ExtensionConfig %q", extensionConfig.Name)
@@ -350,7 +426,7 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 	var cacheKey string
 	if options.WithCaching {
 		// Return a cached response if response is cached.
-		cacheKey = options.CacheKeyFunc(registration.Name, registration.ExtensionConfigResourceVersion, request)
+		cacheKey = options.CacheKeyFunc(registration.Name, registration.ExtensionConfigGeneration, request)
 		if cacheEntry, ok := options.Cache.Has(cacheKey); ok {
 			// Set response to cacheEntry.Response.
 			outVal := reflect.ValueOf(response)
@@ -367,13 +443,20 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 		certFile:        c.certFile,
 		keyFile:         c.keyFile,
 		catalog:         c.catalog,
-		config:          registration.ClientConfig,
 		registrationGVH: registration.GroupVersionHook,
 		hookGVH:         hookGVH,
 		name:            strings.TrimSuffix(registration.Name, "."+registration.ExtensionConfigName),
 		timeout:         timeoutDuration,
+
+		extensionConfigName: registration.ExtensionConfigName,
+		gzipRequests:        c.gzipRequests,
 	}
-	err = httpCall(ctx, request, response, httpOpts)
+	err = callWithFallback(ctx, clientConfigsFor(registration.ClientConfig, registration.SecondaryClientConfig), httpOpts, func(opts *httpCallOptions) error {
+		if options.WithPartialRequests {
+			return httpCallWithPartialRequests(ctx, request, response, opts, options.MaxRequestSizeBytes)
+		}
+		return httpCall(ctx, request, response, opts)
+	})
 	if err != nil {
 		// If the error is errCallingExtensionHandler then apply failure policy to calculate
 		// the effective result of the operation.
@@ -406,6 +489,7 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 			CacheKey: cacheKey,
 			Response: response,
 		})
+		c.trackCachedResponse(registration.ExtensionConfigName, options.Cache, cacheKey)
 	}
 
 	// Received a successful response from the extension handler. The `response` object
@@ -413,6 +497,31 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 	return nil
 }
 
+// trackCachedResponse records a response cached for a RuntimeExtension of the given ExtensionConfig.
+func (c *client) trackCachedResponse(extensionConfigName string, responseCache cache.Cache[runtimeclient.CallExtensionCacheEntry], key string) {
+	c.cachedResponsesLock.Lock()
+	defer c.cachedResponsesLock.Unlock()
+
+	for _, r := range c.cachedResponses[extensionConfigName] {
+		if r.cache == responseCache && r.key == key {
+			return
+		}
+	}
+	c.cachedResponses[extensionConfigName] = append(c.cachedResponses[extensionConfigName], cachedResponse{cache: responseCache, key: key})
+}
+
+// invalidateCachedResponses removes the responses cached for the RuntimeExtensions of the given ExtensionConfig;
+// it is called by the registry when the ExtensionConfig is changed or removed.
+func (c *client) invalidateCachedResponses(extensionConfigName string) {
+	c.cachedResponsesLock.Lock()
+	defer c.cachedResponsesLock.Unlock()
+
+	for _, r := range c.cachedResponses[extensionConfigName] {
+		r.cache.Delete(r.key)
+	}
+	delete(c.cachedResponses, extensionConfigName)
+}
+
 // cloneAndAddSettings creates a new request object and adds settings to it.
 func cloneAndAddSettings(request runtimehooksv1.RequestObject, registrationSettings map[string]string) runtimehooksv1.RequestObject {
 	// Merge the settings from registration with the settings in the request.
@@ -430,6 +539,43 @@ func cloneAndAddSettings(request runtimehooksv1.RequestObject, registrationSetti
 	return request
 }
 
+// chooseSecondaryClientConfig returns true if a call should be sent first to a secondary Extension server
+// with the given weight; it can be overridden for testing.
+var chooseSecondaryClientConfig = func(weight int32) bool {
+	return rand.Int31n(100) < weight //nolint:gosec // Weighting calls doesn't require a cryptographically secure random number.
+}
+
+// clientConfigsFor returns the ClientConfigs of the Extension servers to call, in the order they should be tried.
+// If a secondary Extension server is defined, it is tried first for a share of the calls equal to its weight,
+// and the other Extension server is used as a fallback if the call fails.
+func clientConfigsFor(primary runtimev1.ClientConfig, secondary runtimev1.WeightedClientConfig) []runtimev1.ClientConfig {
+	if !secondary.IsDefined() {
+		return []runtimev1.ClientConfig{primary}
+	}
+	if chooseSecondaryClientConfig(ptr.Deref(secondary.Weight, 0)) {
+		return []runtimev1.ClientConfig{secondary.ClientConfig, primary}
+	}
+	return []runtimev1.ClientConfig{primary, secondary.ClientConfig}
+}
+
+// callWithFallback calls the Extension servers with the given ClientConfigs in order, until a call succeeds.
+// The error of the last call is returned if all the calls fail.
+func callWithFallback(ctx context.Context, clientConfigs []runtimev1.ClientConfig, opts *httpCallOptions, call func(opts *httpCallOptions) error) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	var err error
+	for i, clientConfig := range clientConfigs {
+		opts.config = clientConfig
+		if err = call(opts); err == nil {
+			return nil
+		}
+		if i < len(clientConfigs)-1 {
+			log.V(4).Info(fmt.Sprintf("Call to Extension server failed, retrying with the next Extension server: %v", err))
+		}
+	}
+	return err
+}
+
 type httpCallOptions struct {
 	certFile        string
 	keyFile         string
@@ -439,6 +585,11 @@ type httpCallOptions struct {
 	hookGVH         runtimecatalog.GroupVersionHook
 	name            string
 	timeout         time.Duration
+
+	// extensionConfigName is the name of the ExtensionConfig of the extension and gzipRequests tracks if its
+	// Runtime Extensions accept gzip-encoded requests.
+	extensionConfigName string
+	gzipRequests        *gzipRequestSupport
 }
 
 func httpCall(ctx context.Context, request, response runtime.Object, opts *httpCallOptions) error {
@@ -500,6 +651,11 @@ func httpCall(ctx context.Context, request, response runtime.Object, opts *httpC
 	if err != nil {
 		return errors.Wrap(err, "http call failed: failed to marshall request object")
 	}
+	requestBody, requestEncoding, err := encodeRequestBody(postBody, opts.gzipRequests.isSupported(opts.extensionConfigName))
+	if err != nil {
+		return errors.Wrap(err, "http call failed")
+	}
+	runtimemetrics.RequestSize.Observe(opts.hookGVH, *extensionURL, requestEncoding, len(postBody))
 
 	if opts.timeout != 0 {
 		// Make the call time-bound if timeout is non-zero value.
@@ -512,28 +668,20 @@ func httpCall(ctx context.Context, request, response runtime.Object, opts *httpC
 		defer cancel()
 	}
 
-	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, extensionURL.String(), bytes.NewBuffer(postBody))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, extensionURL.String(), bytes.NewBuffer(requestBody))
 	if err != nil {
 		return errors.Wrap(err, "http call failed: failed to create http request")
 	}
+	// Responses are decoded explicitly, so the size of the response payload can be observed.
+	httpRequest.Header.Set("Accept-Encoding", contentencoding.Gzip)
+	if requestEncoding != "" {
+		httpRequest.Header.Set("Content-Encoding", requestEncoding)
+	}
 
-	// Use client-go's transport.TLSConfigureFor to ensure good defaults for tls
-	client := http.DefaultClient
-	tlsConfig, err := transport.TLSConfigFor(&transport.Config{
-		TLS: transport.TLSConfig{
-			CertFile:   opts.certFile,
-			KeyFile:    opts.keyFile,
-			CAData:     opts.config.CABundle,
-			ServerName: extensionURL.Hostname(),
-		},
-	})
+	client, err := httpClientFor(opts.certFile, opts.keyFile, opts.config, extensionURL)
 	if err != nil {
-		return errors.Wrap(err, "http call failed: failed to create tls config")
+		return errors.Wrap(err, "http call failed")
 	}
-	// This also adds http2
-	client.Transport = utilnet.SetTransportDefaults(&http.Transport{
-		TLSClientConfig: tlsConfig,
-	})
 
 	resp, err := client.Do(httpRequest)
 
@@ -549,6 +697,22 @@ func httpCall(ctx context.Context, request, response runtime.Object, opts *httpC
 	}
 	defer resp.Body.Close()
 
+	// Extensions advertise if they accept gzip-encoded requests with the Accept-Encoding header (RFC 7694).
+	opts.gzipRequests.set(opts.extensionConfigName, contentencoding.AcceptsGzip(resp.Header.Values("Accept-Encoding")))
+
+	if resp.StatusCode == http.StatusUnsupportedMediaType && requestEncoding != "" {
+		// The extension does not accept gzip-encoded requests; retry without compression.
+		log.V(4).Info("Extension handler does not accept gzip-encoded requests, retrying without compression")
+		opts.gzipRequests.set(opts.extensionConfigName, false)
+		return httpCall(ctx, request, response, opts)
+	}
+
+	if resp.StatusCode == http.StatusRequestEntityTooLarge {
+		return errCallingExtensionHandler(
+			errors.Wrapf(errRequestEntityTooLarge, "http call failed: got response with status code %d != 200: request of %d bytes", resp.StatusCode, len(postBody)),
+		)
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		respBody, err := io.ReadAll(resp.Body)
 		if err != nil {
@@ -562,7 +726,15 @@ func httpCall(ctx context.Context, request, response runtime.Object, opts *httpC
 		)
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(responseLocal); err != nil {
+	responseBody, err := readResponseBody(resp)
+	if err != nil {
+		return errCallingExtensionHandler(
+			errors.Wrap(err, "http call failed: failed to read response"),
+		)
+	}
+	runtimemetrics.ResponseSize.Observe(opts.hookGVH, *extensionURL, resp.Header.Get("Content-Encoding"), len(responseBody))
+
+	if err := json.NewDecoder(bytes.NewReader(responseBody)).Decode(responseLocal); err != nil {
 		return errCallingExtensionHandler(
 			errors.Wrap(err, "http call failed: failed to decode response"),
 		)
@@ -579,7 +751,34 @@ func httpCall(ctx context.Context, request, response runtime.Object, opts *httpC
 	return nil
 }
 
+// httpClientFor returns the http client to call the Extension at the given URL.
+func httpClientFor(certFile, keyFile string, config runtimev1.ClientConfig, extensionURL *url.URL) (*http.Client, error) {
+	// Use client-go's transport.TLSConfigureFor to ensure good defaults for tls
+	client := http.DefaultClient
+	tlsConfig, err := transport.TLSConfigFor(&transport.Config{
+		TLS: transport.TLSConfig{
+			CertFile:   certFile,
+			KeyFile:    keyFile,
+			CAData:     config.CABundle,
+			ServerName: extensionURL.Hostname(),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create tls config")
+	}
+	// This also adds http2
+	client.Transport = utilnet.SetTransportDefaults(&http.Transport{
+		TLSClientConfig: tlsConfig,
+	})
+	return client, nil
+}
+
 func urlForExtension(config runtimev1.ClientConfig, gvh runtimecatalog.GroupVersionHook, name string) (*url.URL, error) {
+	return urlForPath(config, runtimecatalog.GVHToPath(gvh, name))
+}
+
+// urlForPath computes the URL for the given path served by the Extension.
+func urlForPath(config runtimev1.ClientConfig, p string) (*url.URL, error) {
 	var u *url.URL
 	if config.Service.IsDefined() {
 		// The Extension's ClientConfig points ot a service. Construct the URL to the service.
@@ -611,8 +810,8 @@ func urlForExtension(config runtimev1.ClientConfig, gvh runtimecatalog.GroupVers
 		}
 	}
 
-	// Append the ExtensionHandler path.
-	u.Path = path.Join(u.Path, runtimecatalog.GVHToPath(gvh, name))
+	// Append the path, e.g. the ExtensionHandler path.
+	u.Path = path.Join(u.Path, p)
 	return u, nil
 }
 
@@ -661,6 +860,32 @@ func defaultAndValidateDiscoveryResponse(cat *runtimecatalog.Catalog, discovery
 		}
 	}
 
+	if discovery.SettingsSchema != nil {
+		settingNames := make(map[string]bool)
+		for _, setting := range discovery.SettingsSchema.Settings {
+			// Setting names should be non-empty and unique.
+			if setting.Name == "" {
+				errs = append(errs, errors.New("settingsSchema contains a setting with an empty name"))
+			}
+			if _, ok := settingNames[setting.Name]; ok {
+				errs = append(errs, errors.Errorf("duplicate name for setting %s found in settingsSchema", setting.Name))
+			}
+			settingNames[setting.Name] = true
+
+			// Pattern should be a valid regular expression.
+			if setting.Pattern != "" {
+				if _, err := regexp.Compile(setting.Pattern); err != nil {
+					errs = append(errs, errors.Wrapf(err, "setting %s pattern %q is not valid", setting.Name, setting.Pattern))
+				}
+			}
+
+			// MaxLength should not be negative.
+			if setting.MaxLength != nil && *setting.MaxLength < 0 {
+				errs = append(errs, errors.Errorf("setting %s maxLength %d must not be negative", setting.Name, *setting.MaxLength))
+			}
+		}
+	}
+
 	return errors.Wrapf(kerrors.NewAggregate(errs), "failed to validate discovery response")
 }
 
@@ -491,6 +491,63 @@ func Test_defaultAndValidateDiscoveryResponse(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "succeed with valid settings schema",
+			discovery: &runtimehooksv1.DiscoveryResponse{
+				SettingsSchema: &runtimehooksv1.SettingsSchema{
+					Settings: []runtimehooksv1.SettingSchema{
+						{Name: "mode", Required: true, Enum: []string{"fast", "safe"}},
+						{Name: "region", Pattern: "^[a-z]+-[0-9]+$", MaxLength: ptr.To[int32](10)},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error when setting name is duplicated",
+			discovery: &runtimehooksv1.DiscoveryResponse{
+				SettingsSchema: &runtimehooksv1.SettingsSchema{
+					Settings: []runtimehooksv1.SettingSchema{
+						{Name: "mode"},
+						{Name: "mode"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error when setting name is empty",
+			discovery: &runtimehooksv1.DiscoveryResponse{
+				SettingsSchema: &runtimehooksv1.SettingsSchema{
+					Settings: []runtimehooksv1.SettingSchema{
+						{Name: ""},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error when setting pattern is not a valid regular expression",
+			discovery: &runtimehooksv1.DiscoveryResponse{
+				SettingsSchema: &runtimehooksv1.SettingsSchema{
+					Settings: []runtimehooksv1.SettingSchema{
+						{Name: "region", Pattern: "^[a-z"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error when setting maxLength is negative",
+			discovery: &runtimehooksv1.DiscoveryResponse{
+				SettingsSchema: &runtimehooksv1.SettingsSchema{
+					Settings: []runtimehooksv1.SettingSchema{
+						{Name: "region", MaxLength: ptr.To[int32](-1)},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -501,6 +558,25 @@ func Test_defaultAndValidateDiscoveryResponse(t *testing.T) {
 	}
 }
 
+func Test_convertSettingsSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(convertSettingsSchema(nil)).To(BeNil())
+	g.Expect(convertSettingsSchema(&runtimehooksv1.SettingsSchema{
+		Settings: []runtimehooksv1.SettingSchema{
+			{Name: "mode", Description: "The mode.", Required: true, Enum: []string{"fast", "safe"}},
+			{Name: "region", Pattern: "^[a-z]+-[0-9]+$", MaxLength: ptr.To[int32](10)},
+		},
+		AllowUnknownSettings: true,
+	})).To(BeComparableTo(&runtimev1.ExtensionSettingsSchema{
+		Settings: []runtimev1.ExtensionSettingSchema{
+			{Name: "mode", Description: "The mode.", Required: ptr.To(true), Enum: []string{"fast", "safe"}},
+			{Name: "region", Pattern: "^[a-z]+-[0-9]+$", MaxLength: ptr.To[int32](10)},
+		},
+		AllowUnknownSettings: ptr.To(true),
+	}))
+}
+
 func TestClient_CallExtension(t *testing.T) {
 	ns := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
@@ -511,6 +587,7 @@ func TestClient_CallExtension(t *testing.T) {
 	validExtensionHandlerWithFailPolicy := runtimev1.ExtensionConfig{
 		ObjectMeta: metav1.ObjectMeta{
 			ResourceVersion: "15",
+			Generation:      15,
 		},
 		Spec: runtimev1.ExtensionConfigSpec{
 			ClientConfig: runtimev1.ClientConfig{
@@ -537,6 +614,7 @@ func TestClient_CallExtension(t *testing.T) {
 	validExtensionHandlerWithIgnorePolicy := runtimev1.ExtensionConfig{
 		ObjectMeta: metav1.ObjectMeta{
 			ResourceVersion: "15",
+			Generation:      15,
 		},
 		Spec: runtimev1.ExtensionConfigSpec{
 			ClientConfig: runtimev1.ClientConfig{
@@ -787,9 +865,10 @@ func TestClient_CallExtension(t *testing.T) {
 				WithObjects(ns).
 				Build()
 
+			reg := registry(tt.registeredExtensionConfigs)
 			c := New(Options{
 				Catalog:  cat,
-				Registry: registry(tt.registeredExtensionConfigs),
+				Registry: reg,
 				Client:   fakeClient,
 			})
 
@@ -834,6 +913,13 @@ func TestClient_CallExtension(t *testing.T) {
 				cacheEntry, isCached = cache.Has("valid-extension-15")
 				g.Expect(isCached).To(BeTrue())
 				g.Expect(cacheEntry).ToNot(BeNil())
+
+				// A change to the ExtensionConfig drops the cached response.
+				extensionConfig := tt.registeredExtensionConfigs[0].DeepCopy()
+				extensionConfig.Generation++
+				g.Expect(reg.Add(extensionConfig)).To(Succeed())
+				_, isCached = cache.Has("valid-extension-15")
+				g.Expect(isCached).To(BeFalse())
 			} else {
 				_, isCached := cache.Has("valid-extension-15")
 				g.Expect(isCached).To(BeFalse())
@@ -852,6 +938,7 @@ func TestClient_CallExtensionWithClientAuthentication(t *testing.T) {
 	validExtensionHandlerWithFailPolicy := runtimev1.ExtensionConfig{
 		ObjectMeta: metav1.ObjectMeta{
 			ResourceVersion: "15",
+			Generation:      15,
 		},
 		Spec: runtimev1.ExtensionConfigSpec{
 			ClientConfig: runtimev1.ClientConfig{
@@ -934,9 +1021,166 @@ func TestClient_CallExtensionWithClientAuthentication(t *testing.T) {
 	g.Expect(serverCallCount).To(Equal(1))
 }
 
-func cacheKeyFunc(extensionName, extensionConfigResourceVersion string, request runtimehooksv1.RequestObject) string {
+func TestClient_CallExtensionWithSecondaryClientConfig(t *testing.T) {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+		},
+	}
+
+	tests := []struct {
+		name                   string
+		weight                 int32
+		primaryResponse        testServerResponse
+		secondaryResponse      testServerResponse
+		wantPrimaryCallCount   int
+		wantSecondaryCallCount int
+		wantErr                bool
+	}{
+		{
+			name:                   "should call the primary Extension server if the weight of the secondary is 0",
+			weight:                 0,
+			primaryResponse:        response(runtimehooksv1.ResponseStatusSuccess),
+			secondaryResponse:      response(runtimehooksv1.ResponseStatusSuccess),
+			wantPrimaryCallCount:   1,
+			wantSecondaryCallCount: 0,
+		},
+		{
+			name:                   "should call the secondary Extension server if its weight is 100",
+			weight:                 100,
+			primaryResponse:        response(runtimehooksv1.ResponseStatusSuccess),
+			secondaryResponse:      response(runtimehooksv1.ResponseStatusSuccess),
+			wantPrimaryCallCount:   0,
+			wantSecondaryCallCount: 1,
+		},
+		{
+			name:   "should fall back to the secondary Extension server if the call to the primary fails",
+			weight: 0,
+			primaryResponse: testServerResponse{
+				response:           &fakev1alpha1.FakeResponse{},
+				responseStatusCode: http.StatusInternalServerError,
+			},
+			secondaryResponse:      response(runtimehooksv1.ResponseStatusSuccess),
+			wantPrimaryCallCount:   1,
+			wantSecondaryCallCount: 1,
+		},
+		{
+			name:            "should fall back to the primary Extension server if the call to the secondary fails",
+			weight:          100,
+			primaryResponse: response(runtimehooksv1.ResponseStatusSuccess),
+			secondaryResponse: testServerResponse{
+				response:           &fakev1alpha1.FakeResponse{},
+				responseStatusCode: http.StatusInternalServerError,
+			},
+			wantPrimaryCallCount:   1,
+			wantSecondaryCallCount: 1,
+		},
+		{
+			name:   "should fail if the calls to both the Extension servers fail",
+			weight: 50,
+			primaryResponse: testServerResponse{
+				response:           &fakev1alpha1.FakeResponse{},
+				responseStatusCode: http.StatusInternalServerError,
+			},
+			secondaryResponse: testServerResponse{
+				response:           &fakev1alpha1.FakeResponse{},
+				responseStatusCode: http.StatusInternalServerError,
+			},
+			wantPrimaryCallCount:   1,
+			wantSecondaryCallCount: 1,
+			wantErr:                true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var primaryCallCount, secondaryCallCount int
+			primary := createSecureTestServer(testServerConfig{
+				start:     true,
+				responses: map[string]testServerResponse{"/*": tt.primaryResponse},
+			}, func() {
+				primaryCallCount++
+			})
+			primary.StartTLS()
+			defer primary.Close()
+			secondary := createSecureTestServer(testServerConfig{
+				start:     true,
+				responses: map[string]testServerResponse{"/*": tt.secondaryResponse},
+			}, func() {
+				secondaryCallCount++
+			})
+			secondary.StartTLS()
+			defer secondary.Close()
+
+			extensionConfig := runtimev1.ExtensionConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					ResourceVersion: "15",
+					Generation:      15,
+				},
+				Spec: runtimev1.ExtensionConfigSpec{
+					ClientConfig: runtimev1.ClientConfig{
+						URL:      fmt.Sprintf("https://%s/", primary.Listener.Addr().String()),
+						CABundle: testcerts.CACert,
+					},
+					SecondaryClientConfig: runtimev1.WeightedClientConfig{
+						ClientConfig: runtimev1.ClientConfig{
+							URL:      fmt.Sprintf("https://%s/", secondary.Listener.Addr().String()),
+							CABundle: testcerts.CACert,
+						},
+						Weight: ptr.To(tt.weight),
+					},
+					NamespaceSelector: &metav1.LabelSelector{},
+				},
+				Status: runtimev1.ExtensionConfigStatus{
+					Handlers: []runtimev1.ExtensionHandler{
+						{
+							Name: "valid-extension",
+							RequestHook: runtimev1.GroupVersionHook{
+								APIVersion: fakev1alpha1.GroupVersion.String(),
+								Hook:       "FakeHook",
+							},
+							TimeoutSeconds: 1,
+							FailurePolicy:  runtimev1.FailurePolicyFail,
+						},
+					},
+				},
+			}
+
+			cat := runtimecatalog.New()
+			_ = fakev1alpha1.AddToCatalog(cat)
+			fakeClient := fake.NewClientBuilder().
+				WithObjects(ns).
+				Build()
+
+			c := New(Options{
+				Catalog:  cat,
+				Registry: registry([]runtimev1.ExtensionConfig{extensionConfig}),
+				Client:   fakeClient,
+			})
+
+			obj := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster",
+					Namespace: "foo",
+				},
+			}
+			err := c.CallExtension(context.Background(), fakev1alpha1.FakeHook, obj, "valid-extension", &fakev1alpha1.FakeRequest{}, &fakev1alpha1.FakeResponse{})
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(primaryCallCount).To(Equal(tt.wantPrimaryCallCount))
+			g.Expect(secondaryCallCount).To(Equal(tt.wantSecondaryCallCount))
+		})
+	}
+}
+
+func cacheKeyFunc(extensionName string, extensionConfigGeneration int64, request runtimehooksv1.RequestObject) string {
 	// Note: extensionName is identical to the value of the name parameter passed into CallExtension.
-	s := fmt.Sprintf("%s-%s", extensionName, extensionConfigResourceVersion)
+	s := fmt.Sprintf("%s-%d", extensionName, extensionConfigGeneration)
 	for k, v := range request.GetSettings() {
 		s += fmt.Sprintf(",%s=%s", k, v)
 	}
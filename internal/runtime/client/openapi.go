@@ -0,0 +1,192 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kube-openapi/pkg/spec3"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	"sigs.k8s.io/cluster-api/internal/runtime/contentencoding"
+)
+
+// getOpenAPI gets the OpenAPI spec served by the Extension of the given ExtensionConfig.
+func (c *client) getOpenAPI(ctx context.Context, extensionConfig *runtimev1.ExtensionConfig) (*spec3.OpenAPI, error) {
+	openAPIURL, err := urlForPath(extensionConfig.Spec.ClientConfig, runtimecatalog.OpenAPIPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get OpenAPI spec")
+	}
+
+	ctx, cancel := context.WithTimeoutCause(ctx, defaultDiscoveryTimeout, errors.New("http request timeout expired"))
+	defer cancel()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, openAPIURL.String(), http.NoBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get OpenAPI spec: failed to create http request")
+	}
+	httpRequest.Header.Set("Accept-Encoding", contentencoding.Gzip)
+
+	client, err := httpClientFor(c.certFile, c.keyFile, extensionConfig.Spec.ClientConfig, openAPIURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get OpenAPI spec")
+	}
+	resp, err := client.Do(httpRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get OpenAPI spec")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, errors.Errorf("failed to get OpenAPI spec: got response with status code %d != 200: response: %q", resp.StatusCode, string(respBody))
+	}
+
+	responseBody, err := readResponseBody(resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get OpenAPI spec: failed to read response")
+	}
+	openAPI := &spec3.OpenAPI{}
+	if err := json.Unmarshal(responseBody, openAPI); err != nil {
+		return nil, errors.Wrap(err, "failed to get OpenAPI spec: failed to decode response")
+	}
+	return openAPI, nil
+}
+
+// validateOpenAPI validates that the OpenAPI spec served by an Extension matches the OpenAPI spec
+// generated from the catalog for all the hooks of the handlers in the Discovery Response.
+// Operations must exist for the same paths and must use request and response types with the same name and properties.
+func validateOpenAPI(cat *runtimecatalog.Catalog, openAPI *spec3.OpenAPI, discovery *runtimehooksv1.DiscoveryResponse) error {
+	if openAPI == nil || openAPI.Paths == nil {
+		return errors.New("failed to validate OpenAPI spec: OpenAPI spec does not define paths")
+	}
+
+	var errs []error
+	for _, handler := range discovery.Handlers {
+		gv, err := schema.ParseGroupVersion(handler.RequestHook.APIVersion)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "handler %s requestHook APIVersion %s is not valid", handler.Name, handler.RequestHook.APIVersion))
+			continue
+		}
+		gvh := runtimecatalog.GroupVersionHook{Group: gv.Group, Version: gv.Version, Hook: handler.RequestHook.Hook}
+
+		expectedOpenAPI, err := cat.OpenAPIForHooks("", gvh)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "handler %s", handler.Name))
+			continue
+		}
+		for p, expectedPath := range expectedOpenAPI.Paths.Paths {
+			path, ok := openAPI.Paths.Paths[p]
+			if !ok || path.Post == nil {
+				errs = append(errs, errors.Errorf("handler %s requestHook %s/%s is not in the OpenAPI spec: path %s is missing", handler.Name, handler.RequestHook.APIVersion, handler.RequestHook.Hook, p))
+				continue
+			}
+
+			if err := validateOpenAPIOperation(expectedOpenAPI, expectedPath.Post, openAPI, path.Post); err != nil {
+				errs = append(errs, errors.Wrapf(err, "handler %s requestHook %s/%s does not match the OpenAPI spec", handler.Name, handler.RequestHook.APIVersion, handler.RequestHook.Hook))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Wrap(kerrors.NewAggregate(errs), "failed to validate OpenAPI spec")
+	}
+	return nil
+}
+
+// validateOpenAPIOperation validates that an operation uses request and response types with the same name and
+// properties as the expected operation.
+func validateOpenAPIOperation(expectedOpenAPI *spec3.OpenAPI, expected *spec3.Operation, openAPI *spec3.OpenAPI, operation *spec3.Operation) error {
+	var expectedRequest, request *spec.Schema
+	if expected.RequestBody != nil {
+		expectedRequest = contentSchema(expected.RequestBody.Content)
+	}
+	if operation.RequestBody != nil {
+		request = contentSchema(operation.RequestBody.Content)
+	}
+	if err := validateOpenAPISchema(expectedOpenAPI, expectedRequest, openAPI, request); err != nil {
+		return errors.Wrap(err, "invalid request")
+	}
+
+	var expectedResponse, response *spec.Schema
+	if expected.Responses != nil && expected.Responses.StatusCodeResponses[http.StatusOK] != nil {
+		expectedResponse = contentSchema(expected.Responses.StatusCodeResponses[http.StatusOK].Content)
+	}
+	if operation.Responses != nil && operation.Responses.StatusCodeResponses[http.StatusOK] != nil {
+		response = contentSchema(operation.Responses.StatusCodeResponses[http.StatusOK].Content)
+	}
+	if err := validateOpenAPISchema(expectedOpenAPI, expectedResponse, openAPI, response); err != nil {
+		return errors.Wrap(err, "invalid response")
+	}
+	return nil
+}
+
+// validateOpenAPISchema validates that a schema references a type with the same name and properties as the expected schema.
+func validateOpenAPISchema(expectedOpenAPI *spec3.OpenAPI, expected *spec.Schema, openAPI *spec3.OpenAPI, actual *spec.Schema) error {
+	if expected == nil {
+		return nil
+	}
+	if actual == nil {
+		return errors.New("type is not defined")
+	}
+
+	expectedRef, ref := expected.Ref.String(), actual.Ref.String()
+	if expectedRef != ref {
+		return errors.Errorf("type %s is not the expected type %s", ref, expectedRef)
+	}
+
+	expectedComponent := expectedOpenAPI.Components.Schemas[componentNameFromRef(expectedRef)]
+	var component *spec.Schema
+	if openAPI.Components != nil {
+		component = openAPI.Components.Schemas[componentNameFromRef(ref)]
+	}
+	if component == nil {
+		return errors.Errorf("type %s is not defined in components", ref)
+	}
+
+	expectedProperties, properties := sets.KeySet(expectedComponent.Properties), sets.KeySet(component.Properties)
+	if !expectedProperties.Equal(properties) {
+		return errors.Errorf("type %s has properties %v, expected %v", ref, sets.List(properties), sets.List(expectedProperties))
+	}
+	return nil
+}
+
+// contentSchema returns the schema of the JSON content of a request or response.
+func contentSchema(content map[string]*spec3.MediaType) *spec.Schema {
+	mediaType, ok := content["application/json"]
+	if !ok || mediaType == nil {
+		return nil
+	}
+	return mediaType.Schema
+}
+
+// componentNameFromRef returns the name of a component from a reference, e.g.
+// "#/components/schemas/sigs.k8s.io.cluster-api.api.runtime.hooks.v1alpha1.DiscoveryRequest" => "sigs.k8s.io.cluster-api.api.runtime.hooks.v1alpha1.DiscoveryRequest".
+func componentNameFromRef(ref string) string {
+	return strings.TrimPrefix(ref, "#/components/schemas/")
+}
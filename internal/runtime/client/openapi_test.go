@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/kube-openapi/pkg/spec3"
+
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+)
+
+func Test_validateOpenAPI(t *testing.T) {
+	g := NewWithT(t)
+
+	cat := runtimecatalog.New()
+	g.Expect(runtimehooksv1.AddToCatalog(cat)).To(Succeed())
+
+	beforeClusterCreateGVH, err := cat.GroupVersionHook(runtimehooksv1.BeforeClusterCreate)
+	g.Expect(err).ToNot(HaveOccurred())
+	beforeClusterDeleteGVH, err := cat.GroupVersionHook(runtimehooksv1.BeforeClusterDelete)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	discovery := &runtimehooksv1.DiscoveryResponse{
+		Handlers: []runtimehooksv1.ExtensionHandler{
+			{
+				Name: "before-cluster-create",
+				RequestHook: runtimehooksv1.GroupVersionHook{
+					APIVersion: runtimehooksv1.GroupVersion.String(),
+					Hook:       "BeforeClusterCreate",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		openAPI func() *spec3.OpenAPI
+		wantErr bool
+	}{
+		{
+			name: "pass if the OpenAPI spec contains the hooks of the handlers",
+			openAPI: func() *spec3.OpenAPI {
+				openAPI, err := cat.OpenAPIForHooks("v1.0.0", beforeClusterCreateGVH)
+				g.Expect(err).ToNot(HaveOccurred())
+				return openAPI
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail if the OpenAPI spec does not define paths",
+			openAPI: func() *spec3.OpenAPI {
+				return &spec3.OpenAPI{}
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail if the OpenAPI spec does not contain the hooks of the handlers",
+			openAPI: func() *spec3.OpenAPI {
+				openAPI, err := cat.OpenAPIForHooks("v1.0.0", beforeClusterDeleteGVH)
+				g.Expect(err).ToNot(HaveOccurred())
+				return openAPI
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail if the request type in the OpenAPI spec has different properties",
+			openAPI: func() *spec3.OpenAPI {
+				openAPI, err := cat.OpenAPIForHooks("v1.0.0", beforeClusterCreateGVH)
+				g.Expect(err).ToNot(HaveOccurred())
+				delete(openAPI.Components.Schemas["sigs.k8s.io.cluster-api.api.runtime.hooks.v1alpha1.BeforeClusterCreateRequest"].Properties, "cluster")
+				return openAPI
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(*testing.T) {
+			err := validateOpenAPI(cat, tt.openAPI(), discovery)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/internal/runtime/contentencoding"
+)
+
+const (
+	// minGzipRequestSizeBytes is the minimum size of a request to be gzip-encoded; smaller requests are not worth the
+	// compression overhead.
+	minGzipRequestSizeBytes = 16 * 1024
+)
+
+// errRequestEntityTooLarge is returned when the extension rejects a request with 413 Request Entity Too Large.
+var errRequestEntityTooLarge = errors.New("request entity too large")
+
+// gzipRequestSupport tracks the ExtensionConfigs whose Runtime Extensions accept gzip-encoded requests.
+// Extensions advertise it with the Accept-Encoding header in their responses, as defined in RFC 7694.
+type gzipRequestSupport struct {
+	lock      sync.RWMutex
+	supported map[string]bool
+}
+
+func newGzipRequestSupport() *gzipRequestSupport {
+	return &gzipRequestSupport{
+		supported: map[string]bool{},
+	}
+}
+
+// isSupported returns true if the Runtime Extensions of the given ExtensionConfig accept gzip-encoded requests.
+func (g *gzipRequestSupport) isSupported(extensionConfigName string) bool {
+	if g == nil || extensionConfigName == "" {
+		return false
+	}
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return g.supported[extensionConfigName]
+}
+
+// set records if the Runtime Extensions of the given ExtensionConfig accept gzip-encoded requests.
+func (g *gzipRequestSupport) set(extensionConfigName string, supported bool) {
+	if g == nil || extensionConfigName == "" {
+		return
+	}
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if supported {
+		g.supported[extensionConfigName] = true
+		return
+	}
+	delete(g.supported, extensionConfigName)
+}
+
+// encodeRequestBody gzip-encodes the request body if gzip is supported by the extension and the request is large
+// enough; it returns the request body to send together with its content encoding.
+func encodeRequestBody(body []byte, gzipSupported bool) ([]byte, string, error) {
+	if !gzipSupported || len(body) < minGzipRequestSizeBytes {
+		return body, "", nil
+	}
+
+	buf := &bytes.Buffer{}
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write(body); err != nil {
+		return nil, "", errors.Wrap(err, "failed to gzip request body")
+	}
+	if err := w.Close(); err != nil {
+		return nil, "", errors.Wrap(err, "failed to gzip request body")
+	}
+	return buf.Bytes(), contentencoding.Gzip, nil
+}
+
+// readResponseBody reads the body of a response, decoding it according to its content encoding.
+func readResponseBody(resp *http.Response) ([]byte, error) {
+	var body io.Reader = resp.Body
+	switch encoding := resp.Header.Get("Content-Encoding"); encoding {
+	case "", "identity":
+	case contentencoding.Gzip:
+		r, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read gzip-encoded response body")
+		}
+		defer r.Close()
+		body = r
+	default:
+		return nil, errors.Errorf("unsupported content encoding %q", encoding)
+	}
+	return io.ReadAll(body)
+}
+
+// httpCallWithPartialRequests sends GeneratePatches requests larger than maxRequestSizeBytes in multiple partial requests,
+// each with a subset of the items, and merges the partial responses. If the extension rejects a partial request
+// as too large, its items are split further, down to a single item per request.
+// Requests of other hooks are sent as a single request.
+func httpCallWithPartialRequests(ctx context.Context, request, response runtime.Object, opts *httpCallOptions, maxRequestSizeBytes int64) error {
+	generatePatchesRequest, ok := request.(*runtimehooksv1.GeneratePatchesRequest)
+	if !ok {
+		return httpCall(ctx, request, response, opts)
+	}
+	generatePatchesResponse, ok := response.(*runtimehooksv1.GeneratePatchesResponse)
+	if !ok {
+		return httpCall(ctx, request, response, opts)
+	}
+
+	partialRequests, err := splitGeneratePatchesRequest(generatePatchesRequest, maxRequestSizeBytes)
+	if err != nil {
+		return errors.Wrap(err, "http call failed")
+	}
+
+	merged := &runtimehooksv1.GeneratePatchesResponse{}
+	var messages []string
+	for len(partialRequests) > 0 {
+		partialRequest := partialRequests[0]
+		partialRequests = partialRequests[1:]
+
+		partialResponse := &runtimehooksv1.GeneratePatchesResponse{}
+		if err := httpCall(ctx, partialRequest, partialResponse, opts); err != nil {
+			if errors.Is(err, errRequestEntityTooLarge) && len(partialRequest.Items) > 1 {
+				half := len(partialRequest.Items) / 2
+				partialRequests = append([]*runtimehooksv1.GeneratePatchesRequest{
+					withGeneratePatchesRequestItems(partialRequest, partialRequest.Items[:half]),
+					withGeneratePatchesRequestItems(partialRequest, partialRequest.Items[half:]),
+				}, partialRequests...)
+				continue
+			}
+			return err
+		}
+
+		// A partial response which is not successful is the response for the entire request.
+		if partialResponse.GetStatus() != runtimehooksv1.ResponseStatusSuccess {
+			*generatePatchesResponse = *partialResponse
+			return nil
+		}
+		merged.Items = append(merged.Items, partialResponse.Items...)
+		if partialResponse.GetMessage() != "" {
+			messages = append(messages, partialResponse.GetMessage())
+		}
+	}
+
+	merged.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+	merged.SetMessage(strings.Join(messages, ", "))
+	*generatePatchesResponse = *merged
+	return nil
+}
+
+// splitGeneratePatchesRequest splits a GeneratePatchesRequest in partial requests with a subset of the items, so the
+// size of each partial request does not exceed maxRequestSizeBytes; items which exceed maxRequestSizeBytes on their
+// own are sent in a partial request with a single item.
+func splitGeneratePatchesRequest(request *runtimehooksv1.GeneratePatchesRequest, maxRequestSizeBytes int64) ([]*runtimehooksv1.GeneratePatchesRequest, error) {
+	size, err := jsonSize(request)
+	if err != nil {
+		return nil, err
+	}
+	if maxRequestSizeBytes <= 0 || size <= maxRequestSizeBytes || len(request.Items) <= 1 {
+		return []*runtimehooksv1.GeneratePatchesRequest{request}, nil
+	}
+
+	// Compute the size of the request without items, which is common to all the partial requests.
+	baseSize, err := jsonSize(withGeneratePatchesRequestItems(request, []runtimehooksv1.GeneratePatchesRequestItem{}))
+	if err != nil {
+		return nil, err
+	}
+
+	var partialRequests []*runtimehooksv1.GeneratePatchesRequest
+	var items []runtimehooksv1.GeneratePatchesRequestItem
+	partialSize := baseSize
+	for _, item := range request.Items {
+		itemSize, err := jsonSize(item)
+		if err != nil {
+			return nil, err
+		}
+		// Account for the separator between items.
+		itemSize++
+
+		if len(items) > 0 && partialSize+itemSize > maxRequestSizeBytes {
+			partialRequests = append(partialRequests, withGeneratePatchesRequestItems(request, items))
+			items = nil
+			partialSize = baseSize
+		}
+		items = append(items, item)
+		partialSize += itemSize
+	}
+	partialRequests = append(partialRequests, withGeneratePatchesRequestItems(request, items))
+	return partialRequests, nil
+}
+
+// withGeneratePatchesRequestItems returns a shallow copy of a GeneratePatchesRequest with the given items.
+func withGeneratePatchesRequestItems(request *runtimehooksv1.GeneratePatchesRequest, items []runtimehooksv1.GeneratePatchesRequestItem) *runtimehooksv1.GeneratePatchesRequest {
+	partialRequest := *request
+	partialRequest.Items = items
+	return &partialRequest
+}
+
+func jsonSize(obj interface{}) (int64, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to compute request size")
+	}
+	return int64(len(data)), nil
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/admission/plugin/webhook/testcerts"
+
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	"sigs.k8s.io/cluster-api/internal/runtime/contentencoding"
+)
+
+func TestSplitGeneratePatchesRequest(t *testing.T) {
+	request := generatePatchesRequest(10, 100)
+	itemSize, err := jsonSize(request.Items[0])
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	tests := []struct {
+		name                string
+		maxRequestSizeBytes int64
+		wantItemsPerRequest []int
+	}{
+		{
+			name:                "no limit",
+			maxRequestSizeBytes: 0,
+			wantItemsPerRequest: []int{10},
+		},
+		{
+			name:                "request smaller than the limit",
+			maxRequestSizeBytes: 1024 * 1024,
+			wantItemsPerRequest: []int{10},
+		},
+		{
+			name:                "request split in partial requests",
+			maxRequestSizeBytes: 4*(itemSize+1) + 100,
+			wantItemsPerRequest: []int{4, 4, 2},
+		},
+		{
+			name:                "items larger than the limit are sent one by one",
+			maxRequestSizeBytes: 1,
+			wantItemsPerRequest: []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			partialRequests, err := splitGeneratePatchesRequest(request, tt.maxRequestSizeBytes)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			itemsPerRequest := []int{}
+			var uids []types.UID
+			for _, partialRequest := range partialRequests {
+				itemsPerRequest = append(itemsPerRequest, len(partialRequest.Items))
+				for _, item := range partialRequest.Items {
+					uids = append(uids, item.UID)
+				}
+				if tt.maxRequestSizeBytes > 1 {
+					g.Expect(jsonSize(partialRequest)).To(BeNumerically("<=", tt.maxRequestSizeBytes))
+				}
+			}
+			g.Expect(itemsPerRequest).To(Equal(tt.wantItemsPerRequest))
+
+			// All the items are sent once and in order.
+			var wantUIDs []types.UID
+			for _, item := range request.Items {
+				wantUIDs = append(wantUIDs, item.UID)
+			}
+			g.Expect(uids).To(Equal(wantUIDs))
+		})
+	}
+}
+
+func TestHTTPCallWithPartialRequests(t *testing.T) {
+	tests := []struct {
+		name                string
+		maxRequestSizeBytes int64
+		maxItemsPerRequest  int
+		failItem            types.UID
+		wantRequests        int
+		wantErr             bool
+		wantStatus          runtimehooksv1.ResponseStatus
+	}{
+		{
+			name:               "single request",
+			maxItemsPerRequest: 8,
+			wantRequests:       1,
+			wantStatus:         runtimehooksv1.ResponseStatusSuccess,
+		},
+		{
+			name:                "request split in partial requests by size",
+			maxRequestSizeBytes: 3 * 1024,
+			maxItemsPerRequest:  8,
+			wantRequests:        4,
+			wantStatus:          runtimehooksv1.ResponseStatusSuccess,
+		},
+		{
+			name:               "request split in partial requests after 413 Request Entity Too Large",
+			maxItemsPerRequest: 3,
+			// 8 => 4+4 => 2+2+2+2.
+			wantRequests: 7,
+			wantStatus:   runtimehooksv1.ResponseStatusSuccess,
+		},
+		{
+			name:               "error if a single item is rejected with 413 Request Entity Too Large",
+			maxItemsPerRequest: 0,
+			wantErr:            true,
+		},
+		{
+			name:                "failure of a partial request is the response of the entire request",
+			maxRequestSizeBytes: 3 * 1024,
+			maxItemsPerRequest:  8,
+			failItem:            "item-3",
+			wantRequests:        2,
+			wantStatus:          runtimehooksv1.ResponseStatusFailure,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			requests := 0
+			mux := http.NewServeMux()
+			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				request := &runtimehooksv1.GeneratePatchesRequest{}
+				if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+					panic(err)
+				}
+				if len(request.Items) > tt.maxItemsPerRequest {
+					w.WriteHeader(http.StatusRequestEntityTooLarge)
+					return
+				}
+
+				response := &runtimehooksv1.GeneratePatchesResponse{}
+				response.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+				response.SetMessage(fmt.Sprintf("request %d", requests))
+				for _, item := range request.Items {
+					if item.UID == tt.failItem {
+						response.SetStatus(runtimehooksv1.ResponseStatusFailure)
+						response.SetMessage(fmt.Sprintf("failed to generate patches for %s", item.UID))
+					}
+					response.Items = append(response.Items, runtimehooksv1.GeneratePatchesResponseItem{
+						UID:       item.UID,
+						PatchType: runtimehooksv1.JSONPatchType,
+						Patch:     []byte("[]"),
+					})
+				}
+				writeJSON(w, response)
+			})
+			srv := newUnstartedTLSServer(mux)
+			srv.StartTLS()
+			defer srv.Close()
+
+			request := generatePatchesRequest(8, 900)
+			response := &runtimehooksv1.GeneratePatchesResponse{}
+			err := httpCallWithPartialRequests(context.TODO(), request, response, generatePatchesHTTPCallOptions(g, srv.URL), tt.maxRequestSizeBytes)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(requests).To(Equal(tt.wantRequests))
+			g.Expect(response.GetStatus()).To(Equal(tt.wantStatus))
+			if tt.wantStatus != runtimehooksv1.ResponseStatusSuccess {
+				g.Expect(response.GetMessage()).To(Equal(fmt.Sprintf("failed to generate patches for %s", tt.failItem)))
+				return
+			}
+
+			g.Expect(response.Items).To(HaveLen(len(request.Items)))
+			for i := range request.Items {
+				g.Expect(response.Items[i].UID).To(Equal(request.Items[i].UID))
+			}
+		})
+	}
+}
+
+func TestHTTPCallGzip(t *testing.T) {
+	tests := []struct {
+		name          string
+		acceptGzip    bool
+		rejectGzip    bool
+		wantEncodings []string
+	}{
+		{
+			name:          "requests are not gzip-encoded if the extension does not accept gzip",
+			wantEncodings: []string{"", ""},
+		},
+		{
+			name:          "requests are gzip-encoded after the extension advertised it accepts gzip",
+			acceptGzip:    true,
+			wantEncodings: []string{"", contentencoding.Gzip},
+		},
+		{
+			name:       "requests are retried without gzip if the extension responds with 415 Unsupported Media Type",
+			acceptGzip: true,
+			rejectGzip: true,
+			// The second call is retried without gzip; the third call is gzip-encoded again, because the extension
+			// advertised again it accepts gzip.
+			wantEncodings: []string{"", contentencoding.Gzip, "", contentencoding.Gzip, ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var encodings []string
+			mux := http.NewServeMux()
+			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				encodings = append(encodings, r.Header.Get("Content-Encoding"))
+				if tt.acceptGzip {
+					w.Header().Set("Accept-Encoding", contentencoding.Gzip)
+				}
+
+				var body io.Reader = r.Body
+				if r.Header.Get("Content-Encoding") == contentencoding.Gzip {
+					if tt.rejectGzip {
+						w.WriteHeader(http.StatusUnsupportedMediaType)
+						return
+					}
+					gzipReader, err := gzip.NewReader(r.Body)
+					if err != nil {
+						panic(err)
+					}
+					body = gzipReader
+				}
+				request := &runtimehooksv1.GeneratePatchesRequest{}
+				if err := json.NewDecoder(body).Decode(request); err != nil {
+					panic(err)
+				}
+
+				response := &runtimehooksv1.GeneratePatchesResponse{}
+				response.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+				responseBody, err := json.Marshal(response)
+				if err != nil {
+					panic(err)
+				}
+				if tt.acceptGzip && r.Header.Get("Accept-Encoding") == contentencoding.Gzip {
+					w.Header().Set("Content-Encoding", contentencoding.Gzip)
+					gzipWriter := gzip.NewWriter(w)
+					_, _ = gzipWriter.Write(responseBody)
+					_ = gzipWriter.Close()
+					return
+				}
+				_, _ = w.Write(responseBody)
+			})
+			srv := newUnstartedTLSServer(mux)
+			srv.StartTLS()
+			defer srv.Close()
+
+			opts := generatePatchesHTTPCallOptions(g, srv.URL)
+			opts.extensionConfigName = "extension"
+			opts.gzipRequests = newGzipRequestSupport()
+			calls := 2
+			if tt.rejectGzip {
+				calls = 3
+			}
+			for range calls {
+				response := &runtimehooksv1.GeneratePatchesResponse{}
+				g.Expect(httpCall(context.TODO(), generatePatchesRequest(10, minGzipRequestSizeBytes/4), response, opts)).To(Succeed())
+				g.Expect(response.GetStatus()).To(Equal(runtimehooksv1.ResponseStatusSuccess))
+			}
+			g.Expect(encodings).To(Equal(tt.wantEncodings))
+		})
+	}
+}
+
+func generatePatchesHTTPCallOptions(g *WithT, url string) *httpCallOptions {
+	c := runtimecatalog.New()
+	g.Expect(runtimehooksv1.AddToCatalog(c)).To(Succeed())
+	gvh, err := c.GroupVersionHook(runtimehooksv1.GeneratePatches)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	opts := &httpCallOptions{
+		catalog:         c,
+		registrationGVH: gvh,
+		hookGVH:         gvh,
+		name:            "generate-patches",
+	}
+	opts.config.URL = url
+	opts.config.CABundle = testcerts.CACert
+	return opts
+}
+
+// generatePatchesRequest returns a GeneratePatchesRequest with the given number of items, each with an object of
+// roughly the given size.
+func generatePatchesRequest(items, size int) *runtimehooksv1.GeneratePatchesRequest {
+	request := &runtimehooksv1.GeneratePatchesRequest{}
+	for i := range items {
+		request.Items = append(request.Items, runtimehooksv1.GeneratePatchesRequestItem{
+			UID: types.UID(fmt.Sprintf("item-%d", i)),
+			Object: runtime.RawExtension{
+				Raw: []byte(fmt.Sprintf(`{"spec":{"data":%q}}`, strings.Repeat("a", size))),
+			},
+		})
+	}
+	return request
+}
+
+func writeJSON(w http.ResponseWriter, obj interface{}) {
+	body, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contentencoding
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestAcceptsGzip(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   bool
+	}{
+		{
+			name:   "no Accept-Encoding header",
+			values: nil,
+			want:   false,
+		},
+		{
+			name:   "gzip",
+			values: []string{"gzip"},
+			want:   true,
+		},
+		{
+			name:   "gzip in a list of encodings",
+			values: []string{"deflate, GZIP;q=0.5"},
+			want:   true,
+		},
+		{
+			name:   "gzip in a second header value",
+			values: []string{"deflate", "gzip"},
+			want:   true,
+		},
+		{
+			name:   "gzip not acceptable",
+			values: []string{"gzip;q=0"},
+			want:   false,
+		},
+		{
+			name:   "other encodings",
+			values: []string{"br, identity"},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(AcceptsGzip(tt.values)).To(Equal(tt.want))
+		})
+	}
+}
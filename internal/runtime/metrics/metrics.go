@@ -35,6 +35,8 @@ func init() {
 	// Register the metrics at the controller-runtime metrics registry.
 	ctrlmetrics.Registry.MustRegister(RequestsTotal.metric)
 	ctrlmetrics.Registry.MustRegister(RequestDuration.metric)
+	ctrlmetrics.Registry.MustRegister(RequestSize.metric)
+	ctrlmetrics.Registry.MustRegister(ResponseSize.metric)
 }
 
 // Metrics subsystem and all of the keys used by the Runtime SDK.
@@ -62,6 +64,24 @@ var (
 				4, 5, 6, 8, 10, 15, 20, 30, 45, 60},
 		}, []string{"host", "group", "version", "hook"}),
 	}
+	// RequestSize reports the size of the request payloads in bytes, before compression.
+	RequestSize = payloadSizeObserver{
+		prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem: runtimeSDKSubsystem,
+			Name:      "request_size_bytes",
+			Help:      "Request payload size in bytes before compression, broken down by hook, host and content encoding.",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 9),
+		}, []string{"host", "group", "version", "hook", "content_encoding"}),
+	}
+	// ResponseSize reports the size of the response payloads in bytes, after decompression.
+	ResponseSize = payloadSizeObserver{
+		prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem: runtimeSDKSubsystem,
+			Name:      "response_size_bytes",
+			Help:      "Response payload size in bytes after decompression, broken down by hook, host and content encoding.",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 9),
+		}, []string{"host", "group", "version", "hook", "content_encoding"}),
+	}
 )
 
 type requestsTotalObserver struct {
@@ -96,3 +116,15 @@ type requestDurationObserver struct {
 func (m *requestDurationObserver) Observe(gvh runtimecatalog.GroupVersionHook, u url.URL, latency time.Duration) {
 	m.metric.WithLabelValues(u.Host, gvh.Group, gvh.Version, gvh.Hook).Observe(latency.Seconds())
 }
+
+type payloadSizeObserver struct {
+	metric *prometheus.HistogramVec
+}
+
+// Observe observes the size of a payload sent to or received from the given host and gvh.
+func (m *payloadSizeObserver) Observe(gvh runtimecatalog.GroupVersionHook, u url.URL, contentEncoding string, size int) {
+	if contentEncoding == "" {
+		contentEncoding = "identity"
+	}
+	m.metric.WithLabelValues(u.Host, gvh.Group, gvh.Version, gvh.Hook, contentEncoding).Observe(float64(size))
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package registry
 
 import (
+	"path"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -50,10 +53,23 @@ type ExtensionRegistry interface {
 	Remove(extensionConfig *runtimev1.ExtensionConfig) error
 
 	// List lists all registered RuntimeExtensions for a given catalog.GroupHook.
-	List(gh runtimecatalog.GroupHook) ([]*ExtensionRegistration, error)
+	// ListOptions can be used to further filter the returned RuntimeExtensions.
+	List(gh runtimecatalog.GroupHook, opts ...ListOption) ([]*ExtensionRegistration, error)
+
+	// ListAll lists all registered RuntimeExtensions, sorted by name.
+	// ListOptions can be used to filter the returned RuntimeExtensions.
+	// Note: ListAll is intended for debugging and diagnostics, callers of RuntimeExtensions should use List.
+	ListAll(opts ...ListOption) ([]*ExtensionRegistration, error)
 
 	// Get gets the RuntimeExtensions with the given name.
 	Get(name string) (*ExtensionRegistration, error)
+
+	// AddChangeListener adds a func which is called with the name of an ExtensionConfig
+	// every time the RuntimeExtensions of the ExtensionConfig are changed or removed, e.g. to
+	// invalidate responses of the RuntimeExtensions which have been cached.
+	// Note: RuntimeExtensions are considered changed if the generation of the ExtensionConfig
+	// or the ExtensionHandlers in its status are changed.
+	AddChangeListener(listener func(extensionConfigName string))
 }
 
 // ExtensionRegistration contains information about a registered RuntimeExtension.
@@ -67,6 +83,9 @@ type ExtensionRegistration struct {
 	// ExtensionConfigResourceVersion is the ResourceVersion of the corresponding ExtensionConfig.
 	ExtensionConfigResourceVersion string
 
+	// ExtensionConfigGeneration is the Generation of the corresponding ExtensionConfig.
+	ExtensionConfigGeneration int64
+
 	// GroupVersionHook is the GroupVersionHook that the RuntimeExtension implements.
 	GroupVersionHook runtimecatalog.GroupVersionHook
 
@@ -76,6 +95,10 @@ type ExtensionRegistration struct {
 	// ClientConfig is the ClientConfig to communicate with the RuntimeExtension.
 	ClientConfig runtimev1.ClientConfig
 
+	// SecondaryClientConfig is the ClientConfig of the secondary Extension server of the RuntimeExtension, if any,
+	// together with the percentage of the calls which are sent to it.
+	SecondaryClientConfig runtimev1.WeightedClientConfig
+
 	// TimeoutSeconds is the timeout duration used for calls to the RuntimeExtension.
 	TimeoutSeconds int32
 
@@ -86,12 +109,114 @@ type ExtensionRegistration struct {
 	Settings map[string]string
 }
 
+// ListOption is some configuration that modifies options for a List or ListAll call.
+type ListOption interface {
+	// ApplyToList applies this configuration to the given ListOptions.
+	ApplyToList(*ListOptions)
+}
+
+// ListOptions contains options to filter RuntimeExtensions when calling List or ListAll.
+// All the options which are set must match for a RuntimeExtension to be returned.
+type ListOptions struct {
+	// ExtensionConfigName only returns RuntimeExtensions of the ExtensionConfig with this name.
+	ExtensionConfigName string
+
+	// HandlerNamePattern only returns RuntimeExtensions with a name matching this pattern.
+	// The pattern uses the syntax of path.Match, e.g. "*.my-extension".
+	HandlerNamePattern string
+
+	// FailurePolicy only returns RuntimeExtensions with this failure policy.
+	FailurePolicy runtimev1.FailurePolicy
+
+	// Settings only returns RuntimeExtensions having all these settings with the same values.
+	Settings map[string]string
+}
+
+// ApplyOptions applies the given ListOptions.
+func (o *ListOptions) ApplyOptions(opts []ListOption) *ListOptions {
+	for _, opt := range opts {
+		opt.ApplyToList(o)
+	}
+	return o
+}
+
+// WithExtensionConfigName filters RuntimeExtensions by the name of their ExtensionConfig.
+type WithExtensionConfigName string
+
+// ApplyToList applies this configuration to the given ListOptions.
+func (w WithExtensionConfigName) ApplyToList(o *ListOptions) {
+	o.ExtensionConfigName = string(w)
+}
+
+// WithHandlerNamePattern filters RuntimeExtensions by name, using the syntax of path.Match.
+type WithHandlerNamePattern string
+
+// ApplyToList applies this configuration to the given ListOptions.
+func (w WithHandlerNamePattern) ApplyToList(o *ListOptions) {
+	o.HandlerNamePattern = string(w)
+}
+
+// WithFailurePolicy filters RuntimeExtensions by failure policy.
+type WithFailurePolicy runtimev1.FailurePolicy
+
+// ApplyToList applies this configuration to the given ListOptions.
+func (w WithFailurePolicy) ApplyToList(o *ListOptions) {
+	o.FailurePolicy = runtimev1.FailurePolicy(w)
+}
+
+// WithSettings filters RuntimeExtensions by settings; all the given settings must be set with the same values.
+type WithSettings map[string]string
+
+// ApplyToList applies this configuration to the given ListOptions.
+func (w WithSettings) ApplyToList(o *ListOptions) {
+	if o.Settings == nil {
+		o.Settings = map[string]string{}
+	}
+	for k, v := range w {
+		o.Settings[k] = v
+	}
+}
+
+// validate validates the ListOptions.
+func (o *ListOptions) validate() error {
+	if o.HandlerNamePattern != "" {
+		if _, err := path.Match(o.HandlerNamePattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid argument: handler name pattern %q is not valid", o.HandlerNamePattern)
+		}
+	}
+	return nil
+}
+
+// matches returns true if the registration matches all the ListOptions which are set.
+// Note: validate must be called before matches, so errors from path.Match can be ignored.
+func (o *ListOptions) matches(registration *ExtensionRegistration) bool {
+	if o.ExtensionConfigName != "" && registration.ExtensionConfigName != o.ExtensionConfigName {
+		return false
+	}
+	if o.HandlerNamePattern != "" {
+		if ok, _ := path.Match(o.HandlerNamePattern, registration.Name); !ok {
+			return false
+		}
+	}
+	if o.FailurePolicy != "" && registration.FailurePolicy != o.FailurePolicy {
+		return false
+	}
+	for k, v := range o.Settings {
+		if value, ok := registration.Settings[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
 // extensionRegistry is an implementation of ExtensionRegistry.
 type extensionRegistry struct {
 	// ready represents if the registry has been warmed up.
 	ready bool
 	// items contains the registry entries.
 	items map[string]*ExtensionRegistration
+	// listeners are called when the RuntimeExtensions of an ExtensionConfig are changed or removed.
+	listeners []func(extensionConfigName string)
 	// lock is used to synchronize access to fields of the extensionRegistry.
 	lock sync.RWMutex
 }
@@ -154,13 +279,21 @@ func (r *extensionRegistry) Add(extensionConfig *runtimev1.ExtensionConfig) erro
 	}
 
 	r.lock.Lock()
-	defer r.lock.Unlock()
 
 	if !r.ready {
+		r.lock.Unlock()
 		return errors.Errorf("failed to add ExtensionConfig %q to registry: invalid operation: Add cannot be called on a registry which has not been warmed up", extensionConfig.Name)
 	}
 
-	return r.add(extensionConfig)
+	previous := r.registrationsFor(extensionConfig.Name)
+	err := r.add(extensionConfig)
+	changed := registrationsChanged(previous, r.registrationsFor(extensionConfig.Name))
+	r.lock.Unlock()
+
+	if changed {
+		r.notify(extensionConfig.Name)
+	}
+	return err
 }
 
 // Remove removes all RuntimeExtensions corresponding to the provided ExtensionConfig.
@@ -170,16 +303,68 @@ func (r *extensionRegistry) Remove(extensionConfig *runtimev1.ExtensionConfig) e
 	}
 
 	r.lock.Lock()
-	defer r.lock.Unlock()
 
 	if !r.ready {
+		r.lock.Unlock()
 		return errors.Errorf("failed to remove ExtensionConfig %q from registry: invalid operation: Remove cannot be called on a registry which has not been warmed up", extensionConfig.Name)
 	}
 
+	changed := len(r.registrationsFor(extensionConfig.Name)) > 0
 	r.remove(extensionConfig)
+	r.lock.Unlock()
+
+	if changed {
+		r.notify(extensionConfig.Name)
+	}
 	return nil
 }
 
+// AddChangeListener adds a func which is called with the name of an ExtensionConfig
+// every time the RuntimeExtensions of the ExtensionConfig are changed or removed.
+func (r *extensionRegistry) AddChangeListener(listener func(extensionConfigName string)) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.listeners = append(r.listeners, listener)
+}
+
+// notify calls the listeners for the given ExtensionConfig.
+// Note: notify must be called without holding the lock, so listeners can use the registry.
+func (r *extensionRegistry) notify(extensionConfigName string) {
+	r.lock.RLock()
+	listeners := append([]func(string){}, r.listeners...)
+	r.lock.RUnlock()
+
+	for _, listener := range listeners {
+		listener(extensionConfigName)
+	}
+}
+
+// registrationsFor returns the registrations of the given ExtensionConfig.
+func (r *extensionRegistry) registrationsFor(extensionConfigName string) map[string]*ExtensionRegistration {
+	registrations := map[string]*ExtensionRegistration{}
+	for _, e := range r.items {
+		if e.ExtensionConfigName == extensionConfigName {
+			registrations[e.Name] = e
+		}
+	}
+	return registrations
+}
+
+// registrationsChanged returns true if the generation of the ExtensionConfig or the registered ExtensionHandlers changed.
+func registrationsChanged(previous, current map[string]*ExtensionRegistration) bool {
+	if len(previous) != len(current) {
+		return true
+	}
+	for name, p := range previous {
+		c, ok := current[name]
+		if !ok || p.ExtensionConfigGeneration != c.ExtensionConfigGeneration || p.GroupVersionHook != c.GroupVersionHook {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *extensionRegistry) remove(extensionConfig *runtimev1.ExtensionConfig) {
 	for _, e := range r.items {
 		if e.ExtensionConfigName == extensionConfig.Name {
@@ -189,7 +374,8 @@ func (r *extensionRegistry) remove(extensionConfig *runtimev1.ExtensionConfig) {
 }
 
 // List lists all registered RuntimeExtensions for a given catalog.GroupHook.
-func (r *extensionRegistry) List(gh runtimecatalog.GroupHook) ([]*ExtensionRegistration, error) {
+// ListOptions can be used to further filter the returned RuntimeExtensions.
+func (r *extensionRegistry) List(gh runtimecatalog.GroupHook, opts ...ListOption) ([]*ExtensionRegistration, error) {
 	if gh.Group == "" {
 		return nil, errors.New("failed to list extension handlers: invalid argument: when calling List gh.Group must not be empty")
 	}
@@ -197,6 +383,11 @@ func (r *extensionRegistry) List(gh runtimecatalog.GroupHook) ([]*ExtensionRegis
 		return nil, errors.New("failed to list extension handlers: invalid argument: when calling List gh.Hook must not be empty")
 	}
 
+	listOptions := (&ListOptions{}).ApplyOptions(opts)
+	if err := listOptions.validate(); err != nil {
+		return nil, errors.Wrapf(err, "failed to list extension handlers for GroupHook %q", gh.String())
+	}
+
 	r.lock.RLock()
 	defer r.lock.RUnlock()
 
@@ -206,13 +397,40 @@ func (r *extensionRegistry) List(gh runtimecatalog.GroupHook) ([]*ExtensionRegis
 
 	l := []*ExtensionRegistration{}
 	for _, registration := range r.items {
-		if registration.GroupVersionHook.Group == gh.Group && registration.GroupVersionHook.Hook == gh.Hook {
+		if registration.GroupVersionHook.Group == gh.Group && registration.GroupVersionHook.Hook == gh.Hook && listOptions.matches(registration) {
 			l = append(l, registration)
 		}
 	}
 	return l, nil
 }
 
+// ListAll lists all registered RuntimeExtensions, sorted by name.
+// ListOptions can be used to filter the returned RuntimeExtensions.
+func (r *extensionRegistry) ListAll(opts ...ListOption) ([]*ExtensionRegistration, error) {
+	listOptions := (&ListOptions{}).ApplyOptions(opts)
+	if err := listOptions.validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to list extension handlers")
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	if !r.ready {
+		return nil, errors.New("failed to list extension handlers: invalid operation: ListAll cannot be called on a registry which has not been warmed up")
+	}
+
+	l := []*ExtensionRegistration{}
+	for _, registration := range r.items {
+		if listOptions.matches(registration) {
+			l = append(l, registration)
+		}
+	}
+	slices.SortFunc(l, func(a, b *ExtensionRegistration) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return l, nil
+}
+
 // Get gets the RuntimeExtensions with the given name.
 func (r *extensionRegistry) Get(name string) (*ExtensionRegistration, error) {
 	r.lock.RLock()
@@ -248,21 +466,27 @@ func (r *extensionRegistry) add(extensionConfig *runtimev1.ExtensionConfig) erro
 			continue
 		}
 
+		// Apply the overrides defined in the ExtensionConfig spec, which take precedence over
+		// the timeout and failure policy returned by the Extension during discovery.
+		timeoutSeconds, failurePolicy := handlerTimeoutAndFailurePolicy(extensionConfig, e)
+
 		// Registrations will only be added to the registry if no errors occur (all or nothing).
 		registrations = append(registrations, &ExtensionRegistration{
 			ExtensionConfigName:            extensionConfig.Name,
 			ExtensionConfigResourceVersion: extensionConfig.ResourceVersion,
+			ExtensionConfigGeneration:      extensionConfig.Generation,
 			Name:                           e.Name,
 			GroupVersionHook: runtimecatalog.GroupVersionHook{
 				Group:   gv.Group,
 				Version: gv.Version,
 				Hook:    e.RequestHook.Hook,
 			},
-			NamespaceSelector: selector,
-			ClientConfig:      extensionConfig.Spec.ClientConfig,
-			TimeoutSeconds:    e.TimeoutSeconds,
-			FailurePolicy:     e.FailurePolicy,
-			Settings:          extensionConfig.Spec.Settings,
+			NamespaceSelector:     selector,
+			ClientConfig:          extensionConfig.Spec.ClientConfig,
+			SecondaryClientConfig: extensionConfig.Spec.SecondaryClientConfig,
+			TimeoutSeconds:        timeoutSeconds,
+			FailurePolicy:         failurePolicy,
+			Settings:              extensionConfig.Spec.Settings,
 		})
 	}
 
@@ -276,3 +500,28 @@ func (r *extensionRegistry) add(extensionConfig *runtimev1.ExtensionConfig) erro
 
 	return nil
 }
+
+// handlerTimeoutAndFailurePolicy returns the timeout and the failure policy for an ExtensionHandler,
+// applying the overrides for the hook of the handler first, and then the overrides for the handler itself.
+func handlerTimeoutAndFailurePolicy(extensionConfig *runtimev1.ExtensionConfig, handler runtimev1.ExtensionHandler) (int32, runtimev1.FailurePolicy) {
+	timeoutSeconds, failurePolicy := handler.TimeoutSeconds, handler.FailurePolicy
+	apply := func(o runtimev1.ExtensionHandlerOverride) {
+		if o.TimeoutSeconds != nil {
+			timeoutSeconds = *o.TimeoutSeconds
+		}
+		if o.FailurePolicy != "" {
+			failurePolicy = o.FailurePolicy
+		}
+	}
+	for _, o := range extensionConfig.Spec.HandlerOverrides {
+		if o.Name == "" && o.Hook == handler.RequestHook.Hook {
+			apply(o)
+		}
+	}
+	for _, o := range extensionConfig.Spec.HandlerOverrides {
+		if o.Name != "" && o.Name == handler.Name {
+			apply(o)
+		}
+	}
+	return timeoutSeconds, failurePolicy
+}
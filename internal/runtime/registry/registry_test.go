@@ -24,6 +24,7 @@ import (
 	"github.com/onsi/gomega/types"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
 	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
@@ -40,6 +41,8 @@ func TestColdRegistry(t *testing.T) {
 	g.Expect(r.Remove(&runtimev1.ExtensionConfig{})).ToNot(Succeed())
 	_, err := r.List(runtimecatalog.GroupHook{Group: "foo", Hook: "bak"})
 	g.Expect(err).To(HaveOccurred())
+	_, err = r.ListAll()
+	g.Expect(err).To(HaveOccurred())
 	_, err = r.Get("foo")
 	g.Expect(err).To(HaveOccurred())
 }
@@ -198,6 +201,151 @@ func TestRegistry(t *testing.T) {
 	g.Expect(registrations).To(ContainExtension("qux.extension2"))
 }
 
+func TestRegistryListOptions(t *testing.T) {
+	extension1 := &runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "extension1",
+		},
+		Spec: runtimev1.ExtensionConfigSpec{
+			Settings: map[string]string{"env": "prod", "team": "a"},
+		},
+		Status: runtimev1.ExtensionConfigStatus{
+			Handlers: []runtimev1.ExtensionHandler{
+				{
+					Name: "foo.extension1",
+					RequestHook: runtimev1.GroupVersionHook{
+						APIVersion: "hook.runtime.cluster.x-k8s.io/v1alpha1",
+						Hook:       "BeforeClusterUpgrade",
+					},
+					FailurePolicy: runtimev1.FailurePolicyFail,
+				},
+				{
+					Name: "bar.extension1",
+					RequestHook: runtimev1.GroupVersionHook{
+						APIVersion: "hook.runtime.cluster.x-k8s.io/v1alpha1",
+						Hook:       "AfterClusterUpgrade",
+					},
+					FailurePolicy: runtimev1.FailurePolicyIgnore,
+				},
+			},
+		},
+	}
+	extension2 := &runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "extension2",
+		},
+		Spec: runtimev1.ExtensionConfigSpec{
+			Settings: map[string]string{"env": "dev"},
+		},
+		Status: runtimev1.ExtensionConfigStatus{
+			Handlers: []runtimev1.ExtensionHandler{
+				{
+					Name: "foo.extension2",
+					RequestHook: runtimev1.GroupVersionHook{
+						APIVersion: "hook.runtime.cluster.x-k8s.io/v1alpha1",
+						Hook:       "BeforeClusterUpgrade",
+					},
+					FailurePolicy: runtimev1.FailurePolicyIgnore,
+				},
+			},
+		},
+	}
+
+	e := New()
+	g := NewWithT(t)
+	g.Expect(e.WarmUp(&runtimev1.ExtensionConfigList{Items: []runtimev1.ExtensionConfig{*extension1, *extension2}})).To(Succeed())
+
+	beforeClusterUpgrade := runtimecatalog.GroupHook{Group: "hook.runtime.cluster.x-k8s.io", Hook: "BeforeClusterUpgrade"}
+
+	tests := []struct {
+		name      string
+		gh        *runtimecatalog.GroupHook
+		opts      []ListOption
+		want      []string
+		wantError bool
+	}{
+		{
+			name: "ListAll returns all the extensions sorted by name",
+			want: []string{"bar.extension1", "foo.extension1", "foo.extension2"},
+		},
+		{
+			name: "ListAll filters by ExtensionConfig name",
+			opts: []ListOption{WithExtensionConfigName("extension1")},
+			want: []string{"bar.extension1", "foo.extension1"},
+		},
+		{
+			name: "ListAll filters by handler name pattern",
+			opts: []ListOption{WithHandlerNamePattern("foo.*")},
+			want: []string{"foo.extension1", "foo.extension2"},
+		},
+		{
+			name: "ListAll filters by failure policy",
+			opts: []ListOption{WithFailurePolicy(runtimev1.FailurePolicyIgnore)},
+			want: []string{"bar.extension1", "foo.extension2"},
+		},
+		{
+			name: "ListAll filters by settings",
+			opts: []ListOption{WithSettings{"env": "prod"}},
+			want: []string{"bar.extension1", "foo.extension1"},
+		},
+		{
+			name: "ListAll filters by all the options",
+			opts: []ListOption{WithExtensionConfigName("extension1"), WithHandlerNamePattern("*.extension1"), WithFailurePolicy(runtimev1.FailurePolicyFail), WithSettings{"team": "a"}},
+			want: []string{"foo.extension1"},
+		},
+		{
+			name: "ListAll returns no extensions if settings do not match",
+			opts: []ListOption{WithSettings{"env": "prod", "team": "b"}},
+			want: []string{},
+		},
+		{
+			name:      "ListAll fails with an invalid handler name pattern",
+			opts:      []ListOption{WithHandlerNamePattern("[")},
+			wantError: true,
+		},
+		{
+			name: "List filters by GroupHook and options",
+			gh:   &beforeClusterUpgrade,
+			opts: []ListOption{WithFailurePolicy(runtimev1.FailurePolicyIgnore)},
+			want: []string{"foo.extension2"},
+		},
+		{
+			name:      "List fails with an invalid handler name pattern",
+			gh:        &beforeClusterUpgrade,
+			opts:      []ListOption{WithHandlerNamePattern("[")},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var registrations []*ExtensionRegistration
+			var err error
+			if tt.gh != nil {
+				registrations, err = e.List(*tt.gh, tt.opts...)
+			} else {
+				registrations, err = e.ListAll(tt.opts...)
+			}
+			if tt.wantError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			names := []string{}
+			for _, r := range registrations {
+				names = append(names, r.Name)
+			}
+			if tt.gh != nil {
+				g.Expect(names).To(ConsistOf(tt.want))
+			} else {
+				g.Expect(names).To(Equal(tt.want))
+			}
+		})
+	}
+}
+
 func ContainExtension(name string) types.GomegaMatcher {
 	return &ContainExtensionMatcher{
 		name: name,
@@ -229,3 +377,142 @@ func (matcher *ContainExtensionMatcher) FailureMessage(actual interface{}) (mess
 func (matcher *ContainExtensionMatcher) NegatedFailureMessage(actual interface{}) (message string) {
 	return format.Message(actual, "not to contain element matching", matcher.name)
 }
+
+func TestRegistryChangeListener(t *testing.T) {
+	g := NewWithT(t)
+
+	extension := &runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "extension",
+			Generation:      1,
+			ResourceVersion: "1",
+		},
+		Status: runtimev1.ExtensionConfigStatus{
+			Handlers: []runtimev1.ExtensionHandler{
+				{
+					Name: "handler.extension",
+					RequestHook: runtimev1.GroupVersionHook{
+						APIVersion: "foo/v1alpha1",
+						Hook:       "bak",
+					},
+				},
+			},
+		},
+	}
+
+	r := New()
+	g.Expect(r.WarmUp(&runtimev1.ExtensionConfigList{})).To(Succeed())
+
+	var changed []string
+	r.AddChangeListener(func(extensionConfigName string) {
+		changed = append(changed, extensionConfigName)
+	})
+
+	// Adding an ExtensionConfig notifies the listeners.
+	g.Expect(r.Add(extension)).To(Succeed())
+	g.Expect(changed).To(Equal([]string{"extension"}))
+
+	// Adding the ExtensionConfig again without changes to generation or handlers does not notify the listeners.
+	extension.ResourceVersion = "2"
+	g.Expect(r.Add(extension)).To(Succeed())
+	g.Expect(changed).To(Equal([]string{"extension"}))
+
+	// A generation change notifies the listeners.
+	extension.Generation = 2
+	g.Expect(r.Add(extension)).To(Succeed())
+	g.Expect(changed).To(Equal([]string{"extension", "extension"}))
+
+	// A change to the handlers notifies the listeners.
+	extension.Status.Handlers = append(extension.Status.Handlers, runtimev1.ExtensionHandler{
+		Name: "handler2.extension",
+		RequestHook: runtimev1.GroupVersionHook{
+			APIVersion: "foo/v1alpha1",
+			Hook:       "bak",
+		},
+	})
+	g.Expect(r.Add(extension)).To(Succeed())
+	g.Expect(changed).To(Equal([]string{"extension", "extension", "extension"}))
+
+	// Removing the ExtensionConfig notifies the listeners, removing it again does not.
+	g.Expect(r.Remove(extension)).To(Succeed())
+	g.Expect(r.Remove(extension)).To(Succeed())
+	g.Expect(changed).To(Equal([]string{"extension", "extension", "extension", "extension"}))
+}
+
+func TestRegistryHandlerOverrides(t *testing.T) {
+	g := NewWithT(t)
+
+	extension := &runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "extension1",
+		},
+		Spec: runtimev1.ExtensionConfigSpec{
+			ClientConfig: runtimev1.ClientConfig{
+				URL: "https://extesions1.com/",
+			},
+			HandlerOverrides: []runtimev1.ExtensionHandlerOverride{
+				{
+					Name:           "foo.extension1",
+					TimeoutSeconds: ptr.To[int32](2),
+				},
+				{
+					Hook:           "BeforeClusterUpgrade",
+					TimeoutSeconds: ptr.To[int32](5),
+					FailurePolicy:  runtimev1.FailurePolicyIgnore,
+				},
+			},
+		},
+		Status: runtimev1.ExtensionConfigStatus{
+			Handlers: []runtimev1.ExtensionHandler{
+				{
+					Name: "foo.extension1",
+					RequestHook: runtimev1.GroupVersionHook{
+						APIVersion: "hook.runtime.cluster.x-k8s.io/v1alpha1",
+						Hook:       "BeforeClusterUpgrade",
+					},
+					TimeoutSeconds: 10,
+					FailurePolicy:  runtimev1.FailurePolicyFail,
+				},
+				{
+					Name: "bar.extension1",
+					RequestHook: runtimev1.GroupVersionHook{
+						APIVersion: "hook.runtime.cluster.x-k8s.io/v1alpha1",
+						Hook:       "BeforeClusterUpgrade",
+					},
+					TimeoutSeconds: 10,
+					FailurePolicy:  runtimev1.FailurePolicyFail,
+				},
+				{
+					Name: "baz.extension1",
+					RequestHook: runtimev1.GroupVersionHook{
+						APIVersion: "hook.runtime.cluster.x-k8s.io/v1alpha1",
+						Hook:       "AfterClusterUpgrade",
+					},
+					TimeoutSeconds: 10,
+					FailurePolicy:  runtimev1.FailurePolicyFail,
+				},
+			},
+		},
+	}
+
+	e := New()
+	g.Expect(e.WarmUp(&runtimev1.ExtensionConfigList{Items: []runtimev1.ExtensionConfig{*extension}})).To(Succeed())
+
+	// Overrides for a handler take precedence over overrides for its hook.
+	registration, err := e.Get("foo.extension1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(registration.TimeoutSeconds).To(Equal(int32(2)))
+	g.Expect(registration.FailurePolicy).To(Equal(runtimev1.FailurePolicyIgnore))
+
+	// Overrides for a hook apply to all the handlers of the hook.
+	registration, err = e.Get("bar.extension1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(registration.TimeoutSeconds).To(Equal(int32(5)))
+	g.Expect(registration.FailurePolicy).To(Equal(runtimev1.FailurePolicyIgnore))
+
+	// Handlers without overrides use the values returned by the Extension.
+	registration, err = e.Get("baz.extension1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(registration.TimeoutSeconds).To(Equal(int32(10)))
+	g.Expect(registration.FailurePolicy).To(Equal(runtimev1.FailurePolicyFail))
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package settings implements validation of ExtensionConfig settings against the settings schema
+// returned by a Runtime Extension during discovery.
+package settings
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+)
+
+// Validate validates settings against the given settings schema.
+// If the schema is nil, settings are not validated.
+func Validate(schema *runtimev1.ExtensionSettingsSchema, settings map[string]string, fldPath *field.Path) field.ErrorList {
+	if schema == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	known := map[string]bool{}
+	for _, s := range schema.Settings {
+		known[s.Name] = true
+
+		value, ok := settings[s.Name]
+		if !ok {
+			if ptr.Deref(s.Required, false) {
+				allErrs = append(allErrs, field.Required(fldPath.Key(s.Name), "setting is required by the Extension"))
+			}
+			continue
+		}
+
+		if len(s.Enum) > 0 && !slices.Contains(s.Enum, value) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Key(s.Name), value, s.Enum))
+		}
+
+		if s.Pattern != "" {
+			re, err := regexp.Compile(s.Pattern)
+			if err != nil {
+				allErrs = append(allErrs, field.InternalError(fldPath.Key(s.Name), fmt.Errorf("failed to compile pattern %q of the settings schema: %w", s.Pattern, err)))
+			} else if !re.MatchString(value) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Key(s.Name), value, fmt.Sprintf("must match pattern %q", s.Pattern)))
+			}
+		}
+
+		if s.MaxLength != nil && len(value) > int(*s.MaxLength) {
+			allErrs = append(allErrs, field.TooLong(fldPath.Key(s.Name), value, int(*s.MaxLength)))
+		}
+	}
+
+	if !ptr.Deref(schema.AllowUnknownSettings, false) {
+		// Sort keys to get a stable error order.
+		keys := make([]string, 0, len(settings))
+		for k := range settings {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if !known[k] {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Key(k), "setting is not defined in the settings schema of the Extension"))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package settings
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+)
+
+func TestValidate(t *testing.T) {
+	schema := &runtimev1.ExtensionSettingsSchema{
+		Settings: []runtimev1.ExtensionSettingSchema{
+			{
+				Name:     "mode",
+				Required: ptr.To(true),
+				Enum:     []string{"fast", "safe"},
+			},
+			{
+				Name:      "region",
+				Pattern:   "^[a-z]+-[0-9]+$",
+				MaxLength: ptr.To[int32](10),
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		schema     *runtimev1.ExtensionSettingsSchema
+		settings   map[string]string
+		wantFields []string
+	}{
+		{
+			name:     "no schema, settings are not validated",
+			schema:   nil,
+			settings: map[string]string{"foo": "bar"},
+		},
+		{
+			name:     "valid settings",
+			schema:   schema,
+			settings: map[string]string{"mode": "fast", "region": "eu-1"},
+		},
+		{
+			name:       "missing required setting",
+			schema:     schema,
+			settings:   map[string]string{"region": "eu-1"},
+			wantFields: []string{"spec.settings[mode]"},
+		},
+		{
+			name:       "value not in enum",
+			schema:     schema,
+			settings:   map[string]string{"mode": "slow"},
+			wantFields: []string{"spec.settings[mode]"},
+		},
+		{
+			name:       "value does not match pattern and is too long",
+			schema:     schema,
+			settings:   map[string]string{"mode": "safe", "region": "europe-west"},
+			wantFields: []string{"spec.settings[region]", "spec.settings[region]"},
+		},
+		{
+			name:       "unknown settings are rejected",
+			schema:     schema,
+			settings:   map[string]string{"mode": "safe", "foo": "bar", "bar": "baz"},
+			wantFields: []string{"spec.settings[bar]", "spec.settings[foo]"},
+		},
+		{
+			name: "unknown settings are allowed",
+			schema: &runtimev1.ExtensionSettingsSchema{
+				Settings:             schema.Settings,
+				AllowUnknownSettings: ptr.To(true),
+			},
+			settings: map[string]string{"mode": "safe", "foo": "bar"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			errs := Validate(tt.schema, tt.settings, field.NewPath("spec", "settings"))
+			gotFields := []string{}
+			for _, err := range errs {
+				gotFields = append(gotFields, err.Field)
+			}
+			g.Expect(gotFields).To(ConsistOf(tt.wantFields))
+		})
+	}
+}
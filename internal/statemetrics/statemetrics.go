@@ -0,0 +1,185 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package statemetrics implements an opt-in prometheus collector exposing the phase and selected conditions
+// of Clusters and Machines, so alerting on them doesn't require custom resource state configuration for kube-state-metrics.
+package statemetrics
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// listTimeout is the timeout for listing objects when metrics are collected.
+const listTimeout = 10 * time.Second
+
+var (
+	clusterPhases = []clusterv1.ClusterPhase{
+		clusterv1.ClusterPhasePending,
+		clusterv1.ClusterPhaseProvisioning,
+		clusterv1.ClusterPhaseProvisioned,
+		clusterv1.ClusterPhaseDeleting,
+		clusterv1.ClusterPhaseFailed,
+		clusterv1.ClusterPhaseUnknown,
+	}
+
+	machinePhases = []clusterv1.MachinePhase{
+		clusterv1.MachinePhasePending,
+		clusterv1.MachinePhaseProvisioning,
+		clusterv1.MachinePhaseProvisioned,
+		clusterv1.MachinePhaseRunning,
+		clusterv1.MachinePhaseUpdating,
+		clusterv1.MachinePhaseDeleting,
+		clusterv1.MachinePhaseDeleted,
+		clusterv1.MachinePhaseFailed,
+		clusterv1.MachinePhaseUnknown,
+	}
+
+	// conditionTypes are the conditions exposed for Clusters and Machines, if they are set.
+	conditionTypes = []string{
+		clusterv1.AvailableCondition,
+		clusterv1.ReadyCondition,
+		clusterv1.MachineUpToDateCondition,
+	}
+
+	conditionStatuses = []metav1.ConditionStatus{
+		metav1.ConditionTrue,
+		metav1.ConditionFalse,
+		metav1.ConditionUnknown,
+	}
+
+	clusterPhaseDesc = prometheus.NewDesc(
+		"capi_cluster_status_phase",
+		"The phase of the Cluster; the value is 1 for the current phase and 0 for all other phases.",
+		[]string{"namespace", "cluster", "phase"}, nil,
+	)
+
+	clusterConditionDesc = prometheus.NewDesc(
+		"capi_cluster_status_condition",
+		"The status of the Available, Ready and UpToDate conditions of the Cluster; the value is 1 for the current status and 0 for all other statuses.",
+		[]string{"namespace", "cluster", "type", "status"}, nil,
+	)
+
+	machinePhaseDesc = prometheus.NewDesc(
+		"capi_machine_status_phase",
+		"The phase of the Machine; the value is 1 for the current phase and 0 for all other phases.",
+		[]string{"namespace", "cluster", "machine", "phase"}, nil,
+	)
+
+	machineConditionDesc = prometheus.NewDesc(
+		"capi_machine_status_condition",
+		"The status of the Available, Ready and UpToDate conditions of the Machine; the value is 1 for the current status and 0 for all other statuses.",
+		[]string{"namespace", "cluster", "machine", "type", "status"}, nil,
+	)
+)
+
+// Collector collects the phase and the conditions of Clusters and Machines when metrics are scraped.
+type Collector struct {
+	// Reader is used to list Clusters and Machines; it should be a cached reader, because Clusters and Machines
+	// are listed on every scrape.
+	Reader client.Reader
+
+	// WatchFilterValue is the label value used to filter Clusters and Machines.
+	WatchFilterValue string
+}
+
+var _ prometheus.Collector = &Collector{}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- clusterPhaseDesc
+	ch <- clusterConditionDesc
+	ch <- machinePhaseDesc
+	ch <- machineConditionDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+	defer cancel()
+	log := ctrl.Log.WithName("statemetrics")
+
+	var listOptions []client.ListOption
+	if c.WatchFilterValue != "" {
+		listOptions = append(listOptions, client.MatchingLabels{clusterv1.WatchLabel: c.WatchFilterValue})
+	}
+
+	clusters := &clusterv1.ClusterList{}
+	if err := c.Reader.List(ctx, clusters, listOptions...); err != nil {
+		log.Error(err, "Failed to list Clusters")
+	}
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		for _, phase := range clusterPhases {
+			ch <- prometheus.MustNewConstMetric(clusterPhaseDesc, prometheus.GaugeValue,
+				boolToFloat(cluster.Status.GetTypedPhase() == phase), cluster.Namespace, cluster.Name, string(phase))
+		}
+		collectConditions(ch, cluster, clusterConditionDesc, cluster.Namespace, cluster.Name)
+	}
+
+	machines := &clusterv1.MachineList{}
+	if err := c.Reader.List(ctx, machines, listOptions...); err != nil {
+		log.Error(err, "Failed to list Machines")
+	}
+	for i := range machines.Items {
+		machine := &machines.Items[i]
+		for _, phase := range machinePhases {
+			ch <- prometheus.MustNewConstMetric(machinePhaseDesc, prometheus.GaugeValue,
+				boolToFloat(machine.Status.GetTypedPhase() == phase), machine.Namespace, machine.Spec.ClusterName, machine.Name, string(phase))
+		}
+		collectConditions(ch, machine, machineConditionDesc, machine.Namespace, machine.Spec.ClusterName, machine.Name)
+	}
+}
+
+// collectConditions collects the conditionTypes set on obj.
+func collectConditions(ch chan<- prometheus.Metric, obj conditions.Getter, desc *prometheus.Desc, labelValues ...string) {
+	for _, conditionType := range conditionTypes {
+		condition := conditions.Get(obj, conditionType)
+		if condition == nil {
+			continue
+		}
+		for _, status := range conditionStatuses {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue,
+				boolToFloat(condition.Status == status), slices.Concat(labelValues, []string{conditionType, string(status)})...)
+		}
+	}
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// AddToManager registers a Collector using the cached client of the manager in the controller-runtime metrics registry.
+func AddToManager(mgr ctrl.Manager, watchFilterValue string) error {
+	if err := ctrlmetrics.Registry.Register(&Collector{Reader: mgr.GetClient(), WatchFilterValue: watchFilterValue}); err != nil {
+		return errors.Wrap(err, "failed to register Cluster and Machine state metrics")
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statemetrics
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestCollector(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "cluster1"},
+		Status: clusterv1.ClusterStatus{
+			Phase: string(clusterv1.ClusterPhaseProvisioned),
+			Conditions: []metav1.Condition{
+				{Type: clusterv1.ClusterAvailableCondition, Status: metav1.ConditionTrue},
+				{Type: clusterv1.ClusterWorkersAvailableCondition, Status: metav1.ConditionTrue},
+			},
+		},
+	}
+	filteredCluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "cluster2", Labels: map[string]string{clusterv1.WatchLabel: "other"}},
+	}
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine1"},
+		Spec:       clusterv1.MachineSpec{ClusterName: cluster.Name},
+		Status: clusterv1.MachineStatus{
+			Phase: string(clusterv1.MachinePhaseRunning),
+			Conditions: []metav1.Condition{
+				{Type: clusterv1.MachineReadyCondition, Status: metav1.ConditionFalse},
+			},
+		},
+	}
+
+	c := &Collector{
+		Reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster, filteredCluster, machine).Build(),
+	}
+
+	// Without a watch filter, both Clusters are collected.
+	g.Expect(testutil.CollectAndCount(c, "capi_cluster_status_phase")).To(Equal(2 * len(clusterPhases)))
+
+	// With a watch filter, only Clusters with the watch label are collected.
+	c.WatchFilterValue = "other"
+	g.Expect(testutil.CollectAndCount(c, "capi_cluster_status_phase")).To(Equal(len(clusterPhases)))
+
+	c.WatchFilterValue = ""
+
+	c.Reader = fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster, machine).Build()
+	g.Expect(testutil.CollectAndCompare(c, strings.NewReader(`
+# HELP capi_cluster_status_condition The status of the Available, Ready and UpToDate conditions of the Cluster; the value is 1 for the current status and 0 for all other statuses.
+# TYPE capi_cluster_status_condition gauge
+capi_cluster_status_condition{cluster="cluster1",namespace="default",status="False",type="Available"} 0
+capi_cluster_status_condition{cluster="cluster1",namespace="default",status="True",type="Available"} 1
+capi_cluster_status_condition{cluster="cluster1",namespace="default",status="Unknown",type="Available"} 0
+# HELP capi_machine_status_condition The status of the Available, Ready and UpToDate conditions of the Machine; the value is 1 for the current status and 0 for all other statuses.
+# TYPE capi_machine_status_condition gauge
+capi_machine_status_condition{cluster="cluster1",machine="machine1",namespace="default",status="False",type="Ready"} 1
+capi_machine_status_condition{cluster="cluster1",machine="machine1",namespace="default",status="True",type="Ready"} 0
+capi_machine_status_condition{cluster="cluster1",machine="machine1",namespace="default",status="Unknown",type="Ready"} 0
+`), "capi_cluster_status_condition", "capi_machine_status_condition")).To(Succeed())
+
+	g.Expect(testutil.CollectAndCompare(c, strings.NewReader(`
+# HELP capi_machine_status_phase The phase of the Machine; the value is 1 for the current phase and 0 for all other phases.
+# TYPE capi_machine_status_phase gauge
+capi_machine_status_phase{cluster="cluster1",machine="machine1",namespace="default",phase="Deleted"} 0
+capi_machine_status_phase{cluster="cluster1",machine="machine1",namespace="default",phase="Deleting"} 0
+capi_machine_status_phase{cluster="cluster1",machine="machine1",namespace="default",phase="Failed"} 0
+capi_machine_status_phase{cluster="cluster1",machine="machine1",namespace="default",phase="Pending"} 0
+capi_machine_status_phase{cluster="cluster1",machine="machine1",namespace="default",phase="Provisioned"} 0
+capi_machine_status_phase{cluster="cluster1",machine="machine1",namespace="default",phase="Provisioning"} 0
+capi_machine_status_phase{cluster="cluster1",machine="machine1",namespace="default",phase="Running"} 1
+capi_machine_status_phase{cluster="cluster1",machine="machine1",namespace="default",phase="Unknown"} 0
+capi_machine_status_phase{cluster="cluster1",machine="machine1",namespace="default",phase="Updating"} 0
+`), "capi_machine_status_phase")).To(Succeed())
+}
@@ -24,6 +24,8 @@ import (
 
 	"github.com/pkg/errors"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // This is a copy of the constants at k8s.io/apiserver/pkg/storage/names.
@@ -87,25 +89,66 @@ func MachinePoolNameGenerator(templateString, clusterName, topologyName string)
 }
 
 // KCPMachineNameGenerator returns a generator for creating a kcp machine name.
-func KCPMachineNameGenerator(templateString, clusterName, kubeadmControlPlaneName string) NameGenerator {
+// predecessorName is the name of the Machine being replaced after remediation, if any.
+func KCPMachineNameGenerator(templateString, clusterName, kubeadmControlPlaneName, predecessorName string) NameGenerator {
 	return newTemplateGenerator(templateString, clusterName,
 		map[string]interface{}{
 			"kubeadmControlPlane": map[string]interface{}{
 				"name": kubeadmControlPlaneName,
 			},
+			"predecessor": map[string]interface{}{
+				"name": predecessorName,
+			},
 		})
 }
 
 // MachineSetMachineNameGenerator returns a generator for creating a machineSet machine name.
-func MachineSetMachineNameGenerator(templateString, clusterName, machineSetName string) NameGenerator {
+// predecessorName is the name of the Machine being replaced after remediation, if any.
+func MachineSetMachineNameGenerator(templateString, clusterName, machineSetName, predecessorName string) NameGenerator {
 	return newTemplateGenerator(templateString, clusterName,
 		map[string]interface{}{
 			"machineSet": map[string]interface{}{
 				"name": machineSetName,
 			},
+			"predecessor": map[string]interface{}{
+				"name": predecessorName,
+			},
 		})
 }
 
+// ValidateMachineNameTemplate validates a Machine naming template by rendering it with the generator
+// returned by newGenerator. The template is rendered both for a new Machine and for a Machine replacing
+// a remediated Machine, because the template could render different names depending on the predecessor.
+func ValidateMachineNameTemplate(template string, newGenerator func(predecessorName string) NameGenerator, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, predecessorName := range []string{"", "machine-abcde"} {
+		name, err := newGenerator(predecessorName).GenerateName()
+		if err != nil {
+			allErrs = append(allErrs,
+				field.Invalid(
+					fldPath,
+					template,
+					fmt.Sprintf("invalid template: %v", err),
+				))
+			break
+		}
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			for _, err := range errs {
+				allErrs = append(allErrs,
+					field.Invalid(
+						fldPath,
+						template,
+						fmt.Sprintf("invalid template, generated names would not be valid Kubernetes object names: %v", err),
+					))
+			}
+			break
+		}
+	}
+
+	return allErrs
+}
+
 // InfraClusterNameGenerator returns a generator for creating a infrastructure cluster name.
 func InfraClusterNameGenerator(templateString, clusterName string) NameGenerator {
 	return newTemplateGenerator(templateString, clusterName,
@@ -153,6 +196,44 @@ func (g *templateGenerator) GenerateName() (string, error) {
 	return name, nil
 }
 
+// BootstrapTemplateNameGenerator returns a generator for creating a bootstrap template name of a machinedeployment.
+// If the template string is empty, the name is generated using BootstrapTemplateNamePrefix.
+func BootstrapTemplateNameGenerator(templateString, clusterName, machineDeploymentTopologyName string) NameGenerator {
+	if templateString == "" {
+		return SimpleNameGenerator(BootstrapTemplateNamePrefix(clusterName, machineDeploymentTopologyName))
+	}
+	return newTemplateGenerator(templateString, clusterName,
+		map[string]interface{}{
+			"machineDeployment": map[string]interface{}{
+				"topologyName": machineDeploymentTopologyName,
+			},
+		})
+}
+
+// InfrastructureMachineTemplateNameGenerator returns a generator for creating an infrastructure machine template name of a machinedeployment.
+// If the template string is empty, the name is generated using InfrastructureMachineTemplateNamePrefix.
+func InfrastructureMachineTemplateNameGenerator(templateString, clusterName, machineDeploymentTopologyName string) NameGenerator {
+	if templateString == "" {
+		return SimpleNameGenerator(InfrastructureMachineTemplateNamePrefix(clusterName, machineDeploymentTopologyName))
+	}
+	return newTemplateGenerator(templateString, clusterName,
+		map[string]interface{}{
+			"machineDeployment": map[string]interface{}{
+				"topologyName": machineDeploymentTopologyName,
+			},
+		})
+}
+
+// ControlPlaneInfrastructureMachineTemplateNameGenerator returns a generator for creating an infrastructure machine template name of a control plane.
+// If the template string is empty, the name is generated using ControlPlaneInfrastructureMachineTemplateNamePrefix.
+func ControlPlaneInfrastructureMachineTemplateNameGenerator(templateString, clusterName string) NameGenerator {
+	if templateString == "" {
+		return SimpleNameGenerator(ControlPlaneInfrastructureMachineTemplateNamePrefix(clusterName))
+	}
+	return newTemplateGenerator(templateString, clusterName,
+		map[string]interface{}{})
+}
+
 // BootstrapTemplateNamePrefix calculates the name prefix for a BootstrapTemplate.
 func BootstrapTemplateNamePrefix(clusterName, machineDeploymentTopologyName string) string {
 	return fmt.Sprintf("%s-%s-", clusterName, machineDeploymentTopologyName)
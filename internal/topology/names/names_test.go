@@ -22,6 +22,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 func Test_templateGenerator_GenerateName(t *testing.T) {
@@ -90,3 +91,149 @@ func Test_templateGenerator_GenerateName(t *testing.T) {
 		})
 	}
 }
+
+func Test_templateNameGenerators(t *testing.T) {
+	tests := []struct {
+		name      string
+		generator NameGenerator
+		want      []types.GomegaMatcher
+	}{
+		{
+			name:      "InfrastructureMachineTemplate name without template",
+			generator: InfrastructureMachineTemplateNameGenerator("", "cluster", "md"),
+			want: []types.GomegaMatcher{
+				HavePrefix("cluster-md-"),
+				HaveLen(len("cluster-md-") + randomLength),
+			},
+		},
+		{
+			name:      "InfrastructureMachineTemplate name with template",
+			generator: InfrastructureMachineTemplateNameGenerator("{{ .machineDeployment.topologyName }}-infra-{{ .cluster.name }}-{{ .random }}", "cluster", "md"),
+			want: []types.GomegaMatcher{
+				HavePrefix("md-infra-cluster-"),
+				HaveLen(len("md-infra-cluster-") + randomLength),
+			},
+		},
+		{
+			name:      "BootstrapTemplate name without template",
+			generator: BootstrapTemplateNameGenerator("", "cluster", "md"),
+			want: []types.GomegaMatcher{
+				HavePrefix("cluster-md-"),
+				HaveLen(len("cluster-md-") + randomLength),
+			},
+		},
+		{
+			name:      "BootstrapTemplate name with template",
+			generator: BootstrapTemplateNameGenerator("{{ .machineDeployment.topologyName }}-bootstrap-{{ .cluster.name }}-{{ .random }}", "cluster", "md"),
+			want: []types.GomegaMatcher{
+				HavePrefix("md-bootstrap-cluster-"),
+				HaveLen(len("md-bootstrap-cluster-") + randomLength),
+			},
+		},
+		{
+			name:      "ControlPlane InfrastructureMachineTemplate name without template",
+			generator: ControlPlaneInfrastructureMachineTemplateNameGenerator("", "cluster"),
+			want: []types.GomegaMatcher{
+				HavePrefix("cluster-"),
+				HaveLen(len("cluster-") + randomLength),
+			},
+		},
+		{
+			name:      "ControlPlane InfrastructureMachineTemplate name with template",
+			generator: ControlPlaneInfrastructureMachineTemplateNameGenerator("cp-infra-{{ .cluster.name }}-{{ .random }}", "cluster"),
+			want: []types.GomegaMatcher{
+				HavePrefix("cp-infra-cluster-"),
+				HaveLen(len("cp-infra-cluster-") + randomLength),
+			},
+		},
+		{
+			name:      "KCP Machine name with predecessor",
+			generator: KCPMachineNameGenerator("{{ if .predecessor.name }}{{ .predecessor.name }}-r{{ else }}{{ .kubeadmControlPlane.name }}{{ end }}-{{ .random }}", "cluster", "kcp", "kcp-abcde"),
+			want: []types.GomegaMatcher{
+				HavePrefix("kcp-abcde-r-"),
+				HaveLen(len("kcp-abcde-r-") + randomLength),
+			},
+		},
+		{
+			name:      "KCP Machine name without predecessor",
+			generator: KCPMachineNameGenerator("{{ if .predecessor.name }}{{ .predecessor.name }}-r{{ else }}{{ .kubeadmControlPlane.name }}{{ end }}-{{ .random }}", "cluster", "kcp", ""),
+			want: []types.GomegaMatcher{
+				HavePrefix("kcp-"),
+				HaveLen(len("kcp-") + randomLength),
+			},
+		},
+		{
+			name:      "MachineSet Machine name with predecessor",
+			generator: MachineSetMachineNameGenerator("{{ if .predecessor.name }}{{ .predecessor.name }}-r{{ else }}{{ .machineSet.name }}{{ end }}-{{ .random }}", "cluster", "ms", "ms-abcde"),
+			want: []types.GomegaMatcher{
+				HavePrefix("ms-abcde-r-"),
+				HaveLen(len("ms-abcde-r-") + randomLength),
+			},
+		},
+		{
+			name:      "MachineSet Machine name without predecessor",
+			generator: MachineSetMachineNameGenerator("{{ if .predecessor.name }}{{ .predecessor.name }}-r{{ else }}{{ .machineSet.name }}{{ end }}-{{ .random }}", "cluster", "ms", ""),
+			want: []types.GomegaMatcher{
+				HavePrefix("ms-"),
+				HaveLen(len("ms-") + randomLength),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := tt.generator.GenerateName()
+			g.Expect(err).ToNot(HaveOccurred())
+			for _, matcher := range tt.want {
+				g.Expect(got).To(matcher)
+			}
+		})
+	}
+}
+
+func TestValidateMachineNameTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{
+			name:     "valid template",
+			template: "{{ .machineSet.name }}-{{ .random }}",
+		},
+		{
+			name:     "valid template using the predecessor name",
+			template: "{{ if .predecessor.name }}{{ .predecessor.name }}-r{{ else }}{{ .machineSet.name }}{{ end }}-{{ .random }}",
+		},
+		{
+			name:     "invalid template",
+			template: "{{ .machineSet.name }-{{ .random }}",
+			wantErr:  true,
+		},
+		{
+			name:     "template rendering an invalid name for a new Machine",
+			template: "{{ .predecessor.name }}-{{ .random }}",
+			wantErr:  true,
+		},
+		{
+			name:     "template rendering an invalid name for a Machine replacing a remediated Machine",
+			template: "{{ if .predecessor.name }}-{{ end }}{{ .machineSet.name }}-{{ .random }}",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			errs := ValidateMachineNameTemplate(tt.template, func(predecessorName string) NameGenerator {
+				return MachineSetMachineNameGenerator(tt.template, "cluster", "machineset", predecessorName)
+			}, field.NewPath("spec", "machineNaming", "template"))
+			if tt.wantErr {
+				g.Expect(errs).ToNot(BeEmpty())
+				return
+			}
+			g.Expect(errs).To(BeEmpty())
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package patchpath implements utils for JSON patch paths using list item selectors.
+//
+// A list item selector is a path segment in the form `[key=value]`, which selects the first item of a list
+// having the field `key` equal to `value`, e.g. `/spec/template/spec/files/[path=/etc/hosts]/content`.
+// List item selectors are resolved to the corresponding index before applying the JSON patch,
+// thus allowing to patch list items independently of their position in the list.
+package patchpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var listItemSelectorKeyRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+
+// Split splits a JSON patch path into its segments, ignoring the leading "/".
+// Differently from a plain split, "/" characters inside list item selectors
+// are not considered segment separators, e.g. `/spec/files/[path=/etc/hosts]/content`
+// is split into `spec`, `files`, `[path=/etc/hosts]`, `content`.
+func Split(path string) []string {
+	path = strings.TrimPrefix(path, "/")
+
+	segments := []string{}
+	var current strings.Builder
+	inSelector := false
+	for _, c := range path {
+		switch {
+		case c == '[' && current.Len() == 0:
+			inSelector = true
+		case c == ']' && inSelector:
+			inSelector = false
+		case c == '/' && !inSelector:
+			segments = append(segments, current.String())
+			current.Reset()
+			continue
+		}
+		current.WriteRune(c)
+	}
+	return append(segments, current.String())
+}
+
+// IsListItemSelector returns true if the path segment is a list item selector.
+func IsListItemSelector(segment string) bool {
+	return strings.HasPrefix(segment, "[") && strings.HasSuffix(segment, "]")
+}
+
+// ParseListItemSelector parses a list item selector in the form `[key=value]`.
+func ParseListItemSelector(segment string) (key, value string, err error) {
+	if !IsListItemSelector(segment) {
+		return "", "", errors.Errorf("%q is not a list item selector", segment)
+	}
+	key, value, found := strings.Cut(strings.TrimSuffix(strings.TrimPrefix(segment, "["), "]"), "=")
+	if !found {
+		return "", "", errors.Errorf("list item selector %q must be in the form [key=value]", segment)
+	}
+	if !listItemSelectorKeyRegex.MatchString(key) {
+		return "", "", errors.Errorf("list item selector %q has an invalid key %q", segment, key)
+	}
+	if value == "" {
+		return "", "", errors.Errorf("list item selector %q must have a value", segment)
+	}
+	return key, value, nil
+}
+
+// HasListItemSelectors returns true if the JSON patch path contains at least a list item selector.
+func HasListItemSelectors(path string) bool {
+	for _, segment := range Split(path) {
+		if IsListItemSelector(segment) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve replaces all the list item selectors in a JSON patch path with the index of the corresponding
+// list items in the given JSON document.
+// An error is returned if a list item selector does not match any item.
+func Resolve(path string, document []byte) (string, error) {
+	if !HasListItemSelectors(path) {
+		return path, nil
+	}
+
+	var current interface{}
+	if err := json.Unmarshal(document, &current); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal document")
+	}
+
+	segments := Split(path)
+	for i, segment := range segments {
+		if _, isList := current.([]interface{}); !isList && IsListItemSelector(segment) {
+			return "", errors.Errorf("failed to resolve path %q: list item selector %q does not point to a list", path, segment)
+		}
+
+		switch typedCurrent := current.(type) {
+		case map[string]interface{}:
+			current = typedCurrent[unescape(segment)]
+		case []interface{}:
+			if !IsListItemSelector(segment) {
+				// Note: "-" and out of range indexes are validated when applying the patch.
+				current = nil
+				if index, err := strconv.Atoi(segment); err == nil && index >= 0 && index < len(typedCurrent) {
+					current = typedCurrent[index]
+				}
+				continue
+			}
+			index, err := resolveListItem(typedCurrent, segment)
+			if err != nil {
+				return "", errors.Wrapf(err, "failed to resolve path %q", path)
+			}
+			segments[i] = strconv.Itoa(index)
+			current = typedCurrent[index]
+		default:
+			// The remaining segments will be validated when applying the patch.
+			current = nil
+		}
+	}
+	return "/" + strings.Join(segments, "/"), nil
+}
+
+// resolveListItem returns the index of the first list item matching a list item selector.
+func resolveListItem(list []interface{}, segment string) (int, error) {
+	key, value, err := ParseListItemSelector(segment)
+	if err != nil {
+		return 0, err
+	}
+	for i, item := range list {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		itemValue, ok := itemMap[key]
+		if !ok {
+			continue
+		}
+		if s, ok := itemValue.(string); ok && s == value || !ok && fmt.Sprint(itemValue) == value {
+			return i, nil
+		}
+	}
+	return 0, errors.Errorf("no list item matches the list item selector %q", segment)
+}
+
+// unescape unescapes a JSON pointer segment as defined in RFC6901.
+func unescape(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package patchpath
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{
+			path: "/spec/template/spec/files",
+			want: []string{"spec", "template", "spec", "files"},
+		},
+		{
+			path: "/spec/template/spec/files/[path=/etc/hosts]/content",
+			want: []string{"spec", "template", "spec", "files", "[path=/etc/hosts]", "content"},
+		},
+		{
+			path: "/spec/users/[name=capv]",
+			want: []string{"spec", "users", "[name=capv]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(Split(tt.path)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestParseListItemSelector(t *testing.T) {
+	tests := []struct {
+		segment   string
+		wantKey   string
+		wantValue string
+		wantErr   bool
+	}{
+		{segment: "[path=/etc/hosts]", wantKey: "path", wantValue: "/etc/hosts"},
+		{segment: "[name=a=b]", wantKey: "name", wantValue: "a=b"},
+		{segment: "files", wantErr: true},
+		{segment: "[path]", wantErr: true},
+		{segment: "[path=]", wantErr: true},
+		{segment: "[/path=foo]", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.segment, func(t *testing.T) {
+			g := NewWithT(t)
+			key, value, err := ParseListItemSelector(tt.segment)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(key).To(Equal(tt.wantKey))
+			g.Expect(value).To(Equal(tt.wantValue))
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	document := []byte(`{"spec":{"files":[{"path":"/etc/a","content":"a"},{"path":"/etc/b","content":"b"}],"users":[{"name":"foo","uid":1000},{"name":"bar","uid":1001}],"a/b":[{"name":"escaped"}]}}`)
+
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "path without selectors is not changed",
+			path: "/spec/files/0/content",
+			want: "/spec/files/0/content",
+		},
+		{
+			name: "selector on string field",
+			path: "/spec/files/[path=/etc/b]/content",
+			want: "/spec/files/1/content",
+		},
+		{
+			name: "selector on number field",
+			path: "/spec/users/[uid=1001]",
+			want: "/spec/users/1",
+		},
+		{
+			name: "selector after escaped segment",
+			path: "/spec/a~1b/[name=escaped]/name",
+			want: "/spec/a~1b/0/name",
+		},
+		{
+			name: "selector on a path that does not exist yet",
+			path: "/spec/files/[path=/etc/b]/owner/name",
+			want: "/spec/files/1/owner/name",
+		},
+		{
+			name: "selector followed by append",
+			path: "/spec/files/[path=/etc/a]/tags/-",
+			want: "/spec/files/0/tags/-",
+		},
+		{
+			name:    "index followed by selector",
+			path:    "/spec/files/1/[path=/etc/a]",
+			wantErr: true,
+		},
+		{
+			name:    "selector not matching",
+			path:    "/spec/files/[path=/etc/c]/content",
+			wantErr: true,
+		},
+		{
+			name:    "selector not pointing to a list",
+			path:    "/spec/[path=/etc/c]/content",
+			wantErr: true,
+		},
+		{
+			name:    "selector on missing list",
+			path:    "/spec/notExisting/[path=/etc/c]",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := Resolve(tt.path, document)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
@@ -19,6 +19,8 @@ package variables
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
@@ -116,7 +118,8 @@ func validateClusterVariables(ctx context.Context, values, oldValues []clusterv1
 func validateRequiredVariables(values map[string]*clusterv1.ClusterVariable, definitions map[string]*clusterv1.ClusterClassStatusVariable, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
-	for name, def := range definitions {
+	for _, name := range slices.Sorted(maps.Keys(definitions)) {
+		def := definitions[name]
 		// Check the required value for the specific variable definition. If the variable is not required continue.
 		if !ptr.Deref(def.Definitions[0].Required, false) {
 			continue
@@ -112,6 +112,63 @@ func Test_ValidateClusterVariables(t *testing.T) {
 			},
 			validateRequired: true,
 		},
+		{
+			name: "Errors are sorted by variable name when values for multiple required definitions are missing.",
+			wantErrs: []validationMatch{
+				required("Required value: required variable \"cpu\" must be set",
+					"spec.topology.variables"),
+				required("Required value: required variable \"location\" must be set",
+					"spec.topology.variables"),
+				required("Required value: required variable \"zone\" must be set",
+					"spec.topology.variables"),
+			},
+			definitions: []clusterv1.ClusterClassStatusVariable{
+				{
+					Name: "zone",
+					Definitions: []clusterv1.ClusterClassStatusVariableDefinition{
+						{
+							Required: ptr.To(true),
+							From:     clusterv1.VariableDefinitionFromInline,
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "string",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "location",
+					Definitions: []clusterv1.ClusterClassStatusVariableDefinition{
+						{
+							Required: ptr.To(true),
+							From:     clusterv1.VariableDefinitionFromInline,
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "string",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "cpu",
+					Definitions: []clusterv1.ClusterClassStatusVariableDefinition{
+						{
+							Required: ptr.To(true),
+							From:     clusterv1.VariableDefinitionFromInline,
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "integer",
+								},
+							},
+						},
+					},
+				},
+			},
+			values:           []clusterv1.ClusterVariable{},
+			validateRequired: true,
+		},
 		{
 			name: "Error when no value for required definition.",
 			wantErrs: []validationMatch{
@@ -19,8 +19,10 @@ package variables
 import (
 	"context"
 	"fmt"
+	"maps"
 	"math"
 	"regexp"
+	"slices"
 	"strings"
 
 	celgo "github.com/google/cel-go/cel"
@@ -168,8 +170,8 @@ func validateClusterClassXVariableMetadata(schema *clusterv1.JSONSchemaProps, fl
 		allErrs = append(allErrs, validateClusterClassXVariableMetadata(schema.AdditionalProperties, fldPath.Child("additionalProperties"))...)
 	}
 
-	for propertyName, propertySchema := range schema.Properties {
-		p := propertySchema
+	for _, propertyName := range slices.Sorted(maps.Keys(schema.Properties)) {
+		p := schema.Properties[propertyName]
 		allErrs = append(allErrs, validateClusterClassXVariableMetadata(&p, fldPath.Child("properties").Key(propertyName))...)
 	}
 
@@ -361,8 +363,8 @@ func validateSchema(ctx context.Context, schema *apiextensions.JSONSchemaProps,
 		allErrs.AppendErrors(validateSchema(ctx, schema.AdditionalProperties.Schema, fldPath.Child("additionalProperties"), opts, celContext.ChildAdditionalPropertiesContext(schema.AdditionalProperties.Schema), uncorrelatablePath))
 	}
 
-	for propertyName, propertySchema := range schema.Properties {
-		p := propertySchema
+	for _, propertyName := range slices.Sorted(maps.Keys(schema.Properties)) {
+		p := schema.Properties[propertyName]
 		allErrs.AppendErrors(validateSchema(ctx, &p, fldPath.Child("properties").Key(propertyName), opts, celContext.ChildPropertyContext(&p, propertyName), uncorrelatablePath))
 	}
 
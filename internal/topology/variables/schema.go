@@ -19,6 +19,8 @@ package variables
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -141,8 +143,8 @@ func convertToAPIExtensionsJSONSchemaProps(schema *clusterv1.JSONSchemaProps, fl
 
 	if len(schema.Properties) > 0 {
 		props.Properties = map[string]apiextensions.JSONSchemaProps{}
-		for propertyName, propertySchema := range schema.Properties {
-			p := propertySchema
+		for _, propertyName := range slices.Sorted(maps.Keys(schema.Properties)) {
+			p := schema.Properties[propertyName]
 			apiExtensionsSchema, errs := convertToAPIExtensionsJSONSchemaProps(&p, fldPath.Child("properties").Key(propertyName))
 			if len(errs) > 0 {
 				allErrs = append(allErrs, errs...)
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fastpath implements utils to skip expensive desired state computations when
+// only the status of the objects a controller depends on changed.
+package fastpath
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/cluster-api/util/cache"
+)
+
+// Cache caches the result of an expensive computation together with the fingerprint of its inputs.
+// Entries expire after cache.DefaultTTL, so the computation is periodically repeated even if the inputs didn't change.
+type Cache[T any] interface {
+	// Get returns the value stored for key, if it was stored with the given fingerprint.
+	Get(key, fingerprint string) (T, bool)
+
+	// Add stores the value for key together with the fingerprint of the inputs used to compute it.
+	Add(key, fingerprint string, value T)
+
+	// Invalidate ensures the next Get for key is a miss.
+	Invalidate(key string)
+}
+
+// NewCache creates a new Cache; controllerName is used to label the fast path metrics.
+func NewCache[T any](controllerName string) Cache[T] {
+	return &fastPathCache[T]{
+		entries:        cache.New[entry[T]](cache.DefaultTTL),
+		controllerName: controllerName,
+	}
+}
+
+type fastPathCache[T any] struct {
+	entries        cache.Cache[entry[T]]
+	controllerName string
+}
+
+type entry[T any] struct {
+	key         string
+	fingerprint string
+	value       T
+}
+
+// Key returns the cache key of an entry.
+func (e entry[T]) Key() string {
+	return e.key
+}
+
+// Get returns the value stored for key, if it was stored with the given fingerprint.
+func (c *fastPathCache[T]) Get(key, fingerprint string) (T, bool) {
+	e, ok := c.entries.Has(key)
+	if ok && e.fingerprint != "" && e.fingerprint == fingerprint {
+		hits.WithLabelValues(c.controllerName).Inc()
+		return e.value, true
+	}
+	misses.WithLabelValues(c.controllerName).Inc()
+	var zero T
+	return zero, false
+}
+
+// Add stores the value for key together with the fingerprint of the inputs used to compute it.
+func (c *fastPathCache[T]) Add(key, fingerprint string, value T) {
+	c.entries.Add(entry[T]{key: key, fingerprint: fingerprint, value: value})
+}
+
+// Invalidate ensures the next Get for key is a miss.
+func (c *fastPathCache[T]) Invalidate(key string) {
+	// Note: an empty fingerprint never matches.
+	c.entries.Add(entry[T]{key: key})
+}
+
+// Fingerprint computes a fingerprint of everything but the status of the given objects, i.e. of their
+// identity, generation, labels, annotations and deletion timestamp.
+// Note: changes to spec increase metadata.generation, so the fingerprint changes on every change except status changes.
+// Nil objects are part of the fingerprint, so a fingerprint also changes when objects are created or deleted.
+func Fingerprint(objs ...client.Object) string {
+	b := &strings.Builder{}
+	for _, obj := range objs {
+		if obj == nil || reflect.ValueOf(obj).IsNil() {
+			b.WriteString("nil\n")
+			continue
+		}
+		fmt.Fprintf(b, "%T %s/%s %s %d %t\n", obj, obj.GetNamespace(), obj.GetName(), obj.GetUID(), obj.GetGeneration(), obj.GetDeletionTimestamp() != nil)
+		writeSorted(b, obj.GetLabels())
+		writeSorted(b, obj.GetAnnotations())
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+func writeSorted(b *strings.Builder, m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(b, "%q=%q\n", k, m[k])
+	}
+	b.WriteString("\n")
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fastpath
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestFingerprint(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cluster", Generation: 1, Labels: map[string]string{"a": "1", "b": "2"}},
+	}
+	md := &clusterv1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "md", Generation: 1},
+	}
+	fingerprint := Fingerprint(cluster, md)
+
+	// Status and resourceVersion changes do not change the fingerprint.
+	statusChanged := cluster.DeepCopy()
+	statusChanged.ResourceVersion = "2"
+	statusChanged.Status.Phase = string(clusterv1.ClusterPhaseProvisioned)
+	g.Expect(Fingerprint(statusChanged, md)).To(Equal(fingerprint))
+
+	// Changes to generation, labels, annotations and deletionTimestamp change the fingerprint.
+	generationChanged := cluster.DeepCopy()
+	generationChanged.Generation = 2
+	g.Expect(Fingerprint(generationChanged, md)).ToNot(Equal(fingerprint))
+
+	labelsChanged := cluster.DeepCopy()
+	labelsChanged.Labels["b"] = "3"
+	g.Expect(Fingerprint(labelsChanged, md)).ToNot(Equal(fingerprint))
+
+	annotationsChanged := cluster.DeepCopy()
+	annotationsChanged.Annotations = map[string]string{"a": "1"}
+	g.Expect(Fingerprint(annotationsChanged, md)).ToNot(Equal(fingerprint))
+
+	deleting := cluster.DeepCopy()
+	deleting.DeletionTimestamp = &metav1.Time{}
+	g.Expect(Fingerprint(deleting, md)).ToNot(Equal(fingerprint))
+
+	// Adding or removing objects changes the fingerprint.
+	var nilMD *clusterv1.MachineDeployment
+	g.Expect(Fingerprint(cluster, nilMD)).ToNot(Equal(fingerprint))
+	g.Expect(Fingerprint(cluster)).ToNot(Equal(fingerprint))
+}
+
+func TestCache(t *testing.T) {
+	g := NewWithT(t)
+
+	c := NewCache[int]("test")
+	hitsBefore := testutil.ToFloat64(hits.WithLabelValues("test"))
+	missesBefore := testutil.ToFloat64(misses.WithLabelValues("test"))
+
+	_, ok := c.Get("key", "fingerprint")
+	g.Expect(ok).To(BeFalse())
+
+	c.Add("key", "fingerprint", 1)
+	value, ok := c.Get("key", "fingerprint")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(value).To(Equal(1))
+
+	_, ok = c.Get("key", "other-fingerprint")
+	g.Expect(ok).To(BeFalse())
+
+	c.Invalidate("key")
+	_, ok = c.Get("key", "fingerprint")
+	g.Expect(ok).To(BeFalse())
+	_, ok = c.Get("key", "")
+	g.Expect(ok).To(BeFalse())
+
+	g.Expect(testutil.ToFloat64(hits.WithLabelValues("test")) - hitsBefore).To(BeEquivalentTo(1))
+	g.Expect(testutil.ToFloat64(misses.WithLabelValues("test")) - missesBefore).To(BeEquivalentTo(4))
+}
@@ -0,0 +1,416 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package helm implements rendering of Helm charts, supporting the most commonly used Helm features.
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+const (
+	// hookAnnotation is the annotation used by Helm to identify hooks.
+	hookAnnotation = "helm.sh/hook"
+
+	// maxChartSize is the maximum size of the uncompressed content of a Helm chart.
+	maxChartSize = 20 * 1024 * 1024
+)
+
+// Chart is a Helm chart loaded from a chart archive.
+type Chart struct {
+	metadata  chartMetadata
+	values    map[string]interface{}
+	crds      map[string][]byte
+	templates map[string][]byte
+	files     map[string][]byte
+}
+
+// chartMetadata is the subset of Chart.yaml exposed to templates as .Chart.
+type chartMetadata struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	AppVersion  string `json:"appVersion,omitempty"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+// LoadOption is an option for Load.
+type LoadOption func(*loadOptions)
+
+type loadOptions struct {
+	ignoredDependencies map[string]bool
+}
+
+// IgnoreDependencies configures Load to ignore the dependencies with the given names, which are
+// expected to be installed by other means, instead of failing.
+func IgnoreDependencies(names ...string) LoadOption {
+	return func(o *loadOptions) {
+		for _, name := range names {
+			o.ignoredDependencies[name] = true
+		}
+	}
+}
+
+// Load loads a Helm chart from a chart archive, i.e. a gzipped tar with all the files
+// of the chart in a top level directory, like the ones created by `helm package`.
+// Charts with dependencies are not supported, unless dependencies are ignored.
+func Load(archive []byte, opts ...LoadOption) (*Chart, error) {
+	options := &loadOptions{ignoredDependencies: map[string]bool{}}
+	for _, o := range opts {
+		o(options)
+	}
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read Helm chart archive")
+	}
+	defer gzipReader.Close()
+
+	chart := &Chart{
+		values:    map[string]interface{}{},
+		crds:      map[string][]byte{},
+		templates: map[string][]byte{},
+		files:     map[string][]byte{},
+	}
+	var chartYAML, valuesYAML []byte
+	var totalSize int64
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read Helm chart archive")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		totalSize += header.Size
+		if totalSize > maxChartSize {
+			return nil, errors.Errorf("failed to read Helm chart archive: chart exceeds the maximum size of %d bytes", maxChartSize)
+		}
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %q from Helm chart archive", header.Name)
+		}
+
+		// Drop the top level directory, e.g. mychart/templates/deployment.yaml becomes templates/deployment.yaml.
+		_, name, ok := strings.Cut(path.Clean(header.Name), "/")
+		if !ok {
+			continue
+		}
+
+		switch {
+		case name == "Chart.yaml":
+			chartYAML = content
+		case name == "values.yaml":
+			valuesYAML = content
+		case strings.HasPrefix(name, "charts/"):
+			if options.ignoredDependencies[dependencyName(name)] {
+				continue
+			}
+			return nil, errors.New("failed to read Helm chart archive: charts with dependencies are not supported")
+		case strings.HasPrefix(name, "crds/"):
+			chart.crds[name] = content
+		case strings.HasPrefix(name, "templates/"):
+			chart.templates[name] = content
+		default:
+			chart.files[name] = content
+		}
+	}
+
+	if chartYAML == nil {
+		return nil, errors.New("failed to read Helm chart archive: Chart.yaml not found")
+	}
+	if err := yaml.Unmarshal(chartYAML, &chart.metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to parse Chart.yaml")
+	}
+	if chart.metadata.Name == "" {
+		return nil, errors.New("failed to parse Chart.yaml: name must be set")
+	}
+	if chart.metadata.Type == "library" {
+		return nil, errors.New("failed to read Helm chart archive: library charts cannot be rendered")
+	}
+	if err := yaml.Unmarshal(valuesYAML, &chart.values); err != nil {
+		return nil, errors.Wrap(err, "failed to parse values.yaml")
+	}
+	if chart.values == nil {
+		chart.values = map[string]interface{}{}
+	}
+
+	return chart, nil
+}
+
+// dependencyName returns the name of a dependency from the path of one of its files, e.g.
+// charts/cert-manager/Chart.yaml or charts/cert-manager-v1.16.0.tgz => cert-manager.
+func dependencyName(name string) string {
+	name = strings.TrimPrefix(name, "charts/")
+	if dir, _, ok := strings.Cut(name, "/"); ok {
+		return dir
+	}
+	name = strings.TrimSuffix(name, ".tgz")
+	if i := strings.LastIndex(name, "-"); i > 0 {
+		return name[:i]
+	}
+	return name
+}
+
+// Name returns the name of the chart.
+func (c *Chart) Name() string {
+	return c.metadata.Name
+}
+
+// Version returns the version of the chart.
+func (c *Chart) Version() string {
+	return c.metadata.Version
+}
+
+// File returns a non-template file of the chart, e.g. a file in the top level directory of the chart.
+func (c *Chart) File(name string) ([]byte, bool) {
+	content, ok := c.files[name]
+	return content, ok
+}
+
+// helmRelease is the release information exposed to templates as .Release.
+type helmRelease struct {
+	Name      string
+	Namespace string
+	Service   string
+	Revision  int
+	IsInstall bool
+	IsUpgrade bool
+}
+
+// helmFiles gives access to the non-template files of a chart, exposed to templates as .Files.
+type helmFiles map[string][]byte
+
+// Get returns the content of a file as a string, or an empty string if the file does not exist.
+func (f helmFiles) Get(name string) string {
+	return string(f[name])
+}
+
+// GetBytes returns the content of a file, or nil if the file does not exist.
+func (f helmFiles) GetBytes(name string) []byte {
+	return f[name]
+}
+
+// Render renders the chart with the given values, returning all the resources of the chart as a single
+// yaml document; CRDs in the crds directory are included, while hooks are dropped given that there is no
+// release lifecycle when resources are applied without Helm.
+//
+// Rendering follows Helm semantics for the most commonly used features: values are merged on top of the
+// default values of the chart, templates can use sprig functions as well as include, tpl, required, toYaml,
+// fromYaml, toJson and fromJson, and partials are read from files starting with an underscore.
+func (c *Chart) Render(releaseName, releaseNamespace string, values map[string]interface{}) ([]byte, error) {
+	data := map[string]interface{}{
+		"Values": mergeValues(runtime.DeepCopyJSON(c.values), values),
+		"Release": helmRelease{
+			Name:      releaseName,
+			Namespace: releaseNamespace,
+			Service:   "Helm",
+			Revision:  1,
+			IsInstall: true,
+		},
+		"Chart": map[string]interface{}{
+			"Name":        c.metadata.Name,
+			"Version":     c.metadata.Version,
+			"AppVersion":  c.metadata.AppVersion,
+			"Description": c.metadata.Description,
+		},
+		"Files": helmFiles(c.files),
+	}
+
+	tmpl := template.New(c.metadata.Name).Option("missingkey=zero")
+	funcMap := funcMap(tmpl)
+	tmpl.Funcs(funcMap)
+
+	templateNames := make([]string, 0, len(c.templates))
+	for name := range c.templates {
+		templateNames = append(templateNames, name)
+	}
+	sort.Strings(templateNames)
+	for _, name := range templateNames {
+		if _, err := tmpl.New(name).Parse(string(c.templates[name])); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse template %q", name)
+		}
+	}
+
+	docs := [][]byte{}
+	crdNames := make([]string, 0, len(c.crds))
+	for name := range c.crds {
+		crdNames = append(crdNames, name)
+	}
+	sort.Strings(crdNames)
+	for _, name := range crdNames {
+		docs = append(docs, c.crds[name])
+	}
+
+	for _, name := range templateNames {
+		base := path.Base(name)
+		if strings.HasPrefix(base, "_") || base == "NOTES.txt" || strings.HasPrefix(name, "templates/tests/") {
+			continue
+		}
+
+		templateData := map[string]interface{}{
+			"Template": map[string]interface{}{"Name": path.Join(c.metadata.Name, name), "BasePath": path.Join(c.metadata.Name, "templates")},
+		}
+		for k, v := range data {
+			templateData[k] = v
+		}
+
+		var buf bytes.Buffer
+		if err := tmpl.ExecuteTemplate(&buf, name, templateData); err != nil {
+			return nil, errors.Wrapf(err, "failed to render template %q", name)
+		}
+		rendered := strings.ReplaceAll(buf.String(), "<no value>", "")
+		if strings.TrimSpace(rendered) == "" {
+			continue
+		}
+		docs = append(docs, []byte(rendered))
+	}
+
+	objs, err := utilyaml.ToUnstructured(utilyaml.JoinYaml(docs...))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse rendered Helm chart")
+	}
+	resources := make([]unstructured.Unstructured, 0, len(objs))
+	for _, obj := range objs {
+		if _, ok := obj.GetAnnotations()[hookAnnotation]; ok {
+			continue
+		}
+		resources = append(resources, obj)
+	}
+	return utilyaml.FromUnstructured(resources)
+}
+
+// funcMap returns the functions available in templates.
+func funcMap(tmpl *template.Template) template.FuncMap {
+	funcMap := sprig.TxtFuncMap()
+	// Drop functions accessing the environment of the process rendering the chart.
+	delete(funcMap, "env")
+	delete(funcMap, "expandenv")
+
+	includedNames := map[string]int{}
+	funcMap["include"] = func(name string, data interface{}) (string, error) {
+		// Prevent infinite recursion.
+		if includedNames[name] > 1000 {
+			return "", errors.Errorf("rendering template has a nested reference name: %s", name)
+		}
+		includedNames[name]++
+		defer func() { includedNames[name]-- }()
+
+		var buf bytes.Buffer
+		if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	}
+	funcMap["tpl"] = func(text string, data interface{}) (string, error) {
+		t, err := tmpl.Clone()
+		if err != nil {
+			return "", err
+		}
+		t, err = t.New("tpl").Parse(text)
+		if err != nil {
+			return "", err
+		}
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, data); err != nil {
+			return "", err
+		}
+		return strings.ReplaceAll(buf.String(), "<no value>", ""), nil
+	}
+	funcMap["required"] = func(message string, value interface{}) (interface{}, error) {
+		if value == nil {
+			return nil, errors.New(message)
+		}
+		if s, ok := value.(string); ok && s == "" {
+			return nil, errors.New(message)
+		}
+		return value, nil
+	}
+	funcMap["toYaml"] = func(value interface{}) string {
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSuffix(string(data), "\n")
+	}
+	funcMap["fromYaml"] = func(value string) map[string]interface{} {
+		m := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(value), &m); err != nil {
+			m["Error"] = err.Error()
+		}
+		return m
+	}
+	funcMap["toJson"] = func(value interface{}) string {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+	funcMap["fromJson"] = func(value string) map[string]interface{} {
+		m := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(value), &m); err != nil {
+			m["Error"] = err.Error()
+		}
+		return m
+	}
+	// There is no connection to the cluster while rendering, so lookup always returns an empty object
+	// like `helm template` does.
+	funcMap["lookup"] = func(string, string, string, string) (map[string]interface{}, error) {
+		return map[string]interface{}{}, nil
+	}
+	return funcMap
+}
+
+// mergeValues merges values on top of dst, recursively merging maps;
+// a null value removes the corresponding key from dst.
+func mergeValues(dst, values map[string]interface{}) map[string]interface{} {
+	for k, v := range values {
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+		vMap, vIsMap := v.(map[string]interface{})
+		dstMap, dstIsMap := dst[k].(map[string]interface{})
+		if vIsMap && dstIsMap {
+			dst[k] = mergeValues(dstMap, vMap)
+			continue
+		}
+		dst[k] = v
+	}
+	return dst
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api/internal/util/helm/helmtest"
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+// testHelmChartFiles are the files of a chart used for testing, relative to the chart directory.
+var testHelmChartFiles = map[string]string{
+	"Chart.yaml": `apiVersion: v2
+name: test-chart
+version: 1.0.0
+appVersion: v2.0.0
+`,
+	"values.yaml": `replicas: 1
+image:
+  repository: registry.example.com/app
+  tag: ""
+extraLabels: {}
+`,
+	"templates/_helpers.tpl": `{{- define "test-chart.labels" -}}
+app.kubernetes.io/name: {{ .Chart.Name }}
+app.kubernetes.io/instance: {{ .Release.Name }}
+{{- end -}}
+`,
+	"templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-config
+  labels:
+    {{- include "test-chart.labels" . | nindent 4 }}
+    {{- with .Values.extraLabels }}
+    {{- toYaml . | nindent 4 }}
+    {{- end }}
+data:
+  image: {{ .Values.image.repository }}:{{ .Values.image.tag | default .Chart.AppVersion }}
+  replicas: {{ .Values.replicas | quote }}
+  namespace: {{ .Release.Namespace }}
+`,
+	"templates/hook.yaml": `apiVersion: v1
+kind: Pod
+metadata:
+  name: {{ .Release.Name }}-hook
+  annotations:
+    helm.sh/hook: post-install
+`,
+	"templates/NOTES.txt": `Thanks for installing {{ .Chart.Name }}.`,
+	"crds/crd.yaml": `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+`,
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		opts    []LoadOption
+		wantErr string
+	}{
+		{
+			name:  "valid chart",
+			files: testHelmChartFiles,
+		},
+		{
+			name:    "fails without Chart.yaml",
+			files:   map[string]string{"templates/configmap.yaml": "kind: ConfigMap"},
+			wantErr: "Chart.yaml not found",
+		},
+		{
+			name: "fails for charts with dependencies",
+			files: map[string]string{
+				"Chart.yaml":                   "name: test-chart\nversion: 1.0.0",
+				"charts/dependency/Chart.yaml": "name: dependency\nversion: 1.0.0",
+			},
+			wantErr: "charts with dependencies are not supported",
+		},
+		{
+			name: "ignored dependencies are skipped",
+			files: map[string]string{
+				"Chart.yaml":                     "name: test-chart\nversion: 1.0.0",
+				"charts/cert-manager-v1.0.0.tgz": "",
+			},
+			opts: []LoadOption{IgnoreDependencies("cert-manager")},
+		},
+		{
+			name:    "fails for library charts",
+			files:   map[string]string{"Chart.yaml": "name: test-chart\nversion: 1.0.0\ntype: library"},
+			wantErr: "library charts cannot be rendered",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			chart, err := Load(helmtest.NewChartArchive(t, "test-chart", tt.files), tt.opts...)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(chart.Name()).To(Equal("test-chart"))
+			if tt.opts != nil {
+				return
+			}
+			g.Expect(chart.templates).To(HaveKey("templates/configmap.yaml"))
+			g.Expect(chart.crds).To(HaveKey("crds/crd.yaml"))
+			g.Expect(chart.values).To(HaveKeyWithValue("replicas", BeNumerically("==", 1)))
+		})
+	}
+}
+
+func TestChartRender(t *testing.T) {
+	tests := []struct {
+		name       string
+		values     map[string]interface{}
+		wantConfig map[string]interface{}
+		wantLabels map[string]string
+	}{
+		{
+			name:   "default values",
+			values: map[string]interface{}{},
+			wantConfig: map[string]interface{}{
+				"image":     "registry.example.com/app:v2.0.0",
+				"replicas":  "1",
+				"namespace": "kube-system",
+			},
+			wantLabels: map[string]string{
+				"app.kubernetes.io/name":     "test-chart",
+				"app.kubernetes.io/instance": "test-release",
+			},
+		},
+		{
+			name: "values are merged with default values",
+			values: map[string]interface{}{
+				"replicas":    3,
+				"image":       map[string]interface{}{"tag": "v2.1.0"},
+				"extraLabels": map[string]interface{}{"foo": "bar"},
+			},
+			wantConfig: map[string]interface{}{
+				"image":     "registry.example.com/app:v2.1.0",
+				"replicas":  "3",
+				"namespace": "kube-system",
+			},
+			wantLabels: map[string]string{
+				"app.kubernetes.io/name":     "test-chart",
+				"app.kubernetes.io/instance": "test-release",
+				"foo":                        "bar",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			chart, err := Load(helmtest.NewChartArchive(t, "test-chart", testHelmChartFiles))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			rendered, err := chart.Render("test-release", "kube-system", tt.values)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			objs, err := utilyaml.ToUnstructured(rendered)
+			g.Expect(err).ToNot(HaveOccurred())
+			// CRDs come first, and hooks are dropped.
+			g.Expect(objs).To(HaveLen(2))
+			g.Expect(objs[0].GetKind()).To(Equal("CustomResourceDefinition"))
+			g.Expect(objs[1].GetKind()).To(Equal("ConfigMap"))
+			g.Expect(objs[1].GetName()).To(Equal("test-release-config"))
+			g.Expect(objs[1].GetLabels()).To(Equal(tt.wantLabels))
+			g.Expect(objs[1].Object["data"]).To(Equal(tt.wantConfig))
+		})
+	}
+}
+
+func TestChartRenderRequired(t *testing.T) {
+	g := NewWithT(t)
+
+	files := map[string]string{
+		"Chart.yaml": "name: test-chart\nversion: 1.0.0",
+		"templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ required "name is required" .Values.name }}
+`,
+	}
+	chart, err := Load(helmtest.NewChartArchive(t, "test-chart", files))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = chart.Render("test-release", "default", map[string]interface{}{})
+	g.Expect(err).To(MatchError(ContainSubstring("name is required")))
+
+	rendered, err := chart.Render("test-release", "default", map[string]interface{}{"name": "foo"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(rendered)).To(ContainSubstring("name: foo"))
+}
+
+func TestMergeValues(t *testing.T) {
+	g := NewWithT(t)
+
+	dst := map[string]interface{}{
+		"a": "a",
+		"b": map[string]interface{}{"c": "c", "d": "d"},
+		"e": "e",
+	}
+	values := map[string]interface{}{
+		"a": "A",
+		"b": map[string]interface{}{"c": "C"},
+		"e": nil,
+		"f": "F",
+	}
+	g.Expect(mergeValues(dst, values)).To(Equal(map[string]interface{}{
+		"a": "A",
+		"b": map[string]interface{}{"c": "C", "d": "d"},
+		"f": "F",
+	}))
+}
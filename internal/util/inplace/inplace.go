@@ -51,6 +51,10 @@ func CleanupMachineSpecForDiff(spec *clusterv1.MachineSpec) *clusterv1.MachineSp
 	spec.Bootstrap = clusterv1.Bootstrap{}
 	spec.InfrastructureRef = clusterv1.ContractVersionedObjectReference{}
 
+	// Machine: should not be set.
+	// MachineSet: not responsibility of the in-place update extension.
+	spec.InfrastructureOverrides = nil
+
 	// Machine: should never change.
 	// MachineSet: should not be set.
 	spec.ProviderID = ""
@@ -58,12 +62,46 @@ func CleanupMachineSpecForDiff(spec *clusterv1.MachineSpec) *clusterv1.MachineSp
 	// Version & FailureDomain should be compared.
 
 	// Fields that are mutated in-place without a rollout.
-	spec.MinReadySeconds = nil
-	spec.ReadinessGates = nil
-	spec.Deletion.NodeDrainTimeoutSeconds = nil
-	spec.Deletion.NodeVolumeDetachTimeoutSeconds = nil
-	spec.Deletion.NodeDeletionTimeoutSeconds = nil
-	spec.Taints = nil
+	ClearMutableMachineSpecFields(spec)
 
 	return spec
 }
+
+// MutableMachineSpecFields is the list of the MachineSpec fields which are propagated in-place from MachineDeployments
+// to MachineSets and from MachineSets to Machines, without triggering a rollout.
+// NOTE: Please keep CopyMutableMachineSpecFields, ClearMutableMachineSpecFields and the MachineDeployment and
+// MachineSet controller documentation in sync with this list.
+var MutableMachineSpecFields = []string{
+	"minReadySeconds",
+	"readinessGates",
+	"deletion.nodeDrainTimeoutSeconds",
+	"deletion.nodeVolumeDetachTimeoutSeconds",
+	"deletion.nodeVolumeDetachExclusions",
+	"deletion.nodeDeletionTimeoutSeconds",
+	"kubeletServingCertificateVerification",
+	"powerState",
+	"infrastructureReadyTimeoutSeconds",
+	"nodeReadinessChecks",
+	"taints",
+}
+
+// CopyMutableMachineSpecFields copies the MachineSpec fields which are propagated in-place from src to dst.
+func CopyMutableMachineSpecFields(dst, src *clusterv1.MachineSpec) {
+	dst.MinReadySeconds = src.MinReadySeconds
+	dst.ReadinessGates = src.ReadinessGates
+	dst.Deletion.NodeDrainTimeoutSeconds = src.Deletion.NodeDrainTimeoutSeconds
+	dst.Deletion.NodeVolumeDetachTimeoutSeconds = src.Deletion.NodeVolumeDetachTimeoutSeconds
+	dst.Deletion.NodeVolumeDetachExclusions = src.Deletion.NodeVolumeDetachExclusions
+	dst.Deletion.NodeDeletionTimeoutSeconds = src.Deletion.NodeDeletionTimeoutSeconds
+	dst.KubeletServingCertificateVerification = src.KubeletServingCertificateVerification
+	dst.PowerState = src.PowerState
+	dst.InfrastructureReadyTimeoutSeconds = src.InfrastructureReadyTimeoutSeconds
+	dst.NodeReadinessChecks = src.NodeReadinessChecks
+	dst.Taints = src.Taints
+}
+
+// ClearMutableMachineSpecFields sets the MachineSpec fields which are propagated in-place to their zero value,
+// so they are omitted when comparing MachineSpecs.
+func ClearMutableMachineSpecFields(spec *clusterv1.MachineSpec) {
+	CopyMutableMachineSpecFields(spec, &clusterv1.MachineSpec{})
+}
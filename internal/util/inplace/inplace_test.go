@@ -0,0 +1,78 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inplace
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestCopyAndClearMutableMachineSpecFields(t *testing.T) {
+	g := NewWithT(t)
+
+	src := &clusterv1.MachineSpec{
+		ClusterName:     "test-cluster",
+		Version:         "v1.34.0",
+		FailureDomain:   "fd1",
+		MinReadySeconds: ptr.To[int32](10),
+		ReadinessGates: []clusterv1.MachineReadinessGate{
+			{ConditionType: "foo"},
+		},
+		Deletion: clusterv1.MachineDeletionSpec{
+			NodeDrainTimeoutSeconds:        ptr.To[int32](20),
+			NodeVolumeDetachTimeoutSeconds: ptr.To[int32](30),
+			NodeVolumeDetachExclusions:     clusterv1.MachineNodeVolumeDetachExclusions{StorageClassNames: []string{"bar"}},
+			NodeDeletionTimeoutSeconds:     ptr.To[int32](40),
+		},
+		KubeletServingCertificateVerification: clusterv1.MachineKubeletServingCertificateVerificationEnabled,
+		PowerState:                            clusterv1.MachinePowerStateStopped,
+		InfrastructureReadyTimeoutSeconds:     ptr.To[int32](50),
+		NodeReadinessChecks: []clusterv1.MachineNodeReadinessCheck{
+			{Name: "gpu", Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "gpu-driver"}}},
+		},
+		Taints: []clusterv1.MachineTaint{
+			{Key: "key", Value: "value", Effect: corev1.TaintEffectNoSchedule},
+		},
+	}
+
+	// Copy should only set the in-place mutable fields.
+	dst := &clusterv1.MachineSpec{
+		ClusterName: "other-cluster",
+		Version:     "v1.33.0",
+	}
+	CopyMutableMachineSpecFields(dst, src)
+
+	want := src.DeepCopy()
+	want.ClusterName = "other-cluster"
+	want.Version = "v1.33.0"
+	want.FailureDomain = ""
+	g.Expect(dst).To(BeComparableTo(want))
+
+	// Clear should only unset the in-place mutable fields.
+	ClearMutableMachineSpecFields(src)
+	g.Expect(src).To(BeComparableTo(&clusterv1.MachineSpec{
+		ClusterName:   "test-cluster",
+		Version:       "v1.34.0",
+		FailureDomain: "fd1",
+	}))
+}
@@ -0,0 +1,454 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package oci implements a minimal client for pulling artifacts from OCI registries.
+package oci
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Scheme is the scheme of URLs pointing to artifacts hosted on OCI registries.
+	Scheme = "oci"
+
+	// TitleAnnotation is the annotation used to identify the file name of an OCI artifact layer.
+	// NOTE: This is the annotation set by oras when pushing files.
+	TitleAnnotation = "org.opencontainers.image.title"
+
+	// ManifestMediaType is the media type of OCI image manifests.
+	ManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+
+	// DockerManifestMediaType is the media type of Docker image manifests.
+	DockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// DockerHubHost is the host used to refer to Docker Hub in image references.
+	DockerHubHost = "docker.io"
+
+	// DockerHubRegistryHost is the host of the Docker Hub registry API.
+	DockerHubRegistryHost = "registry-1.docker.io"
+
+	requestTimeout = 30 * time.Second
+)
+
+// ErrNotFound is returned when the requested manifest or blob does not exist in the registry.
+var ErrNotFound = errors.New("not found")
+
+var challengeParamRegexp = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// Manifest is an OCI image manifest.
+type Manifest struct {
+	Config Descriptor   `json:"config"`
+	Layers []Descriptor `json:"layers"`
+}
+
+// Descriptor is an OCI content descriptor.
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Credentials are the credentials for accessing a registry.
+type Credentials struct {
+	Username      string
+	Password      string
+	IdentityToken string
+	RegistryToken string
+}
+
+// CredentialsFunc returns the credentials for a registry, or nil if credentials for the registry are not defined.
+type CredentialsFunc func(ctx context.Context, registry string) (*Credentials, error)
+
+// Client pulls content from a repository hosted on an OCI registry.
+type Client struct {
+	registry      string
+	repository    string
+	httpClient    *http.Client
+	credentials   CredentialsFunc
+	clientID      string
+	authorization string
+}
+
+// Option is a configuration option supplied to NewClient.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to connect to the registry.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithCredentials sets the function used to get credentials when the registry requires authentication.
+func WithCredentials(credentials CredentialsFunc) Option {
+	return func(c *Client) {
+		c.credentials = credentials
+	}
+}
+
+// WithClientID sets the client ID used when exchanging identity tokens for bearer tokens.
+func WithClientID(clientID string) Option {
+	return func(c *Client) {
+		c.clientID = clientID
+	}
+}
+
+// NewClient returns a Client for a repository hosted on an OCI registry.
+// References to Docker Hub are normalized, see NormalizeRepository.
+func NewClient(registry, repository string, opts ...Option) *Client {
+	registry, repository = NormalizeRepository(registry, repository)
+	c := &Client{
+		registry:   registry,
+		repository: repository,
+		httpClient: http.DefaultClient,
+		clientID:   "cluster-api",
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+// Registry returns the host of the registry.
+func (c *Client) Registry() string {
+	return c.registry
+}
+
+// Repository returns the name of the repository.
+func (c *Client) Repository() string {
+	return c.repository
+}
+
+// NormalizeRepository translates references to Docker Hub to the host of the Docker Hub registry API;
+// official images on Docker Hub are stored under the library namespace.
+func NormalizeRepository(registry, repository string) (string, string) {
+	if registry != DockerHubHost {
+		return registry, repository
+	}
+	if !strings.Contains(repository, "/") {
+		repository = "library/" + repository
+	}
+	return DockerHubRegistryHost, repository
+}
+
+// ParseReference parses a reference in the form oci://{registry}/{repository}:{tag} or
+// oci://{registry}/{repository}@{digest}, and returns registry, repository and tag or digest.
+func ParseReference(reference string) (string, string, string, error) {
+	invalidReferenceError := errors.Errorf("invalid reference %q: an OCI reference should be in the form oci://{registry}/{repository}:{tag} or oci://{registry}/{repository}@{digest}", reference)
+
+	rURL, err := url.Parse(reference)
+	if err != nil || rURL.Scheme != Scheme || rURL.Host == "" {
+		return "", "", "", invalidReferenceError
+	}
+
+	path := strings.TrimPrefix(rURL.Path, "/")
+	if repository, digest, ok := strings.Cut(path, "@"); ok {
+		if repository == "" || digest == "" {
+			return "", "", "", invalidReferenceError
+		}
+		return rURL.Host, repository, digest, nil
+	}
+
+	i := strings.LastIndex(path, ":")
+	if i <= 0 || i == len(path)-1 || strings.Contains(path[i+1:], "/") {
+		return "", "", "", invalidReferenceError
+	}
+	return rURL.Host, path[:i], path[i+1:], nil
+}
+
+// ListTags returns the list of tags in the repository.
+func (c *Client) ListTags(ctx context.Context) ([]string, error) {
+	tags := []string{}
+	next := fmt.Sprintf("/v2/%s/tags/list", c.repository)
+	for next != "" {
+		content, header, err := c.get(ctx, next, "application/json")
+		if err != nil {
+			return nil, err
+		}
+
+		page := struct {
+			Tags []string `json:"tags"`
+		}{}
+		if err := json.Unmarshal(content, &page); err != nil {
+			return nil, errors.Wrap(err, "failed to parse the list of tags")
+		}
+		tags = append(tags, page.Tags...)
+
+		next = nextPage(header.Get("Link"))
+	}
+	return tags, nil
+}
+
+// GetManifest returns the manifest for a tag or digest, together with the digest of the manifest.
+func (c *Client) GetManifest(ctx context.Context, reference string) (*Manifest, string, error) {
+	content, header, err := c.get(ctx, fmt.Sprintf("/v2/%s/manifests/%s", c.repository, reference), strings.Join([]string{ManifestMediaType, DockerManifestMediaType}, ", "))
+	if err != nil {
+		return nil, "", err
+	}
+
+	digest := digestOf(content)
+	if strings.Contains(reference, ":") {
+		if err := verifyDigest(reference, content); err != nil {
+			return nil, "", errors.Wrapf(err, "failed to verify manifest %q", reference)
+		}
+		digest = reference
+	} else if d := header.Get("Docker-Content-Digest"); d != "" && verifyDigest(d, content) == nil {
+		digest = d
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(content, manifest); err != nil {
+		return nil, "", errors.Wrap(err, "failed to parse OCI manifest")
+	}
+	return manifest, digest, nil
+}
+
+// GetBlob returns the content of a blob, after verifying it matches the digest.
+func (c *Client) GetBlob(ctx context.Context, digest string) ([]byte, error) {
+	content, _, err := c.get(ctx, fmt.Sprintf("/v2/%s/blobs/%s", c.repository, digest), "")
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyDigest(digest, content); err != nil {
+		return nil, errors.Wrapf(err, "failed to verify blob %q", digest)
+	}
+	return content, nil
+}
+
+// get executes a GET request against the registry, authenticating if required by the registry.
+func (c *Client) get(ctx context.Context, path, accept string) ([]byte, http.Header, error) {
+	requestURL := path
+	if !strings.HasPrefix(path, "https://") {
+		requestURL = fmt.Sprintf("https://%s%s", c.registry, path)
+	}
+
+	timeoutctx, cancel := context.WithTimeoutCause(ctx, requestTimeout, errors.New("http request timeout expired"))
+	defer cancel()
+
+	response, err := c.do(timeoutctx, requestURL, accept, c.authorization)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer response.Body.Close()
+
+	// If the registry requires authentication, get credentials according to the challenge and retry.
+	if response.StatusCode == http.StatusUnauthorized {
+		authorization, err := c.authorize(timeoutctx, response.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return nil, nil, err
+		}
+		c.authorization = authorization
+
+		response, err = c.do(timeoutctx, requestURL, accept, c.authorization)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer response.Body.Close()
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil, errors.Wrapf(ErrNotFound, "failed to get %q", requestURL)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, nil, errors.Errorf("failed to get %q: unauthorized access, please check your credentials", requestURL)
+	default:
+		return nil, nil, errors.Errorf("failed to get %q, got %d", requestURL, response.StatusCode)
+	}
+
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get %q", requestURL)
+	}
+	return content, response.Header, nil
+}
+
+func (c *Client) do(ctx context.Context, requestURL, accept, authorization string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, http.NoBody)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %q: failed to create request", requestURL)
+	}
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %q", requestURL)
+	}
+	return response, nil
+}
+
+// authorize returns the value of the Authorization header for the given WWW-Authenticate challenge.
+func (c *Client) authorize(ctx context.Context, challenge string) (string, error) {
+	var credentials *Credentials
+	if c.credentials != nil {
+		var err error
+		credentials, err = c.credentials(ctx, c.registry)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get credentials for %q", c.registry)
+		}
+	}
+
+	scheme, _, _ := strings.Cut(challenge, " ")
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if credentials == nil || credentials.Username == "" {
+			return "", errors.Errorf("failed to authenticate to %q: credentials not found", c.registry)
+		}
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials.Username+":"+credentials.Password)), nil
+	case "bearer":
+		params := map[string]string{}
+		for _, match := range challengeParamRegexp.FindAllStringSubmatch(challenge, -1) {
+			params[match[1]] = match[2]
+		}
+		token, err := c.getToken(ctx, params["realm"], params["service"], params["scope"], credentials)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to authenticate to %q", c.registry)
+		}
+		return "Bearer " + token, nil
+	default:
+		return "", errors.Errorf("failed to authenticate to %q: unsupported authentication scheme %q", c.registry, scheme)
+	}
+}
+
+// getToken gets a bearer token from the authorization server of the registry.
+// See https://distribution.github.io/distribution/spec/auth/token/ and https://distribution.github.io/distribution/spec/auth/oauth/.
+func (c *Client) getToken(ctx context.Context, realm, service, scope string, credentials *Credentials) (string, error) {
+	if realm == "" {
+		return "", errors.New("invalid authentication challenge: missing realm")
+	}
+	if credentials != nil && credentials.RegistryToken != "" {
+		return credentials.RegistryToken, nil
+	}
+
+	var request *http.Request
+	var err error
+	if credentials != nil && credentials.IdentityToken != "" {
+		// Exchange the identity token for a bearer token using the OAuth2 refresh token flow.
+		form := url.Values{}
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", credentials.IdentityToken)
+		form.Set("service", service)
+		form.Set("scope", scope)
+		form.Set("client_id", c.clientID)
+		request, err = http.NewRequestWithContext(ctx, http.MethodPost, realm, strings.NewReader(form.Encode()))
+		if err != nil {
+			return "", errors.Wrap(err, "failed to create token request")
+		}
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else {
+		tokenURL, err := url.Parse(realm)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid authentication challenge: invalid realm %q", realm)
+		}
+		query := tokenURL.Query()
+		if service != "" {
+			query.Set("service", service)
+		}
+		if scope != "" {
+			query.Set("scope", scope)
+		}
+		tokenURL.RawQuery = query.Encode()
+		request, err = http.NewRequestWithContext(ctx, http.MethodGet, tokenURL.String(), http.NoBody)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to create token request")
+		}
+		// Note: if credentials are not available, an anonymous token is requested.
+		if credentials != nil && credentials.Username != "" {
+			request.SetBasicAuth(credentials.Username, credentials.Password)
+		}
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get token")
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", errors.Errorf("failed to get token, got %d, please check your credentials", response.StatusCode)
+	}
+
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(response.Body).Decode(&token); err != nil {
+		return "", errors.Wrap(err, "failed to get token: failed to parse response")
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	if token.AccessToken != "" {
+		return token.AccessToken, nil
+	}
+	return "", errors.New("failed to get token: empty token in response")
+}
+
+// nextPage returns the path of the next page from the value of a Link header, if any.
+// e.g. </v2/foo/tags/list?n=100&last=v1.0.0>; rel="next".
+func nextPage(link string) string {
+	if link == "" || !strings.Contains(link, `rel="next"`) {
+		return ""
+	}
+	start := strings.Index(link, "<")
+	end := strings.Index(link, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return link[start+1 : end]
+}
+
+// digestOf returns the sha256 digest of the content.
+func digestOf(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// verifyDigest verifies that the content matches the given digest.
+func verifyDigest(digest string, content []byte) error {
+	algorithm, _, ok := strings.Cut(digest, ":")
+	if !ok {
+		return errors.Errorf("invalid digest %q", digest)
+	}
+	// Note: only sha256 digests, which are used by default by all the OCI tools, are verified.
+	if algorithm != "sha256" {
+		return nil
+	}
+	if digestOf(content) != digest {
+		return errors.Errorf("digest mismatch: expected %s", digest)
+	}
+	return nil
+}
@@ -0,0 +1,338 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+// newFakeRegistry returns a registry serving the repository foo/bar, requiring basic authentication.
+func newFakeRegistry(manifest []byte, blobs map[string][]byte) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/foo/bar/", func(w http.ResponseWriter, req *http.Request) {
+		if username, password, ok := req.BasicAuth(); !ok || username != "user" || password != "pass" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		path := strings.TrimPrefix(req.URL.Path, "/v2/foo/bar/")
+		switch {
+		case path == "tags/list":
+			// Return tags in two pages to test pagination.
+			if req.URL.Query().Get("last") == "" {
+				w.Header().Set("Link", `</v2/foo/bar/tags/list?n=2&last=v1.1.0>; rel="next"`)
+				_, _ = w.Write([]byte(`{"name": "foo/bar", "tags": ["v1.0.0", "v1.1.0"]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"name": "foo/bar", "tags": ["v1.2.0"]}`))
+		case path == "manifests/v1.0.0" || path == "manifests/"+digestOf(manifest):
+			w.Header().Set("Content-Type", ManifestMediaType)
+			w.Header().Set("Docker-Content-Digest", digestOf(manifest))
+			_, _ = w.Write(manifest)
+		case path == "manifests/sha256:0000000000000000000000000000000000000000000000000000000000000000":
+			_, _ = w.Write(manifest)
+		case strings.HasPrefix(path, "blobs/"):
+			content, ok := blobs[strings.TrimPrefix(path, "blobs/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(content)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return httptest.NewTLSServer(mux)
+}
+
+func TestClient(t *testing.T) {
+	layer := []byte("kind: ConfigMap")
+	corruptedLayerDigest := digestOf([]byte("something else"))
+	manifest, err := json.Marshal(Manifest{
+		Layers: []Descriptor{
+			{MediaType: "application/vnd.oci.image.layer.v1.tar", Digest: digestOf(layer), Size: int64(len(layer)), Annotations: map[string]string{TitleAnnotation: "cm.yaml"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := newFakeRegistry(manifest, map[string][]byte{
+		digestOf(layer):      layer,
+		corruptedLayerDigest: layer,
+	})
+	defer server.Close()
+	registry := strings.TrimPrefix(server.URL, "https://")
+
+	credentials := func(_ context.Context, r string) (*Credentials, error) {
+		if r != registry {
+			return nil, errors.Errorf("unexpected registry %q", r)
+		}
+		return &Credentials{Username: "user", Password: "pass"}, nil
+	}
+
+	t.Run("ListTags follows pagination", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry, "foo/bar", WithHTTPClient(server.Client()), WithCredentials(credentials))
+		tags, err := c.ListTags(t.Context())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tags).To(Equal([]string{"v1.0.0", "v1.1.0", "v1.2.0"}))
+	})
+	t.Run("GetManifest by tag and by digest", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry, "foo/bar", WithHTTPClient(server.Client()), WithCredentials(credentials))
+		got, digest, err := c.GetManifest(t.Context(), "v1.0.0")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(digest).To(Equal(digestOf(manifest)))
+		g.Expect(got.Layers).To(HaveLen(1))
+		g.Expect(got.Layers[0].Annotations[TitleAnnotation]).To(Equal("cm.yaml"))
+
+		got, digest, err = c.GetManifest(t.Context(), digestOf(manifest))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(digest).To(Equal(digestOf(manifest)))
+		g.Expect(got.Layers).To(HaveLen(1))
+	})
+	t.Run("GetManifest fails if the manifest does not match the digest", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry, "foo/bar", WithHTTPClient(server.Client()), WithCredentials(credentials))
+		_, _, err := c.GetManifest(t.Context(), "sha256:0000000000000000000000000000000000000000000000000000000000000000")
+		g.Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+	})
+	t.Run("GetManifest returns ErrNotFound for missing tags", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry, "foo/bar", WithHTTPClient(server.Client()), WithCredentials(credentials))
+		_, _, err := c.GetManifest(t.Context(), "v9.9.9")
+		g.Expect(errors.Is(err, ErrNotFound)).To(BeTrue())
+	})
+	t.Run("GetBlob", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry, "foo/bar", WithHTTPClient(server.Client()), WithCredentials(credentials))
+		content, err := c.GetBlob(t.Context(), digestOf(layer))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(content).To(Equal(layer))
+
+		_, err = c.GetBlob(t.Context(), corruptedLayerDigest)
+		g.Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+	})
+	t.Run("fails without credentials", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry, "foo/bar", WithHTTPClient(server.Client()))
+		_, err := c.ListTags(t.Context())
+		g.Expect(err).To(MatchError(ContainSubstring("credentials not found")))
+	})
+}
+
+func TestNewClient(t *testing.T) {
+	tests := []struct {
+		name           string
+		registry       string
+		repository     string
+		wantRegistry   string
+		wantRepository string
+	}{
+		{
+			name:           "registry",
+			registry:       "registry.example.com",
+			repository:     "foo/bar",
+			wantRegistry:   "registry.example.com",
+			wantRepository: "foo/bar",
+		},
+		{
+			name:           "Docker Hub",
+			registry:       "docker.io",
+			repository:     "foo/bar",
+			wantRegistry:   "registry-1.docker.io",
+			wantRepository: "foo/bar",
+		},
+		{
+			name:           "Docker Hub official image",
+			registry:       "docker.io",
+			repository:     "bar",
+			wantRegistry:   "registry-1.docker.io",
+			wantRepository: "library/bar",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := NewClient(tt.registry, tt.repository)
+			g.Expect(c.Registry()).To(Equal(tt.wantRegistry))
+			g.Expect(c.Repository()).To(Equal(tt.wantRepository))
+		})
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		name            string
+		reference       string
+		wantRegistry    string
+		wantRepository  string
+		wantTagOrDigest string
+		wantErr         bool
+	}{
+		{
+			name:            "tag",
+			reference:       "oci://registry.example.com/foo/bar:v1.0.0",
+			wantRegistry:    "registry.example.com",
+			wantRepository:  "foo/bar",
+			wantTagOrDigest: "v1.0.0",
+		},
+		{
+			name:            "registry with port",
+			reference:       "oci://localhost:5000/foo:v1.0.0",
+			wantRegistry:    "localhost:5000",
+			wantRepository:  "foo",
+			wantTagOrDigest: "v1.0.0",
+		},
+		{
+			name:            "digest",
+			reference:       "oci://registry.example.com/foo/bar@sha256:abc",
+			wantRegistry:    "registry.example.com",
+			wantRepository:  "foo/bar",
+			wantTagOrDigest: "sha256:abc",
+		},
+		{
+			name:      "fails without scheme",
+			reference: "registry.example.com/foo/bar:v1.0.0",
+			wantErr:   true,
+		},
+		{
+			name:      "fails with another scheme",
+			reference: "https://registry.example.com/foo/bar:v1.0.0",
+			wantErr:   true,
+		},
+		{
+			name:      "fails without tag",
+			reference: "oci://registry.example.com/foo/bar",
+			wantErr:   true,
+		},
+		{
+			name:      "fails with empty tag",
+			reference: "oci://registry.example.com/foo/bar:",
+			wantErr:   true,
+		},
+		{
+			name:      "fails with path after the tag",
+			reference: "oci://registry.example.com/foo:v1.0.0/bar",
+			wantErr:   true,
+		},
+		{
+			name:      "fails with empty digest",
+			reference: "oci://registry.example.com/foo/bar@",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			registry, repository, tagOrDigest, err := ParseReference(tt.reference)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(registry).To(Equal(tt.wantRegistry))
+			g.Expect(repository).To(Equal(tt.wantRepository))
+			g.Expect(tagOrDigest).To(Equal(tt.wantTagOrDigest))
+		})
+	}
+}
+
+func TestDockerConfigCredentials(t *testing.T) {
+	tests := []struct {
+		name         string
+		dockerConfig string
+		registry     string
+		want         *Credentials
+		wantErr      bool
+	}{
+		{
+			name:         "credentials from auth",
+			dockerConfig: fmt.Sprintf(`{"auths": {"registry.example.com": {"auth": %q}}}`, base64.StdEncoding.EncodeToString([]byte("user:pass"))),
+			registry:     "registry.example.com",
+			want:         &Credentials{Username: "user", Password: "pass"},
+		},
+		{
+			name:         "credentials from username and password, with scheme in the key",
+			dockerConfig: `{"auths": {"https://registry.example.com/v1/": {"username": "user", "password": "pass"}}}`,
+			registry:     "registry.example.com",
+			want:         &Credentials{Username: "user", Password: "pass"},
+		},
+		{
+			name:         "credentials for Docker Hub",
+			dockerConfig: `{"auths": {"https://index.docker.io/v1/": {"username": "user", "password": "pass"}}}`,
+			registry:     "registry-1.docker.io",
+			want:         &Credentials{Username: "user", Password: "pass"},
+		},
+		{
+			name:         "no credentials for the registry",
+			dockerConfig: `{"auths": {"other.example.com": {"username": "user", "password": "pass"}}}`,
+			registry:     "registry.example.com",
+			want:         nil,
+		},
+		{
+			name:         "fails with invalid auth",
+			dockerConfig: fmt.Sprintf(`{"auths": {"registry.example.com": {"auth": %q}}}`, base64.StdEncoding.EncodeToString([]byte("userpass"))),
+			registry:     "registry.example.com",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			dockerConfig, err := ParseDockerConfig([]byte(tt.dockerConfig))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			got, err := dockerConfig.Credentials(tt.registry)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestNextPage(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(nextPage(`</v2/foo/tags/list?n=100&last=v1.0.0>; rel="next"`)).To(Equal("/v2/foo/tags/list?n=100&last=v1.0.0"))
+	g.Expect(nextPage("")).To(BeEmpty())
+	g.Expect(nextPage(`</v2/foo/tags/list>; rel="prev"`)).To(BeEmpty())
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// dockerHubConfigKey is the key used for Docker Hub credentials in docker config files.
+const dockerHubConfigKey = "https://index.docker.io/v1/"
+
+// DockerConfig is the subset of a docker config file used for reading registry credentials;
+// the same format is used by Secrets of type kubernetes.io/dockerconfigjson.
+type DockerConfig struct {
+	Auths       map[string]DockerAuthConfig `json:"auths,omitempty"`
+	CredsStore  string                      `json:"credsStore,omitempty"`
+	CredHelpers map[string]string           `json:"credHelpers,omitempty"`
+}
+
+// DockerAuthConfig are the credentials for a registry stored in a docker config file.
+type DockerAuthConfig struct {
+	Auth          string `json:"auth,omitempty"`
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	IdentityToken string `json:"identitytoken,omitempty"`
+	RegistryToken string `json:"registrytoken,omitempty"`
+}
+
+// ParseDockerConfig parses the content of a docker config file.
+func ParseDockerConfig(content []byte) (*DockerConfig, error) {
+	dockerConfig := &DockerConfig{}
+	if err := json.Unmarshal(content, dockerConfig); err != nil {
+		return nil, errors.Wrap(err, "failed to parse docker config")
+	}
+	return dockerConfig, nil
+}
+
+// ConfigKey returns the key used for the registry in docker config files.
+func ConfigKey(registry string) string {
+	if registry == DockerHubRegistryHost {
+		return dockerHubConfigKey
+	}
+	return registry
+}
+
+// Credentials returns the credentials for a registry from the auths section of the docker config,
+// or nil if credentials for the registry are not defined.
+// NOTE: Credential helpers and credential stores are not considered.
+func (c *DockerConfig) Credentials(registry string) (*Credentials, error) {
+	configKey := ConfigKey(registry)
+	for key, auth := range c.Auths {
+		if normalizeConfigKey(key) != normalizeConfigKey(configKey) {
+			continue
+		}
+		credentials := &Credentials{
+			Username:      auth.Username,
+			Password:      auth.Password,
+			IdentityToken: auth.IdentityToken,
+			RegistryToken: auth.RegistryToken,
+		}
+		if auth.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to decode credentials for %q", key)
+			}
+			username, password, ok := strings.Cut(string(decoded), ":")
+			if !ok {
+				return nil, errors.Errorf("failed to decode credentials for %q: invalid format", key)
+			}
+			credentials.Username = username
+			credentials.Password = password
+		}
+		return credentials, nil
+	}
+	return nil, nil
+}
+
+// normalizeConfigKey drops the scheme and the path from keys in docker config files,
+// e.g. https://registry.example.com/v1/ becomes registry.example.com.
+func normalizeConfigKey(key string) string {
+	key = strings.TrimPrefix(key, "https://")
+	key = strings.TrimPrefix(key, "http://")
+	host, _, _ := strings.Cut(key, "/")
+	return host
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ocitest implements a fake OCI registry for testing.
+package ocitest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/cluster-api/internal/util/oci"
+)
+
+const (
+	// Username is the username accepted by the fake registry.
+	Username = "user"
+	// Password is the password accepted by the fake registry.
+	Password = "pass"
+)
+
+// Registry is a fake OCI registry serving artifacts over TLS, requiring authentication.
+// By default, the registry requires basic authentication; if a token is set using WithBearerToken, the registry
+// requires bearer token authentication, and issues the token to clients authenticating with Username and Password.
+type Registry struct {
+	*httptest.Server
+
+	// Blobs are the blobs served by the registry, by digest.
+	// NOTE: Blobs can be changed after adding layers, e.g. to test digest verification.
+	Blobs map[string][]byte
+
+	token     string
+	artifacts map[string]map[string][]oci.Descriptor
+}
+
+// Option is an option for the fake registry.
+type Option func(*Registry)
+
+// WithBearerToken makes the fake registry require bearer token authentication using the given token.
+func WithBearerToken(token string) Option {
+	return func(r *Registry) {
+		r.token = token
+	}
+}
+
+// NewRegistry starts a fake OCI registry; the registry has to be closed by the caller.
+func NewRegistry(opts ...Option) *Registry {
+	r := &Registry{
+		Blobs:     map[string][]byte{},
+		artifacts: map[string]map[string][]oci.Descriptor{},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", r.serveToken)
+	mux.HandleFunc("/v2/", r.serveRepository)
+	r.Server = httptest.NewTLSServer(mux)
+	return r
+}
+
+// Host returns the host of the registry, to be used in OCI references.
+func (r *Registry) Host() string {
+	return strings.TrimPrefix(r.URL, "https://")
+}
+
+// AddLayer adds a blob with the given content to the registry, and returns a layer descriptor for it.
+func (r *Registry) AddLayer(name, mediaType string, content []byte) oci.Descriptor {
+	sum := sha256.Sum256(content)
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+	r.Blobs[digest] = content
+	return oci.Descriptor{
+		MediaType:   mediaType,
+		Digest:      digest,
+		Size:        int64(len(content)),
+		Annotations: map[string]string{oci.TitleAnnotation: name},
+	}
+}
+
+// AddArtifact adds an artifact with the given layers to the registry.
+func (r *Registry) AddArtifact(repository, tag string, layers ...oci.Descriptor) {
+	if r.artifacts[repository] == nil {
+		r.artifacts[repository] = map[string][]oci.Descriptor{}
+	}
+	r.artifacts[repository][tag] = layers
+}
+
+func (r *Registry) serveToken(w http.ResponseWriter, req *http.Request) {
+	if username, password, ok := req.BasicAuth(); !ok || username != Username || password != Password {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	repository := strings.TrimSuffix(strings.TrimPrefix(req.URL.Query().Get("scope"), "repository:"), ":pull")
+	if _, ok := r.artifacts[repository]; !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	fmt.Fprintf(w, `{"token": %q}`, r.token)
+}
+
+func (r *Registry) serveRepository(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	repository, resource, ok := cutResource(path)
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if !r.authorized(w, req, repository) {
+		return
+	}
+
+	tags, ok := r.artifacts[repository]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case resource == "tags/list":
+		r.serveTags(w, req, repository, tags)
+	case strings.HasPrefix(resource, "manifests/"):
+		layers, ok := tags[strings.TrimPrefix(resource, "manifests/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", oci.ManifestMediaType)
+		_ = json.NewEncoder(w).Encode(oci.Manifest{Layers: layers})
+	case strings.HasPrefix(resource, "blobs/"):
+		content, ok := r.Blobs[strings.TrimPrefix(resource, "blobs/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(content)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// serveTags returns one tag per page, to test pagination.
+func (r *Registry) serveTags(w http.ResponseWriter, req *http.Request, repository string, artifacts map[string][]oci.Descriptor) {
+	tags := make([]string, 0, len(artifacts))
+	for tag := range artifacts {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	i := 0
+	if last := req.URL.Query().Get("last"); last != "" {
+		for i < len(tags) && tags[i] != last {
+			i++
+		}
+		i++
+	}
+	if i >= len(tags) {
+		fmt.Fprintf(w, `{"name": %q, "tags": []}`, repository)
+		return
+	}
+	if i < len(tags)-1 {
+		w.Header().Set("Link", fmt.Sprintf(`</v2/%s/tags/list?n=1&last=%s>; rel="next"`, repository, tags[i]))
+	}
+	fmt.Fprintf(w, `{"name": %q, "tags": [%q]}`, repository, tags[i])
+}
+
+// authorized checks the request is authenticated, and otherwise responds with an authentication challenge.
+func (r *Registry) authorized(w http.ResponseWriter, req *http.Request, repository string) bool {
+	if r.token == "" {
+		if username, password, ok := req.BasicAuth(); ok && username == Username && password == Password {
+			return true
+		}
+		w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+		w.WriteHeader(http.StatusUnauthorized)
+		return false
+	}
+
+	if req.Header.Get("Authorization") == "Bearer "+r.token {
+		return true
+	}
+	w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:%s:pull"`, r.URL, repository))
+	w.WriteHeader(http.StatusUnauthorized)
+	return false
+}
+
+// cutResource splits a registry API path in the repository and the resource, e.g. manifests/<reference>.
+func cutResource(path string) (repository, resource string, found bool) {
+	if repository, found := strings.CutSuffix(path, "/tags/list"); found {
+		return repository, "tags/list", true
+	}
+	for _, sep := range []string{"/manifests/", "/blobs/"} {
+		if repository, reference, found := strings.Cut(path, sep); found {
+			return repository, strings.TrimPrefix(sep, "/") + reference, true
+		}
+	}
+	return "", "", false
+}
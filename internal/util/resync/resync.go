@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resync implements a source which periodically requeues all objects of a controller.
+package resync
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// Source returns a source which requeues all objects of the given list type every period.
+// Objects are listed from the given reader, which is usually the cache of the manager; if watchFilterValue is set,
+// only objects with the corresponding watch label are requeued, consistent with predicates.ResourceHasFilterLabel.
+// If period is 0, the source does nothing and objects are only resynced when the cache is resynced.
+func Source(c client.Reader, list client.ObjectList, period time.Duration, watchFilterValue string) source.Source {
+	return source.Func(func(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+		if period <= 0 {
+			return nil
+		}
+
+		var listOpts []client.ListOption
+		if watchFilterValue != "" {
+			listOpts = append(listOpts, client.MatchingLabels{clusterv1.WatchLabel: watchFilterValue})
+		}
+
+		go func() {
+			ticker := time.NewTicker(period)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					enqueueAll(ctx, c, list, queue, listOpts...)
+				}
+			}
+		}()
+		return nil
+	})
+}
+
+func enqueueAll(ctx context.Context, c client.Reader, list client.ObjectList, queue workqueue.TypedRateLimitingInterface[reconcile.Request], listOpts ...client.ListOption) {
+	log := ctrl.LoggerFrom(ctx)
+
+	l, ok := list.DeepCopyObject().(client.ObjectList)
+	if !ok {
+		return
+	}
+	if err := c.List(ctx, l, listOpts...); err != nil {
+		log.Error(err, "Failed to list objects to resync")
+		return
+	}
+	if err := meta.EachListItem(l, func(o runtime.Object) error {
+		obj, ok := o.(client.Object)
+		if !ok {
+			return nil
+		}
+		queue.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}})
+		return nil
+	}); err != nil {
+		log.Error(err, "Failed to resync objects")
+	}
+}
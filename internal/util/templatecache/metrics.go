@@ -0,0 +1,44 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatecache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func init() {
+	// Register the metrics at the controller-runtime metrics registry.
+	ctrlmetrics.Registry.MustRegister(hits)
+	ctrlmetrics.Registry.MustRegister(misses)
+}
+
+var (
+	hits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "capi_template_cache_hits_total",
+		Help: "Total number of ClusterClass and template reads served from the template cache.",
+	}, []string{
+		"kind",
+	})
+
+	misses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "capi_template_cache_misses_total",
+		Help: "Total number of ClusterClass and template reads not served from the template cache.",
+	}, []string{
+		"kind",
+	})
+)
@@ -0,0 +1,211 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package templatecache implements a cache for objects which are frequently read and rarely changed,
+// like ClusterClasses and templates, that can be shared across controllers.
+package templatecache
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// Cache is a client.Reader serving ClusterClasses and templates from an in-memory cache.
+// Entries are invalidated as soon as the generation or the status of the object changes, or the
+// object is deleted; changes are detected through event handlers added to the informers of the
+// manager cache, so no additional watches are created.
+// Reads of all other objects, and all List calls, are delegated to the underlying reader.
+type Cache struct {
+	reader    client.Reader
+	informers cache.Informers
+	scheme    *runtime.Scheme
+
+	lock    sync.Mutex
+	entries map[string]client.Object
+	// invalidations counts the invalidations of each key; it is used to avoid caching an object
+	// which has been invalidated while it was being read from the underlying reader.
+	invalidations map[string]uint64
+
+	// watched tracks the informers an event handler has already been added to.
+	watchLock sync.Mutex
+	watched   map[string]bool
+}
+
+var _ client.Reader = &Cache{}
+
+// New creates a new Cache.
+// reader is used to read objects on cache misses; informers must be the informers backing reader,
+// e.g. the manager cache when reader is the manager client.
+func New(reader client.Reader, informers cache.Informers, scheme *runtime.Scheme) *Cache {
+	return &Cache{
+		reader:        reader,
+		informers:     informers,
+		scheme:        scheme,
+		entries:       map[string]client.Object{},
+		invalidations: map[string]uint64{},
+		watched:       map[string]bool{},
+	}
+}
+
+// Get retrieves an object for the given key, from the cache if the object is a ClusterClass or a template.
+func (c *Cache) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get GroupVersionKind for object")
+	}
+
+	if !isCacheable(gvk, obj) {
+		return c.reader.Get(ctx, key, obj, opts...)
+	}
+
+	_, isUnstructured := obj.(*unstructured.Unstructured)
+	informerKey := fmt.Sprintf("%s/%t", gvk, isUnstructured)
+	entryKey := fmt.Sprintf("%s/%s", informerKey, key)
+
+	if err := c.watch(ctx, informerKey, obj); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	cached, ok := c.entries[entryKey]
+	invalidations := c.invalidations[entryKey]
+	c.lock.Unlock()
+
+	if ok {
+		hits.WithLabelValues(gvk.Kind).Inc()
+		reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(cached.DeepCopyObject()).Elem())
+		return nil
+	}
+
+	misses.WithLabelValues(gvk.Kind).Inc()
+	if err := c.reader.Get(ctx, key, obj, opts...); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	// Cache the object only if it has not been invalidated while it was being read.
+	if c.invalidations[entryKey] == invalidations {
+		c.entries[entryKey] = obj.DeepCopyObject().(client.Object)
+	}
+	return nil
+}
+
+// List delegates to the underlying reader.
+func (c *Cache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.reader.List(ctx, list, opts...)
+}
+
+// watch adds an event handler invalidating cache entries to the informer for the given object, if not already done.
+func (c *Cache) watch(ctx context.Context, informerKey string, obj client.Object) error {
+	c.watchLock.Lock()
+	defer c.watchLock.Unlock()
+
+	if c.watched[informerKey] {
+		return nil
+	}
+
+	informer, err := c.informers.GetInformer(ctx, obj)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get informer for %s", informerKey)
+	}
+	if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			o, ok1 := oldObj.(client.Object)
+			n, ok2 := newObj.(client.Object)
+			if ok1 && ok2 && !hasChanged(o, n) {
+				return
+			}
+			if ok2 {
+				c.invalidate(informerKey, n)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if o, ok := obj.(client.Object); ok {
+				c.invalidate(informerKey, o)
+			}
+		},
+	}); err != nil {
+		return errors.Wrapf(err, "failed to add event handler to informer for %s", informerKey)
+	}
+	c.watched[informerKey] = true
+	return nil
+}
+
+func (c *Cache) invalidate(informerKey string, obj client.Object) {
+	entryKey := fmt.Sprintf("%s/%s", informerKey, client.ObjectKeyFromObject(obj))
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.entries, entryKey)
+	c.invalidations[entryKey]++
+}
+
+// isCacheable returns true for ClusterClasses and for templates read as Unstructured, i.e. objects whose kind ends with the Template suffix.
+func isCacheable(gvk schema.GroupVersionKind, obj client.Object) bool {
+	switch obj.(type) {
+	case *clusterv1.ClusterClass:
+		return true
+	case *unstructured.Unstructured:
+		return (gvk.Group == clusterv1.GroupVersion.Group && gvk.Kind == "ClusterClass") || strings.HasSuffix(gvk.Kind, clusterv1.TemplateSuffix)
+	default:
+		return false
+	}
+}
+
+// hasChanged returns true if the generation or the status of an object changed.
+func hasChanged(oldObj, newObj client.Object) bool {
+	if oldObj.GetGeneration() != newObj.GetGeneration() || oldObj.GetDeletionTimestamp().IsZero() != newObj.GetDeletionTimestamp().IsZero() {
+		return true
+	}
+	oldStatus, err1 := status(oldObj)
+	newStatus, err2 := status(newObj)
+	if err1 != nil || err2 != nil {
+		return true
+	}
+	return !equality.Semantic.DeepEqual(oldStatus, newStatus)
+}
+
+func status(obj client.Object) (interface{}, error) {
+	if cc, ok := obj.(*clusterv1.ClusterClass); ok {
+		return cc.Status, nil
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("unexpected object type %T", obj)
+	}
+	s, _, err := unstructured.NestedFieldNoCopy(u.Object, "status")
+	return s, err
+}
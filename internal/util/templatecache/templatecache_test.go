@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatecache
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestCache_ClusterClass(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+	clusterClass := &clusterv1.ClusterClass{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "class1", Generation: 1},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(clusterClass).Build()
+	informers := &informertest.FakeInformers{Scheme: scheme}
+	templateCache := New(c, informers, scheme)
+
+	get := func() *clusterv1.ClusterClass {
+		got := &clusterv1.ClusterClass{}
+		g.Expect(templateCache.Get(t.Context(), client.ObjectKeyFromObject(clusterClass), got)).To(Succeed())
+		return got
+	}
+	update := func(mutate func(cc *clusterv1.ClusterClass)) (oldObj, newObj *clusterv1.ClusterClass) {
+		oldObj = &clusterv1.ClusterClass{}
+		g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(clusterClass), oldObj)).To(Succeed())
+		newObj = oldObj.DeepCopy()
+		mutate(newObj)
+		g.Expect(c.Update(t.Context(), newObj)).To(Succeed())
+		return oldObj, newObj
+	}
+
+	g.Expect(get().Labels).To(BeEmpty())
+
+	informer, err := informers.FakeInformerFor(t.Context(), clusterClass)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// A change which doesn't bump the generation or change the status is not picked up.
+	oldObj, newObj := update(func(cc *clusterv1.ClusterClass) { cc.Labels = map[string]string{"foo": "bar"} })
+	informer.Update(oldObj, newObj)
+	g.Expect(get().Labels).To(BeEmpty())
+
+	// A generation change invalidates the entry.
+	oldObj, newObj = update(func(cc *clusterv1.ClusterClass) { cc.Generation = 2 })
+	informer.Update(oldObj, newObj)
+	got := get()
+	g.Expect(got.Generation).To(Equal(int64(2)))
+	g.Expect(got.Labels).To(HaveKeyWithValue("foo", "bar"))
+
+	// A status change invalidates the entry.
+	oldObj, newObj = update(func(cc *clusterv1.ClusterClass) { cc.Status.ObservedGeneration = 2 })
+	informer.Update(oldObj, newObj)
+	g.Expect(get().Status.ObservedGeneration).To(Equal(int64(2)))
+
+	// Objects returned by the cache can be mutated without affecting the cache.
+	got = get()
+	got.Status.ObservedGeneration = 3
+	g.Expect(get().Status.ObservedGeneration).To(Equal(int64(2)))
+
+	// Deletion invalidates the entry.
+	g.Expect(c.Delete(t.Context(), newObj)).To(Succeed())
+	informer.Delete(newObj)
+	g.Expect(templateCache.Get(t.Context(), client.ObjectKeyFromObject(clusterClass), &clusterv1.ClusterClass{})).ToNot(Succeed())
+}
+
+func TestCache_Unstructured(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+
+	newObject := func(kind string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion("infrastructure.cluster.x-k8s.io/v1beta2")
+		u.SetKind(kind)
+		u.SetNamespace(metav1.NamespaceDefault)
+		u.SetName("obj1")
+		u.SetGeneration(1)
+		return u
+	}
+	template := newObject("GenericInfrastructureMachineTemplate")
+	machine := newObject("GenericInfrastructureMachine")
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(template, machine).Build()
+	informers := &informertest.FakeInformers{Scheme: scheme}
+	templateCache := New(c, informers, scheme)
+
+	get := func(obj *unstructured.Unstructured) *unstructured.Unstructured {
+		got := &unstructured.Unstructured{}
+		got.SetGroupVersionKind(obj.GroupVersionKind())
+		g.Expect(templateCache.Get(t.Context(), client.ObjectKeyFromObject(obj), got)).To(Succeed())
+		return got
+	}
+
+	g.Expect(get(template).GetLabels()).To(BeEmpty())
+	g.Expect(get(machine).GetLabels()).To(BeEmpty())
+
+	for _, obj := range []*unstructured.Unstructured{template, machine} {
+		current := get(obj)
+		current.SetLabels(map[string]string{"foo": "bar"})
+		g.Expect(c.Update(t.Context(), current)).To(Succeed())
+	}
+
+	// Templates are served from the cache, while other objects are always read from the underlying reader.
+	g.Expect(get(template).GetLabels()).To(BeEmpty())
+	g.Expect(get(machine).GetLabels()).To(HaveKeyWithValue("foo", "bar"))
+
+	// A generation change invalidates the entry.
+	informer, err := informers.FakeInformerFor(t.Context(), template)
+	g.Expect(err).ToNot(HaveOccurred())
+	newTemplate := template.DeepCopy()
+	newTemplate.SetGeneration(2)
+	informer.Update(template, newTemplate)
+	g.Expect(get(template).GetLabels()).To(HaveKeyWithValue("foo", "bar"))
+}
@@ -20,19 +20,23 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -93,6 +97,23 @@ func (webhook *Cluster) Default(ctx context.Context, obj runtime.Object) error {
 		return apierrors.NewBadRequest(fmt.Sprintf("expected a Cluster but got a %T", obj))
 	}
 
+	// If no request found in context, then this has not come via a webhook request, so skip defaulting and
+	// validation which depend on the old cluster.
+	var oldCluster *clusterv1.Cluster
+	req, err := admission.RequestFromContext(ctx)
+	if err == nil && len(req.OldObject.Raw) > 0 {
+		oldCluster = &clusterv1.Cluster{}
+		if err := webhook.decoder.DecodeRaw(req.OldObject, oldCluster); err != nil {
+			return apierrors.NewBadRequest(errors.Wrap(err, "failed to decode old cluster object").Error())
+		}
+	}
+
+	// Clear the pause information when the Cluster is unpaused, so spec.pause.expireAfter is only honoured
+	// for the pause it was set together with, and not for a later pause, e.g. the one set by clusterctl move.
+	if oldCluster != nil && ptr.Deref(oldCluster.Spec.Paused, false) && !ptr.Deref(cluster.Spec.Paused, false) {
+		cluster.Spec.Pause = clusterv1.ClusterPauseSpec{}
+	}
+
 	// Additional defaulting if the Cluster uses a managed topology.
 	if cluster.Spec.Topology.IsDefined() {
 		// Tolerate version strings without a "v" prefix: prepend it if it's not there.
@@ -122,17 +143,6 @@ func (webhook *Cluster) Default(ctx context.Context, obj runtime.Object) error {
 		}
 
 		// Validate cluster class variables transitions that may be enforced by CEL validation rules on variables.
-		// If no request found in context, then this has not come via a webhook request, so skip validation of old cluster.
-		var oldCluster *clusterv1.Cluster
-		req, err := admission.RequestFromContext(ctx)
-
-		if err == nil && len(req.OldObject.Raw) > 0 {
-			oldCluster = &clusterv1.Cluster{}
-			if err := webhook.decoder.DecodeRaw(req.OldObject, oldCluster); err != nil {
-				return apierrors.NewBadRequest(errors.Wrap(err, "failed to decode old cluster object").Error())
-			}
-		}
-
 		// Doing both defaulting and validating here prevents a race condition where the ClusterClass could be
 		// different in the defaulting and validating webhook.
 		allErrs = append(allErrs, DefaultAndValidateVariables(ctx, cluster, oldCluster, clusterClass)...)
@@ -143,6 +153,39 @@ func (webhook *Cluster) Default(ctx context.Context, obj runtime.Object) error {
 	return nil
 }
 
+// validatePause validates spec.pause; only changes to spec.pause are validated, so Clusters with a pause set before
+// this validation was introduced can still be updated, e.g. to unpause them.
+func validatePause(oldCluster, newCluster *clusterv1.Cluster, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	newPause := newCluster.Spec.Pause
+	if oldCluster != nil && reflect.DeepEqual(oldCluster.Spec.Pause, newPause) {
+		return nil
+	}
+
+	if newPause != (clusterv1.ClusterPauseSpec{}) && !ptr.Deref(newCluster.Spec.Paused, false) {
+		allErrs = append(allErrs,
+			field.Forbidden(
+				fldPath,
+				"can only be set if spec.paused is true",
+			),
+		)
+	}
+
+	if !newPause.ExpireAfter.IsZero() && newPause.ExpireAfter.Before(&metav1.Time{Time: time.Now()}) &&
+		(oldCluster == nil || !oldCluster.Spec.Pause.ExpireAfter.Equal(&newPause.ExpireAfter)) {
+		allErrs = append(allErrs,
+			field.Invalid(
+				fldPath.Child("expireAfter"),
+				newPause.ExpireAfter,
+				"must be in the future",
+			),
+		)
+	}
+
+	return allErrs
+}
+
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
 func (webhook *Cluster) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	cluster, ok := obj.(*clusterv1.Cluster)
@@ -198,6 +241,8 @@ func (webhook *Cluster) validate(ctx context.Context, oldCluster, newCluster *cl
 		)
 	}
 
+	allErrs = append(allErrs, validatePause(oldCluster, newCluster, specPath.Child("pause"))...)
+
 	if !newCluster.Spec.ControlPlaneRef.IsDefined() && !newCluster.Spec.InfrastructureRef.IsDefined() &&
 		!newCluster.Spec.Topology.IsDefined() {
 		allErrs = append(
@@ -225,6 +270,13 @@ func (webhook *Cluster) validate(ctx context.Context, oldCluster, newCluster *cl
 	allErrs = append(allErrs, validateCIDRBlocks(specPath.Child("clusterNetwork", "services", "cidrBlocks"),
 		newCluster.Spec.ClusterNetwork.Services.CIDRBlocks)...)
 
+	// Validate the identity reference, if defined.
+	if newCluster.Spec.IdentityRef.IsDefined() {
+		identityRefWarnings, identityRefErrs := webhook.validateIdentityRef(ctx, oldCluster, newCluster, specPath.Child("identityRef"))
+		allWarnings = append(allWarnings, identityRefWarnings...)
+		allErrs = append(allErrs, identityRefErrs...)
+	}
+
 	topologyPath := specPath.Child("topology")
 
 	// Validate the managed topology, if defined.
@@ -251,6 +303,55 @@ func (webhook *Cluster) validate(ctx context.Context, oldCluster, newCluster *cl
 	return allWarnings, nil
 }
 
+// validateIdentityRef validates the identity reference of a Cluster.
+// NOTE: A missing identity only surfaces a warning, so it is possible to create the Cluster before its credentials, e.g. when using GitOps.
+func (webhook *Cluster) validateIdentityRef(ctx context.Context, oldCluster, newCluster *clusterv1.Cluster, fldPath *field.Path) (admission.Warnings, field.ErrorList) {
+	identityRef := newCluster.Spec.IdentityRef
+
+	if identityRef.APIGroup == "" && identityRef.Kind != "Secret" {
+		return nil, field.ErrorList{
+			field.Invalid(fldPath.Child("kind"), identityRef.Kind, "must be Secret when apiGroup is not set"),
+		}
+	}
+
+	// Check the identity exists only when the reference is set or changed, to avoid reading it at every update of the Cluster.
+	if oldCluster != nil && oldCluster.Spec.IdentityRef == identityRef {
+		return nil, nil
+	}
+
+	var err error
+	if identityRef.IsSecret() {
+		// Note: Only the metadata of the Secret are read, the webhook must never read the credentials.
+		secret := &metav1.PartialObjectMetadata{}
+		secret.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+		err = webhook.Client.Get(ctx, client.ObjectKey{Namespace: newCluster.Namespace, Name: identityRef.Name}, secret)
+	} else {
+		_, err = external.GetObjectFromContractVersionedRef(ctx, webhook.Client, clusterv1.ContractVersionedObjectReference{
+			APIGroup: identityRef.APIGroup,
+			Kind:     identityRef.Kind,
+			Name:     identityRef.Name,
+		}, newCluster.Namespace)
+	}
+
+	identity := fmt.Sprintf("%s %s", identityRef.Kind, identityRef.Name)
+	if identityRef.APIGroup != "" {
+		identity = fmt.Sprintf("%s.%s %s", identityRef.Kind, identityRef.APIGroup, identityRef.Name)
+	}
+	switch {
+	case err == nil:
+		return nil, nil
+	case apierrors.IsNotFound(errors.Cause(err)):
+		return admission.Warnings{
+			fmt.Sprintf("Cluster refers to identity %s, but this identity does not exist in namespace %s. "+
+				"The identity must be created for infrastructure providers to use it", identity, newCluster.Namespace),
+		}, nil
+	default:
+		return admission.Warnings{
+			fmt.Sprintf("Cluster refers to identity %s, but this identity could not be retrieved: %s", identity, err.Error()),
+		}, nil
+	}
+}
+
 func (webhook *Cluster) validateTopology(ctx context.Context, oldCluster, newCluster *clusterv1.Cluster, fldPath *field.Path) (admission.Warnings, field.ErrorList) {
 	var allWarnings admission.Warnings
 
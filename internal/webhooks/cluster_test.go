@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/blang/semver/v4"
 	. "github.com/onsi/gomega"
@@ -1671,6 +1672,242 @@ func TestClusterValidation(t *testing.T) {
 	}
 }
 
+func TestClusterIdentityRefValidation(t *testing.T) {
+	withIdentityRef := func(identityRef clusterv1.ClusterIdentityReference) *clusterv1.Cluster {
+		c := builder.Cluster("fooNamespace", "cluster1").
+			WithControlPlane(builder.ControlPlane("fooNamespace", "cp1").Build()).
+			Build()
+		c.Spec.IdentityRef = identityRef
+		return c
+	}
+	secretIdentityRef := clusterv1.ClusterIdentityReference{Kind: "Secret", Name: "credentials"}
+	providerIdentityRef := clusterv1.ClusterIdentityReference{
+		APIGroup: builder.InfrastructureGroupVersion.Group,
+		Kind:     builder.GenericInfrastructureClusterKind,
+		Name:     "identity",
+	}
+
+	tests := []struct {
+		name              string
+		in                *clusterv1.Cluster
+		old               *clusterv1.Cluster
+		additionalObjects []client.Object
+		expectErr         bool
+		expectErrStr      string
+		expectWarning     string
+	}{
+		{
+			name:         "fails if apiGroup is not set and kind is not Secret",
+			in:           withIdentityRef(clusterv1.ClusterIdentityReference{Kind: "ConfigMap", Name: "credentials"}),
+			expectErr:    true,
+			expectErrStr: "spec.identityRef.kind: Invalid value: \"ConfigMap\": must be Secret when apiGroup is not set",
+		},
+		{
+			name: "succeeds if the referenced Secret exists",
+			in:   withIdentityRef(secretIdentityRef),
+			additionalObjects: []client.Object{
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "fooNamespace", Name: "credentials"}},
+			},
+		},
+		{
+			name:          "warns if the referenced Secret does not exist",
+			in:            withIdentityRef(secretIdentityRef),
+			expectWarning: "Cluster refers to identity Secret credentials, but this identity does not exist in namespace fooNamespace",
+		},
+		{
+			name:          "warns if the referenced Secret exists in another namespace",
+			in:            withIdentityRef(secretIdentityRef),
+			expectWarning: "Cluster refers to identity Secret credentials, but this identity does not exist in namespace fooNamespace",
+			additionalObjects: []client.Object{
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "barNamespace", Name: "credentials"}},
+			},
+		},
+		{
+			name: "does not look up the identity if identityRef is not changed",
+			in:   withIdentityRef(secretIdentityRef),
+			old:  withIdentityRef(secretIdentityRef),
+		},
+		{
+			name: "succeeds if the referenced provider identity exists",
+			in:   withIdentityRef(providerIdentityRef),
+			additionalObjects: []client.Object{
+				builder.InfrastructureCluster("fooNamespace", "identity").Build(),
+				// Note: CRD is needed to look up the apiVersion from contract labels.
+				builder.GenericInfrastructureClusterCRD,
+			},
+		},
+		{
+			name:          "warns if the referenced provider identity does not exist",
+			in:            withIdentityRef(providerIdentityRef),
+			expectWarning: "Cluster refers to identity GenericInfrastructureCluster.infrastructure.cluster.x-k8s.io identity, but this identity does not exist in namespace fooNamespace",
+			additionalObjects: []client.Object{
+				builder.GenericInfrastructureClusterCRD,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fakeClient := fake.NewClientBuilder().
+				WithObjects(tt.additionalObjects...).
+				WithScheme(fakeScheme).
+				Build()
+
+			// Create the webhook.
+			webhook := &Cluster{Client: fakeClient}
+
+			warnings, err := webhook.validate(ctx, tt.old, tt.in)
+			if tt.expectWarning != "" {
+				g.Expect(warnings).To(ConsistOf(ContainSubstring(tt.expectWarning)))
+			} else {
+				g.Expect(warnings).To(BeEmpty())
+			}
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tt.expectErrStr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestClusterPauseValidation(t *testing.T) {
+	withPause := func(paused bool, pause clusterv1.ClusterPauseSpec) *clusterv1.Cluster {
+		c := builder.Cluster("fooNamespace", "cluster1").
+			WithControlPlane(builder.ControlPlane("fooNamespace", "cp1").Build()).
+			Build()
+		c.Spec.Paused = ptr.To(paused)
+		c.Spec.Pause = pause
+		return c
+	}
+	past := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	future := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+
+	tests := []struct {
+		name         string
+		in           *clusterv1.Cluster
+		old          *clusterv1.Cluster
+		expectErr    bool
+		expectErrStr string
+	}{
+		{
+			name: "succeeds if pause is set together with paused",
+			in:   withPause(true, clusterv1.ClusterPauseSpec{Reason: "Maintenance", ExpireAfter: future}),
+		},
+		{
+			name:         "fails if pause is set without paused",
+			in:           withPause(false, clusterv1.ClusterPauseSpec{Reason: "Maintenance"}),
+			expectErr:    true,
+			expectErrStr: "spec.pause: Forbidden: can only be set if spec.paused is true",
+		},
+		{
+			name:         "fails if expireAfter is in the past",
+			in:           withPause(true, clusterv1.ClusterPauseSpec{ExpireAfter: past}),
+			expectErr:    true,
+			expectErrStr: "spec.pause.expireAfter: Invalid value",
+		},
+		{
+			name:         "fails if expireAfter is changed to a time in the past",
+			in:           withPause(true, clusterv1.ClusterPauseSpec{ExpireAfter: past}),
+			old:          withPause(true, clusterv1.ClusterPauseSpec{ExpireAfter: future}),
+			expectErr:    true,
+			expectErrStr: "spec.pause.expireAfter: Invalid value",
+		},
+		{
+			name: "succeeds if an expired pause is not changed",
+			in:   withPause(true, clusterv1.ClusterPauseSpec{ExpireAfter: past}),
+			old:  withPause(true, clusterv1.ClusterPauseSpec{ExpireAfter: past}),
+		},
+		{
+			name: "succeeds if the reason of an expired pause is changed",
+			in:   withPause(true, clusterv1.ClusterPauseSpec{Reason: "Maintenance", ExpireAfter: past}),
+			old:  withPause(true, clusterv1.ClusterPauseSpec{ExpireAfter: past}),
+		},
+		{
+			name: "succeeds if a Cluster with a stale pause is updated without changing the pause",
+			in:   withPause(false, clusterv1.ClusterPauseSpec{Reason: "Maintenance"}),
+			old:  withPause(true, clusterv1.ClusterPauseSpec{Reason: "Maintenance"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			webhook := &Cluster{Client: fake.NewClientBuilder().WithScheme(fakeScheme).Build()}
+
+			_, err := webhook.validate(ctx, tt.old, tt.in)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tt.expectErrStr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestClusterDefaultPause(t *testing.T) {
+	withPause := func(paused bool, pause clusterv1.ClusterPauseSpec) *clusterv1.Cluster {
+		c := builder.Cluster(metav1.NamespaceDefault, "cluster1").Build()
+		c.Spec.Paused = ptr.To(paused)
+		c.Spec.Pause = pause
+		return c
+	}
+	pause := clusterv1.ClusterPauseSpec{Reason: "Maintenance", ExpireAfter: metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))}
+
+	tests := []struct {
+		name      string
+		in        *clusterv1.Cluster
+		old       *clusterv1.Cluster
+		wantPause clusterv1.ClusterPauseSpec
+	}{
+		{
+			name:      "keeps pause on create",
+			in:        withPause(true, pause),
+			wantPause: pause,
+		},
+		{
+			name:      "keeps pause while the Cluster stays paused",
+			in:        withPause(true, pause),
+			old:       withPause(true, pause),
+			wantPause: pause,
+		},
+		{
+			name:      "clears pause when the Cluster is unpaused",
+			in:        withPause(false, pause),
+			old:       withPause(true, pause),
+			wantPause: clusterv1.ClusterPauseSpec{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			webhook := &Cluster{decoder: admission.NewDecoder(fakeScheme)}
+
+			webhookCtx := ctx
+			if tt.old != nil {
+				jsonObj, err := json.Marshal(tt.old)
+				g.Expect(err).ToNot(HaveOccurred())
+				webhookCtx = admission.NewContextWithRequest(ctx, admission.Request{
+					AdmissionRequest: admissionv1.AdmissionRequest{
+						Operation: admissionv1.Update,
+						OldObject: runtime.RawExtension{
+							Raw:    jsonObj,
+							Object: tt.old,
+						},
+					},
+				})
+			}
+
+			g.Expect(webhook.Default(webhookCtx, tt.in)).To(Succeed())
+			g.Expect(tt.in.Spec.Pause).To(BeComparableTo(tt.wantPause))
+		})
+	}
+}
+
 func TestClusterTopologyValidation(t *testing.T) {
 	// NOTE: ClusterTopology feature flag is disabled by default, thus preventing to set Cluster.Topologies.
 	// Enabling the feature flag temporarily for this test.
@@ -472,12 +472,41 @@ func validateNamingStrategies(clusterClass *clusterv1.ClusterClass) field.ErrorL
 		}
 	}
 
+	if clusterClass.Spec.ControlPlane.Naming.MachineInfrastructureTemplate != "" {
+		allErrs = append(allErrs, validateTemplateNamingStrategy(
+			clusterClass.Spec.ControlPlane.Naming.MachineInfrastructureTemplate,
+			topologynames.ControlPlaneInfrastructureMachineTemplateNameGenerator(clusterClass.Spec.ControlPlane.Naming.MachineInfrastructureTemplate, "cluster"),
+			field.NewPath("spec", "controlPlane", "naming", "machineInfrastructureTemplate"),
+			"InfrastructureMachineTemplate",
+		)...)
+	}
+
 	for _, md := range clusterClass.Spec.Workers.MachineDeployments {
+		mdNamingFldPath := field.NewPath("spec", "workers", "machineDeployments").Key(md.Class).Child("naming")
+
+		if md.Naming.InfrastructureTemplate != "" {
+			allErrs = append(allErrs, validateTemplateNamingStrategy(
+				md.Naming.InfrastructureTemplate,
+				topologynames.InfrastructureMachineTemplateNameGenerator(md.Naming.InfrastructureTemplate, "cluster", "mdtopology"),
+				mdNamingFldPath.Child("infrastructureTemplate"),
+				"InfrastructureMachineTemplate",
+			)...)
+		}
+
+		if md.Naming.BootstrapTemplate != "" {
+			allErrs = append(allErrs, validateTemplateNamingStrategy(
+				md.Naming.BootstrapTemplate,
+				topologynames.BootstrapTemplateNameGenerator(md.Naming.BootstrapTemplate, "cluster", "mdtopology"),
+				mdNamingFldPath.Child("bootstrapTemplate"),
+				"BootstrapTemplate",
+			)...)
+		}
+
 		if md.Naming.Template == "" {
 			continue
 		}
 		name, err := topologynames.MachineDeploymentNameGenerator(md.Naming.Template, "cluster", "mdtopology").GenerateName()
-		templateFldPath := field.NewPath("spec", "workers", "machineDeployments").Key(md.Class).Child("naming", "template")
+		templateFldPath := mdNamingFldPath.Child("template")
 		if err != nil {
 			allErrs = append(allErrs,
 				field.Invalid(
@@ -515,6 +544,37 @@ func validateNamingStrategies(clusterClass *clusterv1.ClusterClass) field.ErrorL
 	return allErrs
 }
 
+// validateTemplateNamingStrategy validates the naming strategy of a template generated from a ClusterClass.
+// NOTE: Generated templates are rotated, and each rotation creates a new template; thus the name template must
+// contain {{ .random }} to ensure that a new name is generated.
+func validateTemplateNamingStrategy(template string, nameGenerator topologynames.NameGenerator, fldPath *field.Path, kind string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !strings.Contains(template, "{{ .random }}") {
+		allErrs = append(allErrs,
+			field.Invalid(
+				fldPath,
+				template,
+				"invalid template, {{ .random }} is missing",
+			))
+	}
+
+	name, err := nameGenerator.GenerateName()
+	if err != nil {
+		allErrs = append(allErrs,
+			field.Invalid(
+				fldPath,
+				template,
+				fmt.Sprintf("invalid %s name template: %v", kind, err),
+			))
+	} else {
+		for _, err := range validation.IsDNS1123Subdomain(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath, template, err))
+		}
+	}
+	return allErrs
+}
+
 func validateClusterClassMetadata(clusterClass *clusterv1.ClusterClass) field.ErrorList {
 	var allErrs field.ErrorList
 	allErrs = append(allErrs, clusterClass.Spec.ControlPlane.Metadata.Validate(field.NewPath("spec", "controlPlane", "metadata"))...)
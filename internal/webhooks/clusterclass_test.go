@@ -48,6 +48,7 @@ var (
 func init() {
 	_ = apiextensionsv1.AddToScheme(fakeScheme)
 	_ = clusterv1.AddToScheme(fakeScheme)
+	_ = corev1.AddToScheme(fakeScheme)
 }
 
 func TestClusterClassValidationFeatureGated(t *testing.T) {
@@ -1469,7 +1470,10 @@ func TestClusterClassValidation(t *testing.T) {
 				WithControlPlaneTemplate(
 					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
 						Build()).
-				WithControlPlaneNaming(&clusterv1.ControlPlaneClassNamingSpec{Template: "{{ .cluster.name }}-cp-{{ .random }}"}).
+				WithControlPlaneNaming(&clusterv1.ControlPlaneClassNamingSpec{
+					Template:                      "{{ .cluster.name }}-cp-{{ .random }}",
+					MachineInfrastructureTemplate: "{{ .cluster.name }}-cp-infra-{{ .random }}",
+				}).
 				WithControlPlaneInfrastructureMachineTemplate(
 					builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "cp-infra1").
 						Build()).
@@ -1479,7 +1483,11 @@ func TestClusterClassValidation(t *testing.T) {
 							builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "infra1").Build()).
 						WithBootstrapTemplate(
 							builder.BootstrapTemplate(metav1.NamespaceDefault, "bootstrap1").Build()).
-						WithNaming(&clusterv1.MachineDeploymentClassNamingSpec{Template: "{{ .cluster.name }}-md-{{ .machineDeployment.topologyName }}-{{ .random }}"}).
+						WithNaming(&clusterv1.MachineDeploymentClassNamingSpec{
+							Template:               "{{ .cluster.name }}-md-{{ .machineDeployment.topologyName }}-{{ .random }}",
+							InfrastructureTemplate: "{{ .cluster.name }}-{{ .machineDeployment.topologyName }}-infra-{{ .random }}",
+							BootstrapTemplate:      "{{ .cluster.name }}-{{ .machineDeployment.topologyName }}-bootstrap-{{ .random }}",
+						}).
 						Build()).
 				WithWorkerMachinePoolClasses(
 					*builder.MachinePoolClass("bb").
@@ -1596,6 +1604,87 @@ func TestClusterClassValidation(t *testing.T) {
 				Build(),
 			expectErr: true,
 		},
+		{
+			name: "should return error for ControlPlane naming.machineInfrastructureTemplate without {{ .random }}",
+			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithControlPlaneNaming(&clusterv1.ControlPlaneClassNamingSpec{MachineInfrastructureTemplate: "{{ .cluster.name }}-cp-infra"}).
+				WithControlPlaneInfrastructureMachineTemplate(
+					builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "cp-infra1").
+						Build()).
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "should return error for invalid MachineDeployment naming.infrastructureTemplate",
+			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithControlPlaneInfrastructureMachineTemplate(
+					builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "cp-infra1").
+						Build()).
+				WithWorkerMachineDeploymentClasses(
+					*builder.MachineDeploymentClass("aa").
+						WithInfrastructureTemplate(
+							builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "infra1").Build()).
+						WithBootstrapTemplate(
+							builder.BootstrapTemplate(metav1.NamespaceDefault, "bootstrap1").Build()).
+						WithNaming(&clusterv1.MachineDeploymentClassNamingSpec{InfrastructureTemplate: "template-md-infra-{{ .invalidkey }}-{{ .random }}"}).
+						Build()).
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "should return error for MachineDeployment naming.infrastructureTemplate without {{ .random }}",
+			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithControlPlaneInfrastructureMachineTemplate(
+					builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "cp-infra1").
+						Build()).
+				WithWorkerMachineDeploymentClasses(
+					*builder.MachineDeploymentClass("aa").
+						WithInfrastructureTemplate(
+							builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "infra1").Build()).
+						WithBootstrapTemplate(
+							builder.BootstrapTemplate(metav1.NamespaceDefault, "bootstrap1").Build()).
+						WithNaming(&clusterv1.MachineDeploymentClassNamingSpec{InfrastructureTemplate: "{{ .cluster.name }}-{{ .machineDeployment.topologyName }}"}).
+						Build()).
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "should return error for MachineDeployment naming.bootstrapTemplate when the generated name does not conform to RFC 1123",
+			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithControlPlaneInfrastructureMachineTemplate(
+					builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "cp-infra1").
+						Build()).
+				WithWorkerMachineDeploymentClasses(
+					*builder.MachineDeploymentClass("aa").
+						WithInfrastructureTemplate(
+							builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "infra1").Build()).
+						WithBootstrapTemplate(
+							builder.BootstrapTemplate(metav1.NamespaceDefault, "bootstrap1").Build()).
+						WithNaming(&clusterv1.MachineDeploymentClassNamingSpec{BootstrapTemplate: "template-md-bootstrap-{{ .random }}-"}).
+						Build()).
+				Build(),
+			expectErr: true,
+		},
 		{
 			name: "should return error for invalid MachinePool naming.template",
 			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
@@ -19,17 +19,21 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"reflect"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/util/oci"
 )
 
 // ClusterResourceSet implements a validation and defaulting webhook for ClusterResourceSet.
@@ -123,9 +127,59 @@ func (webhook *ClusterResourceSet) validate(oldCRS, newCRS *addonsv1.ClusterReso
 		)
 	}
 
+	allErrs = append(allErrs, validateClusterResourceSetSources(newCRS)...)
+
 	if len(allErrs) == 0 {
 		return nil
 	}
 
 	return apierrors.NewInvalid(addonsv1.GroupVersion.WithKind("ClusterResourceSet").GroupKind(), newCRS.Name, allErrs)
 }
+
+// validateClusterResourceSetSources validates sources and resources referencing them.
+func validateClusterResourceSetSources(crs *addonsv1.ClusterResourceSet) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, source := range crs.Spec.Sources {
+		sourcePath := field.NewPath("spec", "sources").Index(i)
+		switch {
+		case source.OCIArtifact != nil && source.HelmChart != nil, source.OCIArtifact == nil && source.HelmChart == nil:
+			allErrs = append(allErrs, field.Invalid(sourcePath, source.Name, "exactly one of ociArtifact or helmChart must be set"))
+		case source.OCIArtifact != nil:
+			if _, _, _, err := oci.ParseReference(source.OCIArtifact.URL); err != nil {
+				allErrs = append(allErrs, field.Invalid(sourcePath.Child("ociArtifact", "url"), source.OCIArtifact.URL, err.Error()))
+			}
+		case source.HelmChart != nil:
+			if strings.HasPrefix(source.HelmChart.URL, oci.Scheme+"://") {
+				if _, _, _, err := oci.ParseReference(source.HelmChart.URL); err != nil {
+					allErrs = append(allErrs, field.Invalid(sourcePath.Child("helmChart", "url"), source.HelmChart.URL, err.Error()))
+				}
+			} else if u, err := url.Parse(source.HelmChart.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+				allErrs = append(allErrs, field.Invalid(sourcePath.Child("helmChart", "url"), source.HelmChart.URL, "must be an oci:// reference or an https:// URL"))
+			}
+			if errs := validation.IsDNS1123Label(source.HelmChart.ReleaseName); len(errs) > 0 {
+				allErrs = append(allErrs, field.Invalid(sourcePath.Child("helmChart", "releaseName"), source.HelmChart.ReleaseName, strings.Join(errs, "; ")))
+			}
+		}
+	}
+
+	for i, resource := range crs.Spec.Resources {
+		var wantSource func(*addonsv1.ClusterResourceSetSource) bool
+		switch addonsv1.ClusterResourceSetResourceKind(resource.Kind) {
+		case addonsv1.OCIArtifactClusterResourceSetResourceKind:
+			wantSource = func(s *addonsv1.ClusterResourceSetSource) bool { return s.OCIArtifact != nil }
+		case addonsv1.HelmChartClusterResourceSetResourceKind:
+			wantSource = func(s *addonsv1.ClusterResourceSetSource) bool { return s.HelmChart != nil }
+		default:
+			continue
+		}
+
+		source := crs.Spec.GetSource(resource.Name)
+		if source == nil || !wantSource(source) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "resources").Index(i).Child("name"), resource.Name,
+				fmt.Sprintf("must refer to a source of kind %s defined in spec.sources", resource.Kind)))
+		}
+	}
+
+	return allErrs
+}
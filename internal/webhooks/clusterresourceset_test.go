@@ -210,3 +210,110 @@ func TestClusterResourceSetSelectorNotEmptyValidation(t *testing.T) {
 	g.Expect(err).To(HaveOccurred())
 	g.Expect(err.Error()).To(ContainSubstring("selector must not be empty"))
 }
+
+func TestClusterResourceSetSourcesValidation(t *testing.T) {
+	ociArtifact := addonsv1.ClusterResourceSetSource{
+		Name:        "cni",
+		OCIArtifact: &addonsv1.OCIArtifactSource{URL: "oci://registry.example.com/addons/cni:v1.0.0"},
+	}
+	helmChart := addonsv1.ClusterResourceSetSource{
+		Name: "csi",
+		HelmChart: &addonsv1.HelmChartSource{
+			URL:         "oci://registry.example.com/charts/csi:1.0.0",
+			ReleaseName: "csi",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		resources []addonsv1.ResourceRef
+		sources   []addonsv1.ClusterResourceSetSource
+		expectErr bool
+	}{
+		{
+			name:      "should not return error for valid sources",
+			resources: []addonsv1.ResourceRef{{Kind: "OCIArtifact", Name: "cni"}, {Kind: "HelmChart", Name: "csi"}, {Kind: "ConfigMap", Name: "cm"}},
+			sources:   []addonsv1.ClusterResourceSetSource{ociArtifact, helmChart},
+		},
+		{
+			name:      "should not return error for Helm chart served over https",
+			resources: []addonsv1.ResourceRef{{Kind: "HelmChart", Name: "csi"}},
+			sources: []addonsv1.ClusterResourceSetSource{{
+				Name:      "csi",
+				HelmChart: &addonsv1.HelmChartSource{URL: "https://charts.example.com/csi-1.0.0.tgz", ReleaseName: "csi"},
+			}},
+		},
+		{
+			name:      "should return error for a resource referencing a missing source",
+			resources: []addonsv1.ResourceRef{{Kind: "OCIArtifact", Name: "missing"}},
+			sources:   []addonsv1.ClusterResourceSetSource{ociArtifact},
+			expectErr: true,
+		},
+		{
+			name:      "should return error for a resource referencing a source of a different kind",
+			resources: []addonsv1.ResourceRef{{Kind: "HelmChart", Name: "cni"}},
+			sources:   []addonsv1.ClusterResourceSetSource{ociArtifact},
+			expectErr: true,
+		},
+		{
+			name:      "should return error for a source without ociArtifact and helmChart",
+			sources:   []addonsv1.ClusterResourceSetSource{{Name: "empty"}},
+			expectErr: true,
+		},
+		{
+			name: "should return error for a source with both ociArtifact and helmChart",
+			sources: []addonsv1.ClusterResourceSetSource{{
+				Name:        "both",
+				OCIArtifact: ociArtifact.OCIArtifact,
+				HelmChart:   helmChart.HelmChart,
+			}},
+			expectErr: true,
+		},
+		{
+			name: "should return error for an invalid OCI reference",
+			sources: []addonsv1.ClusterResourceSetSource{{
+				Name:        "cni",
+				OCIArtifact: &addonsv1.OCIArtifactSource{URL: "oci://registry.example.com/addons/cni"},
+			}},
+			expectErr: true,
+		},
+		{
+			name: "should return error for a Helm chart served over http",
+			sources: []addonsv1.ClusterResourceSetSource{{
+				Name:      "csi",
+				HelmChart: &addonsv1.HelmChartSource{URL: "http://charts.example.com/csi-1.0.0.tgz", ReleaseName: "csi"},
+			}},
+			expectErr: true,
+		},
+		{
+			name: "should return error for an invalid release name",
+			sources: []addonsv1.ClusterResourceSetSource{{
+				Name:      "csi",
+				HelmChart: &addonsv1.HelmChartSource{URL: "https://charts.example.com/csi-1.0.0.tgz", ReleaseName: "CSI_release"},
+			}},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterResourceSet := &addonsv1.ClusterResourceSet{
+				Spec: addonsv1.ClusterResourceSetSpec{
+					ClusterSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+					Resources: tt.resources,
+					Sources:   tt.sources,
+				},
+			}
+			webhook := ClusterResourceSet{}
+			_, err := webhook.ValidateCreate(ctx, clusterResourceSet)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/clustertemplate"
+)
+
+func (webhook *ClusterTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&clusterv1.ClusterTemplate{}).
+		WithValidator(webhook).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-cluster-x-k8s-io-v1beta2-clustertemplate,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=cluster.x-k8s.io,resources=clustertemplates,versions=v1beta2,name=validation.clustertemplate.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// ClusterTemplate implements a validation webhook for ClusterTemplate.
+type ClusterTemplate struct {
+	// AllowSecretsAndClusterResourceSets allows ClusterTemplates to contain Secrets and ClusterResourceSets.
+	AllowSecretsAndClusterResourceSets bool
+}
+
+var _ webhook.CustomValidator = &ClusterTemplate{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (webhook *ClusterTemplate) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	ct, ok := obj.(*clusterv1.ClusterTemplate)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a ClusterTemplate but got a %T", obj))
+	}
+
+	return nil, webhook.validate(ct)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (webhook *ClusterTemplate) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	newCT, ok := newObj.(*clusterv1.ClusterTemplate)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a ClusterTemplate but got a %T", newObj))
+	}
+
+	return nil, webhook.validate(newCT)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (webhook *ClusterTemplate) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (webhook *ClusterTemplate) validate(newCT *clusterv1.ClusterTemplate) error {
+	// NOTE: ClusterTemplate is behind the ClusterTemplate feature gate flag; the webhook
+	// must prevent creating new objects when the feature flag is disabled.
+	specPath := field.NewPath("spec")
+	if !feature.Gates.Enabled(feature.ClusterTemplate) {
+		return field.Forbidden(
+			specPath,
+			"can be set only if the ClusterTemplate feature flag is enabled",
+		)
+	}
+
+	var allErrs field.ErrorList
+	declared := sets.New[string]()
+	for i, p := range newCT.Spec.Parameters {
+		paramPath := specPath.Child("parameters").Index(i)
+		declared.Insert(p.Name)
+
+		if clustertemplate.BuiltinParameters.Has(p.Name) {
+			allErrs = append(allErrs, field.Invalid(paramPath.Child("name"), p.Name,
+				fmt.Sprintf("must not be one of the built-in parameters %s", strings.Join(sets.List(clustertemplate.BuiltinParameters), ", "))))
+		}
+		if p.Default == "" {
+			continue
+		}
+		if ptr.Deref(p.Required, false) {
+			allErrs = append(allErrs, field.Forbidden(paramPath.Child("default"), "must not be set for required parameters"))
+			continue
+		}
+		if err := clustertemplate.ValidateParameterValue(p.Type, p.Default); err != nil {
+			allErrs = append(allErrs, field.Invalid(paramPath.Child("default"), p.Default, err.Error()))
+		}
+	}
+
+	templatePath := specPath.Child("template")
+	referenced, err := clustertemplate.ReferencedParameters(newCT.Spec.Template)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(templatePath, "", err.Error()))
+	} else if undeclared := referenced.Difference(declared).Difference(clustertemplate.BuiltinParameters); undeclared.Len() > 0 {
+		allErrs = append(allErrs, field.Invalid(templatePath, "",
+			fmt.Sprintf("references parameters which are not defined in spec.parameters: %s", strings.Join(sets.List(undeclared), ", "))))
+	}
+
+	// Objects of a ClusterTemplate are created with the credentials of the Cluster API controller, so only an
+	// explicit allowlist of kinds is accepted.
+	// NOTE: If the template can't be parsed before the parameters are set, the objects are validated by the
+	// ClusterTemplateInstance controller after rendering.
+	if objs, err := clustertemplate.ParseWithPlaceholders(newCT.Spec.Template, newCT.Spec.Parameters); err == nil {
+		if err := clustertemplate.ValidateObjects(objs, webhook.AllowSecretsAndClusterResourceSets); err != nil {
+			allErrs = append(allErrs, field.Invalid(templatePath, "", err.Error()))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("ClusterTemplate").GroupKind(), newCT.Name, allErrs)
+}
+
+func (webhook *ClusterTemplateInstance) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&clusterv1.ClusterTemplateInstance{}).
+		WithValidator(webhook).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-cluster-x-k8s-io-v1beta2-clustertemplateinstance,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=cluster.x-k8s.io,resources=clustertemplateinstances,versions=v1beta2,name=validation.clustertemplateinstance.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// ClusterTemplateInstance implements a validation webhook for ClusterTemplateInstance.
+type ClusterTemplateInstance struct{}
+
+var _ webhook.CustomValidator = &ClusterTemplateInstance{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (webhook *ClusterTemplateInstance) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cti, ok := obj.(*clusterv1.ClusterTemplateInstance)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a ClusterTemplateInstance but got a %T", obj))
+	}
+
+	return nil, webhook.validate(nil, cti)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (webhook *ClusterTemplateInstance) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldCTI, ok := oldObj.(*clusterv1.ClusterTemplateInstance)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a ClusterTemplateInstance but got a %T", oldObj))
+	}
+	newCTI, ok := newObj.(*clusterv1.ClusterTemplateInstance)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a ClusterTemplateInstance but got a %T", newObj))
+	}
+
+	return nil, webhook.validate(oldCTI, newCTI)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (webhook *ClusterTemplateInstance) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (webhook *ClusterTemplateInstance) validate(oldCTI, newCTI *clusterv1.ClusterTemplateInstance) error {
+	// NOTE: ClusterTemplateInstance is behind the ClusterTemplate feature gate flag; the webhook
+	// must prevent creating new objects when the feature flag is disabled.
+	specPath := field.NewPath("spec")
+	if !feature.Gates.Enabled(feature.ClusterTemplate) {
+		return field.Forbidden(
+			specPath,
+			"can be set only if the ClusterTemplate feature flag is enabled",
+		)
+	}
+
+	var allErrs field.ErrorList
+	// The ClusterTemplate is instantiated only once, so changes to the spec would not have any effect.
+	if oldCTI != nil && !reflect.DeepEqual(oldCTI.Spec, newCTI.Spec) {
+		allErrs = append(allErrs, field.Forbidden(specPath, "field is immutable"))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("ClusterTemplateInstance").GroupKind(), newCTI.Name, allErrs)
+}
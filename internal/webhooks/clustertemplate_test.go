@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/feature"
+)
+
+func TestClusterTemplateValidate(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.ClusterTemplate, true)
+
+	tests := []struct {
+		name                               string
+		parameters                         []clusterv1.ClusterTemplateParameter
+		template                           string
+		allowSecretsAndClusterResourceSets bool
+		expectErr                          bool
+	}{
+		{
+			name: "valid ClusterTemplate",
+			parameters: []clusterv1.ClusterTemplateParameter{
+				{Name: "KUBERNETES_VERSION", Type: clusterv1.ClusterTemplateParameterTypeString, Required: ptr.To(true)},
+				{Name: "WORKER_MACHINE_COUNT", Type: clusterv1.ClusterTemplateParameterTypeInteger, Default: "1"},
+			},
+			template: "apiVersion: cluster.x-k8s.io/v1beta2\nkind: Cluster\nmetadata:\n  name: ${CLUSTER_NAME}\n  namespace: ${NAMESPACE}\n" +
+				"spec:\n  topology:\n    version: ${KUBERNETES_VERSION}\n    workers:\n      machineDeployments:\n      - name: md-0\n        replicas: ${WORKER_MACHINE_COUNT}\n",
+		},
+		{
+			name: "parameter shadowing a built-in parameter",
+			parameters: []clusterv1.ClusterTemplateParameter{
+				{Name: "CLUSTER_NAME", Type: clusterv1.ClusterTemplateParameterTypeString},
+			},
+			template:  "name: ${CLUSTER_NAME}",
+			expectErr: true,
+		},
+		{
+			name: "required parameter with a default",
+			parameters: []clusterv1.ClusterTemplateParameter{
+				{Name: "KUBERNETES_VERSION", Type: clusterv1.ClusterTemplateParameterTypeString, Required: ptr.To(true), Default: "v1.33.0"},
+			},
+			template:  "version: ${KUBERNETES_VERSION}",
+			expectErr: true,
+		},
+		{
+			name: "default not matching the parameter type",
+			parameters: []clusterv1.ClusterTemplateParameter{
+				{Name: "ENABLE_AUDIT", Type: clusterv1.ClusterTemplateParameterTypeBoolean, Default: "yes"},
+			},
+			template:  "audit: ${ENABLE_AUDIT}",
+			expectErr: true,
+		},
+		{
+			name:      "template referencing undeclared parameters",
+			template:  "version: ${KUBERNETES_VERSION}",
+			expectErr: true,
+		},
+		{
+			name:      "invalid template",
+			template:  "name: ${CLUSTER_NAME",
+			expectErr: true,
+		},
+		{
+			name: "template with allowed objects",
+			parameters: []clusterv1.ClusterTemplateParameter{
+				{Name: "WORKER_MACHINE_COUNT", Type: clusterv1.ClusterTemplateParameterTypeInteger, Default: "1"},
+			},
+			template: "apiVersion: cluster.x-k8s.io/v1beta2\nkind: MachineDeployment\nmetadata:\n  name: ${CLUSTER_NAME}-md-0\nspec:\n  replicas: ${WORKER_MACHINE_COUNT}\n" +
+				"---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: ${CLUSTER_NAME}-config\n",
+		},
+		{
+			name:      "template with a Secret",
+			template:  "apiVersion: v1\nkind: Secret\nmetadata:\n  name: ${CLUSTER_NAME}-credentials\n",
+			expectErr: true,
+		},
+		{
+			name:                               "template with a Secret when Secrets and ClusterResourceSets are allowed",
+			template:                           "apiVersion: v1\nkind: Secret\nmetadata:\n  name: ${CLUSTER_NAME}-credentials\n",
+			allowSecretsAndClusterResourceSets: true,
+		},
+		{
+			name:                               "template with a kind which is not allowed",
+			template:                           "apiVersion: rbac.authorization.k8s.io/v1\nkind: RoleBinding\nmetadata:\n  name: ${CLUSTER_NAME}-admin\n",
+			allowSecretsAndClusterResourceSets: true,
+			expectErr:                          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ct := &clusterv1.ClusterTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "ct", Namespace: metav1.NamespaceDefault},
+				Spec: clusterv1.ClusterTemplateSpec{
+					Parameters: tt.parameters,
+					Template:   tt.template,
+				},
+			}
+			webhook := &ClusterTemplate{AllowSecretsAndClusterResourceSets: tt.allowSecretsAndClusterResourceSets}
+
+			_, err := webhook.ValidateCreate(ctx, ct)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			_, err = webhook.ValidateUpdate(ctx, ct, ct)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestClusterTemplateInstanceValidate(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.ClusterTemplate, true)
+
+	g := NewWithT(t)
+
+	instance := &clusterv1.ClusterTemplateInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "cti", Namespace: metav1.NamespaceDefault},
+		Spec: clusterv1.ClusterTemplateInstanceSpec{
+			ClusterTemplateName: "ct",
+			ClusterName:         "my-cluster",
+			Parameters: []clusterv1.ClusterTemplateInstanceParameter{
+				{Name: "KUBERNETES_VERSION", Value: "v1.33.0"},
+			},
+		},
+	}
+	webhook := &ClusterTemplateInstance{}
+
+	_, err := webhook.ValidateCreate(ctx, instance)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	updated := instance.DeepCopy()
+	updated.Labels = map[string]string{"foo": "bar"}
+	_, err = webhook.ValidateUpdate(ctx, instance, updated)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	updated.Spec.Parameters[0].Value = "v1.34.0"
+	_, err = webhook.ValidateUpdate(ctx, instance, updated)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestClusterTemplateFeatureGateDisabled(t *testing.T) {
+	// NOTE: ClusterTemplate feature flag is disabled by default, thus preventing to create or update ClusterTemplates
+	// and ClusterTemplateInstances. Enabling the feature flag is tested in the tests above.
+	g := NewWithT(t)
+
+	_, err := (&ClusterTemplate{}).ValidateCreate(ctx, &clusterv1.ClusterTemplate{})
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = (&ClusterTemplateInstance{}).ValidateCreate(ctx, &clusterv1.ClusterTemplateInstance{})
+	g.Expect(err).To(HaveOccurred())
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"reflect"
 	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -34,6 +35,7 @@ import (
 
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
 	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/runtime/settings"
 )
 
 // ExtensionConfig is the webhook for runtimev1.ExtensionConfig.
@@ -63,14 +65,22 @@ func (webhook *ExtensionConfig) Default(_ context.Context, obj runtime.Object) e
 	if extensionConfig.Spec.NamespaceSelector == nil {
 		extensionConfig.Spec.NamespaceSelector = &metav1.LabelSelector{}
 	}
-	if extensionConfig.Spec.ClientConfig.Service.IsDefined() {
-		if extensionConfig.Spec.ClientConfig.Service.Port == nil {
-			extensionConfig.Spec.ClientConfig.Service.Port = ptr.To[int32](443)
-		}
+	defaultClientConfig(&extensionConfig.Spec.ClientConfig)
+	if extensionConfig.Spec.SecondaryClientConfig.IsDefined() {
+		defaultClientConfig(&extensionConfig.Spec.SecondaryClientConfig.ClientConfig)
 	}
 	return nil
 }
 
+// defaultClientConfig defaults the port of the Service of a ClientConfig to 443, if not set.
+func defaultClientConfig(config *runtimev1.ClientConfig) {
+	if config.Service.IsDefined() {
+		if config.Service.Port == nil {
+			config.Service.Port = ptr.To[int32](443)
+		}
+	}
+}
+
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (webhook *ExtensionConfig) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	extensionConfig, ok := obj.(*runtimev1.ExtensionConfig)
@@ -94,7 +104,7 @@ func (webhook *ExtensionConfig) ValidateUpdate(ctx context.Context, old, updated
 }
 
 // validate validates an ExtensionConfig create or update.
-func (webhook *ExtensionConfig) validate(_ context.Context, _, newExtensionConfig *runtimev1.ExtensionConfig) (admission.Warnings, error) {
+func (webhook *ExtensionConfig) validate(_ context.Context, oldExtensionConfig, newExtensionConfig *runtimev1.ExtensionConfig) (admission.Warnings, error) {
 	// NOTE: ExtensionConfig is behind the RuntimeSDK feature gate flag; the web hook
 	// must prevent creating and updating objects in case the feature flag is disabled.
 	if !feature.Gates.Enabled(feature.RuntimeSDK) {
@@ -115,6 +125,13 @@ func (webhook *ExtensionConfig) validate(_ context.Context, _, newExtensionConfi
 	}
 	allErrs = append(allErrs, validateExtensionConfigSpec(newExtensionConfig)...)
 
+	// Validate settings against the settings schema returned by the Extension during discovery, if any.
+	// Note: Settings are only validated when they are changed, so that other changes to the ExtensionConfig,
+	// e.g. the injection of the caBundle, are not blocked after the Extension published a stricter settings schema.
+	if oldExtensionConfig == nil || !reflect.DeepEqual(oldExtensionConfig.Spec.Settings, newExtensionConfig.Spec.Settings) {
+		allErrs = append(allErrs, settings.Validate(newExtensionConfig.Status.SettingsSchema, newExtensionConfig.Spec.Settings, field.NewPath("spec", "settings"))...)
+	}
+
 	if len(allErrs) > 0 {
 		return nil, apierrors.NewInvalid(runtimev1.GroupVersion.WithKind("ExtensionConfig").GroupKind(), newExtensionConfig.Name, allErrs)
 	}
@@ -131,109 +148,152 @@ func validateExtensionConfigSpec(e *runtimev1.ExtensionConfig) field.ErrorList {
 
 	specPath := field.NewPath("spec")
 
-	if e.Spec.ClientConfig.URL == "" && !e.Spec.ClientConfig.Service.IsDefined() {
+	allErrs = append(allErrs, validateClientConfig(e.Spec.ClientConfig, specPath.Child("clientConfig"))...)
+
+	if e.Spec.SecondaryClientConfig.IsDefined() {
+		secondaryPath := specPath.Child("secondaryClientConfig")
+		allErrs = append(allErrs, validateClientConfig(e.Spec.SecondaryClientConfig.ClientConfig, secondaryPath.Child("clientConfig"))...)
+		if e.Spec.SecondaryClientConfig.Weight == nil {
+			allErrs = append(allErrs, field.Required(
+				secondaryPath.Child("weight"),
+				"must be defined",
+			))
+		} else if weight := *e.Spec.SecondaryClientConfig.Weight; weight < 0 || weight > 100 {
+			allErrs = append(allErrs, field.Invalid(
+				secondaryPath.Child("weight"),
+				weight,
+				"must be between 0 and 100",
+			))
+		}
+	}
+
+	if e.Spec.NamespaceSelector == nil {
+		allErrs = append(allErrs, field.Required(
+			specPath.Child("namespaceSelector"),
+			"must be defined",
+		))
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(e.Spec.NamespaceSelector); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			specPath.Child("namespaceSelector"),
+			e.Spec.NamespaceSelector,
+			err.Error(),
+		))
+	}
+
+	for i, o := range e.Spec.HandlerOverrides {
+		overridePath := specPath.Child("handlerOverrides").Index(i)
+		if (o.Name == "") == (o.Hook == "") {
+			allErrs = append(allErrs, field.Invalid(
+				overridePath,
+				o,
+				"exactly one of name or hook must be defined",
+			))
+		}
+		if o.TimeoutSeconds == nil && o.FailurePolicy == "" {
+			allErrs = append(allErrs, field.Required(
+				overridePath,
+				"at least one of timeoutSeconds or failurePolicy must be defined",
+			))
+		}
+	}
+	return allErrs
+}
+
+// validateClientConfig validates the ClientConfig of an Extension server.
+func validateClientConfig(config runtimev1.ClientConfig, configPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if config.URL == "" && !config.Service.IsDefined() {
 		allErrs = append(allErrs, field.Required(
-			specPath.Child("clientConfig"),
+			configPath,
 			"either url or service must be defined",
 		))
 	}
-	if e.Spec.ClientConfig.URL != "" && e.Spec.ClientConfig.Service.IsDefined() {
+	if config.URL != "" && config.Service.IsDefined() {
 		allErrs = append(allErrs, field.Forbidden(
-			specPath.Child("clientConfig"),
+			configPath,
 			"only one of url or service can be defined",
 		))
 	}
 
 	// Validate URL
-	if e.Spec.ClientConfig.URL != "" {
-		if uri, err := url.ParseRequestURI(e.Spec.ClientConfig.URL); err != nil {
+	if config.URL != "" {
+		if uri, err := url.ParseRequestURI(config.URL); err != nil {
 			allErrs = append(allErrs, field.Invalid(
-				specPath.Child("clientConfig", "url"),
-				e.Spec.ClientConfig.URL,
+				configPath.Child("url"),
+				config.URL,
 				fmt.Sprintf("must be a valid URL, e.g. https://example.com: %v", err),
 			))
 		} else if uri.Scheme != "https" {
 			allErrs = append(allErrs, field.Invalid(
-				specPath.Child("clientConfig", "url"),
-				e.Spec.ClientConfig.URL,
+				configPath.Child("url"),
+				config.URL,
 				"'https' is the only allowed URL scheme, e.g. https://example.com",
 			))
 		}
 	}
 
 	// Validate Service if defined
-	if e.Spec.ClientConfig.Service.IsDefined() {
+	if config.Service.IsDefined() {
 		// Validate that the name is not empty and is a Valid RFC1123 name.
-		if e.Spec.ClientConfig.Service.Name == "" {
+		if config.Service.Name == "" {
 			allErrs = append(allErrs, field.Required(
-				specPath.Child("clientConfig", "service", "name"),
+				configPath.Child("service", "name"),
 				"must not be empty",
 			))
 		}
 
-		for _, msg := range validation.IsDNS1035Label(e.Spec.ClientConfig.Service.Name) {
+		for _, msg := range validation.IsDNS1035Label(config.Service.Name) {
 			allErrs = append(allErrs, field.Invalid(
-				specPath.Child("clientConfig", "service", "name"),
-				e.Spec.ClientConfig.Service.Name,
+				configPath.Child("service", "name"),
+				config.Service.Name,
 				msg,
 			))
 		}
 
-		if e.Spec.ClientConfig.Service.Namespace == "" {
+		if config.Service.Namespace == "" {
 			allErrs = append(allErrs, field.Required(
-				specPath.Child("clientConfig", "service", "namespace"),
+				configPath.Child("service", "namespace"),
 				"must not be empty",
 			))
 		}
 
-		for _, msg := range validation.IsDNS1123Label(e.Spec.ClientConfig.Service.Namespace) {
+		for _, msg := range validation.IsDNS1123Label(config.Service.Namespace) {
 			allErrs = append(allErrs, field.Invalid(
-				specPath.Child("clientConfig", "service", "namespace"),
-				e.Spec.ClientConfig.Service.Namespace,
+				configPath.Child("service", "namespace"),
+				config.Service.Namespace,
 				msg,
 			))
 		}
 
-		if e.Spec.ClientConfig.Service.Path != "" {
-			path := e.Spec.ClientConfig.Service.Path
+		if config.Service.Path != "" {
+			path := config.Service.Path
 			if _, err := url.ParseRequestURI(path); err != nil {
 				allErrs = append(allErrs, field.Invalid(
-					specPath.Child("clientConfig", "service", "path"),
+					configPath.Child("service", "path"),
 					path,
 					fmt.Sprintf("must be a valid URL path e.g. /path/to/hook: %v", err),
 				))
 			}
 			if !strings.HasPrefix(path, "/") {
 				allErrs = append(allErrs, field.Invalid(
-					specPath.Child("clientConfig", "service", "path"),
+					configPath.Child("service", "path"),
 					path,
 					"must start with \"/\" to be a valid URL path",
 				))
 			}
 		}
-		if e.Spec.ClientConfig.Service.Port != nil {
-			for _, msg := range validation.IsValidPortNum(int(*e.Spec.ClientConfig.Service.Port)) {
+		if config.Service.Port != nil {
+			for _, msg := range validation.IsValidPortNum(int(*config.Service.Port)) {
 				allErrs = append(allErrs, field.Invalid(
-					specPath.Child("clientConfig", "service", "port"),
-					*e.Spec.ClientConfig.Service.Port,
+					configPath.Child("service", "port"),
+					*config.Service.Port,
 					msg,
 				))
 			}
 		}
 	}
-	if e.Spec.NamespaceSelector == nil {
-		allErrs = append(allErrs, field.Required(
-			specPath.Child("namespaceSelector"),
-			"must be defined",
-		))
-	}
-
-	if _, err := metav1.LabelSelectorAsSelector(e.Spec.NamespaceSelector); err != nil {
-		allErrs = append(allErrs, field.Invalid(
-			specPath.Child("namespaceSelector"),
-			e.Spec.NamespaceSelector,
-			err.Error(),
-		))
-	}
 	return allErrs
 }
@@ -115,6 +115,15 @@ func TestExtensionConfigDefault(t *testing.T) {
 					Namespace: "namespace",
 				},
 			},
+			SecondaryClientConfig: runtimev1.WeightedClientConfig{
+				ClientConfig: runtimev1.ClientConfig{
+					Service: runtimev1.ServiceReference{
+						Name:      "name-v2",
+						Namespace: "namespace",
+					},
+				},
+				Weight: ptr.To[int32](20),
+			},
 		},
 	}
 
@@ -124,6 +133,7 @@ func TestExtensionConfigDefault(t *testing.T) {
 	g.Expect(extensionConfigWebhook.Default(ctx, extensionConfig)).To(Succeed())
 	g.Expect(extensionConfig.Spec.NamespaceSelector).To(BeComparableTo(&metav1.LabelSelector{}))
 	g.Expect(extensionConfig.Spec.ClientConfig.Service.Port).To(BeComparableTo(ptr.To[int32](443)))
+	g.Expect(extensionConfig.Spec.SecondaryClientConfig.ClientConfig.Service.Port).To(BeComparableTo(ptr.To[int32](443)))
 }
 
 func TestExtensionConfigValidate(t *testing.T) {
@@ -210,6 +220,63 @@ func TestExtensionConfigValidate(t *testing.T) {
 		},
 	}
 
+	extensionWithHandlerOverrides := extensionWithService.DeepCopy()
+	extensionWithHandlerOverrides.Spec.HandlerOverrides = []runtimev1.ExtensionHandlerOverride{
+		{Name: "handler.test-extension", TimeoutSeconds: ptr.To[int32](5)},
+		{Hook: "BeforeClusterUpgrade", FailurePolicy: runtimev1.FailurePolicyIgnore},
+	}
+
+	extensionWithHandlerOverrideNameAndHook := extensionWithService.DeepCopy()
+	extensionWithHandlerOverrideNameAndHook.Spec.HandlerOverrides = []runtimev1.ExtensionHandlerOverride{
+		{Name: "handler.test-extension", Hook: "BeforeClusterUpgrade", TimeoutSeconds: ptr.To[int32](5)},
+	}
+
+	extensionWithEmptyHandlerOverride := extensionWithService.DeepCopy()
+	extensionWithEmptyHandlerOverride.Spec.HandlerOverrides = []runtimev1.ExtensionHandlerOverride{
+		{Hook: "BeforeClusterUpgrade"},
+	}
+
+	extensionWithSecondaryClientConfig := extensionWithService.DeepCopy()
+	extensionWithSecondaryClientConfig.Spec.SecondaryClientConfig = runtimev1.WeightedClientConfig{
+		ClientConfig: runtimev1.ClientConfig{
+			Service: runtimev1.ServiceReference{
+				Name:      "name-v2",
+				Namespace: "namespace",
+			},
+		},
+		Weight: ptr.To[int32](20),
+	}
+
+	extensionWithInvalidSecondaryClientConfig := extensionWithSecondaryClientConfig.DeepCopy()
+	extensionWithInvalidSecondaryClientConfig.Spec.SecondaryClientConfig.ClientConfig.URL = "https://example.com"
+
+	extensionWithSecondaryClientConfigWithoutWeight := extensionWithSecondaryClientConfig.DeepCopy()
+	extensionWithSecondaryClientConfigWithoutWeight.Spec.SecondaryClientConfig.Weight = nil
+
+	extensionWithSecondaryClientConfigWithInvalidWeight := extensionWithSecondaryClientConfig.DeepCopy()
+	extensionWithSecondaryClientConfigWithInvalidWeight.Spec.SecondaryClientConfig.Weight = ptr.To[int32](101)
+
+	extensionWithSettingsSchema := extensionWithService.DeepCopy()
+	extensionWithSettingsSchema.Spec.Settings = map[string]string{"mode": "fast"}
+	extensionWithSettingsSchema.Status.SettingsSchema = &runtimev1.ExtensionSettingsSchema{
+		Settings: []runtimev1.ExtensionSettingSchema{
+			{Name: "mode", Required: ptr.To(true), Enum: []string{"fast", "safe"}},
+		},
+	}
+
+	extensionWithValidSettings := extensionWithSettingsSchema.DeepCopy()
+	extensionWithValidSettings.Spec.Settings["mode"] = "safe"
+
+	extensionWithInvalidSettings := extensionWithSettingsSchema.DeepCopy()
+	extensionWithInvalidSettings.Spec.Settings["mode"] = "slow"
+
+	extensionWithUnknownSettings := extensionWithSettingsSchema.DeepCopy()
+	extensionWithUnknownSettings.Spec.Settings["foo"] = "bar"
+
+	// Settings not valid according to the settings schema, but not changed by the update.
+	extensionWithUnchangedInvalidSettings := extensionWithInvalidSettings.DeepCopy()
+	extensionWithUnchangedInvalidSettings.Spec.ClientConfig.Service.Path = "/another/path"
+
 	tests := []struct {
 		name        string
 		in          *runtimev1.ExtensionConfig
@@ -322,6 +389,76 @@ func TestExtensionConfigValidate(t *testing.T) {
 			featureGate: true,
 			expectErr:   false,
 		},
+		{
+			name:        "creation should pass with handler overrides",
+			in:          extensionWithHandlerOverrides,
+			featureGate: true,
+			expectErr:   false,
+		},
+		{
+			name:        "creation should fail if a handler override defines both name and hook",
+			in:          extensionWithHandlerOverrideNameAndHook,
+			featureGate: true,
+			expectErr:   true,
+		},
+		{
+			name:        "creation should fail if a handler override does not override anything",
+			in:          extensionWithEmptyHandlerOverride,
+			featureGate: true,
+			expectErr:   true,
+		},
+		{
+			name:        "creation should pass with a secondary client config",
+			in:          extensionWithSecondaryClientConfig,
+			featureGate: true,
+			expectErr:   false,
+		},
+		{
+			name:        "creation should fail if both URL and Service of the secondary client config are defined",
+			in:          extensionWithInvalidSecondaryClientConfig,
+			featureGate: true,
+			expectErr:   true,
+		},
+		{
+			name:        "creation should fail if the weight of the secondary client config is not defined",
+			in:          extensionWithSecondaryClientConfigWithoutWeight,
+			featureGate: true,
+			expectErr:   true,
+		},
+		{
+			name:        "creation should fail if the weight of the secondary client config is greater than 100",
+			in:          extensionWithSecondaryClientConfigWithInvalidWeight,
+			featureGate: true,
+			expectErr:   true,
+		},
+		{
+			name:        "update should pass if settings are valid according to the settings schema",
+			old:         extensionWithSettingsSchema,
+			in:          extensionWithValidSettings,
+			featureGate: true,
+			expectErr:   false,
+		},
+		{
+			name:        "update should fail if a setting is not valid according to the settings schema",
+			old:         extensionWithSettingsSchema,
+			in:          extensionWithInvalidSettings,
+			featureGate: true,
+			expectErr:   true,
+		},
+		{
+			name:        "update should fail if a setting is not defined in the settings schema",
+			old:         extensionWithSettingsSchema,
+			in:          extensionWithUnknownSettings,
+			featureGate: true,
+			expectErr:   true,
+		},
+		{
+			name:        "update should pass if invalid settings are not changed",
+			old:         extensionWithInvalidSettings,
+			in:          extensionWithUnchangedInvalidSettings,
+			featureGate: true,
+			expectErr:   false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -18,14 +18,17 @@ package webhooks
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -49,7 +52,15 @@ func (webhook *Machine) SetupWebhookWithManager(mgr ctrl.Manager) error {
 // +kubebuilder:webhook:verbs=create;update,path=/mutate-cluster-x-k8s-io-v1beta2-machine,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=cluster.x-k8s.io,resources=machines,versions=v1beta2,name=default.machine.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
 
 // Machine implements a validation and defaulting webhook for Machine.
-type Machine struct{}
+type Machine struct {
+	// Client is used to read the Cluster and its control plane when validating the version skew.
+	// If it is not set, the version skew validation is skipped.
+	Client client.Reader
+
+	// VersionSkewValidationPolicy defines how versions that do not conform to the
+	// Kubernetes version skew policy relative to the control plane are handled.
+	VersionSkewValidationPolicy VersionSkewValidationPolicy
+}
 
 var _ webhook.CustomValidator = &Machine{}
 var _ webhook.CustomDefaulter = &Machine{}
@@ -79,17 +90,20 @@ func (webhook *Machine) Default(_ context.Context, obj runtime.Object) error {
 }
 
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
-func (webhook *Machine) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (webhook *Machine) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	m, ok := obj.(*clusterv1.Machine)
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a Machine but got a %T", obj))
 	}
 
-	return nil, webhook.validate(nil, m)
+	if err := webhook.validate(nil, m); err != nil {
+		return nil, err
+	}
+	return webhook.validateVersionSkew(ctx, nil, m)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (webhook *Machine) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+func (webhook *Machine) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	oldM, ok := oldObj.(*clusterv1.Machine)
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a Machine but got a %T", oldObj))
@@ -100,7 +114,10 @@ func (webhook *Machine) ValidateUpdate(_ context.Context, oldObj, newObj runtime
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a Machine but got a %T", newObj))
 	}
 
-	return nil, webhook.validate(oldM, newM)
+	if err := webhook.validate(oldM, newM); err != nil {
+		return nil, err
+	}
+	return webhook.validateVersionSkew(ctx, oldM, newM)
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
@@ -139,6 +156,7 @@ func (webhook *Machine) validate(oldM, newM *clusterv1.Machine) error {
 
 	allErrs = append(allErrs, validateMachineTaints(newM.Spec.Taints, specPath.Child("taints"))...)
 	allErrs = append(allErrs, validateMachineTaintsForWorkers(newM.Spec.Taints, newM, specPath.Child("taints"))...)
+	allErrs = append(allErrs, validateMachineNodeReadinessChecks(newM.Spec.NodeReadinessChecks, specPath.Child("nodeReadinessChecks"))...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -146,6 +164,28 @@ func (webhook *Machine) validate(oldM, newM *clusterv1.Machine) error {
 	return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("Machine").GroupKind(), newM.Name, allErrs)
 }
 
+// validateVersionSkew validates the version of a Machine against the version of the control plane of its Cluster.
+// Control plane Machines and Machines controlled by another object, e.g. a MachineSet, are not validated;
+// for the latter the version is validated on the owning object instead.
+func (webhook *Machine) validateVersionSkew(ctx context.Context, oldM, newM *clusterv1.Machine) (admission.Warnings, error) {
+	if _, ok := newM.Labels[clusterv1.MachineControlPlaneLabel]; ok {
+		return nil, nil
+	}
+	if metav1.GetControllerOf(newM) != nil {
+		return nil, nil
+	}
+
+	var oldVersion string
+	if oldM != nil {
+		oldVersion = oldM.Spec.Version
+	}
+	warnings, err := validateVersionSkew(ctx, webhook.Client, webhook.VersionSkewValidationPolicy, newM.Namespace, newM.Spec.ClusterName, oldVersion, newM.Spec.Version, field.NewPath("spec", "version"))
+	if err != nil {
+		return nil, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("Machine").GroupKind(), newM.Name, field.ErrorList{err})
+	}
+	return warnings, nil
+}
+
 func validateMachineTaints(taints []clusterv1.MachineTaint, taintsPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -166,6 +206,8 @@ func validateMachineTaints(taints []clusterv1.MachineTaint, taintsPath *field.Pa
 			allErrs = append(allErrs, field.Invalid(idxPath.Child("key"), taint.Key, "taint key is not allowed"))
 		case taint.Key == clusterv1.NodeOutdatedRevisionTaint.Key:
 			allErrs = append(allErrs, field.Invalid(idxPath.Child("key"), taint.Key, "taint key is not allowed"))
+		case taint.Key == clusterv1.NodeStandbyTaint.Key:
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("key"), taint.Key, "taint key is not allowed"))
 		// Validate for keys which are reserved for usage by the node or node-lifecycle-controller, but allow `node.kubernetes.io/out-of-service`.
 		case strings.HasPrefix(taint.Key, "node.kubernetes.io/") && taint.Key != "node.kubernetes.io/out-of-service":
 			allErrs = append(allErrs, field.Invalid(idxPath.Child("key"), taint.Key, "taint key must not have the prefix node.kubernetes.io/, except for node.kubernetes.io/out-of-service"))
@@ -206,3 +248,29 @@ func validateMachineTaintsForWorkers(taints []clusterv1.MachineTaint, machine *c
 
 	return allErrs
 }
+
+func validateMachineNodeReadinessChecks(checks []clusterv1.MachineNodeReadinessCheck, checksPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, check := range checks {
+		if _, err := metav1.LabelSelectorAsSelector(&check.Selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(checksPath.Index(i).Child("selector"), check.Selector, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateMachineInfrastructureOverrides(overrides []clusterv1.MachineInfrastructureOverride, overridesPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, override := range overrides {
+		// The spec of an override is merged into the spec of the InfraMachine, so it must be a JSON object.
+		spec := map[string]interface{}{}
+		if err := json.Unmarshal(override.Spec.Raw, &spec); err != nil || spec == nil {
+			allErrs = append(allErrs, field.Invalid(overridesPath.Index(i).Child("spec"), string(override.Spec.Raw), "must be a JSON object"))
+		}
+	}
+
+	return allErrs
+}
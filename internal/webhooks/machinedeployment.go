@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/admission/v1"
@@ -33,12 +34,14 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/feature"
 	topologynames "sigs.k8s.io/cluster-api/internal/topology/names"
+	capilabels "sigs.k8s.io/cluster-api/util/labels"
 	"sigs.k8s.io/cluster-api/util/version"
 )
 
@@ -60,6 +63,14 @@ func (webhook *MachineDeployment) SetupWebhookWithManager(mgr ctrl.Manager) erro
 // MachineDeployment implements a validation and defaulting webhook for MachineDeployment.
 type MachineDeployment struct {
 	decoder admission.Decoder
+
+	// Client is used to read the Cluster and its control plane when validating the version skew.
+	// If it is not set, the version skew validation is skipped.
+	Client client.Reader
+
+	// VersionSkewValidationPolicy defines how versions that do not conform to the
+	// Kubernetes version skew policy relative to the control plane are handled.
+	VersionSkewValidationPolicy VersionSkewValidationPolicy
 }
 
 var _ webhook.CustomDefaulter = &MachineDeployment{}
@@ -98,6 +109,9 @@ func (webhook *MachineDeployment) Default(ctx context.Context, obj runtime.Objec
 	if err != nil {
 		return err
 	}
+	if m.Spec.Replicas == nil {
+		replicas = replicasWithinLimits(replicas, m.Spec.MinReplicas, m.Spec.MaxReplicas)
+	}
 	m.Spec.Replicas = ptr.To[int32](replicas)
 
 	if m.Spec.Selector.MatchLabels == nil {
@@ -142,17 +156,20 @@ func (webhook *MachineDeployment) Default(ctx context.Context, obj runtime.Objec
 }
 
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
-func (webhook *MachineDeployment) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (webhook *MachineDeployment) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	m, ok := obj.(*clusterv1.MachineDeployment)
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a MachineDeployment but got a %T", obj))
 	}
 
-	return nil, webhook.validate(nil, m)
+	if err := webhook.validate(nil, m); err != nil {
+		return nil, err
+	}
+	return webhook.validateVersionSkew(ctx, nil, m)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (webhook *MachineDeployment) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+func (webhook *MachineDeployment) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	oldMD, ok := oldObj.(*clusterv1.MachineDeployment)
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a MachineDeployment but got a %T", oldObj))
@@ -163,7 +180,10 @@ func (webhook *MachineDeployment) ValidateUpdate(_ context.Context, oldObj, newO
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a MachineDeployment but got a %T", newObj))
 	}
 
-	return nil, webhook.validate(oldMD, newMD)
+	if err := webhook.validate(oldMD, newMD); err != nil {
+		return nil, err
+	}
+	return webhook.validateVersionSkew(ctx, oldMD, newMD)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -245,6 +265,32 @@ func (webhook *MachineDeployment) validate(oldMD, newMD *clusterv1.MachineDeploy
 
 	allErrs = append(allErrs, validateRolloutStrategy(specPath.Child("rollout", "strategy"), newMD.Spec.Rollout.Strategy.RollingUpdate.MaxUnavailable, newMD.Spec.Rollout.Strategy.RollingUpdate.MaxSurge)...)
 	allErrs = append(allErrs, validateRemediationMaxInFlight(specPath.Child("remediation"), newMD.Spec.Remediation.MaxInFlight)...)
+	allErrs = append(allErrs, validateRebalanceMaxUnavailable(specPath.Child("rebalance"), newMD.Spec.Rebalance.MaxUnavailable)...)
+	allErrs = append(allErrs, validateStandbyReplicas(specPath.Child("standby"), newMD.Spec.Standby.Replicas)...)
+	allErrs = append(allErrs, validateReplicaLimits(specPath, newMD.Spec.Replicas, newMD.Spec.MinReplicas, newMD.Spec.MaxReplicas, newMD.Annotations)...)
+	allErrs = append(allErrs, validatePowerStateSchedule(specPath.Child("powerStateSchedule"), newMD.Spec.PowerStateSchedule)...)
+
+	if newMD.Spec.Rollout.Rollback.Policy == clusterv1.AutomaticMachineDeploymentRollbackPolicy && newMD.Spec.Rollout.MachineSetReadyTimeoutSeconds == nil {
+		allErrs = append(
+			allErrs,
+			field.Required(
+				specPath.Child("rollout", "machineSetReadyTimeoutSeconds"),
+				fmt.Sprintf("must be set when spec.rollout.rollback.policy is %s", clusterv1.AutomaticMachineDeploymentRollbackPolicy),
+			),
+		)
+	}
+
+	// Automatic rollbacks change spec.template, which is owned by the topology controller for MachineDeployments
+	// managed by a ClusterClass; the two controllers would keep reverting each other's changes.
+	if newMD.Spec.Rollout.Rollback.Policy == clusterv1.AutomaticMachineDeploymentRollbackPolicy && capilabels.IsTopologyOwned(newMD) {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(
+				specPath.Child("rollout", "rollback", "policy"),
+				fmt.Sprintf("cannot be %s for MachineDeployments managed by a ClusterClass", clusterv1.AutomaticMachineDeploymentRollbackPolicy),
+			),
+		)
+	}
 
 	if newMD.Spec.Template.Spec.Version != "" {
 		if !version.KubeSemver.MatchString(newMD.Spec.Template.Spec.Version) {
@@ -256,6 +302,9 @@ func (webhook *MachineDeployment) validate(oldMD, newMD *clusterv1.MachineDeploy
 
 	allErrs = append(allErrs, validateMachineTaints(newMD.Spec.Template.Spec.Taints, specPath.Child("template", "spec", "taints"))...)
 	allErrs = append(allErrs, validateMachineTaintsForWorkers(newMD.Spec.Template.Spec.Taints, nil, specPath.Child("template", "spec", "taints"))...)
+	allErrs = append(allErrs, validateMachineInfrastructureOverrides(newMD.Spec.Template.Spec.InfrastructureOverrides, specPath.Child("template", "spec", "infrastructureOverrides"))...)
+	allErrs = append(allErrs, validateMachineNodeReadinessChecks(newMD.Spec.Template.Spec.NodeReadinessChecks, specPath.Child("template", "spec", "nodeReadinessChecks"))...)
+	allErrs = append(allErrs, validateMachineNodeReadinessChecks(newMD.Spec.Rollout.NodeReadinessChecks, specPath.Child("rollout", "nodeReadinessChecks"))...)
 
 	// Validate the metadata of the template.
 	allErrs = append(allErrs, newMD.Spec.Template.Validate(specPath.Child("template", "metadata"))...)
@@ -267,6 +316,24 @@ func (webhook *MachineDeployment) validate(oldMD, newMD *clusterv1.MachineDeploy
 	return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("MachineDeployment").GroupKind(), newMD.Name, allErrs)
 }
 
+// validateVersionSkew validates the version of a MachineDeployment against the version of the control plane of its Cluster.
+// The validation is skipped if the KubernetesVersionSkew preflight check is skipped on the MachineDeployment.
+func (webhook *MachineDeployment) validateVersionSkew(ctx context.Context, oldMD, newMD *clusterv1.MachineDeployment) (admission.Warnings, error) {
+	if skipsKubernetesVersionSkewPreflightCheck(newMD) {
+		return nil, nil
+	}
+
+	var oldVersion string
+	if oldMD != nil {
+		oldVersion = oldMD.Spec.Template.Spec.Version
+	}
+	warnings, err := validateVersionSkew(ctx, webhook.Client, webhook.VersionSkewValidationPolicy, newMD.Namespace, newMD.Spec.ClusterName, oldVersion, newMD.Spec.Template.Spec.Version, field.NewPath("spec", "template", "spec", "version"))
+	if err != nil {
+		return nil, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("MachineDeployment").GroupKind(), newMD.Name, field.ErrorList{err})
+	}
+	return warnings, nil
+}
+
 func validateRolloutStrategy(fldPath *field.Path, maxUnavailable, maxSurge *intstr.IntOrString) field.ErrorList {
 	var allErrs field.ErrorList
 	if maxUnavailable != nil {
@@ -315,6 +382,19 @@ func validateRemediationMaxInFlight(fldPath *field.Path, maxInFlight *intstr.Int
 	return allErrs
 }
 
+func validatePowerStateSchedule(fldPath *field.Path, schedule clusterv1.MachineDeploymentPowerStateScheduleSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if schedule.TimeZone != "" {
+		if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(fldPath.Child("timeZone"), schedule.TimeZone, fmt.Sprintf("must be a valid IANA time zone name: %v", err.Error())),
+			)
+		}
+	}
+	return allErrs
+}
+
 func validateMDMachineNaming(machineNaming clusterv1.MachineNamingSpec, pathPrefix *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -327,29 +407,59 @@ func validateMDMachineNaming(machineNaming clusterv1.MachineNamingSpec, pathPref
 					"invalid template, {{ .random }} is missing",
 				))
 		}
-		name, err := topologynames.MachineSetMachineNameGenerator(machineNaming.Template, "cluster", "machineset").GenerateName()
-		if err != nil {
-			allErrs = append(allErrs,
-				field.Invalid(
-					pathPrefix.Child("template"),
-					machineNaming.Template,
-					fmt.Sprintf("invalid template: %v", err),
-				))
-		} else {
-			for _, err := range validation.IsDNS1123Subdomain(name) {
-				allErrs = append(allErrs,
-					field.Invalid(
-						pathPrefix.Child("template"),
-						machineNaming.Template,
-						fmt.Sprintf("invalid template, generated names would not be valid Kubernetes object names: %v", err),
-					))
-			}
+		allErrs = append(allErrs, topologynames.ValidateMachineNameTemplate(machineNaming.Template, func(predecessorName string) topologynames.NameGenerator {
+			return topologynames.MachineSetMachineNameGenerator(machineNaming.Template, "cluster", "machineset", predecessorName)
+		}, pathPrefix.Child("template"))...)
+	}
+
+	return allErrs
+}
+
+// validateReplicaLimits validates replicas and the min size and max size annotations of the Kubernetes autoscaler
+// against minReplicas and maxReplicas of a MachineDeployment or a MachinePool.
+// Note: Invalid values of the autoscaler annotations are surfaced when defaulting replicas.
+func validateReplicaLimits(specPath *field.Path, replicas, minReplicas, maxReplicas *int32, annotations map[string]string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if minReplicas != nil && maxReplicas != nil && *minReplicas > *maxReplicas {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("minReplicas"), *minReplicas, "must be less than or equal to spec.maxReplicas"))
+	}
+
+	if replicas != nil {
+		if minReplicas != nil && *replicas < *minReplicas {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("replicas"), *replicas, fmt.Sprintf("must be greater than or equal to spec.minReplicas (%d)", *minReplicas)))
+		}
+		if maxReplicas != nil && *replicas > *maxReplicas {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("replicas"), *replicas, fmt.Sprintf("must be less than or equal to spec.maxReplicas (%d)", *maxReplicas)))
+		}
+	}
+
+	annotationsPath := field.NewPath("metadata", "annotations")
+	if minSizeString, ok := annotations[clusterv1.AutoscalerMinSizeAnnotation]; ok && minReplicas != nil {
+		if minSize, err := strconv.ParseInt(minSizeString, 10, 32); err == nil && int32(minSize) < *minReplicas {
+			allErrs = append(allErrs, field.Invalid(annotationsPath.Key(clusterv1.AutoscalerMinSizeAnnotation), minSizeString, fmt.Sprintf("must be greater than or equal to spec.minReplicas (%d)", *minReplicas)))
+		}
+	}
+	if maxSizeString, ok := annotations[clusterv1.AutoscalerMaxSizeAnnotation]; ok && maxReplicas != nil {
+		if maxSize, err := strconv.ParseInt(maxSizeString, 10, 32); err == nil && int32(maxSize) > *maxReplicas {
+			allErrs = append(allErrs, field.Invalid(annotationsPath.Key(clusterv1.AutoscalerMaxSizeAnnotation), maxSizeString, fmt.Sprintf("must be less than or equal to spec.maxReplicas (%d)", *maxReplicas)))
 		}
 	}
 
 	return allErrs
 }
 
+// replicasWithinLimits returns the defaulted value of replicas limited to the (minReplicas, maxReplicas) range.
+func replicasWithinLimits(replicas int32, minReplicas, maxReplicas *int32) int32 {
+	if minReplicas != nil && replicas < *minReplicas {
+		replicas = *minReplicas
+	}
+	if maxReplicas != nil && replicas > *maxReplicas {
+		replicas = *maxReplicas
+	}
+	return replicas
+}
+
 // calculateMachineDeploymentReplicas calculates the default value of the replicas field.
 // The value will be calculated based on the following logic:
 // * if replicas is already set on newMD, keep the current value
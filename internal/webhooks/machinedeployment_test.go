@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -365,12 +366,16 @@ func TestMachineDeploymentValidation(t *testing.T) {
 		name          string
 		md            *clusterv1.MachineDeployment
 		mdName        string
+		mdLabels      map[string]string
 		selectors     map[string]string
 		labels        map[string]string
 		strategy      clusterv1.MachineDeploymentRolloutStrategy
 		remediation   clusterv1.MachineDeploymentRemediationSpec
+		readyTimeout  *int32
+		rollback      clusterv1.MachineDeploymentRolloutRollbackSpec
 		expectErr     bool
 		machineNaming clusterv1.MachineNamingSpec
+		powerSchedule clusterv1.MachineDeploymentPowerStateScheduleSpec
 	}{
 		{
 			name:      "pass with name of under 63 characters",
@@ -467,6 +472,36 @@ func TestMachineDeploymentValidation(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name:      "should return error for automatic rollback without machineSetReadyTimeoutSeconds",
+			selectors: map[string]string{"foo": "bar"},
+			labels:    map[string]string{"foo": "bar"},
+			rollback: clusterv1.MachineDeploymentRolloutRollbackSpec{
+				Policy: clusterv1.AutomaticMachineDeploymentRollbackPolicy,
+			},
+			expectErr: true,
+		},
+		{
+			name:         "should not return error for automatic rollback with machineSetReadyTimeoutSeconds",
+			selectors:    map[string]string{"foo": "bar"},
+			labels:       map[string]string{"foo": "bar"},
+			readyTimeout: ptr.To[int32](600),
+			rollback: clusterv1.MachineDeploymentRolloutRollbackSpec{
+				Policy: clusterv1.AutomaticMachineDeploymentRollbackPolicy,
+			},
+			expectErr: false,
+		},
+		{
+			name:         "should return error for automatic rollback on a MachineDeployment managed by a ClusterClass",
+			mdLabels:     map[string]string{clusterv1.ClusterTopologyOwnedLabel: ""},
+			selectors:    map[string]string{"foo": "bar"},
+			labels:       map[string]string{"foo": "bar"},
+			readyTimeout: ptr.To[int32](600),
+			rollback: clusterv1.MachineDeploymentRolloutRollbackSpec{
+				Policy: clusterv1.AutomaticMachineDeploymentRollbackPolicy,
+			},
+			expectErr: true,
+		},
 		{
 			name:      "should not return error for valid percentage remediation maxInFlight",
 			selectors: map[string]string{"foo": "bar"},
@@ -532,6 +567,29 @@ func TestMachineDeploymentValidation(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "should return error when MachineNamingSpec does not follow DNS1123Subdomain rules only with a predecessor",
+			machineNaming: clusterv1.MachineNamingSpec{
+				Template: "{{ .random }}{{ if .predecessor.name }}-{{ .predecessor.name }}-{{ end }}",
+			},
+			expectErr: true,
+		},
+		{
+			name: "should not return error for a valid power state schedule time zone",
+			powerSchedule: clusterv1.MachineDeploymentPowerStateScheduleSpec{
+				TimeZone:       "Europe/Rome",
+				StoppedWindows: []clusterv1.MachinePowerStateWindow{{Start: "20:00", End: "07:00"}},
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error for an invalid power state schedule time zone",
+			powerSchedule: clusterv1.MachineDeploymentPowerStateScheduleSpec{
+				TimeZone:       "Not/AZone",
+				StoppedWindows: []clusterv1.MachinePowerStateWindow{{Start: "20:00", End: "07:00"}},
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -539,11 +597,14 @@ func TestMachineDeploymentValidation(t *testing.T) {
 			g := NewWithT(t)
 			md := &clusterv1.MachineDeployment{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: tt.mdName,
+					Name:   tt.mdName,
+					Labels: tt.mdLabels,
 				},
 				Spec: clusterv1.MachineDeploymentSpec{
 					Rollout: clusterv1.MachineDeploymentRolloutSpec{
-						Strategy: tt.strategy,
+						Strategy:                      tt.strategy,
+						MachineSetReadyTimeoutSeconds: tt.readyTimeout,
+						Rollback:                      tt.rollback,
 					},
 					Selector: metav1.LabelSelector{
 						MatchLabels: tt.selectors,
@@ -558,8 +619,9 @@ func TestMachineDeploymentValidation(t *testing.T) {
 							},
 						},
 					},
-					Remediation:   tt.remediation,
-					MachineNaming: tt.machineNaming,
+					Remediation:        tt.remediation,
+					MachineNaming:      tt.machineNaming,
+					PowerStateSchedule: tt.powerSchedule,
 				},
 			}
 
@@ -948,3 +1010,191 @@ func TestMachineDeploymentTaintValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestMachineDeploymentNodeReadinessChecksValidation(t *testing.T) {
+	validChecks := []clusterv1.MachineNodeReadinessCheck{
+		{Name: "gpu", Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "gpu-driver"}}},
+	}
+	invalidChecks := []clusterv1.MachineNodeReadinessCheck{
+		{Name: "gpu", Selector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Invalid"}}}},
+	}
+
+	tests := []struct {
+		name           string
+		rolloutChecks  []clusterv1.MachineNodeReadinessCheck
+		templateChecks []clusterv1.MachineNodeReadinessCheck
+		expectErr      bool
+	}{
+		{
+			name:          "should succeed with valid checks in spec.rollout",
+			rolloutChecks: validChecks,
+			expectErr:     false,
+		},
+		{
+			name:           "should succeed with valid checks in spec.template.spec",
+			templateChecks: validChecks,
+			expectErr:      false,
+		},
+		{
+			name:          "should return error with an invalid selector in spec.rollout",
+			rolloutChecks: invalidChecks,
+			expectErr:     true,
+		},
+		{
+			name:           "should return error with an invalid selector in spec.template.spec",
+			templateChecks: invalidChecks,
+			expectErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			md := builder.MachineDeployment("default", "md").
+				WithBootstrapTemplate(builder.BootstrapTemplate("default", "bootstrap-template").Build()).
+				Build()
+			md.Spec.Rollout.NodeReadinessChecks = tt.rolloutChecks
+			md.Spec.Template.Spec.NodeReadinessChecks = tt.templateChecks
+			webhook := &MachineDeployment{}
+
+			warnings, err := webhook.ValidateCreate(ctx, md)
+			g.Expect(err != nil).To(Equal(tt.expectErr))
+			g.Expect(warnings).To(BeEmpty())
+			warnings, err = webhook.ValidateUpdate(ctx, md, md)
+			g.Expect(err != nil).To(Equal(tt.expectErr))
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
+
+func TestValidateReplicaLimits(t *testing.T) {
+	tests := []struct {
+		name        string
+		replicas    *int32
+		minReplicas *int32
+		maxReplicas *int32
+		annotations map[string]string
+		expectErrs  int
+	}{
+		{
+			name:       "pass without limits",
+			replicas:   ptr.To[int32](100),
+			expectErrs: 0,
+		},
+		{
+			name:        "pass with replicas within limits",
+			replicas:    ptr.To[int32](3),
+			minReplicas: ptr.To[int32](1),
+			maxReplicas: ptr.To[int32](5),
+			expectErrs:  0,
+		},
+		{
+			name:        "pass with replicas equal to the limits",
+			replicas:    ptr.To[int32](3),
+			minReplicas: ptr.To[int32](3),
+			maxReplicas: ptr.To[int32](3),
+			expectErrs:  0,
+		},
+		{
+			name:        "error if minReplicas is greater than maxReplicas",
+			replicas:    ptr.To[int32](3),
+			minReplicas: ptr.To[int32](4),
+			maxReplicas: ptr.To[int32](2),
+			// replicas is also outside of both the limits.
+			expectErrs: 3,
+		},
+		{
+			name:        "error if replicas is lower than minReplicas",
+			replicas:    ptr.To[int32](0),
+			minReplicas: ptr.To[int32](1),
+			expectErrs:  1,
+		},
+		{
+			name:        "error if replicas is greater than maxReplicas",
+			replicas:    ptr.To[int32](6),
+			maxReplicas: ptr.To[int32](5),
+			expectErrs:  1,
+		},
+		{
+			name:        "pass with autoscaler annotations within limits",
+			replicas:    ptr.To[int32](3),
+			minReplicas: ptr.To[int32](1),
+			maxReplicas: ptr.To[int32](5),
+			annotations: map[string]string{
+				clusterv1.AutoscalerMinSizeAnnotation: "1",
+				clusterv1.AutoscalerMaxSizeAnnotation: "5",
+			},
+			expectErrs: 0,
+		},
+		{
+			name:        "error with autoscaler annotations outside of limits",
+			replicas:    ptr.To[int32](3),
+			minReplicas: ptr.To[int32](1),
+			maxReplicas: ptr.To[int32](5),
+			annotations: map[string]string{
+				clusterv1.AutoscalerMinSizeAnnotation: "0",
+				clusterv1.AutoscalerMaxSizeAnnotation: "10",
+			},
+			expectErrs: 2,
+		},
+		{
+			name:     "pass with autoscaler annotations without limits",
+			replicas: ptr.To[int32](3),
+			annotations: map[string]string{
+				clusterv1.AutoscalerMinSizeAnnotation: "0",
+				clusterv1.AutoscalerMaxSizeAnnotation: "10",
+			},
+			expectErrs: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			errs := validateReplicaLimits(field.NewPath("spec"), tt.replicas, tt.minReplicas, tt.maxReplicas, tt.annotations)
+			g.Expect(errs).To(HaveLen(tt.expectErrs))
+		})
+	}
+}
+
+func TestReplicasWithinLimits(t *testing.T) {
+	tests := []struct {
+		name        string
+		replicas    int32
+		minReplicas *int32
+		maxReplicas *int32
+		expected    int32
+	}{
+		{
+			name:     "keep replicas without limits",
+			replicas: 1,
+			expected: 1,
+		},
+		{
+			name:        "keep replicas within limits",
+			replicas:    3,
+			minReplicas: ptr.To[int32](1),
+			maxReplicas: ptr.To[int32](5),
+			expected:    3,
+		},
+		{
+			name:        "use minReplicas if replicas is lower",
+			replicas:    1,
+			minReplicas: ptr.To[int32](3),
+			expected:    3,
+		},
+		{
+			name:        "use maxReplicas if replicas is greater",
+			replicas:    1,
+			maxReplicas: ptr.To[int32](0),
+			expected:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(replicasWithinLimits(tt.replicas, tt.minReplicas, tt.maxReplicas)).To(Equal(tt.expected))
+		})
+	}
+}
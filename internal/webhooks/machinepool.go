@@ -92,6 +92,9 @@ func (webhook *MachinePool) Default(ctx context.Context, obj runtime.Object) err
 	if err != nil {
 		return err
 	}
+	if m.Spec.Replicas == nil {
+		replicas = replicasWithinLimits(replicas, m.Spec.MinReplicas, m.Spec.MaxReplicas)
+	}
 
 	m.Spec.Replicas = ptr.To[int32](replicas)
 
@@ -196,6 +199,8 @@ func (webhook *MachinePool) validate(oldObj, newObj *clusterv1.MachinePool) erro
 		}
 	}
 
+	allErrs = append(allErrs, validateReplicaLimits(specPath, newObj.Spec.Replicas, newObj.Spec.MinReplicas, newObj.Spec.MaxReplicas, newObj.Annotations)...)
+
 	// Validate the metadata of the MachinePool template.
 	allErrs = append(allErrs, newObj.Spec.Template.Validate(specPath.Child("template", "metadata"))...)
 
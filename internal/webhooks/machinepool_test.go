@@ -59,6 +59,38 @@ func TestMachinePoolDefault(t *testing.T) {
 	g.Expect(*mp.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds).To(Equal(defaultNodeDeletionTimeoutSeconds))
 }
 
+func TestMachinePoolDefaultReplicasWithinLimits(t *testing.T) {
+	g := NewWithT(t)
+
+	mp := &clusterv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foobar",
+		},
+		Spec: clusterv1.MachinePoolSpec{
+			MinReplicas: ptr.To[int32](3),
+			MaxReplicas: ptr.To[int32](5),
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{ConfigRef: clusterv1.ContractVersionedObjectReference{
+						Name: "bootstrap",
+					}},
+				},
+			},
+		},
+	}
+	webhook := &MachinePool{}
+	ctx = admission.NewContextWithRequest(ctx, admission.Request{})
+	g.Expect(webhook.Default(ctx, mp)).To(Succeed())
+	g.Expect(mp.Spec.Replicas).To(Equal(ptr.To[int32](3)))
+
+	// Explicitly set replicas are not changed, so they can be rejected by the validation.
+	mp.Spec.Replicas = ptr.To[int32](10)
+	g.Expect(webhook.Default(ctx, mp)).To(Succeed())
+	g.Expect(mp.Spec.Replicas).To(Equal(ptr.To[int32](10)))
+	_, err := webhook.ValidateCreate(ctx, mp)
+	g.Expect(err).To(HaveOccurred())
+}
+
 func TestCalculateMachinePoolReplicas(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -28,8 +28,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
-	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -62,6 +62,14 @@ func (webhook *MachineSet) SetupWebhookWithManager(mgr ctrl.Manager) error {
 // MachineSet implements a validation and defaulting webhook for MachineSet.
 type MachineSet struct {
 	decoder admission.Decoder
+
+	// Client is used to read the Cluster and its control plane when validating the version skew.
+	// If it is not set, the version skew validation is skipped.
+	Client client.Reader
+
+	// VersionSkewValidationPolicy defines how versions that do not conform to the
+	// Kubernetes version skew policy relative to the control plane are handled.
+	VersionSkewValidationPolicy VersionSkewValidationPolicy
 }
 
 var _ webhook.CustomDefaulter = &MachineSet{}
@@ -126,17 +134,20 @@ func (webhook *MachineSet) Default(ctx context.Context, obj runtime.Object) erro
 }
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
-func (webhook *MachineSet) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (webhook *MachineSet) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	m, ok := obj.(*clusterv1.MachineSet)
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a MachineSet but got a %T", obj))
 	}
 
-	return nil, webhook.validate(nil, m)
+	if err := webhook.validate(nil, m); err != nil {
+		return nil, err
+	}
+	return webhook.validateVersionSkew(ctx, nil, m)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (webhook *MachineSet) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+func (webhook *MachineSet) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	oldMS, ok := oldObj.(*clusterv1.MachineSet)
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a MachineSet but got a %T", oldObj))
@@ -146,7 +157,10 @@ func (webhook *MachineSet) ValidateUpdate(_ context.Context, oldObj, newObj runt
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a MachineSet but got a %T", newObj))
 	}
 
-	return nil, webhook.validate(oldMS, newMS)
+	if err := webhook.validate(oldMS, newMS); err != nil {
+		return nil, err
+	}
+	return webhook.validateVersionSkew(ctx, oldMS, newMS)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -231,8 +245,12 @@ func (webhook *MachineSet) validate(oldMS, newMS *clusterv1.MachineSet) error {
 
 	allErrs = append(allErrs, validateMachineTaints(newMS.Spec.Template.Spec.Taints, specPath.Child("template", "spec", "taints"))...)
 	allErrs = append(allErrs, validateMachineTaintsForWorkers(newMS.Spec.Template.Spec.Taints, nil, specPath.Child("template", "spec", "taints"))...)
+	allErrs = append(allErrs, validateMachineInfrastructureOverrides(newMS.Spec.Template.Spec.InfrastructureOverrides, specPath.Child("template", "spec", "infrastructureOverrides"))...)
+	allErrs = append(allErrs, validateMachineNodeReadinessChecks(newMS.Spec.Template.Spec.NodeReadinessChecks, specPath.Child("template", "spec", "nodeReadinessChecks"))...)
 
 	allErrs = append(allErrs, validateMSMachineNaming(newMS.Spec.MachineNaming, specPath.Child("machineNaming"))...)
+	allErrs = append(allErrs, validateRebalanceMaxUnavailable(specPath.Child("rebalance"), newMS.Spec.Rebalance.MaxUnavailable)...)
+	allErrs = append(allErrs, validateStandbyReplicas(specPath.Child("standby"), newMS.Spec.Standby.Replicas)...)
 
 	// Validate the metadata of the template.
 	allErrs = append(allErrs, newMS.Spec.Template.Validate(specPath.Child("template", "metadata"))...)
@@ -244,6 +262,26 @@ func (webhook *MachineSet) validate(oldMS, newMS *clusterv1.MachineSet) error {
 	return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("MachineSet").GroupKind(), newMS.Name, allErrs)
 }
 
+// validateVersionSkew validates the version of a MachineSet against the version of the control plane of its Cluster.
+// MachineSets controlled by another object, e.g. a MachineDeployment, are not validated; for those the version is
+// validated on the owning object instead.
+// The validation is skipped if the KubernetesVersionSkew preflight check is skipped on the MachineSet.
+func (webhook *MachineSet) validateVersionSkew(ctx context.Context, oldMS, newMS *clusterv1.MachineSet) (admission.Warnings, error) {
+	if metav1.GetControllerOf(newMS) != nil || skipsKubernetesVersionSkewPreflightCheck(newMS) {
+		return nil, nil
+	}
+
+	var oldVersion string
+	if oldMS != nil {
+		oldVersion = oldMS.Spec.Template.Spec.Version
+	}
+	warnings, err := validateVersionSkew(ctx, webhook.Client, webhook.VersionSkewValidationPolicy, newMS.Namespace, newMS.Spec.ClusterName, oldVersion, newMS.Spec.Template.Spec.Version, field.NewPath("spec", "template", "spec", "version"))
+	if err != nil {
+		return nil, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("MachineSet").GroupKind(), newMS.Name, field.ErrorList{err})
+	}
+	return warnings, nil
+}
+
 func validateMSMachineNaming(machineNaming clusterv1.MachineNamingSpec, pathPrefix *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -256,24 +294,9 @@ func validateMSMachineNaming(machineNaming clusterv1.MachineNamingSpec, pathPref
 					"invalid template, {{ .random }} is missing",
 				))
 		}
-		name, err := topologynames.MachineSetMachineNameGenerator(machineNaming.Template, "cluster", "machineset").GenerateName()
-		if err != nil {
-			allErrs = append(allErrs,
-				field.Invalid(
-					pathPrefix.Child("template"),
-					machineNaming.Template,
-					fmt.Sprintf("invalid template: %v", err),
-				))
-		} else {
-			for _, err := range validation.IsDNS1123Subdomain(name) {
-				allErrs = append(allErrs,
-					field.Invalid(
-						pathPrefix.Child("template"),
-						machineNaming.Template,
-						fmt.Sprintf("invalid template, generated names would not be valid Kubernetes object names: %v", err),
-					))
-			}
-		}
+		allErrs = append(allErrs, topologynames.ValidateMachineNameTemplate(machineNaming.Template, func(predecessorName string) topologynames.NameGenerator {
+			return topologynames.MachineSetMachineNameGenerator(machineNaming.Template, "cluster", "machineset", predecessorName)
+		}, pathPrefix.Child("template"))...)
 	}
 
 	return allErrs
@@ -409,3 +432,31 @@ func calculateMachineSetReplicas(ctx context.Context, oldMS *clusterv1.MachineSe
 	}
 	return 1, nil
 }
+
+func validateRebalanceMaxUnavailable(fldPath *field.Path, maxUnavailable *intstr.IntOrString) field.ErrorList {
+	var allErrs field.ErrorList
+	if maxUnavailable != nil {
+		// Note: total and roundUp parameters don't matter for validation.
+		value, err := intstr.GetScaledValueFromIntOrPercent(maxUnavailable, 100, false)
+		if err != nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(fldPath.Child("maxUnavailable"), maxUnavailable.String(), fmt.Sprintf("must be either an int or a percentage: %v", err.Error())),
+			)
+		} else if value < 0 {
+			allErrs = append(
+				allErrs,
+				field.Invalid(fldPath.Child("maxUnavailable"), maxUnavailable.String(), "must be greater than or equal to 0"),
+			)
+		}
+	}
+	return allErrs
+}
+
+func validateStandbyReplicas(fldPath *field.Path, replicas *int32) field.ErrorList {
+	var allErrs field.ErrorList
+	if replicas != nil && !feature.Gates.Enabled(feature.MachineSetStandby) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "standby is not allowed to be set when the feature gate MachineSetStandby is disabled"))
+	}
+	return allErrs
+}
@@ -23,8 +23,10 @@ import (
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -555,6 +557,59 @@ func TestMachineSetVersionValidation(t *testing.T) {
 	}
 }
 
+func TestMachineSetInfrastructureOverridesValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      string
+		expectErr bool
+	}{
+		{
+			name:      "should succeed when spec is a JSON object",
+			spec:      `{"instanceType":"large"}`,
+			expectErr: false,
+		},
+		{
+			name:      "should return error when spec is a string",
+			spec:      `"large"`,
+			expectErr: true,
+		},
+		{
+			name:      "should return error when spec is null",
+			spec:      `null`,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ms := &clusterv1.MachineSet{
+				Spec: clusterv1.MachineSetSpec{
+					Template: clusterv1.MachineTemplateSpec{
+						Spec: clusterv1.MachineSpec{
+							Bootstrap: clusterv1.Bootstrap{
+								DataSecretName: ptr.To("data-secret"),
+							},
+							InfrastructureOverrides: []clusterv1.MachineInfrastructureOverride{
+								{FailureDomain: "zone-a", Spec: apiextensionsv1.JSON{Raw: []byte(tt.spec)}},
+							},
+						},
+					},
+				},
+			}
+			webhook := &MachineSet{}
+
+			warnings, err := webhook.ValidateCreate(ctx, ms)
+			g.Expect(err != nil).To(Equal(tt.expectErr))
+			g.Expect(warnings).To(BeEmpty())
+			warnings, err = webhook.ValidateUpdate(ctx, ms, ms)
+			g.Expect(err != nil).To(Equal(tt.expectErr))
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
+
 func TestValidateSkippedMachineSetPreflightChecks(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -708,6 +763,20 @@ func TestMachineSetMachineNamingValidation(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "should not return error when MachineNamingSpec uses the predecessor name",
+			machineNaming: clusterv1.MachineNamingSpec{
+				Template: "{{ if .predecessor.name }}{{ .predecessor.name }}-r{{ else }}{{ .machineSet.name }}{{ end }}-{{ .random }}",
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error when MachineNamingSpec does not follow DNS1123Subdomain rules only with a predecessor",
+			machineNaming: clusterv1.MachineNamingSpec{
+				Template: "{{ .random }}{{ if .predecessor.name }}-{{ .predecessor.name }}-{{ end }}",
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -747,6 +816,131 @@ func TestMachineSetMachineNamingValidation(t *testing.T) {
 	}
 }
 
+func TestMachineSetRebalanceValidation(t *testing.T) {
+	tests := []struct {
+		name           string
+		maxUnavailable *intstr.IntOrString
+		expectErr      bool
+	}{
+		{
+			name:           "should not return error when maxUnavailable is not set",
+			maxUnavailable: nil,
+			expectErr:      false,
+		},
+		{
+			name:           "should not return error when maxUnavailable is a positive integer",
+			maxUnavailable: ptr.To(intstr.FromInt32(2)),
+			expectErr:      false,
+		},
+		{
+			name:           "should not return error when maxUnavailable is a percentage",
+			maxUnavailable: ptr.To(intstr.FromString("20%")),
+			expectErr:      false,
+		},
+		{
+			name:           "should return error when maxUnavailable is negative",
+			maxUnavailable: ptr.To(intstr.FromInt32(-1)),
+			expectErr:      true,
+		},
+		{
+			name:           "should return error when maxUnavailable is not a valid percentage",
+			maxUnavailable: ptr.To(intstr.FromString("foo")),
+			expectErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ms := &clusterv1.MachineSet{
+				Spec: clusterv1.MachineSetSpec{
+					Rebalance: clusterv1.MachineSetRebalanceSpec{
+						Policy:         clusterv1.AutomaticMachineSetRebalancePolicy,
+						MaxUnavailable: tt.maxUnavailable,
+					},
+					Template: clusterv1.MachineTemplateSpec{
+						Spec: clusterv1.MachineSpec{
+							Bootstrap: clusterv1.Bootstrap{
+								DataSecretName: ptr.To("data-secret"),
+							},
+						},
+					},
+				},
+			}
+
+			webhook := &MachineSet{}
+
+			warnings, err := webhook.ValidateCreate(ctx, ms)
+			g.Expect(warnings).To(BeEmpty())
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestMachineSetStandbyValidation(t *testing.T) {
+	tests := []struct {
+		name           string
+		replicas       *int32
+		featureEnabled bool
+		expectErr      bool
+	}{
+		{
+			name:           "should not return error when standby is not set with feature gate disabled",
+			featureEnabled: false,
+			expectErr:      false,
+		},
+		{
+			name:           "should not return error when standby is set with feature gate enabled",
+			replicas:       ptr.To[int32](2),
+			featureEnabled: true,
+			expectErr:      false,
+		},
+		{
+			name:           "should return error when standby is set with feature gate disabled",
+			replicas:       ptr.To[int32](2),
+			featureEnabled: false,
+			expectErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachineSetStandby, tt.featureEnabled)
+
+			ms := &clusterv1.MachineSet{
+				Spec: clusterv1.MachineSetSpec{
+					Standby: clusterv1.MachineSetStandbySpec{
+						Replicas: tt.replicas,
+					},
+					Template: clusterv1.MachineTemplateSpec{
+						Spec: clusterv1.MachineSpec{
+							Bootstrap: clusterv1.Bootstrap{
+								DataSecretName: ptr.To("data-secret"),
+							},
+						},
+					},
+				},
+			}
+
+			webhook := &MachineSet{}
+
+			warnings, err := webhook.ValidateCreate(ctx, ms)
+			g.Expect(warnings).To(BeEmpty())
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
 func TestMachineSetTaintValidation(t *testing.T) {
 	ms := builder.MachineSet("default", "machineset1").
 		WithBootstrapTemplate(builder.BootstrapTemplate("default", "bootstrap-template").Build())
@@ -786,6 +980,14 @@ func TestMachineSetTaintValidation(t *testing.T) {
 			}).Build(),
 			expectErr: true,
 		},
+		{
+			name:           "should block taint key node.cluster.x-k8s.io/standby",
+			featureEnabled: true,
+			machineSet: ms.DeepCopy().WithTaints(clusterv1.MachineTaint{
+				Key: "node.cluster.x-k8s.io/standby", Effect: corev1.TaintEffectNoSchedule,
+			}).Build(),
+			expectErr: true,
+		},
 		{
 			name:           "should block taint with key prefix node.kubernetes.io/, which is not `out-of-service`",
 			featureEnabled: true,
@@ -32,6 +32,7 @@ import (
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/topology/patchpath"
 )
 
 // validatePatches returns errors if the Patches in the ClusterClass violate any validation rules.
@@ -471,6 +472,10 @@ var builtinVariables = sets.Set[string]{}.Insert(
 	"builtin.cluster.uid",
 	"builtin.cluster.metadata.labels",
 	"builtin.cluster.metadata.annotations",
+	"builtin.cluster.failureDomains",
+	"builtin.cluster.controlPlaneEndpoint",
+	"builtin.cluster.controlPlaneEndpoint.host",
+	"builtin.cluster.controlPlaneEndpoint.port",
 
 	// ClusterTopology builtins.
 	"builtin.cluster.topology",
@@ -479,6 +484,7 @@ var builtinVariables = sets.Set[string]{}.Insert(
 	"builtin.cluster.topology.class",
 	"builtin.cluster.topology.classNamespace",
 	"builtin.cluster.topology.version",
+	"builtin.cluster.topology.failureDomains",
 
 	// ClusterNetwork builtins
 	"builtin.cluster.network",
@@ -523,14 +529,36 @@ var builtinVariables = sets.Set[string]{}.Insert(
 	"builtin.machinePool.infrastructureRef.name",
 )
 
-// validateIndexAccess checks to see if the jsonPath is attempting to add an element in the array i.e. access by number
+// validateIndexAccess checks to see if the jsonPath is attempting to add an element in the array i.e. access by number,
+// and that list item selectors in the jsonPath are valid.
 // If the operation is add an error is thrown if a number greater than 0 is used as an index.
 // If the operation is replace an error is thrown if an index is used.
 func validateIndexAccess(jsonPatch clusterv1.JSONPatch, path *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
-	pathParts := strings.Split(jsonPatch.Path, "/")
-	for _, part := range pathParts {
+	pathParts := patchpath.Split(jsonPatch.Path)
+	for i, part := range pathParts {
+		// Check if the path segment is a valid list item selector.
+		if patchpath.IsListItemSelector(part) {
+			if _, _, err := patchpath.ParseListItemSelector(part); err != nil {
+				allErrs = append(allErrs,
+					field.Invalid(path,
+						jsonPatch.Path,
+						err.Error(),
+					))
+			}
+
+			// If the operation is add, list item selectors can only be used to select the item to add a field to.
+			if jsonPatch.Op == "add" && i == len(pathParts)-1 {
+				allErrs = append(allErrs,
+					field.Invalid(path,
+						jsonPatch.Path,
+						"list item selectors can not be used as the last element of the path in an add operation",
+					))
+			}
+			continue
+		}
+
 		// Check if the path segment is a valid number. If an error is thrown continue to the next segment.
 		index, err := strconv.Atoi(part)
 		if err != nil {
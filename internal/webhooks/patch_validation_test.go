@@ -633,6 +633,258 @@ func TestValidatePatches(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "pass if jsonPatch path uses a list item selector for replace",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name: "patch1",
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "replace",
+											Path: "/spec/template/spec/kubeadmConfigSpec/files/[path=/etc/hosts]/content",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Variable: "variableName",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Variables: []clusterv1.ClusterClassVariable{
+						{
+							Name:     "variableName",
+							Required: ptr.To(true),
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "string",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass if jsonPatch path uses a list item selector for remove",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name: "patch1",
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "remove",
+											Path: "/spec/template/spec/kubeadmConfigSpec/users/[name=foo]",
+										},
+									},
+								},
+							},
+						},
+					},
+					Variables: []clusterv1.ClusterClassVariable{
+						{
+							Name:     "variableName",
+							Required: ptr.To(true),
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "string",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass if jsonPatch path uses a list item selector for add of a field of a list item",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name: "patch1",
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "add",
+											Path: "/spec/template/spec/kubeadmConfigSpec/files/[path=/etc/hosts]/owner",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Variable: "variableName",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Variables: []clusterv1.ClusterClassVariable{
+						{
+							Name:     "variableName",
+							Required: ptr.To(true),
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "string",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error if jsonPatch path uses a list item selector as last element for add",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name: "patch1",
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "add",
+											Path: "/spec/template/spec/kubeadmConfigSpec/files/[path=/etc/hosts]",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Variable: "variableName",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Variables: []clusterv1.ClusterClassVariable{
+						{
+							Name:     "variableName",
+							Required: ptr.To(true),
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "string",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error if jsonPatch path uses an invalid list item selector",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name: "patch1",
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "replace",
+											Path: "/spec/template/spec/kubeadmConfigSpec/files/[path]/content",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Variable: "variableName",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Variables: []clusterv1.ClusterClassVariable{
+						{
+							Name:     "variableName",
+							Required: ptr.To(true),
+							Schema: clusterv1.VariableSchema{
+								OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+									Type: "string",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 
 		// Patch Value/ValueFrom validation
 		{
@@ -1312,6 +1564,126 @@ func TestValidatePatches(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "pass if jsonPatch uses the builtin.cluster.failureDomains variable",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name: "patch1",
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "add",
+											Path: "/spec/template/spec/",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Variable: "builtin.cluster.failureDomains",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass if jsonPatch uses the builtin.cluster.controlPlaneEndpoint.host variable",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name: "patch1",
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "add",
+											Path: "/spec/template/spec/",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Variable: "builtin.cluster.controlPlaneEndpoint.host",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass if jsonPatch uses the builtin.cluster.topology.failureDomains variable",
+			clusterClass: clusterv1.ClusterClass{
+				Spec: clusterv1.ClusterClassSpec{
+					ControlPlane: clusterv1.ControlPlaneClass{
+						TemplateRef: clusterv1.ClusterClassTemplateReference{
+							APIVersion: clusterv1.GroupVersionControlPlane.String(),
+							Kind:       "ControlPlaneTemplate",
+						},
+					},
+
+					Patches: []clusterv1.ClusterClassPatch{
+						{
+							Name: "patch1",
+							Definitions: []clusterv1.PatchDefinition{
+								{
+									Selector: clusterv1.PatchSelector{
+										APIVersion: clusterv1.GroupVersionControlPlane.String(),
+										Kind:       "ControlPlaneTemplate",
+										MatchResources: clusterv1.PatchSelectorMatch{
+											ControlPlane: ptr.To(true),
+										},
+									},
+									JSONPatches: []clusterv1.JSONPatch{
+										{
+											Op:   "add",
+											Path: "/spec/template/spec/",
+											ValueFrom: &clusterv1.JSONPatchValue{
+												Variable: "builtin.cluster.topology.failureDomains",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 
 		// Patch with External
 		{
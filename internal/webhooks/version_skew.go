@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/external"
+	"sigs.k8s.io/cluster-api/internal/contract"
+)
+
+// VersionSkewValidationPolicy defines how the webhooks for Machine, MachineSet and MachineDeployment
+// handle versions that do not conform to the Kubernetes version skew policy relative to the control plane.
+type VersionSkewValidationPolicy string
+
+const (
+	// VersionSkewValidationPolicyEnforce rejects versions that do not conform to the Kubernetes version skew policy.
+	VersionSkewValidationPolicyEnforce VersionSkewValidationPolicy = "Enforce"
+
+	// VersionSkewValidationPolicyWarn accepts versions that do not conform to the Kubernetes version skew policy,
+	// but returns a warning to the client.
+	VersionSkewValidationPolicyWarn VersionSkewValidationPolicy = "Warn"
+
+	// VersionSkewValidationPolicyIgnore disables the version skew validation.
+	VersionSkewValidationPolicyIgnore VersionSkewValidationPolicy = "Ignore"
+)
+
+// maxKubeletMinorSkew is the maximum number of minor versions a kubelet is allowed to be older than the control plane.
+// Kubernetes skew policy: https://kubernetes.io/releases/version-skew-policy/#kubelet
+const maxKubeletMinorSkew = uint64(3)
+
+// validateVersionSkew validates that version conforms to the Kubernetes version skew policy relative to
+// the version of the control plane of the Cluster with the given name.
+// The validation is skipped if the version did not change, if the Cluster or its control plane do not exist yet,
+// or if the control plane does not report a version.
+// Depending on the policy, violations are returned either as warnings or as errors.
+func validateVersionSkew(ctx context.Context, c client.Reader, policy VersionSkewValidationPolicy, namespace, clusterName, oldVersion, newVersion string, fldPath *field.Path) (admission.Warnings, *field.Error) {
+	if c == nil || (policy != VersionSkewValidationPolicyEnforce && policy != VersionSkewValidationPolicyWarn) {
+		return nil, nil
+	}
+	if newVersion == "" || newVersion == oldVersion {
+		return nil, nil
+	}
+
+	newSemver, err := semver.ParseTolerant(newVersion)
+	if err != nil {
+		// Invalid versions are reported by the validation of the version field.
+		return nil, nil
+	}
+
+	cpVersion, err := getControlPlaneVersion(ctx, c, namespace, clusterName)
+	if err != nil {
+		if policy == VersionSkewValidationPolicyWarn {
+			return admission.Warnings{fmt.Sprintf("%s: unable to validate version %s against the Kubernetes version skew policy: %v", fldPath, newVersion, err)}, nil
+		}
+		return nil, field.InternalError(fldPath, errors.Wrapf(err, "failed to validate version %s against the Kubernetes version skew policy", newVersion))
+	}
+	if cpVersion == nil {
+		return nil, nil
+	}
+
+	msg := kubeletVersionSkewViolation(*cpVersion, newSemver)
+	if msg == "" {
+		return nil, nil
+	}
+
+	if policy == VersionSkewValidationPolicyWarn {
+		return admission.Warnings{fmt.Sprintf("%s: %s", fldPath, msg)}, nil
+	}
+	return nil, field.Forbidden(fldPath, msg)
+}
+
+// getControlPlaneVersion returns the version of the control plane of a Cluster.
+// It returns nil if the Cluster or the control plane do not exist, if the Cluster does not have a
+// control plane reference or if the control plane does not report a version.
+func getControlPlaneVersion(ctx context.Context, c client.Reader, namespace, clusterName string) (*semver.Version, error) {
+	cluster := &clusterv1.Cluster{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: clusterName}, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get Cluster %s", clusterName)
+	}
+
+	if !cluster.Spec.ControlPlaneRef.IsDefined() {
+		return nil, nil
+	}
+
+	controlPlane, err := external.GetObjectFromContractVersionedRef(ctx, c, cluster.Spec.ControlPlaneRef, cluster.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get control plane %s", cluster.Spec.ControlPlaneRef.Name)
+	}
+
+	cpVersion, err := contract.ControlPlane().Version().Get(controlPlane)
+	if err != nil {
+		if errors.Is(err, contract.ErrFieldNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get version of control plane %s", cluster.Spec.ControlPlaneRef.Name)
+	}
+
+	cpSemver, err := semver.ParseTolerant(*cpVersion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse version %s of control plane %s", *cpVersion, cluster.Spec.ControlPlaneRef.Name)
+	}
+	return &cpSemver, nil
+}
+
+// kubeletVersionSkewViolation returns a message describing why the kubelet version does not conform to the
+// Kubernetes version skew policy relative to the control plane version, or an empty string if it conforms.
+func kubeletVersionSkewViolation(cpSemver, kubeletSemver semver.Version) string {
+	if kubeletSemver.Major != cpSemver.Major {
+		return fmt.Sprintf("version %s does not conform to the Kubernetes version skew policy as its major version differs from the control plane version %s", kubeletSemver, cpSemver)
+	}
+	if kubeletSemver.Minor > cpSemver.Minor {
+		return fmt.Sprintf("version %s does not conform to the Kubernetes version skew policy as it is higher than the control plane version %s", kubeletSemver, cpSemver)
+	}
+	if cpSemver.Minor > maxKubeletMinorSkew && kubeletSemver.Minor < cpSemver.Minor-maxKubeletMinorSkew {
+		return fmt.Sprintf("version %s does not conform to the Kubernetes version skew policy as it is more than %d minor versions older than the control plane version %s", kubeletSemver, maxKubeletMinorSkew, cpSemver)
+	}
+	return ""
+}
+
+// skipsKubernetesVersionSkewPreflightCheck returns true if the Kubernetes version skew preflight check
+// is skipped via the MachineSetSkipPreflightChecksAnnotation annotation on the given object.
+func skipsKubernetesVersionSkewPreflightCheck(o client.Object) bool {
+	skip := o.GetAnnotations()[clusterv1.MachineSetSkipPreflightChecksAnnotation]
+	if skip == "" {
+		return false
+	}
+	for _, skipped := range strings.Split(skip, ",") {
+		switch clusterv1.MachineSetPreflightCheck(strings.TrimSpace(skipped)) {
+		case clusterv1.MachineSetPreflightCheckAll, clusterv1.MachineSetPreflightCheckKubernetesVersionSkew:
+			return true
+		}
+	}
+	return false
+}
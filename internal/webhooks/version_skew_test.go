@@ -0,0 +1,216 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+func TestValidateVersionSkew(t *testing.T) {
+	clusterWithControlPlane := builder.Cluster(metav1.NamespaceDefault, "cluster1").
+		WithControlPlane(builder.ControlPlane(metav1.NamespaceDefault, "cp1").Build()).
+		Build()
+	controlPlane := builder.ControlPlane(metav1.NamespaceDefault, "cp1").WithVersion("v1.32.2").Build()
+
+	tests := []struct {
+		name             string
+		policy           VersionSkewValidationPolicy
+		objs             []client.Object
+		oldVersion       string
+		newVersion       string
+		expectWarnings   bool
+		expectErr        bool
+		expectErrMessage string
+	}{
+		{
+			name:       "should pass if the version is the same as the control plane version",
+			policy:     VersionSkewValidationPolicyEnforce,
+			objs:       []client.Object{builder.GenericControlPlaneCRD, clusterWithControlPlane, controlPlane},
+			newVersion: "v1.32.0",
+		},
+		{
+			name:       "should pass if the version is 3 minor versions older than the control plane version",
+			policy:     VersionSkewValidationPolicyEnforce,
+			objs:       []client.Object{builder.GenericControlPlaneCRD, clusterWithControlPlane, controlPlane},
+			newVersion: "v1.29.10",
+		},
+		{
+			name:             "should fail if the version is higher than the control plane version",
+			policy:           VersionSkewValidationPolicyEnforce,
+			objs:             []client.Object{builder.GenericControlPlaneCRD, clusterWithControlPlane, controlPlane},
+			newVersion:       "v1.33.0",
+			expectErr:        true,
+			expectErrMessage: "higher than the control plane version 1.32.2",
+		},
+		{
+			name:             "should fail if the version is more than 3 minor versions older than the control plane version",
+			policy:           VersionSkewValidationPolicyEnforce,
+			objs:             []client.Object{builder.GenericControlPlaneCRD, clusterWithControlPlane, controlPlane},
+			oldVersion:       "v1.29.0",
+			newVersion:       "v1.28.0",
+			expectErr:        true,
+			expectErrMessage: "more than 3 minor versions older than the control plane version 1.32.2",
+		},
+		{
+			name:           "should warn if the version is higher than the control plane version and policy is Warn",
+			policy:         VersionSkewValidationPolicyWarn,
+			objs:           []client.Object{builder.GenericControlPlaneCRD, clusterWithControlPlane, controlPlane},
+			newVersion:     "v1.33.0",
+			expectWarnings: true,
+		},
+		{
+			name:       "should pass if the version is higher than the control plane version and policy is Ignore",
+			policy:     VersionSkewValidationPolicyIgnore,
+			objs:       []client.Object{builder.GenericControlPlaneCRD, clusterWithControlPlane, controlPlane},
+			newVersion: "v1.33.0",
+		},
+		{
+			name:       "should pass if the version did not change",
+			policy:     VersionSkewValidationPolicyEnforce,
+			objs:       []client.Object{builder.GenericControlPlaneCRD, clusterWithControlPlane, controlPlane},
+			oldVersion: "v1.33.0",
+			newVersion: "v1.33.0",
+		},
+		{
+			name:       "should pass if the Cluster does not exist",
+			policy:     VersionSkewValidationPolicyEnforce,
+			newVersion: "v1.33.0",
+		},
+		{
+			name:       "should pass if the control plane does not exist",
+			policy:     VersionSkewValidationPolicyEnforce,
+			objs:       []client.Object{builder.GenericControlPlaneCRD, clusterWithControlPlane},
+			newVersion: "v1.33.0",
+		},
+		{
+			name:   "should pass if the control plane does not have a version",
+			policy: VersionSkewValidationPolicyEnforce,
+			objs: []client.Object{builder.GenericControlPlaneCRD, clusterWithControlPlane,
+				builder.ControlPlane(metav1.NamespaceDefault, "cp1").Build()},
+			newVersion: "v1.33.0",
+		},
+		{
+			name:   "should pass if the Cluster does not have a control plane",
+			policy: VersionSkewValidationPolicyEnforce,
+			objs: []client.Object{builder.GenericControlPlaneCRD, controlPlane,
+				builder.Cluster(metav1.NamespaceDefault, "cluster1").Build()},
+			newVersion: "v1.33.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(tt.objs...).Build()
+
+			warnings, err := validateVersionSkew(ctx, c, tt.policy, metav1.NamespaceDefault, "cluster1", tt.oldVersion, tt.newVersion, field.NewPath("spec", "version"))
+			if tt.expectErr {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Type).To(Equal(field.ErrorTypeForbidden))
+				g.Expect(err.Error()).To(ContainSubstring(tt.expectErrMessage))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			if tt.expectWarnings {
+				g.Expect(warnings).To(HaveLen(1))
+			} else {
+				g.Expect(warnings).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestVersionSkewValidationSkipped(t *testing.T) {
+	objs := []client.Object{
+		builder.GenericControlPlaneCRD,
+		builder.Cluster(metav1.NamespaceDefault, "cluster1").
+			WithControlPlane(builder.ControlPlane(metav1.NamespaceDefault, "cp1").Build()).
+			Build(),
+		builder.ControlPlane(metav1.NamespaceDefault, "cp1").WithVersion("v1.32.0").Build(),
+	}
+	c := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(objs...).Build()
+	controllerRef := metav1.OwnerReference{APIVersion: clusterv1.GroupVersion.String(), Kind: "MachineSet", Name: "ms1", Controller: ptr.To(true)}
+
+	machine := func(labels map[string]string, ownerRefs ...metav1.OwnerReference) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "m1", Labels: labels, OwnerReferences: ownerRefs},
+			Spec:       clusterv1.MachineSpec{ClusterName: "cluster1", Version: "v1.33.0"},
+		}
+	}
+	machineSet := func(annotations map[string]string, ownerRefs ...metav1.OwnerReference) *clusterv1.MachineSet {
+		return &clusterv1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "ms1", Annotations: annotations, OwnerReferences: ownerRefs},
+			Spec: clusterv1.MachineSetSpec{ClusterName: "cluster1", Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{ClusterName: "cluster1", Version: "v1.33.0"},
+			}},
+		}
+	}
+	machineDeployment := func(annotations map[string]string) *clusterv1.MachineDeployment {
+		return &clusterv1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "md1", Annotations: annotations},
+			Spec: clusterv1.MachineDeploymentSpec{ClusterName: "cluster1", Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{ClusterName: "cluster1", Version: "v1.33.0"},
+			}},
+		}
+	}
+	skipAnnotation := map[string]string{clusterv1.MachineSetSkipPreflightChecksAnnotation: "ControlPlaneIsStable, KubernetesVersionSkew"}
+
+	t.Run("Machine", func(t *testing.T) {
+		g := NewWithT(t)
+		webhook := &Machine{Client: c, VersionSkewValidationPolicy: VersionSkewValidationPolicyEnforce}
+
+		_, err := webhook.validateVersionSkew(ctx, nil, machine(nil))
+		g.Expect(err).To(HaveOccurred())
+		_, err = webhook.validateVersionSkew(ctx, nil, machine(map[string]string{clusterv1.MachineControlPlaneLabel: ""}))
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = webhook.validateVersionSkew(ctx, nil, machine(nil, controllerRef))
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+
+	t.Run("MachineSet", func(t *testing.T) {
+		g := NewWithT(t)
+		webhook := &MachineSet{Client: c, VersionSkewValidationPolicy: VersionSkewValidationPolicyEnforce}
+
+		_, err := webhook.validateVersionSkew(ctx, nil, machineSet(nil))
+		g.Expect(err).To(HaveOccurred())
+		_, err = webhook.validateVersionSkew(ctx, nil, machineSet(skipAnnotation))
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = webhook.validateVersionSkew(ctx, nil, machineSet(nil, controllerRef))
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+
+	t.Run("MachineDeployment", func(t *testing.T) {
+		g := NewWithT(t)
+		webhook := &MachineDeployment{Client: c, VersionSkewValidationPolicy: VersionSkewValidationPolicyEnforce}
+
+		_, err := webhook.validateVersionSkew(ctx, nil, machineDeployment(nil))
+		g.Expect(err).To(HaveOccurred())
+		_, err = webhook.validateVersionSkew(ctx, nil, machineDeployment(skipAnnotation))
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+}
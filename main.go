@@ -79,7 +79,10 @@ import (
 	"sigs.k8s.io/cluster-api/internal/contract"
 	internalruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
 	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
+	"sigs.k8s.io/cluster-api/internal/statemetrics"
+	"sigs.k8s.io/cluster-api/internal/util/templatecache"
 	"sigs.k8s.io/cluster-api/util/apiwarnings"
+	"sigs.k8s.io/cluster-api/util/controllerstatus"
 	"sigs.k8s.io/cluster-api/util/flags"
 	"sigs.k8s.io/cluster-api/version"
 	"sigs.k8s.io/cluster-api/webhooks"
@@ -128,10 +131,23 @@ var (
 	machinePoolConcurrency           int
 	clusterResourceSetConcurrency    int
 	machineHealthCheckConcurrency    int
+	clusterTemplateConcurrency       int
+	ownerReferencesConcurrency       int
+	clusterTopologyOptions           = flags.ControllerOptions{}
+	machineOptions                   = flags.ControllerOptions{}
+	machineSetOptions                = flags.ControllerOptions{}
+	machineDeploymentOptions         = flags.ControllerOptions{}
+	machineHealthCheckOptions        = flags.ControllerOptions{}
+	extensionConfigOptions           = flags.ControllerOptions{}
 	machineSetPreflightChecks        []string
+	versionSkewValidationPolicy      string
 	skipCRDMigrationPhases           []string
 	additionalSyncMachineLabels      []string
 	additionalSyncMachineAnnotations []string
+	syncMachineConditionsToNode      []string
+	watchWorkloadClusterNodes        bool
+	enableStateMetrics               bool
+	clusterTemplateAllowSensitive    bool
 )
 
 func init() {
@@ -229,6 +245,24 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&machineHealthCheckConcurrency, "machinehealthcheck-concurrency", 10,
 		"Number of machine health checks to process simultaneously")
 
+	fs.IntVar(&clusterTemplateConcurrency, "clustertemplateinstance-concurrency", 10,
+		"Number of cluster template instances to process simultaneously")
+
+	fs.IntVar(&ownerReferencesConcurrency, "ownerreferences-concurrency", 1,
+		"Number of clusters to check for ownerReference inconsistencies simultaneously")
+
+	flags.AddControllerOptions(fs, "clustertopology", &clusterTopologyOptions)
+
+	flags.AddControllerOptions(fs, "machine", &machineOptions)
+
+	flags.AddControllerOptions(fs, "machineset", &machineSetOptions)
+
+	flags.AddControllerOptions(fs, "machinedeployment", &machineDeploymentOptions)
+
+	flags.AddControllerOptions(fs, "machinehealthcheck", &machineHealthCheckOptions)
+
+	flags.AddControllerOptions(fs, "extensionconfig", &extensionConfigOptions)
+
 	fs.StringSliceVar(&machineSetPreflightChecks, "machineset-preflight-checks", []string{
 		string(clusterv1.MachineSetPreflightCheckAll)},
 		"List of MachineSet preflight checks that should be run. Per default all of them are enabled."+
@@ -236,6 +270,11 @@ func InitFlags(fs *pflag.FlagSet) {
 			"on MachineSets via the 'machineset.cluster.x-k8s.io/skip-preflight-checks' annotation."+
 			"Valid values are: All or a list of KubeadmVersionSkew, KubernetesVersionSkew, ControlPlaneIsStable, ControlPlaneVersionSkew")
 
+	fs.StringVar(&versionSkewValidationPolicy, "version-skew-validation-policy", string(webhooks.VersionSkewValidationPolicyWarn),
+		"Defines how the Machine, MachineSet and MachineDeployment webhooks handle versions that do not conform to the "+
+			"Kubernetes version skew policy relative to the control plane version of the Cluster. "+
+			"Valid values are: Enforce (reject), Warn (return a warning) and Ignore (skip the validation).")
+
 	fs.StringSliceVar(&skipCRDMigrationPhases, "skip-crd-migration-phases", []string{},
 		"List of CRD migration phases to skip. Valid values are: StorageVersionMigration, CleanupManagedFields.")
 
@@ -281,6 +320,18 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.StringSliceVar(&additionalSyncMachineAnnotations, "additional-sync-machine-annotations", []string{},
 		"List of regexes to select an additional set of labels to sync from a Machine to its associated Node. An annotation will be synced as long as it matches at least one of the regexes.")
 
+	fs.StringSliceVar(&syncMachineConditionsToNode, "sync-machine-conditions-to-node", []string{},
+		"List of the types of Machine conditions to mirror as conditions of the corresponding Node, e.g. UpToDate,Deleting,OwnerRemediated. Node conditions are prefixed with cluster.x-k8s.io/.")
+
+	fs.BoolVar(&watchWorkloadClusterNodes, "watch-workload-cluster-nodes", true,
+		"If true, the Machine and MachineHealthCheck controllers watch Nodes in workload clusters to react to Node changes. If false, Node changes are only picked up on periodic requeues and resyncs (see --sync-period).")
+
+	fs.BoolVar(&enableStateMetrics, "enable-state-metrics", false,
+		"If true, the phase and the Available, Ready and UpToDate conditions of Clusters and Machines are exposed as metrics.")
+
+	fs.BoolVar(&clusterTemplateAllowSensitive, "clustertemplate-allow-secrets-and-clusterresourcesets", false,
+		"If true, ClusterTemplates can contain Secrets and ClusterResourceSets. Objects of a ClusterTemplate are created with the credentials of the Cluster API controller, so enabling this allows any user who can create ClusterTemplates to create Secrets and to apply arbitrary objects to workload clusters in their namespace.")
+
 	flags.AddManagerOptions(fs, &managerOptions)
 
 	feature.MutableGates.AddFlag(fs)
@@ -342,6 +393,12 @@ func main() {
 		setupLog.Error(errors.Errorf("--remote-conditions-grace-period must be at least 2m"), "Unable to start manager")
 		os.Exit(1)
 	}
+	switch webhooks.VersionSkewValidationPolicy(versionSkewValidationPolicy) {
+	case webhooks.VersionSkewValidationPolicyEnforce, webhooks.VersionSkewValidationPolicyWarn, webhooks.VersionSkewValidationPolicyIgnore:
+	default:
+		setupLog.Error(errors.Errorf("--version-skew-validation-policy must be one of Enforce, Warn or Ignore"), "Unable to start manager")
+		os.Exit(1)
+	}
 
 	if err := version.CheckKubernetesVersion(restConfig, minVer); err != nil {
 		setupLog.Error(err, "Unable to start manager")
@@ -425,6 +482,11 @@ func main() {
 	ctx := ctrl.SetupSignalHandler()
 
 	setupChecks(mgr)
+	if err := controllerstatus.AddToManager(mgr, controllerstatus.NewFromFlags(controllerName, feature.MutableGates, pflag.CommandLine)); err != nil {
+		setupLog.Error(err, "Unable to expose controller status")
+		os.Exit(1)
+	}
+	setupStateMetrics(mgr)
 	setupIndexes(ctx, mgr)
 	clusterCache := setupReconcilers(ctx, mgr, watchNamespaces, &syncPeriod)
 	setupWebhooks(ctx, mgr, clusterCache)
@@ -448,6 +510,16 @@ func setupChecks(mgr ctrl.Manager) {
 	}
 }
 
+func setupStateMetrics(mgr ctrl.Manager) {
+	if !enableStateMetrics {
+		return
+	}
+	if err := statemetrics.AddToManager(mgr, watchFilterValue); err != nil {
+		setupLog.Error(err, "Unable to expose state metrics")
+		os.Exit(1)
+	}
+}
+
 func setupIndexes(ctx context.Context, mgr ctrl.Manager) {
 	if err := index.AddDefaultIndexes(ctx, mgr); err != nil {
 		setupLog.Error(err, "Unable to setup indexes")
@@ -578,6 +650,10 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map
 		os.Exit(1)
 	}
 
+	// Setup a cache for ClusterClasses and templates shared across the topology, MachineDeployment and MachineSet controllers;
+	// it is backed by the informers of the manager cache, which is also used for Unstructured objects.
+	templateCache := templatecache.New(mgr.GetClient(), mgr.GetCache(), mgr.GetScheme())
+
 	if feature.Gates.Enabled(feature.ClusterTopology) {
 		if err := (&controllers.ClusterClassReconciler{
 			Client:           mgr.GetClient(),
@@ -591,10 +667,12 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map
 		if err := (&controllers.ClusterTopologyReconciler{
 			Client:           mgr.GetClient(),
 			APIReader:        mgr.GetAPIReader(),
+			TemplateReader:   templateCache,
 			RuntimeClient:    runtimeClient,
 			ClusterCache:     clusterCache,
 			WatchFilterValue: watchFilterValue,
-		}).SetupWithManager(ctx, mgr, concurrency(clusterTopologyConcurrency)); err != nil {
+			ResyncPeriod:     clusterTopologyOptions.ResyncPeriod,
+		}).SetupWithManager(ctx, mgr, controllerOptions(clusterTopologyConcurrency, clusterTopologyOptions)); err != nil {
 			setupLog.Error(err, "Unable to create controller", "controller", "ClusterTopology")
 			os.Exit(1)
 		}
@@ -618,6 +696,27 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map
 		}
 	}
 
+	if feature.Gates.Enabled(feature.ClusterTemplate) {
+		if err := (&controllers.ClusterTemplateInstanceReconciler{
+			Client:                             mgr.GetClient(),
+			WatchFilterValue:                   watchFilterValue,
+			AllowSecretsAndClusterResourceSets: clusterTemplateAllowSensitive,
+		}).SetupWithManager(ctx, mgr, concurrency(clusterTemplateConcurrency)); err != nil {
+			setupLog.Error(err, "Unable to create controller", "controller", "ClusterTemplateInstance")
+			os.Exit(1)
+		}
+	}
+
+	if feature.Gates.Enabled(feature.OwnerReferenceRepair) {
+		if err := (&controllers.OwnerReferencesReconciler{
+			Client:           mgr.GetClient(),
+			WatchFilterValue: watchFilterValue,
+		}).SetupWithManager(ctx, mgr, concurrency(ownerReferencesConcurrency)); err != nil {
+			setupLog.Error(err, "Unable to create controller", "controller", "OwnerReferences")
+			os.Exit(1)
+		}
+	}
+
 	if feature.Gates.Enabled(feature.RuntimeSDK) {
 		if err = (&controllers.ExtensionConfigReconciler{
 			Client:             mgr.GetClient(),
@@ -625,7 +724,8 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map
 			RuntimeClient:      runtimeClient,
 			PartialSecretCache: partialSecretCache,
 			WatchFilterValue:   watchFilterValue,
-		}).SetupWithManager(ctx, mgr, concurrency(extensionConfigConcurrency)); err != nil {
+			ResyncPeriod:       extensionConfigOptions.ResyncPeriod,
+		}).SetupWithManager(ctx, mgr, controllerOptions(extensionConfigConcurrency, extensionConfigOptions)); err != nil {
 			setupLog.Error(err, "Unable to create controller", "controller", "ExtensionConfig")
 			os.Exit(1)
 		}
@@ -674,10 +774,13 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map
 		ClusterCache:                     clusterCache,
 		RuntimeClient:                    runtimeClient,
 		WatchFilterValue:                 watchFilterValue,
+		ResyncPeriod:                     machineOptions.ResyncPeriod,
 		RemoteConditionsGracePeriod:      remoteConditionsGracePeriod,
 		AdditionalSyncMachineLabels:      additionalSyncMachineLabelRegexes,
 		AdditionalSyncMachineAnnotations: additionalSyncMachineAnnotationRegexes,
-	}).SetupWithManager(ctx, mgr, concurrency(machineConcurrency)); err != nil {
+		DisableNodeWatch:                 !watchWorkloadClusterNodes,
+		SyncMachineConditionsToNode:      syncMachineConditionsToNode,
+	}).SetupWithManager(ctx, mgr, controllerOptions(machineConcurrency, machineOptions)); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "Machine")
 		os.Exit(1)
 	}
@@ -704,9 +807,11 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map
 		Client:           mgr.GetClient(),
 		APIReader:        mgr.GetAPIReader(),
 		ClusterCache:     clusterCache,
+		TemplateReader:   templateCache,
 		PreflightChecks:  machineSetPreflightChecksSet,
 		WatchFilterValue: watchFilterValue,
-	}).SetupWithManager(ctx, mgr, concurrency(machineSetConcurrency)); err != nil {
+		ResyncPeriod:     machineSetOptions.ResyncPeriod,
+	}).SetupWithManager(ctx, mgr, controllerOptions(machineSetConcurrency, machineSetOptions)); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "MachineSet")
 		os.Exit(1)
 	}
@@ -714,8 +819,11 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map
 		Client:           mgr.GetClient(),
 		APIReader:        mgr.GetAPIReader(),
 		RuntimeClient:    runtimeClient,
+		ClusterCache:     clusterCache,
+		TemplateReader:   templateCache,
 		WatchFilterValue: watchFilterValue,
-	}).SetupWithManager(ctx, mgr, concurrency(machineDeploymentConcurrency)); err != nil {
+		ResyncPeriod:     machineDeploymentOptions.ResyncPeriod,
+	}).SetupWithManager(ctx, mgr, controllerOptions(machineDeploymentConcurrency, machineDeploymentOptions)); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "MachineDeployment")
 		os.Exit(1)
 	}
@@ -751,8 +859,11 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map
 	if err := (&controllers.MachineHealthCheckReconciler{
 		Client:           mgr.GetClient(),
 		ClusterCache:     clusterCache,
+		RuntimeClient:    runtimeClient,
 		WatchFilterValue: watchFilterValue,
-	}).SetupWithManager(ctx, mgr, concurrency(machineHealthCheckConcurrency)); err != nil {
+		ResyncPeriod:     machineHealthCheckOptions.ResyncPeriod,
+		DisableNodeWatch: !watchWorkloadClusterNodes,
+	}).SetupWithManager(ctx, mgr, controllerOptions(machineHealthCheckConcurrency, machineHealthCheckOptions)); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "MachineHealthCheck")
 		os.Exit(1)
 	}
@@ -776,6 +887,18 @@ func setupWebhooks(ctx context.Context, mgr ctrl.Manager, clusterCacheReader web
 		os.Exit(1)
 	}
 
+	// NOTE: ClusterTemplate and ClusterTemplateInstance are behind ClusterTemplate feature gate flag; the webhooks
+	// are going to prevent creating or updating new objects in case the feature flag is disabled.
+	if err := (&webhooks.ClusterTemplate{AllowSecretsAndClusterResourceSets: clusterTemplateAllowSensitive}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "Unable to create webhook", "webhook", "ClusterTemplate")
+		os.Exit(1)
+	}
+
+	if err := (&webhooks.ClusterTemplateInstance{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "Unable to create webhook", "webhook", "ClusterTemplateInstance")
+		os.Exit(1)
+	}
+
 	// NOTE: ClusterClass and managed topologies are behind ClusterTopology feature gate flag; the webhook
 	// is going to prevent usage of Cluster.Topology in case the feature flag is disabled.
 	if err := (&webhooks.Cluster{Client: mgr.GetClient(), ClusterCacheReader: clusterCacheReader}).SetupWebhookWithManager(mgr); err != nil {
@@ -783,17 +906,26 @@ func setupWebhooks(ctx context.Context, mgr ctrl.Manager, clusterCacheReader web
 		os.Exit(1)
 	}
 
-	if err := (&webhooks.Machine{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&webhooks.Machine{
+		Client:                      mgr.GetClient(),
+		VersionSkewValidationPolicy: webhooks.VersionSkewValidationPolicy(versionSkewValidationPolicy),
+	}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "Unable to create webhook", "webhook", "Machine")
 		os.Exit(1)
 	}
 
-	if err := (&webhooks.MachineSet{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&webhooks.MachineSet{
+		Client:                      mgr.GetClient(),
+		VersionSkewValidationPolicy: webhooks.VersionSkewValidationPolicy(versionSkewValidationPolicy),
+	}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "Unable to create webhook", "webhook", "MachineSet")
 		os.Exit(1)
 	}
 
-	if err := (&webhooks.MachineDeployment{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&webhooks.MachineDeployment{
+		Client:                      mgr.GetClient(),
+		VersionSkewValidationPolicy: webhooks.VersionSkewValidationPolicy(versionSkewValidationPolicy),
+	}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "Unable to create webhook", "webhook", "MachineDeployment")
 		os.Exit(1)
 	}
@@ -851,3 +983,12 @@ func setupWebhooks(ctx context.Context, mgr ctrl.Manager, clusterCacheReader web
 func concurrency(c int) controller.Options {
 	return controller.Options{MaxConcurrentReconciles: c}
 }
+
+func controllerOptions(c int, options flags.ControllerOptions) controller.Options {
+	o, err := flags.GetControllerOptions(options, c)
+	if err != nil {
+		setupLog.Error(err, "Unable to get controller options")
+		os.Exit(1)
+	}
+	return o
+}
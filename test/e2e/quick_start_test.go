@@ -240,3 +240,15 @@ var _ = Describe("When following the Cluster API quick-start with ClusterClass w
 		}
 	})
 })
+
+var _ = Describe("When following the Cluster API quick-start with a Kubernetes version matrix", func() {
+	QuickStartVersionMatrixSpec(ctx, func() QuickStartVersionMatrixSpecInput {
+		return QuickStartVersionMatrixSpecInput{
+			E2EConfig:             e2eConfig,
+			ClusterctlConfigPath:  clusterctlConfigPath,
+			BootstrapClusterProxy: bootstrapClusterProxy,
+			ArtifactFolder:        artifactFolder,
+			SkipCleanup:           skipCleanup,
+		}
+	})
+})
@@ -0,0 +1,205 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"context"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api/test/framework"
+	"sigs.k8s.io/cluster-api/test/framework/clusterctl"
+)
+
+// KubernetesVersionMatrix is the e2e config variable with the comma-separated list of Kubernetes versions
+// of the workload clusters created by QuickStartVersionMatrixSpec.
+const KubernetesVersionMatrix = "KUBERNETES_VERSION_MATRIX"
+
+// QuickStartVersionMatrixSpecInput is the input for QuickStartVersionMatrixSpec.
+type QuickStartVersionMatrixSpecInput struct {
+	E2EConfig             *clusterctl.E2EConfig
+	ClusterctlConfigPath  string
+	BootstrapClusterProxy framework.ClusterProxy
+	ArtifactFolder        string
+	SkipCleanup           bool
+
+	// KubernetesVersions are the Kubernetes versions of the workload clusters, one workload cluster is created for each version.
+	// If not set, the versions are read from the KUBERNETES_VERSION_MATRIX variable; if the variable is not set
+	// either, KUBERNETES_VERSION_UPGRADE_FROM and KUBERNETES_VERSION are used.
+	KubernetesVersions []string
+
+	// Concurrency is the maximum number of workload clusters created concurrently.
+	// If not set, all the workload clusters are created concurrently.
+	Concurrency *int
+
+	// FailFast stops the creation of the other workload clusters as soon as one of them fails.
+	FailFast bool
+
+	// InfrastructureProvider allows to specify the infrastructure provider to be used when looking for
+	// cluster templates.
+	// If not set, clusterctl will look at the infrastructure provider installed in the management cluster;
+	// if only one infrastructure provider exists, it will be used, otherwise the operation will fail if more than one exists.
+	InfrastructureProvider *string
+
+	// Flavor, if specified is the template flavor used to create the clusters for testing.
+	// If not specified, the default flavor for the selected infrastructure provider is used.
+	Flavor *string
+
+	// ControlPlaneMachineCount defines the number of control plane machines to be added to each workload cluster.
+	// If not specified, 1 will be used.
+	ControlPlaneMachineCount *int64
+
+	// WorkerMachineCount defines number of worker machines to be added to each workload cluster.
+	// If not specified, 1 will be used.
+	WorkerMachineCount *int64
+
+	// Allows to inject a function to be run after the namespace of each workload cluster is created.
+	// If not specified, this is a no-op.
+	PostNamespaceCreated func(managementClusterProxy framework.ClusterProxy, workloadClusterNamespace string)
+
+	// ClusterctlVariables allows injecting variables to the cluster template.
+	// If not specified, this is a no-op.
+	ClusterctlVariables map[string]string
+}
+
+// QuickStartVersionMatrixSpec implements a spec that creates a workload cluster for each Kubernetes version of a
+// version matrix concurrently, against the same management cluster.
+// Each workload cluster is created in a dedicated namespace and with a unique name, so the spec can also run in
+// parallel with other specs; this allows to cover multiple Kubernetes versions while keeping CI wall time low.
+func QuickStartVersionMatrixSpec(ctx context.Context, inputGetter func() QuickStartVersionMatrixSpecInput) {
+	var (
+		specName           = "quick-start-matrix"
+		input              QuickStartVersionMatrixSpecInput
+		kubernetesVersions []string
+	)
+
+	BeforeEach(func() {
+		Expect(ctx).NotTo(BeNil(), "ctx is required for %s spec", specName)
+		input = inputGetter()
+		Expect(input.E2EConfig).ToNot(BeNil(), "Invalid argument. input.E2EConfig can't be nil when calling %s spec", specName)
+		Expect(input.ClusterctlConfigPath).To(BeAnExistingFile(), "Invalid argument. input.ClusterctlConfigPath must be an existing file when calling %s spec", specName)
+		Expect(input.BootstrapClusterProxy).ToNot(BeNil(), "Invalid argument. input.BootstrapClusterProxy can't be nil when calling %s spec", specName)
+		Expect(os.MkdirAll(input.ArtifactFolder, 0750)).To(Succeed(), "Invalid argument. input.ArtifactFolder can't be created for %s spec", specName)
+
+		kubernetesVersions = versionMatrix(input.KubernetesVersions, input.E2EConfig)
+		Expect(kubernetesVersions).ToNot(BeEmpty(), "Invalid argument. At least one Kubernetes version is required for %s spec", specName)
+		for _, version := range kubernetesVersions {
+			Expect(version).To(HaveValidVersion(version))
+		}
+	})
+
+	It("Should create a workload cluster for each Kubernetes version concurrently", func() {
+		infrastructureProvider := clusterctl.DefaultInfrastructureProvider
+		if input.InfrastructureProvider != nil {
+			infrastructureProvider = *input.InfrastructureProvider
+		}
+
+		flavor := clusterctl.DefaultFlavor
+		if input.Flavor != nil {
+			flavor = *input.Flavor
+		}
+
+		controlPlaneMachineCount := ptr.To[int64](1)
+		if input.ControlPlaneMachineCount != nil {
+			controlPlaneMachineCount = input.ControlPlaneMachineCount
+		}
+
+		workerMachineCount := ptr.To[int64](1)
+		if input.WorkerMachineCount != nil {
+			workerMachineCount = input.WorkerMachineCount
+		}
+
+		variables := map[string]string{}
+		maps.Copy(variables, input.ClusterctlVariables)
+
+		Byf("Creating workload clusters for Kubernetes versions %s", strings.Join(kubernetesVersions, ", "))
+		err := framework.RunVersionMatrix(ctx, framework.RunVersionMatrixInput{
+			SpecName:               specName,
+			ClusterProxy:           input.BootstrapClusterProxy,
+			ClusterctlConfigPath:   input.ClusterctlConfigPath,
+			ArtifactFolder:         input.ArtifactFolder,
+			KubernetesVersions:     kubernetesVersions,
+			Concurrency:            ptr.Deref(input.Concurrency, 0),
+			FailFast:               input.FailFast,
+			SkipCleanup:            input.SkipCleanup,
+			PostNamespaceCreated:   input.PostNamespaceCreated,
+			DeleteClusterIntervals: input.E2EConfig.GetIntervals(specName, "wait-delete-cluster"),
+		}, func(ctx context.Context, entry framework.VersionMatrixEntry) {
+			clusterResources := new(clusterctl.ApplyClusterTemplateAndWaitResult)
+			clusterctl.ApplyClusterTemplateAndWait(ctx, clusterctl.ApplyClusterTemplateAndWaitInput{
+				ClusterProxy: input.BootstrapClusterProxy,
+				ConfigCluster: clusterctl.ConfigClusterInput{
+					LogFolder:                entry.LogFolder,
+					ClusterctlConfigPath:     input.ClusterctlConfigPath,
+					ClusterctlVariables:      variables,
+					KubeconfigPath:           input.BootstrapClusterProxy.GetKubeconfigPath(),
+					InfrastructureProvider:   infrastructureProvider,
+					Flavor:                   flavor,
+					Namespace:                entry.Namespace.Name,
+					ClusterName:              entry.ClusterName,
+					KubernetesVersion:        entry.KubernetesVersion,
+					ControlPlaneMachineCount: controlPlaneMachineCount,
+					WorkerMachineCount:       workerMachineCount,
+				},
+				WaitForClusterIntervals:      input.E2EConfig.GetIntervals(specName, "wait-cluster"),
+				WaitForControlPlaneIntervals: input.E2EConfig.GetIntervals(specName, "wait-control-plane"),
+				WaitForMachineDeployments:    input.E2EConfig.GetIntervals(specName, "wait-worker-nodes"),
+			}, clusterResources)
+
+			framework.VerifyClusterAvailable(ctx, framework.VerifyClusterAvailableInput{
+				Getter:    input.BootstrapClusterProxy.GetClient(),
+				Name:      clusterResources.Cluster.Name,
+				Namespace: clusterResources.Cluster.Namespace,
+			})
+
+			framework.VerifyMachinesReady(ctx, framework.VerifyMachinesReadyInput{
+				Lister:    input.BootstrapClusterProxy.GetClient(),
+				Name:      clusterResources.Cluster.Name,
+				Namespace: clusterResources.Cluster.Namespace,
+			})
+		})
+		Expect(err).ToNot(HaveOccurred(), "Failed to create workload clusters for all Kubernetes versions")
+
+		By("PASSED!")
+	})
+}
+
+// versionMatrix returns the Kubernetes versions of the version matrix, without duplicates.
+func versionMatrix(kubernetesVersions []string, e2eConfig *clusterctl.E2EConfig) []string {
+	if len(kubernetesVersions) == 0 {
+		if matrix := e2eConfig.GetVariableOrEmpty(KubernetesVersionMatrix); matrix != "" {
+			kubernetesVersions = strings.Split(matrix, ",")
+		} else {
+			kubernetesVersions = []string{e2eConfig.GetVariableOrEmpty(KubernetesVersionUpgradeFrom), e2eConfig.GetVariableOrEmpty(KubernetesVersion)}
+		}
+	}
+
+	versions := []string{}
+	for _, version := range kubernetesVersions {
+		version = strings.TrimSpace(version)
+		if version != "" && !slices.Contains(versions, version) {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/test/framework/internal/log"
+	"sigs.k8s.io/cluster-api/test/infrastructure/container"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+)
+
+// KillControlPlaneMachineInput is the input for KillControlPlaneMachine.
+type KillControlPlaneMachineInput struct {
+	ClusterProxy ClusterProxy
+	Cluster      *clusterv1.Cluster
+
+	// Machine is the control plane Machine to kill; if not set, a random control plane Machine is picked.
+	Machine *clusterv1.Machine
+
+	// Signal is the signal sent to the container backing the Machine; defaults to SIGKILL.
+	Signal string
+
+	// Rand is the source used to pick a random control plane Machine; if not set, a new source is used.
+	Rand *rand.Rand
+}
+
+// KillControlPlaneMachine kills the container backing a control plane Machine, thus simulating a sudden failure of the
+// underlying host; the killed Machine is returned.
+// NOTE: This func only works with the Docker infrastructure provider.
+func KillControlPlaneMachine(ctx context.Context, input KillControlPlaneMachineInput) *clusterv1.Machine {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for KillControlPlaneMachine")
+	Expect(input.ClusterProxy).ToNot(BeNil(), "Invalid argument. input.ClusterProxy can't be nil when calling KillControlPlaneMachine")
+	Expect(input.Cluster).ToNot(BeNil(), "Invalid argument. input.Cluster can't be nil when calling KillControlPlaneMachine")
+
+	machine := input.Machine
+	if machine == nil {
+		machines := GetControlPlaneMachinesByCluster(ctx, GetControlPlaneMachinesByClusterInput{
+			Lister:      input.ClusterProxy.GetClient(),
+			ClusterName: input.Cluster.Name,
+			Namespace:   input.Cluster.Namespace,
+		})
+		Expect(machines).ToNot(BeEmpty(), "Failed to find control plane Machines for Cluster %s", klog.KObj(input.Cluster))
+		machine = &machines[randomIndexes(input.Rand, len(machines), 1)[0]]
+	}
+
+	signal := input.Signal
+	if signal == "" {
+		signal = "SIGKILL"
+	}
+
+	containerRuntime, err := container.NewDockerClient()
+	Expect(err).ToNot(HaveOccurred(), "Failed to get a container runtime client")
+	ctx = container.RuntimeInto(ctx, containerRuntime)
+
+	containerName := machineContainerName(machine.Spec.ClusterName, machine.Name)
+	log.Logf("Killing container %s backing control plane Machine %s with signal %s", containerName, klog.KObj(machine), signal)
+	Expect(containerRuntime.KillContainer(ctx, containerName, signal)).To(Succeed(), "Failed to kill container %s", containerName)
+
+	return machine
+}
+
+// PartitionWorkloadAPIServerInput is the input for PartitionWorkloadAPIServer.
+type PartitionWorkloadAPIServerInput struct {
+	Cluster *clusterv1.Cluster
+}
+
+// PartitionWorkloadAPIServer makes the API server of the workload cluster unreachable from the management cluster
+// by freezing the load balancer in front of it; the returned func heals the partition.
+// NOTE: This func only works with the Docker infrastructure provider.
+func PartitionWorkloadAPIServer(ctx context.Context, input PartitionWorkloadAPIServerInput) (heal func()) {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for PartitionWorkloadAPIServer")
+	Expect(input.Cluster).ToNot(BeNil(), "Invalid argument. input.Cluster can't be nil when calling PartitionWorkloadAPIServer")
+
+	containerRuntime, err := container.NewDockerClient()
+	Expect(err).ToNot(HaveOccurred(), "Failed to get a container runtime client")
+	ctx = container.RuntimeInto(ctx, containerRuntime)
+
+	lbContainerName := fmt.Sprintf("%s-lb", input.Cluster.Name)
+	log.Logf("Partitioning the API server of Cluster %s by freezing the load balancer container %s", klog.KObj(input.Cluster), lbContainerName)
+	Expect(containerRuntime.KillContainer(ctx, lbContainerName, "SIGSTOP")).To(Succeed(), "Failed to freeze container %s", lbContainerName)
+
+	return func() {
+		log.Logf("Healing the partition of the API server of Cluster %s by unfreezing the load balancer container %s", klog.KObj(input.Cluster), lbContainerName)
+		Expect(containerRuntime.KillContainer(ctx, lbContainerName, "SIGCONT")).To(Succeed(), "Failed to unfreeze container %s", lbContainerName)
+	}
+}
+
+// CordonRandomNodesInput is the input for CordonRandomNodes.
+type CordonRandomNodesInput struct {
+	ClusterProxy ClusterProxy
+	Cluster      *clusterv1.Cluster
+
+	// Count is the number of Nodes to cordon; if greater than the number of Nodes, all the Nodes are cordoned.
+	Count int
+
+	// IncludeControlPlane determines if control plane Nodes can be cordoned.
+	IncludeControlPlane bool
+
+	// Rand is the source used to pick random Nodes; if not set, a new source is used.
+	Rand *rand.Rand
+}
+
+// CordonRandomNodes cordons random Nodes of the workload cluster and returns the names of the cordoned Nodes.
+func CordonRandomNodes(ctx context.Context, input CordonRandomNodesInput) []string {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for CordonRandomNodes")
+	Expect(input.ClusterProxy).ToNot(BeNil(), "Invalid argument. input.ClusterProxy can't be nil when calling CordonRandomNodes")
+	Expect(input.Cluster).ToNot(BeNil(), "Invalid argument. input.Cluster can't be nil when calling CordonRandomNodes")
+	Expect(input.Count).To(BeNumerically(">", 0), "Invalid argument. input.Count must be greater than 0 when calling CordonRandomNodes")
+
+	workloadClient := input.ClusterProxy.GetWorkloadCluster(ctx, input.Cluster.Namespace, input.Cluster.Name).GetClient()
+
+	nodeList := &corev1.NodeList{}
+	Eventually(func() error {
+		return workloadClient.List(ctx, nodeList)
+	}, retryableOperationTimeout, retryableOperationInterval).Should(Succeed(), "Failed to list Nodes for Cluster %s", klog.KObj(input.Cluster))
+
+	candidates := []corev1.Node{}
+	for _, node := range nodeList.Items {
+		if _, isControlPlane := node.Labels[nodeRoleControlPlane]; isControlPlane && !input.IncludeControlPlane {
+			continue
+		}
+		candidates = append(candidates, node)
+	}
+	Expect(candidates).ToNot(BeEmpty(), "Failed to find Nodes to cordon for Cluster %s", klog.KObj(input.Cluster))
+
+	cordoned := []string{}
+	for _, i := range randomIndexes(input.Rand, len(candidates), input.Count) {
+		node := &candidates[i]
+		log.Logf("Cordoning Node %s", node.Name)
+		setNodeUnschedulable(ctx, workloadClient, node, true)
+		cordoned = append(cordoned, node.Name)
+	}
+	return cordoned
+}
+
+// UncordonNodesInput is the input for UncordonNodes.
+type UncordonNodesInput struct {
+	ClusterProxy ClusterProxy
+	Cluster      *clusterv1.Cluster
+	NodeNames    []string
+}
+
+// UncordonNodes uncordons the given Nodes of the workload cluster, e.g. the Nodes cordoned by CordonRandomNodes.
+// Nodes not existing anymore, e.g. because they have been remediated, are ignored.
+func UncordonNodes(ctx context.Context, input UncordonNodesInput) {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for UncordonNodes")
+	Expect(input.ClusterProxy).ToNot(BeNil(), "Invalid argument. input.ClusterProxy can't be nil when calling UncordonNodes")
+	Expect(input.Cluster).ToNot(BeNil(), "Invalid argument. input.Cluster can't be nil when calling UncordonNodes")
+
+	workloadClient := input.ClusterProxy.GetWorkloadCluster(ctx, input.Cluster.Namespace, input.Cluster.Name).GetClient()
+	for _, name := range input.NodeNames {
+		node := &corev1.Node{}
+		if err := workloadClient.Get(ctx, client.ObjectKey{Name: name}, node); err != nil {
+			log.Logf("Skipping uncordon of Node %s: %v", name, err)
+			continue
+		}
+		log.Logf("Uncordoning Node %s", node.Name)
+		setNodeUnschedulable(ctx, workloadClient, node, false)
+	}
+}
+
+// WaitForClusterRecoveryInput is the input for WaitForClusterRecovery.
+type WaitForClusterRecoveryInput struct {
+	ClusterProxy ClusterProxy
+	Cluster      *clusterv1.Cluster
+
+	// SLO is the maximum time allowed for the Cluster to recover after a disruption.
+	SLO time.Duration
+
+	// DisruptedAt is the time the disruption was injected; if not set, the SLO is measured from the time this func is called.
+	DisruptedAt time.Time
+}
+
+// WaitForClusterRecovery waits for the Cluster and all its Machines to be available and ready again after a disruption,
+// and asserts this happens within the given SLO; the time taken for the recovery is returned.
+func WaitForClusterRecovery(ctx context.Context, input WaitForClusterRecoveryInput) time.Duration {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for WaitForClusterRecovery")
+	Expect(input.ClusterProxy).ToNot(BeNil(), "Invalid argument. input.ClusterProxy can't be nil when calling WaitForClusterRecovery")
+	Expect(input.Cluster).ToNot(BeNil(), "Invalid argument. input.Cluster can't be nil when calling WaitForClusterRecovery")
+	Expect(input.SLO).To(BeNumerically(">", 0), "Invalid argument. input.SLO must be greater than 0 when calling WaitForClusterRecovery")
+
+	disruptedAt := input.DisruptedAt
+	if disruptedAt.IsZero() {
+		disruptedAt = time.Now()
+	}
+	timeout := time.Until(disruptedAt.Add(input.SLO))
+	Expect(timeout).To(BeNumerically(">", 0), "The SLO for the recovery of Cluster %s already expired", klog.KObj(input.Cluster))
+
+	log.Logf("Waiting for Cluster %s to recover within %s", klog.KObj(input.Cluster), input.SLO)
+	mgmtClient := input.ClusterProxy.GetClient()
+	Eventually(func(g Gomega) {
+		cluster := &clusterv1.Cluster{}
+		g.Expect(mgmtClient.Get(ctx, client.ObjectKeyFromObject(input.Cluster), cluster)).To(Succeed())
+		g.Expect(conditions.IsTrue(cluster, clusterv1.ClusterAvailableCondition)).To(BeTrue(), "Cluster %s is not available yet", klog.KObj(cluster))
+
+		machineList := &clusterv1.MachineList{}
+		g.Expect(mgmtClient.List(ctx, machineList, byClusterOptions(input.Cluster.Name, input.Cluster.Namespace)...)).To(Succeed())
+		for i := range machineList.Items {
+			machine := &machineList.Items[i]
+			g.Expect(machine.DeletionTimestamp.IsZero()).To(BeTrue(), "Machine %s is still being deleted", klog.KObj(machine))
+			g.Expect(conditions.IsTrue(machine, clusterv1.MachineReadyCondition)).To(BeTrue(), "Machine %s is not ready yet", klog.KObj(machine))
+		}
+	}, timeout, 10*time.Second).Should(Succeed(), "Cluster %s failed to recover within the SLO of %s", klog.KObj(input.Cluster), input.SLO)
+
+	recoveryTime := time.Since(disruptedAt)
+	log.Logf("Cluster %s recovered in %s", klog.KObj(input.Cluster), recoveryTime.Truncate(time.Second))
+	return recoveryTime
+}
+
+// setNodeUnschedulable sets spec.unschedulable on a Node.
+func setNodeUnschedulable(ctx context.Context, c client.Client, node *corev1.Node, unschedulable bool) {
+	patchHelper, err := patch.NewHelper(node, c)
+	Expect(err).ToNot(HaveOccurred())
+	node.Spec.Unschedulable = unschedulable
+	Eventually(func() error {
+		return patchHelper.Patch(ctx, node)
+	}, retryableOperationTimeout, retryableOperationInterval).Should(Succeed(), "Failed to patch Node %s", node.Name)
+}
+
+// randomIndexes returns count distinct random indexes in the range [0, n); if count is greater than n, all the indexes are returned.
+func randomIndexes(r *rand.Rand, n, count int) []int {
+	if r == nil {
+		r = rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec // Using a non cryptographic source is fine for e2e tests.
+	}
+	if count > n {
+		count = n
+	}
+	return r.Perm(n)[:count]
+}
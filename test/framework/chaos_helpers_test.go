@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_randomIndexes(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         int
+		count     int
+		wantCount int
+	}{
+		{
+			name:      "picks the requested number of indexes",
+			n:         5,
+			count:     2,
+			wantCount: 2,
+		},
+		{
+			name:      "picks all the indexes when count is greater than n",
+			n:         3,
+			count:     10,
+			wantCount: 3,
+		},
+		{
+			name:      "picks nothing when n is zero",
+			n:         0,
+			count:     1,
+			wantCount: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got := randomIndexes(rand.New(rand.NewSource(1)), tt.n, tt.count)
+			g.Expect(got).To(HaveLen(tt.wantCount))
+
+			seen := map[int]bool{}
+			for _, i := range got {
+				g.Expect(i).To(BeNumerically(">=", 0))
+				g.Expect(i).To(BeNumerically("<", tt.n))
+				g.Expect(seen).ToNot(HaveKey(i), "indexes must be distinct")
+				seen[i] = true
+			}
+		})
+	}
+}
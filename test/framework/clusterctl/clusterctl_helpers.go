@@ -49,6 +49,10 @@ type InitManagementClusterAndWatchControllerLogsInput struct {
 	LogFolder                 string
 	DisableMetricsCollection  bool
 	ClusterctlBinaryPath      string
+
+	// PerformanceThresholds, if set, are checked against the performance data collected from the controllers
+	// while metrics collection is enabled.
+	PerformanceThresholds *framework.PerformanceThresholds
 }
 
 // InitManagementClusterAndWatchControllerLogs initializes a management using clusterctl and setup watches for controller logs.
@@ -132,10 +136,11 @@ func InitManagementClusterAndWatchControllerLogs(ctx context.Context, input Init
 
 		if !input.DisableMetricsCollection {
 			framework.WatchPodMetrics(ctx, framework.WatchPodMetricsInput{
-				GetLister:   client,
-				ClientSet:   input.ClusterProxy.GetClientSet(),
-				Deployment:  deployment,
-				MetricsPath: filepath.Join(input.LogFolder, "metrics", deployment.GetNamespace()),
+				GetLister:             client,
+				ClientSet:             input.ClusterProxy.GetClientSet(),
+				Deployment:            deployment,
+				MetricsPath:           filepath.Join(input.LogFolder, "metrics", deployment.GetNamespace()),
+				PerformanceThresholds: input.PerformanceThresholds,
 			})
 		}
 	}
@@ -156,6 +161,9 @@ type UpgradeManagementClusterAndWaitInput struct {
 	AddonProviders            []string
 	LogFolder                 string
 	ClusterctlBinaryPath      string
+
+	// PerformanceThresholds, if set, are checked against the performance data collected from the controllers.
+	PerformanceThresholds *framework.PerformanceThresholds
 }
 
 // UpgradeManagementClusterAndWait upgrades provider a management cluster using clusterctl, and waits for the cluster to be ready.
@@ -243,10 +251,11 @@ func UpgradeManagementClusterAndWait(ctx context.Context, input UpgradeManagemen
 		}, intervals...)
 
 		framework.WatchPodMetrics(ctx, framework.WatchPodMetricsInput{
-			GetLister:   client,
-			ClientSet:   input.ClusterProxy.GetClientSet(),
-			Deployment:  deployment,
-			MetricsPath: filepath.Join(input.LogFolder, "metrics", deployment.GetNamespace()),
+			GetLister:             client,
+			ClientSet:             input.ClusterProxy.GetClientSet(),
+			Deployment:            deployment,
+			MetricsPath:           filepath.Join(input.LogFolder, "metrics", deployment.GetNamespace()),
+			PerformanceThresholds: input.PerformanceThresholds,
 		})
 	}
 }
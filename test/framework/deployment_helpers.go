@@ -345,9 +345,14 @@ type WatchPodMetricsInput struct {
 	ClientSet   *kubernetes.Clientset
 	Deployment  *appsv1.Deployment
 	MetricsPath string
+
+	// PerformanceThresholds, if set, are checked against the performance data collected at every scrape.
+	PerformanceThresholds *PerformanceThresholds
 }
 
-// WatchPodMetrics captures metrics from all pods every 5s. It expects to find port 8080 open on the controller.
+// WatchPodMetrics captures metrics from all pods every 10s. It expects to find port 8080 open on the controller.
+// Together with the raw metrics, a performance sample with memory, CPU, reconcile durations, workqueue depths and
+// API request counts is appended to performance.jsonl in the metrics folder of each Pod.
 func WatchPodMetrics(ctx context.Context, input WatchPodMetricsInput) {
 	// Dump metrics periodically.
 	ticker := time.NewTicker(time.Second * 10)
@@ -376,14 +381,14 @@ func WatchPodMetrics(ctx context.Context, input WatchPodMetricsInput) {
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
-				dumpPodMetrics(ctx, input.ClientSet, input.MetricsPath, deployment.Name, pods)
+				dumpPodMetrics(ctx, input.ClientSet, input.MetricsPath, deployment.Name, pods, input.PerformanceThresholds)
 			}
 		}
 	}()
 }
 
 // dumpPodMetrics captures metrics from all pods. It expects to find port 8080 open on the controller.
-func dumpPodMetrics(ctx context.Context, client *kubernetes.Clientset, metricsPath string, deploymentName string, pods *corev1.PodList) {
+func dumpPodMetrics(ctx context.Context, client *kubernetes.Clientset, metricsPath string, deploymentName string, pods *corev1.PodList, thresholds *PerformanceThresholds) {
 	for _, pod := range pods.Items {
 		metricsDir := path.Join(metricsPath, deploymentName, pod.Name)
 		metricsFile := path.Join(metricsDir, "metrics.txt")
@@ -413,10 +418,32 @@ func dumpPodMetrics(ctx context.Context, client *kubernetes.Clientset, metricsPa
 
 		if !errorRetrievingMetrics {
 			Expect(verifyMetrics(data, &pod)).To(Succeed())
+			verifyPerformance(data, &pod, metricsDir, thresholds)
 		}
 	}
 }
 
+// verifyPerformance records a performance sample for the Pod and checks it against the thresholds, if any.
+func verifyPerformance(data []byte, pod *corev1.Pod, metricsDir string, thresholds *PerformanceThresholds) {
+	var parser expfmt.TextParser
+	mf, err := parser.TextToMetricFamilies(bytes.NewReader(data))
+	if err != nil {
+		// Failing to collect performance data should not cause the test to fail
+		log.Logf("Error parsing metrics for pod %s: %v", klog.KRef(pod.Namespace, pod.Name), err)
+		return
+	}
+
+	sample := newPerformanceSample(pod.Name, time.Now(), mf)
+	if err := writePerformanceSample(metricsDir, sample); err != nil {
+		// Failing to collect performance data should not cause the test to fail
+		log.Logf("Error writing performance data for pod %s: %v", klog.KRef(pod.Namespace, pod.Name), err)
+	}
+
+	if thresholds != nil {
+		Expect(sample.Verify(*thresholds)).To(Succeed(), "Performance thresholds exceeded for pod %s", klog.KRef(pod.Namespace, pod.Name))
+	}
+}
+
 func verifyMetrics(data []byte, pod *corev1.Pod) error {
 	var parser expfmt.TextParser
 	mf, err := parser.TextToMetricFamilies(bytes.NewReader(data))
@@ -0,0 +1,220 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// performanceDataFile is the name of the file where performance samples are appended, one JSON document per line.
+const performanceDataFile = "performance.jsonl"
+
+// PerformanceThresholds defines thresholds for the performance data collected from controller Pods.
+// Thresholds set to zero are not checked.
+type PerformanceThresholds struct {
+	// MaxResidentMemoryBytes is the maximum resident memory of a controller Pod.
+	MaxResidentMemoryBytes float64
+
+	// MaxCPUSeconds is the maximum CPU time consumed by a controller Pod since it started.
+	MaxCPUSeconds float64
+
+	// MaxAverageReconcileDuration is the maximum average reconcile duration of each controller.
+	MaxAverageReconcileDuration time.Duration
+
+	// MaxWorkqueueDepth is the maximum depth of each workqueue.
+	MaxWorkqueueDepth float64
+
+	// MaxAPIRequests is the maximum number of requests a controller Pod sent to the API server since it started.
+	MaxAPIRequests float64
+}
+
+// PerformanceSample is the performance data collected from a controller Pod at a point in time.
+type PerformanceSample struct {
+	Timestamp time.Time `json:"timestamp"`
+	Pod       string    `json:"pod"`
+
+	// ResidentMemoryBytes is the value of process_resident_memory_bytes.
+	ResidentMemoryBytes float64 `json:"residentMemoryBytes"`
+
+	// CPUSeconds is the value of process_cpu_seconds_total.
+	CPUSeconds float64 `json:"cpuSeconds"`
+
+	// Reconciles is the number of reconciles by controller, computed from controller_runtime_reconcile_time_seconds.
+	Reconciles map[string]float64 `json:"reconciles,omitempty"`
+
+	// AverageReconcileSeconds is the average reconcile duration by controller, computed from controller_runtime_reconcile_time_seconds.
+	AverageReconcileSeconds map[string]float64 `json:"averageReconcileSeconds,omitempty"`
+
+	// WorkqueueDepth is the value of workqueue_depth by workqueue.
+	WorkqueueDepth map[string]float64 `json:"workqueueDepth,omitempty"`
+
+	// APIRequests is the number of API requests by method, computed from rest_client_requests_total.
+	APIRequests map[string]float64 `json:"apiRequests,omitempty"`
+}
+
+// newPerformanceSample extracts the performance data from the metrics of a controller Pod.
+func newPerformanceSample(podName string, timestamp time.Time, mf map[string]*dto.MetricFamily) PerformanceSample {
+	sample := PerformanceSample{
+		Timestamp: timestamp,
+		Pod:       podName,
+	}
+
+	if family, ok := mf["process_resident_memory_bytes"]; ok {
+		for _, m := range family.GetMetric() {
+			sample.ResidentMemoryBytes += m.GetGauge().GetValue()
+		}
+	}
+
+	if family, ok := mf["process_cpu_seconds_total"]; ok {
+		for _, m := range family.GetMetric() {
+			sample.CPUSeconds += m.GetCounter().GetValue()
+		}
+	}
+
+	if family, ok := mf["controller_runtime_reconcile_time_seconds"]; ok {
+		sum := map[string]float64{}
+		for _, m := range family.GetMetric() {
+			controller := metricLabel(m, "controller")
+			if sample.Reconciles == nil {
+				sample.Reconciles = map[string]float64{}
+			}
+			sample.Reconciles[controller] += float64(m.GetHistogram().GetSampleCount())
+			sum[controller] += m.GetHistogram().GetSampleSum()
+		}
+		for controller, count := range sample.Reconciles {
+			if count == 0 {
+				continue
+			}
+			if sample.AverageReconcileSeconds == nil {
+				sample.AverageReconcileSeconds = map[string]float64{}
+			}
+			sample.AverageReconcileSeconds[controller] = sum[controller] / count
+		}
+	}
+
+	if family, ok := mf["workqueue_depth"]; ok {
+		for _, m := range family.GetMetric() {
+			if sample.WorkqueueDepth == nil {
+				sample.WorkqueueDepth = map[string]float64{}
+			}
+			sample.WorkqueueDepth[metricLabel(m, "name")] += m.GetGauge().GetValue()
+		}
+	}
+
+	if family, ok := mf["rest_client_requests_total"]; ok {
+		for _, m := range family.GetMetric() {
+			if sample.APIRequests == nil {
+				sample.APIRequests = map[string]float64{}
+			}
+			sample.APIRequests[metricLabel(m, "method")] += m.GetCounter().GetValue()
+		}
+	}
+
+	return sample
+}
+
+// TotalAPIRequests returns the number of API requests across all methods.
+func (s PerformanceSample) TotalAPIRequests() float64 {
+	var total float64
+	for _, count := range s.APIRequests {
+		total += count
+	}
+	return total
+}
+
+// Verify checks the sample against the given thresholds.
+func (s PerformanceSample) Verify(thresholds PerformanceThresholds) error {
+	var errs []error
+
+	if thresholds.MaxResidentMemoryBytes > 0 && s.ResidentMemoryBytes > thresholds.MaxResidentMemoryBytes {
+		errs = append(errs, fmt.Errorf("resident memory of Pod %s is %.0f bytes, above the threshold of %.0f bytes", s.Pod, s.ResidentMemoryBytes, thresholds.MaxResidentMemoryBytes))
+	}
+
+	if thresholds.MaxCPUSeconds > 0 && s.CPUSeconds > thresholds.MaxCPUSeconds {
+		errs = append(errs, fmt.Errorf("CPU time of Pod %s is %.2fs, above the threshold of %.2fs", s.Pod, s.CPUSeconds, thresholds.MaxCPUSeconds))
+	}
+
+	if thresholds.MaxAverageReconcileDuration > 0 {
+		for _, controller := range sortedKeys(s.AverageReconcileSeconds) {
+			if avg := s.AverageReconcileSeconds[controller]; avg > thresholds.MaxAverageReconcileDuration.Seconds() {
+				errs = append(errs, fmt.Errorf("average reconcile duration of %q controller in Pod %s is %.3fs, above the threshold of %s", controller, s.Pod, avg, thresholds.MaxAverageReconcileDuration))
+			}
+		}
+	}
+
+	if thresholds.MaxWorkqueueDepth > 0 {
+		for _, name := range sortedKeys(s.WorkqueueDepth) {
+			if depth := s.WorkqueueDepth[name]; depth > thresholds.MaxWorkqueueDepth {
+				errs = append(errs, fmt.Errorf("depth of %q workqueue in Pod %s is %.0f, above the threshold of %.0f", name, s.Pod, depth, thresholds.MaxWorkqueueDepth))
+			}
+		}
+	}
+
+	if thresholds.MaxAPIRequests > 0 {
+		if total := s.TotalAPIRequests(); total > thresholds.MaxAPIRequests {
+			errs = append(errs, fmt.Errorf("number of API requests sent by Pod %s is %.0f, above the threshold of %.0f", s.Pod, total, thresholds.MaxAPIRequests))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// writePerformanceSample appends the sample to the performance data file in the given directory.
+func writePerformanceSample(dir string, sample PerformanceSample) error {
+	data, err := json.Marshal(sample)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal performance sample for Pod %s", sample.Pod)
+	}
+
+	f, err := os.OpenFile(path.Join(dir, performanceDataFile), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open performance data file for Pod %s", sample.Pod)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return errors.Wrapf(err, "failed to write performance sample for Pod %s", sample.Pod)
+	}
+	return nil
+}
+
+func metricLabel(m *dto.Metric, name string) string {
+	for _, label := range m.GetLabel() {
+		if label.GetName() == name {
+			return label.GetValue()
+		}
+	}
+	return "unknown"
+}
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
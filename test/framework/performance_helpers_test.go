@@ -0,0 +1,164 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/common/expfmt"
+)
+
+const performanceTestMetrics = `# TYPE process_resident_memory_bytes gauge
+process_resident_memory_bytes 1.048576e+08
+# TYPE process_cpu_seconds_total counter
+process_cpu_seconds_total 12.5
+# TYPE controller_runtime_reconcile_time_seconds histogram
+controller_runtime_reconcile_time_seconds_bucket{controller="cluster",le="+Inf"} 4
+controller_runtime_reconcile_time_seconds_sum{controller="cluster"} 2
+controller_runtime_reconcile_time_seconds_count{controller="cluster"} 4
+controller_runtime_reconcile_time_seconds_bucket{controller="machine",le="+Inf"} 0
+controller_runtime_reconcile_time_seconds_sum{controller="machine"} 0
+controller_runtime_reconcile_time_seconds_count{controller="machine"} 0
+# TYPE workqueue_depth gauge
+workqueue_depth{controller="cluster",name="cluster"} 3
+workqueue_depth{controller="machine",name="machine"} 0
+# TYPE rest_client_requests_total counter
+rest_client_requests_total{code="200",host="10.96.0.1:443",method="GET"} 100
+rest_client_requests_total{code="404",host="10.96.0.1:443",method="GET"} 5
+rest_client_requests_total{code="200",host="10.96.0.1:443",method="PATCH"} 20
+`
+
+func Test_newPerformanceSample(t *testing.T) {
+	g := NewWithT(t)
+
+	var parser expfmt.TextParser
+	mf, err := parser.TextToMetricFamilies(strings.NewReader(performanceTestMetrics))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	timestamp := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	sample := newPerformanceSample("pod1", timestamp, mf)
+
+	g.Expect(sample).To(BeComparableTo(PerformanceSample{
+		Timestamp:               timestamp,
+		Pod:                     "pod1",
+		ResidentMemoryBytes:     104857600,
+		CPUSeconds:              12.5,
+		Reconciles:              map[string]float64{"cluster": 4, "machine": 0},
+		AverageReconcileSeconds: map[string]float64{"cluster": 0.5},
+		WorkqueueDepth:          map[string]float64{"cluster": 3, "machine": 0},
+		APIRequests:             map[string]float64{"GET": 105, "PATCH": 20},
+	}))
+	g.Expect(sample.TotalAPIRequests()).To(Equal(float64(125)))
+}
+
+func TestPerformanceSample_Verify(t *testing.T) {
+	sample := PerformanceSample{
+		Pod:                     "pod1",
+		ResidentMemoryBytes:     104857600,
+		CPUSeconds:              12.5,
+		AverageReconcileSeconds: map[string]float64{"cluster": 0.5},
+		WorkqueueDepth:          map[string]float64{"cluster": 3},
+		APIRequests:             map[string]float64{"GET": 105, "PATCH": 20},
+	}
+
+	tests := []struct {
+		name       string
+		thresholds PerformanceThresholds
+		wantErrs   []string
+	}{
+		{
+			name:       "no thresholds",
+			thresholds: PerformanceThresholds{},
+		},
+		{
+			name: "within thresholds",
+			thresholds: PerformanceThresholds{
+				MaxResidentMemoryBytes:      200 * 1024 * 1024,
+				MaxCPUSeconds:               60,
+				MaxAverageReconcileDuration: time.Second,
+				MaxWorkqueueDepth:           10,
+				MaxAPIRequests:              1000,
+			},
+		},
+		{
+			name: "above thresholds",
+			thresholds: PerformanceThresholds{
+				MaxResidentMemoryBytes:      50 * 1024 * 1024,
+				MaxCPUSeconds:               10,
+				MaxAverageReconcileDuration: 100 * time.Millisecond,
+				MaxWorkqueueDepth:           1,
+				MaxAPIRequests:              100,
+			},
+			wantErrs: []string{
+				"resident memory of Pod pod1 is 104857600 bytes, above the threshold of 52428800 bytes",
+				"CPU time of Pod pod1 is 12.50s, above the threshold of 10.00s",
+				"average reconcile duration of \"cluster\" controller in Pod pod1 is 0.500s, above the threshold of 100ms",
+				"depth of \"cluster\" workqueue in Pod pod1 is 3, above the threshold of 1",
+				"number of API requests sent by Pod pod1 is 125, above the threshold of 100",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := sample.Verify(tt.thresholds)
+			if len(tt.wantErrs) == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(HaveOccurred())
+			for _, wantErr := range tt.wantErrs {
+				g.Expect(err.Error()).To(ContainSubstring(wantErr))
+			}
+		})
+	}
+}
+
+func Test_writePerformanceSample(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	samples := []PerformanceSample{
+		{Pod: "pod1", CPUSeconds: 1},
+		{Pod: "pod1", CPUSeconds: 2},
+	}
+	for _, sample := range samples {
+		g.Expect(writePerformanceSample(dir, sample)).To(Succeed())
+	}
+
+	f, err := os.Open(path.Join(dir, performanceDataFile))
+	g.Expect(err).ToNot(HaveOccurred())
+	defer f.Close()
+
+	var got []PerformanceSample
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		sample := PerformanceSample{}
+		g.Expect(json.Unmarshal(scanner.Bytes(), &sample)).To(Succeed())
+		got = append(got, sample)
+	}
+	g.Expect(scanner.Err()).ToNot(HaveOccurred())
+	g.Expect(got).To(BeComparableTo(samples))
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"sigs.k8s.io/cluster-api/test/framework/internal/log"
+	"sigs.k8s.io/cluster-api/util"
+)
+
+// VersionMatrixEntry is a Kubernetes version of a version matrix run, with the resources isolating it
+// from the other entries of the run.
+type VersionMatrixEntry struct {
+	// KubernetesVersion is the Kubernetes version of the workload cluster of the entry.
+	KubernetesVersion string
+
+	// Namespace is the namespace dedicated to the entry.
+	Namespace *corev1.Namespace
+
+	// ClusterName is a cluster name which is unique across the entries of the run and across runs.
+	ClusterName string
+
+	// LogFolder is the folder where the logs of the entry should be stored.
+	LogFolder string
+}
+
+// RunVersionMatrixInput is the input for RunVersionMatrix.
+type RunVersionMatrixInput struct {
+	// SpecName is the name of the spec, used as a prefix for the names of the resources of the entries.
+	SpecName string
+
+	// ClusterProxy is the proxy of the management cluster shared by all the entries.
+	ClusterProxy ClusterProxy
+
+	// ClusterctlConfigPath is the path to the clusterctl config file, used to dump the resources of the entries.
+	ClusterctlConfigPath string
+
+	// ArtifactFolder is the folder where the artifacts of the entries are stored.
+	ArtifactFolder string
+
+	// KubernetesVersions are the Kubernetes versions to run, one entry for each version.
+	KubernetesVersions []string
+
+	// Concurrency is the maximum number of entries to run concurrently.
+	// If not set, all the entries are run concurrently.
+	Concurrency int
+
+	// FailFast cancels the context of the entries still running as soon as an entry fails.
+	FailFast bool
+
+	// SkipCleanup skips the deletion of the clusters and of the namespaces of the entries.
+	SkipCleanup bool
+
+	// PostNamespaceCreated allows to inject a function to be run after the namespace of an entry is created.
+	// If not specified, this is a no-op.
+	PostNamespaceCreated func(managementClusterProxy ClusterProxy, workloadClusterNamespace string)
+
+	// DeleteClusterIntervals are the intervals used when waiting for the clusters of an entry to be deleted.
+	DeleteClusterIntervals []interface{}
+}
+
+// RunVersionMatrix runs the given function for each of the Kubernetes versions concurrently, against the shared management cluster.
+// Each entry gets a dedicated namespace and a unique cluster name, so entries of the same run and of runs of
+// other parallel Ginkgo processes don't interfere with each other.
+// Failures of the entries, including Gomega failures, are recorded and returned as an aggregate error after all the entries
+// have completed; resources of each entry are dumped and cleaned up when the entry completes.
+func RunVersionMatrix(ctx context.Context, input RunVersionMatrixInput, run func(ctx context.Context, entry VersionMatrixEntry)) error {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for RunVersionMatrix")
+	Expect(input.SpecName).ToNot(BeEmpty(), "Invalid argument. input.SpecName can't be empty when calling RunVersionMatrix")
+	Expect(input.ClusterProxy).ToNot(BeNil(), "Invalid argument. input.ClusterProxy can't be nil when calling RunVersionMatrix")
+	Expect(input.KubernetesVersions).ToNot(BeEmpty(), "Invalid argument. input.KubernetesVersions can't be empty when calling RunVersionMatrix")
+	Expect(run).ToNot(BeNil(), "Invalid argument. run can't be nil when calling RunVersionMatrix")
+
+	concurrency := input.Concurrency
+	if concurrency <= 0 || concurrency > len(input.KubernetesVersions) {
+		concurrency = len(input.KubernetesVersions)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg        sync.WaitGroup
+		lock      sync.Mutex
+		errs      []error
+		semaphore = make(chan struct{}, concurrency)
+	)
+	for _, version := range input.KubernetesVersions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			if err := runVersionMatrixEntry(ctx, input, version, run); err != nil {
+				lock.Lock()
+				defer lock.Unlock()
+				errs = append(errs, errors.Wrapf(err, "Kubernetes version %s", version))
+				if input.FailFast {
+					cancel()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return kerrors.NewAggregate(errs)
+}
+
+// runVersionMatrixEntry runs an entry of a version matrix run, recovering from Gomega failures so they can be
+// handled by the caller.
+func runVersionMatrixEntry(ctx context.Context, input RunVersionMatrixInput, version string, run func(ctx context.Context, entry VersionMatrixEntry)) (retErr error) {
+	if ctx.Err() != nil {
+		return errors.Wrap(ctx.Err(), "skipped")
+	}
+
+	defer func() {
+		// Gomega failures panic, recover and return them as errors.
+		if r := recover(); r != nil {
+			retErr = errors.Errorf("%v", r)
+		}
+	}()
+
+	name := VersionMatrixResourceName(input.SpecName, version)
+	entry := VersionMatrixEntry{
+		KubernetesVersion: version,
+		ClusterName:       name,
+		LogFolder:         filepath.Join(input.ArtifactFolder, "clusters", input.ClusterProxy.GetName(), name),
+	}
+
+	log.Logf("Creating namespace %s for Kubernetes version %s", name, version)
+	namespace, cancelWatches := CreateNamespaceAndWatchEvents(ctx, CreateNamespaceAndWatchEventsInput{
+		Creator:   input.ClusterProxy.GetClient(),
+		ClientSet: input.ClusterProxy.GetClientSet(),
+		Name:      name,
+		LogFolder: entry.LogFolder,
+	})
+	defer cancelWatches()
+	entry.Namespace = namespace
+
+	if input.PostNamespaceCreated != nil {
+		log.Logf("Calling postNamespaceCreated for namespace %s", namespace.Name)
+		input.PostNamespaceCreated(input.ClusterProxy, namespace.Name)
+	}
+
+	// Resources are dumped and cleaned up also if the entry fails; use a context which is not canceled when
+	// other entries fail.
+	defer cleanupVersionMatrixEntry(context.WithoutCancel(ctx), input, entry)
+
+	run(ctx, entry)
+	return nil
+}
+
+// cleanupVersionMatrixEntry dumps the resources of an entry, then deletes its clusters and its namespace.
+func cleanupVersionMatrixEntry(ctx context.Context, input RunVersionMatrixInput, entry VersionMatrixEntry) {
+	if input.ClusterctlConfigPath != "" {
+		DumpAllResources(ctx, DumpAllResourcesInput{
+			Lister:               input.ClusterProxy.GetClient(),
+			KubeConfigPath:       input.ClusterProxy.GetKubeconfigPath(),
+			ClusterctlConfigPath: input.ClusterctlConfigPath,
+			Namespace:            entry.Namespace.Name,
+			LogPath:              filepath.Join(entry.LogFolder, "resources"),
+		})
+	}
+
+	if input.SkipCleanup {
+		return
+	}
+
+	DeleteAllClustersAndWait(ctx, DeleteAllClustersAndWaitInput{
+		ClusterProxy:         input.ClusterProxy,
+		ClusterctlConfigPath: input.ClusterctlConfigPath,
+		Namespace:            entry.Namespace.Name,
+		ArtifactFolder:       input.ArtifactFolder,
+	}, input.DeleteClusterIntervals...)
+
+	DeleteNamespace(ctx, DeleteNamespaceInput{
+		Deleter: input.ClusterProxy.GetClient(),
+		Name:    entry.Namespace.Name,
+	})
+}
+
+var invalidNameCharacters = regexp.MustCompile("[^a-z0-9-]+")
+
+// VersionMatrixResourceName returns a name for the resources of a version matrix entry, e.g. quick-start-v1-33-0-abc123.
+// The name is a valid DNS-1123 label, and the random suffix makes it unique across concurrent runs.
+func VersionMatrixResourceName(prefix, kubernetesVersion string) string {
+	suffix := util.RandomString(6)
+	name := strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(fmt.Sprintf("%s-%s", prefix, kubernetesVersion)), "-"), "-")
+	if maxLength := validation.DNS1123LabelMaxLength - len(suffix) - 1; len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-")
+	}
+	return fmt.Sprintf("%s-%s", name, suffix)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"sigs.k8s.io/cluster-api/test/framework"
+)
+
+func TestVersionMatrixResourceName(t *testing.T) {
+	tests := []struct {
+		name              string
+		prefix            string
+		kubernetesVersion string
+		wantPrefix        string
+	}{
+		{
+			name:              "release version",
+			prefix:            "quick-start",
+			kubernetesVersion: "v1.33.0",
+			wantPrefix:        "quick-start-v1-33-0-",
+		},
+		{
+			name:              "CI version",
+			prefix:            "quick-start",
+			kubernetesVersion: "v1.34.0-alpha.1.123+abcdef",
+			wantPrefix:        "quick-start-v1-34-0-alpha-1-123-abcdef-",
+		},
+		{
+			name:              "long prefix",
+			prefix:            strings.Repeat("a", 70),
+			kubernetesVersion: "v1.33.0",
+			wantPrefix:        strings.Repeat("a", 56) + "-",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			name := framework.VersionMatrixResourceName(tt.prefix, tt.kubernetesVersion)
+			g.Expect(name).To(HavePrefix(tt.wantPrefix))
+			g.Expect(validation.IsDNS1123Label(name)).To(BeEmpty())
+			g.Expect(framework.VersionMatrixResourceName(tt.prefix, tt.kubernetesVersion)).ToNot(Equal(name))
+		})
+	}
+}
@@ -76,6 +76,9 @@ func RestoreDockerClusterSpec(restored *infrav1.DockerClusterSpec, dst *infrav1.
 	if restored.LoadBalancer.CustomHAProxyConfigTemplateRef != nil {
 		dst.LoadBalancer.CustomHAProxyConfigTemplateRef = restored.LoadBalancer.CustomHAProxyConfigTemplateRef
 	}
+
+	dst.LoadBalancer.ExtraFrontends = restored.LoadBalancer.ExtraFrontends
+	dst.LoadBalancer.HealthCheck = restored.LoadBalancer.HealthCheck
 }
 
 func RestoreDockerClusterStatus(restored *infrav1.DockerClusterStatus, dst *infrav1.DockerClusterStatus) {
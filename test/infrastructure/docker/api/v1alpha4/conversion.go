@@ -67,6 +67,8 @@ func RestoreDockerClusterSpec(restored *infrav1.DockerClusterSpec, dst *infrav1.
 	if restored.LoadBalancer.CustomHAProxyConfigTemplateRef != nil {
 		dst.LoadBalancer.CustomHAProxyConfigTemplateRef = restored.LoadBalancer.CustomHAProxyConfigTemplateRef
 	}
+	dst.LoadBalancer.ExtraFrontends = restored.LoadBalancer.ExtraFrontends
+	dst.LoadBalancer.HealthCheck = restored.LoadBalancer.HealthCheck
 }
 
 func RestoreDockerClusterStatus(restored *infrav1.DockerClusterStatus, dst *infrav1.DockerClusterStatus) {
@@ -112,6 +114,8 @@ func RestoreDockerClusterTemplateSpec(restored *infrav1.DockerClusterTemplateSpe
 	if restored.Template.Spec.LoadBalancer.CustomHAProxyConfigTemplateRef != nil {
 		dst.Template.Spec.LoadBalancer.CustomHAProxyConfigTemplateRef = restored.Template.Spec.LoadBalancer.CustomHAProxyConfigTemplateRef
 	}
+	dst.Template.Spec.LoadBalancer.ExtraFrontends = restored.Template.Spec.LoadBalancer.ExtraFrontends
+	dst.Template.Spec.LoadBalancer.HealthCheck = restored.Template.Spec.LoadBalancer.HealthCheck
 }
 
 func (dst *DockerClusterTemplate) ConvertFrom(srcRaw conversion.Hub) error {
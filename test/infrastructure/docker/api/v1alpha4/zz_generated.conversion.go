@@ -584,6 +584,8 @@ func autoConvert_v1beta2_DockerLoadBalancer_To_v1alpha4_DockerLoadBalancer(in *v
 		return err
 	}
 	// WARNING: in.CustomHAProxyConfigTemplateRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.ExtraFrontends requires manual conversion: does not exist in peer-type
+	// WARNING: in.HealthCheck requires manual conversion: does not exist in peer-type
 	return nil
 }
 
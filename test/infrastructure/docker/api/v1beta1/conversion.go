@@ -54,6 +54,10 @@ func (src *DockerCluster) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Initialization = initialization
 	}
 
+	if ok {
+		restoreDockerLoadBalancer(&restored.Spec.LoadBalancer, &dst.Spec.LoadBalancer)
+	}
+
 	return nil
 }
 
@@ -70,13 +74,32 @@ func (dst *DockerCluster) ConvertFrom(srcRaw conversion.Hub) error {
 func (src *DockerClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*infrav1.DockerClusterTemplate)
 
-	return Convert_v1beta1_DockerClusterTemplate_To_v1beta2_DockerClusterTemplate(src, dst, nil)
+	if err := Convert_v1beta1_DockerClusterTemplate_To_v1beta2_DockerClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1.DockerClusterTemplate{}
+	ok, err := utilconversion.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		restoreDockerLoadBalancer(&restored.Spec.Template.Spec.LoadBalancer, &dst.Spec.Template.Spec.LoadBalancer)
+	}
+
+	return nil
 }
 
 func (dst *DockerClusterTemplate) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*infrav1.DockerClusterTemplate)
 
-	return Convert_v1beta2_DockerClusterTemplate_To_v1beta1_DockerClusterTemplate(src, dst, nil)
+	if err := Convert_v1beta2_DockerClusterTemplate_To_v1beta1_DockerClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, dst)
 }
 
 func (src *DockerMachine) ConvertTo(dstRaw conversion.Hub) error {
@@ -174,6 +197,11 @@ func (src *DevCluster) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Initialization = initialization
 	}
 
+	if ok {
+		restoreInMemoryClusterBackendSpec(&restored.Spec.Backend, &dst.Spec.Backend)
+		restoreDockerClusterBackendSpec(&restored.Spec.Backend, &dst.Spec.Backend)
+	}
+
 	return nil
 }
 
@@ -190,13 +218,56 @@ func (dst *DevCluster) ConvertFrom(srcRaw conversion.Hub) error {
 func (src *DevClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*infrav1.DevClusterTemplate)
 
-	return Convert_v1beta1_DevClusterTemplate_To_v1beta2_DevClusterTemplate(src, dst, nil)
+	if err := Convert_v1beta1_DevClusterTemplate_To_v1beta2_DevClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1.DevClusterTemplate{}
+	ok, err := utilconversion.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		restoreInMemoryClusterBackendSpec(&restored.Spec.Template.Spec.Backend, &dst.Spec.Template.Spec.Backend)
+		restoreDockerClusterBackendSpec(&restored.Spec.Template.Spec.Backend, &dst.Spec.Template.Spec.Backend)
+	}
+
+	return nil
 }
 
 func (dst *DevClusterTemplate) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*infrav1.DevClusterTemplate)
 
-	return Convert_v1beta2_DevClusterTemplate_To_v1beta1_DevClusterTemplate(src, dst, nil)
+	if err := Convert_v1beta2_DevClusterTemplate_To_v1beta1_DevClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, dst)
+}
+
+// restoreInMemoryClusterBackendSpec restores in memory backend fields which do not exist in v1beta1.
+func restoreInMemoryClusterBackendSpec(restored, dst *infrav1.DevClusterBackendSpec) {
+	if restored.InMemory == nil || dst.InMemory == nil {
+		return
+	}
+	dst.InMemory.APIServer = restored.InMemory.APIServer
+	dst.InMemory.Etcd = restored.InMemory.Etcd
+}
+
+// restoreDockerClusterBackendSpec restores docker backend fields which do not exist in v1beta1.
+func restoreDockerClusterBackendSpec(restored, dst *infrav1.DevClusterBackendSpec) {
+	if restored.Docker == nil || dst.Docker == nil {
+		return
+	}
+	restoreDockerLoadBalancer(&restored.Docker.LoadBalancer, &dst.Docker.LoadBalancer)
+}
+
+// restoreDockerLoadBalancer restores load balancer fields which do not exist in v1beta1.
+func restoreDockerLoadBalancer(restored, dst *infrav1.DockerLoadBalancer) {
+	dst.ExtraFrontends = restored.ExtraFrontends
+	dst.HealthCheck = restored.HealthCheck
 }
 
 func (src *DevMachine) ConvertTo(dstRaw conversion.Hub) error {
@@ -651,10 +722,18 @@ func Convert_v1beta1_DockerClusterBackendSpec_To_v1beta2_DockerClusterBackendSpe
 	return nil
 }
 
+func Convert_v1beta2_DockerLoadBalancer_To_v1beta1_DockerLoadBalancer(in *infrav1.DockerLoadBalancer, out *DockerLoadBalancer, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_DockerLoadBalancer_To_v1beta1_DockerLoadBalancer(in, out, s)
+}
+
 func Convert_v1beta2_DockerMachineTemplate_To_v1beta1_DockerMachineTemplate(in *infrav1.DockerMachineTemplate, out *DockerMachineTemplate, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_DockerMachineTemplate_To_v1beta1_DockerMachineTemplate(in, out, s)
 }
 
+func Convert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(in *infrav1.InMemoryClusterBackendSpec, out *InMemoryClusterBackendSpec, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(in, out, s)
+}
+
 func Convert_v1beta2_DevMachineTemplate_To_v1beta1_DevMachineTemplate(in *infrav1.DevMachineTemplate, out *DevMachineTemplate, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_DevMachineTemplate_To_v1beta1_DevMachineTemplate(in, out, s)
 }
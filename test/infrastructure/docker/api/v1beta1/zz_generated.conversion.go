@@ -290,11 +290,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.DockerLoadBalancer)(nil), (*DockerLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_DockerLoadBalancer_To_v1beta1_DockerLoadBalancer(a.(*v1beta2.DockerLoadBalancer), b.(*DockerLoadBalancer), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*DockerMachine)(nil), (*v1beta2.DockerMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DockerMachine_To_v1beta2_DockerMachine(a.(*DockerMachine), b.(*v1beta2.DockerMachine), scope)
 	}); err != nil {
@@ -630,6 +625,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.DockerLoadBalancer)(nil), (*DockerLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DockerLoadBalancer_To_v1beta1_DockerLoadBalancer(a.(*v1beta2.DockerLoadBalancer), b.(*DockerLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.DockerMachinePoolStatus)(nil), (*DockerMachinePoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_DockerMachinePoolStatus_To_v1beta1_DockerMachinePoolStatus(a.(*v1beta2.DockerMachinePoolStatus), b.(*DockerMachinePoolStatus), scope)
 	}); err != nil {
@@ -739,7 +739,15 @@ func autoConvert_v1beta1_DevClusterBackendSpec_To_v1beta2_DevClusterBackendSpec(
 	} else {
 		out.Docker = nil
 	}
-	out.InMemory = (*v1beta2.InMemoryClusterBackendSpec)(unsafe.Pointer(in.InMemory))
+	if in.InMemory != nil {
+		in, out := &in.InMemory, &out.InMemory
+		*out = new(v1beta2.InMemoryClusterBackendSpec)
+		if err := Convert_v1beta1_InMemoryClusterBackendSpec_To_v1beta2_InMemoryClusterBackendSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.InMemory = nil
+	}
 	return nil
 }
 
@@ -758,7 +766,15 @@ func autoConvert_v1beta2_DevClusterBackendSpec_To_v1beta1_DevClusterBackendSpec(
 	} else {
 		out.Docker = nil
 	}
-	out.InMemory = (*InMemoryClusterBackendSpec)(unsafe.Pointer(in.InMemory))
+	if in.InMemory != nil {
+		in, out := &in.InMemory, &out.InMemory
+		*out = new(InMemoryClusterBackendSpec)
+		if err := Convert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.InMemory = nil
+	}
 	return nil
 }
 
@@ -1587,14 +1603,11 @@ func autoConvert_v1beta2_DockerLoadBalancer_To_v1beta1_DockerLoadBalancer(in *v1
 		return err
 	}
 	out.CustomHAProxyConfigTemplateRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.CustomHAProxyConfigTemplateRef))
+	// WARNING: in.ExtraFrontends requires manual conversion: does not exist in peer-type
+	// WARNING: in.HealthCheck requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_DockerLoadBalancer_To_v1beta1_DockerLoadBalancer is an autogenerated conversion function.
-func Convert_v1beta2_DockerLoadBalancer_To_v1beta1_DockerLoadBalancer(in *v1beta2.DockerLoadBalancer, out *DockerLoadBalancer, s conversion.Scope) error {
-	return autoConvert_v1beta2_DockerLoadBalancer_To_v1beta1_DockerLoadBalancer(in, out, s)
-}
-
 func autoConvert_v1beta1_DockerMachine_To_v1beta2_DockerMachine(in *DockerMachine, out *v1beta2.DockerMachine, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_DockerMachineSpec_To_v1beta2_DockerMachineSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2287,14 +2300,11 @@ func Convert_v1beta1_InMemoryClusterBackendSpec_To_v1beta2_InMemoryClusterBacken
 }
 
 func autoConvert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(in *v1beta2.InMemoryClusterBackendSpec, out *InMemoryClusterBackendSpec, s conversion.Scope) error {
+	// WARNING: in.APIServer requires manual conversion: does not exist in peer-type
+	// WARNING: in.Etcd requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec is an autogenerated conversion function.
-func Convert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(in *v1beta2.InMemoryClusterBackendSpec, out *InMemoryClusterBackendSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_InMemoryEtcdSpec_To_v1beta2_InMemoryEtcdSpec(in *InMemoryEtcdSpec, out *v1beta2.InMemoryEtcdSpec, s conversion.Scope) error {
 	if err := Convert_v1beta1_CommonProvisioningSettings_To_v1beta2_CommonProvisioningSettings(&in.Provisioning, &out.Provisioning, s); err != nil {
 		return err
@@ -109,7 +109,85 @@ type DockerClusterBackendSpec struct {
 }
 
 // InMemoryClusterBackendSpec defines backend for a DevCluster that runs in memory.
-type InMemoryClusterBackendSpec struct{}
+type InMemoryClusterBackendSpec struct {
+	// apiServer defines the behaviour of the simulated API server of the workload cluster.
+	// +optional
+	APIServer *InMemoryClusterAPIServerSpec `json:"apiServer,omitempty"`
+
+	// etcd defines the behaviour of the simulated etcd members of the workload cluster.
+	// +optional
+	Etcd *InMemoryClusterEtcdSpec `json:"etcd,omitempty"`
+}
+
+// InMemoryClusterAPIServerSpec defines the behaviour of the simulated API server of the workload cluster.
+type InMemoryClusterAPIServerSpec struct {
+	// latencyProfile defines latency and errors injected into requests served by the simulated API server.
+	// +optional
+	LatencyProfile *InMemoryLatencyProfile `json:"latencyProfile,omitempty"`
+
+	// watchStall defines periodic stalls of the watches served by the simulated API server.
+	// +optional
+	WatchStall *InMemoryWatchStall `json:"watchStall,omitempty"`
+}
+
+// InMemoryClusterEtcdSpec defines the behaviour of the simulated etcd members of the workload cluster.
+type InMemoryClusterEtcdSpec struct {
+	// latencyProfile defines latency and errors injected into requests served by the simulated etcd members.
+	// +optional
+	LatencyProfile *InMemoryLatencyProfile `json:"latencyProfile,omitempty"`
+}
+
+// InMemoryLatencyProfile defines latency and errors injected into requests served by simulated components.
+type InMemoryLatencyProfile struct {
+	// latency is added to every request, unless a different latency is defined for the request verb in verbLatencies.
+	// +optional
+	Latency metav1.Duration `json:"latency,omitempty"`
+
+	// verbLatencies defines the latency added to requests with a specific verb.
+	// For the API server verbs are get, list, watch, create, update, patch and delete; for etcd verbs are
+	// the names of the gRPC methods, e.g. MemberList or Status.
+	// +optional
+	// +listType=map
+	// +listMapKey=verb
+	// +kubebuilder:validation:MaxItems=32
+	VerbLatencies []InMemoryVerbLatency `json:"verbLatencies,omitempty"`
+
+	// jitter adds some randomness on latency; the actual latency will be latency plus an additional
+	// amount chosen uniformly at random from the interval between zero and `jitter*latency`.
+	// NOTE: this is modeled as string because the usage of float is highly discouraged, as support for them varies across languages.
+	// +optional
+	Jitter string `json:"jitter,omitempty"`
+
+	// errorRatio is the ratio of requests, between 0 and 1, failing with an unavailable error after latency is added.
+	// NOTE: this is modeled as string because the usage of float is highly discouraged, as support for them varies across languages.
+	// +optional
+	ErrorRatio string `json:"errorRatio,omitempty"`
+}
+
+// InMemoryVerbLatency defines the latency added to requests with a specific verb.
+type InMemoryVerbLatency struct {
+	// verb of the requests the latency applies to.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Verb string `json:"verb"`
+
+	// latency added to requests with the verb.
+	// +required
+	Latency metav1.Duration `json:"latency"`
+}
+
+// InMemoryWatchStall defines periodic stalls of watches.
+// While a watch is stalled events are not delivered; they are delivered all at once when the stall ends.
+type InMemoryWatchStall struct {
+	// interval is the time between the start of a watch, or the end of a stall, and the start of the next stall.
+	// +required
+	Interval metav1.Duration `json:"interval"`
+
+	// duration is the duration of each stall.
+	// +required
+	Duration metav1.Duration `json:"duration"`
+}
 
 // DevClusterStatus defines the observed state of the DevCluster.
 type DevClusterStatus struct {
@@ -67,8 +67,78 @@ type DockerLoadBalancer struct {
 	// $BackendControlPlanePort (string) indicates the backend control plane port, $BackendServers (map[string]string) indicates the backend server
 	// where the key is the server name and the value is the address. This map is dynamic and is updated every time a new control plane
 	// node is added or removed. The template will also support the JoinHostPort function to join the host and port of the backend server.
+	// $ExtraFrontends ([]struct) and $HealthCheck (struct) are populated from extraFrontends and healthCheck; see the loadbalancer.ConfigData
+	// type of the docker provider for the available fields.
 	// +optional
 	CustomHAProxyConfigTemplateRef *corev1.LocalObjectReference `json:"customHAProxyConfigTemplateRef,omitempty"`
+
+	// extraFrontends allows adding frontends to the HAProxy config, each one forwarding TCP traffic to a port of the
+	// control plane machines, e.g. to expose the API server on an alternative port or to expose konnectivity.
+	// Traffic is not terminated by HAProxy, so TLS is passed through to the control plane machines.
+	// Extra frontends are exposed on the docker network only; they are not published to the host.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	ExtraFrontends []DockerLoadBalancerFrontend `json:"extraFrontends,omitempty"`
+
+	// healthCheck allows customizing the health checks of the backend servers.
+	// +optional
+	HealthCheck DockerLoadBalancerHealthCheck `json:"healthCheck,omitempty,omitzero"`
+}
+
+// DockerLoadBalancerFrontend defines an additional frontend of the cluster load balancer.
+type DockerLoadBalancerFrontend struct {
+	// name of the frontend; it is also used as a name for the corresponding backend.
+	// The names "stats", "control-plane" and "kube-apiservers" are reserved.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name,omitempty"`
+
+	// port is the port the load balancer listens on.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+
+	// backendPort is the port of the control plane machines traffic is forwarded to.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	BackendPort int32 `json:"backendPort,omitempty"`
+}
+
+// DockerLoadBalancerHealthCheck defines the health checks of the backend servers of the cluster load balancer.
+// +kubebuilder:validation:MinProperties=1
+type DockerLoadBalancerHealthCheck struct {
+	// path is the path used for the HTTPS health check of the API servers.
+	// Backend servers of extra frontends are checked by opening a TCP connection.
+	// Defaults to /healthz.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^/[^\s]*$`
+	Path string `json:"path,omitempty"`
+
+	// intervalSeconds is the interval between two consecutive health checks of a backend server.
+	// If not set, the HAProxy default is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	IntervalSeconds *int32 `json:"intervalSeconds,omitempty"`
+
+	// riseCount is the number of consecutive successful health checks for a backend server to be considered healthy.
+	// If not set, the HAProxy default is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RiseCount *int32 `json:"riseCount,omitempty"`
+
+	// fallCount is the number of consecutive failed health checks for a backend server to be considered unhealthy.
+	// If not set, the HAProxy default is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	FallCount *int32 `json:"fallCount,omitempty"`
 }
 
 // ImageMeta allows customizing the image used for components that are not
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	// InClusterIPPoolClaimFinalizer allows the InClusterIPPool controllers to release the allocated address
+	// before removing an IPAddressClaim from the API server.
+	InClusterIPPoolClaimFinalizer = "inclusterippool.infrastructure.cluster.x-k8s.io"
+)
+
+// InClusterIPPool's Ready condition and corresponding reasons.
+const (
+	// InClusterIPPoolReadyCondition is true if the InClusterIPPool spec is valid and the pool has free addresses.
+	InClusterIPPoolReadyCondition = clusterv1.ReadyCondition
+
+	// InClusterIPPoolReadyReason surfaces when the InClusterIPPool is ready.
+	InClusterIPPoolReadyReason = clusterv1.ReadyReason
+
+	// InClusterIPPoolInvalidReason surfaces when the addresses or the gateway of the InClusterIPPool are not valid.
+	InClusterIPPoolInvalidReason = "Invalid"
+
+	// InClusterIPPoolExhaustedReason surfaces when all the addresses of the InClusterIPPool are allocated.
+	InClusterIPPoolExhaustedReason = "Exhausted"
+)
+
+// InClusterIPPoolSpec defines the desired state of InClusterIPPool.
+type InClusterIPPoolSpec struct {
+	// addresses is a list of IP addresses that can be allocated by the pool.
+	// Each entry can be a single IP address, a range of IP addresses in the form "first-last",
+	// or a CIDR. When using CIDRs, the network address and the IPv4 broadcast address are not allocated.
+	// +required
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=256
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=100
+	Addresses []string `json:"addresses,omitempty"`
+
+	// prefix is the network prefix to use for the allocated addresses.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	Prefix *int32 `json:"prefix,omitempty"`
+
+	// gateway is the network gateway of the allocated addresses; it is never allocated by the pool.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=39
+	Gateway string `json:"gateway,omitempty"`
+}
+
+// InClusterIPPoolStatus defines the observed state of InClusterIPPool.
+// +kubebuilder:validation:MinProperties=1
+type InClusterIPPoolStatus struct {
+	// conditions represents the observations of an InClusterIPPool's current state.
+	// Known condition types are Ready.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// addresses reports the number of addresses of the pool.
+	// +optional
+	Addresses *InClusterIPPoolAddressesStatus `json:"addresses,omitempty"`
+}
+
+// InClusterIPPoolAddressesStatus reports the number of addresses of an InClusterIPPool.
+type InClusterIPPoolAddressesStatus struct {
+	// total is the number of addresses that can be allocated by the pool.
+	// +optional
+	Total *int32 `json:"total,omitempty"`
+
+	// used is the number of addresses that are currently allocated.
+	// +optional
+	Used *int32 `json:"used,omitempty"`
+
+	// free is the number of addresses that are still available for allocation.
+	// +optional
+	Free *int32 `json:"free,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=inclusterippools,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Prefix",type="integer",JSONPath=".spec.prefix",description="Network prefix of the allocated addresses"
+// +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=".status.addresses.total",description="Number of addresses of the pool"
+// +kubebuilder:printcolumn:name="Free",type="integer",JSONPath=".status.addresses.free",description="Number of free addresses of the pool"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="InClusterIPPool pass all readiness checks"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of the InClusterIPPool"
+
+// InClusterIPPool is the schema for the reference in-cluster IP pool API; it fulfills
+// IPAddressClaims referencing it as defined by the IPAM contract.
+type InClusterIPPool struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec is the desired state of InClusterIPPool.
+	// +required
+	Spec InClusterIPPoolSpec `json:"spec,omitempty,omitzero"`
+	// status is the observed state of InClusterIPPool.
+	// +optional
+	Status InClusterIPPoolStatus `json:"status,omitempty,omitzero"`
+}
+
+// GetConditions returns the set of conditions for this object.
+func (p *InClusterIPPool) GetConditions() []metav1.Condition {
+	return p.Status.Conditions
+}
+
+// SetConditions sets conditions for an API object.
+func (p *InClusterIPPool) SetConditions(conditions []metav1.Condition) {
+	p.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// InClusterIPPoolList contains a list of InClusterIPPool.
+type InClusterIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard list's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-lists
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// items is the list of InClusterIPPools.
+	Items []InClusterIPPool `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &InClusterIPPool{}, &InClusterIPPoolList{})
+}
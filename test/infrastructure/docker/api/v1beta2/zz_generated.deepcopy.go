@@ -96,7 +96,7 @@ func (in *DevClusterBackendSpec) DeepCopyInto(out *DevClusterBackendSpec) {
 	if in.InMemory != nil {
 		in, out := &in.InMemory, &out.InMemory
 		*out = new(InMemoryClusterBackendSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -984,6 +984,12 @@ func (in *DockerLoadBalancer) DeepCopyInto(out *DockerLoadBalancer) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.ExtraFrontends != nil {
+		in, out := &in.ExtraFrontends, &out.ExtraFrontends
+		*out = make([]DockerLoadBalancerFrontend, len(*in))
+		copy(*out, *in)
+	}
+	in.HealthCheck.DeepCopyInto(&out.HealthCheck)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerLoadBalancer.
@@ -996,6 +1002,51 @@ func (in *DockerLoadBalancer) DeepCopy() *DockerLoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DockerLoadBalancerFrontend) DeepCopyInto(out *DockerLoadBalancerFrontend) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerLoadBalancerFrontend.
+func (in *DockerLoadBalancerFrontend) DeepCopy() *DockerLoadBalancerFrontend {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerLoadBalancerFrontend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DockerLoadBalancerHealthCheck) DeepCopyInto(out *DockerLoadBalancerHealthCheck) {
+	*out = *in
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RiseCount != nil {
+		in, out := &in.RiseCount, &out.RiseCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FallCount != nil {
+		in, out := &in.FallCount, &out.FallCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerLoadBalancerHealthCheck.
+func (in *DockerLoadBalancerHealthCheck) DeepCopy() *DockerLoadBalancerHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerLoadBalancerHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerMachine) DeepCopyInto(out *DockerMachine) {
 	*out = *in
@@ -1656,6 +1707,147 @@ func (in *ImageMeta) DeepCopy() *ImageMeta {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InClusterIPPool) DeepCopyInto(out *InClusterIPPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InClusterIPPool.
+func (in *InClusterIPPool) DeepCopy() *InClusterIPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(InClusterIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InClusterIPPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InClusterIPPoolAddressesStatus) DeepCopyInto(out *InClusterIPPoolAddressesStatus) {
+	*out = *in
+	if in.Total != nil {
+		in, out := &in.Total, &out.Total
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Free != nil {
+		in, out := &in.Free, &out.Free
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InClusterIPPoolAddressesStatus.
+func (in *InClusterIPPoolAddressesStatus) DeepCopy() *InClusterIPPoolAddressesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InClusterIPPoolAddressesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InClusterIPPoolList) DeepCopyInto(out *InClusterIPPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InClusterIPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InClusterIPPoolList.
+func (in *InClusterIPPoolList) DeepCopy() *InClusterIPPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(InClusterIPPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InClusterIPPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InClusterIPPoolSpec) DeepCopyInto(out *InClusterIPPoolSpec) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InClusterIPPoolSpec.
+func (in *InClusterIPPoolSpec) DeepCopy() *InClusterIPPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InClusterIPPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InClusterIPPoolStatus) DeepCopyInto(out *InClusterIPPoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = new(InClusterIPPoolAddressesStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InClusterIPPoolStatus.
+func (in *InClusterIPPoolStatus) DeepCopy() *InClusterIPPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InClusterIPPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InMemoryAPIServerSpec) DeepCopyInto(out *InMemoryAPIServerSpec) {
 	*out = *in
@@ -1672,9 +1864,44 @@ func (in *InMemoryAPIServerSpec) DeepCopy() *InMemoryAPIServerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InMemoryClusterAPIServerSpec) DeepCopyInto(out *InMemoryClusterAPIServerSpec) {
+	*out = *in
+	if in.LatencyProfile != nil {
+		in, out := &in.LatencyProfile, &out.LatencyProfile
+		*out = new(InMemoryLatencyProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WatchStall != nil {
+		in, out := &in.WatchStall, &out.WatchStall
+		*out = new(InMemoryWatchStall)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InMemoryClusterAPIServerSpec.
+func (in *InMemoryClusterAPIServerSpec) DeepCopy() *InMemoryClusterAPIServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InMemoryClusterAPIServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InMemoryClusterBackendSpec) DeepCopyInto(out *InMemoryClusterBackendSpec) {
 	*out = *in
+	if in.APIServer != nil {
+		in, out := &in.APIServer, &out.APIServer
+		*out = new(InMemoryClusterAPIServerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Etcd != nil {
+		in, out := &in.Etcd, &out.Etcd
+		*out = new(InMemoryClusterEtcdSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InMemoryClusterBackendSpec.
@@ -1687,6 +1914,26 @@ func (in *InMemoryClusterBackendSpec) DeepCopy() *InMemoryClusterBackendSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InMemoryClusterEtcdSpec) DeepCopyInto(out *InMemoryClusterEtcdSpec) {
+	*out = *in
+	if in.LatencyProfile != nil {
+		in, out := &in.LatencyProfile, &out.LatencyProfile
+		*out = new(InMemoryLatencyProfile)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InMemoryClusterEtcdSpec.
+func (in *InMemoryClusterEtcdSpec) DeepCopy() *InMemoryClusterEtcdSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InMemoryClusterEtcdSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InMemoryEtcdSpec) DeepCopyInto(out *InMemoryEtcdSpec) {
 	*out = *in
@@ -1703,6 +1950,27 @@ func (in *InMemoryEtcdSpec) DeepCopy() *InMemoryEtcdSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InMemoryLatencyProfile) DeepCopyInto(out *InMemoryLatencyProfile) {
+	*out = *in
+	out.Latency = in.Latency
+	if in.VerbLatencies != nil {
+		in, out := &in.VerbLatencies, &out.VerbLatencies
+		*out = make([]InMemoryVerbLatency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InMemoryLatencyProfile.
+func (in *InMemoryLatencyProfile) DeepCopy() *InMemoryLatencyProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(InMemoryLatencyProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InMemoryMachineBackendSpec) DeepCopyInto(out *InMemoryMachineBackendSpec) {
 	*out = *in
@@ -1770,6 +2038,39 @@ func (in *InMemoryVMSpec) DeepCopy() *InMemoryVMSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InMemoryVerbLatency) DeepCopyInto(out *InMemoryVerbLatency) {
+	*out = *in
+	out.Latency = in.Latency
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InMemoryVerbLatency.
+func (in *InMemoryVerbLatency) DeepCopy() *InMemoryVerbLatency {
+	if in == nil {
+		return nil
+	}
+	out := new(InMemoryVerbLatency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InMemoryWatchStall) DeepCopyInto(out *InMemoryWatchStall) {
+	*out = *in
+	out.Interval = in.Interval
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InMemoryWatchStall.
+func (in *InMemoryWatchStall) DeepCopy() *InMemoryWatchStall {
+	if in == nil {
+		return nil
+	}
+	out := new(InMemoryWatchStall)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Mount) DeepCopyInto(out *Mount) {
 	*out = *in
@@ -528,7 +528,7 @@ func (r *MachineBackendReconciler) reconcileLoadBalancerConfiguration(ctx contex
 	if err != nil {
 		return errors.Wrap(err, "failed to retrieve HAProxy configuration from CustomHAProxyConfigTemplateRef")
 	}
-	if err := externalLoadBalancer.UpdateConfiguration(ctx, controlPlaneWeight, unsafeLoadBalancerConfigTemplate); err != nil {
+	if err := externalLoadBalancer.UpdateConfiguration(ctx, controlPlaneWeight, dockerCluster.Spec.Backend.Docker.LoadBalancer, unsafeLoadBalancerConfigTemplate); err != nil {
 		return errors.Wrap(err, "failed to update DockerCluster.loadbalancer configuration")
 	}
 	return nil
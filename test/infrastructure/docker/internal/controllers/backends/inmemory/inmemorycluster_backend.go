@@ -19,6 +19,8 @@ package inmemory
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -34,6 +36,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
 	inmemoryruntime "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/runtime"
 	inmemoryserver "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server"
+	"sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/latency"
 	"sigs.k8s.io/cluster-api/util/patch"
 )
 
@@ -120,6 +123,15 @@ func (r *ClusterBackendReconciler) ReconcileNormal(ctx context.Context, cluster
 		return ctrl.Result{}, errors.Wrap(err, "failed to register the resource group for the workload cluster")
 	}
 
+	// Set latency and error injection for the workload cluster's API server and etcd members.
+	apiServerLatencyProfile, etcdLatencyProfile, err := latencyProfiles(inMemoryCluster.Spec.Backend.InMemory)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.APIServerMux.SetLatencyProfiles(listenerName, apiServerLatencyProfile, etcdLatencyProfile); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to set latency profiles for the workload cluster")
+	}
+
 	// Surface the control plane endpoint
 	if inMemoryCluster.Spec.ControlPlaneEndpoint.Host == "" {
 		inMemoryCluster.Spec.ControlPlaneEndpoint.Host = listener.Host()
@@ -169,3 +181,63 @@ func (r *ClusterBackendReconciler) PatchDevCluster(ctx context.Context, patchHel
 		}},
 	)
 }
+
+// latencyProfiles returns the latency profiles for the API server and the etcd members of a workload cluster.
+func latencyProfiles(spec *infrav1.InMemoryClusterBackendSpec) (apiServer, etcd *latency.Profile, err error) {
+	if spec.APIServer != nil {
+		apiServer, err = latencyProfile(spec.APIServer.LatencyProfile)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to parse API server's latencyProfile")
+		}
+		if spec.APIServer.WatchStall != nil {
+			if apiServer == nil {
+				apiServer = &latency.Profile{}
+			}
+			apiServer.WatchStall = &latency.WatchStall{
+				Interval: spec.APIServer.WatchStall.Interval.Duration,
+				Duration: spec.APIServer.WatchStall.Duration.Duration,
+			}
+		}
+	}
+	if spec.Etcd != nil {
+		etcd, err = latencyProfile(spec.Etcd.LatencyProfile)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to parse etcd's latencyProfile")
+		}
+	}
+	return apiServer, etcd, nil
+}
+
+func latencyProfile(spec *infrav1.InMemoryLatencyProfile) (*latency.Profile, error) {
+	if spec == nil {
+		return nil, nil
+	}
+
+	profile := &latency.Profile{
+		Latency: spec.Latency.Duration,
+	}
+	if len(spec.VerbLatencies) > 0 {
+		profile.VerbLatencies = map[string]time.Duration{}
+		for _, v := range spec.VerbLatencies {
+			profile.VerbLatencies[v.Verb] = v.Latency.Duration
+		}
+	}
+	if spec.Jitter != "" {
+		jitter, err := strconv.ParseFloat(spec.Jitter, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse jitter")
+		}
+		profile.Jitter = jitter
+	}
+	if spec.ErrorRatio != "" {
+		errorRatio, err := strconv.ParseFloat(spec.ErrorRatio, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse errorRatio")
+		}
+		if errorRatio < 0 || errorRatio > 1 {
+			return nil, errors.Errorf("errorRatio must be between 0 and 1, got %s", spec.ErrorRatio)
+		}
+		profile.ErrorRatio = errorRatio
+	}
+	return profile, nil
+}
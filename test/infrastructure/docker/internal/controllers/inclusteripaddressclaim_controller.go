@@ -0,0 +1,326 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	"sigs.k8s.io/cluster-api/exp/ipam"
+	infrav1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/finalizers"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+)
+
+// InClusterIPAddressClaimReconciler reconciles IPAddressClaims referencing an InClusterIPPool.
+type InClusterIPAddressClaimReconciler struct {
+	client.Client
+
+	// APIReader is used to list IPAddresses, so addresses are never allocated twice because of a stale cache.
+	APIReader client.Reader
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+
+	// allocationLock serializes address allocations.
+	allocationLock sync.Mutex
+}
+
+// SetupWithManager will add watches for this controller.
+func (r *InClusterIPAddressClaimReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	if r.Client == nil || r.APIReader == nil {
+		return errors.New("Client and APIReader must not be nil")
+	}
+
+	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "inclusteripaddressclaim")
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&ipamv1.IPAddressClaim{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(o client.Object) bool {
+			claim, ok := o.(*ipamv1.IPAddressClaim)
+			return ok && ipam.ReferencesPool(claim, inClusterIPPoolGroupKind)
+		}))).
+		Named("inclusteripaddressclaim").
+		WithOptions(options).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		Watches(
+			&infrav1.InClusterIPPool{},
+			handler.EnqueueRequestsFromMapFunc(r.inClusterIPPoolToIPAddressClaims),
+		).
+		Watches(
+			&clusterv1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(r.clusterToIPAddressClaims),
+			builder.WithPredicates(predicates.ClusterPausedTransitions(mgr.GetScheme(), predicateLog)),
+		).Complete(r)
+	if err != nil {
+		return errors.Wrap(err, "failed setting up with a controller manager")
+	}
+	return nil
+}
+
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=inclusterippools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
+
+func (r *InClusterIPAddressClaimReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, rerr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	claim := &ipamv1.IPAddressClaim{}
+	if err := r.Get(ctx, req.NamespacedName, claim); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Ignore IPAddressClaims for pools managed by other IPAM providers.
+	if !ipam.ReferencesPool(claim, inClusterIPPoolGroupKind) {
+		return ctrl.Result{}, nil
+	}
+
+	// Fetch the Cluster and skip reconciliation if it is paused, as defined by the IPAM contract.
+	clusterName := claim.Spec.ClusterName
+	if clusterName == "" {
+		clusterName = claim.Labels[clusterv1.ClusterNameLabel]
+	}
+	if clusterName != "" {
+		cluster, err := util.GetClusterByName(ctx, r.Client, claim.Namespace, clusterName)
+		switch {
+		case apierrors.IsNotFound(errors.Cause(err)):
+			// Note: Deletion is allowed to proceed without the Cluster, so the IPAddressClaim doesn't get stuck
+			// when the Cluster is deleted first.
+			if claim.DeletionTimestamp.IsZero() {
+				log.Info(fmt.Sprintf("Cluster %s referenced by the IPAddressClaim not found, skipping reconciliation", clusterName))
+				return ctrl.Result{}, nil
+			}
+		case err != nil:
+			return ctrl.Result{}, err
+		default:
+			log = log.WithValues("Cluster", klog.KObj(cluster))
+			ctx = ctrl.LoggerInto(ctx, log)
+
+			if annotations.IsPaused(cluster, claim) {
+				log.Info("Reconciliation is paused for this object")
+				return ctrl.Result{}, nil
+			}
+		}
+	}
+
+	// Add finalizer first if not set to avoid the race condition between init and delete.
+	if finalizerAdded, err := finalizers.EnsureFinalizer(ctx, r.Client, claim, infrav1.InClusterIPPoolClaimFinalizer); err != nil || finalizerAdded {
+		return ctrl.Result{}, err
+	}
+
+	patchHelper, err := patch.NewHelper(claim, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, claim, patch.WithOwnedConditions{Conditions: []string{
+			ipamv1.IPAddressClaimReadyCondition,
+		}}); err != nil {
+			rerr = errors.Wrapf(err, "failed to patch IPAddressClaim")
+		}
+	}()
+
+	if !claim.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.reconcileDelete(ctx, claim)
+	}
+	return ctrl.Result{}, r.reconcileNormal(ctx, claim)
+}
+
+func (r *InClusterIPAddressClaimReconciler) reconcileNormal(ctx context.Context, claim *ipamv1.IPAddressClaim) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	pool := &infrav1.InClusterIPPool{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: claim.Namespace, Name: claim.Spec.PoolRef.Name}, pool); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get InClusterIPPool %s", claim.Spec.PoolRef.Name)
+		}
+		conditions.Set(claim, metav1.Condition{
+			Type:    ipamv1.IPAddressClaimReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ipamv1.IPAddressClaimReadyPoolNotReadyReason,
+			Message: fmt.Sprintf("InClusterIPPool %s does not exist", claim.Spec.PoolRef.Name),
+		})
+		return nil
+	}
+
+	// If the address is already allocated, make sure the IPAddressClaim is pointing to it.
+	ipAddress := &ipamv1.IPAddress{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(claim), ipAddress); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get IPAddress %s", klog.KObj(claim))
+		}
+		ipAddress = nil
+	}
+	if ipAddress == nil {
+		var err error
+		if ipAddress, err = r.allocate(ctx, claim, pool); err != nil || ipAddress == nil {
+			return err
+		}
+		log.Info(fmt.Sprintf("Allocated address %s from InClusterIPPool %s", ipAddress.Spec.Address, pool.Name), "IPAddress", klog.KObj(ipAddress))
+	}
+
+	claim.Status.AddressRef = ipamv1.IPAddressReference{Name: ipAddress.Name}
+	conditions.Set(claim, metav1.Condition{
+		Type:   ipamv1.IPAddressClaimReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: clusterv1.ReadyReason,
+	})
+	return nil
+}
+
+// allocate creates an IPAddress for the IPAddressClaim with the first free address of the pool.
+// If the pool is not valid or exhausted, the Ready condition of the IPAddressClaim is set accordingly and nil is returned.
+func (r *InClusterIPAddressClaimReconciler) allocate(ctx context.Context, claim *ipamv1.IPAddressClaim, pool *infrav1.InClusterIPPool) (*ipamv1.IPAddress, error) {
+	addresses, err := parsePoolAddresses(pool.Spec)
+	if err != nil {
+		conditions.Set(claim, metav1.Condition{
+			Type:    ipamv1.IPAddressClaimReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ipamv1.IPAddressClaimReadyPoolNotReadyReason,
+			Message: fmt.Sprintf("InClusterIPPool %s is not valid: %s", pool.Name, err.Error()),
+		})
+		return nil, nil
+	}
+
+	r.allocationLock.Lock()
+	defer r.allocationLock.Unlock()
+
+	used, err := usedAddresses(ctx, r.APIReader, pool)
+	if err != nil {
+		return nil, err
+	}
+	address, ok := addresses.nextFree(used)
+	if !ok {
+		conditions.Set(claim, metav1.Condition{
+			Type:    ipamv1.IPAddressClaimReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ipamv1.IPAddressClaimReadyPoolExhaustedReason,
+			Message: fmt.Sprintf("All addresses of InClusterIPPool %s are allocated", pool.Name),
+		})
+		return nil, nil
+	}
+
+	gateway := ""
+	if addresses.gateway.IsValid() {
+		gateway = addresses.gateway.String()
+	}
+	ipAddress, err := ipam.NewIPAddress(r.Scheme(), claim, pool, address.String(), ptr.Deref(pool.Spec.Prefix, 0), gateway)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Create(ctx, ipAddress); err != nil {
+		conditions.Set(claim, metav1.Condition{
+			Type:    ipamv1.IPAddressClaimReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  ipamv1.IPAddressClaimReadyAllocationFailedReason,
+			Message: "Failed to create IPAddress, please check controller logs for errors",
+		})
+		return nil, errors.Wrapf(err, "failed to create IPAddress %s", klog.KObj(ipAddress))
+	}
+	return ipAddress, nil
+}
+
+func (r *InClusterIPAddressClaimReconciler) reconcileDelete(ctx context.Context, claim *ipamv1.IPAddressClaim) error {
+	ipAddress := &ipamv1.IPAddress{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(claim), ipAddress); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get IPAddress %s", klog.KObj(claim))
+		}
+		ipAddress = nil
+	}
+
+	// Release the address by removing the finalizer protecting the IPAddress and by deleting it.
+	if ipAddress != nil && metav1.IsControlledBy(ipAddress, claim) {
+		if controllerutil.ContainsFinalizer(ipAddress, ipam.ProtectAddressFinalizer) {
+			ipAddressPatchHelper, err := patch.NewHelper(ipAddress, r.Client)
+			if err != nil {
+				return err
+			}
+			controllerutil.RemoveFinalizer(ipAddress, ipam.ProtectAddressFinalizer)
+			if err := ipAddressPatchHelper.Patch(ctx, ipAddress); err != nil {
+				return errors.Wrapf(err, "failed to remove finalizer from IPAddress %s", klog.KObj(ipAddress))
+			}
+		}
+		if err := r.Delete(ctx, ipAddress); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete IPAddress %s", klog.KObj(ipAddress))
+		}
+	}
+
+	controllerutil.RemoveFinalizer(claim, infrav1.InClusterIPPoolClaimFinalizer)
+	return nil
+}
+
+// inClusterIPPoolToIPAddressClaims maps an InClusterIPPool to the IPAddressClaims referencing it;
+// this allows to allocate addresses as soon as the pool is created, fixed or gets free addresses.
+func (r *InClusterIPAddressClaimReconciler) inClusterIPPoolToIPAddressClaims(ctx context.Context, o client.Object) []reconcile.Request {
+	claims := &ipamv1.IPAddressClaimList{}
+	if err := r.List(ctx, claims, client.InNamespace(o.GetNamespace())); err != nil {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, claim := range claims.Items {
+		if referencesInClusterIPPool(claim.Spec.PoolRef, o.GetName()) && claim.Status.AddressRef.Name == "" {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&claim)})
+		}
+	}
+	return requests
+}
+
+// clusterToIPAddressClaims maps a Cluster to the IPAddressClaims belonging to it, so reconciliation
+// resumes when the Cluster is unpaused.
+func (r *InClusterIPAddressClaimReconciler) clusterToIPAddressClaims(ctx context.Context, o client.Object) []reconcile.Request {
+	claims := &ipamv1.IPAddressClaimList{}
+	if err := r.List(ctx, claims, client.InNamespace(o.GetNamespace())); err != nil {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, claim := range claims.Items {
+		if !ipam.ReferencesPool(&claim, inClusterIPPoolGroupKind) {
+			continue
+		}
+		if claim.Spec.ClusterName == o.GetName() || claim.Labels[clusterv1.ClusterNameLabel] == o.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&claim)})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	"sigs.k8s.io/cluster-api/exp/ipam"
+	infrav1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestInClusterIPAddressClaimReconciler(t *testing.T) {
+	pool := &infrav1.InClusterIPPool{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "pool", UID: "pool-uid"},
+		Spec: infrav1.InClusterIPPoolSpec{
+			Addresses: []string{"10.0.0.1-10.0.0.2"},
+			Prefix:    ptr.To[int32](24),
+			Gateway:   "10.0.0.1",
+		},
+	}
+	ipamCluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "ipam-cluster"}}
+	newClaim := func(name string) *ipamv1.IPAddressClaim {
+		return &ipamv1.IPAddressClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name},
+			Spec: ipamv1.IPAddressClaimSpec{
+				ClusterName: ipamCluster.Name,
+				PoolRef:     ipamv1.IPPoolReference{APIGroup: infrav1.GroupVersion.Group, Kind: "InClusterIPPool", Name: pool.Name},
+			},
+		}
+	}
+	reconcile := func(g *WithT, r *InClusterIPAddressClaimReconciler, claim *ipamv1.IPAddressClaim) *ipamv1.IPAddressClaim {
+		// Reconcile twice, the first reconcile only adds the finalizer.
+		for range 2 {
+			_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(claim)})
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+		got := &ipamv1.IPAddressClaim{}
+		if err := r.Get(t.Context(), client.ObjectKeyFromObject(claim), got); apierrors.IsNotFound(err) {
+			return nil
+		}
+		return got
+	}
+
+	t.Run("allocates addresses until the pool is exhausted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		claim0, claim1 := newClaim("claim-0"), newClaim("claim-1")
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithObjects(pool.DeepCopy(), ipamCluster.DeepCopy(), claim0, claim1).
+			WithStatusSubresource(&ipamv1.IPAddressClaim{}).Build()
+		r := &InClusterIPAddressClaimReconciler{Client: c, APIReader: c}
+
+		got := reconcile(g, r, claim0)
+		g.Expect(got.Finalizers).To(ConsistOf(infrav1.InClusterIPPoolClaimFinalizer))
+		g.Expect(got.Status.AddressRef.Name).To(Equal(claim0.Name))
+		g.Expect(conditions.IsTrue(got, ipamv1.IPAddressClaimReadyCondition)).To(BeTrue())
+
+		ipAddress := &ipamv1.IPAddress{}
+		g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(claim0), ipAddress)).To(Succeed())
+		g.Expect(ipAddress.Spec.Address).To(Equal("10.0.0.2"))
+		g.Expect(ipAddress.Spec.Prefix).To(Equal(ptr.To[int32](24)))
+		g.Expect(ipAddress.Spec.Gateway).To(Equal("10.0.0.1"))
+		g.Expect(ipAddress.Finalizers).To(ConsistOf(ipam.ProtectAddressFinalizer))
+		g.Expect(ipAddress.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, ipamCluster.Name))
+
+		got = reconcile(g, r, claim1)
+		g.Expect(got.Status.AddressRef.Name).To(BeEmpty())
+		g.Expect(conditions.IsFalse(got, ipamv1.IPAddressClaimReadyCondition)).To(BeTrue())
+		g.Expect(conditions.GetReason(got, ipamv1.IPAddressClaimReadyCondition)).To(Equal(ipamv1.IPAddressClaimReadyPoolExhaustedReason))
+	})
+	t.Run("reports claims for pools that do not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		claim := newClaim("claim-0")
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithObjects(ipamCluster.DeepCopy(), claim).
+			WithStatusSubresource(&ipamv1.IPAddressClaim{}).Build()
+		r := &InClusterIPAddressClaimReconciler{Client: c, APIReader: c}
+
+		got := reconcile(g, r, claim)
+		g.Expect(conditions.GetReason(got, ipamv1.IPAddressClaimReadyCondition)).To(Equal(ipamv1.IPAddressClaimReadyPoolNotReadyReason))
+	})
+	t.Run("skips claims of paused clusters", func(t *testing.T) {
+		g := NewWithT(t)
+
+		pausedCluster := ipamCluster.DeepCopy()
+		pausedCluster.Spec.Paused = ptr.To(true)
+		claim := newClaim("claim-0")
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithObjects(pool.DeepCopy(), pausedCluster, claim).
+			WithStatusSubresource(&ipamv1.IPAddressClaim{}).Build()
+		r := &InClusterIPAddressClaimReconciler{Client: c, APIReader: c}
+
+		got := reconcile(g, r, claim)
+		g.Expect(got.Finalizers).To(BeEmpty())
+		g.Expect(got.Status.AddressRef.Name).To(BeEmpty())
+	})
+	t.Run("skips claims for other pools", func(t *testing.T) {
+		g := NewWithT(t)
+
+		claim := newClaim("claim-0")
+		claim.Spec.PoolRef.Kind = "OtherPool"
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithObjects(pool.DeepCopy(), ipamCluster.DeepCopy(), claim).
+			WithStatusSubresource(&ipamv1.IPAddressClaim{}).Build()
+		r := &InClusterIPAddressClaimReconciler{Client: c, APIReader: c}
+
+		got := reconcile(g, r, claim)
+		g.Expect(got.Finalizers).To(BeEmpty())
+	})
+	t.Run("releases the address when the claim is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		claim := newClaim("claim-0")
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithObjects(pool.DeepCopy(), ipamCluster.DeepCopy(), claim).
+			WithStatusSubresource(&ipamv1.IPAddressClaim{}).Build()
+		r := &InClusterIPAddressClaimReconciler{Client: c, APIReader: c}
+
+		got := reconcile(g, r, claim)
+		g.Expect(got.Status.AddressRef.Name).ToNot(BeEmpty())
+
+		g.Expect(c.Delete(t.Context(), got)).To(Succeed())
+		g.Expect(reconcile(g, r, claim)).To(BeNil())
+
+		err := c.Get(t.Context(), client.ObjectKeyFromObject(claim), &ipamv1.IPAddress{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+}
+
+func TestInClusterIPPoolReconciler(t *testing.T) {
+	g := NewWithT(t)
+
+	pool := &infrav1.InClusterIPPool{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "pool"},
+		Spec: infrav1.InClusterIPPoolSpec{
+			Addresses: []string{"10.0.0.0/29"},
+			Prefix:    ptr.To[int32](29),
+			Gateway:   "10.0.0.1",
+		},
+	}
+	ipAddress := &ipamv1.IPAddress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "claim-0"},
+		Spec: ipamv1.IPAddressSpec{
+			PoolRef: ipamv1.IPPoolReference{APIGroup: infrav1.GroupVersion.Group, Kind: "InClusterIPPool", Name: pool.Name},
+			Address: "10.0.0.2",
+			Prefix:  ptr.To[int32](29),
+		},
+	}
+	otherPoolIPAddress := ipAddress.DeepCopy()
+	otherPoolIPAddress.Name = "claim-1"
+	otherPoolIPAddress.Spec.PoolRef.Name = "other-pool"
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).
+		WithObjects(pool, ipAddress, otherPoolIPAddress).
+		WithStatusSubresource(&infrav1.InClusterIPPool{}).Build()
+	r := &InClusterIPPoolReconciler{Client: c}
+
+	_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(pool)})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	got := &infrav1.InClusterIPPool{}
+	g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(pool), got)).To(Succeed())
+	g.Expect(got.Status.Addresses).To(Equal(&infrav1.InClusterIPPoolAddressesStatus{
+		Total: ptr.To[int32](5),
+		Used:  ptr.To[int32](1),
+		Free:  ptr.To[int32](4),
+	}))
+	g.Expect(conditions.IsTrue(got, infrav1.InClusterIPPoolReadyCondition)).To(BeTrue())
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"math"
+	"math/big"
+	"net/netip"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
+)
+
+// addressRange is a range of IP addresses, first and last included.
+type addressRange struct {
+	first netip.Addr
+	last  netip.Addr
+}
+
+// poolAddresses are the addresses that can be allocated by an InClusterIPPool.
+type poolAddresses struct {
+	ranges  []addressRange
+	gateway netip.Addr
+}
+
+// parsePoolAddresses parses and validates the addresses and the gateway of an InClusterIPPool.
+func parsePoolAddresses(spec infrav1.InClusterIPPoolSpec) (*poolAddresses, error) {
+	p := &poolAddresses{}
+	for _, address := range spec.Addresses {
+		r, err := parseAddressRange(address)
+		if err != nil {
+			return nil, err
+		}
+		if len(p.ranges) > 0 && p.ranges[0].first.Is4() != r.first.Is4() {
+			return nil, errors.Errorf("address %q has a different IP family than address %q", address, spec.Addresses[0])
+		}
+		p.ranges = append(p.ranges, r)
+	}
+	if len(p.ranges) == 0 {
+		return nil, errors.New("addresses must not be empty")
+	}
+
+	if spec.Prefix == nil {
+		return nil, errors.New("prefix must be set")
+	}
+	if maxPrefix := int32(p.ranges[0].first.BitLen()); *spec.Prefix < 0 || *spec.Prefix > maxPrefix {
+		return nil, errors.Errorf("prefix %d must be between 0 and %d", *spec.Prefix, maxPrefix)
+	}
+
+	if spec.Gateway != "" {
+		gateway, err := netip.ParseAddr(spec.Gateway)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid gateway %q", spec.Gateway)
+		}
+		if gateway.Is4() != p.ranges[0].first.Is4() {
+			return nil, errors.Errorf("gateway %q has a different IP family than the pool addresses", spec.Gateway)
+		}
+		p.gateway = gateway
+	}
+	return p, nil
+}
+
+// parseAddressRange parses a single IP address, a range of IP addresses in the form "first-last" or a CIDR.
+// The network address and the IPv4 broadcast address of a CIDR are not part of the range, unless
+// the CIDR is too small to have them.
+func parseAddressRange(address string) (addressRange, error) {
+	if first, last, ok := strings.Cut(address, "-"); ok {
+		firstAddr, err := netip.ParseAddr(strings.TrimSpace(first))
+		if err != nil {
+			return addressRange{}, errors.Wrapf(err, "invalid address range %q", address)
+		}
+		lastAddr, err := netip.ParseAddr(strings.TrimSpace(last))
+		if err != nil {
+			return addressRange{}, errors.Wrapf(err, "invalid address range %q", address)
+		}
+		if firstAddr.Is4() != lastAddr.Is4() || lastAddr.Less(firstAddr) {
+			return addressRange{}, errors.Errorf("invalid address range %q: first and last address must have the same IP family and first must not be greater than last", address)
+		}
+		return addressRange{first: firstAddr, last: lastAddr}, nil
+	}
+
+	if strings.Contains(address, "/") {
+		prefix, err := netip.ParsePrefix(address)
+		if err != nil {
+			return addressRange{}, errors.Wrapf(err, "invalid CIDR %q", address)
+		}
+		prefix = prefix.Masked()
+		r := addressRange{first: prefix.Addr(), last: lastAddress(prefix)}
+		if hostBits := prefix.Addr().BitLen() - prefix.Bits(); hostBits > 1 {
+			r.first = r.first.Next()
+			if r.last.Is4() {
+				r.last = r.last.Prev()
+			}
+		}
+		return r, nil
+	}
+
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return addressRange{}, errors.Wrapf(err, "invalid address %q", address)
+	}
+	return addressRange{first: addr, last: addr}, nil
+}
+
+// lastAddress returns the last address of a masked prefix.
+func lastAddress(prefix netip.Prefix) netip.Addr {
+	b := prefix.Addr().AsSlice()
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 1 << (7 - uint(i%8))
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+// total returns the number of addresses that can be allocated, capped to MaxInt32.
+func (p *poolAddresses) total() int32 {
+	total := big.NewInt(0)
+	for _, r := range p.ranges {
+		size := new(big.Int).Sub(new(big.Int).SetBytes(r.last.AsSlice()), new(big.Int).SetBytes(r.first.AsSlice()))
+		total.Add(total, size.Add(size, big.NewInt(1)))
+		if p.gateway.IsValid() && r.contains(p.gateway) {
+			total.Sub(total, big.NewInt(1))
+		}
+	}
+	if !total.IsInt64() || total.Int64() > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(total.Int64())
+}
+
+// nextFree returns the first address of the pool which is not the gateway and not used.
+func (p *poolAddresses) nextFree(used sets.Set[string]) (netip.Addr, bool) {
+	for _, r := range p.ranges {
+		for addr := r.first; addr.IsValid() && !r.last.Less(addr); addr = addr.Next() {
+			if addr == p.gateway || used.Has(addr.String()) {
+				continue
+			}
+			return addr, true
+		}
+	}
+	return netip.Addr{}, false
+}
+
+func (r addressRange) contains(addr netip.Addr) bool {
+	return !addr.Less(r.first) && !r.last.Less(addr)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
+)
+
+func TestParsePoolAddresses(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      infrav1.InClusterIPPoolSpec
+		wantErr   bool
+		wantTotal int32
+		used      []string
+		wantNext  string
+	}{
+		{
+			name:      "single addresses",
+			spec:      infrav1.InClusterIPPoolSpec{Addresses: []string{"10.0.0.1", "10.0.0.3"}, Prefix: ptr.To[int32](24)},
+			wantTotal: 2,
+			used:      []string{"10.0.0.1"},
+			wantNext:  "10.0.0.3",
+		},
+		{
+			name:      "ranges skip the gateway",
+			spec:      infrav1.InClusterIPPoolSpec{Addresses: []string{"10.0.0.1-10.0.0.10"}, Prefix: ptr.To[int32](24), Gateway: "10.0.0.1"},
+			wantTotal: 9,
+			wantNext:  "10.0.0.2",
+		},
+		{
+			name:      "IPv4 CIDRs skip network and broadcast addresses",
+			spec:      infrav1.InClusterIPPoolSpec{Addresses: []string{"10.0.0.0/30"}, Prefix: ptr.To[int32](30)},
+			wantTotal: 2,
+			used:      []string{"10.0.0.1"},
+			wantNext:  "10.0.0.2",
+		},
+		{
+			name:      "IPv4 /31 CIDRs include all addresses",
+			spec:      infrav1.InClusterIPPoolSpec{Addresses: []string{"10.0.0.0/31"}, Prefix: ptr.To[int32](31)},
+			wantTotal: 2,
+			wantNext:  "10.0.0.0",
+		},
+		{
+			name:      "IPv6 CIDRs skip network address only",
+			spec:      infrav1.InClusterIPPoolSpec{Addresses: []string{"fd00::/126"}, Prefix: ptr.To[int32](64)},
+			wantTotal: 3,
+			used:      []string{"fd00::1", "fd00::2"},
+			wantNext:  "fd00::3",
+		},
+		{
+			name:      "total is capped",
+			spec:      infrav1.InClusterIPPoolSpec{Addresses: []string{"fd00::/64"}, Prefix: ptr.To[int32](64)},
+			wantTotal: math.MaxInt32,
+			wantNext:  "fd00::1",
+		},
+		{
+			name:      "no free addresses",
+			spec:      infrav1.InClusterIPPoolSpec{Addresses: []string{"10.0.0.1-10.0.0.2"}, Prefix: ptr.To[int32](24), Gateway: "10.0.0.2"},
+			wantTotal: 1,
+			used:      []string{"10.0.0.1"},
+		},
+		{
+			name:    "invalid address",
+			spec:    infrav1.InClusterIPPoolSpec{Addresses: []string{"10.0.0.300"}, Prefix: ptr.To[int32](24)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid range",
+			spec:    infrav1.InClusterIPPoolSpec{Addresses: []string{"10.0.0.10-10.0.0.1"}, Prefix: ptr.To[int32](24)},
+			wantErr: true,
+		},
+		{
+			name:    "mixed IP families",
+			spec:    infrav1.InClusterIPPoolSpec{Addresses: []string{"10.0.0.1", "fd00::1"}, Prefix: ptr.To[int32](24)},
+			wantErr: true,
+		},
+		{
+			name:    "prefix too big for IPv4",
+			spec:    infrav1.InClusterIPPoolSpec{Addresses: []string{"10.0.0.1"}, Prefix: ptr.To[int32](64)},
+			wantErr: true,
+		},
+		{
+			name:    "gateway with a different IP family",
+			spec:    infrav1.InClusterIPPoolSpec{Addresses: []string{"10.0.0.1"}, Prefix: ptr.To[int32](24), Gateway: "fd00::1"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			addresses, err := parsePoolAddresses(tt.spec)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(addresses.total()).To(Equal(tt.wantTotal))
+
+			next, ok := addresses.nextFree(sets.New(tt.used...))
+			if tt.wantNext == "" {
+				g.Expect(ok).To(BeFalse())
+				return
+			}
+			g.Expect(ok).To(BeTrue())
+			g.Expect(next.String()).To(Equal(tt.wantNext))
+		})
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	infrav1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+)
+
+// inClusterIPPoolGroupKind is the GroupKind IPAddressClaims have to reference to be fulfilled by InClusterIPPools.
+var inClusterIPPoolGroupKind = schema.GroupKind{Group: infrav1.GroupVersion.Group, Kind: "InClusterIPPool"}
+
+// InClusterIPPoolReconciler reconciles an InClusterIPPool object.
+type InClusterIPPoolReconciler struct {
+	client.Client
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+}
+
+// SetupWithManager will add watches for this controller.
+func (r *InClusterIPPoolReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	if r.Client == nil {
+		return errors.New("Client must not be nil")
+	}
+
+	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "inclusterippool")
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.InClusterIPPool{}).
+		WithOptions(options).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		Watches(
+			&ipamv1.IPAddress{},
+			handler.EnqueueRequestsFromMapFunc(ipAddressToInClusterIPPool),
+		).Complete(r)
+	if err != nil {
+		return errors.Wrap(err, "failed setting up with a controller manager")
+	}
+	return nil
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=inclusterippools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=inclusterippools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses,verbs=get;list;watch
+
+func (r *InClusterIPPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, rerr error) {
+	pool := &infrav1.InClusterIPPool{}
+	if err := r.Get(ctx, req.NamespacedName, pool); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	patchHelper, err := patch.NewHelper(pool, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, pool, patch.WithOwnedConditions{Conditions: []string{
+			infrav1.InClusterIPPoolReadyCondition,
+		}}); err != nil {
+			rerr = errors.Wrapf(err, "failed to patch InClusterIPPool")
+		}
+	}()
+
+	addresses, err := parsePoolAddresses(pool.Spec)
+	if err != nil {
+		pool.Status.Addresses = nil
+		conditions.Set(pool, metav1.Condition{
+			Type:    infrav1.InClusterIPPoolReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.InClusterIPPoolInvalidReason,
+			Message: err.Error(),
+		})
+		return ctrl.Result{}, nil
+	}
+
+	used, err := usedAddresses(ctx, r.Client, pool)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	total := addresses.total()
+	free := max(total-int32(used.Len()), 0)
+	pool.Status.Addresses = &infrav1.InClusterIPPoolAddressesStatus{
+		Total: ptr.To(total),
+		Used:  ptr.To(int32(used.Len())),
+		Free:  ptr.To(free),
+	}
+
+	if free == 0 {
+		conditions.Set(pool, metav1.Condition{
+			Type:    infrav1.InClusterIPPoolReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.InClusterIPPoolExhaustedReason,
+			Message: "All addresses are allocated",
+		})
+		return ctrl.Result{}, nil
+	}
+
+	conditions.Set(pool, metav1.Condition{
+		Type:   infrav1.InClusterIPPoolReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.InClusterIPPoolReadyReason,
+	})
+	return ctrl.Result{}, nil
+}
+
+// usedAddresses returns the addresses of the IPAddresses allocated from an InClusterIPPool.
+func usedAddresses(ctx context.Context, c client.Reader, pool *infrav1.InClusterIPPool) (sets.Set[string], error) {
+	ipAddresses := &ipamv1.IPAddressList{}
+	if err := c.List(ctx, ipAddresses, client.InNamespace(pool.Namespace)); err != nil {
+		return nil, errors.Wrap(err, "failed to list IPAddresses")
+	}
+
+	used := sets.New[string]()
+	for _, ipAddress := range ipAddresses.Items {
+		if !referencesInClusterIPPool(ipAddress.Spec.PoolRef, pool.Name) {
+			continue
+		}
+		// Normalize addresses, so they can be compared with the ones computed by the pool.
+		addr, err := netip.ParseAddr(ipAddress.Spec.Address)
+		if err != nil {
+			continue
+		}
+		used.Insert(addr.String())
+	}
+	return used, nil
+}
+
+func referencesInClusterIPPool(poolRef ipamv1.IPPoolReference, name string) bool {
+	return poolRef.APIGroup == inClusterIPPoolGroupKind.Group && poolRef.Kind == inClusterIPPoolGroupKind.Kind && poolRef.Name == name
+}
+
+// ipAddressToInClusterIPPool maps IPAddresses to the InClusterIPPool they are allocated from,
+// so the pool status is updated when addresses are allocated or released.
+func ipAddressToInClusterIPPool(_ context.Context, o client.Object) []reconcile.Request {
+	ipAddress, ok := o.(*ipamv1.IPAddress)
+	if !ok {
+		return nil
+	}
+	if ipAddress.Spec.PoolRef.APIGroup != inClusterIPPoolGroupKind.Group || ipAddress.Spec.PoolRef.Kind != inClusterIPPoolGroupKind.Kind {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Namespace: ipAddress.Namespace, Name: ipAddress.Spec.PoolRef.Name}}}
+}
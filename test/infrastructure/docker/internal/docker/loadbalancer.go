@@ -19,13 +19,16 @@ package docker
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/kind/pkg/cluster/constants"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/test/infrastructure/container"
+	infrav1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
 	"sigs.k8s.io/cluster-api/test/infrastructure/docker/internal/docker/types"
 	"sigs.k8s.io/cluster-api/test/infrastructure/docker/internal/loadbalancer"
 )
@@ -133,8 +136,9 @@ func (s *LoadBalancer) Create(ctx context.Context) error {
 	return nil
 }
 
-// UpdateConfiguration updates the external load balancer configuration with new control plane nodes.
-func (s *LoadBalancer) UpdateConfiguration(ctx context.Context, weights map[string]int, unsafeLoadBalancerConfig string) error {
+// UpdateConfiguration updates the external load balancer configuration with new control plane nodes,
+// with the extra frontends and the health check settings from the DockerLoadBalancer spec.
+func (s *LoadBalancer) UpdateConfiguration(ctx context.Context, weights map[string]int, spec infrav1.DockerLoadBalancer, unsafeLoadBalancerConfig string) error {
 	log := ctrl.LoggerFrom(ctx)
 
 	if s.container == nil {
@@ -145,7 +149,23 @@ func (s *LoadBalancer) UpdateConfiguration(ctx context.Context, weights map[stri
 		FrontendControlPlanePort: s.frontendControlPlanePort,
 		BackendControlPlanePort:  s.backendControlPlanePort,
 		BackendServers:           map[string]loadbalancer.BackendServer{},
-		IPv6:                     s.ipFamily == container.IPv6IPFamily,
+		HealthCheck: loadbalancer.HealthCheck{
+			Path:            loadbalancer.DefaultHealthCheckPath,
+			IntervalSeconds: ptr.Deref(spec.HealthCheck.IntervalSeconds, 0),
+			RiseCount:       ptr.Deref(spec.HealthCheck.RiseCount, 0),
+			FallCount:       ptr.Deref(spec.HealthCheck.FallCount, 0),
+		},
+		IPv6: s.ipFamily == container.IPv6IPFamily,
+	}
+	if spec.HealthCheck.Path != "" {
+		configData.HealthCheck.Path = spec.HealthCheck.Path
+	}
+	for _, frontend := range spec.ExtraFrontends {
+		configData.ExtraFrontends = append(configData.ExtraFrontends, loadbalancer.Frontend{
+			Name:         frontend.Name,
+			FrontendPort: strconv.Itoa(int(frontend.Port)),
+			BackendPort:  strconv.Itoa(int(frontend.BackendPort)),
+		})
 	}
 
 	// collect info about the existing controlplane nodes
@@ -29,6 +29,8 @@ type ConfigData struct {
 	FrontendControlPlanePort string
 	BackendControlPlanePort  string
 	BackendServers           map[string]BackendServer
+	ExtraFrontends           []Frontend
+	HealthCheck              HealthCheck
 	IPv6                     bool
 }
 
@@ -38,6 +40,22 @@ type BackendServer struct {
 	Weight  int
 }
 
+// Frontend defines an additional loadbalancer frontend forwarding traffic to a port of the backend servers.
+type Frontend struct {
+	Name         string
+	FrontendPort string
+	BackendPort  string
+}
+
+// HealthCheck defines the health checks of the loadbalancer backend servers.
+// Zero values for IntervalSeconds, RiseCount and FallCount mean that the HAProxy defaults are used.
+type HealthCheck struct {
+	Path            string
+	IntervalSeconds int32
+	RiseCount       int32
+	FallCount       int32
+}
+
 // DefaultTemplate is the loadbalancer config template.
 const DefaultTemplate = `# generated by kind
 global
@@ -78,11 +96,25 @@ frontend control-plane
   default_backend kube-apiservers
 
 backend kube-apiservers
-  option httpchk GET /healthz
+  option httpchk GET {{ .HealthCheck.Path }}
   {{range $server, $backend := .BackendServers}}
-  server {{ $server }} {{ JoinHostPort $backend.Address $.BackendControlPlanePort }} weight {{ $backend.Weight }} check check-ssl verify none resolvers docker resolve-prefer {{ if $.IPv6 -}} ipv6 {{- else -}} ipv4 {{- end }}
+  server {{ $server }} {{ JoinHostPort $backend.Address $.BackendControlPlanePort }} weight {{ $backend.Weight }} check{{ template "check-options" $ }} check-ssl verify none resolvers docker resolve-prefer {{ if $.IPv6 -}} ipv6 {{- else -}} ipv4 {{- end }}
   {{- end}}
-`
+{{- range $frontend := .ExtraFrontends }}
+
+frontend {{ $frontend.Name }}
+  bind *:{{ $frontend.FrontendPort }}
+  {{ if $.IPv6 -}}
+  bind :::{{ $frontend.FrontendPort }};
+  {{- end }}
+  default_backend {{ $frontend.Name }}
+
+backend {{ $frontend.Name }}
+  {{- range $server, $backend := $.BackendServers }}
+  server {{ $server }} {{ JoinHostPort $backend.Address $frontend.BackendPort }} weight {{ $backend.Weight }} check{{ template "check-options" $ }} resolvers docker resolve-prefer {{ if $.IPv6 -}} ipv6 {{- else -}} ipv4 {{- end }}
+  {{- end }}
+{{- end }}
+{{ define "check-options" }}{{ with .HealthCheck.IntervalSeconds }} inter {{ . }}s{{ end }}{{ with .HealthCheck.RiseCount }} rise {{ . }}{{ end }}{{ with .HealthCheck.FallCount }} fall {{ . }}{{ end }}{{ end }}`
 
 // Config generates the loadbalancer config from the ConfigTemplate and ConfigData.
 func Config(data *ConfigData, configTemplate string) (config string, err error) {
@@ -42,6 +42,9 @@ func TestConfig(t *testing.T) {
 						Weight:  99,
 					},
 				},
+				HealthCheck: HealthCheck{
+					Path: DefaultHealthCheckPath,
+				},
 			},
 			configTemplate: DefaultTemplate,
 			expectedConfig: `# generated by kind
@@ -84,6 +87,83 @@ backend kube-apiservers
   option httpchk GET /healthz
   
   server control-plane-0 1.1.1.1:6443 weight 99 check check-ssl verify none resolvers docker resolve-prefer ipv4
+`,
+		},
+		{
+			name: "should return default HA proxy config with extra frontends and health check settings",
+			data: &ConfigData{
+				BackendControlPlanePort:  "6443",
+				FrontendControlPlanePort: "7777",
+				BackendServers: map[string]BackendServer{
+					"control-plane-0": {
+						Address: "fd00::1",
+						Weight:  99,
+					},
+				},
+				ExtraFrontends: []Frontend{
+					{
+						Name:         "konnectivity",
+						FrontendPort: "8132",
+						BackendPort:  "8132",
+					},
+				},
+				HealthCheck: HealthCheck{
+					Path:            "/readyz",
+					IntervalSeconds: 2,
+					RiseCount:       3,
+					FallCount:       4,
+				},
+				IPv6: true,
+			},
+			configTemplate: DefaultTemplate,
+			expectedConfig: `# generated by kind
+global
+  log /dev/log local0
+  log /dev/log local1 notice
+  daemon
+  # limit memory usage to approximately 18 MB
+  # (see https://github.com/kubernetes-sigs/kind/pull/3115)
+  maxconn 100000
+
+resolvers docker
+  nameserver dns 127.0.0.11:53
+
+defaults
+  log global
+  mode tcp
+  option dontlognull
+  # TODO: tune these
+  timeout connect 5000
+  timeout client 50000
+  timeout server 50000
+  # allow to boot despite dns don't resolve backends
+  default-server init-addr none
+
+frontend stats
+  mode http
+  bind *:8404
+  stats enable
+  stats uri /stats
+  stats refresh 1s
+  stats admin if TRUE
+
+frontend control-plane
+  bind *:7777
+  bind :::7777;
+  default_backend kube-apiservers
+
+backend kube-apiservers
+  option httpchk GET /readyz
+  
+  server control-plane-0 [fd00::1]:6443 weight 99 check inter 2s rise 3 fall 4 check-ssl verify none resolvers docker resolve-prefer ipv6
+
+frontend konnectivity
+  bind *:8132
+  bind :::8132;
+  default_backend konnectivity
+
+backend konnectivity
+  server control-plane-0 [fd00::1]:8132 weight 99 check inter 2s rise 3 fall 4 resolvers docker resolve-prefer ipv6
 `,
 		},
 		{
@@ -28,4 +28,7 @@ const (
 
 	// ConfigPath is the path to the config file in the image.
 	ConfigPath = "/usr/local/etc/haproxy/haproxy.cfg"
+
+	// DefaultHealthCheckPath is the path used for the health check of the API servers.
+	DefaultHealthCheckPath = "/healthz"
 )
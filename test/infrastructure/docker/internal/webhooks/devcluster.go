@@ -116,6 +116,8 @@ func validateDevClusterSpec(spec infrav1.DevClusterSpec) field.ErrorList {
 	if spec.Backend.Docker == nil {
 		return nil
 	}
+	var allErrs field.ErrorList
+
 	domainNames := make([]string, 0, len(spec.Backend.Docker.FailureDomains))
 	for _, fd := range spec.Backend.Docker.FailureDomains {
 		domainNames = append(domainNames, fd.Name)
@@ -123,8 +125,10 @@ func validateDevClusterSpec(spec infrav1.DevClusterSpec) field.ErrorList {
 	originalDomainNames := slices.Clone(domainNames)
 	sort.Strings(domainNames)
 	if !slices.Equal(originalDomainNames, domainNames) {
-		return field.ErrorList{field.Invalid(field.NewPath("spec", "backend", "docker", "failureDomains"), spec.Backend.Docker.FailureDomains, "failure domains must be sorted by name")}
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "backend", "docker", "failureDomains"), spec.Backend.Docker.FailureDomains, "failure domains must be sorted by name"))
 	}
 
-	return nil
+	allErrs = append(allErrs, validateDockerLoadBalancer(spec.Backend.Docker.LoadBalancer, spec.ControlPlaneEndpoint.Port, field.NewPath("spec", "backend", "docker", "loadBalancer"))...)
+
+	return allErrs
 }
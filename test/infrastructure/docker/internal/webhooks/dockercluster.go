@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"slices"
 	"sort"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -105,6 +106,8 @@ func defaultDockerClusterSpec(s *infrav1.DockerClusterSpec) {
 }
 
 func validateDockerClusterSpec(spec infrav1.DockerClusterSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
 	domainNames := make([]string, 0, len(spec.FailureDomains))
 	for _, fd := range spec.FailureDomains {
 		domainNames = append(domainNames, fd.Name)
@@ -112,7 +115,40 @@ func validateDockerClusterSpec(spec infrav1.DockerClusterSpec) field.ErrorList {
 	originalDomainNames := slices.Clone(domainNames)
 	sort.Strings(domainNames)
 	if !slices.Equal(originalDomainNames, domainNames) {
-		return field.ErrorList{field.Invalid(field.NewPath("spec", "failureDomains"), spec.FailureDomains, "failure domains must be sorted by name")}
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "failureDomains"), spec.FailureDomains, "failure domains must be sorted by name"))
 	}
-	return nil
+
+	allErrs = append(allErrs, validateDockerLoadBalancer(spec.LoadBalancer, spec.ControlPlaneEndpoint.Port, field.NewPath("spec", "loadBalancer"))...)
+
+	return allErrs
+}
+
+// validateDockerLoadBalancer validates that extra frontends do not conflict with the frontends
+// of the default HAProxy config and with each other.
+func validateDockerLoadBalancer(loadBalancer infrav1.DockerLoadBalancer, controlPlanePort int32, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if controlPlanePort == 0 {
+		controlPlanePort = 6443
+	}
+	ports := map[int32]string{
+		controlPlanePort: "the control plane endpoint port",
+		// The port of the HAProxy stats frontend.
+		8404: "the stats port",
+	}
+	for i, frontend := range loadBalancer.ExtraFrontends {
+		if slices.Contains(reservedLoadBalancerFrontendNames, frontend.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("extraFrontends").Index(i).Child("name"), frontend.Name, fmt.Sprintf("must not be one of %s", strings.Join(reservedLoadBalancerFrontendNames, ", "))))
+		}
+		if usedBy, ok := ports[frontend.Port]; ok {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("extraFrontends").Index(i).Child("port"), frontend.Port, fmt.Sprintf("port is already used by %s", usedBy)))
+			continue
+		}
+		ports[frontend.Port] = fmt.Sprintf("extra frontend %s", frontend.Name)
+	}
+
+	return allErrs
 }
+
+// reservedLoadBalancerFrontendNames are the names of the frontends and backends of the default HAProxy config.
+var reservedLoadBalancerFrontendNames = []string{"stats", "control-plane", "kube-apiservers"}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
+)
+
+func TestValidateDockerClusterSpecLoadBalancer(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      infrav1.DockerClusterSpec
+		expectErr bool
+	}{
+		{
+			name: "should pass with extra frontends",
+			spec: infrav1.DockerClusterSpec{
+				LoadBalancer: infrav1.DockerLoadBalancer{
+					ExtraFrontends: []infrav1.DockerLoadBalancerFrontend{
+						{Name: "konnectivity", Port: 8132, BackendPort: 8132},
+						{Name: "apiserver-alt", Port: 443, BackendPort: 6443},
+					},
+				},
+			},
+		},
+		{
+			name: "should fail if an extra frontend uses a reserved name",
+			spec: infrav1.DockerClusterSpec{
+				LoadBalancer: infrav1.DockerLoadBalancer{
+					ExtraFrontends: []infrav1.DockerLoadBalancerFrontend{
+						{Name: "kube-apiservers", Port: 8132, BackendPort: 8132},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should fail if an extra frontend uses the control plane endpoint port",
+			spec: infrav1.DockerClusterSpec{
+				ControlPlaneEndpoint: infrav1.APIEndpoint{Port: 7777},
+				LoadBalancer: infrav1.DockerLoadBalancer{
+					ExtraFrontends: []infrav1.DockerLoadBalancerFrontend{
+						{Name: "apiserver-alt", Port: 7777, BackendPort: 6443},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should fail if an extra frontend uses the default control plane endpoint port",
+			spec: infrav1.DockerClusterSpec{
+				LoadBalancer: infrav1.DockerLoadBalancer{
+					ExtraFrontends: []infrav1.DockerLoadBalancerFrontend{
+						{Name: "apiserver-alt", Port: 6443, BackendPort: 6443},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should fail if an extra frontend uses the stats port",
+			spec: infrav1.DockerClusterSpec{
+				LoadBalancer: infrav1.DockerLoadBalancer{
+					ExtraFrontends: []infrav1.DockerLoadBalancerFrontend{
+						{Name: "konnectivity", Port: 8404, BackendPort: 8132},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should fail if two extra frontends use the same port",
+			spec: infrav1.DockerClusterSpec{
+				LoadBalancer: infrav1.DockerLoadBalancer{
+					ExtraFrontends: []infrav1.DockerLoadBalancerFrontend{
+						{Name: "konnectivity", Port: 8132, BackendPort: 8132},
+						{Name: "konnectivity-alt", Port: 8132, BackendPort: 8133},
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			allErrs := validateDockerClusterSpec(tt.spec)
+			if tt.expectErr {
+				g.Expect(allErrs).ToNot(BeEmpty())
+			} else {
+				g.Expect(allErrs).To(BeEmpty())
+			}
+		})
+	}
+}
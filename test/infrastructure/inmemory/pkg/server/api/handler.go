@@ -52,6 +52,7 @@ import (
 	inmemoryruntime "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/runtime"
 	inmemoryclient "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/runtime/client"
 	inmemoryportforward "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/api/portforward"
+	"sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/latency"
 )
 
 // ResourceGroupResolver defines a func that can identify which workloadCluster/resourceGroup a
@@ -59,18 +60,20 @@ import (
 type ResourceGroupResolver func(host string) (string, error)
 
 // NewAPIServerHandler returns an http.Handler for a fake API server.
-func NewAPIServerHandler(manager inmemoryruntime.Manager, log logr.Logger, resolver ResourceGroupResolver) http.Handler {
+func NewAPIServerHandler(manager inmemoryruntime.Manager, log logr.Logger, resolver ResourceGroupResolver, latencyProfileResolver latency.Resolver) http.Handler {
 	apiServer := &apiServerHandler{
-		container:             restful.NewContainer(),
-		manager:               manager,
-		log:                   log,
-		resourceGroupResolver: resolver,
+		container:              restful.NewContainer(),
+		manager:                manager,
+		log:                    log,
+		resourceGroupResolver:  resolver,
+		latencyProfileResolver: latencyProfileResolver,
 		requestInfoResolver: server.NewRequestInfoResolver(&server.Config{
 			LegacyAPIGroupPrefixes: sets.NewString(server.DefaultLegacyAPIPrefix),
 		}),
 	}
 
 	apiServer.container.Filter(apiServer.globalLogging)
+	apiServer.container.Filter(apiServer.injectLatency)
 
 	ws := new(restful.WebService)
 	ws.Consumes(runtime.ContentTypeJSON)
@@ -129,11 +132,12 @@ func NewAPIServerHandler(manager inmemoryruntime.Manager, log logr.Logger, resol
 }
 
 type apiServerHandler struct {
-	container             *restful.Container
-	manager               inmemoryruntime.Manager
-	log                   logr.Logger
-	resourceGroupResolver ResourceGroupResolver
-	requestInfoResolver   *request.RequestInfoFactory
+	container              *restful.Container
+	manager                inmemoryruntime.Manager
+	log                    logr.Logger
+	resourceGroupResolver  ResourceGroupResolver
+	latencyProfileResolver latency.Resolver
+	requestInfoResolver    *request.RequestInfoFactory
 }
 
 func (h *apiServerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -175,6 +179,30 @@ func (h *apiServerHandler) globalLogging(req *restful.Request, resp *restful.Res
 	chain.ProcessFilter(req, resp)
 }
 
+// injectLatency adds latency and errors to requests according to the latency profile of the workload cluster
+// the request targets, if any.
+// NOTE: this applies also to health checks, so it is possible to simulate an API server that is slow or not responding.
+func (h *apiServerHandler) injectLatency(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+	profile := h.latencyProfileResolver(req.Request.Host)
+	if profile == nil {
+		chain.ProcessFilter(req, resp)
+		return
+	}
+
+	verb := strings.ToLower(req.Request.Method)
+	if requestInfo, err := h.requestInfoResolver.NewRequestInfo(req.Request); err == nil {
+		verb = requestInfo.Verb
+	}
+
+	if err := profile.Inject(req.Request.Context(), verb); err != nil {
+		status := apierrors.NewServiceUnavailable(err.Error()).Status()
+		_ = resp.WriteHeaderAndEntity(int(status.Code), status)
+		return
+	}
+
+	chain.ProcessFilter(req, resp)
+}
+
 // cleanDryRun gets dryrun from a URL.
 // Note: This is a copy of k8s.io/apiserver/pkg/endpoints/metrics.cleanDryRun.
 func cleanDryRun(u *url.URL) string {
@@ -29,6 +29,8 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/latency"
 )
 
 // Event records a lifecycle event for a Kubernetes object.
@@ -41,6 +43,7 @@ type Event struct {
 type WatchEventDispatcher struct {
 	resourceGroup string
 	events        chan *Event
+	stall         *latency.WatchStall
 }
 
 // OnCreate dispatches Create events.
@@ -106,6 +109,9 @@ func (h *apiServerHandler) watchForResource(req *restful.Request, resp *restful.
 		resourceGroup: resourceGroup,
 		events:        events,
 	}
+	if profile := h.latencyProfileResolver(req.Request.Host); profile != nil {
+		watcher.stall = profile.WatchStall
+	}
 
 	if err := i.AddEventHandler(watcher); err != nil {
 		return err
@@ -213,6 +219,28 @@ func (m *WatchEventDispatcher) Run(ctx context.Context, timeout string, initialE
 		return err
 	}
 
+	writeEvent := func(event *Event) {
+		if err := resp.WriteEntity(event); err != nil {
+			log.Error(err, "Error writing event", "eventType", event.Type, "objectName", event.Object.GetName(), "resourceVersion", event.Object.GetResourceVersion())
+			_ = resp.WriteErrorString(http.StatusInternalServerError, err.Error())
+		} else {
+			log.V(4).Info("Wrote event", "eventType", event.Type, "objectName", event.Object.GetName(), "resourceVersion", event.Object.GetResourceVersion())
+		}
+	}
+
+	// If the watch should stall periodically, start a timer for the first stall.
+	// NOTE: while the watch is stalled events are still read from the channel, so the informer is never blocked,
+	// but they are written only when the stall ends.
+	var stallTimer *time.Timer
+	var stallTimerC <-chan time.Time
+	var stalled bool
+	var stalledEvents []*Event
+	if m.stall != nil && m.stall.Interval > 0 && m.stall.Duration > 0 {
+		stallTimer = time.NewTimer(m.stall.Interval)
+		defer stallTimer.Stop()
+		stallTimerC = stallTimer.C
+	}
+
 	var objResourceVersion uint64
 	for {
 		select {
@@ -220,6 +248,20 @@ func (m *WatchEventDispatcher) Run(ctx context.Context, timeout string, initialE
 			return nil
 		case <-timeoutTimer.C:
 			return nil
+		case <-stallTimerC:
+			// Toggle the stall, and write events buffered during the stall when it ends.
+			stalled = !stalled
+			if stalled {
+				log.V(4).Info("Stalling watch", "duration", m.stall.Duration)
+				stallTimer.Reset(m.stall.Duration)
+				continue
+			}
+			for _, event := range stalledEvents {
+				writeEvent(event)
+			}
+			stalledEvents = nil
+			flusher.Flush()
+			stallTimer.Reset(m.stall.Interval)
 		case event, ok := <-m.events:
 			if !ok {
 				// End of results.
@@ -239,12 +281,12 @@ func (m *WatchEventDispatcher) Run(ctx context.Context, timeout string, initialE
 				continue
 			}
 
-			if err := resp.WriteEntity(event); err != nil {
-				log.Error(err, "Error writing event", "eventType", event.Type, "objectName", event.Object.GetName(), "resourceVersion", event.Object.GetResourceVersion())
-				_ = resp.WriteErrorString(http.StatusInternalServerError, err.Error())
-			} else {
-				log.V(4).Info("Wrote event", "eventType", event.Type, "objectName", event.Object.GetName(), "resourceVersion", event.Object.GetResourceVersion())
+			if stalled {
+				stalledEvents = append(stalledEvents, event)
+				continue
 			}
+
+			writeEvent(event)
 			if len(m.events) == 0 {
 				flusher.Flush()
 			}
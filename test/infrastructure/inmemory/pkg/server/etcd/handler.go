@@ -28,13 +28,16 @@ import (
 	"github.com/pkg/errors"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	cloudv1 "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/cloud/api/v1alpha1"
 	inmemoryruntime "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/runtime"
+	"sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/latency"
 )
 
 // ResourceGroupResolver defines a func that can identify which workloadCluster/resourceGroup a
@@ -42,8 +45,8 @@ import (
 type ResourceGroupResolver func(host string) (string, error)
 
 // NewEtcdServerHandler returns an http.Handler for fake etcd members.
-func NewEtcdServerHandler(manager inmemoryruntime.Manager, log logr.Logger, resolver ResourceGroupResolver) http.Handler {
-	svr := grpc.NewServer()
+func NewEtcdServerHandler(manager inmemoryruntime.Manager, log logr.Logger, resolver ResourceGroupResolver, latencyProfileResolver latency.Resolver) http.Handler {
+	svr := grpc.NewServer(grpc.UnaryInterceptor(injectLatencyInterceptor(latencyProfileResolver)))
 
 	baseSvr := &baseServer{
 		manager:               manager,
@@ -64,6 +67,20 @@ func NewEtcdServerHandler(manager inmemoryruntime.Manager, log logr.Logger, reso
 	return svr
 }
 
+// injectLatencyInterceptor returns a grpc.UnaryServerInterceptor adding latency and errors to requests according to
+// the latency profile of the workload cluster the request targets, if any.
+// NOTE: the verb of a request is the name of the grpc method, e.g. MemberList or Status.
+func injectLatencyInterceptor(latencyProfileResolver latency.Resolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		profile := latencyProfileResolver(fmt.Sprintf("%s", ctx.Value(http.LocalAddrContextKey)))
+		verb := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+		if err := profile.Inject(ctx, verb); err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		return handler(ctx, req)
+	}
+}
+
 // clusterServerServer implements the MaintenanceServer grpc server.
 type maintenanceServer struct {
 	*baseServer
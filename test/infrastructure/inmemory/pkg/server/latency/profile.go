@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latency
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInjected is the error returned for requests failing because of the ErrorRatio of a Profile.
+var ErrInjected = errors.New("error injected by the latency profile")
+
+// Resolver defines a func that returns the Profile for the workloadCluster a request targets;
+// it returns nil if there is no Profile for the workloadCluster.
+type Resolver func(host string) *Profile
+
+// Profile defines latency and errors injected into requests.
+// NOTE: Profiles are shared across goroutines serving requests, they must not be changed after being set.
+type Profile struct {
+	// Latency is added to every request, unless a different latency is defined for the request verb in VerbLatencies.
+	Latency time.Duration
+
+	// VerbLatencies defines the latency added to requests with a specific verb.
+	VerbLatencies map[string]time.Duration
+
+	// Jitter adds some randomness on latency; the actual latency will be latency plus an additional
+	// amount chosen uniformly at random from the interval between zero and `Jitter*latency`.
+	Jitter float64
+
+	// ErrorRatio is the ratio of requests, between 0 and 1, failing with ErrInjected after latency is added.
+	ErrorRatio float64
+
+	// WatchStall defines periodic stalls of watches; it applies only to the API server.
+	WatchStall *WatchStall
+}
+
+// WatchStall defines periodic stalls of watches.
+type WatchStall struct {
+	// Interval is the time between the start of a watch, or the end of a stall, and the start of the next stall.
+	Interval time.Duration
+
+	// Duration is the duration of each stall.
+	Duration time.Duration
+}
+
+// LatencyFor returns the latency to be added to a request with the given verb, including jitter.
+func (p *Profile) LatencyFor(verb string) time.Duration {
+	if p == nil {
+		return 0
+	}
+
+	latency := p.Latency
+	if l, ok := p.VerbLatencies[verb]; ok {
+		latency = l
+	}
+	if latency > 0 && p.Jitter > 0 {
+		latency += time.Duration(rand.Float64() * p.Jitter * float64(latency)) //nolint:gosec // Intentionally using a weak random number generator here.
+	}
+	return latency
+}
+
+// Inject waits for the latency of a request with the given verb, then it returns ErrInjected
+// if the request must fail according to ErrorRatio.
+// Inject returns early with the context error if the context is done while waiting.
+func (p *Profile) Inject(ctx context.Context, verb string) error {
+	if p == nil {
+		return nil
+	}
+
+	if latency := p.LatencyFor(verb); latency > 0 {
+		t := time.NewTimer(latency)
+		defer t.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+
+	if p.ErrorRatio > 0 && rand.Float64() < p.ErrorRatio { //nolint:gosec // Intentionally using a weak random number generator here.
+		return ErrInjected
+	}
+	return nil
+}
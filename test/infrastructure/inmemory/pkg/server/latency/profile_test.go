@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestProfile_LatencyFor(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile *Profile
+		verb    string
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{
+			name:    "no latency for nil profiles",
+			profile: nil,
+			verb:    "get",
+		},
+		{
+			name:    "latency applies to all verbs",
+			profile: &Profile{Latency: time.Second},
+			verb:    "get",
+			wantMin: time.Second,
+			wantMax: time.Second,
+		},
+		{
+			name:    "verb latency takes precedence",
+			profile: &Profile{Latency: time.Second, VerbLatencies: map[string]time.Duration{"list": 3 * time.Second}},
+			verb:    "list",
+			wantMin: 3 * time.Second,
+			wantMax: 3 * time.Second,
+		},
+		{
+			name:    "verb latency does not apply to other verbs",
+			profile: &Profile{Latency: time.Second, VerbLatencies: map[string]time.Duration{"list": 3 * time.Second}},
+			verb:    "get",
+			wantMin: time.Second,
+			wantMax: time.Second,
+		},
+		{
+			name:    "jitter adds up to jitter*latency",
+			profile: &Profile{Latency: time.Second, Jitter: 0.5},
+			verb:    "get",
+			wantMin: time.Second,
+			wantMax: 1500 * time.Millisecond,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got := tt.profile.LatencyFor(tt.verb)
+			g.Expect(got).To(BeNumerically(">=", tt.wantMin))
+			g.Expect(got).To(BeNumerically("<=", tt.wantMax))
+		})
+	}
+}
+
+func TestProfile_Inject(t *testing.T) {
+	t.Run("nil profiles never fail", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var p *Profile
+		g.Expect(p.Inject(context.Background(), "get")).To(Succeed())
+	})
+	t.Run("requests fail according to the error ratio", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect((&Profile{ErrorRatio: 1}).Inject(context.Background(), "get")).To(MatchError(ErrInjected))
+		g.Expect((&Profile{ErrorRatio: 0}).Inject(context.Background(), "get")).To(Succeed())
+	})
+	t.Run("requests are delayed by latency", func(t *testing.T) {
+		g := NewWithT(t)
+
+		start := time.Now()
+		g.Expect((&Profile{Latency: 50 * time.Millisecond}).Inject(context.Background(), "get")).To(Succeed())
+		g.Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+	})
+	t.Run("return early if the context is done", func(t *testing.T) {
+		g := NewWithT(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		g.Expect((&Profile{Latency: time.Hour}).Inject(ctx, "get")).To(MatchError(context.Canceled))
+	})
+}
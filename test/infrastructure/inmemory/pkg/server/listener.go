@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
+	"sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/latency"
 	"sigs.k8s.io/cluster-api/util/certs"
 )
 
@@ -54,6 +55,9 @@ type WorkloadClusterListener struct {
 	etcdMembers             sets.Set[string]
 	etcdServingCertificates map[string]*tls.Certificate
 
+	apiServerLatencyProfile *latency.Profile
+	etcdLatencyProfile      *latency.Profile
+
 	listener net.Listener
 }
 
@@ -41,6 +41,7 @@ import (
 	inmemoryruntime "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/runtime"
 	inmemoryapi "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/api"
 	inmemoryetcd "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/etcd"
+	"sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/latency"
 	"sigs.k8s.io/cluster-api/util/certs"
 )
 
@@ -191,9 +192,29 @@ func (m *WorkloadClustersMux) mixedHandler() http.Handler {
 		return resourceGroup, nil
 	}
 
+	// Prepare functions that can identify the latency profiles for the workloadCluster a request targets to.
+	apiServerLatencyProfileResolver := func(host string) *latency.Profile {
+		m.lock.RLock()
+		defer m.lock.RUnlock()
+
+		if wcl := m.workloadClusterListenerByHostLocked(host); wcl != nil {
+			return wcl.apiServerLatencyProfile
+		}
+		return nil
+	}
+	etcdLatencyProfileResolver := func(host string) *latency.Profile {
+		m.lock.RLock()
+		defer m.lock.RUnlock()
+
+		if wcl := m.workloadClusterListenerByHostLocked(host); wcl != nil {
+			return wcl.etcdLatencyProfile
+		}
+		return nil
+	}
+
 	// build the handlers for API server and etcd.
-	apiHandler := inmemoryapi.NewAPIServerHandler(m.manager, m.log, resourceGroupResolver)
-	etcdHandler := inmemoryetcd.NewEtcdServerHandler(m.manager, m.log, resourceGroupResolver)
+	apiHandler := inmemoryapi.NewAPIServerHandler(m.manager, m.log, resourceGroupResolver, apiServerLatencyProfileResolver)
+	etcdHandler := inmemoryetcd.NewEtcdServerHandler(m.manager, m.log, resourceGroupResolver, etcdLatencyProfileResolver)
 
 	// Creates the mixed handler combining the two above depending on
 	// the type of request being processed
@@ -208,6 +229,20 @@ func (m *WorkloadClustersMux) mixedHandler() http.Handler {
 	return h2c.NewHandler(mixedHandler, &http2.Server{})
 }
 
+// workloadClusterListenerByHostLocked returns the WorkloadClusterListener serving a host, or nil if there is none.
+// Note: m.lock must be locked before calling this method.
+func (m *WorkloadClustersMux) workloadClusterListenerByHostLocked(host string) *WorkloadClusterListener {
+	_, port, err := net.SplitHostPort(host)
+	if err != nil {
+		return nil
+	}
+	wclName, ok := m.workloadClusterNameByPort[port]
+	if !ok {
+		return nil
+	}
+	return m.workloadClusterListeners[wclName]
+}
+
 // getCertificate selects certificates for a specific cluster depending on the request being processed
 // (API server and etcd have different certificates).
 func (m *WorkloadClustersMux) getCertificate(info *tls.ClientHelloInfo) (*tls.Certificate, error) {
@@ -354,6 +389,22 @@ func (m *WorkloadClustersMux) RegisterResourceGroup(wclName, resourceGroup strin
 	return nil
 }
 
+// SetLatencyProfiles sets the latency profiles for the API server and the etcd members of a WorkloadClusterListener.
+// A nil profile removes latency and error injection.
+// Note: profiles must not be changed after being set; in order to change latency and error injection, set new profiles.
+func (m *WorkloadClustersMux) SetLatencyProfiles(wclName string, apiServer, etcd *latency.Profile) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	wcl, ok := m.workloadClusterListeners[wclName]
+	if !ok {
+		return errors.Errorf("workloadClusterListener with name %s must be initialized before setting latency profiles", wclName)
+	}
+	wcl.apiServerLatencyProfile = apiServer
+	wcl.etcdLatencyProfile = etcd
+	return nil
+}
+
 // ResourceGroupByWorkloadCluster returns the resource group that host in memory resources for a WorkloadClusterListener.
 func (m *WorkloadClustersMux) ResourceGroupByWorkloadCluster(wclName string) (string, error) {
 	m.lock.Lock()
@@ -34,6 +34,7 @@ import (
 	"google.golang.org/grpc"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
@@ -44,6 +45,7 @@ import (
 
 	cloudv1 "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/cloud/api/v1alpha1"
 	inmemoryruntime "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/runtime"
+	"sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/latency"
 	inmemoryproxy "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/proxy"
 	"sigs.k8s.io/cluster-api/util/certs"
 )
@@ -489,6 +491,48 @@ func TestAPI_corev1_Watch(t *testing.T) {
 	g.Expect(receivedEvents).To(Equal(expectedEvents))
 }
 
+func TestAPI_LatencyProfile(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	wcmux, c := setupWorkloadClusterListener(g, CustomPorts{
+		// NOTE: make sure to use ports different than other tests, so we can run tests in parallel
+		MinPort:   DefaultMinPort + 500,
+		MaxPort:   DefaultMinPort + 599,
+		DebugPort: DefaultDebugPort + 5,
+	})
+	wcl1 := "workload-cluster1-controlPlaneEndpoint"
+
+	// requests fail according to the error ratio
+
+	err := wcmux.SetLatencyProfiles(wcl1, &latency.Profile{ErrorRatio: 1}, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	err = c.List(ctx, &corev1.NodeList{})
+	g.Expect(apierrors.IsServiceUnavailable(err)).To(BeTrue(), "expected ServiceUnavailable, got %v", err)
+
+	// requests are delayed according to the verb latency
+
+	err = wcmux.SetLatencyProfiles(wcl1, &latency.Profile{VerbLatencies: map[string]time.Duration{"list": 200 * time.Millisecond}}, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	start := time.Now()
+	err = c.List(ctx, &corev1.NodeList{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(time.Since(start)).To(BeNumerically(">=", 200*time.Millisecond))
+
+	// nil profiles remove latency and error injection
+
+	err = wcmux.SetLatencyProfiles(wcl1, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	err = c.List(ctx, &corev1.NodeList{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	err = wcmux.Shutdown(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
 func setupWorkloadClusterListener(g Gomega, ports CustomPorts) (*WorkloadClustersMux, client.WithWatch) {
 	manager := inmemoryruntime.NewManager(scheme)
 
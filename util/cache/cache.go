@@ -49,6 +49,9 @@ type Cache[E Entry] interface {
 	// Has checks if the given key (still) exists in the Cache.
 	// Note: entries expire after the ttl.
 	Has(key string) (E, bool)
+
+	// Delete deletes the entry with the given key from the Cache, if it exists.
+	Delete(key string)
 }
 
 // New creates a new cache.
@@ -96,6 +99,15 @@ func (r *cache[E]) Has(key string) (E, bool) {
 	return *new(E), false
 }
 
+// Delete deletes the entry with the given key from the Cache, if it exists.
+func (r *cache[E]) Delete(key string) {
+	// Note: We can ignore the error here because GetByKey never returns an error
+	// and Delete only returns errors from the key func.
+	if item, exists, _ := r.GetByKey(key); exists {
+		_ = r.Store.Delete(item)
+	}
+}
+
 // ReconcileEntry is an Entry for the Cache that stores the
 // earliest time after which the next Reconcile should be executed.
 type ReconcileEntry struct {
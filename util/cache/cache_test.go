@@ -47,6 +47,14 @@ func TestCache(t *testing.T) {
 	entryFromCache, ok := c.Has(entry.Key())
 	g.Expect(ok).To(BeTrue())
 	g.Expect(entryFromCache).To(Equal(entry))
+
+	c.Delete(entry.Key())
+
+	_, ok = c.Has(entry.Key())
+	g.Expect(ok).To(BeFalse())
+
+	// Deleting an entry which does not exist is a no-op.
+	c.Delete(entry.Key())
 }
 
 func TestShouldRequeueDrain(t *testing.T) {
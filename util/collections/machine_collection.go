@@ -145,10 +145,55 @@ func (s Machines) Insert(machines ...*clusterv1.Machine) {
 
 // Difference returns a copy without machines that are in the given collection.
 func (s Machines) Difference(machines Machines) Machines {
-	return s.Filter(func(m *clusterv1.Machine) bool {
-		_, found := machines[m.Name]
-		return !found
-	})
+	result := make(Machines, len(s))
+	for name, m := range s {
+		if _, found := machines[name]; !found {
+			result[name] = m
+		}
+	}
+	return result
+}
+
+// Intersection returns a copy with only the machines that are also in the given collection.
+func (s Machines) Intersection(machines Machines) Machines {
+	// Iterate over the smaller collection to minimize the number of lookups.
+	walk, other := s, machines
+	if len(machines) < len(s) {
+		walk, other = machines, s
+	}
+	result := make(Machines, len(walk))
+	for name := range walk {
+		if _, found := other[name]; found {
+			result[name] = s[name]
+		}
+	}
+	return result
+}
+
+// Union returns a copy with the machines that are in either this or the given collection.
+// When a machine is in both collections, the one from this collection is used.
+func (s Machines) Union(machines Machines) Machines {
+	result := make(Machines, len(s)+len(machines))
+	for name, m := range machines {
+		result[name] = m
+	}
+	for name, m := range s {
+		result[name] = m
+	}
+	return result
+}
+
+// ByFailureDomain returns the machines grouped by failure domain.
+// Machines without a failure domain are grouped under the "" key.
+func (s Machines) ByFailureDomain() map[string]Machines {
+	result := map[string]Machines{}
+	for name, m := range s {
+		if _, ok := result[m.Spec.FailureDomain]; !ok {
+			result[m.Spec.FailureDomain] = New()
+		}
+		result[m.Spec.FailureDomain][name] = m
+	}
+	return result
 }
 
 // SortedByCreationTimestamp returns the machines sorted by creation timestamp.
@@ -68,6 +68,51 @@ func TestMachineCollection(t *testing.T) {
 			g.Expect(c3.Names()).To(ConsistOf("machine-1"))
 		})
 	})
+	t.Run("Intersection", func(t *testing.T) {
+		t.Run("should return the collection with only the elements also in the second collection", func(t *testing.T) {
+			g := NewWithT(t)
+			collection := machines()
+			c2 := collections.FromMachines(machine("machine-2"), machine("machine-3"), machine("machine-6"))
+			c3 := collection.Intersection(c2)
+			g.Expect(c3.Names()).To(ConsistOf("machine-2", "machine-3"))
+			// uses the machines of the receiver
+			g.Expect(c3["machine-2"]).To(BeIdenticalTo(collection["machine-2"]))
+			g.Expect(c2.Intersection(collection)["machine-2"]).To(BeIdenticalTo(c2["machine-2"]))
+			// does not mutate
+			g.Expect(collection).To(HaveLen(5))
+			g.Expect(c2).To(HaveLen(3))
+		})
+	})
+	t.Run("Union", func(t *testing.T) {
+		t.Run("should return the collection with elements of both collections", func(t *testing.T) {
+			g := NewWithT(t)
+			collection := collections.FromMachines(machine("machine-1"), machine("machine-2"))
+			c2 := collections.FromMachines(machine("machine-2"), machine("machine-3"))
+			c3 := collection.Union(c2)
+			g.Expect(c3.Names()).To(ConsistOf("machine-1", "machine-2", "machine-3"))
+			// prefers the machines of the receiver
+			g.Expect(c3["machine-2"]).To(BeIdenticalTo(collection["machine-2"]))
+			// does not mutate
+			g.Expect(collection).To(HaveLen(2))
+			g.Expect(c2).To(HaveLen(2))
+		})
+	})
+	t.Run("ByFailureDomain", func(t *testing.T) {
+		t.Run("should group machines by failure domain", func(t *testing.T) {
+			g := NewWithT(t)
+			collection := collections.FromMachines(
+				machine("machine-1", withFailureDomain("fd1")),
+				machine("machine-2", withFailureDomain("fd1")),
+				machine("machine-3", withFailureDomain("fd2")),
+				machine("machine-4"),
+			)
+			byFailureDomain := collection.ByFailureDomain()
+			g.Expect(byFailureDomain).To(HaveLen(3))
+			g.Expect(byFailureDomain["fd1"].Names()).To(ConsistOf("machine-1", "machine-2"))
+			g.Expect(byFailureDomain["fd2"].Names()).To(ConsistOf("machine-3"))
+			g.Expect(byFailureDomain[""].Names()).To(ConsistOf("machine-4"))
+		})
+	})
 	t.Run("Names", func(t *testing.T) {
 		t.Run("should return a slice of names of each machine in the collection", func(t *testing.T) {
 			g := NewWithT(t)
@@ -169,6 +214,12 @@ func withTimestamps(timestamp metav1.Time) machineOpt {
 	}
 }
 
+func withFailureDomain(failureDomain string) machineOpt {
+	return func(m *clusterv1.Machine) {
+		m.Spec.FailureDomain = failureDomain
+	}
+}
+
 func machine(name string, opts ...machineOpt) *clusterv1.Machine {
 	m := &clusterv1.Machine{
 		ObjectMeta: metav1.ObjectMeta{
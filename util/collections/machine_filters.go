@@ -258,6 +258,46 @@ func HasAnnotationKey(key string) Func {
 	}
 }
 
+// HasLabelKey returns a filter to find all machines that have the
+// specified Label key present.
+func HasLabelKey(key string) Func {
+	return func(machine *clusterv1.Machine) bool {
+		if machine == nil || machine.Labels == nil {
+			return false
+		}
+		_, ok := machine.Labels[key]
+		return ok
+	}
+}
+
+// MatchesLabelSelector returns a filter to find all machines with labels matching the given selector.
+// A nil selector doesn't match any machine.
+// Usage: machines.Filter(MatchesLabelSelector(selector)).
+func MatchesLabelSelector(selector labels.Selector) Func {
+	return func(machine *clusterv1.Machine) bool {
+		if machine == nil || selector == nil {
+			return false
+		}
+		return selector.Matches(labels.Set(machine.Labels))
+	}
+}
+
+// HasConditionStatus returns a filter to find all machines that have a condition of the given type
+// with the given status.
+// Machines without the condition are not matched, not even when looking for the Unknown status.
+func HasConditionStatus(conditionType string, status metav1.ConditionStatus) Func {
+	return func(machine *clusterv1.Machine) bool {
+		if machine == nil {
+			return false
+		}
+		c := conditions.Get(machine, conditionType)
+		if c == nil {
+			return false
+		}
+		return c.Status == status
+	}
+}
+
 // ControlPlaneSelectorForCluster returns the label selector necessary to get control plane machines for a given cluster.
 func ControlPlaneSelectorForCluster(clusterName string) labels.Selector {
 	must := func(r *labels.Requirement, err error) labels.Requirement {
@@ -22,6 +22,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
@@ -243,6 +244,80 @@ func TestHashAnnotationKey(t *testing.T) {
 	})
 }
 
+func TestHasLabelKey(t *testing.T) {
+	t.Run("nil machine returns false", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(collections.HasLabelKey("test")(nil)).To(BeFalse())
+	})
+	t.Run("machine with specified label returns true", func(t *testing.T) {
+		g := NewWithT(t)
+		m := &clusterv1.Machine{}
+		m.SetLabels(map[string]string{"test": ""})
+		g.Expect(collections.HasLabelKey("test")(m)).To(BeTrue())
+	})
+	t.Run("machine without specified label returns false", func(t *testing.T) {
+		g := NewWithT(t)
+		m := &clusterv1.Machine{}
+		m.SetLabels(map[string]string{"test": ""})
+		g.Expect(collections.HasLabelKey("foo")(m)).To(BeFalse())
+	})
+}
+
+func TestMatchesLabelSelector(t *testing.T) {
+	selector, err := labels.Parse("env=prod,tier in (frontend,backend)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("nil machine returns false", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(collections.MatchesLabelSelector(selector)(nil)).To(BeFalse())
+	})
+	t.Run("nil selector returns false", func(t *testing.T) {
+		g := NewWithT(t)
+		m := &clusterv1.Machine{}
+		g.Expect(collections.MatchesLabelSelector(nil)(m)).To(BeFalse())
+	})
+	t.Run("machine with matching labels returns true", func(t *testing.T) {
+		g := NewWithT(t)
+		m := &clusterv1.Machine{}
+		m.SetLabels(map[string]string{"env": "prod", "tier": "backend", "foo": "bar"})
+		g.Expect(collections.MatchesLabelSelector(selector)(m)).To(BeTrue())
+	})
+	t.Run("machine with labels not matching returns false", func(t *testing.T) {
+		g := NewWithT(t)
+		m := &clusterv1.Machine{}
+		m.SetLabels(map[string]string{"env": "prod", "tier": "db"})
+		g.Expect(collections.MatchesLabelSelector(selector)(m)).To(BeFalse())
+	})
+	t.Run("everything selector matches machines without labels", func(t *testing.T) {
+		g := NewWithT(t)
+		m := &clusterv1.Machine{}
+		g.Expect(collections.MatchesLabelSelector(labels.Everything())(m)).To(BeTrue())
+	})
+}
+
+func TestHasConditionStatus(t *testing.T) {
+	t.Run("nil machine returns false", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(collections.HasConditionStatus(clusterv1.MachineReadyCondition, metav1.ConditionTrue)(nil)).To(BeFalse())
+	})
+	t.Run("machine without the condition returns false", func(t *testing.T) {
+		g := NewWithT(t)
+		m := &clusterv1.Machine{}
+		g.Expect(collections.HasConditionStatus(clusterv1.MachineReadyCondition, metav1.ConditionTrue)(m)).To(BeFalse())
+		g.Expect(collections.HasConditionStatus(clusterv1.MachineReadyCondition, metav1.ConditionUnknown)(m)).To(BeFalse())
+	})
+	t.Run("machine with the condition returns true only for the matching status", func(t *testing.T) {
+		g := NewWithT(t)
+		m := &clusterv1.Machine{}
+		conditions.Set(m, metav1.Condition{Type: clusterv1.MachineNodeHealthyCondition, Status: metav1.ConditionUnknown, Reason: "Foo"})
+		g.Expect(collections.HasConditionStatus(clusterv1.MachineNodeHealthyCondition, metav1.ConditionUnknown)(m)).To(BeTrue())
+		g.Expect(collections.HasConditionStatus(clusterv1.MachineNodeHealthyCondition, metav1.ConditionTrue)(m)).To(BeFalse())
+		g.Expect(collections.HasConditionStatus(clusterv1.MachineReadyCondition, metav1.ConditionUnknown)(m)).To(BeFalse())
+	})
+}
+
 func TestInFailureDomain(t *testing.T) {
 	t.Run("nil machine returns false", func(t *testing.T) {
 		g := NewWithT(t)
@@ -543,7 +543,11 @@ func sortMessage(i, j string, messageMustGoFirst map[string]bool, messagePriorit
 		return false
 	}
 
-	return strings.Join(messageObjMapForKind[i], ",") < strings.Join(messageObjMapForKind[j], ",")
+	if objs1, objs2 := strings.Join(messageObjMapForKind[i], ","), strings.Join(messageObjMapForKind[j], ","); objs1 != objs2 {
+		return objs1 < objs2
+	}
+
+	return i < j
 }
 
 func sortObj(i, j string, cpMachines sets.Set[string]) bool {
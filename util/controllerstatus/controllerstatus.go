@@ -0,0 +1,151 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package controllerstatus implements an endpoint exposing the runtime configuration of a controller manager.
+package controllerstatus
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"k8s.io/component-base/featuregate"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"sigs.k8s.io/cluster-api/version"
+)
+
+// Path is the path of the controller status endpoint on the diagnostics server of a controller manager.
+const Path = "/debug/controller-status"
+
+// ControllerStatus is the runtime configuration of a controller manager.
+type ControllerStatus struct {
+	// Name is the name of the controller manager.
+	Name string `json:"name"`
+
+	// Version is the version of the controller manager.
+	Version string `json:"version"`
+
+	// GitCommit is the git commit the controller manager has been built from.
+	GitCommit string `json:"gitCommit,omitempty"`
+
+	// StartTime is the time the controller manager has been started.
+	StartTime time.Time `json:"startTime"`
+
+	// FeatureGates are the known feature gates and whether they are enabled.
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+
+	// WatchNamespace is the namespace the controller manager watches; if empty, all namespaces are watched.
+	WatchNamespace string `json:"watchNamespace,omitempty"`
+
+	// WatchFilterValue is the value of the label used to filter watched objects; if empty, objects are not filtered.
+	WatchFilterValue string `json:"watchFilterValue,omitempty"`
+
+	// Concurrency is the number of concurrent reconciles for each controller.
+	Concurrency map[string]int `json:"concurrency,omitempty"`
+}
+
+// FeatureGates is the subset of featuregate.MutableFeatureGate used to compute the status of feature gates.
+type FeatureGates interface {
+	GetAll() map[featuregate.Feature]featuregate.FeatureSpec
+	Enabled(key featuregate.Feature) bool
+}
+
+// New returns the ControllerStatus for a controller manager, with version information and effective feature gates.
+func New(name string, gates FeatureGates) ControllerStatus {
+	info := version.Get()
+	status := ControllerStatus{
+		Name:         name,
+		Version:      info.GitVersion,
+		GitCommit:    info.GitCommit,
+		StartTime:    time.Now().UTC(),
+		FeatureGates: map[string]bool{},
+	}
+	if gates != nil {
+		for feature := range gates.GetAll() {
+			status.FeatureGates[string(feature)] = gates.Enabled(feature)
+		}
+	}
+	return status
+}
+
+// NewFromFlags returns the ControllerStatus for a controller manager like New, with the watch namespace, the watch filter
+// and the concurrency of each controller read from the flags of the controller manager.
+// The concurrency of a controller is read from the --<controller>-concurrency flag; the --concurrency flag, which
+// applies to all the controllers of a controller manager, is surfaced as "default". Deprecated flags are ignored.
+func NewFromFlags(name string, gates FeatureGates, fs *pflag.FlagSet) ControllerStatus {
+	status := New(name, gates)
+	if f := fs.Lookup("namespace"); f != nil {
+		status.WatchNamespace = f.Value.String()
+	}
+	if f := fs.Lookup("watch-filter"); f != nil {
+		status.WatchFilterValue = f.Value.String()
+	}
+
+	fs.VisitAll(func(f *pflag.Flag) {
+		if f.Deprecated != "" || f.Value.Type() != "int" {
+			return
+		}
+		controller, ok := strings.CutSuffix(f.Name, "concurrency")
+		if !ok {
+			return
+		}
+		switch {
+		case controller == "":
+			controller = "default"
+		case strings.HasSuffix(controller, "-"):
+			controller = strings.TrimSuffix(controller, "-")
+		default:
+			return
+		}
+		concurrency, err := strconv.Atoi(f.Value.String())
+		if err != nil {
+			return
+		}
+		if status.Concurrency == nil {
+			status.Concurrency = map[string]int{}
+		}
+		status.Concurrency[controller] = concurrency
+	})
+	return status
+}
+
+// Handler returns an http.Handler serving the ControllerStatus as JSON.
+func Handler(status ControllerStatus) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "only GET is supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// AddToManager serves the ControllerStatus on the diagnostics server of the manager.
+func AddToManager(mgr ctrl.Manager, status ControllerStatus) error {
+	if err := mgr.AddMetricsServerExtraHandler(Path, Handler(status)); err != nil {
+		return errors.Wrapf(err, "failed to add %s endpoint to the diagnostics server", Path)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllerstatus
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+	"k8s.io/component-base/featuregate"
+)
+
+func TestNew(t *testing.T) {
+	g := NewWithT(t)
+
+	gates := featuregate.NewFeatureGate()
+	g.Expect(gates.Add(map[featuregate.Feature]featuregate.FeatureSpec{
+		"Foo": {Default: false, PreRelease: featuregate.Alpha},
+		"Bar": {Default: true, PreRelease: featuregate.Beta},
+	})).To(Succeed())
+	g.Expect(gates.Set("Foo=true,Bar=false")).To(Succeed())
+
+	status := New("test-manager", gates)
+	g.Expect(status.Name).To(Equal("test-manager"))
+	g.Expect(status.StartTime.IsZero()).To(BeFalse())
+	g.Expect(status.FeatureGates).To(HaveKeyWithValue("Foo", true))
+	g.Expect(status.FeatureGates).To(HaveKeyWithValue("Bar", false))
+}
+
+func TestNewFromFlags(t *testing.T) {
+	g := NewWithT(t)
+
+	var watchNamespace, watchFilterValue string
+	var concurrency, machineConcurrency, deprecatedConcurrency, syncPeriod int
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.StringVar(&watchNamespace, "namespace", "", "")
+	fs.StringVar(&watchFilterValue, "watch-filter", "", "")
+	fs.IntVar(&concurrency, "concurrency", 10, "")
+	fs.IntVar(&machineConcurrency, "machine-concurrency", 10, "")
+	fs.IntVar(&deprecatedConcurrency, "cluster-concurrency", 10, "")
+	g.Expect(fs.MarkDeprecated("cluster-concurrency", "deprecated")).To(Succeed())
+	fs.IntVar(&syncPeriod, "sync-period", 10, "")
+	g.Expect(fs.Parse([]string{"--namespace=ns", "--watch-filter=filter", "--machine-concurrency=5"})).To(Succeed())
+
+	status := NewFromFlags("test-manager", nil, fs)
+	g.Expect(status.Name).To(Equal("test-manager"))
+	g.Expect(status.WatchNamespace).To(Equal("ns"))
+	g.Expect(status.WatchFilterValue).To(Equal("filter"))
+	g.Expect(status.Concurrency).To(Equal(map[string]int{
+		"default": 10,
+		"machine": 5,
+	}))
+}
+
+func TestHandler(t *testing.T) {
+	status := ControllerStatus{
+		Name:             "test-manager",
+		Version:          "v1.11.0",
+		FeatureGates:     map[string]bool{"Foo": true},
+		WatchNamespace:   "default",
+		WatchFilterValue: "test",
+		Concurrency:      map[string]int{"machine": 10},
+	}
+
+	t.Run("serves the controller status", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rec := httptest.NewRecorder()
+		Handler(status).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, http.NoBody))
+
+		g.Expect(rec.Code).To(Equal(http.StatusOK))
+		g.Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		got := ControllerStatus{}
+		g.Expect(json.Unmarshal(rec.Body.Bytes(), &got)).To(Succeed())
+		g.Expect(got).To(BeComparableTo(status))
+	})
+	t.Run("rejects methods other than GET", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rec := httptest.NewRecorder()
+		Handler(status).ServeHTTP(rec, httptest.NewRequest(http.MethodPut, Path, http.NoBody))
+
+		g.Expect(rec.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+}
@@ -18,11 +18,16 @@ limitations under the License.
 package conversion
 
 import (
+	"bufio"
+	"bytes"
+	"io"
 	"math/rand"
+	"os"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
@@ -31,6 +36,7 @@ import (
 	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/json"
+	apiyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
@@ -142,6 +148,19 @@ type FuzzTestFuncInput struct {
 	SkipSpokeAnnotationCleanup bool
 
 	FuzzerFuncs []fuzzer.FuzzerFuncs
+
+	// Iterations is the number of fuzzed objects tested for each round trip.
+	// Defaults to 10000.
+	Iterations int
+
+	// Seeds are objects of the Hub or of the Spoke type which are tested before fuzzed objects,
+	// e.g. to ensure well known objects are always covered; see LoadSeeds.
+	Seeds []runtime.Object
+
+	// VerifyDataAnnotationRestore enables an additional hub-spoke-hub round trip where the spoke is serialized
+	// before converting back to the hub, like when the spoke is stored by the API server; this ensures
+	// that data not existing in the spoke is restored from the DataAnnotation.
+	VerifyDataAnnotationRestore bool
 }
 
 // FuzzTestFunc returns a new testing function to be used in tests to make sure conversions between
@@ -150,69 +169,194 @@ func FuzzTestFunc(input FuzzTestFuncInput) func(*testing.T) {
 	if input.Scheme == nil {
 		input.Scheme = scheme.Scheme
 	}
+	if input.Iterations <= 0 {
+		input.Iterations = 10000
+	}
 
 	return func(t *testing.T) {
 		t.Helper()
+		if len(input.Seeds) > 0 {
+			t.Run("seeds", func(t *testing.T) {
+				g := gomega.NewWithT(t)
+
+				for _, seed := range input.Seeds {
+					switch seed := seed.(type) {
+					case conversion.Hub:
+						ExpectHubSpokeHubRoundTrip(g, input, seed)
+					case conversion.Convertible:
+						ExpectSpokeHubSpokeRoundTrip(g, input, seed)
+					default:
+						g.Expect(false).To(gomega.BeTrue(), "seed %T is neither a Hub nor a Convertible", seed)
+					}
+				}
+			})
+		}
 		t.Run("spoke-hub-spoke", func(t *testing.T) {
 			g := gomega.NewWithT(t)
 			fuzzer := GetFuzzer(input.Scheme, input.FuzzerFuncs...)
 
-			for range 10000 {
+			for range input.Iterations {
 				// Create the spoke and fuzz it
 				spokeBefore := input.Spoke.DeepCopyObject().(conversion.Convertible)
 				fuzzer.Fill(spokeBefore)
 
-				// First convert spoke to hub
-				hubCopy := input.Hub.DeepCopyObject().(conversion.Hub)
-				g.Expect(spokeBefore.ConvertTo(hubCopy)).To(gomega.Succeed())
-
-				// Convert hub back to spoke and check if the resulting spoke is equal to the spoke before the round trip
-				spokeAfter := input.Spoke.DeepCopyObject().(conversion.Convertible)
-				g.Expect(spokeAfter.ConvertFrom(hubCopy)).To(gomega.Succeed())
-
-				// Remove data annotation eventually added by ConvertFrom for avoiding data loss in hub-spoke-hub round trips
-				// NOTE: There are use case when we want to skip this operation, e.g. if the spoke object does not have ObjectMeta (e.g. kubeadm types).
-				if !input.SkipSpokeAnnotationCleanup {
-					metaAfter := spokeAfter.(metav1.Object)
-					delete(metaAfter.GetAnnotations(), DataAnnotation)
-				}
-
-				if input.SpokeAfterMutation != nil {
-					input.SpokeAfterMutation(spokeAfter)
-				}
-
-				if !apiequality.Semantic.DeepEqual(spokeBefore, spokeAfter) {
-					diff := cmp.Diff(spokeBefore, spokeAfter)
-					g.Expect(false).To(gomega.BeTrue(), diff)
-				}
+				ExpectSpokeHubSpokeRoundTrip(g, input, spokeBefore)
 			}
 		})
 		t.Run("hub-spoke-hub", func(t *testing.T) {
 			g := gomega.NewWithT(t)
 			fuzzer := GetFuzzer(input.Scheme, input.FuzzerFuncs...)
 
-			for range 10000 {
+			for range input.Iterations {
 				// Create the hub and fuzz it
 				hubBefore := input.Hub.DeepCopyObject().(conversion.Hub)
 				fuzzer.Fill(hubBefore)
 
-				// First convert hub to spoke
-				dstCopy := input.Spoke.DeepCopyObject().(conversion.Convertible)
-				g.Expect(dstCopy.ConvertFrom(hubBefore)).To(gomega.Succeed())
+				ExpectHubSpokeHubRoundTrip(g, input, hubBefore)
+			}
+		})
+		if input.VerifyDataAnnotationRestore {
+			t.Run("hub-spoke-hub-with-data-annotation-restore", func(t *testing.T) {
+				g := gomega.NewWithT(t)
+				fuzzer := GetFuzzer(input.Scheme, input.FuzzerFuncs...)
 
-				// Convert spoke back to hub and check if the resulting hub is equal to the hub before the round trip
-				hubAfter := input.Hub.DeepCopyObject().(conversion.Hub)
-				g.Expect(dstCopy.ConvertTo(hubAfter)).To(gomega.Succeed())
+				for range input.Iterations {
+					// Create the hub and fuzz it
+					hubBefore := input.Hub.DeepCopyObject().(conversion.Hub)
+					fuzzer.Fill(hubBefore)
 
-				if input.HubAfterMutation != nil {
-					input.HubAfterMutation(hubAfter)
+					ExpectDataAnnotationRestore(g, input, hubBefore)
 				}
+			})
+		}
+	}
+}
 
-				if !apiequality.Semantic.DeepEqual(hubBefore, hubAfter) {
-					diff := cmp.Diff(hubBefore, hubAfter)
-					g.Expect(false).To(gomega.BeTrue(), diff)
-				}
-			}
-		})
+// ExpectSpokeHubSpokeRoundTrip asserts that converting the given spoke to the Hub and back
+// results in the same spoke.
+func ExpectSpokeHubSpokeRoundTrip(g gomega.Gomega, input FuzzTestFuncInput, spokeBefore conversion.Convertible) {
+	// First convert spoke to hub
+	hubCopy := input.Hub.DeepCopyObject().(conversion.Hub)
+	g.Expect(spokeBefore.ConvertTo(hubCopy)).To(gomega.Succeed())
+
+	// Convert hub back to spoke and check if the resulting spoke is equal to the spoke before the round trip
+	spokeAfter := input.Spoke.DeepCopyObject().(conversion.Convertible)
+	g.Expect(spokeAfter.ConvertFrom(hubCopy)).To(gomega.Succeed())
+
+	// Remove data annotation eventually added by ConvertFrom for avoiding data loss in hub-spoke-hub round trips
+	// NOTE: There are use case when we want to skip this operation, e.g. if the spoke object does not have ObjectMeta (e.g. kubeadm types).
+	if !input.SkipSpokeAnnotationCleanup {
+		metaAfter := spokeAfter.(metav1.Object)
+		delete(metaAfter.GetAnnotations(), DataAnnotation)
+	}
+
+	if input.SpokeAfterMutation != nil {
+		input.SpokeAfterMutation(spokeAfter)
+	}
+
+	if !apiequality.Semantic.DeepEqual(spokeBefore, spokeAfter) {
+		diff := cmp.Diff(spokeBefore, spokeAfter)
+		g.Expect(false).To(gomega.BeTrue(), diff)
+	}
+}
+
+// ExpectHubSpokeHubRoundTrip asserts that converting the given hub to the Spoke and back
+// results in the same hub.
+func ExpectHubSpokeHubRoundTrip(g gomega.Gomega, input FuzzTestFuncInput, hubBefore conversion.Hub) {
+	// First convert hub to spoke
+	dstCopy := input.Spoke.DeepCopyObject().(conversion.Convertible)
+	g.Expect(dstCopy.ConvertFrom(hubBefore)).To(gomega.Succeed())
+
+	// Convert spoke back to hub and check if the resulting hub is equal to the hub before the round trip
+	hubAfter := input.Hub.DeepCopyObject().(conversion.Hub)
+	g.Expect(dstCopy.ConvertTo(hubAfter)).To(gomega.Succeed())
+
+	if input.HubAfterMutation != nil {
+		input.HubAfterMutation(hubAfter)
+	}
+
+	if !apiequality.Semantic.DeepEqual(hubBefore, hubAfter) {
+		diff := cmp.Diff(hubBefore, hubAfter)
+		g.Expect(false).To(gomega.BeTrue(), diff)
+	}
+}
+
+// ExpectDataAnnotationRestore asserts that converting the given hub to the Spoke, serializing the spoke
+// like the API server does when storing it, and converting it back results in the same hub.
+// This ensures that data not existing in the spoke is preserved in the DataAnnotation and restored from it,
+// and that the DataAnnotation is removed from the hub.
+func ExpectDataAnnotationRestore(g gomega.Gomega, input FuzzTestFuncInput, hubBefore conversion.Hub) {
+	// First convert hub to spoke; a copy of the hub is used, given that conversions might share maps and slices with it
+	spoke := input.Spoke.DeepCopyObject().(conversion.Convertible)
+	g.Expect(spoke.ConvertFrom(hubBefore.DeepCopyObject().(conversion.Hub))).To(gomega.Succeed())
+
+	if !input.SkipSpokeAnnotationCleanup {
+		g.Expect(spoke.(metav1.Object).GetAnnotations()).To(gomega.HaveKey(DataAnnotation), "spoke is expected to have the %s annotation", DataAnnotation)
+	}
+
+	// Serialize and deserialize the spoke, so only data existing in the spoke or in the DataAnnotation is preserved
+	storedSpoke := input.Spoke.DeepCopyObject().(conversion.Convertible)
+	g.Expect(jsonRoundTrip(spoke, storedSpoke)).To(gomega.Succeed())
+
+	// Convert the stored spoke back to hub and check if the resulting hub is equal to the hub before the round trip
+	hubAfter := input.Hub.DeepCopyObject().(conversion.Hub)
+	g.Expect(storedSpoke.ConvertTo(hubAfter)).To(gomega.Succeed())
+
+	if metaAfter, ok := hubAfter.(metav1.Object); ok {
+		g.Expect(metaAfter.GetAnnotations()).ToNot(gomega.HaveKey(DataAnnotation), "hub is not expected to have the %s annotation", DataAnnotation)
+	}
+
+	if input.HubAfterMutation != nil {
+		input.HubAfterMutation(hubAfter)
+	}
+
+	// Compare serialized hubs, given that e.g. empty and nil slices are not distinguishable once stored.
+	hubBeforeStored, hubAfterStored := input.Hub.DeepCopyObject(), input.Hub.DeepCopyObject()
+	g.Expect(jsonRoundTrip(hubBefore, hubBeforeStored)).To(gomega.Succeed())
+	g.Expect(jsonRoundTrip(hubAfter, hubAfterStored)).To(gomega.Succeed())
+	if !apiequality.Semantic.DeepEqual(hubBeforeStored, hubAfterStored) {
+		diff := cmp.Diff(hubBeforeStored, hubAfterStored)
+		g.Expect(false).To(gomega.BeTrue(), diff)
+	}
+}
+
+// jsonRoundTrip serializes an object and deserializes it into another object.
+func jsonRoundTrip(from, to interface{}) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
+
+// LoadSeeds reads the objects in a multi-document YAML file, to be used as FuzzTestFuncInput.Seeds;
+// objects are decoded using the given scheme, so it must contain the Hub and the Spoke types.
+func LoadSeeds(scheme *runtime.Scheme, path string) ([]runtime.Object, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // The path of the seed corpus is controlled by the test.
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read seeds from %s", path)
+	}
+
+	decoder := runtimeserializer.NewCodecFactory(scheme).UniversalDeserializer()
+	reader := apiyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	seeds := []runtime.Object{}
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read seeds from %s", path)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode seed from %s", path)
+		}
+		seeds = append(seeds, obj)
 	}
+	return seeds, nil
 }
@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+)
+
+func TestLoadSeeds(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(clusterv1beta1.AddToScheme(scheme)).To(Succeed())
+
+	seeds, err := utilconversion.LoadSeeds(scheme, "testdata/seeds.yaml")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(seeds).To(HaveLen(2))
+	g.Expect(seeds[0]).To(BeAssignableToTypeOf(&clusterv1.Machine{}))
+	g.Expect(seeds[1]).To(BeAssignableToTypeOf(&clusterv1beta1.Machine{}))
+
+	_, err = utilconversion.LoadSeeds(scheme, "testdata/does-not-exist.yaml")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestRoundTrips(t *testing.T) {
+	input := utilconversion.FuzzTestFuncInput{
+		Hub:   &clusterv1.Machine{},
+		Spoke: &clusterv1beta1.Machine{},
+	}
+	hub := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: metav1.NamespaceDefault},
+		Spec: clusterv1.MachineSpec{
+			ClusterName:     "test-cluster",
+			Version:         "v1.33.0",
+			MinReadySeconds: ptr.To[int32](10),
+		},
+	}
+	spoke := &clusterv1beta1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: metav1.NamespaceDefault},
+		Spec: clusterv1beta1.MachineSpec{
+			ClusterName: "test-cluster",
+			Version:     ptr.To("v1.33.0"),
+			Bootstrap:   clusterv1beta1.Bootstrap{DataSecretName: ptr.To("bootstrap-data")},
+		},
+	}
+
+	t.Run("hub-spoke-hub", func(t *testing.T) {
+		utilconversion.ExpectHubSpokeHubRoundTrip(NewWithT(t), input, hub)
+	})
+	t.Run("spoke-hub-spoke", func(t *testing.T) {
+		utilconversion.ExpectSpokeHubSpokeRoundTrip(NewWithT(t), input, spoke)
+	})
+	t.Run("data annotation restore", func(t *testing.T) {
+		utilconversion.ExpectDataAnnotationRestore(NewWithT(t), input, hub)
+	})
+	t.Run("data annotation restore detects lossy conversions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		failed := false
+		fakeG := NewGomega(func(string, ...int) { failed = true })
+		lossyInput := input
+		lossyInput.Spoke = &lossyMachine{}
+		utilconversion.ExpectDataAnnotationRestore(fakeG, lossyInput, hub)
+		g.Expect(failed).To(BeTrue())
+	})
+}
+
+// lossyMachine is a spoke dropping the data annotation when converting from the hub.
+type lossyMachine struct {
+	clusterv1beta1.Machine
+}
+
+func (m *lossyMachine) DeepCopyObject() runtime.Object {
+	return &lossyMachine{Machine: *m.Machine.DeepCopy()}
+}
+
+func (m *lossyMachine) ConvertFrom(src conversion.Hub) error {
+	if err := m.Machine.ConvertFrom(src); err != nil {
+		return err
+	}
+	delete(m.Annotations, utilconversion.DataAnnotation)
+	return nil
+}
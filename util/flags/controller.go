@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// defaultRateLimiterBaseDelay is the base delay of the per-item exponential backoff used by controller-runtime.
+	defaultRateLimiterBaseDelay = 5 * time.Millisecond
+	// defaultRateLimiterMaxDelay is the max delay of the per-item exponential backoff used by controller-runtime.
+	defaultRateLimiterMaxDelay = 1000 * time.Second
+)
+
+// ControllerOptions provides command line flags for the queue and cache options of a single controller.
+type ControllerOptions struct {
+	// UsePriorityQueue is the field that stores the value of the --<controller>-use-priority-queue flag.
+	// If nil, the manager default is used, which depends on the PriorityQueue feature gate.
+	UsePriorityQueue *bool
+
+	// RateLimiterBaseDelay is the field that stores the value of the --<controller>-rate-limiter-base-delay flag.
+	// For further details, please see the description of the flag.
+	RateLimiterBaseDelay time.Duration
+	// RateLimiterMaxDelay is the field that stores the value of the --<controller>-rate-limiter-max-delay flag.
+	// For further details, please see the description of the flag.
+	RateLimiterMaxDelay time.Duration
+	// RateLimiterQPS is the field that stores the value of the --<controller>-rate-limiter-qps flag.
+	// For further details, please see the description of the flag.
+	RateLimiterQPS float64
+	// RateLimiterBurst is the field that stores the value of the --<controller>-rate-limiter-burst flag.
+	// For further details, please see the description of the flag.
+	RateLimiterBurst int
+
+	// CacheSyncTimeout is the field that stores the value of the --<controller>-cache-sync-timeout flag.
+	// For further details, please see the description of the flag.
+	CacheSyncTimeout time.Duration
+	// ResyncPeriod is the field that stores the value of the --<controller>-resync-period flag.
+	// For further details, please see the description of the flag.
+	// NOTE: This value is not part of the controller.Options returned by GetControllerOptions, it has to be passed to the controller.
+	ResyncPeriod time.Duration
+}
+
+// AddControllerOptions adds the queue and cache options flags for the controller with the given name to the flag set.
+func AddControllerOptions(fs *pflag.FlagSet, name string, options *ControllerOptions) {
+	fs.Var(&optionalBoolValue{value: &options.UsePriorityQueue}, fmt.Sprintf("%s-use-priority-queue", name),
+		fmt.Sprintf("Use the controller-runtime priority queue for the %s controller. The priority queue reconciles changes before "+
+			"routine resyncs, e.g. after a restart of the controller. If not set, the value of the PriorityQueue feature gate is used.", name))
+	fs.Lookup(fmt.Sprintf("%s-use-priority-queue", name)).NoOptDefVal = "true"
+
+	fs.DurationVar(&options.RateLimiterBaseDelay, fmt.Sprintf("%s-rate-limiter-base-delay", name), 0,
+		fmt.Sprintf("Base delay of the per-item exponential backoff used when requeuing objects in the %s controller. "+
+			"If not set, %s is used. Setting any of the --%s-rate-limiter-* flags replaces the controller-runtime default rate limiter.", name, defaultRateLimiterBaseDelay, name))
+
+	fs.DurationVar(&options.RateLimiterMaxDelay, fmt.Sprintf("%s-rate-limiter-max-delay", name), 0,
+		fmt.Sprintf("Max delay of the per-item exponential backoff used when requeuing objects in the %s controller. "+
+			"If not set, %s is used.", name, defaultRateLimiterMaxDelay))
+
+	fs.Float64Var(&options.RateLimiterQPS, fmt.Sprintf("%s-rate-limiter-qps", name), 0,
+		fmt.Sprintf("Overall number of requeues per second allowed in the %s controller. "+
+			"If not set, the overall rate limit is not enforced when using a custom rate limiter.", name))
+
+	fs.IntVar(&options.RateLimiterBurst, fmt.Sprintf("%s-rate-limiter-burst", name), 0,
+		fmt.Sprintf("Overall burst of requeues allowed in the %s controller. Required if --%s-rate-limiter-qps is set.", name, name))
+
+	fs.DurationVar(&options.CacheSyncTimeout, fmt.Sprintf("%s-cache-sync-timeout", name), 0,
+		fmt.Sprintf("Time to wait for the caches used by the %s controller to sync when the controller starts. "+
+			"If not set, the controller-runtime default of 2m is used.", name))
+
+	fs.DurationVar(&options.ResyncPeriod, fmt.Sprintf("%s-resync-period", name), 0,
+		fmt.Sprintf("Period after which all objects of the %s controller are requeued, in addition to the resyncs triggered by --sync-period. "+
+			"If not set, objects are only resynced when the caches are resynced.", name))
+}
+
+// GetControllerOptions returns options which can be used to configure a controller.
+// This function should be used with the corresponding AddControllerOptions func.
+func GetControllerOptions(options ControllerOptions, concurrency int) (controller.Options, error) {
+	if options.CacheSyncTimeout < 0 || options.ResyncPeriod < 0 {
+		return controller.Options{}, errors.New("cache sync timeout and resync period must not be negative")
+	}
+
+	controllerOptions := controller.Options{
+		MaxConcurrentReconciles: concurrency,
+		UsePriorityQueue:        options.UsePriorityQueue,
+		CacheSyncTimeout:        options.CacheSyncTimeout,
+	}
+
+	// If no rate limiter flag is set, use the controller-runtime default rate limiter.
+	if options.RateLimiterBaseDelay == 0 && options.RateLimiterMaxDelay == 0 && options.RateLimiterQPS == 0 && options.RateLimiterBurst == 0 {
+		return controllerOptions, nil
+	}
+
+	baseDelay := options.RateLimiterBaseDelay
+	if baseDelay == 0 {
+		baseDelay = defaultRateLimiterBaseDelay
+	}
+	maxDelay := options.RateLimiterMaxDelay
+	if maxDelay == 0 {
+		maxDelay = defaultRateLimiterMaxDelay
+	}
+	if baseDelay < 0 || maxDelay < 0 {
+		return controller.Options{}, errors.New("rate limiter base delay and max delay must not be negative")
+	}
+	if baseDelay > maxDelay {
+		return controller.Options{}, errors.Errorf("rate limiter base delay %s must not be greater than max delay %s", baseDelay, maxDelay)
+	}
+	if options.RateLimiterQPS < 0 || options.RateLimiterBurst < 0 {
+		return controller.Options{}, errors.New("rate limiter qps and burst must not be negative")
+	}
+	if options.RateLimiterQPS > 0 && options.RateLimiterBurst == 0 {
+		return controller.Options{}, errors.New("rate limiter burst must be set if rate limiter qps is set")
+	}
+
+	var rateLimiter workqueue.TypedRateLimiter[reconcile.Request] = workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](baseDelay, maxDelay)
+	if options.RateLimiterQPS > 0 {
+		rateLimiter = workqueue.NewTypedMaxOfRateLimiter(
+			rateLimiter,
+			&workqueue.TypedBucketRateLimiter[reconcile.Request]{Limiter: rate.NewLimiter(rate.Limit(options.RateLimiterQPS), options.RateLimiterBurst)},
+		)
+	}
+	controllerOptions.RateLimiter = rateLimiter
+
+	return controllerOptions, nil
+}
+
+// optionalBoolValue is a pflag.Value for a bool flag which is nil if the flag is not set.
+type optionalBoolValue struct {
+	value **bool
+}
+
+func (v *optionalBoolValue) String() string {
+	if v.value == nil || *v.value == nil {
+		return ""
+	}
+	return strconv.FormatBool(**v.value)
+}
+
+func (v *optionalBoolValue) Set(s string) error {
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	*v.value = ptr.To(b)
+	return nil
+}
+
+func (v *optionalBoolValue) Type() string {
+	return "bool"
+}
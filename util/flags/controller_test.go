@@ -0,0 +1,193 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestAddControllerOptions(t *testing.T) {
+	tests := []struct {
+		name                  string
+		args                  []string
+		wantControllerOptions ControllerOptions
+	}{
+		{
+			name:                  "no flags",
+			args:                  []string{},
+			wantControllerOptions: ControllerOptions{},
+		},
+		{
+			name: "use priority queue without value",
+			args: []string{"--machine-use-priority-queue"},
+			wantControllerOptions: ControllerOptions{
+				UsePriorityQueue: ptr.To(true),
+			},
+		},
+		{
+			name: "all flags",
+			args: []string{
+				"--machine-use-priority-queue=false",
+				"--machine-rate-limiter-base-delay=10ms",
+				"--machine-rate-limiter-max-delay=5m",
+				"--machine-rate-limiter-qps=20",
+				"--machine-rate-limiter-burst=200",
+				"--machine-cache-sync-timeout=5m",
+				"--machine-resync-period=30m",
+			},
+			wantControllerOptions: ControllerOptions{
+				UsePriorityQueue:     ptr.To(false),
+				RateLimiterBaseDelay: 10 * time.Millisecond,
+				RateLimiterMaxDelay:  5 * time.Minute,
+				RateLimiterQPS:       20,
+				RateLimiterBurst:     200,
+				CacheSyncTimeout:     5 * time.Minute,
+				ResyncPeriod:         30 * time.Minute,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			options := ControllerOptions{}
+			AddControllerOptions(fs, "machine", &options)
+
+			g.Expect(fs.Parse(tt.args)).To(Succeed())
+			g.Expect(options).To(Equal(tt.wantControllerOptions))
+		})
+	}
+}
+
+func TestGetControllerOptions(t *testing.T) {
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "foo"}}
+
+	tests := []struct {
+		name                 string
+		controllerOptions    ControllerOptions
+		wantUsePriorityQueue *bool
+		wantRateLimiter      bool
+		wantFirstDelay       time.Duration
+		wantCacheSyncTimeout time.Duration
+		wantErr              bool
+	}{
+		{
+			name:              "defaults",
+			controllerOptions: ControllerOptions{},
+			wantRateLimiter:   false,
+		},
+		{
+			name: "priority queue",
+			controllerOptions: ControllerOptions{
+				UsePriorityQueue: ptr.To(true),
+			},
+			wantUsePriorityQueue: ptr.To(true),
+			wantRateLimiter:      false,
+		},
+		{
+			name: "cache sync timeout",
+			controllerOptions: ControllerOptions{
+				CacheSyncTimeout: 5 * time.Minute,
+			},
+			wantCacheSyncTimeout: 5 * time.Minute,
+			wantRateLimiter:      false,
+		},
+		{
+			name: "negative cache sync timeout",
+			controllerOptions: ControllerOptions{
+				CacheSyncTimeout: -1 * time.Minute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative resync period",
+			controllerOptions: ControllerOptions{
+				ResyncPeriod: -1 * time.Minute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "custom base delay",
+			controllerOptions: ControllerOptions{
+				RateLimiterBaseDelay: 1 * time.Second,
+			},
+			wantRateLimiter: true,
+			wantFirstDelay:  1 * time.Second,
+		},
+		{
+			name: "custom qps and burst",
+			controllerOptions: ControllerOptions{
+				RateLimiterQPS:   10,
+				RateLimiterBurst: 100,
+			},
+			wantRateLimiter: true,
+			wantFirstDelay:  defaultRateLimiterBaseDelay,
+		},
+		{
+			name: "base delay greater than max delay",
+			controllerOptions: ControllerOptions{
+				RateLimiterBaseDelay: 10 * time.Minute,
+				RateLimiterMaxDelay:  1 * time.Minute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative qps",
+			controllerOptions: ControllerOptions{
+				RateLimiterQPS: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "qps without burst",
+			controllerOptions: ControllerOptions{
+				RateLimiterQPS: 10,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			options, err := GetControllerOptions(tt.controllerOptions, 5)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			g.Expect(options.MaxConcurrentReconciles).To(Equal(5))
+			g.Expect(options.UsePriorityQueue).To(Equal(tt.wantUsePriorityQueue))
+			g.Expect(options.CacheSyncTimeout).To(Equal(tt.wantCacheSyncTimeout))
+			if !tt.wantRateLimiter {
+				g.Expect(options.RateLimiter).To(BeNil())
+				return
+			}
+			g.Expect(options.RateLimiter).ToNot(BeNil())
+			g.Expect(options.RateLimiter.When(req)).To(Equal(tt.wantFirstDelay))
+		})
+	}
+}
@@ -18,6 +18,7 @@ limitations under the License.
 package kubeconfig
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"crypto/x509"
@@ -188,6 +189,46 @@ func NeedsClientCertRotation(configSecret *corev1.Secret, threshold time.Duratio
 	return false, nil
 }
 
+// NeedsCABundleUpdate returns whether the Kubeconfig secret must be regenerated because the cluster CA certificates changed,
+// e.g. during a CA rotation. This happens when the CA certificates in the Kubeconfig are different from the given CA bundle,
+// or when any of the client certificates is not signed by the first certificate in the given CA bundle.
+func NeedsCABundleUpdate(configSecret *corev1.Secret, caBundle []byte) (bool, error) {
+	caCert, err := certs.DecodeCertPEM(caBundle)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to decode CA Cert")
+	} else if caCert == nil {
+		return false, errors.New("CA certificate not found")
+	}
+
+	data, err := toKubeconfigBytes(configSecret)
+	if err != nil {
+		return false, err
+	}
+
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to convert kubeconfig Secret into a clientcmdapi.Config")
+	}
+
+	for _, cluster := range config.Clusters {
+		if !bytes.Equal(cluster.CertificateAuthorityData, caBundle) {
+			return true, nil
+		}
+	}
+
+	for _, authInfo := range config.AuthInfos {
+		cert, err := certs.DecodeCertPEM(authInfo.ClientCertificateData)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to decode kubeconfig client certificate")
+		}
+		if cert.CheckSignatureFrom(caCert) != nil {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // RegenerateSecret creates and stores a new Kubeconfig in the given secret.
 func RegenerateSecret(ctx context.Context, c client.Client, configSecret *corev1.Secret, options ...KubeConfigOption) error {
 	clusterName, _, err := secret.ParseSecretName(configSecret.Name)
@@ -241,6 +282,10 @@ func generateKubeconfig(ctx context.Context, c client.Client, clusterName client
 		return nil, errors.Wrap(err, "failed to generate a kubeconfig")
 	}
 
+	// Trust all the certificates in the CA bundle, not only the one used for signing the client certificate;
+	// this allows clients to keep working during a CA rotation, when the CA bundle contains both the old and the new CA.
+	cfg.Clusters[clusterName.Name].CertificateAuthorityData = clusterCA.Data[secret.TLSCrtDataName]
+
 	out, err := clientcmd.Write(*cfg)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to serialize config to yaml")
@@ -435,6 +435,75 @@ func TestNeedsClientCertRotation(t *testing.T) {
 	g.Expect(NeedsClientCertRotation(kubeconfigSecret, certs.DefaultCertDuration-time.Hour)).To(BeFalse())
 }
 
+func TestNeedsCABundleUpdate(t *testing.T) {
+	g := NewWithT(t)
+
+	oldCAKey, err := certs.NewPrivateKey()
+	g.Expect(err).ToNot(HaveOccurred())
+	oldCACert, err := getTestCACert(oldCAKey)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	newCAKey, err := certs.NewPrivateKey()
+	g.Expect(err).ToNot(HaveOccurred())
+	newCACert, err := getTestCACert(newCAKey)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	oldCABundle := certs.EncodeCertPEM(oldCACert)
+	oldAndNewCABundle := append(certs.EncodeCertPEM(oldCACert), certs.EncodeCertPEM(newCACert)...)
+	newAndOldCABundle := append(certs.EncodeCertPEM(newCACert), certs.EncodeCertPEM(oldCACert)...)
+
+	generateKubeconfigSecret := func(caKey *rsa.PrivateKey, caBundle []byte) *corev1.Secret {
+		caSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test1-ca",
+				Namespace: "test",
+			},
+			Data: map[string][]byte{
+				secret.TLSKeyDataName: certs.EncodePrivateKeyPEM(caKey),
+				secret.TLSCrtDataName: caBundle,
+			},
+		}
+		c := fake.NewClientBuilder().WithObjects(caSecret).Build()
+
+		clusterName := client.ObjectKey{Name: "test1", Namespace: "test"}
+		g.Expect(CreateSecretWithOwner(ctx, c, clusterName, "localhost:6443", metav1.OwnerReference{})).To(Succeed())
+
+		s, err := secret.GetFromNamespacedName(ctx, c, clusterName, secret.Kubeconfig)
+		g.Expect(err).ToNot(HaveOccurred())
+		return s
+	}
+
+	// Kubeconfig generated with the old CA only.
+	kubeconfigSecret := generateKubeconfigSecret(oldCAKey, oldCABundle)
+	g.Expect(NeedsCABundleUpdate(kubeconfigSecret, oldCABundle)).To(BeFalse())
+	g.Expect(NeedsCABundleUpdate(kubeconfigSecret, oldAndNewCABundle)).To(BeTrue())
+
+	// Kubeconfig generated while rotating the CA, signed by the old CA and trusting both the old and the new CA.
+	kubeconfigSecret = generateKubeconfigSecret(oldCAKey, oldAndNewCABundle)
+	restClient, err := clientcmd.RESTConfigFromKubeConfig(kubeconfigSecret.Data[secret.KubeconfigDataName])
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(restClient.CAData).To(Equal(oldAndNewCABundle))
+	g.Expect(NeedsCABundleUpdate(kubeconfigSecret, oldAndNewCABundle)).To(BeFalse())
+	g.Expect(NeedsCABundleUpdate(kubeconfigSecret, newAndOldCABundle)).To(BeTrue())
+
+	// Kubeconfig generated while rotating the CA, signed by the new CA and trusting both the new and the old CA.
+	kubeconfigSecret = generateKubeconfigSecret(newCAKey, newAndOldCABundle)
+	g.Expect(NeedsCABundleUpdate(kubeconfigSecret, newAndOldCABundle)).To(BeFalse())
+
+	// The client certificate is signed by a CA which is not the first one in the bundle.
+	kubeconfigSecret = generateKubeconfigSecret(oldCAKey, oldAndNewCABundle)
+	config, err := clientcmd.Load(kubeconfigSecret.Data[secret.KubeconfigDataName])
+	g.Expect(err).ToNot(HaveOccurred())
+	config.Clusters["test1"].CertificateAuthorityData = newAndOldCABundle
+	kubeconfigSecret.Data[secret.KubeconfigDataName], err = clientcmd.Write(*config)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(NeedsCABundleUpdate(kubeconfigSecret, newAndOldCABundle)).To(BeTrue())
+
+	// Invalid CA bundle.
+	_, err = NeedsCABundleUpdate(kubeconfigSecret, []byte("invalid"))
+	g.Expect(err).To(HaveOccurred())
+}
+
 func TestRegenerateClientCerts(t *testing.T) {
 	g := NewWithT(t)
 	caKey, err := certs.NewPrivateKey()
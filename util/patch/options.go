@@ -30,6 +30,14 @@ type HelperOptions struct {
 	// on the incoming object to match metadata.generation, only if there is a change.
 	IncludeStatusObservedGeneration bool
 
+	// StatusOnly restricts the patch helper to only patch the status of the object, including conditions;
+	// changes to metadata, spec and any other top-level field are not persisted.
+	StatusOnly bool
+
+	// SpecOnly restricts the patch helper to only patch metadata, spec and any other top-level field
+	// which is not status; changes to status, including conditions, are not persisted.
+	SpecOnly bool
+
 	// ForceOverwriteConditions allows the patch helper to overwrite conditions in case of conflicts.
 	// This option should only ever be set in controller managing the object being patched.
 	ForceOverwriteConditions bool
@@ -75,6 +83,26 @@ func (w WithStatusObservedGeneration) ApplyToHelper(in *HelperOptions) {
 	in.IncludeStatusObservedGeneration = true
 }
 
+// WithStatusOnly restricts the patch helper to only patch the status of the object, including conditions.
+// Changes to metadata, spec and any other top-level field are not persisted.
+// This option should be used by controllers that must never mutate anything but status.
+type WithStatusOnly struct{}
+
+// ApplyToHelper applies this configuration to the given HelperOptions.
+func (w WithStatusOnly) ApplyToHelper(in *HelperOptions) {
+	in.StatusOnly = true
+}
+
+// WithSpecOnly restricts the patch helper to only patch metadata, spec and any other top-level field which is not status.
+// Changes to status, including conditions, are not persisted.
+// This option should be used by controllers that must never mutate status.
+type WithSpecOnly struct{}
+
+// ApplyToHelper applies this configuration to the given HelperOptions.
+func (w WithSpecOnly) ApplyToHelper(in *HelperOptions) {
+	in.SpecOnly = true
+}
+
 // WithOwnedV1Beta1Conditions allows to define condition types owned by the controller.
 // In case of conflicts for the owned conditions, the patch helper will always use the value provided by the controller.
 type WithOwnedV1Beta1Conditions struct {
@@ -114,6 +114,12 @@ func (h *Helper) Patch(ctx context.Context, obj client.Object, opts ...Option) e
 	for _, opt := range opts {
 		opt.ApplyToHelper(options)
 	}
+	if options.StatusOnly && options.SpecOnly {
+		return errors.Errorf("failed to patch %s %s: WithStatusOnly and WithSpecOnly options are mutually exclusive", h.gvk.Kind, klog.KObj(h.beforeObject))
+	}
+	if options.SpecOnly && options.IncludeStatusObservedGeneration {
+		return errors.Errorf("failed to patch %s %s: WithSpecOnly and WithStatusObservedGeneration options are mutually exclusive", h.gvk.Kind, klog.KObj(h.beforeObject))
+	}
 
 	// If condition field path override have been provided, propagate them to the helper for usage in various places of this func.
 	if len(options.Clusterv1ConditionsFieldPath) > 0 {
@@ -162,6 +168,14 @@ func (h *Helper) Patch(ctx context.Context, obj client.Object, opts ...Option) e
 		return errors.Wrapf(err, "failed to patch %s %s", h.gvk.Kind, klog.KObj(h.beforeObject))
 	}
 
+	// Drop the changes to be skipped when the patch is restricted to status or spec.
+	switch {
+	case options.StatusOnly:
+		h.changes = h.changes.Intersection(sets.New[string]("status"))
+	case options.SpecOnly:
+		h.changes.Delete("status")
+	}
+
 	// Issue patches and return errors in an aggregate.
 	var errs []error
 	// Patch the conditions first.
@@ -169,8 +183,10 @@ func (h *Helper) Patch(ctx context.Context, obj client.Object, opts ...Option) e
 	// Given that we pass in metadata.resourceVersion to perform a 3-way-merge conflict resolution,
 	// patching conditions first avoids an extra loop if spec or status patch succeeds first
 	// given that causes the resourceVersion to mutate.
-	if err := h.patchStatusConditions(ctx, obj, options.ForceOverwriteConditions, options.OwnedConditions, options.OwnedV1Beta2Conditions); err != nil {
-		errs = append(errs, errors.Wrapf(err, "failed to patch status conditions"))
+	if !options.SpecOnly {
+		if err := h.patchStatusConditions(ctx, obj, options.ForceOverwriteConditions, options.OwnedConditions, options.OwnedV1Beta2Conditions); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to patch status conditions"))
+		}
 	}
 	// Then proceed to patch the rest of the object.
 	if err := h.patch(ctx, obj); err != nil {
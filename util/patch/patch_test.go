@@ -750,6 +750,122 @@ func TestPatchHelper(t *testing.T) {
 					cmp.Equal(obj.Spec, objAfter.Spec)
 			}, timeout).Should(BeTrue())
 		})
+
+		t.Run("updating only status, and adding a condition when using WithStatusOnly option", func(t *testing.T) {
+			g := NewWithT(t)
+
+			obj := obj.DeepCopy()
+
+			t.Log("Creating the object")
+			g.Expect(env.Create(ctx, obj)).To(Succeed())
+			defer func() {
+				g.Expect(env.Delete(ctx, obj)).To(Succeed())
+			}()
+			key := client.ObjectKey{Name: obj.Name, Namespace: obj.Namespace}
+
+			t.Log("Checking that the object has been created")
+			g.Eventually(func() error {
+				obj := obj.DeepCopy()
+				return env.Get(ctx, key, obj)
+			}).Should(Succeed())
+
+			objBefore := obj.DeepCopy()
+
+			t.Log("Creating a new patch helper")
+			patcher, err := NewHelper(obj, env)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			t.Log("Updating the object spec and metadata")
+			obj.Spec.Paused = ptr.To(true)
+			obj.Labels = map[string]string{"foo": "bar"}
+
+			t.Log("Updating the object status")
+			obj.Status.Initialization.InfrastructureProvisioned = ptr.To(true)
+
+			t.Log("Setting Ready condition")
+			conditions.Set(obj, metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, Reason: "AllGood", LastTransitionTime: now})
+
+			t.Log("Patching the object")
+			g.Expect(patcher.Patch(ctx, obj, WithStatusOnly{})).To(Succeed())
+
+			t.Log("Validating only the object status has been updated")
+			g.Eventually(func() bool {
+				objAfter := obj.DeepCopy()
+				if err := env.Get(ctx, key, objAfter); err != nil {
+					return false
+				}
+
+				return cmp.Equal(obj.Status.Initialization, objAfter.Status.Initialization) &&
+					conditions.IsTrue(objAfter, "Ready") &&
+					cmp.Equal(objBefore.Spec, objAfter.Spec) &&
+					cmp.Equal(objBefore.Labels, objAfter.Labels)
+			}, timeout).Should(BeTrue())
+		})
+
+		t.Run("updating only spec and metadata when using WithSpecOnly option", func(t *testing.T) {
+			g := NewWithT(t)
+
+			obj := obj.DeepCopy()
+
+			t.Log("Creating the object")
+			g.Expect(env.Create(ctx, obj)).To(Succeed())
+			defer func() {
+				g.Expect(env.Delete(ctx, obj)).To(Succeed())
+			}()
+			key := client.ObjectKey{Name: obj.Name, Namespace: obj.Namespace}
+
+			t.Log("Checking that the object has been created")
+			g.Eventually(func() error {
+				obj := obj.DeepCopy()
+				return env.Get(ctx, key, obj)
+			}).Should(Succeed())
+
+			objBefore := obj.DeepCopy()
+
+			t.Log("Creating a new patch helper")
+			patcher, err := NewHelper(obj, env)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			t.Log("Updating the object spec and metadata")
+			obj.Spec.Paused = ptr.To(true)
+			obj.Labels = map[string]string{"foo": "bar"}
+
+			t.Log("Updating the object status")
+			obj.Status.Initialization.InfrastructureProvisioned = ptr.To(true)
+
+			t.Log("Setting Ready condition")
+			conditions.Set(obj, metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, Reason: "AllGood", LastTransitionTime: now})
+
+			t.Log("Patching the object")
+			g.Expect(patcher.Patch(ctx, obj, WithSpecOnly{})).To(Succeed())
+
+			t.Log("Validating only the object spec and metadata have been updated")
+			g.Eventually(func() bool {
+				objAfter := obj.DeepCopy()
+				if err := env.Get(ctx, key, objAfter); err != nil {
+					return false
+				}
+
+				return cmp.Equal(obj.Spec, objAfter.Spec) &&
+					cmp.Equal(obj.Labels, objAfter.Labels) &&
+					cmp.Equal(objBefore.Status.Initialization, objAfter.Status.Initialization) &&
+					!conditions.Has(objAfter, "Ready")
+			}, timeout).Should(BeTrue())
+		})
+
+		t.Run("returning an error when using both WithStatusOnly and WithSpecOnly options", func(t *testing.T) {
+			g := NewWithT(t)
+
+			obj := obj.DeepCopy()
+
+			t.Log("Creating a new patch helper")
+			patcher, err := NewHelper(obj, env)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			t.Log("Patching the object")
+			g.Expect(patcher.Patch(ctx, obj, WithStatusOnly{}, WithSpecOnly{})).ToNot(Succeed())
+			g.Expect(patcher.Patch(ctx, obj, WithSpecOnly{}, WithStatusObservedGeneration{})).ToNot(Succeed())
+		})
 	})
 
 	t.Run("should patch a corev1.ConfigMap object", func(t *testing.T) {
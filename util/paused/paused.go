@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -90,12 +91,32 @@ func EnsurePausedCondition(ctx context.Context, c client.Client, cluster *cluste
 	return isPaused, true, nil
 }
 
+// clusterPausedMessage returns the message for a paused Cluster, including the information from spec.pause, if any.
+func clusterPausedMessage(cluster *clusterv1.Cluster) string {
+	var details []string
+	if cluster.Spec.Pause.Reason != "" {
+		details = append(details, fmt.Sprintf("reason: %q", cluster.Spec.Pause.Reason))
+	}
+	if cluster.Spec.Pause.RequestedBy != "" {
+		details = append(details, fmt.Sprintf("requested by: %q", cluster.Spec.Pause.RequestedBy))
+	}
+	if !cluster.Spec.Pause.ExpireAfter.IsZero() {
+		details = append(details, fmt.Sprintf("expires after: %s", cluster.Spec.Pause.ExpireAfter.UTC().Format(time.RFC3339)))
+	}
+
+	message := "Cluster spec.paused is set to true"
+	if len(details) > 0 {
+		message += fmt.Sprintf(" (%s)", strings.Join(details, "; "))
+	}
+	return message
+}
+
 // pausedCondition sets the paused condition on the object and returns if it should be considered as paused.
 func pausedCondition(scheme *runtime.Scheme, cluster *clusterv1.Cluster, obj ConditionSetter, targetConditionType string) metav1.Condition {
 	if (cluster != nil && ptr.Deref(cluster.Spec.Paused, false)) || annotations.HasPaused(obj) {
 		var messages []string
 		if cluster != nil && ptr.Deref(cluster.Spec.Paused, false) {
-			messages = append(messages, "Cluster spec.paused is set to true")
+			messages = append(messages, clusterPausedMessage(cluster))
 		}
 		if annotations.HasPaused(obj) {
 			kind := "Object"
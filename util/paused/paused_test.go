@@ -20,6 +20,7 @@ package paused
 import (
 	"context"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -148,3 +149,45 @@ func assertCondition(g Gomega, object ConditionSetter, wantIsPaused bool) {
 		g.Expect(condition.Message).To(BeEmpty())
 	}
 }
+
+func Test_clusterPausedMessage(t *testing.T) {
+	tests := []struct {
+		name  string
+		pause clusterv1.ClusterPauseSpec
+		want  string
+	}{
+		{
+			name: "without pause details",
+			want: "Cluster spec.paused is set to true",
+		},
+		{
+			name: "with reason",
+			pause: clusterv1.ClusterPauseSpec{
+				Reason: "Maintenance",
+			},
+			want: `Cluster spec.paused is set to true (reason: "Maintenance")`,
+		},
+		{
+			name: "with all pause details",
+			pause: clusterv1.ClusterPauseSpec{
+				Reason:      "Maintenance",
+				RequestedBy: "fleet-operator",
+				ExpireAfter: metav1.Date(2025, time.March, 9, 9, 0, 0, 0, time.UTC),
+			},
+			want: `Cluster spec.paused is set to true (reason: "Maintenance"; requested by: "fleet-operator"; expires after: 2025-03-09T09:00:00Z)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cluster := &clusterv1.Cluster{
+				Spec: clusterv1.ClusterSpec{
+					Paused: ptr.To(true),
+					Pause:  tt.pause,
+				},
+			}
+			g.Expect(clusterPausedMessage(cluster)).To(Equal(tt.want))
+		})
+	}
+}
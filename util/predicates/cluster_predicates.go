@@ -220,6 +220,38 @@ func ClusterPausedTransitions(scheme *runtime.Scheme, logger logr.Logger) predic
 	}
 }
 
+// ClusterDegradedFreezeTransitions returns a predicate that returns true for an update event when
+// the DegradedFreeze condition on a Cluster changes from or to true.
+func ClusterDegradedFreezeTransitions(scheme *runtime.Scheme, logger logr.Logger) predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			log := logger.WithValues("predicate", "ClusterDegradedFreezeTransitions", "eventType", "update")
+			if gvk, err := apiutil.GVKForObject(e.ObjectOld, scheme); err == nil {
+				log = log.WithValues(gvk.Kind, klog.KObj(e.ObjectOld))
+			}
+
+			oldCluster, ok := e.ObjectOld.(*clusterv1.Cluster)
+			if !ok {
+				log.V(4).Info("Expected Cluster", "type", fmt.Sprintf("%T", e.ObjectOld))
+				return false
+			}
+
+			newCluster := e.ObjectNew.(*clusterv1.Cluster)
+
+			if conditions.IsTrue(oldCluster, clusterv1.ClusterDegradedFreezeCondition) != conditions.IsTrue(newCluster, clusterv1.ClusterDegradedFreezeCondition) {
+				log.V(6).Info("Cluster DegradedFreeze changed, allowing further processing")
+				return true
+			}
+
+			log.V(6).Info("Cluster DegradedFreeze was not changed, blocking further processing")
+			return false
+		},
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
 // ClusterControlPlaneInitialized returns a Predicate that returns true on Update events
 // when ControlPlaneInitializedCondition on a Cluster changes to true.
 // Example use:
@@ -99,3 +99,73 @@ func TestClusterControlplaneInitializedPredicate(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterDegradedFreezeTransitionsPredicate(t *testing.T) {
+	predicate := predicates.ClusterDegradedFreezeTransitions(runtime.NewScheme(), logr.New(log.NullLogSink{}))
+
+	markedFalse := clusterv1.Cluster{}
+	conditions.Set(&markedFalse, metav1.Condition{Type: clusterv1.ClusterDegradedFreezeCondition, Status: metav1.ConditionFalse})
+
+	markedTrue := clusterv1.Cluster{}
+	conditions.Set(&markedTrue, metav1.Condition{Type: clusterv1.ClusterDegradedFreezeCondition, Status: metav1.ConditionTrue})
+
+	notMarked := clusterv1.Cluster{}
+
+	testcases := []struct {
+		name       string
+		oldCluster clusterv1.Cluster
+		newCluster clusterv1.Cluster
+		expected   bool
+	}{
+		{
+			name:       "no conditions -> no conditions: should return false",
+			oldCluster: notMarked,
+			newCluster: notMarked,
+			expected:   false,
+		},
+		{
+			name:       "no conditions -> true: should return true",
+			oldCluster: notMarked,
+			newCluster: markedTrue,
+			expected:   true,
+		},
+		{
+			name:       "false -> true: should return true",
+			oldCluster: markedFalse,
+			newCluster: markedTrue,
+			expected:   true,
+		},
+		{
+			name:       "true -> false: should return true",
+			oldCluster: markedTrue,
+			newCluster: markedFalse,
+			expected:   true,
+		},
+		{
+			name:       "false -> no conditions: should return false",
+			oldCluster: markedFalse,
+			newCluster: notMarked,
+			expected:   false,
+		},
+		{
+			name:       "true -> true: should return false",
+			oldCluster: markedTrue,
+			newCluster: markedTrue,
+			expected:   false,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ev := event.UpdateEvent{
+				ObjectOld: &tc.oldCluster,
+				ObjectNew: &tc.newCluster,
+			}
+
+			g.Expect(predicate.Update(ev)).To(Equal(tc.expected))
+		})
+	}
+}
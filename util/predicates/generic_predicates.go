@@ -25,6 +25,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/labels"
 )
@@ -309,6 +310,49 @@ func processIfTopologyOwned(scheme *runtime.Scheme, logger logr.Logger, obj clie
 	return false
 }
 
+// ResourceIsTopologyManaged returns a predicate that returns true only if the resource is managed by a
+// Cluster topology, i.e. if the resource is a Cluster with a topology or if it has the
+// `topology.cluster.x-k8s.io/owned` label.
+func ResourceIsTopologyManaged(scheme *runtime.Scheme, logger logr.Logger) predicate.Funcs {
+	return TypedResourceIsTopologyManaged[client.Object](scheme, logger)
+}
+
+// TypedResourceIsTopologyManaged returns a predicate that returns true only if the resource is managed by a
+// Cluster topology, i.e. if the resource is a Cluster with a topology or if it has the
+// `topology.cluster.x-k8s.io/owned` label.
+func TypedResourceIsTopologyManaged[T client.Object](scheme *runtime.Scheme, logger logr.Logger) predicate.TypedFuncs[T] {
+	return predicate.TypedFuncs[T]{
+		UpdateFunc: func(e event.TypedUpdateEvent[T]) bool {
+			return processIfResourceTopologyManaged(scheme, logger.WithValues("predicate", "ResourceIsTopologyManaged", "eventType", "update"), e.ObjectNew)
+		},
+		CreateFunc: func(e event.TypedCreateEvent[T]) bool {
+			return processIfResourceTopologyManaged(scheme, logger.WithValues("predicate", "ResourceIsTopologyManaged", "eventType", "create"), e.Object)
+		},
+		DeleteFunc: func(e event.TypedDeleteEvent[T]) bool {
+			return processIfResourceTopologyManaged(scheme, logger.WithValues("predicate", "ResourceIsTopologyManaged", "eventType", "delete"), e.Object)
+		},
+		GenericFunc: func(e event.TypedGenericEvent[T]) bool {
+			return processIfResourceTopologyManaged(scheme, logger.WithValues("predicate", "ResourceIsTopologyManaged", "eventType", "generic"), e.Object)
+		},
+	}
+}
+
+func processIfResourceTopologyManaged(scheme *runtime.Scheme, logger logr.Logger, obj client.Object) bool {
+	if gvk, err := apiutil.GVKForObject(obj, scheme); err == nil {
+		logger = logger.WithValues(gvk.Kind, klog.KObj(obj))
+	}
+	if cluster, ok := obj.(*clusterv1.Cluster); ok && cluster.Spec.Topology.IsDefined() {
+		logger.V(6).Info("Resource is a Cluster with topology, will attempt to map resource")
+		return true
+	}
+	if labels.IsTopologyOwned(obj) {
+		logger.V(6).Info("Resource is topology owned, will attempt to map resource")
+		return true
+	}
+	logger.V(6).Info("Resource is not topology managed, will not attempt to map resource")
+	return false
+}
+
 // ResourceIsChanged returns a predicate that returns true only if the resource
 // has changed. This predicate allows to drop resync events on additionally watched objects.
 func ResourceIsChanged(scheme *runtime.Scheme, logger logr.Logger) predicate.Funcs {
@@ -0,0 +1,157 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// ResourceNamespaceMatchesSelector returns a predicate that returns true only if the Namespace of the resource
+// matches the given label selector. A nil or empty selector matches all the Namespaces.
+// NOTE: Namespaces are read using the given client; when using a cached client, this requires permissions to
+// list and watch Namespaces.
+// Example use:
+//
+//	func (r *MyReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+//		controller, err := ctrl.NewControllerManagedBy(mgr).
+//			For(&v1.MyType{}).
+//			WithOptions(options).
+//			WithEventFilter(predicates.ResourceNamespaceMatchesSelector(ctx, mgr.GetClient(), mgr.GetScheme(), r.Log, r.NamespaceSelector)).
+//			Build(r)
+//		return err
+//	}
+func ResourceNamespaceMatchesSelector(ctx context.Context, c client.Reader, scheme *runtime.Scheme, logger logr.Logger, selector labels.Selector) predicate.Funcs {
+	return TypedResourceNamespaceMatchesSelector[client.Object](ctx, c, scheme, logger, selector)
+}
+
+// TypedResourceNamespaceMatchesSelector returns a predicate that returns true only if the Namespace of the resource
+// matches the given label selector. A nil or empty selector matches all the Namespaces.
+func TypedResourceNamespaceMatchesSelector[T client.Object](ctx context.Context, c client.Reader, scheme *runtime.Scheme, logger logr.Logger, selector labels.Selector) predicate.TypedFuncs[T] {
+	return predicate.TypedFuncs[T]{
+		UpdateFunc: func(e event.TypedUpdateEvent[T]) bool {
+			return processIfNamespaceMatchesSelector(ctx, c, scheme, logger.WithValues("predicate", "ResourceNamespaceMatchesSelector", "eventType", "update"), e.ObjectNew, selector)
+		},
+		CreateFunc: func(e event.TypedCreateEvent[T]) bool {
+			return processIfNamespaceMatchesSelector(ctx, c, scheme, logger.WithValues("predicate", "ResourceNamespaceMatchesSelector", "eventType", "create"), e.Object, selector)
+		},
+		DeleteFunc: func(e event.TypedDeleteEvent[T]) bool {
+			return processIfNamespaceMatchesSelector(ctx, c, scheme, logger.WithValues("predicate", "ResourceNamespaceMatchesSelector", "eventType", "delete"), e.Object, selector)
+		},
+		GenericFunc: func(e event.TypedGenericEvent[T]) bool {
+			return processIfNamespaceMatchesSelector(ctx, c, scheme, logger.WithValues("predicate", "ResourceNamespaceMatchesSelector", "eventType", "generic"), e.Object, selector)
+		},
+	}
+}
+
+func processIfNamespaceMatchesSelector(ctx context.Context, c client.Reader, scheme *runtime.Scheme, logger logr.Logger, obj client.Object, selector labels.Selector) bool {
+	// Return early if no selector was set.
+	if selector == nil || selector.Empty() {
+		return true
+	}
+
+	if gvk, err := apiutil.GVKForObject(obj, scheme); err == nil {
+		logger = logger.WithValues(gvk.Kind, klog.KObj(obj))
+	}
+	if obj.GetNamespace() == "" {
+		logger.V(4).Info("Resource is not namespaced, will not attempt to map resource")
+		return false
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := c.Get(ctx, client.ObjectKey{Name: obj.GetNamespace()}, namespace); err != nil {
+		logger.Error(err, "Failed to get Namespace, will not attempt to map resource")
+		return false
+	}
+	if selector.Matches(labels.Set(namespace.GetLabels())) {
+		logger.V(6).Info("Namespace matches selector, will attempt to map resource")
+		return true
+	}
+	logger.V(4).Info("Namespace does not match selector, will not attempt to map resource")
+	return false
+}
+
+// ClusterLabelSelectorMatches returns a predicate that returns true only if the Cluster of the resource matches
+// the given label selector. For Clusters the labels of the Cluster itself are used, for other resources the labels of
+// the Cluster referenced by the `cluster.x-k8s.io/cluster-name` label. A nil or empty selector matches all the Clusters.
+// NOTE: Clusters are read using the given client only for resources which are not Clusters.
+func ClusterLabelSelectorMatches(ctx context.Context, c client.Reader, scheme *runtime.Scheme, logger logr.Logger, selector labels.Selector) predicate.Funcs {
+	return TypedClusterLabelSelectorMatches[client.Object](ctx, c, scheme, logger, selector)
+}
+
+// TypedClusterLabelSelectorMatches returns a predicate that returns true only if the Cluster of the resource matches
+// the given label selector. For Clusters the labels of the Cluster itself are used, for other resources the labels of
+// the Cluster referenced by the `cluster.x-k8s.io/cluster-name` label. A nil or empty selector matches all the Clusters.
+func TypedClusterLabelSelectorMatches[T client.Object](ctx context.Context, c client.Reader, scheme *runtime.Scheme, logger logr.Logger, selector labels.Selector) predicate.TypedFuncs[T] {
+	return predicate.TypedFuncs[T]{
+		UpdateFunc: func(e event.TypedUpdateEvent[T]) bool {
+			return processIfClusterMatchesSelector(ctx, c, scheme, logger.WithValues("predicate", "ClusterLabelSelectorMatches", "eventType", "update"), e.ObjectNew, selector)
+		},
+		CreateFunc: func(e event.TypedCreateEvent[T]) bool {
+			return processIfClusterMatchesSelector(ctx, c, scheme, logger.WithValues("predicate", "ClusterLabelSelectorMatches", "eventType", "create"), e.Object, selector)
+		},
+		DeleteFunc: func(e event.TypedDeleteEvent[T]) bool {
+			return processIfClusterMatchesSelector(ctx, c, scheme, logger.WithValues("predicate", "ClusterLabelSelectorMatches", "eventType", "delete"), e.Object, selector)
+		},
+		GenericFunc: func(e event.TypedGenericEvent[T]) bool {
+			return processIfClusterMatchesSelector(ctx, c, scheme, logger.WithValues("predicate", "ClusterLabelSelectorMatches", "eventType", "generic"), e.Object, selector)
+		},
+	}
+}
+
+func processIfClusterMatchesSelector(ctx context.Context, c client.Reader, scheme *runtime.Scheme, logger logr.Logger, obj client.Object, selector labels.Selector) bool {
+	// Return early if no selector was set.
+	if selector == nil || selector.Empty() {
+		return true
+	}
+
+	if gvk, err := apiutil.GVKForObject(obj, scheme); err == nil {
+		logger = logger.WithValues(gvk.Kind, klog.KObj(obj))
+	}
+
+	cluster, ok := obj.(*clusterv1.Cluster)
+	if !ok {
+		clusterName, ok := obj.GetLabels()[clusterv1.ClusterNameLabel]
+		if !ok || clusterName == "" {
+			logger.V(4).Info("Resource does not have the cluster-name label, will not attempt to map resource")
+			return false
+		}
+		cluster = &clusterv1.Cluster{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: obj.GetNamespace(), Name: clusterName}, cluster); err != nil {
+			logger.Error(err, "Failed to get Cluster, will not attempt to map resource", "Cluster", klog.KRef(obj.GetNamespace(), clusterName))
+			return false
+		}
+	}
+
+	if selector.Matches(labels.Set(cluster.GetLabels())) {
+		logger.V(6).Info("Cluster matches selector, will attempt to map resource")
+		return true
+	}
+	logger.V(4).Info("Cluster does not match selector, will not attempt to map resource")
+	return false
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/predicates"
+)
+
+func TestResourceIsTopologyManaged(t *testing.T) {
+	testcases := []struct {
+		name     string
+		obj      client.Object
+		expected bool
+	}{
+		{
+			name:     "Cluster with topology",
+			obj:      &clusterv1.Cluster{Spec: clusterv1.ClusterSpec{Topology: clusterv1.Topology{ClassRef: clusterv1.ClusterClassRef{Name: "class"}}}},
+			expected: true,
+		},
+		{
+			name:     "Cluster without topology",
+			obj:      &clusterv1.Cluster{},
+			expected: false,
+		},
+		{
+			name:     "topology owned resource",
+			obj:      &clusterv1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{clusterv1.ClusterTopologyOwnedLabel: ""}}},
+			expected: true,
+		},
+		{
+			name:     "resource not topology owned",
+			obj:      &clusterv1.MachineDeployment{},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			predicate := predicates.ResourceIsTopologyManaged(runtime.NewScheme(), logr.New(log.NullLogSink{}))
+			g.Expect(predicate.Create(event.CreateEvent{Object: tc.obj})).To(Equal(tc.expected))
+			g.Expect(predicate.Update(event.UpdateEvent{ObjectOld: tc.obj, ObjectNew: tc.obj})).To(Equal(tc.expected))
+			g.Expect(predicate.Delete(event.DeleteEvent{Object: tc.obj})).To(Equal(tc.expected))
+			g.Expect(predicate.Generic(event.GenericEvent{Object: tc.obj})).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestResourceNamespaceMatchesSelector(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "b"}}},
+	).Build()
+
+	testcases := []struct {
+		name     string
+		selector labels.Selector
+		obj      client.Object
+		expected bool
+	}{
+		{
+			name:     "nil selector matches all the Namespaces",
+			obj:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "team-b"}},
+			expected: true,
+		},
+		{
+			name:     "Namespace matches selector",
+			selector: labels.SelectorFromSet(labels.Set{"team": "a"}),
+			obj:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"}},
+			expected: true,
+		},
+		{
+			name:     "Namespace does not match selector",
+			selector: labels.SelectorFromSet(labels.Set{"team": "a"}),
+			obj:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "team-b"}},
+			expected: false,
+		},
+		{
+			name:     "Namespace does not exist",
+			selector: labels.SelectorFromSet(labels.Set{"team": "a"}),
+			obj:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "team-c"}},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			predicate := predicates.TypedResourceNamespaceMatchesSelector[*clusterv1.Cluster](context.Background(), c, scheme, logr.New(log.NullLogSink{}), tc.selector)
+			cluster := tc.obj.(*clusterv1.Cluster)
+			g.Expect(predicate.Create(event.TypedCreateEvent[*clusterv1.Cluster]{Object: cluster})).To(Equal(tc.expected))
+			g.Expect(predicate.Update(event.TypedUpdateEvent[*clusterv1.Cluster]{ObjectOld: cluster, ObjectNew: cluster})).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestClusterLabelSelectorMatches(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "prod", Labels: map[string]string{"env": "prod"}}},
+		&clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "dev", Labels: map[string]string{"env": "dev"}}},
+	).Build()
+	selector := labels.SelectorFromSet(labels.Set{"env": "prod"})
+	machine := func(clusterName string) client.Object {
+		m := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine"}}
+		if clusterName != "" {
+			m.Labels = map[string]string{clusterv1.ClusterNameLabel: clusterName}
+		}
+		return m
+	}
+
+	testcases := []struct {
+		name     string
+		selector labels.Selector
+		obj      client.Object
+		expected bool
+	}{
+		{
+			name:     "empty selector matches all the Clusters",
+			selector: labels.Everything(),
+			obj:      machine("dev"),
+			expected: true,
+		},
+		{
+			name:     "Cluster matches selector",
+			selector: selector,
+			obj:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"env": "prod"}}},
+			expected: true,
+		},
+		{
+			name:     "Cluster does not match selector",
+			selector: selector,
+			obj:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"env": "dev"}}},
+			expected: false,
+		},
+		{
+			name:     "Cluster of the resource matches selector",
+			selector: selector,
+			obj:      machine("prod"),
+			expected: true,
+		},
+		{
+			name:     "Cluster of the resource does not match selector",
+			selector: selector,
+			obj:      machine("dev"),
+			expected: false,
+		},
+		{
+			name:     "resource without cluster-name label",
+			selector: selector,
+			obj:      machine(""),
+			expected: false,
+		},
+		{
+			name:     "Cluster of the resource does not exist",
+			selector: selector,
+			obj:      machine("test"),
+			expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			predicate := predicates.ClusterLabelSelectorMatches(context.Background(), c, scheme, logr.New(log.NullLogSink{}), tc.selector)
+			g.Expect(predicate.Create(event.CreateEvent{Object: tc.obj})).To(Equal(tc.expected))
+			g.Expect(predicate.Update(event.UpdateEvent{ObjectOld: tc.obj, ObjectNew: tc.obj})).To(Equal(tc.expected))
+			g.Expect(predicate.Delete(event.DeleteEvent{Object: tc.obj})).To(Equal(tc.expected))
+			g.Expect(predicate.Generic(event.GenericEvent{Object: tc.obj})).To(Equal(tc.expected))
+		})
+	}
+}
@@ -362,6 +362,21 @@ func (c *Certificate) Hashes() ([]string, error) {
 	return out, nil
 }
 
+// BundleHash returns a hash of all the certificates stored in a CA certificate.
+// NOTE: The hash changes whenever a certificate is added to or removed from the CA bundle, or when the order
+// of certificates in the bundle changes (the first certificate is the one used for signing).
+func (c *Certificate) BundleHash() (string, error) {
+	if c.KeyPair == nil {
+		return "", errors.Wrapf(ErrMissingCertificate, "unable to compute %s certificate bundle hash", c.Purpose)
+	}
+	hashes, err := c.Hashes()
+	if err != nil {
+		return "", err
+	}
+	bundleHash := sha256.Sum256([]byte(strings.Join(hashes, ",")))
+	return hex.EncodeToString(bundleHash[:]), nil
+}
+
 // hashCert calculates the sha256 of certificate.
 func hashCert(certificate *x509.Certificate) string {
 	spkiHash := sha256.Sum256(certificate.RawSubjectPublicKeyInfo)
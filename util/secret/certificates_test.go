@@ -95,3 +95,34 @@ func TestNewCertificatesForInitialControlPlane(t *testing.T) {
 		})
 	}
 }
+
+func TestCertificateBundleHash(t *testing.T) {
+	g := NewWithT(t)
+
+	oldCA := &secret.Certificate{Purpose: secret.ClusterCA}
+	g.Expect(oldCA.Generate()).To(Succeed())
+	newCA := &secret.Certificate{Purpose: secret.ClusterCA}
+	g.Expect(newCA.Generate()).To(Succeed())
+
+	bundleHash := func(certificates ...*secret.Certificate) string {
+		bundle := &secret.Certificate{Purpose: secret.ClusterCA, KeyPair: &certs.KeyPair{}}
+		for _, c := range certificates {
+			bundle.KeyPair.Cert = append(bundle.KeyPair.Cert, c.KeyPair.Cert...)
+		}
+		hash, err := bundle.BundleHash()
+		g.Expect(err).ToNot(HaveOccurred())
+		return hash
+	}
+
+	// The hash is stable.
+	g.Expect(bundleHash(oldCA)).To(Equal(bundleHash(oldCA)))
+
+	// The hash changes when certificates are added, removed or re-ordered in the bundle.
+	g.Expect(bundleHash(oldCA, newCA)).ToNot(Equal(bundleHash(oldCA)))
+	g.Expect(bundleHash(newCA, oldCA)).ToNot(Equal(bundleHash(oldCA, newCA)))
+	g.Expect(bundleHash(newCA)).ToNot(Equal(bundleHash(newCA, oldCA)))
+
+	// The hash can't be computed for a missing certificate.
+	_, err := (&secret.Certificate{Purpose: secret.ClusterCA}).BundleHash()
+	g.Expect(err).To(HaveOccurred())
+}
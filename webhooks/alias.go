@@ -70,28 +70,83 @@ func (webhook *ClusterClass) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	}).SetupWebhookWithManager(mgr)
 }
 
+// ClusterTemplate implements a validation webhook for ClusterTemplate.
+type ClusterTemplate struct {
+	// AllowSecretsAndClusterResourceSets allows ClusterTemplates to contain Secrets and ClusterResourceSets.
+	AllowSecretsAndClusterResourceSets bool
+}
+
+// SetupWebhookWithManager sets up ClusterTemplate webhooks.
+func (webhook *ClusterTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return (&webhooks.ClusterTemplate{
+		AllowSecretsAndClusterResourceSets: webhook.AllowSecretsAndClusterResourceSets,
+	}).SetupWebhookWithManager(mgr)
+}
+
+// ClusterTemplateInstance implements a validation webhook for ClusterTemplateInstance.
+type ClusterTemplateInstance struct{}
+
+// SetupWebhookWithManager sets up ClusterTemplateInstance webhooks.
+func (webhook *ClusterTemplateInstance) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return (&webhooks.ClusterTemplateInstance{}).SetupWebhookWithManager(mgr)
+}
+
+// VersionSkewValidationPolicy defines how the Machine, MachineSet and MachineDeployment webhooks handle
+// versions that do not conform to the Kubernetes version skew policy relative to the control plane.
+type VersionSkewValidationPolicy = webhooks.VersionSkewValidationPolicy
+
+const (
+	// VersionSkewValidationPolicyEnforce rejects versions that do not conform to the Kubernetes version skew policy.
+	VersionSkewValidationPolicyEnforce = webhooks.VersionSkewValidationPolicyEnforce
+
+	// VersionSkewValidationPolicyWarn accepts versions that do not conform to the Kubernetes version skew policy,
+	// but returns a warning to the client.
+	VersionSkewValidationPolicyWarn = webhooks.VersionSkewValidationPolicyWarn
+
+	// VersionSkewValidationPolicyIgnore disables the version skew validation.
+	VersionSkewValidationPolicyIgnore = webhooks.VersionSkewValidationPolicyIgnore
+)
+
 // Machine implements a validating and defaulting webhook for Machine.
-type Machine struct{}
+type Machine struct {
+	Client                      client.Reader
+	VersionSkewValidationPolicy VersionSkewValidationPolicy
+}
 
 // SetupWebhookWithManager sets up Machine webhooks.
 func (webhook *Machine) SetupWebhookWithManager(mgr ctrl.Manager) error {
-	return (&webhooks.Machine{}).SetupWebhookWithManager(mgr)
+	return (&webhooks.Machine{
+		Client:                      webhook.Client,
+		VersionSkewValidationPolicy: webhook.VersionSkewValidationPolicy,
+	}).SetupWebhookWithManager(mgr)
 }
 
 // MachineDeployment implements a validating and defaulting webhook for MachineDeployment.
-type MachineDeployment struct{}
+type MachineDeployment struct {
+	Client                      client.Reader
+	VersionSkewValidationPolicy VersionSkewValidationPolicy
+}
 
 // SetupWebhookWithManager sets up MachineDeployment webhooks.
 func (webhook *MachineDeployment) SetupWebhookWithManager(mgr ctrl.Manager) error {
-	return (&webhooks.MachineDeployment{}).SetupWebhookWithManager(mgr)
+	return (&webhooks.MachineDeployment{
+		Client:                      webhook.Client,
+		VersionSkewValidationPolicy: webhook.VersionSkewValidationPolicy,
+	}).SetupWebhookWithManager(mgr)
 }
 
 // MachineSet implements a validating and defaulting webhook for MachineSet.
-type MachineSet struct{}
+type MachineSet struct {
+	Client                      client.Reader
+	VersionSkewValidationPolicy VersionSkewValidationPolicy
+}
 
 // SetupWebhookWithManager sets up MachineSet webhooks.
 func (webhook *MachineSet) SetupWebhookWithManager(mgr ctrl.Manager) error {
-	return (&webhooks.MachineSet{}).SetupWebhookWithManager(mgr)
+	return (&webhooks.MachineSet{
+		Client:                      webhook.Client,
+		VersionSkewValidationPolicy: webhook.VersionSkewValidationPolicy,
+	}).SetupWebhookWithManager(mgr)
 }
 
 // MachineHealthCheck implements a validating and defaulting webhook for MachineHealthCheck.